@@ -0,0 +1,499 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// SeedEmailDomain marks seeded users so -reset can find and remove seeded data
+const SeedEmailDomain = "seed.example.com"
+
+// Fee rules mirror ticketing-service reservation_service
+var (
+	platformFeePercent int64 = 5
+	serviceFee               = money.New(2500)
+)
+
+// wib is Asia/Jakarta as a fixed zone so generation does not depend on tzdata
+var wib = time.FixedZone("WIB", 7*60*60)
+
+// Config controls the volume and shape of generated data
+type Config struct {
+	Organizers         int
+	Customers          int
+	EventsPerOrganizer int
+	OrdersPerEvent     int // Average historical orders per published event
+	Seed               int64
+	Now                time.Time
+	PasswordHash       string
+}
+
+// Dataset is the generated demo data, ordered for insertion
+type Dataset struct {
+	Users      []User
+	Events     []Event
+	Tiers      []Tier
+	Orders     []Order
+	OrderItems []OrderItem
+	Tickets    []Ticket
+	Payments   []Payment
+}
+
+// User is a seeded users row
+type User struct {
+	ID           string
+	Email        string
+	PasswordHash string
+	FullName     string
+	Phone        string
+	Role         string
+	CreatedAt    time.Time
+}
+
+// Event is a seeded events row
+type Event struct {
+	ID          string
+	OrganizerID string
+	Title       string
+	Slug        string
+	Description string
+	Category    string
+	Location    string
+	Venue       string
+	StartDate   time.Time
+	EndDate     time.Time
+	Status      string
+	CreatedAt   time.Time
+}
+
+// Tier is a seeded ticket_tiers row
+type Tier struct {
+	ID          string
+	EventID     string
+	Name        string
+	Description string
+	Price       money.Money
+	Quota       int
+	SoldCount   int
+	MaxPerOrder int
+	CreatedAt   time.Time
+}
+
+// Order is a seeded orders row
+type Order struct {
+	ID                   string
+	UserID               string
+	EventID              string
+	TotalAmount          money.Money
+	PlatformFee          money.Money
+	ServiceFee           money.Money
+	GrandTotal           money.Money
+	Status               string
+	PaymentMethod        *string
+	PaymentID            *string
+	ReservationExpiresAt time.Time
+	PaidAt               *time.Time
+	CompletedAt          *time.Time
+	CreatedAt            time.Time
+}
+
+// OrderItem is a seeded order_items row
+type OrderItem struct {
+	ID           string
+	OrderID      string
+	TicketTierID string
+	Quantity     int
+	Price        money.Money
+	Subtotal     money.Money
+	CreatedAt    time.Time
+}
+
+// Ticket is a seeded tickets row
+// QRCode is filled by the writer, generating images is slow for large volumes
+type Ticket struct {
+	ID           string
+	OrderID      string
+	OrderItemID  string
+	TicketTierID string
+	EventID      string
+	UserID       string
+	TicketNumber string
+	QRData       string
+	Status       string
+	ValidatedAt  *time.Time
+	CreatedAt    time.Time
+}
+
+// Payment is a seeded payment_transactions row
+type Payment struct {
+	ID            string
+	OrderID       string
+	ExternalID    string
+	InvoiceID     string
+	InvoiceURL    string
+	Amount        money.Money
+	PaymentMethod *string
+	Status        string
+	PaidAt        *time.Time
+	ExpiresAt     time.Time
+	CreatedAt     time.Time
+}
+
+type categoryProfile struct {
+	basePrice int64 // Regular tier price in Rupiah
+	titles    []string
+}
+
+var categories = []string{"music", "sports", "arts", "technology", "food", "business", "education", "other"}
+
+var categoryProfiles = map[string]categoryProfile{
+	"music":      {350000, []string{"Jazz Night", "Indie Fest", "Symphony Gala", "Rock Revival", "Acoustic Sessions", "K-Pop Party"}},
+	"sports":     {200000, []string{"City Marathon", "Futsal Cup", "Badminton Open", "Fun Run", "Esports Showdown"}},
+	"arts":       {150000, []string{"Wayang Kulit Show", "Contemporary Art Fair", "Theatre Night", "Batik Exhibition", "Dance Showcase"}},
+	"technology": {500000, []string{"DevFest", "Cloud Summit", "AI Conference", "Startup Demo Day", "Hackathon"}},
+	"food":       {100000, []string{"Street Food Festival", "Coffee Expo", "Kuliner Nusantara", "Wine & Cheese Evening"}},
+	"business":   {750000, []string{"Leadership Forum", "Fintech Summit", "Marketing Masterclass", "Investor Meetup"}},
+	"education":  {100000, []string{"Career Fair", "Scholarship Expo", "Data Science Bootcamp", "Parenting Seminar"}},
+	"other":      {75000, []string{"Community Meetup", "Charity Gala", "Pet Festival", "Book Fair"}},
+}
+
+var venues = map[string][]string{
+	"Jakarta":    {"Jakarta International Expo", "Istora Senayan", "Balai Sarbini"},
+	"Bandung":    {"Sabuga ITB", "Trans Studio Convention Center"},
+	"Surabaya":   {"Grand City Convention Hall", "Dyandra Convention Center"},
+	"Yogyakarta": {"Jogja Expo Center", "Taman Budaya Yogyakarta"},
+	"Bali":       {"Bali Nusa Dua Convention Center", "Garuda Wisnu Kencana"},
+	"Medan":      {"Santika Dyandra Convention Hall"},
+	"Makassar":   {"Celebes Convention Center"},
+}
+
+var cities = []string{"Jakarta", "Bandung", "Surabaya", "Yogyakarta", "Bali", "Medan", "Makassar"}
+
+var firstNames = []string{"Andi", "Budi", "Citra", "Dewi", "Eko", "Fajar", "Gita", "Hendra", "Indah", "Joko", "Kartika", "Lestari", "Made", "Nur", "Putri", "Rizky", "Sari", "Teguh", "Wulan", "Yoga"}
+
+var lastNames = []string{"Pratama", "Saputra", "Wijaya", "Kusuma", "Santoso", "Hidayat", "Nugroho", "Lestari", "Siregar", "Halim", "Gunawan", "Putra"}
+
+var paymentMethods = []string{"QRIS", "BANK_TRANSFER", "EWALLET", "CREDIT_CARD", "RETAIL_OUTLET"}
+
+var slugPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// generator holds state shared across the generation steps
+type generator struct {
+	cfg         Config
+	rng         *rand.Rand
+	ds          *Dataset
+	customers   []User
+	orderPrefix map[string]bool // Ticket numbers use the first 8 chars of the order ID
+}
+
+// Generate builds a deterministic dataset for cfg; the same Seed and Now yield the same data
+func Generate(cfg Config) *Dataset {
+	g := &generator{
+		cfg:         cfg,
+		rng:         rand.New(rand.NewSource(cfg.Seed)),
+		ds:          &Dataset{},
+		orderPrefix: make(map[string]bool),
+	}
+
+	g.ds.Users = append(g.ds.Users, User{
+		ID:           g.newID(),
+		Email:        "admin@" + SeedEmailDomain,
+		PasswordHash: cfg.PasswordHash,
+		FullName:     "Demo Admin",
+		Role:         "admin",
+		CreatedAt:    cfg.Now.AddDate(0, -12, 0),
+	})
+
+	organizers := make([]User, cfg.Organizers)
+	for i := range organizers {
+		organizers[i] = g.newUser("organizer", i+1)
+	}
+	g.customers = make([]User, cfg.Customers)
+	for i := range g.customers {
+		g.customers[i] = g.newUser("customer", i+1)
+	}
+	g.ds.Users = append(g.ds.Users, organizers...)
+	g.ds.Users = append(g.ds.Users, g.customers...)
+
+	for i, organizer := range organizers {
+		for j := 0; j < cfg.EventsPerOrganizer; j++ {
+			// Rotate categories so every category is covered
+			category := categories[(i*cfg.EventsPerOrganizer+j)%len(categories)]
+			g.generateEvent(organizer, category)
+		}
+	}
+
+	return g.ds
+}
+
+func (g *generator) newID() string {
+	id, err := uuid.NewRandomFromReader(g.rng)
+	if err != nil {
+		// math/rand readers never fail
+		panic(err)
+	}
+	return id.String()
+}
+
+func (g *generator) pick(values []string) string {
+	return values[g.rng.Intn(len(values))]
+}
+
+// between returns a random int in [min, max]
+func (g *generator) between(min, max int) int {
+	return min + g.rng.Intn(max-min+1)
+}
+
+// randomTime returns a random time in [from, to)
+func (g *generator) randomTime(from, to time.Time) time.Time {
+	if !to.After(from) {
+		return from
+	}
+	return from.Add(time.Duration(g.rng.Int63n(int64(to.Sub(from))))).Truncate(time.Second)
+}
+
+func (g *generator) newUser(role string, n int) User {
+	first := g.pick(firstNames)
+	last := g.pick(lastNames)
+	return User{
+		ID:           g.newID(),
+		Email:        fmt.Sprintf("%s%d@%s", role, n, SeedEmailDomain),
+		PasswordHash: g.cfg.PasswordHash,
+		FullName:     first + " " + last,
+		Phone:        fmt.Sprintf("+628%010d", g.rng.Int63n(10000000000)),
+		Role:         role,
+		CreatedAt:    g.randomTime(g.cfg.Now.AddDate(0, -12, 0), g.cfg.Now.AddDate(0, -6, 0)),
+	}
+}
+
+func (g *generator) generateEvent(organizer User, category string) {
+	profile := categoryProfiles[category]
+	city := g.pick(cities)
+	title := fmt.Sprintf("%s %s %d", g.pick(profile.titles), city, g.cfg.Now.Year())
+
+	// Spread events from four months ago to five months ahead, starting at typical hours
+	day := g.cfg.Now.In(wib).AddDate(0, 0, g.between(-120, 150))
+	start := time.Date(day.Year(), day.Month(), day.Day(), []int{10, 13, 16, 19}[g.rng.Intn(4)], 0, 0, 0, wib)
+	end := start.Add(time.Duration(g.between(2, 8)) * time.Hour)
+
+	createdBefore := start
+	if createdBefore.After(g.cfg.Now) {
+		createdBefore = g.cfg.Now
+	}
+	createdAt := createdBefore.Add(-time.Duration(g.between(14, 90)) * 24 * time.Hour)
+	if createdAt.Before(organizer.CreatedAt) {
+		createdAt = organizer.CreatedAt
+	}
+
+	status := "published"
+	switch roll := g.rng.Intn(100); {
+	case roll < 10 && start.After(g.cfg.Now):
+		status = "draft"
+	case roll < 15:
+		status = "cancelled"
+	}
+
+	event := Event{
+		ID:          g.newID(),
+		OrganizerID: organizer.ID,
+		Title:       title,
+		Category:    category,
+		Location:    city,
+		Venue:       g.pick(venues[city]),
+		StartDate:   start,
+		EndDate:     end,
+		Status:      status,
+		CreatedAt:   createdAt,
+	}
+	event.Slug = strings.Trim(slugPattern.ReplaceAllString(strings.ToLower(title), "-"), "-") + "-" + event.ID[:8]
+	event.Description = fmt.Sprintf("%s at %s, %s. Organized by %s.", title, event.Venue, city, organizer.FullName)
+	g.ds.Events = append(g.ds.Events, event)
+
+	tiers := g.generateTiers(event, profile.basePrice)
+
+	if status == "published" && len(g.customers) > 0 {
+		g.generateOrders(event, tiers)
+	}
+
+	for _, tier := range tiers {
+		g.ds.Tiers = append(g.ds.Tiers, *tier)
+	}
+}
+
+func (g *generator) generateTiers(event Event, basePrice int64) []*Tier {
+	type tierSpec struct {
+		name     string
+		pricePct int64
+		quotaMin int
+		quotaMax int
+	}
+	specs := []tierSpec{{"Regular", 100, 100, 500}}
+	switch g.rng.Intn(3) {
+	case 1:
+		specs = append(specs, tierSpec{"VIP", 250, 20, 100})
+	case 2:
+		specs = append([]tierSpec{{"Early Bird", 70, 50, 150}}, append(specs, tierSpec{"VIP", 250, 20, 100})...)
+	}
+
+	tiers := make([]*Tier, len(specs))
+	for i, spec := range specs {
+		// Round prices to Rp 5.000
+		price := basePrice * spec.pricePct / 100 / 5000 * 5000
+		tiers[i] = &Tier{
+			ID:          g.newID(),
+			EventID:     event.ID,
+			Name:        spec.name,
+			Description: fmt.Sprintf("%s admission for %s", spec.name, event.Title),
+			Price:       money.New(price),
+			Quota:       g.between(spec.quotaMin, spec.quotaMax),
+			MaxPerOrder: 5,
+			CreatedAt:   event.CreatedAt,
+		}
+	}
+	return tiers
+}
+
+func (g *generator) generateOrders(event Event, tiers []*Tier) {
+	salesEnd := event.StartDate
+	if salesEnd.After(g.cfg.Now) {
+		salesEnd = g.cfg.Now
+	}
+	eventPassed := event.EndDate.Before(g.cfg.Now)
+
+	count := 0
+	if g.cfg.OrdersPerEvent > 0 {
+		count = g.between(g.cfg.OrdersPerEvent/2, g.cfg.OrdersPerEvent*3/2)
+	}
+
+	for i := 0; i < count; i++ {
+		tier := tiers[g.rng.Intn(len(tiers))]
+		quantity := g.between(1, 4)
+
+		status := "paid"
+		switch roll := g.rng.Intn(100); {
+		case roll < 15:
+			status = "expired"
+		case roll < 25:
+			status = "cancelled"
+		}
+
+		// Only paid orders hold inventory; skip sales once a tier is sold out
+		if status == "paid" && tier.SoldCount+quantity > tier.Quota {
+			continue
+		}
+
+		customer := g.customers[g.rng.Intn(len(g.customers))]
+		createdAt := g.randomTime(event.CreatedAt, salesEnd)
+		subtotal := tier.Price.Mul(quantity)
+		platformFee := subtotal.Percent(platformFeePercent)
+
+		order := Order{
+			ID:                   g.newOrderID(),
+			UserID:               customer.ID,
+			EventID:              event.ID,
+			TotalAmount:          subtotal,
+			PlatformFee:          platformFee,
+			ServiceFee:           serviceFee,
+			GrandTotal:           subtotal.Add(platformFee).Add(serviceFee),
+			Status:               status,
+			ReservationExpiresAt: createdAt.Add(15 * time.Minute),
+			CreatedAt:            createdAt,
+		}
+
+		item := OrderItem{
+			ID:           g.newID(),
+			OrderID:      order.ID,
+			TicketTierID: tier.ID,
+			Quantity:     quantity,
+			Price:        tier.Price,
+			Subtotal:     subtotal,
+			CreatedAt:    createdAt,
+		}
+
+		payment := Payment{
+			ID:         g.newID(),
+			OrderID:    order.ID,
+			ExternalID: "ORDER-" + order.ID,
+			InvoiceID:  "seed-" + order.ID,
+			InvoiceURL: "https://checkout.xendit.co/web/seed-" + order.ID,
+			Amount:     order.GrandTotal,
+			Status:     "pending",
+			ExpiresAt:  order.ReservationExpiresAt,
+			CreatedAt:  createdAt,
+		}
+
+		switch status {
+		case "paid":
+			method := g.pick(paymentMethods)
+			paidAt := createdAt.Add(time.Duration(g.between(1, 14)) * time.Minute)
+			order.PaymentMethod = &method
+			order.PaymentID = &payment.InvoiceID
+			order.PaidAt = &paidAt
+			payment.PaymentMethod = &method
+			payment.Status = "paid"
+			payment.PaidAt = &paidAt
+			if eventPassed {
+				order.Status = "completed"
+				completedAt := event.EndDate
+				order.CompletedAt = &completedAt
+			}
+			tier.SoldCount += quantity
+			g.generateTickets(order, item, event, eventPassed)
+		case "expired":
+			payment.Status = "expired"
+		}
+
+		g.ds.Orders = append(g.ds.Orders, order)
+		g.ds.OrderItems = append(g.ds.OrderItems, item)
+		// Cancelled orders never reached the payment provider
+		if status != "cancelled" {
+			g.ds.Payments = append(g.ds.Payments, payment)
+		}
+	}
+}
+
+// newOrderID returns an order ID whose ticket number prefix is unique in the dataset
+func (g *generator) newOrderID() string {
+	for {
+		id := g.newID()
+		if !g.orderPrefix[id[:8]] {
+			g.orderPrefix[id[:8]] = true
+			return id
+		}
+	}
+}
+
+func (g *generator) generateTickets(order Order, item OrderItem, event Event, eventPassed bool) {
+	for n := 1; n <= item.Quantity; n++ {
+		ticket := Ticket{
+			ID:           g.newID(),
+			OrderID:      order.ID,
+			OrderItemID:  item.ID,
+			TicketTierID: item.TicketTierID,
+			EventID:      event.ID,
+			UserID:       order.UserID,
+			TicketNumber: fmt.Sprintf("TKT-%s-%03d", order.ID[:8], n),
+			Status:       "valid",
+			CreatedAt:    *order.PaidAt,
+		}
+		ticket.QRData = fmt.Sprintf("TICKET|%s|%s", ticket.ID, event.ID)
+
+		// Most attendees of past events showed up
+		if eventPassed {
+			if g.rng.Intn(100) < 85 {
+				validatedAt := g.randomTime(event.StartDate.Add(-time.Hour), event.StartDate.Add(time.Hour))
+				ticket.Status = "used"
+				ticket.ValidatedAt = &validatedAt
+			} else {
+				ticket.Status = "expired"
+			}
+		}
+		g.ds.Tickets = append(g.ds.Tickets, ticket)
+	}
+}
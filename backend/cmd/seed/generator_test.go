@@ -0,0 +1,97 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig() Config {
+	return Config{
+		Organizers:         4,
+		Customers:          30,
+		EventsPerOrganizer: 6,
+		OrdersPerEvent:     25,
+		Seed:               42,
+		Now:                time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC),
+		PasswordHash:       "hash",
+	}
+}
+
+func TestGenerate_Deterministic(t *testing.T) {
+	a := Generate(testConfig())
+	b := Generate(testConfig())
+	assert.Equal(t, a, b)
+
+	cfg := testConfig()
+	cfg.Seed = 7
+	assert.NotEqual(t, a.Events[0].ID, Generate(cfg).Events[0].ID)
+}
+
+func TestGenerate_Volume(t *testing.T) {
+	cfg := testConfig()
+	ds := Generate(cfg)
+
+	// Admin plus organizers and customers
+	assert.Len(t, ds.Users, 1+cfg.Organizers+cfg.Customers)
+	assert.Len(t, ds.Events, cfg.Organizers*cfg.EventsPerOrganizer)
+	assert.NotEmpty(t, ds.Orders)
+	assert.NotEmpty(t, ds.Tickets)
+
+	seen := map[string]bool{}
+	for _, event := range ds.Events {
+		seen[event.Category] = true
+	}
+	assert.Len(t, seen, len(categories), "every category should have events")
+}
+
+func TestGenerate_Consistency(t *testing.T) {
+	cfg := testConfig()
+	ds := Generate(cfg)
+
+	events := map[string]Event{}
+	for _, event := range ds.Events {
+		events[event.ID] = event
+	}
+
+	// Sold counts match issued tickets and never exceed quota
+	ticketsPerTier := map[string]int{}
+	for _, ticket := range ds.Tickets {
+		ticketsPerTier[ticket.TicketTierID]++
+	}
+	for _, tier := range ds.Tiers {
+		assert.LessOrEqual(t, tier.SoldCount, tier.Quota, "tier %s oversold", tier.ID)
+		assert.Equal(t, ticketsPerTier[tier.ID], tier.SoldCount, "tier %s sold count", tier.ID)
+	}
+
+	items := map[string]OrderItem{}
+	for _, item := range ds.OrderItems {
+		items[item.OrderID] = item
+	}
+
+	ticketNumbers := map[string]bool{}
+	for _, ticket := range ds.Tickets {
+		assert.False(t, ticketNumbers[ticket.TicketNumber], "duplicate ticket number %s", ticket.TicketNumber)
+		ticketNumbers[ticket.TicketNumber] = true
+	}
+
+	for _, order := range ds.Orders {
+		event := events[order.EventID]
+		assert.Equal(t, "published", event.Status, "orders only for published events")
+		assert.False(t, order.CreatedAt.After(cfg.Now), "order %s created in the future", order.ID)
+
+		item := items[order.ID]
+		assert.Equal(t, item.Price.Mul(item.Quantity), order.TotalAmount)
+		assert.Equal(t, order.TotalAmount.Add(order.PlatformFee).Add(order.ServiceFee), order.GrandTotal)
+
+		switch order.Status {
+		case "paid", "completed":
+			require.NotNil(t, order.PaidAt)
+			assert.Equal(t, order.Status == "completed", event.EndDate.Before(cfg.Now))
+		default:
+			assert.Nil(t, order.PaidAt)
+		}
+	}
+}
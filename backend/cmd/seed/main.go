@@ -0,0 +1,139 @@
+// Command seed fills the platform database with realistic demo data:
+// organizers, customers, events across all categories, ticket tiers,
+// and historical orders with tickets and payment transactions.
+//
+// Usage (from backend/):
+//
+//	go run ./cmd/seed -reset
+//	go run ./cmd/seed -size large -no-qr   # load test volume
+//
+// Seeded users share the password given by -password and use the
+// @seed.example.com email domain, which -reset uses to remove them.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+	_ "github.com/lib/pq"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// sizePresets are default volumes; explicit flags override them
+var sizePresets = map[string]Config{
+	"small":  {Organizers: 3, Customers: 25, EventsPerOrganizer: 4, OrdersPerEvent: 10},
+	"medium": {Organizers: 10, Customers: 200, EventsPerOrganizer: 8, OrdersPerEvent: 40},
+	"large":  {Organizers: 50, Customers: 5000, EventsPerOrganizer: 20, OrdersPerEvent: 150},
+}
+
+func main() {
+	size := flag.String("size", "small", "volume preset: small, medium or large")
+	organizers := flag.Int("organizers", 0, "number of organizers (overrides preset)")
+	customers := flag.Int("customers", 0, "number of customers (overrides preset)")
+	eventsPerOrganizer := flag.Int("events-per-organizer", 0, "events per organizer (overrides preset)")
+	ordersPerEvent := flag.Int("orders-per-event", 0, "average historical orders per published event (overrides preset)")
+	seed := flag.Int64("seed", 1, "random seed; the same seed generates the same data")
+	password := flag.String("password", "password123", "password for all seeded users")
+	reset := flag.Bool("reset", false, "remove previously seeded data before seeding")
+	noQR := flag.Bool("no-qr", false, "skip QR image generation for tickets (faster for large volumes)")
+	dryRun := flag.Bool("dry-run", false, "generate data and print counts without writing")
+	databaseURL := flag.String("database-url", "", "PostgreSQL URL (default: DATABASE_URL or DB_* env variables)")
+	flag.Parse()
+
+	cfg, ok := sizePresets[*size]
+	if !ok {
+		log.Fatalf("Unknown size %q (use small, medium or large)", *size)
+	}
+	overrideInt(&cfg.Organizers, *organizers)
+	overrideInt(&cfg.Customers, *customers)
+	overrideInt(&cfg.EventsPerOrganizer, *eventsPerOrganizer)
+	overrideInt(&cfg.OrdersPerEvent, *ordersPerEvent)
+	cfg.Seed = *seed
+	cfg.Now = time.Now().Truncate(time.Second)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(*password), bcrypt.DefaultCost)
+	if err != nil {
+		log.Fatalf("Failed to hash password: %v", err)
+	}
+	cfg.PasswordHash = string(hash)
+
+	start := time.Now()
+	ds := Generate(cfg)
+	log.Printf("Generated %d users, %d events, %d tiers, %d orders, %d tickets, %d payments in %v",
+		len(ds.Users), len(ds.Events), len(ds.Tiers), len(ds.Orders), len(ds.Tickets), len(ds.Payments),
+		time.Since(start).Round(time.Millisecond))
+
+	if *dryRun {
+		return
+	}
+
+	// Load .env from project root when run from backend/
+	if err := godotenv.Load("../.env"); err != nil {
+		log.Println("⚠️  Warning: .env file not found, using environment variables or defaults")
+	}
+
+	url := *databaseURL
+	if url == "" {
+		url = defaultDatabaseURL()
+	}
+
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		log.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	if err := db.PingContext(ctx); err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+
+	if *reset {
+		if err := Reset(ctx, db); err != nil {
+			log.Fatalf("Failed to reset seeded data: %v", err)
+		}
+		log.Println("✓ Removed previously seeded data")
+	}
+
+	if err := Write(ctx, db, ds, !*noQR); err != nil {
+		log.Fatalf("Failed to write seed data: %v (rerun with -reset if data was seeded before)", err)
+	}
+
+	log.Printf("✅ Seeding completed in %v", time.Since(start).Round(time.Millisecond))
+	log.Printf("   Login as admin@%s, organizer1@%s or customer1@%s with password %q",
+		SeedEmailDomain, SeedEmailDomain, SeedEmailDomain, *password)
+}
+
+func overrideInt(target *int, value int) {
+	if value > 0 {
+		*target = value
+	}
+}
+
+// defaultDatabaseURL builds the URL from DATABASE_URL or the DB_* variables used by the services
+func defaultDatabaseURL() string {
+	if url := os.Getenv("DATABASE_URL"); url != "" {
+		return url
+	}
+	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s",
+		getEnv("DB_USER", "postgres"),
+		getEnv("DB_PASSWORD", "postgres"),
+		getEnv("DB_HOST", "localhost"),
+		getEnv("DB_PORT", "5432"),
+		getEnv("DB_NAME", "ticketing_platform"),
+		getEnv("DB_SSL_MODE", "disable"),
+	)
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
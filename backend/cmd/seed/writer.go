@@ -0,0 +1,224 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"log"
+
+	"github.com/lib/pq"
+	"github.com/skip2/go-qrcode"
+)
+
+// Timezone stored on seeded events
+const eventTimezone = "Asia/Jakarta"
+
+// Reset removes previously seeded data, identified by the seed email domain
+// Rows created by real users on seeded events are removed too
+func Reset(ctx context.Context, db *sql.DB) error {
+	seededUsers := `SELECT id FROM users WHERE email LIKE '%@` + SeedEmailDomain + `'`
+	seededEvents := `SELECT id FROM events WHERE organizer_id IN (` + seededUsers + `)`
+	seededOrders := `SELECT id FROM orders WHERE event_id IN (` + seededEvents + `) OR user_id IN (` + seededUsers + `)`
+	archivedOrders := `SELECT id FROM orders_archive WHERE event_id IN (` + seededEvents + `) OR user_id IN (` + seededUsers + `)`
+
+	// Order matters: children before parents
+	statements := []string{
+		`DELETE FROM tickets_archive WHERE order_id IN (` + archivedOrders + `)`,
+		`DELETE FROM order_items_archive WHERE order_id IN (` + archivedOrders + `)`,
+		`DELETE FROM orders_archive WHERE id IN (` + archivedOrders + `)`,
+		`DELETE FROM tickets WHERE order_id IN (` + seededOrders + `)`,
+		`DELETE FROM refunds WHERE payment_transaction_id IN (SELECT id FROM payment_transactions WHERE order_id IN (` + seededOrders + `))`,
+		`DELETE FROM payment_transactions WHERE order_id IN (` + seededOrders + `)`,
+		`DELETE FROM orders WHERE id IN (` + seededOrders + `)`, // order_items cascade
+		`DELETE FROM events WHERE id IN (` + seededEvents + `)`, // ticket_tiers cascade
+		`DELETE FROM users WHERE id IN (` + seededUsers + `)`,
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, stmt := range statements {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to reset seeded data: %w", err)
+		}
+	}
+
+	if err := refreshAvailability(ctx, tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit reset: %w", err)
+	}
+	return nil
+}
+
+// Write inserts ds in a single transaction using COPY
+// withQR renders a QR image per ticket like ticketing-service does, which dominates run time at high volume
+func Write(ctx context.Context, db *sql.DB, ds *Dataset, withQR bool) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	users := make([][]interface{}, len(ds.Users))
+	for i, u := range ds.Users {
+		users[i] = []interface{}{u.ID, u.Email, u.PasswordHash, u.FullName, nullString(u.Phone), u.Role, true, u.CreatedAt, u.CreatedAt}
+	}
+	if err := copyRows(ctx, tx, "users", []string{
+		"id", "email", "password_hash", "full_name", "phone", "role", "is_email_verified", "created_at", "updated_at",
+	}, users); err != nil {
+		return err
+	}
+
+	events := make([][]interface{}, len(ds.Events))
+	for i, e := range ds.Events {
+		events[i] = []interface{}{
+			e.ID, e.OrganizerID, e.Title, e.Slug, e.Description, e.Category, e.Location, e.Venue,
+			e.StartDate, e.EndDate, eventTimezone, e.Status, e.CreatedAt, e.CreatedAt,
+		}
+	}
+	if err := copyRows(ctx, tx, "events", []string{
+		"id", "organizer_id", "title", "slug", "description", "category", "location", "venue",
+		"start_date", "end_date", "timezone", "status", "created_at", "updated_at",
+	}, events); err != nil {
+		return err
+	}
+
+	tiers := make([][]interface{}, len(ds.Tiers))
+	for i, t := range ds.Tiers {
+		tiers[i] = []interface{}{t.ID, t.EventID, t.Name, t.Description, t.Price, t.Quota, t.SoldCount, t.MaxPerOrder, t.CreatedAt, t.CreatedAt}
+	}
+	if err := copyRows(ctx, tx, "ticket_tiers", []string{
+		"id", "event_id", "name", "description", "price", "quota", "sold_count", "max_per_order", "created_at", "updated_at",
+	}, tiers); err != nil {
+		return err
+	}
+
+	orders := make([][]interface{}, len(ds.Orders))
+	for i, o := range ds.Orders {
+		var paidAmount, paidCurrency interface{}
+		if o.PaidAt != nil {
+			paidAmount, paidCurrency = o.GrandTotal, "IDR"
+		}
+		orders[i] = []interface{}{
+			o.ID, o.UserID, o.EventID, o.TotalAmount, o.PlatformFee, o.ServiceFee, o.GrandTotal, o.Status,
+			o.PaymentMethod, o.PaymentID, o.ReservationExpiresAt, o.PaidAt, o.CompletedAt, paidAmount, paidCurrency,
+			o.CreatedAt, o.CreatedAt,
+		}
+	}
+	if err := copyRows(ctx, tx, "orders", []string{
+		"id", "user_id", "event_id", "total_amount", "platform_fee", "service_fee", "grand_total", "status",
+		"payment_method", "payment_id", "reservation_expires_at", "paid_at", "completed_at", "paid_amount", "paid_currency",
+		"created_at", "updated_at",
+	}, orders); err != nil {
+		return err
+	}
+
+	items := make([][]interface{}, len(ds.OrderItems))
+	for i, it := range ds.OrderItems {
+		items[i] = []interface{}{it.ID, it.OrderID, it.TicketTierID, it.Quantity, it.Price, it.Subtotal, it.CreatedAt, it.CreatedAt}
+	}
+	if err := copyRows(ctx, tx, "order_items", []string{
+		"id", "order_id", "ticket_tier_id", "quantity", "price", "subtotal", "created_at", "updated_at",
+	}, items); err != nil {
+		return err
+	}
+
+	tickets := make([][]interface{}, len(ds.Tickets))
+	for i, t := range ds.Tickets {
+		var qrCode interface{}
+		if withQR {
+			code, err := generateQRCode(t.QRData)
+			if err != nil {
+				return err
+			}
+			qrCode = code
+		}
+		tickets[i] = []interface{}{
+			t.ID, t.OrderID, t.OrderItemID, t.TicketTierID, t.EventID, t.UserID, t.TicketNumber,
+			qrCode, t.QRData, t.Status, t.ValidatedAt, t.CreatedAt, t.CreatedAt,
+		}
+	}
+	if err := copyRows(ctx, tx, "tickets", []string{
+		"id", "order_id", "order_item_id", "ticket_tier_id", "event_id", "user_id", "ticket_number",
+		"qr_code", "qr_data", "status", "validated_at", "created_at", "updated_at",
+	}, tickets); err != nil {
+		return err
+	}
+
+	payments := make([][]interface{}, len(ds.Payments))
+	for i, p := range ds.Payments {
+		payments[i] = []interface{}{
+			p.ID, p.OrderID, p.ExternalID, p.InvoiceID, p.InvoiceURL, p.Amount, p.PaymentMethod, p.Status,
+			p.PaidAt, p.ExpiresAt, p.CreatedAt, p.CreatedAt,
+		}
+	}
+	if err := copyRows(ctx, tx, "payment_transactions", []string{
+		"id", "order_id", "external_id", "invoice_id", "invoice_url", "amount", "payment_method", "status",
+		"paid_at", "expires_at", "created_at", "updated_at",
+	}, payments); err != nil {
+		return err
+	}
+
+	if err := refreshAvailability(ctx, tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit seed data: %w", err)
+	}
+	return nil
+}
+
+// copyRows bulk loads rows into table with COPY FROM STDIN
+func copyRows(ctx context.Context, tx *sql.Tx, table string, columns []string, rows [][]interface{}) error {
+	stmt, err := tx.PrepareContext(ctx, pq.CopyIn(table, columns...))
+	if err != nil {
+		return fmt.Errorf("failed to prepare copy into %s: %w", table, err)
+	}
+	defer stmt.Close()
+
+	for _, row := range rows {
+		if _, err := stmt.ExecContext(ctx, row...); err != nil {
+			return fmt.Errorf("failed to copy row into %s: %w", table, err)
+		}
+	}
+
+	// Final Exec flushes buffered rows
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		return fmt.Errorf("failed to copy into %s: %w", table, err)
+	}
+
+	log.Printf("✓ %s: %d rows", table, len(rows))
+	return nil
+}
+
+// refreshAvailability rebuilds the event listing availability view for seeded tiers
+func refreshAvailability(ctx context.Context, tx *sql.Tx) error {
+	if _, err := tx.ExecContext(ctx, `REFRESH MATERIALIZED VIEW event_availability`); err != nil {
+		return fmt.Errorf("failed to refresh event availability: %w", err)
+	}
+	return nil
+}
+
+// generateQRCode matches ticketing-service utility.GenerateQRCode output
+func generateQRCode(data string) (string, error) {
+	png, err := qrcode.Encode(data, qrcode.Medium, 256)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate QR code: %w", err)
+	}
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(png), nil
+}
+
+func nullString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
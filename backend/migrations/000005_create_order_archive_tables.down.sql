@@ -0,0 +1,21 @@
+-- Restore archived rows to live tables before dropping archive tables
+-- archived_at is the only extra column, dropping it leaves the live column layout
+ALTER TABLE IF EXISTS orders_archive DROP COLUMN IF EXISTS archived_at;
+ALTER TABLE IF EXISTS order_items_archive DROP COLUMN IF EXISTS archived_at;
+ALTER TABLE IF EXISTS tickets_archive DROP COLUMN IF EXISTS archived_at;
+
+INSERT INTO orders SELECT * FROM orders_archive ON CONFLICT (id) DO NOTHING;
+INSERT INTO order_items SELECT * FROM order_items_archive ON CONFLICT (id) DO NOTHING;
+INSERT INTO tickets SELECT * FROM tickets_archive ON CONFLICT (id) DO NOTHING;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_orders_archival;
+
+-- Drop archive tables
+DROP TABLE IF EXISTS tickets_archive;
+DROP TABLE IF EXISTS order_items_archive;
+DROP TABLE IF EXISTS orders_archive;
+
+-- Restore payments_legacy foreign key (NOT VALID: legacy rows may point to removed orders)
+ALTER TABLE IF EXISTS payments_legacy
+  ADD CONSTRAINT payments_order_id_fkey FOREIGN KEY (order_id) REFERENCES orders(id) NOT VALID;
@@ -0,0 +1,39 @@
+-- Archive tables for terminal orders (expired, cancelled, completed)
+-- Rows are moved here by the ticketing-service archival worker after the retention period
+-- Archive tables have the columns of the live tables (LIKE) plus archived_at. Rows are copied by
+-- column name, so column order doesn't matter, but any column added to orders/order_items/tickets
+-- must also be added to its archive table (a plain ADD COLUMN), otherwise archival fails
+
+CREATE TABLE IF NOT EXISTS orders_archive (
+  LIKE orders INCLUDING DEFAULTS INCLUDING CONSTRAINTS,
+  archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS order_items_archive (
+  LIKE order_items INCLUDING DEFAULTS INCLUDING CONSTRAINTS,
+  archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS tickets_archive (
+  LIKE tickets INCLUDING DEFAULTS INCLUDING CONSTRAINTS,
+  archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (id)
+);
+
+-- Indexes for user history lookups
+CREATE INDEX IF NOT EXISTS idx_orders_archive_user ON orders_archive(user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_orders_archive_event ON orders_archive(event_id);
+CREATE INDEX IF NOT EXISTS idx_order_items_archive_order ON order_items_archive(order_id);
+CREATE INDEX IF NOT EXISTS idx_tickets_archive_order ON tickets_archive(order_id);
+CREATE INDEX IF NOT EXISTS idx_tickets_archive_user ON tickets_archive(user_id, created_at DESC);
+
+-- Index for archival candidate scan
+CREATE INDEX IF NOT EXISTS idx_orders_archival ON orders(updated_at)
+  WHERE status IN ('expired', 'cancelled', 'completed');
+
+-- payments_legacy (renamed in 000003) still references orders and would block archival
+-- The table is no longer written by any service, so the foreign key is dropped
+ALTER TABLE IF EXISTS payments_legacy
+  DROP CONSTRAINT IF EXISTS payments_order_id_fkey;
@@ -0,0 +1,8 @@
+-- Drop retention indexes
+DROP INDEX IF EXISTS idx_webhook_events_deleted;
+DROP INDEX IF EXISTS idx_webhook_events_retention;
+
+-- Remove retention columns
+ALTER TABLE webhook_events
+  DROP COLUMN IF EXISTS deleted_at,
+  DROP COLUMN IF EXISTS anonymized_at;
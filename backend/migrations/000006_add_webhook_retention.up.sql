@@ -0,0 +1,12 @@
+-- Retention support for webhook_events (payloads contain payer PII)
+-- anonymized_at: payload stripped down to non-PII fields
+-- deleted_at: soft-deleted, kept only for idempotency until purged
+ALTER TABLE webhook_events
+  ADD COLUMN IF NOT EXISTS anonymized_at TIMESTAMPTZ,
+  ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- Indexes for retention worker scans
+CREATE INDEX IF NOT EXISTS idx_webhook_events_retention ON webhook_events(created_at)
+  WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_webhook_events_deleted ON webhook_events(deleted_at)
+  WHERE deleted_at IS NOT NULL;
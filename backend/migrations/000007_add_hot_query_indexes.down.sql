@@ -0,0 +1,9 @@
+-- Restore indexes replaced by the index audit
+CREATE INDEX IF NOT EXISTS idx_payment_transactions_invoice ON payment_transactions(invoice_id);
+CREATE INDEX IF NOT EXISTS idx_tickets_user ON tickets(user_id);
+CREATE INDEX IF NOT EXISTS idx_orders_user ON orders(user_id);
+
+-- Drop audit indexes
+DROP INDEX IF EXISTS idx_tickets_user_created;
+DROP INDEX IF EXISTS idx_orders_status_expires;
+DROP INDEX IF EXISTS idx_orders_user_created;
@@ -0,0 +1,21 @@
+-- Index audit for hot query paths
+-- Migrations run as a single multi-statement Exec (implicit transaction), so CONCURRENTLY is not used
+
+-- Order history: WHERE user_id = $1 ORDER BY created_at DESC LIMIT/OFFSET
+-- Supersedes idx_orders_user (user_id is the leading column)
+CREATE INDEX IF NOT EXISTS idx_orders_user_created ON orders(user_id, created_at DESC);
+DROP INDEX IF EXISTS idx_orders_user;
+
+-- Reservation cleanup: WHERE status = $1 AND reservation_expires_at < $2
+-- idx_orders_cleanup is partial on status = 'reserved' and cannot serve generic (parameterized) plans
+CREATE INDEX IF NOT EXISTS idx_orders_status_expires ON orders(status, reservation_expires_at);
+
+-- My tickets: WHERE user_id = $1 ORDER BY created_at DESC
+-- Supersedes idx_tickets_user
+CREATE INDEX IF NOT EXISTS idx_tickets_user_created ON tickets(user_id, created_at DESC);
+DROP INDEX IF EXISTS idx_tickets_user;
+
+-- Webhook lookup: WHERE invoice_id = $1
+-- Already served by the UNIQUE constraint index (payment_transactions_invoice_id_key),
+-- idx_payment_transactions_invoice from 000003 is a redundant duplicate
+DROP INDEX IF EXISTS idx_payment_transactions_invoice;
@@ -0,0 +1,3 @@
+-- Remove optimistic concurrency version columns
+ALTER TABLE ticket_tiers DROP COLUMN IF EXISTS version;
+ALTER TABLE events DROP COLUMN IF EXISTS version;
@@ -0,0 +1,8 @@
+-- Optimistic concurrency control for organizer edits
+-- version is incremented on every event/ticket tier update made by organizers
+-- (sold_count changes from purchases do not bump it)
+ALTER TABLE events
+  ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE ticket_tiers
+  ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
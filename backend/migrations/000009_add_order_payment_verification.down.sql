@@ -0,0 +1,10 @@
+-- Remove payment verification details
+ALTER TABLE orders_archive
+  DROP COLUMN IF EXISTS amount_discrepancy,
+  DROP COLUMN IF EXISTS paid_currency,
+  DROP COLUMN IF EXISTS paid_amount;
+
+ALTER TABLE orders
+  DROP COLUMN IF EXISTS amount_discrepancy,
+  DROP COLUMN IF EXISTS paid_currency,
+  DROP COLUMN IF EXISTS paid_amount;
@@ -0,0 +1,18 @@
+-- Payment verification details recorded on confirmation
+-- paid_amount/paid_currency are what the payment provider reported,
+-- amount_discrepancy is paid_amount - grand_total when accepted within tolerance
+ALTER TABLE orders
+  ADD COLUMN IF NOT EXISTS paid_amount DECIMAL(12,2),
+  ADD COLUMN IF NOT EXISTS paid_currency VARCHAR(3),
+  ADD COLUMN IF NOT EXISTS amount_discrepancy DECIMAL(12,2);
+
+ALTER TABLE orders_archive
+  ADD COLUMN IF NOT EXISTS paid_amount DECIMAL(12,2),
+  ADD COLUMN IF NOT EXISTS paid_currency VARCHAR(3),
+  ADD COLUMN IF NOT EXISTS amount_discrepancy DECIMAL(12,2);
+
+-- Archival copies rows with SELECT o.*, NOW(), so archived_at must stay the last column
+ALTER TABLE orders_archive RENAME COLUMN archived_at TO archived_at_old;
+ALTER TABLE orders_archive ADD COLUMN archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+UPDATE orders_archive SET archived_at = archived_at_old;
+ALTER TABLE orders_archive DROP COLUMN archived_at_old;
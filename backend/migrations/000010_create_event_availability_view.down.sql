@@ -0,0 +1,2 @@
+-- Remove event availability summary
+DROP MATERIALIZED VIEW IF EXISTS event_availability;
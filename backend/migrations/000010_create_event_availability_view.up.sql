@@ -0,0 +1,16 @@
+-- Per-event availability summary for listing filters (price range, only available)
+-- Aggregating ticket_tiers per listed event on every request is avoided by reading this view,
+-- refreshed with REFRESH MATERIALIZED VIEW CONCURRENTLY after ticket tier changes
+CREATE MATERIALIZED VIEW IF NOT EXISTS event_availability AS
+SELECT e.id AS event_id,
+       COALESCE(SUM(t.quota), 0)::INTEGER AS total_quota,
+       COALESCE(SUM(t.sold_count), 0)::INTEGER AS total_sold,
+       MIN(t.price) AS min_price,
+       MAX(t.price) AS max_price
+FROM events e
+LEFT JOIN ticket_tiers t ON t.event_id = e.id
+GROUP BY e.id;
+
+-- Unique index is required for REFRESH ... CONCURRENTLY
+CREATE UNIQUE INDEX IF NOT EXISTS idx_event_availability_event ON event_availability(event_id);
+CREATE INDEX IF NOT EXISTS idx_event_availability_min_price ON event_availability(min_price);
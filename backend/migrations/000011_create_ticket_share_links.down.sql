@@ -0,0 +1,3 @@
+-- Remove ticket share links
+DROP INDEX IF EXISTS idx_ticket_share_links_active;
+DROP TABLE IF EXISTS ticket_share_links;
@@ -0,0 +1,14 @@
+-- Share links let a ticket owner forward a read-only ticket view to a companion
+-- The URL token is signed over the link id and expiry; the row makes links revocable
+CREATE TABLE IF NOT EXISTS ticket_share_links (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  ticket_id UUID NOT NULL REFERENCES tickets(id) ON DELETE CASCADE,
+  created_by UUID NOT NULL REFERENCES users(id),
+  expires_at TIMESTAMPTZ NOT NULL,
+  revoked_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_ticket_share_links_active
+  ON ticket_share_links(ticket_id)
+  WHERE revoked_at IS NULL;
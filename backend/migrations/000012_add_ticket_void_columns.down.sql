@@ -0,0 +1,22 @@
+-- Remove ticket void details
+-- Void tickets fall back to cancelled so the original status constraint holds
+UPDATE tickets_archive SET status = 'cancelled' WHERE status = 'void';
+UPDATE tickets SET status = 'cancelled' WHERE status = 'void';
+
+ALTER TABLE tickets_archive
+  DROP COLUMN IF EXISTS voided_at,
+  DROP COLUMN IF EXISTS voided_by,
+  DROP COLUMN IF EXISTS void_reason;
+
+ALTER TABLE tickets_archive DROP CONSTRAINT IF EXISTS tickets_status_check;
+ALTER TABLE tickets_archive
+  ADD CONSTRAINT tickets_status_check CHECK (status IN ('valid', 'used', 'cancelled', 'expired'));
+
+ALTER TABLE tickets
+  DROP COLUMN IF EXISTS voided_at,
+  DROP COLUMN IF EXISTS voided_by,
+  DROP COLUMN IF EXISTS void_reason;
+
+ALTER TABLE tickets DROP CONSTRAINT IF EXISTS tickets_status_check;
+ALTER TABLE tickets
+  ADD CONSTRAINT tickets_status_check CHECK (status IN ('valid', 'used', 'cancelled', 'expired'));
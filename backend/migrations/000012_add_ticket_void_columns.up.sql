@@ -0,0 +1,25 @@
+-- Tickets voided by an organizer or admin (e.g. a leaked QR code)
+-- void_reason/voided_by/voided_at record who revoked the ticket and why
+ALTER TABLE tickets DROP CONSTRAINT IF EXISTS tickets_status_check;
+ALTER TABLE tickets
+  ADD CONSTRAINT tickets_status_check CHECK (status IN ('valid', 'used', 'cancelled', 'expired', 'void'));
+
+ALTER TABLE tickets
+  ADD COLUMN IF NOT EXISTS void_reason TEXT,
+  ADD COLUMN IF NOT EXISTS voided_by UUID REFERENCES users(id),
+  ADD COLUMN IF NOT EXISTS voided_at TIMESTAMPTZ;
+
+ALTER TABLE tickets_archive DROP CONSTRAINT IF EXISTS tickets_status_check;
+ALTER TABLE tickets_archive
+  ADD CONSTRAINT tickets_status_check CHECK (status IN ('valid', 'used', 'cancelled', 'expired', 'void'));
+
+ALTER TABLE tickets_archive
+  ADD COLUMN IF NOT EXISTS void_reason TEXT,
+  ADD COLUMN IF NOT EXISTS voided_by UUID,
+  ADD COLUMN IF NOT EXISTS voided_at TIMESTAMPTZ;
+
+-- Archival copies rows with SELECT t.*, NOW(), so archived_at must stay the last column
+ALTER TABLE tickets_archive RENAME COLUMN archived_at TO archived_at_old;
+ALTER TABLE tickets_archive ADD COLUMN archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+UPDATE tickets_archive SET archived_at = archived_at_old;
+ALTER TABLE tickets_archive DROP COLUMN archived_at_old;
@@ -0,0 +1,5 @@
+-- Remove scan history and per-event scan policy
+DROP TABLE IF EXISTS ticket_scans;
+
+ALTER TABLE events
+  DROP COLUMN IF EXISTS scan_policy;
@@ -0,0 +1,19 @@
+-- Per-event scan policy enforced by ticket validation
+-- single_use: one entry per ticket, reentry: entry/exit scans alternate,
+-- per_day: one entry per calendar day (event timezone) while the event runs
+ALTER TABLE events
+  ADD COLUMN IF NOT EXISTS scan_policy VARCHAR(20) NOT NULL DEFAULT 'single_use'
+  CHECK (scan_policy IN ('single_use', 'reentry', 'per_day'));
+
+-- Scan history per ticket
+-- ticket_id has no foreign key so the history outlives ticket archival
+CREATE TABLE IF NOT EXISTS ticket_scans (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  ticket_id UUID NOT NULL,
+  event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+  direction VARCHAR(10) NOT NULL CHECK (direction IN ('entry', 'exit')),
+  scanned_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_ticket_scans_ticket ON ticket_scans(ticket_id, scanned_at DESC);
+CREATE INDEX IF NOT EXISTS idx_ticket_scans_event ON ticket_scans(event_id, scanned_at);
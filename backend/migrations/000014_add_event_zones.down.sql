@@ -0,0 +1,5 @@
+-- Remove event zones and scan gate zones
+ALTER TABLE ticket_scans DROP COLUMN IF EXISTS zone;
+
+DROP TABLE IF EXISTS ticket_tier_zones;
+DROP TABLE IF EXISTS event_zones;
@@ -0,0 +1,21 @@
+-- Access zones per event (e.g. VIP, GA); gates scan tickets for one zone
+CREATE TABLE IF NOT EXISTS event_zones (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+  code VARCHAR(30) NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT event_zones_event_id_code_key UNIQUE (event_id, code)
+);
+
+-- Zones a ticket tier may enter; a tier without rows may enter every zone
+CREATE TABLE IF NOT EXISTS ticket_tier_zones (
+  ticket_tier_id UUID NOT NULL REFERENCES ticket_tiers(id) ON DELETE CASCADE,
+  zone_id UUID NOT NULL REFERENCES event_zones(id) ON DELETE CASCADE,
+  PRIMARY KEY (ticket_tier_id, zone_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ticket_tier_zones_zone ON ticket_tier_zones(zone_id);
+
+-- Gate zone a scan was made at
+ALTER TABLE ticket_scans ADD COLUMN IF NOT EXISTS zone VARCHAR(30);
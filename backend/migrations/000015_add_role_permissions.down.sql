@@ -0,0 +1,2 @@
+-- Remove role permission mapping
+DROP TABLE IF EXISTS role_permissions;
@@ -0,0 +1,18 @@
+-- Role to permission mapping, issued as the JWT scope claim by auth-service
+CREATE TABLE IF NOT EXISTS role_permissions (
+  role VARCHAR(20) NOT NULL CHECK (role IN ('customer', 'organizer', 'admin')),
+  permission VARCHAR(50) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (role, permission)
+);
+
+-- Defaults matching the previous role checks
+INSERT INTO role_permissions (role, permission) VALUES
+  ('organizer', 'events:write'),
+  ('organizer', 'orders:refund'),
+  ('organizer', 'checkin:scan'),
+  ('admin', 'events:write'),
+  ('admin', 'orders:refund'),
+  ('admin', 'checkin:scan'),
+  ('admin', 'roles:manage')
+ON CONFLICT DO NOTHING;
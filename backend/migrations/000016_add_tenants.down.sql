@@ -0,0 +1,14 @@
+-- Remove tenants
+DROP INDEX IF EXISTS idx_orders_tenant;
+DROP INDEX IF EXISTS idx_events_tenant_status;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_tenant_email_key;
+ALTER TABLE users ADD CONSTRAINT users_email_key UNIQUE (email);
+
+ALTER TABLE orders_archive DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE orders DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE events DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE users DROP COLUMN IF EXISTS tenant_id;
+
+DROP TABLE IF EXISTS tenant_domains;
+DROP TABLE IF EXISTS tenants;
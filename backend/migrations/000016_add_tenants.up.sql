@@ -0,0 +1,52 @@
+-- Tenants (white-label sub-platforms) with their branding and fee configuration
+CREATE TABLE IF NOT EXISTS tenants (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  slug VARCHAR(50) UNIQUE NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  logo_url TEXT,
+  primary_color VARCHAR(20),
+  support_email VARCHAR(255),
+  email_from_name VARCHAR(255),
+  email_from_address VARCHAR(255),
+  platform_fee_percent INTEGER NOT NULL DEFAULT 5 CHECK (platform_fee_percent BETWEEN 0 AND 100),
+  service_fee DECIMAL(12,2) NOT NULL DEFAULT 2500 CHECK (service_fee >= 0),
+  is_active BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Domains resolved to a tenant by the gateway
+CREATE TABLE IF NOT EXISTS tenant_domains (
+  domain VARCHAR(255) PRIMARY KEY,
+  tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_tenant_domains_tenant ON tenant_domains(tenant_id);
+
+-- The platform itself; unknown domains and existing rows belong to it
+INSERT INTO tenants (id, slug, name) VALUES
+  ('00000000-0000-0000-0000-000000000001', 'default', 'Event Ticketing Platform')
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE users
+  ADD COLUMN IF NOT EXISTS tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES tenants(id);
+ALTER TABLE events
+  ADD COLUMN IF NOT EXISTS tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES tenants(id);
+ALTER TABLE orders
+  ADD COLUMN IF NOT EXISTS tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES tenants(id);
+ALTER TABLE orders_archive
+  ADD COLUMN IF NOT EXISTS tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+
+-- Archival copies rows with SELECT o.*, NOW(), so archived_at must stay the last column
+ALTER TABLE orders_archive RENAME COLUMN archived_at TO archived_at_old;
+ALTER TABLE orders_archive ADD COLUMN archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+UPDATE orders_archive SET archived_at = archived_at_old;
+ALTER TABLE orders_archive DROP COLUMN archived_at_old;
+
+-- Emails are unique per tenant, so the same person can register on several brands
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_email_key;
+ALTER TABLE users ADD CONSTRAINT users_tenant_email_key UNIQUE (tenant_id, email);
+
+CREATE INDEX IF NOT EXISTS idx_events_tenant_status ON events(tenant_id, status);
+CREATE INDEX IF NOT EXISTS idx_orders_tenant ON orders(tenant_id);
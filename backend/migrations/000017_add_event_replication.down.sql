@@ -0,0 +1,8 @@
+-- Remove event replication
+DROP TABLE IF EXISTS replication_checkpoints;
+DROP TABLE IF EXISTS ticket_tier_replicas;
+DROP TABLE IF EXISTS event_replicas;
+DROP TRIGGER IF EXISTS ticket_tiers_record_change ON ticket_tiers;
+DROP TRIGGER IF EXISTS events_record_change ON events;
+DROP FUNCTION IF EXISTS record_event_change();
+DROP TABLE IF EXISTS event_changes;
@@ -0,0 +1,68 @@
+-- Change feed of events and their ticket tiers, published by event-service
+-- Filled by triggers so every write is recorded in the same transaction; consumers re-read
+-- the event through event-service and only need the event ID
+CREATE TABLE IF NOT EXISTS event_changes (
+  seq BIGSERIAL PRIMARY KEY,
+  event_id UUID NOT NULL,
+  changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE OR REPLACE FUNCTION record_event_change()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF TG_TABLE_NAME = 'events' THEN
+    INSERT INTO event_changes (event_id) VALUES (COALESCE(NEW.id, OLD.id));
+  ELSE
+    INSERT INTO event_changes (event_id) VALUES (COALESCE(NEW.event_id, OLD.event_id));
+  END IF;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER events_record_change AFTER INSERT OR UPDATE OR DELETE ON events
+  FOR EACH ROW EXECUTE FUNCTION record_event_change();
+
+-- sold_count changes on every reservation and is not replicated
+CREATE TRIGGER ticket_tiers_record_change AFTER INSERT OR DELETE OR UPDATE OF name, price, event_id ON ticket_tiers
+  FOR EACH ROW EXECUTE FUNCTION record_event_change();
+
+-- Existing events are published once so consumers can bootstrap from sequence 0
+INSERT INTO event_changes (event_id) SELECT id FROM events;
+
+-- Denormalized copy of events maintained by ticketing-service from the change feed
+CREATE TABLE IF NOT EXISTS event_replicas (
+  id UUID PRIMARY KEY,
+  tenant_id UUID NOT NULL,
+  organizer_id UUID NOT NULL,
+  title VARCHAR(255) NOT NULL,
+  slug VARCHAR(255) NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  category VARCHAR(50) NOT NULL,
+  location VARCHAR(255) NOT NULL,
+  start_date TIMESTAMPTZ NOT NULL,
+  end_date TIMESTAMPTZ NOT NULL,
+  timezone VARCHAR(50) NOT NULL,
+  scan_policy VARCHAR(20) NOT NULL,
+  banner_url VARCHAR(500),
+  status VARCHAR(20) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL,
+  replicated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Ticket tiers of replicated events; display fields only, inventory stays on ticket_tiers
+CREATE TABLE IF NOT EXISTS ticket_tier_replicas (
+  id UUID PRIMARY KEY,
+  event_id UUID NOT NULL REFERENCES event_replicas(id) ON DELETE CASCADE,
+  name VARCHAR(100) NOT NULL,
+  price DECIMAL(12,2) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_ticket_tier_replicas_event ON ticket_tier_replicas(event_id);
+
+-- Last change feed sequence applied per consumer
+CREATE TABLE IF NOT EXISTS replication_checkpoints (
+  consumer VARCHAR(50) PRIMARY KEY,
+  last_seq BIGINT NOT NULL DEFAULT 0,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,17 @@
+-- Remove ticket tier archive
+DROP MATERIALIZED VIEW IF EXISTS event_availability;
+
+CREATE MATERIALIZED VIEW event_availability AS
+SELECT e.id AS event_id,
+       COALESCE(SUM(t.quota), 0)::INTEGER AS total_quota,
+       COALESCE(SUM(t.sold_count), 0)::INTEGER AS total_sold,
+       MIN(t.price) AS min_price,
+       MAX(t.price) AS max_price
+FROM events e
+LEFT JOIN ticket_tiers t ON t.event_id = e.id
+GROUP BY e.id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_event_availability_event ON event_availability(event_id);
+CREATE INDEX IF NOT EXISTS idx_event_availability_min_price ON event_availability(min_price);
+
+ALTER TABLE ticket_tiers DROP COLUMN IF EXISTS archived_at;
@@ -0,0 +1,9 @@
+-- Remove event completion and settlements
+DROP TABLE IF EXISTS event_settlements;
+
+DROP INDEX IF EXISTS idx_events_published_end_date;
+
+UPDATE events SET status = 'published' WHERE status = 'completed';
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_status_check;
+ALTER TABLE events ADD CONSTRAINT events_status_check
+  CHECK (status IN ('draft', 'published', 'cancelled'));
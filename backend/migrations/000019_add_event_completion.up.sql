@@ -0,0 +1,21 @@
+-- Events past their end date are moved to 'completed' by event-service's completion worker
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_status_check;
+ALTER TABLE events ADD CONSTRAINT events_status_check
+  CHECK (status IN ('draft', 'published', 'cancelled', 'completed'));
+
+CREATE INDEX IF NOT EXISTS idx_events_published_end_date ON events(end_date) WHERE status = 'published';
+
+-- Organizer settlement of a completed event, calculated once from its paid orders
+-- Buyers pay platform and service fees on top of the ticket price, so the payout is the ticket sales
+CREATE TABLE IF NOT EXISTS event_settlements (
+  event_id UUID PRIMARY KEY REFERENCES events(id) ON DELETE CASCADE,
+  organizer_id UUID NOT NULL REFERENCES users(id),
+  paid_orders INTEGER NOT NULL,
+  tickets_sold INTEGER NOT NULL,
+  gross_amount DECIMAL(12,2) NOT NULL,  -- Collected from buyers (orders.grand_total)
+  fee_amount DECIMAL(12,2) NOT NULL,    -- Platform and service fees
+  payout_amount DECIMAL(12,2) NOT NULL, -- Owed to the organizer (orders.total_amount)
+  calculated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_settlements_organizer ON event_settlements(organizer_id);
@@ -0,0 +1,5 @@
+-- Remove order issues
+DELETE FROM role_permissions WHERE permission = 'support:manage';
+
+DROP TABLE IF EXISTS order_issue_replies;
+DROP TABLE IF EXISTS order_issues;
@@ -0,0 +1,38 @@
+-- Problems reported by buyers on their orders, handled by support (support:manage)
+-- order_id has no foreign key: orders move to orders_archive, issues stay here
+CREATE TABLE IF NOT EXISTS order_issues (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  order_id UUID NOT NULL,
+  user_id UUID NOT NULL REFERENCES users(id),
+  tenant_id UUID NOT NULL REFERENCES tenants(id),
+  category VARCHAR(30) NOT NULL CHECK (category IN ('email_not_received', 'wrong_event_details', 'payment_problem', 'ticket_problem', 'other')),
+  description TEXT NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'in_progress', 'resolved')),
+  resolution TEXT,
+  resolved_by UUID REFERENCES users(id),
+  resolved_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_issues_order ON order_issues(order_id);
+CREATE INDEX IF NOT EXISTS idx_order_issues_queue ON order_issues(tenant_id, status, created_at);
+
+-- One unresolved issue per order and category
+CREATE UNIQUE INDEX IF NOT EXISTS idx_order_issues_unresolved
+  ON order_issues(order_id, category) WHERE status <> 'resolved';
+
+-- Conversation between the buyer's report and support
+CREATE TABLE IF NOT EXISTS order_issue_replies (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  issue_id UUID NOT NULL REFERENCES order_issues(id) ON DELETE CASCADE,
+  author_id UUID NOT NULL REFERENCES users(id),
+  message TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_issue_replies_issue ON order_issue_replies(issue_id, created_at);
+
+INSERT INTO role_permissions (role, permission) VALUES
+  ('admin', 'support:manage')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,2 @@
+-- Remove order refund requests
+DROP TABLE IF EXISTS order_refund_requests;
@@ -0,0 +1,22 @@
+-- Payments that could not be applied to their order and must be refunded manually by support
+-- Created on confirmation of a late payment (order expired or cancelled) or a second payment for a paid order
+-- order_id has no foreign key: orders move to orders_archive, refund requests stay here
+CREATE TABLE IF NOT EXISTS order_refund_requests (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  order_id UUID NOT NULL,
+  tenant_id UUID NOT NULL REFERENCES tenants(id),
+  payment_id VARCHAR(255) NOT NULL,
+  payment_method VARCHAR(50),
+  amount DECIMAL(12,2) NOT NULL CHECK (amount >= 0),
+  currency VARCHAR(3) NOT NULL,
+  reason VARCHAR(30) NOT NULL CHECK (reason IN ('order_expired', 'order_cancelled', 'duplicate_payment')),
+  status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'refunded')),
+  refunded_by UUID REFERENCES users(id),
+  refunded_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Webhook retries for the same payment flag it once
+CREATE UNIQUE INDEX IF NOT EXISTS idx_order_refund_requests_payment ON order_refund_requests(payment_id);
+CREATE INDEX IF NOT EXISTS idx_order_refund_requests_order ON order_refund_requests(order_id);
+CREATE INDEX IF NOT EXISTS idx_order_refund_requests_queue ON order_refund_requests(tenant_id, status, created_at);
@@ -0,0 +1,6 @@
+-- Remove order metadata
+DROP INDEX IF EXISTS idx_orders_archive_metadata;
+DROP INDEX IF EXISTS idx_orders_metadata;
+
+ALTER TABLE orders_archive DROP COLUMN IF EXISTS metadata;
+ALTER TABLE orders DROP COLUMN IF EXISTS metadata;
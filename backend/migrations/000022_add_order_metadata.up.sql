@@ -0,0 +1,17 @@
+-- Partner-defined key/value references attached to an order at creation
+-- Keys and values are strings, limits are enforced by ticketing-service
+ALTER TABLE orders
+  ADD COLUMN IF NOT EXISTS metadata JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE orders_archive
+  ADD COLUMN IF NOT EXISTS metadata JSONB NOT NULL DEFAULT '{}';
+
+-- Archival copies rows with SELECT o.*, NOW(), so archived_at must stay the last column
+ALTER TABLE orders_archive RENAME COLUMN archived_at TO archived_at_old;
+ALTER TABLE orders_archive ADD COLUMN archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+UPDATE orders_archive SET archived_at = archived_at_old;
+ALTER TABLE orders_archive DROP COLUMN archived_at_old;
+
+-- Support search by metadata key (?) and exact key/value match (@>)
+CREATE INDEX IF NOT EXISTS idx_orders_metadata ON orders USING GIN (metadata);
+CREATE INDEX IF NOT EXISTS idx_orders_archive_metadata ON orders_archive USING GIN (metadata);
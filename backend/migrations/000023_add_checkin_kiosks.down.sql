@@ -0,0 +1,2 @@
+-- Remove check-in kiosks
+DROP TABLE IF EXISTS checkin_kiosks;
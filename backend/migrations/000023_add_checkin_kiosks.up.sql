@@ -0,0 +1,14 @@
+-- Check-in kiosks are self-service devices registered by an organizer for one event
+-- The device token is signed over the kiosk id; the row makes devices revocable
+CREATE TABLE IF NOT EXISTS checkin_kiosks (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  tenant_id UUID NOT NULL REFERENCES tenants(id),
+  event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+  name VARCHAR(100) NOT NULL,
+  registered_by UUID NOT NULL REFERENCES users(id),
+  last_seen_at TIMESTAMPTZ,
+  revoked_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_checkin_kiosks_event ON checkin_kiosks(event_id, created_at);
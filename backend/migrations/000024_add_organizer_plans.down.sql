@@ -0,0 +1,5 @@
+-- Remove organizer plans
+DELETE FROM role_permissions WHERE permission = 'plans:manage';
+
+DROP TABLE IF EXISTS organizer_plan_assignments;
+DROP TABLE IF EXISTS organizer_plans;
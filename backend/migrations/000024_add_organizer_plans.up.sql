@@ -0,0 +1,28 @@
+-- Plan tiers limiting what organizers can do; a limit of 0 means unlimited
+-- Limits are enforced by event-service (events, tickets, API rate) and notification-service (announcement emails)
+CREATE TABLE IF NOT EXISTS organizer_plans (
+  code VARCHAR(20) PRIMARY KEY,
+  name VARCHAR(50) NOT NULL,
+  max_active_events INTEGER NOT NULL CHECK (max_active_events >= 0),                         -- Published events at a time
+  max_tickets_per_event INTEGER NOT NULL CHECK (max_tickets_per_event >= 0),                 -- Sum of tier quotas of one event
+  announcement_emails_per_month INTEGER NOT NULL CHECK (announcement_emails_per_month >= 0), -- Recipients per calendar month (UTC)
+  api_requests_per_minute INTEGER NOT NULL CHECK (api_requests_per_minute >= 0),             -- Event management API requests
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO organizer_plans (code, name, max_active_events, max_tickets_per_event, announcement_emails_per_month, api_requests_per_minute) VALUES
+  ('free', 'Free', 3, 500, 1000, 60),
+  ('pro', 'Pro', 50, 50000, 100000, 600)
+ON CONFLICT (code) DO NOTHING;
+
+-- Organizers without a row are on the free plan
+CREATE TABLE IF NOT EXISTS organizer_plan_assignments (
+  organizer_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+  plan_code VARCHAR(20) NOT NULL REFERENCES organizer_plans(code),
+  assigned_by UUID REFERENCES users(id),
+  assigned_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO role_permissions (role, permission) VALUES
+  ('admin', 'plans:manage')
+ON CONFLICT DO NOTHING;
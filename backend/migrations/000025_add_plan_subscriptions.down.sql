@@ -0,0 +1,4 @@
+-- Remove plan subscription billing
+DROP TABLE IF EXISTS subscription_invoices;
+DROP TABLE IF EXISTS plan_subscriptions;
+DROP TABLE IF EXISTS plan_prices;
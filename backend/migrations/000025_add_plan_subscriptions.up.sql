@@ -0,0 +1,61 @@
+-- Paid organizer plans, billed monthly by payment-service separately from ticket payments
+-- Plans without a price (free) can't be subscribed to; organizers fall back to free when a subscription ends
+CREATE TABLE IF NOT EXISTS plan_prices (
+  plan_code VARCHAR(20) PRIMARY KEY REFERENCES organizer_plans(code) ON DELETE CASCADE,
+  monthly_price DECIMAL(12,2) NOT NULL CHECK (monthly_price > 0),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO plan_prices (plan_code, monthly_price) VALUES
+  ('pro', 299000)
+ON CONFLICT (plan_code) DO NOTHING;
+
+-- One subscription per organizer, reused when they subscribe again after cancellation
+CREATE TABLE IF NOT EXISTS plan_subscriptions (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  organizer_id UUID NOT NULL UNIQUE REFERENCES users(id) ON DELETE CASCADE,
+  plan_code VARCHAR(20) NOT NULL REFERENCES organizer_plans(code),
+  pending_plan_code VARCHAR(20) REFERENCES organizer_plans(code), -- Downgrade applied at the next renewal
+  billing_email VARCHAR(255) NOT NULL,
+  billing_name VARCHAR(255) NOT NULL DEFAULT '',
+  status VARCHAR(20) NOT NULL DEFAULT 'pending',
+  current_period_start TIMESTAMPTZ,
+  current_period_end TIMESTAMPTZ,
+  cancel_at_period_end BOOLEAN NOT NULL DEFAULT FALSE,
+  canceled_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT plan_subscriptions_status_check CHECK (status IN ('pending', 'active', 'past_due', 'canceled'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_plan_subscriptions_renewal ON plan_subscriptions(current_period_end)
+  WHERE status IN ('active', 'past_due');
+
+-- Xendit invoices of subscriptions; external_id is SUB-{id} so webhooks can tell them from ticket payments
+-- A renewal is retried with a new invoice per attempt until it's paid or dunning gives up
+CREATE TABLE IF NOT EXISTS subscription_invoices (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  subscription_id UUID NOT NULL REFERENCES plan_subscriptions(id) ON DELETE CASCADE,
+  kind VARCHAR(20) NOT NULL,
+  plan_code VARCHAR(20) NOT NULL REFERENCES organizer_plans(code), -- Plan the organizer gets once paid
+  external_id VARCHAR(255) NOT NULL UNIQUE,
+  invoice_id VARCHAR(255) UNIQUE,
+  invoice_url TEXT,
+  amount DECIMAL(12,2) NOT NULL CHECK (amount > 0),
+  period_start TIMESTAMPTZ NOT NULL,
+  period_end TIMESTAMPTZ NOT NULL,
+  attempt INTEGER NOT NULL DEFAULT 1,
+  status VARCHAR(20) NOT NULL DEFAULT 'pending',
+  paid_at TIMESTAMPTZ,
+  expires_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT subscription_invoices_kind_check CHECK (kind IN ('initial', 'renewal', 'proration')),
+  CONSTRAINT subscription_invoices_status_check CHECK (status IN ('pending', 'paid', 'expired'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_subscription_invoices_subscription ON subscription_invoices(subscription_id, created_at DESC);
+
+-- Keeps replicas of the renewal worker from invoicing the same attempt twice
+CREATE UNIQUE INDEX IF NOT EXISTS idx_subscription_invoices_renewal_attempt
+  ON subscription_invoices(subscription_id, period_start, attempt) WHERE kind = 'renewal';
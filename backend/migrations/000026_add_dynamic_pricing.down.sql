@@ -0,0 +1,5 @@
+-- Remove dynamic ticket tier pricing
+DROP TABLE IF EXISTS ticket_tier_price_history;
+DROP INDEX IF EXISTS idx_ticket_tiers_dynamic_pricing;
+ALTER TABLE ticket_tiers DROP COLUMN IF EXISTS dynamic_pricing;
+ALTER TABLE ticket_tiers DROP COLUMN IF EXISTS base_price;
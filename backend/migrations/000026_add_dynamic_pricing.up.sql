@@ -0,0 +1,21 @@
+-- Rule-based dynamic pricing of ticket tiers, repriced periodically by event-service
+-- price stays the charged price; base_price keeps the organizer's price the rule adjusts
+ALTER TABLE ticket_tiers ADD COLUMN IF NOT EXISTS base_price DECIMAL(12,2) CHECK (base_price >= 0);
+ALTER TABLE ticket_tiers ADD COLUMN IF NOT EXISTS dynamic_pricing JSONB;
+
+CREATE INDEX IF NOT EXISTS idx_ticket_tiers_dynamic_pricing ON ticket_tiers(event_id)
+  WHERE dynamic_pricing IS NOT NULL AND archived_at IS NULL;
+
+-- Every price a tier was sold at, for organizers and disputes over what a buyer was charged
+CREATE TABLE IF NOT EXISTS ticket_tier_price_history (
+  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+  ticket_tier_id UUID NOT NULL REFERENCES ticket_tiers(id) ON DELETE CASCADE,
+  price DECIMAL(12,2) NOT NULL,
+  base_price DECIMAL(12,2),
+  sold_count INTEGER NOT NULL DEFAULT 0,
+  reason VARCHAR(20) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT ticket_tier_price_history_reason_check CHECK (reason IN ('created', 'organizer', 'dynamic'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_ticket_tier_price_history_tier ON ticket_tier_price_history(ticket_tier_id, created_at DESC);
@@ -0,0 +1,7 @@
+-- Remove ticket insurance
+DELETE FROM order_refund_requests WHERE reason IN ('insurance_claim', 'insurance_not_issued');
+ALTER TABLE order_refund_requests DROP CONSTRAINT IF EXISTS order_refund_requests_reason_check;
+ALTER TABLE order_refund_requests ADD CONSTRAINT order_refund_requests_reason_check
+  CHECK (reason IN ('order_expired', 'order_cancelled', 'duplicate_payment'));
+
+DROP TABLE IF EXISTS order_insurance_policies;
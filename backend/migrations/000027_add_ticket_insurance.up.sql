@@ -0,0 +1,30 @@
+-- Optional refund-protection insurance bought with an order from an insurance partner
+-- The premium is quoted at checkout and charged on top of the grand total; the policy is issued by
+-- ticketing-service's insurance worker once the order is paid
+-- order_id has no foreign key: orders move to orders_archive, policies stay here
+CREATE TABLE IF NOT EXISTS order_insurance_policies (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  order_id UUID NOT NULL,
+  tenant_id UUID NOT NULL REFERENCES tenants(id),
+  provider VARCHAR(50) NOT NULL,
+  quote_id VARCHAR(255) NOT NULL,
+  policy_number VARCHAR(255),
+  premium DECIMAL(12,2) NOT NULL CHECK (premium >= 0),
+  coverage DECIMAL(12,2) NOT NULL CHECK (coverage >= 0),
+  currency VARCHAR(3) NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'quoted' CHECK (status IN ('quoted', 'active', 'failed', 'claimed')),
+  claim_id VARCHAR(255),
+  claim_reason TEXT,
+  issued_at TIMESTAMPTZ,
+  claimed_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_order_insurance_policies_order ON order_insurance_policies(order_id);
+CREATE INDEX IF NOT EXISTS idx_order_insurance_policies_quoted ON order_insurance_policies(created_at) WHERE status = 'quoted';
+
+-- Claims are refunded through the manual refund queue, as are premiums of policies the partner refused
+ALTER TABLE order_refund_requests DROP CONSTRAINT IF EXISTS order_refund_requests_reason_check;
+ALTER TABLE order_refund_requests ADD CONSTRAINT order_refund_requests_reason_check
+  CHECK (reason IN ('order_expired', 'order_cancelled', 'duplicate_payment', 'insurance_claim', 'insurance_not_issued'));
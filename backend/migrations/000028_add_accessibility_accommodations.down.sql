@@ -0,0 +1,7 @@
+-- Remove accessibility accommodations
+DROP TABLE IF EXISTS order_accommodations;
+
+ALTER TABLE ticket_tiers DROP CONSTRAINT IF EXISTS no_accessible_overselling;
+ALTER TABLE ticket_tiers DROP CONSTRAINT IF EXISTS accessible_quota_within_quota;
+ALTER TABLE ticket_tiers DROP COLUMN IF EXISTS accessible_sold_count;
+ALTER TABLE ticket_tiers DROP COLUMN IF EXISTS accessible_quota;
@@ -0,0 +1,29 @@
+-- Accessible seats held back within a tier's quota for buyers who need one (wheelchair spaces and
+-- their companion seats); sold_count includes accessible_sold_count, general sale only reaches
+-- quota - accessible_quota
+ALTER TABLE ticket_tiers ADD COLUMN IF NOT EXISTS accessible_quota INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE ticket_tiers ADD COLUMN IF NOT EXISTS accessible_sold_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE ticket_tiers ADD CONSTRAINT accessible_quota_within_quota
+  CHECK (accessible_quota >= 0 AND accessible_quota <= quota);
+ALTER TABLE ticket_tiers ADD CONSTRAINT no_accessible_overselling
+  CHECK (accessible_sold_count >= 0 AND accessible_sold_count <= accessible_quota);
+
+-- Accommodations buyers request at checkout, one row per ticket
+-- ticket_id is set when the order's tickets are issued
+-- order_id and ticket_id have no foreign key: orders and tickets move to the archive tables, requests stay here
+CREATE TABLE IF NOT EXISTS order_accommodations (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  order_id UUID NOT NULL,
+  tenant_id UUID NOT NULL REFERENCES tenants(id),
+  event_id UUID NOT NULL,
+  ticket_tier_id UUID NOT NULL,
+  ticket_id UUID,
+  type VARCHAR(30) NOT NULL CHECK (type IN ('wheelchair', 'companion_seat', 'hearing_assistance', 'visual_assistance', 'step_free_access', 'other')),
+  notes TEXT,
+  accessible_seat BOOLEAN NOT NULL DEFAULT FALSE,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_accommodations_order ON order_accommodations(order_id);
+CREATE INDEX IF NOT EXISTS idx_order_accommodations_event ON order_accommodations(event_id) WHERE ticket_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_order_accommodations_ticket ON order_accommodations(ticket_id) WHERE ticket_id IS NOT NULL;
@@ -0,0 +1,3 @@
+-- Remove purchase policies
+DROP TABLE IF EXISTS order_policy_acceptances;
+DROP TABLE IF EXISTS policy_documents;
@@ -0,0 +1,28 @@
+-- Policies buyers accept at checkout: the tenant's platform terms and the policies of an event
+-- (refund, health). Rows are immutable versions, the newest of each type is the current one;
+-- version_hash is the SHA-256 of title and content, buyers accept a version by its hash
+-- event_id has no foreign key: acceptances keep the versions of deleted events for disputes
+CREATE TABLE IF NOT EXISTS policy_documents (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  tenant_id UUID NOT NULL REFERENCES tenants(id),
+  event_id UUID,
+  type VARCHAR(20) NOT NULL CHECK (type IN ('terms', 'refund', 'health')),
+  title VARCHAR(200) NOT NULL,
+  content TEXT NOT NULL,
+  version_hash CHAR(64) NOT NULL,
+  published_by UUID NOT NULL REFERENCES users(id),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT terms_are_platform_wide CHECK ((type = 'terms') = (event_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_policy_documents_current ON policy_documents(tenant_id, event_id, type, created_at DESC);
+
+-- Policy versions accepted with an order
+-- order_id has no foreign key: orders move to the archive tables, acceptances stay here
+CREATE TABLE IF NOT EXISTS order_policy_acceptances (
+  order_id UUID NOT NULL,
+  policy_document_id UUID NOT NULL REFERENCES policy_documents(id),
+  user_id UUID NOT NULL,
+  accepted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (order_id, policy_document_id)
+);
@@ -0,0 +1,2 @@
+-- Remove delayed jobs
+DROP TABLE IF EXISTS scheduled_jobs;
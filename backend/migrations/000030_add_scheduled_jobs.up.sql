@@ -0,0 +1,19 @@
+-- Delayed jobs: work due at a later time, such as the reminder sent before a reservation expires
+-- Workers claim due jobs with FOR UPDATE SKIP LOCKED so every ticketing-service instance can poll;
+-- a claim leases the job until locked_until, jobs of a crashed worker are claimed again after it
+-- reference_id has no foreign key: for reservation reminders it's an order, which may be archived
+CREATE TABLE IF NOT EXISTS scheduled_jobs (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  type VARCHAR(50) NOT NULL,
+  reference_id UUID NOT NULL,
+  run_at TIMESTAMPTZ NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'done', 'failed')),
+  attempts INT NOT NULL DEFAULT 0,
+  last_error TEXT,
+  locked_until TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT uq_scheduled_jobs_reference UNIQUE (type, reference_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_jobs_due ON scheduled_jobs(run_at) WHERE status IN ('pending', 'running');
@@ -0,0 +1,2 @@
+-- Remove config reload permission
+DELETE FROM role_permissions WHERE permission = 'config:manage';
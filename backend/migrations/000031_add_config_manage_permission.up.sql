@@ -0,0 +1,4 @@
+-- Hot config reload admin endpoints (GET /admin/config, POST /admin/config/reload)
+INSERT INTO role_permissions (role, permission) VALUES
+  ('admin', 'config:manage')
+ON CONFLICT DO NOTHING;
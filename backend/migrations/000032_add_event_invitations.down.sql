@@ -0,0 +1,2 @@
+-- Remove event invitations
+DROP TABLE IF EXISTS event_invitations;
@@ -0,0 +1,32 @@
+-- Invitations of an invite-only guest list, imported by the organizer from CSV
+-- A pending invitation holds its seats in ticket_tiers.sold_count until it is claimed (the order
+-- then holds them) or released after claim_deadline. price is the unit price the invitee pays, 0 for comps.
+-- batch_id groups the invitations of one import; their email and release jobs reference it
+-- order_id has no foreign key: the claim's order may be archived
+CREATE TABLE IF NOT EXISTS event_invitations (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  batch_id UUID NOT NULL,
+  event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+  ticket_tier_id UUID NOT NULL REFERENCES ticket_tiers(id),
+  email VARCHAR(255) NOT NULL,
+  name VARCHAR(255) NOT NULL DEFAULT '',
+  quantity INT NOT NULL CHECK (quantity > 0),
+  price DECIMAL(12, 2) NOT NULL DEFAULT 0 CHECK (price >= 0),
+  status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'claimed', 'released')),
+  claim_deadline TIMESTAMPTZ NOT NULL,
+  order_id UUID,
+  claimed_by UUID REFERENCES users(id),
+  claimed_at TIMESTAMPTZ,
+  released_at TIMESTAMPTZ,
+  email_sent_at TIMESTAMPTZ,
+  created_by UUID NOT NULL REFERENCES users(id),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- One open invitation per email and event; released invitations may be sent again
+CREATE UNIQUE INDEX IF NOT EXISTS uq_event_invitations_open_email
+  ON event_invitations(event_id, LOWER(email)) WHERE status IN ('pending', 'claimed');
+CREATE INDEX IF NOT EXISTS idx_event_invitations_event ON event_invitations(event_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_event_invitations_batch ON event_invitations(batch_id);
+CREATE INDEX IF NOT EXISTS idx_event_invitations_pending_tier ON event_invitations(ticket_tier_id) WHERE status = 'pending';
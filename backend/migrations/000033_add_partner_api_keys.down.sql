@@ -0,0 +1,4 @@
+-- Remove partner API keys
+DROP INDEX IF EXISTS idx_tickets_event_created;
+DROP INDEX IF EXISTS idx_orders_event_updated;
+DROP TABLE IF EXISTS partner_api_keys;
@@ -0,0 +1,18 @@
+-- Partner API keys let organizers connect automation platforms (Zapier, Make) to their events
+-- The key is signed over the row id like kiosk device tokens; the row makes keys revocable.
+-- A key acts as its organizer and only sees the events they organize
+CREATE TABLE IF NOT EXISTS partner_api_keys (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  tenant_id UUID NOT NULL REFERENCES tenants(id),
+  organizer_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name VARCHAR(100) NOT NULL,
+  last_used_at TIMESTAMPTZ,
+  revoked_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_partner_api_keys_organizer ON partner_api_keys(organizer_id, created_at);
+
+-- Polling triggers list an organizer's orders and attendees changed since a timestamp
+CREATE INDEX IF NOT EXISTS idx_orders_event_updated ON orders(event_id, updated_at);
+CREATE INDEX IF NOT EXISTS idx_tickets_event_created ON tickets(event_id, created_at);
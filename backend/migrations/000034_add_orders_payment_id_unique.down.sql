@@ -0,0 +1,2 @@
+-- Remove unique payment ID index on orders
+DROP INDEX IF EXISTS idx_orders_payment_id;
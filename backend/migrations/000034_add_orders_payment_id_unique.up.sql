@@ -0,0 +1,2 @@
+-- A payment confirms at most one order; retries are answered from the order it confirmed
+CREATE UNIQUE INDEX IF NOT EXISTS idx_orders_payment_id ON orders(payment_id) WHERE payment_id IS NOT NULL;
@@ -0,0 +1,2 @@
+-- Remove order audit entries
+DROP TABLE IF EXISTS order_audit_entries;
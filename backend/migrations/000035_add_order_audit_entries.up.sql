@@ -0,0 +1,15 @@
+-- Actions support takes on orders outside the normal flow, e.g. marking a bank transfer paid
+-- order_id has no foreign key: orders move to orders_archive, audit entries stay here
+CREATE TABLE IF NOT EXISTS order_audit_entries (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  order_id UUID NOT NULL,
+  tenant_id UUID NOT NULL REFERENCES tenants(id),
+  action VARCHAR(30) NOT NULL CHECK (action IN ('mark_paid')),
+  actor_id UUID NOT NULL REFERENCES users(id),
+  reference VARCHAR(100),
+  reason TEXT NOT NULL,
+  outcome VARCHAR(30),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_audit_entries_order ON order_audit_entries(order_id, created_at);
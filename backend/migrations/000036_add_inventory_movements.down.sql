@@ -0,0 +1,2 @@
+-- Remove inventory movements
+DROP TABLE IF EXISTS inventory_movements;
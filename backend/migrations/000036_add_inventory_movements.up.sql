@@ -0,0 +1,12 @@
+-- Every change of a ticket tier's sold_count, written in the same statement as the change
+-- Read as a time series per tier, e.g. for post-mortems after flash sales
+CREATE TABLE IF NOT EXISTS inventory_movements (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  ticket_tier_id UUID NOT NULL REFERENCES ticket_tiers(id) ON DELETE CASCADE,
+  movement VARCHAR(20) NOT NULL CHECK (movement IN ('sold', 'released')),
+  quantity INT NOT NULL,
+  accessible BOOLEAN NOT NULL DEFAULT FALSE,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_inventory_movements_tier ON inventory_movements(ticket_tier_id, created_at);
@@ -0,0 +1,21 @@
+-- Revert inventory_movements to sold/released quantities
+DROP TRIGGER IF EXISTS inventory_movements_append_only ON inventory_movements;
+DROP FUNCTION IF EXISTS reject_inventory_movement_update();
+
+DELETE FROM inventory_movements WHERE reason = 'adjust';
+
+ALTER TABLE inventory_movements
+  ADD COLUMN IF NOT EXISTS movement VARCHAR(20),
+  ADD COLUMN IF NOT EXISTS quantity INT;
+
+UPDATE inventory_movements
+SET movement = CASE WHEN delta >= 0 THEN 'sold' ELSE 'released' END,
+    quantity = ABS(delta);
+
+ALTER TABLE inventory_movements
+  ALTER COLUMN movement SET NOT NULL,
+  ALTER COLUMN quantity SET NOT NULL,
+  ADD CONSTRAINT inventory_movements_movement_check CHECK (movement IN ('sold', 'released')),
+  DROP COLUMN order_id,
+  DROP COLUMN delta,
+  DROP COLUMN reason;
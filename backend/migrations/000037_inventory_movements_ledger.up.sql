@@ -0,0 +1,39 @@
+-- inventory_movements becomes the ledger of ticket tier sold_count: the signed change, its reason
+-- and order, appended in the same transaction as every change
+-- order_id has no foreign key: orders move to orders_archive, their movements stay here
+ALTER TABLE inventory_movements
+  ADD COLUMN IF NOT EXISTS order_id UUID,
+  ADD COLUMN IF NOT EXISTS delta INT,
+  ADD COLUMN IF NOT EXISTS reason VARCHAR(20);
+
+UPDATE inventory_movements
+SET delta = CASE movement WHEN 'sold' THEN quantity ELSE -quantity END,
+    reason = CASE movement WHEN 'sold' THEN 'reserve' ELSE 'release' END;
+
+ALTER TABLE inventory_movements
+  ALTER COLUMN delta SET NOT NULL,
+  ALTER COLUMN reason SET NOT NULL,
+  ADD CONSTRAINT inventory_movements_reason_check CHECK (reason IN ('reserve', 'confirm', 'release', 'adjust')),
+  DROP COLUMN movement,
+  DROP COLUMN quantity;
+
+-- Opening balance, so the ledger of every tier sums to its sold_count
+INSERT INTO inventory_movements (ticket_tier_id, delta, reason)
+SELECT tt.id, tt.sold_count - COALESCE(SUM(m.delta), 0), 'adjust'
+FROM ticket_tiers tt
+LEFT JOIN inventory_movements m ON m.ticket_tier_id = tt.id
+GROUP BY tt.id, tt.sold_count
+HAVING tt.sold_count <> COALESCE(SUM(m.delta), 0);
+
+CREATE INDEX IF NOT EXISTS idx_inventory_movements_order ON inventory_movements(order_id) WHERE order_id IS NOT NULL;
+
+-- Append-only: entries are never changed (they go away only with their ticket tier)
+CREATE OR REPLACE FUNCTION reject_inventory_movement_update()
+RETURNS TRIGGER AS $$
+BEGIN
+  RAISE EXCEPTION 'inventory_movements is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER inventory_movements_append_only BEFORE UPDATE ON inventory_movements
+  FOR EACH ROW EXECUTE FUNCTION reject_inventory_movement_update();
@@ -0,0 +1,3 @@
+-- Remove event FAQs and agenda sessions
+DROP TABLE IF EXISTS event_sessions;
+DROP TABLE IF EXISTS event_faqs;
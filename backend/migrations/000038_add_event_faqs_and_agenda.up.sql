@@ -0,0 +1,29 @@
+-- Frequently asked questions shown on the event page, in organizer order
+CREATE TABLE IF NOT EXISTS event_faqs (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+  question VARCHAR(500) NOT NULL,
+  answer TEXT NOT NULL,
+  position INTEGER NOT NULL DEFAULT 0,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_faqs_event ON event_faqs(event_id, position);
+
+-- Agenda sessions of an event (talks, performances) with their stage and speakers
+CREATE TABLE IF NOT EXISTS event_sessions (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+  title VARCHAR(255) NOT NULL,
+  description TEXT,
+  stage VARCHAR(100),
+  speakers TEXT[] NOT NULL DEFAULT '{}',
+  starts_at TIMESTAMPTZ NOT NULL,
+  ends_at TIMESTAMPTZ NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT event_sessions_time_check CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_sessions_event ON event_sessions(event_id, starts_at);
@@ -0,0 +1,3 @@
+-- Remove performer directory and event lineups
+DROP TABLE IF EXISTS event_performers;
+DROP TABLE IF EXISTS performers;
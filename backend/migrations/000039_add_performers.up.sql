@@ -0,0 +1,24 @@
+-- Speaker/performer directory per tenant, shared by the tenant's organizers
+CREATE TABLE IF NOT EXISTS performers (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  tenant_id UUID NOT NULL REFERENCES tenants(id),
+  name VARCHAR(150) NOT NULL,
+  photo_url TEXT,
+  bio TEXT,
+  social_links JSONB NOT NULL DEFAULT '{}',
+  created_by UUID NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_performers_tenant_name ON performers(tenant_id, lower(name));
+
+-- Performers featured in an event, in lineup order
+CREATE TABLE IF NOT EXISTS event_performers (
+  event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+  performer_id UUID NOT NULL REFERENCES performers(id) ON DELETE CASCADE,
+  position INTEGER NOT NULL DEFAULT 0,
+  PRIMARY KEY (event_id, performer_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_performers_performer ON event_performers(performer_id);
@@ -0,0 +1,5 @@
+-- Remove event abuse reports
+DELETE FROM role_permissions WHERE permission = 'reports:manage';
+
+DROP TABLE IF EXISTS event_moderation;
+DROP TABLE IF EXISTS event_reports;
@@ -0,0 +1,37 @@
+-- Abuse reports filed by users on events, reviewed by moderators (reports:manage)
+CREATE TABLE IF NOT EXISTS event_reports (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+  tenant_id UUID NOT NULL REFERENCES tenants(id),
+  reporter_id UUID NOT NULL REFERENCES users(id),
+  reason VARCHAR(30) NOT NULL CHECK (reason IN ('scam', 'inappropriate', 'misleading', 'spam', 'other')),
+  details TEXT,
+  status VARCHAR(20) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'dismissed', 'upheld')),
+  reviewed_by UUID REFERENCES users(id),
+  reviewed_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_reports_event ON event_reports(event_id, status);
+CREATE INDEX IF NOT EXISTS idx_event_reports_queue ON event_reports(tenant_id, status, created_at);
+
+-- One open report per user and event, so a single user can't reach the auto-unpublish threshold
+CREATE UNIQUE INDEX IF NOT EXISTS idx_event_reports_open_reporter
+  ON event_reports(event_id, reporter_id) WHERE status = 'open';
+
+-- Moderation hold of a reported event; while a row exists the organizer can't publish the event
+-- pending_review: unpublished automatically after too many reports, removed: reports upheld by a moderator
+CREATE TABLE IF NOT EXISTS event_moderation (
+  event_id UUID PRIMARY KEY REFERENCES events(id) ON DELETE CASCADE,
+  status VARCHAR(20) NOT NULL CHECK (status IN ('pending_review', 'removed')),
+  unpublished BOOLEAN NOT NULL DEFAULT FALSE, -- The hold took the event off sale, so dismissing republishes it
+  note TEXT,
+  reviewed_by UUID REFERENCES users(id),
+  reviewed_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO role_permissions (role, permission) VALUES
+  ('admin', 'reports:manage')
+ON CONFLICT DO NOTHING;
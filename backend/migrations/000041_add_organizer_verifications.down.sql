@@ -0,0 +1,4 @@
+-- Remove organizer verification
+DELETE FROM role_permissions WHERE permission = 'orgs:verify';
+
+DROP TABLE IF EXISTS organizer_verifications;
@@ -0,0 +1,27 @@
+-- Organizer verification: identity or business documents submitted by organizers, reviewed by admins (orgs:verify)
+-- Documents are uploaded by the frontend to private storage, only their metadata is kept here
+CREATE TABLE IF NOT EXISTS organizer_verifications (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  organizer_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  tenant_id UUID NOT NULL REFERENCES tenants(id),
+  legal_name VARCHAR(255) NOT NULL,
+  document_type VARCHAR(20) NOT NULL CHECK (document_type IN ('ktp', 'npwp', 'nib', 'akta')),
+  document_number VARCHAR(50) NOT NULL,
+  document_url TEXT NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected', 'revoked')),
+  review_note TEXT,
+  reviewed_by UUID REFERENCES users(id),
+  reviewed_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_organizer_verifications_organizer ON organizer_verifications(organizer_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_organizer_verifications_queue ON organizer_verifications(tenant_id, status, created_at);
+
+-- An organizer is verified while it has an approved submission; at most one submission is pending or approved
+CREATE UNIQUE INDEX IF NOT EXISTS idx_organizer_verifications_active
+  ON organizer_verifications(organizer_id) WHERE status IN ('pending', 'approved');
+
+INSERT INTO role_permissions (role, permission) VALUES
+  ('admin', 'orgs:verify')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,11 @@
+-- Remove event refund policy templates
+DELETE FROM order_refund_requests WHERE reason = 'buyer_request';
+ALTER TABLE order_refund_requests DROP CONSTRAINT IF EXISTS order_refund_requests_reason_check;
+ALTER TABLE order_refund_requests ADD CONSTRAINT order_refund_requests_reason_check
+  CHECK (reason IN ('order_expired', 'order_cancelled', 'duplicate_payment', 'insurance_claim', 'insurance_not_issued'));
+
+ALTER TABLE event_replicas
+  DROP COLUMN IF EXISTS refund_policy;
+
+ALTER TABLE events
+  DROP COLUMN IF EXISTS refund_policy;
@@ -0,0 +1,15 @@
+-- Standard refund policy templates organizers choose per event
+-- NULL: no template, the organizer publishes its own refund policy and buyer refund requests aren't accepted
+-- no_refunds: never refundable, seven_day: within 7 days of payment until the event starts,
+-- flexible: until 24 hours before the event starts
+ALTER TABLE events
+  ADD COLUMN IF NOT EXISTS refund_policy VARCHAR(20)
+  CHECK (refund_policy IN ('no_refunds', 'seven_day', 'flexible'));
+
+ALTER TABLE event_replicas
+  ADD COLUMN IF NOT EXISTS refund_policy VARCHAR(20);
+
+-- Refunds buyers request under the event's refund policy go through the manual refund queue
+ALTER TABLE order_refund_requests DROP CONSTRAINT IF EXISTS order_refund_requests_reason_check;
+ALTER TABLE order_refund_requests ADD CONSTRAINT order_refund_requests_reason_check
+  CHECK (reason IN ('order_expired', 'order_cancelled', 'duplicate_payment', 'insurance_claim', 'insurance_not_issued', 'buyer_request'));
@@ -0,0 +1,3 @@
+-- Remove co-organizer revenue splits
+DROP TABLE IF EXISTS event_settlement_shares;
+DROP TABLE IF EXISTS event_revenue_splits;
@@ -0,0 +1,28 @@
+-- Revenue sharing between co-organizers of an event, set by the event's organizer
+-- Percentages of an event's splits total 100; an event without splits pays its organizer in full
+CREATE TABLE IF NOT EXISTS event_revenue_splits (
+  event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+  organizer_id UUID NOT NULL REFERENCES users(id),
+  percentage DECIMAL(5,2) NOT NULL CHECK (percentage > 0 AND percentage <= 100),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (event_id, organizer_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_revenue_splits_organizer ON event_revenue_splits(organizer_id);
+
+-- Each party's part of a settlement payout, recorded with the settlement from the splits at that time
+CREATE TABLE IF NOT EXISTS event_settlement_shares (
+  event_id UUID NOT NULL REFERENCES event_settlements(event_id) ON DELETE CASCADE,
+  organizer_id UUID NOT NULL REFERENCES users(id),
+  percentage DECIMAL(5,2) NOT NULL,
+  payout_amount DECIMAL(12,2) NOT NULL, -- Owed to the party, shares add up to the settlement payout
+  PRIMARY KEY (event_id, organizer_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_settlement_shares_organizer ON event_settlement_shares(organizer_id);
+
+-- Settlements calculated before splits existed are owed to the event's organizer in full
+INSERT INTO event_settlement_shares (event_id, organizer_id, percentage, payout_amount)
+SELECT event_id, organizer_id, 100, payout_amount
+FROM event_settlements
+ON CONFLICT DO NOTHING;
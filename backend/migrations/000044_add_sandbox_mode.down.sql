@@ -0,0 +1,10 @@
+-- Remove sandbox mode
+ALTER TABLE payment_transactions DROP COLUMN IF EXISTS is_sandbox;
+
+DROP INDEX IF EXISTS idx_orders_sandbox;
+
+ALTER TABLE orders_archive DROP COLUMN IF EXISTS is_sandbox;
+ALTER TABLE orders DROP COLUMN IF EXISTS is_sandbox;
+
+ALTER TABLE event_replicas DROP COLUMN IF EXISTS is_sandbox;
+ALTER TABLE events DROP COLUMN IF EXISTS is_sandbox;
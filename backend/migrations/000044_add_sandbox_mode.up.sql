@@ -0,0 +1,27 @@
+-- Sandbox events let organizers rehearse the whole flow before going live: orders are paid with the
+-- payment gateway's test keys, tickets are marked TEST on PDFs and emails, and sandbox orders are left
+-- out of settlements and inventory history. Test orders are cleared before the event goes live
+ALTER TABLE events
+  ADD COLUMN IF NOT EXISTS is_sandbox BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE event_replicas
+  ADD COLUMN IF NOT EXISTS is_sandbox BOOLEAN NOT NULL DEFAULT false;
+
+-- Orders copy the flag of their event when created, so they stay test orders once the event goes live
+ALTER TABLE orders
+  ADD COLUMN IF NOT EXISTS is_sandbox BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE orders_archive
+  ADD COLUMN IF NOT EXISTS is_sandbox BOOLEAN NOT NULL DEFAULT false;
+
+-- Archival copies rows with SELECT o.*, NOW(), so archived_at must stay the last column
+ALTER TABLE orders_archive RENAME COLUMN archived_at TO archived_at_old;
+ALTER TABLE orders_archive ADD COLUMN archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+UPDATE orders_archive SET archived_at = archived_at_old;
+ALTER TABLE orders_archive DROP COLUMN archived_at_old;
+
+CREATE INDEX IF NOT EXISTS idx_orders_sandbox ON orders(event_id) WHERE is_sandbox;
+
+-- Sandbox payments are created with the test keys and confirmed by the test webhook token
+ALTER TABLE payment_transactions
+  ADD COLUMN IF NOT EXISTS is_sandbox BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,5 @@
+-- Remove event-sourced orders
+DROP TABLE IF EXISTS order_events;
+
+ALTER TABLE orders_archive DROP COLUMN IF EXISTS event_sourced;
+ALTER TABLE orders DROP COLUMN IF EXISTS event_sourced;
@@ -0,0 +1,25 @@
+-- Event-sourced orders (optional, feature flag event_sourced_orders): every change of such an order
+-- is appended to its stream in order_events, and the orders row is the projection of that stream.
+-- Replaying a stream rebuilds the row, e.g. after a bug wrote a wrong projection
+ALTER TABLE orders
+  ADD COLUMN IF NOT EXISTS event_sourced BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE orders_archive
+  ADD COLUMN IF NOT EXISTS event_sourced BOOLEAN NOT NULL DEFAULT false;
+
+-- Archival copies rows with SELECT o.*, NOW(), so archived_at must stay the last column
+ALTER TABLE orders_archive RENAME COLUMN archived_at TO archived_at_old;
+ALTER TABLE orders_archive ADD COLUMN archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+UPDATE orders_archive SET archived_at = archived_at_old;
+ALTER TABLE orders_archive DROP COLUMN archived_at_old;
+
+-- Append-only; streams are kept when their order is archived, as its audit trail
+CREATE TABLE IF NOT EXISTS order_events (
+  id BIGSERIAL PRIMARY KEY,
+  order_id UUID NOT NULL,
+  version INTEGER NOT NULL,
+  event_type VARCHAR(30) NOT NULL,
+  data JSONB NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT uq_order_events_version UNIQUE (order_id, version)
+);
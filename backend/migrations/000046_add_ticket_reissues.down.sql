@@ -0,0 +1,3 @@
+-- Remove bulk ticket reissues
+DROP TABLE IF EXISTS ticket_reissue_items;
+DROP TABLE IF EXISTS ticket_reissues;
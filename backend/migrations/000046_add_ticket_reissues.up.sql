@@ -0,0 +1,27 @@
+-- Bulk reissues replace the QR codes of every unused ticket of an event, e.g. after an export of
+-- its codes leaked. The codes are rotated and the tickets emailed again in batches by the
+-- scheduled job worker; each batch holds whole orders so an order's tickets are sent in one email
+CREATE TABLE IF NOT EXISTS ticket_reissues (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+  reason TEXT NOT NULL,
+  requested_by UUID NOT NULL REFERENCES users(id),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_ticket_reissues_event ON ticket_reissues(event_id, created_at);
+
+-- Tickets of a reissue: pending until their code is rotated (reissued), or skipped when the ticket
+-- was used or voided in the meantime. batch_id is the reference of the batch's scheduled job
+CREATE TABLE IF NOT EXISTS ticket_reissue_items (
+  reissue_id UUID NOT NULL REFERENCES ticket_reissues(id) ON DELETE CASCADE,
+  ticket_id UUID NOT NULL REFERENCES tickets(id) ON DELETE CASCADE,
+  order_id UUID NOT NULL,
+  batch_id UUID NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'reissued', 'skipped')),
+  emailed_at TIMESTAMPTZ,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (reissue_id, ticket_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ticket_reissue_items_batch ON ticket_reissue_items(batch_id);
@@ -0,0 +1,2 @@
+-- Remove white-label email senders
+DROP TABLE IF EXISTS organizer_email_senders;
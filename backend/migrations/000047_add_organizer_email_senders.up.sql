@@ -0,0 +1,21 @@
+-- White-label email senders: an organizer's own sending domain, from name and reply-to address.
+-- The domain is registered with the email provider (Resend) by event service; buyer emails of the
+-- organizer's events only use it once verified, until then they fall back to the tenant or platform sender
+CREATE TABLE IF NOT EXISTS organizer_email_senders (
+  organizer_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+  tenant_id UUID NOT NULL REFERENCES tenants(id),
+  domain VARCHAR(253) NOT NULL,
+  from_name VARCHAR(100) NOT NULL,
+  from_email VARCHAR(255) NOT NULL, -- Address on domain
+  reply_to VARCHAR(255),
+  provider_domain_id VARCHAR(100) NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'verified', 'failed')),
+  dns_records JSONB NOT NULL DEFAULT '[]', -- Records the organizer must publish, as last reported by the provider
+  verified_at TIMESTAMPTZ,
+  last_checked_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- A domain sends for one organizer only
+CREATE UNIQUE INDEX IF NOT EXISTS idx_organizer_email_senders_domain ON organizer_email_senders(LOWER(domain));
@@ -0,0 +1,8 @@
+-- Remove buyer refunds through the payment gateway
+DROP INDEX IF EXISTS idx_refunds_active_payment;
+
+ALTER TABLE refunds
+  DROP COLUMN IF EXISTS updated_at,
+  DROP COLUMN IF EXISTS failure_reason,
+  DROP COLUMN IF EXISTS provider_refund_id,
+  DROP COLUMN IF EXISTS requested_by;
@@ -0,0 +1,10 @@
+-- Buyer refunds through the payment gateway: payment service refunds the paid invoice with Xendit's
+-- refund API once ticketing service marked the order refunded and voided its tickets
+ALTER TABLE refunds
+  ADD COLUMN IF NOT EXISTS requested_by UUID,                 -- Buyer who requested the refund
+  ADD COLUMN IF NOT EXISTS provider_refund_id VARCHAR(255),   -- Xendit refund ID
+  ADD COLUMN IF NOT EXISTS failure_reason TEXT,               -- Why the refund failed, for support
+  ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+-- A payment is refunded once; failed refunds can be requested again
+CREATE UNIQUE INDEX IF NOT EXISTS idx_refunds_active_payment ON refunds(payment_transaction_id) WHERE status <> 'failed';
@@ -0,0 +1,2 @@
+-- Remove e-ticket templates
+DROP TABLE IF EXISTS organizer_ticket_templates;
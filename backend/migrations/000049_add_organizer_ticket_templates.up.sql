@@ -0,0 +1,14 @@
+-- E-ticket templates: an organizer's logo, brand colours, sponsor footer and terms printed on the
+-- PDF tickets of its events. Configured in event service; ticketing service sends the template with
+-- each ticket email and notification service renders it, organizers without one get the platform layout
+CREATE TABLE IF NOT EXISTS organizer_ticket_templates (
+  organizer_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+  tenant_id UUID NOT NULL REFERENCES tenants(id),
+  logo_url VARCHAR(500), -- HTTPS PNG or JPEG
+  primary_color VARCHAR(7), -- Hex, e.g. #1a73e8
+  accent_color VARCHAR(7),
+  sponsor_footer VARCHAR(200),
+  terms_text TEXT,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,5 @@
+-- Remove dynamic QR
+ALTER TABLE tickets_archive DROP COLUMN IF EXISTS qr_secret;
+ALTER TABLE tickets DROP COLUMN IF EXISTS qr_secret;
+ALTER TABLE event_replicas DROP COLUMN IF EXISTS dynamic_qr;
+ALTER TABLE events DROP COLUMN IF EXISTS dynamic_qr;
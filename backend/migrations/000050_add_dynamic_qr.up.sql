@@ -0,0 +1,21 @@
+-- Dynamic QR: tickets of high-security events are only admitted with a rotating TOTP-style token the
+-- buyer's app fetches right before entry, so screenshots of the e-ticket QR code are useless at the gate
+ALTER TABLE events
+  ADD COLUMN IF NOT EXISTS dynamic_qr BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE event_replicas
+  ADD COLUMN IF NOT EXISTS dynamic_qr BOOLEAN NOT NULL DEFAULT false;
+
+-- Per-ticket secret the rotating tokens are derived from; created on the first token request and
+-- cleared when the QR code is regenerated or reissued, so earlier tokens stop validating
+ALTER TABLE tickets
+  ADD COLUMN IF NOT EXISTS qr_secret VARCHAR(64);
+
+ALTER TABLE tickets_archive
+  ADD COLUMN IF NOT EXISTS qr_secret VARCHAR(64);
+
+-- Archival copies rows with SELECT t.*, NOW(), so archived_at must stay the last column
+ALTER TABLE tickets_archive RENAME COLUMN archived_at TO archived_at_old;
+ALTER TABLE tickets_archive ADD COLUMN archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+UPDATE tickets_archive SET archived_at = archived_at_old;
+ALTER TABLE tickets_archive DROP COLUMN archived_at_old;
@@ -0,0 +1,16 @@
+-- Remove webhook quarantine
+DROP INDEX IF EXISTS idx_webhook_events_quarantined;
+
+UPDATE webhook_events SET status = 'failed' WHERE status IN ('quarantined', 'dismissed');
+
+ALTER TABLE webhook_events
+  DROP CONSTRAINT IF EXISTS webhook_events_status_check;
+
+ALTER TABLE webhook_events
+  ADD CONSTRAINT webhook_events_status_check CHECK (status IN ('pending', 'processed', 'failed'));
+
+ALTER TABLE webhook_events
+  DROP COLUMN IF EXISTS resolved_at,
+  DROP COLUMN IF EXISTS resolved_by,
+  DROP COLUMN IF EXISTS quarantine_reason,
+  DROP COLUMN IF EXISTS sandbox;
@@ -0,0 +1,17 @@
+-- Webhook quarantine: callbacks of unknown event types, or that match no payment or refund, are kept
+-- for admins to replay or dismiss instead of being ignored
+ALTER TABLE webhook_events
+  ADD COLUMN IF NOT EXISTS sandbox BOOLEAN NOT NULL DEFAULT false,
+  ADD COLUMN IF NOT EXISTS quarantine_reason TEXT,
+  ADD COLUMN IF NOT EXISTS resolved_by UUID,
+  ADD COLUMN IF NOT EXISTS resolved_at TIMESTAMPTZ;
+
+ALTER TABLE webhook_events
+  DROP CONSTRAINT IF EXISTS webhook_events_status_check;
+
+ALTER TABLE webhook_events
+  ADD CONSTRAINT webhook_events_status_check CHECK (status IN ('pending', 'processed', 'failed', 'quarantined', 'dismissed'));
+
+-- Quarantine list, newest first
+CREATE INDEX IF NOT EXISTS idx_webhook_events_quarantined ON webhook_events(created_at DESC)
+  WHERE status = 'quarantined';
@@ -0,0 +1,2 @@
+-- Remove ticket tier waitlists
+DROP TABLE IF EXISTS waitlist_entries;
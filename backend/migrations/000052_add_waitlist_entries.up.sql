@@ -0,0 +1,28 @@
+-- Waitlist of sold-out ticket tiers, served first come first served
+-- When seats free up the next waiting entry is offered them: an offered entry holds its seats in
+-- ticket_tiers.sold_count until the buyer orders them (the order then holds them) or offer_expires_at
+-- passes and they are offered to the next entry
+-- order_id has no foreign key: the order may be archived
+CREATE TABLE IF NOT EXISTS waitlist_entries (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+  ticket_tier_id UUID NOT NULL REFERENCES ticket_tiers(id),
+  user_id UUID NOT NULL REFERENCES users(id),
+  quantity INT NOT NULL CHECK (quantity > 0),
+  status VARCHAR(20) NOT NULL DEFAULT 'waiting'
+    CHECK (status IN ('waiting', 'offered', 'purchased', 'expired', 'cancelled')),
+  offered_at TIMESTAMPTZ,
+  offer_expires_at TIMESTAMPTZ,
+  order_id UUID,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CHECK (status <> 'offered' OR offer_expires_at IS NOT NULL)
+);
+
+-- One open entry per user and tier; users may join again after their entry closed
+CREATE UNIQUE INDEX IF NOT EXISTS uq_waitlist_entries_open_user
+  ON waitlist_entries(ticket_tier_id, user_id) WHERE status IN ('waiting', 'offered');
+CREATE INDEX IF NOT EXISTS idx_waitlist_entries_open_tier
+  ON waitlist_entries(ticket_tier_id, created_at) WHERE status IN ('waiting', 'offered');
+CREATE INDEX IF NOT EXISTS idx_waitlist_entries_offer_expiry
+  ON waitlist_entries(offer_expires_at) WHERE status = 'offered';
@@ -0,0 +1,2 @@
+-- Remove refresh tokens
+DROP TABLE IF EXISTS refresh_tokens;
@@ -0,0 +1,13 @@
+-- Refresh tokens, stored as SHA-256 hashes and rotated on every use
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ,
+    replaced_by UUID REFERENCES refresh_tokens(id) ON DELETE SET NULL, -- Token issued by rotating this one
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Index for revoking the outstanding tokens of a user (logout, password change)
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user ON refresh_tokens(user_id) WHERE revoked_at IS NULL;
@@ -0,0 +1,5 @@
+-- Remove payment attempt numbering
+DROP INDEX IF EXISTS idx_payment_transactions_order_paid;
+DROP INDEX IF EXISTS idx_payment_transactions_order_attempt;
+
+ALTER TABLE payment_transactions DROP COLUMN IF EXISTS attempt_number;
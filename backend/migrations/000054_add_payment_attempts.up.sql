@@ -0,0 +1,19 @@
+-- Payment attempts: an order gets a new payment transaction each time the buyer retries payment
+ALTER TABLE payment_transactions
+    ADD COLUMN IF NOT EXISTS attempt_number INT NOT NULL DEFAULT 1;
+
+-- Number the transactions of orders that already have several, oldest first
+UPDATE payment_transactions pt
+SET attempt_number = numbered.attempt_number
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY order_id ORDER BY created_at, id) AS attempt_number
+    FROM payment_transactions
+) numbered
+WHERE pt.id = numbered.id AND pt.attempt_number <> numbered.attempt_number;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payment_transactions_order_attempt
+    ON payment_transactions(order_id, attempt_number);
+
+-- Only one attempt per order can be paid
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payment_transactions_order_paid
+    ON payment_transactions(order_id) WHERE status = 'paid';
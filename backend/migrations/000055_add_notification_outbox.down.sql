@@ -0,0 +1,2 @@
+-- Remove notification outbox
+DROP TABLE IF EXISTS notification_outbox;
@@ -0,0 +1,20 @@
+-- Notification outbox: emails that failed to send, retried by a worker with exponential backoff
+-- Workers claim due messages with FOR UPDATE SKIP LOCKED like scheduled_jobs; a message that runs
+-- out of attempts is dead-lettered (status 'dead') and reported instead of being dropped
+-- order_id has no foreign key: the order may be archived before the message is sent
+CREATE TABLE IF NOT EXISTS notification_outbox (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  type VARCHAR(50) NOT NULL,
+  order_id UUID NOT NULL,
+  status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sending', 'sent', 'dead')),
+  attempts INT NOT NULL DEFAULT 0,
+  next_attempt_at TIMESTAMPTZ NOT NULL,
+  last_error TEXT,
+  locked_until TIMESTAMPTZ,
+  sent_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT uq_notification_outbox_order UNIQUE (type, order_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_outbox_due ON notification_outbox(next_attempt_at) WHERE status IN ('pending', 'sending');
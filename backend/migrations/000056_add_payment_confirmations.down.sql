@@ -0,0 +1,2 @@
+-- Remove payment confirmation tracking
+DROP TABLE IF EXISTS payment_confirmations;
@@ -0,0 +1,22 @@
+-- Order confirmations of paid payments: ticketing-service confirms the order and generates its tickets
+-- A confirmation that failed (ticketing-service unreachable) is retried by a payment-service worker with
+-- backoff until it succeeds or runs out of attempts ('failed'); support can replay it at any time
+-- Payments paid before this table existed have no row and are assumed confirmed
+CREATE TABLE IF NOT EXISTS payment_confirmations (
+  payment_id UUID PRIMARY KEY REFERENCES payment_transactions(id) ON DELETE CASCADE,
+  order_id UUID NOT NULL,
+  provider_payment_id VARCHAR(255) NOT NULL, -- Xendit invoice or payment ID, sent to ticketing-service as payment ID
+  payment_method VARCHAR(50),
+  amount DECIMAL(12,2) NOT NULL,
+  currency VARCHAR(3),
+  status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'confirmed', 'failed')),
+  outcome VARCHAR(30), -- Confirmation outcome reported by ticketing-service
+  attempts INT NOT NULL DEFAULT 0,
+  next_attempt_at TIMESTAMPTZ NOT NULL,
+  last_error TEXT,
+  confirmed_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_confirmations_due ON payment_confirmations(next_attempt_at) WHERE status = 'pending';
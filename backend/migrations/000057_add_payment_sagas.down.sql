@@ -0,0 +1,2 @@
+-- Remove paid-order saga tracking
+DROP TABLE IF EXISTS payment_sagas;
@@ -0,0 +1,28 @@
+-- Paid-order saga: payment captured -> order confirmed -> tickets generated -> email sent
+-- payment-service drives the saga, one row per paid payment. Steps only move forward in that order,
+-- the email step reported by ticketing-service is stamped when it arrives early and applied once the
+-- tickets are generated. Payments ticketing-service refused, or whose confirmation permanently failed,
+-- are compensated by refunding them ('compensating' until refunded, 'failed' if the refund failed)
+-- Payments paid before this table existed have no saga
+CREATE TABLE IF NOT EXISTS payment_sagas (
+  payment_id UUID PRIMARY KEY REFERENCES payment_transactions(id) ON DELETE CASCADE,
+  order_id UUID NOT NULL,
+  provider_payment_id VARCHAR(255) NOT NULL, -- Payment ID ticketing-service records on the order
+  step VARCHAR(30) NOT NULL DEFAULT 'payment_captured'
+    CHECK (step IN ('payment_captured', 'order_confirmed', 'tickets_generated', 'email_sent')), -- Last completed step
+  status VARCHAR(20) NOT NULL DEFAULT 'running'
+    CHECK (status IN ('running', 'completed', 'compensating', 'compensated', 'failed')),
+  tickets_generated INT NOT NULL DEFAULT 0,
+  compensation_reason VARCHAR(50), -- order_cancelled, order_expired, duplicate_payment, confirmation_failed
+  last_error TEXT,
+  captured_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  order_confirmed_at TIMESTAMPTZ,
+  tickets_generated_at TIMESTAMPTZ,
+  email_sent_at TIMESTAMPTZ,
+  compensated_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_sagas_order ON payment_sagas(order_id);
+CREATE INDEX IF NOT EXISTS idx_payment_sagas_status ON payment_sagas(status, step, updated_at DESC);
@@ -0,0 +1,2 @@
+-- Remove payment transaction currency
+ALTER TABLE payment_transactions DROP COLUMN IF EXISTS currency;
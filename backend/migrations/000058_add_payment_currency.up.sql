@@ -0,0 +1,3 @@
+-- Currency of a payment transaction: the invoice currency, replaced by the currency Xendit reports paid
+-- Refunds are paid back in it. Every invoice so far was created in IDR
+ALTER TABLE payment_transactions ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'IDR';
@@ -0,0 +1,2 @@
+-- Remove paid payment index
+DROP INDEX IF EXISTS idx_payment_transactions_paid_at;
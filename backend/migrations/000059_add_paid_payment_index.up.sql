@@ -0,0 +1,3 @@
+-- Recently paid payments, scanned by the confirmation retry worker for payments without a confirmation
+-- (e.g. the webhook crashed between marking the payment paid and tracking its confirmation)
+CREATE INDEX IF NOT EXISTS idx_payment_transactions_paid_at ON payment_transactions(paid_at) WHERE status = 'paid';
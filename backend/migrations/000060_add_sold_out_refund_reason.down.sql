@@ -0,0 +1,5 @@
+-- Remove sold out refund reason, its requests are recorded as late payments of expired orders
+UPDATE order_refund_requests SET reason = 'order_expired' WHERE reason = 'sold_out';
+ALTER TABLE order_refund_requests DROP CONSTRAINT IF EXISTS order_refund_requests_reason_check;
+ALTER TABLE order_refund_requests ADD CONSTRAINT order_refund_requests_reason_check
+  CHECK (reason IN ('order_expired', 'order_cancelled', 'duplicate_payment', 'insurance_claim', 'insurance_not_issued', 'buyer_request'));
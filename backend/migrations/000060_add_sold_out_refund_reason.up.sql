@@ -0,0 +1,5 @@
+-- Payments racing reservation expiry whose tickets sold out during the grace period are refunded by
+-- payment-service through Xendit, their refund request is marked refunded once it went through
+ALTER TABLE order_refund_requests DROP CONSTRAINT IF EXISTS order_refund_requests_reason_check;
+ALTER TABLE order_refund_requests ADD CONSTRAINT order_refund_requests_reason_check
+  CHECK (reason IN ('order_expired', 'order_cancelled', 'duplicate_payment', 'insurance_claim', 'insurance_not_issued', 'buyer_request', 'sold_out'));
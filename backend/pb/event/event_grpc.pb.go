@@ -0,0 +1,375 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.29.2
+// source: event/event.proto
+
+package event
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// EventServiceClient is the client API for EventService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EventServiceClient interface {
+	// GetEvent returns a single event (NOT_FOUND if it doesn't exist)
+	GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*Event, error)
+	// GetEvents returns events by IDs in one call; missing IDs are skipped
+	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
+	// GetTier returns a single ticket tier (NOT_FOUND if it doesn't exist)
+	GetTier(ctx context.Context, in *GetTierRequest, opts ...grpc.CallOption) (*TicketTier, error)
+	// GetTiers returns ticket tiers by IDs in one call; missing IDs are skipped
+	GetTiers(ctx context.Context, in *GetTiersRequest, opts ...grpc.CallOption) (*ListTiersResponse, error)
+	// ListTiersByEvent returns all ticket tiers of an event
+	ListTiersByEvent(ctx context.Context, in *ListTiersByEventRequest, opts ...grpc.CallOption) (*ListTiersResponse, error)
+	// ListChanges returns the change feed of events and their tiers after a sequence
+	// Consumers re-read changed events with GetEvent and ListTiersByEvent
+	ListChanges(ctx context.Context, in *ListChangesRequest, opts ...grpc.CallOption) (*ListChangesResponse, error)
+	// GetOrganizerPlan returns the plan limits of an organizer (the free plan if none is assigned)
+	GetOrganizerPlan(ctx context.Context, in *GetOrganizerPlanRequest, opts ...grpc.CallOption) (*OrganizerPlan, error)
+	// AssignOrganizerPlan puts an organizer on a plan (NOT_FOUND if the plan or organizer doesn't exist)
+	AssignOrganizerPlan(ctx context.Context, in *AssignOrganizerPlanRequest, opts ...grpc.CallOption) (*OrganizerPlan, error)
+}
+
+type eventServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventServiceClient(cc grpc.ClientConnInterface) EventServiceClient {
+	return &eventServiceClient{cc}
+}
+
+func (c *eventServiceClient) GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	out := new(Event)
+	err := c.cc.Invoke(ctx, "/event.EventService/GetEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error) {
+	out := new(GetEventsResponse)
+	err := c.cc.Invoke(ctx, "/event.EventService/GetEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetTier(ctx context.Context, in *GetTierRequest, opts ...grpc.CallOption) (*TicketTier, error) {
+	out := new(TicketTier)
+	err := c.cc.Invoke(ctx, "/event.EventService/GetTier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetTiers(ctx context.Context, in *GetTiersRequest, opts ...grpc.CallOption) (*ListTiersResponse, error) {
+	out := new(ListTiersResponse)
+	err := c.cc.Invoke(ctx, "/event.EventService/GetTiers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListTiersByEvent(ctx context.Context, in *ListTiersByEventRequest, opts ...grpc.CallOption) (*ListTiersResponse, error) {
+	out := new(ListTiersResponse)
+	err := c.cc.Invoke(ctx, "/event.EventService/ListTiersByEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListChanges(ctx context.Context, in *ListChangesRequest, opts ...grpc.CallOption) (*ListChangesResponse, error) {
+	out := new(ListChangesResponse)
+	err := c.cc.Invoke(ctx, "/event.EventService/ListChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetOrganizerPlan(ctx context.Context, in *GetOrganizerPlanRequest, opts ...grpc.CallOption) (*OrganizerPlan, error) {
+	out := new(OrganizerPlan)
+	err := c.cc.Invoke(ctx, "/event.EventService/GetOrganizerPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) AssignOrganizerPlan(ctx context.Context, in *AssignOrganizerPlanRequest, opts ...grpc.CallOption) (*OrganizerPlan, error) {
+	out := new(OrganizerPlan)
+	err := c.cc.Invoke(ctx, "/event.EventService/AssignOrganizerPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventServiceServer is the server API for EventService service.
+// All implementations must embed UnimplementedEventServiceServer
+// for forward compatibility
+type EventServiceServer interface {
+	// GetEvent returns a single event (NOT_FOUND if it doesn't exist)
+	GetEvent(context.Context, *GetEventRequest) (*Event, error)
+	// GetEvents returns events by IDs in one call; missing IDs are skipped
+	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
+	// GetTier returns a single ticket tier (NOT_FOUND if it doesn't exist)
+	GetTier(context.Context, *GetTierRequest) (*TicketTier, error)
+	// GetTiers returns ticket tiers by IDs in one call; missing IDs are skipped
+	GetTiers(context.Context, *GetTiersRequest) (*ListTiersResponse, error)
+	// ListTiersByEvent returns all ticket tiers of an event
+	ListTiersByEvent(context.Context, *ListTiersByEventRequest) (*ListTiersResponse, error)
+	// ListChanges returns the change feed of events and their tiers after a sequence
+	// Consumers re-read changed events with GetEvent and ListTiersByEvent
+	ListChanges(context.Context, *ListChangesRequest) (*ListChangesResponse, error)
+	// GetOrganizerPlan returns the plan limits of an organizer (the free plan if none is assigned)
+	GetOrganizerPlan(context.Context, *GetOrganizerPlanRequest) (*OrganizerPlan, error)
+	// AssignOrganizerPlan puts an organizer on a plan (NOT_FOUND if the plan or organizer doesn't exist)
+	AssignOrganizerPlan(context.Context, *AssignOrganizerPlanRequest) (*OrganizerPlan, error)
+	mustEmbedUnimplementedEventServiceServer()
+}
+
+// UnimplementedEventServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedEventServiceServer struct {
+}
+
+func (UnimplementedEventServiceServer) GetEvent(context.Context, *GetEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvent not implemented")
+}
+func (UnimplementedEventServiceServer) GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+}
+func (UnimplementedEventServiceServer) GetTier(context.Context, *GetTierRequest) (*TicketTier, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTier not implemented")
+}
+func (UnimplementedEventServiceServer) GetTiers(context.Context, *GetTiersRequest) (*ListTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTiers not implemented")
+}
+func (UnimplementedEventServiceServer) ListTiersByEvent(context.Context, *ListTiersByEventRequest) (*ListTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTiersByEvent not implemented")
+}
+func (UnimplementedEventServiceServer) ListChanges(context.Context, *ListChangesRequest) (*ListChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChanges not implemented")
+}
+func (UnimplementedEventServiceServer) GetOrganizerPlan(context.Context, *GetOrganizerPlanRequest) (*OrganizerPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizerPlan not implemented")
+}
+func (UnimplementedEventServiceServer) AssignOrganizerPlan(context.Context, *AssignOrganizerPlanRequest) (*OrganizerPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignOrganizerPlan not implemented")
+}
+func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
+
+// UnsafeEventServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventServiceServer will
+// result in compilation errors.
+type UnsafeEventServiceServer interface {
+	mustEmbedUnimplementedEventServiceServer()
+}
+
+func RegisterEventServiceServer(s grpc.ServiceRegistrar, srv EventServiceServer) {
+	s.RegisterService(&EventService_ServiceDesc, srv)
+}
+
+func _EventService_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/event.EventService/GetEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEvent(ctx, req.(*GetEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/event.EventService/GetEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEvents(ctx, req.(*GetEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetTier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetTier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/event.EventService/GetTier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetTier(ctx, req.(*GetTierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/event.EventService/GetTiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetTiers(ctx, req.(*GetTiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListTiersByEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTiersByEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListTiersByEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/event.EventService/ListTiersByEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListTiersByEvent(ctx, req.(*ListTiersByEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/event.EventService/ListChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListChanges(ctx, req.(*ListChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetOrganizerPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizerPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetOrganizerPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/event.EventService/GetOrganizerPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetOrganizerPlan(ctx, req.(*GetOrganizerPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_AssignOrganizerPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignOrganizerPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).AssignOrganizerPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/event.EventService/AssignOrganizerPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).AssignOrganizerPlan(ctx, req.(*AssignOrganizerPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EventService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "event.EventService",
+	HandlerType: (*EventServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetEvent",
+			Handler:    _EventService_GetEvent_Handler,
+		},
+		{
+			MethodName: "GetEvents",
+			Handler:    _EventService_GetEvents_Handler,
+		},
+		{
+			MethodName: "GetTier",
+			Handler:    _EventService_GetTier_Handler,
+		},
+		{
+			MethodName: "GetTiers",
+			Handler:    _EventService_GetTiers_Handler,
+		},
+		{
+			MethodName: "ListTiersByEvent",
+			Handler:    _EventService_ListTiersByEvent_Handler,
+		},
+		{
+			MethodName: "ListChanges",
+			Handler:    _EventService_ListChanges_Handler,
+		},
+		{
+			MethodName: "GetOrganizerPlan",
+			Handler:    _EventService_GetOrganizerPlan_Handler,
+		},
+		{
+			MethodName: "AssignOrganizerPlan",
+			Handler:    _EventService_AssignOrganizerPlan_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "event/event.proto",
+}
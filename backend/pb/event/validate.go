@@ -0,0 +1,21 @@
+package event
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// Validate methods follow the protoc-gen-validate convention and are checked
+// by the shared gRPC interceptors (pkg/interceptor) on both client and server.
+
+// Validate checks a plan assignment
+func (r *AssignOrganizerPlanRequest) Validate() error {
+	if _, err := uuid.Parse(r.GetOrganizerId()); err != nil {
+		return errors.New("organizer_id must be a valid UUID")
+	}
+	if r.GetPlanCode() == "" {
+		return errors.New("plan_code is required")
+	}
+	return nil
+}
@@ -24,6 +24,37 @@ const _ = grpc.SupportPackageIsVersion7
 type NotificationServiceClient interface {
 	// SendTicketEmail sends e-ticket to customer via email
 	SendTicketEmail(ctx context.Context, in *SendTicketEmailRequest, opts ...grpc.CallOption) (*SendTicketEmailResponse, error)
+	// StreamTicketEmail sends e-ticket email for large orders in chunks
+	// so the request never exceeds the configured max message size
+	StreamTicketEmail(ctx context.Context, opts ...grpc.CallOption) (NotificationService_StreamTicketEmailClient, error)
+	// GenerateBadgePDF renders printable attendee badges of an event as one PDF
+	GenerateBadgePDF(ctx context.Context, in *GenerateBadgePDFRequest, opts ...grpc.CallOption) (*GenerateBadgePDFResponse, error)
+	// GenerateTicketPreviewPDF renders a sample e-ticket with an organizer's ticket template
+	GenerateTicketPreviewPDF(ctx context.Context, in *GenerateTicketPreviewPDFRequest, opts ...grpc.CallOption) (*GenerateTicketPreviewPDFResponse, error)
+	// SendAnnouncementEmail sends an organizer announcement to attendees of an event
+	// RESOURCE_EXHAUSTED if the recipients exceed the organizer's monthly announcement quota
+	SendAnnouncementEmail(ctx context.Context, in *SendAnnouncementEmailRequest, opts ...grpc.CallOption) (*SendAnnouncementEmailResponse, error)
+	// SendSubscriptionDunningEmail tells an organizer that a plan subscription renewal failed
+	SendSubscriptionDunningEmail(ctx context.Context, in *SendSubscriptionDunningEmailRequest, opts ...grpc.CallOption) (*SendSubscriptionDunningEmailResponse, error)
+	// SendReservationReminderEmail reminds a buyer to pay a reservation before it expires
+	SendReservationReminderEmail(ctx context.Context, in *SendReservationReminderEmailRequest, opts ...grpc.CallOption) (*SendReservationReminderEmailResponse, error)
+	// SendInvitationEmail sends an invitee the personal claim link of an event invitation
+	SendInvitationEmail(ctx context.Context, in *SendInvitationEmailRequest, opts ...grpc.CallOption) (*SendInvitationEmailResponse, error)
+	// SendWaitlistOfferEmail tells a waitlisted buyer that seats of a sold-out tier are held for them
+	SendWaitlistOfferEmail(ctx context.Context, in *SendWaitlistOfferEmailRequest, opts ...grpc.CallOption) (*SendWaitlistOfferEmailResponse, error)
+	// SendEventModerationEmail tells an organizer that their event was unpublished, restored or removed after abuse reports
+	SendEventModerationEmail(ctx context.Context, in *SendEventModerationEmailRequest, opts ...grpc.CallOption) (*SendEventModerationEmailResponse, error)
+	// GetDigestPreference returns how often a recipient gets non-urgent notifications
+	GetDigestPreference(ctx context.Context, in *GetDigestPreferenceRequest, opts ...grpc.CallOption) (*DigestPreference, error)
+	// SetDigestPreference changes how often a recipient gets non-urgent notifications
+	// INVALID_ARGUMENT for an unknown frequency, FAILED_PRECONDITION when digests are disabled
+	SetDigestPreference(ctx context.Context, in *SetDigestPreferenceRequest, opts ...grpc.CallOption) (*DigestPreference, error)
+	// RegisterSenderDomain adds an organizer's sending domain to the email provider
+	// The returned DNS records must be published before the domain can be verified
+	RegisterSenderDomain(ctx context.Context, in *RegisterSenderDomainRequest, opts ...grpc.CallOption) (*SenderDomain, error)
+	// VerifySenderDomain checks the DNS records of a registered sending domain
+	// NOT_FOUND when the email provider doesn't know the domain
+	VerifySenderDomain(ctx context.Context, in *VerifySenderDomainRequest, opts ...grpc.CallOption) (*SenderDomain, error)
 }
 
 type notificationServiceClient struct {
@@ -43,12 +74,185 @@ func (c *notificationServiceClient) SendTicketEmail(ctx context.Context, in *Sen
 	return out, nil
 }
 
+func (c *notificationServiceClient) StreamTicketEmail(ctx context.Context, opts ...grpc.CallOption) (NotificationService_StreamTicketEmailClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NotificationService_ServiceDesc.Streams[0], "/notification.NotificationService/StreamTicketEmail", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &notificationServiceStreamTicketEmailClient{stream}
+	return x, nil
+}
+
+type NotificationService_StreamTicketEmailClient interface {
+	Send(*TicketEmailChunk) error
+	CloseAndRecv() (*SendTicketEmailResponse, error)
+	grpc.ClientStream
+}
+
+type notificationServiceStreamTicketEmailClient struct {
+	grpc.ClientStream
+}
+
+func (x *notificationServiceStreamTicketEmailClient) Send(m *TicketEmailChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *notificationServiceStreamTicketEmailClient) CloseAndRecv() (*SendTicketEmailResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(SendTicketEmailResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *notificationServiceClient) GenerateBadgePDF(ctx context.Context, in *GenerateBadgePDFRequest, opts ...grpc.CallOption) (*GenerateBadgePDFResponse, error) {
+	out := new(GenerateBadgePDFResponse)
+	err := c.cc.Invoke(ctx, "/notification.NotificationService/GenerateBadgePDF", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) GenerateTicketPreviewPDF(ctx context.Context, in *GenerateTicketPreviewPDFRequest, opts ...grpc.CallOption) (*GenerateTicketPreviewPDFResponse, error) {
+	out := new(GenerateTicketPreviewPDFResponse)
+	err := c.cc.Invoke(ctx, "/notification.NotificationService/GenerateTicketPreviewPDF", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) SendAnnouncementEmail(ctx context.Context, in *SendAnnouncementEmailRequest, opts ...grpc.CallOption) (*SendAnnouncementEmailResponse, error) {
+	out := new(SendAnnouncementEmailResponse)
+	err := c.cc.Invoke(ctx, "/notification.NotificationService/SendAnnouncementEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) SendSubscriptionDunningEmail(ctx context.Context, in *SendSubscriptionDunningEmailRequest, opts ...grpc.CallOption) (*SendSubscriptionDunningEmailResponse, error) {
+	out := new(SendSubscriptionDunningEmailResponse)
+	err := c.cc.Invoke(ctx, "/notification.NotificationService/SendSubscriptionDunningEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) SendReservationReminderEmail(ctx context.Context, in *SendReservationReminderEmailRequest, opts ...grpc.CallOption) (*SendReservationReminderEmailResponse, error) {
+	out := new(SendReservationReminderEmailResponse)
+	err := c.cc.Invoke(ctx, "/notification.NotificationService/SendReservationReminderEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) SendInvitationEmail(ctx context.Context, in *SendInvitationEmailRequest, opts ...grpc.CallOption) (*SendInvitationEmailResponse, error) {
+	out := new(SendInvitationEmailResponse)
+	err := c.cc.Invoke(ctx, "/notification.NotificationService/SendInvitationEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) SendWaitlistOfferEmail(ctx context.Context, in *SendWaitlistOfferEmailRequest, opts ...grpc.CallOption) (*SendWaitlistOfferEmailResponse, error) {
+	out := new(SendWaitlistOfferEmailResponse)
+	err := c.cc.Invoke(ctx, "/notification.NotificationService/SendWaitlistOfferEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) SendEventModerationEmail(ctx context.Context, in *SendEventModerationEmailRequest, opts ...grpc.CallOption) (*SendEventModerationEmailResponse, error) {
+	out := new(SendEventModerationEmailResponse)
+	err := c.cc.Invoke(ctx, "/notification.NotificationService/SendEventModerationEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) GetDigestPreference(ctx context.Context, in *GetDigestPreferenceRequest, opts ...grpc.CallOption) (*DigestPreference, error) {
+	out := new(DigestPreference)
+	err := c.cc.Invoke(ctx, "/notification.NotificationService/GetDigestPreference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) SetDigestPreference(ctx context.Context, in *SetDigestPreferenceRequest, opts ...grpc.CallOption) (*DigestPreference, error) {
+	out := new(DigestPreference)
+	err := c.cc.Invoke(ctx, "/notification.NotificationService/SetDigestPreference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) RegisterSenderDomain(ctx context.Context, in *RegisterSenderDomainRequest, opts ...grpc.CallOption) (*SenderDomain, error) {
+	out := new(SenderDomain)
+	err := c.cc.Invoke(ctx, "/notification.NotificationService/RegisterSenderDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) VerifySenderDomain(ctx context.Context, in *VerifySenderDomainRequest, opts ...grpc.CallOption) (*SenderDomain, error) {
+	out := new(SenderDomain)
+	err := c.cc.Invoke(ctx, "/notification.NotificationService/VerifySenderDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationServiceServer is the server API for NotificationService service.
 // All implementations must embed UnimplementedNotificationServiceServer
 // for forward compatibility
 type NotificationServiceServer interface {
 	// SendTicketEmail sends e-ticket to customer via email
 	SendTicketEmail(context.Context, *SendTicketEmailRequest) (*SendTicketEmailResponse, error)
+	// StreamTicketEmail sends e-ticket email for large orders in chunks
+	// so the request never exceeds the configured max message size
+	StreamTicketEmail(NotificationService_StreamTicketEmailServer) error
+	// GenerateBadgePDF renders printable attendee badges of an event as one PDF
+	GenerateBadgePDF(context.Context, *GenerateBadgePDFRequest) (*GenerateBadgePDFResponse, error)
+	// GenerateTicketPreviewPDF renders a sample e-ticket with an organizer's ticket template
+	GenerateTicketPreviewPDF(context.Context, *GenerateTicketPreviewPDFRequest) (*GenerateTicketPreviewPDFResponse, error)
+	// SendAnnouncementEmail sends an organizer announcement to attendees of an event
+	// RESOURCE_EXHAUSTED if the recipients exceed the organizer's monthly announcement quota
+	SendAnnouncementEmail(context.Context, *SendAnnouncementEmailRequest) (*SendAnnouncementEmailResponse, error)
+	// SendSubscriptionDunningEmail tells an organizer that a plan subscription renewal failed
+	SendSubscriptionDunningEmail(context.Context, *SendSubscriptionDunningEmailRequest) (*SendSubscriptionDunningEmailResponse, error)
+	// SendReservationReminderEmail reminds a buyer to pay a reservation before it expires
+	SendReservationReminderEmail(context.Context, *SendReservationReminderEmailRequest) (*SendReservationReminderEmailResponse, error)
+	// SendInvitationEmail sends an invitee the personal claim link of an event invitation
+	SendInvitationEmail(context.Context, *SendInvitationEmailRequest) (*SendInvitationEmailResponse, error)
+	// SendWaitlistOfferEmail tells a waitlisted buyer that seats of a sold-out tier are held for them
+	SendWaitlistOfferEmail(context.Context, *SendWaitlistOfferEmailRequest) (*SendWaitlistOfferEmailResponse, error)
+	// SendEventModerationEmail tells an organizer that their event was unpublished, restored or removed after abuse reports
+	SendEventModerationEmail(context.Context, *SendEventModerationEmailRequest) (*SendEventModerationEmailResponse, error)
+	// GetDigestPreference returns how often a recipient gets non-urgent notifications
+	GetDigestPreference(context.Context, *GetDigestPreferenceRequest) (*DigestPreference, error)
+	// SetDigestPreference changes how often a recipient gets non-urgent notifications
+	// INVALID_ARGUMENT for an unknown frequency, FAILED_PRECONDITION when digests are disabled
+	SetDigestPreference(context.Context, *SetDigestPreferenceRequest) (*DigestPreference, error)
+	// RegisterSenderDomain adds an organizer's sending domain to the email provider
+	// The returned DNS records must be published before the domain can be verified
+	RegisterSenderDomain(context.Context, *RegisterSenderDomainRequest) (*SenderDomain, error)
+	// VerifySenderDomain checks the DNS records of a registered sending domain
+	// NOT_FOUND when the email provider doesn't know the domain
+	VerifySenderDomain(context.Context, *VerifySenderDomainRequest) (*SenderDomain, error)
 	mustEmbedUnimplementedNotificationServiceServer()
 }
 
@@ -59,6 +263,45 @@ type UnimplementedNotificationServiceServer struct {
 func (UnimplementedNotificationServiceServer) SendTicketEmail(context.Context, *SendTicketEmailRequest) (*SendTicketEmailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendTicketEmail not implemented")
 }
+func (UnimplementedNotificationServiceServer) StreamTicketEmail(NotificationService_StreamTicketEmailServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTicketEmail not implemented")
+}
+func (UnimplementedNotificationServiceServer) GenerateBadgePDF(context.Context, *GenerateBadgePDFRequest) (*GenerateBadgePDFResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateBadgePDF not implemented")
+}
+func (UnimplementedNotificationServiceServer) GenerateTicketPreviewPDF(context.Context, *GenerateTicketPreviewPDFRequest) (*GenerateTicketPreviewPDFResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateTicketPreviewPDF not implemented")
+}
+func (UnimplementedNotificationServiceServer) SendAnnouncementEmail(context.Context, *SendAnnouncementEmailRequest) (*SendAnnouncementEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendAnnouncementEmail not implemented")
+}
+func (UnimplementedNotificationServiceServer) SendSubscriptionDunningEmail(context.Context, *SendSubscriptionDunningEmailRequest) (*SendSubscriptionDunningEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendSubscriptionDunningEmail not implemented")
+}
+func (UnimplementedNotificationServiceServer) SendReservationReminderEmail(context.Context, *SendReservationReminderEmailRequest) (*SendReservationReminderEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendReservationReminderEmail not implemented")
+}
+func (UnimplementedNotificationServiceServer) SendInvitationEmail(context.Context, *SendInvitationEmailRequest) (*SendInvitationEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendInvitationEmail not implemented")
+}
+func (UnimplementedNotificationServiceServer) SendWaitlistOfferEmail(context.Context, *SendWaitlistOfferEmailRequest) (*SendWaitlistOfferEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendWaitlistOfferEmail not implemented")
+}
+func (UnimplementedNotificationServiceServer) SendEventModerationEmail(context.Context, *SendEventModerationEmailRequest) (*SendEventModerationEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEventModerationEmail not implemented")
+}
+func (UnimplementedNotificationServiceServer) GetDigestPreference(context.Context, *GetDigestPreferenceRequest) (*DigestPreference, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDigestPreference not implemented")
+}
+func (UnimplementedNotificationServiceServer) SetDigestPreference(context.Context, *SetDigestPreferenceRequest) (*DigestPreference, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDigestPreference not implemented")
+}
+func (UnimplementedNotificationServiceServer) RegisterSenderDomain(context.Context, *RegisterSenderDomainRequest) (*SenderDomain, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSenderDomain not implemented")
+}
+func (UnimplementedNotificationServiceServer) VerifySenderDomain(context.Context, *VerifySenderDomainRequest) (*SenderDomain, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySenderDomain not implemented")
+}
 func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
 
 // UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -90,6 +333,248 @@ func _NotificationService_SendTicketEmail_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_StreamTicketEmail_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NotificationServiceServer).StreamTicketEmail(&notificationServiceStreamTicketEmailServer{stream})
+}
+
+type NotificationService_StreamTicketEmailServer interface {
+	SendAndClose(*SendTicketEmailResponse) error
+	Recv() (*TicketEmailChunk, error)
+	grpc.ServerStream
+}
+
+type notificationServiceStreamTicketEmailServer struct {
+	grpc.ServerStream
+}
+
+func (x *notificationServiceStreamTicketEmailServer) SendAndClose(m *SendTicketEmailResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *notificationServiceStreamTicketEmailServer) Recv() (*TicketEmailChunk, error) {
+	m := new(TicketEmailChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _NotificationService_GenerateBadgePDF_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateBadgePDFRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GenerateBadgePDF(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notification.NotificationService/GenerateBadgePDF",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GenerateBadgePDF(ctx, req.(*GenerateBadgePDFRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_GenerateTicketPreviewPDF_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateTicketPreviewPDFRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GenerateTicketPreviewPDF(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notification.NotificationService/GenerateTicketPreviewPDF",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GenerateTicketPreviewPDF(ctx, req.(*GenerateTicketPreviewPDFRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_SendAnnouncementEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendAnnouncementEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SendAnnouncementEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notification.NotificationService/SendAnnouncementEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SendAnnouncementEmail(ctx, req.(*SendAnnouncementEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_SendSubscriptionDunningEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendSubscriptionDunningEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SendSubscriptionDunningEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notification.NotificationService/SendSubscriptionDunningEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SendSubscriptionDunningEmail(ctx, req.(*SendSubscriptionDunningEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_SendReservationReminderEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendReservationReminderEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SendReservationReminderEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notification.NotificationService/SendReservationReminderEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SendReservationReminderEmail(ctx, req.(*SendReservationReminderEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_SendInvitationEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendInvitationEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SendInvitationEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notification.NotificationService/SendInvitationEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SendInvitationEmail(ctx, req.(*SendInvitationEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_SendWaitlistOfferEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendWaitlistOfferEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SendWaitlistOfferEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notification.NotificationService/SendWaitlistOfferEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SendWaitlistOfferEmail(ctx, req.(*SendWaitlistOfferEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_SendEventModerationEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendEventModerationEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SendEventModerationEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notification.NotificationService/SendEventModerationEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SendEventModerationEmail(ctx, req.(*SendEventModerationEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_GetDigestPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDigestPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetDigestPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notification.NotificationService/GetDigestPreference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetDigestPreference(ctx, req.(*GetDigestPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_SetDigestPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDigestPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SetDigestPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notification.NotificationService/SetDigestPreference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SetDigestPreference(ctx, req.(*SetDigestPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_RegisterSenderDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterSenderDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).RegisterSenderDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notification.NotificationService/RegisterSenderDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).RegisterSenderDomain(ctx, req.(*RegisterSenderDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_VerifySenderDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySenderDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).VerifySenderDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notification.NotificationService/VerifySenderDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).VerifySenderDomain(ctx, req.(*VerifySenderDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -101,7 +586,61 @@ var NotificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendTicketEmail",
 			Handler:    _NotificationService_SendTicketEmail_Handler,
 		},
+		{
+			MethodName: "GenerateBadgePDF",
+			Handler:    _NotificationService_GenerateBadgePDF_Handler,
+		},
+		{
+			MethodName: "GenerateTicketPreviewPDF",
+			Handler:    _NotificationService_GenerateTicketPreviewPDF_Handler,
+		},
+		{
+			MethodName: "SendAnnouncementEmail",
+			Handler:    _NotificationService_SendAnnouncementEmail_Handler,
+		},
+		{
+			MethodName: "SendSubscriptionDunningEmail",
+			Handler:    _NotificationService_SendSubscriptionDunningEmail_Handler,
+		},
+		{
+			MethodName: "SendReservationReminderEmail",
+			Handler:    _NotificationService_SendReservationReminderEmail_Handler,
+		},
+		{
+			MethodName: "SendInvitationEmail",
+			Handler:    _NotificationService_SendInvitationEmail_Handler,
+		},
+		{
+			MethodName: "SendWaitlistOfferEmail",
+			Handler:    _NotificationService_SendWaitlistOfferEmail_Handler,
+		},
+		{
+			MethodName: "SendEventModerationEmail",
+			Handler:    _NotificationService_SendEventModerationEmail_Handler,
+		},
+		{
+			MethodName: "GetDigestPreference",
+			Handler:    _NotificationService_GetDigestPreference_Handler,
+		},
+		{
+			MethodName: "SetDigestPreference",
+			Handler:    _NotificationService_SetDigestPreference_Handler,
+		},
+		{
+			MethodName: "RegisterSenderDomain",
+			Handler:    _NotificationService_RegisterSenderDomain_Handler,
+		},
+		{
+			MethodName: "VerifySenderDomain",
+			Handler:    _NotificationService_VerifySenderDomain_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamTicketEmail",
+			Handler:       _NotificationService_StreamTicketEmail_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "notification/notification.proto",
 }
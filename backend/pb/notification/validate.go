@@ -0,0 +1,57 @@
+package notification
+
+import (
+	"errors"
+	"strings"
+)
+
+// Validate methods follow the protoc-gen-validate convention and are checked
+// by the shared gRPC interceptors (pkg/interceptor) on both client and server.
+
+// Validate checks a digest preference lookup
+func (r *GetDigestPreferenceRequest) Validate() error {
+	if strings.TrimSpace(r.GetEmail()) == "" {
+		return errors.New("email is required")
+	}
+	return nil
+}
+
+// Validate checks a digest preference change
+// The frequency itself is checked by the notification service, which owns the allowed values
+func (r *SetDigestPreferenceRequest) Validate() error {
+	if strings.TrimSpace(r.GetEmail()) == "" {
+		return errors.New("email is required")
+	}
+	if r.GetFrequency() == "" {
+		return errors.New("frequency is required")
+	}
+	return nil
+}
+
+// Validate checks a sending domain registration
+func (r *RegisterSenderDomainRequest) Validate() error {
+	domain := r.GetDomain()
+	if domain == "" || strings.ContainsAny(domain, " @/") || !strings.Contains(domain, ".") {
+		return errors.New("domain must be a hostname, e.g. mail.example.com")
+	}
+	return nil
+}
+
+// Validate checks a sending domain verification
+func (r *VerifySenderDomainRequest) Validate() error {
+	if r.GetDomainId() == "" {
+		return errors.New("domain_id is required")
+	}
+	return nil
+}
+
+// Validate checks a ticket template preview
+func (r *GenerateTicketPreviewPDFRequest) Validate() error {
+	if r.GetTemplate() == nil {
+		return errors.New("template is required")
+	}
+	if logo := r.GetTemplate().GetLogoUrl(); logo != "" && !strings.HasPrefix(logo, "https://") {
+		return errors.New("template logo_url must be an https URL")
+	}
+	return nil
+}
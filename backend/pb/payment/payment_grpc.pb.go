@@ -26,6 +26,10 @@ type PaymentServiceClient interface {
 	CreateInvoice(ctx context.Context, in *CreateInvoiceRequest, opts ...grpc.CallOption) (*CreateInvoiceResponse, error)
 	// GetPaymentStatus retrieves payment status by order ID
 	GetPaymentStatus(ctx context.Context, in *GetPaymentStatusRequest, opts ...grpc.CallOption) (*GetPaymentStatusResponse, error)
+	// ExpireInvoice expires the open invoices of a cancelled order so they can no longer be paid
+	ExpireInvoice(ctx context.Context, in *ExpireInvoiceRequest, opts ...grpc.CallOption) (*ExpireInvoiceResponse, error)
+	// RecordTicketEmail records that the tickets of a paid order were emailed, the last step of its payment saga
+	RecordTicketEmail(ctx context.Context, in *RecordTicketEmailRequest, opts ...grpc.CallOption) (*RecordTicketEmailResponse, error)
 }
 
 type paymentServiceClient struct {
@@ -54,6 +58,24 @@ func (c *paymentServiceClient) GetPaymentStatus(ctx context.Context, in *GetPaym
 	return out, nil
 }
 
+func (c *paymentServiceClient) ExpireInvoice(ctx context.Context, in *ExpireInvoiceRequest, opts ...grpc.CallOption) (*ExpireInvoiceResponse, error) {
+	out := new(ExpireInvoiceResponse)
+	err := c.cc.Invoke(ctx, "/payment.PaymentService/ExpireInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) RecordTicketEmail(ctx context.Context, in *RecordTicketEmailRequest, opts ...grpc.CallOption) (*RecordTicketEmailResponse, error) {
+	out := new(RecordTicketEmailResponse)
+	err := c.cc.Invoke(ctx, "/payment.PaymentService/RecordTicketEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility
@@ -62,6 +84,10 @@ type PaymentServiceServer interface {
 	CreateInvoice(context.Context, *CreateInvoiceRequest) (*CreateInvoiceResponse, error)
 	// GetPaymentStatus retrieves payment status by order ID
 	GetPaymentStatus(context.Context, *GetPaymentStatusRequest) (*GetPaymentStatusResponse, error)
+	// ExpireInvoice expires the open invoices of a cancelled order so they can no longer be paid
+	ExpireInvoice(context.Context, *ExpireInvoiceRequest) (*ExpireInvoiceResponse, error)
+	// RecordTicketEmail records that the tickets of a paid order were emailed, the last step of its payment saga
+	RecordTicketEmail(context.Context, *RecordTicketEmailRequest) (*RecordTicketEmailResponse, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -75,6 +101,12 @@ func (UnimplementedPaymentServiceServer) CreateInvoice(context.Context, *CreateI
 func (UnimplementedPaymentServiceServer) GetPaymentStatus(context.Context, *GetPaymentStatusRequest) (*GetPaymentStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentStatus not implemented")
 }
+func (UnimplementedPaymentServiceServer) ExpireInvoice(context.Context, *ExpireInvoiceRequest) (*ExpireInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpireInvoice not implemented")
+}
+func (UnimplementedPaymentServiceServer) RecordTicketEmail(context.Context, *RecordTicketEmailRequest) (*RecordTicketEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordTicketEmail not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 
 // UnsafePaymentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -124,6 +156,42 @@ func _PaymentService_GetPaymentStatus_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_ExpireInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ExpireInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/payment.PaymentService/ExpireInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ExpireInvoice(ctx, req.(*ExpireInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_RecordTicketEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordTicketEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RecordTicketEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/payment.PaymentService/RecordTicketEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RecordTicketEmail(ctx, req.(*RecordTicketEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -139,6 +207,14 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPaymentStatus",
 			Handler:    _PaymentService_GetPaymentStatus_Handler,
 		},
+		{
+			MethodName: "ExpireInvoice",
+			Handler:    _PaymentService_ExpireInvoice_Handler,
+		},
+		{
+			MethodName: "RecordTicketEmail",
+			Handler:    _PaymentService_RecordTicketEmail_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "payment/payment.proto",
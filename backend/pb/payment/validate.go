@@ -0,0 +1,59 @@
+package payment
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// Validate methods follow the protoc-gen-validate convention and are checked
+// by the shared gRPC interceptors (pkg/interceptor) on both client and server.
+
+// Validate checks an invoice request
+func (r *CreateInvoiceRequest) Validate() error {
+	if _, err := uuid.Parse(r.GetOrderId()); err != nil {
+		return errors.New("order_id must be a valid UUID")
+	}
+	if _, err := uuid.Parse(r.GetUserId()); err != nil {
+		return errors.New("user_id must be a valid UUID")
+	}
+	if r.GetEmail() == "" {
+		return errors.New("email is required")
+	}
+	if r.GetAmount() < 0 {
+		return errors.New("amount must not be negative")
+	}
+	for _, item := range r.GetItems() {
+		if item.GetQuantity() <= 0 {
+			return errors.New("items quantity must be positive")
+		}
+	}
+	return nil
+}
+
+// Validate checks a payment status request
+func (r *GetPaymentStatusRequest) Validate() error {
+	if _, err := uuid.Parse(r.GetOrderId()); err != nil {
+		return errors.New("order_id must be a valid UUID")
+	}
+	return nil
+}
+
+// Validate checks an invoice expiration request
+func (r *ExpireInvoiceRequest) Validate() error {
+	if _, err := uuid.Parse(r.GetOrderId()); err != nil {
+		return errors.New("order_id must be a valid UUID")
+	}
+	return nil
+}
+
+// Validate checks a ticket email report
+func (r *RecordTicketEmailRequest) Validate() error {
+	if _, err := uuid.Parse(r.GetOrderId()); err != nil {
+		return errors.New("order_id must be a valid UUID")
+	}
+	if r.GetPaymentId() == "" {
+		return errors.New("payment_id is required")
+	}
+	return nil
+}
@@ -24,6 +24,12 @@ const _ = grpc.SupportPackageIsVersion7
 type TicketingServiceClient interface {
 	// ConfirmPayment confirms payment and generates tickets
 	ConfirmPayment(ctx context.Context, in *ConfirmPaymentRequest, opts ...grpc.CallOption) (*ConfirmPaymentResponse, error)
+	// RefundOrder marks a paid order refunded, voids its tickets and returns its seats
+	RefundOrder(ctx context.Context, in *RefundOrderRequest, opts ...grpc.CallOption) (*RefundOrderResponse, error)
+	// CheckRefundEligibility checks, without changing anything, that the buyer may refund the order now
+	CheckRefundEligibility(ctx context.Context, in *CheckRefundEligibilityRequest, opts ...grpc.CallOption) (*CheckRefundEligibilityResponse, error)
+	// RecordPaymentRefund marks the refund request of a flagged payment refunded once payment service refunded it
+	RecordPaymentRefund(ctx context.Context, in *RecordPaymentRefundRequest, opts ...grpc.CallOption) (*RecordPaymentRefundResponse, error)
 }
 
 type ticketingServiceClient struct {
@@ -43,12 +49,45 @@ func (c *ticketingServiceClient) ConfirmPayment(ctx context.Context, in *Confirm
 	return out, nil
 }
 
+func (c *ticketingServiceClient) RefundOrder(ctx context.Context, in *RefundOrderRequest, opts ...grpc.CallOption) (*RefundOrderResponse, error) {
+	out := new(RefundOrderResponse)
+	err := c.cc.Invoke(ctx, "/ticketing.TicketingService/RefundOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketingServiceClient) CheckRefundEligibility(ctx context.Context, in *CheckRefundEligibilityRequest, opts ...grpc.CallOption) (*CheckRefundEligibilityResponse, error) {
+	out := new(CheckRefundEligibilityResponse)
+	err := c.cc.Invoke(ctx, "/ticketing.TicketingService/CheckRefundEligibility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketingServiceClient) RecordPaymentRefund(ctx context.Context, in *RecordPaymentRefundRequest, opts ...grpc.CallOption) (*RecordPaymentRefundResponse, error) {
+	out := new(RecordPaymentRefundResponse)
+	err := c.cc.Invoke(ctx, "/ticketing.TicketingService/RecordPaymentRefund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TicketingServiceServer is the server API for TicketingService service.
 // All implementations must embed UnimplementedTicketingServiceServer
 // for forward compatibility
 type TicketingServiceServer interface {
 	// ConfirmPayment confirms payment and generates tickets
 	ConfirmPayment(context.Context, *ConfirmPaymentRequest) (*ConfirmPaymentResponse, error)
+	// RefundOrder marks a paid order refunded, voids its tickets and returns its seats
+	RefundOrder(context.Context, *RefundOrderRequest) (*RefundOrderResponse, error)
+	// CheckRefundEligibility checks, without changing anything, that the buyer may refund the order now
+	CheckRefundEligibility(context.Context, *CheckRefundEligibilityRequest) (*CheckRefundEligibilityResponse, error)
+	// RecordPaymentRefund marks the refund request of a flagged payment refunded once payment service refunded it
+	RecordPaymentRefund(context.Context, *RecordPaymentRefundRequest) (*RecordPaymentRefundResponse, error)
 	mustEmbedUnimplementedTicketingServiceServer()
 }
 
@@ -59,6 +98,15 @@ type UnimplementedTicketingServiceServer struct {
 func (UnimplementedTicketingServiceServer) ConfirmPayment(context.Context, *ConfirmPaymentRequest) (*ConfirmPaymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPayment not implemented")
 }
+func (UnimplementedTicketingServiceServer) RefundOrder(context.Context, *RefundOrderRequest) (*RefundOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundOrder not implemented")
+}
+func (UnimplementedTicketingServiceServer) CheckRefundEligibility(context.Context, *CheckRefundEligibilityRequest) (*CheckRefundEligibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckRefundEligibility not implemented")
+}
+func (UnimplementedTicketingServiceServer) RecordPaymentRefund(context.Context, *RecordPaymentRefundRequest) (*RecordPaymentRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordPaymentRefund not implemented")
+}
 func (UnimplementedTicketingServiceServer) mustEmbedUnimplementedTicketingServiceServer() {}
 
 // UnsafeTicketingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -90,6 +138,60 @@ func _TicketingService_ConfirmPayment_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TicketingService_RefundOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketingServiceServer).RefundOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticketing.TicketingService/RefundOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketingServiceServer).RefundOrder(ctx, req.(*RefundOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketingService_CheckRefundEligibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRefundEligibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketingServiceServer).CheckRefundEligibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticketing.TicketingService/CheckRefundEligibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketingServiceServer).CheckRefundEligibility(ctx, req.(*CheckRefundEligibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketingService_RecordPaymentRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordPaymentRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketingServiceServer).RecordPaymentRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticketing.TicketingService/RecordPaymentRefund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketingServiceServer).RecordPaymentRefund(ctx, req.(*RecordPaymentRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TicketingService_ServiceDesc is the grpc.ServiceDesc for TicketingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -101,6 +203,18 @@ var TicketingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConfirmPayment",
 			Handler:    _TicketingService_ConfirmPayment_Handler,
 		},
+		{
+			MethodName: "RefundOrder",
+			Handler:    _TicketingService_RefundOrder_Handler,
+		},
+		{
+			MethodName: "CheckRefundEligibility",
+			Handler:    _TicketingService_CheckRefundEligibility_Handler,
+		},
+		{
+			MethodName: "RecordPaymentRefund",
+			Handler:    _TicketingService_RecordPaymentRefund_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ticketing/ticketing.proto",
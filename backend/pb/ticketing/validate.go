@@ -0,0 +1,60 @@
+package ticketing
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// Validate methods follow the protoc-gen-validate convention and are checked
+// by the shared gRPC interceptors (pkg/interceptor) on both client and server.
+
+// Validate checks a payment confirmation
+func (r *ConfirmPaymentRequest) Validate() error {
+	if _, err := uuid.Parse(r.GetOrderId()); err != nil {
+		return errors.New("order_id must be a valid UUID")
+	}
+	if r.GetPaymentId() == "" {
+		return errors.New("payment_id is required")
+	}
+	if r.GetAmount() < 0 {
+		return errors.New("amount must not be negative")
+	}
+	return nil
+}
+
+// Validate checks an order refund
+func (r *RefundOrderRequest) Validate() error {
+	if _, err := uuid.Parse(r.GetOrderId()); err != nil {
+		return errors.New("order_id must be a valid UUID")
+	}
+	if r.GetUserId() == "" {
+		return errors.New("user_id is required")
+	}
+	if r.GetRefundId() == "" {
+		return errors.New("refund_id is required")
+	}
+	return nil
+}
+
+// Validate checks an order refund eligibility check
+func (r *CheckRefundEligibilityRequest) Validate() error {
+	if _, err := uuid.Parse(r.GetOrderId()); err != nil {
+		return errors.New("order_id must be a valid UUID")
+	}
+	if r.GetUserId() == "" {
+		return errors.New("user_id is required")
+	}
+	return nil
+}
+
+// Validate checks a recorded payment refund
+func (r *RecordPaymentRefundRequest) Validate() error {
+	if _, err := uuid.Parse(r.GetOrderId()); err != nil {
+		return errors.New("order_id must be a valid UUID")
+	}
+	if r.GetPaymentId() == "" {
+		return errors.New("payment_id is required")
+	}
+	return nil
+}
@@ -0,0 +1,335 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func accessClaims(userID string) *Claims {
+	return &Claims{
+		Role:      "customer",
+		TokenType: TokenTypeAccess,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+}
+
+func TestKeySet_Rotation(t *testing.T) {
+	oldKey := NewHMACKey("2025-01", []byte("old-secret"))
+	newKey := NewHMACKey("2025-06", []byte("new-secret"))
+
+	before, err := NewKeySet("2025-01", oldKey)
+	require.NoError(t, err)
+	oldToken, err := before.Sign(accessClaims("user-1"))
+	require.NoError(t, err)
+
+	after, err := NewKeySet("2025-06", oldKey, newKey)
+	require.NoError(t, err)
+	newToken, err := after.Sign(accessClaims("user-2"))
+	require.NoError(t, err)
+
+	claims, err := after.Parse(oldToken)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", claims.UserID())
+
+	claims, err = after.Parse(newToken)
+	require.NoError(t, err)
+	assert.Equal(t, "user-2", claims.UserID())
+
+	// Once the old key is retired its tokens are rejected
+	retired, err := NewKeySet("2025-06", newKey)
+	require.NoError(t, err)
+	_, err = retired.Parse(oldToken)
+	assert.ErrorIs(t, err, ErrUnknownKey)
+}
+
+func TestKeySet_LegacyToken(t *testing.T) {
+	// Tokens issued before pkg/auth: no kid header and user_id instead of sub
+	legacy := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id": "user-1",
+		"role":    "organizer",
+		"exp":     time.Now().Add(time.Hour).Unix(),
+	})
+	tokenString, err := legacy.SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	claims, err := NewHMACKeySet("secret").Parse(tokenString)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", claims.UserID())
+	assert.Equal(t, "organizer", claims.Role)
+}
+
+func TestKeySet_RejectsInvalidTokens(t *testing.T) {
+	keys := NewHMACKeySet("secret")
+
+	expired := accessClaims("user-1")
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	expiredToken, err := keys.Sign(expired)
+	require.NoError(t, err)
+	_, err = keys.Parse(expiredToken)
+	assert.Error(t, err)
+
+	otherToken, err := NewHMACKeySet("other-secret").Sign(accessClaims("user-1"))
+	require.NoError(t, err)
+	_, err = keys.Parse(otherToken)
+	assert.Error(t, err)
+
+	noSubject, err := keys.Sign(accessClaims(""))
+	require.NoError(t, err)
+	_, err = keys.Parse(noSubject)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestKeySet_RS256(t *testing.T) {
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	issuer, err := NewKeySet("rsa-1", NewRSAKey("rsa-1", private))
+	require.NoError(t, err)
+	tokenString, err := issuer.Sign(accessClaims("user-1"))
+	require.NoError(t, err)
+
+	// Validating services only need the public key and cannot sign
+	verifier, err := NewKeySet("", NewRSAPublicKey("rsa-1", &private.PublicKey))
+	require.NoError(t, err)
+	claims, err := verifier.Parse(tokenString)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", claims.UserID())
+
+	_, err = verifier.Sign(accessClaims("user-1"))
+	assert.ErrorIs(t, err, ErrNoSigningKey)
+
+	_, err = NewKeySet("rsa-1", NewRSAPublicKey("rsa-1", &private.PublicKey))
+	assert.Error(t, err)
+}
+
+func TestKeySet_RejectsAlgorithmMismatch(t *testing.T) {
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	publicDER, err := x509.MarshalPKIXPublicKey(&private.PublicKey)
+	require.NoError(t, err)
+
+	verifier, err := NewKeySet("", NewRSAPublicKey("rsa-1", &private.PublicKey))
+	require.NoError(t, err)
+
+	// HS256 token signed with the public key bytes must not verify against the RSA key
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims("attacker"))
+	forged.Header["kid"] = "rsa-1"
+	tokenString, err := forged.SignedString(publicDER)
+	require.NoError(t, err)
+
+	_, err = verifier.Parse(tokenString)
+	assert.Error(t, err)
+}
+
+func TestClaims_Scopes(t *testing.T) {
+	claims := &Claims{Scope: "tickets:read  tickets:validate"}
+	assert.Equal(t, []string{"tickets:read", "tickets:validate"}, claims.Scopes())
+	assert.True(t, claims.HasScope("tickets:validate"))
+	assert.False(t, claims.HasScope("tickets"))
+}
+
+func TestKeySetFromEnv(t *testing.T) {
+	t.Run("secret only", func(t *testing.T) {
+		keys, err := KeySetFromEnv("secret")
+		require.NoError(t, err)
+
+		tokenString, err := keys.Sign(accessClaims("user-1"))
+		require.NoError(t, err)
+		_, err = NewHMACKeySet("secret").Parse(tokenString)
+		assert.NoError(t, err)
+	})
+
+	t.Run("rotation keys", func(t *testing.T) {
+		t.Setenv("JWT_KEYS", "k2:second-secret, k3:third-secret")
+		t.Setenv("JWT_SIGNING_KEY_ID", "k3")
+		keys, err := KeySetFromEnv("secret")
+		require.NoError(t, err)
+
+		tokenString, err := keys.Sign(accessClaims("user-1"))
+		require.NoError(t, err)
+		k3, err := NewKeySet("k3", NewHMACKey("k3", []byte("third-secret")))
+		require.NoError(t, err)
+		_, err = k3.Parse(tokenString)
+		assert.NoError(t, err)
+
+		legacyToken, err := NewHMACKeySet("secret").Sign(accessClaims("user-1"))
+		require.NoError(t, err)
+		_, err = keys.Parse(legacyToken)
+		assert.NoError(t, err)
+	})
+
+	t.Run("malformed keys", func(t *testing.T) {
+		t.Setenv("JWT_KEYS", "missing-secret")
+		_, err := KeySetFromEnv("secret")
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown signing key", func(t *testing.T) {
+		t.Setenv("JWT_SIGNING_KEY_ID", "nope")
+		_, err := KeySetFromEnv("secret")
+		assert.ErrorIs(t, err, ErrUnknownKey)
+	})
+
+	t.Run("rsa key files", func(t *testing.T) {
+		private, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		publicDER, err := x509.MarshalPKIXPublicKey(&private.PublicKey)
+		require.NoError(t, err)
+
+		dir := t.TempDir()
+		privatePath := filepath.Join(dir, "jwt.key")
+		publicPath := filepath.Join(dir, "jwt.pub")
+		require.NoError(t, os.WriteFile(privatePath, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(private)}), 0o600))
+		require.NoError(t, os.WriteFile(publicPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}), 0o600))
+
+		t.Setenv("JWT_RSA_PRIVATE_KEY_FILE", privatePath)
+		issuer, err := KeySetFromEnv("secret")
+		require.NoError(t, err)
+		tokenString, err := issuer.Sign(accessClaims("user-1"))
+		require.NoError(t, err)
+
+		t.Setenv("JWT_RSA_PRIVATE_KEY_FILE", "")
+		t.Setenv("JWT_RSA_PUBLIC_KEY_FILE", publicPath)
+		verifier, err := KeySetFromEnv("")
+		require.NoError(t, err)
+		claims, err := verifier.Parse(tokenString)
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", claims.UserID())
+	})
+}
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	keys := NewHMACKeySet("secret")
+
+	access, err := keys.Sign(accessClaims("user-1"))
+	require.NoError(t, err)
+	refreshClaims := accessClaims("user-1")
+	refreshClaims.TokenType = TokenTypeRefresh
+	refresh, err := keys.Sign(refreshClaims)
+	require.NoError(t, err)
+
+	router := gin.New()
+	handler := func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString(ContextUserID))
+	}
+	router.GET("/required", Middleware(keys), handler)
+	router.GET("/optional", OptionalMiddleware(keys), handler)
+
+	tests := []struct {
+		name   string
+		path   string
+		header string
+		status int
+		body   string
+	}{
+		{"valid token", "/required", "Bearer " + access, http.StatusOK, "user-1"},
+		{"missing header", "/required", "", http.StatusUnauthorized, ""},
+		{"malformed header", "/required", access, http.StatusUnauthorized, ""},
+		{"refresh token", "/required", "Bearer " + refresh, http.StatusUnauthorized, ""},
+		{"optional without token", "/optional", "", http.StatusOK, ""},
+		{"optional with token", "/optional", "Bearer " + access, http.StatusOK, "user-1"},
+		{"optional ignores bad token", "/optional", "Bearer garbage", http.StatusOK, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.status, w.Code)
+			if tt.status == http.StatusOK {
+				assert.Equal(t, tt.body, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestRequireScope(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	keys := NewHMACKeySet("secret")
+
+	router := gin.New()
+	router.GET("/scan", Middleware(keys), RequireScope("tickets:validate"), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	scoped := accessClaims("gate-1")
+	scoped.Scope = "tickets:validate"
+	scopedToken, err := keys.Sign(scoped)
+	require.NoError(t, err)
+	plainToken, err := keys.Sign(accessClaims("user-1"))
+	require.NoError(t, err)
+
+	for token, status := range map[string]int{scopedToken: http.StatusOK, plainToken: http.StatusForbidden} {
+		req := httptest.NewRequest(http.MethodGet, "/scan", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, status, w.Code)
+	}
+}
+
+func TestMiddleware_TenantBinding(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	keys := NewHMACKeySet("secret")
+	const brandTenant = "6f1c2a4e-8d0b-4c55-9a77-3e2f1b0c9d11"
+
+	router := gin.New()
+	router.GET("/me", Middleware(keys), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	brandClaims := accessClaims("user-1")
+	brandClaims.TenantID = brandTenant
+	brandToken, err := keys.Sign(brandClaims)
+	require.NoError(t, err)
+	legacyToken, err := keys.Sign(accessClaims("user-2"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		token  string
+		tenant string
+		status int
+	}{
+		{"brand token on brand tenant", brandToken, brandTenant, http.StatusOK},
+		{"brand token on default tenant", brandToken, "", http.StatusUnauthorized},
+		{"legacy token on default tenant", legacyToken, "", http.StatusOK},
+		{"legacy token on brand tenant", legacyToken, brandTenant, http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/me", nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			if tt.tenant != "" {
+				req.Header.Set(tenant.Header, tt.tenant)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
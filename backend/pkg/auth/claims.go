@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
+)
+
+// Token type constants
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+	TokenTypeService = "service" // Issued by a service to call another (pkg/serviceauth)
+)
+
+// Claims is the standardized JWT claims set shared by all services
+// The user ID is the registered "sub" claim; the signing key is named by the "kid" header.
+type Claims struct {
+	Role      string `json:"role"`
+	Scope     string `json:"scope,omitempty"` // Space-separated scopes, e.g. for service tokens
+	Email     string `json:"email,omitempty"`
+	Name      string `json:"name,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	TenantID  string `json:"tid,omitempty"` // Tenant the user belongs to
+
+	// LegacyUserID is read from tokens issued before "sub" was used
+	LegacyUserID string `json:"user_id,omitempty"`
+
+	jwt.RegisteredClaims
+}
+
+// UserID returns the subject, falling back to the legacy user_id claim
+func (c *Claims) UserID() string {
+	if c.Subject != "" {
+		return c.Subject
+	}
+	return c.LegacyUserID
+}
+
+// Tenant returns the tenant claim, treating tokens issued before tenants as the default tenant
+func (c *Claims) Tenant() string {
+	if c.TenantID != "" {
+		return c.TenantID
+	}
+	return tenant.DefaultID
+}
+
+// Scopes returns the scope claim as a list
+func (c *Claims) Scopes() []string {
+	return strings.Fields(c.Scope)
+}
+
+// HasScope checks if the token was granted scope
+func (c *Claims) HasScope(scope string) bool {
+	return slices.Contains(c.Scopes(), scope)
+}
+
+// IsRefresh checks if the token may only be used to obtain new access tokens
+func (c *Claims) IsRefresh() bool {
+	return c.TokenType == TokenTypeRefresh
+}
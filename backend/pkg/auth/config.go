@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// KeySetFromEnv builds the key set from the environment
+// secret is the service's JWT_SECRET (with its service default) and becomes the
+// HS256 key DefaultKeyID when not empty.
+//
+// Environment variables:
+//   - JWT_KEYS: extra HS256 keys for rotation, as kid:secret pairs separated by commas
+//   - JWT_RSA_KEY_ID: kid of the RS256 key (default rsa)
+//   - JWT_RSA_PRIVATE_KEY_FILE: PEM private key; only needed by the token issuer
+//   - JWT_RSA_PUBLIC_KEY_FILE: PEM public key for services that only validate
+//   - JWT_SIGNING_KEY_ID: kid that signs new tokens (default: the RSA key when its
+//     private key is set, otherwise DefaultKeyID)
+func KeySetFromEnv(secret string) (*KeySet, error) {
+	var keys []*Key
+	if secret != "" {
+		keys = append(keys, NewHMACKey(DefaultKeyID, []byte(secret)))
+	}
+
+	if raw := os.Getenv("JWT_KEYS"); raw != "" {
+		for _, pair := range strings.Split(raw, ",") {
+			kid, keySecret, ok := strings.Cut(strings.TrimSpace(pair), ":")
+			if !ok || kid == "" || keySecret == "" {
+				return nil, fmt.Errorf("invalid JWT_KEYS entry %q (expected kid:secret)", pair)
+			}
+			keys = append(keys, NewHMACKey(kid, []byte(keySecret)))
+		}
+	}
+
+	signingKeyID := os.Getenv("JWT_SIGNING_KEY_ID")
+
+	rsaKeyID := getEnv("JWT_RSA_KEY_ID", "rsa")
+	if path := os.Getenv("JWT_RSA_PRIVATE_KEY_FILE"); path != "" {
+		private, err := LoadRSAPrivateKey(path)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, NewRSAKey(rsaKeyID, private))
+		if signingKeyID == "" {
+			signingKeyID = rsaKeyID
+		}
+	} else if path := os.Getenv("JWT_RSA_PUBLIC_KEY_FILE"); path != "" {
+		public, err := LoadRSAPublicKey(path)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, NewRSAPublicKey(rsaKeyID, public))
+	}
+
+	if signingKeyID == "" && secret != "" {
+		signingKeyID = DefaultKeyID
+	}
+
+	return NewKeySet(signingKeyID, keys...)
+}
+
+// LoadRSAPrivateKey reads a PKCS#1 or PKCS#8 PEM private key
+func LoadRSAPrivateKey(path string) (*rsa.PrivateKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse RSA private key %s: %w", path, err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an RSA private key", path)
+	}
+	return key, nil
+}
+
+// LoadRSAPublicKey reads a PKIX or PKCS#1 PEM public key
+func LoadRSAPublicKey(path string) (*rsa.PublicKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse RSA public key %s: %w", path, err)
+	}
+	key, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an RSA public key", path)
+	}
+	return key, nil
+}
+
+// readPEM reads the first PEM block of a file
+func readPEM(path string) (*pem.Block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWT key file: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data in JWT key file " + path)
+	}
+	return block, nil
+}
+
+// getEnv gets environment variable with default value
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,162 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"errors"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// DefaultKeyID names the key built from JWT_SECRET
+// Tokens without a "kid" header, issued before key rotation, are verified with it
+const DefaultKeyID = "default"
+
+var (
+	ErrNoSigningKey = errors.New("no JWT signing key configured")
+	ErrUnknownKey   = errors.New("unknown JWT key id")
+	ErrInvalidToken = errors.New("invalid token")
+)
+
+// Key is a JWT key identified by its kid
+// HMAC keys sign and verify; RSA keys sign only when the private key is known
+type Key struct {
+	ID      string
+	method  jwt.SigningMethod
+	secret  []byte
+	private *rsa.PrivateKey
+	public  *rsa.PublicKey
+}
+
+// NewHMACKey creates an HS256 key
+func NewHMACKey(id string, secret []byte) *Key {
+	return &Key{ID: id, method: jwt.SigningMethodHS256, secret: secret}
+}
+
+// NewRSAKey creates an RS256 key that can sign and verify
+func NewRSAKey(id string, private *rsa.PrivateKey) *Key {
+	return &Key{ID: id, method: jwt.SigningMethodRS256, private: private, public: &private.PublicKey}
+}
+
+// NewRSAPublicKey creates an RS256 key that can only verify
+// Used by services that validate tokens without being able to issue them
+func NewRSAPublicKey(id string, public *rsa.PublicKey) *Key {
+	return &Key{ID: id, method: jwt.SigningMethodRS256, public: public}
+}
+
+// canSign checks if the key holds signing material
+func (k *Key) canSign() bool {
+	return k.secret != nil || k.private != nil
+}
+
+// signingKey returns the material used to sign tokens
+func (k *Key) signingKey() interface{} {
+	if k.private != nil {
+		return k.private
+	}
+	return k.secret
+}
+
+// verificationKey returns the material used to verify tokens
+func (k *Key) verificationKey() interface{} {
+	if k.public != nil {
+		return k.public
+	}
+	return k.secret
+}
+
+// KeySet holds the active JWT keys
+// One key signs new tokens; every key in the set is accepted for validation,
+// so keys can be rotated by adding the new key, switching signing to it and
+// removing the old key once its tokens have expired.
+type KeySet struct {
+	signing *Key
+	keys    map[string]*Key
+}
+
+// NewKeySet creates a key set that signs with the key named signingKeyID
+// signingKeyID may be empty for services that only validate tokens
+func NewKeySet(signingKeyID string, keys ...*Key) (*KeySet, error) {
+	ks := &KeySet{keys: make(map[string]*Key, len(keys))}
+	for _, key := range keys {
+		if _, exists := ks.keys[key.ID]; exists {
+			return nil, fmt.Errorf("duplicate JWT key id: %s", key.ID)
+		}
+		ks.keys[key.ID] = key
+	}
+
+	if signingKeyID != "" {
+		key, ok := ks.keys[signingKeyID]
+		if !ok {
+			return nil, fmt.Errorf("%w: signing key %s", ErrUnknownKey, signingKeyID)
+		}
+		if !key.canSign() {
+			return nil, fmt.Errorf("JWT key %s has no private key to sign with", signingKeyID)
+		}
+		ks.signing = key
+	}
+
+	return ks, nil
+}
+
+// NewHMACKeySet creates a key set with a single HS256 key under DefaultKeyID
+func NewHMACKeySet(secret string) *KeySet {
+	key := NewHMACKey(DefaultKeyID, []byte(secret))
+	return &KeySet{signing: key, keys: map[string]*Key{key.ID: key}}
+}
+
+// Len returns the number of keys accepted for validation
+func (ks *KeySet) Len() int {
+	return len(ks.keys)
+}
+
+// Sign issues a token for claims with the signing key
+func (ks *KeySet) Sign(claims *Claims) (string, error) {
+	if ks.signing == nil {
+		return "", ErrNoSigningKey
+	}
+
+	token := jwt.NewWithClaims(ks.signing.method, claims)
+	token.Header["kid"] = ks.signing.ID
+	return token.SignedString(ks.signing.signingKey())
+}
+
+// Parse validates a token and returns its claims
+// The key is chosen by the "kid" header and must match the token algorithm,
+// so an RSA public key can never be used as an HMAC secret.
+func (ks *KeySet) Parse(tokenString string) (*Claims, error) {
+	claims, _, err := ks.ParseWithKeyID(tokenString)
+	return claims, err
+}
+
+// ParseWithKeyID validates a token like Parse and also returns the kid of the key that verified it
+// Used when the key identifies the issuer, e.g. service tokens signed with per-service keys
+func (ks *KeySet) ParseWithKeyID(tokenString string) (*Claims, string, error) {
+	claims := &Claims{}
+	var keyID string
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			kid = DefaultKeyID
+		}
+
+		key, ok := ks.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownKey, kid)
+		}
+		if token.Method.Alg() != key.method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		keyID = kid
+		return key.verificationKey(), nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !token.Valid || claims.UserID() == "" {
+		return nil, "", ErrInvalidToken
+	}
+
+	return claims, keyID, nil
+}
@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
+)
+
+// Context keys set by the middleware
+const (
+	ContextUserID = "user_id"
+	ContextEmail  = "email"
+	ContextName   = "name"
+	ContextRole   = "role"
+	ContextScopes = "scopes"
+	ContextClaims = "claims"
+)
+
+// Middleware requires a valid access token in the Authorization header
+func Middleware(keys *KeySet) gin.HandlerFunc {
+	return CachedMiddleware(keys, nil)
+}
+
+// CachedMiddleware is Middleware with validated tokens served from tokens
+// tokens may be nil, in which case every request validates its token
+func CachedMiddleware(keys *KeySet, tokens *TokenCache) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if authHeader == "" {
+			c.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode("Authorization header required", sharedresponse.CodeUnauthorized, nil))
+			c.Abort()
+			return
+		}
+
+		tokenString, ok := bearerToken(authHeader)
+		if !ok {
+			c.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode("Invalid authorization header format (expected: Bearer <token>)", sharedresponse.CodeUnauthorized, nil))
+			c.Abort()
+			return
+		}
+
+		var claims *Claims
+		var err error
+		if tokens != nil {
+			claims, err = tokens.parse(c.Request.Context(), keys, tokenString)
+		} else {
+			claims, err = parseAccessToken(keys, tokenString)
+		}
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode("Invalid or expired token", sharedresponse.CodeUnauthorized, nil))
+			c.Abort()
+			return
+		}
+
+		// Users only exist within their tenant, so a token is never valid on another tenant's domain
+		if claims.Tenant() != tenant.Requested(c.Request) {
+			c.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode("Token is not valid for this tenant", sharedresponse.CodeTenantMismatch, nil))
+			c.Abort()
+			return
+		}
+
+		setContext(c, claims)
+		c.Next()
+	}
+}
+
+// OptionalMiddleware sets the user context when a valid token is present, but doesn't require it
+func OptionalMiddleware(keys *KeySet) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if tokenString, ok := bearerToken(c.GetHeader("Authorization")); ok {
+			if claims, err := parseAccessToken(keys, tokenString); err == nil && claims.Tenant() == tenant.Requested(c.Request) {
+				setContext(c, claims)
+			}
+		}
+
+		c.Next()
+	}
+}
+
+// RequireScope checks that the token was granted all scopes
+// Must run after Middleware
+func RequireScope(scopes ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, ok := ClaimsFromContext(c)
+		if !ok {
+			c.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode("Unauthorized", sharedresponse.CodeUnauthorized, nil))
+			c.Abort()
+			return
+		}
+
+		for _, scope := range scopes {
+			if !claims.HasScope(scope) {
+				c.JSON(http.StatusForbidden, sharedresponse.ErrorWithCode(fmt.Sprintf("Access denied: requires scope %s", scope), sharedresponse.CodeForbidden, nil))
+				c.Abort()
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
+
+// ClaimsFromContext returns the claims set by the middleware
+func ClaimsFromContext(c *gin.Context) (*Claims, bool) {
+	value, exists := c.Get(ContextClaims)
+	if !exists {
+		return nil, false
+	}
+	claims, ok := value.(*Claims)
+	return claims, ok
+}
+
+// bearerToken extracts the token from "Bearer <token>"
+func bearerToken(header string) (string, bool) {
+	parts := strings.Split(header, " ")
+	if len(parts) != 2 || parts[0] != "Bearer" || parts[1] == "" {
+		return "", false
+	}
+	return parts[1], true
+}
+
+// parseAccessToken validates a token and rejects refresh tokens
+func parseAccessToken(keys *KeySet, tokenString string) (*Claims, error) {
+	claims, err := keys.Parse(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.IsRefresh() {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// setContext exposes the claims to downstream handlers
+func setContext(c *gin.Context, claims *Claims) {
+	c.Set(ContextClaims, claims)
+	c.Set(ContextUserID, claims.UserID())
+	c.Set(ContextEmail, claims.Email)
+	c.Set(ContextName, claims.Name)
+	c.Set(ContextRole, claims.Role)
+	c.Set(ContextScopes, claims.Scopes())
+}
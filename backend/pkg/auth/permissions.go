@@ -0,0 +1,49 @@
+package auth
+
+import "slices"
+
+// Permissions carried in the scope claim
+const (
+	PermEventsWrite   = "events:write"   // Create and manage events, ticket tiers and zones
+	PermOrdersRefund  = "orders:refund"  // Void tickets and refund orders
+	PermCheckinScan   = "checkin:scan"   // Validate tickets at the gate
+	PermRolesManage   = "roles:manage"   // Manage role permission mappings
+	PermSupportManage = "support:manage" // Respond to and resolve buyer order issues
+	PermPlansManage   = "plans:manage"   // Manage organizer plan limits and assignments
+	PermConfigManage  = "config:manage"  // View and reload hot-reloadable service settings
+	PermReportsManage = "reports:manage" // Review abuse reports and moderate reported events
+	PermOrgsVerify    = "orgs:verify"    // Review organizer verification documents
+)
+
+// Roles
+const (
+	RoleCustomer  = "customer"
+	RoleOrganizer = "organizer"
+	RoleAdmin     = "admin"
+)
+
+// AllPermissions lists every known permission
+var AllPermissions = []string{
+	PermEventsWrite,
+	PermOrdersRefund,
+	PermCheckinScan,
+	PermRolesManage,
+	PermSupportManage,
+	PermPlansManage,
+	PermConfigManage,
+	PermReportsManage,
+	PermOrgsVerify,
+}
+
+// Roles lists every known role
+var Roles = []string{RoleCustomer, RoleOrganizer, RoleAdmin}
+
+// IsPermission checks if permission is known
+func IsPermission(permission string) bool {
+	return slices.Contains(AllPermissions, permission)
+}
+
+// IsRole checks if role is known
+func IsRole(role string) bool {
+	return slices.Contains(Roles, role)
+}
@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+)
+
+// RevokedKeyPrefix namespaces revoked tokens in Redis, keyed by the token's SHA-256
+const RevokedKeyPrefix = "auth:revoked:"
+
+// ErrTokenRevoked is returned for tokens listed under RevokedKeyPrefix
+var ErrTokenRevoked = errors.New("token has been revoked")
+
+// TokenCache remembers validated access tokens for a short TTL
+// Tokens are keyed by their SHA-256, so the cache never holds a usable token.
+// The revocation check costs one Redis EXISTS per cache miss, which keeps
+// Upstash REST (one HTTP round trip per command) off the hot path; the price
+// is that a revoked token is accepted until its cached entry expires.
+type TokenCache struct {
+	ttl        time.Duration
+	maxEntries int
+	redis      cache.RedisClient
+
+	mu      sync.RWMutex
+	entries map[string]tokenCacheEntry
+	now     func() time.Time
+}
+
+type tokenCacheEntry struct {
+	claims    *Claims
+	err       error // ErrTokenRevoked; other failures are never cached
+	expiresAt time.Time
+}
+
+// NewTokenCache creates a validation cache
+// redisClient may be nil, in which case tokens are cached without a revocation check
+func NewTokenCache(ttl time.Duration, maxEntries int, redisClient cache.RedisClient) *TokenCache {
+	return &TokenCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		redis:      redisClient,
+		entries:    make(map[string]tokenCacheEntry),
+		now:        time.Now,
+	}
+}
+
+// Len returns the number of cached tokens, expired ones included until they are swept
+func (tc *TokenCache) Len() int {
+	tc.mu.RLock()
+	defer tc.mu.RUnlock()
+	return len(tc.entries)
+}
+
+// RevokeToken lists a token as revoked until it expires
+// Gateways with a token cache reject it once their cached entry expires.
+func RevokeToken(ctx context.Context, redisClient cache.RedisClient, tokenString string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil // Already expired
+	}
+	if err := redisClient.Set(ctx, RevokedKeyPrefix+tokenHash(tokenString), "1", ttl); err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+	return nil
+}
+
+// parse validates an access token, serving repeated tokens from the cache
+func (tc *TokenCache) parse(ctx context.Context, keys *KeySet, tokenString string) (*Claims, error) {
+	hash := tokenHash(tokenString)
+	now := tc.now()
+
+	tc.mu.RLock()
+	entry, ok := tc.entries[hash]
+	tc.mu.RUnlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.claims, entry.err
+	}
+
+	claims, err := parseAccessToken(keys, tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	entry = tokenCacheEntry{claims: claims, expiresAt: now.Add(tc.ttl)}
+	if claims.ExpiresAt != nil && claims.ExpiresAt.Time.Before(entry.expiresAt) {
+		entry.expiresAt = claims.ExpiresAt.Time
+	}
+
+	if tc.redis != nil {
+		revoked, err := tc.redis.Exists(ctx, RevokedKeyPrefix+hash)
+		if err != nil {
+			// Redis is not the authority on validity: accept the token, but check again on the next request
+			log.Printf("[TokenCache] Failed to check token revocation: %v", err)
+			return claims, nil
+		}
+		if revoked > 0 {
+			entry.claims, entry.err = nil, ErrTokenRevoked
+		}
+	}
+
+	tc.store(hash, entry, now)
+	return entry.claims, entry.err
+}
+
+// store caches an entry, sweeping expired entries when the cache is full
+func (tc *TokenCache) store(hash string, entry tokenCacheEntry, now time.Time) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	if tc.maxEntries > 0 && len(tc.entries) >= tc.maxEntries {
+		for key, cached := range tc.entries {
+			if !now.Before(cached.expiresAt) {
+				delete(tc.entries, key)
+			}
+		}
+		// Still full: evict an arbitrary entry, it is re-validated on its next request
+		for key := range tc.entries {
+			if len(tc.entries) < tc.maxEntries {
+				break
+			}
+			delete(tc.entries, key)
+		}
+	}
+	tc.entries[hash] = entry
+}
+
+// tokenHash returns the hex SHA-256 of a token
+func tokenHash(tokenString string) string {
+	sum := sha256.Sum256([]byte(tokenString))
+	return hex.EncodeToString(sum[:])
+}
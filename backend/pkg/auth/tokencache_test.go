@@ -0,0 +1,266 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRedis stores keys in memory and counts revocation checks
+type fakeRedis struct {
+	cache.RedisClient
+	mu      sync.Mutex
+	values  map[string]string
+	exists  int
+	failing bool
+}
+
+func newFakeRedis() *fakeRedis {
+	return &fakeRedis{values: map[string]string{}}
+}
+
+func (r *fakeRedis) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.values[key] = fmt.Sprint(value)
+	return nil
+}
+
+func (r *fakeRedis) Exists(ctx context.Context, keys ...string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.exists++
+	if r.failing {
+		return 0, errors.New("connection refused")
+	}
+	var n int64
+	for _, key := range keys {
+		if _, ok := r.values[key]; ok {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// fakeClock lets tests expire cache entries
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time          { return c.now }
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func newTestTokenCache(ttl time.Duration, maxEntries int, redis cache.RedisClient) (*TokenCache, *fakeClock) {
+	clock := &fakeClock{now: time.Now()}
+	tokens := NewTokenCache(ttl, maxEntries, redis)
+	tokens.now = clock.Now
+	return tokens, clock
+}
+
+func TestTokenCache_ServesValidatedTokens(t *testing.T) {
+	redis := newFakeRedis()
+	tokens, clock := newTestTokenCache(30*time.Second, 100, redis)
+	keys := NewHMACKeySet("secret")
+	token, err := keys.Sign(accessClaims("user-1"))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	claims, err := tokens.parse(ctx, keys, token)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", claims.UserID())
+
+	cached, err := tokens.parse(ctx, keys, token)
+	require.NoError(t, err)
+	assert.Same(t, claims, cached)
+	assert.Equal(t, 1, redis.exists, "revocation is checked on misses only")
+
+	// Entries are re-validated after the TTL
+	clock.Advance(31 * time.Second)
+	_, err = tokens.parse(ctx, keys, token)
+	require.NoError(t, err)
+	assert.Equal(t, 2, redis.exists)
+
+	// Failures are never cached
+	_, err = tokens.parse(ctx, keys, "garbage")
+	assert.Error(t, err)
+	_, err = tokens.parse(ctx, keys, "garbage")
+	assert.Error(t, err)
+	assert.Equal(t, 1, tokens.Len())
+}
+
+func TestTokenCache_NeverOutlivesToken(t *testing.T) {
+	redis := newFakeRedis()
+	tokens, clock := newTestTokenCache(time.Hour, 100, redis)
+	keys := NewHMACKeySet("secret")
+	shortLived := accessClaims("user-1")
+	shortLived.ExpiresAt = jwt.NewNumericDate(clock.now.Add(10 * time.Second))
+	token, err := keys.Sign(shortLived)
+	require.NoError(t, err)
+
+	_, err = tokens.parse(context.Background(), keys, token)
+	require.NoError(t, err)
+
+	// The entry expires with the token, not after the TTL
+	clock.Advance(11 * time.Second)
+	_, _ = tokens.parse(context.Background(), keys, token)
+	assert.Equal(t, 2, redis.exists, "token was validated again")
+}
+
+func TestTokenCache_Revocation(t *testing.T) {
+	redis := newFakeRedis()
+	tokens, clock := newTestTokenCache(30*time.Second, 100, redis)
+	keys := NewHMACKeySet("secret")
+	claims := accessClaims("user-1")
+	token, err := keys.Sign(claims)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = tokens.parse(ctx, keys, token)
+	require.NoError(t, err)
+
+	require.NoError(t, RevokeToken(ctx, redis, token, claims.ExpiresAt.Time))
+	assert.Contains(t, redis.values, RevokedKeyPrefix+tokenHash(token))
+
+	_, err = tokens.parse(ctx, keys, token)
+	assert.NoError(t, err, "accepted until the cached entry expires")
+
+	clock.Advance(31 * time.Second)
+	_, err = tokens.parse(ctx, keys, token)
+	assert.ErrorIs(t, err, ErrTokenRevoked)
+	_, err = tokens.parse(ctx, keys, token)
+	assert.ErrorIs(t, err, ErrTokenRevoked)
+	assert.Equal(t, 2, redis.exists, "revocations are cached too")
+
+	// Expired tokens need no revocation entry
+	require.NoError(t, RevokeToken(ctx, redis, "expired", time.Now().Add(-time.Minute)))
+	assert.NotContains(t, redis.values, RevokedKeyPrefix+tokenHash("expired"))
+}
+
+func TestTokenCache_RedisErrorAcceptsWithoutCaching(t *testing.T) {
+	redis := newFakeRedis()
+	redis.failing = true
+	tokens, _ := newTestTokenCache(30*time.Second, 100, redis)
+	keys := NewHMACKeySet("secret")
+	token, err := keys.Sign(accessClaims("user-1"))
+	require.NoError(t, err)
+
+	_, err = tokens.parse(context.Background(), keys, token)
+	require.NoError(t, err)
+	assert.Equal(t, 0, tokens.Len())
+}
+
+func TestTokenCache_MaxEntries(t *testing.T) {
+	tokens, clock := newTestTokenCache(30*time.Second, 2, nil)
+	keys := NewHMACKeySet("secret")
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		token, err := keys.Sign(accessClaims(fmt.Sprintf("user-%d", i)))
+		require.NoError(t, err)
+		_, err = tokens.parse(ctx, keys, token)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, tokens.Len(), 2)
+	}
+
+	// Expired entries are swept first
+	clock.Advance(time.Minute)
+	token, err := keys.Sign(accessClaims("user-5"))
+	require.NoError(t, err)
+	_, err = tokens.parse(ctx, keys, token)
+	require.NoError(t, err)
+	assert.Equal(t, 1, tokens.Len())
+}
+
+func TestCachedMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	redis := newFakeRedis()
+	tokens, clock := newTestTokenCache(30*time.Second, 100, redis)
+	keys := NewHMACKeySet("secret")
+	claims := accessClaims("user-1")
+	token, err := keys.Sign(claims)
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.GET("/me", CachedMiddleware(keys, tokens), func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString(ContextUserID))
+	})
+
+	request := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/me", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := request()
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "user-1", w.Body.String())
+
+	require.NoError(t, RevokeToken(context.Background(), redis, token, claims.ExpiresAt.Time))
+	clock.Advance(31 * time.Second)
+	assert.Equal(t, http.StatusUnauthorized, request().Code)
+}
+
+// BenchmarkMiddleware measures the latency the auth middleware adds to a request
+// with and without the token cache, under parallel load:
+//
+//	go test ./pkg/auth -run '^$' -bench Middleware -benchmem
+func BenchmarkMiddleware(b *testing.B) {
+	gin.SetMode(gin.TestMode)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(b, err)
+	rsaKeys, err := NewKeySet("rsa", NewRSAKey("rsa", rsaKey))
+	require.NoError(b, err)
+
+	for _, keySet := range []struct {
+		name string
+		keys *KeySet
+	}{
+		{"HS256", NewHMACKeySet("secret")},
+		{"RS256", rsaKeys},
+	} {
+		token, err := keySet.keys.Sign(accessClaims("user-1"))
+		require.NoError(b, err)
+
+		for _, variant := range []struct {
+			name   string
+			tokens *TokenCache
+		}{
+			{"uncached", nil},
+			{"cached", NewTokenCache(30*time.Second, 10000, newFakeRedis())},
+		} {
+			router := gin.New()
+			router.GET("/me", CachedMiddleware(keySet.keys, variant.tokens), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			b.Run(keySet.name+"/"+variant.name, func(b *testing.B) {
+				b.ReportAllocs()
+				b.RunParallel(func(pb *testing.PB) {
+					for pb.Next() {
+						req := httptest.NewRequest(http.MethodGet, "/me", nil)
+						req.Header.Set("Authorization", "Bearer "+token)
+						w := httptest.NewRecorder()
+						router.ServeHTTP(w, req)
+						if w.Code != http.StatusOK {
+							b.Fatalf("unexpected status %d", w.Code)
+						}
+					}
+				})
+			})
+		}
+	}
+}
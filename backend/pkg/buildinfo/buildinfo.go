@@ -0,0 +1,111 @@
+package buildinfo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"runtime"
+	"runtime/debug"
+
+	"github.com/gin-gonic/gin"
+	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/buildinfo"
+	"google.golang.org/grpc"
+)
+
+// Injected at build time, e.g.
+//
+//	go build -ldflags "-X github.com/raflibima25/event-ticketing-platform/backend/pkg/buildinfo.Version=v1.4.0 \
+//	  -X github.com/raflibima25/event-ticketing-platform/backend/pkg/buildinfo.GitSHA=$(git rev-parse HEAD) \
+//	  -X github.com/raflibima25/event-ticketing-platform/backend/pkg/buildinfo.BuildTime=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+//
+// Without ldflags GitSHA and BuildTime fall back to the VCS stamp go build embeds
+var (
+	Version   = "dev"
+	GitSHA    = ""
+	BuildTime = ""
+)
+
+// Info describes the running binary
+type Info struct {
+	Service   string `json:"service"`
+	Version   string `json:"version"`
+	GitSHA    string `json:"git_sha"`
+	BuildTime string `json:"build_time,omitempty"` // RFC 3339
+	GoVersion string `json:"go_version"`
+}
+
+// Get returns the build info of the running binary for service
+func Get(service string) Info {
+	info := Info{
+		Service:   service,
+		Version:   Version,
+		GitSHA:    GitSHA,
+		BuildTime: BuildTime,
+		GoVersion: runtime.Version(),
+	}
+
+	if build, ok := debug.ReadBuildInfo(); ok {
+		modified := false
+		for _, setting := range build.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if info.GitSHA == "" {
+					info.GitSHA = setting.Value
+				}
+			case "vcs.time":
+				if info.BuildTime == "" {
+					info.BuildTime = setting.Value
+				}
+			case "vcs.modified":
+				modified = setting.Value == "true"
+			}
+		}
+		if modified && GitSHA == "" && info.GitSHA != "" {
+			info.GitSHA += "-dirty"
+		}
+	}
+
+	if info.GitSHA == "" {
+		info.GitSHA = "unknown"
+	}
+	return info
+}
+
+// String formats the info for startup logs
+func (i Info) String() string {
+	buildTime := i.BuildTime
+	if buildTime == "" {
+		buildTime = "unknown"
+	}
+	return fmt.Sprintf("%s %s (git %s, built %s, %s)", i.Service, i.Version, i.GitSHA, buildTime, i.GoVersion)
+}
+
+// Handler serves GET /version
+func Handler(service string) gin.HandlerFunc {
+	info := Get(service)
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, info)
+	}
+}
+
+// RegisterGRPC registers BuildInfoService on a gRPC server, next to reflection
+func RegisterGRPC(s grpc.ServiceRegistrar, service string) {
+	info := Get(service)
+	pb.RegisterBuildInfoServiceServer(s, &grpcServer{info: &pb.BuildInfo{
+		Service:   info.Service,
+		Version:   info.Version,
+		GitSha:    info.GitSHA,
+		BuildTime: info.BuildTime,
+		GoVersion: info.GoVersion,
+	}})
+}
+
+type grpcServer struct {
+	pb.UnimplementedBuildInfoServiceServer
+	info *pb.BuildInfo
+}
+
+// GetBuildInfo returns the build of the serving process
+func (s *grpcServer) GetBuildInfo(ctx context.Context, req *pb.GetBuildInfoRequest) (*pb.BuildInfo, error) {
+	return s.info, nil
+}
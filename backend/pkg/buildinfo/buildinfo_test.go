@@ -0,0 +1,88 @@
+package buildinfo
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/buildinfo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func withLinkerValues(t *testing.T, version, sha, buildTime string) {
+	oldVersion, oldSHA, oldTime := Version, GitSHA, BuildTime
+	Version, GitSHA, BuildTime = version, sha, buildTime
+	t.Cleanup(func() { Version, GitSHA, BuildTime = oldVersion, oldSHA, oldTime })
+}
+
+func TestGet_LinkerValues(t *testing.T) {
+	withLinkerValues(t, "v1.4.0", "abc123", "2026-10-15T08:00:00Z")
+
+	info := Get("ticketing-service")
+	assert.Equal(t, Info{
+		Service:   "ticketing-service",
+		Version:   "v1.4.0",
+		GitSHA:    "abc123",
+		BuildTime: "2026-10-15T08:00:00Z",
+		GoVersion: runtime.Version(),
+	}, info)
+	assert.Equal(t, "ticketing-service v1.4.0 (git abc123, built 2026-10-15T08:00:00Z, "+runtime.Version()+")", info.String())
+}
+
+func TestGet_WithoutLinkerValues(t *testing.T) {
+	withLinkerValues(t, "dev", "", "")
+
+	info := Get("auth-service")
+	assert.Equal(t, "dev", info.Version)
+	assert.NotEmpty(t, info.GitSHA, "falls back to the VCS stamp or unknown")
+	assert.Equal(t, runtime.Version(), info.GoVersion)
+}
+
+func TestHandler(t *testing.T) {
+	withLinkerValues(t, "v1.4.0", "abc123", "")
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/version", Handler("event-service"))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/version", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var body map[string]string
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "event-service", body["service"])
+	assert.Equal(t, "v1.4.0", body["version"])
+	assert.Equal(t, "abc123", body["git_sha"])
+	assert.Equal(t, runtime.Version(), body["go_version"])
+}
+
+func TestRegisterGRPC(t *testing.T) {
+	withLinkerValues(t, "v1.4.0", "abc123", "2026-10-15T08:00:00Z")
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	RegisterGRPC(server, "payment-service")
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	info, err := pb.NewBuildInfoServiceClient(conn).GetBuildInfo(context.Background(), &pb.GetBuildInfoRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "payment-service", info.Service)
+	assert.Equal(t, "v1.4.0", info.Version)
+	assert.Equal(t, "abc123", info.GitSha)
+	assert.Equal(t, "2026-10-15T08:00:00Z", info.BuildTime)
+}
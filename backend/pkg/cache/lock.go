@@ -2,28 +2,90 @@ package cache
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
 	"time"
 )
 
-// DistributedLockClient wraps RedisClient with convenience methods for distributed locking
-type DistributedLockClient struct {
-	RedisClient
+var (
+	ErrLockNotAcquired = errors.New("lock is held by another owner")
+	ErrLockNotHeld     = errors.New("lock is no longer held")
+)
+
+// Lock is a distributed lock held by this process
+// Token identifies the owner; only the owner can release or renew the lock
+type Lock struct {
+	Key   string
+	Token string
+}
+
+// Locker provides distributed locks shared by all service instances
+type Locker interface {
+	// Acquire takes the lock for key without waiting
+	// Returns ErrLockNotAcquired if another owner holds it
+	Acquire(ctx context.Context, key string, ttl time.Duration) (*Lock, error)
+
+	// Release frees the lock if it is still held by its owner
+	// Returns ErrLockNotHeld if it expired or was taken over in the meantime
+	Release(ctx context.Context, lock *Lock) error
+
+	// Renew extends the lock expiration if it is still held by its owner
+	// Returns ErrLockNotHeld if it expired or was taken over in the meantime
+	Renew(ctx context.Context, lock *Lock, ttl time.Duration) error
+}
+
+// Compare-and-delete / compare-and-expire, so an expired lock taken over by
+// another owner is never released or extended by the previous one
+const (
+	releaseScript = `if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("DEL", KEYS[1]) else return 0 end`
+	renewScript   = `if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("PEXPIRE", KEYS[1], ARGV[2]) else return 0 end`
+)
+
+// RedisLocker implements Locker on Redis SET NX with a random token per lock
+type RedisLocker struct {
+	client RedisClient
+}
+
+// NewRedisLocker creates a Locker backed by the given Redis client
+func NewRedisLocker(client RedisClient) *RedisLocker {
+	return &RedisLocker{client: client}
 }
 
-// NewDistributedLockClient creates a wrapper with distributed locking methods
-func NewDistributedLockClient(client RedisClient) *DistributedLockClient {
-	return &DistributedLockClient{RedisClient: client}
+// Acquire takes the lock for key using SET NX with a random token
+func (l *RedisLocker) Acquire(ctx context.Context, key string, ttl time.Duration) (*Lock, error) {
+	lock := &Lock{Key: key, Token: rand.Text()}
+
+	acquired, err := l.client.SetNX(ctx, key, lock.Token, ttl)
+	if err != nil {
+		return nil, err
+	}
+	if !acquired {
+		return nil, ErrLockNotAcquired
+	}
+
+	return lock, nil
 }
 
-// AcquireLock acquires a distributed lock using SetNX
-// Returns true if lock was acquired, false if lock already exists
-func (c *DistributedLockClient) AcquireLock(ctx context.Context, key string, expiration time.Duration) (bool, error) {
-	// Use SET NX (set if not exists) with expiration
-	// Value is set to "locked" but could be any value (e.g., owner ID)
-	return c.SetNX(ctx, key, "locked", expiration)
+// Release deletes the lock key if it still holds the lock token
+func (l *RedisLocker) Release(ctx context.Context, lock *Lock) error {
+	return l.evalOwned(ctx, releaseScript, lock)
 }
 
-// ReleaseLock releases a distributed lock by deleting the key
-func (c *DistributedLockClient) ReleaseLock(ctx context.Context, key string) error {
-	return c.Del(ctx, key)
+// Renew resets the lock key expiration if it still holds the lock token
+func (l *RedisLocker) Renew(ctx context.Context, lock *Lock, ttl time.Duration) error {
+	return l.evalOwned(ctx, renewScript, lock, ttl.Milliseconds())
+}
+
+// evalOwned runs a compare-and-act script and maps a zero reply to ErrLockNotHeld
+func (l *RedisLocker) evalOwned(ctx context.Context, script string, lock *Lock, args ...interface{}) error {
+	result, err := l.client.Eval(ctx, script, []string{lock.Key}, append([]interface{}{lock.Token}, args...)...)
+	if err != nil {
+		return err
+	}
+
+	if n, ok := result.(int64); !ok || n == 0 {
+		return ErrLockNotHeld
+	}
+
+	return nil
 }
@@ -0,0 +1,35 @@
+package cache
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+)
+
+// PGAdvisoryLock provides distributed locks on PostgreSQL transaction-scoped advisory locks
+// Used as fallback when Redis is unavailable: every instance shares the database,
+// so locks taken inside a transaction still exclude other instances. Locks are
+// released automatically on commit or rollback, so nothing can be leaked.
+type PGAdvisoryLock struct{}
+
+// NewPGAdvisoryLock creates a PostgreSQL advisory lock client
+func NewPGAdvisoryLock() *PGAdvisoryLock {
+	return &PGAdvisoryLock{}
+}
+
+// LockTx waits until tx holds the advisory locks for all keys
+// Keys are locked in sorted order so concurrent transactions locking overlapping
+// keys cannot deadlock. Cancel ctx to bound the wait.
+func (l *PGAdvisoryLock) LockTx(ctx context.Context, tx *sql.Tx, keys ...string) error {
+	sorted := slices.Clone(keys)
+	slices.Sort(sorted)
+
+	for _, key := range slices.Compact(sorted) {
+		if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`, key); err != nil {
+			return fmt.Errorf("failed to acquire advisory lock %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
@@ -30,6 +30,10 @@ type RedisClient interface {
 	// Expire sets expiration on existing key
 	Expire(ctx context.Context, key string, expiration time.Duration) error
 
+	// Eval runs a Lua script atomically
+	// Integer replies are returned as int64, missing values as nil
+	Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error)
+
 	// Ping checks connection health
 	// Returns error if connection is not healthy
 	Ping(ctx context.Context) error
@@ -205,6 +205,27 @@ func (c *RESTRedisClient) Expire(ctx context.Context, key string, expiration tim
 	return err
 }
 
+// Eval runs a Lua script atomically
+func (c *RESTRedisClient) Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+	commandArgs := []interface{}{script, len(keys)}
+	for _, key := range keys {
+		commandArgs = append(commandArgs, key)
+	}
+	commandArgs = append(commandArgs, args...)
+
+	result, err := c.executeCommand(ctx, "EVAL", commandArgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Upstash returns numbers as float64; match the TCP client
+	if num, ok := result.(float64); ok {
+		return int64(num), nil
+	}
+
+	return result, nil
+}
+
 // Ping checks connection health
 func (c *RESTRedisClient) Ping(ctx context.Context) error {
 	result, err := c.executeCommand(ctx, "PING")
@@ -69,6 +69,15 @@ func (c *TCPRedisClient) Expire(ctx context.Context, key string, expiration time
 	return c.client.Expire(ctx, key, expiration).Err()
 }
 
+// Eval runs a Lua script atomically
+func (c *TCPRedisClient) Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+	result, err := c.client.Eval(ctx, script, keys, args...).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	return result, err
+}
+
 // Ping checks connection health
 func (c *TCPRedisClient) Ping(ctx context.Context) error {
 	return c.client.Ping(ctx).Err()
@@ -0,0 +1,92 @@
+// Package contract holds the contracts between services: the route contract between
+// the API gateway and backend services, and the wire contract of the gRPC APIs (proto.go).
+//
+// gateway_routes.json is generated by the gateway contract test (run it with -update
+// after changing gateway routes) and verified by each service's router contract test,
+// so a gateway route that no service handles fails the build on either side.
+package contract
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Service names used in the contract
+const (
+	ServiceAuth      = "auth-service"
+	ServiceEvent     = "event-service"
+	ServiceTicketing = "ticketing-service"
+	ServicePayment   = "payment-service"
+)
+
+// Route is a gateway route and the upstream route it proxies to
+// Paths use gin patterns (e.g. /api/v1/orders/:id)
+type Route struct {
+	Method       string `json:"method"`
+	GatewayPath  string `json:"gateway_path"`
+	Service      string `json:"service"`
+	UpstreamPath string `json:"upstream_path"`
+}
+
+// String formats route for test output
+func (r Route) String() string {
+	return fmt.Sprintf("%s %s -> %s %s", r.Method, r.GatewayPath, r.Service, r.UpstreamPath)
+}
+
+//go:embed gateway_routes.json
+var gatewayRoutesJSON []byte
+
+// GatewayRoutes returns all routes in the contract
+func GatewayRoutes() ([]Route, error) {
+	var routes []Route
+	if err := json.Unmarshal(gatewayRoutesJSON, &routes); err != nil {
+		return nil, fmt.Errorf("failed to parse gateway routes contract: %w", err)
+	}
+	return routes, nil
+}
+
+// RoutesFor returns contract routes proxied to service
+func RoutesFor(service string) ([]Route, error) {
+	routes, err := GatewayRoutes()
+	if err != nil {
+		return nil, err
+	}
+
+	serviceRoutes := []Route{}
+	for _, route := range routes {
+		if route.Service == service {
+			serviceRoutes = append(serviceRoutes, route)
+		}
+	}
+	return serviceRoutes, nil
+}
+
+// Unhandled returns routes whose upstream method/path is not registered on engine
+// Handlers are not executed: routes are matched against a stub copy of the engine's route table
+func Unhandled(engine *gin.Engine, routes []Route) []Route {
+	stub := gin.New()
+	stub.HandleMethodNotAllowed = true
+	for _, info := range engine.Routes() {
+		stub.Handle(info.Method, info.Path, func(c *gin.Context) {
+			c.Status(http.StatusNoContent)
+		})
+	}
+
+	unhandled := []Route{}
+	for _, route := range routes {
+		// Pattern segments such as ":id" are valid literal path values
+		req := httptest.NewRequest(route.Method, route.UpstreamPath, nil)
+		w := httptest.NewRecorder()
+		stub.ServeHTTP(w, req)
+
+		if w.Code != http.StatusNoContent {
+			unhandled = append(unhandled, route)
+		}
+	}
+	return unhandled
+}
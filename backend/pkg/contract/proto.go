@@ -0,0 +1,187 @@
+package contract
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// proto_schema.json records the wire contract of the gRPC APIs in backend/proto.
+// It is generated by the proto schema test (run it with -update after changing a
+// .proto file); the test fails on any change that would break a service still
+// running the recorded version, so services can be upgraded one at a time.
+
+// ProtoSchema is the wire contract of a set of proto files
+type ProtoSchema struct {
+	Messages map[string]ProtoMessage `json:"messages"` // By full name, e.g. ticketing.ConfirmPaymentRequest
+	Methods  map[string]ProtoMethod  `json:"methods"`  // By full method, e.g. /ticketing.TicketingService/ConfirmPayment
+}
+
+// ProtoMessage lists the fields of a message and the numbers and names reserved for removed fields
+type ProtoMessage struct {
+	Fields        []ProtoField `json:"fields"`
+	ReservedNums  []int32      `json:"reserved_numbers,omitempty"`
+	ReservedNames []string     `json:"reserved_names,omitempty"`
+}
+
+// ProtoField is one field of a message
+type ProtoField struct {
+	Number   int32  `json:"number"`
+	Name     string `json:"name"`     // JSON clients depend on it, so renames are breaking
+	Type     string `json:"type"`     // Scalar kind, or full name of the message type
+	Repeated bool   `json:"repeated"` // Repeated fields and maps
+}
+
+// ProtoMethod is one RPC of a service
+type ProtoMethod struct {
+	Input           string `json:"input"`
+	Output          string `json:"output"`
+	ClientStreaming bool   `json:"client_streaming,omitempty"`
+	ServerStreaming bool   `json:"server_streaming,omitempty"`
+}
+
+//go:embed proto_schema.json
+var protoSchemaJSON []byte
+
+// RecordedProtoSchema returns the recorded wire contract
+func RecordedProtoSchema() (*ProtoSchema, error) {
+	var schema ProtoSchema
+	if err := json.Unmarshal(protoSchemaJSON, &schema); err != nil {
+		return nil, fmt.Errorf("failed to parse proto schema contract: %w", err)
+	}
+	return &schema, nil
+}
+
+// DescribeProtos builds the wire contract of compiled proto files, e.g. pb/ticketing.File_ticketing_ticketing_proto
+func DescribeProtos(files ...protoreflect.FileDescriptor) *ProtoSchema {
+	schema := &ProtoSchema{Messages: map[string]ProtoMessage{}, Methods: map[string]ProtoMethod{}}
+	for _, file := range files {
+		describeMessages(schema, file.Messages())
+
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			service := services.Get(i)
+			methods := service.Methods()
+			for j := 0; j < methods.Len(); j++ {
+				method := methods.Get(j)
+				schema.Methods[fmt.Sprintf("/%s/%s", service.FullName(), method.Name())] = ProtoMethod{
+					Input:           string(method.Input().FullName()),
+					Output:          string(method.Output().FullName()),
+					ClientStreaming: method.IsStreamingClient(),
+					ServerStreaming: method.IsStreamingServer(),
+				}
+			}
+		}
+	}
+	return schema
+}
+
+// describeMessages adds messages and their nested messages to schema
+func describeMessages(schema *ProtoSchema, messages protoreflect.MessageDescriptors) {
+	for i := 0; i < messages.Len(); i++ {
+		message := messages.Get(i)
+		if message.IsMapEntry() {
+			continue
+		}
+
+		var described ProtoMessage
+		fields := message.Fields()
+		for j := 0; j < fields.Len(); j++ {
+			field := fields.Get(j)
+			fieldType := field.Kind().String()
+			if field.IsMap() {
+				fieldType = fmt.Sprintf("map<%s, %s>", kindName(field.MapKey()), kindName(field.MapValue()))
+			} else if field.Message() != nil {
+				fieldType = string(field.Message().FullName())
+			}
+			described.Fields = append(described.Fields, ProtoField{
+				Number:   int32(field.Number()),
+				Name:     string(field.Name()),
+				Type:     fieldType,
+				Repeated: field.Cardinality() == protoreflect.Repeated,
+			})
+		}
+		sort.Slice(described.Fields, func(a, b int) bool { return described.Fields[a].Number < described.Fields[b].Number })
+
+		ranges := message.ReservedRanges()
+		for j := 0; j < ranges.Len(); j++ {
+			for number := ranges.Get(j)[0]; number < ranges.Get(j)[1]; number++ {
+				described.ReservedNums = append(described.ReservedNums, int32(number))
+			}
+		}
+		names := message.ReservedNames()
+		for j := 0; j < names.Len(); j++ {
+			described.ReservedNames = append(described.ReservedNames, string(names.Get(j)))
+		}
+
+		schema.Messages[string(message.FullName())] = described
+		describeMessages(schema, message.Messages())
+	}
+}
+
+// kindName names a scalar kind, or the message type of a field
+func kindName(field protoreflect.FieldDescriptor) string {
+	if field.Message() != nil {
+		return string(field.Message().FullName())
+	}
+	return field.Kind().String()
+}
+
+// BreakingChanges lists the changes from recorded to current that break peers built against recorded
+// Adding messages, fields and methods is compatible. Removing a field is compatible only when its
+// number and name are reserved, so they can't be reused with another meaning.
+func BreakingChanges(recorded, current *ProtoSchema) []string {
+	var changes []string
+
+	for name, message := range recorded.Messages {
+		next, ok := current.Messages[name]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("message %s was removed", name))
+			continue
+		}
+
+		for _, field := range message.Fields {
+			i := slices.IndexFunc(next.Fields, func(f ProtoField) bool { return f.Number == field.Number })
+			if i < 0 {
+				if !slices.Contains(next.ReservedNums, field.Number) || !slices.Contains(next.ReservedNames, field.Name) {
+					changes = append(changes, fmt.Sprintf("field %s.%s (%d) was removed without reserving its number and name", name, field.Name, field.Number))
+				}
+				continue
+			}
+
+			nextField := next.Fields[i]
+			if nextField.Name != field.Name {
+				changes = append(changes, fmt.Sprintf("field %s.%s (%d) was renamed to %s", name, field.Name, field.Number, nextField.Name))
+			}
+			if nextField.Type != field.Type || nextField.Repeated != field.Repeated {
+				changes = append(changes, fmt.Sprintf("field %s.%s (%d) changed type from %s to %s", name, field.Name, field.Number, field.describeType(), nextField.describeType()))
+			}
+		}
+	}
+
+	for name, method := range recorded.Methods {
+		next, ok := current.Methods[name]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("method %s was removed", name))
+			continue
+		}
+		if next != method {
+			changes = append(changes, fmt.Sprintf("method %s changed signature", name))
+		}
+	}
+
+	sort.Strings(changes)
+	return changes
+}
+
+// describeType formats the field type for change messages
+func (f ProtoField) describeType() string {
+	if f.Repeated {
+		return "repeated " + f.Type
+	}
+	return f.Type
+}
@@ -0,0 +1,117 @@
+package contract
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"reflect"
+	"testing"
+
+	pbbuildinfo "github.com/raflibima25/event-ticketing-platform/backend/pb/buildinfo"
+	pbevent "github.com/raflibima25/event-ticketing-platform/backend/pb/event"
+	pbnotification "github.com/raflibima25/event-ticketing-platform/backend/pb/notification"
+	pbpayment "github.com/raflibima25/event-ticketing-platform/backend/pb/payment"
+	pbticketing "github.com/raflibima25/event-ticketing-platform/backend/pb/ticketing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updateProtoSchema = flag.Bool("update", false, "rewrite pkg/contract/proto_schema.json from the generated protos")
+
+const protoSchemaFile = "proto_schema.json"
+
+// TestProtoSchema compares the generated protos with the recorded wire contract
+// Run with -update after a compatible proto change; breaking changes need a new versioned package instead
+func TestProtoSchema(t *testing.T) {
+	current := DescribeProtos(
+		pbbuildinfo.File_buildinfo_buildinfo_proto,
+		pbevent.File_event_event_proto,
+		pbnotification.File_notification_notification_proto,
+		pbpayment.File_payment_payment_proto,
+		pbticketing.File_ticketing_ticketing_proto,
+	)
+
+	if *updateProtoSchema {
+		if recorded, err := RecordedProtoSchema(); err == nil {
+			require.Empty(t, BreakingChanges(recorded, current), "refusing to record breaking proto changes")
+		}
+		data, err := json.MarshalIndent(current, "", "  ")
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(protoSchemaFile, append(data, '\n'), 0o644))
+		return
+	}
+
+	recorded, err := RecordedProtoSchema()
+	require.NoError(t, err)
+
+	for _, change := range BreakingChanges(recorded, current) {
+		t.Errorf("breaking proto change: %s", change)
+	}
+	if !t.Failed() && !reflect.DeepEqual(recorded, current) {
+		t.Errorf("%s is out of date, run: go test ./pkg/contract -run TestProtoSchema -update", protoSchemaFile)
+	}
+}
+
+func TestBreakingChanges(t *testing.T) {
+	recorded := &ProtoSchema{
+		Messages: map[string]ProtoMessage{
+			"ticketing.ConfirmPaymentRequest": {Fields: []ProtoField{
+				{Number: 1, Name: "order_id", Type: "string"},
+				{Number: 2, Name: "payment_id", Type: "string"},
+				{Number: 3, Name: "amount", Type: "double"},
+			}},
+		},
+		Methods: map[string]ProtoMethod{
+			"/ticketing.TicketingService/ConfirmPayment": {Input: "ticketing.ConfirmPaymentRequest", Output: "ticketing.ConfirmPaymentResponse"},
+		},
+	}
+
+	t.Run("additions are compatible", func(t *testing.T) {
+		current := &ProtoSchema{
+			Messages: map[string]ProtoMessage{
+				"ticketing.ConfirmPaymentRequest": {Fields: append(recorded.Messages["ticketing.ConfirmPaymentRequest"].Fields,
+					ProtoField{Number: 4, Name: "currency", Type: "string"})},
+				"ticketing.RefundRequest": {},
+			},
+			Methods: map[string]ProtoMethod{
+				"/ticketing.TicketingService/ConfirmPayment": recorded.Methods["/ticketing.TicketingService/ConfirmPayment"],
+				"/ticketing.TicketingService/Refund":         {Input: "ticketing.RefundRequest", Output: "ticketing.RefundResponse"},
+			},
+		}
+		assert.Empty(t, BreakingChanges(recorded, current))
+	})
+
+	t.Run("reserved removal is compatible", func(t *testing.T) {
+		current := &ProtoSchema{
+			Messages: map[string]ProtoMessage{
+				"ticketing.ConfirmPaymentRequest": {
+					Fields:        recorded.Messages["ticketing.ConfirmPaymentRequest"].Fields[:2],
+					ReservedNums:  []int32{3},
+					ReservedNames: []string{"amount"},
+				},
+			},
+			Methods: recorded.Methods,
+		}
+		assert.Empty(t, BreakingChanges(recorded, current))
+	})
+
+	t.Run("breaking changes are reported", func(t *testing.T) {
+		current := &ProtoSchema{
+			Messages: map[string]ProtoMessage{
+				"ticketing.ConfirmPaymentRequest": {Fields: []ProtoField{
+					{Number: 1, Name: "order", Type: "string"},
+					{Number: 2, Name: "payment_id", Type: "int64"},
+				}},
+			},
+			Methods: map[string]ProtoMethod{
+				"/ticketing.TicketingService/ConfirmPayment": {Input: "ticketing.ConfirmPaymentRequest", Output: "ticketing.ConfirmPaymentResponse", ServerStreaming: true},
+			},
+		}
+		assert.Equal(t, []string{
+			"field ticketing.ConfirmPaymentRequest.amount (3) was removed without reserving its number and name",
+			"field ticketing.ConfirmPaymentRequest.order_id (1) was renamed to order",
+			"field ticketing.ConfirmPaymentRequest.payment_id (2) changed type from string to int64",
+			"method /ticketing.TicketingService/ConfirmPayment changed signature",
+		}, BreakingChanges(recorded, current))
+	})
+}
@@ -0,0 +1,351 @@
+package errreport
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/buildinfo"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/metrics"
+)
+
+// Errors and panics are reported to Sentry through its HTTP store API.
+// Without a DSN they are only logged, so local runs need no setup.
+
+// Event levels
+const (
+	LevelError = "error"
+	LevelFatal = "fatal" // Recovered panics
+)
+
+// queueSize bounds events waiting to be sent; further events are dropped (and still logged)
+const queueSize = 100
+
+// Config holds error reporting configuration
+type Config struct {
+	DSN         string // Sentry DSN, e.g. https://<key>@o0.ingest.sentry.io/<project>; empty logs only
+	Service     string // Tagged on every event, e.g. "ticketing-service"
+	Release     string // Defaults to the git SHA of the build (pkg/buildinfo)
+	Environment string
+}
+
+// ConfigFromEnv reads SENTRY_DSN, SENTRY_RELEASE and SENTRY_ENVIRONMENT (falling back to ENVIRONMENT)
+func ConfigFromEnv(service string) Config {
+	environment := os.Getenv("SENTRY_ENVIRONMENT")
+	if environment == "" {
+		environment = os.Getenv("ENVIRONMENT")
+	}
+	return Config{
+		DSN:         os.Getenv("SENTRY_DSN"),
+		Service:     service,
+		Release:     os.Getenv("SENTRY_RELEASE"),
+		Environment: environment,
+	}
+}
+
+// reporter sends events in the background
+type reporter struct {
+	cfg        Config
+	endpoint   string // Store API URL, empty when reporting is disabled
+	authHeader string
+	serverName string
+	client     *http.Client
+
+	queue   chan *event
+	pending atomic.Int64 // Events queued or being sent
+}
+
+var (
+	mu        sync.RWMutex
+	current   = &reporter{} // Logs only until Init
+	alarmHook sync.Once
+)
+
+// Init configures error reporting for the process
+// Alarms raised through pkg/metrics are reported too
+func Init(cfg Config) error {
+	if cfg.Release == "" {
+		cfg.Release = buildinfo.Get(cfg.Service).GitSHA
+	}
+
+	r := &reporter{cfg: cfg, client: &http.Client{Timeout: 5 * time.Second}}
+	r.serverName, _ = os.Hostname()
+
+	if cfg.DSN != "" {
+		endpoint, key, err := parseDSN(cfg.DSN)
+		if err != nil {
+			return err
+		}
+		r.endpoint = endpoint
+		r.authHeader = fmt.Sprintf("Sentry sentry_version=7, sentry_client=event-ticketing-errreport/1.0, sentry_key=%s", key)
+		r.queue = make(chan *event, queueSize)
+		go r.run()
+	}
+
+	mu.Lock()
+	current = r
+	mu.Unlock()
+
+	alarmHook.Do(func() {
+		metrics.OnAlarm(func(name, detail string) {
+			CaptureMessage(fmt.Sprintf("alarm %s: %s", name, detail), map[string]string{"alarm": name})
+		})
+	})
+	return nil
+}
+
+// Enabled reports whether events are sent to Sentry
+func Enabled() bool {
+	return get().endpoint != ""
+}
+
+// Release returns the release events are tagged with
+func Release() string {
+	return get().cfg.Release
+}
+
+// CaptureError reports an error with optional tags
+func CaptureError(err error, tags map[string]string) {
+	if err == nil {
+		return
+	}
+	get().capture(LevelError, fmt.Sprintf("%T", err), err.Error(), callerFrames(3), tags)
+}
+
+// CaptureMessage reports a condition that isn't a Go error, e.g. an alarm
+func CaptureMessage(message string, tags map[string]string) {
+	get().capture(LevelError, "message", message, callerFrames(3), tags)
+}
+
+// capturePanic reports a recovered panic; must be called from the deferred function that recovered it
+func capturePanic(recovered any, operation string) {
+	log.Printf("[PANIC] %s: %v\n%s", operation, recovered, debug.Stack())
+	get().capture(LevelFatal, "panic", fmt.Sprint(recovered), panicFrames(), map[string]string{"operation": operation})
+}
+
+// Recover recovers and reports a panic; use as defer errreport.Recover("operation")
+// The panicking goroutine ends instead of taking the process down
+func Recover(operation string) {
+	if recovered := recover(); recovered != nil {
+		capturePanic(recovered, operation)
+	}
+}
+
+// Go runs fn in a new goroutine whose panics are recovered and reported
+func Go(operation string, fn func()) {
+	go func() {
+		defer Recover(operation)
+		fn()
+	}()
+}
+
+// Flush waits up to timeout for queued events to be sent, e.g. before exiting
+func Flush(timeout time.Duration) bool {
+	r := get()
+	deadline := time.Now().Add(timeout)
+	for r.pending.Load() > 0 {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return true
+}
+
+func get() *reporter {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current
+}
+
+// capture builds an event and queues it for sending
+func (r *reporter) capture(level, errType, message string, frames []frame, tags map[string]string) {
+	if r.endpoint == "" {
+		if level != LevelFatal { // Panics are logged with their stack by capturePanic
+			log.Printf("[ErrorReport] %s: %s %v", errType, message, tags)
+		}
+		return
+	}
+
+	allTags := map[string]string{}
+	if r.cfg.Service != "" {
+		allTags["service"] = r.cfg.Service
+	}
+	for k, v := range tags {
+		allTags[k] = v
+	}
+
+	ev := &event{
+		EventID:     newEventID(),
+		Timestamp:   time.Now().UTC().Format(time.RFC3339),
+		Level:       level,
+		Platform:    "go",
+		Logger:      r.cfg.Service,
+		ServerName:  r.serverName,
+		Release:     r.cfg.Release,
+		Environment: r.cfg.Environment,
+		Tags:        allTags,
+		Exception: &exceptions{Values: []exception{{
+			Type:       errType,
+			Value:      message,
+			Stacktrace: &stacktrace{Frames: frames},
+		}}},
+	}
+
+	r.pending.Add(1)
+	select {
+	case r.queue <- ev:
+	default:
+		r.pending.Add(-1)
+		log.Printf("[ErrorReport] Queue full, dropped event: %s: %s", errType, message)
+	}
+}
+
+// run sends queued events
+func (r *reporter) run() {
+	for ev := range r.queue {
+		if err := r.send(ev); err != nil {
+			log.Printf("[ErrorReport] Failed to send event %s: %v", ev.EventID, err)
+		}
+		r.pending.Add(-1)
+	}
+}
+
+// send posts one event to the store API
+func (r *reporter) send(ev *event) error {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, r.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Sentry-Auth", r.authHeader)
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("sentry returned %s", resp.Status)
+	}
+	return nil
+}
+
+// parseDSN returns the store API URL and public key of a DSN
+// https://<key>@<host>[/<path>]/<project> -> https://<host>[/<path>]/api/<project>/store/
+func parseDSN(dsn string) (endpoint, key string, err error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid SENTRY_DSN: %w", err)
+	}
+	if u.User == nil || u.User.Username() == "" || u.Host == "" {
+		return "", "", fmt.Errorf("invalid SENTRY_DSN: missing key or host")
+	}
+
+	path := strings.TrimSuffix(u.Path, "/")
+	slash := strings.LastIndex(path, "/")
+	project := path[slash+1:]
+	if project == "" {
+		return "", "", fmt.Errorf("invalid SENTRY_DSN: missing project ID")
+	}
+
+	return fmt.Sprintf("%s://%s%s/api/%s/store/", u.Scheme, u.Host, path[:slash], project), u.User.Username(), nil
+}
+
+// newEventID returns a random 32 hex digit event ID
+func newEventID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// callerFrames returns the calling goroutine's stack, oldest frame first as Sentry expects
+func callerFrames(skip int) []frame {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(skip, pcs)
+	callers := runtime.CallersFrames(pcs[:n])
+
+	var frames []frame
+	for {
+		f, more := callers.Next()
+		frames = append(frames, frame{
+			Function: f.Function,
+			Filename: f.File,
+			Lineno:   f.Line,
+			InApp:    strings.Contains(f.Function, "event-ticketing-platform"),
+		})
+		if !more {
+			break
+		}
+	}
+
+	for i, j := 0, len(frames)-1; i < j; i, j = i+1, j-1 {
+		frames[i], frames[j] = frames[j], frames[i]
+	}
+	return frames
+}
+
+// panicFrames returns the stack of a panicking goroutine up to the panic site
+// Frames of the recovery code (above runtime.gopanic) are dropped
+func panicFrames() []frame {
+	frames := callerFrames(3)
+	for i := len(frames) - 1; i >= 0; i-- {
+		if frames[i].Function == "runtime.gopanic" {
+			return frames[:i]
+		}
+	}
+	return frames
+}
+
+// Sentry event payload (subset)
+type event struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   string            `json:"timestamp"`
+	Level       string            `json:"level"`
+	Platform    string            `json:"platform"`
+	Logger      string            `json:"logger,omitempty"`
+	ServerName  string            `json:"server_name,omitempty"`
+	Release     string            `json:"release,omitempty"`
+	Environment string            `json:"environment,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	Exception   *exceptions       `json:"exception,omitempty"`
+}
+
+type exceptions struct {
+	Values []exception `json:"values"`
+}
+
+type exception struct {
+	Type       string      `json:"type"`
+	Value      string      `json:"value"`
+	Stacktrace *stacktrace `json:"stacktrace,omitempty"`
+}
+
+type stacktrace struct {
+	Frames []frame `json:"frames"`
+}
+
+type frame struct {
+	Function string `json:"function"`
+	Filename string `json:"filename"`
+	Lineno   int    `json:"lineno"`
+	InApp    bool   `json:"in_app"`
+}
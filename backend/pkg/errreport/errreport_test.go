@@ -0,0 +1,163 @@
+package errreport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeSentry records events posted to the store API
+type fakeSentry struct {
+	mu     sync.Mutex
+	events []event
+	auth   []string
+	paths  []string
+}
+
+func newFakeSentry(t *testing.T) (*fakeSentry, string) {
+	fake := &fakeSentry{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var ev event
+		require.NoError(t, json.Unmarshal(body, &ev))
+
+		fake.mu.Lock()
+		fake.events = append(fake.events, ev)
+		fake.auth = append(fake.auth, r.Header.Get("X-Sentry-Auth"))
+		fake.paths = append(fake.paths, r.URL.Path)
+		fake.mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	return fake, strings.Replace(server.URL, "http://", "http://public-key@", 1) + "/42"
+}
+
+func (f *fakeSentry) Events() []event {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]event(nil), f.events...)
+}
+
+func initFake(t *testing.T) *fakeSentry {
+	fake, dsn := newFakeSentry(t)
+	require.NoError(t, Init(Config{DSN: dsn, Service: "test-service", Release: "v1.2.3", Environment: "test"}))
+	t.Cleanup(func() { require.NoError(t, Init(Config{})) })
+	return fake
+}
+
+func TestParseDSN(t *testing.T) {
+	endpoint, key, err := parseDSN("https://abc123@o1.ingest.sentry.io/4506")
+	require.NoError(t, err)
+	assert.Equal(t, "https://o1.ingest.sentry.io/api/4506/store/", endpoint)
+	assert.Equal(t, "abc123", key)
+
+	endpoint, _, err = parseDSN("https://abc123@sentry.internal/prefix/7/")
+	require.NoError(t, err)
+	assert.Equal(t, "https://sentry.internal/prefix/api/7/store/", endpoint)
+
+	for _, dsn := range []string{"https://sentry.io/1", "https://abc@sentry.io", "://bad"} {
+		_, _, err := parseDSN(dsn)
+		assert.Error(t, err, dsn)
+	}
+}
+
+func TestCaptureError_TagsReleaseAndService(t *testing.T) {
+	fake := initFake(t)
+
+	CaptureError(errors.New("payment sync failed"), map[string]string{"order_id": "order-1"})
+	require.True(t, Flush(2*time.Second))
+
+	events := fake.Events()
+	require.Len(t, events, 1)
+	ev := events[0]
+	assert.Equal(t, LevelError, ev.Level)
+	assert.Equal(t, "v1.2.3", ev.Release)
+	assert.Equal(t, "test", ev.Environment)
+	assert.Equal(t, map[string]string{"service": "test-service", "order_id": "order-1"}, ev.Tags)
+	assert.Equal(t, "payment sync failed", ev.Exception.Values[0].Value)
+	assert.Len(t, ev.EventID, 32)
+
+	assert.Equal(t, "/api/42/store/", fake.paths[0])
+	assert.Contains(t, fake.auth[0], "sentry_key=public-key")
+
+	frames := ev.Exception.Values[0].Stacktrace.Frames
+	require.NotEmpty(t, frames)
+	assert.Contains(t, frames[len(frames)-1].Function, "TestCaptureError_TagsReleaseAndService", "newest frame is the caller")
+}
+
+func TestGo_RecoversAndReportsPanics(t *testing.T) {
+	fake := initFake(t)
+
+	done := make(chan struct{})
+	Go("send_ticket_email", func() {
+		defer close(done)
+		panic("template missing")
+	})
+	<-done
+	require.Eventually(t, func() bool { return len(fake.Events()) == 1 }, 2*time.Second, 10*time.Millisecond)
+
+	ev := fake.Events()[0]
+	assert.Equal(t, LevelFatal, ev.Level)
+	assert.Equal(t, "template missing", ev.Exception.Values[0].Value)
+	assert.Equal(t, "send_ticket_email", ev.Tags["operation"])
+
+	frames := ev.Exception.Values[0].Stacktrace.Frames
+	require.NotEmpty(t, frames)
+	assert.Contains(t, frames[len(frames)-1].Function, "TestGo_RecoversAndReportsPanics", "newest frame is the panic site")
+}
+
+func TestGinRecovery(t *testing.T) {
+	fake := initFake(t)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(GinRecovery())
+	router.GET("/orders/:id", func(c *gin.Context) { panic("nil order") })
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/orders/1", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Contains(t, w.Body.String(), "INTERNAL_ERROR")
+	require.True(t, Flush(2*time.Second))
+	require.Len(t, fake.Events(), 1)
+	assert.Equal(t, "GET /orders/:id", fake.Events()[0].Tags["operation"])
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	fake := initFake(t)
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/ticketing.TicketingService/ConfirmPayment"}
+	_, err := UnaryServerInterceptor()(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		panic("boom")
+	})
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+	require.True(t, Flush(2*time.Second))
+	require.Len(t, fake.Events(), 1)
+	assert.Equal(t, info.FullMethod, fake.Events()[0].Tags["operation"])
+}
+
+func TestWithoutDSN_LogsOnly(t *testing.T) {
+	require.NoError(t, Init(Config{Service: "test-service"}))
+	assert.False(t, Enabled())
+
+	CaptureError(errors.New("not sent"), nil)
+	Go("noop", func() { panic("recovered without reporting") })
+	assert.True(t, Flush(time.Second))
+}
@@ -0,0 +1,51 @@
+package errreport
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GinRecovery replaces gin.Recovery: panics are reported and answered with 500 in the shared error envelope
+func GinRecovery() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				capturePanic(recovered, c.Request.Method+" "+c.FullPath())
+				c.AbortWithStatusJSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode("Internal server error", sharedresponse.CodeInternal, nil))
+			}
+		}()
+		c.Next()
+	}
+}
+
+// UnaryServerInterceptor recovers and reports panics in unary gRPC handlers, answering codes.Internal
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				capturePanic(recovered, info.FullMethod)
+				err = status.Error(codes.Internal, "internal server error")
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor recovers and reports panics in streaming gRPC handlers, answering codes.Internal
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				capturePanic(recovered, info.FullMethod)
+				err = status.Error(codes.Internal, "internal server error")
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,166 @@
+package faultinject
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Targets of the gRPC clients, used as keys of FAULT_INJECTION
+const (
+	TargetEvent        = "event-service"
+	TargetTicketing    = "ticketing-service"
+	TargetPayment      = "payment-service"
+	TargetNotification = "notification-service"
+	TargetAll          = "*" // Applies to targets without their own fault
+)
+
+// Fault is injected into every call to one target
+type Fault struct {
+	Latency   time.Duration // Added before each call, cut short by the call's deadline
+	ErrorRate float64       // Share of calls failed with codes.Unavailable, 0 to 1
+}
+
+// Injector injects configured faults into outgoing gRPC calls
+// A nil Injector is valid and injects nothing
+type Injector struct {
+	faults map[string]Fault
+	random func() float64
+
+	injectedErrors atomic.Int64
+}
+
+// New creates new fault injector instance
+func New(faults map[string]Fault) *Injector {
+	return &Injector{faults: faults, random: rand.Float64}
+}
+
+// NewFromEnv creates an injector from FAULT_INJECTION, nil when it is not set
+// Faults are refused in production, so a leftover setting can't degrade live traffic
+//
+// Environment variables:
+//   - FAULT_INJECTION: faults per target, e.g. "payment-service:latency=2s,error_rate=0.3;notification-service:error_rate=1"
+//   - ENVIRONMENT: faults are only injected outside production (default development)
+func NewFromEnv() (*Injector, error) {
+	spec := os.Getenv("FAULT_INJECTION")
+	if spec == "" {
+		return nil, nil
+	}
+	if os.Getenv("ENVIRONMENT") == "production" {
+		return nil, fmt.Errorf("FAULT_INJECTION is not allowed in production")
+	}
+
+	faults, err := Parse(spec)
+	if err != nil {
+		return nil, err
+	}
+	return New(faults), nil
+}
+
+// Parse parses a FAULT_INJECTION spec: targets separated by ";", each "<target>:<key>=<value>,..."
+// Keys are latency (Go duration) and error_rate (0 to 1)
+func Parse(spec string) (map[string]Fault, error) {
+	faults := make(map[string]Fault)
+	for _, entry := range strings.Split(spec, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		target, settings, ok := strings.Cut(entry, ":")
+		target = strings.TrimSpace(target)
+		if !ok || target == "" {
+			return nil, fmt.Errorf("invalid fault %q: expected <target>:<key>=<value>", entry)
+		}
+
+		var fault Fault
+		for _, setting := range strings.Split(settings, ",") {
+			key, value, ok := strings.Cut(strings.TrimSpace(setting), "=")
+			if !ok {
+				return nil, fmt.Errorf("invalid fault setting %q for %s: expected <key>=<value>", setting, target)
+			}
+
+			switch key {
+			case "latency":
+				latency, err := time.ParseDuration(value)
+				if err != nil || latency < 0 {
+					return nil, fmt.Errorf("invalid latency %q for %s", value, target)
+				}
+				fault.Latency = latency
+			case "error_rate":
+				rate, err := strconv.ParseFloat(value, 64)
+				if err != nil || rate < 0 || rate > 1 {
+					return nil, fmt.Errorf("invalid error_rate %q for %s: expected 0 to 1", value, target)
+				}
+				fault.ErrorRate = rate
+			default:
+				return nil, fmt.Errorf("unknown fault setting %q for %s (expected 'latency' or 'error_rate')", key, target)
+			}
+		}
+		faults[target] = fault
+	}
+	return faults, nil
+}
+
+// DialOption returns the client option injecting the target's fault, a no-op without one
+func (i *Injector) DialOption(target string) grpc.DialOption {
+	fault, ok := i.faultFor(target)
+	if !ok {
+		return grpc.EmptyDialOption{}
+	}
+
+	log.Printf("[FaultInjection] ⚠️  Injecting faults into calls to %s: latency=%s error_rate=%.2f", target, fault.Latency, fault.ErrorRate)
+	return grpc.WithChainUnaryInterceptor(i.unaryClientInterceptor(target, fault))
+}
+
+// InjectedErrors returns how many calls were failed by the injector
+func (i *Injector) InjectedErrors() int64 {
+	if i == nil {
+		return 0
+	}
+	return i.injectedErrors.Load()
+}
+
+func (i *Injector) faultFor(target string) (Fault, bool) {
+	if i == nil {
+		return Fault{}, false
+	}
+	fault, ok := i.faults[target]
+	if !ok {
+		fault, ok = i.faults[TargetAll]
+	}
+	return fault, ok && (fault.Latency > 0 || fault.ErrorRate > 0)
+}
+
+// unaryClientInterceptor delays the call, then fails it or lets it through
+// Injected errors are codes.Unavailable, the code of a service that is down, so callers retry them
+func (i *Injector) unaryClientInterceptor(target string, fault Fault) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if fault.Latency > 0 {
+			timer := time.NewTimer(fault.Latency)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return status.FromContextError(ctx.Err()).Err()
+			}
+		}
+
+		if fault.ErrorRate > 0 && i.random() < fault.ErrorRate {
+			i.injectedErrors.Add(1)
+			return status.Errorf(codes.Unavailable, "fault injection: %s %s failed", target, method)
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,100 @@
+package faultinject
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParse(t *testing.T) {
+	faults, err := Parse("payment-service:latency=2s,error_rate=0.3; notification-service:error_rate=1;")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]Fault{
+		TargetPayment:      {Latency: 2 * time.Second, ErrorRate: 0.3},
+		TargetNotification: {ErrorRate: 1},
+	}, faults)
+
+	for _, spec := range []string{
+		"payment-service",
+		":latency=1s",
+		"payment-service:latency",
+		"payment-service:latency=soon",
+		"payment-service:error_rate=1.5",
+		"payment-service:jitter=1s",
+	} {
+		_, err := Parse(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestNewFromEnv_RefusedInProduction(t *testing.T) {
+	t.Setenv("FAULT_INJECTION", "*:error_rate=1")
+	t.Setenv("ENVIRONMENT", "production")
+
+	injector, err := NewFromEnv()
+	assert.Error(t, err)
+	assert.Nil(t, injector)
+}
+
+func TestNewFromEnv_UnsetIsDisabled(t *testing.T) {
+	t.Setenv("FAULT_INJECTION", "")
+
+	injector, err := NewFromEnv()
+	require.NoError(t, err)
+	assert.Nil(t, injector)
+	assert.Equal(t, grpc.EmptyDialOption{}, injector.DialOption(TargetPayment))
+}
+
+func TestInterceptor_InjectsErrors(t *testing.T) {
+	injector := New(map[string]Fault{TargetAll: {ErrorRate: 0.5}})
+	rolls := []float64{0.2, 0.8}
+	injector.random = func() float64 {
+		roll := rolls[0]
+		rolls = rolls[1:]
+		return roll
+	}
+	fault, ok := injector.faultFor(TargetEvent)
+	require.True(t, ok)
+	interceptor := injector.unaryClientInterceptor(TargetEvent, fault)
+
+	invoked := 0
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		invoked++
+		return nil
+	}
+
+	err := interceptor(context.Background(), "/event.EventService/GetEvent", nil, nil, nil, invoker)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 0, invoked)
+
+	err = interceptor(context.Background(), "/event.EventService/GetEvent", nil, nil, nil, invoker)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, invoked)
+	assert.Equal(t, int64(1), injector.InjectedErrors())
+}
+
+func TestInterceptor_LatencyRespectsDeadline(t *testing.T) {
+	injector := New(map[string]Fault{TargetPayment: {Latency: time.Minute}})
+	fault, ok := injector.faultFor(TargetPayment)
+	require.True(t, ok)
+	interceptor := injector.unaryClientInterceptor(TargetPayment, fault)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		t.Fatal("call should not reach the server after its deadline")
+		return nil
+	}
+	err := interceptor(ctx, "/payment.PaymentService/CreateInvoice", nil, nil, nil, invoker)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	_, ok = injector.faultFor(TargetTicketing)
+	assert.False(t, ok, "targets without a fault are not intercepted")
+}
@@ -0,0 +1,213 @@
+// Package featureflags provides feature flags shared across services.
+//
+// Flags are stored by a Provider (environment variables, Redis or ConfigCat) as a
+// Definition: on/off, optionally limited to environments, allowlisted organizers or
+// users, and a percentage rollout. Lookups never fail; an undefined flag, a backend
+// error or a nil Client all return the caller's fallback.
+package featureflags
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"strconv"
+	"strings"
+)
+
+// Flag is a feature flag key
+type Flag string
+
+// Known flags
+const (
+	NewPaymentGateway  Flag = "new_payment_gateway"
+	WaitingRoom        Flag = "waiting_room"
+	V2Responses        Flag = "v2_responses"
+	EventSourcedOrders Flag = "event_sourced_orders" // Evaluated per organizer when ticketing-service creates an order
+)
+
+// KnownFlags lists every known flag
+var KnownFlags = []Flag{NewPaymentGateway, WaitingRoom, V2Responses, EventSourcedOrders}
+
+// Definition describes who a flag is enabled for
+//
+// Evaluation order:
+//  1. Enabled false disables the flag everywhere
+//  2. Environments, when set, must include the service environment
+//  3. Allowlisted organizers and users are always enabled
+//  4. Percentage, when set, enables a stable share of organizers (or users when no organizer)
+//  5. Otherwise the flag is enabled unless an allowlist was given
+type Definition struct {
+	Enabled      bool     `json:"enabled"`
+	Environments []string `json:"environments,omitempty"`
+	Organizers   []string `json:"organizers,omitempty"`
+	Users        []string `json:"users,omitempty"`
+	Percentage   *int     `json:"percentage,omitempty"`
+	Value        string   `json:"value,omitempty"` // Payload for String/Int lookups
+}
+
+// Target identifies who a flag is evaluated for
+type Target struct {
+	UserID      string
+	OrganizerID string
+}
+
+// Provider loads flag definitions from a backend
+// Definition returns nil without error when the flag is not defined
+type Provider interface {
+	Definition(ctx context.Context, flag Flag) (*Definition, error)
+}
+
+// Client evaluates flags for one service environment
+// A nil Client is valid and always returns fallbacks
+type Client struct {
+	provider    Provider
+	environment string
+}
+
+// New creates new feature flag client instance
+func New(provider Provider, environment string) *Client {
+	return &Client{provider: provider, environment: environment}
+}
+
+// Bool reports whether flag is enabled for target
+func (c *Client) Bool(ctx context.Context, flag Flag, target Target, fallback bool) bool {
+	def, ok := c.lookup(ctx, flag)
+	if !ok {
+		return fallback
+	}
+	return def.enabledFor(flag, c.environment, target)
+}
+
+// String returns the flag value for target, or fallback when disabled or empty
+func (c *Client) String(ctx context.Context, flag Flag, target Target, fallback string) string {
+	def, ok := c.lookup(ctx, flag)
+	if !ok || def.Value == "" || !def.enabledFor(flag, c.environment, target) {
+		return fallback
+	}
+	return def.Value
+}
+
+// Int returns the flag value for target, or fallback when disabled or not an integer
+func (c *Client) Int(ctx context.Context, flag Flag, target Target, fallback int) int {
+	value := c.String(ctx, flag, target, "")
+	if value == "" {
+		return fallback
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("[FeatureFlags] Flag %s value %q is not an integer: %v", flag, value, err)
+		return fallback
+	}
+	return n
+}
+
+// Definitions returns the current definitions of the known flags, nil when undefined
+func (c *Client) Definitions(ctx context.Context) map[Flag]*Definition {
+	defs := make(map[Flag]*Definition, len(KnownFlags))
+	for _, flag := range KnownFlags {
+		def, _ := c.lookup(ctx, flag)
+		defs[flag] = def
+	}
+	return defs
+}
+
+// Invalidate drops cached definitions so the next lookups read the backend, e.g. on config reload
+func (c *Client) Invalidate() {
+	if c == nil {
+		return
+	}
+	if p, ok := c.provider.(interface{ Invalidate() }); ok {
+		p.Invalidate()
+	}
+}
+
+func (c *Client) lookup(ctx context.Context, flag Flag) (*Definition, bool) {
+	if c == nil || c.provider == nil {
+		return nil, false
+	}
+
+	def, err := c.provider.Definition(ctx, flag)
+	if err != nil {
+		log.Printf("[FeatureFlags] Failed to load flag %s: %v", flag, err)
+		return nil, false
+	}
+	return def, def != nil
+}
+
+func (d *Definition) enabledFor(flag Flag, environment string, target Target) bool {
+	if !d.Enabled {
+		return false
+	}
+	if len(d.Environments) > 0 && !contains(d.Environments, environment) {
+		return false
+	}
+	if (target.OrganizerID != "" && contains(d.Organizers, target.OrganizerID)) ||
+		(target.UserID != "" && contains(d.Users, target.UserID)) {
+		return true
+	}
+
+	if d.Percentage != nil {
+		if *d.Percentage >= 100 {
+			return true
+		}
+		key := target.OrganizerID
+		if key == "" {
+			key = target.UserID
+		}
+		// Anonymous requests only see fully rolled out flags
+		if key == "" {
+			return false
+		}
+		return bucket(flag, key) < *d.Percentage
+	}
+
+	return len(d.Organizers) == 0 && len(d.Users) == 0
+}
+
+// bucket maps key to a stable bucket in [0, 100) per flag,
+// so the same organizer stays enabled as the percentage grows
+func bucket(flag Flag, key string) int {
+	h := fnv.New32a()
+	h.Write([]byte(string(flag) + ":" + key))
+	return int(h.Sum32() % 100)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseDefinition parses a stored flag value
+// Accepts a boolean ("true", "off", "1"), a JSON Definition, or any other
+// string, which is treated as an enabled flag with that Value
+// An empty string means the flag is not defined
+func ParseDefinition(raw string) (*Definition, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+
+	switch strings.ToLower(raw) {
+	case "true", "on", "1":
+		return &Definition{Enabled: true}, nil
+	case "false", "off", "0":
+		return &Definition{Enabled: false}, nil
+	}
+
+	if strings.HasPrefix(raw, "{") {
+		var def Definition
+		if err := json.Unmarshal([]byte(raw), &def); err != nil {
+			return nil, fmt.Errorf("invalid flag definition: %w", err)
+		}
+		return &def, nil
+	}
+
+	return &Definition{Enabled: true, Value: raw}, nil
+}
@@ -0,0 +1,182 @@
+package featureflags
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func percentage(p int) *int {
+	return &p
+}
+
+func TestClientBool(t *testing.T) {
+	ctx := context.Background()
+	organizer := Target{UserID: "org-1", OrganizerID: "org-1"}
+	customer := Target{UserID: "user-1"}
+
+	tests := []struct {
+		name     string
+		def      *Definition
+		target   Target
+		fallback bool
+		want     bool
+	}{
+		{"undefined uses fallback", nil, customer, true, true},
+		{"disabled", &Definition{Enabled: false}, customer, true, false},
+		{"enabled for everyone", &Definition{Enabled: true}, customer, false, true},
+		{"environment match", &Definition{Enabled: true, Environments: []string{"staging"}}, customer, false, true},
+		{"environment mismatch", &Definition{Enabled: true, Environments: []string{"production"}}, customer, true, false},
+		{"organizer allowlisted", &Definition{Enabled: true, Organizers: []string{"org-1"}}, organizer, false, true},
+		{"organizer not allowlisted", &Definition{Enabled: true, Organizers: []string{"org-2"}}, organizer, true, false},
+		{"user allowlisted", &Definition{Enabled: true, Users: []string{"user-1"}}, customer, false, true},
+		{"allowlist wins over zero percent", &Definition{Enabled: true, Organizers: []string{"org-1"}, Percentage: percentage(0)}, organizer, false, true},
+		{"zero percent", &Definition{Enabled: true, Percentage: percentage(0)}, organizer, true, false},
+		{"full rollout", &Definition{Enabled: true, Percentage: percentage(100)}, Target{}, false, true},
+		{"partial rollout excludes anonymous", &Definition{Enabled: true, Percentage: percentage(99)}, Target{}, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := New(StaticProvider{WaitingRoom: tt.def}, "staging")
+			assert.Equal(t, tt.want, client.Bool(ctx, WaitingRoom, tt.target, tt.fallback))
+		})
+	}
+}
+
+func TestClientBool_PercentageRollout(t *testing.T) {
+	ctx := context.Background()
+	client := New(StaticProvider{NewPaymentGateway: {Enabled: true, Percentage: percentage(30)}}, "production")
+
+	enabled := 0
+	for i := 0; i < 1000; i++ {
+		target := Target{OrganizerID: "org-" + string(rune('a'+i%26)) + string(rune('a'+i/26))}
+		first := client.Bool(ctx, NewPaymentGateway, target, false)
+		assert.Equal(t, first, client.Bool(ctx, NewPaymentGateway, target, false), "rollout must be stable")
+		if first {
+			enabled++
+		}
+	}
+	assert.InDelta(t, 300, enabled, 60)
+
+	// Organizers enabled at a lower percentage stay enabled as it grows
+	grown := New(StaticProvider{NewPaymentGateway: {Enabled: true, Percentage: percentage(60)}}, "production")
+	for i := 0; i < 200; i++ {
+		target := Target{OrganizerID: "org-" + string(rune('a'+i%26)) + string(rune('a'+i/26))}
+		if client.Bool(ctx, NewPaymentGateway, target, false) {
+			assert.True(t, grown.Bool(ctx, NewPaymentGateway, target, false))
+		}
+	}
+}
+
+type failingProvider struct{}
+
+func (failingProvider) Definition(ctx context.Context, flag Flag) (*Definition, error) {
+	return nil, errors.New("backend down")
+}
+
+func TestClient_Fallbacks(t *testing.T) {
+	ctx := context.Background()
+
+	var nilClient *Client
+	assert.True(t, nilClient.Bool(ctx, V2Responses, Target{}, true))
+	assert.Equal(t, "v1", nilClient.String(ctx, V2Responses, Target{}, "v1"))
+	assert.Equal(t, 3, nilClient.Int(ctx, V2Responses, Target{}, 3))
+
+	failing := New(failingProvider{}, "production")
+	assert.True(t, failing.Bool(ctx, V2Responses, Target{}, true))
+	assert.False(t, failing.Bool(ctx, V2Responses, Target{}, false))
+}
+
+func TestClient_TypedValues(t *testing.T) {
+	ctx := context.Background()
+	client := New(StaticProvider{
+		WaitingRoom:       {Enabled: true, Value: "500"},
+		NewPaymentGateway: {Enabled: true, Value: "midtrans", Organizers: []string{"org-1"}},
+		V2Responses:       {Enabled: true, Value: "not-a-number"},
+	}, "production")
+
+	assert.Equal(t, 500, client.Int(ctx, WaitingRoom, Target{}, 100))
+	assert.Equal(t, 100, client.Int(ctx, V2Responses, Target{}, 100))
+	assert.Equal(t, "midtrans", client.String(ctx, NewPaymentGateway, Target{OrganizerID: "org-1"}, "xendit"))
+	assert.Equal(t, "xendit", client.String(ctx, NewPaymentGateway, Target{OrganizerID: "org-2"}, "xendit"))
+}
+
+func TestParseDefinition(t *testing.T) {
+	def, err := ParseDefinition("")
+	require.NoError(t, err)
+	assert.Nil(t, def)
+
+	for _, raw := range []string{"true", "ON", "1"} {
+		def, err := ParseDefinition(raw)
+		require.NoError(t, err)
+		assert.Equal(t, &Definition{Enabled: true}, def, raw)
+	}
+
+	def, err = ParseDefinition("off")
+	require.NoError(t, err)
+	assert.Equal(t, &Definition{Enabled: false}, def)
+
+	def, err = ParseDefinition(`{"enabled":true,"environments":["staging"],"organizers":["org-1"],"percentage":25}`)
+	require.NoError(t, err)
+	assert.Equal(t, &Definition{
+		Enabled:      true,
+		Environments: []string{"staging"},
+		Organizers:   []string{"org-1"},
+		Percentage:   percentage(25),
+	}, def)
+
+	def, err = ParseDefinition("250")
+	require.NoError(t, err)
+	assert.Equal(t, &Definition{Enabled: true, Value: "250"}, def)
+
+	_, err = ParseDefinition(`{"enabled":`)
+	assert.Error(t, err)
+}
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	client := New(StaticProvider{WaitingRoom: {Enabled: true, Organizers: []string{"org-1"}}}, "production")
+
+	router := gin.New()
+	router.Use(Middleware(client))
+	router.GET("/check", func(c *gin.Context) {
+		c.Set("user_id", c.Query("user"))
+		c.Set("role", c.Query("role"))
+
+		assert.Same(t, client, FromContext(c.Request.Context()))
+		if Enabled(c, WaitingRoom, false) {
+			c.Status(http.StatusOK)
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+
+	for _, tt := range []struct {
+		query string
+		want  int
+	}{
+		{"user=org-1&role=organizer", http.StatusOK},
+		{"user=org-1&role=customer", http.StatusNoContent},
+		{"user=org-2&role=organizer", http.StatusNoContent},
+	} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/check?"+tt.query, nil))
+		assert.Equal(t, tt.want, w.Code, tt.query)
+	}
+}
+
+func TestEnabled_WithoutMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+
+	assert.Nil(t, FromGin(c))
+	assert.True(t, Enabled(c, V2Responses, true))
+}
@@ -0,0 +1,62 @@
+package featureflags
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GinKey is the gin context key holding the *Client
+const GinKey = "feature_flags"
+
+type contextKey struct{}
+
+// Middleware injects client into the gin context and the request context,
+// so handlers and services called with c.Request.Context() can look up flags
+func Middleware(client *Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(GinKey, client)
+		c.Request = c.Request.WithContext(NewContext(c.Request.Context(), client))
+		c.Next()
+	}
+}
+
+// NewContext returns ctx carrying client
+func NewContext(ctx context.Context, client *Client) context.Context {
+	return context.WithValue(ctx, contextKey{}, client)
+}
+
+// FromContext returns the client injected into ctx, or nil
+func FromContext(ctx context.Context) *Client {
+	client, _ := ctx.Value(contextKey{}).(*Client)
+	return client
+}
+
+// FromGin returns the client injected by Middleware, or nil
+func FromGin(c *gin.Context) *Client {
+	value, exists := c.Get(GinKey)
+	if !exists {
+		return nil
+	}
+	client, _ := value.(*Client)
+	return client
+}
+
+// TargetFromGin builds the evaluation target from auth middleware keys
+// Organizers are targeted by their user ID unless an "organizer_id" key is set
+// (e.g. by a handler acting on another organizer's event)
+func TargetFromGin(c *gin.Context) Target {
+	target := Target{UserID: c.GetString("user_id")}
+	if organizerID := c.GetString("organizer_id"); organizerID != "" {
+		target.OrganizerID = organizerID
+	} else if c.GetString("role") == "organizer" {
+		target.OrganizerID = target.UserID
+	}
+	return target
+}
+
+// Enabled reports whether flag is enabled for the current request
+// Evaluate after auth middleware has run so user targeting applies
+func Enabled(c *gin.Context, flag Flag, fallback bool) bool {
+	return FromGin(c).Bool(c.Request.Context(), flag, TargetFromGin(c), fallback)
+}
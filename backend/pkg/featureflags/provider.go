@@ -0,0 +1,282 @@
+package featureflags
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+)
+
+// Backend names for FEATURE_FLAGS_BACKEND
+const (
+	BackendEnv       = "env"
+	BackendRedis     = "redis"
+	BackendConfigCat = "configcat"
+)
+
+// NewFromEnv creates a client for the backend selected by FEATURE_FLAGS_BACKEND (default env)
+// redisClient is only required by the redis backend
+//
+// Environment variables:
+//   - FEATURE_FLAGS_BACKEND: env, redis or configcat
+//   - FEATURE_FLAGS_CACHE_TTL: redis/configcat refresh interval (default 30s)
+//   - FEATURE_FLAGS_CONFIGCAT_SDK_KEY: required by the configcat backend
+//   - ENVIRONMENT: environment flags are evaluated for (default development)
+func NewFromEnv(redisClient cache.RedisClient) (*Client, error) {
+	environment := getEnv("ENVIRONMENT", "development")
+
+	ttl, err := time.ParseDuration(getEnv("FEATURE_FLAGS_CACHE_TTL", "30s"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid FEATURE_FLAGS_CACHE_TTL: %w", err)
+	}
+
+	backend := getEnv("FEATURE_FLAGS_BACKEND", BackendEnv)
+	switch backend {
+	case BackendEnv:
+		return New(EnvProvider{}, environment), nil
+	case BackendRedis:
+		if redisClient == nil {
+			return nil, fmt.Errorf("feature flags backend %q requires a Redis client", backend)
+		}
+		return New(NewRedisProvider(redisClient, ttl), environment), nil
+	case BackendConfigCat:
+		sdkKey := os.Getenv("FEATURE_FLAGS_CONFIGCAT_SDK_KEY")
+		if sdkKey == "" {
+			return nil, fmt.Errorf("FEATURE_FLAGS_CONFIGCAT_SDK_KEY must be set for the configcat backend")
+		}
+		return New(NewConfigCatProvider(ConfigCatConfig{SDKKey: sdkKey, RefreshInterval: ttl}), environment), nil
+	default:
+		return nil, fmt.Errorf("unknown feature flags backend: %s (expected 'env', 'redis' or 'configcat')", backend)
+	}
+}
+
+// EnvProvider reads flags from FEATURE_FLAG_<FLAG> environment variables
+// e.g. FEATURE_FLAG_WAITING_ROOM=true or FEATURE_FLAG_V2_RESPONSES={"enabled":true,"percentage":20}
+type EnvProvider struct{}
+
+// Definition parses the flag's environment variable
+func (EnvProvider) Definition(ctx context.Context, flag Flag) (*Definition, error) {
+	return ParseDefinition(os.Getenv(EnvKey(flag)))
+}
+
+// EnvKey returns the environment variable name for flag
+func EnvKey(flag Flag) string {
+	return "FEATURE_FLAG_" + strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(string(flag)))
+}
+
+// RedisKeyPrefix prefixes flag keys in Redis, e.g. featureflags:waiting_room
+const RedisKeyPrefix = "featureflags:"
+
+// RedisProvider reads flags from Redis keys shared by all services
+// Definitions are cached in memory for ttl so lookups don't hit Redis on every request
+type RedisProvider struct {
+	client cache.RedisClient
+	ttl    time.Duration
+
+	mu      sync.Mutex
+	entries map[Flag]cachedDefinition
+}
+
+type cachedDefinition struct {
+	def       *Definition
+	expiresAt time.Time
+}
+
+// NewRedisProvider creates new Redis flag provider instance
+func NewRedisProvider(client cache.RedisClient, ttl time.Duration) *RedisProvider {
+	return &RedisProvider{
+		client:  client,
+		ttl:     ttl,
+		entries: make(map[Flag]cachedDefinition),
+	}
+}
+
+// Definition returns the cached definition or loads it from Redis
+func (p *RedisProvider) Definition(ctx context.Context, flag Flag) (*Definition, error) {
+	p.mu.Lock()
+	entry, ok := p.entries[flag]
+	p.mu.Unlock()
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.def, nil
+	}
+
+	raw, err := p.client.Get(ctx, RedisKeyPrefix+string(flag))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get flag from redis: %w", err)
+	}
+
+	def, err := ParseDefinition(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	p.entries[flag] = cachedDefinition{def: def, expiresAt: time.Now().Add(p.ttl)}
+	p.mu.Unlock()
+	return def, nil
+}
+
+// Invalidate drops the cached definitions
+func (p *RedisProvider) Invalidate() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.entries = make(map[Flag]cachedDefinition)
+}
+
+// DefaultConfigCatBaseURL is the ConfigCat global CDN
+const DefaultConfigCatBaseURL = "https://cdn-global.configcat.com"
+
+// ConfigCatConfig holds ConfigCat provider configuration
+type ConfigCatConfig struct {
+	SDKKey          string
+	BaseURL         string        // Default: DefaultConfigCatBaseURL
+	RefreshInterval time.Duration // Default: 30s
+	HTTPClient      *http.Client  // Default: client with 10s timeout
+}
+
+// ConfigCatProvider reads flags from a ConfigCat config JSON (v6 format)
+//
+// Only setting values are used, ConfigCat targeting rules are not evaluated.
+// Boolean settings map to Enabled; text settings are parsed with ParseDefinition,
+// so organizer allowlists and percentage rollouts are stored as a JSON Definition.
+type ConfigCatProvider struct {
+	cfg ConfigCatConfig
+
+	mu        sync.Mutex
+	settings  map[Flag]*Definition
+	etag      string
+	fetchedAt time.Time
+}
+
+// NewConfigCatProvider creates new ConfigCat flag provider instance
+func NewConfigCatProvider(cfg ConfigCatConfig) *ConfigCatProvider {
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = DefaultConfigCatBaseURL
+	}
+	if cfg.RefreshInterval <= 0 {
+		cfg.RefreshInterval = 30 * time.Second
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &ConfigCatProvider{cfg: cfg}
+}
+
+// configCatConfig is the subset of the ConfigCat config_v6.json format used here
+type configCatConfig struct {
+	Settings map[string]struct {
+		Type  int `json:"t"` // 0 bool, 1 string, 2 int, 3 double
+		Value struct {
+			Bool   *bool    `json:"b"`
+			String *string  `json:"s"`
+			Int    *int64   `json:"i"`
+			Double *float64 `json:"d"`
+		} `json:"v"`
+	} `json:"f"`
+}
+
+// Definition returns the flag from the last fetched config, refreshing it when stale
+// A failed refresh keeps serving the previous config
+func (p *ConfigCatProvider) Definition(ctx context.Context, flag Flag) (*Definition, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.settings == nil || time.Since(p.fetchedAt) >= p.cfg.RefreshInterval {
+		if err := p.refresh(ctx); err != nil {
+			if p.settings == nil {
+				return nil, err
+			}
+			// Retry after the next interval instead of on every lookup
+			p.fetchedAt = time.Now()
+		}
+	}
+	return p.settings[flag], nil
+}
+
+// refresh fetches the config JSON; must be called with mu held
+func (p *ConfigCatProvider) refresh(ctx context.Context) error {
+	url := fmt.Sprintf("%s/configuration-files/%s/config_v6.json", strings.TrimRight(p.cfg.BaseURL, "/"), p.cfg.SDKKey)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create configcat request: %w", err)
+	}
+	if p.etag != "" {
+		req.Header.Set("If-None-Match", p.etag)
+	}
+
+	resp, err := p.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch configcat config: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNotModified:
+		p.fetchedAt = time.Now()
+		return nil
+	case http.StatusOK:
+	default:
+		return fmt.Errorf("configcat config request failed with status %d", resp.StatusCode)
+	}
+
+	var config configCatConfig
+	if err := json.NewDecoder(resp.Body).Decode(&config); err != nil {
+		return fmt.Errorf("failed to decode configcat config: %w", err)
+	}
+
+	settings := make(map[Flag]*Definition, len(config.Settings))
+	for key, setting := range config.Settings {
+		v := setting.Value
+		switch {
+		case v.Bool != nil:
+			settings[Flag(key)] = &Definition{Enabled: *v.Bool}
+		case v.String != nil:
+			def, err := ParseDefinition(*v.String)
+			if err != nil {
+				return fmt.Errorf("configcat setting %s: %w", key, err)
+			}
+			if def != nil {
+				settings[Flag(key)] = def
+			}
+		case v.Int != nil:
+			settings[Flag(key)] = &Definition{Enabled: true, Value: strconv.FormatInt(*v.Int, 10)}
+		case v.Double != nil:
+			settings[Flag(key)] = &Definition{Enabled: true, Value: strconv.FormatFloat(*v.Double, 'f', -1, 64)}
+		}
+	}
+
+	p.settings = settings
+	p.etag = resp.Header.Get("ETag")
+	p.fetchedAt = time.Now()
+	return nil
+}
+
+// Invalidate makes the next lookup refetch the config
+// The last config keeps being served if the refetch fails
+func (p *ConfigCatProvider) Invalidate() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.fetchedAt = time.Time{}
+}
+
+// StaticProvider serves fixed definitions, for tests and local overrides
+type StaticProvider map[Flag]*Definition
+
+// Definition returns the configured definition
+func (p StaticProvider) Definition(ctx context.Context, flag Flag) (*Definition, error) {
+	return p[flag], nil
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
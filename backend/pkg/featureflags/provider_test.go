@@ -0,0 +1,161 @@
+package featureflags
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvProvider(t *testing.T) {
+	t.Setenv("FEATURE_FLAG_WAITING_ROOM", `{"enabled":true,"environments":["production"]}`)
+	t.Setenv("FEATURE_FLAG_V2_RESPONSES", "false")
+
+	assert.Equal(t, "FEATURE_FLAG_NEW_PAYMENT_GATEWAY", EnvKey(NewPaymentGateway))
+
+	client := New(EnvProvider{}, "production")
+	ctx := context.Background()
+	assert.True(t, client.Bool(ctx, WaitingRoom, Target{}, false))
+	assert.False(t, client.Bool(ctx, V2Responses, Target{}, true))
+	assert.True(t, client.Bool(ctx, NewPaymentGateway, Target{}, true), "unset flag uses fallback")
+}
+
+// fakeRedis serves Get from a map and counts lookups
+type fakeRedis struct {
+	cache.RedisClient
+	values map[string]string
+	gets   atomic.Int32
+}
+
+func (r *fakeRedis) Get(ctx context.Context, key string) (string, error) {
+	r.gets.Add(1)
+	return r.values[key], nil
+}
+
+func TestRedisProvider(t *testing.T) {
+	redis := &fakeRedis{values: map[string]string{
+		"featureflags:waiting_room": `{"enabled":true,"organizers":["org-1"]}`,
+	}}
+	provider := NewRedisProvider(redis, time.Minute)
+	client := New(provider, "production")
+	ctx := context.Background()
+
+	assert.True(t, client.Bool(ctx, WaitingRoom, Target{OrganizerID: "org-1"}, false))
+	assert.False(t, client.Bool(ctx, WaitingRoom, Target{OrganizerID: "org-2"}, true))
+	assert.Equal(t, int32(1), redis.gets.Load(), "definition should be cached")
+
+	// Undefined flags are cached too
+	assert.True(t, client.Bool(ctx, V2Responses, Target{}, true))
+	assert.True(t, client.Bool(ctx, V2Responses, Target{}, true))
+	assert.Equal(t, int32(2), redis.gets.Load())
+}
+
+func TestRedisProvider_Invalidate(t *testing.T) {
+	redis := &fakeRedis{values: map[string]string{"featureflags:waiting_room": "true"}}
+	client := New(NewRedisProvider(redis, time.Hour), "production")
+	ctx := context.Background()
+
+	assert.True(t, client.Bool(ctx, WaitingRoom, Target{}, false))
+
+	// Changes show up after invalidation instead of after the TTL
+	redis.values["featureflags:waiting_room"] = "false"
+	assert.True(t, client.Bool(ctx, WaitingRoom, Target{}, false), "still cached")
+	client.Invalidate()
+	assert.False(t, client.Bool(ctx, WaitingRoom, Target{}, true))
+
+	defs := client.Definitions(ctx)
+	assert.Len(t, defs, len(KnownFlags))
+	assert.False(t, defs[WaitingRoom].Enabled)
+	assert.Nil(t, defs[V2Responses])
+}
+
+func TestConfigCatProvider(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		assert.Equal(t, "/configuration-files/test-key/config_v6.json", r.URL.Path)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`{"f":{
+			"v2_responses":{"t":0,"v":{"b":false}},
+			"waiting_room":{"t":1,"v":{"s":"{\"enabled\":true,\"organizers\":[\"org-1\"]}"}},
+			"queue_size":{"t":2,"v":{"i":250}}
+		}}`))
+	}))
+	defer server.Close()
+
+	provider := NewConfigCatProvider(ConfigCatConfig{SDKKey: "test-key", BaseURL: server.URL, RefreshInterval: time.Hour})
+	client := New(provider, "production")
+	ctx := context.Background()
+
+	assert.False(t, client.Bool(ctx, V2Responses, Target{}, true))
+	assert.True(t, client.Bool(ctx, WaitingRoom, Target{OrganizerID: "org-1"}, false))
+	assert.Equal(t, 250, client.Int(ctx, Flag("queue_size"), Target{}, 0))
+	assert.True(t, client.Bool(ctx, NewPaymentGateway, Target{}, true), "undefined flag uses fallback")
+	assert.Equal(t, int32(1), requests.Load(), "config should be cached")
+
+	// Stale config is revalidated with the ETag and kept on 304
+	provider.fetchedAt = time.Time{}
+	assert.False(t, client.Bool(ctx, V2Responses, Target{}, true))
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestConfigCatProvider_KeepsLastConfigOnError(t *testing.T) {
+	var fail atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"f":{"waiting_room":{"t":0,"v":{"b":true}}}}`))
+	}))
+	defer server.Close()
+
+	provider := NewConfigCatProvider(ConfigCatConfig{SDKKey: "k", BaseURL: server.URL, RefreshInterval: time.Hour})
+	def, err := provider.Definition(context.Background(), WaitingRoom)
+	require.NoError(t, err)
+	require.NotNil(t, def)
+
+	fail.Store(true)
+	provider.fetchedAt = time.Time{}
+	def, err = provider.Definition(context.Background(), WaitingRoom)
+	require.NoError(t, err)
+	assert.True(t, def.Enabled)
+
+	empty := NewConfigCatProvider(ConfigCatConfig{SDKKey: "k", BaseURL: server.URL})
+	_, err = empty.Definition(context.Background(), WaitingRoom)
+	assert.Error(t, err)
+}
+
+func TestNewFromEnv(t *testing.T) {
+	t.Setenv("FEATURE_FLAGS_BACKEND", "")
+	client, err := NewFromEnv(nil)
+	require.NoError(t, err)
+	assert.IsType(t, EnvProvider{}, client.provider)
+
+	t.Setenv("FEATURE_FLAGS_BACKEND", BackendRedis)
+	_, err = NewFromEnv(nil)
+	assert.Error(t, err)
+
+	client, err = NewFromEnv(&fakeRedis{})
+	require.NoError(t, err)
+	assert.IsType(t, &RedisProvider{}, client.provider)
+
+	t.Setenv("FEATURE_FLAGS_BACKEND", BackendConfigCat)
+	t.Setenv("FEATURE_FLAGS_CONFIGCAT_SDK_KEY", "")
+	_, err = NewFromEnv(nil)
+	assert.Error(t, err)
+
+	t.Setenv("FEATURE_FLAGS_BACKEND", "unknown")
+	_, err = NewFromEnv(nil)
+	assert.Error(t, err)
+}
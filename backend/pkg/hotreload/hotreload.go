@@ -0,0 +1,245 @@
+// Package hotreload swaps hot-reloadable settings without a redeploy.
+//
+// A reload re-reads the env file (when configured) and calls every registered
+// component, which parses its settings from the environment, validates them and
+// swaps them atomically. A component that fails keeps its previous settings.
+//
+// Reloads are triggered by SIGHUP, by the admin endpoint, or by any replica of
+// any service bumping the shared Redis generation key, so one admin request
+// reloads the whole platform.
+package hotreload
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/joho/godotenv"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+)
+
+// GenerationKey is the Redis key replicas poll; bumping it reloads every service
+const GenerationKey = "config:reload:generation"
+
+// Reload triggers
+const (
+	TriggerSIGHUP = "sighup"
+	TriggerRedis  = "redis"
+	TriggerAdmin  = "admin"
+)
+
+// Config holds watcher configuration
+type Config struct {
+	Service      string
+	EnvFile      string        // Re-read on every reload; empty or missing keeps the process environment
+	PollInterval time.Duration // Redis generation poll interval, default: 10 seconds
+}
+
+// Status describes the last reload and the settings in effect
+type Status struct {
+	Service    string            `json:"service"`
+	Generation string            `json:"generation,omitempty"` // Last Redis generation seen
+	Trigger    string            `json:"trigger,omitempty"`
+	ReloadedAt *time.Time        `json:"reloaded_at,omitempty"`
+	Errors     map[string]string `json:"errors,omitempty"` // Components that kept their previous settings
+	Settings   map[string]any    `json:"settings"`         // Current settings by component
+}
+
+type component struct {
+	name    string
+	reload  func() error
+	current func() any
+}
+
+// Watcher reloads registered components on SIGHUP, admin requests and Redis generation changes
+type Watcher struct {
+	cfg   Config
+	redis cache.RedisClient
+
+	mu         sync.Mutex
+	components []component
+	generation string
+	last       Status
+
+	stopChan chan struct{}
+}
+
+// NewWatcher creates new config watcher
+// redisClient may be nil, in which case only SIGHUP and the admin endpoint reload this replica
+func NewWatcher(cfg Config, redisClient cache.RedisClient) *Watcher {
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = 10 * time.Second
+	}
+	return &Watcher{
+		cfg:      cfg,
+		redis:    redisClient,
+		stopChan: make(chan struct{}),
+	}
+}
+
+// Register adds a component
+// reload re-reads and swaps its settings, current returns the settings in effect for the status endpoint
+func (w *Watcher) Register(name string, reload func() error, current func() any) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.components = append(w.components, component{name: name, reload: reload, current: current})
+}
+
+// Start listens for SIGHUP and polls the Redis generation until ctx is done or Stop is called
+func (w *Watcher) Start(ctx context.Context) {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	defer signal.Stop(sighup)
+
+	// The generation at startup is already reflected in the loaded config
+	w.mu.Lock()
+	w.generation, _ = w.readGeneration(ctx)
+	w.mu.Unlock()
+
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+
+	log.Printf("[HotReload] Watching SIGHUP and %s every %s", GenerationKey, w.cfg.PollInterval)
+
+	for {
+		select {
+		case <-sighup:
+			w.Reload(TriggerSIGHUP)
+		case <-ticker.C:
+			w.poll(ctx)
+		case <-w.stopChan:
+			log.Println("[HotReload] Stopping config watcher")
+			return
+		case <-ctx.Done():
+			log.Println("[HotReload] Context cancelled, stopping config watcher")
+			return
+		}
+	}
+}
+
+// Stop stops the watcher
+func (w *Watcher) Stop() {
+	close(w.stopChan)
+}
+
+// Reload re-reads the env file and reloads every component
+func (w *Watcher) Reload(trigger string) Status {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.reloadLocked(trigger)
+}
+
+// Publish bumps the Redis generation so every replica reloads on its next poll
+// This replica is reloaded right away; the new generation is returned
+func (w *Watcher) Publish(ctx context.Context) (Status, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.redis != nil {
+		generation, err := w.redis.Eval(ctx, "return redis.call('INCR', KEYS[1])", []string{GenerationKey})
+		if err != nil {
+			return w.statusLocked(), fmt.Errorf("failed to publish config reload: %w", err)
+		}
+		w.generation = fmt.Sprint(generation)
+	}
+	return w.reloadLocked(TriggerAdmin), nil
+}
+
+// Status returns the last reload and the settings in effect
+func (w *Watcher) Status() Status {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.statusLocked()
+}
+
+// StatusHandler serves the current settings and last reload
+func (w *Watcher) StatusHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, sharedresponse.Success("Config retrieved successfully", w.Status()))
+	}
+}
+
+// ReloadHandler publishes a reload to every replica and reloads this one
+// Responds 200 with the status even when a component rejected its new settings; errors are listed in it
+func (w *Watcher) ReloadHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		status, err := w.Publish(c.Request.Context())
+		if err != nil {
+			log.Printf("[HotReload] %v", err)
+			c.JSON(http.StatusServiceUnavailable, sharedresponse.ErrorWithCode("Failed to publish config reload", sharedresponse.CodeServiceUnavailable, nil))
+			return
+		}
+		c.JSON(http.StatusOK, sharedresponse.Success("Config reloaded", status))
+	}
+}
+
+// poll reloads when another replica bumped the generation
+// Redis errors are logged and retried on the next tick
+func (w *Watcher) poll(ctx context.Context) {
+	generation, err := w.readGeneration(ctx)
+	if err != nil {
+		log.Printf("[HotReload] Failed to read %s: %v", GenerationKey, err)
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if generation == w.generation {
+		return
+	}
+	w.generation = generation
+	w.reloadLocked(TriggerRedis)
+}
+
+// readGeneration returns the current Redis generation, empty without Redis or before the first publish
+func (w *Watcher) readGeneration(ctx context.Context) (string, error) {
+	if w.redis == nil {
+		return "", nil
+	}
+	return w.redis.Get(ctx, GenerationKey)
+}
+
+// reloadLocked reloads every component; must be called with mu held
+func (w *Watcher) reloadLocked(trigger string) Status {
+	if w.cfg.EnvFile != "" {
+		if err := godotenv.Overload(w.cfg.EnvFile); err != nil && !os.IsNotExist(err) {
+			log.Printf("[HotReload] Failed to re-read %s, using the current environment: %v", w.cfg.EnvFile, err)
+		}
+	}
+
+	errs := map[string]string{}
+	for _, c := range w.components {
+		if err := c.reload(); err != nil {
+			errs[c.name] = err.Error()
+			log.Printf("[HotReload] %s kept its previous settings: %v", c.name, err)
+		}
+	}
+
+	now := time.Now()
+	w.last = Status{Trigger: trigger, ReloadedAt: &now}
+	if len(errs) > 0 {
+		w.last.Errors = errs
+	}
+	log.Printf("[HotReload] Reloaded %d components (trigger: %s, generation: %q, failed: %d)", len(w.components), trigger, w.generation, len(errs))
+	return w.statusLocked()
+}
+
+// statusLocked builds the status; must be called with mu held
+func (w *Watcher) statusLocked() Status {
+	status := w.last
+	status.Service = w.cfg.Service
+	status.Generation = w.generation
+	status.Settings = make(map[string]any, len(w.components))
+	for _, c := range w.components {
+		status.Settings[c.name] = c.current()
+	}
+	return status
+}
@@ -0,0 +1,197 @@
+package hotreload
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRedis keeps the generation counter in memory
+type fakeRedis struct {
+	cache.RedisClient
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func newFakeRedis() *fakeRedis {
+	return &fakeRedis{values: map[string]string{}}
+}
+
+func (r *fakeRedis) Get(ctx context.Context, key string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.values[key], nil
+}
+
+// Eval implements the INCR script used by Publish
+func (r *fakeRedis) Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n, _ := strconv.ParseInt(r.values[keys[0]], 10, 64)
+	n++
+	r.values[keys[0]] = strconv.FormatInt(n, 10)
+	return n, nil
+}
+
+// setting is a hot-reloadable int read from an environment variable
+type setting struct {
+	mu    sync.Mutex
+	value int
+}
+
+func (s *setting) register(w *Watcher, name, env string) {
+	w.Register(name, func() error {
+		v, err := strconv.Atoi(os.Getenv(env))
+		if err != nil || v <= 0 {
+			return errors.New("invalid " + env)
+		}
+		s.mu.Lock()
+		s.value = v
+		s.mu.Unlock()
+		return nil
+	}, func() any {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.value
+	})
+}
+
+func TestReload_FailedComponentKeepsPreviousSettings(t *testing.T) {
+	t.Setenv("TEST_RPM", "100")
+	t.Setenv("TEST_BURST", "20")
+
+	w := NewWatcher(Config{Service: "test-service"}, nil)
+	rpm, burst := &setting{value: 100}, &setting{value: 20}
+	rpm.register(w, "rpm", "TEST_RPM")
+	burst.register(w, "burst", "TEST_BURST")
+
+	t.Setenv("TEST_RPM", "300")
+	t.Setenv("TEST_BURST", "not-a-number")
+	status := w.Reload(TriggerSIGHUP)
+
+	assert.Equal(t, "test-service", status.Service)
+	assert.Equal(t, TriggerSIGHUP, status.Trigger)
+	require.NotNil(t, status.ReloadedAt)
+	assert.Equal(t, map[string]any{"rpm": 300, "burst": 20}, status.Settings)
+	assert.Equal(t, map[string]string{"burst": "invalid TEST_BURST"}, status.Errors)
+}
+
+func TestReload_RereadsEnvFile(t *testing.T) {
+	envFile := filepath.Join(t.TempDir(), ".env")
+	require.NoError(t, os.WriteFile(envFile, []byte("TEST_RESERVATION_MINUTES=15\n"), 0o644))
+	t.Setenv("TEST_RESERVATION_MINUTES", "15")
+
+	w := NewWatcher(Config{Service: "test-service", EnvFile: envFile}, nil)
+	minutes := &setting{value: 15}
+	minutes.register(w, "reservation", "TEST_RESERVATION_MINUTES")
+
+	require.NoError(t, os.WriteFile(envFile, []byte("TEST_RESERVATION_MINUTES=10\n"), 0o644))
+	status := w.Reload(TriggerSIGHUP)
+	assert.Equal(t, 10, status.Settings["reservation"])
+	assert.Empty(t, status.Errors)
+
+	// A missing env file keeps the process environment
+	require.NoError(t, os.Remove(envFile))
+	status = w.Reload(TriggerSIGHUP)
+	assert.Equal(t, 10, status.Settings["reservation"])
+}
+
+func TestPublish_ReloadsOtherReplicas(t *testing.T) {
+	t.Setenv("TEST_RPM", "100")
+	redis := newFakeRedis()
+	redis.values[GenerationKey] = "4"
+
+	// Two replicas sharing Redis
+	publisher := NewWatcher(Config{Service: "gateway-service", PollInterval: 10 * time.Millisecond}, redis)
+	follower := NewWatcher(Config{Service: "gateway-service", PollInterval: 10 * time.Millisecond}, redis)
+	publisherRPM, followerRPM := &setting{value: 100}, &setting{value: 100}
+	publisherRPM.register(publisher, "rpm", "TEST_RPM")
+	followerRPM.register(follower, "rpm", "TEST_RPM")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go follower.Start(ctx)
+	require.Eventually(t, func() bool { return follower.Status().Generation == "4" }, time.Second, 5*time.Millisecond, "follower read the startup generation")
+
+	t.Setenv("TEST_RPM", "250")
+	status, err := publisher.Publish(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "5", status.Generation)
+	assert.Equal(t, TriggerAdmin, status.Trigger)
+	assert.Equal(t, 250, status.Settings["rpm"], "publisher reloads right away")
+
+	require.Eventually(t, func() bool {
+		s := follower.Status()
+		return s.Generation == "5" && s.Trigger == TriggerRedis
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 250, follower.Status().Settings["rpm"])
+}
+
+func TestStart_IgnoresGenerationAtStartup(t *testing.T) {
+	redis := newFakeRedis()
+	redis.values[GenerationKey] = "7"
+
+	reloads := 0
+	w := NewWatcher(Config{Service: "test-service", PollInterval: 5 * time.Millisecond}, redis)
+	w.Register("counter", func() error { reloads++; return nil }, func() any { return reloads })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		w.Start(ctx)
+		close(done)
+	}()
+	time.Sleep(30 * time.Millisecond)
+	cancel()
+	<-done
+
+	status := w.Status()
+	assert.Equal(t, "7", status.Generation)
+	assert.Nil(t, status.ReloadedAt)
+	assert.Equal(t, 0, status.Settings["counter"])
+}
+
+func TestHandlers(t *testing.T) {
+	t.Setenv("TEST_RPM", "120")
+	w := NewWatcher(Config{Service: "gateway-service"}, newFakeRedis())
+	rpm := &setting{value: 100}
+	rpm.register(w, "rpm", "TEST_RPM")
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/admin/config", w.StatusHandler())
+	router.POST("/admin/config/reload", w.ReloadHandler())
+
+	var body struct {
+		Status bool   `json:"status"`
+		Data   Status `json:"data"`
+	}
+
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/admin/config", nil))
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+	assert.Equal(t, float64(100), body.Data.Settings["rpm"])
+	assert.Empty(t, body.Data.Trigger)
+
+	resp = httptest.NewRecorder()
+	router.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/admin/config/reload", nil))
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+	assert.Equal(t, float64(120), body.Data.Settings["rpm"])
+	assert.Equal(t, "1", body.Data.Generation)
+	assert.Equal(t, TriggerAdmin, body.Data.Trigger)
+}
@@ -0,0 +1,325 @@
+package interceptor
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/metrics"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Shared interceptors registered on every gRPC server and client:
+// request IDs, structured logging, metrics, panic recovery, deadlines and payload validation.
+
+// RequestIDMetadataKey carries the request ID between services
+const RequestIDMetadataKey = "x-request-id"
+
+// maxRequestIDLength bounds incoming request IDs
+const maxRequestIDLength = 128
+
+// gRPC metrics (exposed via /debug/vars)
+var (
+	serverCalls    = metrics.NewCounterVec("grpc_server_calls_total") // By "<method> <code>"
+	serverDuration = metrics.NewHistogram("grpc_server_call_duration", callBuckets)
+	clientCalls    = metrics.NewCounterVec("grpc_client_calls_total") // By "<method> <code>"
+	clientDuration = metrics.NewHistogram("grpc_client_call_duration", callBuckets)
+)
+
+var callBuckets = []time.Duration{
+	5 * time.Millisecond, 25 * time.Millisecond, 100 * time.Millisecond, 500 * time.Millisecond,
+	time.Second, 5 * time.Second, timeout.RPC,
+}
+
+// UnaryServer returns the interceptor chain of a server
+// extra interceptors (e.g. service authentication) run after deadline enforcement, before validation
+func UnaryServer(extra ...grpc.UnaryServerInterceptor) grpc.ServerOption {
+	chain := []grpc.UnaryServerInterceptor{
+		unaryServerLog,
+		errreport.UnaryServerInterceptor(),
+		unaryServerDeadline,
+	}
+	chain = append(chain, extra...)
+	return grpc.ChainUnaryInterceptor(append(chain, unaryServerValidate)...)
+}
+
+// StreamServer returns the stream interceptor chain of a server, in the same order as UnaryServer
+// Streamed messages are validated as they are received
+func StreamServer(extra ...grpc.StreamServerInterceptor) grpc.ServerOption {
+	chain := []grpc.StreamServerInterceptor{
+		streamServerLog,
+		errreport.StreamServerInterceptor(),
+		streamServerDeadline,
+	}
+	chain = append(chain, extra...)
+	return grpc.ChainStreamInterceptor(append(chain, streamServerValidate)...)
+}
+
+// UnaryClient returns the interceptor chain of a client
+// Calls are validated before they are sent and get the default RPC timeout when the caller set none;
+// failed calls are logged (the server logs every call)
+func UnaryClient() grpc.DialOption {
+	return grpc.WithChainUnaryInterceptor(unaryClient)
+}
+
+// StreamClient returns the stream interceptor of a client, propagating the request ID
+func StreamClient() grpc.DialOption {
+	return grpc.WithChainStreamInterceptor(streamClient)
+}
+
+type requestIDKey struct{}
+
+// WithRequestID stores the request ID propagated to outgoing calls
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestID returns the request ID of ctx, empty when there is none
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// incomingRequestID takes the caller's request ID, generating one when it is missing or invalid
+func incomingRequestID(ctx context.Context) context.Context {
+	var requestID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDMetadataKey); len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+	if !validRequestID(requestID) {
+		requestID = uuid.New().String()
+	}
+	return WithRequestID(ctx, requestID)
+}
+
+// outgoingRequestID attaches the request ID of ctx to the call, generating one when there is none
+func outgoingRequestID(ctx context.Context) (context.Context, string) {
+	requestID := RequestID(ctx)
+	if requestID == "" {
+		requestID = uuid.New().String()
+		ctx = WithRequestID(ctx, requestID)
+	}
+	return metadata.AppendToOutgoingContext(ctx, RequestIDMetadataKey, requestID), requestID
+}
+
+// validRequestID accepts short printable IDs, so callers can't inject into logs
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		if r < '!' || r > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// callLogEntry is one structured log line of a gRPC call
+type callLogEntry struct {
+	Time      string  `json:"time"`
+	Kind      string  `json:"kind"` // server or client
+	RequestID string  `json:"request_id"`
+	Method    string  `json:"method"`
+	Code      string  `json:"code"`
+	LatencyMs float64 `json:"latency_ms"`
+	Peer      string  `json:"peer,omitempty"`
+	Error     string  `json:"error,omitempty"`
+}
+
+var (
+	logMu  sync.Mutex
+	logOut io.Writer = os.Stdout
+)
+
+// writeLog emits one log line
+func writeLog(kind, requestID, method string, start time.Time, err error, peerAddr string) {
+	entry := callLogEntry{
+		Time:      start.UTC().Format(time.RFC3339Nano),
+		Kind:      kind,
+		RequestID: requestID,
+		Method:    method,
+		Code:      status.Code(err).String(),
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+		Peer:      peerAddr,
+	}
+	if err != nil {
+		entry.Error = status.Convert(err).Message()
+	}
+
+	line, marshalErr := json.Marshal(&entry)
+	if marshalErr != nil {
+		log.Printf("[gRPC] Failed to encode log entry of %s: %v", method, marshalErr)
+		return
+	}
+
+	logMu.Lock()
+	defer logMu.Unlock()
+	logOut.Write(append(line, '\n'))
+}
+
+// record counts a finished call
+func record(calls *metrics.CounterVec, duration *metrics.Histogram, method string, start time.Time, err error) {
+	calls.Inc(method + " " + status.Code(err).String())
+	duration.ObserveSince(start)
+}
+
+func peerAddr(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// unaryServerLog assigns the request ID, then logs and counts the call once the inner chain
+// (including panic recovery) has answered
+func unaryServerLog(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	ctx = incomingRequestID(ctx)
+	grpc.SetHeader(ctx, metadata.Pairs(RequestIDMetadataKey, RequestID(ctx)))
+
+	resp, err := handler(ctx, req)
+
+	record(serverCalls, serverDuration, info.FullMethod, start, err)
+	writeLog("server", RequestID(ctx), info.FullMethod, start, err, peerAddr(ctx))
+	return resp, err
+}
+
+func streamServerLog(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	ctx := incomingRequestID(ss.Context())
+	ss.SetHeader(metadata.Pairs(RequestIDMetadataKey, RequestID(ctx)))
+
+	err := handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+
+	record(serverCalls, serverDuration, info.FullMethod, start, err)
+	writeLog("server", RequestID(ctx), info.FullMethod, start, err, peerAddr(ctx))
+	return err
+}
+
+// unaryServerDeadline refuses calls whose deadline already passed and bounds calls without one by timeout.RPC
+func unaryServerDeadline(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, cancel, err := enforceDeadline(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+	return handler(ctx, req)
+}
+
+// streamServerDeadline refuses streams whose deadline already passed
+// Streams without a deadline are not bounded, they may legitimately outlast timeout.RPC
+func streamServerDeadline(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := ss.Context().Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	return handler(srv, ss)
+}
+
+func enforceDeadline(ctx context.Context) (context.Context, context.CancelFunc, error) {
+	if err := ctx.Err(); err != nil {
+		return ctx, nil, status.FromContextError(err).Err()
+	}
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}, nil
+	}
+	ctx, cancel := timeout.WithRPCTimeout(ctx)
+	return ctx, cancel, nil
+}
+
+// validator is implemented by messages with validation rules, the protoc-gen-validate convention
+type validator interface {
+	Validate() error
+}
+
+// validate answers codes.InvalidArgument for a message failing its rules
+func validate(msg any) error {
+	v, ok := msg.(validator)
+	if !ok {
+		return nil
+	}
+	if err := v.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+func unaryServerValidate(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func streamServerValidate(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &validatingStream{ServerStream: ss})
+}
+
+// unaryClient validates, propagates the request ID, bounds the call, then counts it and logs failures
+func unaryClient(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+	ctx, requestID := outgoingRequestID(ctx)
+
+	err := validate(req)
+	if err == nil {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = timeout.WithRPCTimeout(ctx)
+			defer cancel()
+		}
+		err = invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	record(clientCalls, clientDuration, method, start, err)
+	if err != nil {
+		writeLog("client", requestID, method, start, err, cc.Target())
+	}
+	return err
+}
+
+func streamClient(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	ctx, requestID := outgoingRequestID(ctx)
+	start := time.Now()
+
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		record(clientCalls, clientDuration, method, start, err)
+		writeLog("client", requestID, method, start, err, cc.Target())
+	}
+	return stream, err
+}
+
+// contextStream replaces the context of a server stream
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// validatingStream validates every received message
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validate(m)
+}
@@ -0,0 +1,170 @@
+package interceptor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/ticketing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+const validOrderID = "9b2f4c1e-0a7d-4f3b-8c55-2d1e6f7a8b90"
+
+// fakeTicketingServer runs handle for every ConfirmPayment call
+type fakeTicketingServer struct {
+	pb.UnimplementedTicketingServiceServer
+	handle func(ctx context.Context) error
+}
+
+func (s *fakeTicketingServer) ConfirmPayment(ctx context.Context, req *pb.ConfirmPaymentRequest) (*pb.ConfirmPaymentResponse, error) {
+	if err := s.handle(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.ConfirmPaymentResponse{Success: true}, nil
+}
+
+// startServer serves the fake with the shared interceptors and returns a client,
+// with the client interceptors when withClient is set
+func startServer(t *testing.T, handle func(ctx context.Context) error, withClient bool) pb.TicketingServiceClient {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer(UnaryServer(), StreamServer())
+	pb.RegisterTicketingServiceServer(server, &fakeTicketingServer{handle: handle})
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if withClient {
+		opts = append(opts, UnaryClient(), StreamClient())
+	}
+	conn, err := grpc.NewClient(listener.Addr().String(), opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return pb.NewTicketingServiceClient(conn)
+}
+
+func captureLog(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	logMu.Lock()
+	previous := logOut
+	logOut = &buf
+	logMu.Unlock()
+	t.Cleanup(func() {
+		logMu.Lock()
+		logOut = previous
+		logMu.Unlock()
+	})
+	return &buf
+}
+
+func validRequest() *pb.ConfirmPaymentRequest {
+	return &pb.ConfirmPaymentRequest{OrderId: validOrderID, PaymentId: "inv-1", Amount: 150000, Currency: "IDR"}
+}
+
+func TestRequestID_PropagatedAndLogged(t *testing.T) {
+	logs := captureLog(t)
+	var seen string
+	client := startServer(t, func(ctx context.Context) error {
+		seen = RequestID(ctx)
+		return nil
+	}, true)
+
+	_, err := client.ConfirmPayment(WithRequestID(context.Background(), "req-123"), validRequest())
+	require.NoError(t, err)
+	assert.Equal(t, "req-123", seen)
+
+	var entry callLogEntry
+	require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+	assert.Equal(t, "server", entry.Kind)
+	assert.Equal(t, "req-123", entry.RequestID)
+	assert.Equal(t, "/ticketing.TicketingService/ConfirmPayment", entry.Method)
+	assert.Equal(t, codes.OK.String(), entry.Code)
+}
+
+func TestRequestID_GeneratedWhenMissing(t *testing.T) {
+	captureLog(t)
+	var seen string
+	client := startServer(t, func(ctx context.Context) error {
+		seen = RequestID(ctx)
+		return nil
+	}, false)
+
+	_, err := client.ConfirmPayment(context.Background(), validRequest())
+	require.NoError(t, err)
+	assert.NotEmpty(t, seen)
+}
+
+func TestServer_RecoversPanics(t *testing.T) {
+	logs := captureLog(t)
+	client := startServer(t, func(ctx context.Context) error {
+		panic("boom")
+	}, false)
+
+	_, err := client.ConfirmPayment(context.Background(), validRequest())
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	// The recovered panic is logged and counted with its answer
+	var entry callLogEntry
+	require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+	assert.Equal(t, codes.Internal.String(), entry.Code)
+	assert.Positive(t, serverCalls.Value("/ticketing.TicketingService/ConfirmPayment Internal"))
+}
+
+func TestServer_ValidatesRequests(t *testing.T) {
+	captureLog(t)
+	called := false
+	client := startServer(t, func(ctx context.Context) error {
+		called = true
+		return nil
+	}, false)
+
+	_, err := client.ConfirmPayment(context.Background(), &pb.ConfirmPaymentRequest{OrderId: "not-a-uuid", PaymentId: "inv-1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.False(t, called)
+}
+
+func TestClient_ValidatesBeforeSending(t *testing.T) {
+	captureLog(t)
+	called := false
+	client := startServer(t, func(ctx context.Context) error {
+		called = true
+		return nil
+	}, true)
+
+	_, err := client.ConfirmPayment(context.Background(), &pb.ConfirmPaymentRequest{OrderId: validOrderID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.False(t, called)
+}
+
+func TestServer_BoundsCallsWithoutDeadline(t *testing.T) {
+	captureLog(t)
+	var deadline time.Time
+	client := startServer(t, func(ctx context.Context) error {
+		deadline, _ = ctx.Deadline()
+		return nil
+	}, false)
+
+	_, err := client.ConfirmPayment(context.Background(), validRequest())
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(10*time.Second), deadline, 2*time.Second)
+}
+
+func TestValidRequestID(t *testing.T) {
+	assert.True(t, validRequestID("9b2f4c1e-0a7d"))
+	assert.False(t, validRequestID(""))
+	assert.False(t, validRequestID("id\nforged log line"))
+	assert.False(t, validRequestID(string(make([]byte, maxRequestIDLength+1))))
+}
@@ -0,0 +1,175 @@
+package metrics
+
+import (
+	"expvar"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// Metrics are published via expvar; services expose them at /debug/vars.
+// Names must be unique per process, constructors panic on duplicates like expvar.
+
+// Counter is a monotonically increasing count
+type Counter struct {
+	v *expvar.Int
+}
+
+// NewCounter creates and publishes a counter
+func NewCounter(name string) *Counter {
+	return &Counter{v: expvar.NewInt(name)}
+}
+
+// Inc adds one to the counter
+func (c *Counter) Inc() {
+	c.v.Add(1)
+}
+
+// Add adds n to the counter
+func (c *Counter) Add(n int64) {
+	c.v.Add(n)
+}
+
+// Value returns the current count
+func (c *Counter) Value() int64 {
+	return c.v.Value()
+}
+
+// CounterVec is a set of counters keyed by a label, e.g. a failure reason
+type CounterVec struct {
+	m *expvar.Map
+}
+
+// NewCounterVec creates and publishes a labelled counter set
+func NewCounterVec(name string) *CounterVec {
+	return &CounterVec{m: expvar.NewMap(name)}
+}
+
+// Inc adds one to the counter of label
+func (c *CounterVec) Inc(label string) {
+	c.m.Add(label, 1)
+}
+
+// Value returns the count of label, 0 if never incremented
+func (c *CounterVec) Value(label string) int64 {
+	if v, ok := c.m.Get(label).(*expvar.Int); ok {
+		return v.Value()
+	}
+	return 0
+}
+
+// Histogram records durations into cumulative buckets
+// Published as {"count", "sum_ms", "max_ms", "buckets": {"le_<bound>": count, "le_inf": count}}
+type Histogram struct {
+	mu      sync.Mutex
+	bounds  []time.Duration // Ascending upper bounds
+	buckets []int64         // Observations <= bounds[i]; last is +Inf
+	count   int64
+	sum     time.Duration
+	max     time.Duration
+}
+
+// DefaultWaitBuckets suit lock and row-lock waits
+var DefaultWaitBuckets = []time.Duration{
+	time.Millisecond, 5 * time.Millisecond, 25 * time.Millisecond, 100 * time.Millisecond,
+	500 * time.Millisecond, time.Second, 5 * time.Second,
+}
+
+// NewHistogram creates and publishes a histogram with ascending bucket bounds
+func NewHistogram(name string, bounds []time.Duration) *Histogram {
+	h := &Histogram{
+		bounds:  bounds,
+		buckets: make([]int64, len(bounds)+1),
+	}
+	expvar.Publish(name, expvar.Func(func() any { return h.snapshot() }))
+	return h
+}
+
+// Observe records one duration
+func (h *Histogram) Observe(d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for i, bound := range h.bounds {
+		if d <= bound {
+			h.buckets[i]++
+		}
+	}
+	h.buckets[len(h.bounds)]++
+	h.count++
+	h.sum += d
+	if d > h.max {
+		h.max = d
+	}
+}
+
+// ObserveSince records the time elapsed since start
+func (h *Histogram) ObserveSince(start time.Time) {
+	h.Observe(time.Since(start))
+}
+
+// snapshot returns the published representation
+func (h *Histogram) snapshot() map[string]any {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	buckets := make(map[string]int64, len(h.buckets))
+	for i, bound := range h.bounds {
+		buckets["le_"+bound.String()] = h.buckets[i]
+	}
+	buckets["le_inf"] = h.buckets[len(h.bounds)]
+
+	return map[string]any{
+		"count":   h.count,
+		"sum_ms":  float64(h.sum) / float64(time.Millisecond),
+		"max_ms":  float64(h.max) / float64(time.Millisecond),
+		"buckets": buckets,
+	}
+}
+
+// AlarmHook is notified when an alarm is raised, e.g. to page on-call
+// Hooks run synchronously on the raising goroutine and must return quickly
+type AlarmHook func(name, detail string)
+
+var (
+	hooksMu sync.RWMutex
+	hooks   []AlarmHook
+)
+
+// OnAlarm registers a hook called for every raised alarm
+func OnAlarm(hook AlarmHook) {
+	hooksMu.Lock()
+	defer hooksMu.Unlock()
+	hooks = append(hooks, hook)
+}
+
+// Alarm counts occurrences of a condition that must never happen
+// Any non-zero value should alert; raising also logs and notifies the alarm hooks
+type Alarm struct {
+	name string
+	v    *expvar.Int
+}
+
+// NewAlarm creates and publishes an alarm
+func NewAlarm(name string) *Alarm {
+	return &Alarm{name: name, v: expvar.NewInt(name)}
+}
+
+// Raise records an occurrence with a detail describing the affected record
+func (a *Alarm) Raise(format string, args ...any) {
+	a.v.Add(1)
+	detail := fmt.Sprintf(format, args...)
+	log.Printf("[ALARM] %s: %s", a.name, detail)
+
+	hooksMu.RLock()
+	defer hooksMu.RUnlock()
+	for _, hook := range hooks {
+		hook(a.name, detail)
+	}
+}
+
+// Value returns how many times the alarm was raised
+func (a *Alarm) Value() int64 {
+	return a.v.Value()
+}
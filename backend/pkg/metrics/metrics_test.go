@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"encoding/json"
+	"expvar"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCounters(t *testing.T) {
+	counter := NewCounter("test_counter_total")
+	counter.Inc()
+	counter.Add(2)
+	assert.Equal(t, int64(3), counter.Value())
+	assert.Equal(t, "3", expvar.Get("test_counter_total").String())
+
+	vec := NewCounterVec("test_counter_vec_total")
+	vec.Inc("redis")
+	vec.Inc("redis")
+	vec.Inc("advisory")
+	assert.Equal(t, int64(2), vec.Value("redis"))
+	assert.Equal(t, int64(1), vec.Value("advisory"))
+	assert.Zero(t, vec.Value("unknown"))
+}
+
+func TestHistogram(t *testing.T) {
+	h := NewHistogram("test_wait", []time.Duration{time.Millisecond, 10 * time.Millisecond})
+	h.Observe(500 * time.Microsecond)
+	h.Observe(5 * time.Millisecond)
+	h.Observe(20 * time.Millisecond)
+
+	var published struct {
+		Count   int64            `json:"count"`
+		SumMs   float64          `json:"sum_ms"`
+		MaxMs   float64          `json:"max_ms"`
+		Buckets map[string]int64 `json:"buckets"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(expvar.Get("test_wait").String()), &published))
+
+	assert.Equal(t, int64(3), published.Count)
+	assert.InDelta(t, 25.5, published.SumMs, 0.001)
+	assert.InDelta(t, 20, published.MaxMs, 0.001)
+	assert.Equal(t, map[string]int64{"le_1ms": 1, "le_10ms": 2, "le_inf": 3}, published.Buckets)
+}
+
+func TestAlarm_NotifiesHooks(t *testing.T) {
+	var raised []string
+	OnAlarm(func(name, detail string) {
+		raised = append(raised, name+": "+detail)
+	})
+
+	alarm := NewAlarm("test_alarm")
+	assert.Zero(t, alarm.Value())
+
+	alarm.Raise("tier %s sold %d of %d", "tier-1", 11, 10)
+	assert.Equal(t, int64(1), alarm.Value())
+	assert.Equal(t, []string{"test_alarm: tier tier-1 sold 11 of 10"}, raised)
+}
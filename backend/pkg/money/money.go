@@ -0,0 +1,285 @@
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Scale is the number of minor units per major unit
+// Matches DECIMAL(12,2) columns used for all amounts in the database
+const Scale = 100
+
+var (
+	ErrInvalidAmount = errors.New("invalid money amount")
+)
+
+// Money represents a monetary amount in integer minor units (1/100 of the currency unit)
+// Using integers avoids float rounding errors in fee calculation and amount comparison
+// JSON and database representations stay in major units (e.g. 107500.50) for compatibility
+type Money int64
+
+// New creates Money from whole major units (e.g. New(2500) is Rp 2.500)
+func New(major int64) Money {
+	return Money(major * Scale)
+}
+
+// FromMinor creates Money from minor units
+func FromMinor(minor int64) Money {
+	return Money(minor)
+}
+
+// FromFloat converts float major units to Money, rounding to the nearest minor unit
+// Only use at boundaries that still carry floats (protobuf double, third-party APIs)
+func FromFloat(f float64) Money {
+	return Money(math.Round(f * Scale))
+}
+
+// Parse parses a decimal string in major units (e.g. "107500", "107500.5", "-10.25")
+// Parsing is exact, more than 2 fractional digits is rejected
+func Parse(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, ErrInvalidAmount
+	}
+
+	negative := false
+	if s[0] == '-' || s[0] == '+' {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+
+	intPart, fracPart, hasFrac := strings.Cut(s, ".")
+	if intPart == "" && (!hasFrac || fracPart == "") {
+		return 0, ErrInvalidAmount
+	}
+	// strconv.ParseInt accepts a sign of its own, the sign was already consumed above
+	if !isDigits(intPart) || !isDigits(fracPart) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	if len(fracPart) > 2 {
+		// Allow trailing zeros beyond scale (e.g. "10.500" from NUMERIC columns with larger scale)
+		if strings.TrimRight(fracPart[2:], "0") != "" {
+			return 0, fmt.Errorf("%w: too many decimal places in %q", ErrInvalidAmount, s)
+		}
+		fracPart = fracPart[:2]
+	}
+	for len(fracPart) < 2 {
+		fracPart += "0"
+	}
+
+	if intPart == "" {
+		intPart = "0"
+	}
+
+	major, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	minor, err := strconv.ParseInt(fracPart, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+
+	m := Money(major*Scale + minor)
+	if negative {
+		m = -m
+	}
+	return m, nil
+}
+
+// isDigits reports whether s only holds ASCII digits
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Minor returns amount in minor units
+func (m Money) Minor() int64 {
+	return int64(m)
+}
+
+// Major returns amount rounded half away from zero to whole major units
+func (m Money) Major() int64 {
+	return int64(m.MulRatio(1, Scale))
+}
+
+// Float64 returns amount in major units as float
+// Only use at boundaries that still carry floats (protobuf double, display)
+func (m Money) Float64() float64 {
+	return float64(m) / Scale
+}
+
+// Add returns m + other
+func (m Money) Add(other Money) Money {
+	return m + other
+}
+
+// Sub returns m - other
+func (m Money) Sub(other Money) Money {
+	return m - other
+}
+
+// Mul returns m multiplied by an integer quantity
+func (m Money) Mul(quantity int) Money {
+	return m * Money(quantity)
+}
+
+// Percent returns pct percent of m, rounded half away from zero to the nearest minor unit
+func (m Money) Percent(pct int64) Money {
+	return m.MulRatio(pct, 100)
+}
+
+// MulRatio returns m * num / den, rounded half away from zero to the nearest minor unit
+func (m Money) MulRatio(num, den int64) Money {
+	product := int64(m) * num
+	quotient := product / den
+	remainder := product % den
+	if remainder < 0 {
+		remainder = -remainder
+	}
+	if remainder*2 >= abs(den) {
+		if (product < 0) != (den < 0) {
+			quotient--
+		} else {
+			quotient++
+		}
+	}
+	return Money(quotient)
+}
+
+// Abs returns absolute value of m
+func (m Money) Abs() Money {
+	if m < 0 {
+		return -m
+	}
+	return m
+}
+
+// IsZero reports whether m is zero
+func (m Money) IsZero() bool {
+	return m == 0
+}
+
+// IsNegative reports whether m is below zero
+func (m Money) IsNegative() bool {
+	return m < 0
+}
+
+// String returns amount in major units with two decimal places (e.g. "107500.50")
+func (m Money) String() string {
+	sign := ""
+	v := int64(m)
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, v/Scale, v%Scale)
+}
+
+// compactString returns major units without trailing fractional zeros (e.g. "107500", "10.5")
+func (m Money) compactString() string {
+	s := m.String()
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// MarshalJSON encodes Money as a JSON number in major units
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.compactString()), nil
+}
+
+// UnmarshalJSON decodes Money from a JSON number or numeric string in major units
+func (m *Money) UnmarshalJSON(data []byte) error {
+	s := strings.TrimSpace(string(data))
+	if s == "null" {
+		return nil
+	}
+
+	var str string
+	if len(s) > 0 && s[0] == '"' {
+		if err := json.Unmarshal(data, &str); err != nil {
+			return err
+		}
+	} else {
+		str = s
+	}
+
+	// Exponent notation (e.g. 1e5) is valid JSON, fall back to float conversion
+	if strings.ContainsAny(str, "eE") {
+		f, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return fmt.Errorf("%w: %q", ErrInvalidAmount, str)
+		}
+		*m = FromFloat(f)
+		return nil
+	}
+
+	parsed, err := Parse(str)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// UnmarshalParam decodes Money from a query or form value in major units (gin BindUnmarshaler)
+func (m *Money) UnmarshalParam(param string) error {
+	parsed, err := Parse(param)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// Scan implements sql.Scanner for DECIMAL/NUMERIC columns
+func (m *Money) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*m = 0
+		return nil
+	case []byte:
+		parsed, err := Parse(string(v))
+		if err != nil {
+			return err
+		}
+		*m = parsed
+		return nil
+	case string:
+		parsed, err := Parse(v)
+		if err != nil {
+			return err
+		}
+		*m = parsed
+		return nil
+	case int64:
+		*m = New(v)
+		return nil
+	case float64:
+		*m = FromFloat(v)
+		return nil
+	default:
+		return fmt.Errorf("%w: cannot scan %T into Money", ErrInvalidAmount, src)
+	}
+}
+
+// Value implements driver.Valuer, stored as exact decimal string in major units
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
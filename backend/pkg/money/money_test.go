@@ -0,0 +1,133 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestParse tests exact decimal parsing from database and JSON representations
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Money
+		wantErr bool
+	}{
+		{"107500", 10750000, false},
+		{"107500.00", 10750000, false},
+		{"107500.5", 10750050, false},
+		{"0.01", 1, false},
+		{".5", 50, false},
+		{"-10.25", -1025, false},
+		{"10.500", 1050, false},
+		{"10.555", 0, true},
+		{"", 0, true},
+		{"abc", 0, true},
+		{"1.-5", 0, true},
+		{"1.+5", 0, true},
+		{"--5", 0, true},
+		{"+-5", 0, true},
+		{"1 .5", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := Parse(tt.input)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("Parse(%q) expected error, got %v", tt.input, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Parse(%q) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Parse(%q) = %d, want %d", tt.input, got, tt.want)
+		}
+	}
+}
+
+// TestFeeCalculation tests that fee math has no float rounding drift
+func TestFeeCalculation(t *testing.T) {
+	// 0.1 + 0.2 style drift must not occur
+	if FromFloat(0.1).Add(FromFloat(0.2)) != FromFloat(0.3) {
+		t.Fatalf("0.10 + 0.20 should equal 0.30 exactly")
+	}
+
+	subtotal := New(150000).Mul(3)
+	platformFee := subtotal.Percent(5)
+	grandTotal := subtotal.Add(platformFee).Add(New(2500))
+
+	if platformFee != New(22500) {
+		t.Errorf("platform fee = %s, want 22500.00", platformFee)
+	}
+	if grandTotal != New(475000) {
+		t.Errorf("grand total = %s, want 475000.00", grandTotal)
+	}
+
+	// Half away from zero rounding on minor units
+	if got := FromMinor(5).MulRatio(1, 2); got != FromMinor(3) {
+		t.Errorf("0.05 / 2 = %d minor, want 3", got)
+	}
+	if got := FromMinor(-5).MulRatio(1, 2); got != FromMinor(-3) {
+		t.Errorf("-0.05 / 2 = %d minor, want -3", got)
+	}
+}
+
+// TestJSONRoundTrip tests that JSON stays a number in major units
+func TestJSONRoundTrip(t *testing.T) {
+	type payload struct {
+		Amount Money  `json:"amount"`
+		Price  *Money `json:"price,omitempty"`
+	}
+
+	price := FromMinor(1050)
+	data, err := json.Marshal(payload{Amount: New(107500), Price: &price})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `{"amount":107500,"price":10.5}` {
+		t.Errorf("Marshal = %s", data)
+	}
+
+	var decoded payload
+	if err := json.Unmarshal([]byte(`{"amount":107500.25,"price":"10.50"}`), &decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if decoded.Amount != FromMinor(10750025) || decoded.Price == nil || *decoded.Price != price {
+		t.Errorf("Unmarshal = %+v", decoded)
+	}
+}
+
+// TestUnmarshalParam tests query parameter binding in major units
+func TestUnmarshalParam(t *testing.T) {
+	var m Money
+	if err := m.UnmarshalParam("100000"); err != nil {
+		t.Fatalf("UnmarshalParam failed: %v", err)
+	}
+	if m != New(100000) {
+		t.Errorf("UnmarshalParam = %d, want %d", m, New(100000))
+	}
+	if err := m.UnmarshalParam("abc"); err == nil {
+		t.Errorf("UnmarshalParam(abc) expected error")
+	}
+}
+
+// TestScanValue tests database round trip for DECIMAL columns
+func TestScanValue(t *testing.T) {
+	var m Money
+	if err := m.Scan([]byte("2500.00")); err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if m != New(2500) {
+		t.Errorf("Scan = %d, want %d", m, New(2500))
+	}
+
+	v, err := m.Value()
+	if err != nil {
+		t.Fatalf("Value failed: %v", err)
+	}
+	if v != "2500.00" {
+		t.Errorf("Value = %v, want 2500.00", v)
+	}
+}
@@ -0,0 +1,153 @@
+package pricing
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// Dynamic pricing limits
+const (
+	MaxPriceSteps       = 10
+	MinAdjustPercent    = -90  // Price never drops below 10% of the base price through steps
+	MaxAdjustPercent    = 500  // Per step
+	MaxTimeStepHours    = 8760 // One year before the event
+	maxAvailabilityStep = 100
+)
+
+var ErrInvalidDynamicRule = errors.New("invalid dynamic pricing rule")
+
+// PriceStep adjusts the base price once its threshold is reached
+// Negative AdjustPercent lowers the price (decay), positive raises it (surge)
+type PriceStep struct {
+	Threshold     int   `json:"threshold"`
+	AdjustPercent int64 `json:"adjust_percent"`
+}
+
+// DynamicRule is an organizer's rule-based pricing of a ticket tier
+// The step reached on each dimension applies; their adjustments add up and the result is
+// kept within MinPrice and MaxPrice
+type DynamicRule struct {
+	AvailabilitySteps []PriceStep  `json:"availability_steps,omitempty"` // Threshold: percent of quota sold
+	TimeSteps         []PriceStep  `json:"time_steps,omitempty"`         // Threshold: hours before event start
+	MinPrice          *money.Money `json:"min_price,omitempty"`
+	MaxPrice          *money.Money `json:"max_price,omitempty"`
+}
+
+// Validate checks the rule has steps within limits
+func (r *DynamicRule) Validate() error {
+	if len(r.AvailabilitySteps) == 0 && len(r.TimeSteps) == 0 {
+		return fmt.Errorf("%w: at least one step is required", ErrInvalidDynamicRule)
+	}
+	if err := validateSteps("availability", r.AvailabilitySteps, 1, maxAvailabilityStep); err != nil {
+		return err
+	}
+	if err := validateSteps("time", r.TimeSteps, 1, MaxTimeStepHours); err != nil {
+		return err
+	}
+	if r.MinPrice != nil && r.MinPrice.IsNegative() {
+		return fmt.Errorf("%w: min_price can't be negative", ErrInvalidDynamicRule)
+	}
+	if r.MinPrice != nil && r.MaxPrice != nil && *r.MinPrice > *r.MaxPrice {
+		return fmt.Errorf("%w: min_price is above max_price", ErrInvalidDynamicRule)
+	}
+	return nil
+}
+
+// validateSteps checks step count, threshold range, uniqueness and adjustment range
+func validateSteps(dimension string, steps []PriceStep, minThreshold, maxThreshold int) error {
+	if len(steps) > MaxPriceSteps {
+		return fmt.Errorf("%w: at most %d %s steps allowed", ErrInvalidDynamicRule, MaxPriceSteps, dimension)
+	}
+
+	seen := make(map[int]bool, len(steps))
+	for _, step := range steps {
+		if step.Threshold < minThreshold || step.Threshold > maxThreshold {
+			return fmt.Errorf("%w: %s threshold must be %d-%d", ErrInvalidDynamicRule, dimension, minThreshold, maxThreshold)
+		}
+		if seen[step.Threshold] {
+			return fmt.Errorf("%w: duplicate %s threshold %d", ErrInvalidDynamicRule, dimension, step.Threshold)
+		}
+		seen[step.Threshold] = true
+
+		if step.AdjustPercent < MinAdjustPercent || step.AdjustPercent > MaxAdjustPercent {
+			return fmt.Errorf("%w: adjust_percent must be %d-%d", ErrInvalidDynamicRule, MinAdjustPercent, MaxAdjustPercent)
+		}
+	}
+	return nil
+}
+
+// Price returns the price of a tier under the rule
+// Availability steps apply from the highest threshold the sold percentage has reached,
+// time steps from the lowest threshold the event start is within. Prices are rounded to
+// whole major units so buyers never see fractions of a rupiah.
+func (r *DynamicRule) Price(base money.Money, soldCount, quota int, untilStart time.Duration) money.Money {
+	var adjust int64
+
+	if quota > 0 {
+		soldPercent := soldCount * 100 / quota
+		if step, ok := reachedStep(r.AvailabilitySteps, func(s PriceStep) bool { return soldPercent >= s.Threshold }, true); ok {
+			adjust += step.AdjustPercent
+		}
+	}
+
+	if step, ok := reachedStep(r.TimeSteps, func(s PriceStep) bool { return untilStart <= time.Duration(s.Threshold)*time.Hour }, false); ok {
+		adjust += step.AdjustPercent
+	}
+
+	price := RoundWholeUnit.percent(base, 100+adjust)
+	if r.MinPrice != nil && price < *r.MinPrice {
+		price = *r.MinPrice
+	}
+	if r.MaxPrice != nil && price > *r.MaxPrice {
+		price = *r.MaxPrice
+	}
+	if price.IsNegative() {
+		price = 0
+	}
+	return price
+}
+
+// reachedStep returns the reached step with the highest (or lowest) threshold
+func reachedStep(steps []PriceStep, reached func(PriceStep) bool, highest bool) (PriceStep, bool) {
+	sorted := append([]PriceStep(nil), steps...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if highest {
+			return sorted[i].Threshold > sorted[j].Threshold
+		}
+		return sorted[i].Threshold < sorted[j].Threshold
+	})
+
+	for _, step := range sorted {
+		if reached(step) {
+			return step, true
+		}
+	}
+	return PriceStep{}, false
+}
+
+// Value implements driver.Valuer, rules are stored as JSONB
+func (r DynamicRule) Value() (driver.Value, error) {
+	encoded, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	return string(encoded), nil
+}
+
+// Scan implements sql.Scanner
+func (r *DynamicRule) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, r)
+	case string:
+		return json.Unmarshal([]byte(v), r)
+	default:
+		return fmt.Errorf("cannot scan %T into DynamicRule", src)
+	}
+}
@@ -0,0 +1,105 @@
+package pricing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamicRule_Price(t *testing.T) {
+	base := money.New(100000)
+	farAway := 30 * 24 * time.Hour
+	floor, ceiling := money.New(80000), money.New(120000)
+
+	rule := DynamicRule{
+		AvailabilitySteps: []PriceStep{{Threshold: 50, AdjustPercent: 10}, {Threshold: 80, AdjustPercent: 25}},
+		TimeSteps:         []PriceStep{{Threshold: 72, AdjustPercent: 5}, {Threshold: 24, AdjustPercent: -20}},
+	}
+
+	tests := []struct {
+		name       string
+		rule       DynamicRule
+		sold       int
+		untilStart time.Duration
+		want       money.Money
+	}{
+		{"no step reached", rule, 10, farAway, base},
+		{"availability step", rule, 50, farAway, money.New(110000)},
+		{"highest availability step wins", rule, 95, farAway, money.New(125000)},
+		{"time step", rule, 0, 48 * time.Hour, money.New(105000)},
+		{"closest time step wins", rule, 0, 12 * time.Hour, money.New(80000)},
+		{"steps add up", rule, 85, 48 * time.Hour, money.New(130000)},
+		{"clamped to max price", DynamicRule{AvailabilitySteps: rule.AvailabilitySteps, MaxPrice: &ceiling}, 90, farAway, ceiling},
+		{"clamped to min price", DynamicRule{TimeSteps: rule.TimeSteps, MinPrice: &floor}, 0, time.Hour, money.New(80000)},
+		{"decay below min price", DynamicRule{TimeSteps: []PriceStep{{Threshold: 24, AdjustPercent: -50}}, MinPrice: &floor}, 0, time.Hour, floor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.rule.Price(base, tt.sold, 100, tt.untilStart))
+		})
+	}
+}
+
+func TestDynamicRule_PriceRoundsToWholeUnit(t *testing.T) {
+	rule := DynamicRule{AvailabilitySteps: []PriceStep{{Threshold: 10, AdjustPercent: 7}}}
+
+	assert.Equal(t, money.New(35685), rule.Price(money.New(33350), 50, 100, time.Hour)) // 35684.50
+}
+
+func TestDynamicRule_PriceZeroQuota(t *testing.T) {
+	rule := DynamicRule{AvailabilitySteps: []PriceStep{{Threshold: 10, AdjustPercent: 50}}}
+
+	assert.Equal(t, money.New(1000), rule.Price(money.New(1000), 0, 0, time.Hour))
+}
+
+func TestDynamicRule_Validate(t *testing.T) {
+	low, high := money.New(1000), money.New(500)
+	negative := money.New(-1)
+	tooMany := make([]PriceStep, MaxPriceSteps+1)
+	for i := range tooMany {
+		tooMany[i] = PriceStep{Threshold: i + 1, AdjustPercent: 1}
+	}
+
+	valid := DynamicRule{AvailabilitySteps: []PriceStep{{Threshold: 50, AdjustPercent: 10}}}
+	require.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name string
+		rule DynamicRule
+	}{
+		{"no steps", DynamicRule{}},
+		{"too many steps", DynamicRule{TimeSteps: tooMany}},
+		{"availability above 100 percent", DynamicRule{AvailabilitySteps: []PriceStep{{Threshold: 101, AdjustPercent: 10}}}},
+		{"zero threshold", DynamicRule{TimeSteps: []PriceStep{{Threshold: 0, AdjustPercent: 10}}}},
+		{"duplicate threshold", DynamicRule{TimeSteps: []PriceStep{{Threshold: 24, AdjustPercent: 10}, {Threshold: 24, AdjustPercent: 20}}}},
+		{"adjustment too low", DynamicRule{TimeSteps: []PriceStep{{Threshold: 24, AdjustPercent: -95}}}},
+		{"adjustment too high", DynamicRule{AvailabilitySteps: []PriceStep{{Threshold: 50, AdjustPercent: 501}}}},
+		{"negative min price", DynamicRule{AvailabilitySteps: valid.AvailabilitySteps, MinPrice: &negative}},
+		{"min above max", DynamicRule{AvailabilitySteps: valid.AvailabilitySteps, MinPrice: &low, MaxPrice: &high}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.rule.Validate(), ErrInvalidDynamicRule)
+		})
+	}
+}
+
+func TestDynamicRule_ScanValue(t *testing.T) {
+	ceiling := money.New(150000)
+	rule := DynamicRule{
+		AvailabilitySteps: []PriceStep{{Threshold: 80, AdjustPercent: 25}},
+		MaxPrice:          &ceiling,
+	}
+
+	stored, err := rule.Value()
+	require.NoError(t, err)
+
+	var scanned DynamicRule
+	require.NoError(t, scanned.Scan([]byte(stored.(string))))
+	assert.Equal(t, rule, scanned)
+}
@@ -0,0 +1,134 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+var (
+	ErrNoLines         = errors.New("order has no lines")
+	ErrInvalidQuantity = errors.New("line quantity must be positive")
+	ErrNegativeAmount  = errors.New("price, fee or discount can't be negative")
+)
+
+// Rounding decides the precision of computed amounts (percentages of fees, discounts and taxes)
+// Unit prices and flat amounts are used as given
+type Rounding int
+
+const (
+	RoundMinorUnit Rounding = iota // Nearest minor unit, half away from zero (default)
+	RoundWholeUnit                 // Nearest whole major unit, for currencies without minor units like IDR
+)
+
+// percent returns pct percent of m rounded under the rule
+func (r Rounding) percent(m money.Money, pct int64) money.Money {
+	if r == RoundWholeUnit {
+		return money.New(int64(m.MulRatio(pct, 100*money.Scale)))
+	}
+	return m.Percent(pct)
+}
+
+// Line is a priced item of an order
+type Line struct {
+	Ref       string // Caller reference, e.g. ticket tier ID
+	Name      string
+	UnitPrice money.Money
+	Quantity  int
+	AddOn     bool // Sold at cost, e.g. a partner's insurance premium: no discount, fees or tax
+}
+
+// Subtotal returns the price of all units of the line
+func (l Line) Subtotal() money.Money {
+	return l.UnitPrice.Mul(l.Quantity)
+}
+
+// Discount reduces the subtotal before fees and taxes
+// Percent and Amount may be combined; both are taken off the undiscounted subtotal
+type Discount struct {
+	Code    string
+	Percent int64
+	Amount  money.Money
+}
+
+// Policy holds the fee, tax and rounding rules an order is priced under
+type Policy struct {
+	PlatformFeePercent int64       // Of the discounted subtotal
+	ServiceFee         money.Money // Flat per order
+	TaxPercent         int64       // Of the discounted subtotal plus fees
+	Rounding           Rounding
+}
+
+// Breakdown is a priced order
+type Breakdown struct {
+	Lines       []Line
+	Subtotal    money.Money // Sum of line subtotals, add-ons excluded
+	Discount    money.Money // Never more than Subtotal
+	PlatformFee money.Money
+	ServiceFee  money.Money
+	Tax         money.Money
+	AddOns      money.Money // Sum of add-on line subtotals
+	GrandTotal  money.Money // Amount the buyer pays
+}
+
+// Calculate prices lines under a policy and optional discounts
+// Every order amount is computed here so quotes, reservations, invoices and payment
+// verification can't drift apart
+func Calculate(lines []Line, policy Policy, discounts ...Discount) (*Breakdown, error) {
+	if len(lines) == 0 {
+		return nil, ErrNoLines
+	}
+	if policy.PlatformFeePercent < 0 || policy.ServiceFee.IsNegative() || policy.TaxPercent < 0 {
+		return nil, ErrNegativeAmount
+	}
+
+	var subtotal, addOns money.Money
+	for _, line := range lines {
+		if line.Quantity <= 0 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidQuantity, line.Ref)
+		}
+		if line.UnitPrice.IsNegative() {
+			return nil, fmt.Errorf("%w: price of %s", ErrNegativeAmount, line.Ref)
+		}
+		if line.AddOn {
+			addOns = addOns.Add(line.Subtotal())
+			continue
+		}
+		subtotal = subtotal.Add(line.Subtotal())
+	}
+
+	var discount money.Money
+	for _, d := range discounts {
+		if d.Percent < 0 || d.Amount.IsNegative() {
+			return nil, fmt.Errorf("%w: discount %s", ErrNegativeAmount, d.Code)
+		}
+		discount = discount.Add(policy.Rounding.percent(subtotal, d.Percent)).Add(d.Amount)
+	}
+	if discount > subtotal {
+		discount = subtotal
+	}
+
+	discounted := subtotal.Sub(discount)
+	platformFee := policy.Rounding.percent(discounted, policy.PlatformFeePercent)
+	serviceFee := policy.ServiceFee
+	tax := policy.Rounding.percent(discounted.Add(platformFee).Add(serviceFee), policy.TaxPercent)
+
+	return &Breakdown{
+		Lines:       lines,
+		Subtotal:    subtotal,
+		Discount:    discount,
+		PlatformFee: platformFee,
+		ServiceFee:  serviceFee,
+		Tax:         tax,
+		AddOns:      addOns,
+		GrandTotal:  discounted.Add(platformFee).Add(serviceFee).Add(tax).Add(addOns),
+	}, nil
+}
+
+// Reconcile compares a paid amount with the expected grand total
+// Returns the discrepancy (paid - expected) and whether it's within tolerance, e.g. provider rounding
+func Reconcile(expected, paid, tolerance money.Money) (discrepancy money.Money, ok bool) {
+	discrepancy = paid.Sub(expected)
+	return discrepancy, discrepancy.Abs() <= tolerance
+}
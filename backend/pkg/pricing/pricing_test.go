@@ -0,0 +1,192 @@
+package pricing
+
+import (
+	"testing"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculate(t *testing.T) {
+	vip := Line{Ref: "tier-vip", Name: "VIP", UnitPrice: money.New(500000), Quantity: 2}
+	regular := Line{Ref: "tier-reg", Name: "Regular", UnitPrice: money.New(150000), Quantity: 3}
+	insurance := Line{Ref: "insurance", Name: "Refund protection", UnitPrice: money.New(30000), Quantity: 1, AddOn: true}
+
+	tests := []struct {
+		name      string
+		lines     []Line
+		policy    Policy
+		discounts []Discount
+		want      Breakdown
+	}{
+		{
+			name:   "no fees",
+			lines:  []Line{vip},
+			policy: Policy{},
+			want:   Breakdown{Subtotal: money.New(1000000), GrandTotal: money.New(1000000)},
+		},
+		{
+			name:   "platform and service fee",
+			lines:  []Line{vip, regular},
+			policy: Policy{PlatformFeePercent: 3, ServiceFee: money.New(1000)},
+			want: Breakdown{
+				Subtotal:    money.New(1450000),
+				PlatformFee: money.New(43500),
+				ServiceFee:  money.New(1000),
+				GrandTotal:  money.New(1494500),
+			},
+		},
+		{
+			name:      "discounts come off before fees",
+			lines:     []Line{vip},
+			policy:    Policy{PlatformFeePercent: 5},
+			discounts: []Discount{{Code: "EARLY", Percent: 10}, {Code: "FRIEND", Amount: money.New(50000)}},
+			want: Breakdown{
+				Subtotal:    money.New(1000000),
+				Discount:    money.New(150000),
+				PlatformFee: money.New(42500),
+				GrandTotal:  money.New(892500),
+			},
+		},
+		{
+			name:      "discount capped at subtotal",
+			lines:     []Line{regular},
+			policy:    Policy{PlatformFeePercent: 3, ServiceFee: money.New(1000)},
+			discounts: []Discount{{Code: "FREE", Amount: money.New(1000000)}},
+			want: Breakdown{
+				Subtotal:   money.New(450000),
+				Discount:   money.New(450000),
+				ServiceFee: money.New(1000),
+				GrandTotal: money.New(1000),
+			},
+		},
+		{
+			name:   "tax on subtotal and fees",
+			lines:  []Line{vip},
+			policy: Policy{PlatformFeePercent: 2, ServiceFee: money.New(5000), TaxPercent: 11},
+			want: Breakdown{
+				Subtotal:    money.New(1000000),
+				PlatformFee: money.New(20000),
+				ServiceFee:  money.New(5000),
+				Tax:         money.New(112750),
+				GrandTotal:  money.New(1137750),
+			},
+		},
+		{
+			name:      "add-ons are not discounted, charged fees or taxed",
+			lines:     []Line{vip, insurance},
+			policy:    Policy{PlatformFeePercent: 3, TaxPercent: 11},
+			discounts: []Discount{{Code: "EARLY", Percent: 10}},
+			want: Breakdown{
+				Subtotal:    money.New(1000000),
+				Discount:    money.New(100000),
+				PlatformFee: money.New(27000),
+				Tax:         money.New(101970),
+				AddOns:      money.New(30000),
+				GrandTotal:  money.New(1058970),
+			},
+		},
+		{
+			name:   "minor unit rounding",
+			lines:  []Line{{Ref: "tier-1", UnitPrice: money.FromMinor(3333), Quantity: 1}},
+			policy: Policy{PlatformFeePercent: 3},
+			want: Breakdown{
+				Subtotal:    money.FromMinor(3333),
+				PlatformFee: money.FromMinor(100), // 99.99 rounds up
+				GrandTotal:  money.FromMinor(3433),
+			},
+		},
+		{
+			name:   "whole unit rounding",
+			lines:  []Line{{Ref: "tier-1", UnitPrice: money.New(33350), Quantity: 1}},
+			policy: Policy{PlatformFeePercent: 3, TaxPercent: 11, Rounding: RoundWholeUnit},
+			want: Breakdown{
+				Subtotal:    money.New(33350),
+				PlatformFee: money.New(1001), // 1000.50 rounds half away from zero
+				Tax:         money.New(3779), // 3778.61
+				GrandTotal:  money.New(38130),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Calculate(tt.lines, tt.policy, tt.discounts...)
+			require.NoError(t, err)
+
+			tt.want.Lines = tt.lines
+			assert.Equal(t, tt.want, *got)
+		})
+	}
+}
+
+func TestCalculate_GrandTotalAddsUp(t *testing.T) {
+	lines := []Line{
+		{Ref: "a", UnitPrice: money.FromMinor(12345), Quantity: 7},
+		{Ref: "b", UnitPrice: money.FromMinor(99), Quantity: 13},
+		{Ref: "c", UnitPrice: money.FromMinor(4321), Quantity: 1, AddOn: true},
+	}
+	policy := Policy{PlatformFeePercent: 7, ServiceFee: money.FromMinor(250), TaxPercent: 11}
+
+	for _, rounding := range []Rounding{RoundMinorUnit, RoundWholeUnit} {
+		policy.Rounding = rounding
+		got, err := Calculate(lines, policy, Discount{Percent: 15})
+		require.NoError(t, err)
+
+		sum := got.Subtotal.Sub(got.Discount).Add(got.PlatformFee).Add(got.ServiceFee).Add(got.Tax).Add(got.AddOns)
+		assert.Equal(t, sum, got.GrandTotal)
+	}
+}
+
+func TestCalculate_Invalid(t *testing.T) {
+	line := Line{Ref: "tier-1", UnitPrice: money.New(1000), Quantity: 1}
+
+	tests := []struct {
+		name      string
+		lines     []Line
+		policy    Policy
+		discounts []Discount
+		wantErr   error
+	}{
+		{"no lines", nil, Policy{}, nil, ErrNoLines},
+		{"zero quantity", []Line{{Ref: "tier-1", UnitPrice: money.New(1000)}}, Policy{}, nil, ErrInvalidQuantity},
+		{"negative quantity", []Line{{Ref: "tier-1", UnitPrice: money.New(1000), Quantity: -1}}, Policy{}, nil, ErrInvalidQuantity},
+		{"negative price", []Line{{Ref: "tier-1", UnitPrice: money.New(-1000), Quantity: 1}}, Policy{}, nil, ErrNegativeAmount},
+		{"negative fee percent", []Line{line}, Policy{PlatformFeePercent: -1}, nil, ErrNegativeAmount},
+		{"negative service fee", []Line{line}, Policy{ServiceFee: money.New(-1)}, nil, ErrNegativeAmount},
+		{"negative tax", []Line{line}, Policy{TaxPercent: -1}, nil, ErrNegativeAmount},
+		{"negative discount", []Line{line}, Policy{}, []Discount{{Amount: money.New(-1)}}, ErrNegativeAmount},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Calculate(tt.lines, tt.policy, tt.discounts...)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	tests := []struct {
+		name            string
+		expected, paid  money.Money
+		tolerance       money.Money
+		wantDiscrepancy money.Money
+		wantOK          bool
+	}{
+		{"exact", money.New(150000), money.New(150000), 0, 0, true},
+		{"underpaid within tolerance", money.New(150000), money.FromMinor(14999950), money.New(1), money.FromMinor(-50), true},
+		{"overpaid within tolerance", money.New(150000), money.New(150001), money.New(1), money.New(1), true},
+		{"underpaid", money.New(150000), money.New(149000), money.New(1), money.New(-1000), false},
+		{"no tolerance", money.New(150000), money.FromMinor(15000001), 0, money.FromMinor(1), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discrepancy, ok := Reconcile(tt.expected, tt.paid, tt.tolerance)
+			assert.Equal(t, tt.wantDiscrepancy, discrepancy)
+			assert.Equal(t, tt.wantOK, ok)
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package response
+
+import "net/http"
+
+// ============================================
+// Error Codes
+// ============================================
+
+// Machine-readable error codes returned in ErrorResponse.ErrorCode
+// Clients should branch on these instead of parsing messages
+const (
+	// Generic
+	CodeInvalidRequest        = "INVALID_REQUEST"
+	CodeValidationFailed      = "VALIDATION_FAILED"
+	CodeUnauthorized          = "UNAUTHORIZED"
+	CodeForbidden             = "FORBIDDEN"
+	CodeNotFound              = "NOT_FOUND"
+	CodeConflict              = "CONFLICT"
+	CodeRateLimited           = "RATE_LIMITED"
+	CodeInternal              = "INTERNAL_ERROR"
+	CodeNotImplemented        = "NOT_IMPLEMENTED"
+	CodeServiceUnavailable    = "SERVICE_UNAVAILABLE"
+	CodeMaintenanceMode       = "MAINTENANCE_MODE"
+	CodeUpstreamTimeout       = "UPSTREAM_TIMEOUT"
+	CodeVersionConflict       = "VERSION_CONFLICT"
+	CodeInvalidVersion        = "INVALID_VERSION"
+	CodeUnsupportedAPIVersion = "UNSUPPORTED_API_VERSION"
+	CodePayloadTooLarge       = "PAYLOAD_TOO_LARGE"
+	CodeUnsupportedMediaType  = "UNSUPPORTED_MEDIA_TYPE"
+	CodeTenantNotFound        = "TENANT_NOT_FOUND"
+	CodeTenantMismatch        = "TENANT_MISMATCH"
+
+	// Auth
+	CodeEmailExists        = "EMAIL_ALREADY_EXISTS"
+	CodeInvalidCredentials = "INVALID_CREDENTIALS"
+	CodeInvalidToken       = "INVALID_TOKEN"
+	CodeUserNotFound       = "USER_NOT_FOUND"
+	CodePasswordMismatch   = "PASSWORD_MISMATCH"
+	CodeUnknownRole        = "UNKNOWN_ROLE"
+	CodeUnknownPermission  = "UNKNOWN_PERMISSION"
+	CodeAdminLockout       = "ADMIN_LOCKOUT"
+
+	// Event
+	CodeEventNotFound          = "EVENT_NOT_FOUND"
+	CodeEventCompleted         = "EVENT_COMPLETED"
+	CodeEventNotOnSale         = "EVENT_NOT_ON_SALE"
+	CodeCheckInClosed          = "CHECK_IN_CLOSED"
+	CodeInvalidDateRange       = "INVALID_DATE_RANGE"
+	CodeTicketTierNotFound     = "TICKET_TIER_NOT_FOUND"
+	CodeTicketTierHasOrders    = "TICKET_TIER_HAS_ORDERS"
+	CodeQuotaBelowSoldCount    = "QUOTA_BELOW_SOLD_COUNT"
+	CodeInvalidEarlyBirdConfig = "INVALID_EARLY_BIRD_CONFIG"
+	CodeInvalidDynamicPricing  = "INVALID_DYNAMIC_PRICING"
+	CodeZoneNotFound           = "ZONE_NOT_FOUND"
+	CodeZoneCodeExists         = "ZONE_CODE_EXISTS"
+	CodeInvalidZoneCode        = "INVALID_ZONE_CODE"
+	CodeFAQNotFound            = "FAQ_NOT_FOUND"
+	CodeSessionNotFound        = "SESSION_NOT_FOUND"
+	CodeSessionOutsideEvent    = "SESSION_OUTSIDE_EVENT"
+	CodePerformerNotFound      = "PERFORMER_NOT_FOUND"
+	CodeEventAlreadyReported   = "EVENT_ALREADY_REPORTED"
+	CodeEventUnderReview       = "EVENT_UNDER_REVIEW"
+	CodeVerificationNotFound   = "VERIFICATION_NOT_FOUND"
+	CodeVerificationPending    = "VERIFICATION_PENDING"
+	CodeOrganizerVerified      = "ORGANIZER_ALREADY_VERIFIED"
+	CodeVerificationReviewed   = "VERIFICATION_ALREADY_REVIEWED"
+	CodeOrganizerNotVerified   = "ORGANIZER_NOT_VERIFIED"
+	CodeInvalidRevenueSplit    = "INVALID_REVENUE_SPLIT"
+	CodeSandboxLiveEvent       = "SANDBOX_LIVE_EVENT"
+	CodeSandboxOrdersRemain    = "SANDBOX_ORDERS_REMAIN"
+	CodeEventNotSandbox        = "EVENT_NOT_SANDBOX"
+
+	// Ticketing
+	CodeTicketTierSoldOut    = "TICKET_TIER_SOLD_OUT"
+	CodeInvalidQuantity      = "INVALID_QUANTITY"
+	CodeMaxPerOrderExceeded  = "MAX_PER_ORDER_EXCEEDED"
+	CodeLockNotAcquired      = "LOCK_NOT_ACQUIRED"
+	CodeOrderNotFound        = "ORDER_NOT_FOUND"
+	CodeOrderExpired         = "ORDER_EXPIRED"
+	CodeOrderNotReserved     = "ORDER_NOT_RESERVED"
+	CodeOrderNotCancellable  = "ORDER_NOT_CANCELLABLE"
+	CodeOrderAlreadyPaid     = "ORDER_ALREADY_PAID"
+	CodeAmountMismatch       = "PAYMENT_AMOUNT_MISMATCH"
+	CodeCurrencyMismatch     = "PAYMENT_CURRENCY_MISMATCH"
+	CodeInvalidOrderMetadata = "INVALID_ORDER_METADATA"
+	CodeOrderNotEventSourced = "ORDER_NOT_EVENT_SOURCED"
+	CodeTicketNotFound       = "TICKET_NOT_FOUND"
+	CodeTicketAlreadyUsed    = "TICKET_ALREADY_USED"
+	CodeTicketInvalid        = "TICKET_INVALID"
+	CodeShareLinkInvalid     = "SHARE_LINK_INVALID"
+	CodeShareLinkExpired     = "SHARE_LINK_EXPIRED"
+	CodeTicketVoid           = "TICKET_VOID"
+
+	// Bulk ticket reissues
+	CodeNoTicketsToReissue      = "NO_TICKETS_TO_REISSUE"
+	CodeTicketReissueInProgress = "TICKET_REISSUE_IN_PROGRESS"
+	CodeTicketReissueNotFound   = "TICKET_REISSUE_NOT_FOUND"
+
+	// Ticket scan policies
+	CodeTicketAlreadyInside    = "TICKET_ALREADY_INSIDE"
+	CodeTicketNotInside        = "TICKET_NOT_INSIDE"
+	CodeTicketAlreadyUsedToday = "TICKET_ALREADY_USED_TODAY"
+	CodeTicketNotValidToday    = "TICKET_NOT_VALID_TODAY"
+	CodeExitScanNotAllowed     = "EXIT_SCAN_NOT_ALLOWED"
+	CodeTicketZoneNotAllowed   = "TICKET_ZONE_NOT_ALLOWED"
+	CodeDynamicQRRequired      = "DYNAMIC_QR_REQUIRED"
+	CodeDynamicQRDisabled      = "DYNAMIC_QR_DISABLED"
+
+	// Badges and check-in kiosks
+	CodeKioskNotFound    = "KIOSK_NOT_FOUND"
+	CodeKioskInvalid     = "KIOSK_INVALID"
+	CodeTicketWrongEvent = "TICKET_WRONG_EVENT"
+	CodeNoBadges         = "NO_BADGES"
+	CodeTooManyBadges    = "TOO_MANY_BADGES"
+
+	// Organizer plans
+	CodePlanNotFound              = "PLAN_NOT_FOUND"
+	CodeOrganizerNotFound         = "ORGANIZER_NOT_FOUND"
+	CodeEventQuotaExceeded        = "EVENT_QUOTA_EXCEEDED"
+	CodeTicketQuotaExceeded       = "TICKET_QUOTA_EXCEEDED"
+	CodeAnnouncementQuotaExceeded = "ANNOUNCEMENT_QUOTA_EXCEEDED"
+	CodeNoAnnouncementRecipients  = "NO_ANNOUNCEMENT_RECIPIENTS"
+	CodeAPIRateLimitExceeded      = "API_RATE_LIMIT_EXCEEDED"
+
+	// Organizer email senders
+	CodeEmailSenderNotFound = "EMAIL_SENDER_NOT_FOUND"
+	CodeSenderAddressDomain = "SENDER_ADDRESS_NOT_ON_DOMAIN"
+	CodeSenderDomainTaken   = "SENDER_DOMAIN_TAKEN"
+
+	// Organizer ticket templates
+	CodeTicketTemplateNotFound = "TICKET_TEMPLATE_NOT_FOUND"
+	CodeTicketLogoUnavailable  = "TICKET_LOGO_UNAVAILABLE"
+
+	// Order issues
+	CodeIssueNotFound    = "ISSUE_NOT_FOUND"
+	CodeIssueAlreadyOpen = "ISSUE_ALREADY_OPEN"
+	CodeIssueResolved    = "ISSUE_RESOLVED"
+
+	// Refund requests
+	CodeRefundNotFound        = "REFUND_NOT_FOUND"
+	CodeRefundAlreadyRefunded = "REFUND_ALREADY_REFUNDED"
+	CodeRefundNotAllowed      = "REFUND_NOT_ALLOWED"
+	CodeRefundWindowClosed    = "REFUND_WINDOW_CLOSED"
+	CodeRefundRequested       = "REFUND_ALREADY_REQUESTED"
+
+	// Payment
+	CodePaymentNotFound       = "PAYMENT_NOT_FOUND"
+	CodePaymentAlreadyPaid    = "PAYMENT_ALREADY_PAID"
+	CodePaymentAlreadyApplied = "PAYMENT_ALREADY_APPLIED"
+	CodePaymentProviderError  = "PAYMENT_PROVIDER_ERROR"
+	CodeInvalidSignature      = "INVALID_WEBHOOK_SIGNATURE"
+	CodePaymentNotPaid        = "PAYMENT_NOT_PAID"
+	CodeConfirmationFailed    = "PAYMENT_CONFIRMATION_FAILED"
+	CodePaymentCompensated    = "PAYMENT_COMPENSATED"
+	CodeSagaNotFound          = "PAYMENT_SAGA_NOT_FOUND"
+
+	// Plan subscriptions
+	CodePlanNotBillable           = "PLAN_NOT_BILLABLE"
+	CodeSubscriptionNotFound      = "SUBSCRIPTION_NOT_FOUND"
+	CodeSubscriptionAlreadyActive = "SUBSCRIPTION_ALREADY_ACTIVE"
+	CodeSubscriptionNotActive     = "SUBSCRIPTION_NOT_ACTIVE"
+	CodeSubscriptionPastDue       = "SUBSCRIPTION_PAST_DUE"
+
+	// Ticket insurance
+	CodeInsuranceUnavailable     = "INSURANCE_UNAVAILABLE"
+	CodeInsuranceCoverageChanged = "INSURANCE_COVERAGE_CHANGED"
+	CodeInsuranceNotFound        = "INSURANCE_NOT_FOUND"
+	CodeInsuranceNotActive       = "INSURANCE_NOT_ACTIVE"
+	CodeInsuranceClaimNotAllowed = "INSURANCE_CLAIM_NOT_ALLOWED"
+	CodeInsuranceClaimRejected   = "INSURANCE_CLAIM_REJECTED"
+
+	// Accessibility accommodations
+	CodeInvalidAccommodations    = "INVALID_ACCOMMODATIONS"
+	CodeAccessibleSeatingSoldOut = "ACCESSIBLE_SEATING_SOLD_OUT"
+	CodeInvalidAccessibleQuota   = "INVALID_ACCESSIBLE_QUOTA"
+	CodeAccessibleQuotaBelowSold = "ACCESSIBLE_QUOTA_BELOW_SOLD_COUNT"
+
+	// Terms and event policies
+	CodePolicyNotFound           = "POLICY_NOT_FOUND"
+	CodeInvalidPolicyScope       = "INVALID_POLICY_SCOPE"
+	CodePolicyAcceptanceRequired = "POLICY_ACCEPTANCE_REQUIRED"
+	CodeRefundPolicyTemplate     = "REFUND_POLICY_TEMPLATE"
+
+	// Event invitations
+	CodeInvitationsClosed    = "INVITATIONS_CLOSED"
+	CodeInvalidClaimDeadline = "INVALID_CLAIM_DEADLINE"
+	CodeInvitationInvalid    = "INVITATION_INVALID"
+	CodeInvitationClaimed    = "INVITATION_CLAIMED"
+	CodeInvitationExpired    = "INVITATION_EXPIRED"
+
+	// Partner integrations
+	CodeAPIKeyNotFound = "API_KEY_NOT_FOUND"
+	CodeAPIKeyInvalid  = "API_KEY_INVALID"
+
+	// Notification preferences
+	CodeDigestsDisabled = "DIGESTS_DISABLED"
+
+	// Live check-in metrics
+	CodeCheckinMetricsDisabled = "CHECKIN_METRICS_DISABLED"
+
+	// Webhook quarantine
+	CodeWebhookNotFound       = "WEBHOOK_NOT_FOUND"
+	CodeWebhookNotQuarantined = "WEBHOOK_NOT_QUARANTINED"
+	CodeWebhookUnmatched      = "WEBHOOK_UNMATCHED"
+
+	// Ticket tier waitlists
+	CodeTierNotSoldOut        = "TICKET_TIER_NOT_SOLD_OUT"
+	CodeAlreadyOnWaitlist     = "ALREADY_ON_WAITLIST"
+	CodeWaitlistEntryNotFound = "WAITLIST_ENTRY_NOT_FOUND"
+)
+
+// CodeForStatus returns the generic error code for an HTTP status
+// Used when an upstream error carries no code of its own
+func CodeForStatus(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeInvalidRequest
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusConflict, http.StatusPreconditionFailed:
+		return CodeConflict
+	case http.StatusRequestEntityTooLarge:
+		return CodePayloadTooLarge
+	case http.StatusUnsupportedMediaType:
+		return CodeUnsupportedMediaType
+	case http.StatusTooManyRequests:
+		return CodeRateLimited
+	case http.StatusNotImplemented:
+		return CodeNotImplemented
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		return CodeServiceUnavailable
+	case http.StatusGatewayTimeout:
+		return CodeUpstreamTimeout
+	default:
+		if status >= 400 && status < 500 {
+			return CodeInvalidRequest
+		}
+		return CodeInternal
+	}
+}
@@ -0,0 +1,57 @@
+package response
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ============================================
+// gRPC Status Mapping
+// ============================================
+
+// HTTPStatusFromGRPC maps a gRPC status code onto an HTTP status and error code
+func HTTPStatusFromGRPC(code codes.Code) (int, string) {
+	switch code {
+	case codes.OK:
+		return http.StatusOK, ""
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest, CodeInvalidRequest
+	case codes.FailedPrecondition:
+		return http.StatusBadRequest, CodeValidationFailed
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized, CodeUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden, CodeForbidden
+	case codes.NotFound:
+		return http.StatusNotFound, CodeNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict, CodeConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests, CodeRateLimited
+	case codes.Unimplemented:
+		return http.StatusNotImplemented, CodeNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable, CodeServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout, CodeUpstreamTimeout
+	case codes.Canceled:
+		// Client closed request (nginx convention), no net/http constant
+		return 499, CodeInvalidRequest
+	default:
+		return http.StatusInternalServerError, CodeInternal
+	}
+}
+
+// FromGRPCError converts a gRPC error into HTTP status and error envelope
+// Non-gRPC errors are treated as internal errors
+func FromGRPCError(err error) (int, ErrorResponse) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return http.StatusInternalServerError, ErrorWithCode("Internal server error", CodeInternal, err.Error())
+	}
+
+	httpStatus, code := HTTPStatusFromGRPC(st.Code())
+	return httpStatus, ErrorWithCode(st.Message(), code, nil)
+}
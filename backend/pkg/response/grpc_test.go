@@ -0,0 +1,54 @@
+package response
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestHTTPStatusFromGRPC tests gRPC status codes map onto HTTP status and error codes
+func TestHTTPStatusFromGRPC(t *testing.T) {
+	tests := []struct {
+		code       codes.Code
+		wantStatus int
+		wantCode   string
+	}{
+		{codes.InvalidArgument, http.StatusBadRequest, CodeInvalidRequest},
+		{codes.FailedPrecondition, http.StatusBadRequest, CodeValidationFailed},
+		{codes.Unauthenticated, http.StatusUnauthorized, CodeUnauthorized},
+		{codes.PermissionDenied, http.StatusForbidden, CodeForbidden},
+		{codes.NotFound, http.StatusNotFound, CodeNotFound},
+		{codes.AlreadyExists, http.StatusConflict, CodeConflict},
+		{codes.ResourceExhausted, http.StatusTooManyRequests, CodeRateLimited},
+		{codes.Unavailable, http.StatusServiceUnavailable, CodeServiceUnavailable},
+		{codes.DeadlineExceeded, http.StatusGatewayTimeout, CodeUpstreamTimeout},
+		{codes.Internal, http.StatusInternalServerError, CodeInternal},
+		{codes.Unknown, http.StatusInternalServerError, CodeInternal},
+	}
+
+	for _, tt := range tests {
+		gotStatus, gotCode := HTTPStatusFromGRPC(tt.code)
+		if gotStatus != tt.wantStatus || gotCode != tt.wantCode {
+			t.Errorf("HTTPStatusFromGRPC(%v) = (%d, %q), want (%d, %q)", tt.code, gotStatus, gotCode, tt.wantStatus, tt.wantCode)
+		}
+	}
+}
+
+// TestFromGRPCError tests gRPC errors are converted into the shared error envelope
+func TestFromGRPCError(t *testing.T) {
+	httpStatus, resp := FromGRPCError(status.Error(codes.NotFound, "order not found"))
+	if httpStatus != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", httpStatus, http.StatusNotFound)
+	}
+	if resp.Status || resp.Message != "order not found" || resp.ErrorCode != CodeNotFound {
+		t.Errorf("unexpected envelope: %+v", resp)
+	}
+
+	httpStatus, resp = FromGRPCError(errors.New("boom"))
+	if httpStatus != http.StatusInternalServerError || resp.ErrorCode != CodeInternal {
+		t.Errorf("non-gRPC error = (%d, %q), want (%d, %q)", httpStatus, resp.ErrorCode, http.StatusInternalServerError, CodeInternal)
+	}
+}
@@ -56,6 +56,7 @@ type ErrorResponse struct {
 	Message   string      `json:"message"`
 	Errors    interface{} `json:"errors,omitempty"`
 	ErrorCode string      `json:"error_code,omitempty"`
+	Data      interface{} `json:"data,omitempty"` // Current resource state when useful for recovery (e.g. version conflict)
 	Timestamp time.Time   `json:"timestamp"`
 }
 
@@ -77,3 +78,13 @@ func ErrorWithCode(message string, errorCode string, errors interface{}) ErrorRe
 		Timestamp: time.Now(),
 	}
 }
+
+func ErrorWithData(message string, errorCode string, data interface{}) ErrorResponse {
+	return ErrorResponse{
+		Status:    false,
+		Message:   message,
+		ErrorCode: errorCode,
+		Data:      data,
+		Timestamp: time.Now(),
+	}
+}
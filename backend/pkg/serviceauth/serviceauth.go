@@ -0,0 +1,352 @@
+package serviceauth
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Internal gRPC calls carry a short-lived RS256 token naming the calling service.
+// Every service signs with its own private key (kid = service name), so a leaked
+// key only lets an attacker act as that one service, and the receiving service
+// checks the caller against a per-method policy.
+
+// Service names, used as token subject, audience and key ID
+const (
+	ServiceEvent        = "event-service"
+	ServiceTicketing    = "ticketing-service"
+	ServicePayment      = "payment-service"
+	ServiceNotification = "notification-service"
+)
+
+// TokenTTL is how long a service token is valid
+// Tokens are reused until half of it has passed, so at most one is signed per minute and target
+const TokenTTL = 2 * time.Minute
+
+// metadataKey carries the token as "Bearer <token>"
+const metadataKey = "authorization"
+
+// publicMethodPrefixes are served without a token: reflection and build info are debugging aids
+var publicMethodPrefixes = []string{
+	"/grpc.reflection.",
+	"/buildinfo.BuildInfoService/",
+}
+
+// Policy lists the services allowed to call each method, by full method name
+// e.g. "/ticketing.TicketingService/ConfirmPayment": {ServicePayment}
+// Methods not listed accept any authenticated service.
+type Policy map[string][]string
+
+// Identity signs the tokens a service attaches to its outgoing calls
+// A nil Identity is valid and attaches nothing
+type Identity struct {
+	service string
+	keys    *auth.KeySet
+	now     func() time.Time
+
+	mu     sync.Mutex
+	tokens map[string]cachedToken // By audience
+}
+
+type cachedToken struct {
+	token     string
+	refreshAt time.Time
+}
+
+// NewIdentity creates the identity of service, signing with its private key
+func NewIdentity(service string, private *rsa.PrivateKey) (*Identity, error) {
+	keys, err := auth.NewKeySet(service, auth.NewRSAKey(service, private))
+	if err != nil {
+		return nil, err
+	}
+	return &Identity{service: service, keys: keys, now: time.Now, tokens: make(map[string]cachedToken)}, nil
+}
+
+// IdentityFromEnv creates the identity of service from SERVICE_AUTH_PRIVATE_KEY_FILE, nil when it is not set
+//
+// Environment variables:
+//   - SERVICE_AUTH_PRIVATE_KEY_FILE: PEM private key of this service
+func IdentityFromEnv(service string) (*Identity, error) {
+	path := os.Getenv("SERVICE_AUTH_PRIVATE_KEY_FILE")
+	if path == "" {
+		return nil, nil
+	}
+
+	private, err := auth.LoadRSAPrivateKey(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewIdentity(service, private)
+}
+
+// Service returns the name of the service, empty for a nil Identity
+func (id *Identity) Service() string {
+	if id == nil {
+		return ""
+	}
+	return id.service
+}
+
+// Token returns a token for calling audience, reusing the last one while it is fresh
+func (id *Identity) Token(audience string) (string, error) {
+	id.mu.Lock()
+	defer id.mu.Unlock()
+
+	now := id.now()
+	if cached, ok := id.tokens[audience]; ok && now.Before(cached.refreshAt) {
+		return cached.token, nil
+	}
+
+	token, err := id.keys.Sign(&auth.Claims{
+		TokenType: auth.TokenTypeService,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   id.service,
+			Audience:  jwt.ClaimStrings{audience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(TokenTTL)),
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to sign service token: %w", err)
+	}
+
+	id.tokens[audience] = cachedToken{token: token, refreshAt: now.Add(TokenTTL / 2)}
+	return token, nil
+}
+
+// DialOption returns the client option attaching a token for audience to every call, a no-op for a nil Identity
+func (id *Identity) DialOption(audience string) grpc.DialOption {
+	if id == nil {
+		return grpc.EmptyDialOption{}
+	}
+	return grpc.WithPerRPCCredentials(&tokenCredentials{identity: id, audience: audience})
+}
+
+// tokenCredentials implements credentials.PerRPCCredentials, covering unary and streaming calls
+type tokenCredentials struct {
+	identity *Identity
+	audience string
+}
+
+func (c *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	token, err := c.identity.Token(c.audience)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{metadataKey: "Bearer " + token}, nil
+}
+
+// RequireTransportSecurity is false so local insecure connections carry tokens too
+func (c *tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// Verifier authenticates the callers of a service and enforces its policy
+// A nil Verifier is valid and lets every call through
+type Verifier struct {
+	service string
+	keys    *auth.KeySet
+	policy  Policy
+}
+
+// NewVerifier creates the verifier of service, trusting the public key of each calling service
+func NewVerifier(service string, publicKeys map[string]*rsa.PublicKey, policy Policy) (*Verifier, error) {
+	keys := make([]*auth.Key, 0, len(publicKeys))
+	for caller, public := range publicKeys {
+		keys = append(keys, auth.NewRSAPublicKey(caller, public))
+	}
+
+	keySet, err := auth.NewKeySet("", keys...)
+	if err != nil {
+		return nil, err
+	}
+	return &Verifier{service: service, keys: keySet, policy: policy}, nil
+}
+
+// VerifierFromEnv creates the verifier of service from SERVICE_AUTH_PUBLIC_KEYS, nil when it is not set
+//
+// Environment variables:
+//   - SERVICE_AUTH_PUBLIC_KEYS: PEM public keys of the calling services, as service=path pairs
+//     separated by commas, e.g. "payment-service=/keys/payment.pub,ticketing-service=/keys/ticketing.pub"
+func VerifierFromEnv(service string, policy Policy) (*Verifier, error) {
+	raw := os.Getenv("SERVICE_AUTH_PUBLIC_KEYS")
+	if raw == "" {
+		return nil, nil
+	}
+
+	publicKeys := make(map[string]*rsa.PublicKey)
+	for _, pair := range strings.Split(raw, ",") {
+		caller, path, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || caller == "" || path == "" {
+			return nil, fmt.Errorf("invalid SERVICE_AUTH_PUBLIC_KEYS entry %q (expected service=path)", pair)
+		}
+		if _, exists := publicKeys[caller]; exists {
+			return nil, fmt.Errorf("duplicate SERVICE_AUTH_PUBLIC_KEYS entry for %s", caller)
+		}
+
+		public, err := auth.LoadRSAPublicKey(path)
+		if err != nil {
+			return nil, err
+		}
+		publicKeys[caller] = public
+	}
+
+	return NewVerifier(service, publicKeys, policy)
+}
+
+// UnaryServerInterceptor rejects unary calls from unauthenticated or unauthorized callers
+func (v *Verifier) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := v.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects streams from unauthenticated or unauthorized callers
+func (v *Verifier) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := v.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &callerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authorize verifies the caller's token and checks it may call method
+// The caller is stored in the returned context (see Caller)
+func (v *Verifier) authorize(ctx context.Context, method string) (context.Context, error) {
+	if v == nil || isPublic(method) {
+		return ctx, nil
+	}
+
+	caller, err := v.authenticate(ctx)
+	if err != nil {
+		return ctx, status.Errorf(codes.Unauthenticated, "service authentication failed: %v", err)
+	}
+
+	if allowed, ok := v.policy[method]; ok && !slices.Contains(allowed, caller) {
+		return ctx, status.Errorf(codes.PermissionDenied, "%s may not call %s", caller, method)
+	}
+
+	return context.WithValue(ctx, callerKey{}, caller), nil
+}
+
+// authenticate verifies the token in the call metadata and returns the calling service
+func (v *Verifier) authenticate(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(metadataKey)
+	if len(values) == 0 {
+		return "", errors.New("missing service token")
+	}
+
+	token, ok := strings.CutPrefix(values[0], "Bearer ")
+	if !ok {
+		return "", errors.New("authorization metadata must be a Bearer token")
+	}
+
+	return v.verifyToken(token)
+}
+
+// verifyToken verifies a service token and returns the calling service
+func (v *Verifier) verifyToken(token string) (string, error) {
+	claims, keyID, err := v.keys.ParseWithKeyID(token)
+	if err != nil {
+		return "", err
+	}
+
+	// The key names the service that signed the token; the subject must agree with it
+	if claims.TokenType != auth.TokenTypeService || claims.Subject != keyID {
+		return "", auth.ErrInvalidToken
+	}
+	if !slices.Contains(claims.Audience, v.service) {
+		return "", fmt.Errorf("token is not issued for %s", v.service)
+	}
+
+	return keyID, nil
+}
+
+// GinMiddleware rejects HTTP requests to internal routes from unauthenticated or unauthorized callers
+// Callers send their service token as "Authorization: Bearer <token>". Routes are checked against the
+// policy as "METHOD /full/path" (e.g. "POST /api/v1/internal/payments/:id/replay"), unlisted routes accept
+// any authenticated service. Unlike gRPC, a nil Verifier rejects every request: HTTP ports are public
+func (v *Verifier) GinMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if v == nil {
+			c.JSON(http.StatusServiceUnavailable, sharedresponse.ErrorWithCode("Service authentication is not configured", sharedresponse.CodeServiceUnavailable, nil))
+			c.Abort()
+			return
+		}
+
+		token, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if !ok {
+			c.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode("Service token required", sharedresponse.CodeUnauthorized, nil))
+			c.Abort()
+			return
+		}
+
+		caller, err := v.verifyToken(token)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode("Invalid service token", sharedresponse.CodeUnauthorized, nil))
+			c.Abort()
+			return
+		}
+
+		route := c.Request.Method + " " + c.FullPath()
+		if allowed, ok := v.policy[route]; ok && !slices.Contains(allowed, caller) {
+			c.JSON(http.StatusForbidden, sharedresponse.ErrorWithCode(fmt.Sprintf("%s may not call %s", caller, route), sharedresponse.CodeForbidden, nil))
+			c.Abort()
+			return
+		}
+
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), callerKey{}, caller))
+		c.Next()
+	}
+}
+
+// isPublic checks if method is served without a token
+func isPublic(method string) bool {
+	for _, prefix := range publicMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+type callerKey struct{}
+
+// Caller returns the authenticated service that made the call, empty when service auth is disabled
+func Caller(ctx context.Context) string {
+	caller, _ := ctx.Value(callerKey{}).(string)
+	return caller
+}
+
+// callerStream carries the context holding the caller into stream handlers
+type callerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *callerStream) Context() context.Context {
+	return s.ctx
+}
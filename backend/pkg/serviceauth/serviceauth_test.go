@@ -0,0 +1,188 @@
+package serviceauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const confirmPayment = "/ticketing.TicketingService/ConfirmPayment"
+
+func newTestIdentity(t *testing.T, service string) (*Identity, *rsa.PublicKey) {
+	t.Helper()
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	identity, err := NewIdentity(service, private)
+	require.NoError(t, err)
+	return identity, &private.PublicKey
+}
+
+// call runs a unary call to method through the verifier, with the token of identity when not nil
+func call(t *testing.T, verifier *Verifier, identity *Identity, method string) (string, error) {
+	t.Helper()
+	ctx := context.Background()
+	if identity != nil {
+		token, err := identity.Token(ServiceTicketing)
+		require.NoError(t, err)
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(metadataKey, "Bearer "+token))
+	}
+
+	var caller string
+	_, err := verifier.UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			caller = Caller(ctx)
+			return nil, nil
+		})
+	return caller, err
+}
+
+func TestVerifier_EnforcesPolicy(t *testing.T) {
+	payment, paymentKey := newTestIdentity(t, ServicePayment)
+	event, eventKey := newTestIdentity(t, ServiceEvent)
+	verifier, err := NewVerifier(ServiceTicketing, map[string]*rsa.PublicKey{
+		ServicePayment: paymentKey,
+		ServiceEvent:   eventKey,
+	}, Policy{confirmPayment: {ServicePayment}})
+	require.NoError(t, err)
+
+	caller, err := call(t, verifier, payment, confirmPayment)
+	require.NoError(t, err)
+	assert.Equal(t, ServicePayment, caller)
+
+	_, err = call(t, verifier, event, confirmPayment)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Methods outside the policy accept any known service
+	caller, err = call(t, verifier, event, "/ticketing.TicketingService/Other")
+	require.NoError(t, err)
+	assert.Equal(t, ServiceEvent, caller)
+}
+
+func TestVerifier_RejectsUnauthenticated(t *testing.T) {
+	_, paymentKey := newTestIdentity(t, ServicePayment)
+	impostor, _ := newTestIdentity(t, ServicePayment) // Same name, different key
+	verifier, err := NewVerifier(ServiceTicketing, map[string]*rsa.PublicKey{ServicePayment: paymentKey}, nil)
+	require.NoError(t, err)
+
+	_, err = call(t, verifier, nil, confirmPayment)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = call(t, verifier, impostor, confirmPayment)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Build info and reflection stay open
+	_, err = call(t, verifier, nil, "/buildinfo.BuildInfoService/GetBuildInfo")
+	assert.NoError(t, err)
+}
+
+func TestVerifier_RejectsOtherAudience(t *testing.T) {
+	payment, paymentKey := newTestIdentity(t, ServicePayment)
+	verifier, err := NewVerifier(ServiceEvent, map[string]*rsa.PublicKey{ServicePayment: paymentKey}, nil)
+	require.NoError(t, err)
+
+	// The token is issued for ticketing-service, replayed against event-service
+	_, err = call(t, verifier, payment, "/event.EventService/AssignOrganizerPlan")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestVerifier_RejectsExpiredToken(t *testing.T) {
+	payment, paymentKey := newTestIdentity(t, ServicePayment)
+	payment.now = func() time.Time { return time.Now().Add(-TokenTTL - time.Minute) }
+	verifier, err := NewVerifier(ServiceTicketing, map[string]*rsa.PublicKey{ServicePayment: paymentKey}, nil)
+	require.NoError(t, err)
+
+	_, err = call(t, verifier, payment, confirmPayment)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestIdentity_ReusesFreshToken(t *testing.T) {
+	identity, _ := newTestIdentity(t, ServicePayment)
+	now := time.Now()
+	identity.now = func() time.Time { return now }
+
+	first, err := identity.Token(ServiceTicketing)
+	require.NoError(t, err)
+	second, err := identity.Token(ServiceTicketing)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	now = now.Add(TokenTTL / 2)
+	third, err := identity.Token(ServiceTicketing)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, third)
+}
+
+func TestNilIsDisabled(t *testing.T) {
+	var identity *Identity
+	assert.Equal(t, grpc.EmptyDialOption{}, identity.DialOption(ServiceTicketing))
+
+	var verifier *Verifier
+	_, err := call(t, verifier, nil, confirmPayment)
+	assert.NoError(t, err)
+}
+
+func TestVerifierFromEnv_InvalidEntry(t *testing.T) {
+	t.Setenv("SERVICE_AUTH_PUBLIC_KEYS", "payment-service")
+
+	_, err := VerifierFromEnv(ServiceTicketing, nil)
+	assert.Error(t, err)
+}
+
+func TestVerifier_GinMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	const route = "POST /internal/orders/:id/confirm"
+
+	payment, paymentKey := newTestIdentity(t, ServicePayment)
+	event, eventKey := newTestIdentity(t, ServiceEvent)
+	verifier, err := NewVerifier(ServiceTicketing, map[string]*rsa.PublicKey{
+		ServicePayment: paymentKey,
+		ServiceEvent:   eventKey,
+	}, Policy{route: {ServicePayment}})
+	require.NoError(t, err)
+
+	// serve posts to the route through the middleware, with the token of identity when not nil
+	serve := func(verifier *Verifier, identity *Identity) (int, string) {
+		var caller string
+		router := gin.New()
+		router.POST("/internal/orders/:id/confirm", verifier.GinMiddleware(), func(c *gin.Context) {
+			caller = Caller(c.Request.Context())
+			c.Status(http.StatusOK)
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/internal/orders/order-1/confirm", nil)
+		if identity != nil {
+			token, err := identity.Token(ServiceTicketing)
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code, caller
+	}
+
+	code, caller := serve(verifier, payment)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, ServicePayment, caller)
+
+	code, _ = serve(verifier, event)
+	assert.Equal(t, http.StatusForbidden, code)
+
+	code, _ = serve(verifier, nil)
+	assert.Equal(t, http.StatusUnauthorized, code)
+
+	// HTTP routes are not opened up when service auth is disabled
+	code, _ = serve(nil, payment)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+}
@@ -0,0 +1,68 @@
+package tenant
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+)
+
+// Header carries the tenant resolved by the gateway to upstream services
+const Header = "X-Tenant-ID"
+
+// DefaultID is the platform's own tenant, seeded by migration 000016
+const DefaultID = "00000000-0000-0000-0000-000000000001"
+
+// GinKey is the gin context key holding the tenant ID
+const GinKey = "tenant_id"
+
+type contextKey struct{}
+
+// Middleware reads the tenant from the Header and injects it into the gin context
+// and the request context, falling back to the default tenant when absent
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tenantID := c.GetHeader(Header)
+		if tenantID == "" {
+			tenantID = DefaultID
+		} else if _, err := uuid.Parse(tenantID); err != nil {
+			c.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode("Invalid tenant", sharedresponse.CodeInvalidRequest, nil))
+			c.Abort()
+			return
+		}
+
+		c.Set(GinKey, tenantID)
+		c.Request = c.Request.WithContext(NewContext(c.Request.Context(), tenantID))
+		c.Next()
+	}
+}
+
+// NewContext returns ctx carrying tenantID
+func NewContext(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, tenantID)
+}
+
+// FromContext returns the tenant injected into ctx
+// Internal callers (workers, gRPC) carry no tenant and are not scoped
+func FromContext(ctx context.Context) (string, bool) {
+	tenantID, ok := ctx.Value(contextKey{}).(string)
+	return tenantID, ok && tenantID != ""
+}
+
+// FromGin returns the tenant injected by Middleware, or the default tenant
+func FromGin(c *gin.Context) string {
+	if tenantID := c.GetString(GinKey); tenantID != "" {
+		return tenantID
+	}
+	return DefaultID
+}
+
+// Requested returns the tenant named by the request Header, or the default tenant
+func Requested(r *http.Request) string {
+	if tenantID := r.Header.Get(Header); tenantID != "" {
+		return tenantID
+	}
+	return DefaultID
+}
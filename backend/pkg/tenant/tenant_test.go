@@ -0,0 +1,54 @@
+package tenant
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(Middleware())
+	router.GET("/", func(c *gin.Context) {
+		fromCtx, _ := FromContext(c.Request.Context())
+		c.String(http.StatusOK, FromGin(c)+"|"+fromCtx)
+	})
+
+	tests := []struct {
+		name   string
+		header string
+		status int
+		body   string
+	}{
+		{"default when absent", "", http.StatusOK, DefaultID + "|" + DefaultID},
+		{"header tenant", "6f1c2a4e-8d0b-4c55-9a77-3e2f1b0c9d11", http.StatusOK, "6f1c2a4e-8d0b-4c55-9a77-3e2f1b0c9d11|6f1c2a4e-8d0b-4c55-9a77-3e2f1b0c9d11"},
+		{"invalid tenant", "brand", http.StatusBadRequest, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(Header, tt.header)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.status, w.Code)
+			if tt.status == http.StatusOK {
+				assert.Equal(t, tt.body, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestFromContext_Unscoped(t *testing.T) {
+	_, ok := FromContext(context.Background())
+	assert.False(t, ok)
+}
@@ -0,0 +1,32 @@
+package timeout
+
+import (
+	"context"
+	"time"
+)
+
+// Timeout policy shared by all services
+// A shorter deadline already on the context (e.g. the caller's request deadline) always wins
+const (
+	DB         = 5 * time.Second  // One repository call
+	RPC        = 10 * time.Second // One call to another service
+	Background = 30 * time.Second // Work a request hands off to a goroutine, e.g. sending an email
+)
+
+// WithDBTimeout bounds a repository call
+// Statements still running at the deadline are cancelled by the driver
+func WithDBTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, DB)
+}
+
+// WithRPCTimeout bounds a call to another service
+func WithRPCTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, RPC)
+}
+
+// Detach returns a context for work that outlives the request that started it
+// It keeps ctx's values (tenant, user, correlation ID) but not its cancellation,
+// and is bounded by the Background timeout instead of running without deadline
+func Detach(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), Background)
+}
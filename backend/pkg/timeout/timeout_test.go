@@ -0,0 +1,49 @@
+package timeout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ctxKey struct{}
+
+func TestWithDBTimeout(t *testing.T) {
+	ctx, cancel := WithDBTimeout(context.Background())
+	defer cancel()
+
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(DB), deadline, time.Second)
+}
+
+func TestWithRPCTimeout_KeepsShorterDeadline(t *testing.T) {
+	parent, cancelParent := context.WithTimeout(context.Background(), time.Second)
+	defer cancelParent()
+	parentDeadline, _ := parent.Deadline()
+
+	ctx, cancel := WithRPCTimeout(parent)
+	defer cancel()
+
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok)
+	assert.Equal(t, parentDeadline, deadline)
+}
+
+func TestDetach(t *testing.T) {
+	parent, cancelParent := context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "tenant-1"))
+
+	ctx, cancel := Detach(parent)
+	defer cancel()
+	cancelParent()
+
+	assert.NoError(t, ctx.Err(), "detached work must survive the end of the request")
+	assert.Equal(t, "tenant-1", ctx.Value(ctxKey{}))
+
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(Background), deadline, time.Second)
+}
@@ -7,7 +7,10 @@ import (
 	"time"
 
 	"github.com/joho/godotenv"
+	sharedauth "github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/buildinfo"
 	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/config"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/controller"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/repository"
@@ -28,6 +31,13 @@ func main() {
 	// Load configuration
 	cfg := config.Load()
 
+	// Error reporting: panics and alarms go to Sentry when SENTRY_DSN is set, otherwise they are only logged
+	if err := errreport.Init(errreport.ConfigFromEnv("auth-service")); err != nil {
+		log.Printf("⚠️  Warning: Error reporting disabled: %v", err)
+	}
+	defer errreport.Flush(2 * time.Second)
+	log.Printf("Build: %s", buildinfo.Get("auth-service"))
+
 	// Initialize database connection
 	db, err := utility.NewDatabase(utility.DatabaseConfig{
 		URL:             cfg.GetDatabaseURL(),
@@ -55,7 +65,7 @@ func main() {
 	}
 
 	// Initialize Redis with abstraction layer (auto-detects TCP or REST)
-	// Used for future features: token blacklist, rate limiting, session cache
+	// Used for access token revocation on logout (read by the gateway token cache)
 	redisClient, err := cache.NewRedisClient()
 	if err != nil {
 		log.Printf("⚠️  Warning: Failed to connect to Redis: %v", err)
@@ -66,8 +76,13 @@ func main() {
 		defer redisClient.Close()
 	}
 
-	// Initialize JWT utility
-	jwtUtil, err := utility.NewJWTUtil(cfg.JWTSecret, cfg.JWTExpiry, cfg.RefreshTokenExpiry)
+	// Initialize JWT keys and utility
+	jwtKeys, err := sharedauth.KeySetFromEnv(cfg.JWTSecret)
+	if err != nil {
+		log.Fatalf("Failed to load JWT keys: %v", err)
+	}
+
+	jwtUtil, err := utility.NewJWTUtil(jwtKeys, cfg.JWTExpiry, cfg.RefreshTokenExpiry)
 	if err != nil {
 		log.Fatalf("Failed to initialize JWT utility: %v", err)
 	}
@@ -79,18 +94,25 @@ func main() {
 	// 1. Initialize Repository Layer (Data Access)
 	userRepo := repository.NewUserRepository(db)
 	passwordResetRepo := repository.NewPasswordResetRepository(db)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(db)
+	rolePermRepo := repository.NewRolePermissionRepository(db)
+	tenantRepo := repository.NewTenantRepository(db)
 	log.Println("✓ Repository layer initialized")
 
 	// 2. Initialize Service Layer (Business Logic)
-	authService := service.NewAuthService(userRepo, passwordResetRepo, jwtUtil, redisClient, cfg.BcryptCost)
+	authService := service.NewAuthService(userRepo, passwordResetRepo, refreshTokenRepo, rolePermRepo, jwtUtil, redisClient, cfg.BcryptCost)
+	permissionService := service.NewPermissionService(rolePermRepo)
+	tenantService := service.NewTenantService(tenantRepo)
 	log.Println("✓ Service layer initialized")
 
 	// 3. Initialize Controller Layer (HTTP Handlers)
 	authController := controller.NewAuthController(authService)
+	permissionController := controller.NewPermissionController(permissionService)
+	tenantController := controller.NewTenantController(tenantService)
 	log.Println("✓ Controller layer initialized")
 
 	// 4. Setup Router with all routes
-	r := router.SetupRouter(authController, cfg.JWTSecret)
+	r := router.SetupRouter(authController, permissionController, tenantController, jwtKeys)
 	log.Println("✓ Router configured")
 
 	// Start HTTP server
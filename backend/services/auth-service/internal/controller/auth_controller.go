@@ -3,6 +3,7 @@ package controller
 import (
 	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 
@@ -40,7 +41,7 @@ func (c *AuthController) Register(ctx *gin.Context) {
 
 	// Bind and validate request
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, sharedresponse.Error(message.ErrInvalidRequest, err.Error()))
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
 		return
 	}
 
@@ -49,17 +50,20 @@ func (c *AuthController) Register(ctx *gin.Context) {
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		errorMessage := message.ErrInternalServer
+		errorCode := sharedresponse.CodeInternal
 
 		// Handle specific errors
 		if errors.Is(err, service.ErrEmailExists) {
 			statusCode = http.StatusConflict
 			errorMessage = message.ErrEmailAlreadyExists
+			errorCode = sharedresponse.CodeEmailExists
 		} else if errors.Is(err, service.ErrHashPassword) {
 			statusCode = http.StatusInternalServerError
 			errorMessage = message.ErrHashPassword
+			errorCode = sharedresponse.CodeInternal
 		}
 
-		ctx.JSON(statusCode, sharedresponse.Error(errorMessage, err.Error()))
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
 		return
 	}
 
@@ -83,7 +87,7 @@ func (c *AuthController) Login(ctx *gin.Context) {
 
 	// Bind and validate request
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, sharedresponse.Error(message.ErrInvalidRequest, err.Error()))
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
 		return
 	}
 
@@ -92,14 +96,16 @@ func (c *AuthController) Login(ctx *gin.Context) {
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		errorMessage := message.ErrInternalServer
+		errorCode := sharedresponse.CodeInternal
 
 		// Handle specific errors
 		if errors.Is(err, service.ErrInvalidCredentials) {
 			statusCode = http.StatusUnauthorized
 			errorMessage = message.ErrInvalidCredentials
+			errorCode = sharedresponse.CodeInvalidCredentials
 		}
 
-		ctx.JSON(statusCode, sharedresponse.Error(errorMessage, err.Error()))
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
 		return
 	}
 
@@ -120,7 +126,7 @@ func (c *AuthController) GetProfile(ctx *gin.Context) {
 	// Get user ID from context (set by auth middleware)
 	userID, exists := ctx.Get("user_id")
 	if !exists {
-		ctx.JSON(http.StatusUnauthorized, sharedresponse.Error(message.ErrUnauthorized, nil))
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
 		return
 	}
 
@@ -129,13 +135,15 @@ func (c *AuthController) GetProfile(ctx *gin.Context) {
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		errorMessage := message.ErrInternalServer
+		errorCode := sharedresponse.CodeInternal
 
 		if errors.Is(err, repository.ErrUserNotFound) {
 			statusCode = http.StatusNotFound
 			errorMessage = message.ErrUserNotFound
+			errorCode = sharedresponse.CodeUserNotFound
 		}
 
-		ctx.JSON(statusCode, sharedresponse.Error(errorMessage, err.Error()))
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
 		return
 	}
 
@@ -158,22 +166,24 @@ func (c *AuthController) RefreshToken(ctx *gin.Context) {
 
 	// Bind and validate request
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, sharedresponse.Error(message.ErrInvalidRequest, err.Error()))
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
 		return
 	}
 
 	// Call service
 	tokenResponse, err := c.authService.RefreshAccessToken(ctx.Request.Context(), req.RefreshToken)
 	if err != nil {
-		statusCode := http.StatusUnauthorized
-		errorMessage := message.ErrInvalidToken
+		statusCode := http.StatusInternalServerError
+		errorMessage := message.ErrInternalServer
+		errorCode := sharedresponse.CodeInternal
 
-		if errors.Is(err, service.ErrInvalidRefreshToken) || errors.Is(err, service.ErrInvalidTokenType) {
+		if errors.Is(err, service.ErrInvalidRefreshToken) {
 			statusCode = http.StatusUnauthorized
 			errorMessage = message.ErrInvalidToken
+			errorCode = sharedresponse.CodeInvalidToken
 		}
 
-		ctx.JSON(statusCode, sharedresponse.Error(errorMessage, err.Error()))
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
 		return
 	}
 
@@ -181,6 +191,33 @@ func (c *AuthController) RefreshToken(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgTokenRefreshed, tokenResponse))
 }
 
+// Logout revokes all refresh tokens of the authenticated user and the access token used
+// @Summary Logout
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.SuccessResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /api/v1/auth/logout [post]
+func (c *AuthController) Logout(ctx *gin.Context) {
+	// Get user ID from context (set by auth middleware)
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	// Call service
+	accessToken := strings.TrimPrefix(ctx.GetHeader("Authorization"), "Bearer ")
+	if err := c.authService.Logout(ctx.Request.Context(), userID.(string), accessToken); err != nil {
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, err.Error()))
+		return
+	}
+
+	// Success response
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgLogoutSuccess, nil))
+}
+
 // ChangePassword handles password change request for authenticated users
 // @Summary Change password
 // @Tags auth
@@ -198,13 +235,13 @@ func (c *AuthController) ChangePassword(ctx *gin.Context) {
 	// Get user ID from context (set by auth middleware)
 	userID, exists := ctx.Get("user_id")
 	if !exists {
-		ctx.JSON(http.StatusUnauthorized, sharedresponse.Error(message.ErrUnauthorized, nil))
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
 		return
 	}
 
 	// Bind and validate request
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, sharedresponse.Error(message.ErrInvalidRequest, err.Error()))
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
 		return
 	}
 
@@ -213,16 +250,19 @@ func (c *AuthController) ChangePassword(ctx *gin.Context) {
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		errorMessage := message.ErrInternalServer
+		errorCode := sharedresponse.CodeInternal
 
 		if errors.Is(err, service.ErrPasswordMismatch) {
 			statusCode = http.StatusBadRequest
 			errorMessage = "Current password is incorrect"
+			errorCode = sharedresponse.CodePasswordMismatch
 		} else if errors.Is(err, repository.ErrUserNotFound) {
 			statusCode = http.StatusNotFound
 			errorMessage = message.ErrUserNotFound
+			errorCode = sharedresponse.CodeUserNotFound
 		}
 
-		ctx.JSON(statusCode, sharedresponse.Error(errorMessage, err.Error()))
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
 		return
 	}
 
@@ -244,14 +284,14 @@ func (c *AuthController) ForgotPassword(ctx *gin.Context) {
 
 	// Bind and validate request
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, sharedresponse.Error(message.ErrInvalidRequest, err.Error()))
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
 		return
 	}
 
 	// Call service
 	err := c.authService.ForgotPassword(ctx.Request.Context(), &req)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, sharedresponse.Error(message.ErrInternalServer, err.Error()))
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, err.Error()))
 		return
 	}
 
@@ -273,7 +313,7 @@ func (c *AuthController) ResetPassword(ctx *gin.Context) {
 
 	// Bind and validate request
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, sharedresponse.Error(message.ErrInvalidRequest, err.Error()))
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
 		return
 	}
 
@@ -282,13 +322,15 @@ func (c *AuthController) ResetPassword(ctx *gin.Context) {
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		errorMessage := message.ErrInternalServer
+		errorCode := sharedresponse.CodeInternal
 
 		if errors.Is(err, service.ErrInvalidResetToken) {
 			statusCode = http.StatusBadRequest
 			errorMessage = message.ErrInvalidToken
+			errorCode = sharedresponse.CodeInvalidToken
 		}
 
-		ctx.JSON(statusCode, sharedresponse.Error(errorMessage, err.Error()))
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
 		return
 	}
 
@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/service"
+)
+
+// PermissionController handles HTTP requests for role permission management
+type PermissionController struct {
+	permissionService service.PermissionService
+}
+
+// NewPermissionController creates new permission controller instance
+func NewPermissionController(permissionService service.PermissionService) *PermissionController {
+	return &PermissionController{
+		permissionService: permissionService,
+	}
+}
+
+// ListRolePermissions returns the permission catalogue and role mapping
+// @Summary List role permissions
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.RolePermissionsResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Router /api/v1/admin/permissions [get]
+func (c *PermissionController) ListRolePermissions(ctx *gin.Context) {
+	result, err := c.permissionService.ListRolePermissions(ctx.Request.Context())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, err.Error()))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgRolePermissionsRetrieved, result))
+}
+
+// SetRolePermissions replaces the permissions granted to a role
+// @Summary Set role permissions
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param role path string true "Role"
+// @Param request body request.SetRolePermissionsRequest true "Permissions"
+// @Success 200 {object} response.RolePermissionResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /api/v1/admin/roles/{role}/permissions [put]
+func (c *PermissionController) SetRolePermissions(ctx *gin.Context) {
+	var req request.SetRolePermissionsRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	result, err := c.permissionService.SetRolePermissions(ctx.Request.Context(), ctx.Param("role"), &req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		errorMessage := message.ErrInternalServer
+		errorCode := sharedresponse.CodeInternal
+
+		switch {
+		case errors.Is(err, service.ErrUnknownRole):
+			statusCode = http.StatusNotFound
+			errorMessage = message.ErrUnknownRole
+			errorCode = sharedresponse.CodeUnknownRole
+		case errors.Is(err, service.ErrUnknownPermission):
+			statusCode = http.StatusBadRequest
+			errorMessage = message.ErrUnknownPermission
+			errorCode = sharedresponse.CodeUnknownPermission
+		case errors.Is(err, service.ErrAdminLockout):
+			statusCode = http.StatusConflict
+			errorMessage = message.ErrAdminLockout
+			errorCode = sharedresponse.CodeAdminLockout
+		}
+
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgRolePermissionsUpdated, result))
+}
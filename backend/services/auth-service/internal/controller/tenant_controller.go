@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/service"
+)
+
+// TenantController handles HTTP requests for tenant resolution
+type TenantController struct {
+	tenantService service.TenantService
+}
+
+// NewTenantController creates new tenant controller instance
+func NewTenantController(tenantService service.TenantService) *TenantController {
+	return &TenantController{
+		tenantService: tenantService,
+	}
+}
+
+// ResolveTenant returns the tenant serving a domain (used by the gateway)
+// @Summary Resolve tenant by domain
+// @Tags tenants
+// @Produce json
+// @Param domain query string true "Domain"
+// @Success 200 {object} response.TenantResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/v1/tenants/resolve [get]
+func (c *TenantController) ResolveTenant(ctx *gin.Context) {
+	domain := ctx.Query("domain")
+	if domain == "" {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, "domain is required"))
+		return
+	}
+
+	result, err := c.tenantService.ResolveDomain(ctx.Request.Context(), domain)
+	c.respond(ctx, result, err)
+}
+
+// GetCurrentTenant returns the branding of the tenant serving the request
+// @Summary Get current tenant
+// @Tags tenants
+// @Produce json
+// @Success 200 {object} response.TenantResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/v1/tenant [get]
+func (c *TenantController) GetCurrentTenant(ctx *gin.Context) {
+	result, err := c.tenantService.GetTenant(ctx.Request.Context(), tenant.FromGin(ctx))
+	c.respond(ctx, result, err)
+}
+
+// respond writes a tenant lookup result
+func (c *TenantController) respond(ctx *gin.Context, result any, err error) {
+	if err != nil {
+		if errors.Is(err, service.ErrTenantNotFound) {
+			ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrTenantNotFound, sharedresponse.CodeTenantNotFound, nil))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, err.Error()))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgTenantRetrieved, result))
+}
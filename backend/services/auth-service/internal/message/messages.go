@@ -5,6 +5,12 @@ const (
 	MsgRegisterSuccess = "User registered successfully"
 	MsgLoginSuccess    = "Login successful"
 	MsgTokenRefreshed  = "Token refreshed successfully"
+	MsgLogoutSuccess   = "Logged out successfully"
+
+	MsgRolePermissionsRetrieved = "Role permissions retrieved successfully"
+	MsgRolePermissionsUpdated   = "Role permissions updated successfully"
+
+	MsgTenantRetrieved = "Tenant retrieved successfully"
 )
 
 // Error messages
@@ -18,4 +24,10 @@ const (
 	ErrInvalidToken       = "Invalid or expired token"
 	ErrHashPassword       = "Failed to hash password"
 	ErrCreateUser         = "Failed to create user"
+
+	ErrUnknownRole       = "Unknown role"
+	ErrUnknownPermission = "Unknown permission"
+	ErrAdminLockout      = "Admin role must keep the roles:manage permission"
+
+	ErrTenantNotFound = "Tenant not found"
 )
@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// Tenant represents a white-label sub-platform
+type Tenant struct {
+	ID                 string      `db:"id"`
+	Slug               string      `db:"slug"`
+	Name               string      `db:"name"`
+	LogoURL            *string     `db:"logo_url"`
+	PrimaryColor       *string     `db:"primary_color"`
+	SupportEmail       *string     `db:"support_email"`
+	EmailFromName      *string     `db:"email_from_name"`
+	EmailFromAddress   *string     `db:"email_from_address"`
+	PlatformFeePercent int         `db:"platform_fee_percent"`
+	ServiceFee         money.Money `db:"service_fee"`
+	IsActive           bool        `db:"is_active"`
+	CreatedAt          time.Time   `db:"created_at"`
+	UpdatedAt          time.Time   `db:"updated_at"`
+}
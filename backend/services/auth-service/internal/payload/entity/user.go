@@ -5,6 +5,7 @@ import "time"
 // User represents the user entity in database
 type User struct {
 	ID              string    `json:"id" db:"id"`
+	TenantID        string    `json:"tenant_id" db:"tenant_id"`
 	Email           string    `json:"email" db:"email"`
 	PasswordHash    string    `json:"-" db:"password_hash"` // Never expose password in JSON
 	FullName        string    `json:"full_name" db:"full_name"`
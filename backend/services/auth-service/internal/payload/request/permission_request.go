@@ -0,0 +1,6 @@
+package request
+
+// SetRolePermissionsRequest replaces the permissions granted to a role
+type SetRolePermissionsRequest struct {
+	Permissions []string `json:"permissions" binding:"required"`
+}
@@ -22,9 +22,11 @@ type UserResponse struct {
 	CreatedAt       time.Time `json:"created_at"`
 }
 
-// TokenRefreshResponse represents token refresh response (access token only)
+// TokenRefreshResponse represents token refresh response
+// The refresh token used is revoked, RefreshToken replaces it
 type TokenRefreshResponse struct {
-	AccessToken string `json:"access_token"`
-	TokenType   string `json:"token_type"`
-	ExpiresIn   int    `json:"expires_in"` // seconds
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"` // seconds
 }
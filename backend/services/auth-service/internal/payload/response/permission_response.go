@@ -0,0 +1,13 @@
+package response
+
+// RolePermissionResponse represents the permissions granted to a role
+type RolePermissionResponse struct {
+	Role        string   `json:"role"`
+	Permissions []string `json:"permissions"`
+}
+
+// RolePermissionsResponse represents the permission catalogue and role mapping
+type RolePermissionsResponse struct {
+	Permissions []string                 `json:"permissions"`
+	Roles       []RolePermissionResponse `json:"roles"`
+}
@@ -0,0 +1,15 @@
+package response
+
+import "github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+
+// TenantResponse represents the public branding and fee configuration of a tenant
+type TenantResponse struct {
+	ID                 string      `json:"id"`
+	Slug               string      `json:"slug"`
+	Name               string      `json:"name"`
+	LogoURL            *string     `json:"logo_url,omitempty"`
+	PrimaryColor       *string     `json:"primary_color,omitempty"`
+	SupportEmail       *string     `json:"support_email,omitempty"`
+	PlatformFeePercent int         `json:"platform_fee_percent"`
+	ServiceFee         money.Money `json:"service_fee"`
+}
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
 )
 
 var (
@@ -56,6 +58,9 @@ func generateSecureToken(length int) (string, error) {
 
 // Create creates a new password reset token
 func (r *passwordResetRepository) Create(ctx context.Context, userID string, expiresIn time.Duration) (*PasswordResetToken, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	// Generate secure token (32 bytes = 64 hex characters)
 	token, err := generateSecureToken(32)
 	if err != nil {
@@ -88,6 +93,9 @@ func (r *passwordResetRepository) Create(ctx context.Context, userID string, exp
 
 // GetByToken retrieves a password reset token by its token value
 func (r *passwordResetRepository) GetByToken(ctx context.Context, token string) (*PasswordResetToken, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT id, user_id, token, expires_at, used, created_at
 		FROM password_reset_tokens
@@ -127,6 +135,9 @@ func (r *passwordResetRepository) GetByToken(ctx context.Context, token string)
 
 // MarkAsUsed marks a token as used
 func (r *passwordResetRepository) MarkAsUsed(ctx context.Context, tokenID string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		UPDATE password_reset_tokens
 		SET used = TRUE
@@ -152,6 +163,9 @@ func (r *passwordResetRepository) MarkAsUsed(ctx context.Context, tokenID string
 
 // DeleteExpired deletes all expired tokens (cleanup job)
 func (r *passwordResetRepository) DeleteExpired(ctx context.Context) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		DELETE FROM password_reset_tokens
 		WHERE expires_at < NOW() OR used = TRUE
@@ -167,6 +181,9 @@ func (r *passwordResetRepository) DeleteExpired(ctx context.Context) error {
 
 // DeleteByUserID deletes all tokens for a user (invalidate previous tokens)
 func (r *passwordResetRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		DELETE FROM password_reset_tokens
 		WHERE user_id = $1
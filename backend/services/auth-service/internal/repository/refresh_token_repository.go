@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+)
+
+var (
+	ErrRefreshTokenNotFound = errors.New("refresh token not found")
+	ErrRefreshTokenRevoked  = errors.New("refresh token has already been revoked")
+)
+
+// RefreshToken represents a refresh token entity
+// Only the SHA-256 hash of the token is stored; Token is set when the token is issued
+type RefreshToken struct {
+	ID         string
+	UserID     string
+	Token      string
+	TokenHash  string
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+	ReplacedBy *string // Token issued when this one was rotated
+	CreatedAt  time.Time
+}
+
+// IsExpired checks if the token can no longer be used at now
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// RefreshTokenRepository defines interface for refresh token operations
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, userID string, expiresIn time.Duration) (*RefreshToken, error)
+	GetByToken(ctx context.Context, token string) (*RefreshToken, error)
+	Rotate(ctx context.Context, tokenID, userID string, expiresIn time.Duration) (*RefreshToken, error)
+	RevokeByUserID(ctx context.Context, userID string) error
+}
+
+// refreshTokenRepository implements RefreshTokenRepository interface
+type refreshTokenRepository struct {
+	db *sql.DB
+}
+
+// NewRefreshTokenRepository creates new refresh token repository instance
+func NewRefreshTokenRepository(db *sql.DB) RefreshTokenRepository {
+	return &refreshTokenRepository{db: db}
+}
+
+// hashToken returns the hex SHA-256 hash a refresh token is stored and looked up by
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// refreshTokenInserter is a *sql.DB or *sql.Tx
+type refreshTokenInserter interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// insert generates a new refresh token for the user and stores its hash
+func (r *refreshTokenRepository) insert(ctx context.Context, db refreshTokenInserter, userID string, expiresIn time.Duration) (*RefreshToken, error) {
+	// Generate secure token (32 bytes = 64 hex characters)
+	token, err := generateSecureToken(32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	refreshToken := &RefreshToken{
+		UserID:    userID,
+		Token:     token,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(expiresIn),
+	}
+
+	query := `
+		INSERT INTO refresh_tokens (user_id, token_hash, expires_at)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at
+	`
+
+	err = db.QueryRowContext(ctx, query, userID, refreshToken.TokenHash, refreshToken.ExpiresAt).
+		Scan(&refreshToken.ID, &refreshToken.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create refresh token: %w", err)
+	}
+
+	return refreshToken, nil
+}
+
+// Create issues a new refresh token for the user
+func (r *refreshTokenRepository) Create(ctx context.Context, userID string, expiresIn time.Duration) (*RefreshToken, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	return r.insert(ctx, r.db, userID, expiresIn)
+}
+
+// GetByToken retrieves a refresh token by its token value, including revoked and expired tokens
+func (r *refreshTokenRepository) GetByToken(ctx context.Context, token string) (*RefreshToken, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, user_id, token_hash, expires_at, revoked_at, replaced_by, created_at
+		FROM refresh_tokens
+		WHERE token_hash = $1
+	`
+
+	refreshToken := &RefreshToken{}
+	err := r.db.QueryRowContext(ctx, query, hashToken(token)).Scan(
+		&refreshToken.ID,
+		&refreshToken.UserID,
+		&refreshToken.TokenHash,
+		&refreshToken.ExpiresAt,
+		&refreshToken.RevokedAt,
+		&refreshToken.ReplacedBy,
+		&refreshToken.CreatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, ErrRefreshTokenNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get refresh token: %w", err)
+	}
+
+	return refreshToken, nil
+}
+
+// Rotate revokes a refresh token and issues its replacement in one transaction
+// Returns ErrRefreshTokenRevoked when the token was revoked meanwhile (e.g. by a concurrent refresh)
+func (r *refreshTokenRepository) Rotate(ctx context.Context, tokenID, userID string, expiresIn time.Duration) (*RefreshToken, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	replacement, err := r.insert(ctx, tx, userID, expiresIn)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		UPDATE refresh_tokens
+		SET revoked_at = NOW(), replaced_by = $2
+		WHERE id = $1 AND revoked_at IS NULL
+	`
+
+	result, err := tx.ExecContext(ctx, query, tokenID, replacement.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke refresh token: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if rows == 0 {
+		return nil, ErrRefreshTokenRevoked
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return replacement, nil
+}
+
+// RevokeByUserID revokes all outstanding refresh tokens of a user (logout, password change)
+func (r *refreshTokenRepository) RevokeByUserID(ctx context.Context, userID string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE refresh_tokens
+		SET revoked_at = NOW()
+		WHERE user_id = $1 AND revoked_at IS NULL
+	`
+
+	_, err := r.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke user refresh tokens: %w", err)
+	}
+
+	return nil
+}
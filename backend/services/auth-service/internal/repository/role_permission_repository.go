@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+)
+
+// RolePermissionRepository defines interface for role permission mapping operations
+type RolePermissionRepository interface {
+	GetByRole(ctx context.Context, role string) ([]string, error)
+	GetAll(ctx context.Context) (map[string][]string, error)
+	ReplaceForRole(ctx context.Context, role string, permissions []string) error
+}
+
+// rolePermissionRepository implements RolePermissionRepository interface
+type rolePermissionRepository struct {
+	db *sql.DB
+}
+
+// NewRolePermissionRepository creates new role permission repository instance
+func NewRolePermissionRepository(db *sql.DB) RolePermissionRepository {
+	return &rolePermissionRepository{db: db}
+}
+
+// GetByRole retrieves the permissions granted to a role
+func (r *rolePermissionRepository) GetByRole(ctx context.Context, role string) ([]string, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT permission FROM role_permissions WHERE role = $1 ORDER BY permission`
+
+	rows, err := r.db.QueryContext(ctx, query, role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role permissions: %w", err)
+	}
+	defer rows.Close()
+
+	permissions := []string{}
+	for rows.Next() {
+		var permission string
+		if err := rows.Scan(&permission); err != nil {
+			return nil, fmt.Errorf("failed to scan role permission: %w", err)
+		}
+		permissions = append(permissions, permission)
+	}
+
+	return permissions, rows.Err()
+}
+
+// GetAll retrieves the permissions of every role that has any
+func (r *rolePermissionRepository) GetAll(ctx context.Context) (map[string][]string, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT role, permission FROM role_permissions ORDER BY role, permission`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role permissions: %w", err)
+	}
+	defer rows.Close()
+
+	mapping := make(map[string][]string)
+	for rows.Next() {
+		var role, permission string
+		if err := rows.Scan(&role, &permission); err != nil {
+			return nil, fmt.Errorf("failed to scan role permission: %w", err)
+		}
+		mapping[role] = append(mapping[role], permission)
+	}
+
+	return mapping, rows.Err()
+}
+
+// ReplaceForRole replaces the permissions of a role in one transaction
+func (r *rolePermissionRepository) ReplaceForRole(ctx context.Context, role string, permissions []string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM role_permissions WHERE role = $1`, role); err != nil {
+		return fmt.Errorf("failed to clear role permissions: %w", err)
+	}
+
+	for _, permission := range permissions {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO role_permissions (role, permission) VALUES ($1, $2) ON CONFLICT DO NOTHING`,
+			role, permission,
+		); err != nil {
+			return fmt.Errorf("failed to insert role permission: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit role permissions: %w", err)
+	}
+
+	return nil
+}
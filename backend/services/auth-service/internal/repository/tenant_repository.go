@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/payload/entity"
+)
+
+var ErrTenantNotFound = errors.New("tenant not found")
+
+// TenantRepository defines interface for tenant data operations
+type TenantRepository interface {
+	GetByID(ctx context.Context, id string) (*entity.Tenant, error)
+	GetByDomain(ctx context.Context, domain string) (*entity.Tenant, error)
+}
+
+// tenantRepository implements TenantRepository interface
+type tenantRepository struct {
+	db *sql.DB
+}
+
+// NewTenantRepository creates new tenant repository instance
+func NewTenantRepository(db *sql.DB) TenantRepository {
+	return &tenantRepository{db: db}
+}
+
+const tenantColumns = `
+	t.id, t.slug, t.name, t.logo_url, t.primary_color, t.support_email,
+	t.email_from_name, t.email_from_address, t.platform_fee_percent, t.service_fee,
+	t.is_active, t.created_at, t.updated_at`
+
+// GetByID retrieves an active tenant by ID
+func (r *tenantRepository) GetByID(ctx context.Context, id string) (*entity.Tenant, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + tenantColumns + `
+		FROM tenants t
+		WHERE t.id = $1 AND t.is_active = TRUE`
+
+	return r.scanTenant(r.db.QueryRowContext(ctx, query, id))
+}
+
+// GetByDomain retrieves the active tenant serving a domain
+func (r *tenantRepository) GetByDomain(ctx context.Context, domain string) (*entity.Tenant, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + tenantColumns + `
+		FROM tenants t
+		JOIN tenant_domains d ON d.tenant_id = t.id
+		WHERE d.domain = $1 AND t.is_active = TRUE`
+
+	return r.scanTenant(r.db.QueryRowContext(ctx, query, domain))
+}
+
+// scanTenant scans a single tenant row
+func (r *tenantRepository) scanTenant(row *sql.Row) (*entity.Tenant, error) {
+	tenant := &entity.Tenant{}
+	err := row.Scan(
+		&tenant.ID,
+		&tenant.Slug,
+		&tenant.Name,
+		&tenant.LogoURL,
+		&tenant.PrimaryColor,
+		&tenant.SupportEmail,
+		&tenant.EmailFromName,
+		&tenant.EmailFromAddress,
+		&tenant.PlatformFeePercent,
+		&tenant.ServiceFee,
+		&tenant.IsActive,
+		&tenant.CreatedAt,
+		&tenant.UpdatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, ErrTenantNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	return tenant, nil
+}
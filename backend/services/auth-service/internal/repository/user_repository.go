@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/payload/entity"
 )
 
@@ -18,7 +19,7 @@ var (
 // UserRepository defines interface for user data operations
 type UserRepository interface {
 	Create(ctx context.Context, user *entity.User) error
-	GetByEmail(ctx context.Context, email string) (*entity.User, error)
+	GetByEmail(ctx context.Context, tenantID, email string) (*entity.User, error)
 	GetByID(ctx context.Context, id string) (*entity.User, error)
 	Update(ctx context.Context, user *entity.User) error
 	UpdatePassword(ctx context.Context, userID string, passwordHash string) error
@@ -37,9 +38,12 @@ func NewUserRepository(db *sql.DB) UserRepository {
 
 // Create inserts new user into database
 func (r *userRepository) Create(ctx context.Context, user *entity.User) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
-		INSERT INTO users (id, email, password_hash, full_name, phone, role, is_email_verified, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		INSERT INTO users (id, tenant_id, email, password_hash, full_name, phone, role, is_email_verified, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
 		RETURNING id, created_at, updated_at
 	`
 
@@ -49,6 +53,7 @@ func (r *userRepository) Create(ctx context.Context, user *entity.User) error {
 		ctx,
 		query,
 		user.ID,
+		user.TenantID,
 		user.Email,
 		user.PasswordHash,
 		user.FullName,
@@ -59,7 +64,7 @@ func (r *userRepository) Create(ctx context.Context, user *entity.User) error {
 
 	if err != nil {
 		// Check for unique constraint violation (duplicate email)
-		if err.Error() == `pq: duplicate key value violates unique constraint "users_email_key"` {
+		if err.Error() == `pq: duplicate key value violates unique constraint "users_tenant_email_key"` {
 			return ErrEmailAlreadyExists
 		}
 		return fmt.Errorf("failed to create user: %w", err)
@@ -69,17 +74,21 @@ func (r *userRepository) Create(ctx context.Context, user *entity.User) error {
 }
 
 // GetByEmail retrieves user by email
-func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entity.User, error) {
+func (r *userRepository) GetByEmail(ctx context.Context, tenantID, email string) (*entity.User, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
-		SELECT id, email, password_hash, full_name, phone, role, is_email_verified,
+		SELECT id, tenant_id, email, password_hash, full_name, phone, role, is_email_verified,
 		       oauth_provider, oauth_id, is_deleted, created_at, updated_at
 		FROM users
-		WHERE email = $1 AND is_deleted = FALSE
+		WHERE tenant_id = $1 AND email = $2 AND is_deleted = FALSE
 	`
 
 	user := &entity.User{}
-	err := r.db.QueryRowContext(ctx, query, email).Scan(
+	err := r.db.QueryRowContext(ctx, query, tenantID, email).Scan(
 		&user.ID,
+		&user.TenantID,
 		&user.Email,
 		&user.PasswordHash,
 		&user.FullName,
@@ -106,8 +115,11 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*entity.
 
 // GetByID retrieves user by ID
 func (r *userRepository) GetByID(ctx context.Context, id string) (*entity.User, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
-		SELECT id, email, password_hash, full_name, phone, role, is_email_verified,
+		SELECT id, tenant_id, email, password_hash, full_name, phone, role, is_email_verified,
 		       oauth_provider, oauth_id, is_deleted, created_at, updated_at
 		FROM users
 		WHERE id = $1 AND is_deleted = FALSE
@@ -116,6 +128,7 @@ func (r *userRepository) GetByID(ctx context.Context, id string) (*entity.User,
 	user := &entity.User{}
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&user.ID,
+		&user.TenantID,
 		&user.Email,
 		&user.PasswordHash,
 		&user.FullName,
@@ -142,6 +155,9 @@ func (r *userRepository) GetByID(ctx context.Context, id string) (*entity.User,
 
 // Update updates user information
 func (r *userRepository) Update(ctx context.Context, user *entity.User) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		UPDATE users
 		SET full_name = $1, phone = $2, updated_at = NOW()
@@ -167,6 +183,9 @@ func (r *userRepository) Update(ctx context.Context, user *entity.User) error {
 
 // UpdatePassword updates user password hash
 func (r *userRepository) UpdatePassword(ctx context.Context, userID string, passwordHash string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		UPDATE users
 		SET password_hash = $1, updated_at = NOW()
@@ -192,6 +211,9 @@ func (r *userRepository) UpdatePassword(ctx context.Context, userID string, pass
 
 // Delete soft deletes user by setting is_deleted flag
 func (r *userRepository) Delete(ctx context.Context, id string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		UPDATE users
 		SET is_deleted = TRUE, updated_at = NOW()
@@ -3,13 +3,22 @@ package router
 import (
 	"github.com/gin-gonic/gin"
 
+	sharedauth "github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/buildinfo"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/controller"
-	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/middleware"
 )
 
 // SetupRouter configures all routes for the service
-func SetupRouter(authController *controller.AuthController, jwtSecret string) *gin.Engine {
-	router := gin.Default()
+func SetupRouter(
+	authController *controller.AuthController,
+	permissionController *controller.PermissionController,
+	tenantController *controller.TenantController,
+	keys *sharedauth.KeySet,
+) *gin.Engine {
+	router := gin.New()
+	router.Use(gin.Logger(), errreport.GinRecovery()) // Panics are reported to Sentry
 
 	// NOTE: CORS is handled by API Gateway - do not add CORS middleware here
 	// Adding CORS here causes duplicate Access-Control-Allow-Origin headers
@@ -17,9 +26,18 @@ func SetupRouter(authController *controller.AuthController, jwtSecret string) *g
 	// Health check (public)
 	router.GET("/health", authController.Health)
 
+	// Build info (version, git SHA, build time)
+	router.GET("/version", buildinfo.Handler("auth-service"))
+
 	// API routes
+	// Users are scoped to the tenant resolved by the gateway (X-Tenant-ID)
 	api := router.Group("/api/v1")
+	api.Use(tenant.Middleware())
 	{
+		// Tenant routes (public)
+		api.GET("/tenant", tenantController.GetCurrentTenant)
+		api.GET("/tenants/resolve", tenantController.ResolveTenant) // Domain lookup for the gateway
+
 		// Auth routes (public)
 		auth := api.Group("/auth")
 		{
@@ -32,10 +50,19 @@ func SetupRouter(authController *controller.AuthController, jwtSecret string) *g
 
 		// Protected routes (require authentication)
 		protected := api.Group("/auth")
-		protected.Use(middleware.AuthMiddleware(jwtSecret))
+		protected.Use(sharedauth.Middleware(keys))
 		{
 			protected.GET("/profile", authController.GetProfile)
 			protected.POST("/change-password", authController.ChangePassword)
+			protected.POST("/logout", authController.Logout)
+		}
+
+		// Role permission management (admin)
+		admin := api.Group("/admin")
+		admin.Use(sharedauth.Middleware(keys), sharedauth.RequireScope(sharedauth.PermRolesManage))
+		{
+			admin.GET("/permissions", permissionController.ListRolePermissions)
+			admin.PUT("/roles/:role/permissions", permissionController.SetRolePermissions)
 		}
 	}
 
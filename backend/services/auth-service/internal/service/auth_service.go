@@ -9,7 +9,9 @@ import (
 
 	"golang.org/x/crypto/bcrypt"
 
+	sharedauth "github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
 	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/payload/entity"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/payload/request"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/payload/response"
@@ -25,7 +27,6 @@ var (
 	ErrEmailExists         = errors.New("email already registered")
 	ErrHashPassword        = errors.New("failed to hash password")
 	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
-	ErrInvalidTokenType    = errors.New("invalid token type")
 	ErrPasswordMismatch    = errors.New("current password is incorrect")
 	ErrInvalidResetToken   = errors.New("invalid or expired reset token")
 )
@@ -36,6 +37,7 @@ type AuthService interface {
 	Login(ctx context.Context, req *request.LoginRequest) (*response.AuthResponse, error)
 	GetUserByID(ctx context.Context, userID string) (*response.UserResponse, error)
 	RefreshAccessToken(ctx context.Context, refreshToken string) (*response.TokenRefreshResponse, error)
+	Logout(ctx context.Context, userID, accessToken string) error
 	ChangePassword(ctx context.Context, userID string, req *request.ChangePasswordRequest) error
 	ForgotPassword(ctx context.Context, req *request.ForgotPasswordRequest) error
 	ResetPassword(ctx context.Context, req *request.ResetPasswordRequest) error
@@ -45,8 +47,10 @@ type AuthService interface {
 type authService struct {
 	userRepo          repository.UserRepository
 	passwordResetRepo repository.PasswordResetRepository
+	refreshTokenRepo  repository.RefreshTokenRepository
+	rolePermRepo      repository.RolePermissionRepository
 	jwtUtil           *utility.JWTUtil
-	cache             cache.RedisClient // For future features: token blacklist, rate limiting
+	cache             cache.RedisClient // Access token revocations read by the gateway's token cache; nil disables them
 	bcryptCost        int
 }
 
@@ -54,6 +58,8 @@ type authService struct {
 func NewAuthService(
 	userRepo repository.UserRepository,
 	passwordResetRepo repository.PasswordResetRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	rolePermRepo repository.RolePermissionRepository,
 	jwtUtil *utility.JWTUtil,
 	redisClient cache.RedisClient,
 	bcryptCost int,
@@ -61,6 +67,8 @@ func NewAuthService(
 	return &authService{
 		userRepo:          userRepo,
 		passwordResetRepo: passwordResetRepo,
+		refreshTokenRepo:  refreshTokenRepo,
+		rolePermRepo:      rolePermRepo,
 		jwtUtil:           jwtUtil,
 		cache:             redisClient,
 		bcryptCost:        bcryptCost,
@@ -69,8 +77,10 @@ func NewAuthService(
 
 // Register handles user registration
 func (s *authService) Register(ctx context.Context, req *request.RegisterRequest) (*response.AuthResponse, error) {
+	tenantID := tenantFromContext(ctx)
+
 	// Check if email already exists
-	existingUser, err := s.userRepo.GetByEmail(ctx, req.Email)
+	existingUser, err := s.userRepo.GetByEmail(ctx, tenantID, req.Email)
 	if err == nil && existingUser != nil {
 		return nil, ErrEmailExists
 	}
@@ -83,6 +93,7 @@ func (s *authService) Register(ctx context.Context, req *request.RegisterRequest
 
 	// Create user entity
 	user := &entity.User{
+		TenantID:        tenantID,
 		Email:           req.Email,
 		PasswordHash:    string(hashedPassword),
 		FullName:        req.FullName,
@@ -103,12 +114,12 @@ func (s *authService) Register(ctx context.Context, req *request.RegisterRequest
 	}
 
 	// Generate tokens
-	accessToken, err := s.jwtUtil.GenerateToken(user.ID, user.Email, user.FullName, user.Role)
+	accessToken, err := s.generateAccessToken(ctx, user)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate access token: %w", err)
 	}
 
-	refreshToken, err := s.jwtUtil.GenerateRefreshToken(user.ID, user.Email, user.FullName, user.Role)
+	refreshToken, err := s.refreshTokenRepo.Create(ctx, user.ID, s.jwtUtil.GetRefreshExpiryDuration())
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
 	}
@@ -116,7 +127,7 @@ func (s *authService) Register(ctx context.Context, req *request.RegisterRequest
 	// Build response
 	return &response.AuthResponse{
 		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		RefreshToken: refreshToken.Token,
 		TokenType:    "Bearer",
 		ExpiresIn:    int(s.jwtUtil.GetExpiryDuration().Seconds()),
 		User:         s.mapUserToResponse(user),
@@ -125,8 +136,8 @@ func (s *authService) Register(ctx context.Context, req *request.RegisterRequest
 
 // Login handles user authentication
 func (s *authService) Login(ctx context.Context, req *request.LoginRequest) (*response.AuthResponse, error) {
-	// Get user by email
-	user, err := s.userRepo.GetByEmail(ctx, req.Email)
+	// Get user by email within the requesting tenant
+	user, err := s.userRepo.GetByEmail(ctx, tenantFromContext(ctx), req.Email)
 	if err != nil {
 		if errors.Is(err, repository.ErrUserNotFound) {
 			return nil, ErrInvalidCredentials
@@ -140,12 +151,12 @@ func (s *authService) Login(ctx context.Context, req *request.LoginRequest) (*re
 	}
 
 	// Generate tokens
-	accessToken, err := s.jwtUtil.GenerateToken(user.ID, user.Email, user.FullName, user.Role)
+	accessToken, err := s.generateAccessToken(ctx, user)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate access token: %w", err)
 	}
 
-	refreshToken, err := s.jwtUtil.GenerateRefreshToken(user.ID, user.Email, user.FullName, user.Role)
+	refreshToken, err := s.refreshTokenRepo.Create(ctx, user.ID, s.jwtUtil.GetRefreshExpiryDuration())
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
 	}
@@ -153,7 +164,7 @@ func (s *authService) Login(ctx context.Context, req *request.LoginRequest) (*re
 	// Build response
 	return &response.AuthResponse{
 		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		RefreshToken: refreshToken.Token,
 		TokenType:    "Bearer",
 		ExpiresIn:    int(s.jwtUtil.GetExpiryDuration().Seconds()),
 		User:         s.mapUserToResponse(user),
@@ -187,21 +198,45 @@ func (s *authService) mapUserToResponse(user *entity.User) response.UserResponse
 	}
 }
 
-// RefreshAccessToken generates a new access token using a valid refresh token
+// generateAccessToken issues an access token scoped to the permissions of the user's role
+func (s *authService) generateAccessToken(ctx context.Context, user *entity.User) (string, error) {
+	permissions, err := s.rolePermRepo.GetByRole(ctx, user.Role)
+	if err != nil {
+		return "", err
+	}
+
+	return s.jwtUtil.GenerateToken(user.ID, user.Email, user.FullName, user.Role, user.TenantID, permissions)
+}
+
+// RefreshAccessToken rotates a valid refresh token: it is revoked and a new access and refresh token are issued
+// A refresh token that was already rotated being used again means it leaked, so all of the user's
+// refresh tokens are revoked and the user has to log in again
 func (s *authService) RefreshAccessToken(ctx context.Context, refreshToken string) (*response.TokenRefreshResponse, error) {
-	// Validate refresh token
-	claims, err := s.jwtUtil.ValidateToken(refreshToken)
+	// Look up refresh token
+	stored, err := s.refreshTokenRepo.GetByToken(ctx, refreshToken)
 	if err != nil {
+		if errors.Is(err, repository.ErrRefreshTokenNotFound) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, fmt.Errorf("failed to get refresh token: %w", err)
+	}
+
+	if stored.RevokedAt != nil {
+		if stored.ReplacedBy != nil {
+			log.Printf("Rotated refresh token %s of user %s reused, revoking all refresh tokens of the user", stored.ID, stored.UserID)
+			if err := s.refreshTokenRepo.RevokeByUserID(ctx, stored.UserID); err != nil {
+				return nil, fmt.Errorf("failed to revoke refresh tokens: %w", err)
+			}
+		}
 		return nil, ErrInvalidRefreshToken
 	}
 
-	// Verify token type is refresh
-	if claims.TokenType != utility.TokenTypeRefresh {
-		return nil, ErrInvalidTokenType
+	if stored.IsExpired(time.Now()) {
+		return nil, ErrInvalidRefreshToken
 	}
 
 	// Verify user still exists
-	user, err := s.userRepo.GetByID(ctx, claims.UserID)
+	user, err := s.userRepo.GetByID(ctx, stored.UserID)
 	if err != nil {
 		if errors.Is(err, repository.ErrUserNotFound) {
 			return nil, ErrInvalidRefreshToken
@@ -209,19 +244,57 @@ func (s *authService) RefreshAccessToken(ctx context.Context, refreshToken strin
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
-	// Generate new access token only (not a new refresh token)
-	accessToken, err := s.jwtUtil.GenerateToken(user.ID, user.Email, user.FullName, user.Role)
+	// Refresh tokens are only honoured on the tenant that issued them
+	if user.TenantID != tenantFromContext(ctx) {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	// Replace the refresh token; a concurrent refresh with the same token wins at most once
+	rotated, err := s.refreshTokenRepo.Rotate(ctx, stored.ID, user.ID, s.jwtUtil.GetRefreshExpiryDuration())
+	if err != nil {
+		if errors.Is(err, repository.ErrRefreshTokenRevoked) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+
+	accessToken, err := s.generateAccessToken(ctx, user)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate access token: %w", err)
 	}
 
 	return &response.TokenRefreshResponse{
-		AccessToken: accessToken,
-		TokenType:   "Bearer",
-		ExpiresIn:   int(s.jwtUtil.GetExpiryDuration().Seconds()),
+		AccessToken:  accessToken,
+		RefreshToken: rotated.Token,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(s.jwtUtil.GetExpiryDuration().Seconds()),
 	}, nil
 }
 
+// Logout revokes all outstanding refresh tokens of the user and the access token of the request
+// Without Redis, and on other devices, access tokens already issued stay valid until they expire
+func (s *authService) Logout(ctx context.Context, userID, accessToken string) error {
+	if err := s.refreshTokenRepo.RevokeByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+
+	if s.cache == nil {
+		return nil
+	}
+
+	claims, err := s.jwtUtil.ValidateToken(accessToken)
+	if err != nil || claims.ExpiresAt == nil {
+		return nil
+	}
+
+	// Refresh tokens are already revoked, the access token expires on its own
+	if err := sharedauth.RevokeToken(ctx, s.cache, accessToken, claims.ExpiresAt.Time); err != nil {
+		log.Printf("Failed to revoke access token of user %s: %v", userID, err)
+	}
+
+	return nil
+}
+
 // ChangePassword changes the password for an authenticated user
 func (s *authService) ChangePassword(ctx context.Context, userID string, req *request.ChangePasswordRequest) error {
 	// Get user by ID
@@ -249,13 +322,18 @@ func (s *authService) ChangePassword(ctx context.Context, userID string, req *re
 		return fmt.Errorf("failed to update password: %w", err)
 	}
 
+	// Sessions started with the old password must log in again
+	if err := s.refreshTokenRepo.RevokeByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+
 	return nil
 }
 
 // ForgotPassword initiates password reset flow by generating a reset token
 func (s *authService) ForgotPassword(ctx context.Context, req *request.ForgotPasswordRequest) error {
 	// Check if user exists
-	user, err := s.userRepo.GetByEmail(ctx, req.Email)
+	user, err := s.userRepo.GetByEmail(ctx, tenantFromContext(ctx), req.Email)
 	if err != nil {
 		if errors.Is(err, repository.ErrUserNotFound) {
 			// Don't reveal if email exists or not for security
@@ -310,6 +388,11 @@ func (s *authService) ResetPassword(ctx context.Context, req *request.ResetPassw
 		return fmt.Errorf("failed to update password: %w", err)
 	}
 
+	// Sessions started with the old password must log in again
+	if err := s.refreshTokenRepo.RevokeByUserID(ctx, resetToken.UserID); err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+
 	// Mark token as used
 	if err := s.passwordResetRepo.MarkAsUsed(ctx, resetToken.ID); err != nil {
 		log.Printf("Failed to mark reset token as used: %v", err)
@@ -318,3 +401,11 @@ func (s *authService) ResetPassword(ctx context.Context, req *request.ResetPassw
 
 	return nil
 }
+
+// tenantFromContext returns the tenant set by the tenant middleware, or the default tenant
+func tenantFromContext(ctx context.Context) string {
+	if tenantID, ok := tenant.FromContext(ctx); ok {
+		return tenantID
+	}
+	return tenant.DefaultID
+}
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/repository"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubUserRepo keeps users in memory
+type stubUserRepo struct {
+	repository.UserRepository
+	users map[string]*entity.User
+}
+
+func (r *stubUserRepo) GetByID(ctx context.Context, id string) (*entity.User, error) {
+	if user, ok := r.users[id]; ok {
+		return user, nil
+	}
+	return nil, repository.ErrUserNotFound
+}
+
+func (r *stubUserRepo) GetByEmail(ctx context.Context, tenantID, email string) (*entity.User, error) {
+	for _, user := range r.users {
+		if user.TenantID == tenantID && user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, repository.ErrUserNotFound
+}
+
+func (r *stubUserRepo) UpdatePassword(ctx context.Context, userID string, passwordHash string) error {
+	r.users[userID].PasswordHash = passwordHash
+	return nil
+}
+
+// stubRefreshTokenRepo keeps refresh tokens in memory, keyed by token value
+type stubRefreshTokenRepo struct {
+	tokens map[string]*repository.RefreshToken
+	issued int
+}
+
+func (r *stubRefreshTokenRepo) Create(ctx context.Context, userID string, expiresIn time.Duration) (*repository.RefreshToken, error) {
+	r.issued++
+	token := &repository.RefreshToken{
+		ID:        fmt.Sprintf("refresh-%d", r.issued),
+		UserID:    userID,
+		Token:     fmt.Sprintf("token-%d", r.issued),
+		ExpiresAt: time.Now().Add(expiresIn),
+	}
+	r.tokens[token.Token] = token
+	return token, nil
+}
+
+func (r *stubRefreshTokenRepo) GetByToken(ctx context.Context, token string) (*repository.RefreshToken, error) {
+	if stored, ok := r.tokens[token]; ok {
+		copied := *stored
+		return &copied, nil
+	}
+	return nil, repository.ErrRefreshTokenNotFound
+}
+
+func (r *stubRefreshTokenRepo) Rotate(ctx context.Context, tokenID, userID string, expiresIn time.Duration) (*repository.RefreshToken, error) {
+	for _, stored := range r.tokens {
+		if stored.ID != tokenID {
+			continue
+		}
+		if stored.RevokedAt != nil {
+			return nil, repository.ErrRefreshTokenRevoked
+		}
+		replacement, _ := r.Create(ctx, userID, expiresIn)
+		now := time.Now()
+		stored.RevokedAt = &now
+		stored.ReplacedBy = &replacement.ID
+		return replacement, nil
+	}
+	return nil, repository.ErrRefreshTokenNotFound
+}
+
+func (r *stubRefreshTokenRepo) RevokeByUserID(ctx context.Context, userID string) error {
+	now := time.Now()
+	for _, stored := range r.tokens {
+		if stored.UserID == userID && stored.RevokedAt == nil {
+			stored.RevokedAt = &now
+		}
+	}
+	return nil
+}
+
+// outstanding counts the user's refresh tokens that are not revoked
+func (r *stubRefreshTokenRepo) outstanding(userID string) int {
+	n := 0
+	for _, stored := range r.tokens {
+		if stored.UserID == userID && stored.RevokedAt == nil {
+			n++
+		}
+	}
+	return n
+}
+
+// stubRedis records the keys that were set
+type stubRedis struct {
+	cache.RedisClient
+	keys []string
+}
+
+func (r *stubRedis) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	r.keys = append(r.keys, key)
+	return nil
+}
+
+func newAuthFixture(t *testing.T) (*authService, *stubRefreshTokenRepo) {
+	jwtUtil, err := utility.NewJWTUtil(auth.NewHMACKeySet("auth-test-secret"), "15m", "168h")
+	require.NoError(t, err)
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("old-password"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	refreshTokenRepo := &stubRefreshTokenRepo{tokens: map[string]*repository.RefreshToken{}}
+	svc := &authService{
+		userRepo: &stubUserRepo{users: map[string]*entity.User{
+			"user-1": {ID: "user-1", TenantID: tenant.DefaultID, Email: "ana@example.com", PasswordHash: string(passwordHash), Role: entity.RoleCustomer},
+		}},
+		refreshTokenRepo: refreshTokenRepo,
+		rolePermRepo:     &stubRolePermissionRepo{mapping: map[string][]string{}},
+		jwtUtil:          jwtUtil,
+		bcryptCost:       bcrypt.MinCost,
+	}
+	return svc, refreshTokenRepo
+}
+
+func TestRefreshAccessToken_Rotates(t *testing.T) {
+	ctx := context.Background()
+	svc, refreshTokenRepo := newAuthFixture(t)
+
+	login, err := svc.Login(ctx, &request.LoginRequest{Email: "ana@example.com", Password: "old-password"})
+	require.NoError(t, err)
+
+	refreshed, err := svc.RefreshAccessToken(ctx, login.RefreshToken)
+	require.NoError(t, err)
+	assert.NotEmpty(t, refreshed.AccessToken)
+	assert.NotEqual(t, login.RefreshToken, refreshed.RefreshToken)
+	assert.Equal(t, 1, refreshTokenRepo.outstanding("user-1"), "the used refresh token is revoked")
+
+	_, err = svc.RefreshAccessToken(ctx, refreshed.RefreshToken)
+	assert.NoError(t, err, "the new refresh token can be used in turn")
+}
+
+func TestRefreshAccessToken_ReuseRevokesAll(t *testing.T) {
+	ctx := context.Background()
+	svc, refreshTokenRepo := newAuthFixture(t)
+
+	login, err := svc.Login(ctx, &request.LoginRequest{Email: "ana@example.com", Password: "old-password"})
+	require.NoError(t, err)
+	refreshed, err := svc.RefreshAccessToken(ctx, login.RefreshToken)
+	require.NoError(t, err)
+
+	_, err = svc.RefreshAccessToken(ctx, login.RefreshToken)
+	assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+	assert.Zero(t, refreshTokenRepo.outstanding("user-1"))
+
+	_, err = svc.RefreshAccessToken(ctx, refreshed.RefreshToken)
+	assert.ErrorIs(t, err, ErrInvalidRefreshToken, "the token issued by rotation is revoked too")
+}
+
+func TestRefreshAccessToken_Rejected(t *testing.T) {
+	tests := []struct {
+		name   string
+		token  string
+		mutate func(svc *authService, refreshTokenRepo *stubRefreshTokenRepo)
+		ctx    context.Context
+	}{
+		{
+			name:  "unknown token",
+			token: "not-issued",
+		},
+		{
+			name:  "expired token",
+			token: "token-1",
+			mutate: func(svc *authService, refreshTokenRepo *stubRefreshTokenRepo) {
+				refreshTokenRepo.tokens["token-1"].ExpiresAt = time.Now().Add(-time.Minute)
+			},
+		},
+		{
+			name:  "token revoked by logout",
+			token: "token-1",
+			mutate: func(svc *authService, refreshTokenRepo *stubRefreshTokenRepo) {
+				require.NoError(t, refreshTokenRepo.RevokeByUserID(context.Background(), "user-1"))
+			},
+		},
+		{
+			name:  "other tenant",
+			token: "token-1",
+			ctx:   tenant.NewContext(context.Background(), "6f1c2a4e-8d0b-4c55-9a77-3e2f1b0c9d11"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, refreshTokenRepo := newAuthFixture(t)
+			_, err := refreshTokenRepo.Create(context.Background(), "user-1", time.Hour)
+			require.NoError(t, err)
+			if tt.mutate != nil {
+				tt.mutate(svc, refreshTokenRepo)
+			}
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			_, err = svc.RefreshAccessToken(ctx, tt.token)
+			assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+		})
+	}
+}
+
+func TestLogout(t *testing.T) {
+	ctx := context.Background()
+	svc, refreshTokenRepo := newAuthFixture(t)
+	redis := &stubRedis{}
+	svc.cache = redis
+
+	login, err := svc.Login(ctx, &request.LoginRequest{Email: "ana@example.com", Password: "old-password"})
+	require.NoError(t, err)
+	_, err = svc.Login(ctx, &request.LoginRequest{Email: "ana@example.com", Password: "old-password"})
+	require.NoError(t, err)
+
+	require.NoError(t, svc.Logout(ctx, "user-1", login.AccessToken))
+	assert.Zero(t, refreshTokenRepo.outstanding("user-1"), "every device is logged out")
+	require.Len(t, redis.keys, 1)
+	assert.True(t, strings.HasPrefix(redis.keys[0], auth.RevokedKeyPrefix), "the access token is revoked at the gateway")
+}
+
+func TestChangePassword_RevokesRefreshTokens(t *testing.T) {
+	ctx := context.Background()
+	svc, refreshTokenRepo := newAuthFixture(t)
+
+	login, err := svc.Login(ctx, &request.LoginRequest{Email: "ana@example.com", Password: "old-password"})
+	require.NoError(t, err)
+
+	err = svc.ChangePassword(ctx, "user-1", &request.ChangePasswordRequest{CurrentPassword: "old-password", NewPassword: "new-password"})
+	require.NoError(t, err)
+	assert.Zero(t, refreshTokenRepo.outstanding("user-1"))
+
+	_, err = svc.RefreshAccessToken(ctx, login.RefreshToken)
+	assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/repository"
+)
+
+var (
+	ErrUnknownRole       = errors.New("unknown role")
+	ErrUnknownPermission = errors.New("unknown permission")
+	ErrAdminLockout      = errors.New("admin role must keep " + auth.PermRolesManage)
+)
+
+// PermissionService defines interface for role permission management
+type PermissionService interface {
+	ListRolePermissions(ctx context.Context) (*response.RolePermissionsResponse, error)
+	SetRolePermissions(ctx context.Context, role string, req *request.SetRolePermissionsRequest) (*response.RolePermissionResponse, error)
+}
+
+// permissionService implements PermissionService interface
+type permissionService struct {
+	rolePermissionRepo repository.RolePermissionRepository
+}
+
+// NewPermissionService creates new permission service instance
+func NewPermissionService(rolePermissionRepo repository.RolePermissionRepository) PermissionService {
+	return &permissionService{rolePermissionRepo: rolePermissionRepo}
+}
+
+// ListRolePermissions returns every known permission and what each role is granted
+func (s *permissionService) ListRolePermissions(ctx context.Context) (*response.RolePermissionsResponse, error) {
+	mapping, err := s.rolePermissionRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role permissions: %w", err)
+	}
+
+	roles := make([]response.RolePermissionResponse, 0, len(auth.Roles))
+	for _, role := range auth.Roles {
+		permissions := mapping[role]
+		if permissions == nil {
+			permissions = []string{}
+		}
+		roles = append(roles, response.RolePermissionResponse{Role: role, Permissions: permissions})
+	}
+
+	return &response.RolePermissionsResponse{
+		Permissions: auth.AllPermissions,
+		Roles:       roles,
+	}, nil
+}
+
+// SetRolePermissions replaces the permissions of a role
+// Tokens already issued keep their scopes until they are refreshed
+func (s *permissionService) SetRolePermissions(ctx context.Context, role string, req *request.SetRolePermissionsRequest) (*response.RolePermissionResponse, error) {
+	if !auth.IsRole(role) {
+		return nil, ErrUnknownRole
+	}
+
+	permissions := make([]string, 0, len(req.Permissions))
+	for _, permission := range req.Permissions {
+		if !auth.IsPermission(permission) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownPermission, permission)
+		}
+		if !slices.Contains(permissions, permission) {
+			permissions = append(permissions, permission)
+		}
+	}
+	slices.Sort(permissions)
+
+	// Nobody could manage permissions anymore
+	if role == auth.RoleAdmin && !slices.Contains(permissions, auth.PermRolesManage) {
+		return nil, ErrAdminLockout
+	}
+
+	if err := s.rolePermissionRepo.ReplaceForRole(ctx, role, permissions); err != nil {
+		return nil, fmt.Errorf("failed to set role permissions: %w", err)
+	}
+
+	return &response.RolePermissionResponse{Role: role, Permissions: permissions}, nil
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/payload/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubRolePermissionRepo keeps the mapping in memory
+type stubRolePermissionRepo struct {
+	mapping map[string][]string
+}
+
+func (r *stubRolePermissionRepo) GetByRole(ctx context.Context, role string) ([]string, error) {
+	return r.mapping[role], nil
+}
+
+func (r *stubRolePermissionRepo) GetAll(ctx context.Context) (map[string][]string, error) {
+	return r.mapping, nil
+}
+
+func (r *stubRolePermissionRepo) ReplaceForRole(ctx context.Context, role string, permissions []string) error {
+	r.mapping[role] = permissions
+	return nil
+}
+
+func TestSetRolePermissions(t *testing.T) {
+	ctx := context.Background()
+	repo := &stubRolePermissionRepo{mapping: map[string][]string{
+		auth.RoleAdmin: {auth.PermRolesManage},
+	}}
+	svc := NewPermissionService(repo)
+
+	result, err := svc.SetRolePermissions(ctx, auth.RoleOrganizer, &request.SetRolePermissionsRequest{
+		Permissions: []string{auth.PermEventsWrite, auth.PermCheckinScan, auth.PermEventsWrite},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{auth.PermCheckinScan, auth.PermEventsWrite}, result.Permissions)
+	assert.Equal(t, result.Permissions, repo.mapping[auth.RoleOrganizer])
+
+	_, err = svc.SetRolePermissions(ctx, "superuser", &request.SetRolePermissionsRequest{})
+	assert.ErrorIs(t, err, ErrUnknownRole)
+
+	_, err = svc.SetRolePermissions(ctx, auth.RoleCustomer, &request.SetRolePermissionsRequest{
+		Permissions: []string{"events:delete"},
+	})
+	assert.ErrorIs(t, err, ErrUnknownPermission)
+
+	_, err = svc.SetRolePermissions(ctx, auth.RoleAdmin, &request.SetRolePermissionsRequest{
+		Permissions: []string{auth.PermEventsWrite},
+	})
+	assert.ErrorIs(t, err, ErrAdminLockout)
+	assert.Equal(t, []string{auth.PermRolesManage}, repo.mapping[auth.RoleAdmin])
+}
+
+func TestListRolePermissions(t *testing.T) {
+	repo := &stubRolePermissionRepo{mapping: map[string][]string{
+		auth.RoleOrganizer: {auth.PermEventsWrite},
+	}}
+
+	result, err := NewPermissionService(repo).ListRolePermissions(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, auth.AllPermissions, result.Permissions)
+	require.Len(t, result.Roles, len(auth.Roles))
+	for _, role := range result.Roles {
+		switch role.Role {
+		case auth.RoleOrganizer:
+			assert.Equal(t, []string{auth.PermEventsWrite}, role.Permissions)
+		default:
+			assert.Empty(t, role.Permissions)
+			assert.NotNil(t, role.Permissions)
+		}
+	}
+}
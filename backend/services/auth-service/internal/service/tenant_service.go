@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/repository"
+)
+
+var ErrTenantNotFound = errors.New("tenant not found")
+
+// TenantService defines interface for tenant resolution
+type TenantService interface {
+	ResolveDomain(ctx context.Context, domain string) (*response.TenantResponse, error)
+	GetTenant(ctx context.Context, tenantID string) (*response.TenantResponse, error)
+}
+
+// tenantService implements TenantService interface
+type tenantService struct {
+	tenantRepo repository.TenantRepository
+}
+
+// NewTenantService creates new tenant service instance
+func NewTenantService(tenantRepo repository.TenantRepository) TenantService {
+	return &tenantService{tenantRepo: tenantRepo}
+}
+
+// ResolveDomain returns the tenant serving domain
+// Domains without a tenant of their own belong to the default tenant
+func (s *tenantService) ResolveDomain(ctx context.Context, domain string) (*response.TenantResponse, error) {
+	found, err := s.tenantRepo.GetByDomain(ctx, NormalizeDomain(domain))
+	if errors.Is(err, repository.ErrTenantNotFound) {
+		return s.GetTenant(ctx, tenant.DefaultID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toTenantResponse(found), nil
+}
+
+// GetTenant returns an active tenant by ID
+func (s *tenantService) GetTenant(ctx context.Context, tenantID string) (*response.TenantResponse, error) {
+	found, err := s.tenantRepo.GetByID(ctx, tenantID)
+	if errors.Is(err, repository.ErrTenantNotFound) {
+		return nil, ErrTenantNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toTenantResponse(found), nil
+}
+
+// NormalizeDomain lowercases a host and strips its port and trailing dot
+func NormalizeDomain(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.TrimSuffix(host, ".")
+}
+
+// toTenantResponse converts entity.Tenant to response.TenantResponse
+func toTenantResponse(t *entity.Tenant) *response.TenantResponse {
+	return &response.TenantResponse{
+		ID:                 t.ID,
+		Slug:               t.Slug,
+		Name:               t.Name,
+		LogoURL:            t.LogoURL,
+		PrimaryColor:       t.PrimaryColor,
+		SupportEmail:       t.SupportEmail,
+		PlatformFeePercent: t.PlatformFeePercent,
+		ServiceFee:         t.ServiceFee,
+	}
+}
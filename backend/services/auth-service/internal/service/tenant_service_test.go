@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/auth-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubTenantRepo keeps tenants and their domains in memory
+type stubTenantRepo struct {
+	tenants map[string]*entity.Tenant
+	domains map[string]string
+}
+
+func (r *stubTenantRepo) GetByID(ctx context.Context, id string) (*entity.Tenant, error) {
+	if found, ok := r.tenants[id]; ok {
+		return found, nil
+	}
+	return nil, repository.ErrTenantNotFound
+}
+
+func (r *stubTenantRepo) GetByDomain(ctx context.Context, domain string) (*entity.Tenant, error) {
+	if id, ok := r.domains[domain]; ok {
+		return r.GetByID(ctx, id)
+	}
+	return nil, repository.ErrTenantNotFound
+}
+
+func TestResolveDomain(t *testing.T) {
+	ctx := context.Background()
+	const brandID = "6f1c2a4e-8d0b-4c55-9a77-3e2f1b0c9d11"
+	svc := NewTenantService(&stubTenantRepo{
+		tenants: map[string]*entity.Tenant{
+			tenant.DefaultID: {ID: tenant.DefaultID, Slug: "default", PlatformFeePercent: 5},
+			brandID:          {ID: brandID, Slug: "brand", PlatformFeePercent: 3},
+		},
+		domains: map[string]string{"tickets.brand.com": brandID},
+	})
+
+	result, err := svc.ResolveDomain(ctx, "Tickets.Brand.com:443")
+	require.NoError(t, err)
+	assert.Equal(t, "brand", result.Slug)
+	assert.Equal(t, 3, result.PlatformFeePercent)
+
+	result, err = svc.ResolveDomain(ctx, "unknown.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, tenant.DefaultID, result.ID)
+
+	_, err = svc.GetTenant(ctx, "00000000-0000-0000-0000-00000000dead")
+	assert.ErrorIs(t, err, ErrTenantNotFound)
+}
+
+func TestNormalizeDomain(t *testing.T) {
+	assert.Equal(t, "tickets.brand.com", NormalizeDomain(" Tickets.Brand.COM. "))
+	assert.Equal(t, "localhost", NormalizeDomain("localhost:3000"))
+}
@@ -1,37 +1,22 @@
 package utility
 
 import (
-	"errors"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
 )
 
-// Token type constants
-const (
-	TokenTypeAccess  = "access"
-	TokenTypeRefresh = "refresh"
-)
-
-// JWTClaims represents JWT claims structure
-type JWTClaims struct {
-	UserID    string `json:"user_id"`
-	Email     string `json:"email"`
-	Name      string `json:"name"`
-	Role      string `json:"role"`
-	TokenType string `json:"token_type"`
-	jwt.RegisteredClaims
-}
-
 // JWTUtil handles JWT operations
 type JWTUtil struct {
-	secretKey     string
+	keys          *auth.KeySet
 	expiry        time.Duration
 	refreshExpiry time.Duration
 }
 
 // NewJWTUtil creates new JWT utility instance
-func NewJWTUtil(secretKey string, expiryStr string, refreshExpiryStr string) (*JWTUtil, error) {
+func NewJWTUtil(keys *auth.KeySet, expiryStr string, refreshExpiryStr string) (*JWTUtil, error) {
 	expiry, err := time.ParseDuration(expiryStr)
 	if err != nil {
 		return nil, err
@@ -43,59 +28,41 @@ func NewJWTUtil(secretKey string, expiryStr string, refreshExpiryStr string) (*J
 	}
 
 	return &JWTUtil{
-		secretKey:     secretKey,
+		keys:          keys,
 		expiry:        expiry,
 		refreshExpiry: refreshExpiry,
 	}, nil
 }
 
-// GenerateToken generates new JWT access token
-func (j *JWTUtil) GenerateToken(userID, email, name, role string) (string, error) {
-	return j.generateTokenWithType(userID, email, name, role, TokenTypeAccess, j.expiry)
-}
-
-// GenerateRefreshToken generates new JWT refresh token with longer expiry
-func (j *JWTUtil) GenerateRefreshToken(userID, email, name, role string) (string, error) {
-	return j.generateTokenWithType(userID, email, name, role, TokenTypeRefresh, j.refreshExpiry)
+// GenerateToken generates new JWT access token carrying the role's permissions as scopes
+func (j *JWTUtil) GenerateToken(userID, email, name, role, tenantID string, scopes []string) (string, error) {
+	return j.generateTokenWithType(userID, email, name, role, tenantID, strings.Join(scopes, " "), auth.TokenTypeAccess, j.expiry)
 }
 
 // generateTokenWithType generates a JWT token with specified type and expiry
-func (j *JWTUtil) generateTokenWithType(userID, email, name, role, tokenType string, expiry time.Duration) (string, error) {
-	claims := JWTClaims{
-		UserID:    userID,
+func (j *JWTUtil) generateTokenWithType(userID, email, name, role, tenantID, scope, tokenType string, expiry time.Duration) (string, error) {
+	now := time.Now()
+	claims := &auth.Claims{
 		Email:     email,
 		Name:      name,
 		Role:      role,
+		Scope:     scope,
 		TokenType: tokenType,
+		TenantID:  tenantID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiry)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
+			Subject:   userID,
+			ExpiresAt: jwt.NewNumericDate(now.Add(expiry)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(j.secretKey))
+	return j.keys.Sign(claims)
 }
 
 // ValidateToken validates JWT token and returns claims
-func (j *JWTUtil) ValidateToken(tokenString string) (*JWTClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("unexpected signing method")
-		}
-		return []byte(j.secretKey), nil
-	})
-
-	if err != nil {
-		return nil, err
-	}
-
-	if claims, ok := token.Claims.(*JWTClaims); ok && token.Valid {
-		return claims, nil
-	}
-
-	return nil, errors.New("invalid token")
+func (j *JWTUtil) ValidateToken(tokenString string) (*auth.Claims, error) {
+	return j.keys.Parse(tokenString)
 }
 
 // GetExpiryDuration returns access token expiry duration
@@ -1,19 +1,36 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net"
+	"net/http"
 	"path/filepath"
 	"time"
 
 	"github.com/joho/godotenv"
+	"github.com/soheilhy/cmux"
+	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/event"
+	sharedauth "github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/buildinfo"
 	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/faultinject"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/interceptor"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/serviceauth"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/config"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/client"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/controller"
+	grpcHandler "github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/grpc"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/repository"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/router"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/service"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/storage"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/utility"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/worker"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 )
 
 func main() {
@@ -28,6 +45,13 @@ func main() {
 	// Load configuration
 	cfg := config.Load()
 
+	// Error reporting: panics and alarms go to Sentry when SENTRY_DSN is set, otherwise they are only logged
+	if err := errreport.Init(errreport.ConfigFromEnv("event-service")); err != nil {
+		log.Printf("⚠️  Warning: Error reporting disabled: %v", err)
+	}
+	defer errreport.Flush(2 * time.Second)
+	log.Printf("Build: %s", buildinfo.Get("event-service"))
+
 	// Initialize database connection
 	db, err := utility.NewDatabase(utility.DatabaseConfig{
 		URL:             cfg.GetDatabaseURL(),
@@ -68,30 +92,160 @@ func main() {
 	// Initialize Repository Layer
 	eventRepo := repository.NewEventRepository(db)
 	ticketTierRepo := repository.NewTicketTierRepository(db)
+	zoneRepo := repository.NewZoneRepository(db)
+	contentRepo := repository.NewContentRepository(db)
+	performerRepo := repository.NewPerformerRepository(db)
+	changeRepo := repository.NewChangeRepository(db)
+	settlementRepo := repository.NewSettlementRepository(db)
+	planRepo := repository.NewPlanRepository(db)
+	tenantRepo := repository.NewTenantRepository(db)
+	reportRepo := repository.NewReportRepository(db)
+	userRepo := repository.NewUserRepository(db)
+	verificationRepo := repository.NewVerificationRepository(db)
+	emailSenderRepo := repository.NewEmailSenderRepository(db)
+	ticketTemplateRepo := repository.NewTicketTemplateRepository(db)
+	analyticsRepo := repository.NewAnalyticsRepository(db)
+	revenueSplitRepo := repository.NewRevenueSplitRepository(db)
 
 	log.Println("Repository layer initialized")
 
+	// Fault injection for inter-service calls (FAULT_INJECTION, refused in production)
+	faults, err := faultinject.NewFromEnv()
+	if err != nil {
+		log.Printf("⚠️  Warning: Fault injection disabled: %v", err)
+	}
+
+	// Service identity signing the tokens of outgoing gRPC calls (SERVICE_AUTH_PRIVATE_KEY_FILE)
+	serviceIdentity, err := serviceauth.IdentityFromEnv(serviceauth.ServiceEvent)
+	if err != nil {
+		log.Fatalf("Failed to load service identity: %v", err)
+	}
+	if serviceIdentity == nil {
+		log.Println("⚠️  Warning: SERVICE_AUTH_PRIVATE_KEY_FILE not set, gRPC calls carry no service token")
+	}
+
+	// Initialize notification gRPC client (with auto-reconnect), emails organizers about moderation actions
+	notificationClient, err := client.NewNotificationClient(cfg.NotificationService.GRPCAddress, cfg.GRPC.MaxRecvMsgSize, cfg.GRPC.MaxSendMsgSize, faults, serviceIdentity)
+	if err != nil {
+		log.Fatalf("Failed to create notification client: %v", err)
+	}
+	defer notificationClient.Close()
+
 	// Initialize Service Layer with Redis caching
-	eventService := service.NewEventService(eventRepo, ticketTierRepo, redisClient)
+	planService := service.NewPlanService(planRepo, eventRepo, redisClient)
+	eventService := service.NewEventService(eventRepo, ticketTierRepo, zoneRepo, contentRepo, performerRepo, reportRepo, verificationRepo, planService, redisClient)
+	performerService := service.NewPerformerService(performerRepo, eventRepo, eventService, redisClient)
+
+	// Share images are uploaded to object storage when a bucket is configured, otherwise cached in Redis
+	var imageStore storage.ObjectStore
+	if cfg.SEO.ImageBucket != "" {
+		imageStore, err = storage.NewGCSStore(context.Background(), cfg.SEO.ImageBucket, cfg.SEO.ImageBaseURL)
+		if err != nil {
+			log.Fatalf("Failed to initialize share image storage: %v", err)
+		}
+		log.Printf("✓ Share images stored in bucket %s", cfg.SEO.ImageBucket)
+	}
+	moderationService := service.NewModerationService(reportRepo, eventRepo, userRepo, verificationRepo, notificationClient, redisClient, cfg.Moderation.AutoUnpublishThreshold, cfg.Moderation.VerifiedAutoUnpublishThreshold)
+	verificationService := service.NewVerificationService(verificationRepo, eventRepo, userRepo, redisClient)
+	revenueSplitService := service.NewRevenueSplitService(revenueSplitRepo, eventRepo, userRepo)
+	emailSenderService := service.NewEmailSenderService(emailSenderRepo, notificationClient)
+	ticketTemplateService := service.NewTicketTemplateService(ticketTemplateRepo, notificationClient)
+	analyticsService := service.NewAnalyticsService(analyticsRepo, eventRepo, redisClient)
+	seoService := service.NewSEOService(eventRepo, tenantRepo, imageStore, redisClient, cfg.SEO.EventBaseURL, cfg.SEO.APIBaseURL, cfg.SEO.BannerMaxBytes, cfg.SEO.BannerTimeout)
 
 	log.Println("Service layer initialized")
 
+	// Start background worker completing ended events
+	if cfg.Completion.Enabled {
+		completionService := service.NewEventCompletionService(eventRepo, settlementRepo, redisClient, cfg.Completion.BatchSize)
+		completionWorker := worker.NewEventCompletionWorker(completionService, cfg.Completion.Interval)
+		go completionWorker.Start(context.Background())
+		defer completionWorker.Stop()
+	}
+
+	// Start background worker repricing ticket tiers under dynamic pricing
+	if cfg.Pricing.Enabled {
+		pricingService := service.NewDynamicPricingService(ticketTierRepo, redisClient, cfg.Pricing.BatchSize)
+		pricingWorker := worker.NewDynamicPricingWorker(pricingService, cfg.Pricing.Interval)
+		go pricingWorker.Start(context.Background())
+		defer pricingWorker.Stop()
+	}
+
 	// Initialize Controller Layer
-	eventController := controller.NewEventController(eventService)
+	eventController := controller.NewEventController(eventService, planService)
+	planController := controller.NewPlanController(planService)
+	performerController := controller.NewPerformerController(performerService)
+	seoController := controller.NewSEOController(seoService)
+	moderationController := controller.NewModerationController(moderationService)
+	verificationController := controller.NewVerificationController(verificationService)
+	revenueSplitController := controller.NewRevenueSplitController(revenueSplitService)
+	emailSenderController := controller.NewEmailSenderController(emailSenderService)
+	ticketTemplateController := controller.NewTicketTemplateController(ticketTemplateService)
+	analyticsController := controller.NewAnalyticsController(analyticsService)
 
 	log.Println("Controller layer initialized")
 
+	// Load JWT keys for local token validation
+	jwtKeys, err := sharedauth.KeySetFromEnv(cfg.JWTSecret)
+	if err != nil {
+		log.Fatalf("Failed to load JWT keys: %v", err)
+	}
+
 	// Setup Router
-	r := router.SetupRouter(eventController, cfg.JWTSecret)
+	r := router.SetupRouter(eventController, planController, performerController, seoController, moderationController, verificationController, revenueSplitController, emailSenderController, ticketTemplateController, analyticsController, jwtKeys)
 
 	log.Println("Router configured")
 
+	// Authenticate and authorize callers of the gRPC server (SERVICE_AUTH_PUBLIC_KEYS)
+	serviceVerifier, err := serviceauth.VerifierFromEnv(serviceauth.ServiceEvent, grpcHandler.ServiceAuthPolicy)
+	if err != nil {
+		log.Fatalf("Failed to load service auth keys: %v", err)
+	}
+	if serviceVerifier == nil {
+		log.Println("⚠️  Warning: SERVICE_AUTH_PUBLIC_KEYS not set, gRPC callers are not authenticated")
+	}
+
+	// Initialize gRPC server for internal event/tier reads (ticketing-service)
+	grpcServer := grpc.NewServer(
+		interceptor.UnaryServer(serviceVerifier.UnaryServerInterceptor()),
+		interceptor.StreamServer(serviceVerifier.StreamServerInterceptor()),
+		grpc.MaxRecvMsgSize(cfg.GRPC.MaxRecvMsgSize),
+		grpc.MaxSendMsgSize(cfg.GRPC.MaxSendMsgSize),
+	)
+	pb.RegisterEventServiceServer(grpcServer, grpcHandler.NewEventGRPCServer(eventRepo, ticketTierRepo, changeRepo, planRepo, planService))
+	reflection.Register(grpcServer)
+	buildinfo.RegisterGRPC(grpcServer, "event-service")
+
+	log.Println("gRPC server initialized")
+
 	// Start server
+	// HTTP and gRPC share one port (Cloud Run only allows one port)
 	addr := fmt.Sprintf(":%s", cfg.Port)
 	log.Printf("Event Service starting on port %s", cfg.Port)
 	log.Printf("Environment: %s", cfg.Environment)
 
-	if err := r.Run(addr); err != nil {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("Failed to create listener: %v", err)
+	}
+
+	m := cmux.New(listener)
+	grpcListener := m.MatchWithWriters(cmux.HTTP2MatchHeaderFieldSendSettings("content-type", "application/grpc"))
+	httpListener := m.Match(cmux.Any())
+
+	go func() {
+		if err := (&http.Server{Handler: r}).Serve(httpListener); err != nil && err != http.ErrServerClosed {
+			log.Printf("HTTP server error: %v", err)
+		}
+	}()
+
+	go func() {
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			log.Printf("gRPC server error: %v", err)
+		}
+	}()
+
+	if err := m.Serve(); err != nil {
 		log.Fatalf("Failed to start server: %v", err)
 	}
 }
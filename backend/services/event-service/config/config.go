@@ -3,14 +3,64 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 )
 
 // Config holds application configuration
 type Config struct {
-	Port        string
-	Database    DatabaseConfig
-	JWTSecret   string
-	Environment string
+	Port                string
+	Database            DatabaseConfig
+	JWTSecret           string
+	GRPC                GRPCConfig
+	Completion          CompletionConfig
+	Pricing             DynamicPricingConfig
+	SEO                 SEOConfig
+	Moderation          ModerationConfig
+	NotificationService NotificationServiceConfig
+	Environment         string
+}
+
+// GRPCConfig holds gRPC message size limits (in bytes)
+// The gRPC server shares the HTTP port (see cmd/main.go)
+type GRPCConfig struct {
+	MaxRecvMsgSize int // Default: 4 MB (gRPC default)
+	MaxSendMsgSize int // Default: 4 MB
+}
+
+// CompletionConfig holds configuration of the worker completing ended events
+type CompletionConfig struct {
+	Enabled   bool
+	Interval  time.Duration // Max delay between end_date and completion, default: 5 minutes
+	BatchSize int           // Events completed or settled per query, default: 100
+}
+
+// DynamicPricingConfig holds configuration of the worker repricing ticket tiers under dynamic pricing
+type DynamicPricingConfig struct {
+	Enabled   bool
+	Interval  time.Duration // Max delay between a rule step being reached and the new price, default: 1 minute
+	BatchSize int           // Tiers repriced per query, default: 100
+}
+
+// SEOConfig holds configuration of event SEO metadata and share images
+type SEOConfig struct {
+	EventBaseURL   string        // Public event page, joined with the event slug
+	APIBaseURL     string        // Public API (gateway) URL the share image endpoint is served under
+	ImageBucket    string        // GCS bucket for rendered share images; empty serves them from Redis
+	ImageBaseURL   string        // Public URL of the bucket (or its CDN), default: https://storage.googleapis.com/<bucket>
+	BannerMaxBytes int64         // Largest banner fetched as card background, default: 5 MB
+	BannerTimeout  time.Duration // Banner fetch timeout, default: 5 seconds
+}
+
+// ModerationConfig holds configuration of event abuse reports
+type ModerationConfig struct {
+	AutoUnpublishThreshold         int // Open reports from distinct users that unpublish an event pending review, default: 5
+	VerifiedAutoUnpublishThreshold int // Same for events of verified organizers, default: twice AutoUnpublishThreshold
+}
+
+// NotificationServiceConfig holds notification service gRPC configuration
+type NotificationServiceConfig struct {
+	GRPCAddress string
 }
 
 // DatabaseConfig holds database configuration
@@ -25,6 +75,81 @@ type DatabaseConfig struct {
 
 // Load loads configuration from environment variables
 func Load() *Config {
+	// Parse gRPC message size limits (default 4 MB)
+	grpcMaxRecv := 4 * 1024 * 1024
+	if sizeStr := os.Getenv("GRPC_MAX_RECV_MSG_SIZE"); sizeStr != "" {
+		if size, err := strconv.Atoi(sizeStr); err == nil && size > 0 {
+			grpcMaxRecv = size
+		}
+	}
+
+	grpcMaxSend := 4 * 1024 * 1024
+	if sizeStr := os.Getenv("GRPC_MAX_SEND_MSG_SIZE"); sizeStr != "" {
+		if size, err := strconv.Atoi(sizeStr); err == nil && size > 0 {
+			grpcMaxSend = size
+		}
+	}
+
+	// Parse event completion settings (default: every 5 minutes, 100 events per query)
+	completionInterval := 5 * time.Minute
+	if intervalStr := os.Getenv("EVENT_COMPLETION_INTERVAL"); intervalStr != "" {
+		if d, err := time.ParseDuration(intervalStr); err == nil && d > 0 {
+			completionInterval = d
+		}
+	}
+
+	completionBatchSize := 100
+	if sizeStr := os.Getenv("EVENT_COMPLETION_BATCH_SIZE"); sizeStr != "" {
+		if size, err := strconv.Atoi(sizeStr); err == nil && size > 0 {
+			completionBatchSize = size
+		}
+	}
+
+	// Parse dynamic pricing settings (default: every minute, 100 tiers per query)
+	pricingInterval := time.Minute
+	if intervalStr := os.Getenv("DYNAMIC_PRICING_INTERVAL"); intervalStr != "" {
+		if d, err := time.ParseDuration(intervalStr); err == nil && d > 0 {
+			pricingInterval = d
+		}
+	}
+
+	pricingBatchSize := 100
+	if sizeStr := os.Getenv("DYNAMIC_PRICING_BATCH_SIZE"); sizeStr != "" {
+		if size, err := strconv.Atoi(sizeStr); err == nil && size > 0 {
+			pricingBatchSize = size
+		}
+	}
+
+	// Parse share image banner limits (default: 5 MB within 5 seconds)
+	bannerMaxBytes := int64(5 * 1024 * 1024)
+	if sizeStr := os.Getenv("OG_BANNER_MAX_BYTES"); sizeStr != "" {
+		if size, err := strconv.ParseInt(sizeStr, 10, 64); err == nil && size > 0 {
+			bannerMaxBytes = size
+		}
+	}
+
+	bannerTimeout := 5 * time.Second
+	if timeoutStr := os.Getenv("OG_BANNER_TIMEOUT"); timeoutStr != "" {
+		if d, err := time.ParseDuration(timeoutStr); err == nil && d > 0 {
+			bannerTimeout = d
+		}
+	}
+
+	// Parse abuse report thresholds (default: 5 open reports, twice as many for verified organizers)
+	autoUnpublishThreshold := 5
+	if thresholdStr := os.Getenv("REPORT_AUTO_UNPUBLISH_THRESHOLD"); thresholdStr != "" {
+		if threshold, err := strconv.Atoi(thresholdStr); err == nil && threshold > 0 {
+			autoUnpublishThreshold = threshold
+		}
+	}
+
+	verifiedAutoUnpublishThreshold := 2 * autoUnpublishThreshold
+	if thresholdStr := os.Getenv("REPORT_VERIFIED_AUTO_UNPUBLISH_THRESHOLD"); thresholdStr != "" {
+		if threshold, err := strconv.Atoi(thresholdStr); err == nil && threshold > 0 {
+			verifiedAutoUnpublishThreshold = threshold
+		}
+	}
+
 	return &Config{
 		Port: getEnv("EVENT_SERVER_PORT", "8082"),
 		Database: DatabaseConfig{
@@ -35,7 +160,36 @@ func Load() *Config {
 			Name:     getEnv("DB_NAME", "ticketing_platform"),
 			SSLMode:  getEnv("DB_SSL_MODE", "disable"),
 		},
-		JWTSecret:   getEnv("JWT_SECRET", "your-secret-key"),
+		JWTSecret: getEnv("JWT_SECRET", "your-secret-key"),
+		GRPC: GRPCConfig{
+			MaxRecvMsgSize: grpcMaxRecv,
+			MaxSendMsgSize: grpcMaxSend,
+		},
+		Completion: CompletionConfig{
+			Enabled:   getEnv("EVENT_COMPLETION_ENABLED", "true") == "true",
+			Interval:  completionInterval,
+			BatchSize: completionBatchSize,
+		},
+		Pricing: DynamicPricingConfig{
+			Enabled:   getEnv("DYNAMIC_PRICING_ENABLED", "true") == "true",
+			Interval:  pricingInterval,
+			BatchSize: pricingBatchSize,
+		},
+		SEO: SEOConfig{
+			EventBaseURL:   getEnv("EVENT_PAGE_BASE_URL", "http://localhost:3000/events"),
+			APIBaseURL:     getEnv("PUBLIC_API_BASE_URL", "http://localhost:8080/api/v1"),
+			ImageBucket:    getEnv("OG_IMAGE_BUCKET", ""),
+			ImageBaseURL:   getEnv("OG_IMAGE_BASE_URL", ""),
+			BannerMaxBytes: bannerMaxBytes,
+			BannerTimeout:  bannerTimeout,
+		},
+		Moderation: ModerationConfig{
+			AutoUnpublishThreshold:         autoUnpublishThreshold,
+			VerifiedAutoUnpublishThreshold: verifiedAutoUnpublishThreshold,
+		},
+		NotificationService: NotificationServiceConfig{
+			GRPCAddress: getEnv("NOTIFICATION_SERVICE_GRPC_ADDR", "localhost:50055"),
+		},
 		Environment: getEnv("ENVIRONMENT", "development"),
 	}
 }
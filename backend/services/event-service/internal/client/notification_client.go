@@ -0,0 +1,219 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/notification"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/faultinject"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/interceptor"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/serviceauth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrSenderDomainNotFound is returned when the email provider no longer knows a sending domain
+	ErrSenderDomainNotFound = errors.New("sender domain not found at email provider")
+	// ErrTicketLogoUnavailable is returned when notification service can't fetch a ticket template's logo
+	ErrTicketLogoUnavailable = errors.New("ticket template logo can't be used")
+)
+
+// NotificationClient handles gRPC communication with Notification Service
+type NotificationClient struct {
+	client pb.NotificationServiceClient
+	conn   *grpc.ClientConn
+}
+
+// ModerationEmailRequest represents a moderation action on a reported event to tell the organizer about
+type ModerationEmailRequest struct {
+	EventID        string
+	RecipientEmail string
+	RecipientName  string
+	EventName      string
+	Action         string // unpublished, restored, removed
+	ReportCount    int
+	Reasons        []string
+	Note           string
+}
+
+// SenderDomain represents an organizer's sending domain registered with the email provider
+type SenderDomain struct {
+	ID      string
+	Name    string
+	Status  string // not_started, pending, verified, failed, temporary_failure
+	Records []DNSRecord
+}
+
+// DNSRecord represents a DNS record the organizer must publish for its domain to be verified
+type DNSRecord struct {
+	Record   string // SPF or DKIM
+	Name     string
+	Type     string
+	Value    string
+	TTL      string
+	Priority int
+	Status   string
+}
+
+// TicketTemplate represents an organizer's e-ticket PDF layout, empty fields keep the platform layout
+type TicketTemplate struct {
+	LogoURL       string
+	PrimaryColor  string
+	AccentColor   string
+	SponsorFooter string
+	TermsText     string
+}
+
+// NewNotificationClient creates new notification gRPC client instance
+// Connection is lazy and will auto-reconnect if service is unavailable
+// maxRecvMsgSize and maxSendMsgSize limit the size of a single gRPC message (bytes)
+// faults injects configured latency and errors into calls outside production, may be nil
+// identity signs the service token attached to every call, may be nil
+func NewNotificationClient(grpcURL string, maxRecvMsgSize, maxSendMsgSize int, faults *faultinject.Injector, identity *serviceauth.Identity) (*NotificationClient, error) {
+	// Use TLS for Cloud Run services (production) or insecure for localhost (development)
+	var creds credentials.TransportCredentials
+	if grpcURL == "localhost:50055" || grpcURL == "127.0.0.1:50055" {
+		creds = insecure.NewCredentials()
+		log.Printf("[NotificationGRPC] Using insecure connection for local development")
+	} else {
+		// Use TLS for Cloud Run
+		creds = credentials.NewClientTLSFromCert(nil, "")
+		log.Printf("[NotificationGRPC] Using TLS connection for Cloud Run")
+	}
+
+	conn, err := grpc.NewClient(
+		grpcURL,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(maxRecvMsgSize),
+			grpc.MaxCallSendMsgSize(maxSendMsgSize),
+		),
+		interceptor.UnaryClient(),
+		interceptor.StreamClient(),
+		faults.DialOption(faultinject.TargetNotification),
+		identity.DialOption(serviceauth.ServiceNotification),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create notification client: %w", err)
+	}
+
+	log.Printf("[NotificationGRPC] Notification client initialized for %s (lazy connection with auto-reconnect)", grpcURL)
+
+	return &NotificationClient{
+		client: pb.NewNotificationServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// SendModerationEmail sends an event moderation email via gRPC
+func (c *NotificationClient) SendModerationEmail(ctx context.Context, req *ModerationEmailRequest) error {
+	callCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	resp, err := c.client.SendEventModerationEmail(callCtx, &pb.SendEventModerationEmailRequest{
+		EventId:        req.EventID,
+		RecipientEmail: req.RecipientEmail,
+		RecipientName:  req.RecipientName,
+		EventName:      req.EventName,
+		Action:         req.Action,
+		ReportCount:    int32(req.ReportCount),
+		Reasons:        req.Reasons,
+		Note:           req.Note,
+	})
+	if err != nil {
+		return fmt.Errorf("gRPC call failed: %w", err)
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("moderation email failed: %s", resp.Message)
+	}
+
+	return nil
+}
+
+// RegisterSenderDomain registers an organizer's sending domain with the email provider via gRPC
+func (c *NotificationClient) RegisterSenderDomain(ctx context.Context, domain string) (*SenderDomain, error) {
+	resp, err := c.client.RegisterSenderDomain(ctx, &pb.RegisterSenderDomainRequest{Domain: domain})
+	if err != nil {
+		return nil, fmt.Errorf("gRPC call failed: %w", err)
+	}
+
+	log.Printf("[NotificationGRPC] Sender domain %s registered, ID: %s", resp.Name, resp.Id)
+
+	return senderDomainFromProto(resp), nil
+}
+
+// VerifySenderDomain checks the DNS records of a registered sending domain via gRPC
+// Returns ErrSenderDomainNotFound when the email provider doesn't know the domain
+func (c *NotificationClient) VerifySenderDomain(ctx context.Context, domainID string) (*SenderDomain, error) {
+	resp, err := c.client.VerifySenderDomain(ctx, &pb.VerifySenderDomainRequest{DomainId: domainID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, ErrSenderDomainNotFound
+		}
+		return nil, fmt.Errorf("gRPC call failed: %w", err)
+	}
+	return senderDomainFromProto(resp), nil
+}
+
+// GenerateTicketPreviewPDF renders a sample e-ticket with an organizer's ticket template via gRPC
+// Returns ErrTicketLogoUnavailable, with notification service's reason, when the logo can't be fetched
+func (c *NotificationClient) GenerateTicketPreviewPDF(ctx context.Context, eventName string, tpl *TicketTemplate) ([]byte, error) {
+	callCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GenerateTicketPreviewPDF(callCtx, &pb.GenerateTicketPreviewPDFRequest{
+		Template: &pb.TicketTemplate{
+			LogoUrl:       tpl.LogoURL,
+			PrimaryColor:  tpl.PrimaryColor,
+			AccentColor:   tpl.AccentColor,
+			SponsorFooter: tpl.SponsorFooter,
+			TermsText:     tpl.TermsText,
+		},
+		EventName: eventName,
+	})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, fmt.Errorf("%w: %s", ErrTicketLogoUnavailable, status.Convert(err).Message())
+		}
+		return nil, fmt.Errorf("gRPC call failed: %w", err)
+	}
+
+	return resp.Pdf, nil
+}
+
+func senderDomainFromProto(resp *pb.SenderDomain) *SenderDomain {
+	domain := &SenderDomain{
+		ID:      resp.Id,
+		Name:    resp.Name,
+		Status:  resp.Status,
+		Records: make([]DNSRecord, len(resp.Records)),
+	}
+	for i, record := range resp.Records {
+		domain.Records[i] = DNSRecord{
+			Record:   record.Record,
+			Name:     record.Name,
+			Type:     record.Type,
+			Value:    record.Value,
+			TTL:      record.Ttl,
+			Priority: int(record.Priority),
+			Status:   record.Status,
+		}
+	}
+	return domain
+}
+
+// Close closes the gRPC connection
+func (c *NotificationClient) Close() error {
+	if c.conn != nil {
+		return c.conn.Close()
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/service"
+)
+
+// AnalyticsController handles HTTP requests for organizer sales analytics
+type AnalyticsController struct {
+	analyticsService service.AnalyticsService
+}
+
+// NewAnalyticsController creates new analytics controller instance
+func NewAnalyticsController(analyticsService service.AnalyticsService) *AnalyticsController {
+	return &AnalyticsController{
+		analyticsService: analyticsService,
+	}
+}
+
+// GetEventAnalytics handles GET /organizer/events/:id/analytics
+func (c *AnalyticsController) GetEventAnalytics(ctx *gin.Context) {
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	analytics, err := c.analyticsService.GetEventAnalytics(ctx.Request.Context(), organizerID.(string), ctx.Param("id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrEventNotFound):
+			ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrEventNotFound, sharedresponse.CodeEventNotFound, nil))
+		case errors.Is(err, service.ErrUnauthorized):
+			ctx.JSON(http.StatusForbidden, sharedresponse.ErrorWithCode(message.ErrForbidden, sharedresponse.CodeForbidden, nil))
+		default:
+			log.Printf("[AnalyticsController] Event analytics failed: %v", err)
+			ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgAnalyticsRetrieved,
+		"data":    analytics,
+	})
+}
@@ -0,0 +1,124 @@
+package controller
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/service"
+)
+
+// EmailSenderController handles HTTP requests for organizers' white-label email senders
+type EmailSenderController struct {
+	emailSenderService service.EmailSenderService
+}
+
+// NewEmailSenderController creates new email sender controller instance
+func NewEmailSenderController(emailSenderService service.EmailSenderService) *EmailSenderController {
+	return &EmailSenderController{
+		emailSenderService: emailSenderService,
+	}
+}
+
+// GetMySender handles GET /organizer/email-sender
+func (c *EmailSenderController) GetMySender(ctx *gin.Context) {
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	sender, err := c.emailSenderService.GetMySender(ctx.Request.Context(), organizerID.(string))
+	if err != nil {
+		c.respondEmailSenderError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgSenderRetrieved,
+		"data":    sender,
+	})
+}
+
+// ConfigureSender handles PUT /organizer/email-sender
+func (c *EmailSenderController) ConfigureSender(ctx *gin.Context) {
+	var req request.ConfigureEmailSenderRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	sender, err := c.emailSenderService.ConfigureSender(ctx.Request.Context(), organizerID.(string), &req)
+	if err != nil {
+		c.respondEmailSenderError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgSenderConfigured,
+		"data":    sender,
+	})
+}
+
+// VerifySender handles POST /organizer/email-sender/verify
+func (c *EmailSenderController) VerifySender(ctx *gin.Context) {
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	sender, err := c.emailSenderService.VerifySender(ctx.Request.Context(), organizerID.(string))
+	if err != nil {
+		c.respondEmailSenderError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgSenderVerified,
+		"data":    sender,
+	})
+}
+
+// DeleteSender handles DELETE /organizer/email-sender
+func (c *EmailSenderController) DeleteSender(ctx *gin.Context) {
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	if err := c.emailSenderService.DeleteSender(ctx.Request.Context(), organizerID.(string)); err != nil {
+		c.respondEmailSenderError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgSenderDeleted,
+	})
+}
+
+// respondEmailSenderError maps email sender operation errors to HTTP responses
+func (c *EmailSenderController) respondEmailSenderError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrEmailSenderNotFound):
+		ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrEmailSenderNotFound, sharedresponse.CodeEmailSenderNotFound, nil))
+	case errors.Is(err, service.ErrSenderAddressDomain):
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrSenderAddressDomain, sharedresponse.CodeSenderAddressDomain, nil))
+	case errors.Is(err, service.ErrSenderDomainTaken):
+		ctx.JSON(http.StatusConflict, sharedresponse.ErrorWithCode(message.ErrSenderDomainTaken, sharedresponse.CodeSenderDomainTaken, nil))
+	default:
+		log.Printf("[EmailSenderController] Email sender operation failed: %v", err)
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+	}
+}
@@ -1,10 +1,15 @@
 package controller
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/pricing"
 	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/message"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/request"
@@ -14,12 +19,14 @@ import (
 // EventController handles HTTP requests for events
 type EventController struct {
 	eventService service.EventService
+	planService  service.PlanService
 }
 
 // NewEventController creates new event controller instance
-func NewEventController(eventService service.EventService) *EventController {
+func NewEventController(eventService service.EventService, planService service.PlanService) *EventController {
 	return &EventController{
 		eventService: eventService,
+		planService:  planService,
 	}
 }
 
@@ -27,19 +34,14 @@ func NewEventController(eventService service.EventService) *EventController {
 func (c *EventController) CreateEvent(ctx *gin.Context) {
 	var req request.CreateEventRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error":   message.ErrInvalidRequest,
-			"details": err.Error(),
-		})
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
 		return
 	}
 
 	// Get organizer ID from context (set by auth middleware)
 	organizerID, exists := ctx.Get("user_id")
 	if !exists {
-		ctx.JSON(http.StatusUnauthorized, gin.H{
-			"error": message.ErrUnauthorized,
-		})
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
 		return
 	}
 
@@ -47,15 +49,16 @@ func (c *EventController) CreateEvent(ctx *gin.Context) {
 	event, err := c.eventService.CreateEvent(ctx.Request.Context(), organizerID.(string), &req)
 	if err != nil {
 		if errors.Is(err, service.ErrInvalidDateRange) {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": message.ErrInvalidDateRange,
-			})
+			ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidDateRange, sharedresponse.CodeInvalidDateRange, nil))
 			return
 		}
 
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"error": message.ErrInternalServer,
-		})
+		if errors.Is(err, service.ErrEventQuotaExceeded) {
+			c.respondQuotaExceeded(ctx, organizerID.(string), message.ErrEventQuotaExceeded, sharedresponse.CodeEventQuotaExceeded)
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
 		return
 	}
 
@@ -72,18 +75,15 @@ func (c *EventController) GetEvent(ctx *gin.Context) {
 	event, err := c.eventService.GetEventByID(ctx.Request.Context(), id)
 	if err != nil {
 		if errors.Is(err, service.ErrEventNotFound) {
-			ctx.JSON(http.StatusNotFound, gin.H{
-				"error": message.ErrEventNotFound,
-			})
+			ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrEventNotFound, sharedresponse.CodeEventNotFound, nil))
 			return
 		}
 
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"error": message.ErrInternalServer,
-		})
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
 		return
 	}
 
+	ctx.Header("ETag", versionETag(event.Version))
 	ctx.JSON(http.StatusOK, gin.H{
 		"message": message.MsgEventRetrieved,
 		"data":    event,
@@ -97,15 +97,11 @@ func (c *EventController) GetEventBySlug(ctx *gin.Context) {
 	event, err := c.eventService.GetEventBySlug(ctx.Request.Context(), slug)
 	if err != nil {
 		if errors.Is(err, service.ErrEventNotFound) {
-			ctx.JSON(http.StatusNotFound, gin.H{
-				"error": message.ErrEventNotFound,
-			})
+			ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrEventNotFound, sharedresponse.CodeEventNotFound, nil))
 			return
 		}
 
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"error": message.ErrInternalServer,
-		})
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
 		return
 	}
 
@@ -119,13 +115,13 @@ func (c *EventController) GetEventBySlug(ctx *gin.Context) {
 func (c *EventController) ListEvents(ctx *gin.Context) {
 	var filters request.ListEventsRequest
 	if err := ctx.ShouldBindQuery(&filters); err != nil {
-		ctx.JSON(http.StatusBadRequest, sharedresponse.Error(message.ErrInvalidRequest, err.Error()))
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
 		return
 	}
 
 	events, err := c.eventService.ListEvents(ctx.Request.Context(), filters)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, sharedresponse.Error(message.ErrInternalServer, err.Error()))
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, err.Error()))
 		return
 	}
 
@@ -138,52 +134,85 @@ func (c *EventController) UpdateEvent(ctx *gin.Context) {
 
 	var req request.UpdateEventRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error":   message.ErrInvalidRequest,
-			"details": err.Error(),
-		})
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
 		return
 	}
 
 	// Get organizer ID from context
 	organizerID, exists := ctx.Get("user_id")
 	if !exists {
-		ctx.JSON(http.StatusUnauthorized, gin.H{
-			"error": message.ErrUnauthorized,
-		})
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
 		return
 	}
 
+	// Expected version from body, or from If-Match header
+	if req.Version == nil {
+		version, err := parseIfMatchVersion(ctx.GetHeader("If-Match"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidVersion, sharedresponse.CodeInvalidVersion, nil))
+			return
+		}
+		req.Version = version
+	}
+
 	// Update event
 	event, err := c.eventService.UpdateEvent(ctx.Request.Context(), organizerID.(string), id, &req)
 	if err != nil {
+		if errors.Is(err, service.ErrVersionConflict) {
+			// Return latest state so client can merge and retry
+			latest, getErr := c.eventService.GetEventByID(ctx.Request.Context(), id)
+			if getErr == nil {
+				ctx.Header("ETag", versionETag(latest.Version))
+			}
+			ctx.JSON(http.StatusConflict, sharedresponse.ErrorWithData(message.ErrVersionConflict, sharedresponse.CodeVersionConflict, latest))
+			return
+		}
+
+		if errors.Is(err, service.ErrEventCompleted) {
+			ctx.JSON(http.StatusConflict, sharedresponse.ErrorWithCode(message.ErrEventCompleted, sharedresponse.CodeEventCompleted, nil))
+			return
+		}
+
+		if errors.Is(err, service.ErrEventUnderReview) {
+			ctx.JSON(http.StatusConflict, sharedresponse.ErrorWithCode(message.ErrEventUnderReview, sharedresponse.CodeEventUnderReview, nil))
+			return
+		}
+
+		if errors.Is(err, service.ErrSandboxLiveEvent) {
+			ctx.JSON(http.StatusConflict, sharedresponse.ErrorWithCode(message.ErrSandboxLiveEvent, sharedresponse.CodeSandboxLiveEvent, nil))
+			return
+		}
+
+		if errors.Is(err, service.ErrSandboxOrdersRemain) {
+			ctx.JSON(http.StatusConflict, sharedresponse.ErrorWithCode(message.ErrSandboxOrdersRemain, sharedresponse.CodeSandboxOrdersRemain, nil))
+			return
+		}
+
 		if errors.Is(err, service.ErrEventNotFound) {
-			ctx.JSON(http.StatusNotFound, gin.H{
-				"error": message.ErrEventNotFound,
-			})
+			ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrEventNotFound, sharedresponse.CodeEventNotFound, nil))
 			return
 		}
 
 		if errors.Is(err, service.ErrUnauthorized) {
-			ctx.JSON(http.StatusForbidden, gin.H{
-				"error": message.ErrForbidden,
-			})
+			ctx.JSON(http.StatusForbidden, sharedresponse.ErrorWithCode(message.ErrForbidden, sharedresponse.CodeForbidden, nil))
 			return
 		}
 
 		if errors.Is(err, service.ErrInvalidDateRange) {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": message.ErrInvalidDateRange,
-			})
+			ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidDateRange, sharedresponse.CodeInvalidDateRange, nil))
 			return
 		}
 
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"error": message.ErrInternalServer,
-		})
+		if errors.Is(err, service.ErrEventQuotaExceeded) {
+			c.respondQuotaExceeded(ctx, organizerID.(string), message.ErrEventQuotaExceeded, sharedresponse.CodeEventQuotaExceeded)
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
 		return
 	}
 
+	ctx.Header("ETag", versionETag(event.Version))
 	ctx.JSON(http.StatusOK, gin.H{
 		"message": message.MsgEventUpdated,
 		"data":    event,
@@ -197,9 +226,7 @@ func (c *EventController) DeleteEvent(ctx *gin.Context) {
 	// Get organizer ID from context
 	organizerID, exists := ctx.Get("user_id")
 	if !exists {
-		ctx.JSON(http.StatusUnauthorized, gin.H{
-			"error": message.ErrUnauthorized,
-		})
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
 		return
 	}
 
@@ -207,22 +234,16 @@ func (c *EventController) DeleteEvent(ctx *gin.Context) {
 	err := c.eventService.DeleteEvent(ctx.Request.Context(), organizerID.(string), id)
 	if err != nil {
 		if errors.Is(err, service.ErrEventNotFound) {
-			ctx.JSON(http.StatusNotFound, gin.H{
-				"error": message.ErrEventNotFound,
-			})
+			ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrEventNotFound, sharedresponse.CodeEventNotFound, nil))
 			return
 		}
 
 		if errors.Is(err, service.ErrUnauthorized) {
-			ctx.JSON(http.StatusForbidden, gin.H{
-				"error": message.ErrForbidden,
-			})
+			ctx.JSON(http.StatusForbidden, sharedresponse.ErrorWithCode(message.ErrForbidden, sharedresponse.CodeForbidden, nil))
 			return
 		}
 
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"error": message.ErrInternalServer,
-		})
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
 		return
 	}
 
@@ -236,17 +257,13 @@ func (c *EventController) GetOrganizerEvents(ctx *gin.Context) {
 	// Get organizer ID from context
 	organizerID, exists := ctx.Get("user_id")
 	if !exists {
-		ctx.JSON(http.StatusUnauthorized, gin.H{
-			"error": message.ErrUnauthorized,
-		})
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
 		return
 	}
 
 	events, err := c.eventService.GetOrganizerEvents(ctx.Request.Context(), organizerID.(string))
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"error": message.ErrInternalServer,
-		})
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
 		return
 	}
 
@@ -256,23 +273,132 @@ func (c *EventController) GetOrganizerEvents(ctx *gin.Context) {
 	})
 }
 
+// GetOrganizerEventIDs handles GET /organizer/event-ids
+func (c *EventController) GetOrganizerEventIDs(ctx *gin.Context) {
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	ids, err := c.eventService.GetOrganizerEventIDs(ctx.Request.Context(), organizerID.(string))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgEventsRetrieved,
+		"data":    ids,
+	})
+}
+
+// ImportEvents handles POST /organizer/events/import
+// The body is an events file, JSON or CSV; with dry_run=true it is only validated
+func (c *EventController) ImportEvents(ctx *gin.Context) {
+	var req request.ImportEventsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	format := req.Format
+	if format == "" {
+		format = request.EventsFileJSON
+		if ctx.ContentType() == "text/csv" {
+			format = request.EventsFileCSV
+		}
+	}
+
+	result, err := c.eventService.ImportEvents(ctx.Request.Context(), organizerID.(string), format, ctx.Request.Body, req.DryRun)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidEventsFile) {
+			ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidEventsFile, sharedresponse.CodeInvalidRequest, err.Error()))
+			return
+		}
+
+		if errors.Is(err, service.ErrImportIncomplete) {
+			ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithData(message.ErrImportIncomplete, sharedresponse.CodeInternal, result))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+		return
+	}
+
+	if len(result.Errors) > 0 {
+		ctx.JSON(http.StatusUnprocessableEntity, sharedresponse.ErrorWithData(message.ErrImportInvalidRows, sharedresponse.CodeValidationFailed, result))
+		return
+	}
+
+	if result.DryRun {
+		ctx.JSON(http.StatusOK, gin.H{
+			"message": message.MsgEventsImportValid,
+			"data":    result,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": message.MsgEventsImported,
+		"data":    result,
+	})
+}
+
+// ExportEvents handles GET /organizer/events/export
+// The events file is returned as an attachment that ImportEvents accepts
+func (c *EventController) ExportEvents(ctx *gin.Context) {
+	var req request.ExportEventsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	file, err := c.eventService.ExportEvents(ctx.Request.Context(), organizerID.(string), req.Status)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+		return
+	}
+
+	if req.Format == request.EventsFileCSV {
+		var buf bytes.Buffer
+		if err := service.WriteEventsCSV(&buf, file); err != nil {
+			ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+			return
+		}
+		ctx.Header("Content-Disposition", `attachment; filename="events.csv"`)
+		ctx.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+		return
+	}
+
+	ctx.Header("Content-Disposition", `attachment; filename="events.json"`)
+	ctx.JSON(http.StatusOK, file)
+}
+
 // CreateTicketTier handles POST /ticket-tiers
 func (c *EventController) CreateTicketTier(ctx *gin.Context) {
 	var req request.CreateTicketTierRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error":   message.ErrInvalidRequest,
-			"details": err.Error(),
-		})
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
 		return
 	}
 
 	// Get organizer ID from context
 	organizerID, exists := ctx.Get("user_id")
 	if !exists {
-		ctx.JSON(http.StatusUnauthorized, gin.H{
-			"error": message.ErrUnauthorized,
-		})
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
 		return
 	}
 
@@ -280,16 +406,12 @@ func (c *EventController) CreateTicketTier(ctx *gin.Context) {
 	tier, err := c.eventService.CreateTicketTier(ctx.Request.Context(), organizerID.(string), &req)
 	if err != nil {
 		if errors.Is(err, service.ErrEventNotFound) {
-			ctx.JSON(http.StatusNotFound, gin.H{
-				"error": message.ErrEventNotFound,
-			})
+			ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrEventNotFound, sharedresponse.CodeEventNotFound, nil))
 			return
 		}
 
 		if errors.Is(err, service.ErrUnauthorized) {
-			ctx.JSON(http.StatusForbidden, gin.H{
-				"error": message.ErrForbidden,
-			})
+			ctx.JSON(http.StatusForbidden, sharedresponse.ErrorWithCode(message.ErrForbidden, sharedresponse.CodeForbidden, nil))
 			return
 		}
 
@@ -297,15 +419,26 @@ func (c *EventController) CreateTicketTier(ctx *gin.Context) {
 		if errors.Is(err, request.ErrInvalidEarlyBirdSettings) ||
 			errors.Is(err, request.ErrInvalidEarlyBirdPrice) ||
 			errors.Is(err, request.ErrInvalidEarlyBirdEndDate) {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
+			ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(err.Error(), sharedresponse.CodeInvalidEarlyBirdConfig, nil))
 			return
 		}
 
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"error": message.ErrInternalServer,
-		})
+		if errors.Is(err, request.ErrInvalidAccessibleQuota) {
+			ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(err.Error(), sharedresponse.CodeInvalidAccessibleQuota, nil))
+			return
+		}
+
+		if errors.Is(err, request.ErrDynamicPricingEarlyBird) || errors.Is(err, pricing.ErrInvalidDynamicRule) {
+			ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(err.Error(), sharedresponse.CodeInvalidDynamicPricing, nil))
+			return
+		}
+
+		if errors.Is(err, service.ErrTicketQuotaExceeded) {
+			c.respondQuotaExceeded(ctx, organizerID.(string), message.ErrTicketQuotaExceeded, sharedresponse.CodeTicketQuotaExceeded)
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
 		return
 	}
 
@@ -322,18 +455,15 @@ func (c *EventController) GetTicketTier(ctx *gin.Context) {
 	tier, err := c.eventService.GetTicketTierByID(ctx.Request.Context(), id)
 	if err != nil {
 		if errors.Is(err, service.ErrTicketTierNotFound) {
-			ctx.JSON(http.StatusNotFound, gin.H{
-				"error": message.ErrTicketTierNotFound,
-			})
+			ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrTicketTierNotFound, sharedresponse.CodeTicketTierNotFound, nil))
 			return
 		}
 
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"error": message.ErrInternalServer,
-		})
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
 		return
 	}
 
+	ctx.Header("ETag", versionETag(tier.Version))
 	ctx.JSON(http.StatusOK, gin.H{
 		"data": tier,
 	})
@@ -345,9 +475,7 @@ func (c *EventController) GetEventTicketTiers(ctx *gin.Context) {
 
 	tiers, err := c.eventService.GetTicketTiersByEventID(ctx.Request.Context(), eventID)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"error": message.ErrInternalServer,
-		})
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
 		return
 	}
 
@@ -362,61 +490,90 @@ func (c *EventController) UpdateTicketTier(ctx *gin.Context) {
 
 	var req request.UpdateTicketTierRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{
-			"error":   message.ErrInvalidRequest,
-			"details": err.Error(),
-		})
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
 		return
 	}
 
 	// Get organizer ID from context
 	organizerID, exists := ctx.Get("user_id")
 	if !exists {
-		ctx.JSON(http.StatusUnauthorized, gin.H{
-			"error": message.ErrUnauthorized,
-		})
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
 		return
 	}
 
+	// Expected version from body, or from If-Match header
+	if req.Version == nil {
+		version, err := parseIfMatchVersion(ctx.GetHeader("If-Match"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidVersion, sharedresponse.CodeInvalidVersion, nil))
+			return
+		}
+		req.Version = version
+	}
+
 	// Update ticket tier
 	tier, err := c.eventService.UpdateTicketTier(ctx.Request.Context(), organizerID.(string), id, &req)
 	if err != nil {
+		if errors.Is(err, service.ErrVersionConflict) {
+			// Return latest state so client can merge and retry
+			latest, getErr := c.eventService.GetTicketTierByID(ctx.Request.Context(), id)
+			if getErr == nil {
+				ctx.Header("ETag", versionETag(latest.Version))
+			}
+			ctx.JSON(http.StatusConflict, sharedresponse.ErrorWithData(message.ErrVersionConflict, sharedresponse.CodeVersionConflict, latest))
+			return
+		}
+
 		if errors.Is(err, service.ErrTicketTierNotFound) {
-			ctx.JSON(http.StatusNotFound, gin.H{
-				"error": message.ErrTicketTierNotFound,
-			})
+			ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrTicketTierNotFound, sharedresponse.CodeTicketTierNotFound, nil))
 			return
 		}
 
 		if errors.Is(err, service.ErrUnauthorized) {
-			ctx.JSON(http.StatusForbidden, gin.H{
-				"error": message.ErrForbidden,
-			})
+			ctx.JSON(http.StatusForbidden, sharedresponse.ErrorWithCode(message.ErrForbidden, sharedresponse.CodeForbidden, nil))
 			return
 		}
 
 		if errors.Is(err, service.ErrQuotaBelowSoldCount) {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": message.ErrQuotaBelowSoldCount,
-			})
+			// Return latest sold count so organizer can pick a valid quota
+			latest, _ := c.eventService.GetTicketTierByID(ctx.Request.Context(), id)
+			ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithData(message.ErrQuotaBelowSoldCount, sharedresponse.CodeQuotaBelowSoldCount, latest))
+			return
+		}
+
+		if errors.Is(err, service.ErrAccessibleBelowSold) {
+			latest, _ := c.eventService.GetTicketTierByID(ctx.Request.Context(), id)
+			ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithData(message.ErrAccessibleBelowSold, sharedresponse.CodeAccessibleQuotaBelowSold, latest))
+			return
+		}
+
+		if errors.Is(err, service.ErrTicketQuotaExceeded) {
+			c.respondQuotaExceeded(ctx, organizerID.(string), message.ErrTicketQuotaExceeded, sharedresponse.CodeTicketQuotaExceeded)
 			return
 		}
 
 		// Check for validation errors
 		if errors.Is(err, request.ErrInvalidEarlyBirdSettings) ||
 			errors.Is(err, request.ErrInvalidEarlyBirdPrice) {
-			ctx.JSON(http.StatusBadRequest, gin.H{
-				"error": err.Error(),
-			})
+			ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(err.Error(), sharedresponse.CodeInvalidEarlyBirdConfig, nil))
 			return
 		}
 
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"error": message.ErrInternalServer,
-		})
+		if errors.Is(err, request.ErrInvalidAccessibleQuota) {
+			ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(err.Error(), sharedresponse.CodeInvalidAccessibleQuota, nil))
+			return
+		}
+
+		if errors.Is(err, request.ErrDynamicPricingEarlyBird) || errors.Is(err, pricing.ErrInvalidDynamicRule) {
+			ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(err.Error(), sharedresponse.CodeInvalidDynamicPricing, nil))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
 		return
 	}
 
+	ctx.Header("ETag", versionETag(tier.Version))
 	ctx.JSON(http.StatusOK, gin.H{
 		"message": message.MsgTicketTierUpdated,
 		"data":    tier,
@@ -430,9 +587,7 @@ func (c *EventController) DeleteTicketTier(ctx *gin.Context) {
 	// Get organizer ID from context
 	organizerID, exists := ctx.Get("user_id")
 	if !exists {
-		ctx.JSON(http.StatusUnauthorized, gin.H{
-			"error": message.ErrUnauthorized,
-		})
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
 		return
 	}
 
@@ -440,22 +595,21 @@ func (c *EventController) DeleteTicketTier(ctx *gin.Context) {
 	err := c.eventService.DeleteTicketTier(ctx.Request.Context(), organizerID.(string), id)
 	if err != nil {
 		if errors.Is(err, service.ErrTicketTierNotFound) {
-			ctx.JSON(http.StatusNotFound, gin.H{
-				"error": message.ErrTicketTierNotFound,
-			})
+			ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrTicketTierNotFound, sharedresponse.CodeTicketTierNotFound, nil))
 			return
 		}
 
 		if errors.Is(err, service.ErrUnauthorized) {
-			ctx.JSON(http.StatusForbidden, gin.H{
-				"error": message.ErrForbidden,
-			})
+			ctx.JSON(http.StatusForbidden, sharedresponse.ErrorWithCode(message.ErrForbidden, sharedresponse.CodeForbidden, nil))
 			return
 		}
 
-		ctx.JSON(http.StatusInternalServerError, gin.H{
-			"error": message.ErrInternalServer,
-		})
+		if errors.Is(err, service.ErrTicketTierHasOrders) {
+			ctx.JSON(http.StatusConflict, sharedresponse.ErrorWithCode(message.ErrTicketTierHasOrders, sharedresponse.CodeTicketTierHasOrders, nil))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
 		return
 	}
 
@@ -463,3 +617,428 @@ func (c *EventController) DeleteTicketTier(ctx *gin.Context) {
 		"message": message.MsgTicketTierDeleted,
 	})
 }
+
+// ArchiveTicketTier handles POST /ticket-tiers/:id/archive
+func (c *EventController) ArchiveTicketTier(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	// Get organizer ID from context
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	// Archive ticket tier
+	tier, err := c.eventService.ArchiveTicketTier(ctx.Request.Context(), organizerID.(string), id)
+	if err != nil {
+		if errors.Is(err, service.ErrTicketTierNotFound) {
+			ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrTicketTierNotFound, sharedresponse.CodeTicketTierNotFound, nil))
+			return
+		}
+
+		if errors.Is(err, service.ErrUnauthorized) {
+			ctx.JSON(http.StatusForbidden, sharedresponse.ErrorWithCode(message.ErrForbidden, sharedresponse.CodeForbidden, nil))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+		return
+	}
+
+	ctx.Header("ETag", versionETag(tier.Version))
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgTicketTierArchived,
+		"data":    tier,
+	})
+}
+
+// GetTicketTierPriceHistory handles GET /ticket-tiers/:id/price-history
+func (c *EventController) GetTicketTierPriceHistory(ctx *gin.Context) {
+	id := ctx.Param("id")
+
+	// Get organizer ID from context
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	prices, err := c.eventService.GetTicketTierPriceHistory(ctx.Request.Context(), organizerID.(string), id)
+	if err != nil {
+		if errors.Is(err, service.ErrTicketTierNotFound) {
+			ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrTicketTierNotFound, sharedresponse.CodeTicketTierNotFound, nil))
+			return
+		}
+
+		if errors.Is(err, service.ErrUnauthorized) {
+			ctx.JSON(http.StatusForbidden, sharedresponse.ErrorWithCode(message.ErrForbidden, sharedresponse.CodeForbidden, nil))
+			return
+		}
+
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgPricesRetrieved,
+		"data":    prices,
+	})
+}
+
+// CreateZone handles POST /events/:id/zones
+func (c *EventController) CreateZone(ctx *gin.Context) {
+	eventID := ctx.Param("id")
+
+	var req request.CreateZoneRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	// Get organizer ID from context
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	zone, err := c.eventService.CreateZone(ctx.Request.Context(), organizerID.(string), eventID, &req)
+	if err != nil {
+		c.respondZoneError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": message.MsgZoneCreated,
+		"data":    zone,
+	})
+}
+
+// GetEventZones handles GET /events/:id/zones
+func (c *EventController) GetEventZones(ctx *gin.Context) {
+	eventID := ctx.Param("id")
+
+	zones, err := c.eventService.GetEventZones(ctx.Request.Context(), eventID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgZonesRetrieved,
+		"data":    zones,
+	})
+}
+
+// DeleteZone handles DELETE /events/:id/zones/:zoneId
+func (c *EventController) DeleteZone(ctx *gin.Context) {
+	eventID := ctx.Param("id")
+	zoneID := ctx.Param("zoneId")
+
+	// Get organizer ID from context
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	if err := c.eventService.DeleteZone(ctx.Request.Context(), organizerID.(string), eventID, zoneID); err != nil {
+		c.respondZoneError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgZoneDeleted,
+	})
+}
+
+// SetTicketTierZones handles PUT /ticket-tiers/:id/zones
+func (c *EventController) SetTicketTierZones(ctx *gin.Context) {
+	tierID := ctx.Param("id")
+
+	var req request.SetTicketTierZonesRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	// Get organizer ID from context
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	tierZones, err := c.eventService.SetTicketTierZones(ctx.Request.Context(), organizerID.(string), tierID, &req)
+	if err != nil {
+		c.respondZoneError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgTierZonesUpdated,
+		"data":    tierZones,
+	})
+}
+
+// respondZoneError maps zone operation errors to HTTP responses
+func (c *EventController) respondZoneError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrEventNotFound):
+		ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrEventNotFound, sharedresponse.CodeEventNotFound, nil))
+	case errors.Is(err, service.ErrTicketTierNotFound):
+		ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrTicketTierNotFound, sharedresponse.CodeTicketTierNotFound, nil))
+	case errors.Is(err, service.ErrZoneNotFound):
+		ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrZoneNotFound, sharedresponse.CodeZoneNotFound, nil))
+	case errors.Is(err, service.ErrUnauthorized):
+		ctx.JSON(http.StatusForbidden, sharedresponse.ErrorWithCode(message.ErrForbidden, sharedresponse.CodeForbidden, nil))
+	case errors.Is(err, service.ErrZoneCodeExists):
+		ctx.JSON(http.StatusConflict, sharedresponse.ErrorWithCode(message.ErrZoneCodeExists, sharedresponse.CodeZoneCodeExists, nil))
+	case errors.Is(err, request.ErrInvalidZoneCode):
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(err.Error(), sharedresponse.CodeInvalidZoneCode, nil))
+	default:
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+	}
+}
+
+// CreateFAQ handles POST /events/:id/faqs
+func (c *EventController) CreateFAQ(ctx *gin.Context) {
+	eventID := ctx.Param("id")
+
+	var req request.FAQRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	// Get organizer ID from context
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	faq, err := c.eventService.CreateFAQ(ctx.Request.Context(), organizerID.(string), eventID, &req)
+	if err != nil {
+		c.respondContentError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": message.MsgFAQCreated,
+		"data":    faq,
+	})
+}
+
+// UpdateFAQ handles PUT /events/:id/faqs/:faqId
+func (c *EventController) UpdateFAQ(ctx *gin.Context) {
+	eventID := ctx.Param("id")
+	faqID := ctx.Param("faqId")
+
+	var req request.FAQRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	// Get organizer ID from context
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	faq, err := c.eventService.UpdateFAQ(ctx.Request.Context(), organizerID.(string), eventID, faqID, &req)
+	if err != nil {
+		c.respondContentError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgFAQUpdated,
+		"data":    faq,
+	})
+}
+
+// DeleteFAQ handles DELETE /events/:id/faqs/:faqId
+func (c *EventController) DeleteFAQ(ctx *gin.Context) {
+	eventID := ctx.Param("id")
+	faqID := ctx.Param("faqId")
+
+	// Get organizer ID from context
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	if err := c.eventService.DeleteFAQ(ctx.Request.Context(), organizerID.(string), eventID, faqID); err != nil {
+		c.respondContentError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgFAQDeleted,
+	})
+}
+
+// GetEventFAQs handles GET /events/:id/faqs
+func (c *EventController) GetEventFAQs(ctx *gin.Context) {
+	eventID := ctx.Param("id")
+
+	faqs, err := c.eventService.GetEventFAQs(ctx.Request.Context(), eventID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgFAQsRetrieved,
+		"data":    faqs,
+	})
+}
+
+// CreateSession handles POST /events/:id/agenda
+func (c *EventController) CreateSession(ctx *gin.Context) {
+	eventID := ctx.Param("id")
+
+	var req request.SessionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	// Get organizer ID from context
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	session, err := c.eventService.CreateSession(ctx.Request.Context(), organizerID.(string), eventID, &req)
+	if err != nil {
+		c.respondContentError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": message.MsgSessionCreated,
+		"data":    session,
+	})
+}
+
+// UpdateSession handles PUT /events/:id/agenda/:sessionId
+func (c *EventController) UpdateSession(ctx *gin.Context) {
+	eventID := ctx.Param("id")
+	sessionID := ctx.Param("sessionId")
+
+	var req request.SessionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	// Get organizer ID from context
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	session, err := c.eventService.UpdateSession(ctx.Request.Context(), organizerID.(string), eventID, sessionID, &req)
+	if err != nil {
+		c.respondContentError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgSessionUpdated,
+		"data":    session,
+	})
+}
+
+// DeleteSession handles DELETE /events/:id/agenda/:sessionId
+func (c *EventController) DeleteSession(ctx *gin.Context) {
+	eventID := ctx.Param("id")
+	sessionID := ctx.Param("sessionId")
+
+	// Get organizer ID from context
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	if err := c.eventService.DeleteSession(ctx.Request.Context(), organizerID.(string), eventID, sessionID); err != nil {
+		c.respondContentError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgSessionDeleted,
+	})
+}
+
+// GetEventAgenda handles GET /events/:id/agenda
+func (c *EventController) GetEventAgenda(ctx *gin.Context) {
+	eventID := ctx.Param("id")
+
+	sessions, err := c.eventService.GetEventAgenda(ctx.Request.Context(), eventID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgAgendaRetrieved,
+		"data":    sessions,
+	})
+}
+
+// respondContentError maps FAQ and agenda operation errors to HTTP responses
+func (c *EventController) respondContentError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrEventNotFound):
+		ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrEventNotFound, sharedresponse.CodeEventNotFound, nil))
+	case errors.Is(err, service.ErrFAQNotFound):
+		ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrFAQNotFound, sharedresponse.CodeFAQNotFound, nil))
+	case errors.Is(err, service.ErrSessionNotFound):
+		ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrSessionNotFound, sharedresponse.CodeSessionNotFound, nil))
+	case errors.Is(err, service.ErrUnauthorized):
+		ctx.JSON(http.StatusForbidden, sharedresponse.ErrorWithCode(message.ErrForbidden, sharedresponse.CodeForbidden, nil))
+	case errors.Is(err, service.ErrSessionOutsideEvent):
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrSessionOutsideEvent, sharedresponse.CodeSessionOutsideEvent, nil))
+	default:
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+	}
+}
+
+// respondQuotaExceeded rejects a request over a plan limit
+// Returns the organizer's plan and usage so they can see which limit was hit
+func (c *EventController) respondQuotaExceeded(ctx *gin.Context, organizerID string, msg string, code string) {
+	plan, _ := c.planService.GetOrganizerPlan(ctx.Request.Context(), organizerID)
+	ctx.JSON(http.StatusForbidden, sharedresponse.ErrorWithData(msg, code, plan))
+}
+
+// parseIfMatchVersion extracts resource version from If-Match header
+// Accepts `"3"`, `W/"3"` and `3`; returns nil when header is absent
+func parseIfMatchVersion(header string) (*int, error) {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return nil, nil
+	}
+
+	value := strings.Trim(strings.TrimPrefix(header, "W/"), `"`)
+	version, err := strconv.Atoi(value)
+	if err != nil || version < 1 {
+		return nil, fmt.Errorf("invalid If-Match version: %s", header)
+	}
+
+	return &version, nil
+}
+
+// versionETag formats resource version as ETag header value
+func versionETag(version int) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
@@ -0,0 +1,124 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/service"
+)
+
+// ModerationController handles HTTP requests for event abuse reports and their review
+type ModerationController struct {
+	moderationService service.ModerationService
+}
+
+// NewModerationController creates new moderation controller instance
+func NewModerationController(moderationService service.ModerationService) *ModerationController {
+	return &ModerationController{
+		moderationService: moderationService,
+	}
+}
+
+// ReportEvent handles POST /events/:id/report
+func (c *ModerationController) ReportEvent(ctx *gin.Context) {
+	var req request.ReportEventRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	report, err := c.moderationService.ReportEvent(ctx.Request.Context(), userID.(string), ctx.Param("id"), &req)
+	if err != nil {
+		c.respondModerationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": message.MsgEventReported,
+		"data":    report,
+	})
+}
+
+// ListReportedEvents handles GET /admin/event-reports
+func (c *ModerationController) ListReportedEvents(ctx *gin.Context) {
+	var req request.ListReportedEventsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	queue, err := c.moderationService.ListReportedEvents(ctx.Request.Context(), req)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgReportsListed,
+		"data":    queue,
+	})
+}
+
+// GetEventReports handles GET /admin/events/:id/reports
+func (c *ModerationController) GetEventReports(ctx *gin.Context) {
+	reports, err := c.moderationService.GetEventReports(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		c.respondModerationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgReportsRetrieved,
+		"data":    reports,
+	})
+}
+
+// ModerateEvent handles POST /admin/events/:id/moderation
+func (c *ModerationController) ModerateEvent(ctx *gin.Context) {
+	var req request.ModerateEventRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	moderatorID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	reports, err := c.moderationService.ModerateEvent(ctx.Request.Context(), moderatorID.(string), ctx.Param("id"), &req)
+	if err != nil {
+		c.respondModerationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgEventModerated,
+		"data":    reports,
+	})
+}
+
+// respondModerationError maps moderation operation errors to HTTP responses
+func (c *ModerationController) respondModerationError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrEventNotFound):
+		ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrEventNotFound, sharedresponse.CodeEventNotFound, nil))
+	case errors.Is(err, service.ErrAlreadyReported):
+		ctx.JSON(http.StatusConflict, sharedresponse.ErrorWithCode(message.ErrEventAlreadyReported, sharedresponse.CodeEventAlreadyReported, nil))
+	case errors.Is(err, service.ErrOwnEventReport):
+		ctx.JSON(http.StatusForbidden, sharedresponse.ErrorWithCode(message.ErrOwnEventReport, sharedresponse.CodeForbidden, nil))
+	default:
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+	}
+}
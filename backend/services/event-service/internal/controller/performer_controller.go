@@ -0,0 +1,202 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/service"
+)
+
+// PerformerController handles HTTP requests for the performer directory and event lineups
+type PerformerController struct {
+	performerService service.PerformerService
+}
+
+// NewPerformerController creates new performer controller instance
+func NewPerformerController(performerService service.PerformerService) *PerformerController {
+	return &PerformerController{
+		performerService: performerService,
+	}
+}
+
+// SearchPerformers handles GET /performers
+func (c *PerformerController) SearchPerformers(ctx *gin.Context) {
+	var req request.SearchPerformersRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	performers, err := c.performerService.SearchPerformers(ctx.Request.Context(), req)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgPerformersListed,
+		"data":    performers,
+	})
+}
+
+// GetPerformer handles GET /performers/:id
+func (c *PerformerController) GetPerformer(ctx *gin.Context) {
+	performer, err := c.performerService.GetPerformer(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		c.respondPerformerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgPerformerRetrieved,
+		"data":    performer,
+	})
+}
+
+// GetPerformerEvents handles GET /performers/:id/events
+func (c *PerformerController) GetPerformerEvents(ctx *gin.Context) {
+	var req request.PerformerEventsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	events, err := c.performerService.GetPerformerEvents(ctx.Request.Context(), ctx.Param("id"), req)
+	if err != nil {
+		c.respondPerformerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgEventsRetrieved,
+		"data":    events,
+	})
+}
+
+// CreatePerformer handles POST /performers
+func (c *PerformerController) CreatePerformer(ctx *gin.Context) {
+	var req request.PerformerRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	performer, err := c.performerService.CreatePerformer(ctx.Request.Context(), userID.(string), &req)
+	if err != nil {
+		c.respondPerformerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": message.MsgPerformerCreated,
+		"data":    performer,
+	})
+}
+
+// UpdatePerformer handles PUT /performers/:id
+func (c *PerformerController) UpdatePerformer(ctx *gin.Context) {
+	var req request.PerformerRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	performer, err := c.performerService.UpdatePerformer(ctx.Request.Context(), userID.(string), ctx.Param("id"), &req)
+	if err != nil {
+		c.respondPerformerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgPerformerUpdated,
+		"data":    performer,
+	})
+}
+
+// DeletePerformer handles DELETE /performers/:id
+func (c *PerformerController) DeletePerformer(ctx *gin.Context) {
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	if err := c.performerService.DeletePerformer(ctx.Request.Context(), userID.(string), ctx.Param("id")); err != nil {
+		c.respondPerformerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgPerformerDeleted,
+	})
+}
+
+// GetEventPerformers handles GET /events/:id/performers
+func (c *PerformerController) GetEventPerformers(ctx *gin.Context) {
+	lineup, err := c.performerService.GetEventPerformers(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgPerformersListed,
+		"data":    lineup,
+	})
+}
+
+// SetEventPerformers handles PUT /events/:id/performers
+func (c *PerformerController) SetEventPerformers(ctx *gin.Context) {
+	var req request.SetEventPerformersRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	lineup, err := c.performerService.SetEventPerformers(ctx.Request.Context(), organizerID.(string), ctx.Param("id"), &req)
+	if err != nil {
+		c.respondPerformerError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgLineupUpdated,
+		"data":    lineup,
+	})
+}
+
+// respondPerformerError maps performer operation errors to HTTP responses
+func (c *PerformerController) respondPerformerError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrPerformerNotFound):
+		ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrPerformerNotFound, sharedresponse.CodePerformerNotFound, nil))
+	case errors.Is(err, service.ErrEventNotFound):
+		ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrEventNotFound, sharedresponse.CodeEventNotFound, nil))
+	case errors.Is(err, service.ErrUnauthorized):
+		ctx.JSON(http.StatusForbidden, sharedresponse.ErrorWithCode(message.ErrForbidden, sharedresponse.CodeForbidden, nil))
+	default:
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+	}
+}
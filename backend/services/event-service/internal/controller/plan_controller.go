@@ -0,0 +1,170 @@
+package controller
+
+import (
+	"errors"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/service"
+)
+
+// PlanController handles HTTP requests for organizer plans
+type PlanController struct {
+	planService service.PlanService
+}
+
+// NewPlanController creates new plan controller instance
+func NewPlanController(planService service.PlanService) *PlanController {
+	return &PlanController{
+		planService: planService,
+	}
+}
+
+// ListPlans handles GET /admin/plans
+func (c *PlanController) ListPlans(ctx *gin.Context) {
+	plans, err := c.planService.ListPlans(ctx.Request.Context())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgPlansRetrieved,
+		"data":    plans,
+	})
+}
+
+// UpdatePlan handles PUT /admin/plans/:code
+func (c *PlanController) UpdatePlan(ctx *gin.Context) {
+	var req request.UpdatePlanRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	plan, err := c.planService.UpdatePlan(ctx.Request.Context(), ctx.Param("code"), &req)
+	if err != nil {
+		c.respondPlanError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgPlanUpdated,
+		"data":    plan,
+	})
+}
+
+// GetOrganizerPlan handles GET /admin/organizers/:id/plan
+func (c *PlanController) GetOrganizerPlan(ctx *gin.Context) {
+	plan, err := c.planService.GetOrganizerPlan(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		c.respondPlanError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgPlanRetrieved,
+		"data":    plan,
+	})
+}
+
+// AssignPlan handles PUT /admin/organizers/:id/plan
+func (c *PlanController) AssignPlan(ctx *gin.Context) {
+	var req request.AssignPlanRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	// Get admin ID from context
+	adminID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	plan, err := c.planService.AssignPlan(ctx.Request.Context(), adminID.(string), ctx.Param("id"), &req)
+	if err != nil {
+		c.respondPlanError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgPlanAssigned,
+		"data":    plan,
+	})
+}
+
+// GetMyPlan handles GET /organizer/plan
+func (c *PlanController) GetMyPlan(ctx *gin.Context) {
+	// Get organizer ID from context
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	plan, err := c.planService.GetOrganizerPlan(ctx.Request.Context(), organizerID.(string))
+	if err != nil {
+		c.respondPlanError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgPlanRetrieved,
+		"data":    plan,
+	})
+}
+
+// RateLimit limits event management API requests to the organizer's plan rate
+// Must run after auth middleware; lets requests through if the limit can't be checked
+func (c *PlanController) RateLimit(ctx *gin.Context) {
+	organizerID := ctx.GetString("user_id")
+	if organizerID == "" {
+		ctx.Next()
+		return
+	}
+
+	result, err := c.planService.AllowRequest(ctx.Request.Context(), organizerID)
+	if err != nil {
+		log.Printf("[RateLimit] Failed to check rate limit of organizer %s: %v", organizerID, err)
+		ctx.Next()
+		return
+	}
+
+	if result.Limit > 0 {
+		ctx.Header("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+		ctx.Header("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	}
+
+	if !result.Allowed {
+		retryAfter := int(math.Ceil(result.RetryAfter.Seconds()))
+		ctx.Header("Retry-After", strconv.Itoa(retryAfter))
+		ctx.JSON(http.StatusTooManyRequests, sharedresponse.ErrorWithCode(message.ErrAPIRateLimitExceeded, sharedresponse.CodeAPIRateLimitExceeded, map[string]interface{}{
+			"limit":       result.Limit,
+			"retry_after": retryAfter,
+		}))
+		ctx.Abort()
+		return
+	}
+
+	ctx.Next()
+}
+
+// respondPlanError maps plan operation errors to HTTP responses
+func (c *PlanController) respondPlanError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrPlanNotFound):
+		ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrPlanNotFound, sharedresponse.CodePlanNotFound, nil))
+	case errors.Is(err, service.ErrOrganizerNotFound):
+		ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrOrganizerNotFound, sharedresponse.CodeOrganizerNotFound, nil))
+	default:
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+	}
+}
@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/service"
+)
+
+// RevenueSplitController handles HTTP requests for revenue sharing between co-organizers
+type RevenueSplitController struct {
+	revenueSplitService service.RevenueSplitService
+}
+
+// NewRevenueSplitController creates new revenue split controller instance
+func NewRevenueSplitController(revenueSplitService service.RevenueSplitService) *RevenueSplitController {
+	return &RevenueSplitController{
+		revenueSplitService: revenueSplitService,
+	}
+}
+
+// GetEventSplits handles GET /events/:id/revenue-splits
+func (c *RevenueSplitController) GetEventSplits(ctx *gin.Context) {
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	splits, err := c.revenueSplitService.GetEventSplits(ctx.Request.Context(), userID.(string), ctx.Param("id"))
+	if err != nil {
+		c.respondRevenueSplitError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgSplitsRetrieved,
+		"data":    splits,
+	})
+}
+
+// SetEventSplits handles PUT /events/:id/revenue-splits
+func (c *RevenueSplitController) SetEventSplits(ctx *gin.Context) {
+	var req request.SetRevenueSplitsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	splits, err := c.revenueSplitService.SetEventSplits(ctx.Request.Context(), organizerID.(string), ctx.Param("id"), &req)
+	if err != nil {
+		c.respondRevenueSplitError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgSplitsUpdated,
+		"data":    splits,
+	})
+}
+
+// GetMyShares handles GET /organizer/revenue-shares
+func (c *RevenueSplitController) GetMyShares(ctx *gin.Context) {
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	shares, err := c.revenueSplitService.GetMyShares(ctx.Request.Context(), organizerID.(string))
+	if err != nil {
+		c.respondRevenueSplitError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgSharesRetrieved,
+		"data":    shares,
+	})
+}
+
+// respondRevenueSplitError maps revenue split operation errors to HTTP responses
+func (c *RevenueSplitController) respondRevenueSplitError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrInvalidRevenueSplit):
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRevenueSplit, sharedresponse.CodeInvalidRevenueSplit, nil))
+	case errors.Is(err, service.ErrOrganizerNotFound):
+		ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrOrganizerNotFound, sharedresponse.CodeOrganizerNotFound, nil))
+	case errors.Is(err, service.ErrEventNotFound):
+		ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrEventNotFound, sharedresponse.CodeEventNotFound, nil))
+	case errors.Is(err, service.ErrEventCompleted):
+		ctx.JSON(http.StatusConflict, sharedresponse.ErrorWithCode(message.ErrEventCompleted, sharedresponse.CodeEventCompleted, nil))
+	case errors.Is(err, service.ErrUnauthorized):
+		ctx.JSON(http.StatusForbidden, sharedresponse.ErrorWithCode(message.ErrForbidden, sharedresponse.CodeForbidden, nil))
+	default:
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+	}
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/service"
+)
+
+// SEOController handles HTTP requests for event page metadata and share images
+type SEOController struct {
+	seoService service.SEOService
+}
+
+// NewSEOController creates new SEO controller instance
+func NewSEOController(seoService service.SEOService) *SEOController {
+	return &SEOController{
+		seoService: seoService,
+	}
+}
+
+// GetEventSEO handles GET /events/:id/seo
+func (c *SEOController) GetEventSEO(ctx *gin.Context) {
+	seo, err := c.seoService.GetEventSEO(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		c.respondSEOError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgSEORetrieved,
+		"data":    seo,
+	})
+}
+
+// GetShareImage handles GET /events/:id/og-image
+// Redirects to the stored image, or serves the PNG when no object storage is configured
+func (c *SEOController) GetShareImage(ctx *gin.Context) {
+	image, err := c.seoService.GetShareImage(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		c.respondSEOError(ctx, err)
+		return
+	}
+
+	// The image changes with the event, so only the stored, content-named image is cached long
+	ctx.Header("Cache-Control", "public, max-age=300")
+	if image.URL != "" {
+		ctx.Redirect(http.StatusFound, image.URL)
+		return
+	}
+
+	ctx.Data(http.StatusOK, "image/png", image.Data)
+}
+
+// respondSEOError maps SEO operation errors to HTTP responses
+func (c *SEOController) respondSEOError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrEventNotFound):
+		ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrEventNotFound, sharedresponse.CodeEventNotFound, nil))
+	default:
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+	}
+}
@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/service"
+)
+
+// TicketTemplateController handles HTTP requests for organizers' e-ticket templates
+type TicketTemplateController struct {
+	ticketTemplateService service.TicketTemplateService
+}
+
+// NewTicketTemplateController creates new ticket template controller instance
+func NewTicketTemplateController(ticketTemplateService service.TicketTemplateService) *TicketTemplateController {
+	return &TicketTemplateController{
+		ticketTemplateService: ticketTemplateService,
+	}
+}
+
+// GetMyTemplate handles GET /organizer/ticket-template
+func (c *TicketTemplateController) GetMyTemplate(ctx *gin.Context) {
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	tpl, err := c.ticketTemplateService.GetMyTemplate(ctx.Request.Context(), organizerID.(string))
+	if err != nil {
+		c.respondTicketTemplateError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgTemplateRetrieved,
+		"data":    tpl,
+	})
+}
+
+// SaveTemplate handles PUT /organizer/ticket-template
+func (c *TicketTemplateController) SaveTemplate(ctx *gin.Context) {
+	var req request.SaveTicketTemplateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	tpl, err := c.ticketTemplateService.SaveTemplate(ctx.Request.Context(), organizerID.(string), &req)
+	if err != nil {
+		c.respondTicketTemplateError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgTemplateSaved,
+		"data":    tpl,
+	})
+}
+
+// DeleteTemplate handles DELETE /organizer/ticket-template
+func (c *TicketTemplateController) DeleteTemplate(ctx *gin.Context) {
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	if err := c.ticketTemplateService.DeleteTemplate(ctx.Request.Context(), organizerID.(string)); err != nil {
+		c.respondTicketTemplateError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgTemplateDeleted,
+	})
+}
+
+// PreviewTemplate handles POST /organizer/ticket-template/preview
+// Renders the template in the body on a sample e-ticket, or the saved template when the body is empty
+func (c *TicketTemplateController) PreviewTemplate(ctx *gin.Context) {
+	var req *request.PreviewTicketTemplateRequest
+	if ctx.Request.ContentLength != 0 {
+		req = &request.PreviewTicketTemplateRequest{}
+		if err := ctx.ShouldBindJSON(req); err != nil {
+			ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+			return
+		}
+	}
+
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	pdf, err := c.ticketTemplateService.PreviewTemplate(ctx.Request.Context(), organizerID.(string), req)
+	if err != nil {
+		c.respondTicketTemplateError(ctx, err)
+		return
+	}
+
+	ctx.Header("Content-Disposition", `inline; filename="e-ticket-preview.pdf"`)
+	ctx.Data(http.StatusOK, "application/pdf", pdf)
+}
+
+// respondTicketTemplateError maps ticket template operation errors to HTTP responses
+func (c *TicketTemplateController) respondTicketTemplateError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrTicketTemplateNotFound):
+		ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrTicketTemplateNotFound, sharedresponse.CodeTicketTemplateNotFound, nil))
+	case errors.Is(err, service.ErrTicketLogoUnavailable):
+		ctx.JSON(http.StatusUnprocessableEntity, sharedresponse.ErrorWithCode(message.ErrTicketLogoUnavailable, sharedresponse.CodeTicketLogoUnavailable, err.Error()))
+	default:
+		log.Printf("[TicketTemplateController] Ticket template operation failed: %v", err)
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+	}
+}
@@ -0,0 +1,176 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/service"
+)
+
+// VerificationController handles HTTP requests for organizer verification and profiles
+type VerificationController struct {
+	verificationService service.VerificationService
+}
+
+// NewVerificationController creates new verification controller instance
+func NewVerificationController(verificationService service.VerificationService) *VerificationController {
+	return &VerificationController{
+		verificationService: verificationService,
+	}
+}
+
+// GetOrganizerProfile handles GET /organizers/:id
+func (c *VerificationController) GetOrganizerProfile(ctx *gin.Context) {
+	profile, err := c.verificationService.GetOrganizerProfile(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		c.respondVerificationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgOrganizerRetrieved,
+		"data":    profile,
+	})
+}
+
+// SubmitVerification handles POST /organizer/verification
+func (c *VerificationController) SubmitVerification(ctx *gin.Context) {
+	var req request.SubmitVerificationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	verification, err := c.verificationService.SubmitVerification(ctx.Request.Context(), organizerID.(string), &req)
+	if err != nil {
+		c.respondVerificationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"message": message.MsgVerifySubmitted,
+		"data":    verification,
+	})
+}
+
+// GetMyVerification handles GET /organizer/verification
+func (c *VerificationController) GetMyVerification(ctx *gin.Context) {
+	organizerID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	verification, err := c.verificationService.GetMyVerification(ctx.Request.Context(), organizerID.(string))
+	if err != nil {
+		c.respondVerificationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgVerifyRetrieved,
+		"data":    verification,
+	})
+}
+
+// ListVerifications handles GET /admin/organizer-verifications
+func (c *VerificationController) ListVerifications(ctx *gin.Context) {
+	var req request.ListVerificationsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	verifications, err := c.verificationService.ListVerifications(ctx.Request.Context(), req)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgVerifyListed,
+		"data":    verifications,
+	})
+}
+
+// ReviewVerification handles POST /admin/organizer-verifications/:id/review
+func (c *VerificationController) ReviewVerification(ctx *gin.Context) {
+	var req request.ReviewVerificationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	adminID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	verification, err := c.verificationService.ReviewVerification(ctx.Request.Context(), adminID.(string), ctx.Param("id"), &req)
+	if err != nil {
+		c.respondVerificationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgVerifyReviewed,
+		"data":    verification,
+	})
+}
+
+// RevokeVerification handles POST /admin/organizers/:id/verification/revoke
+func (c *VerificationController) RevokeVerification(ctx *gin.Context) {
+	var req request.RevokeVerificationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	adminID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	verification, err := c.verificationService.RevokeVerification(ctx.Request.Context(), adminID.(string), ctx.Param("id"), &req)
+	if err != nil {
+		c.respondVerificationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": message.MsgVerifyRevoked,
+		"data":    verification,
+	})
+}
+
+// respondVerificationError maps verification operation errors to HTTP responses
+func (c *VerificationController) respondVerificationError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrOrganizerNotFound):
+		ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrOrganizerNotFound, sharedresponse.CodeOrganizerNotFound, nil))
+	case errors.Is(err, service.ErrVerificationNotFound):
+		ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrVerificationNotFound, sharedresponse.CodeVerificationNotFound, nil))
+	case errors.Is(err, service.ErrVerificationPending):
+		ctx.JSON(http.StatusConflict, sharedresponse.ErrorWithCode(message.ErrVerificationPending, sharedresponse.CodeVerificationPending, nil))
+	case errors.Is(err, service.ErrOrganizerVerified):
+		ctx.JSON(http.StatusConflict, sharedresponse.ErrorWithCode(message.ErrOrganizerVerified, sharedresponse.CodeOrganizerVerified, nil))
+	case errors.Is(err, service.ErrVerificationReviewed):
+		ctx.JSON(http.StatusConflict, sharedresponse.ErrorWithCode(message.ErrVerificationReviewed, sharedresponse.CodeVerificationReviewed, nil))
+	case errors.Is(err, service.ErrOrganizerNotVerified):
+		ctx.JSON(http.StatusConflict, sharedresponse.ErrorWithCode(message.ErrOrganizerNotVerified, sharedresponse.CodeOrganizerNotVerified, nil))
+	default:
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+	}
+}
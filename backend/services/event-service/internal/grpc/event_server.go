@@ -0,0 +1,254 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/event"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/serviceauth"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/repository"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxChangesPerCall caps ListChanges responses
+const maxChangesPerCall = 500
+
+// ServiceAuthPolicy lists the services allowed to call each method
+// Plans are assigned by payment-service once a subscription is paid
+var ServiceAuthPolicy = serviceauth.Policy{
+	"/event.EventService/GetEvent":            {serviceauth.ServiceTicketing},
+	"/event.EventService/GetEvents":           {serviceauth.ServiceTicketing},
+	"/event.EventService/GetTier":             {serviceauth.ServiceTicketing},
+	"/event.EventService/GetTiers":            {serviceauth.ServiceTicketing},
+	"/event.EventService/ListTiersByEvent":    {serviceauth.ServiceTicketing},
+	"/event.EventService/ListChanges":         {serviceauth.ServiceTicketing},
+	"/event.EventService/GetOrganizerPlan":    {serviceauth.ServiceTicketing},
+	"/event.EventService/AssignOrganizerPlan": {serviceauth.ServicePayment},
+}
+
+// EventGRPCServer implements event gRPC service
+// Reads go straight to the repositories: internal callers need fresh sold counts,
+// not the cached public responses served by the event service
+// Plan assignment goes through the plan service so cached plan limits are dropped
+type EventGRPCServer struct {
+	pb.UnimplementedEventServiceServer
+	eventRepo      repository.EventRepository
+	ticketTierRepo repository.TicketTierRepository
+	changeRepo     repository.ChangeRepository
+	planRepo       repository.PlanRepository
+	planService    service.PlanService
+}
+
+// NewEventGRPCServer creates new event gRPC server instance
+func NewEventGRPCServer(
+	eventRepo repository.EventRepository,
+	ticketTierRepo repository.TicketTierRepository,
+	changeRepo repository.ChangeRepository,
+	planRepo repository.PlanRepository,
+	planService service.PlanService,
+) *EventGRPCServer {
+	return &EventGRPCServer{
+		eventRepo:      eventRepo,
+		ticketTierRepo: ticketTierRepo,
+		changeRepo:     changeRepo,
+		planRepo:       planRepo,
+		planService:    planService,
+	}
+}
+
+// GetEvent returns a single event
+func (s *EventGRPCServer) GetEvent(ctx context.Context, req *pb.GetEventRequest) (*pb.Event, error) {
+	event, err := s.eventRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		log.Printf("[gRPC] GetEvent failed for event %s: %v", req.Id, err)
+		return nil, status.Error(codes.Internal, "failed to get event")
+	}
+
+	return toPBEvent(event), nil
+}
+
+// GetEvents returns events by IDs, skipping missing ones
+func (s *EventGRPCServer) GetEvents(ctx context.Context, req *pb.GetEventsRequest) (*pb.GetEventsResponse, error) {
+	events, err := s.eventRepo.GetByIDs(ctx, req.Ids)
+	if err != nil {
+		log.Printf("[gRPC] GetEvents failed for %d events: %v", len(req.Ids), err)
+		return nil, status.Error(codes.Internal, "failed to get events")
+	}
+
+	resp := &pb.GetEventsResponse{Events: make([]*pb.Event, len(events))}
+	for i := range events {
+		resp.Events[i] = toPBEvent(&events[i])
+	}
+	return resp, nil
+}
+
+// GetTier returns a single ticket tier
+func (s *EventGRPCServer) GetTier(ctx context.Context, req *pb.GetTierRequest) (*pb.TicketTier, error) {
+	tier, err := s.ticketTierRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketTierNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		log.Printf("[gRPC] GetTier failed for tier %s: %v", req.Id, err)
+		return nil, status.Error(codes.Internal, "failed to get ticket tier")
+	}
+
+	return toPBTier(tier), nil
+}
+
+// GetTiers returns ticket tiers by IDs, skipping missing ones
+func (s *EventGRPCServer) GetTiers(ctx context.Context, req *pb.GetTiersRequest) (*pb.ListTiersResponse, error) {
+	tiers, err := s.ticketTierRepo.GetByIDs(ctx, req.Ids)
+	if err != nil {
+		log.Printf("[gRPC] GetTiers failed for %d tiers: %v", len(req.Ids), err)
+		return nil, status.Error(codes.Internal, "failed to get ticket tiers")
+	}
+
+	return toPBTiers(tiers), nil
+}
+
+// ListTiersByEvent returns all ticket tiers of an event
+func (s *EventGRPCServer) ListTiersByEvent(ctx context.Context, req *pb.ListTiersByEventRequest) (*pb.ListTiersResponse, error) {
+	tiers, err := s.ticketTierRepo.GetByEventID(ctx, req.EventId)
+	if err != nil {
+		log.Printf("[gRPC] ListTiersByEvent failed for event %s: %v", req.EventId, err)
+		return nil, status.Error(codes.Internal, "failed to list ticket tiers")
+	}
+
+	return toPBTiers(tiers), nil
+}
+
+// ListChanges returns the change feed after req.AfterSeq in sequence order
+func (s *EventGRPCServer) ListChanges(ctx context.Context, req *pb.ListChangesRequest) (*pb.ListChangesResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 || limit > maxChangesPerCall {
+		limit = maxChangesPerCall
+	}
+
+	changes, err := s.changeRepo.ListAfter(ctx, req.AfterSeq, limit)
+	if err != nil {
+		log.Printf("[gRPC] ListChanges failed after seq %d: %v", req.AfterSeq, err)
+		return nil, status.Error(codes.Internal, "failed to list event changes")
+	}
+
+	resp := &pb.ListChangesResponse{Changes: make([]*pb.EventChange, len(changes))}
+	for i, change := range changes {
+		resp.Changes[i] = &pb.EventChange{Seq: change.Seq, EventId: change.EventID}
+	}
+	return resp, nil
+}
+
+// GetOrganizerPlan returns the plan limits of an organizer, the free plan if none is assigned
+func (s *EventGRPCServer) GetOrganizerPlan(ctx context.Context, req *pb.GetOrganizerPlanRequest) (*pb.OrganizerPlan, error) {
+	plan, err := s.planRepo.GetForOrganizer(ctx, req.OrganizerId)
+	if err != nil {
+		log.Printf("[gRPC] GetOrganizerPlan failed for organizer %s: %v", req.OrganizerId, err)
+		return nil, status.Error(codes.Internal, "failed to get organizer plan")
+	}
+
+	return toPBOrganizerPlan(plan), nil
+}
+
+// AssignOrganizerPlan puts an organizer on a plan
+func (s *EventGRPCServer) AssignOrganizerPlan(ctx context.Context, req *pb.AssignOrganizerPlanRequest) (*pb.OrganizerPlan, error) {
+	if req.OrganizerId == "" || req.PlanCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "organizer_id and plan_code are required")
+	}
+
+	if _, err := s.planService.AssignPlan(ctx, req.AssignedBy, req.OrganizerId, &request.AssignPlanRequest{Plan: req.PlanCode}); err != nil {
+		switch {
+		case errors.Is(err, service.ErrPlanNotFound), errors.Is(err, service.ErrOrganizerNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		log.Printf("[gRPC] AssignOrganizerPlan failed for organizer %s: %v", req.OrganizerId, err)
+		return nil, status.Error(codes.Internal, "failed to assign organizer plan")
+	}
+
+	plan, err := s.planRepo.GetForOrganizer(ctx, req.OrganizerId)
+	if err != nil {
+		log.Printf("[gRPC] AssignOrganizerPlan failed to read plan of organizer %s: %v", req.OrganizerId, err)
+		return nil, status.Error(codes.Internal, "failed to get organizer plan")
+	}
+
+	return toPBOrganizerPlan(plan), nil
+}
+
+// toPBOrganizerPlan converts a plan entity to its gRPC representation
+func toPBOrganizerPlan(plan *entity.OrganizerPlan) *pb.OrganizerPlan {
+	return &pb.OrganizerPlan{
+		Code:                       plan.Code,
+		Name:                       plan.Name,
+		MaxActiveEvents:            int32(plan.MaxActiveEvents),
+		MaxTicketsPerEvent:         int32(plan.MaxTicketsPerEvent),
+		AnnouncementEmailsPerMonth: int32(plan.AnnouncementEmailsPerMonth),
+		ApiRequestsPerMinute:       int32(plan.APIRequestsPerMinute),
+	}
+}
+
+// toPBEvent converts an event entity to its gRPC representation
+func toPBEvent(event *entity.Event) *pb.Event {
+	location := event.Location
+	if event.Venue != nil && *event.Venue != "" {
+		location = *event.Venue
+	}
+
+	return &pb.Event{
+		Id:           event.ID,
+		Title:        event.Title,
+		Slug:         event.Slug,
+		Description:  stringValue(event.Description),
+		Location:     location,
+		StartDate:    event.StartDate.Format(time.RFC3339),
+		EndDate:      event.EndDate.Format(time.RFC3339),
+		Timezone:     event.Timezone,
+		ScanPolicy:   event.ScanPolicy,
+		BannerUrl:    stringValue(event.BannerURL),
+		Category:     event.Category,
+		OrganizerId:  event.OrganizerID,
+		TenantId:     event.TenantID,
+		Status:       event.Status,
+		CreatedAt:    event.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    event.UpdatedAt.Format(time.RFC3339),
+		RefundPolicy: stringValue(event.RefundPolicy),
+		IsSandbox:    event.IsSandbox,
+		DynamicQr:    event.DynamicQR,
+	}
+}
+
+// toPBTier converts a ticket tier entity to its gRPC representation
+func toPBTier(tier *entity.TicketTier) *pb.TicketTier {
+	return &pb.TicketTier{
+		Id:          tier.ID,
+		EventId:     tier.EventID,
+		Name:        tier.Name,
+		Price:       tier.Price.Float64(),
+		Quota:       int32(tier.Quota),
+		SoldCount:   int32(tier.SoldCount),
+		MaxPerOrder: int32(tier.MaxPerOrder),
+	}
+}
+
+// toPBTiers converts ticket tier entities to a gRPC list response
+func toPBTiers(tiers []entity.TicketTier) *pb.ListTiersResponse {
+	resp := &pb.ListTiersResponse{Tiers: make([]*pb.TicketTier, len(tiers))}
+	for i := range tiers {
+		resp.Tiers[i] = toPBTier(&tiers[i])
+	}
+	return resp
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -2,14 +2,62 @@ package message
 
 // Success messages
 const (
-	MsgEventCreated      = "Event created successfully"
-	MsgEventUpdated      = "Event updated successfully"
-	MsgEventDeleted      = "Event deleted successfully"
-	MsgEventRetrieved    = "Event retrieved successfully"
-	MsgEventsRetrieved   = "Events retrieved successfully"
-	MsgTicketTierCreated = "Ticket tier created successfully"
-	MsgTicketTierUpdated = "Ticket tier updated successfully"
-	MsgTicketTierDeleted = "Ticket tier deleted successfully"
+	MsgEventCreated       = "Event created successfully"
+	MsgEventUpdated       = "Event updated successfully"
+	MsgEventDeleted       = "Event deleted successfully"
+	MsgEventRetrieved     = "Event retrieved successfully"
+	MsgEventsRetrieved    = "Events retrieved successfully"
+	MsgTicketTierCreated  = "Ticket tier created successfully"
+	MsgTicketTierUpdated  = "Ticket tier updated successfully"
+	MsgTicketTierDeleted  = "Ticket tier deleted successfully"
+	MsgTicketTierArchived = "Ticket tier archived successfully"
+	MsgZoneCreated        = "Zone created successfully"
+	MsgZoneDeleted        = "Zone deleted successfully"
+	MsgZonesRetrieved     = "Zones retrieved successfully"
+	MsgTierZonesUpdated   = "Ticket tier zones updated successfully"
+	MsgFAQCreated         = "FAQ created successfully"
+	MsgFAQUpdated         = "FAQ updated successfully"
+	MsgFAQDeleted         = "FAQ deleted successfully"
+	MsgFAQsRetrieved      = "FAQs retrieved successfully"
+	MsgSessionCreated     = "Agenda session created successfully"
+	MsgSessionUpdated     = "Agenda session updated successfully"
+	MsgSessionDeleted     = "Agenda session deleted successfully"
+	MsgAgendaRetrieved    = "Agenda retrieved successfully"
+	MsgPerformerCreated   = "Performer created successfully"
+	MsgPerformerUpdated   = "Performer updated successfully"
+	MsgPerformerDeleted   = "Performer deleted successfully"
+	MsgPerformerRetrieved = "Performer retrieved successfully"
+	MsgPerformersListed   = "Performers retrieved successfully"
+	MsgLineupUpdated      = "Event performers updated successfully"
+	MsgSEORetrieved       = "Event SEO metadata retrieved successfully"
+	MsgEventReported      = "Event reported successfully, our team will review it"
+	MsgReportsListed      = "Reported events retrieved successfully"
+	MsgReportsRetrieved   = "Event reports retrieved successfully"
+	MsgEventModerated     = "Event reports reviewed successfully"
+	MsgVerifySubmitted    = "Verification documents submitted successfully, our team will review them"
+	MsgVerifyRetrieved    = "Verification retrieved successfully"
+	MsgVerifyListed       = "Verifications retrieved successfully"
+	MsgVerifyReviewed     = "Verification reviewed successfully"
+	MsgVerifyRevoked      = "Organizer verification revoked successfully"
+	MsgOrganizerRetrieved = "Organizer retrieved successfully"
+	MsgPricesRetrieved    = "Ticket tier price history retrieved successfully"
+	MsgPlansRetrieved     = "Plans retrieved successfully"
+	MsgPlanRetrieved      = "Plan retrieved successfully"
+	MsgPlanUpdated        = "Plan updated successfully"
+	MsgPlanAssigned       = "Plan assigned successfully"
+	MsgEventsImported     = "Events imported successfully"
+	MsgEventsImportValid  = "Events file is valid, nothing was imported (dry run)"
+	MsgSplitsRetrieved    = "Revenue splits retrieved successfully"
+	MsgSplitsUpdated      = "Revenue splits updated successfully"
+	MsgSharesRetrieved    = "Revenue shares retrieved successfully"
+	MsgSenderRetrieved    = "Email sender retrieved successfully"
+	MsgSenderConfigured   = "Email sender saved, publish the DNS records and verify the domain to start sending from it"
+	MsgSenderVerified     = "Email sender domain checked successfully"
+	MsgSenderDeleted      = "Email sender removed, emails are sent by the platform again"
+	MsgTemplateRetrieved  = "Ticket template retrieved successfully"
+	MsgTemplateSaved      = "Ticket template saved, e-tickets sent from now on use it"
+	MsgTemplateDeleted    = "Ticket template removed, e-tickets use the platform layout again"
+	MsgAnalyticsRetrieved = "Event analytics retrieved successfully"
 )
 
 // Error messages
@@ -24,8 +72,43 @@ const (
 	ErrEventSlugExists          = "Event with this slug already exists"
 	ErrInvalidStatus            = "Invalid event status"
 	ErrInvalidCategory          = "Invalid event category"
-	ErrQuotaBelowSoldCount      = "Quota cannot be less than sold count"
+	ErrQuotaBelowSoldCount      = "Quota cannot be less than sold and reserved tickets"
+	ErrAccessibleBelowSold      = "Accessible quota cannot be less than sold and reserved accessible seats"
 	ErrInvalidEarlyBirdSettings = "Early bird end date must be set when early bird price is provided"
 	ErrInvalidEarlyBirdPrice    = "Early bird price must be less than regular price"
 	ErrInvalidEarlyBirdEndDate  = "Early bird end date must be in the future"
+	ErrVersionConflict          = "Resource was modified by another request, please review the latest data and retry"
+	ErrInvalidVersion           = "Invalid If-Match header, expected resource version"
+	ErrZoneNotFound             = "Zone not found"
+	ErrZoneCodeExists           = "Zone with this code already exists for the event"
+	ErrFAQNotFound              = "FAQ not found"
+	ErrSessionNotFound          = "Agenda session not found"
+	ErrSessionOutsideEvent      = "Agenda session must take place within the event dates"
+	ErrPerformerNotFound        = "Performer not found"
+	ErrEventAlreadyReported     = "You have already reported this event, our team will review it"
+	ErrOwnEventReport           = "You cannot report your own event"
+	ErrEventUnderReview         = "Event is held for review after user reports and cannot be published"
+	ErrTicketTierHasOrders      = "Ticket tier has orders and cannot be deleted, archive it instead"
+	ErrEventCompleted           = "Event has ended and can no longer be edited"
+	ErrPlanNotFound             = "Plan not found"
+	ErrOrganizerNotFound        = "Organizer not found"
+	ErrVerificationNotFound     = "Verification not found"
+	ErrVerificationPending      = "Your verification is already being reviewed"
+	ErrOrganizerVerified        = "Organizer is already verified"
+	ErrVerificationReviewed     = "Verification has already been reviewed"
+	ErrOrganizerNotVerified     = "Organizer is not verified"
+	ErrEventQuotaExceeded       = "Active event limit of your plan reached, unpublish an event or upgrade your plan"
+	ErrTicketQuotaExceeded      = "Total ticket quota of the event exceeds the limit of your plan"
+	ErrAPIRateLimitExceeded     = "API request limit of your plan reached, retry later"
+	ErrInvalidEventsFile        = "Invalid events file"
+	ErrImportInvalidRows        = "Events file has invalid rows, nothing was imported"
+	ErrImportIncomplete         = "Import stopped before all events were created"
+	ErrSandboxLiveEvent         = "Only draft events can be switched to sandbox mode"
+	ErrSandboxOrdersRemain      = "Clear the sandbox test orders before taking the event live"
+	ErrInvalidRevenueSplit      = "Revenue splits must total 100 percent, with up to 2 decimals and each organizer listed once"
+	ErrEmailSenderNotFound      = "Email sender not configured"
+	ErrSenderAddressDomain      = "From email must be an address on the sending domain"
+	ErrSenderDomainTaken        = "Sending domain is already used by another organizer"
+	ErrTicketTemplateNotFound   = "Ticket template not configured"
+	ErrTicketLogoUnavailable    = "Logo can't be fetched, use a public HTTPS PNG or JPEG up to 1 MB"
 )
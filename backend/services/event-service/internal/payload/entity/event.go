@@ -4,21 +4,27 @@ import "time"
 
 // Event represents the event entity in database
 type Event struct {
-	ID          string    `json:"id" db:"id"`
-	OrganizerID string    `json:"organizer_id" db:"organizer_id"`
-	Title       string    `json:"title" db:"title"`
-	Slug        string    `json:"slug" db:"slug"`
-	Description *string   `json:"description,omitempty" db:"description"`
-	Category    string    `json:"category" db:"category"`
-	Location    string    `json:"location" db:"location"`
-	Venue       *string   `json:"venue,omitempty" db:"venue"`
-	StartDate   time.Time `json:"start_date" db:"start_date"`
-	EndDate     time.Time `json:"end_date" db:"end_date"`
-	Timezone    string    `json:"timezone" db:"timezone"`
-	BannerURL   *string   `json:"banner_url,omitempty" db:"banner_url"`
-	Status      string    `json:"status" db:"status"`
-	CreatedAt   time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+	ID           string    `json:"id" db:"id"`
+	OrganizerID  string    `json:"organizer_id" db:"organizer_id"`
+	TenantID     string    `json:"tenant_id" db:"tenant_id"`
+	Title        string    `json:"title" db:"title"`
+	Slug         string    `json:"slug" db:"slug"`
+	Description  *string   `json:"description,omitempty" db:"description"`
+	Category     string    `json:"category" db:"category"`
+	Location     string    `json:"location" db:"location"`
+	Venue        *string   `json:"venue,omitempty" db:"venue"`
+	StartDate    time.Time `json:"start_date" db:"start_date"`
+	EndDate      time.Time `json:"end_date" db:"end_date"`
+	Timezone     string    `json:"timezone" db:"timezone"`
+	ScanPolicy   string    `json:"scan_policy" db:"scan_policy"`               // How tickets are scanned at the entrance
+	RefundPolicy *string   `json:"refund_policy,omitempty" db:"refund_policy"` // Standard refund template, nil if the organizer publishes its own
+	IsSandbox    bool      `json:"is_sandbox" db:"is_sandbox"`                 // Rehearsal: test payments, TEST tickets, left out of settlements
+	DynamicQR    bool      `json:"dynamic_qr" db:"dynamic_qr"`                 // Entry needs the rotating QR from the buyer's app
+	BannerURL    *string   `json:"banner_url,omitempty" db:"banner_url"`
+	Status       string    `json:"status" db:"status"`
+	Version      int       `json:"version" db:"version"` // Optimistic concurrency version
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
 }
 
 // EventStatus constants
@@ -26,6 +32,22 @@ const (
 	StatusDraft     = "draft"
 	StatusPublished = "published"
 	StatusCancelled = "cancelled"
+	StatusCompleted = "completed" // Set by the completion worker once end_date has passed
+)
+
+// ScanPolicy constants
+const (
+	ScanPolicySingleUse = "single_use" // One entry per ticket
+	ScanPolicyReentry   = "reentry"    // Re-entry allowed after an exit scan
+	ScanPolicyPerDay    = "per_day"    // One entry per event day (multi-day events)
+)
+
+// RefundPolicy constants (standard templates enforced on buyer refund requests by ticketing service)
+const (
+	RefundPolicyNoRefunds = "no_refunds" // Tickets are never refunded by the buyer
+	RefundPolicySevenDay  = "seven_day"  // Refundable within 7 days of purchase, until the event starts
+	RefundPolicyFlexible  = "flexible"   // Refundable until 24 hours before the event starts
+	RefundPolicyCustom    = "custom"     // Request value clearing the template; the organizer publishes its own refund policy
 )
 
 // EventCategory constants
@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"math"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// TierSales represents the paid sales of a ticket tier
+// Only paid and completed orders count; reservations, refunds and sandbox test orders are left out
+type TierSales struct {
+	TierID      string      `db:"id"`
+	Name        string      `db:"name"`
+	Price       money.Money `db:"price"` // Current price
+	Quota       int         `db:"quota"`
+	TicketsSold int         `db:"tickets_sold"`
+	Revenue     money.Money `db:"revenue"` // Ticket subtotals, before fees
+}
+
+// DailySales represents the paid sales of one day in the event's timezone
+type DailySales struct {
+	Date        string      `db:"day"` // YYYY-MM-DD
+	Orders      int         `db:"orders"`
+	TicketsSold int         `db:"tickets_sold"`
+	Revenue     money.Money `db:"revenue"`
+}
+
+// OrderFunnel counts an event's orders by outcome; every order starts as a reservation
+type OrderFunnel struct {
+	Reservations int `db:"reservations"`
+	Pending      int `db:"pending"`  // Still reserved, waiting for payment
+	Paid         int `db:"paid"`     // Paid or completed
+	Refunded     int `db:"refunded"` // Paid, then refunded
+	Expired      int `db:"expired"`
+	Cancelled    int `db:"cancelled"`
+}
+
+// ConversionRate returns the percentage of reservations that were paid, refunded ones included,
+// rounded to 2 decimals
+func (f *OrderFunnel) ConversionRate() float64 {
+	if f.Reservations == 0 {
+		return 0
+	}
+	return math.Round(float64(f.Paid+f.Refunded)*10000/float64(f.Reservations)) / 100
+}
@@ -0,0 +1,18 @@
+package entity
+
+import "github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+
+// EventAvailability represents per-event ticket summary from the event_availability view
+// Refreshed by ticketing-service after sold_count changes and by event-service after tier changes
+type EventAvailability struct {
+	EventID    string       `json:"event_id" db:"event_id"`
+	TotalQuota int          `json:"total_quota" db:"total_quota"`
+	TotalSold  int          `json:"total_sold" db:"total_sold"`
+	MinPrice   *money.Money `json:"min_price,omitempty" db:"min_price"`
+	MaxPrice   *money.Money `json:"max_price,omitempty" db:"max_price"`
+}
+
+// AvailableCount returns tickets still available across all tiers
+func (a *EventAvailability) AvailableCount() int {
+	return a.TotalQuota - a.TotalSold
+}
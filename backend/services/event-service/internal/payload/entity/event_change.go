@@ -0,0 +1,7 @@
+package entity
+
+// EventChange represents an entry of the event change feed (see migration 000017)
+type EventChange struct {
+	Seq     int64  `json:"seq" db:"seq"`
+	EventID string `json:"event_id" db:"event_id"`
+}
@@ -0,0 +1,28 @@
+package entity
+
+import "time"
+
+// EventFAQ represents a frequently asked question shown on the event page
+type EventFAQ struct {
+	ID        string    `json:"id" db:"id"`
+	EventID   string    `json:"event_id" db:"event_id"`
+	Question  string    `json:"question" db:"question"`
+	Answer    string    `json:"answer" db:"answer"`
+	Position  int       `json:"position" db:"position"` // FAQs are listed by ascending position
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// EventSession represents an agenda entry of an event (talk, performance, break)
+type EventSession struct {
+	ID          string    `json:"id" db:"id"`
+	EventID     string    `json:"event_id" db:"event_id"`
+	Title       string    `json:"title" db:"title"`
+	Description *string   `json:"description,omitempty" db:"description"`
+	Stage       *string   `json:"stage,omitempty" db:"stage"`
+	Speakers    []string  `json:"speakers" db:"speakers"`
+	StartsAt    time.Time `json:"starts_at" db:"starts_at"`
+	EndsAt      time.Time `json:"ends_at" db:"ends_at"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
@@ -0,0 +1,65 @@
+package entity
+
+import "time"
+
+// EventReport is an abuse report filed by a user on an event
+type EventReport struct {
+	ID         string     `db:"id"`
+	EventID    string     `db:"event_id"`
+	TenantID   string     `db:"tenant_id"`
+	ReporterID string     `db:"reporter_id"`
+	Reason     string     `db:"reason"`
+	Details    *string    `db:"details"`
+	Status     string     `db:"status"` // open, dismissed, upheld
+	ReviewedBy *string    `db:"reviewed_by"`
+	ReviewedAt *time.Time `db:"reviewed_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+}
+
+// EventModeration is the moderation hold of a reported event
+type EventModeration struct {
+	EventID     string     `db:"event_id"`
+	Status      string     `db:"status"`      // pending_review, removed
+	Unpublished bool       `db:"unpublished"` // The hold took the event off sale
+	Note        *string    `db:"note"`
+	ReviewedBy  *string    `db:"reviewed_by"`
+	ReviewedAt  *time.Time `db:"reviewed_at"`
+	CreatedAt   time.Time  `db:"created_at"`
+	UpdatedAt   time.Time  `db:"updated_at"`
+}
+
+// ReportSummary aggregates the open reports of an event
+type ReportSummary struct {
+	EventID        string
+	OpenReports    int
+	Reasons        []string // Distinct reasons of the open reports
+	LatestReportAt time.Time
+}
+
+// Report reason constants
+const (
+	ReportReasonScam          = "scam"
+	ReportReasonInappropriate = "inappropriate"
+	ReportReasonMisleading    = "misleading"
+	ReportReasonSpam          = "spam"
+	ReportReasonOther         = "other"
+)
+
+// Report status constants
+const (
+	ReportStatusOpen      = "open"      // Waiting for review
+	ReportStatusDismissed = "dismissed" // Reviewed, no violation
+	ReportStatusUpheld    = "upheld"    // Reviewed, event removed
+)
+
+// Moderation status constants
+const (
+	ModerationPendingReview = "pending_review" // Unpublished automatically after too many reports
+	ModerationRemoved       = "removed"        // Reports upheld by a moderator
+)
+
+// Moderation decision constants
+const (
+	ModerationDecisionDismiss = "dismiss" // Close the reports and lift the hold
+	ModerationDecisionRemove  = "remove"  // Uphold the reports and keep the event unpublished
+)
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// EventSettlement represents the organizer settlement of a completed event (see migration 000019)
+type EventSettlement struct {
+	EventID      string      `json:"event_id" db:"event_id"`
+	OrganizerID  string      `json:"organizer_id" db:"organizer_id"`
+	PaidOrders   int         `json:"paid_orders" db:"paid_orders"`
+	TicketsSold  int         `json:"tickets_sold" db:"tickets_sold"`
+	GrossAmount  money.Money `json:"gross_amount" db:"gross_amount"`   // Collected from buyers
+	FeeAmount    money.Money `json:"fee_amount" db:"fee_amount"`       // Platform and service fees
+	PayoutAmount money.Money `json:"payout_amount" db:"payout_amount"` // Owed to the organizer
+	CalculatedAt time.Time   `json:"calculated_at" db:"calculated_at"`
+}
@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+// EventZone represents an access zone of an event venue (e.g. VIP, GA)
+// Gates scan tickets for one zone; ticket tiers are mapped to the zones they may enter
+type EventZone struct {
+	ID            string    `json:"id" db:"id"`
+	EventID       string    `json:"event_id" db:"event_id"`
+	Code          string    `json:"code" db:"code"` // Short uppercase code used by gates and QR claims
+	Name          string    `json:"name" db:"name"`
+	TicketTierIDs []string  `json:"ticket_tier_ids" db:"-"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+}
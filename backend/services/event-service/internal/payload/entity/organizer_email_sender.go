@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// OrganizerEmailSender is an organizer's own sending domain for the emails of its events
+// Emails only use it while verified, otherwise they're sent by the tenant or platform sender
+type OrganizerEmailSender struct {
+	OrganizerID      string     `db:"organizer_id"`
+	TenantID         string     `db:"tenant_id"`
+	Domain           string     `db:"domain"`
+	FromName         string     `db:"from_name"`
+	FromEmail        string     `db:"from_email"` // Address on Domain
+	ReplyTo          *string    `db:"reply_to"`
+	ProviderDomainID string     `db:"provider_domain_id"` // Domain ID at the email provider (Resend)
+	Status           string     `db:"status"`             // pending, verified, failed
+	DNSRecords       DNSRecords `db:"dns_records"`
+	VerifiedAt       *time.Time `db:"verified_at"`
+	LastCheckedAt    *time.Time `db:"last_checked_at"`
+	CreatedAt        time.Time  `db:"created_at"`
+	UpdatedAt        time.Time  `db:"updated_at"`
+}
+
+// IsVerified checks if emails are sent from the organizer's domain
+func (s *OrganizerEmailSender) IsVerified() bool {
+	return s.Status == EmailSenderVerified
+}
+
+// Email sender status constants
+const (
+	EmailSenderPending  = "pending"  // DNS records not published or not checked yet
+	EmailSenderVerified = "verified" // Emails are sent from the domain
+	EmailSenderFailed   = "failed"   // Provider couldn't verify the records, fix them and verify again
+)
+
+// DNSRecord is a record the organizer must publish for the provider to verify the domain
+type DNSRecord struct {
+	Record   string `json:"record"` // SPF or DKIM
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Value    string `json:"value"`
+	TTL      string `json:"ttl"`
+	Priority int    `json:"priority,omitempty"`
+	Status   string `json:"status"`
+}
+
+// DNSRecords lists the records of a sending domain
+type DNSRecords []DNSRecord
+
+// Value implements driver.Valuer, records are stored as JSONB
+func (r DNSRecords) Value() (driver.Value, error) {
+	if r == nil {
+		return "[]", nil
+	}
+	encoded, err := json.Marshal([]DNSRecord(r))
+	if err != nil {
+		return nil, err
+	}
+	return string(encoded), nil
+}
+
+// Scan implements sql.Scanner
+func (r *DNSRecords) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, r)
+	case string:
+		return json.Unmarshal([]byte(v), r)
+	default:
+		return fmt.Errorf("cannot scan %T into DNSRecords", src)
+	}
+}
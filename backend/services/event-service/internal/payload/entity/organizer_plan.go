@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+// OrganizerPlan represents the limits of an organizer plan tier (see migration 000024)
+// A limit of 0 means unlimited
+type OrganizerPlan struct {
+	Code                       string    `json:"code" db:"code"`
+	Name                       string    `json:"name" db:"name"`
+	MaxActiveEvents            int       `json:"max_active_events" db:"max_active_events"`                         // Published events at a time
+	MaxTicketsPerEvent         int       `json:"max_tickets_per_event" db:"max_tickets_per_event"`                 // Sum of tier quotas of one event
+	AnnouncementEmailsPerMonth int       `json:"announcement_emails_per_month" db:"announcement_emails_per_month"` // Enforced by notification-service
+	APIRequestsPerMinute       int       `json:"api_requests_per_minute" db:"api_requests_per_minute"`             // Event management API requests
+	UpdatedAt                  time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Plan codes
+const (
+	PlanFree = "free" // Organizers without an assignment are on this plan
+	PlanPro  = "pro"
+)
+
+// WithinLimit checks if total stays within limit, 0 meaning unlimited
+func WithinLimit(limit, total int) bool {
+	return limit == 0 || total <= limit
+}
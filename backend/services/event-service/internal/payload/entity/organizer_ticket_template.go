@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+// OrganizerTicketTemplate is an organizer's customized layout of the PDF e-tickets of its events
+// Unset fields keep the platform layout
+type OrganizerTicketTemplate struct {
+	OrganizerID   string    `db:"organizer_id"`
+	TenantID      string    `db:"tenant_id"`
+	LogoURL       *string   `db:"logo_url"`      // HTTPS PNG or JPEG shown in the ticket header
+	PrimaryColor  *string   `db:"primary_color"` // Hex colour of the header and borders
+	AccentColor   *string   `db:"accent_color"`  // Hex colour of the section titles
+	SponsorFooter *string   `db:"sponsor_footer"`
+	TermsText     *string   `db:"terms_text"` // Printed on a page after the ticket
+	CreatedAt     time.Time `db:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+}
@@ -0,0 +1,47 @@
+package entity
+
+import "time"
+
+// OrganizerVerification is a submission of identity or business documents by an organizer
+// An organizer is verified while its latest submission is approved
+type OrganizerVerification struct {
+	ID             string     `db:"id"`
+	OrganizerID    string     `db:"organizer_id"`
+	TenantID       string     `db:"tenant_id"`
+	LegalName      string     `db:"legal_name"`
+	DocumentType   string     `db:"document_type"`
+	DocumentNumber string     `db:"document_number"`
+	DocumentURL    string     `db:"document_url"` // Private storage, uploaded by the frontend
+	Status         string     `db:"status"`       // pending, approved, rejected, revoked
+	ReviewNote     *string    `db:"review_note"`
+	ReviewedBy     *string    `db:"reviewed_by"`
+	ReviewedAt     *time.Time `db:"reviewed_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+}
+
+// IsApproved checks if the submission currently verifies its organizer
+func (v *OrganizerVerification) IsApproved() bool {
+	return v.Status == VerificationApproved
+}
+
+// Verification document type constants
+const (
+	DocumentTypeKTP  = "ktp"  // National identity card, individual organizers
+	DocumentTypeNPWP = "npwp" // Tax registration number
+	DocumentTypeNIB  = "nib"  // Business identification number
+	DocumentTypeAkta = "akta" // Deed of establishment, companies and foundations
+)
+
+// Verification status constants
+const (
+	VerificationPending  = "pending"  // Waiting for review
+	VerificationApproved = "approved" // Organizer is verified
+	VerificationRejected = "rejected" // Documents declined, the organizer may submit again
+	VerificationRevoked  = "revoked"  // Approval withdrawn by an admin
+)
+
+// Verification decision constants
+const (
+	VerificationDecisionApprove = "approve"
+	VerificationDecisionReject  = "reject"
+)
@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Performer represents a speaker or performer in the tenant's directory
+// Any organizer of the tenant may feature a performer; only its creator edits it
+type Performer struct {
+	ID          string      `json:"id" db:"id"`
+	TenantID    string      `json:"tenant_id" db:"tenant_id"`
+	Name        string      `json:"name" db:"name"`
+	PhotoURL    *string     `json:"photo_url,omitempty" db:"photo_url"`
+	Bio         *string     `json:"bio,omitempty" db:"bio"`
+	SocialLinks SocialLinks `json:"social_links" db:"social_links"`
+	CreatedBy   string      `json:"created_by" db:"created_by"`
+	CreatedAt   time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at" db:"updated_at"`
+}
+
+// SocialLinks maps a network (e.g. instagram, website) to the performer's profile URL
+type SocialLinks map[string]string
+
+// Value implements driver.Valuer, links are stored as JSONB
+func (l SocialLinks) Value() (driver.Value, error) {
+	if l == nil {
+		return "{}", nil
+	}
+	encoded, err := json.Marshal(map[string]string(l))
+	if err != nil {
+		return nil, err
+	}
+	return string(encoded), nil
+}
+
+// Scan implements sql.Scanner
+func (l *SocialLinks) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, l)
+	case string:
+		return json.Unmarshal([]byte(v), l)
+	default:
+		return fmt.Errorf("cannot scan %T into SocialLinks", src)
+	}
+}
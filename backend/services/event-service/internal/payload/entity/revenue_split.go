@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"math"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// RevenueSplit represents a co-organizer's percentage of an event's revenue (see migration 000043)
+// An event without splits pays its organizer in full
+type RevenueSplit struct {
+	EventID       string    `json:"event_id" db:"event_id"`
+	OrganizerID   string    `json:"organizer_id" db:"organizer_id"`
+	Percentage    float64   `json:"percentage" db:"percentage"` // Up to 2 decimals, an event's splits total 100
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+	OrganizerName string    `json:"organizer_name" db:"full_name"` // Joined from users
+}
+
+// SettlementShare represents a party's part of a settlement payout, recorded with the settlement
+type SettlementShare struct {
+	EventID      string      `json:"event_id" db:"event_id"`
+	OrganizerID  string      `json:"organizer_id" db:"organizer_id"`
+	Percentage   float64     `json:"percentage" db:"percentage"`
+	PayoutAmount money.Money `json:"payout_amount" db:"payout_amount"`
+}
+
+// RevenueShare represents an organizer's share of an event they are a party to
+// Settlement fields are set once the event is settled
+type RevenueShare struct {
+	EventID          string       `db:"event_id"`
+	EventTitle       string       `db:"title"`
+	EventStatus      string       `db:"status"`
+	StartDate        time.Time    `db:"start_date"`
+	IsOwner          bool         `db:"is_owner"` // The event's organizer, who sets the splits
+	Percentage       float64      `db:"percentage"`
+	PayoutAmount     *money.Money `db:"payout_amount"`     // Owed to the organizer
+	SettlementPayout *money.Money `db:"settlement_payout"` // Owed to all parties
+	SettledAt        *time.Time   `db:"calculated_at"`
+}
+
+// PercentageBasisPoints converts a percentage with up to 2 decimals to basis points (100% is 10000)
+func PercentageBasisPoints(percentage float64) int64 {
+	return int64(math.Round(percentage * 100))
+}
+
+// SplitPayout divides a settlement payout between the parties of an event's splits
+// Shares are rounded to the minor unit, the rounding difference goes to the first split so shares
+// add up to the payout. Without splits the organizer is owed the payout in full
+func SplitPayout(settlement *EventSettlement, splits []RevenueSplit) []SettlementShare {
+	if len(splits) == 0 {
+		return []SettlementShare{{
+			EventID:      settlement.EventID,
+			OrganizerID:  settlement.OrganizerID,
+			Percentage:   100,
+			PayoutAmount: settlement.PayoutAmount,
+		}}
+	}
+
+	shares := make([]SettlementShare, len(splits))
+	allocated := money.Money(0)
+	for i, split := range splits {
+		amount := settlement.PayoutAmount.MulRatio(PercentageBasisPoints(split.Percentage), 10000)
+		shares[i] = SettlementShare{
+			EventID:      settlement.EventID,
+			OrganizerID:  split.OrganizerID,
+			Percentage:   split.Percentage,
+			PayoutAmount: amount,
+		}
+		allocated = allocated.Add(amount)
+	}
+	shares[0].PayoutAmount = shares[0].PayoutAmount.Add(settlement.PayoutAmount.Sub(allocated))
+
+	return shares
+}
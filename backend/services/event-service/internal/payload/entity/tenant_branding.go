@@ -0,0 +1,7 @@
+package entity
+
+// TenantBranding represents the brand of a tenant shown on generated share images
+type TenantBranding struct {
+	Name         string  `json:"name" db:"name"`
+	PrimaryColor *string `json:"primary_color,omitempty" db:"primary_color"` // "#RRGGBB"
+}
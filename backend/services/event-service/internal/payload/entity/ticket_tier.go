@@ -1,21 +1,59 @@
 package entity
 
-import "time"
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/pricing"
+)
+
+// Price history reasons
+const (
+	PriceReasonCreated   = "created"
+	PriceReasonOrganizer = "organizer"
+	PriceReasonDynamic   = "dynamic"
+)
 
 // TicketTier represents ticket tier entity in database
 type TicketTier struct {
-	ID               string     `json:"id" db:"id"`
-	EventID          string     `json:"event_id" db:"event_id"`
-	Name             string     `json:"name" db:"name"`
-	Description      *string    `json:"description,omitempty" db:"description"`
-	Price            float64    `json:"price" db:"price"`
-	Quota            int        `json:"quota" db:"quota"`
-	SoldCount        int        `json:"sold_count" db:"sold_count"`
-	MaxPerOrder      int        `json:"max_per_order" db:"max_per_order"`
-	EarlyBirdPrice   *float64   `json:"early_bird_price,omitempty" db:"early_bird_price"`
-	EarlyBirdEndDate *time.Time `json:"early_bird_end_date,omitempty" db:"early_bird_end_date"`
-	CreatedAt        time.Time  `json:"created_at" db:"created_at"`
-	UpdatedAt        time.Time  `json:"updated_at" db:"updated_at"`
+	ID               string               `json:"id" db:"id"`
+	EventID          string               `json:"event_id" db:"event_id"`
+	Name             string               `json:"name" db:"name"`
+	Description      *string              `json:"description,omitempty" db:"description"`
+	Price            money.Money          `json:"price" db:"price"`
+	Quota            int                  `json:"quota" db:"quota"`
+	SoldCount        int                  `json:"sold_count" db:"sold_count"`
+	MaxPerOrder      int                  `json:"max_per_order" db:"max_per_order"`
+	EarlyBirdPrice   *money.Money         `json:"early_bird_price,omitempty" db:"early_bird_price"`
+	EarlyBirdEndDate *time.Time           `json:"early_bird_end_date,omitempty" db:"early_bird_end_date"`
+	BasePrice        *money.Money         `json:"base_price,omitempty" db:"base_price"`           // Organizer's price the dynamic rule adjusts
+	DynamicPricing   *pricing.DynamicRule `json:"dynamic_pricing,omitempty" db:"dynamic_pricing"` // Nil when the tier has a fixed price
+	Version          int                  `json:"version" db:"version"`                           // Optimistic concurrency version
+	ArchivedAt       *time.Time           `json:"archived_at,omitempty" db:"archived_at"`         // Hidden from sale, kept for existing orders
+	CreatedAt        time.Time            `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time            `json:"updated_at" db:"updated_at"`
+
+	// Seats within Quota held back for accessible seating; accessible sales count in SoldCount too
+	AccessibleQuota     int `json:"accessible_quota" db:"accessible_quota"`
+	AccessibleSoldCount int `json:"accessible_sold_count" db:"accessible_sold_count"`
+}
+
+// TicketTierPrice is a price a ticket tier was sold at from CreatedAt
+type TicketTierPrice struct {
+	ID           string       `json:"id" db:"id"`
+	TicketTierID string       `json:"ticket_tier_id" db:"ticket_tier_id"`
+	Price        money.Money  `json:"price" db:"price"`
+	BasePrice    *money.Money `json:"base_price,omitempty" db:"base_price"`
+	SoldCount    int          `json:"sold_count" db:"sold_count"`
+	Reason       string       `json:"reason" db:"reason"`
+	CreatedAt    time.Time    `json:"created_at" db:"created_at"`
+}
+
+// DynamicTier is a ticket tier under dynamic pricing with the event data its rule needs
+type DynamicTier struct {
+	TicketTier
+	EventSlug      string
+	EventStartDate time.Time
 }
 
 // AvailableCount returns available tickets
@@ -29,7 +67,11 @@ func (t *TicketTier) IsAvailable() bool {
 }
 
 // CurrentPrice returns current price (early bird or regular)
-func (t *TicketTier) CurrentPrice() float64 {
+// Under dynamic pricing Price already holds the current price
+func (t *TicketTier) CurrentPrice() money.Money {
+	if t.DynamicPricing != nil {
+		return t.Price
+	}
 	if t.EarlyBirdPrice != nil && t.EarlyBirdEndDate != nil {
 		if time.Now().Before(*t.EarlyBirdEndDate) {
 			return *t.EarlyBirdPrice
@@ -38,6 +80,16 @@ func (t *TicketTier) CurrentPrice() float64 {
 	return t.Price
 }
 
+// HasDynamicPricing checks if tier price is set by a dynamic pricing rule
+func (t *TicketTier) HasDynamicPricing() bool {
+	return t.DynamicPricing != nil
+}
+
+// IsArchived checks if tier was archived (hidden from sale)
+func (t *TicketTier) IsArchived() bool {
+	return t.ArchivedAt != nil
+}
+
 // IsSoldOut checks if tier is sold out
 func (t *TicketTier) IsSoldOut() bool {
 	return t.SoldCount >= t.Quota
@@ -0,0 +1,14 @@
+package entity
+
+// User represents the contact details of a user from auth service
+type User struct {
+	ID       string `db:"id"`
+	Email    string `db:"email"`
+	FullName string `db:"full_name"`
+	Role     string `db:"role"` // customer, organizer, admin
+}
+
+// User role constants
+const (
+	RoleCustomer = "customer"
+)
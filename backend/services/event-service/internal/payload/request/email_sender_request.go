@@ -0,0 +1,19 @@
+package request
+
+import "strings"
+
+// ConfigureEmailSenderRequest represents an organizer's own sender for the emails of its events
+type ConfigureEmailSenderRequest struct {
+	Domain    string `json:"domain" binding:"required,fqdn,max=253"`      // Sending domain, e.g. mail.example.com
+	FromName  string `json:"from_name" binding:"required,max=100"`        // Shown as the sender
+	FromEmail string `json:"from_email" binding:"required,email,max=255"` // Must be an address on domain
+	ReplyTo   string `json:"reply_to" binding:"omitempty,email,max=255"`  // Where buyers' replies go, any domain
+}
+
+// Normalize trims the sender and lowercases the domain
+func (r *ConfigureEmailSenderRequest) Normalize() {
+	r.Domain = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(r.Domain), "."))
+	r.FromName = strings.TrimSpace(r.FromName)
+	r.FromEmail = strings.TrimSpace(r.FromEmail)
+	r.ReplyTo = strings.TrimSpace(r.ReplyTo)
+}
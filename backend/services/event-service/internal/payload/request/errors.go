@@ -7,4 +7,9 @@ var (
 	ErrInvalidEarlyBirdSettings = errors.New("early bird end date must be set when early bird price is provided")
 	ErrInvalidEarlyBirdPrice    = errors.New("early bird price must be less than regular price")
 	ErrInvalidEarlyBirdEndDate  = errors.New("early bird end date must be in the future")
+	ErrDynamicPricingEarlyBird  = errors.New("dynamic pricing can't be combined with early bird price")
+	ErrInvalidAccessibleQuota   = errors.New("accessible quota must not exceed quota")
+
+	// Zone validation errors
+	ErrInvalidZoneCode = errors.New("zone code may only contain letters, digits, '-' and '_'")
 )
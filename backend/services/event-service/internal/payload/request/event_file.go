@@ -0,0 +1,89 @@
+package request
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/pricing"
+)
+
+// Events file formats
+const (
+	EventsFileJSON = "json"
+	EventsFileCSV  = "csv"
+)
+
+// MaxImportEvents caps the events of one import file
+const MaxImportEvents = 200
+
+// EventsCSVColumns is the header of CSV events files, one row per ticket tier
+// Rows with the same event_ref form one event whose fields are read from its first row;
+// a row without tier columns adds an event without ticket tiers.
+var EventsCSVColumns = []string{
+	"event_ref", "title", "description", "category", "location", "venue",
+	"start_date", "end_date", "timezone", "scan_policy", "refund_policy", "banner_url", "status",
+	"tier_name", "tier_description", "tier_price", "tier_quota", "tier_max_per_order",
+	"tier_early_bird_price", "tier_early_bird_end_date", "tier_accessible_quota", "tier_dynamic_pricing",
+}
+
+// EventsFile is the format of organizer event imports and exports
+// Exports are re-importable: importing one creates the events again as new events
+type EventsFile struct {
+	Events []EventsFileEvent `json:"events"`
+}
+
+// EventsFileEvent is an event of an events file with its ticket tiers
+type EventsFileEvent struct {
+	CreateEventRequest
+	TicketTiers []EventsFileTicketTier `json:"ticket_tiers"`
+
+	// Ref groups CSV rows, Row is the CSV line or 1-based JSON position reported in validation errors
+	Ref string `json:"-"`
+	Row int    `json:"-"`
+}
+
+// EventsFileTicketTier is a ticket tier of an events file, CreateTicketTierRequest without the event
+type EventsFileTicketTier struct {
+	Name             string               `json:"name" binding:"required,min=3,max=100"`
+	Description      string               `json:"description"`
+	Price            money.Money          `json:"price" binding:"required,min=0"`
+	Quota            int                  `json:"quota" binding:"required,min=1"`
+	MaxPerOrder      int                  `json:"max_per_order" binding:"omitempty,min=1"`
+	EarlyBirdPrice   *money.Money         `json:"early_bird_price,omitempty" binding:"omitempty,min=0"`
+	EarlyBirdEndDate *time.Time           `json:"early_bird_end_date,omitempty"`
+	AccessibleQuota  int                  `json:"accessible_quota" binding:"omitempty,min=0"`
+	DynamicPricing   *pricing.DynamicRule `json:"dynamic_pricing,omitempty"`
+
+	// Row and FieldPrefix locate the tier in validation errors, e.g. line 4 "tier_price" or "ticket_tiers[1].price"
+	Row         int    `json:"-"`
+	FieldPrefix string `json:"-"`
+}
+
+// ImportEventsRequest represents import events query parameters
+type ImportEventsRequest struct {
+	DryRun bool `form:"dry_run"`
+	// Defaults to csv for text/csv bodies and json otherwise
+	Format string `form:"format" binding:"omitempty,oneof=json csv"`
+}
+
+// ExportEventsRequest represents export events query parameters
+type ExportEventsRequest struct {
+	Format string `form:"format" binding:"omitempty,oneof=json csv"`
+	Status string `form:"status" binding:"omitempty,oneof=draft published cancelled completed"`
+}
+
+// ToCreateRequest converts the tier to a create request for eventID
+func (t *EventsFileTicketTier) ToCreateRequest(eventID string) *CreateTicketTierRequest {
+	return &CreateTicketTierRequest{
+		EventID:          eventID,
+		Name:             t.Name,
+		Description:      t.Description,
+		Price:            t.Price,
+		Quota:            t.Quota,
+		MaxPerOrder:      t.MaxPerOrder,
+		EarlyBirdPrice:   t.EarlyBirdPrice,
+		EarlyBirdEndDate: t.EarlyBirdEndDate,
+		AccessibleQuota:  t.AccessibleQuota,
+		DynamicPricing:   t.DynamicPricing,
+	}
+}
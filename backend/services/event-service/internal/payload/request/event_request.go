@@ -1,6 +1,16 @@
 package request
 
-import "time"
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/pricing"
+)
+
+// zoneCodePattern restricts zone codes to what fits in a QR claim (no separators)
+var zoneCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{1,30}$`)
 
 // CreateEventRequest represents create event request
 type CreateEventRequest struct {
@@ -12,8 +22,16 @@ type CreateEventRequest struct {
 	StartDate   time.Time `json:"start_date" binding:"required"`
 	EndDate     time.Time `json:"end_date" binding:"required,gtfield=StartDate"`
 	Timezone    string    `json:"timezone" binding:"required"`
-	BannerURL   string    `json:"banner_url"`
-	Status      string    `json:"status" binding:"omitempty,oneof=draft published"`
+	ScanPolicy  string    `json:"scan_policy" binding:"omitempty,oneof=single_use reentry per_day"`
+	// Standard refund template; omitted or custom leaves the refund policy to the organizer's own document
+	RefundPolicy string `json:"refund_policy" binding:"omitempty,oneof=no_refunds seven_day flexible custom"`
+	BannerURL    string `json:"banner_url"`
+	Status       string `json:"status" binding:"omitempty,oneof=draft published"`
+	// Sandbox events sell TEST tickets paid with the payment gateway's test keys, for rehearsal before going live
+	IsSandbox bool `json:"is_sandbox"`
+	// High-security events admit only the short-lived QR the buyer's app fetches right before entry,
+	// screenshots and printed e-tickets are refused
+	DynamicQR bool `json:"dynamic_qr"`
 }
 
 // UpdateEventRequest represents update event request
@@ -26,8 +44,17 @@ type UpdateEventRequest struct {
 	StartDate   time.Time `json:"start_date"`
 	EndDate     time.Time `json:"end_date"`
 	Timezone    string    `json:"timezone"`
-	BannerURL   string    `json:"banner_url"`
-	Status      string    `json:"status" binding:"omitempty,oneof=draft published cancelled"`
+	ScanPolicy  string    `json:"scan_policy" binding:"omitempty,oneof=single_use reentry per_day"`
+	// Standard refund template, custom removes it
+	RefundPolicy string `json:"refund_policy" binding:"omitempty,oneof=no_refunds seven_day flexible custom"`
+	BannerURL    string `json:"banner_url"`
+	Status       string `json:"status" binding:"omitempty,oneof=draft published cancelled"`
+	// false takes a sandbox event live; only drafts can be switched to sandbox
+	IsSandbox *bool `json:"is_sandbox"`
+	// Switches rotating QR entry on or off, see CreateEventRequest.DynamicQR
+	DynamicQR *bool `json:"dynamic_qr"`
+	// Version the client last read; falls back to If-Match header when omitted
+	Version *int `json:"version" binding:"omitempty,min=1"`
 }
 
 // ListEventsRequest represents list events with filters
@@ -36,35 +63,83 @@ type ListEventsRequest struct {
 	Location  string    `form:"location"`
 	StartDate time.Time `form:"start_date"`
 	EndDate   time.Time `form:"end_date"`
-	Status    string    `form:"status" binding:"omitempty,oneof=draft published cancelled"`
+	Status    string    `form:"status" binding:"omitempty,oneof=draft published cancelled completed"`
 	Search    string    `form:"search"`
 	Page      int       `form:"page" binding:"omitempty,min=1"`
 	Limit     int       `form:"limit" binding:"omitempty,min=1,max=100"`
-	SortBy    string    `form:"sort_by" binding:"omitempty,oneof=start_date created_at title"`
+	SortBy    string    `form:"sort_by" binding:"omitempty,oneof=start_date created_at title price_min price_max"`
 	SortOrder string    `form:"sort_order" binding:"omitempty,oneof=asc desc"`
+	// Price range filters apply to the event's cheapest ticket tier (e.g. max_price=100000 for "under 100k")
+	MinPrice *money.Money `form:"min_price" binding:"omitempty,min=0"`
+	MaxPrice *money.Money `form:"max_price" binding:"omitempty,min=0"`
+	// OnlyAvailable excludes events whose ticket tiers are all sold out
+	OnlyAvailable bool `form:"only_available"`
+	// PerformerID limits the listing to events featuring the performer
+	PerformerID string `form:"performer_id" binding:"omitempty,uuid"`
+	// ExcludeEventID leaves one event out, set by "more events featuring" lists
+	ExcludeEventID string `form:"-"`
 }
 
 // CreateTicketTierRequest represents create ticket tier request
 type CreateTicketTierRequest struct {
-	EventID          string     `json:"event_id" binding:"required,uuid"`
-	Name             string     `json:"name" binding:"required,min=3,max=100"`
-	Description      string     `json:"description"`
-	Price            float64    `json:"price" binding:"required,min=0"`
-	Quota            int        `json:"quota" binding:"required,min=1"`
-	MaxPerOrder      int        `json:"max_per_order" binding:"omitempty,min=1"`
-	EarlyBirdPrice   *float64   `json:"early_bird_price" binding:"omitempty,min=0"`
-	EarlyBirdEndDate *time.Time `json:"early_bird_end_date"`
+	EventID          string       `json:"event_id" binding:"required,uuid"`
+	Name             string       `json:"name" binding:"required,min=3,max=100"`
+	Description      string       `json:"description"`
+	Price            money.Money  `json:"price" binding:"required,min=0"`
+	Quota            int          `json:"quota" binding:"required,min=1"`
+	MaxPerOrder      int          `json:"max_per_order" binding:"omitempty,min=1"`
+	EarlyBirdPrice   *money.Money `json:"early_bird_price" binding:"omitempty,min=0"`
+	EarlyBirdEndDate *time.Time   `json:"early_bird_end_date"`
+	// Seats within quota held back for buyers who need accessible seating
+	AccessibleQuota int `json:"accessible_quota" binding:"omitempty,min=0"`
+	// Rule-based pricing; price becomes the base price the rule adjusts
+	DynamicPricing *pricing.DynamicRule `json:"dynamic_pricing"`
 }
 
 // UpdateTicketTierRequest represents update ticket tier request
 type UpdateTicketTierRequest struct {
-	Name             string     `json:"name" binding:"omitempty,min=3,max=100"`
-	Description      string     `json:"description"`
-	Price            float64    `json:"price" binding:"omitempty,min=0"`
-	Quota            int        `json:"quota" binding:"omitempty,min=1"`
-	MaxPerOrder      int        `json:"max_per_order" binding:"omitempty,min=1"`
-	EarlyBirdPrice   *float64   `json:"early_bird_price" binding:"omitempty,min=0"`
-	EarlyBirdEndDate *time.Time `json:"early_bird_end_date"`
+	Name             string       `json:"name" binding:"omitempty,min=3,max=100"`
+	Description      string       `json:"description"`
+	Price            money.Money  `json:"price" binding:"omitempty,min=0"`
+	Quota            int          `json:"quota" binding:"omitempty,min=1"`
+	MaxPerOrder      int          `json:"max_per_order" binding:"omitempty,min=1"`
+	EarlyBirdPrice   *money.Money `json:"early_bird_price" binding:"omitempty,min=0"`
+	EarlyBirdEndDate *time.Time   `json:"early_bird_end_date"`
+	// Seats within quota held back for buyers who need accessible seating
+	AccessibleQuota int `json:"accessible_quota" binding:"omitempty,min=0"`
+	// Rule-based pricing replacing the current rule; omitting it returns the tier to a fixed price
+	DynamicPricing *pricing.DynamicRule `json:"dynamic_pricing"`
+	// Version the client last read; falls back to If-Match header when omitted
+	Version *int `json:"version" binding:"omitempty,min=1"`
+}
+
+// CreateZoneRequest represents create event zone request
+type CreateZoneRequest struct {
+	Code string `json:"code" binding:"required,max=30"`
+	Name string `json:"name" binding:"required,max=100"`
+}
+
+// SetTicketTierZonesRequest represents the zones a ticket tier may enter
+// An empty list lets the tier enter every zone
+type SetTicketTierZonesRequest struct {
+	ZoneCodes []string `json:"zone_codes" binding:"max=20,dive,required,max=30"`
+}
+
+// FAQRequest represents create or replace event FAQ entry request
+type FAQRequest struct {
+	Question string `json:"question" binding:"required,max=500"`
+	Answer   string `json:"answer" binding:"required,max=5000"`
+	Position int    `json:"position" binding:"omitempty,min=0"`
+}
+
+// SessionRequest represents create or replace agenda session request
+type SessionRequest struct {
+	Title       string    `json:"title" binding:"required,max=255"`
+	Description string    `json:"description" binding:"max=5000"`
+	Stage       string    `json:"stage" binding:"max=100"`
+	Speakers    []string  `json:"speakers" binding:"max=20,dive,required,max=100"`
+	StartsAt    time.Time `json:"starts_at" binding:"required"`
+	EndsAt      time.Time `json:"ends_at" binding:"required,gtfield=StartsAt"`
 }
 
 // Validate validates CreateTicketTierRequest business rules
@@ -84,7 +159,11 @@ func (r *CreateTicketTierRequest) Validate() error {
 		return ErrInvalidEarlyBirdEndDate
 	}
 
-	return nil
+	if r.AccessibleQuota > r.Quota {
+		return ErrInvalidAccessibleQuota
+	}
+
+	return validateDynamicPricing(r.DynamicPricing, r.EarlyBirdPrice)
 }
 
 // Validate validates UpdateTicketTierRequest business rules
@@ -99,5 +178,66 @@ func (r *UpdateTicketTierRequest) Validate() error {
 		return ErrInvalidEarlyBirdPrice
 	}
 
+	if r.AccessibleQuota > r.Quota {
+		return ErrInvalidAccessibleQuota
+	}
+
+	return validateDynamicPricing(r.DynamicPricing, r.EarlyBirdPrice)
+}
+
+// validateDynamicPricing checks an optional dynamic pricing rule of a ticket tier
+// Both would set the current price, so a tier has either a rule or an early bird price
+func validateDynamicPricing(rule *pricing.DynamicRule, earlyBirdPrice *money.Money) error {
+	if rule == nil {
+		return nil
+	}
+	if earlyBirdPrice != nil {
+		return ErrDynamicPricingEarlyBird
+	}
+	return rule.Validate()
+}
+
+// Normalize uppercases the zone code and checks its format
+func (r *CreateZoneRequest) Normalize() error {
+	r.Code = strings.ToUpper(strings.TrimSpace(r.Code))
+	r.Name = strings.TrimSpace(r.Name)
+	if !zoneCodePattern.MatchString(r.Code) {
+		return ErrInvalidZoneCode
+	}
 	return nil
 }
+
+// Normalize uppercases and de-duplicates the zone codes
+func (r *SetTicketTierZonesRequest) Normalize() {
+	seen := make(map[string]bool, len(r.ZoneCodes))
+	codes := make([]string, 0, len(r.ZoneCodes))
+	for _, code := range r.ZoneCodes {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if !seen[code] {
+			seen[code] = true
+			codes = append(codes, code)
+		}
+	}
+	r.ZoneCodes = codes
+}
+
+// Normalize trims the FAQ text
+func (r *FAQRequest) Normalize() {
+	r.Question = strings.TrimSpace(r.Question)
+	r.Answer = strings.TrimSpace(r.Answer)
+}
+
+// Normalize trims the session text and drops blank speakers
+func (r *SessionRequest) Normalize() {
+	r.Title = strings.TrimSpace(r.Title)
+	r.Description = strings.TrimSpace(r.Description)
+	r.Stage = strings.TrimSpace(r.Stage)
+
+	speakers := make([]string, 0, len(r.Speakers))
+	for _, speaker := range r.Speakers {
+		if speaker = strings.TrimSpace(speaker); speaker != "" {
+			speakers = append(speakers, speaker)
+		}
+	}
+	r.Speakers = speakers
+}
@@ -0,0 +1,60 @@
+package request
+
+import "strings"
+
+// PerformerRequest represents create or replace performer profile request
+type PerformerRequest struct {
+	Name     string `json:"name" binding:"required,max=150"`
+	PhotoURL string `json:"photo_url" binding:"omitempty,url"`
+	Bio      string `json:"bio" binding:"max=5000"`
+	// Profile URL per network, e.g. {"instagram": "https://instagram.com/..."}
+	SocialLinks map[string]string `json:"social_links" binding:"max=10,dive,keys,required,max=30,endkeys,url"`
+}
+
+// SearchPerformersRequest represents performer directory search with pagination
+type SearchPerformersRequest struct {
+	Search string `form:"search"`
+	Page   int    `form:"page" binding:"omitempty,min=1"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// PerformerEventsRequest represents upcoming events featuring a performer with pagination
+type PerformerEventsRequest struct {
+	// Event the list is shown on, left out of "more events featuring" lists
+	ExcludeEventID string `form:"exclude_event_id" binding:"omitempty,uuid"`
+	Page           int    `form:"page" binding:"omitempty,min=1"`
+	Limit          int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// SetEventPerformersRequest represents the lineup of an event in display order
+// An empty list removes all performers from the event
+type SetEventPerformersRequest struct {
+	PerformerIDs []string `json:"performer_ids" binding:"max=50,dive,required,uuid"`
+}
+
+// Normalize trims the profile text and lowercases the social networks
+func (r *PerformerRequest) Normalize() {
+	r.Name = strings.TrimSpace(r.Name)
+	r.PhotoURL = strings.TrimSpace(r.PhotoURL)
+	r.Bio = strings.TrimSpace(r.Bio)
+
+	links := make(map[string]string, len(r.SocialLinks))
+	for network, url := range r.SocialLinks {
+		links[strings.ToLower(strings.TrimSpace(network))] = url
+	}
+	r.SocialLinks = links
+}
+
+// Normalize de-duplicates the lineup, keeping the first position of a performer
+func (r *SetEventPerformersRequest) Normalize() {
+	seen := make(map[string]bool, len(r.PerformerIDs))
+	ids := make([]string, 0, len(r.PerformerIDs))
+	for _, id := range r.PerformerIDs {
+		id = strings.ToLower(id)
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	r.PerformerIDs = ids
+}
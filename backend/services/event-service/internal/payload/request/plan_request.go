@@ -0,0 +1,14 @@
+package request
+
+// UpdatePlanRequest represents new limits of an organizer plan, 0 meaning unlimited
+type UpdatePlanRequest struct {
+	MaxActiveEvents            *int `json:"max_active_events" binding:"required,min=0"`
+	MaxTicketsPerEvent         *int `json:"max_tickets_per_event" binding:"required,min=0"`
+	AnnouncementEmailsPerMonth *int `json:"announcement_emails_per_month" binding:"required,min=0"`
+	APIRequestsPerMinute       *int `json:"api_requests_per_minute" binding:"required,min=0"`
+}
+
+// AssignPlanRequest represents request to put an organizer on a plan
+type AssignPlanRequest struct {
+	Plan string `json:"plan" binding:"required,max=20"`
+}
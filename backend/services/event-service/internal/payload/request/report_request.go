@@ -0,0 +1,31 @@
+package request
+
+import "strings"
+
+// ReportEventRequest represents an abuse report filed by a user on an event
+type ReportEventRequest struct {
+	Reason  string `json:"reason" binding:"required,oneof=scam inappropriate misleading spam other"`
+	Details string `json:"details" binding:"max=2000"`
+}
+
+// ListReportedEventsRequest represents the moderation queue with pagination
+type ListReportedEventsRequest struct {
+	Page  int `form:"page" binding:"omitempty,min=1"`
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// ModerateEventRequest represents a moderator's decision on the open reports of an event
+type ModerateEventRequest struct {
+	Decision string `json:"decision" binding:"required,oneof=dismiss remove"`
+	Note     string `json:"note" binding:"max=2000"` // Included in the email to the organizer
+}
+
+// Normalize trims the report details
+func (r *ReportEventRequest) Normalize() {
+	r.Details = strings.TrimSpace(r.Details)
+}
+
+// Normalize trims the moderator note
+func (r *ModerateEventRequest) Normalize() {
+	r.Note = strings.TrimSpace(r.Note)
+}
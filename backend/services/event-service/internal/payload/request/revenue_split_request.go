@@ -0,0 +1,22 @@
+package request
+
+import "strings"
+
+// SetRevenueSplitsRequest represents how an event's revenue is shared between co-organizers
+// Percentages total 100; an empty list pays the event's organizer in full
+type SetRevenueSplitsRequest struct {
+	Splits []RevenueSplitRequest `json:"splits" binding:"max=20,dive"`
+}
+
+// RevenueSplitRequest represents a party's percentage of the event's revenue
+type RevenueSplitRequest struct {
+	OrganizerID string  `json:"organizer_id" binding:"required,uuid"`
+	Percentage  float64 `json:"percentage" binding:"required,gt=0,lte=100"` // Up to 2 decimals
+}
+
+// Normalize lowercases the organizer IDs so duplicates are detected
+func (r *SetRevenueSplitsRequest) Normalize() {
+	for i := range r.Splits {
+		r.Splits[i].OrganizerID = strings.ToLower(r.Splits[i].OrganizerID)
+	}
+}
@@ -0,0 +1,27 @@
+package request
+
+import "strings"
+
+// SaveTicketTemplateRequest represents an organizer's e-ticket layout, empty fields keep the platform layout
+type SaveTicketTemplateRequest struct {
+	LogoURL       string `json:"logo_url" binding:"omitempty,url,startswith=https://,max=500"` // PNG or JPEG up to 1 MB
+	PrimaryColor  string `json:"primary_color" binding:"omitempty,hexcolor,len=7"`             // e.g. #1a73e8
+	AccentColor   string `json:"accent_color" binding:"omitempty,hexcolor,len=7"`
+	SponsorFooter string `json:"sponsor_footer" binding:"max=200"`
+	TermsText     string `json:"terms_text" binding:"max=5000"`
+}
+
+// Normalize trims the template and lowercases its colours
+func (r *SaveTicketTemplateRequest) Normalize() {
+	r.LogoURL = strings.TrimSpace(r.LogoURL)
+	r.PrimaryColor = strings.ToLower(strings.TrimSpace(r.PrimaryColor))
+	r.AccentColor = strings.ToLower(strings.TrimSpace(r.AccentColor))
+	r.SponsorFooter = strings.TrimSpace(r.SponsorFooter)
+	r.TermsText = strings.TrimSpace(r.TermsText)
+}
+
+// PreviewTicketTemplateRequest represents a template to render on a sample e-ticket before saving it
+type PreviewTicketTemplateRequest struct {
+	SaveTicketTemplateRequest
+	EventName string `json:"event_name" binding:"max=200"` // Shown on the sample ticket, a placeholder when empty
+}
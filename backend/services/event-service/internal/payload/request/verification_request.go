@@ -0,0 +1,46 @@
+package request
+
+import "strings"
+
+// SubmitVerificationRequest represents the documents an organizer submits for verification
+type SubmitVerificationRequest struct {
+	LegalName      string `json:"legal_name" binding:"required,max=255"`
+	DocumentType   string `json:"document_type" binding:"required,oneof=ktp npwp nib akta"`
+	DocumentNumber string `json:"document_number" binding:"required,max=50"`
+	DocumentURL    string `json:"document_url" binding:"required,url"` // Uploaded beforehand to private storage
+}
+
+// ListVerificationsRequest represents the verification review queue with pagination
+type ListVerificationsRequest struct {
+	Status string `form:"status" binding:"omitempty,oneof=pending approved rejected revoked"` // Default: pending
+	Page   int    `form:"page" binding:"omitempty,min=1"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// ReviewVerificationRequest represents an admin's decision on a pending submission
+type ReviewVerificationRequest struct {
+	Decision string `json:"decision" binding:"required,oneof=approve reject"`
+	Note     string `json:"note" binding:"max=2000"` // Shown to the organizer, e.g. why documents were rejected
+}
+
+// RevokeVerificationRequest represents an admin withdrawing an organizer's verification
+type RevokeVerificationRequest struct {
+	Note string `json:"note" binding:"required,max=2000"` // Reason, kept on the submission
+}
+
+// Normalize trims the submitted document metadata
+func (r *SubmitVerificationRequest) Normalize() {
+	r.LegalName = strings.TrimSpace(r.LegalName)
+	r.DocumentNumber = strings.TrimSpace(r.DocumentNumber)
+	r.DocumentURL = strings.TrimSpace(r.DocumentURL)
+}
+
+// Normalize trims the review note
+func (r *ReviewVerificationRequest) Normalize() {
+	r.Note = strings.TrimSpace(r.Note)
+}
+
+// Normalize trims the revocation note
+func (r *RevokeVerificationRequest) Normalize() {
+	r.Note = strings.TrimSpace(r.Note)
+}
@@ -0,0 +1,92 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+// EventAnalyticsResponse represents the sales analytics of an event for its organizer
+// Aggregates are cached, GeneratedAt tells how fresh they are
+type EventAnalyticsResponse struct {
+	EventID     string               `json:"event_id"`
+	TicketsSold int                  `json:"tickets_sold"`
+	Revenue     money.Money          `json:"revenue"` // Ticket subtotals of paid orders, before fees
+	Tiers       []TierSalesResponse  `json:"tiers"`
+	DailySales  []DailySalesResponse `json:"daily_sales"`
+	Conversion  ConversionResponse   `json:"conversion"`
+	GeneratedAt time.Time            `json:"generated_at"`
+}
+
+// TierSalesResponse represents the paid sales of a ticket tier
+type TierSalesResponse struct {
+	TierID      string      `json:"tier_id"`
+	Name        string      `json:"name"`
+	Price       money.Money `json:"price"`
+	Quota       int         `json:"quota"`
+	TicketsSold int         `json:"tickets_sold"`
+	Revenue     money.Money `json:"revenue"`
+}
+
+// DailySalesResponse represents the paid sales of one day in the event's timezone
+type DailySalesResponse struct {
+	Date        string      `json:"date"`
+	Orders      int         `json:"orders"`
+	TicketsSold int         `json:"tickets_sold"`
+	Revenue     money.Money `json:"revenue"`
+}
+
+// ConversionResponse represents how the event's reservations turned into paid orders
+type ConversionResponse struct {
+	Reservations   int     `json:"reservations"`
+	Pending        int     `json:"pending"`
+	Paid           int     `json:"paid"`
+	Refunded       int     `json:"refunded"`
+	Expired        int     `json:"expired"`
+	Cancelled      int     `json:"cancelled"`
+	ConversionRate float64 `json:"conversion_rate"` // Percentage of reservations paid, refunded ones included
+}
+
+// ToEventAnalyticsResponse converts the sales aggregates of an event to EventAnalyticsResponse
+func ToEventAnalyticsResponse(eventID string, tiers []entity.TierSales, daily []entity.DailySales, funnel *entity.OrderFunnel, generatedAt time.Time) *EventAnalyticsResponse {
+	resp := &EventAnalyticsResponse{
+		EventID:    eventID,
+		Tiers:      make([]TierSalesResponse, 0, len(tiers)),
+		DailySales: make([]DailySalesResponse, 0, len(daily)),
+		Conversion: ConversionResponse{
+			Reservations:   funnel.Reservations,
+			Pending:        funnel.Pending,
+			Paid:           funnel.Paid,
+			Refunded:       funnel.Refunded,
+			Expired:        funnel.Expired,
+			Cancelled:      funnel.Cancelled,
+			ConversionRate: funnel.ConversionRate(),
+		},
+		GeneratedAt: generatedAt,
+	}
+
+	for _, tier := range tiers {
+		resp.TicketsSold += tier.TicketsSold
+		resp.Revenue = resp.Revenue.Add(tier.Revenue)
+		resp.Tiers = append(resp.Tiers, TierSalesResponse{
+			TierID:      tier.TierID,
+			Name:        tier.Name,
+			Price:       tier.Price,
+			Quota:       tier.Quota,
+			TicketsSold: tier.TicketsSold,
+			Revenue:     tier.Revenue,
+		})
+	}
+
+	for _, day := range daily {
+		resp.DailySales = append(resp.DailySales, DailySalesResponse{
+			Date:        day.Date,
+			Orders:      day.Orders,
+			TicketsSold: day.TicketsSold,
+			Revenue:     day.Revenue,
+		})
+	}
+
+	return resp
+}
@@ -0,0 +1,41 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+// EmailSenderResponse represents an organizer's white-label email sender and its verification status
+type EmailSenderResponse struct {
+	Domain        string             `json:"domain"`
+	FromName      string             `json:"from_name"`
+	FromEmail     string             `json:"from_email"`
+	ReplyTo       *string            `json:"reply_to,omitempty"`
+	Status        string             `json:"status"` // pending, verified, failed
+	Active        bool               `json:"active"` // Emails are sent from the domain, otherwise by the platform sender
+	DNSRecords    []entity.DNSRecord `json:"dns_records"`
+	VerifiedAt    *time.Time         `json:"verified_at,omitempty"`
+	LastCheckedAt *time.Time         `json:"last_checked_at,omitempty"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+}
+
+// ToEmailSenderResponse converts entity to response
+func ToEmailSenderResponse(sender *entity.OrganizerEmailSender) *EmailSenderResponse {
+	records := []entity.DNSRecord(sender.DNSRecords)
+	if records == nil {
+		records = []entity.DNSRecord{}
+	}
+	return &EmailSenderResponse{
+		Domain:        sender.Domain,
+		FromName:      sender.FromName,
+		FromEmail:     sender.FromEmail,
+		ReplyTo:       sender.ReplyTo,
+		Status:        sender.Status,
+		Active:        sender.IsVerified(),
+		DNSRecords:    records,
+		VerifiedAt:    sender.VerifiedAt,
+		LastCheckedAt: sender.LastCheckedAt,
+		UpdatedAt:     sender.UpdatedAt,
+	}
+}
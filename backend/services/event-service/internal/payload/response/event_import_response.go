@@ -0,0 +1,29 @@
+package response
+
+// ImportEventsResponse represents the result of an events import
+// Events are only created when no row has errors; a dry run never creates them
+type ImportEventsResponse struct {
+	DryRun      bool             `json:"dry_run"`
+	Events      int              `json:"events"`       // Events in the file
+	TicketTiers int              `json:"ticket_tiers"` // Ticket tiers in the file
+	Imported    []ImportedEvent  `json:"imported,omitempty"`
+	Errors      []ImportRowError `json:"errors,omitempty"`
+}
+
+// ImportedEvent represents an event created by an import
+type ImportedEvent struct {
+	Row         int    `json:"row"`
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Slug        string `json:"slug"`
+	Status      string `json:"status"`
+	TicketTiers int    `json:"ticket_tiers"`
+}
+
+// ImportRowError represents a problem with one row of an import file
+// Row is the CSV line (the header is line 1) or the 1-based position of the event in a JSON file
+type ImportRowError struct {
+	Row     int    `json:"row"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
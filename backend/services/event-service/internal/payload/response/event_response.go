@@ -3,46 +3,77 @@ package response
 import (
 	"time"
 
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/pricing"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
 )
 
 // EventResponse represents event information in response
 type EventResponse struct {
-	ID          string               `json:"id"`
-	OrganizerID string               `json:"organizer_id"`
-	Title       string               `json:"title"`
-	Slug        string               `json:"slug"`
-	Description *string              `json:"description,omitempty"`
-	Category    string               `json:"category"`
-	Location    string               `json:"location"`
-	Venue       *string              `json:"venue,omitempty"`
-	StartDate   time.Time            `json:"start_date"`
-	EndDate     time.Time            `json:"end_date"`
-	Timezone    string               `json:"timezone"`
-	BannerURL   *string              `json:"banner_url,omitempty"`
-	Status      string               `json:"status"`
-	TicketTiers []TicketTierResponse `json:"ticket_tiers,omitempty"`
-	CreatedAt   time.Time            `json:"created_at"`
-	UpdatedAt   time.Time            `json:"updated_at"`
+	ID           string                     `json:"id"`
+	OrganizerID  string                     `json:"organizer_id"`
+	Verified     bool                       `json:"organizer_verified"` // Organizer verification badge
+	Title        string                     `json:"title"`
+	Slug         string                     `json:"slug"`
+	Description  *string                    `json:"description,omitempty"`
+	Category     string                     `json:"category"`
+	Location     string                     `json:"location"`
+	Venue        *string                    `json:"venue,omitempty"`
+	StartDate    time.Time                  `json:"start_date"`
+	EndDate      time.Time                  `json:"end_date"`
+	Timezone     string                     `json:"timezone"`
+	ScanPolicy   string                     `json:"scan_policy"`
+	RefundPolicy *string                    `json:"refund_policy,omitempty"` // Standard refund template, omitted for custom policies
+	BannerURL    *string                    `json:"banner_url,omitempty"`
+	Status       string                     `json:"status"`
+	IsSandbox    bool                       `json:"is_sandbox"`
+	DynamicQR    bool                       `json:"dynamic_qr"`
+	TicketTiers  []TicketTierResponse       `json:"ticket_tiers,omitempty"`
+	Availability *EventAvailabilityResponse `json:"availability,omitempty"` // Listing only
+	FAQs         []FAQResponse              `json:"faqs,omitempty"`         // Detail only
+	Agenda       []SessionResponse          `json:"agenda,omitempty"`       // Detail only
+	Performers   []PerformerResponse        `json:"performers,omitempty"`   // Detail only
+	Version      int                        `json:"version"`
+	CreatedAt    time.Time                  `json:"created_at"`
+	UpdatedAt    time.Time                  `json:"updated_at"`
 }
 
 // TicketTierResponse represents ticket tier information
 type TicketTierResponse struct {
-	ID               string     `json:"id"`
-	EventID          string     `json:"event_id"`
-	Name             string     `json:"name"`
-	Description      *string    `json:"description,omitempty"`
-	Price            float64    `json:"price"`
-	Quota            int        `json:"quota"`
-	SoldCount        int        `json:"sold_count"`
-	Available        int        `json:"available_count"` // Calculated field
-	MaxPerOrder      int        `json:"max_per_order"`
-	EarlyBirdPrice   *float64   `json:"early_bird_price,omitempty"`
-	EarlyBirdEndDate *time.Time `json:"early_bird_end_date,omitempty"`
-	CurrentPrice     float64    `json:"current_price"` // Calculated field
-	IsSoldOut        bool       `json:"is_sold_out"`   // Calculated field
-	CreatedAt        time.Time  `json:"created_at"`
-	UpdatedAt        time.Time  `json:"updated_at"`
+	ID               string               `json:"id"`
+	EventID          string               `json:"event_id"`
+	Name             string               `json:"name"`
+	Description      *string              `json:"description,omitempty"`
+	Price            money.Money          `json:"price"`
+	Quota            int                  `json:"quota"`
+	SoldCount        int                  `json:"sold_count"`
+	Available        int                  `json:"available_count"` // Calculated field
+	MaxPerOrder      int                  `json:"max_per_order"`
+	EarlyBirdPrice   *money.Money         `json:"early_bird_price,omitempty"`
+	EarlyBirdEndDate *time.Time           `json:"early_bird_end_date,omitempty"`
+	CurrentPrice     money.Money          `json:"current_price"` // Calculated field
+	BasePrice        money.Money          `json:"base_price"`    // Organizer's price, differs from current under dynamic pricing
+	IsSoldOut        bool                 `json:"is_sold_out"`   // Calculated field
+	DynamicPricing   *pricing.DynamicRule `json:"dynamic_pricing,omitempty"`
+	Version          int                  `json:"version"`
+	ArchivedAt       *time.Time           `json:"archived_at,omitempty"`
+	CreatedAt        time.Time            `json:"created_at"`
+	UpdatedAt        time.Time            `json:"updated_at"`
+
+	// Accessible seating held back within quota, included in quota, sold_count and available_count
+	AccessibleQuota     int `json:"accessible_quota"`
+	AccessibleSoldCount int `json:"accessible_sold_count"`
+	AccessibleAvailable int `json:"accessible_available_count"` // Calculated field
+}
+
+// EventAvailabilityResponse represents ticket summary across all tiers of an event
+type EventAvailabilityResponse struct {
+	TotalQuota int          `json:"total_quota"`
+	SoldCount  int          `json:"sold_count"`
+	Available  int          `json:"available_count"`
+	MinPrice   *money.Money `json:"min_price,omitempty"`
+	MaxPrice   *money.Money `json:"max_price,omitempty"`
+	IsSoldOut  bool         `json:"is_sold_out"`
 }
 
 // PaginatedEventsResponse represents paginated events response
@@ -59,30 +90,43 @@ type PaginationMeta struct {
 	TotalPages  int   `json:"total_pages"`
 }
 
+// OrganizerEventIDsResponse represents the IDs of an organizer's events
+type OrganizerEventIDsResponse struct {
+	EventIDs []string `json:"event_ids"`
+}
+
 // ToEventResponse converts Event entity to EventResponse
 func ToEventResponse(event *entity.Event, tiers []entity.TicketTier) *EventResponse {
 	response := &EventResponse{
-		ID:          event.ID,
-		OrganizerID: event.OrganizerID,
-		Title:       event.Title,
-		Slug:        event.Slug,
-		Description: event.Description,
-		Category:    event.Category,
-		Location:    event.Location,
-		Venue:       event.Venue,
-		StartDate:   event.StartDate,
-		EndDate:     event.EndDate,
-		Timezone:    event.Timezone,
-		BannerURL:   event.BannerURL,
-		Status:      event.Status,
-		CreatedAt:   event.CreatedAt,
-		UpdatedAt:   event.UpdatedAt,
+		ID:           event.ID,
+		OrganizerID:  event.OrganizerID,
+		Title:        event.Title,
+		Slug:         event.Slug,
+		Description:  event.Description,
+		Category:     event.Category,
+		Location:     event.Location,
+		Venue:        event.Venue,
+		StartDate:    event.StartDate,
+		EndDate:      event.EndDate,
+		Timezone:     event.Timezone,
+		ScanPolicy:   event.ScanPolicy,
+		RefundPolicy: event.RefundPolicy,
+		BannerURL:    event.BannerURL,
+		Status:       event.Status,
+		IsSandbox:    event.IsSandbox,
+		DynamicQR:    event.DynamicQR,
+		Version:      event.Version,
+		CreatedAt:    event.CreatedAt,
+		UpdatedAt:    event.UpdatedAt,
 	}
 
-	// Convert ticket tiers if provided
+	// Convert ticket tiers if provided, archived tiers are no longer on sale
 	if tiers != nil {
 		tierResponses := make([]TicketTierResponse, 0, len(tiers))
 		for _, tier := range tiers {
+			if tier.IsArchived() {
+				continue
+			}
 			tierResponses = append(tierResponses, *ToTicketTierResponse(&tier))
 		}
 		response.TicketTiers = tierResponses
@@ -97,6 +141,11 @@ func ToTicketTierResponse(tier *entity.TicketTier) *TicketTierResponse {
 	currentPrice := tier.CurrentPrice()
 	isSoldOut := tier.SoldCount >= tier.Quota
 
+	basePrice := tier.Price
+	if tier.BasePrice != nil {
+		basePrice = *tier.BasePrice
+	}
+
 	return &TicketTierResponse{
 		ID:               tier.ID,
 		EventID:          tier.EventID,
@@ -110,8 +159,162 @@ func ToTicketTierResponse(tier *entity.TicketTier) *TicketTierResponse {
 		EarlyBirdPrice:   tier.EarlyBirdPrice,
 		EarlyBirdEndDate: tier.EarlyBirdEndDate,
 		CurrentPrice:     currentPrice,
+		BasePrice:        basePrice,
 		IsSoldOut:        isSoldOut,
+		DynamicPricing:   tier.DynamicPricing,
+		Version:          tier.Version,
+		ArchivedAt:       tier.ArchivedAt,
 		CreatedAt:        tier.CreatedAt,
 		UpdatedAt:        tier.UpdatedAt,
+
+		AccessibleQuota:     tier.AccessibleQuota,
+		AccessibleSoldCount: tier.AccessibleSoldCount,
+		AccessibleAvailable: tier.AccessibleQuota - tier.AccessibleSoldCount,
+	}
+}
+
+// TicketTierPriceResponse represents a price a ticket tier was sold at
+type TicketTierPriceResponse struct {
+	Price     money.Money  `json:"price"`
+	BasePrice *money.Money `json:"base_price,omitempty"`
+	SoldCount int          `json:"sold_count"` // When the price was set
+	Reason    string       `json:"reason"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+// ToTicketTierPriceResponses converts TicketTierPrice entities to responses
+func ToTicketTierPriceResponses(prices []entity.TicketTierPrice) []TicketTierPriceResponse {
+	responses := make([]TicketTierPriceResponse, 0, len(prices))
+	for _, price := range prices {
+		responses = append(responses, TicketTierPriceResponse{
+			Price:     price.Price,
+			BasePrice: price.BasePrice,
+			SoldCount: price.SoldCount,
+			Reason:    price.Reason,
+			CreatedAt: price.CreatedAt,
+		})
+	}
+	return responses
+}
+
+// ToEventAvailabilityResponse converts EventAvailability entity to EventAvailabilityResponse
+func ToEventAvailabilityResponse(availability *entity.EventAvailability) *EventAvailabilityResponse {
+	return &EventAvailabilityResponse{
+		TotalQuota: availability.TotalQuota,
+		SoldCount:  availability.TotalSold,
+		Available:  availability.AvailableCount(),
+		MinPrice:   availability.MinPrice,
+		MaxPrice:   availability.MaxPrice,
+		IsSoldOut:  availability.TotalSold >= availability.TotalQuota,
+	}
+}
+
+// ZoneResponse represents an event zone with the ticket tiers allowed in it
+type ZoneResponse struct {
+	ID            string    `json:"id"`
+	EventID       string    `json:"event_id"`
+	Code          string    `json:"code"`
+	Name          string    `json:"name"`
+	TicketTierIDs []string  `json:"ticket_tier_ids"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// TicketTierZonesResponse represents the zones a ticket tier may enter
+type TicketTierZonesResponse struct {
+	TicketTierID string   `json:"ticket_tier_id"`
+	ZoneCodes    []string `json:"zone_codes"` // Empty means every zone
+}
+
+// ToZoneResponse converts EventZone entity to ZoneResponse
+func ToZoneResponse(zone *entity.EventZone) *ZoneResponse {
+	tierIDs := zone.TicketTierIDs
+	if tierIDs == nil {
+		tierIDs = []string{}
+	}
+
+	return &ZoneResponse{
+		ID:            zone.ID,
+		EventID:       zone.EventID,
+		Code:          zone.Code,
+		Name:          zone.Name,
+		TicketTierIDs: tierIDs,
+		CreatedAt:     zone.CreatedAt,
+	}
+}
+
+// FAQResponse represents an event FAQ entry
+type FAQResponse struct {
+	ID        string    `json:"id"`
+	EventID   string    `json:"event_id"`
+	Question  string    `json:"question"`
+	Answer    string    `json:"answer"`
+	Position  int       `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// SessionResponse represents an agenda session of an event
+type SessionResponse struct {
+	ID          string    `json:"id"`
+	EventID     string    `json:"event_id"`
+	Title       string    `json:"title"`
+	Description *string   `json:"description,omitempty"`
+	Stage       *string   `json:"stage,omitempty"`
+	Speakers    []string  `json:"speakers"`
+	StartsAt    time.Time `json:"starts_at"`
+	EndsAt      time.Time `json:"ends_at"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ToFAQResponse converts EventFAQ entity to FAQResponse
+func ToFAQResponse(faq *entity.EventFAQ) *FAQResponse {
+	return &FAQResponse{
+		ID:        faq.ID,
+		EventID:   faq.EventID,
+		Question:  faq.Question,
+		Answer:    faq.Answer,
+		Position:  faq.Position,
+		CreatedAt: faq.CreatedAt,
+		UpdatedAt: faq.UpdatedAt,
+	}
+}
+
+// ToFAQResponses converts EventFAQ entities to FAQResponses
+func ToFAQResponses(faqs []entity.EventFAQ) []FAQResponse {
+	responses := make([]FAQResponse, 0, len(faqs))
+	for _, faq := range faqs {
+		responses = append(responses, *ToFAQResponse(&faq))
+	}
+	return responses
+}
+
+// ToSessionResponse converts EventSession entity to SessionResponse
+func ToSessionResponse(session *entity.EventSession) *SessionResponse {
+	speakers := session.Speakers
+	if speakers == nil {
+		speakers = []string{}
+	}
+
+	return &SessionResponse{
+		ID:          session.ID,
+		EventID:     session.EventID,
+		Title:       session.Title,
+		Description: session.Description,
+		Stage:       session.Stage,
+		Speakers:    speakers,
+		StartsAt:    session.StartsAt,
+		EndsAt:      session.EndsAt,
+		CreatedAt:   session.CreatedAt,
+		UpdatedAt:   session.UpdatedAt,
+	}
+}
+
+// ToSessionResponses converts EventSession entities to SessionResponses
+func ToSessionResponses(sessions []entity.EventSession) []SessionResponse {
+	responses := make([]SessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		responses = append(responses, *ToSessionResponse(&session))
 	}
+	return responses
 }
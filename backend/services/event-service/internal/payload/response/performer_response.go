@@ -0,0 +1,59 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+// PerformerResponse represents a performer profile
+type PerformerResponse struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	PhotoURL    *string           `json:"photo_url,omitempty"`
+	Bio         *string           `json:"bio,omitempty"`
+	SocialLinks map[string]string `json:"social_links"`
+	CreatedBy   string            `json:"created_by"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+// PaginatedPerformersResponse represents a page of the performer directory
+type PaginatedPerformersResponse struct {
+	Performers []PerformerResponse `json:"performers"`
+	Meta       PaginationMeta      `json:"meta"`
+}
+
+// EventPerformersResponse represents the lineup of an event in display order
+type EventPerformersResponse struct {
+	EventID    string              `json:"event_id"`
+	Performers []PerformerResponse `json:"performers"`
+}
+
+// ToPerformerResponse converts Performer entity to PerformerResponse
+func ToPerformerResponse(performer *entity.Performer) *PerformerResponse {
+	links := map[string]string(performer.SocialLinks)
+	if links == nil {
+		links = map[string]string{}
+	}
+
+	return &PerformerResponse{
+		ID:          performer.ID,
+		Name:        performer.Name,
+		PhotoURL:    performer.PhotoURL,
+		Bio:         performer.Bio,
+		SocialLinks: links,
+		CreatedBy:   performer.CreatedBy,
+		CreatedAt:   performer.CreatedAt,
+		UpdatedAt:   performer.UpdatedAt,
+	}
+}
+
+// ToPerformerResponses converts Performer entities to PerformerResponses
+func ToPerformerResponses(performers []entity.Performer) []PerformerResponse {
+	responses := make([]PerformerResponse, 0, len(performers))
+	for _, performer := range performers {
+		responses = append(responses, *ToPerformerResponse(&performer))
+	}
+	return responses
+}
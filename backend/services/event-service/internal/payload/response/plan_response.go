@@ -0,0 +1,44 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+// PlanResponse represents an organizer plan and its limits, 0 meaning unlimited
+type PlanResponse struct {
+	Code                       string    `json:"code"`
+	Name                       string    `json:"name"`
+	MaxActiveEvents            int       `json:"max_active_events"`
+	MaxTicketsPerEvent         int       `json:"max_tickets_per_event"`
+	AnnouncementEmailsPerMonth int       `json:"announcement_emails_per_month"`
+	APIRequestsPerMinute       int       `json:"api_requests_per_minute"`
+	UpdatedAt                  time.Time `json:"updated_at"`
+}
+
+// OrganizerPlanResponse represents the plan of an organizer with current usage
+type OrganizerPlanResponse struct {
+	OrganizerID string            `json:"organizer_id"`
+	Plan        PlanResponse      `json:"plan"`
+	Usage       PlanUsageResponse `json:"usage"`
+}
+
+// PlanUsageResponse represents how much of the plan limits an organizer uses
+// Announcement emails are counted by notification-service and reported when sending
+type PlanUsageResponse struct {
+	ActiveEvents int `json:"active_events"`
+}
+
+// ToPlanResponse converts OrganizerPlan entity to PlanResponse
+func ToPlanResponse(plan *entity.OrganizerPlan) *PlanResponse {
+	return &PlanResponse{
+		Code:                       plan.Code,
+		Name:                       plan.Name,
+		MaxActiveEvents:            plan.MaxActiveEvents,
+		MaxTicketsPerEvent:         plan.MaxTicketsPerEvent,
+		AnnouncementEmailsPerMonth: plan.AnnouncementEmailsPerMonth,
+		APIRequestsPerMinute:       plan.APIRequestsPerMinute,
+		UpdatedAt:                  plan.UpdatedAt,
+	}
+}
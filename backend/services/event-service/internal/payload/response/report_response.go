@@ -0,0 +1,99 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+// EventReportResponse represents an abuse report on an event
+type EventReportResponse struct {
+	ID         string     `json:"id"`
+	EventID    string     `json:"event_id"`
+	ReporterID string     `json:"reporter_id"`
+	Reason     string     `json:"reason"`
+	Details    *string    `json:"details,omitempty"`
+	Status     string     `json:"status"`
+	ReviewedBy *string    `json:"reviewed_by,omitempty"`
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// EventModerationResponse represents the moderation hold of an event
+type EventModerationResponse struct {
+	Status      string     `json:"status"`      // pending_review, removed
+	Unpublished bool       `json:"unpublished"` // The hold took the event off sale
+	Note        *string    `json:"note,omitempty"`
+	ReviewedBy  *string    `json:"reviewed_by,omitempty"`
+	ReviewedAt  *time.Time `json:"reviewed_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// ReportedEventResponse represents an event in the moderation queue
+type ReportedEventResponse struct {
+	EventID        string                   `json:"event_id"`
+	Title          string                   `json:"title"`
+	OrganizerID    string                   `json:"organizer_id"`
+	Verified       bool                     `json:"organizer_verified"` // Verified organizers have a higher auto-unpublish threshold
+	Status         string                   `json:"status"`
+	OpenReports    int                      `json:"open_reports"`
+	Reasons        []string                 `json:"reasons"`
+	LatestReportAt time.Time                `json:"latest_report_at"`
+	Moderation     *EventModerationResponse `json:"moderation,omitempty"` // Nil when the event isn't held
+}
+
+// PaginatedReportedEventsResponse represents a page of the moderation queue
+type PaginatedReportedEventsResponse struct {
+	Events []ReportedEventResponse `json:"events"`
+	Meta   PaginationMeta          `json:"meta"`
+}
+
+// EventReportsResponse represents an event with all its reports, for review
+type EventReportsResponse struct {
+	ReportedEventResponse
+	Reports []EventReportResponse `json:"reports"`
+}
+
+// ToEventReportResponse converts entity to response
+func ToEventReportResponse(report *entity.EventReport) *EventReportResponse {
+	return &EventReportResponse{
+		ID:         report.ID,
+		EventID:    report.EventID,
+		ReporterID: report.ReporterID,
+		Reason:     report.Reason,
+		Details:    report.Details,
+		Status:     report.Status,
+		ReviewedBy: report.ReviewedBy,
+		ReviewedAt: report.ReviewedAt,
+		CreatedAt:  report.CreatedAt,
+	}
+}
+
+// ToEventModerationResponse converts entity to response, nil for events that aren't held
+func ToEventModerationResponse(moderation *entity.EventModeration) *EventModerationResponse {
+	if moderation == nil {
+		return nil
+	}
+	return &EventModerationResponse{
+		Status:      moderation.Status,
+		Unpublished: moderation.Unpublished,
+		Note:        moderation.Note,
+		ReviewedBy:  moderation.ReviewedBy,
+		ReviewedAt:  moderation.ReviewedAt,
+		CreatedAt:   moderation.CreatedAt,
+	}
+}
+
+// ToReportedEventResponse converts an event with its open report summary to a queue entry
+func ToReportedEventResponse(event *entity.Event, summary *entity.ReportSummary, moderation *entity.EventModeration) ReportedEventResponse {
+	return ReportedEventResponse{
+		EventID:        event.ID,
+		Title:          event.Title,
+		OrganizerID:    event.OrganizerID,
+		Status:         event.Status,
+		OpenReports:    summary.OpenReports,
+		Reasons:        summary.Reasons,
+		LatestReportAt: summary.LatestReportAt,
+		Moderation:     ToEventModerationResponse(moderation),
+	}
+}
@@ -0,0 +1,73 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+// RevenueSplitResponse represents a party's percentage of an event's revenue
+type RevenueSplitResponse struct {
+	OrganizerID   string  `json:"organizer_id"`
+	OrganizerName string  `json:"organizer_name"`
+	Percentage    float64 `json:"percentage"`
+}
+
+// EventRevenueSplitsResponse represents how an event's revenue is shared
+// An event without splits lists its organizer at 100 percent
+type EventRevenueSplitsResponse struct {
+	EventID string                 `json:"event_id"`
+	Splits  []RevenueSplitResponse `json:"splits"`
+}
+
+// RevenueShareResponse represents the caller's share of an event they are a party to
+type RevenueShareResponse struct {
+	EventID          string       `json:"event_id"`
+	EventTitle       string       `json:"event_title"`
+	EventStatus      string       `json:"event_status"`
+	StartDate        time.Time    `json:"start_date"`
+	IsOwner          bool         `json:"is_owner"`
+	Percentage       float64      `json:"percentage"`
+	Settled          bool         `json:"settled"`
+	PayoutAmount     *money.Money `json:"payout_amount,omitempty"`     // Caller's part of the settlement payout
+	SettlementPayout *money.Money `json:"settlement_payout,omitempty"` // Payout owed to all parties
+	SettledAt        *time.Time   `json:"settled_at,omitempty"`
+}
+
+// ToEventRevenueSplitsResponse converts RevenueSplit entities to EventRevenueSplitsResponse
+func ToEventRevenueSplitsResponse(eventID string, splits []entity.RevenueSplit) *EventRevenueSplitsResponse {
+	responses := make([]RevenueSplitResponse, 0, len(splits))
+	for _, split := range splits {
+		responses = append(responses, RevenueSplitResponse{
+			OrganizerID:   split.OrganizerID,
+			OrganizerName: split.OrganizerName,
+			Percentage:    split.Percentage,
+		})
+	}
+
+	return &EventRevenueSplitsResponse{
+		EventID: eventID,
+		Splits:  responses,
+	}
+}
+
+// ToRevenueShareResponses converts RevenueShare entities to RevenueShareResponses
+func ToRevenueShareResponses(shares []entity.RevenueShare) []RevenueShareResponse {
+	responses := make([]RevenueShareResponse, 0, len(shares))
+	for _, share := range shares {
+		responses = append(responses, RevenueShareResponse{
+			EventID:          share.EventID,
+			EventTitle:       share.EventTitle,
+			EventStatus:      share.EventStatus,
+			StartDate:        share.StartDate,
+			IsOwner:          share.IsOwner,
+			Percentage:       share.Percentage,
+			Settled:          share.SettledAt != nil,
+			PayoutAmount:     share.PayoutAmount,
+			SettlementPayout: share.SettlementPayout,
+			SettledAt:        share.SettledAt,
+		})
+	}
+	return responses
+}
@@ -0,0 +1,31 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// EventSEOResponse represents the metadata of an event page for search engines and link previews
+type EventSEOResponse struct {
+	EventID      string            `json:"event_id"`
+	Title        string            `json:"title"`
+	Description  string            `json:"description"` // Plain text, at most 160 characters
+	CanonicalURL string            `json:"canonical_url"`
+	SiteName     string            `json:"site_name"`
+	Image        SEOImageResponse  `json:"image"`
+	StartDate    time.Time         `json:"start_date"`
+	EndDate      time.Time         `json:"end_date"`
+	Location     string            `json:"location"`
+	PriceFrom    *money.Money      `json:"price_from,omitempty"`
+	OpenGraph    map[string]string `json:"open_graph"` // og:* meta tags, keyed by property
+	Twitter      map[string]string `json:"twitter"`    // twitter:* meta tags, keyed by name
+}
+
+// SEOImageResponse represents the generated share image of an event
+type SEOImageResponse struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Alt    string `json:"alt"`
+}
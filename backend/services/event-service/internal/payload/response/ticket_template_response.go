@@ -0,0 +1,29 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+// TicketTemplateResponse represents an organizer's e-ticket layout
+type TicketTemplateResponse struct {
+	LogoURL       *string   `json:"logo_url,omitempty"`
+	PrimaryColor  *string   `json:"primary_color,omitempty"`
+	AccentColor   *string   `json:"accent_color,omitempty"`
+	SponsorFooter *string   `json:"sponsor_footer,omitempty"`
+	TermsText     *string   `json:"terms_text,omitempty"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// ToTicketTemplateResponse converts entity to response
+func ToTicketTemplateResponse(tpl *entity.OrganizerTicketTemplate) *TicketTemplateResponse {
+	return &TicketTemplateResponse{
+		LogoURL:       tpl.LogoURL,
+		PrimaryColor:  tpl.PrimaryColor,
+		AccentColor:   tpl.AccentColor,
+		SponsorFooter: tpl.SponsorFooter,
+		TermsText:     tpl.TermsText,
+		UpdatedAt:     tpl.UpdatedAt,
+	}
+}
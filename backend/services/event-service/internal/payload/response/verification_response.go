@@ -0,0 +1,54 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+// VerificationResponse represents an organizer verification submission
+type VerificationResponse struct {
+	ID             string     `json:"id"`
+	OrganizerID    string     `json:"organizer_id"`
+	LegalName      string     `json:"legal_name"`
+	DocumentType   string     `json:"document_type"`
+	DocumentNumber string     `json:"document_number"`
+	DocumentURL    string     `json:"document_url"`
+	Status         string     `json:"status"` // pending, approved, rejected, revoked
+	ReviewNote     *string    `json:"review_note,omitempty"`
+	ReviewedBy     *string    `json:"reviewed_by,omitempty"`
+	ReviewedAt     *time.Time `json:"reviewed_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// PaginatedVerificationsResponse represents a page of the verification review queue
+type PaginatedVerificationsResponse struct {
+	Verifications []VerificationResponse `json:"verifications"`
+	Meta          PaginationMeta         `json:"meta"`
+}
+
+// OrganizerProfileResponse represents the public profile of an organizer
+type OrganizerProfileResponse struct {
+	ID           string     `json:"id"`
+	Name         string     `json:"name"`
+	IsVerified   bool       `json:"is_verified"`
+	VerifiedAt   *time.Time `json:"verified_at,omitempty"`
+	ActiveEvents int        `json:"active_events"` // Published events
+}
+
+// ToVerificationResponse converts entity to response
+func ToVerificationResponse(verification *entity.OrganizerVerification) *VerificationResponse {
+	return &VerificationResponse{
+		ID:             verification.ID,
+		OrganizerID:    verification.OrganizerID,
+		LegalName:      verification.LegalName,
+		DocumentType:   verification.DocumentType,
+		DocumentNumber: verification.DocumentNumber,
+		DocumentURL:    verification.DocumentURL,
+		Status:         verification.Status,
+		ReviewNote:     verification.ReviewNote,
+		ReviewedBy:     verification.ReviewedBy,
+		ReviewedAt:     verification.ReviewedAt,
+		CreatedAt:      verification.CreatedAt,
+	}
+}
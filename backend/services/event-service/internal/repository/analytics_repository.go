@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+// analyticsOrdersCTE selects an event's orders and their items, archived ones included, as
+// event_orders and event_items; sandbox test orders are left out
+// $1 is the event ID
+const analyticsOrdersCTE = `
+	WITH event_orders AS (
+		SELECT id, status, created_at::timestamptz AS created_at, completed_at
+		FROM orders
+		WHERE event_id = $1 AND NOT is_sandbox
+		UNION ALL
+		SELECT id, status, created_at::timestamptz, completed_at
+		FROM orders_archive
+		WHERE event_id = $1 AND NOT is_sandbox
+	),
+	event_items AS (
+		SELECT order_id, ticket_tier_id, quantity, subtotal
+		FROM order_items
+		WHERE order_id IN (SELECT id FROM event_orders)
+		UNION ALL
+		SELECT order_id, ticket_tier_id, quantity, subtotal
+		FROM order_items_archive
+		WHERE order_id IN (SELECT id FROM event_orders)
+	)
+`
+
+// AnalyticsRepository defines interface for organizer sales analytics of an event
+type AnalyticsRepository interface {
+	GetTierSales(ctx context.Context, eventID string) ([]entity.TierSales, error)
+	GetDailySales(ctx context.Context, eventID, timezone string) ([]entity.DailySales, error)
+	GetOrderFunnel(ctx context.Context, eventID string) (*entity.OrderFunnel, error)
+}
+
+// analyticsRepository implements AnalyticsRepository interface
+type analyticsRepository struct {
+	db *sql.DB
+}
+
+// NewAnalyticsRepository creates new analytics repository instance
+func NewAnalyticsRepository(db *sql.DB) AnalyticsRepository {
+	return &analyticsRepository{db: db}
+}
+
+// GetTierSales retrieves the paid sales of every ticket tier of an event, archived tiers included
+func (r *analyticsRepository) GetTierSales(ctx context.Context, eventID string) ([]entity.TierSales, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := analyticsOrdersCTE + `
+		SELECT t.id, t.name, t.price, t.quota,
+		       COALESCE(SUM(i.quantity), 0) AS tickets_sold,
+		       COALESCE(SUM(i.subtotal), 0) AS revenue
+		FROM ticket_tiers t
+		LEFT JOIN (
+			SELECT i.ticket_tier_id, i.quantity, i.subtotal
+			FROM event_items i
+			JOIN event_orders o ON o.id = i.order_id
+			WHERE o.status IN ('paid', 'completed')
+		) i ON i.ticket_tier_id = t.id
+		WHERE t.event_id = $1
+		GROUP BY t.id
+		ORDER BY t.created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tier sales: %w", err)
+	}
+	defer rows.Close()
+
+	sales := []entity.TierSales{}
+	for rows.Next() {
+		var s entity.TierSales
+		if err := rows.Scan(&s.TierID, &s.Name, &s.Price, &s.Quota, &s.TicketsSold, &s.Revenue); err != nil {
+			return nil, fmt.Errorf("failed to scan tier sales: %w", err)
+		}
+		sales = append(sales, s)
+	}
+
+	return sales, rows.Err()
+}
+
+// GetDailySales retrieves an event's paid sales per day, in the event's timezone
+// Orders are dated when they were paid; days without sales are left out
+func (r *analyticsRepository) GetDailySales(ctx context.Context, eventID, timezone string) ([]entity.DailySales, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := analyticsOrdersCTE + `
+		SELECT to_char(COALESCE(o.completed_at, o.created_at) AT TIME ZONE $2, 'YYYY-MM-DD') AS day,
+		       COUNT(DISTINCT o.id) AS orders,
+		       SUM(i.quantity) AS tickets_sold,
+		       SUM(i.subtotal) AS revenue
+		FROM event_orders o
+		JOIN event_items i ON i.order_id = o.id
+		WHERE o.status IN ('paid', 'completed')
+		GROUP BY day
+		ORDER BY day
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, eventID, timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily sales: %w", err)
+	}
+	defer rows.Close()
+
+	sales := []entity.DailySales{}
+	for rows.Next() {
+		var s entity.DailySales
+		if err := rows.Scan(&s.Date, &s.Orders, &s.TicketsSold, &s.Revenue); err != nil {
+			return nil, fmt.Errorf("failed to scan daily sales: %w", err)
+		}
+		sales = append(sales, s)
+	}
+
+	return sales, rows.Err()
+}
+
+// GetOrderFunnel counts an event's orders by outcome
+func (r *analyticsRepository) GetOrderFunnel(ctx context.Context, eventID string) (*entity.OrderFunnel, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := analyticsOrdersCTE + `
+		SELECT COUNT(*),
+		       COUNT(*) FILTER (WHERE status = 'reserved'),
+		       COUNT(*) FILTER (WHERE status IN ('paid', 'completed')),
+		       COUNT(*) FILTER (WHERE status = 'refunded'),
+		       COUNT(*) FILTER (WHERE status = 'expired'),
+		       COUNT(*) FILTER (WHERE status = 'cancelled')
+		FROM event_orders
+	`
+
+	var f entity.OrderFunnel
+	err := r.db.QueryRowContext(ctx, query, eventID).Scan(
+		&f.Reservations,
+		&f.Pending,
+		&f.Paid,
+		&f.Refunded,
+		&f.Expired,
+		&f.Cancelled,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order funnel: %w", err)
+	}
+
+	return &f, nil
+}
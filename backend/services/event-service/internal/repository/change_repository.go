@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+// changeSettleDelay hides changes newer than this from consumers
+// Sequence numbers are allocated before commit, so a fresh change may still be followed by
+// a lower sequence committing later; waiting lets those writes land before consumers move past them
+const changeSettleDelay = 5 * time.Second
+
+// ChangeRepository defines interface for the event change feed
+type ChangeRepository interface {
+	ListAfter(ctx context.Context, afterSeq int64, limit int) ([]entity.EventChange, error)
+}
+
+// changeRepository implements ChangeRepository interface
+type changeRepository struct {
+	db *sql.DB
+}
+
+// NewChangeRepository creates new change repository instance
+func NewChangeRepository(db *sql.DB) ChangeRepository {
+	return &changeRepository{db: db}
+}
+
+// ListAfter retrieves up to limit settled changes with a sequence greater than afterSeq
+func (r *changeRepository) ListAfter(ctx context.Context, afterSeq int64, limit int) ([]entity.EventChange, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT seq, event_id
+		FROM event_changes
+		WHERE seq > $1 AND changed_at < NOW() - $2 * INTERVAL '1 second'
+		ORDER BY seq
+		LIMIT $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, afterSeq, changeSettleDelay.Seconds(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list event changes: %w", err)
+	}
+	defer rows.Close()
+
+	changes := []entity.EventChange{}
+	for rows.Next() {
+		var change entity.EventChange
+		if err := rows.Scan(&change.Seq, &change.EventID); err != nil {
+			return nil, fmt.Errorf("failed to scan event change: %w", err)
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, rows.Err()
+}
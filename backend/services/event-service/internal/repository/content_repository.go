@@ -0,0 +1,274 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+var (
+	ErrFAQNotFound     = errors.New("faq not found")
+	ErrSessionNotFound = errors.New("session not found")
+)
+
+// ContentRepository defines interface for event FAQ and agenda data operations
+type ContentRepository interface {
+	CreateFAQ(ctx context.Context, faq *entity.EventFAQ) error
+	UpdateFAQ(ctx context.Context, faq *entity.EventFAQ) error
+	DeleteFAQ(ctx context.Context, eventID, faqID string) error
+	GetFAQsByEventID(ctx context.Context, eventID string) ([]entity.EventFAQ, error)
+
+	CreateSession(ctx context.Context, session *entity.EventSession) error
+	UpdateSession(ctx context.Context, session *entity.EventSession) error
+	DeleteSession(ctx context.Context, eventID, sessionID string) error
+	GetSessionsByEventID(ctx context.Context, eventID string) ([]entity.EventSession, error)
+}
+
+// contentRepository implements ContentRepository interface
+type contentRepository struct {
+	db *sql.DB
+}
+
+// NewContentRepository creates new content repository instance
+func NewContentRepository(db *sql.DB) ContentRepository {
+	return &contentRepository{db: db}
+}
+
+// CreateFAQ inserts new FAQ entry for an event
+func (r *contentRepository) CreateFAQ(ctx context.Context, faq *entity.EventFAQ) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO event_faqs (id, event_id, question, answer, position, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`
+
+	faq.ID = uuid.New().String()
+
+	err := r.db.QueryRowContext(ctx, query, faq.ID, faq.EventID, faq.Question, faq.Answer, faq.Position).
+		Scan(&faq.CreatedAt, &faq.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create faq: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateFAQ replaces question, answer and position of an event's FAQ entry
+func (r *contentRepository) UpdateFAQ(ctx context.Context, faq *entity.EventFAQ) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE event_faqs
+		SET question = $1, answer = $2, position = $3, updated_at = NOW()
+		WHERE id = $4 AND event_id = $5
+		RETURNING created_at, updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query, faq.Question, faq.Answer, faq.Position, faq.ID, faq.EventID).
+		Scan(&faq.CreatedAt, &faq.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrFAQNotFound
+		}
+		return fmt.Errorf("failed to update faq: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteFAQ removes an FAQ entry of an event
+func (r *contentRepository) DeleteFAQ(ctx context.Context, eventID, faqID string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	result, err := r.db.ExecContext(ctx, `DELETE FROM event_faqs WHERE id = $1 AND event_id = $2`, faqID, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to delete faq: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrFAQNotFound
+	}
+
+	return nil
+}
+
+// GetFAQsByEventID retrieves all FAQ entries of an event in display order
+func (r *contentRepository) GetFAQsByEventID(ctx context.Context, eventID string) ([]entity.EventFAQ, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, event_id, question, answer, position, created_at, updated_at
+		FROM event_faqs
+		WHERE event_id = $1
+		ORDER BY position ASC, created_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get faqs by event: %w", err)
+	}
+	defer rows.Close()
+
+	faqs := []entity.EventFAQ{}
+	for rows.Next() {
+		var faq entity.EventFAQ
+		err := rows.Scan(
+			&faq.ID,
+			&faq.EventID,
+			&faq.Question,
+			&faq.Answer,
+			&faq.Position,
+			&faq.CreatedAt,
+			&faq.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan faq: %w", err)
+		}
+		faqs = append(faqs, faq)
+	}
+
+	return faqs, nil
+}
+
+// CreateSession inserts new agenda session for an event
+func (r *contentRepository) CreateSession(ctx context.Context, session *entity.EventSession) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO event_sessions (id, event_id, title, description, stage, speakers, starts_at, ends_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`
+
+	session.ID = uuid.New().String()
+
+	err := r.db.QueryRowContext(ctx, query,
+		session.ID,
+		session.EventID,
+		session.Title,
+		session.Description,
+		session.Stage,
+		pq.Array(session.Speakers),
+		session.StartsAt,
+		session.EndsAt,
+	).Scan(&session.CreatedAt, &session.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateSession replaces all fields of an event's agenda session
+func (r *contentRepository) UpdateSession(ctx context.Context, session *entity.EventSession) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE event_sessions
+		SET title = $1, description = $2, stage = $3, speakers = $4, starts_at = $5, ends_at = $6, updated_at = NOW()
+		WHERE id = $7 AND event_id = $8
+		RETURNING created_at, updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		session.Title,
+		session.Description,
+		session.Stage,
+		pq.Array(session.Speakers),
+		session.StartsAt,
+		session.EndsAt,
+		session.ID,
+		session.EventID,
+	).Scan(&session.CreatedAt, &session.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrSessionNotFound
+		}
+		return fmt.Errorf("failed to update session: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSession removes an agenda session of an event
+func (r *contentRepository) DeleteSession(ctx context.Context, eventID, sessionID string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	result, err := r.db.ExecContext(ctx, `DELETE FROM event_sessions WHERE id = $1 AND event_id = $2`, sessionID, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrSessionNotFound
+	}
+
+	return nil
+}
+
+// GetSessionsByEventID retrieves the agenda of an event, ordered by start time and stage
+func (r *contentRepository) GetSessionsByEventID(ctx context.Context, eventID string) ([]entity.EventSession, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, event_id, title, description, stage, speakers, starts_at, ends_at, created_at, updated_at
+		FROM event_sessions
+		WHERE event_id = $1
+		ORDER BY starts_at ASC, stage ASC NULLS FIRST, title ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sessions by event: %w", err)
+	}
+	defer rows.Close()
+
+	sessions := []entity.EventSession{}
+	for rows.Next() {
+		var session entity.EventSession
+		err := rows.Scan(
+			&session.ID,
+			&session.EventID,
+			&session.Title,
+			&session.Description,
+			&session.Stage,
+			pq.Array(&session.Speakers),
+			&session.StartsAt,
+			&session.EndsAt,
+			&session.CreatedAt,
+			&session.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan session: %w", err)
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
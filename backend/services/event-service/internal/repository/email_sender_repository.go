@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+var (
+	ErrEmailSenderNotFound = errors.New("email sender not found")
+	ErrSenderDomainTaken   = errors.New("sending domain is used by another organizer")
+)
+
+const emailSenderColumns = `organizer_id, tenant_id, domain, from_name, from_email, reply_to, provider_domain_id,
+		       status, dns_records, verified_at, last_checked_at, created_at, updated_at`
+
+// EmailSenderRepository defines interface for organizers' white-label email senders
+type EmailSenderRepository interface {
+	Get(ctx context.Context, organizerID string) (*entity.OrganizerEmailSender, error)
+	Save(ctx context.Context, sender *entity.OrganizerEmailSender) error
+	UpdateStatus(ctx context.Context, organizerID, status string, records entity.DNSRecords) (*entity.OrganizerEmailSender, error)
+	Delete(ctx context.Context, organizerID string) error
+
+	// DomainTaken checks if another organizer configured the domain
+	DomainTaken(ctx context.Context, domain, organizerID string) (bool, error)
+}
+
+// emailSenderRepository implements EmailSenderRepository interface
+type emailSenderRepository struct {
+	db *sql.DB
+}
+
+// NewEmailSenderRepository creates new email sender repository instance
+func NewEmailSenderRepository(db *sql.DB) EmailSenderRepository {
+	return &emailSenderRepository{db: db}
+}
+
+// Get retrieves the email sender of an organizer
+func (r *emailSenderRepository) Get(ctx context.Context, organizerID string) (*entity.OrganizerEmailSender, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + emailSenderColumns + ` FROM organizer_email_senders WHERE organizer_id = $1`
+
+	sender, err := scanEmailSender(r.db.QueryRowContext(ctx, query, organizerID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrEmailSenderNotFound
+		}
+		return nil, fmt.Errorf("failed to get email sender: %w", err)
+	}
+
+	return sender, nil
+}
+
+// Save creates or replaces the email sender of an organizer in the tenant of ctx
+// A verified sender keeps its verification time, or is verified now
+func (r *emailSenderRepository) Save(ctx context.Context, sender *entity.OrganizerEmailSender) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO organizer_email_senders (organizer_id, tenant_id, domain, from_name, from_email, reply_to,
+		                                     provider_domain_id, status, dns_records, verified_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8::VARCHAR, $9,
+		        CASE WHEN $8::VARCHAR = 'verified' THEN COALESCE($10::TIMESTAMPTZ, NOW()) END, NOW(), NOW())
+		ON CONFLICT (organizer_id) DO UPDATE
+		SET domain = EXCLUDED.domain, from_name = EXCLUDED.from_name, from_email = EXCLUDED.from_email,
+		    reply_to = EXCLUDED.reply_to, provider_domain_id = EXCLUDED.provider_domain_id, status = EXCLUDED.status,
+		    dns_records = EXCLUDED.dns_records, verified_at = EXCLUDED.verified_at, updated_at = NOW()
+		RETURNING verified_at, created_at, updated_at
+	`
+
+	sender.TenantID = tenantOrDefault(ctx)
+
+	err := r.db.QueryRowContext(ctx, query,
+		sender.OrganizerID,
+		sender.TenantID,
+		sender.Domain,
+		sender.FromName,
+		sender.FromEmail,
+		sender.ReplyTo,
+		sender.ProviderDomainID,
+		sender.Status,
+		sender.DNSRecords,
+		sender.VerifiedAt,
+	).Scan(&sender.VerifiedAt, &sender.CreatedAt, &sender.UpdatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return ErrSenderDomainTaken
+		}
+		return fmt.Errorf("failed to save email sender: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateStatus records the result of a verification check, the domain is verified since the first successful one
+func (r *emailSenderRepository) UpdateStatus(ctx context.Context, organizerID, status string, records entity.DNSRecords) (*entity.OrganizerEmailSender, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE organizer_email_senders
+		SET status = $1::VARCHAR, dns_records = $2, last_checked_at = NOW(), updated_at = NOW(),
+		    verified_at = CASE WHEN $1::VARCHAR = 'verified' THEN COALESCE(verified_at, NOW()) END
+		WHERE organizer_id = $3
+		RETURNING ` + emailSenderColumns
+
+	sender, err := scanEmailSender(r.db.QueryRowContext(ctx, query, status, records, organizerID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrEmailSenderNotFound
+		}
+		return nil, fmt.Errorf("failed to update email sender status: %w", err)
+	}
+
+	return sender, nil
+}
+
+// Delete removes the email sender of an organizer, its emails are sent by the tenant or platform sender again
+func (r *emailSenderRepository) Delete(ctx context.Context, organizerID string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	result, err := r.db.ExecContext(ctx, `DELETE FROM organizer_email_senders WHERE organizer_id = $1`, organizerID)
+	if err != nil {
+		return fmt.Errorf("failed to delete email sender: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return ErrEmailSenderNotFound
+	}
+
+	return nil
+}
+
+// DomainTaken checks if another organizer configured the domain
+func (r *emailSenderRepository) DomainTaken(ctx context.Context, domain, organizerID string) (bool, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	var taken bool
+	query := `SELECT EXISTS(SELECT 1 FROM organizer_email_senders WHERE LOWER(domain) = LOWER($1) AND organizer_id <> $2)`
+	if err := r.db.QueryRowContext(ctx, query, domain, organizerID).Scan(&taken); err != nil {
+		return false, fmt.Errorf("failed to check sending domain: %w", err)
+	}
+
+	return taken, nil
+}
+
+// scanEmailSender scans a row of emailSenderColumns
+func scanEmailSender(row rowScanner) (*entity.OrganizerEmailSender, error) {
+	var sender entity.OrganizerEmailSender
+	err := row.Scan(
+		&sender.OrganizerID,
+		&sender.TenantID,
+		&sender.Domain,
+		&sender.FromName,
+		&sender.FromEmail,
+		&sender.ReplyTo,
+		&sender.ProviderDomainID,
+		&sender.Status,
+		&sender.DNSRecords,
+		&sender.VerifiedAt,
+		&sender.LastCheckedAt,
+		&sender.CreatedAt,
+		&sender.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &sender, nil
+}
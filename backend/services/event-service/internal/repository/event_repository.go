@@ -6,26 +6,58 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/request"
 )
 
 var (
-	ErrEventNotFound   = errors.New("event not found")
-	ErrEventSlugExists = errors.New("event slug already exists")
+	ErrEventNotFound        = errors.New("event not found")
+	ErrEventSlugExists      = errors.New("event slug already exists")
+	ErrEventVersionConflict = errors.New("event was modified by another request")
 )
 
+// eventSortColumns maps allowed sort_by values to SQL expressions
+// Identifiers cannot be bound as query parameters, so only values from this map reach ORDER BY
+var eventSortColumns = map[string]string{
+	"start_date": "start_date",
+	"created_at": "created_at",
+	"title":      "title",
+	"price_min":  "event_availability.min_price",
+	"price_max":  "event_availability.max_price",
+}
+
+// eventSortOrders maps allowed sort_order values to SQL keywords
+var eventSortOrders = map[string]string{
+	"asc":  "ASC",
+	"desc": "DESC",
+}
+
+// availabilityJoin attaches the event_availability summary (see migration 000010)
+// Only joined when filtering or sorting by price range or availability; events without tiers sort last
+const availabilityJoin = `
+		LEFT JOIN event_availability ON event_availability.event_id = events.id`
+
 // EventRepository defines interface for event data operations
 type EventRepository interface {
 	Create(ctx context.Context, event *entity.Event) error
 	GetByID(ctx context.Context, id string) (*entity.Event, error)
+	GetByIDs(ctx context.Context, ids []string) ([]entity.Event, error)
 	GetBySlug(ctx context.Context, slug string) (*entity.Event, error)
 	List(ctx context.Context, filters request.ListEventsRequest) ([]entity.Event, int64, error)
 	Update(ctx context.Context, event *entity.Event) error
 	Delete(ctx context.Context, id string) error
 	GetByOrganizerID(ctx context.Context, organizerID string) ([]entity.Event, error)
+	GetIDsByOrganizerID(ctx context.Context, organizerID string) ([]string, error)
+	CountActiveByOrganizerID(ctx context.Context, organizerID string) (int, error)
+	HasSandboxOrders(ctx context.Context, eventID string) (bool, error)
+	GetAvailability(ctx context.Context, eventIDs []string) (map[string]entity.EventAvailability, error)
+	CompleteEnded(ctx context.Context, endedBefore time.Time, limit int) ([]entity.Event, error)
 }
 
 // eventRepository implements EventRepository interface
@@ -40,11 +72,15 @@ func NewEventRepository(db *sql.DB) EventRepository {
 
 // Create inserts new event into database
 func (r *eventRepository) Create(ctx context.Context, event *entity.Event) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		INSERT INTO events (id, organizer_id, title, slug, description, category, location, venue,
-		                   start_date, end_date, timezone, banner_url, status, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, NOW(), NOW())
-		RETURNING id, created_at, updated_at
+		                   start_date, end_date, timezone, scan_policy, refund_policy, banner_url, status, tenant_id, is_sandbox,
+		                   dynamic_qr, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, NOW(), NOW())
+		RETURNING id, version, created_at, updated_at
 	`
 
 	event.ID = uuid.New().String()
@@ -63,9 +99,14 @@ func (r *eventRepository) Create(ctx context.Context, event *entity.Event) error
 		event.StartDate,
 		event.EndDate,
 		event.Timezone,
+		event.ScanPolicy,
+		event.RefundPolicy,
 		event.BannerURL,
 		event.Status,
-	).Scan(&event.ID, &event.CreatedAt, &event.UpdatedAt)
+		tenantOrDefault(ctx),
+		event.IsSandbox,
+		event.DynamicQR,
+	).Scan(&event.ID, &event.Version, &event.CreatedAt, &event.UpdatedAt)
 
 	if err != nil {
 		if strings.Contains(err.Error(), "events_slug_key") {
@@ -79,17 +120,21 @@ func (r *eventRepository) Create(ctx context.Context, event *entity.Event) error
 
 // GetByID retrieves event by ID
 func (r *eventRepository) GetByID(ctx context.Context, id string) (*entity.Event, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
-		SELECT id, organizer_id, title, slug, description, category, location, venue,
-		       start_date, end_date, timezone, banner_url, status, created_at, updated_at
+		SELECT id, organizer_id, tenant_id, title, slug, description, category, location, venue,
+		       start_date, end_date, timezone, scan_policy, refund_policy, is_sandbox, dynamic_qr, banner_url, status, version, created_at, updated_at
 		FROM events
-		WHERE id = $1
+		WHERE id = $1` + tenantCondition(ctx, 2) + `
 	`
 
 	event := &entity.Event{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := r.db.QueryRowContext(ctx, query, tenantArgs(ctx, id)...).Scan(
 		&event.ID,
 		&event.OrganizerID,
+		&event.TenantID,
 		&event.Title,
 		&event.Slug,
 		&event.Description,
@@ -99,8 +144,13 @@ func (r *eventRepository) GetByID(ctx context.Context, id string) (*entity.Event
 		&event.StartDate,
 		&event.EndDate,
 		&event.Timezone,
+		&event.ScanPolicy,
+		&event.RefundPolicy,
+		&event.IsSandbox,
+		&event.DynamicQR,
 		&event.BannerURL,
 		&event.Status,
+		&event.Version,
 		&event.CreatedAt,
 		&event.UpdatedAt,
 	)
@@ -116,17 +166,78 @@ func (r *eventRepository) GetByID(ctx context.Context, id string) (*entity.Event
 	return event, nil
 }
 
+// GetByIDs retrieves events by IDs in a single query
+// Missing IDs are skipped rather than reported as errors
+func (r *eventRepository) GetByIDs(ctx context.Context, ids []string) ([]entity.Event, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	events := []entity.Event{}
+	if len(ids) == 0 {
+		return events, nil
+	}
+
+	query := `
+		SELECT id, organizer_id, tenant_id, title, slug, description, category, location, venue,
+		       start_date, end_date, timezone, scan_policy, refund_policy, is_sandbox, dynamic_qr, banner_url, status, version, created_at, updated_at
+		FROM events
+		WHERE id = ANY($1)` + tenantCondition(ctx, 2) + `
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, tenantArgs(ctx, pq.Array(ids))...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var event entity.Event
+		err := rows.Scan(
+			&event.ID,
+			&event.OrganizerID,
+			&event.TenantID,
+			&event.Title,
+			&event.Slug,
+			&event.Description,
+			&event.Category,
+			&event.Location,
+			&event.Venue,
+			&event.StartDate,
+			&event.EndDate,
+			&event.Timezone,
+			&event.ScanPolicy,
+			&event.RefundPolicy,
+			&event.IsSandbox,
+			&event.DynamicQR,
+			&event.BannerURL,
+			&event.Status,
+			&event.Version,
+			&event.CreatedAt,
+			&event.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
 // GetBySlug retrieves event by slug
 func (r *eventRepository) GetBySlug(ctx context.Context, slug string) (*entity.Event, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT id, organizer_id, title, slug, description, category, location, venue,
-		       start_date, end_date, timezone, banner_url, status, created_at, updated_at
+		       start_date, end_date, timezone, scan_policy, refund_policy, is_sandbox, dynamic_qr, banner_url, status, version, created_at, updated_at
 		FROM events
-		WHERE slug = $1
+		WHERE slug = $1` + tenantCondition(ctx, 2) + `
 	`
 
 	event := &entity.Event{}
-	err := r.db.QueryRowContext(ctx, query, slug).Scan(
+	err := r.db.QueryRowContext(ctx, query, tenantArgs(ctx, slug)...).Scan(
 		&event.ID,
 		&event.OrganizerID,
 		&event.Title,
@@ -138,8 +249,13 @@ func (r *eventRepository) GetBySlug(ctx context.Context, slug string) (*entity.E
 		&event.StartDate,
 		&event.EndDate,
 		&event.Timezone,
+		&event.ScanPolicy,
+		&event.RefundPolicy,
+		&event.IsSandbox,
+		&event.DynamicQR,
 		&event.BannerURL,
 		&event.Status,
+		&event.Version,
 		&event.CreatedAt,
 		&event.UpdatedAt,
 	)
@@ -157,11 +273,20 @@ func (r *eventRepository) GetBySlug(ctx context.Context, slug string) (*entity.E
 
 // List retrieves events with filters and pagination
 func (r *eventRepository) List(ctx context.Context, filters request.ListEventsRequest) ([]entity.Event, int64, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	// Build WHERE clause
 	whereConditions := []string{}
 	args := []interface{}{}
 	argCount := 1
 
+	if tenantID, ok := tenant.FromContext(ctx); ok {
+		whereConditions = append(whereConditions, fmt.Sprintf("events.tenant_id = $%d", argCount))
+		args = append(args, tenantID)
+		argCount++
+	}
+
 	if filters.Category != "" {
 		whereConditions = append(whereConditions, fmt.Sprintf("category = $%d", argCount))
 		args = append(args, filters.Category)
@@ -183,6 +308,9 @@ func (r *eventRepository) List(ctx context.Context, filters request.ListEventsRe
 		whereConditions = append(whereConditions, "status = 'published'")
 	}
 
+	// Sandbox events are rehearsals, reachable by ID or slug but never listed
+	whereConditions = append(whereConditions, "NOT is_sandbox")
+
 	if !filters.StartDate.IsZero() {
 		whereConditions = append(whereConditions, fmt.Sprintf("start_date >= $%d", argCount))
 		args = append(args, filters.StartDate)
@@ -195,37 +323,78 @@ func (r *eventRepository) List(ctx context.Context, filters request.ListEventsRe
 		argCount++
 	}
 
+	// Search also matches the names of the event's performers
 	if filters.Search != "" {
-		whereConditions = append(whereConditions, fmt.Sprintf("(title ILIKE $%d OR description ILIKE $%d)", argCount, argCount))
+		whereConditions = append(whereConditions, fmt.Sprintf(`(title ILIKE $%d OR description ILIKE $%d OR EXISTS (
+			SELECT 1 FROM event_performers ep JOIN performers p ON p.id = ep.performer_id
+			WHERE ep.event_id = events.id AND p.name ILIKE $%d))`, argCount, argCount, argCount))
 		args = append(args, "%"+filters.Search+"%")
 		argCount++
 	}
 
+	if filters.PerformerID != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("EXISTS (SELECT 1 FROM event_performers ep WHERE ep.event_id = events.id AND ep.performer_id = $%d)", argCount))
+		args = append(args, filters.PerformerID)
+		argCount++
+	}
+
+	if filters.ExcludeEventID != "" {
+		whereConditions = append(whereConditions, fmt.Sprintf("events.id <> $%d", argCount))
+		args = append(args, filters.ExcludeEventID)
+		argCount++
+	}
+
+	// Price range and availability filters read the precomputed availability summary
+	availabilityClause := ""
+
+	if filters.MinPrice != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("event_availability.min_price >= $%d", argCount))
+		args = append(args, *filters.MinPrice)
+		argCount++
+		availabilityClause = availabilityJoin
+	}
+
+	if filters.MaxPrice != nil {
+		whereConditions = append(whereConditions, fmt.Sprintf("event_availability.min_price <= $%d", argCount))
+		args = append(args, *filters.MaxPrice)
+		argCount++
+		availabilityClause = availabilityJoin
+	}
+
+	if filters.OnlyAvailable {
+		whereConditions = append(whereConditions, "event_availability.total_sold < event_availability.total_quota")
+		availabilityClause = availabilityJoin
+	}
+
 	whereClause := ""
 	if len(whereConditions) > 0 {
 		whereClause = "WHERE " + strings.Join(whereConditions, " AND ")
 	}
 
 	// Count total
-	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM events %s", whereClause)
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM events%s %s", availabilityClause, whereClause)
 	var total int64
 	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count events: %w", err)
 	}
 
-	// Build ORDER BY clause
-	sortBy := "start_date"
-	if filters.SortBy != "" {
-		sortBy = filters.SortBy
+	// Build ORDER BY clause from whitelist, id as tie-breaker keeps pagination stable
+	sortColumn, ok := eventSortColumns[filters.SortBy]
+	if !ok {
+		sortColumn = eventSortColumns["start_date"]
+	}
+
+	sortOrder, ok := eventSortOrders[strings.ToLower(filters.SortOrder)]
+	if !ok {
+		sortOrder = eventSortOrders["asc"]
 	}
 
-	sortOrder := "ASC"
-	if filters.SortOrder != "" {
-		sortOrder = strings.ToUpper(filters.SortOrder)
+	if strings.HasPrefix(sortColumn, "event_availability.") {
+		availabilityClause = availabilityJoin
 	}
 
-	orderClause := fmt.Sprintf("ORDER BY %s %s", sortBy, sortOrder)
+	orderClause := fmt.Sprintf("ORDER BY %s %s NULLS LAST, events.id %s", sortColumn, sortOrder, sortOrder)
 
 	// Pagination
 	page := 1
@@ -242,13 +411,13 @@ func (r *eventRepository) List(ctx context.Context, filters request.ListEventsRe
 
 	// Build final query
 	query := fmt.Sprintf(`
-		SELECT id, organizer_id, title, slug, description, category, location, venue,
-		       start_date, end_date, timezone, banner_url, status, created_at, updated_at
-		FROM events
+		SELECT events.id, organizer_id, title, slug, description, category, location, venue,
+		       start_date, end_date, timezone, scan_policy, refund_policy, is_sandbox, dynamic_qr, banner_url, status, version, created_at, updated_at
+		FROM events%s
 		%s
 		%s
 		LIMIT $%d OFFSET $%d
-	`, whereClause, orderClause, argCount, argCount+1)
+	`, availabilityClause, whereClause, orderClause, argCount, argCount+1)
 
 	args = append(args, limit, offset)
 
@@ -274,8 +443,13 @@ func (r *eventRepository) List(ctx context.Context, filters request.ListEventsRe
 			&event.StartDate,
 			&event.EndDate,
 			&event.Timezone,
+			&event.ScanPolicy,
+			&event.RefundPolicy,
+			&event.IsSandbox,
+			&event.DynamicQR,
 			&event.BannerURL,
 			&event.Status,
+			&event.Version,
 			&event.CreatedAt,
 			&event.UpdatedAt,
 		)
@@ -288,17 +462,22 @@ func (r *eventRepository) List(ctx context.Context, filters request.ListEventsRe
 	return events, total, nil
 }
 
-// Update updates event information
+// Update updates event information using optimistic concurrency
+// event.Version must hold the version the caller read; on success it is set to the new version
 func (r *eventRepository) Update(ctx context.Context, event *entity.Event) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		UPDATE events
 		SET title = $1, description = $2, category = $3, location = $4, venue = $5,
-		    start_date = $6, end_date = $7, timezone = $8, banner_url = $9, status = $10,
-		    updated_at = NOW()
-		WHERE id = $11
+		    start_date = $6, end_date = $7, timezone = $8, scan_policy = $9, refund_policy = $10, banner_url = $11,
+		    status = $12, is_sandbox = $13, dynamic_qr = $14, version = version + 1, updated_at = NOW()
+		WHERE id = $15 AND version = $16
+		RETURNING version, updated_at
 	`
 
-	result, err := r.db.ExecContext(
+	err := r.db.QueryRowContext(
 		ctx,
 		query,
 		event.Title,
@@ -309,22 +488,26 @@ func (r *eventRepository) Update(ctx context.Context, event *entity.Event) error
 		event.StartDate,
 		event.EndDate,
 		event.Timezone,
+		event.ScanPolicy,
+		event.RefundPolicy,
 		event.BannerURL,
 		event.Status,
+		event.IsSandbox,
+		event.DynamicQR,
 		event.ID,
-	)
+		event.Version,
+	).Scan(&event.Version, &event.UpdatedAt)
 
-	if err != nil {
-		return fmt.Errorf("failed to update event: %w", err)
+	if err == sql.ErrNoRows {
+		// Either event not found or version mismatch
+		if _, getErr := r.GetByID(ctx, event.ID); getErr != nil {
+			return getErr
+		}
+		return ErrEventVersionConflict
 	}
 
-	rows, err := result.RowsAffected()
 	if err != nil {
-		return err
-	}
-
-	if rows == 0 {
-		return ErrEventNotFound
+		return fmt.Errorf("failed to update event: %w", err)
 	}
 
 	return nil
@@ -332,6 +515,9 @@ func (r *eventRepository) Update(ctx context.Context, event *entity.Event) error
 
 // Delete soft deletes event
 func (r *eventRepository) Delete(ctx context.Context, id string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `DELETE FROM events WHERE id = $1`
 
 	result, err := r.db.ExecContext(ctx, query, id)
@@ -351,11 +537,109 @@ func (r *eventRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// CompleteEnded moves up to limit published events that ended before endedBefore to completed
+// Returns the completed events with their ID, organizer ID and slug only
+func (r *eventRepository) CompleteEnded(ctx context.Context, endedBefore time.Time, limit int) ([]entity.Event, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE events
+		SET status = 'completed', version = version + 1, updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM events
+			WHERE status = 'published' AND end_date < $1
+			ORDER BY end_date
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, organizer_id, slug
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, endedBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete ended events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []entity.Event{}
+	for rows.Next() {
+		event := entity.Event{Status: entity.StatusCompleted}
+		if err := rows.Scan(&event.ID, &event.OrganizerID, &event.Slug); err != nil {
+			return nil, fmt.Errorf("failed to scan completed event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+// GetIDsByOrganizerID retrieves the IDs of all events owned by an organizer
+func (r *eventRepository) GetIDsByOrganizerID(ctx context.Context, organizerID string) ([]string, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx, `SELECT id FROM events WHERE organizer_id = $1`, organizerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event ids by organizer: %w", err)
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan event id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// CountActiveByOrganizerID counts the published events of an organizer (plan limit)
+func (r *eventRepository) CountActiveByOrganizerID(ctx context.Context, organizerID string) (int, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	var count int
+	query := `SELECT COUNT(*) FROM events WHERE organizer_id = $1 AND status = $2`
+
+	if err := r.db.QueryRowContext(ctx, query, organizerID, entity.StatusPublished).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count active events: %w", err)
+	}
+
+	return count, nil
+}
+
+// HasSandboxOrders reports whether the event still has reserved or paid test orders
+func (r *eventRepository) HasSandboxOrders(ctx context.Context, eventID string) (bool, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	var exists bool
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM orders
+			WHERE event_id = $1 AND is_sandbox AND status IN ('reserved', 'paid')
+		)
+	`
+
+	if err := r.db.QueryRowContext(ctx, query, eventID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check sandbox orders: %w", err)
+	}
+
+	return exists, nil
+}
+
 // GetByOrganizerID retrieves all events by organizer
 func (r *eventRepository) GetByOrganizerID(ctx context.Context, organizerID string) ([]entity.Event, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT id, organizer_id, title, slug, description, category, location, venue,
-		       start_date, end_date, timezone, banner_url, status, created_at, updated_at
+		       start_date, end_date, timezone, scan_policy, refund_policy, is_sandbox, dynamic_qr, banner_url, status, version, created_at, updated_at
 		FROM events
 		WHERE organizer_id = $1
 		ORDER BY created_at DESC
@@ -382,8 +666,13 @@ func (r *eventRepository) GetByOrganizerID(ctx context.Context, organizerID stri
 			&event.StartDate,
 			&event.EndDate,
 			&event.Timezone,
+			&event.ScanPolicy,
+			&event.RefundPolicy,
+			&event.IsSandbox,
+			&event.DynamicQR,
 			&event.BannerURL,
 			&event.Status,
+			&event.Version,
 			&event.CreatedAt,
 			&event.UpdatedAt,
 		)
@@ -395,3 +684,62 @@ func (r *eventRepository) GetByOrganizerID(ctx context.Context, organizerID stri
 
 	return events, nil
 }
+
+// GetAvailability retrieves availability summaries for the given events, keyed by event ID
+// Events missing from the summary (no tiers, or created since the last refresh) are omitted
+func (r *eventRepository) GetAvailability(ctx context.Context, eventIDs []string) (map[string]entity.EventAvailability, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	availability := make(map[string]entity.EventAvailability, len(eventIDs))
+	if len(eventIDs) == 0 {
+		return availability, nil
+	}
+
+	query := `
+		SELECT event_id, total_quota, total_sold, min_price, max_price
+		FROM event_availability
+		WHERE event_id = ANY($1)
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(eventIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event availability: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var a entity.EventAvailability
+		if err := rows.Scan(&a.EventID, &a.TotalQuota, &a.TotalSold, &a.MinPrice, &a.MaxPrice); err != nil {
+			return nil, fmt.Errorf("failed to scan event availability: %w", err)
+		}
+		availability[a.EventID] = a
+	}
+
+	return availability, rows.Err()
+}
+
+// tenantCondition scopes a single-row lookup to the tenant in ctx as argument arg
+// Internal callers carry no tenant and are not scoped
+func tenantCondition(ctx context.Context, arg int) string {
+	if _, ok := tenant.FromContext(ctx); !ok {
+		return ""
+	}
+	return fmt.Sprintf(" AND tenant_id = $%d", arg)
+}
+
+// tenantArgs appends the tenant in ctx to args to match tenantCondition
+func tenantArgs(ctx context.Context, args ...interface{}) []interface{} {
+	if tenantID, ok := tenant.FromContext(ctx); ok {
+		return append(args, tenantID)
+	}
+	return args
+}
+
+// tenantOrDefault returns the tenant in ctx, or the default tenant
+func tenantOrDefault(ctx context.Context) string {
+	if tenantID, ok := tenant.FromContext(ctx); ok {
+		return tenantID
+	}
+	return tenant.DefaultID
+}
@@ -0,0 +1,304 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+var ErrPerformerNotFound = errors.New("performer not found")
+
+// PerformerRepository defines interface for performer directory data operations
+type PerformerRepository interface {
+	Create(ctx context.Context, performer *entity.Performer) error
+	GetByID(ctx context.Context, id string) (*entity.Performer, error)
+	GetByIDs(ctx context.Context, ids []string) ([]entity.Performer, error)
+	Update(ctx context.Context, performer *entity.Performer) error
+	Delete(ctx context.Context, id string) error
+	Search(ctx context.Context, search string, limit, offset int) ([]entity.Performer, int64, error)
+
+	// Event lineups
+	GetByEventID(ctx context.Context, eventID string) ([]entity.Performer, error)
+	GetEventIDs(ctx context.Context, performerID string) ([]string, error)
+	SetEventPerformers(ctx context.Context, eventID string, performerIDs []string) error
+}
+
+// performerRepository implements PerformerRepository interface
+type performerRepository struct {
+	db *sql.DB
+}
+
+// NewPerformerRepository creates new performer repository instance
+func NewPerformerRepository(db *sql.DB) PerformerRepository {
+	return &performerRepository{db: db}
+}
+
+const performerColumns = `p.id, p.tenant_id, p.name, p.photo_url, p.bio, p.social_links, p.created_by, p.created_at, p.updated_at`
+
+// Create inserts new performer into the directory of the tenant in ctx
+func (r *performerRepository) Create(ctx context.Context, performer *entity.Performer) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO performers (id, tenant_id, name, photo_url, bio, social_links, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`
+
+	performer.ID = uuid.New().String()
+	performer.TenantID = tenantOrDefault(ctx)
+
+	err := r.db.QueryRowContext(ctx, query,
+		performer.ID,
+		performer.TenantID,
+		performer.Name,
+		performer.PhotoURL,
+		performer.Bio,
+		performer.SocialLinks,
+		performer.CreatedBy,
+	).Scan(&performer.CreatedAt, &performer.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create performer: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves performer by ID
+func (r *performerRepository) GetByID(ctx context.Context, id string) (*entity.Performer, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + performerColumns + ` FROM performers p WHERE p.id = $1` + tenantCondition(ctx, 2)
+
+	performer, err := scanPerformer(r.db.QueryRowContext(ctx, query, tenantArgs(ctx, id)...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPerformerNotFound
+		}
+		return nil, fmt.Errorf("failed to get performer: %w", err)
+	}
+
+	return performer, nil
+}
+
+// GetByIDs retrieves the performers with the given IDs; unknown IDs are skipped
+func (r *performerRepository) GetByIDs(ctx context.Context, ids []string) ([]entity.Performer, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + performerColumns + ` FROM performers p WHERE p.id = ANY($1)` + tenantCondition(ctx, 2)
+
+	rows, err := r.db.QueryContext(ctx, query, tenantArgs(ctx, pq.Array(ids))...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get performers: %w", err)
+	}
+	defer rows.Close()
+
+	return scanPerformers(rows)
+}
+
+// Update replaces the profile of a performer
+func (r *performerRepository) Update(ctx context.Context, performer *entity.Performer) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE performers
+		SET name = $1, photo_url = $2, bio = $3, social_links = $4, updated_at = NOW()
+		WHERE id = $5
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		performer.Name,
+		performer.PhotoURL,
+		performer.Bio,
+		performer.SocialLinks,
+		performer.ID,
+	).Scan(&performer.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrPerformerNotFound
+		}
+		return fmt.Errorf("failed to update performer: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes a performer; event lineups drop it by cascade
+func (r *performerRepository) Delete(ctx context.Context, id string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	result, err := r.db.ExecContext(ctx, `DELETE FROM performers WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete performer: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrPerformerNotFound
+	}
+
+	return nil
+}
+
+// Search retrieves a page of the tenant's performers whose name contains search, by name
+func (r *performerRepository) Search(ctx context.Context, search string, limit, offset int) ([]entity.Performer, int64, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	where := `WHERE p.tenant_id = $1 AND ($2 = '' OR p.name ILIKE '%' || $2 || '%')`
+	args := []interface{}{tenantOrDefault(ctx), search}
+
+	var total int64
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM performers p `+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count performers: %w", err)
+	}
+
+	query := `SELECT ` + performerColumns + ` FROM performers p ` + where + `
+		ORDER BY lower(p.name) ASC, p.id ASC
+		LIMIT $3 OFFSET $4`
+
+	rows, err := r.db.QueryContext(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search performers: %w", err)
+	}
+	defer rows.Close()
+
+	performers, err := scanPerformers(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return performers, total, nil
+}
+
+// GetByEventID retrieves the lineup of an event in order
+func (r *performerRepository) GetByEventID(ctx context.Context, eventID string) ([]entity.Performer, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + performerColumns + `
+		FROM event_performers ep
+		JOIN performers p ON p.id = ep.performer_id
+		WHERE ep.event_id = $1
+		ORDER BY ep.position ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event performers: %w", err)
+	}
+	defer rows.Close()
+
+	return scanPerformers(rows)
+}
+
+// GetEventIDs retrieves the IDs of the events featuring a performer
+func (r *performerRepository) GetEventIDs(ctx context.Context, performerID string) ([]string, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx, `SELECT event_id FROM event_performers WHERE performer_id = $1`, performerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get performer events: %w", err)
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan event id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// SetEventPerformers replaces the lineup of an event, positioned in the given order
+func (r *performerRepository) SetEventPerformers(ctx context.Context, eventID string, performerIDs []string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM event_performers WHERE event_id = $1`, eventID); err != nil {
+		return fmt.Errorf("failed to clear event performers: %w", err)
+	}
+
+	if len(performerIDs) > 0 {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO event_performers (event_id, performer_id, position)
+			SELECT $1, performer_id, position
+			FROM UNNEST($2::uuid[]) WITH ORDINALITY AS lineup(performer_id, position)
+		`, eventID, pq.Array(performerIDs))
+		if err != nil {
+			return fmt.Errorf("failed to set event performers: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// performerScanner is satisfied by *sql.Row and *sql.Rows
+type performerScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanPerformer scans a performer row selected with performerColumns
+func scanPerformer(row performerScanner) (*entity.Performer, error) {
+	var performer entity.Performer
+	err := row.Scan(
+		&performer.ID,
+		&performer.TenantID,
+		&performer.Name,
+		&performer.PhotoURL,
+		&performer.Bio,
+		&performer.SocialLinks,
+		&performer.CreatedBy,
+		&performer.CreatedAt,
+		&performer.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &performer, nil
+}
+
+// scanPerformers scans all performer rows selected with performerColumns
+func scanPerformers(rows *sql.Rows) ([]entity.Performer, error) {
+	performers := []entity.Performer{}
+	for rows.Next() {
+		performer, err := scanPerformer(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan performer: %w", err)
+		}
+		performers = append(performers, *performer)
+	}
+	return performers, rows.Err()
+}
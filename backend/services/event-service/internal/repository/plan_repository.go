@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+var (
+	ErrPlanNotFound      = errors.New("plan not found")
+	ErrOrganizerNotFound = errors.New("organizer not found")
+)
+
+// PlanRepository defines interface for organizer plan data operations
+type PlanRepository interface {
+	List(ctx context.Context) ([]entity.OrganizerPlan, error)
+	GetByCode(ctx context.Context, code string) (*entity.OrganizerPlan, error)
+	Update(ctx context.Context, plan *entity.OrganizerPlan) error
+	GetForOrganizer(ctx context.Context, organizerID string) (*entity.OrganizerPlan, error)
+	Assign(ctx context.Context, organizerID, code, assignedBy string) error
+}
+
+// planRepository implements PlanRepository interface
+type planRepository struct {
+	db *sql.DB
+}
+
+// NewPlanRepository creates new plan repository instance
+func NewPlanRepository(db *sql.DB) PlanRepository {
+	return &planRepository{db: db}
+}
+
+const planColumns = `p.code, p.name, p.max_active_events, p.max_tickets_per_event,
+		       p.announcement_emails_per_month, p.api_requests_per_minute, p.updated_at`
+
+// List retrieves all plans, cheapest first
+func (r *planRepository) List(ctx context.Context) ([]entity.OrganizerPlan, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + planColumns + ` FROM organizer_plans p ORDER BY p.max_active_events ASC, p.code ASC`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plans: %w", err)
+	}
+	defer rows.Close()
+
+	plans := []entity.OrganizerPlan{}
+	for rows.Next() {
+		plan, err := scanPlan(rows)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, *plan)
+	}
+
+	return plans, rows.Err()
+}
+
+// GetByCode retrieves a plan by its code
+func (r *planRepository) GetByCode(ctx context.Context, code string) (*entity.OrganizerPlan, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + planColumns + ` FROM organizer_plans p WHERE p.code = $1`
+
+	plan, err := scanPlan(r.db.QueryRowContext(ctx, query, code))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrPlanNotFound
+	}
+	return plan, err
+}
+
+// Update replaces the limits of a plan
+func (r *planRepository) Update(ctx context.Context, plan *entity.OrganizerPlan) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE organizer_plans
+		SET max_active_events = $2, max_tickets_per_event = $3,
+		    announcement_emails_per_month = $4, api_requests_per_minute = $5, updated_at = NOW()
+		WHERE code = $1
+		RETURNING name, updated_at
+	`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		plan.Code,
+		plan.MaxActiveEvents,
+		plan.MaxTicketsPerEvent,
+		plan.AnnouncementEmailsPerMonth,
+		plan.APIRequestsPerMinute,
+	).Scan(&plan.Name, &plan.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrPlanNotFound
+		}
+		return fmt.Errorf("failed to update plan: %w", err)
+	}
+
+	return nil
+}
+
+// GetForOrganizer retrieves the plan assigned to an organizer, the free plan if none is
+func (r *planRepository) GetForOrganizer(ctx context.Context, organizerID string) (*entity.OrganizerPlan, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + planColumns + `
+		FROM organizer_plans p
+		WHERE p.code = COALESCE(
+			(SELECT plan_code FROM organizer_plan_assignments WHERE organizer_id = $1),
+			$2
+		)
+	`
+
+	plan, err := scanPlan(r.db.QueryRowContext(ctx, query, organizerID, entity.PlanFree))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrPlanNotFound
+	}
+	return plan, err
+}
+
+// Assign puts an organizer on a plan, replacing the previous assignment
+// assignedBy is the admin user ID, empty when the plan is switched by billing
+func (r *planRepository) Assign(ctx context.Context, organizerID, code, assignedBy string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO organizer_plan_assignments (organizer_id, plan_code, assigned_by, assigned_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (organizer_id) DO UPDATE
+		SET plan_code = EXCLUDED.plan_code, assigned_by = EXCLUDED.assigned_by, assigned_at = EXCLUDED.assigned_at
+	`
+
+	// Plans switched by billing have no admin
+	var assignedByID interface{}
+	if assignedBy != "" {
+		assignedByID = assignedBy
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, organizerID, code, assignedByID); err != nil {
+		switch {
+		case strings.Contains(err.Error(), "organizer_plan_assignments_plan_code_fkey"):
+			return ErrPlanNotFound
+		case strings.Contains(err.Error(), "organizer_plan_assignments_organizer_id_fkey"):
+			return ErrOrganizerNotFound
+		}
+		return fmt.Errorf("failed to assign plan: %w", err)
+	}
+
+	return nil
+}
+
+// planScanner is satisfied by *sql.Row and *sql.Rows
+type planScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanPlan scans a plan row selected with planColumns
+func scanPlan(row planScanner) (*entity.OrganizerPlan, error) {
+	var plan entity.OrganizerPlan
+	err := row.Scan(
+		&plan.Code,
+		&plan.Name,
+		&plan.MaxActiveEvents,
+		&plan.MaxTicketsPerEvent,
+		&plan.AnnouncementEmailsPerMonth,
+		&plan.APIRequestsPerMinute,
+		&plan.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan plan: %w", err)
+	}
+	return &plan, nil
+}
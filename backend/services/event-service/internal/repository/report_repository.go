@@ -0,0 +1,420 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+var ErrReportAlreadyOpen = errors.New("user already has an open report on this event")
+
+// Columns selected for event reports and moderation holds
+const (
+	eventReportColumns = `id, event_id, tenant_id, reporter_id, reason, details, status, reviewed_by, reviewed_at, created_at`
+	moderationColumns  = `event_id, status, unpublished, note, reviewed_by, reviewed_at, created_at, updated_at`
+)
+
+// ReportRepository defines interface for event abuse reports and moderation holds
+type ReportRepository interface {
+	Create(ctx context.Context, report *entity.EventReport) error
+	GetByEventID(ctx context.Context, eventID string) ([]entity.EventReport, error)
+	GetOpenSummary(ctx context.Context, eventID string) (*entity.ReportSummary, error)
+	ListOpenSummaries(ctx context.Context, limit, offset int) ([]entity.ReportSummary, int64, error)
+
+	// Moderation holds
+	GetModeration(ctx context.Context, eventID string) (*entity.EventModeration, error)
+	GetModerations(ctx context.Context, eventIDs []string) (map[string]entity.EventModeration, error)
+	Hold(ctx context.Context, eventID string) (bool, error)
+	Dismiss(ctx context.Context, eventID, reviewerID string, note *string) (*entity.EventModeration, error)
+	Remove(ctx context.Context, eventID, reviewerID string, note *string) (*entity.EventModeration, error)
+}
+
+// reportRepository implements ReportRepository interface
+type reportRepository struct {
+	db *sql.DB
+}
+
+// NewReportRepository creates new report repository instance
+func NewReportRepository(db *sql.DB) ReportRepository {
+	return &reportRepository{db: db}
+}
+
+// Create inserts a new open report
+func (r *reportRepository) Create(ctx context.Context, report *entity.EventReport) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO event_reports (id, event_id, tenant_id, reporter_id, reason, details, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
+		RETURNING created_at
+	`
+
+	report.ID = uuid.New().String()
+	report.Status = entity.ReportStatusOpen
+
+	err := r.db.QueryRowContext(ctx, query,
+		report.ID,
+		report.EventID,
+		report.TenantID,
+		report.ReporterID,
+		report.Reason,
+		report.Details,
+		report.Status,
+	).Scan(&report.CreatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return ErrReportAlreadyOpen
+		}
+		return fmt.Errorf("failed to create event report: %w", err)
+	}
+
+	return nil
+}
+
+// GetByEventID retrieves all reports of an event, newest first
+func (r *reportRepository) GetByEventID(ctx context.Context, eventID string) ([]entity.EventReport, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + eventReportColumns + ` FROM event_reports WHERE event_id = $1` + tenantCondition(ctx, 2) + ` ORDER BY created_at DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, tenantArgs(ctx, eventID)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event reports: %w", err)
+	}
+	defer rows.Close()
+
+	reports := []entity.EventReport{}
+	for rows.Next() {
+		var report entity.EventReport
+		if err := rows.Scan(
+			&report.ID,
+			&report.EventID,
+			&report.TenantID,
+			&report.ReporterID,
+			&report.Reason,
+			&report.Details,
+			&report.Status,
+			&report.ReviewedBy,
+			&report.ReviewedAt,
+			&report.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan event report: %w", err)
+		}
+		reports = append(reports, report)
+	}
+
+	return reports, rows.Err()
+}
+
+// openSummaryQuery aggregates open reports per event
+const openSummaryQuery = `
+	SELECT event_id, COUNT(*), ARRAY_AGG(DISTINCT reason ORDER BY reason), MAX(created_at)
+	FROM event_reports
+	WHERE status = 'open'`
+
+// GetOpenSummary counts the open reports of an event; an event without open reports has a zero summary
+func (r *reportRepository) GetOpenSummary(ctx context.Context, eventID string) (*entity.ReportSummary, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := openSummaryQuery + ` AND event_id = $1` + tenantCondition(ctx, 2) + ` GROUP BY event_id`
+
+	summary, err := scanReportSummary(r.db.QueryRowContext(ctx, query, tenantArgs(ctx, eventID)...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &entity.ReportSummary{EventID: eventID, Reasons: []string{}}, nil
+		}
+		return nil, fmt.Errorf("failed to get report summary: %w", err)
+	}
+
+	return summary, nil
+}
+
+// ListOpenSummaries retrieves the moderation queue: events with open reports, most reported first
+func (r *reportRepository) ListOpenSummaries(ctx context.Context, limit, offset int) ([]entity.ReportSummary, int64, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	var total int64
+	countQuery := `SELECT COUNT(DISTINCT event_id) FROM event_reports WHERE status = 'open'` + tenantCondition(ctx, 1)
+	if err := r.db.QueryRowContext(ctx, countQuery, tenantArgs(ctx)...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count reported events: %w", err)
+	}
+
+	args := tenantArgs(ctx)
+	query := openSummaryQuery + tenantCondition(ctx, 1) + fmt.Sprintf(`
+		GROUP BY event_id
+		ORDER BY COUNT(*) DESC, MAX(created_at) DESC
+		LIMIT $%d OFFSET $%d`, len(args)+1, len(args)+2)
+
+	rows, err := r.db.QueryContext(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list reported events: %w", err)
+	}
+	defer rows.Close()
+
+	summaries := []entity.ReportSummary{}
+	for rows.Next() {
+		summary, err := scanReportSummary(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan report summary: %w", err)
+		}
+		summaries = append(summaries, *summary)
+	}
+
+	return summaries, total, rows.Err()
+}
+
+// GetModeration retrieves the moderation hold of an event, nil when the event isn't held
+func (r *reportRepository) GetModeration(ctx context.Context, eventID string) (*entity.EventModeration, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	moderation, err := getModeration(ctx, r.db, eventID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get event moderation: %w", err)
+	}
+
+	return moderation, nil
+}
+
+// GetModerations retrieves the moderation holds of the given events, keyed by event ID
+func (r *reportRepository) GetModerations(ctx context.Context, eventIDs []string) (map[string]entity.EventModeration, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	moderations := make(map[string]entity.EventModeration, len(eventIDs))
+	if len(eventIDs) == 0 {
+		return moderations, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+moderationColumns+`
+		FROM event_moderation
+		WHERE event_id = ANY($1)
+	`, pq.Array(eventIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event moderations: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		moderation, err := scanModeration(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan event moderation: %w", err)
+		}
+		moderations[moderation.EventID] = *moderation
+	}
+
+	return moderations, rows.Err()
+}
+
+// Hold puts an event under review and unpublishes it
+// Returns false when the event was already held, so only the first caller notifies the organizer
+func (r *reportRepository) Hold(ctx context.Context, eventID string) (bool, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO event_moderation (event_id, status, created_at, updated_at)
+		VALUES ($1, $2, NOW(), NOW())
+		ON CONFLICT (event_id) DO NOTHING
+	`, eventID, entity.ModerationPendingReview)
+	if err != nil {
+		return false, fmt.Errorf("failed to hold event: %w", err)
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return false, nil
+	}
+
+	unpublished, err := setEventStatus(ctx, tx, eventID, entity.StatusPublished, entity.StatusDraft)
+	if err != nil {
+		return false, err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE event_moderation SET unpublished = $1 WHERE event_id = $2`, unpublished, eventID); err != nil {
+		return false, fmt.Errorf("failed to hold event: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+// Dismiss closes the open reports of an event and lifts its hold,
+// republishing the event when the hold unpublished it
+// Returns the lifted hold, nil when the event wasn't held
+func (r *reportRepository) Dismiss(ctx context.Context, eventID, reviewerID string, note *string) (*entity.EventModeration, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := reviewOpenReports(ctx, tx, eventID, reviewerID, entity.ReportStatusDismissed); err != nil {
+		return nil, err
+	}
+
+	moderation, err := getModeration(ctx, tx, eventID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get event moderation: %w", err)
+	}
+	if moderation != nil {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM event_moderation WHERE event_id = $1`, eventID); err != nil {
+			return nil, fmt.Errorf("failed to lift event hold: %w", err)
+		}
+		if moderation.Unpublished {
+			if _, err := setEventStatus(ctx, tx, eventID, entity.StatusDraft, entity.StatusPublished); err != nil {
+				return nil, err
+			}
+		}
+		moderation.Note = note
+		moderation.ReviewedBy = &reviewerID
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return moderation, nil
+}
+
+// Remove upholds the open reports of an event and holds it as removed, unpublishing it
+func (r *reportRepository) Remove(ctx context.Context, eventID, reviewerID string, note *string) (*entity.EventModeration, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := reviewOpenReports(ctx, tx, eventID, reviewerID, entity.ReportStatusUpheld); err != nil {
+		return nil, err
+	}
+
+	unpublished, err := setEventStatus(ctx, tx, eventID, entity.StatusPublished, entity.StatusDraft)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO event_moderation (event_id, status, unpublished, note, reviewed_by, reviewed_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), NOW(), NOW())
+		ON CONFLICT (event_id) DO UPDATE
+		SET status = EXCLUDED.status, unpublished = event_moderation.unpublished OR EXCLUDED.unpublished,
+		    note = EXCLUDED.note, reviewed_by = EXCLUDED.reviewed_by, reviewed_at = NOW(), updated_at = NOW()
+	`, eventID, entity.ModerationRemoved, unpublished, note, reviewerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove event: %w", err)
+	}
+
+	moderation, err := getModeration(ctx, tx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event moderation: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return moderation, nil
+}
+
+// queryRower is satisfied by *sql.DB and *sql.Tx
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// getModeration retrieves the moderation hold of an event
+func getModeration(ctx context.Context, q queryRower, eventID string) (*entity.EventModeration, error) {
+	return scanModeration(q.QueryRowContext(ctx, `SELECT `+moderationColumns+` FROM event_moderation WHERE event_id = $1`, eventID))
+}
+
+// scanModeration scans a row of moderationColumns
+func scanModeration(row rowScanner) (*entity.EventModeration, error) {
+	var moderation entity.EventModeration
+	err := row.Scan(
+		&moderation.EventID,
+		&moderation.Status,
+		&moderation.Unpublished,
+		&moderation.Note,
+		&moderation.ReviewedBy,
+		&moderation.ReviewedAt,
+		&moderation.CreatedAt,
+		&moderation.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &moderation, nil
+}
+
+// reviewOpenReports closes the open reports of an event with status
+func reviewOpenReports(ctx context.Context, tx *sql.Tx, eventID, reviewerID, status string) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE event_reports
+		SET status = $1, reviewed_by = $2, reviewed_at = NOW()
+		WHERE event_id = $3 AND status = 'open'
+	`, status, reviewerID, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to review event reports: %w", err)
+	}
+	return nil
+}
+
+// setEventStatus moves an event from one status to another, bumping its version
+// Returns false when the event wasn't in the from status
+func setEventStatus(ctx context.Context, tx *sql.Tx, eventID, from, to string) (bool, error) {
+	result, err := tx.ExecContext(ctx, `
+		UPDATE events
+		SET status = $1, version = version + 1, updated_at = NOW()
+		WHERE id = $2 AND status = $3
+	`, to, eventID, from)
+	if err != nil {
+		return false, fmt.Errorf("failed to update event status: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to update event status: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// rowScanner is satisfied by *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanReportSummary scans a row of openSummaryQuery
+func scanReportSummary(row rowScanner) (*entity.ReportSummary, error) {
+	var summary entity.ReportSummary
+	var reasons pq.StringArray
+	if err := row.Scan(&summary.EventID, &summary.OpenReports, &reasons, &summary.LatestReportAt); err != nil {
+		return nil, err
+	}
+	summary.Reasons = []string(reasons)
+	return &summary, nil
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+// RevenueSplitRepository defines interface for revenue sharing between co-organizers of events
+type RevenueSplitRepository interface {
+	GetByEventID(ctx context.Context, eventID string) ([]entity.RevenueSplit, error)
+	SetByEventID(ctx context.Context, eventID string, splits []entity.RevenueSplit) error
+	ListShares(ctx context.Context, organizerID string) ([]entity.RevenueShare, error)
+}
+
+// revenueSplitRepository implements RevenueSplitRepository interface
+type revenueSplitRepository struct {
+	db *sql.DB
+}
+
+// NewRevenueSplitRepository creates new revenue split repository instance
+func NewRevenueSplitRepository(db *sql.DB) RevenueSplitRepository {
+	return &revenueSplitRepository{db: db}
+}
+
+// GetByEventID retrieves the splits of an event, largest share first
+func (r *revenueSplitRepository) GetByEventID(ctx context.Context, eventID string) ([]entity.RevenueSplit, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	return getRevenueSplits(ctx, r.db, eventID)
+}
+
+// SetByEventID replaces the splits of an event; no splits pays the event's organizer in full
+func (r *revenueSplitRepository) SetByEventID(ctx context.Context, eventID string, splits []entity.RevenueSplit) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM event_revenue_splits WHERE event_id = $1`, eventID); err != nil {
+		return fmt.Errorf("failed to clear revenue splits: %w", err)
+	}
+
+	if len(splits) > 0 {
+		organizerIDs := make([]string, len(splits))
+		percentages := make([]float64, len(splits))
+		for i, split := range splits {
+			organizerIDs[i] = split.OrganizerID
+			percentages[i] = split.Percentage
+		}
+
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO event_revenue_splits (event_id, organizer_id, percentage)
+			SELECT $1, organizer_id, percentage
+			FROM UNNEST($2::uuid[], $3::numeric[]) AS split(organizer_id, percentage)
+		`, eventID, pq.Array(organizerIDs), pq.Array(percentages))
+		if err != nil {
+			return fmt.Errorf("failed to set revenue splits: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ListShares retrieves the events of the request tenant an organizer is a party to, most recent first
+// Settled events show the recorded share, others the current split. Without splits the event's
+// organizer is the only party
+func (r *revenueSplitRepository) ListShares(ctx context.Context, organizerID string) ([]entity.RevenueShare, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT e.id, e.title, e.status, e.start_date, e.organizer_id = $1,
+		       COALESCE(sh.percentage, rs.percentage, 100), sh.payout_amount, s.payout_amount, s.calculated_at
+		FROM events e
+		LEFT JOIN event_revenue_splits rs ON rs.event_id = e.id AND rs.organizer_id = $1
+		LEFT JOIN event_settlements s ON s.event_id = e.id
+		LEFT JOIN event_settlement_shares sh ON sh.event_id = e.id AND sh.organizer_id = $1
+		WHERE (
+			sh.organizer_id IS NOT NULL
+			OR (s.event_id IS NULL AND rs.organizer_id IS NOT NULL)
+			OR (s.event_id IS NULL AND e.organizer_id = $1
+			    AND NOT EXISTS (SELECT 1 FROM event_revenue_splits x WHERE x.event_id = e.id))
+		)` + tenantCondition(ctx, 2) + `
+		ORDER BY e.start_date DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, tenantArgs(ctx, organizerID)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revenue shares: %w", err)
+	}
+	defer rows.Close()
+
+	shares := []entity.RevenueShare{}
+	for rows.Next() {
+		var share entity.RevenueShare
+		err := rows.Scan(
+			&share.EventID,
+			&share.EventTitle,
+			&share.EventStatus,
+			&share.StartDate,
+			&share.IsOwner,
+			&share.Percentage,
+			&share.PayoutAmount,
+			&share.SettlementPayout,
+			&share.SettledAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan revenue share: %w", err)
+		}
+		shares = append(shares, share)
+	}
+
+	return shares, rows.Err()
+}
+
+// revenueSplitQuerier is satisfied by *sql.DB and *sql.Tx
+type revenueSplitQuerier interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// getRevenueSplits retrieves the splits of an event with the parties' names, largest share first
+// so the event's rounding difference goes to the same party every time
+func getRevenueSplits(ctx context.Context, db revenueSplitQuerier, eventID string) ([]entity.RevenueSplit, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT rs.event_id, rs.organizer_id, rs.percentage, rs.created_at, u.full_name
+		FROM event_revenue_splits rs
+		JOIN users u ON u.id = rs.organizer_id
+		WHERE rs.event_id = $1
+		ORDER BY rs.percentage DESC, rs.created_at, rs.organizer_id
+	`, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get revenue splits: %w", err)
+	}
+	defer rows.Close()
+
+	splits := []entity.RevenueSplit{}
+	for rows.Next() {
+		var split entity.RevenueSplit
+		if err := rows.Scan(&split.EventID, &split.OrganizerID, &split.Percentage, &split.CreatedAt, &split.OrganizerName); err != nil {
+			return nil, fmt.Errorf("failed to scan revenue split: %w", err)
+		}
+		splits = append(splits, split)
+	}
+
+	return splits, rows.Err()
+}
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+// SettlementRepository defines interface for organizer settlements of completed events
+type SettlementRepository interface {
+	ListUnsettled(ctx context.Context, limit int) ([]string, error)
+	Calculate(ctx context.Context, eventID string) (*entity.EventSettlement, error)
+}
+
+// settlementRepository implements SettlementRepository interface
+type settlementRepository struct {
+	db *sql.DB
+}
+
+// NewSettlementRepository creates new settlement repository instance
+func NewSettlementRepository(db *sql.DB) SettlementRepository {
+	return &settlementRepository{db: db}
+}
+
+// ListUnsettled retrieves IDs of completed events without a settlement yet
+func (r *settlementRepository) ListUnsettled(ctx context.Context, limit int) ([]string, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT e.id
+		FROM events e
+		LEFT JOIN event_settlements s ON s.event_id = e.id
+		WHERE e.status = 'completed' AND s.event_id IS NULL
+		ORDER BY e.end_date
+		LIMIT $1
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unsettled events: %w", err)
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan event id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// Calculate records the settlement of a completed event from its paid orders, with the share of
+// each party under the event's revenue splits at that time
+// A settlement is calculated once; calculating it again returns the recorded one
+func (r *settlementRepository) Calculate(ctx context.Context, eventID string) (*entity.EventSettlement, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		WITH paid AS (
+			SELECT o.id, o.grand_total, o.total_amount, o.platform_fee, COALESCE(o.service_fee, 0) AS service_fee
+			FROM orders o
+			WHERE o.event_id = $1 AND o.status IN ('paid', 'completed') AND NOT o.is_sandbox
+		)
+		INSERT INTO event_settlements (
+			event_id, organizer_id, paid_orders, tickets_sold, gross_amount, fee_amount, payout_amount
+		)
+		SELECT e.id, e.organizer_id,
+		       (SELECT COUNT(*) FROM paid),
+		       (SELECT COALESCE(SUM(oi.quantity), 0) FROM order_items oi WHERE oi.order_id IN (SELECT id FROM paid)),
+		       (SELECT COALESCE(SUM(grand_total), 0) FROM paid),
+		       (SELECT COALESCE(SUM(platform_fee + service_fee), 0) FROM paid),
+		       (SELECT COALESCE(SUM(total_amount), 0) FROM paid)
+		FROM events e
+		WHERE e.id = $1
+		ON CONFLICT (event_id) DO NOTHING
+	`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate settlement: %w", err)
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate settlement: %w", err)
+	}
+
+	// Either inserted now or recorded earlier; a missing event inserts nothing
+	settlement := &entity.EventSettlement{}
+	err = tx.QueryRowContext(ctx, `
+		SELECT event_id, organizer_id, paid_orders, tickets_sold, gross_amount, fee_amount, payout_amount, calculated_at
+		FROM event_settlements
+		WHERE event_id = $1
+	`, eventID).Scan(
+		&settlement.EventID,
+		&settlement.OrganizerID,
+		&settlement.PaidOrders,
+		&settlement.TicketsSold,
+		&settlement.GrossAmount,
+		&settlement.FeeAmount,
+		&settlement.PayoutAmount,
+		&settlement.CalculatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, ErrEventNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get settlement: %w", err)
+	}
+
+	if inserted > 0 {
+		splits, err := getRevenueSplits(ctx, tx, eventID)
+		if err != nil {
+			return nil, err
+		}
+		for _, share := range entity.SplitPayout(settlement, splits) {
+			_, err := tx.ExecContext(ctx, `
+				INSERT INTO event_settlement_shares (event_id, organizer_id, percentage, payout_amount)
+				VALUES ($1, $2, $3, $4)
+			`, share.EventID, share.OrganizerID, share.Percentage, share.PayoutAmount)
+			if err != nil {
+				return nil, fmt.Errorf("failed to record settlement share: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return settlement, nil
+}
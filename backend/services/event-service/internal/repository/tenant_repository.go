@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+var ErrTenantNotFound = errors.New("tenant not found")
+
+// TenantRepository defines interface for reading tenant branding (tenants are managed by auth-service)
+type TenantRepository interface {
+	GetBranding(ctx context.Context, tenantID string) (*entity.TenantBranding, error)
+}
+
+// tenantRepository implements TenantRepository interface
+type tenantRepository struct {
+	db *sql.DB
+}
+
+// NewTenantRepository creates new tenant repository instance
+func NewTenantRepository(db *sql.DB) TenantRepository {
+	return &tenantRepository{db: db}
+}
+
+// GetBranding retrieves the name and primary color of a tenant
+func (r *tenantRepository) GetBranding(ctx context.Context, tenantID string) (*entity.TenantBranding, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	var branding entity.TenantBranding
+	err := r.db.QueryRowContext(ctx, `SELECT name, primary_color FROM tenants WHERE id = $1`, tenantID).
+		Scan(&branding.Name, &branding.PrimaryColor)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTenantNotFound
+		}
+		return nil, fmt.Errorf("failed to get tenant branding: %w", err)
+	}
+
+	return &branding, nil
+}
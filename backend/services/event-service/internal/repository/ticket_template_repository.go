@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+// ErrTicketTemplateNotFound is returned when an organizer has no ticket template
+var ErrTicketTemplateNotFound = errors.New("ticket template not found")
+
+const ticketTemplateColumns = `organizer_id, tenant_id, logo_url, primary_color, accent_color, sponsor_footer,
+		       terms_text, created_at, updated_at`
+
+// TicketTemplateRepository defines interface for organizers' e-ticket templates
+type TicketTemplateRepository interface {
+	Get(ctx context.Context, organizerID string) (*entity.OrganizerTicketTemplate, error)
+	Save(ctx context.Context, tpl *entity.OrganizerTicketTemplate) error
+	Delete(ctx context.Context, organizerID string) error
+}
+
+// ticketTemplateRepository implements TicketTemplateRepository interface
+type ticketTemplateRepository struct {
+	db *sql.DB
+}
+
+// NewTicketTemplateRepository creates new ticket template repository instance
+func NewTicketTemplateRepository(db *sql.DB) TicketTemplateRepository {
+	return &ticketTemplateRepository{db: db}
+}
+
+// Get retrieves the ticket template of an organizer
+func (r *ticketTemplateRepository) Get(ctx context.Context, organizerID string) (*entity.OrganizerTicketTemplate, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + ticketTemplateColumns + ` FROM organizer_ticket_templates WHERE organizer_id = $1`
+
+	var tpl entity.OrganizerTicketTemplate
+	err := r.db.QueryRowContext(ctx, query, organizerID).Scan(
+		&tpl.OrganizerID,
+		&tpl.TenantID,
+		&tpl.LogoURL,
+		&tpl.PrimaryColor,
+		&tpl.AccentColor,
+		&tpl.SponsorFooter,
+		&tpl.TermsText,
+		&tpl.CreatedAt,
+		&tpl.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTicketTemplateNotFound
+		}
+		return nil, fmt.Errorf("failed to get ticket template: %w", err)
+	}
+
+	return &tpl, nil
+}
+
+// Save creates or replaces the ticket template of an organizer in the tenant of ctx
+func (r *ticketTemplateRepository) Save(ctx context.Context, tpl *entity.OrganizerTicketTemplate) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO organizer_ticket_templates (organizer_id, tenant_id, logo_url, primary_color, accent_color,
+		                                        sponsor_footer, terms_text, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		ON CONFLICT (organizer_id) DO UPDATE
+		SET logo_url = EXCLUDED.logo_url, primary_color = EXCLUDED.primary_color, accent_color = EXCLUDED.accent_color,
+		    sponsor_footer = EXCLUDED.sponsor_footer, terms_text = EXCLUDED.terms_text, updated_at = NOW()
+		RETURNING created_at, updated_at
+	`
+
+	tpl.TenantID = tenantOrDefault(ctx)
+
+	err := r.db.QueryRowContext(ctx, query,
+		tpl.OrganizerID,
+		tpl.TenantID,
+		tpl.LogoURL,
+		tpl.PrimaryColor,
+		tpl.AccentColor,
+		tpl.SponsorFooter,
+		tpl.TermsText,
+	).Scan(&tpl.CreatedAt, &tpl.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save ticket template: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the ticket template of an organizer, its tickets use the platform layout again
+func (r *ticketTemplateRepository) Delete(ctx context.Context, organizerID string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	result, err := r.db.ExecContext(ctx, `DELETE FROM organizer_ticket_templates WHERE organizer_id = $1`, organizerID)
+	if err != nil {
+		return fmt.Errorf("failed to delete ticket template: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return ErrTicketTemplateNotFound
+	}
+
+	return nil
+}
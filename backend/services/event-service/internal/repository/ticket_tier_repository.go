@@ -7,23 +7,38 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
 )
 
 var (
-	ErrTicketTierNotFound = errors.New("ticket tier not found")
-	ErrInsufficientQuota  = errors.New("insufficient ticket quota")
+	ErrTicketTierNotFound            = errors.New("ticket tier not found")
+	ErrInsufficientQuota             = errors.New("insufficient ticket quota")
+	ErrTicketTierVersionConflict     = errors.New("ticket tier was modified by another request")
+	ErrTicketTierHasOrders           = errors.New("ticket tier has orders")
+	ErrTicketTierQuotaBelowSold      = errors.New("quota is below sold count")
+	ErrTicketTierAccessibleBelowSold = errors.New("accessible quota is below accessible sold count")
 )
 
 // TicketTierRepository defines interface for ticket tier data operations
 type TicketTierRepository interface {
 	Create(ctx context.Context, tier *entity.TicketTier) error
 	GetByID(ctx context.Context, id string) (*entity.TicketTier, error)
+	GetByIDs(ctx context.Context, ids []string) ([]entity.TicketTier, error)
 	GetByEventID(ctx context.Context, eventID string) ([]entity.TicketTier, error)
 	Update(ctx context.Context, tier *entity.TicketTier) error
 	Delete(ctx context.Context, id string) error
+	Archive(ctx context.Context, id string) error
+	HasOrders(ctx context.Context, id string) (bool, error)
 	CheckAvailability(ctx context.Context, tierID string, quantity int) (bool, error)
 	UpdateSoldCount(ctx context.Context, tierID string, quantity int) error
+	RefreshAvailability(ctx context.Context) error
+	ListDynamicPricing(ctx context.Context, afterID string, limit int) ([]entity.DynamicTier, error)
+	UpdateDynamicPrice(ctx context.Context, tier *entity.TicketTier, price money.Money) error
+	RecordPrice(ctx context.Context, tier *entity.TicketTier, reason string) error
+	GetPriceHistory(ctx context.Context, tierID string, limit int) ([]entity.TicketTierPrice, error)
 }
 
 // ticketTierRepository implements TicketTierRepository interface
@@ -38,11 +53,15 @@ func NewTicketTierRepository(db *sql.DB) TicketTierRepository {
 
 // Create inserts new ticket tier into database
 func (r *ticketTierRepository) Create(ctx context.Context, tier *entity.TicketTier) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		INSERT INTO ticket_tiers (id, event_id, name, description, price, quota, sold_count,
-		                         max_per_order, early_bird_price, early_bird_end_date, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW(), NOW())
-		RETURNING id, created_at, updated_at
+		                         max_per_order, early_bird_price, early_bird_end_date, base_price,
+		                         dynamic_pricing, accessible_quota, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, NOW(), NOW())
+		RETURNING id, version, created_at, updated_at
 	`
 
 	tier.ID = uuid.New().String()
@@ -61,7 +80,10 @@ func (r *ticketTierRepository) Create(ctx context.Context, tier *entity.TicketTi
 		tier.MaxPerOrder,
 		tier.EarlyBirdPrice,
 		tier.EarlyBirdEndDate,
-	).Scan(&tier.ID, &tier.CreatedAt, &tier.UpdatedAt)
+		tier.BasePrice,
+		tier.DynamicPricing,
+		tier.AccessibleQuota,
+	).Scan(&tier.ID, &tier.Version, &tier.CreatedAt, &tier.UpdatedAt)
 
 	if err != nil {
 		return fmt.Errorf("failed to create ticket tier: %w", err)
@@ -72,9 +94,13 @@ func (r *ticketTierRepository) Create(ctx context.Context, tier *entity.TicketTi
 
 // GetByID retrieves ticket tier by ID
 func (r *ticketTierRepository) GetByID(ctx context.Context, id string) (*entity.TicketTier, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT id, event_id, name, description, price, quota, sold_count, max_per_order,
-		       early_bird_price, early_bird_end_date, created_at, updated_at
+		       early_bird_price, early_bird_end_date, base_price, dynamic_pricing, version,
+		       archived_at, accessible_quota, accessible_sold_count, created_at, updated_at
 		FROM ticket_tiers
 		WHERE id = $1
 	`
@@ -91,6 +117,12 @@ func (r *ticketTierRepository) GetByID(ctx context.Context, id string) (*entity.
 		&tier.MaxPerOrder,
 		&tier.EarlyBirdPrice,
 		&tier.EarlyBirdEndDate,
+		&tier.BasePrice,
+		&tier.DynamicPricing,
+		&tier.Version,
+		&tier.ArchivedAt,
+		&tier.AccessibleQuota,
+		&tier.AccessibleSoldCount,
 		&tier.CreatedAt,
 		&tier.UpdatedAt,
 	)
@@ -106,11 +138,71 @@ func (r *ticketTierRepository) GetByID(ctx context.Context, id string) (*entity.
 	return tier, nil
 }
 
+// GetByIDs retrieves ticket tiers by IDs in a single query
+// Missing IDs are skipped rather than reported as errors
+func (r *ticketTierRepository) GetByIDs(ctx context.Context, ids []string) ([]entity.TicketTier, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	tiers := []entity.TicketTier{}
+	if len(ids) == 0 {
+		return tiers, nil
+	}
+
+	query := `
+		SELECT id, event_id, name, description, price, quota, sold_count, max_per_order,
+		       early_bird_price, early_bird_end_date, base_price, dynamic_pricing, version,
+		       archived_at, accessible_quota, accessible_sold_count, created_at, updated_at
+		FROM ticket_tiers
+		WHERE id = ANY($1)
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket tiers: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tier entity.TicketTier
+		err := rows.Scan(
+			&tier.ID,
+			&tier.EventID,
+			&tier.Name,
+			&tier.Description,
+			&tier.Price,
+			&tier.Quota,
+			&tier.SoldCount,
+			&tier.MaxPerOrder,
+			&tier.EarlyBirdPrice,
+			&tier.EarlyBirdEndDate,
+			&tier.BasePrice,
+			&tier.DynamicPricing,
+			&tier.Version,
+			&tier.ArchivedAt,
+			&tier.AccessibleQuota,
+			&tier.AccessibleSoldCount,
+			&tier.CreatedAt,
+			&tier.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan ticket tier: %w", err)
+		}
+		tiers = append(tiers, tier)
+	}
+
+	return tiers, rows.Err()
+}
+
 // GetByEventID retrieves all ticket tiers for an event
 func (r *ticketTierRepository) GetByEventID(ctx context.Context, eventID string) ([]entity.TicketTier, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT id, event_id, name, description, price, quota, sold_count, max_per_order,
-		       early_bird_price, early_bird_end_date, created_at, updated_at
+		       early_bird_price, early_bird_end_date, base_price, dynamic_pricing, version,
+		       archived_at, accessible_quota, accessible_sold_count, created_at, updated_at
 		FROM ticket_tiers
 		WHERE event_id = $1
 		ORDER BY price ASC
@@ -136,6 +228,12 @@ func (r *ticketTierRepository) GetByEventID(ctx context.Context, eventID string)
 			&tier.MaxPerOrder,
 			&tier.EarlyBirdPrice,
 			&tier.EarlyBirdEndDate,
+			&tier.BasePrice,
+			&tier.DynamicPricing,
+			&tier.Version,
+			&tier.ArchivedAt,
+			&tier.AccessibleQuota,
+			&tier.AccessibleSoldCount,
 			&tier.CreatedAt,
 			&tier.UpdatedAt,
 		)
@@ -148,16 +246,24 @@ func (r *ticketTierRepository) GetByEventID(ctx context.Context, eventID string)
 	return tiers, nil
 }
 
-// Update updates ticket tier information
+// Update updates ticket tier information using optimistic concurrency
+// tier.Version must hold the version the caller read; on success it is set to the new version
+// The quota is checked against sold_count in the same statement: reservations bump sold_count
+// without touching version, so a check on the caller's read alone can race with them
 func (r *ticketTierRepository) Update(ctx context.Context, tier *entity.TicketTier) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		UPDATE ticket_tiers
 		SET name = $1, description = $2, price = $3, quota = $4, max_per_order = $5,
-		    early_bird_price = $6, early_bird_end_date = $7, updated_at = NOW()
-		WHERE id = $8
+		    early_bird_price = $6, early_bird_end_date = $7, base_price = $8, dynamic_pricing = $9,
+		    accessible_quota = $12, version = version + 1, updated_at = NOW()
+		WHERE id = $10 AND version = $11 AND sold_count <= $4 AND accessible_sold_count <= $12
+		RETURNING version, updated_at
 	`
 
-	result, err := r.db.ExecContext(
+	err := r.db.QueryRowContext(
 		ctx,
 		query,
 		tier.Name,
@@ -167,13 +273,51 @@ func (r *ticketTierRepository) Update(ctx context.Context, tier *entity.TicketTi
 		tier.MaxPerOrder,
 		tier.EarlyBirdPrice,
 		tier.EarlyBirdEndDate,
+		tier.BasePrice,
+		tier.DynamicPricing,
 		tier.ID,
-	)
+		tier.Version,
+		tier.AccessibleQuota,
+	).Scan(&tier.Version, &tier.UpdatedAt)
+
+	if err == sql.ErrNoRows {
+		// Ticket tier not found, version mismatch, or sold counts grew past the new quotas
+		current, getErr := r.GetByID(ctx, tier.ID)
+		if getErr != nil {
+			return getErr
+		}
+		if current.Version == tier.Version && current.SoldCount > tier.Quota {
+			return ErrTicketTierQuotaBelowSold
+		}
+		if current.Version == tier.Version && current.AccessibleSoldCount > tier.AccessibleQuota {
+			return ErrTicketTierAccessibleBelowSold
+		}
+		return ErrTicketTierVersionConflict
+	}
 
 	if err != nil {
 		return fmt.Errorf("failed to update ticket tier: %w", err)
 	}
 
+	return nil
+}
+
+// Delete removes ticket tier from database
+func (r *ticketTierRepository) Delete(ctx context.Context, id string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `DELETE FROM ticket_tiers WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		// order_items and tickets reference the tier without ON DELETE CASCADE
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+			return ErrTicketTierHasOrders
+		}
+		return fmt.Errorf("failed to delete ticket tier: %w", err)
+	}
+
 	rows, err := result.RowsAffected()
 	if err != nil {
 		return err
@@ -186,13 +330,20 @@ func (r *ticketTierRepository) Update(ctx context.Context, tier *entity.TicketTi
 	return nil
 }
 
-// Delete removes ticket tier from database
-func (r *ticketTierRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM ticket_tiers WHERE id = $1`
+// Archive hides ticket tier from sale; archiving an archived tier keeps the original time
+func (r *ticketTierRepository) Archive(ctx context.Context, id string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE ticket_tiers
+		SET archived_at = COALESCE(archived_at, NOW()), version = version + 1, updated_at = NOW()
+		WHERE id = $1
+	`
 
 	result, err := r.db.ExecContext(ctx, query, id)
 	if err != nil {
-		return fmt.Errorf("failed to delete ticket tier: %w", err)
+		return fmt.Errorf("failed to archive ticket tier: %w", err)
 	}
 
 	rows, err := result.RowsAffected()
@@ -207,8 +358,26 @@ func (r *ticketTierRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// HasOrders checks if any order item references the ticket tier, whatever the order status
+func (r *ticketTierRepository) HasOrders(ctx context.Context, id string) (bool, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	var exists bool
+	query := `SELECT EXISTS (SELECT 1 FROM order_items WHERE ticket_tier_id = $1)`
+
+	if err := r.db.QueryRowContext(ctx, query, id).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check ticket tier orders: %w", err)
+	}
+
+	return exists, nil
+}
+
 // CheckAvailability checks if requested quantity is available for a ticket tier
 func (r *ticketTierRepository) CheckAvailability(ctx context.Context, tierID string, quantity int) (bool, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT (quota - sold_count) >= $1 as available
 		FROM ticket_tiers
@@ -232,6 +401,9 @@ func (r *ticketTierRepository) CheckAvailability(ctx context.Context, tierID str
 // UpdateSoldCount increments sold count for a ticket tier
 // This should be called within a transaction from the service layer
 func (r *ticketTierRepository) UpdateSoldCount(ctx context.Context, tierID string, quantity int) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		UPDATE ticket_tiers
 		SET sold_count = sold_count + $1, updated_at = NOW()
@@ -265,3 +437,175 @@ func (r *ticketTierRepository) UpdateSoldCount(ctx context.Context, tierID strin
 
 	return nil
 }
+
+// RefreshAvailability rebuilds the event_availability summary used by listing filters
+// CONCURRENTLY keeps the view readable during refresh (requires its unique index)
+func (r *ticketTierRepository) RefreshAvailability(ctx context.Context) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	if _, err := r.db.ExecContext(ctx, "REFRESH MATERIALIZED VIEW CONCURRENTLY event_availability"); err != nil {
+		return fmt.Errorf("failed to refresh event availability: %w", err)
+	}
+	return nil
+}
+
+// ListDynamicPricing retrieves up to limit tiers under dynamic pricing with an ID greater than afterID
+// Only tiers on sale are listed: not archived, of published events that haven't ended
+func (r *ticketTierRepository) ListDynamicPricing(ctx context.Context, afterID string, limit int) ([]entity.DynamicTier, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT t.id, t.event_id, t.name, t.price, t.quota, t.sold_count, t.base_price,
+		       t.dynamic_pricing, t.version, e.slug, e.start_date
+		FROM ticket_tiers t
+		JOIN events e ON e.id = t.event_id
+		WHERE t.dynamic_pricing IS NOT NULL AND t.archived_at IS NULL
+		  AND e.status = 'published' AND e.end_date > NOW()
+		  AND t.id > $1
+		ORDER BY t.id
+		LIMIT $2
+	`
+
+	// UUIDs compare greater than the nil UUID, so it starts the first page
+	if afterID == "" {
+		afterID = uuid.Nil.String()
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dynamic pricing tiers: %w", err)
+	}
+	defer rows.Close()
+
+	tiers := []entity.DynamicTier{}
+	for rows.Next() {
+		var tier entity.DynamicTier
+		err := rows.Scan(
+			&tier.ID,
+			&tier.EventID,
+			&tier.Name,
+			&tier.Price,
+			&tier.Quota,
+			&tier.SoldCount,
+			&tier.BasePrice,
+			&tier.DynamicPricing,
+			&tier.Version,
+			&tier.EventSlug,
+			&tier.EventStartDate,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan dynamic pricing tier: %w", err)
+		}
+		tiers = append(tiers, tier)
+	}
+
+	return tiers, rows.Err()
+}
+
+// UpdateDynamicPrice sets the price computed by the tier's dynamic rule and records it in the price history
+// tier.Version must hold the version the rule was read at; an organizer edit since then wins and
+// ErrTicketTierVersionConflict is returned. The version isn't bumped so repricing never
+// conflicts with organizers editing the tier.
+func (r *ticketTierRepository) UpdateDynamicPrice(ctx context.Context, tier *entity.TicketTier, price money.Money) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		UPDATE ticket_tiers
+		SET price = $1, updated_at = NOW()
+		WHERE id = $2 AND version = $3 AND dynamic_pricing IS NOT NULL
+		RETURNING sold_count, updated_at
+	`, price, tier.ID, tier.Version).Scan(&tier.SoldCount, &tier.UpdatedAt)
+
+	if err == sql.ErrNoRows {
+		return ErrTicketTierVersionConflict
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to update dynamic price: %w", err)
+	}
+
+	tier.Price = price
+	if err := recordPrice(ctx, tx, tier, entity.PriceReasonDynamic); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// RecordPrice adds the tier's current price to its price history
+func (r *ticketTierRepository) RecordPrice(ctx context.Context, tier *entity.TicketTier, reason string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	return recordPrice(ctx, r.db, tier, reason)
+}
+
+// execer is satisfied by *sql.DB and *sql.Tx
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+func recordPrice(ctx context.Context, db execer, tier *entity.TicketTier, reason string) error {
+	query := `
+		INSERT INTO ticket_tier_price_history (ticket_tier_id, price, base_price, sold_count, reason)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	if _, err := db.ExecContext(ctx, query, tier.ID, tier.Price, tier.BasePrice, tier.SoldCount, reason); err != nil {
+		return fmt.Errorf("failed to record ticket tier price: %w", err)
+	}
+	return nil
+}
+
+// GetPriceHistory retrieves the latest limit prices of a ticket tier, newest first
+func (r *ticketTierRepository) GetPriceHistory(ctx context.Context, tierID string, limit int) ([]entity.TicketTierPrice, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, ticket_tier_id, price, base_price, sold_count, reason, created_at
+		FROM ticket_tier_price_history
+		WHERE ticket_tier_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, tierID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket tier price history: %w", err)
+	}
+	defer rows.Close()
+
+	prices := []entity.TicketTierPrice{}
+	for rows.Next() {
+		var price entity.TicketTierPrice
+		err := rows.Scan(
+			&price.ID,
+			&price.TicketTierID,
+			&price.Price,
+			&price.BasePrice,
+			&price.SoldCount,
+			&price.Reason,
+			&price.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan ticket tier price: %w", err)
+		}
+		prices = append(prices, price)
+	}
+
+	return prices, rows.Err()
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+var ErrUserNotFound = errors.New("user not found")
+
+// UserRepository defines interface for reading users (users are managed by auth-service)
+type UserRepository interface {
+	GetByID(ctx context.Context, id string) (*entity.User, error)
+}
+
+// userRepository implements UserRepository interface
+type userRepository struct {
+	db *sql.DB
+}
+
+// NewUserRepository creates new user repository instance
+func NewUserRepository(db *sql.DB) UserRepository {
+	return &userRepository{db: db}
+}
+
+// GetByID retrieves the contact details and role of a user
+func (r *userRepository) GetByID(ctx context.Context, id string) (*entity.User, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	var user entity.User
+	err := r.db.QueryRowContext(ctx, `SELECT id, email, full_name, role FROM users WHERE id = $1`, id).
+		Scan(&user.ID, &user.Email, &user.FullName, &user.Role)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return &user, nil
+}
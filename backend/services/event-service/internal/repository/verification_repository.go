@@ -0,0 +1,255 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+var (
+	ErrVerificationNotFound = errors.New("verification not found")
+	ErrVerificationActive   = errors.New("organizer already has a pending or approved verification")
+	ErrVerificationReviewed = errors.New("verification is no longer pending")
+)
+
+const verificationColumns = `id, organizer_id, tenant_id, legal_name, document_type, document_number, document_url,
+		       status, review_note, reviewed_by, reviewed_at, created_at`
+
+// VerificationRepository defines interface for organizer verification submissions
+type VerificationRepository interface {
+	Create(ctx context.Context, verification *entity.OrganizerVerification) error
+	GetByID(ctx context.Context, id string) (*entity.OrganizerVerification, error)
+	GetLatest(ctx context.Context, organizerID string) (*entity.OrganizerVerification, error)
+	List(ctx context.Context, status string, limit, offset int) ([]entity.OrganizerVerification, int64, error)
+	Review(ctx context.Context, id, status, reviewerID string, note *string) (*entity.OrganizerVerification, error)
+	Revoke(ctx context.Context, organizerID, reviewerID string, note *string) (*entity.OrganizerVerification, error)
+
+	// Verified organizers, keyed by organizer ID with the approval time
+	GetVerified(ctx context.Context, organizerIDs []string) (map[string]time.Time, error)
+}
+
+// verificationRepository implements VerificationRepository interface
+type verificationRepository struct {
+	db *sql.DB
+}
+
+// NewVerificationRepository creates new verification repository instance
+func NewVerificationRepository(db *sql.DB) VerificationRepository {
+	return &verificationRepository{db: db}
+}
+
+// Create inserts a new pending submission in the tenant of ctx
+func (r *verificationRepository) Create(ctx context.Context, verification *entity.OrganizerVerification) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO organizer_verifications (id, organizer_id, tenant_id, legal_name, document_type, document_number, document_url, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+		RETURNING created_at
+	`
+
+	verification.ID = uuid.New().String()
+	verification.TenantID = tenantOrDefault(ctx)
+	verification.Status = entity.VerificationPending
+
+	err := r.db.QueryRowContext(ctx, query,
+		verification.ID,
+		verification.OrganizerID,
+		verification.TenantID,
+		verification.LegalName,
+		verification.DocumentType,
+		verification.DocumentNumber,
+		verification.DocumentURL,
+		verification.Status,
+	).Scan(&verification.CreatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return ErrVerificationActive
+		}
+		return fmt.Errorf("failed to create verification: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a submission by ID
+func (r *verificationRepository) GetByID(ctx context.Context, id string) (*entity.OrganizerVerification, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + verificationColumns + ` FROM organizer_verifications WHERE id = $1` + tenantCondition(ctx, 2)
+
+	verification, err := scanVerification(r.db.QueryRowContext(ctx, query, tenantArgs(ctx, id)...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrVerificationNotFound
+		}
+		return nil, fmt.Errorf("failed to get verification: %w", err)
+	}
+
+	return verification, nil
+}
+
+// GetLatest retrieves the latest submission of an organizer
+func (r *verificationRepository) GetLatest(ctx context.Context, organizerID string) (*entity.OrganizerVerification, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + verificationColumns + `
+		FROM organizer_verifications
+		WHERE organizer_id = $1
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+
+	verification, err := scanVerification(r.db.QueryRowContext(ctx, query, organizerID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrVerificationNotFound
+		}
+		return nil, fmt.Errorf("failed to get verification: %w", err)
+	}
+
+	return verification, nil
+}
+
+// List retrieves the submissions with a status, oldest first so the review queue is first come first served
+func (r *verificationRepository) List(ctx context.Context, status string, limit, offset int) ([]entity.OrganizerVerification, int64, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	var total int64
+	countQuery := `SELECT COUNT(*) FROM organizer_verifications WHERE status = $1` + tenantCondition(ctx, 2)
+	if err := r.db.QueryRowContext(ctx, countQuery, tenantArgs(ctx, status)...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count verifications: %w", err)
+	}
+
+	args := tenantArgs(ctx, status)
+	query := `SELECT ` + verificationColumns + ` FROM organizer_verifications WHERE status = $1` + tenantCondition(ctx, 2) +
+		fmt.Sprintf(` ORDER BY created_at ASC LIMIT $%d OFFSET $%d`, len(args)+1, len(args)+2)
+
+	rows, err := r.db.QueryContext(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list verifications: %w", err)
+	}
+	defer rows.Close()
+
+	verifications := []entity.OrganizerVerification{}
+	for rows.Next() {
+		verification, err := scanVerification(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan verification: %w", err)
+		}
+		verifications = append(verifications, *verification)
+	}
+
+	return verifications, total, rows.Err()
+}
+
+// Review approves or rejects a pending submission
+func (r *verificationRepository) Review(ctx context.Context, id, status, reviewerID string, note *string) (*entity.OrganizerVerification, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE organizer_verifications
+		SET status = $1, review_note = $2, reviewed_by = $3, reviewed_at = NOW()
+		WHERE id = $4 AND status = 'pending'` + tenantCondition(ctx, 5) + `
+		RETURNING ` + verificationColumns
+
+	verification, err := scanVerification(r.db.QueryRowContext(ctx, query, tenantArgs(ctx, status, note, reviewerID, id)...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrVerificationReviewed
+		}
+		return nil, fmt.Errorf("failed to review verification: %w", err)
+	}
+
+	return verification, nil
+}
+
+// Revoke withdraws the approved submission of an organizer
+func (r *verificationRepository) Revoke(ctx context.Context, organizerID, reviewerID string, note *string) (*entity.OrganizerVerification, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE organizer_verifications
+		SET status = 'revoked', review_note = $1, reviewed_by = $2, reviewed_at = NOW()
+		WHERE organizer_id = $3 AND status = 'approved'` + tenantCondition(ctx, 4) + `
+		RETURNING ` + verificationColumns
+
+	verification, err := scanVerification(r.db.QueryRowContext(ctx, query, tenantArgs(ctx, note, reviewerID, organizerID)...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrVerificationNotFound
+		}
+		return nil, fmt.Errorf("failed to revoke verification: %w", err)
+	}
+
+	return verification, nil
+}
+
+// GetVerified retrieves which of the given organizers are verified and since when
+func (r *verificationRepository) GetVerified(ctx context.Context, organizerIDs []string) (map[string]time.Time, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	verified := make(map[string]time.Time, len(organizerIDs))
+	if len(organizerIDs) == 0 {
+		return verified, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT organizer_id, reviewed_at
+		FROM organizer_verifications
+		WHERE organizer_id = ANY($1) AND status = 'approved'
+	`, pq.Array(organizerIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get verified organizers: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var organizerID string
+		var verifiedAt time.Time
+		if err := rows.Scan(&organizerID, &verifiedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan verified organizer: %w", err)
+		}
+		verified[organizerID] = verifiedAt
+	}
+
+	return verified, rows.Err()
+}
+
+// scanVerification scans a row of verificationColumns
+func scanVerification(row rowScanner) (*entity.OrganizerVerification, error) {
+	var verification entity.OrganizerVerification
+	err := row.Scan(
+		&verification.ID,
+		&verification.OrganizerID,
+		&verification.TenantID,
+		&verification.LegalName,
+		&verification.DocumentType,
+		&verification.DocumentNumber,
+		&verification.DocumentURL,
+		&verification.Status,
+		&verification.ReviewNote,
+		&verification.ReviewedBy,
+		&verification.ReviewedAt,
+		&verification.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &verification, nil
+}
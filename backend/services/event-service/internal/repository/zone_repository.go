@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+)
+
+var (
+	ErrZoneNotFound   = errors.New("zone not found")
+	ErrZoneCodeExists = errors.New("zone code already exists for this event")
+)
+
+// ZoneRepository defines interface for event zone data operations
+type ZoneRepository interface {
+	Create(ctx context.Context, zone *entity.EventZone) error
+	GetByEventID(ctx context.Context, eventID string) ([]entity.EventZone, error)
+	Delete(ctx context.Context, eventID, zoneID string) error
+	SetTierZones(ctx context.Context, tierID string, zoneIDs []string) error
+}
+
+// zoneRepository implements ZoneRepository interface
+type zoneRepository struct {
+	db *sql.DB
+}
+
+// NewZoneRepository creates new zone repository instance
+func NewZoneRepository(db *sql.DB) ZoneRepository {
+	return &zoneRepository{db: db}
+}
+
+// Create inserts new zone for an event
+func (r *zoneRepository) Create(ctx context.Context, zone *entity.EventZone) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO event_zones (id, event_id, code, name, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		RETURNING created_at
+	`
+
+	zone.ID = uuid.New().String()
+
+	err := r.db.QueryRowContext(ctx, query, zone.ID, zone.EventID, zone.Code, zone.Name).Scan(&zone.CreatedAt)
+	if err != nil {
+		if strings.Contains(err.Error(), "event_zones_event_id_code_key") {
+			return ErrZoneCodeExists
+		}
+		return fmt.Errorf("failed to create zone: %w", err)
+	}
+
+	zone.TicketTierIDs = []string{}
+	return nil
+}
+
+// GetByEventID retrieves all zones of an event with the ticket tiers mapped to them
+func (r *zoneRepository) GetByEventID(ctx context.Context, eventID string) ([]entity.EventZone, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT z.id, z.event_id, z.code, z.name, z.created_at,
+		       COALESCE(array_agg(tz.ticket_tier_id::text) FILTER (WHERE tz.ticket_tier_id IS NOT NULL), '{}')
+		FROM event_zones z
+		LEFT JOIN ticket_tier_zones tz ON tz.zone_id = z.id
+		WHERE z.event_id = $1
+		GROUP BY z.id
+		ORDER BY z.code ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get zones by event: %w", err)
+	}
+	defer rows.Close()
+
+	zones := []entity.EventZone{}
+	for rows.Next() {
+		var zone entity.EventZone
+		err := rows.Scan(
+			&zone.ID,
+			&zone.EventID,
+			&zone.Code,
+			&zone.Name,
+			&zone.CreatedAt,
+			pq.Array(&zone.TicketTierIDs),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan zone: %w", err)
+		}
+		zones = append(zones, zone)
+	}
+
+	return zones, nil
+}
+
+// Delete removes a zone of an event; tier mappings are removed by cascade
+func (r *zoneRepository) Delete(ctx context.Context, eventID, zoneID string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `DELETE FROM event_zones WHERE id = $1 AND event_id = $2`
+
+	result, err := r.db.ExecContext(ctx, query, zoneID, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to delete zone: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrZoneNotFound
+	}
+
+	return nil
+}
+
+// SetTierZones replaces the zones a ticket tier may enter
+// An empty list removes all restrictions for the tier
+func (r *zoneRepository) SetTierZones(ctx context.Context, tierID string, zoneIDs []string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM ticket_tier_zones WHERE ticket_tier_id = $1`, tierID); err != nil {
+		return fmt.Errorf("failed to clear tier zones: %w", err)
+	}
+
+	if len(zoneIDs) > 0 {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO ticket_tier_zones (ticket_tier_id, zone_id)
+			SELECT $1, UNNEST($2::uuid[])
+		`, tierID, pq.Array(zoneIDs))
+		if err != nil {
+			return fmt.Errorf("failed to set tier zones: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -2,13 +2,17 @@ package router
 
 import (
 	"github.com/gin-gonic/gin"
+	sharedauth "github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/buildinfo"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/controller"
-	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/middleware"
 )
 
 // SetupRouter configures all routes
-func SetupRouter(eventController *controller.EventController, jwtSecret string) *gin.Engine {
-	r := gin.Default()
+func SetupRouter(eventController *controller.EventController, planController *controller.PlanController, performerController *controller.PerformerController, seoController *controller.SEOController, moderationController *controller.ModerationController, verificationController *controller.VerificationController, revenueSplitController *controller.RevenueSplitController, emailSenderController *controller.EmailSenderController, ticketTemplateController *controller.TicketTemplateController, analyticsController *controller.AnalyticsController, keys *sharedauth.KeySet) *gin.Engine {
+	r := gin.New()
+	r.Use(gin.Logger(), errreport.GinRecovery()) // Panics are reported to Sentry
 
 	// Health check
 	r.GET("/health", func(c *gin.Context) {
@@ -18,8 +22,13 @@ func SetupRouter(eventController *controller.EventController, jwtSecret string)
 		})
 	})
 
+	// Build info (version, git SHA, build time)
+	r.GET("/version", buildinfo.Handler("event-service"))
+
 	// API v1 routes
+	// Events are scoped to the tenant resolved by the gateway (X-Tenant-ID)
 	v1 := r.Group("/api/v1")
+	v1.Use(tenant.Middleware())
 	{
 		// Public event routes
 		events := v1.Group("/events")
@@ -28,6 +37,26 @@ func SetupRouter(eventController *controller.EventController, jwtSecret string)
 			events.GET("/slug/:slug", eventController.GetEventBySlug)       // Get event by slug (must be before /:id)
 			events.GET("/:id", eventController.GetEvent)                    // Get event by ID
 			events.GET("/:id/ticket-tiers", eventController.GetEventTicketTiers) // Get ticket tiers for event
+			events.GET("/:id/zones", eventController.GetEventZones)              // Get access zones for event
+			events.GET("/:id/faqs", eventController.GetEventFAQs)                // Get FAQ entries for event
+			events.GET("/:id/agenda", eventController.GetEventAgenda)            // Get agenda sessions for event
+			events.GET("/:id/performers", performerController.GetEventPerformers) // Get performer lineup for event
+			events.GET("/:id/seo", seoController.GetEventSEO)                     // Get page metadata for event
+			events.GET("/:id/og-image", seoController.GetShareImage)              // Get share image for event
+		}
+
+		// Public performer directory
+		performers := v1.Group("/performers")
+		{
+			performers.GET("", performerController.SearchPerformers)              // Search performers by name
+			performers.GET("/:id", performerController.GetPerformer)              // Get performer profile
+			performers.GET("/:id/events", performerController.GetPerformerEvents) // Upcoming events featuring performer
+		}
+
+		// Public organizer profiles with the verification badge
+		organizers := v1.Group("/organizers")
+		{
+			organizers.GET("/:id", verificationController.GetOrganizerProfile) // Get organizer profile
 		}
 
 		// Public ticket tier routes
@@ -38,31 +67,104 @@ func SetupRouter(eventController *controller.EventController, jwtSecret string)
 
 		// Protected routes (require authentication)
 		protected := v1.Group("")
-		protected.Use(middleware.AuthMiddleware(jwtSecret))
+		protected.Use(sharedauth.Middleware(keys))
 		{
-			// Organizer-only event routes
+			// Event management routes (events:write), rate limited by organizer plan
 			organizerEvents := protected.Group("/events")
-			organizerEvents.Use(middleware.OrganizerOnly())
+			organizerEvents.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite), planController.RateLimit)
 			{
 				organizerEvents.POST("", eventController.CreateEvent)       // Create event
 				organizerEvents.PUT("/:id", eventController.UpdateEvent)    // Update event
 				organizerEvents.DELETE("/:id", eventController.DeleteEvent) // Delete event
+				organizerEvents.POST("/:id/zones", eventController.CreateZone)           // Create access zone
+				organizerEvents.DELETE("/:id/zones/:zoneId", eventController.DeleteZone) // Delete access zone
+				organizerEvents.POST("/:id/faqs", eventController.CreateFAQ)                     // Add FAQ entry
+				organizerEvents.PUT("/:id/faqs/:faqId", eventController.UpdateFAQ)               // Replace FAQ entry
+				organizerEvents.DELETE("/:id/faqs/:faqId", eventController.DeleteFAQ)            // Delete FAQ entry
+				organizerEvents.POST("/:id/agenda", eventController.CreateSession)               // Add agenda session
+				organizerEvents.PUT("/:id/agenda/:sessionId", eventController.UpdateSession)     // Replace agenda session
+				organizerEvents.DELETE("/:id/agenda/:sessionId", eventController.DeleteSession)  // Delete agenda session
+				organizerEvents.PUT("/:id/performers", performerController.SetEventPerformers)  // Set performer lineup
+				organizerEvents.GET("/:id/revenue-splits", revenueSplitController.GetEventSplits) // Get revenue splits (organizer and co-organizers)
+				organizerEvents.PUT("/:id/revenue-splits", revenueSplitController.SetEventSplits) // Set revenue splits between co-organizers
 			}
 
-			// Organizer dashboard
+			// Abuse reports, open to any signed-in user (one open report per user and event)
+			reports := protected.Group("/events")
+			{
+				reports.POST("/:id/report", moderationController.ReportEvent) // Report event for review
+			}
+
+			// Organizer dashboard; event-ids is fetched by the gateway's ownership cache, so it isn't rate limited
 			organizer := protected.Group("/organizer")
-			organizer.Use(middleware.OrganizerOnly())
+			organizer.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite))
+			{
+				organizer.GET("/events", planController.RateLimit, eventController.GetOrganizerEvents) // Get organizer's events
+				organizer.POST("/events/import", planController.RateLimit, eventController.ImportEvents) // Import events from a JSON or CSV file
+				organizer.GET("/events/export", planController.RateLimit, eventController.ExportEvents)  // Export events as a re-importable file
+				organizer.GET("/events/:id/analytics", planController.RateLimit, analyticsController.GetEventAnalytics) // Sales per tier, daily sales and conversion of own event
+				organizer.GET("/event-ids", eventController.GetOrganizerEventIDs) // Get organizer's event IDs (gateway ownership cache)
+				organizer.GET("/plan", planController.GetMyPlan)                  // Get organizer's plan limits and usage
+				organizer.GET("/verification", verificationController.GetMyVerification)   // Get latest verification submission
+				organizer.POST("/verification", verificationController.SubmitVerification) // Submit verification documents
+				organizer.GET("/revenue-shares", revenueSplitController.GetMyShares)        // Get share of events organizer is a party to
+				organizer.GET("/email-sender", emailSenderController.GetMySender)            // Get own email sender and domain verification status
+				organizer.PUT("/email-sender", emailSenderController.ConfigureSender)        // Set own sending domain, from name and reply-to
+				organizer.POST("/email-sender/verify", emailSenderController.VerifySender)   // Check the domain's DNS records with the email provider
+				organizer.DELETE("/email-sender", emailSenderController.DeleteSender)        // Go back to the platform sender
+				organizer.GET("/ticket-template", ticketTemplateController.GetMyTemplate)            // Get own e-ticket layout
+				organizer.PUT("/ticket-template", ticketTemplateController.SaveTemplate)             // Set logo, brand colours, sponsor footer and terms of e-tickets
+				organizer.DELETE("/ticket-template", ticketTemplateController.DeleteTemplate)        // Go back to the platform e-ticket layout
+				organizer.POST("/ticket-template/preview", ticketTemplateController.PreviewTemplate) // Render a sample e-ticket PDF
+			}
+
+			// Performer directory management (events:write, profiles edited by their creator), rate limited by organizer plan
+			organizerPerformers := protected.Group("/performers")
+			organizerPerformers.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite), planController.RateLimit)
 			{
-				organizer.GET("/events", eventController.GetOrganizerEvents) // Get organizer's events
+				organizerPerformers.POST("", performerController.CreatePerformer)       // Create performer
+				organizerPerformers.PUT("/:id", performerController.UpdatePerformer)    // Update performer
+				organizerPerformers.DELETE("/:id", performerController.DeletePerformer) // Delete performer
 			}
 
-			// Organizer-only ticket tier routes
+			// Ticket tier management routes (events:write), rate limited by organizer plan
 			organizerTicketTiers := protected.Group("/ticket-tiers")
-			organizerTicketTiers.Use(middleware.OrganizerOnly())
+			organizerTicketTiers.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite), planController.RateLimit)
 			{
 				organizerTicketTiers.POST("", eventController.CreateTicketTier)       // Create ticket tier
 				organizerTicketTiers.PUT("/:id", eventController.UpdateTicketTier)    // Update ticket tier
 				organizerTicketTiers.DELETE("/:id", eventController.DeleteTicketTier) // Delete ticket tier
+				organizerTicketTiers.POST("/:id/archive", eventController.ArchiveTicketTier) // Archive ticket tier (hide from sale)
+				organizerTicketTiers.PUT("/:id/zones", eventController.SetTicketTierZones) // Set zones the tier may enter
+				organizerTicketTiers.GET("/:id/price-history", eventController.GetTicketTierPriceHistory) // Prices the tier was sold at
+			}
+
+			// Organizer plan management (plans:manage)
+			admin := protected.Group("/admin")
+			admin.Use(sharedauth.RequireScope(sharedauth.PermPlansManage))
+			{
+				admin.GET("/plans", planController.ListPlans)                   // List plans and their limits
+				admin.PUT("/plans/:code", planController.UpdatePlan)            // Update plan limits
+				admin.GET("/organizers/:id/plan", planController.GetOrganizerPlan) // Get organizer's plan and usage
+				admin.PUT("/organizers/:id/plan", planController.AssignPlan)    // Assign organizer to a plan
+			}
+
+			// Event moderation (reports:manage)
+			moderation := protected.Group("/admin")
+			moderation.Use(sharedauth.RequireScope(sharedauth.PermReportsManage))
+			{
+				moderation.GET("/event-reports", moderationController.ListReportedEvents)      // Moderation queue, most reported first
+				moderation.GET("/events/:id/reports", moderationController.GetEventReports)    // Event with all its reports
+				moderation.POST("/events/:id/moderation", moderationController.ModerateEvent) // Dismiss or uphold open reports
+			}
+
+			// Organizer verification review (orgs:verify)
+			verification := protected.Group("/admin")
+			verification.Use(sharedauth.RequireScope(sharedauth.PermOrgsVerify))
+			{
+				verification.GET("/organizer-verifications", verificationController.ListVerifications)               // Review queue, pending by default
+				verification.POST("/organizer-verifications/:id/review", verificationController.ReviewVerification) // Approve or reject submission
+				verification.POST("/organizers/:id/verification/revoke", verificationController.RevokeVerification) // Withdraw verified badge
 			}
 		}
 	}
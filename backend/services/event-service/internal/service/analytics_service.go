@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/repository"
+)
+
+// cacheEventAnalyticsTTL bounds how stale the cached sales aggregates of an event get
+const cacheEventAnalyticsTTL = 5 * time.Minute
+
+// AnalyticsService defines interface for organizer sales analytics
+type AnalyticsService interface {
+	GetEventAnalytics(ctx context.Context, organizerID, eventID string) (*response.EventAnalyticsResponse, error)
+}
+
+// analyticsService implements AnalyticsService interface
+type analyticsService struct {
+	analyticsRepo repository.AnalyticsRepository
+	eventRepo     repository.EventRepository
+	cache         cache.RedisClient
+	now           func() time.Time
+}
+
+// NewAnalyticsService creates new analytics service instance
+func NewAnalyticsService(analyticsRepo repository.AnalyticsRepository, eventRepo repository.EventRepository, redisClient cache.RedisClient) AnalyticsService {
+	return &analyticsService{
+		analyticsRepo: analyticsRepo,
+		eventRepo:     eventRepo,
+		cache:         redisClient,
+		now:           time.Now,
+	}
+}
+
+// GetEventAnalytics retrieves tickets sold and revenue per tier, daily sales and the reservation
+// to paid conversion of the organizer's event
+// The aggregates scan every order of the event, so they are cached for cacheEventAnalyticsTTL
+func (s *analyticsService) GetEventAnalytics(ctx context.Context, organizerID, eventID string) (*response.EventAnalyticsResponse, error) {
+	event, err := ownedEvent(ctx, s.eventRepo, organizerID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := fmt.Sprintf("event:analytics:%s", event.ID)
+	if s.cache != nil {
+		cached, err := s.cache.Get(ctx, cacheKey)
+		if err == nil && cached != "" {
+			var analytics response.EventAnalyticsResponse
+			if err := json.Unmarshal([]byte(cached), &analytics); err == nil {
+				return &analytics, nil
+			}
+		}
+	}
+
+	tiers, err := s.analyticsRepo.GetTierSales(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	daily, err := s.analyticsRepo.GetDailySales(ctx, event.ID, event.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	funnel, err := s.analyticsRepo.GetOrderFunnel(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	analytics := response.ToEventAnalyticsResponse(event.ID, tiers, daily, funnel, s.now().UTC())
+
+	if s.cache != nil {
+		if data, err := json.Marshal(analytics); err == nil {
+			s.cache.Set(ctx, cacheKey, string(data), cacheEventAnalyticsTTL)
+		}
+	}
+
+	return analytics, nil
+}
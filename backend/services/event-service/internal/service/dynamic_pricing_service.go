@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/repository"
+)
+
+// DynamicPricingService reprices ticket tiers under dynamic pricing
+type DynamicPricingService interface {
+	RepriceTiers(ctx context.Context) (int, error)
+}
+
+// dynamicPricingService implements DynamicPricingService interface
+type dynamicPricingService struct {
+	ticketTierRepo repository.TicketTierRepository
+	cache          cache.RedisClient
+	batchSize      int
+	now            func() time.Time
+}
+
+// NewDynamicPricingService creates new dynamic pricing service instance
+func NewDynamicPricingService(
+	ticketTierRepo repository.TicketTierRepository,
+	redisClient cache.RedisClient,
+	batchSize int,
+) DynamicPricingService {
+	return &dynamicPricingService{
+		ticketTierRepo: ticketTierRepo,
+		cache:          redisClient,
+		batchSize:      batchSize,
+		now:            time.Now,
+	}
+}
+
+// RepriceTiers applies the dynamic rule of every tier on sale and returns how many prices changed
+// The new price reaches ticketing service through the event change feed (the price column is
+// replicated), so reservations are charged the price in effect when they're made. Tiers an
+// organizer edits meanwhile are skipped until the next run.
+func (s *dynamicPricingService) RepriceTiers(ctx context.Context) (int, error) {
+	repriced := 0
+	changedEvents := make(map[string]string) // Event ID to slug, for cache invalidation
+
+	afterID := ""
+	for {
+		tiers, err := s.ticketTierRepo.ListDynamicPricing(ctx, afterID, s.batchSize)
+		if err != nil {
+			return repriced, err
+		}
+
+		for i := range tiers {
+			tier := &tiers[i]
+			if tier.BasePrice == nil {
+				continue
+			}
+
+			price := tier.DynamicPricing.Price(*tier.BasePrice, tier.SoldCount, tier.Quota, tier.EventStartDate.Sub(s.now()))
+			if price == tier.Price {
+				continue
+			}
+
+			if err := s.ticketTierRepo.UpdateDynamicPrice(ctx, &tier.TicketTier, price); err != nil {
+				if errors.Is(err, repository.ErrTicketTierVersionConflict) {
+					continue
+				}
+				return repriced, err
+			}
+
+			repriced++
+			changedEvents[tier.EventID] = tier.EventSlug
+		}
+
+		if len(tiers) < s.batchSize {
+			break
+		}
+		afterID = tiers[len(tiers)-1].ID
+	}
+
+	if len(changedEvents) == 0 {
+		return repriced, nil
+	}
+
+	// Event detail is cached with its ticket tiers
+	if s.cache != nil {
+		for eventID, slug := range changedEvents {
+			s.cache.Del(ctx, fmt.Sprintf("event:id:%s", eventID))
+			s.cache.Del(ctx, fmt.Sprintf("event:slug:%s", slug))
+		}
+	}
+
+	// Listing price filters read the availability summary
+	if err := s.ticketTierRepo.RefreshAvailability(ctx); err != nil {
+		log.Printf("[DynamicPricingService] Failed to refresh event availability: %v", err)
+	}
+
+	return repriced, nil
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/repository"
+)
+
+var (
+	ErrEmailSenderNotFound = errors.New("email sender not configured")
+	ErrSenderAddressDomain = errors.New("from email must be an address on the sending domain")
+	ErrSenderDomainTaken   = errors.New("sending domain is used by another organizer")
+)
+
+// SenderDomainRegistrar defines interface for registering sending domains with the email provider (notification service)
+type SenderDomainRegistrar interface {
+	RegisterSenderDomain(ctx context.Context, domain string) (*client.SenderDomain, error)
+	VerifySenderDomain(ctx context.Context, domainID string) (*client.SenderDomain, error)
+}
+
+// EmailSenderService defines interface for organizers' white-label email senders
+type EmailSenderService interface {
+	GetMySender(ctx context.Context, organizerID string) (*response.EmailSenderResponse, error)
+	ConfigureSender(ctx context.Context, organizerID string, req *request.ConfigureEmailSenderRequest) (*response.EmailSenderResponse, error)
+	VerifySender(ctx context.Context, organizerID string) (*response.EmailSenderResponse, error)
+	DeleteSender(ctx context.Context, organizerID string) error
+}
+
+// emailSenderService implements EmailSenderService interface
+type emailSenderService struct {
+	senderRepo repository.EmailSenderRepository
+	registrar  SenderDomainRegistrar
+}
+
+// NewEmailSenderService creates new email sender service instance
+func NewEmailSenderService(senderRepo repository.EmailSenderRepository, registrar SenderDomainRegistrar) EmailSenderService {
+	return &emailSenderService{
+		senderRepo: senderRepo,
+		registrar:  registrar,
+	}
+}
+
+// GetMySender retrieves an organizer's sender with the status of its last verification check
+func (s *emailSenderService) GetMySender(ctx context.Context, organizerID string) (*response.EmailSenderResponse, error) {
+	sender, err := s.senderRepo.Get(ctx, organizerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrEmailSenderNotFound) {
+			return nil, ErrEmailSenderNotFound
+		}
+		return nil, fmt.Errorf("failed to get email sender: %w", err)
+	}
+
+	return response.ToEmailSenderResponse(sender), nil
+}
+
+// ConfigureSender sets an organizer's sender
+// A new domain is registered with the email provider and stays pending until verified, so emails
+// keep using the platform sender meanwhile; changing only the names keeps the domain's status
+func (s *emailSenderService) ConfigureSender(ctx context.Context, organizerID string, req *request.ConfigureEmailSenderRequest) (*response.EmailSenderResponse, error) {
+	req.Normalize()
+
+	if !strings.HasSuffix(strings.ToLower(req.FromEmail), "@"+req.Domain) {
+		return nil, ErrSenderAddressDomain
+	}
+
+	existing, err := s.senderRepo.Get(ctx, organizerID)
+	if err != nil && !errors.Is(err, repository.ErrEmailSenderNotFound) {
+		return nil, fmt.Errorf("failed to get email sender: %w", err)
+	}
+
+	sender := &entity.OrganizerEmailSender{
+		OrganizerID: organizerID,
+		Domain:      req.Domain,
+		FromName:    req.FromName,
+		FromEmail:   req.FromEmail,
+	}
+	if req.ReplyTo != "" {
+		sender.ReplyTo = &req.ReplyTo
+	}
+
+	if existing != nil && existing.Domain == req.Domain {
+		sender.ProviderDomainID = existing.ProviderDomainID
+		sender.Status = existing.Status
+		sender.DNSRecords = existing.DNSRecords
+		sender.VerifiedAt = existing.VerifiedAt
+	} else {
+		taken, err := s.senderRepo.DomainTaken(ctx, req.Domain, organizerID)
+		if err != nil {
+			return nil, err
+		}
+		if taken {
+			return nil, ErrSenderDomainTaken
+		}
+
+		domain, err := s.registrar.RegisterSenderDomain(ctx, req.Domain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to register sending domain: %w", err)
+		}
+		sender.ProviderDomainID = domain.ID
+		sender.Status = senderStatus(domain.Status)
+		sender.DNSRecords = dnsRecords(domain.Records)
+		log.Printf("[EmailSenderService] Organizer %s registered sending domain %s", organizerID, req.Domain)
+	}
+
+	if err := s.senderRepo.Save(ctx, sender); err != nil {
+		// Lost a race with another organizer configuring the domain
+		if errors.Is(err, repository.ErrSenderDomainTaken) {
+			return nil, ErrSenderDomainTaken
+		}
+		return nil, fmt.Errorf("failed to save email sender: %w", err)
+	}
+
+	return response.ToEmailSenderResponse(sender), nil
+}
+
+// VerifySender checks the DNS records of an organizer's domain with the email provider
+// The provider checks asynchronously, so a freshly published domain may stay pending for a few minutes
+func (s *emailSenderService) VerifySender(ctx context.Context, organizerID string) (*response.EmailSenderResponse, error) {
+	sender, err := s.senderRepo.Get(ctx, organizerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrEmailSenderNotFound) {
+			return nil, ErrEmailSenderNotFound
+		}
+		return nil, fmt.Errorf("failed to get email sender: %w", err)
+	}
+
+	domain, err := s.registrar.VerifySenderDomain(ctx, sender.ProviderDomainID)
+	if errors.Is(err, client.ErrSenderDomainNotFound) {
+		log.Printf("[EmailSenderService] Sending domain %s of organizer %s is unknown to the email provider, registering it again", sender.Domain, organizerID)
+		return s.reregister(ctx, sender)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify sending domain: %w", err)
+	}
+
+	updated, err := s.senderRepo.UpdateStatus(ctx, organizerID, senderStatus(domain.Status), dnsRecords(domain.Records))
+	if err != nil {
+		if errors.Is(err, repository.ErrEmailSenderNotFound) {
+			return nil, ErrEmailSenderNotFound
+		}
+		return nil, fmt.Errorf("failed to update email sender status: %w", err)
+	}
+
+	if updated.Status != sender.Status {
+		log.Printf("[EmailSenderService] Sending domain %s of organizer %s is now %s", updated.Domain, organizerID, updated.Status)
+	}
+
+	return response.ToEmailSenderResponse(updated), nil
+}
+
+// reregister registers a sender's domain removed at the email provider again
+// The organizer gets the new records to check, the domain is pending until verified again
+func (s *emailSenderService) reregister(ctx context.Context, sender *entity.OrganizerEmailSender) (*response.EmailSenderResponse, error) {
+	domain, err := s.registrar.RegisterSenderDomain(ctx, sender.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register sending domain: %w", err)
+	}
+
+	sender.ProviderDomainID = domain.ID
+	sender.Status = senderStatus(domain.Status)
+	sender.DNSRecords = dnsRecords(domain.Records)
+	sender.VerifiedAt = nil
+	if err := s.senderRepo.Save(ctx, sender); err != nil {
+		return nil, fmt.Errorf("failed to save email sender: %w", err)
+	}
+
+	return response.ToEmailSenderResponse(sender), nil
+}
+
+// DeleteSender removes an organizer's sender, its emails are sent by the platform sender again
+func (s *emailSenderService) DeleteSender(ctx context.Context, organizerID string) error {
+	if err := s.senderRepo.Delete(ctx, organizerID); err != nil {
+		if errors.Is(err, repository.ErrEmailSenderNotFound) {
+			return ErrEmailSenderNotFound
+		}
+		return fmt.Errorf("failed to delete email sender: %w", err)
+	}
+	return nil
+}
+
+// senderStatus maps the email provider's domain status to the sender status
+// Temporary failures (e.g. DNS timeouts) stay pending so the organizer simply checks again
+func senderStatus(providerStatus string) string {
+	switch providerStatus {
+	case "verified":
+		return entity.EmailSenderVerified
+	case "failed":
+		return entity.EmailSenderFailed
+	default:
+		return entity.EmailSenderPending
+	}
+}
+
+// dnsRecords converts the email provider's records of a domain
+func dnsRecords(records []client.DNSRecord) entity.DNSRecords {
+	converted := make(entity.DNSRecords, len(records))
+	for i, record := range records {
+		converted[i] = entity.DNSRecord{
+			Record:   record.Record,
+			Name:     record.Name,
+			Type:     record.Type,
+			Value:    record.Value,
+			TTL:      record.TTL,
+			Priority: record.Priority,
+			Status:   record.Status,
+		}
+	}
+	return converted
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/repository"
+)
+
+// EventCompletionService moves ended events to completed and runs their post-event flows
+type EventCompletionService interface {
+	CompleteEndedEvents(ctx context.Context) (int, error)
+}
+
+// eventCompletionService implements EventCompletionService interface
+type eventCompletionService struct {
+	eventRepo      repository.EventRepository
+	settlementRepo repository.SettlementRepository
+	cache          cache.RedisClient
+	batchSize      int
+	now            func() time.Time
+}
+
+// NewEventCompletionService creates new event completion service instance
+func NewEventCompletionService(
+	eventRepo repository.EventRepository,
+	settlementRepo repository.SettlementRepository,
+	redisClient cache.RedisClient,
+	batchSize int,
+) EventCompletionService {
+	return &eventCompletionService{
+		eventRepo:      eventRepo,
+		settlementRepo: settlementRepo,
+		cache:          redisClient,
+		batchSize:      batchSize,
+		now:            time.Now,
+	}
+}
+
+// CompleteEndedEvents completes published events past their end date and returns how many were completed
+// Completed events stop selling tickets and close check-in in ticketing service, which sees
+// the new status through the event change feed. Settlements are calculated afterwards for every
+// completed event still missing one, so a failed calculation is retried on the next run.
+func (s *eventCompletionService) CompleteEndedEvents(ctx context.Context) (int, error) {
+	completed := 0
+	for {
+		events, err := s.eventRepo.CompleteEnded(ctx, s.now(), s.batchSize)
+		if err != nil {
+			return completed, err
+		}
+		completed += len(events)
+
+		// Event detail is cached with its status
+		if s.cache != nil {
+			for _, event := range events {
+				s.cache.Del(ctx, fmt.Sprintf("event:id:%s", event.ID))
+				s.cache.Del(ctx, fmt.Sprintf("event:slug:%s", event.Slug))
+			}
+		}
+
+		if len(events) < s.batchSize {
+			break
+		}
+	}
+
+	if err := s.settleCompletedEvents(ctx); err != nil {
+		return completed, err
+	}
+
+	return completed, nil
+}
+
+// settleCompletedEvents calculates the organizer settlement of completed events without one
+func (s *eventCompletionService) settleCompletedEvents(ctx context.Context) error {
+	for {
+		eventIDs, err := s.settlementRepo.ListUnsettled(ctx, s.batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, eventID := range eventIDs {
+			settlement, err := s.settlementRepo.Calculate(ctx, eventID)
+			if err != nil {
+				return fmt.Errorf("failed to settle event %s: %w", eventID, err)
+			}
+			log.Printf("[EventCompletion] Event %s settled: %d orders, %d tickets, payout %s",
+				eventID, settlement.PaidOrders, settlement.TicketsSold, settlement.PayoutAmount)
+		}
+
+		if len(eventIDs) < s.batchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/repository"
+)
+
+// CreateFAQ adds an FAQ entry to an event
+func (s *eventService) CreateFAQ(ctx context.Context, organizerID string, eventID string, req *request.FAQRequest) (*response.FAQResponse, error) {
+	req.Normalize()
+
+	event, err := s.getOwnedEvent(ctx, organizerID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	faq := &entity.EventFAQ{
+		EventID:  eventID,
+		Question: req.Question,
+		Answer:   req.Answer,
+		Position: req.Position,
+	}
+
+	if err := s.contentRepo.CreateFAQ(ctx, faq); err != nil {
+		return nil, fmt.Errorf("failed to create faq: %w", err)
+	}
+
+	invalidateEventDetail(ctx, s.cache, event)
+	return response.ToFAQResponse(faq), nil
+}
+
+// UpdateFAQ replaces an FAQ entry of an event
+func (s *eventService) UpdateFAQ(ctx context.Context, organizerID string, eventID string, faqID string, req *request.FAQRequest) (*response.FAQResponse, error) {
+	req.Normalize()
+
+	event, err := s.getOwnedEvent(ctx, organizerID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	faq := &entity.EventFAQ{
+		ID:       faqID,
+		EventID:  eventID,
+		Question: req.Question,
+		Answer:   req.Answer,
+		Position: req.Position,
+	}
+
+	if err := s.contentRepo.UpdateFAQ(ctx, faq); err != nil {
+		if errors.Is(err, repository.ErrFAQNotFound) {
+			return nil, ErrFAQNotFound
+		}
+		return nil, fmt.Errorf("failed to update faq: %w", err)
+	}
+
+	invalidateEventDetail(ctx, s.cache, event)
+	return response.ToFAQResponse(faq), nil
+}
+
+// DeleteFAQ deletes an FAQ entry of an event
+func (s *eventService) DeleteFAQ(ctx context.Context, organizerID string, eventID string, faqID string) error {
+	event, err := s.getOwnedEvent(ctx, organizerID, eventID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.contentRepo.DeleteFAQ(ctx, eventID, faqID); err != nil {
+		if errors.Is(err, repository.ErrFAQNotFound) {
+			return ErrFAQNotFound
+		}
+		return fmt.Errorf("failed to delete faq: %w", err)
+	}
+
+	invalidateEventDetail(ctx, s.cache, event)
+	return nil
+}
+
+// GetEventFAQs retrieves the FAQ entries of an event in display order
+func (s *eventService) GetEventFAQs(ctx context.Context, eventID string) ([]response.FAQResponse, error) {
+	faqs, err := s.contentRepo.GetFAQsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get faqs: %w", err)
+	}
+
+	return response.ToFAQResponses(faqs), nil
+}
+
+// CreateSession adds a session to the agenda of an event
+func (s *eventService) CreateSession(ctx context.Context, organizerID string, eventID string, req *request.SessionRequest) (*response.SessionResponse, error) {
+	req.Normalize()
+
+	event, err := s.getOwnedEvent(ctx, organizerID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := newSession(event, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.contentRepo.CreateSession(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	invalidateEventDetail(ctx, s.cache, event)
+	return response.ToSessionResponse(session), nil
+}
+
+// UpdateSession replaces a session of an event's agenda
+func (s *eventService) UpdateSession(ctx context.Context, organizerID string, eventID string, sessionID string, req *request.SessionRequest) (*response.SessionResponse, error) {
+	req.Normalize()
+
+	event, err := s.getOwnedEvent(ctx, organizerID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := newSession(event, req)
+	if err != nil {
+		return nil, err
+	}
+	session.ID = sessionID
+
+	if err := s.contentRepo.UpdateSession(ctx, session); err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, fmt.Errorf("failed to update session: %w", err)
+	}
+
+	invalidateEventDetail(ctx, s.cache, event)
+	return response.ToSessionResponse(session), nil
+}
+
+// DeleteSession deletes a session of an event's agenda
+func (s *eventService) DeleteSession(ctx context.Context, organizerID string, eventID string, sessionID string) error {
+	event, err := s.getOwnedEvent(ctx, organizerID, eventID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.contentRepo.DeleteSession(ctx, eventID, sessionID); err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return ErrSessionNotFound
+		}
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	invalidateEventDetail(ctx, s.cache, event)
+	return nil
+}
+
+// GetEventAgenda retrieves the sessions of an event ordered by start time
+func (s *eventService) GetEventAgenda(ctx context.Context, eventID string) ([]response.SessionResponse, error) {
+	sessions, err := s.contentRepo.GetSessionsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get agenda: %w", err)
+	}
+
+	return response.ToSessionResponses(sessions), nil
+}
+
+// attachEventContent adds the FAQ, agenda and performer lineup of an event to its detail response
+func (s *eventService) attachEventContent(ctx context.Context, eventResp *response.EventResponse) error {
+	faqs, err := s.contentRepo.GetFAQsByEventID(ctx, eventResp.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get faqs: %w", err)
+	}
+
+	sessions, err := s.contentRepo.GetSessionsByEventID(ctx, eventResp.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get agenda: %w", err)
+	}
+
+	performers, err := s.performerRepo.GetByEventID(ctx, eventResp.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get performers: %w", err)
+	}
+
+	eventResp.FAQs = response.ToFAQResponses(faqs)
+	eventResp.Agenda = response.ToSessionResponses(sessions)
+	eventResp.Performers = response.ToPerformerResponses(performers)
+	return nil
+}
+
+// newSession builds an agenda session of event from a session request
+// Sessions must take place within the event's dates
+func newSession(event *entity.Event, req *request.SessionRequest) (*entity.EventSession, error) {
+	if req.StartsAt.Before(event.StartDate) || req.EndsAt.After(event.EndDate) {
+		return nil, ErrSessionOutsideEvent
+	}
+
+	session := &entity.EventSession{
+		EventID:  event.ID,
+		Title:    req.Title,
+		Speakers: req.Speakers,
+		StartsAt: req.StartsAt,
+		EndsAt:   req.EndsAt,
+	}
+	if req.Description != "" {
+		session.Description = &req.Description
+	}
+	if req.Stage != "" {
+		session.Stage = &req.Stage
+	}
+
+	return session, nil
+}
@@ -0,0 +1,633 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/pricing"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/response"
+)
+
+var (
+	ErrInvalidEventsFile = errors.New("invalid events file")
+	ErrImportIncomplete  = errors.New("import stopped before all events were created")
+)
+
+// fileValidator checks events file rows against the binding tags of the request types,
+// naming fields by their JSON name like the API does
+var fileValidator = newFileValidator()
+
+func newFileValidator() *validator.Validate {
+	v := validator.New()
+	v.SetTagName("binding")
+	v.RegisterTagNameFunc(jsonFieldName)
+	return v
+}
+
+// ImportEvents creates the events and ticket tiers of an events file
+// Every row is validated first, plan limits included; events are only created when no row
+// has errors, and never on a dry run. An event is created with all its tiers or not at all.
+func (s *eventService) ImportEvents(ctx context.Context, organizerID string, format string, body io.Reader, dryRun bool) (*response.ImportEventsResponse, error) {
+	var (
+		file      *request.EventsFile
+		rowErrors []response.ImportRowError
+		err       error
+	)
+	if format == request.EventsFileCSV {
+		file, rowErrors, err = parseEventsCSV(body)
+	} else {
+		file, err = parseEventsJSON(body)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(file.Events) == 0 {
+		return nil, fmt.Errorf("%w: no events", ErrInvalidEventsFile)
+	}
+	if len(file.Events) > request.MaxImportEvents {
+		return nil, fmt.Errorf("%w: %d events, at most %d per import", ErrInvalidEventsFile, len(file.Events), request.MaxImportEvents)
+	}
+
+	result := &response.ImportEventsResponse{DryRun: dryRun, Events: len(file.Events)}
+	for _, event := range file.Events {
+		result.TicketTiers += len(event.TicketTiers)
+	}
+
+	validationErrors, err := s.validateEventsFile(ctx, organizerID, file)
+	if err != nil {
+		return nil, err
+	}
+	// A value that failed to parse is reported once, not again by validation
+	reported := make(map[response.ImportRowError]bool, len(rowErrors))
+	for _, rowError := range rowErrors {
+		reported[response.ImportRowError{Row: rowError.Row, Field: rowError.Field}] = true
+	}
+	result.Errors = rowErrors
+	for _, validationError := range validationErrors {
+		if !reported[response.ImportRowError{Row: validationError.Row, Field: validationError.Field}] {
+			result.Errors = append(result.Errors, validationError)
+		}
+	}
+	sort.SliceStable(result.Errors, func(i, j int) bool { return result.Errors[i].Row < result.Errors[j].Row })
+
+	if len(result.Errors) > 0 || dryRun {
+		return result, nil
+	}
+
+	for i := range file.Events {
+		imported, err := s.importEvent(ctx, organizerID, &file.Events[i])
+		if err != nil {
+			result.Errors = append(result.Errors, response.ImportRowError{
+				Row:     file.Events[i].Row,
+				Message: "failed to create the event, it and the events after it were not imported",
+			})
+			if len(result.Imported) > 0 {
+				s.refreshAvailability(ctx)
+			}
+			return result, fmt.Errorf("%w: %v", ErrImportIncomplete, err)
+		}
+		result.Imported = append(result.Imported, *imported)
+	}
+
+	s.refreshAvailability(ctx)
+
+	return result, nil
+}
+
+// ExportEvents returns the organizer's events with their ticket tiers as a re-importable events file
+// status optionally selects events by status
+func (s *eventService) ExportEvents(ctx context.Context, organizerID string, status string) (*request.EventsFile, error) {
+	events, err := s.eventRepo.GetByOrganizerID(ctx, organizerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organizer events: %w", err)
+	}
+
+	now := time.Now()
+	file := &request.EventsFile{Events: make([]request.EventsFileEvent, 0, len(events))}
+	for _, event := range events {
+		if status != "" && event.Status != status {
+			continue
+		}
+
+		tiers, err := s.ticketTierRepo.GetByEventID(ctx, event.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ticket tiers: %w", err)
+		}
+		file.Events = append(file.Events, toEventsFileEvent(&event, tiers, now))
+	}
+
+	return file, nil
+}
+
+// WriteEventsCSV writes an events file as CSV, one row per ticket tier
+func WriteEventsCSV(w io.Writer, file *request.EventsFile) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(request.EventsCSVColumns); err != nil {
+		return err
+	}
+
+	for _, event := range file.Events {
+		values := map[string]string{
+			"event_ref":     event.Ref,
+			"title":         event.Title,
+			"description":   event.Description,
+			"category":      event.Category,
+			"location":      event.Location,
+			"venue":         event.Venue,
+			"start_date":    event.StartDate.Format(time.RFC3339),
+			"end_date":      event.EndDate.Format(time.RFC3339),
+			"timezone":      event.Timezone,
+			"scan_policy":   event.ScanPolicy,
+			"refund_policy": event.RefundPolicy,
+			"banner_url":    event.BannerURL,
+			"status":        event.Status,
+		}
+		if len(event.TicketTiers) == 0 {
+			if err := writer.Write(csvRecord(values)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, tier := range event.TicketTiers {
+			values["tier_name"] = tier.Name
+			values["tier_description"] = tier.Description
+			values["tier_price"] = tier.Price.String()
+			values["tier_quota"] = strconv.Itoa(tier.Quota)
+			values["tier_max_per_order"] = formatOptionalInt(tier.MaxPerOrder)
+			values["tier_early_bird_price"] = ""
+			if tier.EarlyBirdPrice != nil {
+				values["tier_early_bird_price"] = tier.EarlyBirdPrice.String()
+			}
+			values["tier_early_bird_end_date"] = ""
+			if tier.EarlyBirdEndDate != nil {
+				values["tier_early_bird_end_date"] = tier.EarlyBirdEndDate.Format(time.RFC3339)
+			}
+			values["tier_accessible_quota"] = formatOptionalInt(tier.AccessibleQuota)
+			values["tier_dynamic_pricing"] = ""
+			if tier.DynamicPricing != nil {
+				rule, err := json.Marshal(tier.DynamicPricing)
+				if err != nil {
+					return err
+				}
+				values["tier_dynamic_pricing"] = string(rule)
+			}
+			if err := writer.Write(csvRecord(values)); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// validateEventsFile checks every event and ticket tier, then the organizer's plan limits
+func (s *eventService) validateEventsFile(ctx context.Context, organizerID string, file *request.EventsFile) ([]response.ImportRowError, error) {
+	var rowErrors []response.ImportRowError
+	for i := range file.Events {
+		event := &file.Events[i]
+		rowErrors = append(rowErrors, validateFileRow(event.Row, "", &event.CreateEventRequest)...)
+
+		for j := range event.TicketTiers {
+			tier := &event.TicketTiers[j]
+			tierErrors := validateFileRow(tier.Row, tier.FieldPrefix, tier)
+			if len(tierErrors) == 0 {
+				if err := tier.ToCreateRequest("").Validate(); err != nil {
+					tierErrors = append(tierErrors, response.ImportRowError{Row: tier.Row, Message: err.Error()})
+				}
+			}
+			rowErrors = append(rowErrors, tierErrors...)
+		}
+	}
+
+	plan, err := s.plans.GetPlan(ctx, organizerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Published events count towards the active event limit, in file order
+	if plan.MaxActiveEvents > 0 {
+		active, err := s.eventRepo.CountActiveByOrganizerID(ctx, organizerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count active events: %w", err)
+		}
+		for _, event := range file.Events {
+			if event.Status != entity.StatusPublished {
+				continue
+			}
+			active++
+			if !entity.WithinLimit(plan.MaxActiveEvents, active) {
+				rowErrors = append(rowErrors, response.ImportRowError{
+					Row:     event.Row,
+					Field:   "status",
+					Message: fmt.Sprintf("publishing exceeds the active event limit of your plan (%d), import it as draft", plan.MaxActiveEvents),
+				})
+			}
+		}
+	}
+
+	if plan.MaxTicketsPerEvent > 0 {
+		for _, event := range file.Events {
+			total := 0
+			for _, tier := range event.TicketTiers {
+				total += tier.Quota
+			}
+			if !entity.WithinLimit(plan.MaxTicketsPerEvent, total) {
+				rowErrors = append(rowErrors, response.ImportRowError{
+					Row:     event.Row,
+					Message: fmt.Sprintf("total ticket quota %d exceeds the limit of your plan (%d)", total, plan.MaxTicketsPerEvent),
+				})
+			}
+		}
+	}
+
+	return rowErrors, nil
+}
+
+// importEvent creates an event with its ticket tiers
+// Repositories don't share a transaction, so the event is deleted again when a tier can't be created
+func (s *eventService) importEvent(ctx context.Context, organizerID string, item *request.EventsFileEvent) (*response.ImportedEvent, error) {
+	event := newEvent(organizerID, &item.CreateEventRequest)
+	if err := s.eventRepo.Create(ctx, event); err != nil {
+		return nil, fmt.Errorf("failed to create event: %w", err)
+	}
+
+	for i := range item.TicketTiers {
+		tier := newTicketTier(item.TicketTiers[i].ToCreateRequest(event.ID), event.StartDate)
+		if err := s.ticketTierRepo.Create(ctx, tier); err != nil {
+			if deleteErr := s.eventRepo.Delete(ctx, event.ID); deleteErr != nil {
+				log.Printf("[EventService] Failed to delete partially imported event %s: %v", event.ID, deleteErr)
+			}
+			return nil, fmt.Errorf("failed to create ticket tier: %w", err)
+		}
+		s.recordPrice(ctx, tier, entity.PriceReasonCreated)
+	}
+
+	return &response.ImportedEvent{
+		Row:         item.Row,
+		ID:          event.ID,
+		Title:       event.Title,
+		Slug:        event.Slug,
+		Status:      event.Status,
+		TicketTiers: len(item.TicketTiers),
+	}, nil
+}
+
+// toEventsFileEvent converts an event for export
+// Only draft and published events can be imported, so cancelled and completed events are exported
+// as drafts. Archived tiers are left out, and early bird prices that have ended are dropped since
+// imports reject them.
+func toEventsFileEvent(event *entity.Event, tiers []entity.TicketTier, now time.Time) request.EventsFileEvent {
+	item := request.EventsFileEvent{
+		CreateEventRequest: request.CreateEventRequest{
+			Title:        event.Title,
+			Description:  derefString(event.Description),
+			Category:     event.Category,
+			Location:     event.Location,
+			Venue:        derefString(event.Venue),
+			StartDate:    event.StartDate,
+			EndDate:      event.EndDate,
+			Timezone:     event.Timezone,
+			ScanPolicy:   event.ScanPolicy,
+			RefundPolicy: derefString(event.RefundPolicy),
+			BannerURL:    derefString(event.BannerURL),
+			Status:       event.Status,
+		},
+		TicketTiers: []request.EventsFileTicketTier{},
+		Ref:         event.Slug,
+	}
+	if event.Status != entity.StatusPublished {
+		item.Status = entity.StatusDraft
+	}
+
+	for _, tier := range tiers {
+		if tier.IsArchived() {
+			continue
+		}
+
+		fileTier := request.EventsFileTicketTier{
+			Name:            tier.Name,
+			Description:     derefString(tier.Description),
+			Price:           tier.Price,
+			Quota:           tier.Quota,
+			MaxPerOrder:     tier.MaxPerOrder,
+			AccessibleQuota: tier.AccessibleQuota,
+			DynamicPricing:  tier.DynamicPricing,
+		}
+		if tier.DynamicPricing != nil && tier.BasePrice != nil {
+			fileTier.Price = *tier.BasePrice
+		}
+		if tier.EarlyBirdPrice != nil && tier.EarlyBirdEndDate != nil && tier.EarlyBirdEndDate.After(now) {
+			fileTier.EarlyBirdPrice = tier.EarlyBirdPrice
+			fileTier.EarlyBirdEndDate = tier.EarlyBirdEndDate
+		}
+		item.TicketTiers = append(item.TicketTiers, fileTier)
+	}
+
+	return item
+}
+
+// parseEventsJSON reads a JSON events file, numbering events by position
+func parseEventsJSON(body io.Reader) (*request.EventsFile, error) {
+	var file request.EventsFile
+	if err := json.NewDecoder(body).Decode(&file); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEventsFile, err)
+	}
+
+	for i := range file.Events {
+		event := &file.Events[i]
+		event.Row = i + 1
+		for j := range event.TicketTiers {
+			event.TicketTiers[j].Row = event.Row
+			event.TicketTiers[j].FieldPrefix = fmt.Sprintf("ticket_tiers[%d].", j)
+		}
+	}
+	return &file, nil
+}
+
+// parseEventsCSV reads a CSV events file, grouping rows into events by event_ref
+// Values that can't be parsed are returned as row errors; a malformed file is an error
+func parseEventsCSV(body io.Reader) (*request.EventsFile, []response.ImportRowError, error) {
+	reader := csv.NewReader(body)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, fmt.Errorf("%w: empty file", ErrInvalidEventsFile)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidEventsFile, err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if !slices.Contains(request.EventsCSVColumns, name) {
+			return nil, nil, fmt.Errorf("%w: unknown column %q", ErrInvalidEventsFile, name)
+		}
+		if _, ok := columns[name]; ok {
+			return nil, nil, fmt.Errorf("%w: duplicate column %q", ErrInvalidEventsFile, name)
+		}
+		columns[name] = i
+	}
+	if _, ok := columns["event_ref"]; !ok {
+		return nil, nil, fmt.Errorf("%w: missing column \"event_ref\"", ErrInvalidEventsFile)
+	}
+
+	file := &request.EventsFile{}
+	eventIndex := map[string]int{}
+	var rowErrors []response.ImportRowError
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrInvalidEventsFile, err)
+		}
+		line, _ := reader.FieldPos(0)
+		row := &csvRow{line: line, record: record, columns: columns}
+
+		ref := row.str("event_ref")
+		if ref == "" {
+			rowErrors = append(rowErrors, response.ImportRowError{Row: line, Field: "event_ref", Message: "is required"})
+			continue
+		}
+
+		index, ok := eventIndex[ref]
+		if !ok {
+			index = len(file.Events)
+			eventIndex[ref] = index
+			file.Events = append(file.Events, row.event(ref))
+		}
+		if row.hasTier() {
+			file.Events[index].TicketTiers = append(file.Events[index].TicketTiers, row.ticketTier())
+		}
+		rowErrors = append(rowErrors, row.errors...)
+	}
+
+	return file, rowErrors, nil
+}
+
+// csvRow reads the columns of one CSV record, collecting values that can't be parsed
+type csvRow struct {
+	line    int
+	record  []string
+	columns map[string]int
+	errors  []response.ImportRowError
+}
+
+// event reads the event columns
+func (r *csvRow) event(ref string) request.EventsFileEvent {
+	return request.EventsFileEvent{
+		CreateEventRequest: request.CreateEventRequest{
+			Title:        r.str("title"),
+			Description:  r.str("description"),
+			Category:     r.str("category"),
+			Location:     r.str("location"),
+			Venue:        r.str("venue"),
+			StartDate:    r.time("start_date"),
+			EndDate:      r.time("end_date"),
+			Timezone:     r.str("timezone"),
+			ScanPolicy:   r.str("scan_policy"),
+			RefundPolicy: r.str("refund_policy"),
+			BannerURL:    r.str("banner_url"),
+			Status:       r.str("status"),
+		},
+		Ref: ref,
+		Row: r.line,
+	}
+}
+
+// hasTier checks if any ticket tier column is set
+func (r *csvRow) hasTier() bool {
+	for column := range r.columns {
+		if strings.HasPrefix(column, "tier_") && r.str(column) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// ticketTier reads the tier columns
+func (r *csvRow) ticketTier() request.EventsFileTicketTier {
+	tier := request.EventsFileTicketTier{
+		Name:            r.str("tier_name"),
+		Description:     r.str("tier_description"),
+		Price:           r.money("tier_price"),
+		Quota:           r.int("tier_quota"),
+		MaxPerOrder:     r.int("tier_max_per_order"),
+		AccessibleQuota: r.int("tier_accessible_quota"),
+		Row:             r.line,
+		FieldPrefix:     "tier_",
+	}
+	if r.str("tier_early_bird_price") != "" {
+		price := r.money("tier_early_bird_price")
+		tier.EarlyBirdPrice = &price
+	}
+	if r.str("tier_early_bird_end_date") != "" {
+		endDate := r.time("tier_early_bird_end_date")
+		tier.EarlyBirdEndDate = &endDate
+	}
+	if raw := r.str("tier_dynamic_pricing"); raw != "" {
+		var rule pricing.DynamicRule
+		if err := json.Unmarshal([]byte(raw), &rule); err != nil {
+			r.fail("tier_dynamic_pricing", "must be a JSON dynamic pricing rule")
+		} else {
+			tier.DynamicPricing = &rule
+		}
+	}
+	return tier
+}
+
+func (r *csvRow) str(column string) string {
+	i, ok := r.columns[column]
+	if !ok || i >= len(r.record) {
+		return ""
+	}
+	return strings.TrimSpace(r.record[i])
+}
+
+func (r *csvRow) time(column string) time.Time {
+	value := r.str(column)
+	if value == "" {
+		return time.Time{}
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		r.fail(column, "must be an RFC 3339 timestamp, e.g. 2026-12-31T19:00:00+07:00")
+	}
+	return parsed
+}
+
+func (r *csvRow) int(column string) int {
+	value := r.str(column)
+	if value == "" {
+		return 0
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		r.fail(column, "must be a whole number")
+	}
+	return parsed
+}
+
+func (r *csvRow) money(column string) money.Money {
+	value := r.str(column)
+	if value == "" {
+		return 0
+	}
+	parsed, err := money.Parse(value)
+	if err != nil {
+		r.fail(column, "must be an amount with at most 2 decimals, e.g. 150000 or 99.50")
+	}
+	return parsed
+}
+
+func (r *csvRow) fail(column, message string) {
+	r.errors = append(r.errors, response.ImportRowError{Row: r.line, Field: column, Message: message})
+}
+
+// validateFileRow checks the binding tags of a row, prefixing field names with prefix
+func validateFileRow(row int, prefix string, obj interface{}) []response.ImportRowError {
+	err := fileValidator.Struct(obj)
+	if err == nil {
+		return nil
+	}
+
+	var fieldErrors validator.ValidationErrors
+	if !errors.As(err, &fieldErrors) {
+		return []response.ImportRowError{{Row: row, Message: err.Error()}}
+	}
+
+	rowErrors := make([]response.ImportRowError, 0, len(fieldErrors))
+	for _, fieldError := range fieldErrors {
+		rowErrors = append(rowErrors, response.ImportRowError{
+			Row:     row,
+			Field:   prefix + fieldError.Field(),
+			Message: validationMessage(obj, fieldError),
+		})
+	}
+	return rowErrors
+}
+
+// validationMessage describes a failed binding tag
+func validationMessage(obj interface{}, fieldError validator.FieldError) string {
+	switch fieldError.Tag() {
+	case "required":
+		return "is required"
+	case "min", "max":
+		bound := "at least"
+		if fieldError.Tag() == "max" {
+			bound = "at most"
+		}
+		if fieldError.Kind() == reflect.String {
+			return fmt.Sprintf("must be %s %s characters", bound, fieldError.Param())
+		}
+		return fmt.Sprintf("must be %s %s", bound, fieldError.Param())
+	case "oneof":
+		return "must be one of: " + strings.ReplaceAll(fieldError.Param(), " ", ", ")
+	case "gtfield":
+		other := fieldError.Param()
+		if field, ok := reflect.Indirect(reflect.ValueOf(obj)).Type().FieldByName(other); ok {
+			other = jsonFieldName(field)
+		}
+		return "must be after " + other
+	default:
+		return fmt.Sprintf("is invalid (%s)", fieldError.Tag())
+	}
+}
+
+// jsonFieldName returns the JSON name of a struct field
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// csvRecord orders values by the CSV columns
+func csvRecord(values map[string]string) []string {
+	record := make([]string, len(request.EventsCSVColumns))
+	for i, column := range request.EventsCSVColumns {
+		record[i] = values[column]
+	}
+	return record
+}
+
+// formatOptionalInt leaves zero values empty
+func formatOptionalInt(value int) string {
+	if value == 0 {
+		return ""
+	}
+	return strconv.Itoa(value)
+}
+
+// derefString returns the value of an optional string
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
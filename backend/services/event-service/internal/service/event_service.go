@@ -5,9 +5,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"log"
 	"time"
 
 	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/pricing"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/request"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/response"
@@ -22,6 +26,17 @@ var (
 	ErrInvalidDateRange    = errors.New("end date must be after start date")
 	ErrCannotUpdateSlug    = errors.New("slug cannot be updated")
 	ErrQuotaBelowSoldCount = errors.New("quota cannot be less than sold count")
+	ErrAccessibleBelowSold = errors.New("accessible quota cannot be less than accessible sold count")
+	ErrVersionConflict     = errors.New("resource was modified by another request")
+	ErrZoneNotFound        = errors.New("zone not found")
+	ErrZoneCodeExists      = errors.New("zone code already exists for this event")
+	ErrTicketTierHasOrders = errors.New("ticket tier has orders")
+	ErrEventCompleted      = errors.New("event is completed")
+	ErrFAQNotFound         = errors.New("faq not found")
+	ErrSessionNotFound     = errors.New("session not found")
+	ErrSessionOutsideEvent = errors.New("session must take place within the event dates")
+	ErrSandboxLiveEvent    = errors.New("only draft events can be switched to sandbox mode")
+	ErrSandboxOrdersRemain = errors.New("sandbox test orders must be cleared before going live")
 )
 
 // Cache TTL constants
@@ -31,6 +46,9 @@ const (
 	cacheEventListingTTL   = 5 * time.Minute  // Event listing cache TTL
 )
 
+// priceHistoryLimit caps the prices returned for a ticket tier
+const priceHistoryLimit = 200
+
 // EventService defines interface for event business logic
 type EventService interface {
 	// Event operations
@@ -41,6 +59,9 @@ type EventService interface {
 	UpdateEvent(ctx context.Context, organizerID string, eventID string, req *request.UpdateEventRequest) (*response.EventResponse, error)
 	DeleteEvent(ctx context.Context, organizerID string, eventID string) error
 	GetOrganizerEvents(ctx context.Context, organizerID string) ([]response.EventResponse, error)
+	GetOrganizerEventIDs(ctx context.Context, organizerID string) (*response.OrganizerEventIDsResponse, error)
+	ImportEvents(ctx context.Context, organizerID string, format string, body io.Reader, dryRun bool) (*response.ImportEventsResponse, error)
+	ExportEvents(ctx context.Context, organizerID string, status string) (*request.EventsFile, error)
 
 	// Ticket tier operations
 	CreateTicketTier(ctx context.Context, organizerID string, req *request.CreateTicketTierRequest) (*response.TicketTierResponse, error)
@@ -48,12 +69,36 @@ type EventService interface {
 	GetTicketTiersByEventID(ctx context.Context, eventID string) ([]response.TicketTierResponse, error)
 	UpdateTicketTier(ctx context.Context, organizerID string, tierID string, req *request.UpdateTicketTierRequest) (*response.TicketTierResponse, error)
 	DeleteTicketTier(ctx context.Context, organizerID string, tierID string) error
+	ArchiveTicketTier(ctx context.Context, organizerID string, tierID string) (*response.TicketTierResponse, error)
+	GetTicketTierPriceHistory(ctx context.Context, organizerID string, tierID string) ([]response.TicketTierPriceResponse, error)
+
+	// Zone operations
+	CreateZone(ctx context.Context, organizerID string, eventID string, req *request.CreateZoneRequest) (*response.ZoneResponse, error)
+	GetEventZones(ctx context.Context, eventID string) ([]response.ZoneResponse, error)
+	DeleteZone(ctx context.Context, organizerID string, eventID string, zoneID string) error
+	SetTicketTierZones(ctx context.Context, organizerID string, tierID string, req *request.SetTicketTierZonesRequest) (*response.TicketTierZonesResponse, error)
+
+	// FAQ and agenda operations
+	CreateFAQ(ctx context.Context, organizerID string, eventID string, req *request.FAQRequest) (*response.FAQResponse, error)
+	UpdateFAQ(ctx context.Context, organizerID string, eventID string, faqID string, req *request.FAQRequest) (*response.FAQResponse, error)
+	DeleteFAQ(ctx context.Context, organizerID string, eventID string, faqID string) error
+	GetEventFAQs(ctx context.Context, eventID string) ([]response.FAQResponse, error)
+	CreateSession(ctx context.Context, organizerID string, eventID string, req *request.SessionRequest) (*response.SessionResponse, error)
+	UpdateSession(ctx context.Context, organizerID string, eventID string, sessionID string, req *request.SessionRequest) (*response.SessionResponse, error)
+	DeleteSession(ctx context.Context, organizerID string, eventID string, sessionID string) error
+	GetEventAgenda(ctx context.Context, eventID string) ([]response.SessionResponse, error)
 }
 
 // eventService implements EventService interface
 type eventService struct {
 	eventRepo      repository.EventRepository
 	ticketTierRepo repository.TicketTierRepository
+	zoneRepo       repository.ZoneRepository
+	contentRepo    repository.ContentRepository
+	performerRepo  repository.PerformerRepository
+	reportRepo     repository.ReportRepository
+	verifications  repository.VerificationRepository
+	plans          PlanService
 	cache          cache.RedisClient
 }
 
@@ -61,11 +106,23 @@ type eventService struct {
 func NewEventService(
 	eventRepo repository.EventRepository,
 	ticketTierRepo repository.TicketTierRepository,
+	zoneRepo repository.ZoneRepository,
+	contentRepo repository.ContentRepository,
+	performerRepo repository.PerformerRepository,
+	reportRepo repository.ReportRepository,
+	verificationRepo repository.VerificationRepository,
+	plans PlanService,
 	redisClient cache.RedisClient,
 ) EventService {
 	return &eventService{
 		eventRepo:      eventRepo,
 		ticketTierRepo: ticketTierRepo,
+		zoneRepo:       zoneRepo,
+		contentRepo:    contentRepo,
+		performerRepo:  performerRepo,
+		reportRepo:     reportRepo,
+		verifications:  verificationRepo,
+		plans:          plans,
 		cache:          redisClient,
 	}
 }
@@ -77,28 +134,13 @@ func (s *eventService) CreateEvent(ctx context.Context, organizerID string, req
 		return nil, ErrInvalidDateRange
 	}
 
-	// Generate slug
-	slug := utility.GenerateSlug(req.Title)
+	event := newEvent(organizerID, req)
 
-	// Create event entity
-	event := &entity.Event{
-		OrganizerID: organizerID,
-		Title:       req.Title,
-		Slug:        slug,
-		Description: &req.Description,
-		Category:    req.Category,
-		Location:    req.Location,
-		Venue:       &req.Venue,
-		StartDate:   req.StartDate,
-		EndDate:     req.EndDate,
-		Timezone:    req.Timezone,
-		BannerURL:   &req.BannerURL,
-		Status:      req.Status,
-	}
-
-	// Set default status if not provided
-	if event.Status == "" {
-		event.Status = "draft"
+	// Publishing counts towards the active event limit of the organizer's plan
+	if event.Status == entity.StatusPublished {
+		if err := s.checkEventQuota(ctx, organizerID); err != nil {
+			return nil, err
+		}
 	}
 
 	// Create event in repository
@@ -144,6 +186,12 @@ func (s *eventService) GetEventByID(ctx context.Context, id string) (*response.E
 	}
 
 	eventResp := response.ToEventResponse(event, tiers)
+	_, eventResp.Verified = organizerVerification(ctx, s.verifications, []string{event.OrganizerID})[event.OrganizerID]
+
+	// FAQ, agenda and lineup are part of the detail
+	if err := s.attachEventContent(ctx, eventResp); err != nil {
+		return nil, err
+	}
 
 	// Store in cache for next time
 	if s.cache != nil {
@@ -186,6 +234,12 @@ func (s *eventService) GetEventBySlug(ctx context.Context, slug string) (*respon
 	}
 
 	eventResp := response.ToEventResponse(event, tiers)
+	_, eventResp.Verified = organizerVerification(ctx, s.verifications, []string{event.OrganizerID})[event.OrganizerID]
+
+	// FAQ, agenda and lineup are part of the detail
+	if err := s.attachEventContent(ctx, eventResp); err != nil {
+		return nil, err
+	}
 
 	// Store in cache
 	if s.cache != nil {
@@ -204,10 +258,34 @@ func (s *eventService) ListEvents(ctx context.Context, filters request.ListEvent
 		return nil, fmt.Errorf("failed to list events: %w", err)
 	}
 
+	// Attach precomputed availability summary (no per-request tier aggregation)
+	eventIDs := make([]string, 0, len(events))
+	for _, event := range events {
+		eventIDs = append(eventIDs, event.ID)
+	}
+
+	availability, err := s.eventRepo.GetAvailability(ctx, eventIDs)
+	if err != nil {
+		// Listing still works without the summary
+		log.Printf("[EventService] Failed to get event availability: %v", err)
+		availability = nil
+	}
+
+	organizerIDs := make([]string, 0, len(events))
+	for _, event := range events {
+		organizerIDs = append(organizerIDs, event.OrganizerID)
+	}
+	verified := organizerVerification(ctx, s.verifications, organizerIDs)
+
 	// Convert to response
 	eventResponses := make([]response.EventResponse, 0, len(events))
 	for _, event := range events {
-		eventResponses = append(eventResponses, *response.ToEventResponse(&event, nil))
+		eventResponse := response.ToEventResponse(&event, nil)
+		_, eventResponse.Verified = verified[event.OrganizerID]
+		if a, ok := availability[event.ID]; ok {
+			eventResponse.Availability = response.ToEventAvailabilityResponse(&a)
+		}
+		eventResponses = append(eventResponses, *eventResponse)
 	}
 
 	// Calculate pagination metadata
@@ -253,6 +331,19 @@ func (s *eventService) UpdateEvent(ctx context.Context, organizerID string, even
 		return nil, ErrUnauthorized
 	}
 
+	// Completed events are settled; editing one could put it back on sale
+	if event.Status == entity.StatusCompleted {
+		return nil, ErrEventCompleted
+	}
+
+	// Optimistic concurrency: reject stale edits early,
+	// repository re-checks the version atomically on UPDATE
+	if req.Version != nil {
+		if *req.Version != event.Version {
+			return nil, ErrVersionConflict
+		}
+	}
+
 	// Update fields if provided
 	if req.Title != "" {
 		event.Title = req.Title
@@ -278,10 +369,51 @@ func (s *eventService) UpdateEvent(ctx context.Context, organizerID string, even
 	if req.Timezone != "" {
 		event.Timezone = req.Timezone
 	}
+	if req.ScanPolicy != "" {
+		event.ScanPolicy = req.ScanPolicy
+	}
+	if req.RefundPolicy != "" {
+		event.RefundPolicy = refundPolicyTemplate(req.RefundPolicy)
+	}
 	if req.BannerURL != "" {
 		event.BannerURL = &req.BannerURL
 	}
+	if req.IsSandbox != nil && *req.IsSandbox != event.IsSandbox {
+		// Orders keep the mode they were placed in, a live event going back to sandbox would mix them
+		if *req.IsSandbox && event.Status != entity.StatusDraft {
+			return nil, ErrSandboxLiveEvent
+		}
+		// Going live needs the test orders cleared first, they would otherwise hold real inventory
+		if !*req.IsSandbox {
+			hasOrders, err := s.eventRepo.HasSandboxOrders(ctx, eventID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to check sandbox orders: %w", err)
+			}
+			if hasOrders {
+				return nil, ErrSandboxOrdersRemain
+			}
+		}
+		event.IsSandbox = *req.IsSandbox
+	}
+	if req.DynamicQR != nil {
+		event.DynamicQR = *req.DynamicQR
+	}
 	if req.Status != "" {
+		// Publishing counts towards the active event limit of the organizer's plan
+		if req.Status == entity.StatusPublished && event.Status != entity.StatusPublished {
+			if err := s.checkEventQuota(ctx, organizerID); err != nil {
+				return nil, err
+			}
+
+			// Events held by moderation stay unpublished until a moderator dismisses the reports
+			moderation, err := s.reportRepo.GetModeration(ctx, eventID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get event moderation: %w", err)
+			}
+			if moderation != nil {
+				return nil, ErrEventUnderReview
+			}
+		}
 		event.Status = req.Status
 	}
 
@@ -295,6 +427,9 @@ func (s *eventService) UpdateEvent(ctx context.Context, organizerID string, even
 		if errors.Is(err, repository.ErrEventNotFound) {
 			return nil, ErrEventNotFound
 		}
+		if errors.Is(err, repository.ErrEventVersionConflict) {
+			return nil, ErrVersionConflict
+		}
 		return nil, fmt.Errorf("failed to update event: %w", err)
 	}
 
@@ -362,6 +497,17 @@ func (s *eventService) GetOrganizerEvents(ctx context.Context, organizerID strin
 	return eventResponses, nil
 }
 
+// GetOrganizerEventIDs retrieves the IDs of an organizer's events
+// Used by the gateway's ownership cache, so it skips loading full events
+func (s *eventService) GetOrganizerEventIDs(ctx context.Context, organizerID string) (*response.OrganizerEventIDsResponse, error) {
+	ids, err := s.eventRepo.GetIDsByOrganizerID(ctx, organizerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organizer event ids: %w", err)
+	}
+
+	return &response.OrganizerEventIDsResponse{EventIDs: ids}, nil
+}
+
 // CreateTicketTier creates new ticket tier for an event
 func (s *eventService) CreateTicketTier(ctx context.Context, organizerID string, req *request.CreateTicketTierRequest) (*response.TicketTierResponse, error) {
 	// Validate request
@@ -382,23 +528,21 @@ func (s *eventService) CreateTicketTier(ctx context.Context, organizerID string,
 		return nil, ErrUnauthorized
 	}
 
-	// Create ticket tier entity
-	tier := &entity.TicketTier{
-		EventID:          req.EventID,
-		Name:             req.Name,
-		Description:      &req.Description,
-		Price:            req.Price,
-		Quota:            req.Quota,
-		MaxPerOrder:      req.MaxPerOrder,
-		EarlyBirdPrice:   req.EarlyBirdPrice,
-		EarlyBirdEndDate: req.EarlyBirdEndDate,
+	if err := s.checkTicketQuota(ctx, organizerID, event.ID, "", req.Quota); err != nil {
+		return nil, err
 	}
 
+	tier := newTicketTier(req, event.StartDate)
+
 	// Create in repository
 	if err := s.ticketTierRepo.Create(ctx, tier); err != nil {
 		return nil, fmt.Errorf("failed to create ticket tier: %w", err)
 	}
 
+	s.recordPrice(ctx, tier, entity.PriceReasonCreated)
+
+	s.refreshAvailability(ctx)
+
 	return response.ToTicketTierResponse(tier), nil
 }
 
@@ -422,9 +566,12 @@ func (s *eventService) GetTicketTiersByEventID(ctx context.Context, eventID stri
 		return nil, fmt.Errorf("failed to get ticket tiers: %w", err)
 	}
 
-	// Convert to response
+	// Convert to response, archived tiers are no longer on sale
 	tierResponses := make([]response.TicketTierResponse, 0, len(tiers))
 	for _, tier := range tiers {
+		if tier.IsArchived() {
+			continue
+		}
 		tierResponses = append(tierResponses, *response.ToTicketTierResponse(&tier))
 	}
 
@@ -460,10 +607,32 @@ func (s *eventService) UpdateTicketTier(ctx context.Context, organizerID string,
 		return nil, ErrUnauthorized
 	}
 
-	// Validate quota is not less than sold count
+	// Optimistic concurrency: reject stale edits early,
+	// repository re-checks the version atomically on UPDATE
+	if req.Version != nil {
+		if *req.Version != tier.Version {
+			return nil, ErrVersionConflict
+		}
+	}
+
+	// Validate quota is not less than sold count; sold_count also holds tickets of
+	// in-flight reservations (ticketing service reserves inventory on it), the
+	// repository re-checks atomically on UPDATE
 	if req.Quota < tier.SoldCount {
 		return nil, ErrQuotaBelowSoldCount
 	}
+	if req.AccessibleQuota < tier.AccessibleSoldCount {
+		return nil, ErrAccessibleBelowSold
+	}
+
+	// Only raising the quota is checked, so organizers over a lowered plan limit can still shrink tiers
+	if req.Quota > tier.Quota {
+		if err := s.checkTicketQuota(ctx, organizerID, event.ID, tier.ID, req.Quota); err != nil {
+			return nil, err
+		}
+	}
+
+	previousPrice, previousBase := tier.Price, tier.BasePrice
 
 	// Update fields
 	tier.Name = req.Name
@@ -473,15 +642,38 @@ func (s *eventService) UpdateTicketTier(ctx context.Context, organizerID string,
 	tier.MaxPerOrder = req.MaxPerOrder
 	tier.EarlyBirdPrice = req.EarlyBirdPrice
 	tier.EarlyBirdEndDate = req.EarlyBirdEndDate
+	tier.AccessibleQuota = req.AccessibleQuota
+	applyDynamicPricing(tier, req.DynamicPricing, req.Price, event.StartDate)
 
 	// Update in repository
 	if err := s.ticketTierRepo.Update(ctx, tier); err != nil {
 		if errors.Is(err, repository.ErrTicketTierNotFound) {
 			return nil, ErrTicketTierNotFound
 		}
+		if errors.Is(err, repository.ErrTicketTierVersionConflict) {
+			return nil, ErrVersionConflict
+		}
+		if errors.Is(err, repository.ErrTicketTierQuotaBelowSold) {
+			return nil, ErrQuotaBelowSoldCount
+		}
+		if errors.Is(err, repository.ErrTicketTierAccessibleBelowSold) {
+			return nil, ErrAccessibleBelowSold
+		}
 		return nil, fmt.Errorf("failed to update ticket tier: %w", err)
 	}
 
+	if tier.Price != previousPrice || !equalPrice(tier.BasePrice, previousBase) {
+		s.recordPrice(ctx, tier, entity.PriceReasonOrganizer)
+	}
+
+	// Invalidate event cache (event detail embeds ticket tiers with their versions)
+	if s.cache != nil {
+		s.cache.Del(ctx, fmt.Sprintf("event:id:%s", event.ID))
+		s.cache.Del(ctx, fmt.Sprintf("event:slug:%s", event.Slug))
+	}
+
+	s.refreshAvailability(ctx)
+
 	return response.ToTicketTierResponse(tier), nil
 }
 
@@ -509,16 +701,377 @@ func (s *eventService) DeleteTicketTier(ctx context.Context, organizerID string,
 		return ErrUnauthorized
 	}
 
-	// TODO: Check if there are existing orders for this ticket tier
-	// For now, we'll allow deletion but in production this should be prevented
+	// Deleting a tier with orders would orphan order items and tickets,
+	// such tiers can only be archived
+	hasOrders, err := s.ticketTierRepo.HasOrders(ctx, tierID)
+	if err != nil {
+		return fmt.Errorf("failed to check ticket tier orders: %w", err)
+	}
+	if hasOrders {
+		return ErrTicketTierHasOrders
+	}
 
 	// Delete ticket tier
 	if err := s.ticketTierRepo.Delete(ctx, tierID); err != nil {
 		if errors.Is(err, repository.ErrTicketTierNotFound) {
 			return ErrTicketTierNotFound
 		}
+		// An order placed after the check above still blocks deletion through the foreign key
+		if errors.Is(err, repository.ErrTicketTierHasOrders) {
+			return ErrTicketTierHasOrders
+		}
 		return fmt.Errorf("failed to delete ticket tier: %w", err)
 	}
 
+	s.refreshAvailability(ctx)
+
 	return nil
 }
+
+// ArchiveTicketTier hides ticket tier from sale while keeping it for existing orders and tickets
+func (s *eventService) ArchiveTicketTier(ctx context.Context, organizerID string, tierID string) (*response.TicketTierResponse, error) {
+	// Get existing ticket tier
+	tier, err := s.ticketTierRepo.GetByID(ctx, tierID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketTierNotFound) {
+			return nil, ErrTicketTierNotFound
+		}
+		return nil, fmt.Errorf("failed to get ticket tier: %w", err)
+	}
+
+	// Check if user is the event organizer
+	event, err := s.eventRepo.GetByID(ctx, tier.EventID)
+	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return nil, ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	if event.OrganizerID != organizerID {
+		return nil, ErrUnauthorized
+	}
+
+	if err := s.ticketTierRepo.Archive(ctx, tierID); err != nil {
+		if errors.Is(err, repository.ErrTicketTierNotFound) {
+			return nil, ErrTicketTierNotFound
+		}
+		return nil, fmt.Errorf("failed to archive ticket tier: %w", err)
+	}
+
+	// Invalidate event cache (event detail embeds ticket tiers)
+	if s.cache != nil {
+		s.cache.Del(ctx, fmt.Sprintf("event:id:%s", event.ID))
+		s.cache.Del(ctx, fmt.Sprintf("event:slug:%s", event.Slug))
+	}
+
+	s.refreshAvailability(ctx)
+
+	archived, err := s.ticketTierRepo.GetByID(ctx, tierID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket tier: %w", err)
+	}
+
+	return response.ToTicketTierResponse(archived), nil
+}
+
+// GetTicketTierPriceHistory retrieves the prices a ticket tier was sold at, newest first
+func (s *eventService) GetTicketTierPriceHistory(ctx context.Context, organizerID string, tierID string) ([]response.TicketTierPriceResponse, error) {
+	tier, err := s.ticketTierRepo.GetByID(ctx, tierID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketTierNotFound) {
+			return nil, ErrTicketTierNotFound
+		}
+		return nil, fmt.Errorf("failed to get ticket tier: %w", err)
+	}
+
+	if _, err := s.getOwnedEvent(ctx, organizerID, tier.EventID); err != nil {
+		return nil, err
+	}
+
+	prices, err := s.ticketTierRepo.GetPriceHistory(ctx, tierID, priceHistoryLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get price history: %w", err)
+	}
+
+	return response.ToTicketTierPriceResponses(prices), nil
+}
+
+// CreateZone creates new access zone for an event
+func (s *eventService) CreateZone(ctx context.Context, organizerID string, eventID string, req *request.CreateZoneRequest) (*response.ZoneResponse, error) {
+	if err := req.Normalize(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOwnedEvent(ctx, organizerID, eventID); err != nil {
+		return nil, err
+	}
+
+	zone := &entity.EventZone{
+		EventID: eventID,
+		Code:    req.Code,
+		Name:    req.Name,
+	}
+
+	if err := s.zoneRepo.Create(ctx, zone); err != nil {
+		if errors.Is(err, repository.ErrZoneCodeExists) {
+			return nil, ErrZoneCodeExists
+		}
+		return nil, fmt.Errorf("failed to create zone: %w", err)
+	}
+
+	return response.ToZoneResponse(zone), nil
+}
+
+// GetEventZones retrieves all zones of an event with their allowed ticket tiers
+func (s *eventService) GetEventZones(ctx context.Context, eventID string) ([]response.ZoneResponse, error) {
+	zones, err := s.zoneRepo.GetByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get zones: %w", err)
+	}
+
+	zoneResponses := make([]response.ZoneResponse, 0, len(zones))
+	for _, zone := range zones {
+		zoneResponses = append(zoneResponses, *response.ToZoneResponse(&zone))
+	}
+
+	return zoneResponses, nil
+}
+
+// DeleteZone deletes an access zone of an event
+func (s *eventService) DeleteZone(ctx context.Context, organizerID string, eventID string, zoneID string) error {
+	if _, err := s.getOwnedEvent(ctx, organizerID, eventID); err != nil {
+		return err
+	}
+
+	if err := s.zoneRepo.Delete(ctx, eventID, zoneID); err != nil {
+		if errors.Is(err, repository.ErrZoneNotFound) {
+			return ErrZoneNotFound
+		}
+		return fmt.Errorf("failed to delete zone: %w", err)
+	}
+
+	return nil
+}
+
+// SetTicketTierZones replaces the zones a ticket tier may enter
+// Tickets issued afterwards carry the new zones; validation always checks the current mapping
+func (s *eventService) SetTicketTierZones(ctx context.Context, organizerID string, tierID string, req *request.SetTicketTierZonesRequest) (*response.TicketTierZonesResponse, error) {
+	req.Normalize()
+
+	tier, err := s.ticketTierRepo.GetByID(ctx, tierID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketTierNotFound) {
+			return nil, ErrTicketTierNotFound
+		}
+		return nil, fmt.Errorf("failed to get ticket tier: %w", err)
+	}
+
+	if _, err := s.getOwnedEvent(ctx, organizerID, tier.EventID); err != nil {
+		return nil, err
+	}
+
+	zones, err := s.zoneRepo.GetByEventID(ctx, tier.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get zones: %w", err)
+	}
+
+	zoneIDsByCode := make(map[string]string, len(zones))
+	for _, zone := range zones {
+		zoneIDsByCode[zone.Code] = zone.ID
+	}
+
+	zoneIDs := make([]string, 0, len(req.ZoneCodes))
+	for _, code := range req.ZoneCodes {
+		zoneID, ok := zoneIDsByCode[code]
+		if !ok {
+			return nil, ErrZoneNotFound
+		}
+		zoneIDs = append(zoneIDs, zoneID)
+	}
+
+	if err := s.zoneRepo.SetTierZones(ctx, tierID, zoneIDs); err != nil {
+		return nil, fmt.Errorf("failed to set ticket tier zones: %w", err)
+	}
+
+	return &response.TicketTierZonesResponse{
+		TicketTierID: tierID,
+		ZoneCodes:    req.ZoneCodes,
+	}, nil
+}
+
+// newEvent builds a new event of organizerID from a create request
+func newEvent(organizerID string, req *request.CreateEventRequest) *entity.Event {
+	event := &entity.Event{
+		OrganizerID: organizerID,
+		Title:       req.Title,
+		Slug:        utility.GenerateSlug(req.Title),
+		Description: &req.Description,
+		Category:    req.Category,
+		Location:    req.Location,
+		Venue:       &req.Venue,
+		StartDate:   req.StartDate,
+		EndDate:     req.EndDate,
+		Timezone:    req.Timezone,
+		ScanPolicy:  req.ScanPolicy,
+		BannerURL:   &req.BannerURL,
+		Status:      req.Status,
+		IsSandbox:   req.IsSandbox,
+		DynamicQR:   req.DynamicQR,
+	}
+	event.RefundPolicy = refundPolicyTemplate(req.RefundPolicy)
+
+	// Set default status if not provided
+	if event.Status == "" {
+		event.Status = entity.StatusDraft
+	}
+
+	// Tickets are single-use unless the organizer allows re-entry
+	if event.ScanPolicy == "" {
+		event.ScanPolicy = entity.ScanPolicySingleUse
+	}
+
+	return event
+}
+
+// refundPolicyTemplate maps a requested refund policy to the stored template, nil for a custom policy
+func refundPolicyTemplate(policy string) *string {
+	if policy == "" || policy == entity.RefundPolicyCustom {
+		return nil
+	}
+	return &policy
+}
+
+// newTicketTier builds a new ticket tier from a create request for an event starting at eventStart
+func newTicketTier(req *request.CreateTicketTierRequest, eventStart time.Time) *entity.TicketTier {
+	tier := &entity.TicketTier{
+		EventID:          req.EventID,
+		Name:             req.Name,
+		Description:      &req.Description,
+		Price:            req.Price,
+		Quota:            req.Quota,
+		MaxPerOrder:      req.MaxPerOrder,
+		EarlyBirdPrice:   req.EarlyBirdPrice,
+		EarlyBirdEndDate: req.EarlyBirdEndDate,
+		AccessibleQuota:  req.AccessibleQuota,
+	}
+	applyDynamicPricing(tier, req.DynamicPricing, req.Price, eventStart)
+	return tier
+}
+
+// invalidateEventDetail drops the cached detail of an event (both ID and slug keys)
+func invalidateEventDetail(ctx context.Context, redisClient cache.RedisClient, event *entity.Event) {
+	if redisClient != nil {
+		redisClient.Del(ctx, fmt.Sprintf("event:id:%s", event.ID))
+		redisClient.Del(ctx, fmt.Sprintf("event:slug:%s", event.Slug))
+	}
+}
+
+// getOwnedEvent retrieves an event and checks that the user is its organizer
+func (s *eventService) getOwnedEvent(ctx context.Context, organizerID string, eventID string) (*entity.Event, error) {
+	return ownedEvent(ctx, s.eventRepo, organizerID, eventID)
+}
+
+// ownedEvent retrieves an event from eventRepo and checks that the user is its organizer
+func ownedEvent(ctx context.Context, eventRepo repository.EventRepository, organizerID string, eventID string) (*entity.Event, error) {
+	event, err := eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return nil, ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	if event.OrganizerID != organizerID {
+		return nil, ErrUnauthorized
+	}
+
+	return event, nil
+}
+
+// checkEventQuota checks the organizer may publish one more event under their plan
+func (s *eventService) checkEventQuota(ctx context.Context, organizerID string) error {
+	plan, err := s.plans.GetPlan(ctx, organizerID)
+	if err != nil {
+		return err
+	}
+	if plan.MaxActiveEvents == 0 {
+		return nil
+	}
+
+	active, err := s.eventRepo.CountActiveByOrganizerID(ctx, organizerID)
+	if err != nil {
+		return fmt.Errorf("failed to count active events: %w", err)
+	}
+
+	if !entity.WithinLimit(plan.MaxActiveEvents, active+1) {
+		return ErrEventQuotaExceeded
+	}
+	return nil
+}
+
+// checkTicketQuota checks the event's tier quotas stay within the organizer's plan
+// once the tier identified by tierID (empty for a new tier) has the given quota
+func (s *eventService) checkTicketQuota(ctx context.Context, organizerID string, eventID string, tierID string, quota int) error {
+	plan, err := s.plans.GetPlan(ctx, organizerID)
+	if err != nil {
+		return err
+	}
+	if plan.MaxTicketsPerEvent == 0 {
+		return nil
+	}
+
+	tiers, err := s.ticketTierRepo.GetByEventID(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to get ticket tiers: %w", err)
+	}
+
+	// Archived tiers keep their quota: their sold tickets still count
+	total := quota
+	for _, tier := range tiers {
+		if tier.ID != tierID {
+			total += tier.Quota
+		}
+	}
+
+	if !entity.WithinLimit(plan.MaxTicketsPerEvent, total) {
+		return ErrTicketQuotaExceeded
+	}
+	return nil
+}
+
+// recordPrice adds the tier's price to its history
+// The tier is already saved, so a failure is logged rather than failing the request
+func (s *eventService) recordPrice(ctx context.Context, tier *entity.TicketTier, reason string) {
+	if err := s.ticketTierRepo.RecordPrice(ctx, tier, reason); err != nil {
+		log.Printf("[EventService] Failed to record price of ticket tier %s: %v", tier.ID, err)
+	}
+}
+
+// applyDynamicPricing sets the tier's rule and prices it from basePrice, or returns it to a fixed price
+func applyDynamicPricing(tier *entity.TicketTier, rule *pricing.DynamicRule, basePrice money.Money, eventStart time.Time) {
+	tier.DynamicPricing = rule
+	if rule == nil {
+		tier.BasePrice = nil
+		return
+	}
+
+	tier.BasePrice = &basePrice
+	tier.Price = rule.Price(basePrice, tier.SoldCount, tier.Quota, time.Until(eventStart))
+}
+
+// equalPrice compares optional prices
+func equalPrice(a, b *money.Money) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// refreshAvailability refreshes the listing availability summary after ticket tier changes
+// Failure is logged only, listing filters are briefly stale until the next refresh
+func (s *eventService) refreshAvailability(ctx context.Context) {
+	if err := s.ticketTierRepo.RefreshAvailability(ctx); err != nil {
+		log.Printf("[EventService] Failed to refresh event availability: %v", err)
+	}
+}
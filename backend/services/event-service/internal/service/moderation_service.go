@@ -0,0 +1,346 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/repository"
+)
+
+var (
+	ErrAlreadyReported  = errors.New("event already reported by this user")
+	ErrOwnEventReport   = errors.New("organizers cannot report their own events")
+	ErrEventUnderReview = errors.New("event is held by moderation")
+)
+
+// Moderation actions emailed to the organizer
+const (
+	moderationActionUnpublished = "unpublished"
+	moderationActionRestored    = "restored"
+	moderationActionRemoved     = "removed"
+)
+
+// ModerationNotifier defines interface for emailing organizers about moderation actions (notification service)
+type ModerationNotifier interface {
+	SendModerationEmail(ctx context.Context, req *client.ModerationEmailRequest) error
+}
+
+// ModerationService defines interface for event abuse reports and their review
+type ModerationService interface {
+	// User operations
+	ReportEvent(ctx context.Context, userID string, eventID string, req *request.ReportEventRequest) (*response.EventReportResponse, error)
+
+	// Moderator operations, scoped to the request tenant
+	ListReportedEvents(ctx context.Context, req request.ListReportedEventsRequest) (*response.PaginatedReportedEventsResponse, error)
+	GetEventReports(ctx context.Context, eventID string) (*response.EventReportsResponse, error)
+	ModerateEvent(ctx context.Context, moderatorID string, eventID string, req *request.ModerateEventRequest) (*response.EventReportsResponse, error)
+}
+
+// moderationService implements ModerationService interface
+type moderationService struct {
+	reportRepo             repository.ReportRepository
+	eventRepo              repository.EventRepository
+	userRepo               repository.UserRepository
+	verifications          repository.VerificationRepository
+	notifier               ModerationNotifier
+	cache                  cache.RedisClient
+	autoUnpublishThreshold int
+	verifiedThreshold      int // autoUnpublishThreshold for events of verified organizers
+}
+
+// NewModerationService creates new moderation service instance
+// A published event reaching autoUnpublishThreshold open reports (verifiedThreshold when its organizer is verified)
+// is unpublished until a moderator reviews it;
+// organizer emails are best effort, a failed email doesn't fail the report or decision
+func NewModerationService(
+	reportRepo repository.ReportRepository,
+	eventRepo repository.EventRepository,
+	userRepo repository.UserRepository,
+	verificationRepo repository.VerificationRepository,
+	notifier ModerationNotifier,
+	redisClient cache.RedisClient,
+	autoUnpublishThreshold int,
+	verifiedThreshold int,
+) ModerationService {
+	return &moderationService{
+		reportRepo:             reportRepo,
+		eventRepo:              eventRepo,
+		userRepo:               userRepo,
+		verifications:          verificationRepo,
+		notifier:               notifier,
+		cache:                  redisClient,
+		autoUnpublishThreshold: autoUnpublishThreshold,
+		verifiedThreshold:      verifiedThreshold,
+	}
+}
+
+// ReportEvent files a user's abuse report, holding the event once it is reported too often
+func (s *moderationService) ReportEvent(ctx context.Context, userID string, eventID string, req *request.ReportEventRequest) (*response.EventReportResponse, error) {
+	req.Normalize()
+
+	event, err := s.getEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.OrganizerID == userID {
+		return nil, ErrOwnEventReport
+	}
+
+	report := &entity.EventReport{
+		EventID:    event.ID,
+		TenantID:   event.TenantID,
+		ReporterID: userID,
+		Reason:     req.Reason,
+	}
+	if req.Details != "" {
+		report.Details = &req.Details
+	}
+
+	if err := s.reportRepo.Create(ctx, report); err != nil {
+		if errors.Is(err, repository.ErrReportAlreadyOpen) {
+			return nil, ErrAlreadyReported
+		}
+		return nil, fmt.Errorf("failed to create event report: %w", err)
+	}
+
+	// The report is recorded either way, so a failed hold is only logged
+	if event.Status == entity.StatusPublished {
+		if err := s.holdIfReportedTooOften(ctx, event); err != nil {
+			log.Printf("[Moderation] Failed to hold event %s: %v", event.ID, err)
+		}
+	}
+
+	return response.ToEventReportResponse(report), nil
+}
+
+// ListReportedEvents retrieves the moderation queue, most reported events first
+func (s *moderationService) ListReportedEvents(ctx context.Context, req request.ListReportedEventsRequest) (*response.PaginatedReportedEventsResponse, error) {
+	page := 1
+	if req.Page > 0 {
+		page = req.Page
+	}
+
+	limit := 20
+	if req.Limit > 0 {
+		limit = req.Limit
+	}
+
+	summaries, total, err := s.reportRepo.ListOpenSummaries(ctx, limit, (page-1)*limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reported events: %w", err)
+	}
+
+	eventIDs := make([]string, len(summaries))
+	for i, summary := range summaries {
+		eventIDs[i] = summary.EventID
+	}
+
+	events, err := s.eventRepo.GetByIDs(ctx, eventIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reported events: %w", err)
+	}
+	eventsByID := make(map[string]*entity.Event, len(events))
+	for i := range events {
+		eventsByID[events[i].ID] = &events[i]
+	}
+
+	moderations, err := s.reportRepo.GetModerations(ctx, eventIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event moderations: %w", err)
+	}
+
+	organizerIDs := make([]string, 0, len(events))
+	for i := range events {
+		organizerIDs = append(organizerIDs, events[i].OrganizerID)
+	}
+	verified := organizerVerification(ctx, s.verifications, organizerIDs)
+
+	queue := make([]response.ReportedEventResponse, 0, len(summaries))
+	for i := range summaries {
+		event, ok := eventsByID[summaries[i].EventID]
+		if !ok {
+			continue
+		}
+		var moderation *entity.EventModeration
+		if m, ok := moderations[event.ID]; ok {
+			moderation = &m
+		}
+		entry := response.ToReportedEventResponse(event, &summaries[i], moderation)
+		_, entry.Verified = verified[event.OrganizerID]
+		queue = append(queue, entry)
+	}
+
+	totalPages := int(total) / limit
+	if int(total)%limit > 0 {
+		totalPages++
+	}
+
+	return &response.PaginatedReportedEventsResponse{
+		Events: queue,
+		Meta: response.PaginationMeta{
+			CurrentPage: page,
+			PerPage:     limit,
+			Total:       total,
+			TotalPages:  totalPages,
+		},
+	}, nil
+}
+
+// GetEventReports retrieves an event with its moderation hold and all its reports
+func (s *moderationService) GetEventReports(ctx context.Context, eventID string) (*response.EventReportsResponse, error) {
+	event, err := s.getEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := s.reportRepo.GetOpenSummary(ctx, event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get report summary: %w", err)
+	}
+
+	moderation, err := s.reportRepo.GetModeration(ctx, event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event moderation: %w", err)
+	}
+
+	reports, err := s.reportRepo.GetByEventID(ctx, event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event reports: %w", err)
+	}
+
+	resp := &response.EventReportsResponse{
+		ReportedEventResponse: response.ToReportedEventResponse(event, summary, moderation),
+		Reports:               make([]response.EventReportResponse, len(reports)),
+	}
+	_, resp.Verified = organizerVerification(ctx, s.verifications, []string{event.OrganizerID})[event.OrganizerID]
+	for i := range reports {
+		resp.Reports[i] = *response.ToEventReportResponse(&reports[i])
+	}
+
+	return resp, nil
+}
+
+// ModerateEvent closes the open reports of an event with a moderator's decision
+// dismiss lifts any hold (republishing the event if the hold unpublished it), remove unpublishes it for good
+func (s *moderationService) ModerateEvent(ctx context.Context, moderatorID string, eventID string, req *request.ModerateEventRequest) (*response.EventReportsResponse, error) {
+	req.Normalize()
+
+	event, err := s.getEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reports closed by the decision, for the organizer email
+	summary, err := s.reportRepo.GetOpenSummary(ctx, event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get report summary: %w", err)
+	}
+
+	var note *string
+	if req.Note != "" {
+		note = &req.Note
+	}
+
+	action := ""
+	switch req.Decision {
+	case entity.ModerationDecisionDismiss:
+		moderation, err := s.reportRepo.Dismiss(ctx, event.ID, moderatorID, note)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dismiss event reports: %w", err)
+		}
+		// Only organizers whose event was held hear about dismissed reports
+		if moderation != nil {
+			action = moderationActionRestored
+		}
+	case entity.ModerationDecisionRemove:
+		if _, err := s.reportRepo.Remove(ctx, event.ID, moderatorID, note); err != nil {
+			return nil, fmt.Errorf("failed to remove event: %w", err)
+		}
+		action = moderationActionRemoved
+	}
+
+	invalidateEventDetail(ctx, s.cache, event)
+	if action != "" {
+		s.notifyOrganizer(ctx, event, action, summary, req.Note)
+	}
+
+	return s.GetEventReports(ctx, event.ID)
+}
+
+// holdIfReportedTooOften unpublishes an event pending review once its open reports reach the threshold
+// of its organizer, verified organizers having a known identity to answer for their events
+func (s *moderationService) holdIfReportedTooOften(ctx context.Context, event *entity.Event) error {
+	summary, err := s.reportRepo.GetOpenSummary(ctx, event.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get report summary: %w", err)
+	}
+
+	threshold := s.autoUnpublishThreshold
+	if _, ok := organizerVerification(ctx, s.verifications, []string{event.OrganizerID})[event.OrganizerID]; ok {
+		threshold = s.verifiedThreshold
+	}
+	if summary.OpenReports < threshold {
+		return nil
+	}
+
+	// Concurrent reports race for the hold; only the one creating it notifies
+	held, err := s.reportRepo.Hold(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+	if !held {
+		return nil
+	}
+
+	log.Printf("[Moderation] Event %s unpublished pending review after %d reports", event.ID, summary.OpenReports)
+
+	invalidateEventDetail(ctx, s.cache, event)
+	s.notifyOrganizer(ctx, event, moderationActionUnpublished, summary, "")
+	return nil
+}
+
+// notifyOrganizer emails the organizer of an event about a moderation action, logging failures
+func (s *moderationService) notifyOrganizer(ctx context.Context, event *entity.Event, action string, summary *entity.ReportSummary, note string) {
+	if s.notifier == nil {
+		return
+	}
+
+	organizer, err := s.userRepo.GetByID(ctx, event.OrganizerID)
+	if err != nil {
+		log.Printf("[Moderation] Failed to get organizer of event %s: %v", event.ID, err)
+		return
+	}
+
+	err = s.notifier.SendModerationEmail(ctx, &client.ModerationEmailRequest{
+		EventID:        event.ID,
+		RecipientEmail: organizer.Email,
+		RecipientName:  organizer.FullName,
+		EventName:      event.Title,
+		Action:         action,
+		ReportCount:    summary.OpenReports,
+		Reasons:        summary.Reasons,
+		Note:           note,
+	})
+	if err != nil {
+		log.Printf("[Moderation] Failed to email organizer of event %s (%s): %v", event.ID, action, err)
+	}
+}
+
+// getEvent retrieves an event, mapping a missing event to ErrEventNotFound
+func (s *moderationService) getEvent(ctx context.Context, eventID string) (*entity.Event, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return nil, ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	return event, nil
+}
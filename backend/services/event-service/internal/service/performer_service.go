@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/repository"
+)
+
+var ErrPerformerNotFound = errors.New("performer not found")
+
+// PerformerService defines interface for performer directory business logic
+type PerformerService interface {
+	// Directory
+	CreatePerformer(ctx context.Context, userID string, req *request.PerformerRequest) (*response.PerformerResponse, error)
+	GetPerformer(ctx context.Context, id string) (*response.PerformerResponse, error)
+	UpdatePerformer(ctx context.Context, userID string, id string, req *request.PerformerRequest) (*response.PerformerResponse, error)
+	DeletePerformer(ctx context.Context, userID string, id string) error
+	SearchPerformers(ctx context.Context, req request.SearchPerformersRequest) (*response.PaginatedPerformersResponse, error)
+	GetPerformerEvents(ctx context.Context, id string, req request.PerformerEventsRequest) (*response.PaginatedEventsResponse, error)
+
+	// Event lineups
+	GetEventPerformers(ctx context.Context, eventID string) (*response.EventPerformersResponse, error)
+	SetEventPerformers(ctx context.Context, organizerID string, eventID string, req *request.SetEventPerformersRequest) (*response.EventPerformersResponse, error)
+}
+
+// performerService implements PerformerService interface
+type performerService struct {
+	performerRepo repository.PerformerRepository
+	eventRepo     repository.EventRepository
+	events        EventService
+	cache         cache.RedisClient
+	now           func() time.Time
+}
+
+// NewPerformerService creates new performer service instance
+// Lineups are part of the cached event detail, so changes invalidate the events featuring the performer
+func NewPerformerService(
+	performerRepo repository.PerformerRepository,
+	eventRepo repository.EventRepository,
+	events EventService,
+	redisClient cache.RedisClient,
+) PerformerService {
+	return &performerService{
+		performerRepo: performerRepo,
+		eventRepo:     eventRepo,
+		events:        events,
+		cache:         redisClient,
+		now:           time.Now,
+	}
+}
+
+// CreatePerformer adds a performer to the tenant's directory
+func (s *performerService) CreatePerformer(ctx context.Context, userID string, req *request.PerformerRequest) (*response.PerformerResponse, error) {
+	req.Normalize()
+
+	performer := &entity.Performer{CreatedBy: userID}
+	applyPerformerRequest(performer, req)
+
+	if err := s.performerRepo.Create(ctx, performer); err != nil {
+		return nil, fmt.Errorf("failed to create performer: %w", err)
+	}
+
+	return response.ToPerformerResponse(performer), nil
+}
+
+// GetPerformer retrieves a performer profile
+func (s *performerService) GetPerformer(ctx context.Context, id string) (*response.PerformerResponse, error) {
+	performer, err := s.getPerformer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.ToPerformerResponse(performer), nil
+}
+
+// UpdatePerformer replaces the profile of a performer created by the user
+func (s *performerService) UpdatePerformer(ctx context.Context, userID string, id string, req *request.PerformerRequest) (*response.PerformerResponse, error) {
+	req.Normalize()
+
+	performer, err := s.getOwnedPerformer(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	applyPerformerRequest(performer, req)
+
+	if err := s.performerRepo.Update(ctx, performer); err != nil {
+		if errors.Is(err, repository.ErrPerformerNotFound) {
+			return nil, ErrPerformerNotFound
+		}
+		return nil, fmt.Errorf("failed to update performer: %w", err)
+	}
+
+	s.invalidateLineups(ctx, id)
+	return response.ToPerformerResponse(performer), nil
+}
+
+// DeletePerformer removes a performer created by the user from the directory and all lineups
+func (s *performerService) DeletePerformer(ctx context.Context, userID string, id string) error {
+	if _, err := s.getOwnedPerformer(ctx, userID, id); err != nil {
+		return err
+	}
+
+	// Lineups are gone after the delete cascades, so invalidate first
+	s.invalidateLineups(ctx, id)
+
+	if err := s.performerRepo.Delete(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrPerformerNotFound) {
+			return ErrPerformerNotFound
+		}
+		return fmt.Errorf("failed to delete performer: %w", err)
+	}
+
+	return nil
+}
+
+// SearchPerformers retrieves a page of the directory, optionally filtered by name
+func (s *performerService) SearchPerformers(ctx context.Context, req request.SearchPerformersRequest) (*response.PaginatedPerformersResponse, error) {
+	page := 1
+	if req.Page > 0 {
+		page = req.Page
+	}
+
+	limit := 10
+	if req.Limit > 0 {
+		limit = req.Limit
+	}
+
+	performers, total, err := s.performerRepo.Search(ctx, req.Search, limit, (page-1)*limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search performers: %w", err)
+	}
+
+	totalPages := int(total) / limit
+	if int(total)%limit > 0 {
+		totalPages++
+	}
+
+	return &response.PaginatedPerformersResponse{
+		Performers: response.ToPerformerResponses(performers),
+		Meta: response.PaginationMeta{
+			CurrentPage: page,
+			PerPage:     limit,
+			Total:       total,
+			TotalPages:  totalPages,
+		},
+	}, nil
+}
+
+// GetPerformerEvents retrieves upcoming published events featuring a performer, soonest first
+func (s *performerService) GetPerformerEvents(ctx context.Context, id string, req request.PerformerEventsRequest) (*response.PaginatedEventsResponse, error) {
+	if _, err := s.getPerformer(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return s.events.ListEvents(ctx, request.ListEventsRequest{
+		PerformerID:    id,
+		ExcludeEventID: req.ExcludeEventID,
+		StartDate:      s.now(),
+		SortBy:         "start_date",
+		SortOrder:      "asc",
+		Page:           req.Page,
+		Limit:          req.Limit,
+	})
+}
+
+// GetEventPerformers retrieves the lineup of an event
+func (s *performerService) GetEventPerformers(ctx context.Context, eventID string) (*response.EventPerformersResponse, error) {
+	performers, err := s.performerRepo.GetByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event performers: %w", err)
+	}
+
+	return &response.EventPerformersResponse{
+		EventID:    eventID,
+		Performers: response.ToPerformerResponses(performers),
+	}, nil
+}
+
+// SetEventPerformers replaces the lineup of an event with performers of the directory
+func (s *performerService) SetEventPerformers(ctx context.Context, organizerID string, eventID string, req *request.SetEventPerformersRequest) (*response.EventPerformersResponse, error) {
+	req.Normalize()
+
+	event, err := ownedEvent(ctx, s.eventRepo, organizerID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	performers, err := s.performerRepo.GetByIDs(ctx, req.PerformerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get performers: %w", err)
+	}
+	if len(performers) != len(req.PerformerIDs) {
+		return nil, ErrPerformerNotFound
+	}
+
+	if err := s.performerRepo.SetEventPerformers(ctx, eventID, req.PerformerIDs); err != nil {
+		return nil, fmt.Errorf("failed to set event performers: %w", err)
+	}
+
+	invalidateEventDetail(ctx, s.cache, event)
+	return s.GetEventPerformers(ctx, eventID)
+}
+
+// getPerformer retrieves a performer of the directory
+func (s *performerService) getPerformer(ctx context.Context, id string) (*entity.Performer, error) {
+	performer, err := s.performerRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrPerformerNotFound) {
+			return nil, ErrPerformerNotFound
+		}
+		return nil, fmt.Errorf("failed to get performer: %w", err)
+	}
+
+	return performer, nil
+}
+
+// getOwnedPerformer retrieves a performer and checks that the user created it
+func (s *performerService) getOwnedPerformer(ctx context.Context, userID string, id string) (*entity.Performer, error) {
+	performer, err := s.getPerformer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if performer.CreatedBy != userID {
+		return nil, ErrUnauthorized
+	}
+
+	return performer, nil
+}
+
+// invalidateLineups drops the cached detail of every event featuring a performer
+func (s *performerService) invalidateLineups(ctx context.Context, performerID string) {
+	if s.cache == nil {
+		return
+	}
+
+	eventIDs, err := s.performerRepo.GetEventIDs(ctx, performerID)
+	if err != nil {
+		log.Printf("[PerformerService] Failed to get events of performer %s: %v", performerID, err)
+		return
+	}
+	if len(eventIDs) == 0 {
+		return
+	}
+
+	events, err := s.eventRepo.GetByIDs(ctx, eventIDs)
+	if err != nil {
+		log.Printf("[PerformerService] Failed to get events of performer %s: %v", performerID, err)
+		return
+	}
+
+	for i := range events {
+		invalidateEventDetail(ctx, s.cache, &events[i])
+	}
+}
+
+// applyPerformerRequest copies a performer request onto performer
+func applyPerformerRequest(performer *entity.Performer, req *request.PerformerRequest) {
+	performer.Name = req.Name
+	performer.SocialLinks = entity.SocialLinks(req.SocialLinks)
+	performer.PhotoURL = nil
+	if req.PhotoURL != "" {
+		performer.PhotoURL = &req.PhotoURL
+	}
+	performer.Bio = nil
+	if req.Bio != "" {
+		performer.Bio = &req.Bio
+	}
+}
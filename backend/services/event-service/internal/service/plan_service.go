@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/repository"
+)
+
+var (
+	ErrPlanNotFound        = errors.New("plan not found")
+	ErrOrganizerNotFound   = errors.New("organizer not found")
+	ErrEventQuotaExceeded  = errors.New("active event limit of plan reached")
+	ErrTicketQuotaExceeded = errors.New("ticket limit per event of plan exceeded")
+)
+
+const (
+	cacheOrganizerPlanTTL = time.Minute // Plan limit changes reach organizers within this
+	rateLimitWindow       = time.Minute
+)
+
+// rateLimitScript counts a request in the organizer's current window, expiring with the window
+const rateLimitScript = `
+local count = redis.call('INCR', KEYS[1])
+if count == 1 then
+  redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return count
+`
+
+// RateLimitResult represents an API request counted against the organizer's plan rate
+type RateLimitResult struct {
+	Allowed    bool
+	Limit      int // Requests per minute, 0 = unlimited
+	Remaining  int
+	RetryAfter time.Duration // Until the current window ends
+}
+
+// PlanService defines interface for organizer plan business logic
+type PlanService interface {
+	// Admin plan management
+	ListPlans(ctx context.Context) ([]response.PlanResponse, error)
+	UpdatePlan(ctx context.Context, code string, req *request.UpdatePlanRequest) (*response.PlanResponse, error)
+	AssignPlan(ctx context.Context, adminID string, organizerID string, req *request.AssignPlanRequest) (*response.OrganizerPlanResponse, error)
+
+	// Organizer plan and limits
+	GetOrganizerPlan(ctx context.Context, organizerID string) (*response.OrganizerPlanResponse, error)
+	GetPlan(ctx context.Context, organizerID string) (*entity.OrganizerPlan, error)
+	AllowRequest(ctx context.Context, organizerID string) (*RateLimitResult, error)
+}
+
+// planService implements PlanService interface
+type planService struct {
+	planRepo  repository.PlanRepository
+	eventRepo repository.EventRepository
+	cache     cache.RedisClient
+}
+
+// NewPlanService creates new plan service instance
+// redisClient may be nil, in which case plans aren't cached and API requests aren't rate limited
+func NewPlanService(planRepo repository.PlanRepository, eventRepo repository.EventRepository, redisClient cache.RedisClient) PlanService {
+	return &planService{
+		planRepo:  planRepo,
+		eventRepo: eventRepo,
+		cache:     redisClient,
+	}
+}
+
+// ListPlans retrieves all plans
+func (s *planService) ListPlans(ctx context.Context) ([]response.PlanResponse, error) {
+	plans, err := s.planRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plans: %w", err)
+	}
+
+	planResponses := make([]response.PlanResponse, 0, len(plans))
+	for _, plan := range plans {
+		planResponses = append(planResponses, *response.ToPlanResponse(&plan))
+	}
+
+	return planResponses, nil
+}
+
+// UpdatePlan replaces the limits of a plan
+// Organizers over a lowered limit keep what they have but can't add more
+func (s *planService) UpdatePlan(ctx context.Context, code string, req *request.UpdatePlanRequest) (*response.PlanResponse, error) {
+	plan := &entity.OrganizerPlan{
+		Code:                       code,
+		MaxActiveEvents:            *req.MaxActiveEvents,
+		MaxTicketsPerEvent:         *req.MaxTicketsPerEvent,
+		AnnouncementEmailsPerMonth: *req.AnnouncementEmailsPerMonth,
+		APIRequestsPerMinute:       *req.APIRequestsPerMinute,
+	}
+
+	if err := s.planRepo.Update(ctx, plan); err != nil {
+		if errors.Is(err, repository.ErrPlanNotFound) {
+			return nil, ErrPlanNotFound
+		}
+		return nil, fmt.Errorf("failed to update plan: %w", err)
+	}
+
+	return response.ToPlanResponse(plan), nil
+}
+
+// AssignPlan puts an organizer on a plan
+func (s *planService) AssignPlan(ctx context.Context, adminID string, organizerID string, req *request.AssignPlanRequest) (*response.OrganizerPlanResponse, error) {
+	if err := s.planRepo.Assign(ctx, organizerID, req.Plan, adminID); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrPlanNotFound):
+			return nil, ErrPlanNotFound
+		case errors.Is(err, repository.ErrOrganizerNotFound):
+			return nil, ErrOrganizerNotFound
+		}
+		return nil, fmt.Errorf("failed to assign plan: %w", err)
+	}
+
+	// New limits apply to the organizer's next request
+	if s.cache != nil {
+		s.cache.Del(ctx, organizerPlanCacheKey(organizerID))
+	}
+
+	return s.GetOrganizerPlan(ctx, organizerID)
+}
+
+// GetOrganizerPlan retrieves the plan of an organizer with current usage
+func (s *planService) GetOrganizerPlan(ctx context.Context, organizerID string) (*response.OrganizerPlanResponse, error) {
+	plan, err := s.planRepo.GetForOrganizer(ctx, organizerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organizer plan: %w", err)
+	}
+
+	activeEvents, err := s.eventRepo.CountActiveByOrganizerID(ctx, organizerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count active events: %w", err)
+	}
+
+	return &response.OrganizerPlanResponse{
+		OrganizerID: organizerID,
+		Plan:        *response.ToPlanResponse(plan),
+		Usage:       response.PlanUsageResponse{ActiveEvents: activeEvents},
+	}, nil
+}
+
+// GetPlan retrieves the plan limits of an organizer with caching
+// Used on every event management request by the rate limiter and quota checks
+func (s *planService) GetPlan(ctx context.Context, organizerID string) (*entity.OrganizerPlan, error) {
+	cacheKey := organizerPlanCacheKey(organizerID)
+
+	if s.cache != nil {
+		cached, err := s.cache.Get(ctx, cacheKey)
+		if err == nil && cached != "" {
+			var plan entity.OrganizerPlan
+			if err := json.Unmarshal([]byte(cached), &plan); err == nil {
+				return &plan, nil
+			}
+		}
+	}
+
+	plan, err := s.planRepo.GetForOrganizer(ctx, organizerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organizer plan: %w", err)
+	}
+
+	if s.cache != nil {
+		if data, err := json.Marshal(plan); err == nil {
+			s.cache.Set(ctx, cacheKey, string(data), cacheOrganizerPlanTTL)
+		}
+	}
+
+	return plan, nil
+}
+
+// AllowRequest counts an event management API request of an organizer in a fixed one-minute window
+// Requests aren't limited without Redis
+func (s *planService) AllowRequest(ctx context.Context, organizerID string) (*RateLimitResult, error) {
+	plan, err := s.GetPlan(ctx, organizerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if plan.APIRequestsPerMinute == 0 || s.cache == nil {
+		return &RateLimitResult{Allowed: true}, nil
+	}
+
+	now := time.Now()
+	windowStart := now.Truncate(rateLimitWindow)
+	key := fmt.Sprintf("ratelimit:organizer:%s:%d", organizerID, windowStart.Unix())
+
+	result, err := s.cache.Eval(ctx, rateLimitScript, []string{key}, rateLimitWindow.Milliseconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count request: %w", err)
+	}
+
+	count, ok := result.(int64)
+	if !ok {
+		return nil, fmt.Errorf("unexpected rate limit count %v", result)
+	}
+
+	return &RateLimitResult{
+		Allowed:    entity.WithinLimit(plan.APIRequestsPerMinute, int(count)),
+		Limit:      plan.APIRequestsPerMinute,
+		Remaining:  max(plan.APIRequestsPerMinute-int(count), 0),
+		RetryAfter: windowStart.Add(rateLimitWindow).Sub(now),
+	}, nil
+}
+
+// organizerPlanCacheKey returns the cache key of an organizer's plan limits
+func organizerPlanCacheKey(organizerID string) string {
+	return fmt.Sprintf("plan:organizer:%s", organizerID)
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/repository"
+)
+
+var ErrInvalidRevenueSplit = errors.New("revenue splits must total 100 percent with up to 2 decimals and list each organizer once")
+
+// RevenueSplitService defines interface for revenue sharing between co-organizers of an event
+// Splits are applied when the event is settled; each party's share is recorded with the settlement
+type RevenueSplitService interface {
+	GetEventSplits(ctx context.Context, userID string, eventID string) (*response.EventRevenueSplitsResponse, error)
+	SetEventSplits(ctx context.Context, organizerID string, eventID string, req *request.SetRevenueSplitsRequest) (*response.EventRevenueSplitsResponse, error)
+	GetMyShares(ctx context.Context, organizerID string) ([]response.RevenueShareResponse, error)
+}
+
+// revenueSplitService implements RevenueSplitService interface
+type revenueSplitService struct {
+	revenueSplitRepo repository.RevenueSplitRepository
+	eventRepo        repository.EventRepository
+	userRepo         repository.UserRepository
+}
+
+// NewRevenueSplitService creates new revenue split service instance
+func NewRevenueSplitService(
+	revenueSplitRepo repository.RevenueSplitRepository,
+	eventRepo repository.EventRepository,
+	userRepo repository.UserRepository,
+) RevenueSplitService {
+	return &revenueSplitService{
+		revenueSplitRepo: revenueSplitRepo,
+		eventRepo:        eventRepo,
+		userRepo:         userRepo,
+	}
+}
+
+// GetEventSplits retrieves how an event's revenue is shared, visible to the event's organizer and its parties
+func (s *revenueSplitService) GetEventSplits(ctx context.Context, userID string, eventID string) (*response.EventRevenueSplitsResponse, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return nil, ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	splits, err := s.eventSplits(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.OrganizerID != userID {
+		party := false
+		for _, split := range splits {
+			if split.OrganizerID == userID {
+				party = true
+				break
+			}
+		}
+		if !party {
+			return nil, ErrUnauthorized
+		}
+	}
+
+	return response.ToEventRevenueSplitsResponse(event.ID, splits), nil
+}
+
+// SetEventSplits replaces how an event's revenue is shared, until the event is completed and settled
+// Every party must be an organizer account; the event's organizer may give away their whole share
+func (s *revenueSplitService) SetEventSplits(ctx context.Context, organizerID string, eventID string, req *request.SetRevenueSplitsRequest) (*response.EventRevenueSplitsResponse, error) {
+	req.Normalize()
+
+	event, err := ownedEvent(ctx, s.eventRepo, organizerID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.Status == entity.StatusCompleted {
+		return nil, ErrEventCompleted
+	}
+
+	splits, err := revenueSplits(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range splits {
+		user, err := s.userRepo.GetByID(ctx, splits[i].OrganizerID)
+		if err != nil {
+			if errors.Is(err, repository.ErrUserNotFound) {
+				return nil, ErrOrganizerNotFound
+			}
+			return nil, fmt.Errorf("failed to get organizer: %w", err)
+		}
+		if user.Role == entity.RoleCustomer {
+			return nil, ErrOrganizerNotFound
+		}
+	}
+
+	if err := s.revenueSplitRepo.SetByEventID(ctx, event.ID, splits); err != nil {
+		return nil, fmt.Errorf("failed to set revenue splits: %w", err)
+	}
+
+	current, err := s.eventSplits(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.ToEventRevenueSplitsResponse(event.ID, current), nil
+}
+
+// GetMyShares retrieves the organizer's share of every event they are a party to
+func (s *revenueSplitService) GetMyShares(ctx context.Context, organizerID string) ([]response.RevenueShareResponse, error) {
+	shares, err := s.revenueSplitRepo.ListShares(ctx, organizerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.ToRevenueShareResponses(shares), nil
+}
+
+// eventSplits retrieves the splits of an event, or its organizer at 100 percent if it has none
+func (s *revenueSplitService) eventSplits(ctx context.Context, event *entity.Event) ([]entity.RevenueSplit, error) {
+	splits, err := s.revenueSplitRepo.GetByEventID(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(splits) > 0 {
+		return splits, nil
+	}
+
+	split := entity.RevenueSplit{EventID: event.ID, OrganizerID: event.OrganizerID, Percentage: 100}
+	if organizer, err := s.userRepo.GetByID(ctx, event.OrganizerID); err == nil {
+		split.OrganizerName = organizer.FullName
+	}
+	return []entity.RevenueSplit{split}, nil
+}
+
+// revenueSplits validates the requested splits total exactly 100 percent, compared in basis points
+// so float percentages like 33.33 + 33.33 + 33.34 add up
+func revenueSplits(req *request.SetRevenueSplitsRequest) ([]entity.RevenueSplit, error) {
+	if len(req.Splits) == 0 {
+		return nil, nil
+	}
+
+	seen := make(map[string]bool, len(req.Splits))
+	splits := make([]entity.RevenueSplit, len(req.Splits))
+	total := int64(0)
+	for i, split := range req.Splits {
+		basisPoints := entity.PercentageBasisPoints(split.Percentage)
+		if seen[split.OrganizerID] || basisPoints <= 0 || math.Abs(split.Percentage*100-float64(basisPoints)) > 1e-6 {
+			return nil, ErrInvalidRevenueSplit
+		}
+		seen[split.OrganizerID] = true
+		total += basisPoints
+
+		splits[i] = entity.RevenueSplit{OrganizerID: split.OrganizerID, Percentage: float64(basisPoints) / 100}
+	}
+
+	if total != 10000 {
+		return nil, ErrInvalidRevenueSplit
+	}
+
+	return splits, nil
+}
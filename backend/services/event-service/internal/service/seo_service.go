@@ -0,0 +1,336 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"html"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/repository"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/storage"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/utility"
+)
+
+const (
+	shareImageCacheTTL    = 24 * time.Hour // Rendered PNG when no object storage is configured
+	shareImageMarkerTTL   = 24 * time.Hour // Skips the storage lookup for images already uploaded
+	seoDescriptionMaxRune = 160
+)
+
+// Indonesian month abbreviations, matching the "Rp" price on the card
+var cardMonths = [...]string{"JAN", "FEB", "MAR", "APR", "MEI", "JUN", "JUL", "AGU", "SEP", "OKT", "NOV", "DES"}
+
+var htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// ShareImage is a rendered share card, either stored at URL or returned as PNG Data
+type ShareImage struct {
+	URL  string
+	Data []byte
+}
+
+// SEOService defines interface for event page metadata and share images
+type SEOService interface {
+	GetEventSEO(ctx context.Context, eventID string) (*response.EventSEOResponse, error)
+	GetShareImage(ctx context.Context, eventID string) (*ShareImage, error)
+}
+
+// seoService implements SEOService interface
+type seoService struct {
+	eventRepo      repository.EventRepository
+	tenantRepo     repository.TenantRepository
+	store          storage.ObjectStore // Optional; rendered cards are cached in Redis without it
+	cache          cache.RedisClient
+	eventBaseURL   string
+	apiBaseURL     string
+	bannerClient   *http.Client
+	bannerMaxBytes int64
+}
+
+// NewSEOService creates new SEO service instance
+// Share images are named after a hash of their content, so an edited event gets a new image
+// without invalidation and stored images can be cached forever
+func NewSEOService(
+	eventRepo repository.EventRepository,
+	tenantRepo repository.TenantRepository,
+	store storage.ObjectStore,
+	redisClient cache.RedisClient,
+	eventBaseURL string,
+	apiBaseURL string,
+	bannerMaxBytes int64,
+	bannerTimeout time.Duration,
+) SEOService {
+	return &seoService{
+		eventRepo:      eventRepo,
+		tenantRepo:     tenantRepo,
+		store:          store,
+		cache:          redisClient,
+		eventBaseURL:   strings.TrimRight(eventBaseURL, "/"),
+		apiBaseURL:     strings.TrimRight(apiBaseURL, "/"),
+		bannerClient:   &http.Client{Timeout: bannerTimeout},
+		bannerMaxBytes: bannerMaxBytes,
+	}
+}
+
+// GetEventSEO builds the page metadata of an event, referencing its share image
+func (s *seoService) GetEventSEO(ctx context.Context, eventID string) (*response.EventSEOResponse, error) {
+	event, card, priceFrom, err := s.buildCard(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	description := ""
+	if event.Description != nil {
+		description = plainText(*event.Description, seoDescriptionMaxRune)
+	}
+	if description == "" {
+		description = fmt.Sprintf("%s, %s", card.Date, event.Location)
+	}
+
+	canonicalURL := fmt.Sprintf("%s/%s", s.eventBaseURL, event.Slug)
+	image := response.SEOImageResponse{
+		URL:    fmt.Sprintf("%s/events/%s/og-image?v=%s", s.apiBaseURL, event.ID, card.Hash()),
+		Width:  utility.OGImageWidth,
+		Height: utility.OGImageHeight,
+		Alt:    event.Title,
+	}
+	title := event.Title
+	if card.BrandName != "" {
+		title = fmt.Sprintf("%s | %s", event.Title, card.BrandName)
+	}
+
+	return &response.EventSEOResponse{
+		EventID:      event.ID,
+		Title:        title,
+		Description:  description,
+		CanonicalURL: canonicalURL,
+		SiteName:     card.BrandName,
+		Image:        image,
+		StartDate:    event.StartDate,
+		EndDate:      event.EndDate,
+		Location:     event.Location,
+		PriceFrom:    priceFrom,
+		OpenGraph: map[string]string{
+			"og:type":         "website",
+			"og:title":        event.Title,
+			"og:description":  description,
+			"og:url":          canonicalURL,
+			"og:site_name":    card.BrandName,
+			"og:image":        image.URL,
+			"og:image:type":   "image/png",
+			"og:image:width":  strconv.Itoa(image.Width),
+			"og:image:height": strconv.Itoa(image.Height),
+			"og:image:alt":    image.Alt,
+		},
+		Twitter: map[string]string{
+			"twitter:card":        "summary_large_image",
+			"twitter:title":       event.Title,
+			"twitter:description": description,
+			"twitter:image":       image.URL,
+			"twitter:image:alt":   image.Alt,
+		},
+	}, nil
+}
+
+// GetShareImage returns the share card of an event, rendering it on the first request
+func (s *seoService) GetShareImage(ctx context.Context, eventID string) (*ShareImage, error) {
+	event, card, _, err := s.buildCard(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := fmt.Sprintf("og/%s/%s.png", event.ID, card.Hash())
+
+	if s.store != nil {
+		return s.storedShareImage(ctx, name, card)
+	}
+
+	// Without object storage the PNG itself is cached, base64 encoded as the REST client
+	// only round-trips strings
+	cacheKey := fmt.Sprintf("og:image:%s", name)
+	if s.cache != nil {
+		if cached, err := s.cache.Get(ctx, cacheKey); err == nil && cached != "" {
+			if data, err := base64.StdEncoding.DecodeString(cached); err == nil {
+				return &ShareImage{Data: data}, nil
+			}
+		}
+	}
+
+	data, err := s.renderCard(ctx, card)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cache != nil {
+		if err := s.cache.Set(ctx, cacheKey, base64.StdEncoding.EncodeToString(data), shareImageCacheTTL); err != nil {
+			log.Printf("[SEO] Failed to cache share image %s: %v", name, err)
+		}
+	}
+
+	return &ShareImage{Data: data}, nil
+}
+
+// storedShareImage uploads the card to object storage unless it is already there
+func (s *seoService) storedShareImage(ctx context.Context, name string, card *utility.OGCard) (*ShareImage, error) {
+	markerKey := fmt.Sprintf("og:stored:%s", name)
+	if s.cache != nil {
+		if exists, err := s.cache.Exists(ctx, markerKey); err == nil && exists > 0 {
+			return &ShareImage{URL: s.store.URL(name)}, nil
+		}
+	}
+
+	exists, err := s.store.Exists(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check share image: %w", err)
+	}
+
+	if !exists {
+		data, err := s.renderCard(ctx, card)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.store.Put(ctx, name, "image/png", data); err != nil {
+			return nil, fmt.Errorf("failed to store share image: %w", err)
+		}
+	}
+
+	if s.cache != nil {
+		s.cache.Set(ctx, markerKey, "1", shareImageMarkerTTL)
+	}
+
+	return &ShareImage{URL: s.store.URL(name)}, nil
+}
+
+// renderCard renders the card, falling back to the brand background when the banner can't be fetched
+func (s *seoService) renderCard(ctx context.Context, card *utility.OGCard) ([]byte, error) {
+	if card.BannerURL != "" {
+		banner, err := utility.FetchImage(ctx, s.bannerClient, card.BannerURL, s.bannerMaxBytes)
+		if err != nil {
+			log.Printf("[SEO] Rendering share card without banner %s: %v", card.BannerURL, err)
+		} else {
+			card.Banner = banner
+		}
+	}
+
+	data, err := utility.RenderOGCard(card)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render share image: %w", err)
+	}
+	return data, nil
+}
+
+// buildCard loads an event with its lowest price and tenant branding into a share card (without banner image)
+func (s *seoService) buildCard(ctx context.Context, eventID string) (*entity.Event, *utility.OGCard, *money.Money, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return nil, nil, nil, ErrEventNotFound
+		}
+		return nil, nil, nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	availability, err := s.eventRepo.GetAvailability(ctx, []string{event.ID})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get event availability: %w", err)
+	}
+	priceFrom := availability[event.ID].MinPrice
+
+	card := &utility.OGCard{
+		Title:      event.Title,
+		Date:       formatCardDate(event.StartDate, event.EndDate, event.Timezone),
+		Location:   event.Location,
+		BrandColor: utility.DefaultBrandColor,
+	}
+	if event.Venue != nil && *event.Venue != "" {
+		card.Location = fmt.Sprintf("%s, %s", *event.Venue, event.Location)
+	}
+	if priceFrom != nil {
+		card.PriceFrom = "GRATIS"
+		if !priceFrom.IsZero() {
+			card.PriceFrom = fmt.Sprintf("MULAI RP %s", formatCurrency(*priceFrom))
+		}
+	}
+	if event.BannerURL != nil {
+		card.BannerURL = *event.BannerURL
+	}
+
+	// A missing tenant only loses the branding
+	branding, err := s.tenantRepo.GetBranding(ctx, event.TenantID)
+	if err != nil && !errors.Is(err, repository.ErrTenantNotFound) {
+		return nil, nil, nil, fmt.Errorf("failed to get tenant branding: %w", err)
+	}
+	if branding != nil {
+		card.BrandName = branding.Name
+		if branding.PrimaryColor != nil {
+			card.BrandColor = utility.ParseBrandColor(*branding.PrimaryColor)
+		}
+	}
+
+	return event, card, priceFrom, nil
+}
+
+// formatCardDate formats the event dates in its timezone, e.g. "14 NOV 2026, 19:00 WIB"
+// or "14 NOV - 16 NOV 2026" for multi-day events
+func formatCardDate(start, end time.Time, timezone string) string {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	start, end = start.In(loc), end.In(loc)
+
+	day := func(t time.Time) string {
+		return fmt.Sprintf("%d %s", t.Day(), cardMonths[t.Month()-1])
+	}
+
+	if start.Year() == end.Year() && start.YearDay() == end.YearDay() {
+		return fmt.Sprintf("%s %d, %s", day(start), start.Year(), start.Format("15:04 MST"))
+	}
+	if start.Year() == end.Year() {
+		return fmt.Sprintf("%s - %s %d", day(start), day(end), end.Year())
+	}
+	return fmt.Sprintf("%s %d - %s %d", day(start), start.Year(), day(end), end.Year())
+}
+
+// plainText strips HTML from a description and shortens it to maxRunes at a word boundary
+func plainText(value string, maxRunes int) string {
+	text := strings.Join(strings.Fields(html.UnescapeString(htmlTagPattern.ReplaceAllString(value, " "))), " ")
+
+	runes := []rune(text)
+	if len(runes) <= maxRunes {
+		return text
+	}
+
+	cut := string(runes[:maxRunes-3])
+	if i := strings.LastIndex(cut, " "); i > 0 {
+		cut = cut[:i]
+	}
+	return strings.TrimRight(cut, " ,.;:") + "..."
+}
+
+// formatCurrency formats an amount as Indonesian Rupiah with thousand separators
+func formatCurrency(amount money.Money) string {
+	str := strconv.FormatInt(amount.Major(), 10)
+
+	var result []rune
+	count := 0
+
+	for i := len(str) - 1; i >= 0; i-- {
+		if count > 0 && count%3 == 0 {
+			result = append([]rune{'.'}, result...)
+		}
+		result = append([]rune{rune(str[i])}, result...)
+		count++
+	}
+
+	return string(result)
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/repository"
+)
+
+var (
+	ErrTicketTemplateNotFound = errors.New("ticket template not configured")
+	ErrTicketLogoUnavailable  = errors.New("ticket template logo can't be fetched, use a public HTTPS PNG or JPEG up to 1 MB")
+)
+
+// TicketPreviewRenderer defines interface for rendering sample e-tickets (notification service)
+type TicketPreviewRenderer interface {
+	GenerateTicketPreviewPDF(ctx context.Context, eventName string, tpl *client.TicketTemplate) ([]byte, error)
+}
+
+// TicketTemplateService defines interface for organizers' e-ticket templates
+type TicketTemplateService interface {
+	GetMyTemplate(ctx context.Context, organizerID string) (*response.TicketTemplateResponse, error)
+	SaveTemplate(ctx context.Context, organizerID string, req *request.SaveTicketTemplateRequest) (*response.TicketTemplateResponse, error)
+	DeleteTemplate(ctx context.Context, organizerID string) error
+	PreviewTemplate(ctx context.Context, organizerID string, req *request.PreviewTicketTemplateRequest) ([]byte, error)
+}
+
+// ticketTemplateService implements TicketTemplateService interface
+type ticketTemplateService struct {
+	templateRepo repository.TicketTemplateRepository
+	renderer     TicketPreviewRenderer
+}
+
+// NewTicketTemplateService creates new ticket template service instance
+func NewTicketTemplateService(templateRepo repository.TicketTemplateRepository, renderer TicketPreviewRenderer) TicketTemplateService {
+	return &ticketTemplateService{
+		templateRepo: templateRepo,
+		renderer:     renderer,
+	}
+}
+
+// GetMyTemplate retrieves an organizer's ticket template
+func (s *ticketTemplateService) GetMyTemplate(ctx context.Context, organizerID string) (*response.TicketTemplateResponse, error) {
+	tpl, err := s.templateRepo.Get(ctx, organizerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketTemplateNotFound) {
+			return nil, ErrTicketTemplateNotFound
+		}
+		return nil, fmt.Errorf("failed to get ticket template: %w", err)
+	}
+
+	return response.ToTicketTemplateResponse(tpl), nil
+}
+
+// SaveTemplate sets an organizer's ticket template, used by the e-tickets of all its events sent from now on
+func (s *ticketTemplateService) SaveTemplate(ctx context.Context, organizerID string, req *request.SaveTicketTemplateRequest) (*response.TicketTemplateResponse, error) {
+	req.Normalize()
+
+	tpl := &entity.OrganizerTicketTemplate{
+		OrganizerID:   organizerID,
+		LogoURL:       optionalString(req.LogoURL),
+		PrimaryColor:  optionalString(req.PrimaryColor),
+		AccentColor:   optionalString(req.AccentColor),
+		SponsorFooter: optionalString(req.SponsorFooter),
+		TermsText:     optionalString(req.TermsText),
+	}
+	if err := s.templateRepo.Save(ctx, tpl); err != nil {
+		return nil, fmt.Errorf("failed to save ticket template: %w", err)
+	}
+
+	return response.ToTicketTemplateResponse(tpl), nil
+}
+
+// DeleteTemplate removes an organizer's ticket template, its e-tickets use the platform layout again
+func (s *ticketTemplateService) DeleteTemplate(ctx context.Context, organizerID string) error {
+	if err := s.templateRepo.Delete(ctx, organizerID); err != nil {
+		if errors.Is(err, repository.ErrTicketTemplateNotFound) {
+			return ErrTicketTemplateNotFound
+		}
+		return fmt.Errorf("failed to delete ticket template: %w", err)
+	}
+	return nil
+}
+
+// PreviewTemplate renders a sample e-ticket as PDF
+// req is a template to try before saving it; without one the organizer's saved template is rendered
+func (s *ticketTemplateService) PreviewTemplate(ctx context.Context, organizerID string, req *request.PreviewTicketTemplateRequest) ([]byte, error) {
+	var tpl *client.TicketTemplate
+	eventName := ""
+	if req != nil {
+		req.Normalize()
+		eventName = req.EventName
+		tpl = &client.TicketTemplate{
+			LogoURL:       req.LogoURL,
+			PrimaryColor:  req.PrimaryColor,
+			AccentColor:   req.AccentColor,
+			SponsorFooter: req.SponsorFooter,
+			TermsText:     req.TermsText,
+		}
+	} else {
+		saved, err := s.templateRepo.Get(ctx, organizerID)
+		if err != nil {
+			if errors.Is(err, repository.ErrTicketTemplateNotFound) {
+				return nil, ErrTicketTemplateNotFound
+			}
+			return nil, fmt.Errorf("failed to get ticket template: %w", err)
+		}
+		tpl = &client.TicketTemplate{
+			LogoURL:       stringValue(saved.LogoURL),
+			PrimaryColor:  stringValue(saved.PrimaryColor),
+			AccentColor:   stringValue(saved.AccentColor),
+			SponsorFooter: stringValue(saved.SponsorFooter),
+			TermsText:     stringValue(saved.TermsText),
+		}
+	}
+
+	pdf, err := s.renderer.GenerateTicketPreviewPDF(ctx, eventName, tpl)
+	if err != nil {
+		if errors.Is(err, client.ErrTicketLogoUnavailable) {
+			return nil, fmt.Errorf("%w (%v)", ErrTicketLogoUnavailable, err)
+		}
+		return nil, fmt.Errorf("failed to render ticket preview: %w", err)
+	}
+
+	return pdf, nil
+}
+
+// optionalString returns nil for "", so unset template fields are stored as NULL
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// stringValue returns the pointed-to string, or "" for nil
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/repository"
+)
+
+var (
+	ErrVerificationNotFound = errors.New("verification not found")
+	ErrVerificationPending  = errors.New("verification already pending review")
+	ErrOrganizerVerified    = errors.New("organizer already verified")
+	ErrVerificationReviewed = errors.New("verification already reviewed")
+	ErrOrganizerNotVerified = errors.New("organizer not verified")
+)
+
+// VerificationService defines interface for organizer verification and public organizer profiles
+type VerificationService interface {
+	// Organizer operations
+	SubmitVerification(ctx context.Context, organizerID string, req *request.SubmitVerificationRequest) (*response.VerificationResponse, error)
+	GetMyVerification(ctx context.Context, organizerID string) (*response.VerificationResponse, error)
+
+	// Admin review, scoped to the request tenant
+	ListVerifications(ctx context.Context, req request.ListVerificationsRequest) (*response.PaginatedVerificationsResponse, error)
+	ReviewVerification(ctx context.Context, adminID string, verificationID string, req *request.ReviewVerificationRequest) (*response.VerificationResponse, error)
+	RevokeVerification(ctx context.Context, adminID string, organizerID string, req *request.RevokeVerificationRequest) (*response.VerificationResponse, error)
+
+	// Public profile with the verification badge
+	GetOrganizerProfile(ctx context.Context, organizerID string) (*response.OrganizerProfileResponse, error)
+}
+
+// verificationService implements VerificationService interface
+type verificationService struct {
+	verificationRepo repository.VerificationRepository
+	eventRepo        repository.EventRepository
+	userRepo         repository.UserRepository
+	cache            cache.RedisClient
+}
+
+// NewVerificationService creates new verification service instance
+func NewVerificationService(
+	verificationRepo repository.VerificationRepository,
+	eventRepo repository.EventRepository,
+	userRepo repository.UserRepository,
+	redisClient cache.RedisClient,
+) VerificationService {
+	return &verificationService{
+		verificationRepo: verificationRepo,
+		eventRepo:        eventRepo,
+		userRepo:         userRepo,
+		cache:            redisClient,
+	}
+}
+
+// SubmitVerification records an organizer's documents for review
+// Rejected or revoked organizers may submit again, pending or verified ones may not
+func (s *verificationService) SubmitVerification(ctx context.Context, organizerID string, req *request.SubmitVerificationRequest) (*response.VerificationResponse, error) {
+	req.Normalize()
+
+	latest, err := s.verificationRepo.GetLatest(ctx, organizerID)
+	if err != nil && !errors.Is(err, repository.ErrVerificationNotFound) {
+		return nil, fmt.Errorf("failed to get verification: %w", err)
+	}
+	if err := activeVerificationError(latest); err != nil {
+		return nil, err
+	}
+
+	verification := &entity.OrganizerVerification{
+		OrganizerID:    organizerID,
+		LegalName:      req.LegalName,
+		DocumentType:   req.DocumentType,
+		DocumentNumber: req.DocumentNumber,
+		DocumentURL:    req.DocumentURL,
+	}
+
+	if err := s.verificationRepo.Create(ctx, verification); err != nil {
+		// Lost a race with a concurrent submission or approval
+		if errors.Is(err, repository.ErrVerificationActive) {
+			return nil, ErrVerificationPending
+		}
+		return nil, fmt.Errorf("failed to create verification: %w", err)
+	}
+
+	return response.ToVerificationResponse(verification), nil
+}
+
+// GetMyVerification retrieves the latest submission of an organizer
+func (s *verificationService) GetMyVerification(ctx context.Context, organizerID string) (*response.VerificationResponse, error) {
+	verification, err := s.verificationRepo.GetLatest(ctx, organizerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrVerificationNotFound) {
+			return nil, ErrVerificationNotFound
+		}
+		return nil, fmt.Errorf("failed to get verification: %w", err)
+	}
+
+	return response.ToVerificationResponse(verification), nil
+}
+
+// ListVerifications retrieves the review queue, pending submissions unless another status is requested
+func (s *verificationService) ListVerifications(ctx context.Context, req request.ListVerificationsRequest) (*response.PaginatedVerificationsResponse, error) {
+	status := entity.VerificationPending
+	if req.Status != "" {
+		status = req.Status
+	}
+
+	page := 1
+	if req.Page > 0 {
+		page = req.Page
+	}
+
+	limit := 20
+	if req.Limit > 0 {
+		limit = req.Limit
+	}
+
+	verifications, total, err := s.verificationRepo.List(ctx, status, limit, (page-1)*limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list verifications: %w", err)
+	}
+
+	responses := make([]response.VerificationResponse, len(verifications))
+	for i := range verifications {
+		responses[i] = *response.ToVerificationResponse(&verifications[i])
+	}
+
+	totalPages := int(total) / limit
+	if int(total)%limit > 0 {
+		totalPages++
+	}
+
+	return &response.PaginatedVerificationsResponse{
+		Verifications: responses,
+		Meta: response.PaginationMeta{
+			CurrentPage: page,
+			PerPage:     limit,
+			Total:       total,
+			TotalPages:  totalPages,
+		},
+	}, nil
+}
+
+// ReviewVerification approves or rejects a pending submission
+func (s *verificationService) ReviewVerification(ctx context.Context, adminID string, verificationID string, req *request.ReviewVerificationRequest) (*response.VerificationResponse, error) {
+	req.Normalize()
+
+	if _, err := s.verificationRepo.GetByID(ctx, verificationID); err != nil {
+		if errors.Is(err, repository.ErrVerificationNotFound) {
+			return nil, ErrVerificationNotFound
+		}
+		return nil, fmt.Errorf("failed to get verification: %w", err)
+	}
+
+	status := entity.VerificationRejected
+	if req.Decision == entity.VerificationDecisionApprove {
+		status = entity.VerificationApproved
+	}
+
+	var note *string
+	if req.Note != "" {
+		note = &req.Note
+	}
+
+	verification, err := s.verificationRepo.Review(ctx, verificationID, status, adminID, note)
+	if err != nil {
+		if errors.Is(err, repository.ErrVerificationReviewed) {
+			return nil, ErrVerificationReviewed
+		}
+		return nil, fmt.Errorf("failed to review verification: %w", err)
+	}
+
+	if verification.IsApproved() {
+		s.invalidateOrganizerEvents(ctx, verification.OrganizerID)
+	}
+
+	return response.ToVerificationResponse(verification), nil
+}
+
+// RevokeVerification withdraws the verification of an organizer, removing the badge from its events
+func (s *verificationService) RevokeVerification(ctx context.Context, adminID string, organizerID string, req *request.RevokeVerificationRequest) (*response.VerificationResponse, error) {
+	req.Normalize()
+
+	var note *string
+	if req.Note != "" {
+		note = &req.Note
+	}
+
+	verification, err := s.verificationRepo.Revoke(ctx, organizerID, adminID, note)
+	if err != nil {
+		if errors.Is(err, repository.ErrVerificationNotFound) {
+			return nil, ErrOrganizerNotVerified
+		}
+		return nil, fmt.Errorf("failed to revoke verification: %w", err)
+	}
+
+	s.invalidateOrganizerEvents(ctx, organizerID)
+
+	return response.ToVerificationResponse(verification), nil
+}
+
+// GetOrganizerProfile retrieves the public profile of an organizer
+func (s *verificationService) GetOrganizerProfile(ctx context.Context, organizerID string) (*response.OrganizerProfileResponse, error) {
+	user, err := s.userRepo.GetByID(ctx, organizerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrOrganizerNotFound
+		}
+		return nil, fmt.Errorf("failed to get organizer: %w", err)
+	}
+	// Customers have no public profile
+	if user.Role == entity.RoleCustomer {
+		return nil, ErrOrganizerNotFound
+	}
+
+	activeEvents, err := s.eventRepo.CountActiveByOrganizerID(ctx, organizerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count organizer events: %w", err)
+	}
+
+	verified, err := s.verificationRepo.GetVerified(ctx, []string{organizerID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organizer verification: %w", err)
+	}
+
+	profile := &response.OrganizerProfileResponse{
+		ID:           user.ID,
+		Name:         user.FullName,
+		ActiveEvents: activeEvents,
+	}
+	if verifiedAt, ok := verified[organizerID]; ok {
+		profile.IsVerified = true
+		profile.VerifiedAt = &verifiedAt
+	}
+
+	return profile, nil
+}
+
+// invalidateOrganizerEvents drops the cached details of an organizer's events after its badge changed
+func (s *verificationService) invalidateOrganizerEvents(ctx context.Context, organizerID string) {
+	if s.cache == nil {
+		return
+	}
+
+	events, err := s.eventRepo.GetByOrganizerID(ctx, organizerID)
+	if err != nil {
+		log.Printf("[Verification] Failed to invalidate events of organizer %s: %v", organizerID, err)
+		return
+	}
+	for i := range events {
+		invalidateEventDetail(ctx, s.cache, &events[i])
+	}
+}
+
+// activeVerificationError maps an organizer's latest submission to the error preventing a new one
+func activeVerificationError(latest *entity.OrganizerVerification) error {
+	if latest == nil {
+		return nil
+	}
+	switch latest.Status {
+	case entity.VerificationPending:
+		return ErrVerificationPending
+	case entity.VerificationApproved:
+		return ErrOrganizerVerified
+	}
+	return nil
+}
+
+// organizerVerification looks up which organizers are verified for the badge
+// A failed lookup only hides the badge, so it is logged rather than returned
+func organizerVerification(ctx context.Context, verificationRepo repository.VerificationRepository, organizerIDs []string) map[string]time.Time {
+	verified, err := verificationRepo.GetVerified(ctx, organizerIDs)
+	if err != nil {
+		log.Printf("[Verification] Failed to get verified organizers: %v", err)
+		return nil
+	}
+	return verified
+}
@@ -0,0 +1,85 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/api/googleapi"
+	gcs "google.golang.org/api/storage/v1"
+)
+
+// ObjectStore stores publicly readable files such as generated share images
+type ObjectStore interface {
+	// Exists checks if an object named name was stored
+	Exists(ctx context.Context, name string) (bool, error)
+
+	// Put stores data under name, replacing an existing object
+	Put(ctx context.Context, name string, contentType string, data []byte) error
+
+	// URL returns the public URL of the object named name
+	URL(name string) string
+}
+
+// gcsStore implements ObjectStore with a Google Cloud Storage bucket
+type gcsStore struct {
+	objects       *gcs.ObjectsService
+	bucket        string
+	publicBaseURL string
+}
+
+// NewGCSStore creates an object store for bucket using Application Default Credentials
+// Objects must be publicly readable through the bucket policy; publicBaseURL defaults to
+// https://storage.googleapis.com/<bucket> and may point to a CDN in front of the bucket instead
+func NewGCSStore(ctx context.Context, bucket string, publicBaseURL string) (ObjectStore, error) {
+	service, err := gcs.NewService(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create storage client: %w", err)
+	}
+
+	if publicBaseURL == "" {
+		publicBaseURL = "https://storage.googleapis.com/" + bucket
+	}
+
+	return &gcsStore{
+		objects:       gcs.NewObjectsService(service),
+		bucket:        bucket,
+		publicBaseURL: strings.TrimSuffix(publicBaseURL, "/"),
+	}, nil
+}
+
+// Exists checks if an object named name is in the bucket
+func (s *gcsStore) Exists(ctx context.Context, name string) (bool, error) {
+	_, err := s.objects.Get(s.bucket, name).Fields("name").Context(ctx).Do()
+	if err != nil {
+		var apiErr *googleapi.Error
+		if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get object %s: %w", name, err)
+	}
+	return true, nil
+}
+
+// Put uploads data to the bucket; stored objects never change, so they may be cached for long
+func (s *gcsStore) Put(ctx context.Context, name string, contentType string, data []byte) error {
+	object := &gcs.Object{
+		Name:         name,
+		ContentType:  contentType,
+		CacheControl: "public, max-age=31536000, immutable",
+	}
+
+	_, err := s.objects.Insert(s.bucket, object).Media(bytes.NewReader(data)).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("failed to upload object %s: %w", name, err)
+	}
+	return nil
+}
+
+// URL returns the public URL of an object of the bucket
+func (s *gcsStore) URL(name string) string {
+	return s.publicBaseURL + "/" + name
+}
@@ -0,0 +1,198 @@
+package utility
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif" // Banner formats
+	_ "image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Open Graph card size recommended by Facebook, LinkedIn and X
+const (
+	OGImageWidth  = 1200
+	OGImageHeight = 630
+)
+
+// DefaultBrandColor is used for tenants without a primary color
+var DefaultBrandColor = color.RGBA{R: 0x25, G: 0x63, B: 0xEB, A: 0xFF}
+
+// OGCard holds the content of an event share card
+type OGCard struct {
+	Title      string
+	Date       string // Formatted in the event's timezone
+	Location   string
+	PriceFrom  string // Empty hides the price badge
+	BrandName  string
+	BrandColor color.RGBA
+	BannerURL  string      // Identifies the banner in Hash
+	Banner     image.Image // Optional background, scaled to cover the card
+}
+
+// Hash identifies the rendered card, so a changed event gets a new image
+func (c *OGCard) Hash() string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		c.Title, c.Date, c.Location, c.PriceFrom, c.BrandName, hexColor(c.BrandColor), c.BannerURL,
+	}, "\x00")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// RenderOGCard renders an event share card as PNG
+func RenderOGCard(card *OGCard) ([]byte, error) {
+	img := image.NewRGBA(image.Rect(0, 0, OGImageWidth, OGImageHeight))
+
+	// Banner darkened for legible text, or a plain brand background
+	if card.Banner != nil {
+		drawCover(img, card.Banner)
+		draw.Draw(img, img.Bounds(), image.NewUniform(color.RGBA{A: 0x99}), image.Point{}, draw.Over)
+	} else {
+		draw.Draw(img, img.Bounds(), image.NewUniform(darken(card.BrandColor)), image.Point{}, draw.Src)
+	}
+
+	white := color.RGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF}
+	muted := color.RGBA{R: 0xE5, G: 0xE7, B: 0xEB, A: 0xFF}
+	brand := image.NewUniform(card.BrandColor)
+
+	// Brand stripe along the left edge
+	draw.Draw(img, image.Rect(0, 0, 20, OGImageHeight), brand, image.Point{}, draw.Src)
+
+	const left = 80
+	y := 70
+
+	if card.BrandName != "" {
+		drawText(img, card.BrandName, left, y, 4, muted)
+		y += glyphHeight*4 + 32
+	}
+
+	// Title, up to 3 lines
+	const titleScale = 8
+	maxChars := (OGImageWidth - left*2 + glyphSpacing*titleScale) / ((glyphWidth + glyphSpacing) * titleScale)
+	for _, line := range wrapText(card.Title, maxChars, 3) {
+		drawText(img, line, left, y, titleScale, white)
+		y += glyphHeight*titleScale + 20
+	}
+	y += 14
+
+	const detailScale = 4
+	detailChars := (OGImageWidth - left*2) / ((glyphWidth + glyphSpacing) * detailScale)
+	for _, detail := range []string{card.Date, card.Location} {
+		if detail == "" {
+			continue
+		}
+		for _, line := range wrapText(detail, detailChars, 1) {
+			drawText(img, line, left, y, detailScale, muted)
+			y += glyphHeight*detailScale + 16
+		}
+	}
+
+	// Price badge at the bottom
+	if card.PriceFrom != "" {
+		const priceScale = 5
+		padding := 20
+		height := glyphHeight*priceScale + padding*2
+		width := textWidth(card.PriceFrom, priceScale) + padding*2
+		top := OGImageHeight - 60 - height
+		draw.Draw(img, image.Rect(left, top, left+width, top+height), brand, image.Point{}, draw.Src)
+		drawText(img, card.PriceFrom, left+padding, top+padding, priceScale, white)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode share card: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// ParseBrandColor parses a "#RRGGBB" tenant color, falling back to DefaultBrandColor
+func ParseBrandColor(value string) color.RGBA {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "#")
+	decoded, err := hex.DecodeString(value)
+	if err != nil || len(decoded) != 3 {
+		return DefaultBrandColor
+	}
+	return color.RGBA{R: decoded[0], G: decoded[1], B: decoded[2], A: 0xFF}
+}
+
+// drawCover scales src to cover dst, cropping the centre (nearest neighbour)
+func drawCover(dst *image.RGBA, src image.Image) {
+	sb := src.Bounds()
+	db := dst.Bounds()
+	if sb.Dx() == 0 || sb.Dy() == 0 {
+		return
+	}
+
+	// Source region with the aspect ratio of dst
+	cropW, cropH := sb.Dx(), sb.Dx()*db.Dy()/db.Dx()
+	if cropH > sb.Dy() {
+		cropW, cropH = sb.Dy()*db.Dx()/db.Dy(), sb.Dy()
+	}
+	offX := sb.Min.X + (sb.Dx()-cropW)/2
+	offY := sb.Min.Y + (sb.Dy()-cropH)/2
+
+	for y := 0; y < db.Dy(); y++ {
+		sy := offY + y*cropH/db.Dy()
+		for x := 0; x < db.Dx(); x++ {
+			sx := offX + x*cropW/db.Dx()
+			dst.Set(db.Min.X+x, db.Min.Y+y, src.At(sx, sy))
+		}
+	}
+}
+
+// darken returns c at 40% brightness, the background of cards without a banner
+func darken(c color.RGBA) color.RGBA {
+	scale := func(v uint8) uint8 { return uint8(int(v) * 2 / 5) }
+	return color.RGBA{R: scale(c.R), G: scale(c.G), B: scale(c.B), A: 0xFF}
+}
+
+// hexColor formats c as "#rrggbb"
+func hexColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// FetchImage downloads and decodes a JPEG, PNG or GIF image of at most maxBytes
+func FetchImage(ctx context.Context, client *http.Client, url string, maxBytes int64) (image.Image, error) {
+	if !strings.HasPrefix(url, "https://") && !strings.HasPrefix(url, "http://") {
+		return nil, fmt.Errorf("unsupported image URL: %s", url)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create image request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch image: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch image: status %d", resp.StatusCode)
+	}
+	if resp.ContentLength > maxBytes {
+		return nil, fmt.Errorf("image exceeds %d bytes", maxBytes)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, fmt.Errorf("image exceeds %d bytes", maxBytes)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	return img, nil
+}
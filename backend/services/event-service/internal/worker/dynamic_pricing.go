@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/service"
+)
+
+// DynamicPricingWorker reprices ticket tiers under dynamic pricing
+type DynamicPricingWorker struct {
+	pricingService service.DynamicPricingService
+	interval       time.Duration
+	stopChan       chan struct{}
+}
+
+// NewDynamicPricingWorker creates new dynamic pricing worker instance
+func NewDynamicPricingWorker(
+	pricingService service.DynamicPricingService,
+	interval time.Duration,
+) *DynamicPricingWorker {
+	return &DynamicPricingWorker{
+		pricingService: pricingService,
+		interval:       interval,
+		stopChan:       make(chan struct{}),
+	}
+}
+
+// Start begins the dynamic pricing worker
+func (w *DynamicPricingWorker) Start(ctx context.Context) {
+	log.Printf("[Worker] Dynamic pricing worker started (interval: %v)", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	// Reprice immediately on start
+	w.runRepricing(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			w.runRepricing(ctx)
+		case <-w.stopChan:
+			log.Println("[Worker] Dynamic pricing worker stopped")
+			return
+		case <-ctx.Done():
+			log.Println("[Worker] Dynamic pricing worker stopped due to context cancellation")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the dynamic pricing worker
+func (w *DynamicPricingWorker) Stop() {
+	close(w.stopChan)
+}
+
+// runRepricing executes the repricing operation
+func (w *DynamicPricingWorker) runRepricing(ctx context.Context) {
+	defer errreport.Recover("dynamic_pricing_worker")
+
+	startTime := time.Now()
+	repriced, err := w.pricingService.RepriceTiers(ctx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		log.Printf("[Worker] Dynamic pricing failed after %d tiers: %v (duration: %v)", repriced, err, duration)
+		return
+	}
+
+	if repriced > 0 {
+		log.Printf("[Worker] Dynamic pricing finished: %d tiers repriced (duration: %v)", repriced, duration)
+	}
+}
@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/event-service/internal/service"
+)
+
+// EventCompletionWorker completes events past their end date
+type EventCompletionWorker struct {
+	completionService service.EventCompletionService
+	interval          time.Duration
+	stopChan          chan struct{}
+}
+
+// NewEventCompletionWorker creates new event completion worker instance
+func NewEventCompletionWorker(
+	completionService service.EventCompletionService,
+	interval time.Duration,
+) *EventCompletionWorker {
+	return &EventCompletionWorker{
+		completionService: completionService,
+		interval:          interval,
+		stopChan:          make(chan struct{}),
+	}
+}
+
+// Start begins the event completion worker
+func (w *EventCompletionWorker) Start(ctx context.Context) {
+	log.Printf("[Worker] Event completion worker started (interval: %v)", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	// Run completion immediately on start
+	w.runCompletion(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			w.runCompletion(ctx)
+		case <-w.stopChan:
+			log.Println("[Worker] Event completion worker stopped")
+			return
+		case <-ctx.Done():
+			log.Println("[Worker] Event completion worker stopped due to context cancellation")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the event completion worker
+func (w *EventCompletionWorker) Stop() {
+	close(w.stopChan)
+}
+
+// runCompletion executes the completion operation
+func (w *EventCompletionWorker) runCompletion(ctx context.Context) {
+	defer errreport.Recover("event_completion_worker")
+
+	startTime := time.Now()
+	completed, err := w.completionService.CompleteEndedEvents(ctx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		log.Printf("[Worker] Event completion failed after %d events: %v (duration: %v)", completed, err, duration)
+		return
+	}
+
+	if completed > 0 {
+		log.Printf("[Worker] Event completion finished: %d events completed (duration: %v)", completed, duration)
+	}
+}
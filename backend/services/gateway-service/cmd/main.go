@@ -11,6 +11,12 @@ import (
 	"time"
 
 	"github.com/joho/godotenv"
+	sharedauth "github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/buildinfo"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/featureflags"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/hotreload"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/config"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/internal/router"
 )
@@ -27,6 +33,13 @@ func main() {
 	// Load configuration
 	cfg := config.Load()
 
+	// Error reporting: panics and alarms go to Sentry when SENTRY_DSN is set, otherwise they are only logged
+	if err := errreport.Init(errreport.ConfigFromEnv("gateway-service")); err != nil {
+		log.Printf("⚠️  Warning: Error reporting disabled: %v", err)
+	}
+	defer errreport.Flush(2 * time.Second)
+	log.Printf("Build: %s", buildinfo.Get("gateway-service"))
+
 	// Validate configuration
 	if err := cfg.Validate(); err != nil {
 		log.Fatalf("Configuration validation failed: %v", err)
@@ -39,9 +52,45 @@ func main() {
 		cfg.RateLimit.RequestsPerMinute,
 		cfg.RateLimit.BurstSize,
 	)
+	log.Printf("Maintenance mode: %s (runtime override: Redis key %s)", cfg.Maintenance.Mode, cfg.Maintenance.RedisKey)
+	log.Printf("JWT validation cache: %v (TTL: %s, max entries: %d)", cfg.TokenCache.Enabled, cfg.TokenCache.TTL, cfg.TokenCache.MaxEntries)
+	log.Printf("Circuit breaker: %v (threshold: %d failures, open timeout: %s)", cfg.Breaker.Enabled, cfg.Breaker.FailureThreshold, cfg.Breaker.OpenTimeout)
+	log.Printf("Access log: %v (sample rate: %g, slow threshold: %s)", cfg.AccessLog.Enabled, cfg.AccessLog.SampleRate, cfg.AccessLog.SlowThreshold)
+
+	// JWT keys (JWT_SECRET, rotation keys and optional RS256 public key)
+	jwtKeys, err := sharedauth.KeySetFromEnv(cfg.JWTSecret)
+	if err != nil {
+		log.Fatalf("Failed to load JWT keys: %v", err)
+	}
+	if jwtKeys.Len() == 0 {
+		log.Println("⚠️  Warning: no JWT keys configured (JWT_SECRET, JWT_KEYS or JWT_RSA_PUBLIC_KEY_FILE) - authentication will not work")
+	}
+
+	// Redis is optional: it backs the maintenance switch, Redis feature flags and token revocations
+	redisClient, err := cache.NewRedisClient()
+	if err != nil {
+		log.Printf("⚠️  Warning: Failed to connect to Redis: %v", err)
+		log.Println("⚠️  Maintenance mode will follow MAINTENANCE_MODE only")
+		redisClient = nil
+	} else {
+		defer redisClient.Close()
+	}
+
+	// Feature flags
+	flags, err := featureflags.NewFromEnv(redisClient)
+	if err != nil {
+		log.Printf("⚠️  Warning: Feature flags unavailable, using defaults: %v", err)
+	}
+
+	// Hot config reload: SIGHUP, POST /admin/config/reload or a Redis generation bump from any service
+	configWatcher := hotreload.NewWatcher(hotreload.Config{Service: "gateway-service", EnvFile: envPath}, redisClient)
 
 	// Setup router with all middleware and routes
-	r := router.SetupRouter(cfg)
+	r := router.SetupRouter(cfg, jwtKeys, flags, redisClient, configWatcher)
+
+	watcherCtx, stopWatcher := context.WithCancel(context.Background())
+	defer stopWatcher()
+	go configWatcher.Start(watcherCtx)
 
 	// Create HTTP server
 	srv := &http.Server{
@@ -2,9 +2,10 @@ package config
 
 import (
 	"fmt"
-	"log"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // Config holds gateway configuration
@@ -12,8 +13,15 @@ type Config struct {
 	Port        string
 	Environment string
 	JWTSecret   string
+	TokenCache  TokenCacheConfig
 	CORS        CORSConfig
 	RateLimit   RateLimitConfig
+	Maintenance MaintenanceConfig
+	BodyLimits  BodyLimitConfig
+	Ownership   OwnershipConfig
+	Tenants     TenantConfig
+	AccessLog   AccessLogConfig
+	Breaker     CircuitBreakerConfig
 	Services    ServiceURLs
 }
 
@@ -31,6 +39,31 @@ type RateLimitConfig struct {
 	Enabled           bool
 }
 
+// MaintenanceConfig holds maintenance mode configuration
+// Mode is the default; operators override it at runtime through RedisKey
+type MaintenanceConfig struct {
+	Mode            string        // "off" or "read_only"
+	Message         string        // Returned to rejected requests
+	RetryAfter      time.Duration // Sent as Retry-After on rejected requests
+	RedisKey        string        // Runtime override, e.g. SET gateway:maintenance read_only
+	RefreshInterval time.Duration // How often the Redis override is re-read
+}
+
+// BodyLimitConfig holds request body limits per route type (sizes in bytes)
+type BodyLimitConfig struct {
+	JSON         int64 // JSON API endpoints
+	Webhook      int64 // Payment provider webhooks
+	Upload       int64 // Multipart/image upload endpoints
+	MaxJSONDepth int   // Maximum nesting of objects/arrays in JSON bodies
+}
+
+// OwnershipConfig holds the event ownership cache configuration
+// Event writes by organizers who don't own the event are rejected at the gateway
+type OwnershipConfig struct {
+	Enabled bool
+	TTL     time.Duration // How long an organizer's event IDs are cached in Redis
+}
+
 // ServiceURLs holds backend service URLs
 type ServiceURLs struct {
 	AuthService         string
@@ -40,21 +73,92 @@ type ServiceURLs struct {
 	NotificationService string
 }
 
+// TenantConfig holds the tenant resolution configuration
+// Requests are mapped to a white-label tenant by domain via auth-service
+type TenantConfig struct {
+	Enabled bool
+	TTL     time.Duration // How long a domain's tenant is cached in memory
+}
+
+// TokenCacheConfig holds the JWT validation cache configuration
+// Validated tokens are trusted for TTL, so revoking a token takes effect within TTL
+type TokenCacheConfig struct {
+	Enabled    bool
+	TTL        time.Duration // How long a validated token is cached in memory
+	MaxEntries int           // Cached tokens per replica
+}
+
+// AccessLogConfig holds the structured access log configuration
+// Server errors and slow requests are always logged, other requests are sampled
+type AccessLogConfig struct {
+	Enabled       bool
+	SampleRate    float64       // Share of ordinary requests logged, 0 to 1
+	SlowThreshold time.Duration // Requests at least this slow are always logged
+	LogHeaders    bool          // Include request headers (Authorization and cookies redacted)
+	LogBodies     bool          // Include JSON request bodies (password, secret and token fields redacted)
+	MaxBodyBytes  int           // Logged body prefix
+}
+
+// CircuitBreakerConfig holds the per-upstream circuit breaker configuration
+// After FailureThreshold consecutive failures, requests to the upstream get 503 right away for OpenTimeout
+type CircuitBreakerConfig struct {
+	Enabled          bool
+	FailureThreshold int           // Consecutive failures (connection errors, timeouts, 502/504) that open the circuit
+	OpenTimeout      time.Duration // How long an open circuit rejects requests before probing the upstream
+	HalfOpenRequests int           // Probe requests let through at once while half-open
+}
+
 // Load loads configuration from environment variables
 func Load() *Config {
 	return &Config{
 		Port:        getEnv("PORT", "8080"),
 		Environment: getEnv("ENVIRONMENT", "development"),
 		JWTSecret:   getEnv("JWT_SECRET", ""),
+		TokenCache: TokenCacheConfig{
+			Enabled:    getEnv("JWT_CACHE_ENABLED", "false") == "true",
+			TTL:        getEnvAsDuration("JWT_CACHE_TTL", 30*time.Second),
+			MaxEntries: getEnvAsInt("JWT_CACHE_MAX_ENTRIES", 10000),
+		},
 		CORS: CORSConfig{
 			AllowedOrigins: getEnvAsSlice("CORS_ALLOWED_ORIGINS", "http://localhost:3000"),
 			AllowedMethods: []string{"GET", "POST", "PUT", "DELETE", "PATCH", "OPTIONS"},
 			AllowedHeaders: []string{"Content-Type", "Authorization", "X-Request-ID"},
 		},
-		RateLimit: RateLimitConfig{
-			RequestsPerMinute: getEnvAsInt("RATE_LIMIT_RPM", 100),
-			BurstSize:         getEnvAsInt("RATE_LIMIT_BURST", 20),
-			Enabled:           getEnv("RATE_LIMIT_ENABLED", "true") == "true",
+		RateLimit: LoadRateLimit(),
+		Maintenance: MaintenanceConfig{
+			Mode:            getEnv("MAINTENANCE_MODE", "off"),
+			Message:         getEnv("MAINTENANCE_MESSAGE", "The platform is in read-only mode for scheduled maintenance. Please try again later."),
+			RetryAfter:      getEnvAsDuration("MAINTENANCE_RETRY_AFTER", 5*time.Minute),
+			RedisKey:        getEnv("MAINTENANCE_REDIS_KEY", "gateway:maintenance"),
+			RefreshInterval: getEnvAsDuration("MAINTENANCE_REFRESH_INTERVAL", 5*time.Second),
+		},
+		BodyLimits: BodyLimitConfig{
+			JSON:         int64(getEnvAsInt("BODY_LIMIT_JSON", 64<<10)),
+			Webhook:      int64(getEnvAsInt("BODY_LIMIT_WEBHOOK", 512<<10)),
+			Upload:       int64(getEnvAsInt("BODY_LIMIT_UPLOAD", 5<<20)),
+			MaxJSONDepth: getEnvAsInt("BODY_MAX_JSON_DEPTH", 20),
+		},
+		Ownership: OwnershipConfig{
+			Enabled: getEnv("OWNERSHIP_CACHE_ENABLED", "true") == "true",
+			TTL:     getEnvAsDuration("OWNERSHIP_CACHE_TTL", 5*time.Minute),
+		},
+		Tenants: TenantConfig{
+			Enabled: getEnv("TENANT_RESOLUTION_ENABLED", "true") == "true",
+			TTL:     getEnvAsDuration("TENANT_CACHE_TTL", 5*time.Minute),
+		},
+		AccessLog: AccessLogConfig{
+			Enabled:       getEnv("ACCESS_LOG_ENABLED", "true") == "true",
+			SampleRate:    getEnvAsFloat("ACCESS_LOG_SAMPLE_RATE", 1),
+			SlowThreshold: getEnvAsDuration("ACCESS_LOG_SLOW_THRESHOLD", time.Second),
+			LogHeaders:    getEnv("ACCESS_LOG_HEADERS", "false") == "true",
+			LogBodies:     getEnv("ACCESS_LOG_BODIES", "false") == "true",
+			MaxBodyBytes:  getEnvAsInt("ACCESS_LOG_MAX_BODY_BYTES", 4<<10),
+		},
+		Breaker: CircuitBreakerConfig{
+			Enabled:          getEnv("CIRCUIT_BREAKER_ENABLED", "true") == "true",
+			FailureThreshold: getEnvAsInt("CIRCUIT_BREAKER_FAILURE_THRESHOLD", 5),
+			OpenTimeout:      getEnvAsDuration("CIRCUIT_BREAKER_OPEN_TIMEOUT", 30*time.Second),
+			HalfOpenRequests: getEnvAsInt("CIRCUIT_BREAKER_HALF_OPEN_REQUESTS", 1),
 		},
 		Services: ServiceURLs{
 			AuthService:         getEnv("AUTH_SERVICE_URL", "http://localhost:8081"),
@@ -66,10 +170,41 @@ func Load() *Config {
 	}
 }
 
+// LoadRateLimit loads the rate limiting configuration
+// Also called on config reload, rate limits are hot-reloadable
+func LoadRateLimit() RateLimitConfig {
+	return RateLimitConfig{
+		RequestsPerMinute: getEnvAsInt("RATE_LIMIT_RPM", 100),
+		BurstSize:         getEnvAsInt("RATE_LIMIT_BURST", 20),
+		Enabled:           getEnv("RATE_LIMIT_ENABLED", "true") == "true",
+	}
+}
+
+// Validate validates rate limiting configuration
+func (c RateLimitConfig) Validate() error {
+	if c.Enabled && (c.RequestsPerMinute <= 0 || c.BurstSize <= 0) {
+		return fmt.Errorf("invalid rate limit %d rpm, burst %d (expected positive values)", c.RequestsPerMinute, c.BurstSize)
+	}
+	return nil
+}
+
 // Validate validates configuration
 func (c *Config) Validate() error {
-	if c.JWTSecret == "" {
-		log.Println("⚠️  Warning: JWT_SECRET not set - authentication will not work")
+	if err := c.RateLimit.Validate(); err != nil {
+		return err
+	}
+	if c.TokenCache.Enabled && c.TokenCache.TTL <= 0 {
+		return fmt.Errorf("invalid JWT_CACHE_TTL %s (expected a positive duration)", c.TokenCache.TTL)
+	}
+	if c.Maintenance.Mode != "off" && c.Maintenance.Mode != "read_only" {
+		return fmt.Errorf("invalid MAINTENANCE_MODE %q (expected 'off' or 'read_only')", c.Maintenance.Mode)
+	}
+	if c.AccessLog.SampleRate < 0 || c.AccessLog.SampleRate > 1 {
+		return fmt.Errorf("invalid ACCESS_LOG_SAMPLE_RATE %v (expected 0 to 1)", c.AccessLog.SampleRate)
+	}
+	if c.Breaker.Enabled && (c.Breaker.FailureThreshold <= 0 || c.Breaker.OpenTimeout <= 0 || c.Breaker.HalfOpenRequests <= 0) {
+		return fmt.Errorf("invalid circuit breaker threshold %d, open timeout %s, half-open requests %d (expected positive values)",
+			c.Breaker.FailureThreshold, c.Breaker.OpenTimeout, c.Breaker.HalfOpenRequests)
 	}
 	return nil
 }
@@ -93,6 +228,26 @@ func getEnvAsInt(key string, fallback int) int {
 	return fallback
 }
 
+// getEnvAsFloat gets environment variable as float with fallback
+func getEnvAsFloat(key string, fallback float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if result, err := strconv.ParseFloat(value, 64); err == nil {
+			return result
+		}
+	}
+	return fallback
+}
+
+// getEnvAsDuration gets environment variable as duration (e.g. "5m") with fallback
+func getEnvAsDuration(key string, fallback time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if result, err := time.ParseDuration(value); err == nil {
+			return result
+		}
+	}
+	return fallback
+}
+
 // getEnvAsSlice gets environment variable as slice (comma-separated)
 func getEnvAsSlice(key, fallback string) []string {
 	value := getEnv(key, fallback)
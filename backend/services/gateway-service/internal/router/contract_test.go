@@ -0,0 +1,138 @@
+package router
+
+import (
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	sharedauth "github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/contract"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updateContract = flag.Bool("update", false, "rewrite pkg/contract/gateway_routes.json from gateway routes")
+
+const contractFile = "../../../../pkg/contract/gateway_routes.json"
+
+// TestGatewayContract proxies every gateway API route to recording upstreams and
+// compares the resulting route mapping with the shared contract
+// Run with -update after intentionally changing gateway routes
+func TestGatewayContract(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var mu sync.Mutex
+	var hits []contract.Route
+
+	// One recording upstream per service
+	recorder := func(service string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			hits = append(hits, contract.Route{Method: r.Method, Service: service, UpstreamPath: r.URL.Path})
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		}))
+	}
+
+	upstreams := map[string]*httptest.Server{
+		contract.ServiceAuth:      recorder(contract.ServiceAuth),
+		contract.ServiceEvent:     recorder(contract.ServiceEvent),
+		contract.ServiceTicketing: recorder(contract.ServiceTicketing),
+		contract.ServicePayment:   recorder(contract.ServicePayment),
+	}
+	for _, srv := range upstreams {
+		defer srv.Close()
+	}
+
+	cfg := config.Load()
+	cfg.JWTSecret = "contract-test-secret"
+	cfg.RateLimit.Enabled = false
+	cfg.Tenants.Enabled = false
+	cfg.Services.AuthService = upstreams[contract.ServiceAuth].URL
+	cfg.Services.EventService = upstreams[contract.ServiceEvent].URL
+	cfg.Services.TicketingService = upstreams[contract.ServiceTicketing].URL
+	cfg.Services.PaymentService = upstreams[contract.ServicePayment].URL
+
+	keys := sharedauth.NewHMACKeySet(cfg.JWTSecret)
+	token, err := keys.Sign(&sharedauth.Claims{
+		Email:            "contract@example.com",
+		Role:             "admin",
+		Scope:            strings.Join(sharedauth.AllPermissions, " "),
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "contract-user"},
+	})
+	require.NoError(t, err)
+
+	engine := SetupRouter(cfg, keys, nil, nil, nil)
+
+	var routes []contract.Route
+	for _, info := range engine.Routes() {
+		if !strings.HasPrefix(info.Path, "/api/") {
+			continue
+		}
+		if isAggregationRoute(info.Path) {
+			continue
+		}
+
+		mu.Lock()
+		hits = nil
+		mu.Unlock()
+
+		// Route patterns are sent literally; ":id" is a valid path segment value
+		req := httptest.NewRequest(info.Method, info.Path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+
+		mu.Lock()
+		routeHits := hits
+		mu.Unlock()
+
+		if !assert.Len(t, routeHits, 1, "%s %s should reach exactly one upstream (status %d)", info.Method, info.Path, w.Code) {
+			continue
+		}
+
+		route := routeHits[0]
+		route.GatewayPath = info.Path
+		routes = append(routes, route)
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].GatewayPath != routes[j].GatewayPath {
+			return routes[i].GatewayPath < routes[j].GatewayPath
+		}
+		return routes[i].Method < routes[j].Method
+	})
+
+	if *updateContract {
+		data, err := json.MarshalIndent(routes, "", "  ")
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(contractFile, append(data, '\n'), 0o644))
+		t.Logf("wrote %d routes to %s", len(routes), contractFile)
+		return
+	}
+
+	expected, err := contract.GatewayRoutes()
+	require.NoError(t, err)
+	assert.Equal(t, expected, routes,
+		"gateway routes drifted from pkg/contract/gateway_routes.json; rerun this test with -update and check the service contract tests")
+}
+
+// isAggregationRoute reports routes that fan out to several services
+// They have no single upstream mapping and are tested in pkg
+func isAggregationRoute(path string) bool {
+	for _, segment := range []string{"/checkout/", "/my-tickets"} {
+		if strings.Contains(path, segment) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,20 @@
+package router
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/middleware"
+)
+
+// deprecations lists gateway routes slated for removal
+// Paths are route patterns below the API prefix; entries must match a route registered
+// by registerRoutes. Remove an entry together with its route once it is past its sunset date
+var deprecations = []middleware.Deprecation{
+	{
+		Method:       "POST",
+		Path:         "/internal/orders/:id/confirm",
+		DeprecatedAt: time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC),
+		SunsetAt:     time.Date(2027, 4, 1, 0, 0, 0, 0, time.UTC),
+		Note:         "Payment confirmations go through the TicketingService.ConfirmPayment gRPC call",
+	},
+}
@@ -0,0 +1,46 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sharedauth "github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/config"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDeprecations_MatchRoutes keeps the registry in step with registered routes,
+// so a renamed or removed route doesn't leave a stale entry behind
+func TestDeprecations_MatchRoutes(t *testing.T) {
+	cfg := config.Load()
+	cfg.JWTSecret = "deprecations-test-secret"
+	cfg.Tenants.Enabled = false
+	engine := SetupRouter(cfg, sharedauth.NewHMACKeySet(cfg.JWTSecret), nil, nil, nil)
+
+	routes := make(map[string]bool)
+	for _, info := range engine.Routes() {
+		routes[info.Method+" "+info.Path] = true
+	}
+
+	for _, entry := range deprecations {
+		assert.True(t, routes[entry.Method+" /api/v1"+entry.Path], "%s %s is not a gateway route", entry.Method, entry.Path)
+		assert.False(t, entry.DeprecatedAt.IsZero(), "%s %s needs a deprecation date", entry.Method, entry.Path)
+		if !entry.SunsetAt.IsZero() {
+			assert.True(t, entry.SunsetAt.After(entry.DeprecatedAt), "%s %s sunsets before it is deprecated", entry.Method, entry.Path)
+		}
+	}
+
+	// Responses of deprecated routes carry the Deprecation header, even when the upstream is down
+	for _, entry := range deprecations {
+		req := httptest.NewRequest(entry.Method, "/api/v1"+entry.Path, nil)
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+		assert.NotEmpty(t, w.Header().Get(middleware.DeprecationHeader), "%s %s", entry.Method, entry.Path)
+	}
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/deprecations", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
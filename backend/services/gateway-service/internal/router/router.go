@@ -1,8 +1,15 @@
 package router
 
 import (
+	"context"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	sharedauth "github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/buildinfo"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/featureflags"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/hotreload"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/config"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/middleware"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/pkg"
@@ -11,7 +18,11 @@ import (
 )
 
 // SetupRouter configures all routes for the API Gateway
-func SetupRouter(cfg *config.Config) *gin.Engine {
+// flags may be nil, in which case every flag uses its fallback;
+// redisClient may be nil, in which case maintenance mode only follows configuration
+// and event ownership is left to event-service;
+// watcher may be nil, in which case nothing is hot-reloadable and the config admin routes are not registered
+func SetupRouter(cfg *config.Config, keys *sharedauth.KeySet, flags *featureflags.Client, redisClient cache.RedisClient, watcher *hotreload.Watcher) *gin.Engine {
 	// Set Gin mode
 	if cfg.Environment == "production" {
 		gin.SetMode(gin.ReleaseMode)
@@ -20,28 +31,45 @@ func SetupRouter(cfg *config.Config) *gin.Engine {
 	router := gin.New()
 
 	// Global middleware
-	router.Use(gin.Recovery())
-	router.Use(gin.Logger())
+	// The access log wraps recovery so requests that panicked are logged with their 500
+	if cfg.AccessLog.Enabled {
+		router.Use(middleware.NewAccessLog(cfg.AccessLog, nil).Middleware())
+	} else {
+		router.Use(gin.Logger())
+	}
+	router.Use(errreport.GinRecovery()) // Panics are reported to Sentry
 
 	// CORS middleware
 	corsConfig := cors.Config{
 		AllowOrigins:     cfg.CORS.AllowedOrigins,
 		AllowMethods:     cfg.CORS.AllowedMethods,
 		AllowHeaders:     cfg.CORS.AllowedHeaders,
+		ExposeHeaders:    []string{"API-Version", middleware.DeprecationHeader, middleware.SunsetHeader, middleware.LinkHeader, "Retry-After", "X-RateLimit-Limit", "X-RateLimit-Remaining", middleware.MaintenanceHeader, middleware.RequestIDHeader},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}
 	router.Use(cors.New(corsConfig))
 
-	// Rate limiting middleware (if enabled)
-	if cfg.RateLimit.Enabled {
-		rateLimiter := middleware.NewRateLimiter(
-			cfg.RateLimit.RequestsPerMinute,
-			cfg.RateLimit.BurstSize,
-		)
-		router.Use(rateLimiter.Middleware())
+	// Maintenance mode: read-only switch controlled by config and Redis
+	router.Use(middleware.NewMaintenance(cfg.Maintenance, redisClient).Middleware())
+
+	// Circuit breaker per upstream: a failing service gets a fast 503 instead of proxy timeouts
+	if cfg.Breaker.Enabled {
+		router.Use(middleware.NewCircuitBreakers(cfg.Breaker).Middleware())
 	}
 
+	// Feature flags for handlers and proxied routes
+	router.Use(featureflags.Middleware(flags))
+
+	// Rate limiting middleware
+	// Always installed so config reloads can enable it, disabled limiters let every request through
+	rateLimiter := middleware.NewRateLimiter(
+		cfg.RateLimit.RequestsPerMinute,
+		cfg.RateLimit.BurstSize,
+	)
+	rateLimiter.Apply(cfg.RateLimit)
+	router.Use(rateLimiter.Middleware())
+
 	// Health check endpoint (no auth required)
 	router.GET("/health", func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{
@@ -50,130 +78,550 @@ func SetupRouter(cfg *config.Config) *gin.Engine {
 		})
 	})
 
-	// API routes
-	v1 := router.Group("/api/v1")
-	{
-		// ============================================================
-		// AUTH SERVICE ROUTES
-		// ============================================================
-		auth := v1.Group("/auth")
-		{
-			// Public routes
-			auth.POST("/register", pkg.ProxyHandler(cfg.Services.AuthService))
-			auth.POST("/login", pkg.ProxyHandler(cfg.Services.AuthService))
-			auth.POST("/refresh", pkg.ProxyHandler(cfg.Services.AuthService))
-			auth.POST("/forgot-password", pkg.ProxyHandler(cfg.Services.AuthService))
-			auth.POST("/reset-password", pkg.ProxyHandler(cfg.Services.AuthService))
-
-			// Protected routes
-			authProtected := auth.Group("")
-			authProtected.Use(middleware.AuthMiddleware(cfg.JWTSecret))
-			{
-				authProtected.GET("/profile", pkg.ProxyHandler(cfg.Services.AuthService))
-				authProtected.POST("/change-password", pkg.ProxyHandler(cfg.Services.AuthService))
-			}
-		}
+	// Build info (version, git SHA, build time)
+	router.GET("/version", buildinfo.Handler("gateway-service"))
 
-		// ============================================================
-		// EVENT SERVICE ROUTES
-		// ============================================================
+	// Deprecated routes, annotated with Deprecation/Sunset/Link headers and listed for API consumers
+	deprecated := middleware.NewDeprecations(deprecations)
+	router.GET("/deprecations", deprecated.Handler())
 
-		// Public event routes
-		events := v1.Group("/events")
-		{
-			events.GET("", pkg.ProxyHandler(cfg.Services.EventService))                    // List events
-			events.GET("/slug/:slug", pkg.ProxyHandler(cfg.Services.EventService))         // Get by slug
-			events.GET("/:id", pkg.ProxyHandler(cfg.Services.EventService))                // Get by ID
-			events.GET("/:id/ticket-tiers", pkg.ProxyHandler(cfg.Services.EventService))   // Get ticket tiers
-		}
+	// JWT validation cache: repeated tokens skip signature verification and
+	// are checked against revocations in Redis once per TTL
+	var tokens *sharedauth.TokenCache
+	if cfg.TokenCache.Enabled {
+		tokens = sharedauth.NewTokenCache(cfg.TokenCache.TTL, cfg.TokenCache.MaxEntries, redisClient)
+	}
 
-		// Protected event routes (organizer only)
-		eventsProtected := v1.Group("/events")
-		eventsProtected.Use(middleware.AuthMiddleware(cfg.JWTSecret))
-		eventsProtected.Use(middleware.RoleMiddleware("organizer", "admin"))
-		{
-			eventsProtected.POST("", pkg.ProxyHandler(cfg.Services.EventService))          // Create event
-			eventsProtected.PUT("/:id", pkg.ProxyHandler(cfg.Services.EventService))       // Update event
-			eventsProtected.DELETE("/:id", pkg.ProxyHandler(cfg.Services.EventService))    // Delete event
-		}
+	// Hot-reloadable settings: rate limits and feature flags
+	if watcher != nil {
+		watcher.Register("rate_limit", func() error {
+			rateLimit := config.LoadRateLimit()
+			if err := rateLimit.Validate(); err != nil {
+				return err
+			}
+			rateLimiter.Apply(rateLimit)
+			return nil
+		}, func() any {
+			current := rateLimiter.Config()
+			return map[string]any{"enabled": current.Enabled, "requests_per_minute": current.RequestsPerMinute, "burst": current.BurstSize}
+		})
+		watcher.Register("feature_flags", func() error {
+			flags.Invalidate()
+			return nil
+		}, func() any { return flags.Definitions(context.Background()) })
 
-		// Public ticket tier routes
-		ticketTiers := v1.Group("/ticket-tiers")
+		// Config reload (config:manage), served by the gateway itself
+		configAdmin := router.Group("/admin/config")
+		configAdmin.Use(sharedauth.CachedMiddleware(keys, tokens), sharedauth.RequireScope(sharedauth.PermConfigManage))
 		{
-			ticketTiers.GET("/:id", pkg.ProxyHandler(cfg.Services.EventService))           // Get tier by ID
+			configAdmin.GET("", watcher.StatusHandler())         // Settings in effect and last reload
+			configAdmin.POST("/reload", watcher.ReloadHandler()) // Reload every replica of every service
 		}
+	}
 
-		// Protected ticket tier routes (organizer only)
-		ticketTiersProtected := v1.Group("/ticket-tiers")
-		ticketTiersProtected.Use(middleware.AuthMiddleware(cfg.JWTSecret))
-		ticketTiersProtected.Use(middleware.RoleMiddleware("organizer", "admin"))
-		{
-			ticketTiersProtected.POST("", pkg.ProxyHandler(cfg.Services.EventService))     // Create tier
-			ticketTiersProtected.PUT("/:id", pkg.ProxyHandler(cfg.Services.EventService))  // Update tier
-			ticketTiersProtected.DELETE("/:id", pkg.ProxyHandler(cfg.Services.EventService)) // Delete tier
-		}
+	// Early rejection of writes to events the organizer doesn't own
+	ownership := pkg.NewEventOwnership(cfg.Ownership, cfg.Services.EventService, redisClient)
 
-		// Organizer dashboard
-		organizer := v1.Group("/organizer")
-		organizer.Use(middleware.AuthMiddleware(cfg.JWTSecret))
-		organizer.Use(middleware.RoleMiddleware("organizer", "admin"))
-		{
-			organizer.GET("/events", pkg.ProxyHandler(cfg.Services.EventService))          // Get organizer's events
-		}
+	// White-label tenant of the request's domain, resolved before auth
+	tenants := pkg.NewTenantResolver(cfg.Tenants, cfg.Services.AuthService)
 
-		// ============================================================
-		// TICKETING SERVICE ROUTES
-		// ============================================================
+	// API routes
+	// /api/v1 is frozen: it always proxies to v1 upstream handlers.
+	// /api/v2 serves v2 handlers where an upstream has them and v1 otherwise.
+	// /api negotiates the version from the Accept header (default v1).
+	for _, api := range []struct {
+		prefix  string
+		version string
+	}{
+		{"/api/v1", pkg.APIVersionV1},
+		{"/api/v2", pkg.APIVersionV2},
+		{"/api", ""},
+	} {
+		registerRoutes(router.Group(api.prefix, middleware.APIVersion(api.prefix, api.version), deprecated.Middleware(), tenants.Middleware()), cfg, keys, tokens, ownership)
+	}
 
-		// Protected order routes
-		orders := v1.Group("/orders")
-		orders.Use(middleware.AuthMiddleware(cfg.JWTSecret))
-		{
-			orders.POST("", pkg.ProxyHandler(cfg.Services.TicketingService))               // Create order (reserve)
-			orders.GET("", pkg.ProxyHandler(cfg.Services.TicketingService))                // Get user orders
-			orders.GET("/:id", pkg.ProxyHandler(cfg.Services.TicketingService))            // Get order detail
-			orders.POST("/:id/cancel", pkg.ProxyHandler(cfg.Services.TicketingService))    // Cancel order
-		}
+	return router
+}
 
-		// Protected ticket routes
-		tickets := v1.Group("/tickets")
-		tickets.Use(middleware.AuthMiddleware(cfg.JWTSecret))
-		{
-			tickets.GET("", pkg.ProxyHandler(cfg.Services.TicketingService))               // Get user tickets
-			tickets.GET("/:id", pkg.ProxyHandler(cfg.Services.TicketingService))           // Get ticket detail
-		}
+// registerRoutes registers proxy routes on an API version group
+// Routes proxying to v2 upstream handlers must list the versions they support
+func registerRoutes(api *gin.RouterGroup, cfg *config.Config, keys *sharedauth.KeySet, tokens *sharedauth.TokenCache, ownership *pkg.EventOwnership) {
+	// Body policies: small JSON payloads for the API, larger allowances for provider webhooks and file imports
+	jsonBody := middleware.BodyGuard(middleware.BodyPolicy{
+		MaxBytes:     cfg.BodyLimits.JSON,
+		ContentTypes: middleware.JSONContentTypes,
+		MaxJSONDepth: cfg.BodyLimits.MaxJSONDepth,
+	})
+	webhookBody := middleware.BodyGuard(middleware.BodyPolicy{
+		MaxBytes:     cfg.BodyLimits.Webhook,
+		ContentTypes: middleware.JSONContentTypes,
+		MaxJSONDepth: cfg.BodyLimits.MaxJSONDepth,
+	})
+	importBody := middleware.BodyGuard(middleware.BodyPolicy{
+		MaxBytes:     cfg.BodyLimits.Upload,
+		ContentTypes: middleware.ImportContentTypes,
+		MaxJSONDepth: cfg.BodyLimits.MaxJSONDepth,
+	})
 
-		// Internal routes (for inter-service communication)
-		// These should ideally be on a separate internal network or use API keys
-		internal := v1.Group("/internal")
-		{
-			internal.POST("/orders/:id/confirm", pkg.ProxyHandler(cfg.Services.TicketingService)) // Confirm payment
-		}
+	// ============================================================
+	// AUTH SERVICE ROUTES
+	// ============================================================
+	auth := api.Group("/auth")
+	auth.Use(jsonBody)
+	{
+		// Public routes
+		auth.POST("/register", pkg.ProxyHandler(cfg.Services.AuthService))
+		auth.POST("/login", pkg.ProxyHandler(cfg.Services.AuthService))
+		auth.POST("/refresh", pkg.ProxyHandler(cfg.Services.AuthService))
+		auth.POST("/forgot-password", pkg.ProxyHandler(cfg.Services.AuthService))
+		auth.POST("/reset-password", pkg.ProxyHandler(cfg.Services.AuthService))
 
-		// Public ticket validation (for event staff)
-		public := v1.Group("/public")
+		// Protected routes
+		authProtected := auth.Group("")
+		authProtected.Use(sharedauth.CachedMiddleware(keys, tokens))
 		{
-			public.POST("/tickets/validate", pkg.ProxyHandler(cfg.Services.TicketingService)) // Validate ticket
+			authProtected.GET("/profile", pkg.ProxyHandler(cfg.Services.AuthService))
+			authProtected.POST("/change-password", pkg.ProxyHandler(cfg.Services.AuthService))
+			authProtected.POST("/logout", pkg.ProxyHandler(cfg.Services.AuthService))
 		}
+	}
 
-		// ============================================================
-		// PAYMENT SERVICE ROUTES
-		// ============================================================
+	// Branding of the tenant serving the request (white-label frontends)
+	api.GET("/tenant", pkg.ProxyHandler(cfg.Services.AuthService))
 
-		// Protected payment routes
-		payments := v1.Group("/payments")
-		payments.Use(middleware.AuthMiddleware(cfg.JWTSecret))
-		{
-			payments.POST("/invoices", pkg.ProxyHandler(cfg.Services.PaymentService))      // Create invoice
-			payments.GET("/invoices/:orderId", pkg.ProxyHandler(cfg.Services.PaymentService)) // Get invoice
-		}
+	// Role permission management (roles:manage)
+	admin := api.Group("/admin")
+	admin.Use(sharedauth.CachedMiddleware(keys, tokens))
+	admin.Use(sharedauth.RequireScope(sharedauth.PermRolesManage))
+	admin.Use(jsonBody)
+	{
+		admin.GET("/permissions", pkg.ProxyHandler(cfg.Services.AuthService))             // List role permissions
+		admin.PUT("/roles/:role/permissions", pkg.ProxyHandler(cfg.Services.AuthService)) // Set role permissions
+	}
 
-		// Webhook routes (no auth - signature verified by service)
-		webhooks := v1.Group("/webhooks")
-		{
-			webhooks.POST("/xendit", pkg.ProxyHandler(cfg.Services.PaymentService))        // Xendit webhook
-		}
+	// ============================================================
+	// EVENT SERVICE ROUTES
+	// ============================================================
+
+	// Public event routes
+	events := api.Group("/events")
+	{
+		events.GET("", pkg.ProxyHandler(cfg.Services.EventService))                  // List events
+		events.GET("/slug/:slug", pkg.ProxyHandler(cfg.Services.EventService))       // Get by slug
+		events.GET("/:id", pkg.ProxyHandler(cfg.Services.EventService))              // Get by ID
+		events.GET("/:id/ticket-tiers", pkg.ProxyHandler(cfg.Services.EventService)) // Get ticket tiers
+		events.GET("/:id/zones", pkg.ProxyHandler(cfg.Services.EventService))        // Get access zones
+		events.GET("/:id/faqs", pkg.ProxyHandler(cfg.Services.EventService))         // Get FAQ entries
+		events.GET("/:id/agenda", pkg.ProxyHandler(cfg.Services.EventService))       // Get agenda sessions
+		events.GET("/:id/performers", pkg.ProxyHandler(cfg.Services.EventService))   // Get performer lineup
+		events.GET("/:id/seo", pkg.ProxyHandler(cfg.Services.EventService))          // Get page metadata
+		events.GET("/:id/og-image", pkg.ProxyHandler(cfg.Services.EventService))     // Get share image
 	}
 
-	return router
+	// Protected event routes (events:write)
+	eventsProtected := api.Group("/events")
+	eventsProtected.Use(sharedauth.CachedMiddleware(keys, tokens))
+	eventsProtected.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite))
+	eventsProtected.Use(ownership.Middleware())
+	eventsProtected.Use(jsonBody)
+	{
+		eventsProtected.POST("", pkg.ProxyHandler(cfg.Services.EventService))                         // Create event
+		eventsProtected.PUT("/:id", pkg.ProxyHandler(cfg.Services.EventService))                      // Update event
+		eventsProtected.DELETE("/:id", pkg.ProxyHandler(cfg.Services.EventService))                   // Delete event
+		eventsProtected.POST("/:id/zones", pkg.ProxyHandler(cfg.Services.EventService))               // Create access zone
+		eventsProtected.DELETE("/:id/zones/:zoneId", pkg.ProxyHandler(cfg.Services.EventService))     // Delete access zone
+		eventsProtected.POST("/:id/faqs", pkg.ProxyHandler(cfg.Services.EventService))                // Add FAQ entry
+		eventsProtected.PUT("/:id/faqs/:faqId", pkg.ProxyHandler(cfg.Services.EventService))          // Replace FAQ entry
+		eventsProtected.DELETE("/:id/faqs/:faqId", pkg.ProxyHandler(cfg.Services.EventService))       // Delete FAQ entry
+		eventsProtected.POST("/:id/agenda", pkg.ProxyHandler(cfg.Services.EventService))              // Add agenda session
+		eventsProtected.PUT("/:id/agenda/:sessionId", pkg.ProxyHandler(cfg.Services.EventService))    // Replace agenda session
+		eventsProtected.DELETE("/:id/agenda/:sessionId", pkg.ProxyHandler(cfg.Services.EventService)) // Delete agenda session
+		eventsProtected.PUT("/:id/performers", pkg.ProxyHandler(cfg.Services.EventService))           // Set performer lineup
+		eventsProtected.PUT("/:id/revenue-splits", pkg.ProxyHandler(cfg.Services.EventService))       // Set revenue splits between co-organizers
+	}
+
+	// Event revenue splits, readable by co-organizers who don't own the event (checked by event-service)
+	eventSplits := api.Group("/events")
+	eventSplits.Use(sharedauth.CachedMiddleware(keys, tokens))
+	eventSplits.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite))
+	{
+		eventSplits.GET("/:id/revenue-splits", pkg.ProxyHandler(cfg.Services.EventService)) // Get revenue splits
+	}
+
+	// Event abuse reports (any authenticated user, not only the organizer)
+	eventReports := api.Group("/events")
+	eventReports.Use(sharedauth.CachedMiddleware(keys, tokens))
+	eventReports.Use(jsonBody)
+	{
+		eventReports.POST("/:id/report", pkg.ProxyHandler(cfg.Services.EventService)) // Report event
+	}
+
+	// Public performer directory
+	performers := api.Group("/performers")
+	{
+		performers.GET("", pkg.ProxyHandler(cfg.Services.EventService))            // Search performers
+		performers.GET("/:id", pkg.ProxyHandler(cfg.Services.EventService))        // Get performer
+		performers.GET("/:id/events", pkg.ProxyHandler(cfg.Services.EventService)) // Upcoming events featuring performer
+	}
+
+	// Protected performer routes (events:write)
+	performersProtected := api.Group("/performers")
+	performersProtected.Use(sharedauth.CachedMiddleware(keys, tokens))
+	performersProtected.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite))
+	performersProtected.Use(jsonBody)
+	{
+		performersProtected.POST("", pkg.ProxyHandler(cfg.Services.EventService))       // Create performer
+		performersProtected.PUT("/:id", pkg.ProxyHandler(cfg.Services.EventService))    // Update performer
+		performersProtected.DELETE("/:id", pkg.ProxyHandler(cfg.Services.EventService)) // Delete performer
+	}
+
+	// Public organizer profiles
+	organizers := api.Group("/organizers")
+	{
+		organizers.GET("/:id", pkg.ProxyHandler(cfg.Services.EventService)) // Get organizer profile with verification badge
+	}
+
+	// Public ticket tier routes
+	ticketTiers := api.Group("/ticket-tiers")
+	{
+		ticketTiers.GET("/:id", pkg.ProxyHandler(cfg.Services.EventService)) // Get tier by ID
+	}
+
+	// Protected ticket tier routes (events:write)
+	ticketTiersProtected := api.Group("/ticket-tiers")
+	ticketTiersProtected.Use(sharedauth.CachedMiddleware(keys, tokens))
+	ticketTiersProtected.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite))
+	ticketTiersProtected.Use(jsonBody)
+	{
+		ticketTiersProtected.POST("", pkg.ProxyHandler(cfg.Services.EventService))                  // Create tier
+		ticketTiersProtected.PUT("/:id", pkg.ProxyHandler(cfg.Services.EventService))               // Update tier
+		ticketTiersProtected.DELETE("/:id", pkg.ProxyHandler(cfg.Services.EventService))            // Delete tier
+		ticketTiersProtected.POST("/:id/archive", pkg.ProxyHandler(cfg.Services.EventService))      // Archive tier
+		ticketTiersProtected.PUT("/:id/zones", pkg.ProxyHandler(cfg.Services.EventService))         // Set tier zones
+		ticketTiersProtected.GET("/:id/price-history", pkg.ProxyHandler(cfg.Services.EventService)) // Tier price history
+	}
+
+	// Waitlists of sold-out ticket tiers, joined by any signed-in user
+	ticketTierWaitlist := api.Group("/ticket-tiers/:id/waitlist")
+	ticketTierWaitlist.Use(sharedauth.CachedMiddleware(keys, tokens))
+	ticketTierWaitlist.Use(jsonBody)
+	{
+		ticketTierWaitlist.POST("", pkg.ProxyHandler(cfg.Services.TicketingService))   // Join waitlist
+		ticketTierWaitlist.GET("", pkg.ProxyHandler(cfg.Services.TicketingService))    // Place in line or offer
+		ticketTierWaitlist.DELETE("", pkg.ProxyHandler(cfg.Services.TicketingService)) // Leave waitlist
+	}
+
+	// Organizer dashboard
+	organizer := api.Group("/organizer")
+	organizer.Use(sharedauth.CachedMiddleware(keys, tokens))
+	organizer.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite))
+	{
+		organizer.GET("/events", pkg.ProxyHandler(cfg.Services.EventService))                                             // Get organizer's events
+		organizer.POST("/events/import", ownership.Middleware(), importBody, pkg.ProxyHandler(cfg.Services.EventService)) // Import events from a JSON or CSV file
+		organizer.GET("/events/export", pkg.ProxyHandler(cfg.Services.EventService))                                      // Export events as a re-importable file
+		organizer.GET("/events/:id/analytics", pkg.ProxyHandler(cfg.Services.EventService))                               // Sales per tier, daily sales and conversion (event ownership is checked by event-service)
+		organizer.GET("/ticket-tiers/:id/inventory-history", pkg.ProxyHandler(cfg.Services.TicketingService))             // Seats sold/released over time (event ownership is checked by ticketing-service)
+		organizer.POST("/events/:id/sandbox/reset", pkg.ProxyHandler(cfg.Services.TicketingService))                      // Clear test orders of a sandbox event (event ownership is checked by ticketing-service)
+		organizer.POST("/events/:id/ticket-reissues", jsonBody, pkg.ProxyHandler(cfg.Services.TicketingService))          // Replace QR codes of all unused tickets (event ownership is checked by ticketing-service)
+		organizer.GET("/events/:id/ticket-reissues/:reissueId", pkg.ProxyHandler(cfg.Services.TicketingService))          // Ticket reissue progress
+		organizer.GET("/events/:id/checkins/live", pkg.ProxyHandler(cfg.Services.TicketingService))                       // Scans per gate and minute, scanners online (event ownership is checked by ticketing-service)
+		organizer.GET("/plan", pkg.ProxyHandler(cfg.Services.EventService))                                               // Get organizer's plan limits and usage
+		organizer.GET("/verification", pkg.ProxyHandler(cfg.Services.EventService))                                       // Get latest verification submission
+		organizer.POST("/verification", jsonBody, pkg.ProxyHandler(cfg.Services.EventService))                            // Submit verification documents
+		organizer.GET("/revenue-shares", pkg.ProxyHandler(cfg.Services.EventService))                                     // Share of events organizer is a party to
+		organizer.GET("/email-sender", pkg.ProxyHandler(cfg.Services.EventService))                                       // Own email sender and domain verification status
+		organizer.PUT("/email-sender", jsonBody, pkg.ProxyHandler(cfg.Services.EventService))                             // Set own sending domain, from name and reply-to
+		organizer.POST("/email-sender/verify", pkg.ProxyHandler(cfg.Services.EventService))                               // Check the domain's DNS records with the email provider
+		organizer.DELETE("/email-sender", pkg.ProxyHandler(cfg.Services.EventService))                                    // Go back to the platform sender
+		organizer.GET("/ticket-template", pkg.ProxyHandler(cfg.Services.EventService))                                    // Own e-ticket layout
+		organizer.PUT("/ticket-template", jsonBody, pkg.ProxyHandler(cfg.Services.EventService))                          // Set logo, brand colours, sponsor footer and terms of e-tickets
+		organizer.DELETE("/ticket-template", pkg.ProxyHandler(cfg.Services.EventService))                                 // Go back to the platform e-ticket layout
+		organizer.POST("/ticket-template/preview", jsonBody, pkg.ProxyHandler(cfg.Services.EventService))                 // Render a sample e-ticket PDF
+	}
+
+	// Organizer plan management (plans:manage)
+	plans := api.Group("/admin")
+	plans.Use(sharedauth.CachedMiddleware(keys, tokens))
+	plans.Use(sharedauth.RequireScope(sharedauth.PermPlansManage))
+	plans.Use(jsonBody)
+	{
+		plans.GET("/plans", pkg.ProxyHandler(cfg.Services.EventService))               // List plans
+		plans.PUT("/plans/:code", pkg.ProxyHandler(cfg.Services.EventService))         // Update plan limits
+		plans.GET("/organizers/:id/plan", pkg.ProxyHandler(cfg.Services.EventService)) // Organizer's plan and usage
+		plans.PUT("/organizers/:id/plan", pkg.ProxyHandler(cfg.Services.EventService)) // Assign organizer to plan
+	}
+
+	// ============================================================
+	// TICKETING SERVICE ROUTES
+	// ============================================================
+
+	// Protected order routes
+	orders := api.Group("/orders")
+	orders.Use(sharedauth.CachedMiddleware(keys, tokens))
+	orders.Use(jsonBody)
+	{
+		orders.POST("", pkg.ProxyHandler(cfg.Services.TicketingService))                                        // Create order (reserve)
+		orders.POST("/quote", pkg.ProxyHandler(cfg.Services.TicketingService))                                  // Price order without reserving
+		orders.GET("", pkg.ProxyHandler(cfg.Services.TicketingService, pkg.APIVersionV1, pkg.APIVersionV2))     // Get user orders
+		orders.GET("/:id", pkg.ProxyHandler(cfg.Services.TicketingService, pkg.APIVersionV1, pkg.APIVersionV2)) // Get order detail
+		orders.POST("/:id/cancel", pkg.ProxyHandler(cfg.Services.TicketingService))                             // Cancel order
+		orders.POST("/:id/issues", pkg.ProxyHandler(cfg.Services.TicketingService))                             // Report order issue
+		orders.GET("/:id/issues", pkg.ProxyHandler(cfg.Services.TicketingService))                              // Get order issues
+		orders.GET("/:id/insurance", pkg.ProxyHandler(cfg.Services.TicketingService))                           // Get order ticket insurance
+		orders.POST("/:id/insurance/claim", pkg.ProxyHandler(cfg.Services.TicketingService))                    // Claim ticket insurance
+		orders.POST("/:id/refund", pkg.ProxyHandler(cfg.Services.TicketingService))                             // Refund under the event's refund policy
+		orders.GET("/:id/policies", pkg.ProxyHandler(cfg.Services.TicketingService))                            // Policy versions accepted with the order
+	}
+
+	// Protected ticket routes
+	tickets := api.Group("/tickets")
+	tickets.Use(sharedauth.CachedMiddleware(keys, tokens))
+	tickets.Use(jsonBody)
+	{
+		tickets.GET("", pkg.ProxyHandler(cfg.Services.TicketingService, pkg.APIVersionV1, pkg.APIVersionV2))     // Get user tickets
+		tickets.GET("/:id", pkg.ProxyHandler(cfg.Services.TicketingService, pkg.APIVersionV1, pkg.APIVersionV2)) // Get ticket detail
+		tickets.GET("/:id/share", pkg.ProxyHandler(cfg.Services.TicketingService))                               // Create share link
+		tickets.DELETE("/:id/share", pkg.ProxyHandler(cfg.Services.TicketingService))                            // Revoke share links
+		tickets.POST("/:id/regenerate", pkg.ProxyHandler(cfg.Services.TicketingService))                         // Rotate QR code
+		tickets.GET("/:id/qr", pkg.ProxyHandler(cfg.Services.TicketingService))                                  // Current rotating QR code (dynamic QR events)
+	}
+
+	// Ticket revocation (orders:refund; event ownership is checked by ticketing-service)
+	ticketsProtected := api.Group("/tickets")
+	ticketsProtected.Use(sharedauth.CachedMiddleware(keys, tokens))
+	ticketsProtected.Use(sharedauth.RequireScope(sharedauth.PermOrdersRefund))
+	ticketsProtected.Use(jsonBody)
+	{
+		ticketsProtected.POST("/:id/revoke", pkg.ProxyHandler(cfg.Services.TicketingService)) // Void ticket
+	}
+
+	// Badge data for check-in desks (checkin:scan; event ownership is checked by ticketing-service)
+	ticketsCheckin := api.Group("/tickets")
+	ticketsCheckin.Use(sharedauth.CachedMiddleware(keys, tokens))
+	ticketsCheckin.Use(sharedauth.RequireScope(sharedauth.PermCheckinScan))
+	ticketsCheckin.Use(jsonBody)
+	{
+		ticketsCheckin.GET("/:id/badge", pkg.ProxyHandler(cfg.Services.TicketingService)) // Badge-ready data
+	}
+
+	// Conference badges and check-in kiosks (events:write; event ownership is checked by ticketing-service)
+	badges := api.Group("/badges")
+	badges.Use(sharedauth.CachedMiddleware(keys, tokens))
+	badges.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite))
+	badges.Use(jsonBody)
+	{
+		badges.POST("/pdf", pkg.ProxyHandler(cfg.Services.TicketingService)) // Batch badge PDF
+	}
+
+	kiosks := api.Group("/kiosks")
+	kiosks.Use(sharedauth.CachedMiddleware(keys, tokens))
+	kiosks.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite))
+	kiosks.Use(jsonBody)
+	{
+		kiosks.POST("", pkg.ProxyHandler(cfg.Services.TicketingService))       // Register kiosk
+		kiosks.GET("", pkg.ProxyHandler(cfg.Services.TicketingService))        // List event kiosks
+		kiosks.DELETE("/:id", pkg.ProxyHandler(cfg.Services.TicketingService)) // Revoke kiosk
+	}
+
+	// Attendee lists with accessibility accommodations (events:write; event ownership is checked by ticketing-service)
+	attendees := api.Group("/attendees")
+	attendees.Use(sharedauth.CachedMiddleware(keys, tokens))
+	attendees.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite))
+	attendees.Use(jsonBody)
+	{
+		attendees.GET("", pkg.ProxyHandler(cfg.Services.TicketingService))                // List attendees (?event_id=)
+		attendees.GET("/accommodations", pkg.ProxyHandler(cfg.Services.TicketingService)) // Accommodation summary
+	}
+
+	// Terms and event policies (events:write; platform terms by admins, event policies by the event's organizer)
+	policies := api.Group("/policies")
+	policies.Use(sharedauth.CachedMiddleware(keys, tokens))
+	policies.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite))
+	policies.Use(jsonBody)
+	{
+		policies.POST("", pkg.ProxyHandler(cfg.Services.TicketingService)) // Publish policy version
+	}
+
+	// Announcement emails to event attendees (events:write; counted against the organizer's plan)
+	announcements := api.Group("/announcements")
+	announcements.Use(sharedauth.CachedMiddleware(keys, tokens))
+	announcements.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite))
+	announcements.Use(jsonBody)
+	{
+		announcements.POST("", pkg.ProxyHandler(cfg.Services.TicketingService)) // Send announcement
+	}
+
+	// Notification preferences of the signed-in user (digest frequency of non-urgent notifications)
+	notificationPreferences := api.Group("/notification-preferences")
+	notificationPreferences.Use(sharedauth.CachedMiddleware(keys, tokens))
+	notificationPreferences.Use(jsonBody)
+	{
+		notificationPreferences.GET("", pkg.ProxyHandler(cfg.Services.TicketingService)) // Get digest frequency
+		notificationPreferences.PUT("", pkg.ProxyHandler(cfg.Services.TicketingService)) // Set digest frequency
+	}
+
+	// Invite-only guest lists (events:write; event ownership is checked by ticketing-service), claimed by any signed-in user
+	invitations := api.Group("/invitations")
+	invitations.Use(sharedauth.CachedMiddleware(keys, tokens))
+	{
+		invitations.POST("/import", sharedauth.RequireScope(sharedauth.PermEventsWrite), importBody, pkg.ProxyHandler(cfg.Services.TicketingService)) // Import invitees from CSV
+		invitations.GET("", sharedauth.RequireScope(sharedauth.PermEventsWrite), jsonBody, pkg.ProxyHandler(cfg.Services.TicketingService))           // List invitations (?event_id=)
+		invitations.POST("/claim", jsonBody, pkg.ProxyHandler(cfg.Services.TicketingService))                                                         // Claim invitation
+	}
+
+	// Partner API keys for automation platforms (events:write)
+	apiKeys := api.Group("/api-keys")
+	apiKeys.Use(sharedauth.CachedMiddleware(keys, tokens))
+	apiKeys.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite))
+	apiKeys.Use(jsonBody)
+	{
+		apiKeys.POST("", pkg.ProxyHandler(cfg.Services.TicketingService))       // Create API key
+		apiKeys.GET("", pkg.ProxyHandler(cfg.Services.TicketingService))        // List API keys
+		apiKeys.DELETE("/:id", pkg.ProxyHandler(cfg.Services.TicketingService)) // Revoke API key
+	}
+
+	// Partner integrations (Zapier, Make); the X-API-Key header is checked by ticketing-service
+	integrations := api.Group("/integrations")
+	integrations.Use(jsonBody)
+	{
+		integrations.GET("/me", pkg.ProxyHandler(cfg.Services.TicketingService))                   // Connection test
+		integrations.GET("/triggers/orders", pkg.ProxyHandler(cfg.Services.TicketingService))      // Orders trigger
+		integrations.GET("/triggers/attendees", pkg.ProxyHandler(cfg.Services.TicketingService))   // Attendees trigger
+		integrations.GET("/triggers/checkins", pkg.ProxyHandler(cfg.Services.TicketingService))    // Check-ins trigger
+		integrations.POST("/actions/checkins", pkg.ProxyHandler(cfg.Services.TicketingService))    // Check-in action
+		integrations.POST("/actions/invitations", pkg.ProxyHandler(cfg.Services.TicketingService)) // Invitation action
+	}
+
+	// Buyer support (support:manage)
+	support := api.Group("/admin")
+	support.Use(sharedauth.CachedMiddleware(keys, tokens))
+	support.Use(sharedauth.RequireScope(sharedauth.PermSupportManage))
+	support.Use(jsonBody)
+	{
+		support.GET("/issues", pkg.ProxyHandler(cfg.Services.TicketingService))                         // Unresolved issues queue
+		support.GET("/orders", pkg.ProxyHandler(cfg.Services.TicketingService))                         // Search orders by metadata
+		support.GET("/orders/:id", pkg.ProxyHandler(cfg.Services.TicketingService))                     // Order with payment and issues
+		support.POST("/issues/:id/replies", pkg.ProxyHandler(cfg.Services.TicketingService))            // Reply to issue
+		support.POST("/issues/:id/resolve", pkg.ProxyHandler(cfg.Services.TicketingService))            // Resolve issue
+		support.GET("/refunds", pkg.ProxyHandler(cfg.Services.TicketingService))                        // Payments flagged for manual refund
+		support.POST("/refunds/:id/complete", pkg.ProxyHandler(cfg.Services.TicketingService))          // Mark refunded
+		support.POST("/orders/:id/mark-paid", pkg.ProxyHandler(cfg.Services.TicketingService))          // Confirm bank transfer without webhook
+		support.GET("/orders/:id/events", pkg.ProxyHandler(cfg.Services.TicketingService))              // Event stream of an event-sourced order
+		support.POST("/orders/:id/replay", pkg.ProxyHandler(cfg.Services.TicketingService))             // Rebuild order from its stream
+		support.POST("/orders/replay", pkg.ProxyHandler(cfg.Services.TicketingService))                 // Rebuild every event-sourced order
+		support.GET("/webhooks/quarantine", pkg.ProxyHandler(cfg.Services.PaymentService))              // Payment webhooks that matched no payment
+		support.POST("/webhooks/quarantine/:id/replay", pkg.ProxyHandler(cfg.Services.PaymentService))  // Process quarantined webhook again
+		support.POST("/webhooks/quarantine/:id/dismiss", pkg.ProxyHandler(cfg.Services.PaymentService)) // Drop quarantined webhook
+		support.POST("/payments/:id/replay", pkg.ProxyHandler(cfg.Services.PaymentService))             // Confirm paid payment's order again
+		support.GET("/sagas", pkg.ProxyHandler(cfg.Services.PaymentService))                            // Where paid payments sit in the paid-order saga
+		support.GET("/sagas/:id", pkg.ProxyHandler(cfg.Services.PaymentService))                        // Saga of one payment
+	}
+
+	// Event abuse report moderation (reports:manage)
+	moderation := api.Group("/admin")
+	moderation.Use(sharedauth.CachedMiddleware(keys, tokens))
+	moderation.Use(sharedauth.RequireScope(sharedauth.PermReportsManage))
+	moderation.Use(jsonBody)
+	{
+		moderation.GET("/event-reports", pkg.ProxyHandler(cfg.Services.EventService))          // Reported events queue
+		moderation.GET("/events/:id/reports", pkg.ProxyHandler(cfg.Services.EventService))     // Event with its reports
+		moderation.POST("/events/:id/moderation", pkg.ProxyHandler(cfg.Services.EventService)) // Dismiss reports or remove event
+	}
+
+	// Organizer verification review (orgs:verify)
+	verification := api.Group("/admin")
+	verification.Use(sharedauth.CachedMiddleware(keys, tokens))
+	verification.Use(sharedauth.RequireScope(sharedauth.PermOrgsVerify))
+	verification.Use(jsonBody)
+	{
+		verification.GET("/organizer-verifications", pkg.ProxyHandler(cfg.Services.EventService))             // Verification review queue
+		verification.POST("/organizer-verifications/:id/review", pkg.ProxyHandler(cfg.Services.EventService)) // Approve or reject submission
+		verification.POST("/organizers/:id/verification/revoke", pkg.ProxyHandler(cfg.Services.EventService)) // Withdraw verified badge
+	}
+
+	// Internal routes (for inter-service communication)
+	// These should ideally be on a separate internal network or use API keys
+	// Deprecated: payment-service confirms orders over gRPC (see deprecations)
+	internal := api.Group("/internal")
+	internal.Use(jsonBody)
+	{
+		internal.POST("/orders/:id/confirm", pkg.ProxyHandler(cfg.Services.TicketingService)) // Confirm payment
+	}
+
+	// Public ticket and policy routes; validation and scanner heartbeats are for gate staff (checkin:scan),
+	// kiosk check-in is authenticated by the kiosk device token
+	public := api.Group("/public")
+	public.Use(jsonBody)
+	{
+		// Validate ticket
+		public.POST("/tickets/validate",
+			sharedauth.CachedMiddleware(keys, tokens),
+			sharedauth.RequireScope(sharedauth.PermCheckinScan),
+			pkg.ProxyHandler(cfg.Services.TicketingService),
+		)
+		// Scanner heartbeat, sent by scanner apps every 30 seconds
+		public.POST("/scanners/heartbeat",
+			sharedauth.CachedMiddleware(keys, tokens),
+			sharedauth.RequireScope(sharedauth.PermCheckinScan),
+			pkg.ProxyHandler(cfg.Services.TicketingService),
+		)
+		public.GET("/tickets/shared/:token", pkg.ProxyHandler(cfg.Services.TicketingService)) // Shared ticket view
+		public.POST("/kiosk/checkin", pkg.ProxyHandler(cfg.Services.TicketingService))        // Kiosk check-in (X-Kiosk-Token)
+		public.GET("/policies", pkg.ProxyHandler(cfg.Services.TicketingService))              // Current terms and event policies
+		public.GET("/policies/:id", pkg.ProxyHandler(cfg.Services.TicketingService))          // Policy version
+		public.GET("/invitations/:token", pkg.ProxyHandler(cfg.Services.TicketingService))    // Invitation behind a claim link
+	}
+
+	// ============================================================
+	// AGGREGATION ROUTES
+	// ============================================================
+
+	// Checkout page data (event, tier availability, profile, open reservation)
+	checkout := api.Group("/checkout")
+	checkout.Use(sharedauth.CachedMiddleware(keys, tokens))
+	{
+		checkout.GET("/:eventId", pkg.CheckoutHandler(cfg.Services))
+	}
+
+	// My tickets grouped by event (upcoming/past) with event details
+	myTickets := api.Group("/my-tickets")
+	myTickets.Use(sharedauth.CachedMiddleware(keys, tokens))
+	{
+		myTickets.GET("", pkg.MyTicketsHandler(cfg.Services))
+	}
+
+	// ============================================================
+	// PAYMENT SERVICE ROUTES
+	// ============================================================
+
+	// Protected payment routes
+	payments := api.Group("/payments")
+	payments.Use(sharedauth.CachedMiddleware(keys, tokens))
+	payments.Use(jsonBody)
+	{
+		payments.POST("/invoices", pkg.ProxyHandler(cfg.Services.PaymentService))         // Create invoice
+		payments.GET("/invoices/:orderId", pkg.ProxyHandler(cfg.Services.PaymentService)) // Get invoice
+		payments.GET("/status/:orderId", pkg.ProxyHandler(cfg.Services.PaymentService))   // Payment status polling (cached, ?refresh=true syncs)
+		payments.POST("/refunds", pkg.ProxyHandler(cfg.Services.PaymentService))          // Refund a paid order under its event's refund policy
+	}
+
+	// Organizer plan subscriptions (events:write), billed separately from ticket payments
+	subscriptions := api.Group("/subscriptions")
+	subscriptions.Use(sharedauth.CachedMiddleware(keys, tokens))
+	subscriptions.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite))
+	subscriptions.Use(jsonBody)
+	{
+		subscriptions.GET("/plans", pkg.ProxyHandler(cfg.Services.PaymentService)) // Paid plans and prices
+		subscriptions.GET("", pkg.ProxyHandler(cfg.Services.PaymentService))       // Organizer's subscription
+		subscriptions.POST("", pkg.ProxyHandler(cfg.Services.PaymentService))      // Subscribe
+		subscriptions.PUT("/plan", pkg.ProxyHandler(cfg.Services.PaymentService))  // Upgrade or downgrade
+		subscriptions.DELETE("", pkg.ProxyHandler(cfg.Services.PaymentService))    // Cancel at period end
+	}
+
+	// Webhook routes (no auth - signature verified by service)
+	webhooks := api.Group("/webhooks")
+	webhooks.Use(webhookBody)
+	{
+		webhooks.POST("/xendit", pkg.ProxyHandler(cfg.Services.PaymentService)) // Xendit webhook
+	}
 }
@@ -0,0 +1,272 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"math/rand/v2"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/config"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/pkg"
+)
+
+// RequestIDHeader carries the request ID; a valid client value is kept, otherwise one is generated
+const RequestIDHeader = "X-Request-ID"
+
+// redactedValue replaces sensitive header, query and body values
+const redactedValue = "[REDACTED]"
+
+// maxRequestIDLength bounds client supplied request IDs
+const maxRequestIDLength = 128
+
+// redactedHeaders are never logged in clear
+var redactedHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"X-Api-Key":           true,
+}
+
+// sensitiveFieldMarkers redact any query or JSON field whose name contains one of them
+// e.g. password, new_password, client_secret, refresh_token
+var sensitiveFieldMarkers = []string{"password", "secret", "token"}
+
+// accessLogEntry is one structured access log line
+type accessLogEntry struct {
+	Time      string            `json:"time"`
+	RequestID string            `json:"request_id"`
+	Method    string            `json:"method"`
+	Path      string            `json:"path"`
+	Route     string            `json:"route,omitempty"`
+	Query     string            `json:"query,omitempty"`
+	Status    int               `json:"status"`
+	LatencyMs float64           `json:"latency_ms"`
+	Upstream  string            `json:"upstream,omitempty"`
+	UserID    string            `json:"user_id,omitempty"`
+	ClientIP  string            `json:"client_ip"`
+	UserAgent string            `json:"user_agent,omitempty"`
+	BytesOut  int               `json:"bytes_out"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	Body      interface{}       `json:"body,omitempty"`
+}
+
+// AccessLog writes one JSON line per request for the log pipeline
+// Server errors and slow requests are always logged; other requests are sampled
+type AccessLog struct {
+	cfg    config.AccessLogConfig
+	sample func() float64 // Uniform in [0, 1), swapped in tests
+
+	mu  sync.Mutex
+	out io.Writer
+}
+
+// NewAccessLog creates a new access log writing to out (os.Stdout if nil)
+func NewAccessLog(cfg config.AccessLogConfig, out io.Writer) *AccessLog {
+	if out == nil {
+		out = os.Stdout
+	}
+	return &AccessLog{cfg: cfg, sample: rand.Float64, out: out}
+}
+
+// Middleware returns the access log middleware
+// It also assigns the request ID, echoed in the response and forwarded upstream as X-Correlation-ID
+func (a *AccessLog) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		requestID := c.GetHeader(RequestIDHeader)
+		if !validRequestID(requestID) {
+			requestID = uuid.New().String()
+		}
+		c.Set(pkg.CorrelationIDKey, requestID)
+		c.Header(RequestIDHeader, requestID)
+
+		var body *bodyCapture
+		if a.cfg.LogBodies && c.Request.Body != nil && c.Request.Body != http.NoBody && isJSONRequest(c.Request) {
+			body = &bodyCapture{ReadCloser: c.Request.Body, max: a.cfg.MaxBodyBytes}
+			c.Request.Body = body
+		}
+
+		c.Next()
+
+		latency := time.Since(start)
+		status := c.Writer.Status()
+		if !a.shouldLog(status, latency) {
+			return
+		}
+
+		entry := accessLogEntry{
+			Time:      start.UTC().Format(time.RFC3339Nano),
+			RequestID: requestID,
+			Method:    c.Request.Method,
+			Path:      c.Request.URL.Path,
+			Route:     c.FullPath(),
+			Query:     redactQuery(c.Request.URL.RawQuery),
+			Status:    status,
+			LatencyMs: float64(latency.Microseconds()) / 1000,
+			Upstream:  c.GetString(pkg.UpstreamKey),
+			UserID:    c.GetString("user_id"),
+			ClientIP:  c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+			BytesOut:  c.Writer.Size(),
+		}
+		if entry.BytesOut < 0 {
+			entry.BytesOut = 0
+		}
+		if a.cfg.LogHeaders {
+			entry.Headers = redactHeaders(c.Request.Header)
+		}
+		if body != nil {
+			entry.Body = body.redacted()
+		}
+
+		a.write(&entry)
+	}
+}
+
+// shouldLog decides whether a finished request is logged
+func (a *AccessLog) shouldLog(status int, latency time.Duration) bool {
+	if status >= http.StatusInternalServerError {
+		return true
+	}
+	if a.cfg.SlowThreshold > 0 && latency >= a.cfg.SlowThreshold {
+		return true
+	}
+	return a.cfg.SampleRate >= 1 || a.sample() < a.cfg.SampleRate
+}
+
+// write emits one log line
+func (a *AccessLog) write(entry *accessLogEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("[AccessLog] Failed to encode entry of request %s: %v", entry.RequestID, err)
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.out.Write(append(line, '\n'))
+}
+
+// validRequestID accepts short printable IDs, so clients can't inject into logs
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		if r < '!' || r > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// isJSONRequest reports whether the request body is declared as JSON
+func isJSONRequest(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == "application/json"
+}
+
+// isSensitiveField reports whether a query or JSON field must be redacted
+func isSensitiveField(name string) bool {
+	name = strings.ToLower(name)
+	for _, marker := range sensitiveFieldMarkers {
+		if strings.Contains(name, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactHeaders flattens request headers, hiding credentials
+func redactHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for key, values := range header {
+		if redactedHeaders[http.CanonicalHeaderKey(key)] {
+			headers[key] = redactedValue
+			continue
+		}
+		headers[key] = strings.Join(values, ", ")
+	}
+	return headers
+}
+
+// redactQuery hides sensitive query parameters, e.g. a reset token
+func redactQuery(rawQuery string) string {
+	if rawQuery == "" {
+		return ""
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return redactedValue
+	}
+	for key := range query {
+		if isSensitiveField(key) {
+			query[key] = []string{redactedValue}
+		}
+	}
+	return query.Encode()
+}
+
+// redactJSON hides sensitive fields at any depth of a decoded JSON value
+func redactJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if isSensitiveField(key) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactJSON(field)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redactJSON(v[i])
+		}
+	}
+	return value
+}
+
+// bodyCapture keeps a prefix of the request body as the handler reads it
+// Reading along instead of up front leaves body limits to BodyGuard
+type bodyCapture struct {
+	io.ReadCloser
+	max  int
+	buf  bytes.Buffer
+	read int
+}
+
+// Read reads from the body, copying up to max bytes aside
+func (b *bodyCapture) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if remaining := b.max - b.buf.Len(); remaining > 0 {
+		b.buf.Write(p[:min(n, remaining)])
+	}
+	b.read += n
+	return n, err
+}
+
+// redacted returns the captured body for logging, with sensitive fields hidden
+// Bodies that can't be decoded (truncated or malformed) are summarized, never logged raw
+func (b *bodyCapture) redacted() interface{} {
+	if b.read == 0 {
+		return nil
+	}
+
+	var decoded interface{}
+	if b.read > b.buf.Len() || json.Unmarshal(b.buf.Bytes(), &decoded) != nil {
+		return map[string]interface{}{"unlogged_bytes": b.read}
+	}
+	return redactJSON(decoded)
+}
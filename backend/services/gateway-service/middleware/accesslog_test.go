@@ -0,0 +1,149 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/config"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/pkg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAccessLogRouter(accessLog *AccessLog, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(accessLog.Middleware())
+	router.Any("/api/v1/auth/:action", func(c *gin.Context) {
+		c.Set("user_id", "user-1")
+		c.Set(pkg.UpstreamKey, "http://auth-service")
+		handler(c)
+	})
+	return router
+}
+
+func accessLogLines(t *testing.T, out *bytes.Buffer) []map[string]interface{} {
+	var lines []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		lines = append(lines, entry)
+	}
+	return lines
+}
+
+func TestAccessLog_LogsRequestWithRedaction(t *testing.T) {
+	var out bytes.Buffer
+	accessLog := NewAccessLog(config.AccessLogConfig{SampleRate: 1, LogHeaders: true, LogBodies: true, MaxBodyBytes: 1024}, &out)
+
+	var forwarded string
+	router := newAccessLogRouter(accessLog, func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		forwarded = string(body)
+		c.JSON(http.StatusCreated, gin.H{"ok": true})
+	})
+
+	reqBody := `{"email":"a@b.c","password":"hunter2","profile":{"new_password":"x","name":"A"}}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/register?token=abc&ref=home", strings.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer secret-jwt")
+	req.Header.Set(RequestIDHeader, "req-123")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, reqBody, forwarded, "body must reach the handler unchanged")
+	assert.Equal(t, "req-123", w.Header().Get(RequestIDHeader))
+	assert.NotContains(t, out.String(), "hunter2")
+	assert.NotContains(t, out.String(), "secret-jwt")
+	assert.NotContains(t, out.String(), "abc")
+
+	lines := accessLogLines(t, &out)
+	require.Len(t, lines, 1)
+	entry := lines[0]
+	assert.Equal(t, "req-123", entry["request_id"])
+	assert.Equal(t, "/api/v1/auth/:action", entry["route"])
+	assert.Equal(t, float64(http.StatusCreated), entry["status"])
+	assert.Equal(t, "http://auth-service", entry["upstream"])
+	assert.Equal(t, "user-1", entry["user_id"])
+	assert.Contains(t, entry, "latency_ms")
+	assert.Equal(t, "ref=home&token=%5BREDACTED%5D", entry["query"])
+	assert.Equal(t, redactedValue, entry["headers"].(map[string]interface{})["Authorization"])
+
+	body := entry["body"].(map[string]interface{})
+	assert.Equal(t, "a@b.c", body["email"])
+	assert.Equal(t, redactedValue, body["password"])
+	assert.Equal(t, redactedValue, body["profile"].(map[string]interface{})["new_password"])
+	assert.Equal(t, "A", body["profile"].(map[string]interface{})["name"])
+}
+
+func TestAccessLog_TruncatedBodyIsNotLogged(t *testing.T) {
+	var out bytes.Buffer
+	accessLog := NewAccessLog(config.AccessLogConfig{SampleRate: 1, LogBodies: true, MaxBodyBytes: 16}, &out)
+	router := newAccessLogRouter(accessLog, func(c *gin.Context) {
+		io.ReadAll(c.Request.Body)
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", strings.NewReader(`{"email":"a@b.c","password":"hunter2"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.NotContains(t, out.String(), "hunter2")
+	lines := accessLogLines(t, &out)
+	require.Len(t, lines, 1)
+	assert.Equal(t, map[string]interface{}{"unlogged_bytes": float64(38)}, lines[0]["body"])
+}
+
+func TestAccessLog_Sampling(t *testing.T) {
+	var out bytes.Buffer
+	accessLog := NewAccessLog(config.AccessLogConfig{SampleRate: 0.1, SlowThreshold: 50 * time.Millisecond}, &out)
+	accessLog.sample = func() float64 { return 0.5 }
+
+	status := http.StatusOK
+	delay := time.Duration(0)
+	router := newAccessLogRouter(accessLog, func(c *gin.Context) {
+		time.Sleep(delay)
+		c.Status(status)
+	})
+	serve := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/auth/profile", nil))
+		return w
+	}
+
+	w := serve()
+	assert.Empty(t, out.String(), "ordinary requests outside the sample are dropped")
+	assert.NotEmpty(t, w.Header().Get(RequestIDHeader), "request ID is assigned even when not logged")
+
+	status = http.StatusBadGateway
+	serve()
+	require.Len(t, accessLogLines(t, &out), 1, "server errors are always logged")
+
+	status = http.StatusOK
+	delay = 60 * time.Millisecond
+	serve()
+	require.Len(t, accessLogLines(t, &out), 2, "slow requests are always logged")
+
+	delay = 0
+	accessLog.sample = func() float64 { return 0.05 }
+	serve()
+	assert.Len(t, accessLogLines(t, &out), 3)
+}
+
+func TestValidRequestID(t *testing.T) {
+	assert.True(t, validRequestID("3f1c9a2e-req"))
+	assert.False(t, validRequestID(""))
+	assert.False(t, validRequestID("id with spaces"))
+	assert.False(t, validRequestID("id\nforged"))
+	assert.False(t, validRequestID(strings.Repeat("a", maxRequestIDLength+1)))
+}
@@ -1,134 +0,0 @@
-package middleware
-
-import (
-	"fmt"
-	"net/http"
-	"strings"
-
-	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt/v5"
-	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
-)
-
-// AuthMiddleware validates JWT tokens
-func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		// Get Authorization header
-		authHeader := c.GetHeader("Authorization")
-		if authHeader == "" {
-			c.JSON(http.StatusUnauthorized, sharedresponse.Error("Authorization header required", nil))
-			c.Abort()
-			return
-		}
-
-		// Check Bearer prefix
-		parts := strings.Split(authHeader, " ")
-		if len(parts) != 2 || parts[0] != "Bearer" {
-			c.JSON(http.StatusUnauthorized, sharedresponse.Error("Invalid authorization header format (expected: Bearer <token>)", nil))
-			c.Abort()
-			return
-		}
-
-		tokenString := parts[1]
-
-		// Parse and validate token
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			// Validate signing method
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-			}
-			return []byte(jwtSecret), nil
-		})
-
-		if err != nil {
-			c.JSON(http.StatusUnauthorized, sharedresponse.Error("Invalid or expired token", err.Error()))
-			c.Abort()
-			return
-		}
-
-		if !token.Valid {
-			c.JSON(http.StatusUnauthorized, sharedresponse.Error("Token is not valid", nil))
-			c.Abort()
-			return
-		}
-
-		// Extract claims
-		if claims, ok := token.Claims.(jwt.MapClaims); ok {
-			// Set user information in context for downstream handlers
-			if userID, ok := claims["user_id"].(string); ok {
-				c.Set("user_id", userID)
-			}
-			if email, ok := claims["email"].(string); ok {
-				c.Set("email", email)
-			}
-			if role, ok := claims["role"].(string); ok {
-				c.Set("role", role)
-			}
-		}
-
-		c.Next()
-	}
-}
-
-// OptionalAuthMiddleware validates JWT if present, but doesn't require it
-func OptionalAuthMiddleware(jwtSecret string) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		authHeader := c.GetHeader("Authorization")
-		if authHeader == "" {
-			// No token provided, continue without auth
-			c.Next()
-			return
-		}
-
-		// Token provided, validate it
-		parts := strings.Split(authHeader, " ")
-		if len(parts) == 2 && parts[0] == "Bearer" {
-			tokenString := parts[1]
-			token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-				if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-					return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-				}
-				return []byte(jwtSecret), nil
-			})
-
-			if err == nil && token.Valid {
-				if claims, ok := token.Claims.(jwt.MapClaims); ok {
-					if userID, ok := claims["user_id"].(string); ok {
-						c.Set("user_id", userID)
-					}
-					if email, ok := claims["email"].(string); ok {
-						c.Set("email", email)
-					}
-					if role, ok := claims["role"].(string); ok {
-						c.Set("role", role)
-					}
-				}
-			}
-		}
-
-		c.Next()
-	}
-}
-
-// RoleMiddleware checks if user has required role
-func RoleMiddleware(requiredRoles ...string) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		role, exists := c.Get("role")
-		if !exists {
-			c.JSON(http.StatusForbidden, sharedresponse.Error("Access denied: role information not found", nil))
-			c.Abort()
-			return
-		}
-
-		userRole := role.(string)
-		for _, requiredRole := range requiredRoles {
-			if userRole == requiredRole {
-				c.Next()
-				return
-			}
-		}
-
-		c.JSON(http.StatusForbidden, sharedresponse.Error(fmt.Sprintf("Access denied: requires one of roles: %v", requiredRoles), nil))
-		c.Abort()
-	}
-}
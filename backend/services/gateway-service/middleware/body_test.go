@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newBodyGuardRouter(policy BodyPolicy, received *string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Any("/api/v1/orders", BodyGuard(policy), func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		*received = string(body)
+		c.Status(http.StatusOK)
+	})
+	return router
+}
+
+func serveBody(router *gin.Engine, method, contentType string, body io.Reader) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/api/v1/orders", body)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func errorCode(t *testing.T, w *httptest.ResponseRecorder) string {
+	var body sharedresponse.ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	return body.ErrorCode
+}
+
+func jsonPolicy() BodyPolicy {
+	return BodyPolicy{MaxBytes: 64, ContentTypes: JSONContentTypes, MaxJSONDepth: 3}
+}
+
+func TestBodyGuard_AllowsValidJSON(t *testing.T) {
+	var received string
+	router := newBodyGuardRouter(jsonPolicy(), &received)
+
+	w := serveBody(router, http.MethodPost, "application/json; charset=utf-8", strings.NewReader(`{"items":[{"qty":1}]}`))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `{"items":[{"qty":1}]}`, received, "body must still reach the handler")
+}
+
+func TestBodyGuard_SkipsEmptyBody(t *testing.T) {
+	var received string
+	router := newBodyGuardRouter(jsonPolicy(), &received)
+
+	assert.Equal(t, http.StatusOK, serveBody(router, http.MethodGet, "", nil).Code)
+	assert.Equal(t, http.StatusOK, serveBody(router, http.MethodPost, "", nil).Code)
+}
+
+func TestBodyGuard_RejectsOversizedBody(t *testing.T) {
+	var received string
+	router := newBodyGuardRouter(jsonPolicy(), &received)
+
+	body := `{"notes":"` + strings.Repeat("a", 100) + `"}`
+	w := serveBody(router, http.MethodPost, "application/json", strings.NewReader(body))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Equal(t, sharedresponse.CodePayloadTooLarge, errorCode(t, w))
+
+	// Chunked bodies have no Content-Length and are limited while reading
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/orders", io.MultiReader(strings.NewReader(body)))
+	req.ContentLength = -1
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Empty(t, received)
+}
+
+func TestBodyGuard_RejectsUnsupportedContentType(t *testing.T) {
+	var received string
+	router := newBodyGuardRouter(jsonPolicy(), &received)
+
+	for _, contentType := range []string{"", "text/plain", "application/x-www-form-urlencoded", "invalid;;"} {
+		w := serveBody(router, http.MethodPost, contentType, strings.NewReader(`{}`))
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code, contentType)
+		assert.Equal(t, sharedresponse.CodeUnsupportedMediaType, errorCode(t, w), contentType)
+	}
+}
+
+func TestBodyGuard_RejectsDeeplyNestedJSON(t *testing.T) {
+	var received string
+	router := newBodyGuardRouter(jsonPolicy(), &received)
+
+	assert.Equal(t, http.StatusOK, serveBody(router, http.MethodPost, "application/json", strings.NewReader(`{"a":{"b":[1]}}`)).Code)
+
+	w := serveBody(router, http.MethodPost, "application/json", strings.NewReader(`{"a":{"b":[[1]]}}`))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, sharedresponse.CodeInvalidRequest, errorCode(t, w))
+	assert.Contains(t, w.Body.String(), "nested too deeply")
+
+	w = serveBody(router, http.MethodPost, "application/json", strings.NewReader(`{"a":`))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Malformed JSON body")
+}
+
+func TestBodyGuard_UploadPolicy(t *testing.T) {
+	var received string
+	router := newBodyGuardRouter(BodyPolicy{MaxBytes: 1 << 10, ContentTypes: UploadContentTypes}, &received)
+
+	w := serveBody(router, http.MethodPost, "image/png", strings.NewReader(strings.Repeat("\x89", 512)))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = serveBody(router, http.MethodPost, "multipart/form-data; boundary=xyz", strings.NewReader("--xyz--"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = serveBody(router, http.MethodPost, "image/gif", strings.NewReader("GIF89a"))
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+
+	w = serveBody(router, http.MethodPost, "image/png", strings.NewReader(strings.Repeat("\x89", 2<<10)))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+}
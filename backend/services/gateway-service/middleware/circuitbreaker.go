@@ -0,0 +1,139 @@
+package middleware
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/config"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/pkg"
+)
+
+// Circuit states
+const (
+	CircuitClosed   = "closed"    // Requests pass, consecutive failures are counted
+	CircuitOpen     = "open"      // Requests get 503 right away until the open timeout has passed
+	CircuitHalfOpen = "half_open" // A few probe requests pass; a success closes the circuit, a failure opens it again
+)
+
+// halfOpenRetryAfter is suggested to requests rejected while the probes of a half-open circuit are in flight
+const halfOpenRetryAfter = time.Second
+
+// circuit is the breaker state of one upstream
+type circuit struct {
+	state    string
+	failures int       // Consecutive failures while closed
+	openedAt time.Time // When the circuit last opened
+	probes   int       // Requests in flight while half-open
+}
+
+// CircuitBreakers keeps a circuit breaker per upstream service URL
+// A service that is down then costs clients a fast 503 instead of a proxy timeout,
+// and is probed again after the open timeout so it recovers without a gateway restart
+type CircuitBreakers struct {
+	cfg config.CircuitBreakerConfig
+	now func() time.Time
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+// NewCircuitBreakers creates the circuit breakers of the gateway's upstreams
+func NewCircuitBreakers(cfg config.CircuitBreakerConfig) *CircuitBreakers {
+	return &CircuitBreakers{
+		cfg:      cfg,
+		now:      time.Now,
+		circuits: make(map[string]*circuit),
+	}
+}
+
+// Middleware returns the circuit breaker middleware
+// It makes the breakers available to the proxy and aggregation handlers, which know the upstream of the route
+func (b *CircuitBreakers) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(pkg.CircuitBreakerKey, b)
+		c.Next()
+	}
+}
+
+// Allow reports whether a request may be sent to upstream, and otherwise how long until it is tried again
+// An open circuit whose timeout has passed turns half-open and lets the probe requests through
+func (b *CircuitBreakers) Allow(upstream string) (bool, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	cb := b.circuit(upstream)
+	switch cb.state {
+	case CircuitOpen:
+		if wait := b.cfg.OpenTimeout - b.now().Sub(cb.openedAt); wait > 0 {
+			return false, wait
+		}
+		log.Printf("[CircuitBreaker] %s half-open, probing", upstream)
+		cb.state = CircuitHalfOpen
+		cb.probes = 0
+		fallthrough
+	case CircuitHalfOpen:
+		if cb.probes >= b.cfg.HalfOpenRequests {
+			return false, halfOpenRetryAfter
+		}
+		cb.probes++
+	}
+	return true, 0
+}
+
+// Done records the outcome of a request Allow let through
+func (b *CircuitBreakers) Done(upstream string, failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	cb := b.circuit(upstream)
+	switch cb.state {
+	case CircuitClosed:
+		if !failed {
+			cb.failures = 0
+			return
+		}
+		cb.failures++
+		if cb.failures >= b.cfg.FailureThreshold {
+			log.Printf("[CircuitBreaker] %s open after %d consecutive failures", upstream, cb.failures)
+			b.open(cb)
+		}
+	case CircuitHalfOpen:
+		cb.probes--
+		if failed {
+			log.Printf("[CircuitBreaker] %s still failing, open again", upstream)
+			b.open(cb)
+			return
+		}
+		log.Printf("[CircuitBreaker] %s recovered, closed", upstream)
+		cb.state = CircuitClosed
+		cb.failures = 0
+		cb.probes = 0
+	}
+	// Requests let through before the circuit opened finish while it is open; their outcome is ignored
+}
+
+// State returns the circuit state of upstream
+func (b *CircuitBreakers) State(upstream string) string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.circuit(upstream).state
+}
+
+func (b *CircuitBreakers) open(cb *circuit) {
+	cb.state = CircuitOpen
+	cb.openedAt = b.now()
+	cb.failures = 0
+	cb.probes = 0
+}
+
+// circuit returns the circuit of upstream, closed when it wasn't called before; b.mu must be held
+func (b *CircuitBreakers) circuit(upstream string) *circuit {
+	cb, ok := b.circuits[upstream]
+	if !ok {
+		cb = &circuit{state: CircuitClosed}
+		b.circuits[upstream] = cb
+	}
+	return cb
+}
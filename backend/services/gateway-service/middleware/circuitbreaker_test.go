@@ -0,0 +1,130 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/config"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestBreakers(now *time.Time) *CircuitBreakers {
+	b := NewCircuitBreakers(config.CircuitBreakerConfig{
+		Enabled:          true,
+		FailureThreshold: 3,
+		OpenTimeout:      30 * time.Second,
+		HalfOpenRequests: 1,
+	})
+	b.now = func() time.Time { return *now }
+	return b
+}
+
+func TestCircuitBreakers_OpensAfterConsecutiveFailures(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	b := newTestBreakers(&now)
+	const upstream = "http://ticketing:8083"
+
+	// A success in between resets the count
+	for _, failed := range []bool{true, true, false, true, true} {
+		ok, _ := b.Allow(upstream)
+		assert.True(t, ok)
+		b.Done(upstream, failed)
+	}
+	assert.Equal(t, CircuitClosed, b.State(upstream))
+
+	b.Allow(upstream)
+	b.Done(upstream, true)
+	assert.Equal(t, CircuitOpen, b.State(upstream))
+
+	now = now.Add(10 * time.Second)
+	ok, retryAfter := b.Allow(upstream)
+	assert.False(t, ok)
+	assert.Equal(t, 20*time.Second, retryAfter)
+
+	// Other upstreams are not affected
+	ok, _ = b.Allow("http://event:8082")
+	assert.True(t, ok)
+}
+
+func TestCircuitBreakers_HalfOpenProbe(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	b := newTestBreakers(&now)
+	const upstream = "http://ticketing:8083"
+
+	for i := 0; i < 3; i++ {
+		b.Allow(upstream)
+		b.Done(upstream, true)
+	}
+	assert.Equal(t, CircuitOpen, b.State(upstream))
+
+	// Once the timeout has passed one probe goes through, the rest wait for it
+	now = now.Add(30 * time.Second)
+	ok, _ := b.Allow(upstream)
+	assert.True(t, ok)
+	assert.Equal(t, CircuitHalfOpen, b.State(upstream))
+	ok, _ = b.Allow(upstream)
+	assert.False(t, ok)
+
+	// A failed probe opens the circuit for another timeout
+	b.Done(upstream, true)
+	assert.Equal(t, CircuitOpen, b.State(upstream))
+	ok, _ = b.Allow(upstream)
+	assert.False(t, ok)
+
+	now = now.Add(30 * time.Second)
+	ok, _ = b.Allow(upstream)
+	assert.True(t, ok)
+	b.Done(upstream, false)
+	assert.Equal(t, CircuitClosed, b.State(upstream))
+
+	ok, _ = b.Allow(upstream)
+	assert.True(t, ok)
+}
+
+func TestCircuitBreakers_ProxyFailsFastWhileOpen(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var calls atomic.Int32
+	status := atomic.Int32{}
+	status.Store(http.StatusBadGateway)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(int(status.Load()))
+	}))
+	defer upstream.Close()
+
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	b := newTestBreakers(&now)
+
+	router := gin.New()
+	router.Use(b.Middleware())
+	router.GET("/api/v1/orders", pkg.ProxyHandler(upstream.URL))
+
+	serve := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/orders", nil))
+		return w
+	}
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusBadGateway, serve().Code)
+	}
+
+	w := serve()
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "30", w.Header().Get("Retry-After"))
+	assert.Contains(t, w.Body.String(), "SERVICE_UNAVAILABLE")
+	assert.Equal(t, int32(3), calls.Load())
+
+	// Business 503s of a healthy upstream don't count as failures
+	status.Store(http.StatusServiceUnavailable)
+	now = now.Add(30 * time.Second)
+	assert.Equal(t, http.StatusServiceUnavailable, serve().Code)
+	assert.Equal(t, CircuitClosed, b.State(upstream.URL))
+	assert.Equal(t, int32(4), calls.Load())
+}
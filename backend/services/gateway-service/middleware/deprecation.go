@@ -0,0 +1,150 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/pkg"
+)
+
+// Deprecation response headers
+// Deprecation (RFC 9745) carries the date an endpoint was deprecated, Sunset (RFC 8594) the date it
+// will stop working, and Link points to its replacement with rel="successor-version"
+const (
+	DeprecationHeader = "Deprecation"
+	SunsetHeader      = "Sunset"
+	LinkHeader        = "Link"
+)
+
+// Deprecation describes an endpoint slated for removal
+type Deprecation struct {
+	Method       string
+	Path         string   // Route pattern below the API prefix, e.g. "/orders/:id"
+	Versions     []string // Deprecated API versions, empty for every version
+	DeprecatedAt time.Time
+	SunsetAt     time.Time // Zero when no removal date is set
+	Successor    string    // Replacing route below the API prefix, or an absolute URL
+	Note         string    // What to migrate to when there is no successor route
+}
+
+// deprecationView is a registry entry as served to API consumers
+type deprecationView struct {
+	Method       string     `json:"method"`
+	Path         string     `json:"path"`
+	Versions     []string   `json:"versions"`
+	DeprecatedAt time.Time  `json:"deprecated_at"`
+	SunsetAt     *time.Time `json:"sunset_at,omitempty"`
+	Successor    string     `json:"successor,omitempty"`
+	Note         string     `json:"note,omitempty"`
+}
+
+// Deprecations is the registry of deprecated gateway routes
+// Responses of registered routes are annotated with deprecation headers, and the
+// registry itself is served so API consumers can list upcoming removals
+type Deprecations struct {
+	entries []Deprecation
+	routes  map[string][]Deprecation // "METHOD path" -> entries
+}
+
+// NewDeprecations creates a registry of deprecated routes
+func NewDeprecations(entries []Deprecation) *Deprecations {
+	d := &Deprecations{
+		entries: entries,
+		routes:  make(map[string][]Deprecation, len(entries)),
+	}
+	for _, entry := range entries {
+		key := deprecationKey(entry.Method, entry.Path)
+		d.routes[key] = append(d.routes[key], entry)
+	}
+	return d
+}
+
+// Entries returns the registered deprecations
+func (d *Deprecations) Entries() []Deprecation {
+	return d.entries
+}
+
+// Lookup finds the deprecation of a route pattern (below the API prefix) in an API version
+func (d *Deprecations) Lookup(method, path, version string) (Deprecation, bool) {
+	for _, entry := range d.routes[deprecationKey(method, path)] {
+		if len(entry.Versions) == 0 || containsString(entry.Versions, version) {
+			return entry, true
+		}
+	}
+	return Deprecation{}, false
+}
+
+// Middleware annotates responses of deprecated routes
+// Must run after APIVersion, which records the version and prefix of the request
+func (d *Deprecations) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if route == "" {
+			c.Next()
+			return
+		}
+
+		prefix := c.GetString(pkg.APIPrefixKey)
+		entry, ok := d.Lookup(c.Request.Method, strings.TrimPrefix(route, prefix), c.GetString(pkg.APIVersionKey))
+		if !ok {
+			c.Next()
+			return
+		}
+
+		c.Header(DeprecationHeader, "@"+strconv.FormatInt(entry.DeprecatedAt.Unix(), 10))
+		if !entry.SunsetAt.IsZero() {
+			c.Header(SunsetHeader, entry.SunsetAt.UTC().Format(http.TimeFormat))
+		}
+		if entry.Successor != "" {
+			successor := entry.Successor
+			if strings.HasPrefix(successor, "/") {
+				successor = prefix + successor
+			}
+			c.Header(LinkHeader, "<"+successor+`>; rel="successor-version"`)
+		}
+
+		c.Next()
+	}
+}
+
+// Handler serves the registry, e.g. for consumers checking upcoming removals in CI
+func (d *Deprecations) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		views := make([]deprecationView, len(d.entries))
+		for i, entry := range d.entries {
+			views[i] = deprecationView{
+				Method:       entry.Method,
+				Path:         entry.Path,
+				Versions:     entry.Versions,
+				DeprecatedAt: entry.DeprecatedAt,
+				Successor:    entry.Successor,
+				Note:         entry.Note,
+			}
+			if len(entry.Versions) == 0 {
+				views[i].Versions = pkg.SupportedAPIVersions
+			}
+			if !entry.SunsetAt.IsZero() {
+				sunsetAt := entry.SunsetAt
+				views[i].SunsetAt = &sunsetAt
+			}
+		}
+		c.JSON(http.StatusOK, gin.H{"deprecations": views})
+	}
+}
+
+func deprecationKey(method, path string) string {
+	return strings.ToUpper(method) + " " + path
+}
+
+// containsString checks if values contains value
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
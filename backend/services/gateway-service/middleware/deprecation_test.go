@@ -0,0 +1,142 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/pkg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	deprecatedAt = time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)
+	sunsetAt     = time.Date(2027, 4, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func newDeprecationRouter(d *Deprecations) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/deprecations", d.Handler())
+	for _, api := range []struct {
+		prefix  string
+		version string
+	}{
+		{"/api/v1", pkg.APIVersionV1},
+		{"/api/v2", pkg.APIVersionV2},
+		{"/api", ""},
+	} {
+		group := router.Group(api.prefix, APIVersion(api.prefix, api.version), d.Middleware())
+		group.GET("/tickets/:id", func(c *gin.Context) { c.Status(http.StatusOK) })
+		group.GET("/orders/:id", func(c *gin.Context) { c.Status(http.StatusOK) })
+		group.POST("/orders/:id", func(c *gin.Context) { c.Status(http.StatusOK) })
+	}
+	return router
+}
+
+func TestDeprecations_AnnotatesDeprecatedRoutes(t *testing.T) {
+	router := newDeprecationRouter(NewDeprecations([]Deprecation{{
+		Method:       http.MethodGet,
+		Path:         "/tickets/:id",
+		DeprecatedAt: deprecatedAt,
+		SunsetAt:     sunsetAt,
+		Successor:    "/my-tickets",
+	}}))
+
+	for _, prefix := range []string{"/api/v1", "/api/v2", "/api"} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, prefix+"/tickets/ticket-1", nil))
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "@1792022400", w.Header().Get(DeprecationHeader), prefix)
+		assert.Equal(t, "Thu, 01 Apr 2027 00:00:00 GMT", w.Header().Get(SunsetHeader), prefix)
+		assert.Equal(t, "<"+prefix+`/my-tickets>; rel="successor-version"`, w.Header().Get(LinkHeader), "successor keeps the request's prefix")
+	}
+
+	// Other routes and methods are not annotated
+	for _, req := range []*http.Request{
+		httptest.NewRequest(http.MethodGet, "/api/v1/orders/order-1", nil),
+		httptest.NewRequest(http.MethodGet, "/api/v1/missing", nil),
+	} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Empty(t, w.Header().Get(DeprecationHeader), req.URL.Path)
+	}
+}
+
+func TestDeprecations_PerVersion(t *testing.T) {
+	router := newDeprecationRouter(NewDeprecations([]Deprecation{{
+		Method:       http.MethodGet,
+		Path:         "/orders/:id",
+		Versions:     []string{pkg.APIVersionV1},
+		DeprecatedAt: deprecatedAt,
+		Successor:    "https://docs.example.com/api/v2/orders",
+	}}))
+
+	tests := []struct {
+		name       string
+		path       string
+		accept     string
+		deprecated bool
+	}{
+		{name: "v1 path", path: "/api/v1/orders/order-1", deprecated: true},
+		{name: "unversioned path defaults to v1", path: "/api/orders/order-1", deprecated: true},
+		{name: "v2 path", path: "/api/v2/orders/order-1"},
+		{name: "v2 negotiated via Accept", path: "/api/orders/order-1", accept: pkg.VersionMediaTypePrefix + "v2+json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			if !tt.deprecated {
+				assert.Empty(t, w.Header().Get(DeprecationHeader))
+				return
+			}
+			assert.Equal(t, "@1792022400", w.Header().Get(DeprecationHeader))
+			assert.Empty(t, w.Header().Get(SunsetHeader), "no sunset date set")
+			assert.Equal(t, `<https://docs.example.com/api/v2/orders>; rel="successor-version"`, w.Header().Get(LinkHeader))
+		})
+	}
+}
+
+func TestDeprecations_Handler(t *testing.T) {
+	router := newDeprecationRouter(NewDeprecations([]Deprecation{
+		{Method: http.MethodGet, Path: "/tickets/:id", DeprecatedAt: deprecatedAt, SunsetAt: sunsetAt, Successor: "/my-tickets"},
+		{Method: http.MethodPost, Path: "/orders/:id", Versions: []string{pkg.APIVersionV1}, DeprecatedAt: deprecatedAt, Note: "Use gRPC"},
+	}))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/deprecations", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var body struct {
+		Deprecations []map[string]interface{} `json:"deprecations"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Len(t, body.Deprecations, 2)
+
+	assert.Equal(t, "/tickets/:id", body.Deprecations[0]["path"])
+	assert.Equal(t, []interface{}{"v1", "v2"}, body.Deprecations[0]["versions"], "every version when none are listed")
+	assert.Equal(t, "2027-04-01T00:00:00Z", body.Deprecations[0]["sunset_at"])
+	assert.Equal(t, "/my-tickets", body.Deprecations[0]["successor"])
+
+	assert.Equal(t, []interface{}{"v1"}, body.Deprecations[1]["versions"])
+	assert.NotContains(t, body.Deprecations[1], "sunset_at")
+	assert.Equal(t, "Use gRPC", body.Deprecations[1]["note"])
+
+	// An empty registry is an empty list, not null
+	w = httptest.NewRecorder()
+	newDeprecationRouter(NewDeprecations(nil)).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/deprecations", nil))
+	assert.JSONEq(t, `{"deprecations": []}`, w.Body.String())
+}
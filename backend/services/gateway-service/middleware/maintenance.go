@@ -0,0 +1,145 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/config"
+)
+
+// Maintenance modes
+const (
+	MaintenanceOff      = "off"
+	MaintenanceReadOnly = "read_only"
+)
+
+// MaintenanceHeader tells clients the platform is in maintenance, e.g. to show a banner
+const MaintenanceHeader = "X-Maintenance-Mode"
+
+// maintenanceState is the effective maintenance setting
+// Stored in Redis either as a bare mode ("read_only", "off") or as JSON:
+// {"mode":"read_only","message":"Upgrading database","retry_after":600}
+type maintenanceState struct {
+	Mode       string `json:"mode"`
+	Message    string `json:"message,omitempty"`
+	RetryAfter int    `json:"retry_after,omitempty"` // seconds
+}
+
+// Maintenance rejects mutations while the platform is in read-only mode
+// The Redis key overrides the configured mode, so operators can switch without redeploying
+type Maintenance struct {
+	cfg   config.MaintenanceConfig
+	redis cache.RedisClient
+
+	mu        sync.Mutex
+	state     maintenanceState
+	checkedAt time.Time
+}
+
+// NewMaintenance creates a new maintenance switch
+// redisClient may be nil, in which case only the configured mode applies
+func NewMaintenance(cfg config.MaintenanceConfig, redisClient cache.RedisClient) *Maintenance {
+	return &Maintenance{
+		cfg:   cfg,
+		redis: redisClient,
+		state: maintenanceState{Mode: cfg.Mode},
+	}
+}
+
+// Middleware returns the maintenance mode middleware
+// Safe methods (GET, HEAD, OPTIONS) pass through; others get 503 with Retry-After
+func (m *Maintenance) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		state := m.current(c.Request.Context())
+		if state.Mode != MaintenanceReadOnly {
+			c.Next()
+			return
+		}
+
+		c.Header(MaintenanceHeader, state.Mode)
+
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+			return
+		}
+
+		message := state.Message
+		if message == "" {
+			message = m.cfg.Message
+		}
+		retryAfter := state.RetryAfter
+		if retryAfter <= 0 {
+			retryAfter = int(m.cfg.RetryAfter.Seconds())
+		}
+
+		c.Header("Retry-After", strconv.Itoa(retryAfter))
+		c.JSON(http.StatusServiceUnavailable, sharedresponse.ErrorWithCode(message, sharedresponse.CodeMaintenanceMode, map[string]interface{}{
+			"mode":        state.Mode,
+			"retry_after": retryAfter,
+		}))
+		c.Abort()
+	}
+}
+
+// current returns the effective state, re-reading Redis at most once per refresh interval
+// Redis errors keep the last known state so a Redis outage doesn't flip the mode
+func (m *Maintenance) current(ctx context.Context) maintenanceState {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.redis == nil || time.Since(m.checkedAt) < m.cfg.RefreshInterval {
+		return m.state
+	}
+	m.checkedAt = time.Now()
+
+	raw, err := m.redis.Get(ctx, m.cfg.RedisKey)
+	if err != nil {
+		log.Printf("[Maintenance] Failed to read %s from Redis, keeping mode %q: %v", m.cfg.RedisKey, m.state.Mode, err)
+		return m.state
+	}
+
+	state, ok := parseMaintenanceState(raw)
+	if !ok {
+		// No valid override: fall back to the configured mode
+		if raw != "" {
+			log.Printf("[Maintenance] Ignoring invalid value for %s: %q", m.cfg.RedisKey, raw)
+		}
+		state = maintenanceState{Mode: m.cfg.Mode}
+	}
+
+	if state.Mode != m.state.Mode {
+		log.Printf("[Maintenance] Mode changed: %s -> %s", m.state.Mode, state.Mode)
+	}
+	m.state = state
+	return m.state
+}
+
+func parseMaintenanceState(raw string) (maintenanceState, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return maintenanceState{}, false
+	}
+
+	state := maintenanceState{Mode: raw}
+	if strings.HasPrefix(raw, "{") {
+		state = maintenanceState{}
+		if err := json.Unmarshal([]byte(raw), &state); err != nil {
+			return maintenanceState{}, false
+		}
+	}
+
+	if state.Mode != MaintenanceOff && state.Mode != MaintenanceReadOnly {
+		return maintenanceState{}, false
+	}
+	return state, true
+}
@@ -0,0 +1,144 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRedis serves Get for a single key
+type fakeRedis struct {
+	cache.RedisClient
+	mu    sync.Mutex
+	value string
+	err   error
+	gets  int
+}
+
+func (r *fakeRedis) Get(ctx context.Context, key string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.gets++
+	return r.value, r.err
+}
+
+func (r *fakeRedis) set(value string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.value, r.err = value, err
+}
+
+func maintenanceConfig(mode string) config.MaintenanceConfig {
+	return config.MaintenanceConfig{
+		Mode:       mode,
+		Message:    "Read-only maintenance",
+		RetryAfter: 5 * time.Minute,
+		RedisKey:   "gateway:maintenance",
+	}
+}
+
+func newMaintenanceRouter(m *Maintenance) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(m.Middleware())
+	router.Any("/api/v1/orders", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return router
+}
+
+func serveMethod(router *gin.Engine, method string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(method, "/api/v1/orders", nil))
+	return w
+}
+
+func TestMaintenance_Off(t *testing.T) {
+	router := newMaintenanceRouter(NewMaintenance(maintenanceConfig(MaintenanceOff), nil))
+
+	w := serveMethod(router, http.MethodPost)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get(MaintenanceHeader))
+}
+
+func TestMaintenance_ReadOnly(t *testing.T) {
+	router := newMaintenanceRouter(NewMaintenance(maintenanceConfig(MaintenanceReadOnly), nil))
+
+	for _, method := range []string{http.MethodGet, http.MethodHead, http.MethodOptions} {
+		w := serveMethod(router, method)
+		assert.Equal(t, http.StatusOK, w.Code, method)
+		assert.Equal(t, MaintenanceReadOnly, w.Header().Get(MaintenanceHeader), method)
+	}
+
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		w := serveMethod(router, method)
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code, method)
+		assert.Equal(t, "300", w.Header().Get("Retry-After"), method)
+
+		var body sharedresponse.ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, sharedresponse.CodeMaintenanceMode, body.ErrorCode)
+		assert.Equal(t, "Read-only maintenance", body.Message)
+	}
+}
+
+func TestMaintenance_RedisOverride(t *testing.T) {
+	redis := &fakeRedis{}
+	m := NewMaintenance(maintenanceConfig(MaintenanceOff), redis)
+	router := newMaintenanceRouter(m)
+
+	// No key: configured mode applies
+	assert.Equal(t, http.StatusOK, serveMethod(router, http.MethodPost).Code)
+
+	redis.set(`{"mode":"read_only","message":"Migrating orders","retry_after":120}`, nil)
+	m.checkedAt = time.Time{}
+	w := serveMethod(router, http.MethodPost)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "120", w.Header().Get("Retry-After"))
+	assert.Contains(t, w.Body.String(), "Migrating orders")
+
+	// Redis errors keep the last known mode
+	redis.set("", errors.New("connection refused"))
+	m.checkedAt = time.Time{}
+	assert.Equal(t, http.StatusServiceUnavailable, serveMethod(router, http.MethodPost).Code)
+
+	redis.set("off", nil)
+	m.checkedAt = time.Time{}
+	assert.Equal(t, http.StatusOK, serveMethod(router, http.MethodPost).Code)
+
+	// Invalid values fall back to the configured mode
+	redis.set("maybe", nil)
+	m.checkedAt = time.Time{}
+	assert.Equal(t, http.StatusOK, serveMethod(router, http.MethodPost).Code)
+}
+
+func TestMaintenance_RedisOverrideCanDisableConfiguredMode(t *testing.T) {
+	redis := &fakeRedis{value: "off"}
+	router := newMaintenanceRouter(NewMaintenance(maintenanceConfig(MaintenanceReadOnly), redis))
+
+	assert.Equal(t, http.StatusOK, serveMethod(router, http.MethodPost).Code)
+}
+
+func TestMaintenance_CachesRedisLookups(t *testing.T) {
+	redis := &fakeRedis{value: "read_only"}
+	cfg := maintenanceConfig(MaintenanceOff)
+	cfg.RefreshInterval = time.Minute
+	router := newMaintenanceRouter(NewMaintenance(cfg, redis))
+
+	for i := 0; i < 5; i++ {
+		serveMethod(router, http.MethodGet)
+	}
+	assert.Equal(t, 1, redis.gets)
+}
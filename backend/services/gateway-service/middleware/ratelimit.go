@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/config"
 )
 
 // RateLimiter implements token bucket rate limiting
@@ -14,6 +16,7 @@ type RateLimiter struct {
 	mu       sync.RWMutex
 	rate     int           // requests per minute
 	burst    int           // burst size
+	enabled  bool          // false lets every request through
 	cleanup  time.Duration // cleanup interval
 }
 
@@ -36,6 +39,7 @@ func NewRateLimiter(requestsPerMinute, burstSize int) *RateLimiter {
 		visitors: make(map[string]*visitor),
 		rate:     requestsPerMinute,
 		burst:    burstSize,
+		enabled:  true,
 		cleanup:  5 * time.Minute,
 	}
 
@@ -53,6 +57,11 @@ func (rl *RateLimiter) Middleware() gin.HandlerFunc {
 
 		// Get or create visitor
 		rl.mu.Lock()
+		if !rl.enabled {
+			rl.mu.Unlock()
+			c.Next()
+			return
+		}
 		v, exists := rl.visitors[ip]
 		if !exists {
 			v = &visitor{
@@ -65,9 +74,7 @@ func (rl *RateLimiter) Middleware() gin.HandlerFunc {
 
 		// Check if request is allowed
 		if !v.limiter.allow() {
-			c.JSON(http.StatusTooManyRequests, gin.H{
-				"error": "Rate limit exceeded. Please try again later.",
-			})
+			c.JSON(http.StatusTooManyRequests, sharedresponse.ErrorWithCode("Rate limit exceeded. Please try again later.", sharedresponse.CodeRateLimited, nil))
 			c.Abort()
 			return
 		}
@@ -76,6 +83,27 @@ func (rl *RateLimiter) Middleware() gin.HandlerFunc {
 	}
 }
 
+// Apply swaps the limits, e.g. on config reload
+// Buckets are reset when the limits change so every client gets the new burst right away
+func (rl *RateLimiter) Apply(cfg config.RateLimitConfig) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if cfg.RequestsPerMinute != rl.rate || cfg.BurstSize != rl.burst {
+		rl.visitors = make(map[string]*visitor)
+	}
+	rl.rate = cfg.RequestsPerMinute
+	rl.burst = cfg.BurstSize
+	rl.enabled = cfg.Enabled
+}
+
+// Config returns the limits in effect
+func (rl *RateLimiter) Config() config.RateLimitConfig {
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+	return config.RateLimitConfig{RequestsPerMinute: rl.rate, BurstSize: rl.burst, Enabled: rl.enabled}
+}
+
 // cleanupVisitors removes old visitors to prevent memory leak
 func (rl *RateLimiter) cleanupVisitors() {
 	ticker := time.NewTicker(rl.cleanup)
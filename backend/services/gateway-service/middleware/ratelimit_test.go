@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter_Apply(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := NewRateLimiter(60, 1)
+	router := gin.New()
+	router.Use(limiter.Middleware())
+	router.GET("/events", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	request := func() int {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/events", nil))
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusOK, request())
+	assert.Equal(t, http.StatusTooManyRequests, request(), "burst of 1 exhausted")
+
+	// New limits reset the buckets
+	limiter.Apply(config.RateLimitConfig{RequestsPerMinute: 60, BurstSize: 3, Enabled: true})
+	assert.Equal(t, config.RateLimitConfig{RequestsPerMinute: 60, BurstSize: 3, Enabled: true}, limiter.Config())
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusOK, request())
+	}
+	assert.Equal(t, http.StatusTooManyRequests, request())
+
+	// Disabled limiters let every request through
+	limiter.Apply(config.RateLimitConfig{RequestsPerMinute: 60, BurstSize: 3, Enabled: false})
+	assert.Equal(t, http.StatusOK, request())
+}
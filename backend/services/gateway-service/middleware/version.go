@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/pkg"
+)
+
+// APIVersion negotiates the API version for requests routed through prefix
+// A non-empty pathVersion pins the version (e.g. /api/v1); an empty one negotiates
+// from the Accept header and falls back to the default version
+func APIVersion(prefix, pathVersion string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requested := pkg.VersionFromAccept(c.GetHeader("Accept"))
+
+		version := pathVersion
+		if pathVersion == "" {
+			// Response shape depends on Accept for unversioned paths
+			c.Header("Vary", "Accept")
+			version = requested
+			if version == "" {
+				version = pkg.DefaultAPIVersion
+			}
+		} else if requested != "" && requested != pathVersion {
+			c.JSON(http.StatusNotAcceptable, sharedresponse.ErrorWithCode(
+				"Accept header requests API "+requested+" but path is "+pathVersion,
+				sharedresponse.CodeUnsupportedAPIVersion,
+				map[string]interface{}{"supported_versions": pkg.SupportedAPIVersions},
+			))
+			c.Abort()
+			return
+		}
+
+		if !pkg.IsSupportedAPIVersion(version) {
+			c.JSON(http.StatusNotAcceptable, sharedresponse.ErrorWithCode(
+				"Unsupported API version: "+version,
+				sharedresponse.CodeUnsupportedAPIVersion,
+				map[string]interface{}{"supported_versions": pkg.SupportedAPIVersions},
+			))
+			c.Abort()
+			return
+		}
+
+		c.Set(pkg.APIVersionKey, version)
+		c.Set(pkg.APIPrefixKey, prefix)
+		c.Header("API-Version", version)
+
+		c.Next()
+	}
+}
@@ -0,0 +1,128 @@
+package pkg
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+)
+
+// aggregateUpstreamTimeout bounds each fan-out call so one slow service can't stall an aggregated page
+const aggregateUpstreamTimeout = 5 * time.Second
+
+// UpstreamFailure describes why part of an aggregated response could not be loaded
+type UpstreamFailure struct {
+	Service   string `json:"service"`
+	Status    int    `json:"status"`
+	ErrorCode string `json:"error_code"`
+	Message   string `json:"message"`
+}
+
+// upstreamResult is the outcome of a single upstream call
+type upstreamResult struct {
+	data    json.RawMessage
+	failure *UpstreamFailure
+	body    []byte // raw upstream error body, forwarded when a required section fails
+}
+
+// newAggregateClient creates the HTTP client used by aggregation handlers
+func newAggregateClient() *http.Client {
+	return &http.Client{
+		Timeout: aggregateUpstreamTimeout,
+		Transport: &http.Transport{
+			MaxIdleConns:        100,
+			MaxIdleConnsPerHost: 10,
+			IdleConnTimeout:     90 * time.Second,
+		},
+	}
+}
+
+// fetchUpstreamData calls one upstream endpoint and unwraps the "data" field of its envelope
+func fetchUpstreamData(c *gin.Context, client *http.Client, service, baseURL, path string) upstreamResult {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), aggregateUpstreamTimeout)
+	defer cancel()
+
+	req, err := newUpstreamRequest(ctx, c, http.MethodGet, baseURL, baseURL+path, nil)
+	if err != nil {
+		return upstreamFailed(service, http.StatusInternalServerError, sharedresponse.CodeInternal, "Failed to create upstream request")
+	}
+	// Let the transport negotiate compression so the body can be decoded here
+	req.Header.Del("Accept-Encoding")
+
+	if ok, _ := allowUpstream(c, baseURL); !ok {
+		return upstreamFailed(service, http.StatusServiceUnavailable, sharedresponse.CodeServiceUnavailable, "Service unavailable")
+	}
+
+	resp, err := client.Do(req)
+	recordUpstream(c, baseURL, resp, err)
+	if err != nil {
+		log.Printf("[Aggregate] %s request failed: %v", service, err)
+		if errors.Is(err, context.DeadlineExceeded) {
+			return upstreamFailed(service, http.StatusGatewayTimeout, sharedresponse.CodeUpstreamTimeout, "Service timed out")
+		}
+		return upstreamFailed(service, http.StatusBadGateway, sharedresponse.CodeServiceUnavailable, "Service unavailable")
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return upstreamFailed(service, http.StatusBadGateway, sharedresponse.CodeServiceUnavailable, "Failed to read service response")
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var upstreamErr sharedresponse.ErrorResponse
+		_ = json.Unmarshal(body, &upstreamErr)
+		if upstreamErr.ErrorCode == "" {
+			upstreamErr.ErrorCode = sharedresponse.CodeForStatus(resp.StatusCode)
+		}
+		if upstreamErr.Message == "" {
+			upstreamErr.Message = http.StatusText(resp.StatusCode)
+		}
+		result := upstreamFailed(service, resp.StatusCode, upstreamErr.ErrorCode, upstreamErr.Message)
+		result.body = body
+		return result
+	}
+
+	var envelope struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return upstreamFailed(service, http.StatusBadGateway, sharedresponse.CodeServiceUnavailable, "Unexpected service response")
+	}
+	return upstreamResult{data: envelope.Data}
+}
+
+func upstreamFailed(service string, status int, errorCode, message string) upstreamResult {
+	return upstreamResult{failure: &UpstreamFailure{
+		Service:   service,
+		Status:    status,
+		ErrorCode: errorCode,
+		Message:   message,
+	}}
+}
+
+// abortWithUpstreamFailure responds with a failed required section
+// Client errors are forwarded as the service sent them; server errors become 502, timeouts 504
+func abortWithUpstreamFailure(c *gin.Context, message, section string, result upstreamResult) {
+	failure := result.failure
+	if len(result.body) > 0 && failure.Status < http.StatusInternalServerError {
+		c.Data(failure.Status, "application/json; charset=utf-8", result.body)
+		return
+	}
+
+	status := http.StatusBadGateway
+	if failure.Status < http.StatusInternalServerError || failure.Status == http.StatusGatewayTimeout {
+		status = failure.Status
+	}
+	c.JSON(status, sharedresponse.ErrorWithCode(
+		message,
+		failure.ErrorCode,
+		map[string]interface{}{"failures": map[string]UpstreamFailure{section: *failure}},
+	))
+}
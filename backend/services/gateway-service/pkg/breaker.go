@@ -0,0 +1,51 @@
+package pkg
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CircuitBreakerKey is the gin context key of the Breaker installed by the circuit breaker middleware
+const CircuitBreakerKey = "circuit_breaker"
+
+// Breaker fails requests to an upstream fast while it keeps failing (implemented by middleware.CircuitBreakers)
+type Breaker interface {
+	// Allow reports whether a request may be sent to upstream, and otherwise how long until it is tried again
+	Allow(upstream string) (bool, time.Duration)
+	// Done records the outcome of a request Allow let through
+	Done(upstream string, failed bool)
+}
+
+// allowUpstream asks the request's breaker, if any, whether upstream may be called
+func allowUpstream(c *gin.Context, upstream string) (bool, time.Duration) {
+	value, _ := c.Get(CircuitBreakerKey)
+	breaker, ok := value.(Breaker)
+	if !ok {
+		return true, 0
+	}
+	return breaker.Allow(upstream)
+}
+
+// recordUpstream reports the outcome of an upstream call to the request's breaker, if any
+// Only an unreachable or unresponsive upstream is a failure: connection errors, timeouts,
+// 502 and 504. Other errors, including 503, are answers of a healthy service
+// (e.g. a feature that is switched off), and a client hanging up is not the upstream's fault
+func recordUpstream(c *gin.Context, upstream string, resp *http.Response, err error) {
+	value, _ := c.Get(CircuitBreakerKey)
+	breaker, ok := value.(Breaker)
+	if !ok {
+		return
+	}
+
+	failed := false
+	if err != nil {
+		failed = !errors.Is(err, context.Canceled)
+	} else {
+		failed = resp.StatusCode == http.StatusBadGateway || resp.StatusCode == http.StatusGatewayTimeout
+	}
+	breaker.Done(upstream, failed)
+}
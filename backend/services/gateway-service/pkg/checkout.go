@@ -0,0 +1,154 @@
+package pkg
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/config"
+)
+
+// checkoutOrderScan is how many recent orders are searched for an open reservation
+// Reservations expire within minutes, so they are always among the newest orders
+const checkoutOrderScan = 20
+
+// Checkout page sections
+const (
+	CheckoutSectionEvent         = "event"
+	CheckoutSectionTicketTiers   = "ticket_tiers"
+	CheckoutSectionProfile       = "profile"
+	CheckoutSectionReservedOrder = "reserved_order"
+)
+
+// CheckoutResponse is the merged payload for the checkout page
+// Sections that failed are null and listed in Failures; only the event is required
+type CheckoutResponse struct {
+	Event         json.RawMessage            `json:"event"`
+	TicketTiers   json.RawMessage            `json:"ticket_tiers"`
+	Profile       json.RawMessage            `json:"profile"`
+	ReservedOrder json.RawMessage            `json:"reserved_order"`
+	Partial       bool                       `json:"partial"`
+	Failures      map[string]UpstreamFailure `json:"failures,omitempty"`
+}
+
+// CheckoutHandler serves GET /checkout/:eventId by fanning out to the auth, event
+// and ticketing services concurrently and merging their responses
+func CheckoutHandler(services config.ServiceURLs) gin.HandlerFunc {
+	client := newAggregateClient()
+
+	return func(c *gin.Context) {
+		eventID := url.PathEscape(c.Param("eventId"))
+
+		calls := map[string]struct {
+			service string
+			baseURL string
+			path    string
+		}{
+			CheckoutSectionEvent:         {"event-service", services.EventService, "/api/v1/events/" + eventID},
+			CheckoutSectionTicketTiers:   {"event-service", services.EventService, "/api/v1/events/" + eventID + "/ticket-tiers"},
+			CheckoutSectionProfile:       {"auth-service", services.AuthService, "/api/v1/auth/profile"},
+			CheckoutSectionReservedOrder: {"ticketing-service", services.TicketingService, fmt.Sprintf("/api/v1/orders?page=1&limit=%d", checkoutOrderScan)},
+		}
+
+		var (
+			mu      sync.Mutex
+			wg      sync.WaitGroup
+			results = make(map[string]upstreamResult, len(calls))
+		)
+		for section, call := range calls {
+			wg.Add(1)
+			go func(section, service, baseURL, path string) {
+				defer wg.Done()
+				defer errreport.Recover("checkout " + section)
+				result := fetchUpstreamData(c, client, service, baseURL, path)
+				mu.Lock()
+				results[section] = result
+				mu.Unlock()
+			}(section, call.service, call.baseURL, call.path)
+		}
+		wg.Wait()
+
+		// Without the event there is nothing to check out
+		event := results[CheckoutSectionEvent]
+		if event.failure != nil {
+			abortWithUpstreamFailure(c, "Failed to load event for checkout", CheckoutSectionEvent, event)
+			return
+		}
+
+		payload := CheckoutResponse{Event: event.data}
+		sections := map[string]*json.RawMessage{
+			CheckoutSectionTicketTiers:   &payload.TicketTiers,
+			CheckoutSectionProfile:       &payload.Profile,
+			CheckoutSectionReservedOrder: &payload.ReservedOrder,
+		}
+		for section, target := range sections {
+			result := results[section]
+			if section == CheckoutSectionReservedOrder && result.failure == nil {
+				order, err := findReservedOrder(result.data, c.Param("eventId"), time.Now())
+				if err != nil {
+					result.failure = &UpstreamFailure{
+						Service:   "ticketing-service",
+						Status:    http.StatusBadGateway,
+						ErrorCode: sharedresponse.CodeServiceUnavailable,
+						Message:   "Unexpected orders response",
+					}
+				}
+				result.data = order
+			}
+
+			if result.failure != nil {
+				if payload.Failures == nil {
+					payload.Failures = make(map[string]UpstreamFailure)
+				}
+				payload.Failures[section] = *result.failure
+				continue
+			}
+			*target = result.data
+		}
+		payload.Partial = len(payload.Failures) > 0
+
+		message := "Checkout data retrieved successfully"
+		if payload.Partial {
+			message = "Checkout data partially retrieved"
+		}
+		c.JSON(http.StatusOK, sharedresponse.Success(message, payload))
+	}
+}
+
+// findReservedOrder returns the user's open reservation for the event, or nil when there is none
+func findReservedOrder(orders json.RawMessage, eventID string, now time.Time) (json.RawMessage, error) {
+	if len(orders) == 0 || string(orders) == "null" {
+		return nil, nil
+	}
+
+	var list []json.RawMessage
+	if err := json.Unmarshal(orders, &list); err != nil {
+		return nil, err
+	}
+
+	for _, raw := range list {
+		var order struct {
+			EventID              string     `json:"event_id"`
+			Status               string     `json:"status"`
+			ReservationExpiresAt *time.Time `json:"reservation_expires_at"`
+		}
+		if err := json.Unmarshal(raw, &order); err != nil {
+			return nil, err
+		}
+		if order.EventID != eventID || order.Status != "reserved" {
+			continue
+		}
+		// The expiry job may lag behind; an elapsed reservation can't be paid anymore
+		if order.ReservationExpiresAt != nil && !order.ReservationExpiresAt.After(now) {
+			continue
+		}
+		return raw, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,165 @@
+package pkg
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// checkoutUpstream serves canned responses keyed by request path
+func checkoutUpstream(t *testing.T, responses map[string]func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "user-1", r.Header.Get("X-User-ID"))
+		handler, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func jsonReply(status int, body string) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+func assertNullSection(t *testing.T, section json.RawMessage) {
+	t.Helper()
+	if len(section) > 0 {
+		assert.Equal(t, "null", string(section))
+	}
+}
+
+func serveCheckout(t *testing.T, services config.ServiceURLs) (*httptest.ResponseRecorder, CheckoutResponse) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/api/v1/checkout/:eventId", func(c *gin.Context) {
+		c.Set("user_id", "user-1")
+	}, CheckoutHandler(services))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/checkout/event-1", nil))
+
+	var body struct {
+		Data CheckoutResponse `json:"data"`
+	}
+	if w.Code == http.StatusOK {
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	}
+	return w, body.Data
+}
+
+func TestCheckoutHandler_MergesSections(t *testing.T) {
+	expires := time.Now().Add(10 * time.Minute).UTC().Format(time.RFC3339)
+	expired := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+
+	event := checkoutUpstream(t, map[string]func(http.ResponseWriter, *http.Request){
+		"/api/v1/events/event-1":              jsonReply(http.StatusOK, `{"status":true,"data":{"id":"event-1","title":"Concert"}}`),
+		"/api/v1/events/event-1/ticket-tiers": jsonReply(http.StatusOK, `{"data":[{"id":"tier-1","available":5}]}`),
+	})
+	auth := checkoutUpstream(t, map[string]func(http.ResponseWriter, *http.Request){
+		"/api/v1/auth/profile": jsonReply(http.StatusOK, `{"status":true,"data":{"id":"user-1","email":"a@example.com"}}`),
+	})
+	ticketing := checkoutUpstream(t, map[string]func(http.ResponseWriter, *http.Request){
+		"/api/v1/orders": jsonReply(http.StatusOK, `{"status":true,"data":[
+			{"id":"order-other","event_id":"event-2","status":"reserved","reservation_expires_at":"`+expires+`"},
+			{"id":"order-stale","event_id":"event-1","status":"reserved","reservation_expires_at":"`+expired+`"},
+			{"id":"order-paid","event_id":"event-1","status":"paid"},
+			{"id":"order-open","event_id":"event-1","status":"reserved","reservation_expires_at":"`+expires+`"}
+		]}`),
+	})
+
+	w, data := serveCheckout(t, config.ServiceURLs{
+		AuthService:      auth.URL,
+		EventService:     event.URL,
+		TicketingService: ticketing.URL,
+	})
+	require.Equal(t, http.StatusOK, w.Code)
+
+	assert.False(t, data.Partial)
+	assert.Empty(t, data.Failures)
+	assert.JSONEq(t, `{"id":"event-1","title":"Concert"}`, string(data.Event))
+	assert.JSONEq(t, `[{"id":"tier-1","available":5}]`, string(data.TicketTiers))
+	assert.JSONEq(t, `{"id":"user-1","email":"a@example.com"}`, string(data.Profile))
+	assert.Contains(t, string(data.ReservedOrder), `"order-open"`)
+}
+
+func TestCheckoutHandler_NoReservedOrder(t *testing.T) {
+	event := checkoutUpstream(t, map[string]func(http.ResponseWriter, *http.Request){
+		"/api/v1/events/event-1":              jsonReply(http.StatusOK, `{"data":{"id":"event-1"}}`),
+		"/api/v1/events/event-1/ticket-tiers": jsonReply(http.StatusOK, `{"data":[]}`),
+	})
+	other := checkoutUpstream(t, map[string]func(http.ResponseWriter, *http.Request){
+		"/api/v1/auth/profile": jsonReply(http.StatusOK, `{"data":{"id":"user-1"}}`),
+		"/api/v1/orders":       jsonReply(http.StatusOK, `{"data":[]}`),
+	})
+
+	w, data := serveCheckout(t, config.ServiceURLs{AuthService: other.URL, EventService: event.URL, TicketingService: other.URL})
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.False(t, data.Partial)
+	assertNullSection(t, data.ReservedOrder)
+}
+
+func TestCheckoutHandler_PartialFailure(t *testing.T) {
+	event := checkoutUpstream(t, map[string]func(http.ResponseWriter, *http.Request){
+		"/api/v1/events/event-1":              jsonReply(http.StatusOK, `{"data":{"id":"event-1"}}`),
+		"/api/v1/events/event-1/ticket-tiers": jsonReply(http.StatusOK, `{"data":[]}`),
+	})
+	ticketing := checkoutUpstream(t, map[string]func(http.ResponseWriter, *http.Request){
+		"/api/v1/orders": jsonReply(http.StatusInternalServerError, `{"status":false,"message":"Internal server error","error_code":"INTERNAL_ERROR"}`),
+	})
+	// Auth service is unreachable
+	auth := httptest.NewServer(http.NotFoundHandler())
+	auth.Close()
+
+	w, data := serveCheckout(t, config.ServiceURLs{AuthService: auth.URL, EventService: event.URL, TicketingService: ticketing.URL})
+	require.Equal(t, http.StatusOK, w.Code)
+
+	assert.True(t, data.Partial)
+	assert.JSONEq(t, `{"id":"event-1"}`, string(data.Event))
+	assertNullSection(t, data.Profile)
+	assertNullSection(t, data.ReservedOrder)
+
+	require.Contains(t, data.Failures, CheckoutSectionProfile)
+	assert.Equal(t, sharedresponse.CodeServiceUnavailable, data.Failures[CheckoutSectionProfile].ErrorCode)
+	require.Contains(t, data.Failures, CheckoutSectionReservedOrder)
+	assert.Equal(t, http.StatusInternalServerError, data.Failures[CheckoutSectionReservedOrder].Status)
+	assert.Equal(t, sharedresponse.CodeInternal, data.Failures[CheckoutSectionReservedOrder].ErrorCode)
+	assert.NotContains(t, data.Failures, CheckoutSectionTicketTiers)
+}
+
+func TestCheckoutHandler_EventRequired(t *testing.T) {
+	other := checkoutUpstream(t, map[string]func(http.ResponseWriter, *http.Request){
+		"/api/v1/auth/profile": jsonReply(http.StatusOK, `{"data":{"id":"user-1"}}`),
+		"/api/v1/orders":       jsonReply(http.StatusOK, `{"data":[]}`),
+	})
+
+	// Not found is passed through from the event service
+	event := checkoutUpstream(t, map[string]func(http.ResponseWriter, *http.Request){
+		"/api/v1/events/event-1": jsonReply(http.StatusNotFound, `{"status":false,"message":"Event not found","error_code":"EVENT_NOT_FOUND"}`),
+	})
+	w, _ := serveCheckout(t, config.ServiceURLs{AuthService: other.URL, EventService: event.URL, TicketingService: other.URL})
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Body.String(), sharedresponse.CodeEventNotFound)
+
+	// Event service outage fails the whole page
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+	w, _ = serveCheckout(t, config.ServiceURLs{AuthService: other.URL, EventService: down.URL, TicketingService: other.URL})
+	assert.Equal(t, http.StatusBadGateway, w.Code)
+	assert.Contains(t, w.Body.String(), sharedresponse.CodeServiceUnavailable)
+}
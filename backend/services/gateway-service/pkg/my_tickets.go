@@ -0,0 +1,272 @@
+package pkg
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/config"
+)
+
+// myTicketsEventConcurrency caps concurrent event-service lookups per request
+const myTicketsEventConcurrency = 8
+
+// Ticket badges shown on the my-tickets page
+const (
+	TicketBadgeUpcoming     = "upcoming"      // Valid ticket, event not started yet
+	TicketBadgeHappeningNow = "happening_now" // Valid ticket, event in progress
+	TicketBadgeValid        = "valid"         // Valid ticket, event details unavailable
+	TicketBadgeUsed         = "used"
+	TicketBadgeCancelled    = "cancelled"
+	TicketBadgeExpired      = "expired"
+)
+
+// MyTicketsResponse groups the user's tickets by event, split into upcoming and past events
+// Events that could not be loaded keep their tickets under upcoming with a null event
+type MyTicketsResponse struct {
+	Upcoming     []TicketEventGroup         `json:"upcoming"`
+	Past         []TicketEventGroup         `json:"past"`
+	TotalTickets int                        `json:"total_tickets"`
+	Partial      bool                       `json:"partial"`
+	Failures     map[string]UpstreamFailure `json:"failures,omitempty"` // Keyed by event ID
+}
+
+// TicketEventGroup holds the tickets a user has for one event
+type TicketEventGroup struct {
+	EventID string              `json:"event_id"`
+	Event   *TicketEventSummary `json:"event"`
+	Tickets []MyTicket          `json:"tickets"`
+}
+
+// TicketEventSummary is the event information shown with a ticket group
+type TicketEventSummary struct {
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+	Slug      string    `json:"slug"`
+	Location  string    `json:"location"`
+	Venue     *string   `json:"venue,omitempty"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	Timezone  string    `json:"timezone"`
+	BannerURL *string   `json:"banner_url,omitempty"`
+	Status    string    `json:"status"`
+}
+
+// MyTicket is a ticket with its tier name and display badge
+type MyTicket struct {
+	ID           string     `json:"id"`
+	OrderID      string     `json:"order_id"`
+	TicketTierID string     `json:"ticket_tier_id"`
+	TierName     string     `json:"tier_name,omitempty"`
+	TicketNumber string     `json:"ticket_number"`
+	QRCode       string     `json:"qr_code"`
+	Status       string     `json:"status"`
+	Badge        string     `json:"badge"`
+	UsedAt       *time.Time `json:"used_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// upstreamTicket mirrors the ticketing-service v1 ticket response
+type upstreamTicket struct {
+	ID           string     `json:"id"`
+	OrderID      string     `json:"order_id"`
+	TicketTierID string     `json:"ticket_tier_id"`
+	EventID      string     `json:"event_id"`
+	TicketNumber string     `json:"ticket_number"`
+	QRCode       string     `json:"qr_code"`
+	Status       string     `json:"status"`
+	UsedAt       *time.Time `json:"used_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// eventDetails is what the my-tickets page needs from event-service for one event
+type eventDetails struct {
+	event     *TicketEventSummary
+	tierNames map[string]string
+	failure   *UpstreamFailure
+}
+
+// MyTicketsHandler serves GET /my-tickets: the user's tickets from ticketing-service
+// grouped by event, with event details fetched concurrently from event-service
+func MyTicketsHandler(services config.ServiceURLs) gin.HandlerFunc {
+	client := newAggregateClient()
+
+	return func(c *gin.Context) {
+		result := fetchUpstreamData(c, client, "ticketing-service", services.TicketingService, "/api/v1/tickets")
+		if result.failure != nil {
+			abortWithUpstreamFailure(c, "Failed to load tickets", "tickets", result)
+			return
+		}
+
+		var tickets []upstreamTicket
+		if len(result.data) > 0 {
+			if err := json.Unmarshal(result.data, &tickets); err != nil {
+				c.JSON(http.StatusBadGateway, sharedresponse.ErrorWithCode("Unexpected tickets response", sharedresponse.CodeServiceUnavailable, nil))
+				return
+			}
+		}
+
+		// Group in order of first appearance; ticketing-service returns newest first
+		var eventIDs []string
+		ticketsByEvent := make(map[string][]upstreamTicket)
+		for _, ticket := range tickets {
+			if _, seen := ticketsByEvent[ticket.EventID]; !seen {
+				eventIDs = append(eventIDs, ticket.EventID)
+			}
+			ticketsByEvent[ticket.EventID] = append(ticketsByEvent[ticket.EventID], ticket)
+		}
+
+		details := fetchEventDetails(c, client, services.EventService, eventIDs)
+		payload := buildMyTickets(eventIDs, ticketsByEvent, details, time.Now())
+
+		message := "Tickets retrieved successfully"
+		if payload.Partial {
+			message = "Tickets retrieved with missing event details"
+		}
+		c.JSON(http.StatusOK, sharedresponse.Success(message, payload))
+	}
+}
+
+// fetchEventDetails loads each event and its tiers from event-service with bounded concurrency
+func fetchEventDetails(c *gin.Context, client *http.Client, eventServiceURL string, eventIDs []string) map[string]eventDetails {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, myTicketsEventConcurrency)
+		details = make(map[string]eventDetails, len(eventIDs))
+	)
+
+	for _, eventID := range eventIDs {
+		wg.Add(1)
+		go func(eventID string) {
+			defer wg.Done()
+			defer errreport.Recover("my_tickets event details")
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			path := "/api/v1/events/" + url.PathEscape(eventID)
+			detail := eventDetails{}
+
+			eventResult := fetchUpstreamData(c, client, "event-service", eventServiceURL, path)
+			if eventResult.failure != nil {
+				detail.failure = eventResult.failure
+			} else if err := json.Unmarshal(eventResult.data, &detail.event); err != nil {
+				detail.event = nil
+				detail.failure = upstreamFailed("event-service", http.StatusBadGateway, sharedresponse.CodeServiceUnavailable, "Unexpected event response").failure
+			}
+
+			// Tier names are cosmetic; tickets are still shown without them
+			if detail.failure == nil {
+				tiersResult := fetchUpstreamData(c, client, "event-service", eventServiceURL, path+"/ticket-tiers")
+				var tiers []struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+				}
+				if tiersResult.failure == nil && json.Unmarshal(tiersResult.data, &tiers) == nil {
+					detail.tierNames = make(map[string]string, len(tiers))
+					for _, tier := range tiers {
+						detail.tierNames[tier.ID] = tier.Name
+					}
+				}
+			}
+
+			mu.Lock()
+			details[eventID] = detail
+			mu.Unlock()
+		}(eventID)
+	}
+	wg.Wait()
+
+	return details
+}
+
+// buildMyTickets assembles the grouped response
+// Upcoming events are sorted soonest first, past events most recent first
+func buildMyTickets(eventIDs []string, ticketsByEvent map[string][]upstreamTicket, details map[string]eventDetails, now time.Time) MyTicketsResponse {
+	payload := MyTicketsResponse{
+		Upcoming: []TicketEventGroup{},
+		Past:     []TicketEventGroup{},
+	}
+
+	for _, eventID := range eventIDs {
+		detail := details[eventID]
+		if detail.failure != nil {
+			if payload.Failures == nil {
+				payload.Failures = make(map[string]UpstreamFailure)
+			}
+			payload.Failures[eventID] = *detail.failure
+		}
+
+		group := TicketEventGroup{
+			EventID: eventID,
+			Event:   detail.event,
+			Tickets: make([]MyTicket, 0, len(ticketsByEvent[eventID])),
+		}
+		for _, ticket := range ticketsByEvent[eventID] {
+			group.Tickets = append(group.Tickets, MyTicket{
+				ID:           ticket.ID,
+				OrderID:      ticket.OrderID,
+				TicketTierID: ticket.TicketTierID,
+				TierName:     detail.tierNames[ticket.TicketTierID],
+				TicketNumber: ticket.TicketNumber,
+				QRCode:       ticket.QRCode,
+				Status:       ticket.Status,
+				Badge:        ticketBadge(ticket.Status, detail.event, now),
+				UsedAt:       ticket.UsedAt,
+				CreatedAt:    ticket.CreatedAt,
+			})
+		}
+		payload.TotalTickets += len(group.Tickets)
+
+		if detail.event != nil && !detail.event.EndDate.After(now) {
+			payload.Past = append(payload.Past, group)
+		} else {
+			payload.Upcoming = append(payload.Upcoming, group)
+		}
+	}
+
+	// Groups without event details go last
+	sort.SliceStable(payload.Upcoming, func(i, j int) bool {
+		a, b := payload.Upcoming[i].Event, payload.Upcoming[j].Event
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		return a.StartDate.Before(b.StartDate)
+	})
+	sort.SliceStable(payload.Past, func(i, j int) bool {
+		return payload.Past[i].Event.StartDate.After(payload.Past[j].Event.StartDate)
+	})
+
+	payload.Partial = len(payload.Failures) > 0
+	return payload
+}
+
+// ticketBadge derives the display badge from the ticket status and event schedule
+func ticketBadge(status string, event *TicketEventSummary, now time.Time) string {
+	switch status {
+	case "used":
+		return TicketBadgeUsed
+	case "cancelled":
+		return TicketBadgeCancelled
+	case "expired":
+		return TicketBadgeExpired
+	}
+
+	switch {
+	case event == nil:
+		return TicketBadgeValid
+	case !event.EndDate.After(now):
+		// Valid but never scanned before the event ended
+		return TicketBadgeExpired
+	case !event.StartDate.After(now):
+		return TicketBadgeHappeningNow
+	default:
+		return TicketBadgeUpcoming
+	}
+}
@@ -0,0 +1,146 @@
+package pkg
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serveMyTickets(t *testing.T, services config.ServiceURLs) (*httptest.ResponseRecorder, MyTicketsResponse) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/api/v1/my-tickets", func(c *gin.Context) {
+		c.Set("user_id", "user-1")
+	}, MyTicketsHandler(services))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/my-tickets", nil))
+
+	var body struct {
+		Data MyTicketsResponse `json:"data"`
+	}
+	if w.Code == http.StatusOK {
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	}
+	return w, body.Data
+}
+
+func eventJSON(id, title string, start, end time.Time) string {
+	return `{"data":{"id":"` + id + `","title":"` + title + `","location":"Jakarta","start_date":"` +
+		start.UTC().Format(time.RFC3339) + `","end_date":"` + end.UTC().Format(time.RFC3339) + `","status":"published"}}`
+}
+
+func TestMyTicketsHandler_GroupsByEvent(t *testing.T) {
+	now := time.Now()
+
+	ticketing := checkoutUpstream(t, map[string]func(http.ResponseWriter, *http.Request){
+		"/api/v1/tickets": jsonReply(http.StatusOK, `{"data":[
+			{"id":"t1","event_id":"later","ticket_tier_id":"tier-vip","status":"valid"},
+			{"id":"t2","event_id":"past","ticket_tier_id":"tier-a","status":"used"},
+			{"id":"t3","event_id":"soon","ticket_tier_id":"tier-b","status":"valid"},
+			{"id":"t4","event_id":"later","ticket_tier_id":"tier-vip","status":"cancelled"},
+			{"id":"t5","event_id":"past","ticket_tier_id":"tier-a","status":"valid"},
+			{"id":"t6","event_id":"live","ticket_tier_id":"tier-c","status":"valid"}
+		]}`),
+	})
+	events := checkoutUpstream(t, map[string]func(http.ResponseWriter, *http.Request){
+		"/api/v1/events/later":              jsonReply(http.StatusOK, eventJSON("later", "Later Fest", now.Add(30*24*time.Hour), now.Add(31*24*time.Hour))),
+		"/api/v1/events/later/ticket-tiers": jsonReply(http.StatusOK, `{"data":[{"id":"tier-vip","name":"VIP"}]}`),
+		"/api/v1/events/soon":               jsonReply(http.StatusOK, eventJSON("soon", "Soon Show", now.Add(24*time.Hour), now.Add(26*time.Hour))),
+		"/api/v1/events/soon/ticket-tiers":  jsonReply(http.StatusInternalServerError, `{}`),
+		"/api/v1/events/live":               jsonReply(http.StatusOK, eventJSON("live", "Live Gig", now.Add(-time.Hour), now.Add(time.Hour))),
+		"/api/v1/events/live/ticket-tiers":  jsonReply(http.StatusOK, `{"data":[]}`),
+		"/api/v1/events/past":               jsonReply(http.StatusOK, eventJSON("past", "Past Expo", now.Add(-48*time.Hour), now.Add(-47*time.Hour))),
+		"/api/v1/events/past/ticket-tiers":  jsonReply(http.StatusOK, `{"data":[{"id":"tier-a","name":"Regular"}]}`),
+	})
+
+	w, data := serveMyTickets(t, config.ServiceURLs{TicketingService: ticketing.URL, EventService: events.URL})
+	require.Equal(t, http.StatusOK, w.Code)
+
+	assert.False(t, data.Partial)
+	assert.Equal(t, 6, data.TotalTickets)
+
+	require.Len(t, data.Upcoming, 3)
+	assert.Equal(t, []string{"live", "soon", "later"}, []string{data.Upcoming[0].EventID, data.Upcoming[1].EventID, data.Upcoming[2].EventID})
+	assert.Equal(t, "Later Fest", data.Upcoming[2].Event.Title)
+
+	later := data.Upcoming[2].Tickets
+	require.Len(t, later, 2)
+	assert.Equal(t, "VIP", later[0].TierName)
+	assert.Equal(t, TicketBadgeUpcoming, later[0].Badge)
+	assert.Equal(t, TicketBadgeCancelled, later[1].Badge)
+
+	assert.Equal(t, TicketBadgeHappeningNow, data.Upcoming[0].Tickets[0].Badge)
+	// Tier lookup failure only drops the tier name
+	assert.Empty(t, data.Upcoming[1].Tickets[0].TierName)
+
+	require.Len(t, data.Past, 1)
+	past := data.Past[0].Tickets
+	assert.Equal(t, "Regular", past[0].TierName)
+	assert.Equal(t, TicketBadgeUsed, past[0].Badge)
+	assert.Equal(t, TicketBadgeExpired, past[1].Badge)
+}
+
+func TestMyTicketsHandler_MissingEventDetails(t *testing.T) {
+	now := time.Now()
+
+	ticketing := checkoutUpstream(t, map[string]func(http.ResponseWriter, *http.Request){
+		"/api/v1/tickets": jsonReply(http.StatusOK, `{"data":[
+			{"id":"t1","event_id":"gone","status":"valid"},
+			{"id":"t2","event_id":"soon","status":"valid"}
+		]}`),
+	})
+	events := checkoutUpstream(t, map[string]func(http.ResponseWriter, *http.Request){
+		"/api/v1/events/soon":              jsonReply(http.StatusOK, eventJSON("soon", "Soon Show", now.Add(time.Hour), now.Add(2*time.Hour))),
+		"/api/v1/events/soon/ticket-tiers": jsonReply(http.StatusOK, `{"data":[]}`),
+		"/api/v1/events/gone":              jsonReply(http.StatusNotFound, `{"status":false,"message":"Event not found","error_code":"EVENT_NOT_FOUND"}`),
+	})
+
+	w, data := serveMyTickets(t, config.ServiceURLs{TicketingService: ticketing.URL, EventService: events.URL})
+	require.Equal(t, http.StatusOK, w.Code)
+
+	assert.True(t, data.Partial)
+	require.Contains(t, data.Failures, "gone")
+	assert.Equal(t, sharedresponse.CodeEventNotFound, data.Failures["gone"].ErrorCode)
+
+	require.Len(t, data.Upcoming, 2)
+	assert.Equal(t, "soon", data.Upcoming[0].EventID)
+	assert.Equal(t, "gone", data.Upcoming[1].EventID)
+	assert.Nil(t, data.Upcoming[1].Event)
+	assert.Equal(t, TicketBadgeValid, data.Upcoming[1].Tickets[0].Badge)
+	assert.Empty(t, data.Past)
+}
+
+func TestMyTicketsHandler_NoTickets(t *testing.T) {
+	ticketing := checkoutUpstream(t, map[string]func(http.ResponseWriter, *http.Request){
+		"/api/v1/tickets": jsonReply(http.StatusOK, `{"data":null}`),
+	})
+
+	w, data := serveMyTickets(t, config.ServiceURLs{TicketingService: ticketing.URL})
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"upcoming":[],"past":[],"total_tickets":0,"partial":false}`, mustMarshal(t, data))
+}
+
+func TestMyTicketsHandler_TicketingFailure(t *testing.T) {
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+
+	w, _ := serveMyTickets(t, config.ServiceURLs{TicketingService: down.URL})
+	assert.Equal(t, http.StatusBadGateway, w.Code)
+	assert.Contains(t, w.Body.String(), sharedresponse.CodeServiceUnavailable)
+}
+
+func mustMarshal(t *testing.T, v interface{}) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return string(data)
+}
@@ -0,0 +1,108 @@
+package pkg
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryRedis keeps string keys in memory
+type memoryRedis struct {
+	cache.RedisClient
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func newMemoryRedis() *memoryRedis {
+	return &memoryRedis{values: map[string]string{}}
+}
+
+func (r *memoryRedis) Get(ctx context.Context, key string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.values[key], nil
+}
+
+func (r *memoryRedis) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.values[key] = value.(string)
+	return nil
+}
+
+func (r *memoryRedis) Del(ctx context.Context, keys ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, key := range keys {
+		delete(r.values, key)
+	}
+	return nil
+}
+
+// ownershipUpstream serves the organizer's event IDs and counts the lookups
+func ownershipUpstream(t *testing.T, status int, body string) (*httptest.Server, *atomic.Int32) {
+	var calls atomic.Int32
+	srv := checkoutUpstream(t, map[string]func(http.ResponseWriter, *http.Request){
+		"/api/v1/organizer/event-ids": func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			jsonReply(status, body)(w, r)
+		},
+	})
+	return srv, &calls
+}
+
+func newOwnershipRouter(o *EventOwnership) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	events := router.Group("/api/v1/events", func(c *gin.Context) {
+		c.Set("user_id", "user-1")
+	}, o.Middleware())
+	events.POST("", func(c *gin.Context) { c.Status(http.StatusCreated) })
+	events.PUT("/:id", func(c *gin.Context) { c.Status(http.StatusOK) })
+	return router
+}
+
+func serveOwnership(router *gin.Engine, method, path string) int {
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+	return w.Code
+}
+
+func TestEventOwnership_RejectsForeignEvents(t *testing.T) {
+	upstream, calls := ownershipUpstream(t, http.StatusOK, `{"message":"ok","data":{"event_ids":["event-1"]}}`)
+	redis := newMemoryRedis()
+	router := newOwnershipRouter(NewEventOwnership(config.OwnershipConfig{Enabled: true, TTL: time.Minute}, upstream.URL, redis))
+
+	assert.Equal(t, http.StatusOK, serveOwnership(router, http.MethodPut, "/api/v1/events/event-1"))
+	assert.Equal(t, http.StatusOK, serveOwnership(router, http.MethodPut, "/api/v1/events/EVENT-1"))
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, http.StatusForbidden, serveOwnership(router, http.MethodPut, "/api/v1/events/event-2"))
+	}
+	assert.EqualValues(t, 1, calls.Load(), "event IDs should be loaded once and served from Redis")
+
+	// Creating an event drops the cache so the new event is allowed next
+	assert.Equal(t, http.StatusCreated, serveOwnership(router, http.MethodPost, "/api/v1/events"))
+	assert.Empty(t, redis.values)
+	serveOwnership(router, http.MethodPut, "/api/v1/events/event-1")
+	assert.EqualValues(t, 2, calls.Load())
+}
+
+func TestEventOwnership_PassesThroughWhenUnknown(t *testing.T) {
+	upstream, _ := ownershipUpstream(t, http.StatusInternalServerError, `{"message":"boom"}`)
+
+	router := newOwnershipRouter(NewEventOwnership(config.OwnershipConfig{Enabled: true, TTL: time.Minute}, upstream.URL, newMemoryRedis()))
+	assert.Equal(t, http.StatusOK, serveOwnership(router, http.MethodPut, "/api/v1/events/event-2"))
+
+	// Without Redis the check is skipped entirely
+	router = newOwnershipRouter(NewEventOwnership(config.OwnershipConfig{Enabled: true, TTL: time.Minute}, upstream.URL, nil))
+	assert.Equal(t, http.StatusOK, serveOwnership(router, http.MethodPut, "/api/v1/events/event-2"))
+}
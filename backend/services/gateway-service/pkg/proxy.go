@@ -4,16 +4,34 @@ import (
 	"context"
 	"io"
 	"log"
+	"math"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/featureflags"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
 	"google.golang.org/api/idtoken"
+	"google.golang.org/grpc/codes"
+)
+
+// Gin context keys shared with the access log
+const (
+	UpstreamKey      = "upstream"       // Backend service URL a request was proxied to
+	CorrelationIDKey = "correlation_id" // Request ID forwarded to backend services as X-Correlation-ID
 )
 
 // ProxyHandler creates a reverse proxy handler for backend services
-func ProxyHandler(targetURL string) gin.HandlerFunc {
+// versions lists the API versions the upstream implements for this route (default v1);
+// requests for a newer version are served by the newest one available
+func ProxyHandler(targetURL string, versions ...string) gin.HandlerFunc {
+	if len(versions) == 0 {
+		versions = []string{APIVersionV1}
+	}
+
 	client := &http.Client{
 		Timeout: 30 * time.Second,
 		Transport: &http.Transport{
@@ -24,80 +42,62 @@ func ProxyHandler(targetURL string) gin.HandlerFunc {
 	}
 
 	return func(c *gin.Context) {
+		// v2 rolls out behind a flag; evaluated here so auth middleware has set the user
+		if c.GetString(APIVersionKey) == APIVersionV2 && !featureflags.Enabled(c, featureflags.V2Responses, true) {
+			c.JSON(http.StatusNotAcceptable, sharedresponse.ErrorWithCode(
+				"API "+APIVersionV2+" is not enabled for this account",
+				sharedresponse.CodeUnsupportedAPIVersion,
+				map[string]interface{}{"supported_versions": []string{APIVersionV1}},
+			))
+			return
+		}
+
+		c.Set(UpstreamKey, targetURL)
+
 		// Build target URL
-		target := targetURL + c.Request.URL.Path
+		target := targetURL + upstreamPath(c, versions)
 		if c.Request.URL.RawQuery != "" {
 			target += "?" + c.Request.URL.RawQuery
 		}
 
-		// Create new request
-		proxyReq, err := http.NewRequest(c.Request.Method, target, c.Request.Body)
+		proxyReq, err := newUpstreamRequest(context.Background(), c, c.Request.Method, targetURL, target, c.Request.Body)
 		if err != nil {
 			log.Printf("[Proxy Error] Failed to create request: %v", err)
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": "Failed to create proxy request",
-			})
+			c.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode("Failed to create proxy request", sharedresponse.CodeInternal, nil))
 			return
 		}
 
-		// Copy headers from original request
-		for key, values := range c.Request.Header {
-			// Skip host header as it will be set by http.Client
-			if strings.ToLower(key) == "host" {
-				continue
-			}
-			for _, value := range values {
-				proxyReq.Header.Add(key, value)
-			}
-		}
-
-		// Add user context headers from JWT middleware
-		if userID, exists := c.Get("user_id"); exists {
-			proxyReq.Header.Set("X-User-ID", userID.(string))
-		}
-		if email, exists := c.Get("email"); exists {
-			proxyReq.Header.Set("X-User-Email", email.(string))
-		}
-		if role, exists := c.Get("role"); exists {
-			proxyReq.Header.Set("X-User-Role", role.(string))
-		}
-
-		// Add correlation ID
-		if correlationID, exists := c.Get("correlation_id"); exists {
-			proxyReq.Header.Set("X-Correlation-ID", correlationID.(string))
-		} else if correlationID := c.GetHeader("X-Request-ID"); correlationID != "" {
-			proxyReq.Header.Set("X-Correlation-ID", correlationID)
-		}
-
-		// Add identity token for Cloud Run service-to-service authentication
-		// This allows the gateway to call private Cloud Run services
-		// ONLY add identity token if Authorization header is NOT already present (preserve user JWT)
-		if strings.Contains(targetURL, "run.app") && proxyReq.Header.Get("Authorization") == "" {
-			tokenSource, err := idtoken.NewTokenSource(context.Background(), targetURL)
-			if err != nil {
-				log.Printf("[Proxy Warning] Failed to create token source for %s: %v", targetURL, err)
-			} else {
-				token, err := tokenSource.Token()
-				if err != nil {
-					log.Printf("[Proxy Warning] Failed to get identity token for %s: %v", targetURL, err)
-				} else {
-					proxyReq.Header.Set("Authorization", "Bearer "+token.AccessToken)
-				}
-			}
+		// Fail fast while the upstream's circuit is open
+		if ok, retryAfter := allowUpstream(c, targetURL); !ok {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			c.JSON(http.StatusServiceUnavailable, sharedresponse.ErrorWithCode("Backend service temporarily unavailable, please try again later", sharedresponse.CodeServiceUnavailable, map[string]string{"service": targetURL}))
+			return
 		}
 
 		// Execute request
 		resp, err := client.Do(proxyReq)
+		recordUpstream(c, targetURL, resp, err)
 		if err != nil {
 			log.Printf("[Proxy Error] Request failed: %v", err)
-			c.JSON(http.StatusBadGateway, gin.H{
-				"error":   "Backend service unavailable",
-				"service": targetURL,
-			})
+			c.JSON(http.StatusBadGateway, sharedresponse.ErrorWithCode("Backend service unavailable", sharedresponse.CodeServiceUnavailable, map[string]string{"service": targetURL}))
 			return
 		}
 		defer resp.Body.Close()
 
+		// Translate gRPC status (e.g. from gRPC-gateway style upstreams) into the shared error envelope
+		if grpcStatus := resp.Header.Get("Grpc-Status"); grpcStatus != "" && grpcStatus != "0" {
+			if n, err := strconv.Atoi(grpcStatus); err == nil {
+				httpStatus, errorCode := sharedresponse.HTTPStatusFromGRPC(codes.Code(n))
+				// Grpc-Message is percent-encoded per the gRPC HTTP/2 spec
+				errorMessage, err := url.PathUnescape(resp.Header.Get("Grpc-Message"))
+				if err != nil || errorMessage == "" {
+					errorMessage = http.StatusText(httpStatus)
+				}
+				c.JSON(httpStatus, sharedresponse.ErrorWithCode(errorMessage, errorCode, nil))
+				return
+			}
+		}
+
 		// Copy response headers
 		for key, values := range resp.Header {
 			for _, value := range values {
@@ -114,3 +114,74 @@ func ProxyHandler(targetURL string) gin.HandlerFunc {
 		}
 	}
 }
+
+// newUpstreamRequest builds a request to a backend service carrying the client's headers,
+// the authenticated user context and the correlation ID
+func newUpstreamRequest(ctx context.Context, c *gin.Context, method, serviceURL, target string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Copy headers from original request
+	for key, values := range c.Request.Header {
+		// Skip host header as it will be set by http.Client
+		if strings.ToLower(key) == "host" {
+			continue
+		}
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	// Add user context headers from JWT middleware
+	if userID, exists := c.Get("user_id"); exists {
+		req.Header.Set("X-User-ID", userID.(string))
+	}
+	if email, exists := c.Get("email"); exists {
+		req.Header.Set("X-User-Email", email.(string))
+	}
+	if role, exists := c.Get("role"); exists {
+		req.Header.Set("X-User-Role", role.(string))
+	}
+
+	// Add correlation ID
+	if correlationID, exists := c.Get(CorrelationIDKey); exists {
+		req.Header.Set("X-Correlation-ID", correlationID.(string))
+	} else if correlationID := c.GetHeader("X-Request-ID"); correlationID != "" {
+		req.Header.Set("X-Correlation-ID", correlationID)
+	}
+
+	// Add identity token for Cloud Run service-to-service authentication
+	// This allows the gateway to call private Cloud Run services
+	// ONLY add identity token if Authorization header is NOT already present (preserve user JWT)
+	if strings.Contains(serviceURL, "run.app") && req.Header.Get("Authorization") == "" {
+		tokenSource, err := idtoken.NewTokenSource(context.Background(), serviceURL)
+		if err != nil {
+			log.Printf("[Proxy Warning] Failed to create token source for %s: %v", serviceURL, err)
+		} else {
+			token, err := tokenSource.Token()
+			if err != nil {
+				log.Printf("[Proxy Warning] Failed to get identity token for %s: %v", serviceURL, err)
+			} else {
+				req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+			}
+		}
+	}
+
+	return req, nil
+}
+
+// upstreamPath rewrites the request path onto the upstream version of the route
+// e.g. /api/events (negotiated v2) -> /api/v1/events when only v1 exists upstream
+func upstreamPath(c *gin.Context, versions []string) string {
+	path := c.Request.URL.Path
+
+	prefix := c.GetString(APIPrefixKey)
+	if prefix == "" || !strings.HasPrefix(path, prefix) {
+		return path
+	}
+
+	version := UpstreamVersion(c.GetString(APIVersionKey), versions)
+	return "/api/" + version + strings.TrimPrefix(path, prefix)
+}
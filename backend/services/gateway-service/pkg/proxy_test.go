@@ -0,0 +1,53 @@
+package pkg
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/featureflags"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProxyHandler_V2ResponsesFlag(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var upstreamPaths []string
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upstreamPaths = append(upstreamPaths, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer upstream.Close()
+
+	newRouter := func(flags *featureflags.Client) *gin.Engine {
+		router := gin.New()
+		router.Use(featureflags.Middleware(flags))
+		router.GET("/api/v2/orders", func(c *gin.Context) {
+			c.Set(APIVersionKey, APIVersionV2)
+			c.Set(APIPrefixKey, "/api/v2")
+			c.Set("user_id", c.GetHeader("X-Test-User"))
+		}, ProxyHandler(upstream.URL, APIVersionV1, APIVersionV2))
+		return router
+	}
+
+	serve := func(router *gin.Engine, user string) int {
+		req := httptest.NewRequest(http.MethodGet, "/api/v2/orders", nil)
+		req.Header.Set("X-Test-User", user)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	// Undefined flag keeps v2 enabled
+	assert.Equal(t, http.StatusNoContent, serve(newRouter(nil), "user-1"))
+
+	flags := featureflags.New(featureflags.StaticProvider{
+		featureflags.V2Responses: {Enabled: true, Users: []string{"beta-user"}},
+	}, "production")
+	router := newRouter(flags)
+	assert.Equal(t, http.StatusNoContent, serve(router, "beta-user"))
+	assert.Equal(t, http.StatusNotAcceptable, serve(router, "user-1"))
+
+	assert.Equal(t, []string{"/api/v2/orders", "/api/v2/orders"}, upstreamPaths)
+}
@@ -0,0 +1,132 @@
+package pkg
+
+import (
+	"encoding/json"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/config"
+)
+
+// maxCachedDomains bounds the cache, since Host and Origin are client-controlled
+const maxCachedDomains = 1024
+
+// TenantResolver maps the request's domain to a white-label tenant and passes it
+// to upstream services in the X-Tenant-ID header
+// Domains are resolved by auth-service and cached in memory; a client-supplied
+// X-Tenant-ID is always replaced.
+type TenantResolver struct {
+	cfg            config.TenantConfig
+	authServiceURL string
+	client         *http.Client
+
+	mu      sync.Mutex
+	domains map[string]resolvedTenant
+}
+
+// resolvedTenant is a cached domain lookup
+type resolvedTenant struct {
+	id        string
+	expiresAt time.Time
+}
+
+// NewTenantResolver creates the tenant resolver
+func NewTenantResolver(cfg config.TenantConfig, authServiceURL string) *TenantResolver {
+	return &TenantResolver{
+		cfg:            cfg,
+		authServiceURL: authServiceURL,
+		client:         newAggregateClient(),
+		domains:        make(map[string]resolvedTenant),
+	}
+}
+
+// Middleware resolves the tenant before auth runs, so tokens are checked against it
+// When auth-service can't be reached a stale entry is used; without one the request fails,
+// since serving a brand's domain as another tenant would show the wrong users and events.
+func (t *TenantResolver) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request.Header.Del(tenant.Header)
+		if !t.cfg.Enabled {
+			c.Next()
+			return
+		}
+
+		tenantID, ok := t.resolve(c, requestDomain(c.Request))
+		if !ok {
+			c.JSON(http.StatusServiceUnavailable, sharedresponse.ErrorWithCode("Tenant could not be resolved", sharedresponse.CodeServiceUnavailable, nil))
+			c.Abort()
+			return
+		}
+
+		c.Request.Header.Set(tenant.Header, tenantID)
+		c.Set(tenant.GinKey, tenantID)
+		c.Next()
+	}
+}
+
+// resolve returns the tenant serving domain, from cache or auth-service
+func (t *TenantResolver) resolve(c *gin.Context, domain string) (string, bool) {
+	t.mu.Lock()
+	cached, found := t.domains[domain]
+	t.mu.Unlock()
+	if found && time.Now().Before(cached.expiresAt) {
+		return cached.id, true
+	}
+
+	result := fetchUpstreamData(c, t.client, "auth-service", t.authServiceURL, "/api/v1/tenants/resolve?domain="+url.QueryEscape(domain))
+	var data struct {
+		ID string `json:"id"`
+	}
+	if result.failure != nil || json.Unmarshal(result.data, &data) != nil || data.ID == "" {
+		log.Printf("[Tenant] Failed to resolve tenant for %s", domain)
+		return cached.id, found
+	}
+
+	t.store(domain, data.ID)
+	return data.ID, true
+}
+
+// store caches a resolved domain, dropping expired entries when the cache is full
+func (t *TenantResolver) store(domain, tenantID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	if len(t.domains) >= maxCachedDomains {
+		for cachedDomain, cached := range t.domains {
+			if now.After(cached.expiresAt) {
+				delete(t.domains, cachedDomain)
+			}
+		}
+		if len(t.domains) >= maxCachedDomains {
+			return
+		}
+	}
+	t.domains[domain] = resolvedTenant{id: tenantID, expiresAt: now.Add(t.cfg.TTL)}
+}
+
+// requestDomain returns the domain the client is on
+// Brand frontends either proxy /api to the gateway (X-Forwarded-Host) or call it
+// cross-origin from the browser (Origin); otherwise the gateway's own host is used.
+func requestDomain(r *http.Request) string {
+	host := r.Host
+	if forwarded := r.Header.Get("X-Forwarded-Host"); forwarded != "" {
+		host = strings.TrimSpace(strings.Split(forwarded, ",")[0])
+	} else if origin, err := url.Parse(r.Header.Get("Origin")); err == nil && origin.Host != "" {
+		host = origin.Host
+	}
+
+	host = strings.ToLower(host)
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.TrimSuffix(host, ".")
+}
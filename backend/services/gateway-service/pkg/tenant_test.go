@@ -0,0 +1,105 @@
+package pkg
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/gateway-service/config"
+	"github.com/stretchr/testify/assert"
+)
+
+const brandTenantID = "6f1c2a4e-8d0b-4c55-9a77-3e2f1b0c9d11"
+
+// tenantUpstream resolves tickets.brand.com to the brand tenant and anything else to the default
+// failing makes every lookup fail
+func tenantUpstream(t *testing.T, failing *atomic.Bool) (*httptest.Server, *atomic.Int32) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		assert.Empty(t, r.Header.Get(tenant.Header))
+		if r.URL.Path != "/api/v1/tenants/resolve" || failing.Load() {
+			jsonReply(http.StatusInternalServerError, `{"message":"boom"}`)(w, r)
+			return
+		}
+		id := tenant.DefaultID
+		if r.URL.Query().Get("domain") == "tickets.brand.com" {
+			id = brandTenantID
+		}
+		jsonReply(http.StatusOK, `{"message":"ok","data":{"id":"`+id+`"}}`)(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func newTenantRouter(resolver *TenantResolver) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/api/v1/events", resolver.Middleware(), func(c *gin.Context) {
+		c.String(http.StatusOK, c.Request.Header.Get(tenant.Header))
+	})
+	return router
+}
+
+func serveTenant(router *gin.Engine, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/events", nil)
+	req.Host = "api.platform.com"
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestTenantResolver_ResolvesDomain(t *testing.T) {
+	var failing atomic.Bool
+	upstream, calls := tenantUpstream(t, &failing)
+	router := newTenantRouter(NewTenantResolver(config.TenantConfig{Enabled: true, TTL: time.Minute}, upstream.URL))
+
+	for i := 0; i < 3; i++ {
+		w := serveTenant(router, map[string]string{"Origin": "https://tickets.brand.com"})
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, brandTenantID, w.Body.String())
+	}
+	assert.EqualValues(t, 1, calls.Load(), "domain should be resolved once and served from cache")
+
+	// A client-supplied tenant is replaced by the resolved one
+	w := serveTenant(router, map[string]string{tenant.Header: brandTenantID})
+	assert.Equal(t, tenant.DefaultID, w.Body.String())
+
+	w = serveTenant(router, map[string]string{"X-Forwarded-Host": "Tickets.Brand.com:443"})
+	assert.Equal(t, brandTenantID, w.Body.String())
+}
+
+func TestTenantResolver_Unavailable(t *testing.T) {
+	var failing atomic.Bool
+	upstream, _ := tenantUpstream(t, &failing)
+	resolver := NewTenantResolver(config.TenantConfig{Enabled: true, TTL: time.Millisecond}, upstream.URL)
+	router := newTenantRouter(resolver)
+
+	assert.Equal(t, brandTenantID, serveTenant(router, map[string]string{"Origin": "https://tickets.brand.com"}).Body.String())
+
+	// Expired entries are used while auth-service is down
+	failing.Store(true)
+	time.Sleep(5 * time.Millisecond)
+	w := serveTenant(router, map[string]string{"Origin": "https://tickets.brand.com"})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, brandTenantID, w.Body.String())
+
+	// Unknown domains can't be served as some other tenant
+	w = serveTenant(router, map[string]string{"Origin": "https://other.brand.com"})
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
+
+func TestTenantResolver_Disabled(t *testing.T) {
+	router := newTenantRouter(NewTenantResolver(config.TenantConfig{Enabled: false}, "http://unused"))
+
+	w := serveTenant(router, map[string]string{tenant.Header: brandTenantID})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Body.String())
+}
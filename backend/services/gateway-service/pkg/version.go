@@ -0,0 +1,88 @@
+package pkg
+
+import (
+	"mime"
+	"strings"
+)
+
+// API versions understood by the gateway
+const (
+	APIVersionV1 = "v1"
+	APIVersionV2 = "v2"
+
+	// DefaultAPIVersion is served when a request does not ask for a version
+	DefaultAPIVersion = APIVersionV1
+)
+
+// Gin context keys set by version negotiation
+const (
+	APIVersionKey = "api_version" // Negotiated API version (e.g. "v2")
+	APIPrefixKey  = "api_prefix"  // Path prefix the request was routed through (e.g. "/api/v2")
+)
+
+// VersionMediaTypePrefix is the vendor media type used for Accept-header versioning
+// e.g. "Accept: application/vnd.eventticketing.v2+json"
+const VersionMediaTypePrefix = "application/vnd.eventticketing."
+
+// SupportedAPIVersions lists versions in ascending order
+var SupportedAPIVersions = []string{APIVersionV1, APIVersionV2}
+
+// IsSupportedAPIVersion checks if version is known to the gateway
+func IsSupportedAPIVersion(version string) bool {
+	for _, v := range SupportedAPIVersions {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
+
+// VersionFromAccept extracts the requested API version from an Accept header
+// Returns empty string when no vendor media type is present
+func VersionFromAccept(accept string) string {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil || !strings.HasPrefix(mediaType, VersionMediaTypePrefix) {
+			continue
+		}
+
+		version := strings.TrimSuffix(strings.TrimPrefix(mediaType, VersionMediaTypePrefix), "+json")
+		if version != "" {
+			return version
+		}
+	}
+	return ""
+}
+
+// UpstreamVersion picks the version an upstream route is served from
+// Falls back to the highest upstream version not newer than the requested one,
+// so routes without a dedicated v2 handler keep serving their v1 shape
+func UpstreamVersion(requested string, available []string) string {
+	if len(available) == 0 {
+		return APIVersionV1
+	}
+
+	best := ""
+	for _, v := range SupportedAPIVersions {
+		if containsVersion(available, v) {
+			best = v
+		}
+		if v == requested {
+			break
+		}
+	}
+	if best == "" {
+		return APIVersionV1
+	}
+	return best
+}
+
+// containsVersion checks if versions contains version
+func containsVersion(versions []string, version string) bool {
+	for _, v := range versions {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+package pkg
+
+import "testing"
+
+// TestVersionFromAccept tests API version extraction from Accept headers
+func TestVersionFromAccept(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   string
+	}{
+		{"", ""},
+		{"application/json", ""},
+		{"application/vnd.eventticketing.v2+json", "v2"},
+		{"application/vnd.eventticketing.v1+json", "v1"},
+		{"text/html, application/vnd.eventticketing.v2+json;q=0.9", "v2"},
+		{"APPLICATION/VND.EVENTTICKETING.V2+JSON", "v2"},
+	}
+
+	for _, tt := range tests {
+		if got := VersionFromAccept(tt.accept); got != tt.want {
+			t.Errorf("VersionFromAccept(%q) = %q, want %q", tt.accept, got, tt.want)
+		}
+	}
+}
+
+// TestUpstreamVersion tests routes fall back to the newest upstream version available
+func TestUpstreamVersion(t *testing.T) {
+	tests := []struct {
+		requested string
+		available []string
+		want      string
+	}{
+		{APIVersionV1, nil, APIVersionV1},
+		{APIVersionV2, nil, APIVersionV1},
+		{APIVersionV2, []string{APIVersionV1}, APIVersionV1},
+		{APIVersionV1, []string{APIVersionV1, APIVersionV2}, APIVersionV1},
+		{APIVersionV2, []string{APIVersionV1, APIVersionV2}, APIVersionV2},
+	}
+
+	for _, tt := range tests {
+		if got := UpstreamVersion(tt.requested, tt.available); got != tt.want {
+			t.Errorf("UpstreamVersion(%q, %v) = %q, want %q", tt.requested, tt.available, got, tt.want)
+		}
+	}
+}
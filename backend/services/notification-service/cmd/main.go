@@ -1,19 +1,27 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"syscall"
+	"time"
 
 	"github.com/joho/godotenv"
 	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/notification"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/buildinfo"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/interceptor"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/serviceauth"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/notification-service/config"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/notification-service/internal/client"
 	grpcHandler "github.com/raflibima25/event-ticketing-platform/backend/services/notification-service/internal/grpc"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/notification-service/internal/service"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/notification-service/internal/worker"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
@@ -30,7 +38,15 @@ func main() {
 	// Load configuration
 	cfg := config.Load()
 
+	// Error reporting: panics and alarms go to Sentry when SENTRY_DSN is set, otherwise they are only logged
+	if err := errreport.Init(errreport.ConfigFromEnv("notification-service")); err != nil {
+		log.Printf("⚠️  Warning: Error reporting disabled: %v", err)
+	}
+	defer errreport.Flush(2 * time.Second)
+	log.Printf("Build: %s", buildinfo.Get("notification-service"))
+
 	log.Printf("Starting Notification Service on gRPC port %s...", cfg.Server.GRPCPort)
+	log.Printf("gRPC max message size: recv %d bytes, send %d bytes", cfg.Server.MaxRecvMsgSize, cfg.Server.MaxSendMsgSize)
 
 	// Validate Resend configuration
 	if cfg.Resend.APIKey == "" {
@@ -51,6 +67,29 @@ func main() {
 		log.Println("📧 Production mode - emails will be sent to actual recipients")
 	}
 
+	// Initialize Redis for announcement quotas (auto-detects TCP or REST)
+	redisClient, err := cache.NewRedisClient()
+	if err != nil {
+		log.Printf("⚠️  Warning: Failed to connect to Redis: %v", err)
+		log.Println("⚠️  Continuing without Redis (announcement quotas counted in memory)")
+		redisClient = nil
+	} else {
+		log.Println("✅ Redis connected successfully")
+		defer redisClient.Close()
+	}
+
+	// Digest rules: non-urgent notifications wait for the recipient's daily or weekly digest (needs Redis)
+	var digestRules *service.DigestRules
+	if cfg.Digest.Enabled {
+		digestRules, err = service.NewDigestRules(cfg.Digest.Types, cfg.Digest.DefaultFrequency, cfg.Digest.Hour, cfg.Digest.Timezone)
+		if err != nil {
+			log.Fatalf("❌ Invalid notification digest configuration: %v", err)
+		}
+		if redisClient == nil {
+			log.Println("⚠️  Warning: Notification digests need Redis, all notifications are sent right away")
+		}
+	}
+
 	// Initialize services
 	emailService := service.NewEmailService(
 		resendClient,
@@ -58,17 +97,55 @@ func main() {
 		cfg.Resend.FromEmail,
 		cfg.Resend.TestMode,
 		cfg.Resend.TestEmail,
+		redisClient,
+		digestRules,
 	)
+	digestService := service.NewDigestService(
+		resendClient,
+		cfg.Resend.FromName,
+		cfg.Resend.FromEmail,
+		cfg.Resend.TestMode,
+		cfg.Resend.TestEmail,
+		redisClient,
+		digestRules,
+	)
+	senderDomainService := service.NewSenderDomainService(resendClient)
 	log.Println("✅ Email service initialized")
 
+	// Authenticate and authorize callers of the gRPC server (SERVICE_AUTH_PUBLIC_KEYS)
+	serviceVerifier, err := serviceauth.VerifierFromEnv(serviceauth.ServiceNotification, grpcHandler.ServiceAuthPolicy)
+	if err != nil {
+		log.Fatalf("Failed to load service auth keys: %v", err)
+	}
+	if serviceVerifier == nil {
+		log.Println("⚠️  Warning: SERVICE_AUTH_PUBLIC_KEYS not set, gRPC callers are not authenticated")
+	}
+
 	// Initialize gRPC server
-	grpcServer := grpc.NewServer()
-	notificationGRPCServer := grpcHandler.NewNotificationGRPCServer(emailService)
+	grpcServer := grpc.NewServer(
+		interceptor.UnaryServer(serviceVerifier.UnaryServerInterceptor()),
+		interceptor.StreamServer(serviceVerifier.StreamServerInterceptor()),
+		grpc.MaxRecvMsgSize(cfg.Server.MaxRecvMsgSize),
+		grpc.MaxSendMsgSize(cfg.Server.MaxSendMsgSize),
+	)
+	notificationGRPCServer := grpcHandler.NewNotificationGRPCServer(emailService, digestService, senderDomainService)
 	pb.RegisterNotificationServiceServer(grpcServer, notificationGRPCServer)
 	reflection.Register(grpcServer)
+	buildinfo.RegisterGRPC(grpcServer, "notification-service")
 
 	log.Println("✅ gRPC server initialized")
 
+	// Start digest worker
+	workerCtx, workerCancel := context.WithCancel(context.Background())
+	defer workerCancel()
+
+	var digestWorker *worker.DigestWorker
+	if digestRules != nil && redisClient != nil {
+		digestWorker = worker.NewDigestWorker(digestService, cfg.Digest.Interval, cfg.Digest.BatchSize)
+		go digestWorker.Start(workerCtx)
+		log.Println("✅ Digest worker started")
+	}
+
 	// Setup graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -89,6 +166,11 @@ func main() {
 	<-quit
 	log.Println("Shutting down Notification Service...")
 
+	// Stop digest worker
+	if digestWorker != nil {
+		digestWorker.Stop()
+	}
+
 	// Gracefully stop gRPC server
 	grpcServer.GracefulStop()
 
@@ -1,18 +1,25 @@
 package config
 
 import (
+	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // Config holds all application configuration
 type Config struct {
 	Server ServerConfig
 	Resend ResendConfig
+	Digest DigestConfig
 }
 
 // ServerConfig holds server configuration
 type ServerConfig struct {
-	GRPCPort string
+	GRPCPort       string
+	MaxRecvMsgSize int // Max gRPC message size the server accepts (bytes)
+	MaxSendMsgSize int // Max gRPC message size the server sends (bytes)
 }
 
 // ResendConfig holds Resend email service configuration
@@ -24,13 +31,27 @@ type ResendConfig struct {
 	TestEmail string
 }
 
+// DigestConfig holds notification digest configuration
+// Digests need Redis; without it every notification is sent right away
+type DigestConfig struct {
+	Enabled          bool
+	Types            []string      // Notification types that may wait for a digest
+	DefaultFrequency string        // Frequency of recipients without a preference: immediate, daily or weekly
+	Hour             int           // Hour of day digests are sent, in Timezone
+	Timezone         string        // IANA timezone of Hour
+	Interval         time.Duration // How often the worker looks for due digests
+	BatchSize        int           // Max recipients per worker run
+}
+
 // Load loads configuration from environment variables
 func Load() *Config {
 	testMode := getEnv("RESEND_TEST_MODE", "false") == "true"
 
 	return &Config{
 		Server: ServerConfig{
-			GRPCPort: getEnv("NOTIFICATION_GRPC_PORT", "50055"),
+			GRPCPort:       getEnv("NOTIFICATION_GRPC_PORT", "50055"),
+			MaxRecvMsgSize: getEnvAsPositiveInt("GRPC_MAX_RECV_MSG_SIZE", 4*1024*1024), // 4 MB default
+			MaxSendMsgSize: getEnvAsPositiveInt("GRPC_MAX_SEND_MSG_SIZE", 4*1024*1024), // 4 MB default
 		},
 		Resend: ResendConfig{
 			APIKey:    getEnv("RESEND_API_KEY", ""),
@@ -39,6 +60,15 @@ func Load() *Config {
 			TestMode:  testMode,
 			TestEmail: getEnv("RESEND_TEST_EMAIL", ""),
 		},
+		Digest: DigestConfig{
+			Enabled:          getEnv("NOTIFICATION_DIGEST_ENABLED", "true") == "true",
+			Types:            getEnvAsSlice("NOTIFICATION_DIGEST_TYPES", "announcement"),
+			DefaultFrequency: getEnv("NOTIFICATION_DIGEST_DEFAULT_FREQUENCY", "immediate"),
+			Hour:             getEnvAsInt("NOTIFICATION_DIGEST_HOUR", 8),
+			Timezone:         getEnv("NOTIFICATION_DIGEST_TIMEZONE", "Asia/Jakarta"),
+			Interval:         getEnvAsDuration("NOTIFICATION_DIGEST_INTERVAL", time.Minute),
+			BatchSize:        getEnvAsInt("NOTIFICATION_DIGEST_BATCH_SIZE", 100),
+		},
 	}
 }
 
@@ -50,3 +80,53 @@ func getEnv(key, defaultValue string) string {
 	}
 	return value
 }
+
+// getEnvAsInt gets environment variable as integer with default value
+func getEnvAsInt(key string, defaultValue int) int {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+	value, err := strconv.Atoi(valueStr)
+	if err != nil {
+		log.Printf("Warning: Invalid integer value for %s, using default: %d", key, defaultValue)
+		return defaultValue
+	}
+	return value
+}
+
+// getEnvAsPositiveInt gets environment variable as positive integer with default value
+// Values that aren't integers or aren't above zero fall back to the default
+func getEnvAsPositiveInt(key string, defaultValue int) int {
+	value := getEnvAsInt(key, defaultValue)
+	if value <= 0 {
+		log.Printf("Warning: Non-positive value for %s, using default: %d", key, defaultValue)
+		return defaultValue
+	}
+	return value
+}
+
+// getEnvAsDuration gets environment variable as duration (e.g. 1m) with default value
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+	value, err := time.ParseDuration(valueStr)
+	if err != nil || value <= 0 {
+		log.Printf("Warning: Invalid duration value for %s, using default: %s", key, defaultValue)
+		return defaultValue
+	}
+	return value
+}
+
+// getEnvAsSlice gets environment variable as comma-separated list with default value
+func getEnvAsSlice(key, defaultValue string) []string {
+	var values []string
+	for _, value := range strings.Split(getEnv(key, defaultValue), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
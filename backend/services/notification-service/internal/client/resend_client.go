@@ -3,9 +3,11 @@ package client
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -16,6 +18,9 @@ type ResendClient struct {
 	httpClient *http.Client
 }
 
+// ErrDomainNotFound is returned when Resend doesn't know a sending domain
+var ErrDomainNotFound = errors.New("sending domain not found")
+
 // NewResendClient creates new Resend client instance
 func NewResendClient(apiKey string) *ResendClient {
 	return &ResendClient{
@@ -39,6 +44,7 @@ type EmailRequest struct {
 	To          string             `json:"to"`
 	Subject     string             `json:"subject"`
 	HTML        string             `json:"html"`
+	ReplyTo     string             `json:"reply_to,omitempty"`
 	Attachments []EmailAttachment  `json:"attachments,omitempty"`
 }
 
@@ -93,3 +99,89 @@ func (c *ResendClient) SendEmail(req *EmailRequest) (*EmailResponse, error) {
 
 	return &emailResp, nil
 }
+
+// DomainRecord represents a DNS record Resend needs to verify a sending domain
+type DomainRecord struct {
+	Record   string `json:"record"` // SPF or DKIM
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	TTL      string `json:"ttl"`
+	Status   string `json:"status"`
+	Value    string `json:"value"`
+	Priority int    `json:"priority,omitempty"`
+}
+
+// Domain represents a sending domain registered with Resend
+type Domain struct {
+	ID      string         `json:"id"`
+	Name    string         `json:"name"`
+	Status  string         `json:"status"` // not_started, pending, verified, failed or temporary_failure
+	Records []DomainRecord `json:"records"`
+}
+
+// CreateDomain registers a sending domain, the returned records must be published in its DNS
+func (c *ResendClient) CreateDomain(name string) (*Domain, error) {
+	var domain Domain
+	if err := c.do(http.MethodPost, "/domains", map[string]string{"name": name}, &domain); err != nil {
+		return nil, err
+	}
+	return &domain, nil
+}
+
+// GetDomain retrieves a sending domain with the verification status of its records
+func (c *ResendClient) GetDomain(id string) (*Domain, error) {
+	var domain Domain
+	if err := c.do(http.MethodGet, "/domains/"+url.PathEscape(id), nil, &domain); err != nil {
+		return nil, err
+	}
+	return &domain, nil
+}
+
+// VerifyDomain starts an asynchronous check of a sending domain's DNS records
+func (c *ResendClient) VerifyDomain(id string) error {
+	return c.do(http.MethodPost, "/domains/"+url.PathEscape(id)+"/verify", nil, nil)
+}
+
+// do sends a JSON request to the Resend API and decodes the response into out, if set
+func (c *ResendClient) do(method, path string, body, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		jsonData, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
+		reqBody = bytes.NewBuffer(jsonData)
+	}
+
+	httpReq, err := http.NewRequest(method, c.baseURL+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrDomainNotFound
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("resend API error: %s - %s", resp.Status, string(respBody))
+	}
+
+	if out != nil {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("failed to parse response: %w", err)
+		}
+	}
+	return nil
+}
@@ -2,22 +2,50 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"io"
 	"log"
 
 	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/notification"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/serviceauth"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/notification-service/internal/service"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/notification-service/internal/utility"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// ServiceAuthPolicy lists the services allowed to call each method
+var ServiceAuthPolicy = serviceauth.Policy{
+	"/notification.NotificationService/SendTicketEmail":              {serviceauth.ServiceTicketing},
+	"/notification.NotificationService/StreamTicketEmail":            {serviceauth.ServiceTicketing},
+	"/notification.NotificationService/GenerateBadgePDF":             {serviceauth.ServiceTicketing},
+	"/notification.NotificationService/GenerateTicketPreviewPDF":     {serviceauth.ServiceEvent},
+	"/notification.NotificationService/SendAnnouncementEmail":        {serviceauth.ServiceTicketing},
+	"/notification.NotificationService/SendReservationReminderEmail": {serviceauth.ServiceTicketing},
+	"/notification.NotificationService/SendInvitationEmail":          {serviceauth.ServiceTicketing},
+	"/notification.NotificationService/SendWaitlistOfferEmail":       {serviceauth.ServiceTicketing},
+	"/notification.NotificationService/SendSubscriptionDunningEmail": {serviceauth.ServicePayment},
+	"/notification.NotificationService/SendEventModerationEmail":     {serviceauth.ServiceEvent},
+	"/notification.NotificationService/GetDigestPreference":          {serviceauth.ServiceTicketing},
+	"/notification.NotificationService/SetDigestPreference":          {serviceauth.ServiceTicketing},
+	"/notification.NotificationService/RegisterSenderDomain":         {serviceauth.ServiceEvent},
+	"/notification.NotificationService/VerifySenderDomain":           {serviceauth.ServiceEvent},
+}
+
 // NotificationGRPCServer implements notification gRPC service
 type NotificationGRPCServer struct {
 	pb.UnimplementedNotificationServiceServer
-	emailService service.EmailService
+	emailService        service.EmailService
+	digestService       service.DigestService
+	senderDomainService service.SenderDomainService
 }
 
 // NewNotificationGRPCServer creates new notification gRPC server instance
-func NewNotificationGRPCServer(emailService service.EmailService) *NotificationGRPCServer {
+func NewNotificationGRPCServer(emailService service.EmailService, digestService service.DigestService, senderDomainService service.SenderDomainService) *NotificationGRPCServer {
 	return &NotificationGRPCServer{
-		emailService: emailService,
+		emailService:        emailService,
+		digestService:       digestService,
+		senderDomainService: senderDomainService,
 	}
 }
 
@@ -40,3 +68,275 @@ func (s *NotificationGRPCServer) SendTicketEmail(ctx context.Context, req *pb.Se
 
 	return resp, nil
 }
+
+// StreamTicketEmail receives e-ticket email in chunks for large orders
+// First chunk must carry the header, tickets are accumulated until client closes the stream
+func (s *NotificationGRPCServer) StreamTicketEmail(stream pb.NotificationService_StreamTicketEmailServer) error {
+	var req *pb.SendTicketEmailRequest
+
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if req == nil {
+			if chunk.Header == nil {
+				return status.Error(codes.InvalidArgument, "first chunk must contain header")
+			}
+			req = chunk.Header
+		}
+		req.Tickets = append(req.Tickets, chunk.Tickets...)
+	}
+
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "stream closed without header")
+	}
+
+	log.Printf("[gRPC] StreamTicketEmail received order: %s, recipient: %s, tickets: %d",
+		req.OrderId, req.RecipientEmail, len(req.Tickets))
+
+	resp, err := s.SendTicketEmail(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(resp)
+}
+
+// SendAnnouncementEmail sends an organizer's announcement to event attendees
+// Returns RESOURCE_EXHAUSTED when the recipients exceed the organizer's monthly quota
+func (s *NotificationGRPCServer) SendAnnouncementEmail(ctx context.Context, req *pb.SendAnnouncementEmailRequest) (*pb.SendAnnouncementEmailResponse, error) {
+	log.Printf("[gRPC] SendAnnouncementEmail called for event: %s, organizer: %s, recipients: %d",
+		req.EventId, req.OrganizerId, len(req.RecipientEmails))
+
+	resp, err := s.emailService.SendAnnouncementEmail(ctx, req)
+	if err != nil {
+		var quotaErr *service.QuotaExceededError
+		switch {
+		case errors.As(err, &quotaErr):
+			return nil, status.Errorf(codes.ResourceExhausted,
+				"announcement would send %d emails but plan %q allows %d per month and %d are already used",
+				quotaErr.Requested, req.Plan, quotaErr.Limit, quotaErr.Used)
+		case errors.Is(err, service.ErrNoRecipients):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		log.Printf("[gRPC] SendAnnouncementEmail failed for event %s: %v", req.EventId, err)
+		return nil, status.Errorf(codes.Internal, "failed to send announcement: %v", err)
+	}
+
+	log.Printf("[gRPC] SendAnnouncementEmail completed for event %s, sent: %d, failed: %d", req.EventId, resp.SentCount, resp.FailedCount)
+
+	return resp, nil
+}
+
+// SendSubscriptionDunningEmail tells an organizer that a plan subscription renewal failed
+func (s *NotificationGRPCServer) SendSubscriptionDunningEmail(ctx context.Context, req *pb.SendSubscriptionDunningEmailRequest) (*pb.SendSubscriptionDunningEmailResponse, error) {
+	log.Printf("[gRPC] SendSubscriptionDunningEmail called for recipient: %s, attempt: %d", req.RecipientEmail, req.Attempt)
+
+	if req.RecipientEmail == "" {
+		return nil, status.Error(codes.InvalidArgument, "recipient_email is required")
+	}
+
+	resp, err := s.emailService.SendSubscriptionDunningEmail(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC] SendSubscriptionDunningEmail failed for %s: %v", req.RecipientEmail, err)
+		return &pb.SendSubscriptionDunningEmailResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return resp, nil
+}
+
+// SendReservationReminderEmail reminds a buyer to pay a reservation before it expires
+func (s *NotificationGRPCServer) SendReservationReminderEmail(ctx context.Context, req *pb.SendReservationReminderEmailRequest) (*pb.SendReservationReminderEmailResponse, error) {
+	log.Printf("[gRPC] SendReservationReminderEmail called for order: %s, recipient: %s", req.OrderId, req.RecipientEmail)
+
+	if req.RecipientEmail == "" || req.PaymentUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "recipient_email and payment_url are required")
+	}
+
+	resp, err := s.emailService.SendReservationReminderEmail(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC] SendReservationReminderEmail failed for order %s: %v", req.OrderId, err)
+		return &pb.SendReservationReminderEmailResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return resp, nil
+}
+
+// SendInvitationEmail sends an invitee the personal claim link of an event invitation
+func (s *NotificationGRPCServer) SendInvitationEmail(ctx context.Context, req *pb.SendInvitationEmailRequest) (*pb.SendInvitationEmailResponse, error) {
+	log.Printf("[gRPC] SendInvitationEmail called for invitation: %s, recipient: %s", req.InvitationId, req.RecipientEmail)
+
+	if req.RecipientEmail == "" || req.ClaimUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "recipient_email and claim_url are required")
+	}
+
+	resp, err := s.emailService.SendInvitationEmail(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC] SendInvitationEmail failed for invitation %s: %v", req.InvitationId, err)
+		return &pb.SendInvitationEmailResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return resp, nil
+}
+
+// SendWaitlistOfferEmail tells a waitlisted buyer that seats are held for them until the offer expires
+func (s *NotificationGRPCServer) SendWaitlistOfferEmail(ctx context.Context, req *pb.SendWaitlistOfferEmailRequest) (*pb.SendWaitlistOfferEmailResponse, error) {
+	log.Printf("[gRPC] SendWaitlistOfferEmail called for waitlist entry: %s, recipient: %s", req.EntryId, req.RecipientEmail)
+
+	if req.RecipientEmail == "" || req.PurchaseUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "recipient_email and purchase_url are required")
+	}
+
+	resp, err := s.emailService.SendWaitlistOfferEmail(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC] SendWaitlistOfferEmail failed for waitlist entry %s: %v", req.EntryId, err)
+		return &pb.SendWaitlistOfferEmailResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return resp, nil
+}
+
+// SendEventModerationEmail tells an organizer about a moderation action on their reported event
+func (s *NotificationGRPCServer) SendEventModerationEmail(ctx context.Context, req *pb.SendEventModerationEmailRequest) (*pb.SendEventModerationEmailResponse, error) {
+	log.Printf("[gRPC] SendEventModerationEmail called for event: %s, action: %s", req.EventId, req.Action)
+
+	if req.RecipientEmail == "" || req.Action == "" {
+		return nil, status.Error(codes.InvalidArgument, "recipient_email and action are required")
+	}
+
+	resp, err := s.emailService.SendEventModerationEmail(ctx, req)
+	if err != nil {
+		log.Printf("[gRPC] SendEventModerationEmail failed for event %s: %v", req.EventId, err)
+		return &pb.SendEventModerationEmailResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return resp, nil
+}
+
+// GetDigestPreference returns how often a recipient gets non-urgent notifications
+func (s *NotificationGRPCServer) GetDigestPreference(ctx context.Context, req *pb.GetDigestPreferenceRequest) (*pb.DigestPreference, error) {
+	preference, err := s.digestService.GetPreference(ctx, req.Email)
+	if err != nil {
+		log.Printf("[gRPC] GetDigestPreference failed for %s: %v", req.Email, err)
+		return nil, status.Errorf(codes.Internal, "failed to get digest preference: %v", err)
+	}
+	return preference, nil
+}
+
+// SetDigestPreference changes how often a recipient gets non-urgent notifications
+func (s *NotificationGRPCServer) SetDigestPreference(ctx context.Context, req *pb.SetDigestPreferenceRequest) (*pb.DigestPreference, error) {
+	log.Printf("[gRPC] SetDigestPreference called for %s, frequency: %s", req.Email, req.Frequency)
+
+	preference, err := s.digestService.SetPreference(ctx, req.Email, req.Frequency)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidDigestFrequency):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, service.ErrDigestsDisabled):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		log.Printf("[gRPC] SetDigestPreference failed for %s: %v", req.Email, err)
+		return nil, status.Errorf(codes.Internal, "failed to set digest preference: %v", err)
+	}
+	return preference, nil
+}
+
+// RegisterSenderDomain adds an organizer's sending domain to the email provider
+func (s *NotificationGRPCServer) RegisterSenderDomain(ctx context.Context, req *pb.RegisterSenderDomainRequest) (*pb.SenderDomain, error) {
+	log.Printf("[gRPC] RegisterSenderDomain called for domain: %s", req.Domain)
+
+	domain, err := s.senderDomainService.Register(ctx, req.Domain)
+	if err != nil {
+		log.Printf("[gRPC] RegisterSenderDomain failed for %s: %v", req.Domain, err)
+		return nil, status.Errorf(codes.Internal, "failed to register sender domain: %v", err)
+	}
+	return domain, nil
+}
+
+// VerifySenderDomain checks the DNS records of a registered sending domain
+func (s *NotificationGRPCServer) VerifySenderDomain(ctx context.Context, req *pb.VerifySenderDomainRequest) (*pb.SenderDomain, error) {
+	domain, err := s.senderDomainService.Verify(ctx, req.DomainId)
+	if err != nil {
+		if errors.Is(err, service.ErrSenderDomainNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		log.Printf("[gRPC] VerifySenderDomain failed for %s: %v", req.DomainId, err)
+		return nil, status.Errorf(codes.Internal, "failed to verify sender domain: %v", err)
+	}
+
+	log.Printf("[gRPC] VerifySenderDomain completed for %s, status: %s", domain.Name, domain.Status)
+	return domain, nil
+}
+
+// GenerateBadgePDF renders attendee badges of an event into one printable PDF
+func (s *NotificationGRPCServer) GenerateBadgePDF(ctx context.Context, req *pb.GenerateBadgePDFRequest) (*pb.GenerateBadgePDFResponse, error) {
+	log.Printf("[gRPC] GenerateBadgePDF called for event: %s, badges: %d", req.EventName, len(req.Badges))
+
+	if len(req.Badges) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one badge is required")
+	}
+
+	sheet := &utility.BadgeSheet{
+		EventName:      req.EventName,
+		EventStartTime: req.EventStartTime,
+		BrandName:      req.BrandName,
+		Badges:         make([]utility.BadgePDFData, 0, len(req.Badges)),
+	}
+	for _, badge := range req.Badges {
+		sheet.Badges = append(sheet.Badges, utility.BadgePDFData{
+			TicketID:     badge.TicketId,
+			TicketNumber: badge.TicketNumber,
+			AttendeeName: badge.AttendeeName,
+			Company:      badge.Company,
+			TierName:     badge.TierName,
+			QRCodeBase64: badge.QrCode,
+		})
+	}
+
+	pdf, err := utility.GenerateBadgePDF(sheet)
+	if err != nil {
+		log.Printf("[gRPC] GenerateBadgePDF failed for event %s: %v", req.EventName, err)
+		return nil, status.Errorf(codes.Internal, "failed to generate badge PDF: %v", err)
+	}
+
+	log.Printf("[gRPC] GenerateBadgePDF completed for event %s, size: %d bytes", req.EventName, len(pdf))
+
+	return &pb.GenerateBadgePDFResponse{
+		Pdf:        pdf,
+		BadgeCount: int32(len(sheet.Badges)),
+	}, nil
+}
+
+// GenerateTicketPreviewPDF renders a sample e-ticket with an organizer's ticket template
+func (s *NotificationGRPCServer) GenerateTicketPreviewPDF(ctx context.Context, req *pb.GenerateTicketPreviewPDFRequest) (*pb.GenerateTicketPreviewPDFResponse, error) {
+	resp, err := s.emailService.GenerateTicketPreviewPDF(ctx, req)
+	if err != nil {
+		if errors.Is(err, service.ErrTicketLogoUnavailable) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		log.Printf("[gRPC] GenerateTicketPreviewPDF failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to generate ticket preview: %v", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+)
+
+// QuotaExceededError is returned when an announcement would exceed the organizer's monthly email quota
+type QuotaExceededError struct {
+	Used      int
+	Requested int
+	Limit     int
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("monthly announcement email quota exceeded: %d of %d used, %d requested", e.Used, e.Limit, e.Requested)
+}
+
+// reserveQuotaScript adds ARGV[1] emails to the month's counter if it stays within ARGV[2]
+// Returns the new count, or minus the current count when the quota would be exceeded
+const reserveQuotaScript = `
+local used = tonumber(redis.call('GET', KEYS[1]) or '0')
+if used + tonumber(ARGV[1]) > tonumber(ARGV[2]) then
+  return -used
+end
+used = redis.call('INCRBY', KEYS[1], ARGV[1])
+redis.call('EXPIREAT', KEYS[1], ARGV[3])
+return used
+`
+
+// releaseQuotaScript gives back emails that failed to send
+const releaseQuotaScript = `return redis.call('DECRBY', KEYS[1], ARGV[1])`
+
+// announcementQuota counts announcement emails per organizer per calendar month (UTC)
+// Counts are kept in Redis so they survive restarts and are shared by replicas;
+// without Redis they are kept in memory
+type announcementQuota struct {
+	redis cache.RedisClient
+
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+// newAnnouncementQuota creates new announcement quota counter, redisClient may be nil
+func newAnnouncementQuota(redisClient cache.RedisClient) *announcementQuota {
+	return &announcementQuota{
+		redis:  redisClient,
+		counts: make(map[string]int),
+	}
+}
+
+// Reserve counts emails against the organizer's quota for the current month
+// A limit of 0 means unlimited; returns the month's count including the reserved emails
+func (q *announcementQuota) Reserve(ctx context.Context, organizerID string, count, limit int) (int, error) {
+	now := time.Now().UTC()
+	key := announcementQuotaKey(organizerID, now)
+
+	if limit == 0 {
+		// Still counted so usage is visible if the organizer moves to a limited plan
+		limit = int(^uint32(0) >> 1)
+	}
+
+	if q.redis == nil {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+
+		used := q.counts[key]
+		if used+count > limit {
+			return 0, &QuotaExceededError{Used: used, Requested: count, Limit: limit}
+		}
+		q.counts[key] = used + count
+		return used + count, nil
+	}
+
+	// Keep the counter a day past the month so late releases don't recreate it
+	nextMonth := time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+	result, err := q.redis.Eval(ctx, reserveQuotaScript, []string{key}, count, limit, nextMonth.Add(24*time.Hour).Unix())
+	if err != nil {
+		return 0, fmt.Errorf("failed to reserve announcement quota: %w", err)
+	}
+
+	used, ok := result.(int64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected announcement quota count %v", result)
+	}
+	if used <= 0 {
+		return 0, &QuotaExceededError{Used: int(-used), Requested: count, Limit: limit}
+	}
+
+	return int(used), nil
+}
+
+// Release gives back reserved emails of the current month that weren't sent
+func (q *announcementQuota) Release(ctx context.Context, organizerID string, count int) {
+	if count == 0 {
+		return
+	}
+
+	key := announcementQuotaKey(organizerID, time.Now().UTC())
+
+	if q.redis == nil {
+		q.mu.Lock()
+		q.counts[key] = max(q.counts[key]-count, 0)
+		q.mu.Unlock()
+		return
+	}
+
+	if _, err := q.redis.Eval(ctx, releaseQuotaScript, []string{key}, count); err != nil {
+		log.Printf("[AnnouncementQuota] Failed to release %d emails of organizer %s: %v", count, organizerID, err)
+	}
+}
+
+// announcementQuotaKey returns the counter key of an organizer's month
+func announcementQuotaKey(organizerID string, month time.Time) string {
+	return fmt.Sprintf("announcements:%s:%s", organizerID, month.Format("2006-01"))
+}
@@ -0,0 +1,491 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // Digest timezone; the runtime image ships without tzdata
+
+	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/notification"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/notification-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/notification-service/internal/template"
+)
+
+// Notification types, the unit of urgency classification
+const (
+	NotificationTicket              = "ticket"
+	NotificationReservationReminder = "reservation_reminder"
+	NotificationInvitation          = "invitation"
+	NotificationWaitlistOffer       = "waitlist_offer"
+	NotificationSubscriptionDunning = "subscription_dunning"
+	NotificationAnnouncement        = "announcement"
+	NotificationEventModeration     = "event_moderation"
+)
+
+// digestableTypes are the notification types that may wait for a digest
+// E-tickets, payment reminders, invitations, waitlist offers and billing notices carry attachments or deadlines,
+// so they are always urgent and sent right away
+var digestableTypes = map[string]string{
+	NotificationAnnouncement:    "Pengumuman",
+	NotificationEventModeration: "Moderasi event",
+}
+
+// Digest frequency constants
+const (
+	DigestImmediate = "immediate"
+	DigestDaily     = "daily"
+	DigestWeekly    = "weekly"
+)
+
+// digestRetryDelay postpones a digest that failed to send
+const digestRetryDelay = 15 * time.Minute
+
+var (
+	// ErrInvalidDigestFrequency is returned for a frequency other than immediate, daily or weekly
+	ErrInvalidDigestFrequency = errors.New("digest frequency must be immediate, daily or weekly")
+	// ErrDigestsDisabled is returned when preferences are changed while digests are disabled
+	ErrDigestsDisabled = errors.New("notification digests are disabled")
+)
+
+// DigestRules decide which notifications wait for a digest and when digests are sent
+type DigestRules struct {
+	Types            []string // Digestable notification types that wait for a digest
+	DefaultFrequency string   // Frequency of recipients without a preference
+	Hour             int      // Hour of day digests are sent
+	Location         *time.Location
+}
+
+// NewDigestRules validates the digest configuration
+func NewDigestRules(types []string, defaultFrequency string, hour int, timezone string) (*DigestRules, error) {
+	for _, notificationType := range types {
+		if _, ok := digestableTypes[notificationType]; !ok {
+			return nil, fmt.Errorf("notification type %q can't wait for a digest", notificationType)
+		}
+	}
+	if !validDigestFrequency(defaultFrequency) {
+		return nil, ErrInvalidDigestFrequency
+	}
+	if hour < 0 || hour > 23 {
+		return nil, fmt.Errorf("digest hour must be between 0 and 23, got %d", hour)
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid digest timezone: %w", err)
+	}
+
+	return &DigestRules{
+		Types:            types,
+		DefaultFrequency: defaultFrequency,
+		Hour:             hour,
+		Location:         location,
+	}, nil
+}
+
+// NextDigestAt returns when a digest of the frequency is sent after now:
+// the next Hour for daily digests, the next Monday at Hour for weekly ones
+func (r *DigestRules) NextDigestAt(frequency string, now time.Time) time.Time {
+	local := now.In(r.Location)
+	at := time.Date(local.Year(), local.Month(), local.Day(), r.Hour, 0, 0, 0, r.Location)
+
+	switch frequency {
+	case DigestDaily:
+		if !at.After(local) {
+			at = at.AddDate(0, 0, 1)
+		}
+	case DigestWeekly:
+		at = at.AddDate(0, 0, (int(time.Monday)-int(at.Weekday())+7)%7)
+		if !at.After(local) {
+			at = at.AddDate(0, 0, 7)
+		}
+	default:
+		return now
+	}
+	return at
+}
+
+func validDigestFrequency(frequency string) bool {
+	return frequency == DigestImmediate || frequency == DigestDaily || frequency == DigestWeekly
+}
+
+// digestItem is one notification waiting in a recipient's digest
+type digestItem struct {
+	Type      string    `json:"type"`
+	EventName string    `json:"event_name"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	QueuedAt  time.Time `json:"queued_at"`
+}
+
+// addDigestItemScript appends ARGV[1] to the recipient's queue and schedules the recipient at ARGV[3]
+// unless a digest is already scheduled
+const addDigestItemScript = `
+redis.call('RPUSH', KEYS[1], ARGV[1])
+redis.call('ZADD', KEYS[2], 'NX', ARGV[3], ARGV[2])
+return 1
+`
+
+// setDigestPreferenceScript stores the frequency and moves a scheduled digest to ARGV[2]
+const setDigestPreferenceScript = `
+redis.call('SET', KEYS[1], ARGV[1])
+if redis.call('ZSCORE', KEYS[2], ARGV[3]) then
+  redis.call('ZADD', KEYS[2], ARGV[2], ARGV[3])
+end
+return 1
+`
+
+// pendingDigestScript returns the queue length and the scheduled time, empty when not scheduled
+const pendingDigestScript = `
+return {tostring(redis.call('LLEN', KEYS[1])), redis.call('ZSCORE', KEYS[2], ARGV[1]) or ''}
+`
+
+// dueDigestsScript returns up to ARGV[2] recipients whose digest is due at ARGV[1]
+const dueDigestsScript = `return redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])`
+
+// takeDigestScript removes and returns the recipient's queue, so a digest is sent by one replica only
+const takeDigestScript = `
+local items = redis.call('LRANGE', KEYS[1], 0, -1)
+redis.call('DEL', KEYS[1])
+redis.call('ZREM', KEYS[2], ARGV[1])
+return items
+`
+
+// requeueDigestScript puts items ARGV[3..] back in front of the queue and schedules the recipient at ARGV[1]
+const requeueDigestScript = `
+for i = #ARGV, 3, -1 do
+  redis.call('LPUSH', KEYS[1], ARGV[i])
+end
+redis.call('ZADD', KEYS[2], ARGV[1], ARGV[2])
+return 1
+`
+
+// digestDueKey is the sorted set of recipients by time their digest is due
+const digestDueKey = "digest:due"
+
+// digestQueue keeps non-urgent notifications in Redis until the recipient's digest is due
+// A nil queue is disabled: every notification is urgent
+type digestQueue struct {
+	redis cache.RedisClient
+	rules *DigestRules
+	now   func() time.Time
+}
+
+// newDigestQueue creates new digest queue, nil when redisClient or rules is nil
+func newDigestQueue(redisClient cache.RedisClient, rules *DigestRules) *digestQueue {
+	if redisClient == nil || rules == nil {
+		return nil
+	}
+	return &digestQueue{
+		redis: redisClient,
+		rules: rules,
+		now:   time.Now,
+	}
+}
+
+// Add queues a notification of a non-urgent type for the recipient's digest
+// Returns false, and the notification must be sent right away, when the type is urgent
+// or the recipient gets notifications immediately
+func (q *digestQueue) Add(ctx context.Context, email string, item digestItem) (bool, error) {
+	if q == nil || !slices.Contains(q.rules.Types, item.Type) {
+		return false, nil
+	}
+
+	email = normalizeEmail(email)
+	frequency, err := q.Frequency(ctx, email)
+	if err != nil {
+		return false, err
+	}
+	if frequency == DigestImmediate {
+		return false, nil
+	}
+
+	now := q.now()
+	item.QueuedAt = now.UTC()
+	data, err := json.Marshal(item)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode digest item: %w", err)
+	}
+
+	if _, err := q.redis.Eval(ctx, addDigestItemScript, []string{digestQueueKey(email), digestDueKey},
+		string(data), email, q.rules.NextDigestAt(frequency, now).Unix()); err != nil {
+		return false, fmt.Errorf("failed to queue digest item: %w", err)
+	}
+	return true, nil
+}
+
+// Frequency returns the recipient's digest frequency, the default when they have none
+func (q *digestQueue) Frequency(ctx context.Context, email string) (string, error) {
+	frequency, err := q.redis.Get(ctx, digestPreferenceKey(normalizeEmail(email)))
+	if err != nil {
+		return "", fmt.Errorf("failed to get digest preference: %w", err)
+	}
+	if !validDigestFrequency(frequency) {
+		return q.rules.DefaultFrequency, nil
+	}
+	return frequency, nil
+}
+
+// SetFrequency stores the recipient's digest frequency and reschedules their waiting digest
+// Switching to immediate sends what is waiting on the next worker run
+func (q *digestQueue) SetFrequency(ctx context.Context, email, frequency string) error {
+	email = normalizeEmail(email)
+	now := q.now()
+	if _, err := q.redis.Eval(ctx, setDigestPreferenceScript, []string{digestPreferenceKey(email), digestDueKey},
+		frequency, q.rules.NextDigestAt(frequency, now).Unix(), email); err != nil {
+		return fmt.Errorf("failed to set digest preference: %w", err)
+	}
+	return nil
+}
+
+// Pending returns the number of waiting notifications and when their digest is due
+func (q *digestQueue) Pending(ctx context.Context, email string) (int, time.Time, error) {
+	email = normalizeEmail(email)
+	result, err := q.redis.Eval(ctx, pendingDigestScript, []string{digestQueueKey(email), digestDueKey}, email)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("failed to get pending digest: %w", err)
+	}
+
+	values, ok := result.([]interface{})
+	if !ok || len(values) != 2 {
+		return 0, time.Time{}, fmt.Errorf("unexpected pending digest %v", result)
+	}
+	count, _ := strconv.Atoi(fmt.Sprint(values[0]))
+	var dueAt time.Time
+	if score, err := strconv.ParseInt(fmt.Sprint(values[1]), 10, 64); err == nil {
+		dueAt = time.Unix(score, 0)
+	}
+	return count, dueAt, nil
+}
+
+// Due returns up to limit recipients whose digest is due
+func (q *digestQueue) Due(ctx context.Context, limit int) ([]string, error) {
+	result, err := q.redis.Eval(ctx, dueDigestsScript, []string{digestDueKey}, q.now().Unix(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due digests: %w", err)
+	}
+	return evalStrings(result), nil
+}
+
+// Take removes and returns the recipient's waiting notifications
+func (q *digestQueue) Take(ctx context.Context, email string) ([]digestItem, error) {
+	result, err := q.redis.Eval(ctx, takeDigestScript, []string{digestQueueKey(email), digestDueKey}, email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take digest: %w", err)
+	}
+
+	var items []digestItem
+	for _, data := range evalStrings(result) {
+		var item digestItem
+		if err := json.Unmarshal([]byte(data), &item); err != nil {
+			log.Printf("[DigestQueue] Dropping unreadable digest item of %s: %v", email, err)
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// Requeue puts taken notifications back, to be retried after digestRetryDelay
+func (q *digestQueue) Requeue(ctx context.Context, email string, items []digestItem) error {
+	args := []interface{}{q.now().Add(digestRetryDelay).Unix(), email}
+	for _, item := range items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return fmt.Errorf("failed to encode digest item: %w", err)
+		}
+		args = append(args, string(data))
+	}
+
+	if _, err := q.redis.Eval(ctx, requeueDigestScript, []string{digestQueueKey(email), digestDueKey}, args...); err != nil {
+		return fmt.Errorf("failed to requeue digest: %w", err)
+	}
+	return nil
+}
+
+// evalStrings converts an array reply of a script
+func evalStrings(result interface{}) []string {
+	values, _ := result.([]interface{})
+	strs := make([]string, 0, len(values))
+	for _, value := range values {
+		if s, ok := value.(string); ok {
+			strs = append(strs, s)
+		}
+	}
+	return strs
+}
+
+// normalizeEmail makes preferences and queues case-insensitive
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func digestPreferenceKey(email string) string {
+	return "digest:pref:" + email
+}
+
+func digestQueueKey(email string) string {
+	return "digest:queue:" + email
+}
+
+// DigestService manages digest preferences and sends due digests
+type DigestService interface {
+	GetPreference(ctx context.Context, email string) (*pb.DigestPreference, error)
+	SetPreference(ctx context.Context, email, frequency string) (*pb.DigestPreference, error)
+	// SendDueDigests sends the digests that are due, returns the number sent
+	SendDueDigests(ctx context.Context, batchSize int) (int, error)
+}
+
+// digestService implements DigestService interface
+type digestService struct {
+	queue        *digestQueue
+	resendClient ResendClient
+	fromName     string
+	fromEmail    string
+	testRecipient
+}
+
+// NewDigestService creates new digest service instance
+// Digests are disabled when redisClient or rules is nil
+func NewDigestService(resendClient ResendClient, fromName, fromEmail string, testMode bool, testEmail string, redisClient cache.RedisClient, rules *DigestRules) DigestService {
+	return &digestService{
+		queue:         newDigestQueue(redisClient, rules),
+		resendClient:  resendClient,
+		fromName:      fromName,
+		fromEmail:     fromEmail,
+		testRecipient: testRecipient{testMode: testMode, testEmail: testEmail},
+	}
+}
+
+// GetPreference returns the recipient's digest frequency and waiting notifications
+func (s *digestService) GetPreference(ctx context.Context, email string) (*pb.DigestPreference, error) {
+	email = normalizeEmail(email)
+	if s.queue == nil {
+		return &pb.DigestPreference{Email: email, Frequency: DigestImmediate}, nil
+	}
+
+	frequency, err := s.queue.Frequency(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	return s.preference(ctx, email, frequency)
+}
+
+// SetPreference changes the recipient's digest frequency
+// Returns ErrInvalidDigestFrequency for an unknown frequency, ErrDigestsDisabled without digests
+func (s *digestService) SetPreference(ctx context.Context, email, frequency string) (*pb.DigestPreference, error) {
+	if !validDigestFrequency(frequency) {
+		return nil, ErrInvalidDigestFrequency
+	}
+	if s.queue == nil {
+		return nil, ErrDigestsDisabled
+	}
+
+	email = normalizeEmail(email)
+	if err := s.queue.SetFrequency(ctx, email, frequency); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DigestService] Digest frequency of %s set to %s", email, frequency)
+
+	return s.preference(ctx, email, frequency)
+}
+
+func (s *digestService) preference(ctx context.Context, email, frequency string) (*pb.DigestPreference, error) {
+	pending, dueAt, err := s.queue.Pending(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	preference := &pb.DigestPreference{
+		Email:        email,
+		Frequency:    frequency,
+		DigestTypes:  s.queue.rules.Types,
+		PendingCount: int32(pending),
+	}
+	if pending > 0 && !dueAt.IsZero() {
+		preference.NextDigestAt = dueAt.UTC().Format(time.RFC3339)
+	}
+	return preference, nil
+}
+
+// SendDueDigests sends up to batchSize due digests
+// A digest that fails to send is put back and retried after digestRetryDelay
+func (s *digestService) SendDueDigests(ctx context.Context, batchSize int) (int, error) {
+	if s.queue == nil {
+		return 0, nil
+	}
+
+	recipients, err := s.queue.Due(ctx, batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, email := range recipients {
+		items, err := s.queue.Take(ctx, email)
+		if err != nil {
+			log.Printf("[DigestService] Failed to take digest of %s: %v", email, err)
+			continue
+		}
+		if len(items) == 0 {
+			// Taken by another replica
+			continue
+		}
+
+		if err := s.send(ctx, email, items); err != nil {
+			log.Printf("[DigestService] Failed to send digest to %s, retrying in %s: %v", email, digestRetryDelay, err)
+			if err := s.queue.Requeue(ctx, email, items); err != nil {
+				log.Printf("[DigestService] Lost %d digest items of %s: %v", len(items), email, err)
+			}
+			continue
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+// send emails one digest, weekly when the recipient's current preference is weekly
+func (s *digestService) send(ctx context.Context, email string, items []digestItem) error {
+	frequency, err := s.queue.Frequency(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	data := &template.DigestEmailData{Weekly: frequency == DigestWeekly}
+	for _, item := range items {
+		data.Items = append(data.Items, template.DigestItemData{
+			Label:     digestableTypes[item.Type],
+			EventName: item.EventName,
+			Title:     item.Title,
+			Body:      item.Body,
+			QueuedAt:  item.QueuedAt.In(s.queue.rules.Location).Format("02 Jan 2006 15:04"),
+		})
+	}
+
+	subject := fmt.Sprintf("📬 Ringkasan harian: %d notifikasi", len(items))
+	if data.Weekly {
+		subject = fmt.Sprintf("📬 Ringkasan mingguan: %d notifikasi", len(items))
+	}
+
+	to := s.recipientFor(email)
+
+	emailResp, err := s.resendClient.SendEmail(&client.EmailRequest{
+		From:    fmt.Sprintf("%s <%s>", s.fromName, s.fromEmail),
+		To:      to,
+		Subject: subject,
+		HTML:    template.BuildDigestEmail(data),
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DigestService] ✅ Digest of %d notifications sent to %s, email ID: %s", len(items), email, emailResp.ID)
+	return nil
+}
@@ -3,37 +3,64 @@ package service
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"strings"
+	"time"
 
 	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/notification"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/notification-service/internal/client"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/notification-service/internal/template"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/notification-service/internal/utility"
 )
 
+// ErrNoRecipients is returned when an announcement has no recipients
+var ErrNoRecipients = errors.New("announcement has no recipients")
+
 // EmailService handles email sending logic
 type EmailService interface {
 	SendTicketEmail(ctx context.Context, req *pb.SendTicketEmailRequest) (*pb.SendTicketEmailResponse, error)
+	SendAnnouncementEmail(ctx context.Context, req *pb.SendAnnouncementEmailRequest) (*pb.SendAnnouncementEmailResponse, error)
+	SendSubscriptionDunningEmail(ctx context.Context, req *pb.SendSubscriptionDunningEmailRequest) (*pb.SendSubscriptionDunningEmailResponse, error)
+	SendReservationReminderEmail(ctx context.Context, req *pb.SendReservationReminderEmailRequest) (*pb.SendReservationReminderEmailResponse, error)
+	SendInvitationEmail(ctx context.Context, req *pb.SendInvitationEmailRequest) (*pb.SendInvitationEmailResponse, error)
+	SendWaitlistOfferEmail(ctx context.Context, req *pb.SendWaitlistOfferEmailRequest) (*pb.SendWaitlistOfferEmailResponse, error)
+	SendEventModerationEmail(ctx context.Context, req *pb.SendEventModerationEmailRequest) (*pb.SendEventModerationEmailResponse, error)
+	GenerateTicketPreviewPDF(ctx context.Context, req *pb.GenerateTicketPreviewPDFRequest) (*pb.GenerateTicketPreviewPDFResponse, error)
+}
+
+// ResendClient defines interface for Resend email API communication
+type ResendClient interface {
+	SendEmail(req *client.EmailRequest) (*client.EmailResponse, error)
 }
 
 // emailService implements EmailService interface
 type emailService struct {
-	resendClient *client.ResendClient
+	resendClient ResendClient
 	fromName     string
 	fromEmail    string
-	testMode     bool
-	testEmail    string
+	testRecipient
+	quota      *announcementQuota
+	digests    *digestQueue
+	logoClient *http.Client // Fetches organizer logos of ticket templates
 }
 
 // NewEmailService creates new email service instance
-func NewEmailService(resendClient *client.ResendClient, fromName, fromEmail string, testMode bool, testEmail string) EmailService {
+// redisClient counts announcement quotas across replicas; when nil they are counted in memory
+// Non-urgent notifications wait for the recipient's digest when redisClient and digestRules are set
+func NewEmailService(resendClient ResendClient, fromName, fromEmail string, testMode bool, testEmail string, redisClient cache.RedisClient, digestRules *DigestRules) EmailService {
 	return &emailService{
-		resendClient: resendClient,
-		fromName:     fromName,
-		fromEmail:    fromEmail,
-		testMode:     testMode,
-		testEmail:    testEmail,
+		resendClient:  resendClient,
+		fromName:      fromName,
+		fromEmail:     fromEmail,
+		testRecipient: testRecipient{testMode: testMode, testEmail: testEmail},
+		quota:         newAnnouncementQuota(redisClient),
+		digests:       newDigestQueue(redisClient, digestRules),
+		logoClient:    &http.Client{Timeout: ticketLogoTimeout},
 	}
 }
 
@@ -41,6 +68,12 @@ func NewEmailService(resendClient *client.ResendClient, fromName, fromEmail stri
 func (s *emailService) SendTicketEmail(ctx context.Context, req *pb.SendTicketEmailRequest) (*pb.SendTicketEmailResponse, error) {
 	log.Printf("[EmailService] Preparing ticket email for order: %s, recipient: %s, tickets: %d", req.OrderId, req.RecipientEmail, len(req.Tickets))
 
+	// The organizer's ticket template applies to every ticket, a logo that can't be fetched is left out
+	ticketTemplate, err := s.ticketTemplate(ctx, req.GetTicketTemplate())
+	if err != nil {
+		log.Printf("[EmailService] Rendering tickets of order %s without organizer logo: %v", req.OrderId, err)
+	}
+
 	// Generate PDF for each ticket
 	var attachments []client.EmailAttachment
 	for i, ticket := range req.Tickets {
@@ -51,12 +84,15 @@ func (s *emailService) SendTicketEmail(ctx context.Context, req *pb.SendTicketEm
 			TicketID:       ticket.TicketId,
 			TicketNumber:   fmt.Sprintf("TKT-%s-%03d", req.OrderId[:8], i+1),
 			TierName:       ticket.TierName,
-			Price:          ticket.Price,
+			Price:          money.FromFloat(ticket.Price),
 			QRCodeBase64:   ticket.QrCode,
 			EventName:      req.EventName,
 			EventLocation:  req.EventLocation,
 			EventStartTime: req.EventStartTime,
 			OrderID:        req.OrderId,
+			RefundPolicy:   req.RefundPolicy,
+			IsTest:         req.IsSandbox,
+			Template:       ticketTemplate,
 		}
 
 		// Generate PDF
@@ -89,24 +125,39 @@ func (s *emailService) SendTicketEmail(ctx context.Context, req *pb.SendTicketEm
 		EventName:      req.EventName,
 		EventLocation:  req.EventLocation,
 		EventStartTime: req.EventStartTime,
-		TotalAmount:    req.TotalAmount,
+		TotalAmount:    money.FromFloat(req.TotalAmount),
 		PaymentMethod:  req.PaymentMethod,
 		TicketCount:    len(req.Tickets),
+		Branding: template.Branding{
+			Name:         req.GetBranding().GetBrandName(),
+			LogoURL:      req.GetBranding().GetLogoUrl(),
+			PrimaryColor: req.GetBranding().GetPrimaryColor(),
+			SupportEmail: req.GetBranding().GetSupportEmail(),
+		},
+		AcceptedPolicies:  acceptedPolicies(req.GetAcceptedPolicies()),
+		RefundPolicyTitle: req.RefundPolicyTitle,
+		RefundPolicy:      req.RefundPolicy,
+		IsTest:            req.IsSandbox,
+		IsReissue:         req.IsReissue,
 	})
 
-	// Determine recipient email (use test email if in test mode)
-	recipientEmail := req.RecipientEmail
-	if s.testMode && s.testEmail != "" {
-		log.Printf("[EmailService] 🧪 Test mode enabled - redirecting email from %s to %s", req.RecipientEmail, s.testEmail)
-		recipientEmail = s.testEmail
+	recipientEmail := s.recipientFor(req.RecipientEmail)
+
+	subject := fmt.Sprintf("🎟️ E-Ticket Anda - %s", req.EventName)
+	if req.IsReissue {
+		subject = fmt.Sprintf("🔄 E-Ticket Baru (QR Code Diperbarui) - %s", req.EventName)
+	}
+	if req.IsSandbox {
+		subject = "[TEST] " + subject
 	}
 
 	// Send email via Resend with PDF attachments
 	emailReq := &client.EmailRequest{
-		From:        fmt.Sprintf("%s <%s>", s.fromName, s.fromEmail),
+		From:        s.sender(req.GetBranding()),
 		To:          recipientEmail,
-		Subject:     fmt.Sprintf("🎟️ E-Ticket Anda - %s", req.EventName),
+		Subject:     subject,
 		HTML:        htmlContent,
+		ReplyTo:     req.GetBranding().GetReplyTo(),
 		Attachments: attachments,
 	}
 
@@ -127,3 +178,412 @@ func (s *emailService) SendTicketEmail(ctx context.Context, req *pb.SendTicketEm
 		EmailId: emailResp.ID,
 	}, nil
 }
+
+// SendAnnouncementEmail sends an organizer's announcement to each attendee of an event
+// Recipients are counted against the organizer's monthly quota before anything is sent;
+// returns *QuotaExceededError when they don't fit. Unless the announcement is urgent, recipients
+// who get a digest receive it there; they still count towards the quota
+func (s *emailService) SendAnnouncementEmail(ctx context.Context, req *pb.SendAnnouncementEmailRequest) (*pb.SendAnnouncementEmailResponse, error) {
+	recipients := uniqueEmails(req.RecipientEmails)
+	if len(recipients) == 0 {
+		return nil, ErrNoRecipients
+	}
+
+	log.Printf("[EmailService] Preparing announcement for event: %s, organizer: %s, recipients: %d", req.EventId, req.OrganizerId, len(recipients))
+
+	used, err := s.quota.Reserve(ctx, req.OrganizerId, len(recipients), int(req.MonthlyEmailQuota))
+	if err != nil {
+		return nil, err
+	}
+
+	htmlContent := template.BuildAnnouncementEmail(&template.AnnouncementEmailData{
+		EventName: req.EventName,
+		Subject:   req.Subject,
+		Message:   req.Message,
+		Branding: template.Branding{
+			Name:         req.GetBranding().GetBrandName(),
+			LogoURL:      req.GetBranding().GetLogoUrl(),
+			PrimaryColor: req.GetBranding().GetPrimaryColor(),
+			SupportEmail: req.GetBranding().GetSupportEmail(),
+		},
+	})
+	subject := fmt.Sprintf("📢 %s - %s", req.Subject, req.EventName)
+	from := s.sender(req.GetBranding())
+
+	var sent, failed, digested int
+	for _, recipient := range recipients {
+		if !req.Urgent {
+			queued, err := s.digests.Add(ctx, recipient, digestItem{
+				Type:      NotificationAnnouncement,
+				EventName: req.EventName,
+				Title:     req.Subject,
+				Body:      req.Message,
+			})
+			if err != nil {
+				// Better twice than never, send it right away
+				log.Printf("[EmailService] Failed to queue announcement of event %s for digest of %s: %v", req.EventId, recipient, err)
+			}
+			if queued {
+				digested++
+				continue
+			}
+		}
+
+		to := s.recipientFor(recipient)
+
+		if _, err := s.resendClient.SendEmail(&client.EmailRequest{
+			From:    from,
+			To:      to,
+			Subject: subject,
+			HTML:    htmlContent,
+			ReplyTo: req.GetBranding().GetReplyTo(),
+		}); err != nil {
+			log.Printf("[EmailService] Failed to send announcement of event %s to %s: %v", req.EventId, recipient, err)
+			failed++
+			continue
+		}
+		sent++
+	}
+
+	// Failed emails don't count towards the quota
+	s.quota.Release(ctx, req.OrganizerId, failed)
+
+	log.Printf("[EmailService] ✅ Announcement sent for event %s: %d sent, %d digested, %d failed", req.EventId, sent, digested, failed)
+
+	return &pb.SendAnnouncementEmailResponse{
+		SentCount:     int32(sent),
+		FailedCount:   int32(failed),
+		QuotaUsed:     int32(used - failed),
+		QuotaLimit:    req.MonthlyEmailQuota,
+		DigestedCount: int32(digested),
+	}, nil
+}
+
+// SendSubscriptionDunningEmail tells an organizer that a plan subscription renewal wasn't paid
+func (s *emailService) SendSubscriptionDunningEmail(ctx context.Context, req *pb.SendSubscriptionDunningEmailRequest) (*pb.SendSubscriptionDunningEmailResponse, error) {
+	log.Printf("[EmailService] Preparing dunning email for %s, plan: %s, attempt: %d/%d, canceled: %v",
+		req.RecipientEmail, req.PlanName, req.Attempt, req.MaxAttempts, req.Canceled)
+
+	expiresAt := req.InvoiceExpiresAt
+	if t, err := time.Parse(time.RFC3339, req.InvoiceExpiresAt); err == nil {
+		expiresAt = t.UTC().Format("02 Jan 2006 15:04 UTC")
+	}
+
+	htmlContent := template.BuildDunningEmail(&template.DunningEmailData{
+		RecipientName:    req.RecipientName,
+		PlanName:         req.PlanName,
+		Amount:           money.FromFloat(req.Amount),
+		InvoiceURL:       req.InvoiceUrl,
+		InvoiceExpiresAt: expiresAt,
+		Attempt:          int(req.Attempt),
+		MaxAttempts:      int(req.MaxAttempts),
+		Canceled:         req.Canceled,
+	})
+
+	subject := fmt.Sprintf("⚠️ Pembayaran paket %s gagal", req.PlanName)
+	if req.Canceled {
+		subject = fmt.Sprintf("❌ Langganan paket %s dihentikan", req.PlanName)
+	}
+
+	to := s.recipientFor(req.RecipientEmail)
+
+	emailResp, err := s.resendClient.SendEmail(&client.EmailRequest{
+		From:    fmt.Sprintf("%s <%s>", s.fromName, s.fromEmail),
+		To:      to,
+		Subject: subject,
+		HTML:    htmlContent,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send dunning email: %w", err)
+	}
+
+	log.Printf("[EmailService] ✅ Dunning email sent to %s, email ID: %s", req.RecipientEmail, emailResp.ID)
+
+	return &pb.SendSubscriptionDunningEmailResponse{
+		Success: true,
+		Message: "Dunning email sent successfully",
+	}, nil
+}
+
+// SendReservationReminderEmail reminds a buyer to pay a reservation before its tickets are released
+func (s *emailService) SendReservationReminderEmail(ctx context.Context, req *pb.SendReservationReminderEmailRequest) (*pb.SendReservationReminderEmailResponse, error) {
+	log.Printf("[EmailService] Preparing reservation reminder for order: %s, recipient: %s", req.OrderId, req.RecipientEmail)
+
+	// Rounded up so the reminder never promises less time than is left
+	expiresAt := req.ExpiresAt
+	minutesLeft := 0
+	if t, err := time.Parse(time.RFC3339, req.ExpiresAt); err == nil {
+		expiresAt = t.UTC().Format("02 Jan 2006 15:04 UTC")
+		if left := time.Until(t); left > 0 {
+			minutesLeft = int((left + time.Minute - 1) / time.Minute)
+		}
+	}
+
+	htmlContent := template.BuildReminderEmail(&template.ReminderEmailData{
+		RecipientName: req.RecipientName,
+		EventName:     req.EventName,
+		OrderID:       req.OrderId,
+		GrandTotal:    money.FromFloat(req.GrandTotal),
+		PaymentURL:    req.PaymentUrl,
+		ExpiresAt:     expiresAt,
+		MinutesLeft:   minutesLeft,
+		Branding: template.Branding{
+			Name:         req.GetBranding().GetBrandName(),
+			LogoURL:      req.GetBranding().GetLogoUrl(),
+			PrimaryColor: req.GetBranding().GetPrimaryColor(),
+			SupportEmail: req.GetBranding().GetSupportEmail(),
+		},
+	})
+
+	to := s.recipientFor(req.RecipientEmail)
+
+	emailResp, err := s.resendClient.SendEmail(&client.EmailRequest{
+		From:    s.sender(req.GetBranding()),
+		To:      to,
+		Subject: fmt.Sprintf("⏰ Selesaikan pembayaran tiket %s dalam %d menit", req.EventName, minutesLeft),
+		HTML:    htmlContent,
+		ReplyTo: req.GetBranding().GetReplyTo(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send reservation reminder email: %w", err)
+	}
+
+	log.Printf("[EmailService] ✅ Reservation reminder sent for order %s, email ID: %s", req.OrderId, emailResp.ID)
+
+	return &pb.SendReservationReminderEmailResponse{
+		Success: true,
+		Message: "Reservation reminder email sent successfully",
+	}, nil
+}
+
+// SendInvitationEmail sends an invitee the personal link to claim their event invitation
+func (s *emailService) SendInvitationEmail(ctx context.Context, req *pb.SendInvitationEmailRequest) (*pb.SendInvitationEmailResponse, error) {
+	log.Printf("[EmailService] Preparing invitation email for invitation: %s, recipient: %s", req.InvitationId, req.RecipientEmail)
+
+	claimDeadline := req.ClaimDeadline
+	if t, err := time.Parse(time.RFC3339, req.ClaimDeadline); err == nil {
+		claimDeadline = t.UTC().Format("02 Jan 2006 15:04 UTC")
+	}
+
+	recipientName := req.RecipientName
+	if recipientName == "" {
+		recipientName = req.RecipientEmail
+	}
+
+	htmlContent := template.BuildInvitationEmail(&template.InvitationEmailData{
+		RecipientName:  recipientName,
+		EventName:      req.EventName,
+		EventLocation:  req.EventLocation,
+		EventStartTime: req.EventStartTime,
+		TierName:       req.TierName,
+		Quantity:       int(req.Quantity),
+		Price:          money.FromFloat(req.Price),
+		ClaimURL:       req.ClaimUrl,
+		ClaimDeadline:  claimDeadline,
+		Branding: template.Branding{
+			Name:         req.GetBranding().GetBrandName(),
+			LogoURL:      req.GetBranding().GetLogoUrl(),
+			PrimaryColor: req.GetBranding().GetPrimaryColor(),
+			SupportEmail: req.GetBranding().GetSupportEmail(),
+		},
+	})
+
+	to := s.recipientFor(req.RecipientEmail)
+
+	emailResp, err := s.resendClient.SendEmail(&client.EmailRequest{
+		From:    s.sender(req.GetBranding()),
+		To:      to,
+		Subject: fmt.Sprintf("💌 Undangan: %s", req.EventName),
+		HTML:    htmlContent,
+		ReplyTo: req.GetBranding().GetReplyTo(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send invitation email: %w", err)
+	}
+
+	log.Printf("[EmailService] ✅ Invitation email sent for invitation %s, email ID: %s", req.InvitationId, emailResp.ID)
+
+	return &pb.SendInvitationEmailResponse{
+		Success: true,
+		Message: "Invitation email sent successfully",
+	}, nil
+}
+
+// SendWaitlistOfferEmail tells a waitlisted buyer that seats of a sold-out tier are held for them
+// The offer is time-limited, so it is always sent right away
+func (s *emailService) SendWaitlistOfferEmail(ctx context.Context, req *pb.SendWaitlistOfferEmailRequest) (*pb.SendWaitlistOfferEmailResponse, error) {
+	log.Printf("[EmailService] Preparing waitlist offer email for waitlist entry: %s, recipient: %s", req.EntryId, req.RecipientEmail)
+
+	offerExpiresAt := req.OfferExpiresAt
+	if t, err := time.Parse(time.RFC3339, req.OfferExpiresAt); err == nil {
+		offerExpiresAt = t.UTC().Format("02 Jan 2006 15:04 UTC")
+	}
+
+	recipientName := req.RecipientName
+	if recipientName == "" {
+		recipientName = req.RecipientEmail
+	}
+
+	htmlContent := template.BuildWaitlistOfferEmail(&template.WaitlistOfferEmailData{
+		RecipientName:  recipientName,
+		EventName:      req.EventName,
+		EventLocation:  req.EventLocation,
+		EventStartTime: req.EventStartTime,
+		TierName:       req.TierName,
+		Quantity:       int(req.Quantity),
+		Price:          money.FromFloat(req.Price),
+		PurchaseURL:    req.PurchaseUrl,
+		OfferExpiresAt: offerExpiresAt,
+		Branding: template.Branding{
+			Name:         req.GetBranding().GetBrandName(),
+			LogoURL:      req.GetBranding().GetLogoUrl(),
+			PrimaryColor: req.GetBranding().GetPrimaryColor(),
+			SupportEmail: req.GetBranding().GetSupportEmail(),
+		},
+	})
+
+	to := s.recipientFor(req.RecipientEmail)
+
+	emailResp, err := s.resendClient.SendEmail(&client.EmailRequest{
+		From:    s.sender(req.GetBranding()),
+		To:      to,
+		Subject: fmt.Sprintf("🎟️ Tiket tersedia untuk Anda: %s", req.EventName),
+		HTML:    htmlContent,
+		ReplyTo: req.GetBranding().GetReplyTo(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send waitlist offer email: %w", err)
+	}
+
+	log.Printf("[EmailService] ✅ Waitlist offer email sent for waitlist entry %s, email ID: %s", req.EntryId, emailResp.ID)
+
+	return &pb.SendWaitlistOfferEmailResponse{
+		Success: true,
+		Message: "Waitlist offer email sent successfully",
+	}, nil
+}
+
+// SendEventModerationEmail tells an organizer that their reported event was unpublished, restored or removed
+// Waits for the organizer's digest when moderation notifications are configured as non-urgent
+func (s *emailService) SendEventModerationEmail(ctx context.Context, req *pb.SendEventModerationEmailRequest) (*pb.SendEventModerationEmailResponse, error) {
+	log.Printf("[EmailService] Preparing moderation email for event: %s, action: %s, recipient: %s", req.EventId, req.Action, req.RecipientEmail)
+
+	subject := fmt.Sprintf("⚠️ Event %s sedang ditinjau", req.EventName)
+	summary := "Event Anda untuk sementara disembunyikan dari publik sampai peninjauan laporan pengguna selesai."
+	switch req.Action {
+	case template.ModerationActionRestored:
+		subject = fmt.Sprintf("✅ Event %s dipublikasikan kembali", req.EventName)
+		summary = "Event Anda kembali tampil dan tiket dapat dibeli seperti biasa."
+	case template.ModerationActionRemoved:
+		subject = fmt.Sprintf("❌ Event %s diturunkan", req.EventName)
+		summary = "Event Anda dinyatakan melanggar ketentuan platform dan tidak dapat dipublikasikan kembali."
+	}
+	if req.Note != "" {
+		summary += "\nCatatan moderator: " + req.Note
+	}
+
+	queued, err := s.digests.Add(ctx, req.RecipientEmail, digestItem{
+		Type:      NotificationEventModeration,
+		EventName: req.EventName,
+		Title:     subject,
+		Body:      summary,
+	})
+	if err != nil {
+		log.Printf("[EmailService] Failed to queue moderation email of event %s for digest: %v", req.EventId, err)
+	}
+	if queued {
+		log.Printf("[EmailService] ✅ Moderation email of event %s queued for digest of %s", req.EventId, req.RecipientEmail)
+		return &pb.SendEventModerationEmailResponse{
+			Success:  true,
+			Message:  "Moderation email queued for digest",
+			Digested: true,
+		}, nil
+	}
+
+	htmlContent := template.BuildModerationEmail(&template.ModerationEmailData{
+		RecipientName: req.RecipientName,
+		EventName:     req.EventName,
+		Action:        req.Action,
+		ReportCount:   int(req.ReportCount),
+		Reasons:       req.Reasons,
+		Note:          req.Note,
+	})
+
+	to := s.recipientFor(req.RecipientEmail)
+
+	emailResp, err := s.resendClient.SendEmail(&client.EmailRequest{
+		From:    fmt.Sprintf("%s <%s>", s.fromName, s.fromEmail),
+		To:      to,
+		Subject: subject,
+		HTML:    htmlContent,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to send moderation email: %w", err)
+	}
+
+	log.Printf("[EmailService] ✅ Moderation email sent for event %s, email ID: %s", req.EventId, emailResp.ID)
+
+	return &pb.SendEventModerationEmailResponse{
+		Success: true,
+		Message: "Moderation email sent successfully",
+	}, nil
+}
+
+// acceptedPolicies converts the policy versions accepted with an order for the receipt
+func acceptedPolicies(policies []*pb.AcceptedPolicy) []template.AcceptedPolicyData {
+	data := make([]template.AcceptedPolicyData, len(policies))
+	for i, policy := range policies {
+		data[i] = template.AcceptedPolicyData{
+			Title:       policy.GetTitle(),
+			VersionHash: policy.GetVersionHash(),
+			AcceptedAt:  policy.GetAcceptedAt(),
+		}
+	}
+	return data
+}
+
+// uniqueEmails drops blank and duplicate addresses, comparing case-insensitively
+func uniqueEmails(emails []string) []string {
+	seen := make(map[string]bool, len(emails))
+	unique := make([]string, 0, len(emails))
+	for _, email := range emails {
+		email = strings.TrimSpace(email)
+		key := strings.ToLower(email)
+		if email == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, email)
+	}
+	return unique
+}
+
+// testRecipient redirects every email to the test address in test mode
+type testRecipient struct {
+	testMode  bool
+	testEmail string
+}
+
+// recipientFor returns the address an email to email is sent to, the test address in test mode
+func (r testRecipient) recipientFor(email string) string {
+	if r.testMode && r.testEmail != "" {
+		log.Printf("[EmailService] 🧪 Test mode enabled - redirecting email from %s to %s", email, r.testEmail)
+		return r.testEmail
+	}
+	return email
+}
+
+// sender returns the From header, using the organizer's or tenant's sender when it has one
+// The from address must be on a domain verified with the email provider
+func (s *emailService) sender(branding *pb.EmailBranding) string {
+	fromName, fromEmail := s.fromName, s.fromEmail
+	if branding.GetFromEmail() != "" {
+		fromEmail = branding.GetFromEmail()
+	}
+	if branding.GetFromName() != "" {
+		fromName = branding.GetFromName()
+	} else if branding.GetBrandName() != "" {
+		fromName = branding.GetBrandName()
+	}
+	return fmt.Sprintf("%s <%s>", fromName, fromEmail)
+}
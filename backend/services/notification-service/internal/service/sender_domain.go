@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/notification"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/notification-service/internal/client"
+)
+
+// ErrSenderDomainNotFound is returned for a domain the email provider doesn't know
+var ErrSenderDomainNotFound = errors.New("sender domain not found")
+
+// DomainClient manages sending domains with the email provider
+type DomainClient interface {
+	CreateDomain(name string) (*client.Domain, error)
+	GetDomain(id string) (*client.Domain, error)
+	VerifyDomain(id string) error
+}
+
+// SenderDomainService registers and verifies the sending domains of white-label organizers
+// Emails only use an organizer's domain once the provider verified it, see emailService.sender
+type SenderDomainService interface {
+	Register(ctx context.Context, domain string) (*pb.SenderDomain, error)
+	Verify(ctx context.Context, domainID string) (*pb.SenderDomain, error)
+}
+
+// senderDomainService implements SenderDomainService interface
+type senderDomainService struct {
+	domainClient DomainClient
+}
+
+// NewSenderDomainService creates new sender domain service instance
+func NewSenderDomainService(domainClient DomainClient) SenderDomainService {
+	return &senderDomainService{domainClient: domainClient}
+}
+
+// Register adds a sending domain, returning the DNS records its owner must publish
+func (s *senderDomainService) Register(ctx context.Context, domain string) (*pb.SenderDomain, error) {
+	created, err := s.domainClient.CreateDomain(domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register sender domain: %w", err)
+	}
+	return senderDomainProto(created), nil
+}
+
+// Verify asks the provider to check the domain's DNS records and returns its current status
+// The check is asynchronous, so a domain usually stays pending for a few minutes
+func (s *senderDomainService) Verify(ctx context.Context, domainID string) (*pb.SenderDomain, error) {
+	if err := s.domainClient.VerifyDomain(domainID); err != nil {
+		if errors.Is(err, client.ErrDomainNotFound) {
+			return nil, ErrSenderDomainNotFound
+		}
+		return nil, fmt.Errorf("failed to verify sender domain: %w", err)
+	}
+
+	domain, err := s.domainClient.GetDomain(domainID)
+	if err != nil {
+		if errors.Is(err, client.ErrDomainNotFound) {
+			return nil, ErrSenderDomainNotFound
+		}
+		return nil, fmt.Errorf("failed to get sender domain: %w", err)
+	}
+	return senderDomainProto(domain), nil
+}
+
+// senderDomainProto converts a provider domain to its gRPC representation
+func senderDomainProto(domain *client.Domain) *pb.SenderDomain {
+	records := make([]*pb.DnsRecord, len(domain.Records))
+	for i, record := range domain.Records {
+		records[i] = &pb.DnsRecord{
+			Record:   record.Record,
+			Name:     record.Name,
+			Type:     record.Type,
+			Value:    record.Value,
+			Ttl:      record.TTL,
+			Priority: int32(record.Priority),
+			Status:   record.Status,
+		}
+	}
+	return &pb.SenderDomain{
+		Id:      domain.ID,
+		Name:    domain.Name,
+		Status:  domain.Status,
+		Records: records,
+	}
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/notification"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/notification-service/internal/utility"
+)
+
+// Organizer logos of ticket templates are fetched once per email
+const (
+	ticketLogoMaxBytes = 1 << 20 // 1 MB
+	ticketLogoTimeout  = 5 * time.Second
+)
+
+// ErrTicketLogoUnavailable is returned when a ticket template's logo can't be fetched or embedded
+var ErrTicketLogoUnavailable = errors.New("ticket template logo can't be used")
+
+// ticketTemplate converts an organizer's ticket template, fetching its logo
+// The template is returned without the logo, along with the reason, when the logo can't be fetched
+func (s *emailService) ticketTemplate(ctx context.Context, t *pb.TicketTemplate) (*utility.TicketTemplate, error) {
+	if t == nil {
+		return nil, nil
+	}
+
+	tpl := &utility.TicketTemplate{
+		PrimaryColor:  t.PrimaryColor,
+		AccentColor:   t.AccentColor,
+		SponsorFooter: t.SponsorFooter,
+		TermsText:     t.TermsText,
+	}
+	if t.LogoUrl == "" {
+		return tpl, nil
+	}
+
+	logo, logoType, err := utility.FetchLogo(ctx, s.logoClient, t.LogoUrl, ticketLogoMaxBytes)
+	if err != nil {
+		return tpl, fmt.Errorf("%w: %v", ErrTicketLogoUnavailable, err)
+	}
+	tpl.Logo = logo
+	tpl.LogoType = logoType
+	return tpl, nil
+}
+
+// GenerateTicketPreviewPDF renders a sample e-ticket with an organizer's ticket template
+// Unlike ticket emails, which leave out a logo that can't be fetched, the preview fails with
+// ErrTicketLogoUnavailable so the organizer can fix the URL
+func (s *emailService) GenerateTicketPreviewPDF(ctx context.Context, req *pb.GenerateTicketPreviewPDFRequest) (*pb.GenerateTicketPreviewPDFResponse, error) {
+	tpl, err := s.ticketTemplate(ctx, req.GetTemplate())
+	if err != nil {
+		return nil, err
+	}
+
+	ticket, err := utility.SampleTicketPDFData(req.EventName, tpl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sample ticket: %w", err)
+	}
+
+	pdf, err := utility.GenerateTicketPDF(ticket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ticket PDF: %w", err)
+	}
+
+	log.Printf("[EmailService] Ticket template preview generated (%d KB)", len(pdf)/1024)
+
+	return &pb.GenerateTicketPreviewPDFResponse{Pdf: pdf}, nil
+}
@@ -0,0 +1,113 @@
+package template
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// AnnouncementEmailData represents data for organizer announcement email template
+type AnnouncementEmailData struct {
+	EventName string
+	Subject   string
+	Message   string // Plain text written by the organizer
+	Branding  Branding
+}
+
+// BuildAnnouncementEmail builds HTML email for an organizer's announcement to event attendees
+// The message is escaped so organizers can't inject markup; line breaks are kept
+func BuildAnnouncementEmail(data *AnnouncementEmailData) string {
+	message := strings.ReplaceAll(html.EscapeString(data.Message), "\n", "<br>")
+
+	return data.Branding.Apply(fmt.Sprintf(`
+<!DOCTYPE html>
+<html lang="id">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>%s</title>
+    <style>
+        body {
+            font-family: 'Segoe UI', Tahoma, Geneva, Verdana, sans-serif;
+            background-color: #f4f4f4;
+            margin: 0;
+            padding: 20px;
+        }
+        .container {
+            max-width: 600px;
+            margin: 0 auto;
+            background-color: #ffffff;
+            border-radius: 8px;
+            overflow: hidden;
+            box-shadow: 0 2px 8px rgba(0,0,0,0.1);
+        }
+        .header {
+            background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%);
+            color: white;
+            padding: 30px 20px;
+            text-align: center;
+        }
+        .header h1 {
+            margin: 0;
+            font-size: 28px;
+        }
+        .content {
+            padding: 30px 20px;
+        }
+        .event-name {
+            color: #667eea;
+            font-size: 14px;
+            font-weight: bold;
+            text-transform: uppercase;
+            margin-bottom: 10px;
+        }
+        .subject {
+            font-size: 22px;
+            color: #333;
+            margin: 0 0 20px 0;
+        }
+        .message {
+            color: #555;
+            line-height: 1.6;
+        }
+        .footer {
+            background-color: #f8f9fa;
+            padding: 20px;
+            text-align: center;
+            color: #666;
+            font-size: 14px;
+        }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>📢 Pengumuman Event</h1>
+        </div>
+
+        <div class="content">
+            <div class="event-name">%s</div>
+            <h2 class="subject">%s</h2>
+            <div class="message">%s</div>
+
+            <p style="color: #666; font-size: 14px; margin-top: 20px;">
+                Anda menerima email ini karena memiliki tiket untuk event ini. Jika ada pertanyaan, silakan hubungi customer service kami.
+            </p>
+        </div>
+
+        <div class="footer">
+            <p>Event Ticketing Platform</p>
+            <p style="font-size: 12px; margin-top: 10px;">
+                Email ini dikirim secara otomatis, mohon tidak membalas email ini.
+            </p>
+        </div>
+    </div>
+</body>
+</html>
+	`,
+		html.EscapeString(data.Subject),
+		html.EscapeString(data.EventName),
+		html.EscapeString(data.Subject),
+		message,
+	))
+}
@@ -0,0 +1,59 @@
+package template
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// Platform defaults used by the templates, replaced by tenant branding
+const (
+	defaultBrandName    = "Event Ticketing Platform"
+	defaultPrimaryColor = "#667eea"
+	defaultHeaderStyle  = "background: linear-gradient(135deg, #667eea 0%, #764ba2 100%);"
+	headerTitle         = "<h1>🎟️ E-Ticket Anda</h1>"
+	announcementTitle   = "<h1>📢 Pengumuman Event</h1>"
+	reminderTitle       = "<h1>⏰ Segera Selesaikan Pembayaran</h1>"
+	supportSentence     = "silakan hubungi customer service kami."
+)
+
+// hexColor limits brand colours to CSS hex values so they can't break out of the stylesheet
+var hexColor = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// Branding represents a tenant's white-label email branding
+// Empty fields keep the platform defaults
+type Branding struct {
+	Name         string
+	LogoURL      string
+	PrimaryColor string
+	SupportEmail string
+}
+
+// Apply swaps the platform name, colour, logo and support contact in a rendered email for the tenant's
+func (b Branding) Apply(content string) string {
+	if name := strings.TrimSpace(b.Name); name != "" {
+		content = strings.ReplaceAll(content, defaultBrandName, html.EscapeString(name))
+	}
+
+	if hexColor.MatchString(b.PrimaryColor) {
+		content = strings.ReplaceAll(content, defaultHeaderStyle, fmt.Sprintf("background: %s;", b.PrimaryColor))
+		content = strings.ReplaceAll(content, defaultPrimaryColor, b.PrimaryColor)
+	}
+
+	if strings.HasPrefix(b.LogoURL, "https://") {
+		logo := fmt.Sprintf(`<img src="%s" alt="%s" style="max-height: 48px; margin-bottom: 10px;"><br>`,
+			html.EscapeString(b.LogoURL), html.EscapeString(b.Name))
+		for _, title := range []string{headerTitle, announcementTitle, reminderTitle} {
+			content = strings.Replace(content, title, logo+title, 1)
+		}
+	}
+
+	if email := strings.TrimSpace(b.SupportEmail); email != "" {
+		escaped := html.EscapeString(email)
+		content = strings.ReplaceAll(content, supportSentence,
+			fmt.Sprintf(`silakan hubungi customer service kami di <a href="mailto:%s">%s</a>.`, escaped, escaped))
+	}
+
+	return content
+}
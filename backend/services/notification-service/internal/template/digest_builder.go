@@ -0,0 +1,140 @@
+package template
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// DigestItemData represents one notification collected in a digest
+type DigestItemData struct {
+	Label     string // Notification type, e.g. Pengumuman
+	EventName string
+	Title     string
+	Body      string // Plain text, line breaks are kept
+	QueuedAt  string
+}
+
+// DigestEmailData represents data for notification digest email template
+type DigestEmailData struct {
+	Weekly bool
+	Items  []DigestItemData
+}
+
+// BuildDigestEmail builds HTML email collecting a recipient's non-urgent notifications
+// Items come from different organizers, so tenant branding isn't applied
+func BuildDigestEmail(data *DigestEmailData) string {
+	title := "📬 Ringkasan Notifikasi Harian"
+	period := "sejak ringkasan kemarin"
+	if data.Weekly {
+		title = "📬 Ringkasan Notifikasi Mingguan"
+		period = "selama seminggu terakhir"
+	}
+
+	var items strings.Builder
+	for _, item := range data.Items {
+		fmt.Fprintf(&items, `
+            <div class="item">
+                <div class="item-meta">%s · %s · %s</div>
+                <h3>%s</h3>
+                <p>%s</p>
+            </div>`,
+			html.EscapeString(item.Label),
+			html.EscapeString(item.EventName),
+			html.EscapeString(item.QueuedAt),
+			html.EscapeString(item.Title),
+			strings.ReplaceAll(html.EscapeString(item.Body), "\n", "<br>"),
+		)
+	}
+
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html lang="id">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>%s</title>
+    <style>
+        body {
+            font-family: 'Segoe UI', Tahoma, Geneva, Verdana, sans-serif;
+            background-color: #f4f4f4;
+            margin: 0;
+            padding: 20px;
+        }
+        .container {
+            max-width: 600px;
+            margin: 0 auto;
+            background-color: #ffffff;
+            border-radius: 8px;
+            overflow: hidden;
+            box-shadow: 0 2px 8px rgba(0,0,0,0.1);
+        }
+        .header {
+            background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%);
+            color: white;
+            padding: 30px 20px;
+            text-align: center;
+        }
+        .header h1 {
+            margin: 0;
+            font-size: 26px;
+        }
+        .content {
+            padding: 30px 20px;
+            color: #555;
+            line-height: 1.6;
+        }
+        .item {
+            border-left: 4px solid #667eea;
+            padding: 10px 15px;
+            margin-bottom: 20px;
+        }
+        .item h3 {
+            margin: 5px 0;
+            color: #333;
+        }
+        .item-meta {
+            color: #667eea;
+            font-size: 13px;
+            font-weight: bold;
+        }
+        .footer {
+            background-color: #f8f9fa;
+            padding: 20px;
+            text-align: center;
+            color: #666;
+            font-size: 14px;
+        }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>%s</h1>
+        </div>
+
+        <div class="content">
+            <p>Berikut %d notifikasi untuk Anda %s.</p>
+            %s
+            <p style="font-size: 14px; margin-top: 20px;">
+                E-ticket, pengingat pembayaran, dan undangan tetap dikirim langsung. Frekuensi ringkasan dapat diubah di pengaturan notifikasi akun Anda.
+            </p>
+        </div>
+
+        <div class="footer">
+            <p>Event Ticketing Platform</p>
+            <p style="font-size: 12px; margin-top: 10px;">
+                Email ini dikirim secara otomatis, mohon tidak membalas email ini.
+            </p>
+        </div>
+    </div>
+</body>
+</html>
+	`,
+		title,
+		title,
+		len(data.Items),
+		period,
+		items.String(),
+	)
+}
@@ -0,0 +1,134 @@
+package template
+
+import (
+	"fmt"
+	"html"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// DunningEmailData represents data for failed plan subscription renewal email template
+type DunningEmailData struct {
+	RecipientName    string
+	PlanName         string
+	Amount           money.Money
+	InvoiceURL       string
+	InvoiceExpiresAt string // Already formatted for display
+	Attempt          int
+	MaxAttempts      int
+	Canceled         bool // Final notice, the organizer is back on the free plan
+}
+
+// BuildDunningEmail builds HTML email telling an organizer that their plan renewal wasn't paid
+// Billing is between the organizer and the platform, so tenant branding isn't applied
+func BuildDunningEmail(data *DunningEmailData) string {
+	title := "⚠️ Pembayaran Langganan Gagal"
+	body := fmt.Sprintf(`
+            <p>Pembayaran perpanjangan paket <strong>%s</strong> sebesar <strong>Rp %s</strong> belum kami terima (percobaan %d dari %d).</p>
+            <p>Paket Anda tetap aktif sementara. Silakan selesaikan pembayaran sebelum <strong>%s</strong> agar paket tidak dihentikan.</p>
+            <p style="text-align: center; margin: 30px 0;">
+                <a class="button" href="%s">Bayar Sekarang</a>
+            </p>`,
+		html.EscapeString(data.PlanName),
+		formatCurrency(data.Amount),
+		data.Attempt,
+		data.MaxAttempts,
+		html.EscapeString(data.InvoiceExpiresAt),
+		html.EscapeString(data.InvoiceURL),
+	)
+
+	if data.Canceled {
+		title = "❌ Langganan Dihentikan"
+		body = fmt.Sprintf(`
+            <p>Setelah %d kali percobaan, pembayaran perpanjangan paket <strong>%s</strong> tidak berhasil sehingga langganan Anda dihentikan.</p>
+            <p>Akun Anda kini menggunakan paket Free. Event dan tiket yang sudah ada tetap tersimpan, namun batas paket Free berlaku untuk event dan tiket baru.</p>
+            <p>Anda dapat berlangganan kembali kapan saja dari dashboard organizer.</p>`,
+			data.MaxAttempts,
+			html.EscapeString(data.PlanName),
+		)
+	}
+
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html lang="id">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>%s</title>
+    <style>
+        body {
+            font-family: 'Segoe UI', Tahoma, Geneva, Verdana, sans-serif;
+            background-color: #f4f4f4;
+            margin: 0;
+            padding: 20px;
+        }
+        .container {
+            max-width: 600px;
+            margin: 0 auto;
+            background-color: #ffffff;
+            border-radius: 8px;
+            overflow: hidden;
+            box-shadow: 0 2px 8px rgba(0,0,0,0.1);
+        }
+        .header {
+            background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%);
+            color: white;
+            padding: 30px 20px;
+            text-align: center;
+        }
+        .header h1 {
+            margin: 0;
+            font-size: 26px;
+        }
+        .content {
+            padding: 30px 20px;
+            color: #555;
+            line-height: 1.6;
+        }
+        .button {
+            background-color: #667eea;
+            color: #ffffff;
+            padding: 12px 28px;
+            border-radius: 6px;
+            text-decoration: none;
+            font-weight: bold;
+        }
+        .footer {
+            background-color: #f8f9fa;
+            padding: 20px;
+            text-align: center;
+            color: #666;
+            font-size: 14px;
+        }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>%s</h1>
+        </div>
+
+        <div class="content">
+            <p>Halo %s,</p>
+            %s
+            <p style="font-size: 14px; margin-top: 20px;">
+                Jika Anda merasa sudah membayar, abaikan email ini atau hubungi customer service kami.
+            </p>
+        </div>
+
+        <div class="footer">
+            <p>Event Ticketing Platform</p>
+            <p style="font-size: 12px; margin-top: 10px;">
+                Email ini dikirim secara otomatis, mohon tidak membalas email ini.
+            </p>
+        </div>
+    </div>
+</body>
+</html>
+	`,
+		title,
+		title,
+		html.EscapeString(data.RecipientName),
+		body,
+	)
+}
@@ -2,6 +2,11 @@ package template
 
 import (
 	"fmt"
+	"html"
+	"strconv"
+	"strings"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
 )
 
 // TicketEmailData represents data for ticket email template
@@ -11,17 +16,34 @@ type TicketEmailData struct {
 	EventName      string
 	EventLocation  string
 	EventStartTime string
-	TotalAmount    float64
+	TotalAmount    money.Money
 	PaymentMethod  string
 	Tickets        []TicketData
 	TicketCount    int
+	Branding       Branding
+	// Policy versions accepted with the order, listed on the receipt for dispute defense
+	AcceptedPolicies []AcceptedPolicyData
+	// Refund policy accepted with the order, rendered in full; empty if the event has none
+	RefundPolicyTitle string
+	RefundPolicy      string
+	// Test order of a sandbox event: a TEST notice is shown, the tickets are not valid for entry
+	IsTest bool
+	// Tickets reissued with new QR codes: a notice says the codes sent before no longer work
+	IsReissue bool
+}
+
+// AcceptedPolicyData represents a policy version accepted with the order
+type AcceptedPolicyData struct {
+	Title       string
+	VersionHash string
+	AcceptedAt  string
 }
 
 // TicketData represents individual ticket data
 type TicketData struct {
 	TicketID   string
 	TierName   string
-	Price      float64
+	Price      money.Money
 	QRCodeBase64 string
 }
 
@@ -32,7 +54,7 @@ func BuildTicketEmail(data *TicketEmailData) string {
 		ticketsHTML += buildTicketCard(ticket)
 	}
 
-	return fmt.Sprintf(`
+	return data.Branding.Apply(fmt.Sprintf(`
 <!DOCTYPE html>
 <html lang="id">
 <head>
@@ -262,7 +284,7 @@ func BuildTicketEmail(data *TicketEmailData) string {
 		data.OrderID,
 		data.PaymentMethod,
 		formatCurrency(data.TotalAmount),
-	)
+	))
 }
 
 func buildTicketCard(ticket TicketData) string {
@@ -292,7 +314,7 @@ func BuildTicketEmailWithPDF(data *TicketEmailData) string {
 		ticketWord = "tiket"
 	}
 
-	return fmt.Sprintf(`
+	return data.Branding.Apply(fmt.Sprintf(`
 <!DOCTYPE html>
 <html lang="id">
 <head>
@@ -437,7 +459,7 @@ func BuildTicketEmailWithPDF(data *TicketEmailData) string {
             <div class="greeting">
                 Halo <strong>%s</strong>! 👋
             </div>
-
+%s
             <p>Terima kasih atas pembelian tiket Anda. Pembayaran telah berhasil dikonfirmasi!</p>
 
             <div class="event-info">
@@ -481,7 +503,7 @@ func BuildTicketEmailWithPDF(data *TicketEmailData) string {
                     <span>Rp %s</span>
                 </div>
             </div>
-
+%s%s
             <div class="instructions">
                 <h3>📋 Instruksi Penting</h3>
                 <ul>
@@ -510,6 +532,7 @@ func BuildTicketEmailWithPDF(data *TicketEmailData) string {
 </html>
 	`,
 		data.RecipientName,
+		buildTestNotice(data.IsTest)+buildReissueNotice(data.IsReissue),
 		data.EventName,
 		data.EventLocation,
 		data.EventStartTime,
@@ -520,12 +543,78 @@ func BuildTicketEmailWithPDF(data *TicketEmailData) string {
 		ticketWord,
 		data.PaymentMethod,
 		formatCurrency(data.TotalAmount),
-	)
+		buildAcceptedPolicies(data.AcceptedPolicies),
+		buildRefundPolicy(data.RefundPolicyTitle, data.RefundPolicy),
+	))
+}
+
+// buildAcceptedPolicies lists the policy versions accepted with the order, empty without any
+func buildAcceptedPolicies(policies []AcceptedPolicyData) string {
+	if len(policies) == 0 {
+		return ""
+	}
+
+	rows := ""
+	for _, policy := range policies {
+		rows += fmt.Sprintf(`
+                <div class="summary-row">
+                    <span>%s</span>
+                    <span style="font-family: 'Courier New', monospace;">v%s</span>
+                </div>`, html.EscapeString(policy.Title), policy.VersionHash[:min(12, len(policy.VersionHash))])
+	}
+
+	return fmt.Sprintf(`
+            <div class="order-summary">
+                <p style="margin: 0 0 10px 0;"><strong>Kebijakan yang Anda setujui</strong> (%s):</p>%s
+            </div>
+`, html.EscapeString(policies[0].AcceptedAt), rows)
+}
+
+// buildTestNotice marks the email of a sandbox test order, empty for real orders
+func buildTestNotice(isTest bool) string {
+	if !isTest {
+		return ""
+	}
+
+	return `
+            <div class="instructions" style="background-color: #f8d7da; border-left-color: #dc3545;">
+                <h3 style="color: #721c24;">🧪 TEST - Bukan Tiket Asli</h3>
+                <p style="margin: 0; color: #721c24;">Pesanan ini dibuat saat uji coba (sandbox) event dengan pembayaran test. E-ticket terlampir bertanda TEST dan tidak berlaku untuk masuk event.</p>
+            </div>
+`
+}
+
+// buildReissueNotice explains an email of reissued tickets, empty for the purchase email
+func buildReissueNotice(isReissue bool) string {
+	if !isReissue {
+		return ""
+	}
+
+	return `
+            <div class="instructions" style="background-color: #fff3cd; border-left-color: #ffc107;">
+                <h3 style="color: #856404;">🔄 Tiket Anda Diterbitkan Ulang</h3>
+                <p style="margin: 0; color: #856404;">Demi keamanan, penyelenggara menerbitkan ulang tiket event ini dengan QR Code baru. QR Code pada e-ticket yang dikirim sebelumnya <strong>tidak berlaku lagi</strong>, gunakan e-ticket terlampir.</p>
+            </div>
+`
+}
+
+// buildRefundPolicy states the refund policy accepted with the order, empty without one
+func buildRefundPolicy(title, content string) string {
+	if content == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(`
+            <div class="order-summary">
+                <p style="margin: 0 0 10px 0;"><strong>%s</strong></p>
+                <p style="margin: 0; font-size: 14px;">%s</p>
+            </div>
+`, html.EscapeString(title), strings.ReplaceAll(html.EscapeString(content), "\n", "<br>"))
 }
 
-func formatCurrency(amount float64) string {
+func formatCurrency(amount money.Money) string {
 	// Simple currency formatting for Indonesian Rupiah
-	str := fmt.Sprintf("%.0f", amount)
+	str := strconv.FormatInt(amount.Major(), 10)
 
 	// Add thousand separators
 	var result []rune
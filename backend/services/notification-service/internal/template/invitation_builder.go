@@ -0,0 +1,141 @@
+package template
+
+import (
+	"fmt"
+	"html"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// InvitationEmailData represents data for event invitation email template
+type InvitationEmailData struct {
+	RecipientName  string
+	EventName      string
+	EventLocation  string
+	EventStartTime string
+	TierName       string
+	Quantity       int
+	Price          money.Money // Unit price, zero for complimentary tickets
+	ClaimURL       string
+	ClaimDeadline  string // Already formatted for display
+	Branding       Branding
+}
+
+// BuildInvitationEmail builds HTML email inviting a guest to claim their tickets before the deadline
+func BuildInvitationEmail(data *InvitationEmailData) string {
+	offer := "gratis"
+	if !data.Price.IsZero() {
+		offer = fmt.Sprintf("dengan harga khusus Rp %s per tiket", formatCurrency(data.Price))
+	}
+
+	return data.Branding.Apply(fmt.Sprintf(`
+<!DOCTYPE html>
+<html lang="id">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>Undangan Event</title>
+    <style>
+        body {
+            font-family: 'Segoe UI', Tahoma, Geneva, Verdana, sans-serif;
+            background-color: #f4f4f4;
+            margin: 0;
+            padding: 20px;
+        }
+        .container {
+            max-width: 600px;
+            margin: 0 auto;
+            background-color: #ffffff;
+            border-radius: 8px;
+            overflow: hidden;
+            box-shadow: 0 2px 8px rgba(0,0,0,0.1);
+        }
+        .header {
+            background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%);
+            color: white;
+            padding: 30px 20px;
+            text-align: center;
+        }
+        .header h1 {
+            margin: 0;
+            font-size: 26px;
+        }
+        .content {
+            padding: 30px 20px;
+            color: #555;
+            line-height: 1.6;
+        }
+        .details {
+            background-color: #f8f9fa;
+            border-left: 4px solid #667eea;
+            padding: 15px;
+            margin: 20px 0;
+        }
+        .deadline {
+            background-color: #fff3cd;
+            border-left: 4px solid #ffc107;
+            padding: 15px;
+            margin: 20px 0;
+        }
+        .button {
+            background-color: #667eea;
+            color: #ffffff;
+            padding: 12px 28px;
+            border-radius: 6px;
+            text-decoration: none;
+            font-weight: bold;
+        }
+        .footer {
+            background-color: #f8f9fa;
+            padding: 20px;
+            text-align: center;
+            color: #666;
+            font-size: 14px;
+        }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>💌 Anda Diundang!</h1>
+        </div>
+
+        <div class="content">
+            <p>Halo %s,</p>
+            <p>Anda diundang ke <strong>%s</strong>. Kami sudah menyiapkan <strong>%d tiket %s</strong> untuk Anda, %s.</p>
+            <div class="details">
+                <p style="margin: 0;">📍 %s</p>
+                <p style="margin: 5px 0 0 0;">🗓️ %s</p>
+            </div>
+            <div class="deadline">
+                Klaim tiket Anda sebelum <strong>%s</strong>. Setelah itu tiket akan dilepas untuk pembeli lain.
+            </div>
+            <p style="text-align: center; margin: 30px 0;">
+                <a class="button" href="%s">Klaim Tiket</a>
+            </p>
+            <p style="font-size: 14px; margin-top: 20px;">
+                Tautan ini khusus untuk Anda, mohon tidak dibagikan. Jika ada pertanyaan, silakan hubungi penyelenggara event.
+            </p>
+        </div>
+
+        <div class="footer">
+            <p>Event Ticketing Platform</p>
+            <p style="font-size: 12px; margin-top: 10px;">
+                Email ini dikirim secara otomatis, mohon tidak membalas email ini.
+            </p>
+        </div>
+    </div>
+</body>
+</html>
+	`,
+		html.EscapeString(data.RecipientName),
+		html.EscapeString(data.EventName),
+		data.Quantity,
+		html.EscapeString(data.TierName),
+		html.EscapeString(offer),
+		html.EscapeString(data.EventLocation),
+		html.EscapeString(data.EventStartTime),
+		html.EscapeString(data.ClaimDeadline),
+		html.EscapeString(data.ClaimURL),
+	))
+}
@@ -0,0 +1,156 @@
+package template
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// Moderation action constants, matching SendEventModerationEmailRequest.action
+const (
+	ModerationActionUnpublished = "unpublished"
+	ModerationActionRestored    = "restored"
+	ModerationActionRemoved     = "removed"
+)
+
+// reportReasonLabels translates report reasons for the organizer
+var reportReasonLabels = map[string]string{
+	"scam":          "penipuan",
+	"inappropriate": "konten tidak pantas",
+	"misleading":    "informasi menyesatkan",
+	"spam":          "spam",
+	"other":         "lainnya",
+}
+
+// ModerationEmailData represents data for event moderation email template
+type ModerationEmailData struct {
+	RecipientName string
+	EventName     string
+	Action        string
+	ReportCount   int
+	Reasons       []string
+	Note          string // Moderator note, empty for automatic actions
+}
+
+// BuildModerationEmail builds HTML email telling an organizer about a moderation action on their event
+// Moderation is between the organizer and the platform, so tenant branding isn't applied
+func BuildModerationEmail(data *ModerationEmailData) string {
+	labels := make([]string, 0, len(data.Reasons))
+	for _, reason := range data.Reasons {
+		if label, ok := reportReasonLabels[reason]; ok {
+			reason = label
+		}
+		labels = append(labels, html.EscapeString(reason))
+	}
+
+	var title, body string
+	switch data.Action {
+	case ModerationActionRestored:
+		title = "✅ Event Dipublikasikan Kembali"
+		body = fmt.Sprintf(`
+            <p>Tim kami telah meninjau laporan terhadap event <strong>%s</strong> dan tidak menemukan pelanggaran.</p>
+            <p>Event Anda kembali tampil dan tiket dapat dibeli seperti biasa.</p>`,
+			html.EscapeString(data.EventName),
+		)
+	case ModerationActionRemoved:
+		title = "❌ Event Diturunkan"
+		body = fmt.Sprintf(`
+            <p>Setelah peninjauan, event <strong>%s</strong> dinyatakan melanggar ketentuan platform dan tidak dapat dipublikasikan kembali.</p>
+            <p>Laporan pengguna: %s.</p>`,
+			html.EscapeString(data.EventName),
+			strings.Join(labels, ", "),
+		)
+	default:
+		title = "⚠️ Event Ditinjau"
+		body = fmt.Sprintf(`
+            <p>Event <strong>%s</strong> menerima %d laporan dari pengguna (%s), sehingga untuk sementara kami sembunyikan dari publik.</p>
+            <p>Tim kami akan meninjau event ini. Anda tidak dapat mempublikasikannya kembali sampai peninjauan selesai, dan kami akan mengabari hasilnya melalui email.</p>`,
+			html.EscapeString(data.EventName),
+			data.ReportCount,
+			strings.Join(labels, ", "),
+		)
+	}
+
+	if data.Note != "" {
+		body += fmt.Sprintf(`
+            <p><strong>Catatan moderator:</strong> %s</p>`,
+			html.EscapeString(data.Note),
+		)
+	}
+
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html lang="id">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>%s</title>
+    <style>
+        body {
+            font-family: 'Segoe UI', Tahoma, Geneva, Verdana, sans-serif;
+            background-color: #f4f4f4;
+            margin: 0;
+            padding: 20px;
+        }
+        .container {
+            max-width: 600px;
+            margin: 0 auto;
+            background-color: #ffffff;
+            border-radius: 8px;
+            overflow: hidden;
+            box-shadow: 0 2px 8px rgba(0,0,0,0.1);
+        }
+        .header {
+            background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%);
+            color: white;
+            padding: 30px 20px;
+            text-align: center;
+        }
+        .header h1 {
+            margin: 0;
+            font-size: 26px;
+        }
+        .content {
+            padding: 30px 20px;
+            color: #555;
+            line-height: 1.6;
+        }
+        .footer {
+            background-color: #f8f9fa;
+            padding: 20px;
+            text-align: center;
+            color: #666;
+            font-size: 14px;
+        }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>%s</h1>
+        </div>
+
+        <div class="content">
+            <p>Halo %s,</p>
+            %s
+            <p style="font-size: 14px; margin-top: 20px;">
+                Jika ada pertanyaan terkait keputusan ini, silakan hubungi customer service kami.
+            </p>
+        </div>
+
+        <div class="footer">
+            <p>Event Ticketing Platform</p>
+            <p style="font-size: 12px; margin-top: 10px;">
+                Email ini dikirim secara otomatis, mohon tidak membalas email ini.
+            </p>
+        </div>
+    </div>
+</body>
+</html>
+	`,
+		title,
+		title,
+		html.EscapeString(data.RecipientName),
+		body,
+	)
+}
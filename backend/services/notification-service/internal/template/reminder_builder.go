@@ -0,0 +1,127 @@
+package template
+
+import (
+	"fmt"
+	"html"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// ReminderEmailData represents data for reservation payment reminder email template
+type ReminderEmailData struct {
+	RecipientName string
+	EventName     string
+	OrderID       string
+	GrandTotal    money.Money
+	PaymentURL    string
+	ExpiresAt     string // Already formatted for display
+	MinutesLeft   int
+	Branding      Branding
+}
+
+// BuildReminderEmail builds HTML email reminding a buyer to pay their reservation before the tickets are released
+func BuildReminderEmail(data *ReminderEmailData) string {
+	orderRef := data.OrderID
+	if len(orderRef) > 8 {
+		orderRef = orderRef[:8]
+	}
+
+	return data.Branding.Apply(fmt.Sprintf(`
+<!DOCTYPE html>
+<html lang="id">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>Segera Selesaikan Pembayaran</title>
+    <style>
+        body {
+            font-family: 'Segoe UI', Tahoma, Geneva, Verdana, sans-serif;
+            background-color: #f4f4f4;
+            margin: 0;
+            padding: 20px;
+        }
+        .container {
+            max-width: 600px;
+            margin: 0 auto;
+            background-color: #ffffff;
+            border-radius: 8px;
+            overflow: hidden;
+            box-shadow: 0 2px 8px rgba(0,0,0,0.1);
+        }
+        .header {
+            background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%);
+            color: white;
+            padding: 30px 20px;
+            text-align: center;
+        }
+        .header h1 {
+            margin: 0;
+            font-size: 26px;
+        }
+        .content {
+            padding: 30px 20px;
+            color: #555;
+            line-height: 1.6;
+        }
+        .countdown {
+            background-color: #fff3cd;
+            border-left: 4px solid #ffc107;
+            padding: 15px;
+            margin: 20px 0;
+        }
+        .button {
+            background-color: #667eea;
+            color: #ffffff;
+            padding: 12px 28px;
+            border-radius: 6px;
+            text-decoration: none;
+            font-weight: bold;
+        }
+        .footer {
+            background-color: #f8f9fa;
+            padding: 20px;
+            text-align: center;
+            color: #666;
+            font-size: 14px;
+        }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>⏰ Segera Selesaikan Pembayaran</h1>
+        </div>
+
+        <div class="content">
+            <p>Halo %s,</p>
+            <p>Tiket <strong>%s</strong> pada order <strong>#%s</strong> sudah kami simpan untuk Anda, namun pembayarannya sebesar <strong>Rp %s</strong> belum kami terima.</p>
+            <div class="countdown">
+                Reservasi berakhir dalam <strong>%d menit</strong> (%s). Setelah itu tiket akan dilepas untuk pembeli lain.
+            </div>
+            <p style="text-align: center; margin: 30px 0;">
+                <a class="button" href="%s">Bayar Sekarang</a>
+            </p>
+            <p style="font-size: 14px; margin-top: 20px;">
+                Jika Anda sudah membayar, abaikan email ini. Jika ada pertanyaan, silakan hubungi customer service kami.
+            </p>
+        </div>
+
+        <div class="footer">
+            <p>Event Ticketing Platform</p>
+            <p style="font-size: 12px; margin-top: 10px;">
+                Email ini dikirim secara otomatis, mohon tidak membalas email ini.
+            </p>
+        </div>
+    </div>
+</body>
+</html>
+	`,
+		html.EscapeString(data.RecipientName),
+		html.EscapeString(data.EventName),
+		html.EscapeString(orderRef),
+		formatCurrency(data.GrandTotal),
+		data.MinutesLeft,
+		html.EscapeString(data.ExpiresAt),
+		html.EscapeString(data.PaymentURL),
+	))
+}
@@ -0,0 +1,136 @@
+package template
+
+import (
+	"fmt"
+	"html"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// WaitlistOfferEmailData represents data for waitlist offer email template
+type WaitlistOfferEmailData struct {
+	RecipientName  string
+	EventName      string
+	EventLocation  string
+	EventStartTime string
+	TierName       string
+	Quantity       int
+	Price          money.Money // Unit price of the tier
+	PurchaseURL    string
+	OfferExpiresAt string // Already formatted for display
+	Branding       Branding
+}
+
+// BuildWaitlistOfferEmail builds HTML email telling a waitlisted buyer to purchase their held tickets before the offer expires
+func BuildWaitlistOfferEmail(data *WaitlistOfferEmailData) string {
+	return data.Branding.Apply(fmt.Sprintf(`
+<!DOCTYPE html>
+<html lang="id">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>Tiket Tersedia</title>
+    <style>
+        body {
+            font-family: 'Segoe UI', Tahoma, Geneva, Verdana, sans-serif;
+            background-color: #f4f4f4;
+            margin: 0;
+            padding: 20px;
+        }
+        .container {
+            max-width: 600px;
+            margin: 0 auto;
+            background-color: #ffffff;
+            border-radius: 8px;
+            overflow: hidden;
+            box-shadow: 0 2px 8px rgba(0,0,0,0.1);
+        }
+        .header {
+            background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%);
+            color: white;
+            padding: 30px 20px;
+            text-align: center;
+        }
+        .header h1 {
+            margin: 0;
+            font-size: 26px;
+        }
+        .content {
+            padding: 30px 20px;
+            color: #555;
+            line-height: 1.6;
+        }
+        .details {
+            background-color: #f8f9fa;
+            border-left: 4px solid #667eea;
+            padding: 15px;
+            margin: 20px 0;
+        }
+        .deadline {
+            background-color: #fff3cd;
+            border-left: 4px solid #ffc107;
+            padding: 15px;
+            margin: 20px 0;
+        }
+        .button {
+            background-color: #667eea;
+            color: #ffffff;
+            padding: 12px 28px;
+            border-radius: 6px;
+            text-decoration: none;
+            font-weight: bold;
+        }
+        .footer {
+            background-color: #f8f9fa;
+            padding: 20px;
+            text-align: center;
+            color: #666;
+            font-size: 14px;
+        }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>🎟️ Giliran Anda!</h1>
+        </div>
+
+        <div class="content">
+            <p>Halo %s,</p>
+            <p>Tiket <strong>%s</strong> yang Anda tunggu kini tersedia. Kami menyimpan <strong>%d tiket %s</strong> untuk Anda seharga Rp %s per tiket.</p>
+            <div class="details">
+                <p style="margin: 0;">📍 %s</p>
+                <p style="margin: 5px 0 0 0;">🗓️ %s</p>
+            </div>
+            <div class="deadline">
+                Selesaikan pemesanan sebelum <strong>%s</strong>. Setelah itu tiket akan ditawarkan ke antrean berikutnya.
+            </div>
+            <p style="text-align: center; margin: 30px 0;">
+                <a class="button" href="%s">Beli Sekarang</a>
+            </p>
+            <p style="font-size: 14px; margin-top: 20px;">
+                Tiket hanya disimpan untuk akun Anda, masuk dengan akun yang sama saat memesan. Jika ada pertanyaan, silakan hubungi penyelenggara event.
+            </p>
+        </div>
+
+        <div class="footer">
+            <p>Event Ticketing Platform</p>
+            <p style="font-size: 12px; margin-top: 10px;">
+                Email ini dikirim secara otomatis, mohon tidak membalas email ini.
+            </p>
+        </div>
+    </div>
+</body>
+</html>
+	`,
+		html.EscapeString(data.RecipientName),
+		html.EscapeString(data.EventName),
+		data.Quantity,
+		html.EscapeString(data.TierName),
+		formatCurrency(data.Price),
+		html.EscapeString(data.EventLocation),
+		html.EscapeString(data.EventStartTime),
+		html.EscapeString(data.OfferExpiresAt),
+		html.EscapeString(data.PurchaseURL),
+	))
+}
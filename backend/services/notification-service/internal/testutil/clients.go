@@ -0,0 +1,38 @@
+// Package testutil provides test doubles for notification-service dependencies
+// so services can be unit tested without calling the Resend API.
+package testutil
+
+import (
+	"sync"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/notification-service/internal/client"
+)
+
+// ResendClient is a test double for service.ResendClient
+// Calls are recorded; SendEmailFunc overrides the default result
+type ResendClient struct {
+	SendEmailFunc func(req *client.EmailRequest) (*client.EmailResponse, error)
+
+	mu    sync.Mutex
+	calls []*client.EmailRequest
+}
+
+// SendEmail records the request and returns SendEmailFunc's result
+// Defaults to a fixed email ID
+func (m *ResendClient) SendEmail(req *client.EmailRequest) (*client.EmailResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, req)
+	m.mu.Unlock()
+
+	if m.SendEmailFunc != nil {
+		return m.SendEmailFunc(req)
+	}
+	return &client.EmailResponse{ID: "test-email-id"}, nil
+}
+
+// SendEmailCalls returns recorded SendEmail requests
+func (m *ResendClient) SendEmailCalls() []*client.EmailRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*client.EmailRequest(nil), m.calls...)
+}
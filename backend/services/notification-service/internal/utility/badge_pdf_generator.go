@@ -0,0 +1,153 @@
+package utility
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// Badge sheet layout (mm) - A4 portrait, 2 columns x 3 rows of cut-out badges
+const (
+	badgeWidth    = 90.0
+	badgeHeight   = 88.0
+	badgeColumns  = 2
+	badgeRows     = 3
+	badgeGapX     = 10.0
+	badgeGapY     = 5.0
+	badgeMarginX  = 10.0
+	badgeMarginY  = 10.0
+	badgeQRSize   = 32.0
+	badgesPerPage = badgeColumns * badgeRows
+)
+
+// BadgePDFData represents data for a single attendee badge
+type BadgePDFData struct {
+	TicketID     string
+	TicketNumber string
+	AttendeeName string
+	Company      string
+	TierName     string
+	QRCodeBase64 string
+}
+
+// BadgeSheet represents a batch of badges of one event
+type BadgeSheet struct {
+	EventName      string
+	EventStartTime string
+	BrandName      string
+	Badges         []BadgePDFData
+}
+
+// GenerateBadgePDF renders badges in a printable grid, six per A4 page
+func GenerateBadgePDF(sheet *BadgeSheet) ([]byte, error) {
+	if len(sheet.Badges) == 0 {
+		return nil, fmt.Errorf("no badges to render")
+	}
+
+	brandName := sheet.BrandName
+	if brandName == "" {
+		brandName = "EVENT TICKETING PLATFORM"
+	}
+
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(badgeMarginX, badgeMarginY, badgeMarginX)
+	pdf.SetAutoPageBreak(false, 0)
+
+	// Core fonts are cp1252; translate so accented names render correctly
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	primaryColor := gofpdf.RGBType{R: 102, G: 126, B: 234} // Purple
+	grayColor := gofpdf.RGBType{R: 108, G: 117, B: 125}    // Gray
+
+	for i, badge := range sheet.Badges {
+		slot := i % badgesPerPage
+		if slot == 0 {
+			pdf.AddPage()
+		}
+
+		x := badgeMarginX + float64(slot%badgeColumns)*(badgeWidth+badgeGapX)
+		y := badgeMarginY + float64(slot/badgeColumns)*(badgeHeight+badgeGapY)
+
+		// Dashed cut line around the badge
+		pdf.SetDrawColor(grayColor.R, grayColor.G, grayColor.B)
+		pdf.SetLineWidth(0.2)
+		pdf.SetDashPattern([]float64{2, 2}, 0)
+		pdf.Rect(x, y, badgeWidth, badgeHeight, "D")
+		pdf.SetDashPattern([]float64{}, 0)
+
+		// Header strip with brand and event
+		pdf.SetFillColor(primaryColor.R, primaryColor.G, primaryColor.B)
+		pdf.Rect(x, y, badgeWidth, 16, "F")
+
+		pdf.SetTextColor(255, 255, 255)
+		pdf.SetFont("Arial", "B", 10)
+		pdf.SetXY(x+3, y+2)
+		pdf.CellFormat(badgeWidth-6, 6, fitText(pdf, tr(brandName), badgeWidth-6), "", 0, "C", false, 0, "")
+		pdf.SetFont("Arial", "", 8)
+		pdf.SetXY(x+3, y+8)
+		pdf.CellFormat(badgeWidth-6, 5, fitText(pdf, tr(sheet.EventName), badgeWidth-6), "", 0, "C", false, 0, "")
+
+		// Attendee name and company
+		pdf.SetTextColor(0, 0, 0)
+		pdf.SetFont("Arial", "B", 16)
+		pdf.SetXY(x+3, y+20)
+		pdf.CellFormat(badgeWidth-6, 8, fitText(pdf, tr(badge.AttendeeName), badgeWidth-6), "", 0, "C", false, 0, "")
+
+		if badge.Company != "" {
+			pdf.SetFont("Arial", "", 11)
+			pdf.SetTextColor(grayColor.R, grayColor.G, grayColor.B)
+			pdf.SetXY(x+3, y+28)
+			pdf.CellFormat(badgeWidth-6, 6, fitText(pdf, tr(badge.Company), badgeWidth-6), "", 0, "C", false, 0, "")
+		}
+
+		// Tier label
+		pdf.SetFont("Arial", "B", 9)
+		pdf.SetTextColor(primaryColor.R, primaryColor.G, primaryColor.B)
+		pdf.SetXY(x+3, y+35)
+		pdf.CellFormat(badgeWidth-6, 5, fitText(pdf, tr(strings.ToUpper(badge.TierName)), badgeWidth-6), "", 0, "C", false, 0, "")
+
+		// QR code
+		qrData, err := decodeBase64Image(badge.QRCodeBase64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode QR code of ticket %s: %w", badge.TicketID, err)
+		}
+
+		imageName := fmt.Sprintf("badge_qr_%s", badge.TicketID)
+		pdf.RegisterImageReader(imageName, "png", strings.NewReader(qrData))
+		qrX := x + (badgeWidth-badgeQRSize)/2
+		pdf.ImageOptions(imageName, qrX, y+42, badgeQRSize, badgeQRSize, false, gofpdf.ImageOptions{ImageType: "png"}, 0, "")
+
+		// Ticket number below QR
+		pdf.SetFont("Courier", "", 8)
+		pdf.SetTextColor(grayColor.R, grayColor.G, grayColor.B)
+		pdf.SetXY(x+3, y+badgeHeight-11)
+		pdf.CellFormat(badgeWidth-6, 4, badge.TicketNumber, "", 0, "C", false, 0, "")
+		if sheet.EventStartTime != "" {
+			pdf.SetFont("Arial", "", 7)
+			pdf.SetXY(x+3, y+badgeHeight-7)
+			pdf.CellFormat(badgeWidth-6, 4, tr(sheet.EventStartTime), "", 0, "C", false, 0, "")
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("failed to output PDF: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// fitText shortens text with an ellipsis until it fits width in the current font
+// Text is already cp1252-translated, so trimming byte by byte is safe
+func fitText(pdf *gofpdf.Fpdf, text string, width float64) string {
+	if pdf.GetStringWidth(text) <= width {
+		return text
+	}
+
+	for len(text) > 0 && pdf.GetStringWidth(text+"...") > width {
+		text = text[:len(text)-1]
+	}
+	return text + "..."
+}
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"encoding/base64"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/jung-kurt/gofpdf"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
 )
 
 // TicketPDFData represents data for a single ticket in PDF
@@ -15,28 +17,42 @@ type TicketPDFData struct {
 	TicketID       string
 	TicketNumber   string
 	TierName       string
-	Price          float64
+	Price          money.Money
 	QRCodeBase64   string
 	EventName      string
 	EventLocation  string
 	EventStartTime string
 	OrderID        string
+	RefundPolicy   string          // Refund policy accepted with the order, empty if the event has none
+	IsTest         bool            // Test order of a sandbox event, marked TEST and not valid for entry
+	Template       *TicketTemplate // Event organizer's layout, nil for the platform layout
 }
 
 // GenerateTicketPDF generates a professional e-ticket PDF with QR code
+// The organizer's ticket template, if any, sets the logo, colours, sponsor footer and terms page
 func GenerateTicketPDF(ticket *TicketPDFData) ([]byte, error) {
+	tpl := ticket.Template
+	if tpl == nil {
+		tpl = &TicketTemplate{}
+	}
+
 	// Create new PDF - A4 portrait
 	pdf := gofpdf.New("P", "mm", "A4", "")
 	pdf.SetMargins(15, 15, 15)
 	pdf.AddPage()
 
+	// Core fonts are cp1252; translate so organizer text with accents renders correctly
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
 	// Colors
-	primaryColor := gofpdf.RGBType{R: 102, G: 126, B: 234} // Purple
-	grayColor := gofpdf.RGBType{R: 108, G: 117, B: 125}    // Gray
+	primaryColor := templateColor(tpl.PrimaryColor, gofpdf.RGBType{R: 102, G: 126, B: 234}) // Purple
+	accentColor := templateColor(tpl.AccentColor, primaryColor)
+	grayColor := gofpdf.RGBType{R: 108, G: 117, B: 125} // Gray
 
 	// Header background
 	pdf.SetFillColor(primaryColor.R, primaryColor.G, primaryColor.B)
 	pdf.Rect(0, 0, 210, 40, "F")
+	drawTemplateLogo(pdf, "logo_"+ticket.TicketID, tpl)
 
 	// Company name
 	pdf.SetTextColor(255, 255, 255)
@@ -44,10 +60,21 @@ func GenerateTicketPDF(ticket *TicketPDFData) ([]byte, error) {
 	pdf.SetY(15)
 	pdf.CellFormat(0, 10, "EVENT TICKETING PLATFORM", "", 1, "C", false, 0, "")
 
+	// Tickets of sandbox test orders are marked TEST in the header
+	title := "E-TICKET"
+	if ticket.IsTest {
+		pdf.SetFillColor(220, 53, 69)
+		pdf.Rect(165, 5, 35, 12, "F")
+		pdf.SetFont("Arial", "B", 14)
+		pdf.SetXY(165, 5)
+		pdf.CellFormat(35, 12, "TEST", "", 0, "C", false, 0, "")
+		title = "TEST E-TICKET - NOT VALID FOR ENTRY"
+	}
+
 	// E-Ticket title
 	pdf.SetFont("Arial", "", 12)
 	pdf.SetY(28)
-	pdf.CellFormat(0, 8, "E-TICKET", "", 1, "C", false, 0, "")
+	pdf.CellFormat(0, 8, title, "", 1, "C", false, 0, "")
 
 	// Reset text color
 	pdf.SetTextColor(0, 0, 0)
@@ -55,7 +82,7 @@ func GenerateTicketPDF(ticket *TicketPDFData) ([]byte, error) {
 
 	// Event details section
 	pdf.SetFont("Arial", "B", 16)
-	pdf.SetTextColor(primaryColor.R, primaryColor.G, primaryColor.B)
+	pdf.SetTextColor(accentColor.R, accentColor.G, accentColor.B)
 	pdf.CellFormat(0, 10, "Event Details", "", 1, "L", false, 0, "")
 	pdf.SetTextColor(0, 0, 0)
 	pdf.Ln(3)
@@ -92,7 +119,7 @@ func GenerateTicketPDF(ticket *TicketPDFData) ([]byte, error) {
 
 	// Ticket details section
 	pdf.SetFont("Arial", "B", 16)
-	pdf.SetTextColor(primaryColor.R, primaryColor.G, primaryColor.B)
+	pdf.SetTextColor(accentColor.R, accentColor.G, accentColor.B)
 	pdf.CellFormat(0, 10, "Ticket Information", "", 1, "L", false, 0, "")
 	pdf.SetTextColor(0, 0, 0)
 	pdf.Ln(3)
@@ -119,7 +146,7 @@ func GenerateTicketPDF(ticket *TicketPDFData) ([]byte, error) {
 
 	// QR Code section
 	pdf.SetFont("Arial", "B", 16)
-	pdf.SetTextColor(primaryColor.R, primaryColor.G, primaryColor.B)
+	pdf.SetTextColor(accentColor.R, accentColor.G, accentColor.B)
 	pdf.CellFormat(0, 10, "QR Code", "", 1, "C", false, 0, "")
 	pdf.SetTextColor(0, 0, 0)
 	pdf.Ln(3)
@@ -169,18 +196,44 @@ func GenerateTicketPDF(ticket *TicketPDFData) ([]byte, error) {
 	pdf.Cell(0, 6, "IMPORTANT INSTRUCTIONS")
 	pdf.Ln(8)
 
+	// Refunds follow the event's refund policy when it has one
+	refundLine := "• This ticket is non-transferable and non-refundable"
+	if ticket.RefundPolicy != "" {
+		refundLine = "• This ticket is non-transferable, refunds follow the refund policy below"
+	}
+
 	pdf.SetX(20)
 	pdf.SetFont("Arial", "", 10)
 	pdf.MultiCell(160, 5,
 		"• Show this QR code at the entrance\n"+
 			"• One-time use only - cannot be used after scanned\n"+
 			"• Arrive at least 30 minutes before event starts\n"+
-			"• This ticket is non-transferable and non-refundable",
+			refundLine,
 		"", "L", false)
 
 	pdf.Ln(5)
 
-	// Footer
+	if ticket.RefundPolicy != "" {
+		pdf.SetY(y + 45)
+		pdf.SetX(15)
+		pdf.SetFont("Arial", "B", 10)
+		pdf.SetTextColor(0, 0, 0)
+		pdf.Cell(0, 6, "REFUND POLICY")
+		pdf.Ln(6)
+		pdf.SetX(15)
+		pdf.SetFont("Arial", "", 9)
+		pdf.SetTextColor(grayColor.R, grayColor.G, grayColor.B)
+		pdf.MultiCell(180, 4.5, ticket.RefundPolicy, "", "L", false)
+	}
+
+	// Footer, with the organizer's sponsors above it
+	if tpl.SponsorFooter != "" {
+		pdf.SetY(263)
+		pdf.SetFont("Arial", "B", 9)
+		pdf.SetTextColor(accentColor.R, accentColor.G, accentColor.B)
+		pdf.CellFormat(0, 5, tr(tpl.SponsorFooter), "", 1, "C", false, 0, "")
+	}
+
 	pdf.SetY(270)
 	pdf.SetFont("Arial", "I", 9)
 	pdf.SetTextColor(grayColor.R, grayColor.G, grayColor.B)
@@ -188,6 +241,18 @@ func GenerateTicketPDF(ticket *TicketPDFData) ([]byte, error) {
 	pdf.CellFormat(0, 5, "Generated on: "+time.Now().Format("2 Jan 2006 15:04 MST"), "", 1, "C", false, 0, "")
 	pdf.CellFormat(0, 5, "Event Ticketing Platform - www.eventticket.com", "", 1, "C", false, 0, "")
 
+	// Organizer's terms get a page of their own so they never push the ticket off the first page
+	if tpl.TermsText != "" {
+		pdf.AddPage()
+		pdf.SetFont("Arial", "B", 16)
+		pdf.SetTextColor(accentColor.R, accentColor.G, accentColor.B)
+		pdf.CellFormat(0, 10, "Terms & Conditions", "", 1, "L", false, 0, "")
+		pdf.Ln(3)
+		pdf.SetFont("Arial", "", 10)
+		pdf.SetTextColor(0, 0, 0)
+		pdf.MultiCell(180, 5, tr(tpl.TermsText), "", "L", false)
+	}
+
 	// Get PDF bytes
 	var buf bytes.Buffer
 	err = pdf.Output(&buf)
@@ -218,8 +283,8 @@ func decodeBase64Image(base64Str string) (string, error) {
 }
 
 // formatCurrency formats amount to Indonesian Rupiah format
-func formatCurrency(amount float64) string {
-	str := fmt.Sprintf("%.0f", amount)
+func formatCurrency(amount money.Money) string {
+	str := strconv.FormatInt(amount.Major(), 10)
 
 	var result []rune
 	count := 0
@@ -0,0 +1,79 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/notification-service/internal/service"
+)
+
+// DigestWorker periodically sends notification digests that are due
+type DigestWorker struct {
+	digestService service.DigestService
+	interval      time.Duration
+	batchSize     int
+	stopChan      chan struct{}
+}
+
+// NewDigestWorker creates new digest worker instance
+func NewDigestWorker(
+	digestService service.DigestService,
+	interval time.Duration,
+	batchSize int,
+) *DigestWorker {
+	return &DigestWorker{
+		digestService: digestService,
+		interval:      interval,
+		batchSize:     batchSize,
+		stopChan:      make(chan struct{}),
+	}
+}
+
+// Start begins the digest worker
+func (w *DigestWorker) Start(ctx context.Context) {
+	log.Printf("[Worker] Digest worker started (interval: %v, batch size: %d)", w.interval, w.batchSize)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	// Send digests that came due while the service was down
+	w.sendDigests(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			w.sendDigests(ctx)
+		case <-w.stopChan:
+			log.Println("[Worker] Digest worker stopped")
+			return
+		case <-ctx.Done():
+			log.Println("[Worker] Digest worker stopped due to context cancellation")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the digest worker
+func (w *DigestWorker) Stop() {
+	close(w.stopChan)
+}
+
+// sendDigests executes one digest pass
+func (w *DigestWorker) sendDigests(ctx context.Context) {
+	defer errreport.Recover("digest_worker")
+
+	startTime := time.Now()
+	sent, err := w.digestService.SendDueDigests(ctx, w.batchSize)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		log.Printf("[Worker] Digest run failed: %v (duration: %v)", err, duration)
+		return
+	}
+
+	if sent > 0 {
+		log.Printf("[Worker] Digest run completed: sent=%d (duration: %v)", sent, duration)
+	}
+}
@@ -14,6 +14,13 @@ import (
 	"github.com/joho/godotenv"
 	"github.com/soheilhy/cmux"
 	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/payment"
+	sharedauth "github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/buildinfo"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/faultinject"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/interceptor"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/serviceauth"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/config"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/client"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/controller"
@@ -21,6 +28,7 @@ import (
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/repository"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/service"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/utility"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/worker"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/router"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -36,6 +44,13 @@ func main() {
 	// Load configuration
 	cfg := config.Load()
 
+	// Error reporting: panics and alarms go to Sentry when SENTRY_DSN is set, otherwise they are only logged
+	if err := errreport.Init(errreport.ConfigFromEnv("payment-service")); err != nil {
+		log.Printf("⚠️  Warning: Error reporting disabled: %v", err)
+	}
+	defer errreport.Flush(2 * time.Second)
+	log.Printf("Build: %s", buildinfo.Get("payment-service"))
+
 	// Initialize database connection
 	db, err := utility.NewDatabase(&cfg.Database)
 	if err != nil {
@@ -57,38 +72,138 @@ func main() {
 		log.Println("⚠️  Continuing without migrations (ensure database schema is correct)")
 	}
 
+	// Initialize Redis for payment status polling (auto-detects TCP or REST)
+	redisClient, err := cache.NewRedisClient()
+	if err != nil {
+		log.Printf("⚠️  Warning: Failed to connect to Redis: %v", err)
+		log.Println("⚠️  Continuing without Redis (payment status polling served from the database)")
+		redisClient = nil
+	} else {
+		log.Println("✅ Redis connected successfully")
+		defer redisClient.Close()
+	}
+
 	// Initialize repositories
 	paymentRepo := repository.NewPaymentRepository(db)
 	webhookRepo := repository.NewWebhookRepository(db)
+	subscriptionRepo := repository.NewSubscriptionRepository(db)
+	refundRepo := repository.NewRefundRepository(db)
+	confirmationRepo := repository.NewPaymentConfirmationRepository(db)
+	sagaRepo := repository.NewPaymentSagaRepository(db)
 	log.Println("✅ Repositories initialized")
 
 	// Initialize clients
 	xenditClient := client.NewXenditClient(&cfg.Xendit)
 
+	// Sandbox events' test orders are invoiced with the test keys
+	var testXenditClient service.XenditClient
+	if cfg.Xendit.TestAPIKey != "" {
+		testConfig := cfg.Xendit
+		testConfig.APIKey = cfg.Xendit.TestAPIKey
+		testXenditClient = client.NewXenditClient(&testConfig)
+	} else {
+		log.Println("⚠️  XENDIT_TEST_API_KEY not set, sandbox payments are disabled")
+	}
+
+	// Fault injection for inter-service calls (FAULT_INJECTION, refused in production)
+	faults, err := faultinject.NewFromEnv()
+	if err != nil {
+		log.Printf("⚠️  Warning: Fault injection disabled: %v", err)
+	}
+
+	// Service identity signing the tokens of outgoing gRPC calls (SERVICE_AUTH_PRIVATE_KEY_FILE)
+	serviceIdentity, err := serviceauth.IdentityFromEnv(serviceauth.ServicePayment)
+	if err != nil {
+		log.Fatalf("Failed to load service identity: %v", err)
+	}
+	if serviceIdentity == nil {
+		log.Println("⚠️  Warning: SERVICE_AUTH_PRIVATE_KEY_FILE not set, gRPC calls carry no service token")
+	}
+
 	// Initialize ticketing gRPC client (non-blocking with auto-reconnect)
-	ticketingClient, err := client.NewTicketingClient(cfg.TicketingService.GRPCAddress)
+	// Left as a nil interface on failure so the webhook service can detect it
+	var ticketingClient service.TicketingClient
+	grpcTicketingClient, err := client.NewTicketingClient(cfg.TicketingService.GRPCAddress, cfg.GRPC.MaxRecvMsgSize, cfg.GRPC.MaxSendMsgSize, faults, serviceIdentity)
 	if err != nil {
 		log.Printf("⚠️  Warning: Failed to initialize Ticketing Service gRPC client: %v", err)
 		log.Println("⚠️  Payment service will continue without ticketing client")
-		ticketingClient = nil
 	} else {
-		defer ticketingClient.Close()
+		defer grpcTicketingClient.Close()
+		ticketingClient = grpcTicketingClient
+	}
+
+	// Initialize event gRPC client to switch organizer plans of subscriptions
+	var eventClient service.EventClient
+	grpcEventClient, err := client.NewEventClient(cfg.EventService.GRPCAddress, cfg.GRPC.MaxRecvMsgSize, cfg.GRPC.MaxSendMsgSize, faults, serviceIdentity)
+	if err != nil {
+		log.Printf("⚠️  Warning: Failed to initialize Event Service gRPC client: %v", err)
+		log.Println("⚠️  Subscription plans will have to be assigned manually")
+	} else {
+		defer grpcEventClient.Close()
+		eventClient = grpcEventClient
+	}
+
+	// Initialize notification gRPC client for dunning emails
+	var notificationClient service.NotificationClient
+	grpcNotificationClient, err := client.NewNotificationClient(cfg.Notification.GRPCAddress, cfg.GRPC.MaxRecvMsgSize, cfg.GRPC.MaxSendMsgSize, faults, serviceIdentity)
+	if err != nil {
+		log.Printf("⚠️  Warning: Failed to initialize Notification Service gRPC client: %v", err)
+		log.Println("⚠️  Dunning emails will not be sent")
+	} else {
+		defer grpcNotificationClient.Close()
+		notificationClient = grpcNotificationClient
 	}
 
 	log.Println("✅ External clients initialized")
 
 	// Initialize services
-	paymentService := service.NewPaymentService(paymentRepo, xenditClient, cfg)
-	webhookService := service.NewWebhookService(webhookRepo, paymentRepo, ticketingClient)
+	paymentService := service.NewPaymentService(paymentRepo, xenditClient, testXenditClient, redisClient, cfg)
+	subscriptionService := service.NewSubscriptionService(subscriptionRepo, xenditClient, eventClient, notificationClient, service.SubscriptionPolicy{
+		InvoiceExpiry:      cfg.Subscription.InvoiceExpiry,
+		MaxRenewalAttempts: cfg.Subscription.MaxRenewalAttempts,
+		BatchSize:          cfg.Subscription.BatchSize,
+	})
+	refundService := service.NewRefundService(paymentRepo, refundRepo, ticketingClient, xenditClient, testXenditClient)
+	webhookService := service.NewWebhookService(webhookRepo, paymentRepo, refundRepo, confirmationRepo, sagaRepo, refundService, ticketingClient, subscriptionService, redisClient)
+	retentionService := service.NewRetentionService(webhookRepo, service.RetentionPolicy{
+		AnonymizeAfter:  cfg.Retention.AnonymizeAfter,
+		SoftDeleteAfter: cfg.Retention.SoftDeleteAfter,
+		PurgeAfter:      cfg.Retention.PurgeAfter,
+		BatchSize:       cfg.Retention.BatchSize,
+	})
 	log.Println("✅ Services initialized")
 
 	// Initialize controllers
 	paymentController := controller.NewPaymentController(paymentService)
 	webhookController := controller.NewWebhookController(webhookService, cfg)
+	subscriptionController := controller.NewSubscriptionController(subscriptionService)
+	refundController := controller.NewRefundController(refundService)
+
+	// Webhook simulator for local development, Xendit can't reach localhost
+	var devController *controller.DevController
+	if cfg.WebhookSimulatorEnabled() {
+		devController = controller.NewDevController(service.NewWebhookSimulator(paymentRepo, cfg), webhookController)
+		log.Println("⚠️  Webhook simulator enabled at POST /dev/simulate-webhook (development only)")
+	}
 	log.Println("✅ Controllers initialized")
 
+	// Load JWT keys for local token validation
+	jwtKeys, err := sharedauth.KeySetFromEnv(cfg.JWT.Secret)
+	if err != nil {
+		log.Fatalf("Failed to load JWT keys: %v", err)
+	}
+
+	// Authenticate and authorize callers of the gRPC server and internal HTTP routes (SERVICE_AUTH_PUBLIC_KEYS)
+	serviceVerifier, err := serviceauth.VerifierFromEnv(serviceauth.ServicePayment, grpcHandler.ServiceAuthPolicy)
+	if err != nil {
+		log.Fatalf("Failed to load service auth keys: %v", err)
+	}
+	if serviceVerifier == nil {
+		log.Println("⚠️  Warning: SERVICE_AUTH_PUBLIC_KEYS not set, gRPC callers are not authenticated and internal HTTP routes are disabled")
+	}
+
 	// Setup HTTP router
-	r := router.SetupRouter(cfg, paymentController, webhookController)
+	r := router.SetupRouter(jwtKeys, serviceVerifier, paymentController, webhookController, subscriptionController, refundController, devController)
 
 	// Create HTTP server (without Addr - will use cmux listener)
 	httpServer := &http.Server{
@@ -96,14 +211,45 @@ func main() {
 	}
 
 	// Create gRPC server
-	grpcServer := grpc.NewServer()
-	paymentGRPCServer := grpcHandler.NewPaymentGRPCServer(paymentService)
+	grpcServer := grpc.NewServer(
+		interceptor.UnaryServer(serviceVerifier.UnaryServerInterceptor()),
+		interceptor.StreamServer(serviceVerifier.StreamServerInterceptor()),
+		grpc.MaxRecvMsgSize(cfg.GRPC.MaxRecvMsgSize),
+		grpc.MaxSendMsgSize(cfg.GRPC.MaxSendMsgSize),
+	)
+	paymentGRPCServer := grpcHandler.NewPaymentGRPCServer(paymentService, webhookService)
 	pb.RegisterPaymentServiceServer(grpcServer, paymentGRPCServer)
 
 	// Enable gRPC reflection for debugging (optional)
 	reflection.Register(grpcServer)
+	buildinfo.RegisterGRPC(grpcServer, "payment-service")
 	log.Println("✅ gRPC server initialized")
 
+	// Start background workers for webhook retention, subscription renewals and confirmation retries
+	workerCtx, workerCancel := context.WithCancel(context.Background())
+	defer workerCancel()
+
+	var retentionWorker *worker.WebhookRetentionWorker
+	if cfg.Retention.Enabled {
+		retentionWorker = worker.NewWebhookRetentionWorker(retentionService, cfg.Retention.Interval)
+		go retentionWorker.Start(workerCtx)
+		log.Println("✅ Webhook retention worker started")
+	}
+
+	var renewalWorker *worker.SubscriptionRenewalWorker
+	if cfg.Subscription.WorkerEnabled {
+		renewalWorker = worker.NewSubscriptionRenewalWorker(subscriptionService, cfg.Subscription.WorkerInterval)
+		go renewalWorker.Start(workerCtx)
+		log.Println("✅ Subscription renewal worker started")
+	}
+
+	var confirmationWorker *worker.ConfirmationRetryWorker
+	if cfg.Confirmation.WorkerEnabled {
+		confirmationWorker = worker.NewConfirmationRetryWorker(webhookService, cfg.Confirmation.WorkerInterval)
+		go confirmationWorker.Start(workerCtx)
+		log.Println("✅ Confirmation retry worker started")
+	}
+
 	// Create a single listener on HTTP port (Cloud Run only allows one port)
 	listener, err := net.Listen("tcp", ":"+cfg.Server.Port)
 	if err != nil {
@@ -164,5 +310,16 @@ func main() {
 	// Close multiplexer listener
 	listener.Close()
 
+	// Stop background workers
+	if retentionWorker != nil {
+		retentionWorker.Stop()
+	}
+	if renewalWorker != nil {
+		renewalWorker.Stop()
+	}
+	if confirmationWorker != nil {
+		confirmationWorker.Stop()
+	}
+
 	log.Println("✅ Payment service stopped gracefully")
 }
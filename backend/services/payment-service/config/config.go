@@ -4,15 +4,24 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"time"
 )
 
 // Config holds all application configuration
 type Config struct {
+	Environment      string
 	Server           ServerConfig
 	Database         DatabaseConfig
 	JWT              JWTConfig
 	Xendit           XenditConfig
 	TicketingService TicketingServiceConfig
+	EventService     EventServiceConfig
+	Notification     NotificationServiceConfig
+	GRPC             GRPCConfig
+	Retention        RetentionConfig
+	Subscription     SubscriptionConfig
+	Confirmation     ConfirmationConfig
+	DevTools         DevToolsConfig
 }
 
 // ServerConfig holds server configuration
@@ -43,6 +52,63 @@ type XenditConfig struct {
 	WebhookToken  string
 	BaseURL       string
 	InvoiceExpiry int // in seconds
+	// Test mode keys for sandbox events' test orders; sandbox payments are refused when empty
+	TestAPIKey       string
+	TestWebhookToken string
+}
+
+// GRPCConfig holds gRPC message size limits (in bytes)
+// Applied to the gRPC server and to every outgoing gRPC client
+type GRPCConfig struct {
+	MaxRecvMsgSize int
+	MaxSendMsgSize int
+}
+
+// RetentionConfig holds webhook event retention policy
+type RetentionConfig struct {
+	Enabled         bool
+	AnonymizeAfter  time.Duration // Strip PII from payload (default 30 days)
+	SoftDeleteAfter time.Duration // Clear payload and mark deleted (default 180 days)
+	PurgeAfter      time.Duration // Hard delete after soft deletion (default 30 days)
+	Interval        time.Duration // Background job interval
+	BatchSize       int
+}
+
+// SubscriptionConfig holds plan subscription billing configuration
+// A failed renewal is retried with a new invoice until MaxRenewalAttempts invoices expired unpaid
+type SubscriptionConfig struct {
+	WorkerEnabled      bool
+	WorkerInterval     time.Duration
+	InvoiceExpiry      time.Duration // How long each subscription invoice can be paid, also the dunning retry interval
+	MaxRenewalAttempts int
+	BatchSize          int
+}
+
+// ConfirmationConfig holds the retry worker of order confirmations that didn't reach ticketing service
+type ConfirmationConfig struct {
+	WorkerEnabled  bool
+	WorkerInterval time.Duration
+}
+
+// DevToolsConfig holds local development helpers, never enabled in production
+type DevToolsConfig struct {
+	WebhookSimulator bool // POST /dev/simulate-webhook, fabricates signed Xendit callbacks
+}
+
+// WebhookSimulatorEnabled reports whether the webhook simulator may be served
+// Both ENVIRONMENT=development and DEV_WEBHOOK_SIMULATOR_ENABLED=true are required
+func (c *Config) WebhookSimulatorEnabled() bool {
+	return c.Environment == "development" && c.DevTools.WebhookSimulator
+}
+
+// EventServiceConfig holds event service configuration
+type EventServiceConfig struct {
+	GRPCAddress string
+}
+
+// NotificationServiceConfig holds notification service configuration
+type NotificationServiceConfig struct {
+	GRPCAddress string
 }
 
 // TicketingServiceConfig holds ticketing service configuration
@@ -54,6 +120,7 @@ type TicketingServiceConfig struct {
 // Load loads configuration from environment variables
 func Load() *Config {
 	return &Config{
+		Environment: getEnv("ENVIRONMENT", "development"),
 		Server: ServerConfig{
 			Port:     getEnv("PAYMENT_SERVER_PORT", "8084"),
 			GRPCPort: getEnv("PAYMENT_GRPC_PORT", "50054"),
@@ -71,15 +138,49 @@ func Load() *Config {
 			Expiry: getEnv("JWT_EXPIRY", "24h"),
 		},
 		Xendit: XenditConfig{
-			APIKey:        getEnv("XENDIT_API_KEY", ""),
-			WebhookToken:  getEnv("XENDIT_WEBHOOK_TOKEN", ""),
-			BaseURL:       getEnv("XENDIT_BASE_URL", "https://api.xendit.co"),
-			InvoiceExpiry: getEnvAsInt("XENDIT_INVOICE_EXPIRY", 1800), // 30 minutes default
+			APIKey:           getEnv("XENDIT_API_KEY", ""),
+			WebhookToken:     getEnv("XENDIT_WEBHOOK_TOKEN", ""),
+			BaseURL:          getEnv("XENDIT_BASE_URL", "https://api.xendit.co"),
+			InvoiceExpiry:    getEnvAsInt("XENDIT_INVOICE_EXPIRY", 1800), // 30 minutes default
+			TestAPIKey:       getEnv("XENDIT_TEST_API_KEY", ""),
+			TestWebhookToken: getEnv("XENDIT_TEST_WEBHOOK_TOKEN", ""),
 		},
 		TicketingService: TicketingServiceConfig{
 			BaseURL:     getEnv("TICKETING_SERVICE_URL", "http://localhost:8083"),
 			GRPCAddress: getEnv("TICKETING_SERVICE_GRPC_ADDR", "localhost:50053"),
 		},
+		EventService: EventServiceConfig{
+			GRPCAddress: getEnv("EVENT_SERVICE_GRPC_ADDR", "localhost:8082"),
+		},
+		Notification: NotificationServiceConfig{
+			GRPCAddress: getEnv("NOTIFICATION_SERVICE_GRPC_ADDR", "localhost:50055"),
+		},
+		GRPC: GRPCConfig{
+			MaxRecvMsgSize: getEnvAsPositiveInt("GRPC_MAX_RECV_MSG_SIZE", 4*1024*1024), // 4 MB default
+			MaxSendMsgSize: getEnvAsPositiveInt("GRPC_MAX_SEND_MSG_SIZE", 4*1024*1024), // 4 MB default
+		},
+		Retention: RetentionConfig{
+			Enabled:         getEnv("WEBHOOK_RETENTION_ENABLED", "true") == "true",
+			AnonymizeAfter:  getEnvAsDuration("WEBHOOK_ANONYMIZE_AFTER", 30*24*time.Hour),
+			SoftDeleteAfter: getEnvAsDuration("WEBHOOK_SOFT_DELETE_AFTER", 180*24*time.Hour),
+			PurgeAfter:      getEnvAsDuration("WEBHOOK_PURGE_AFTER", 30*24*time.Hour),
+			Interval:        getEnvAsDuration("WEBHOOK_RETENTION_INTERVAL", 6*time.Hour),
+			BatchSize:       getEnvAsInt("WEBHOOK_RETENTION_BATCH_SIZE", 500),
+		},
+		Subscription: SubscriptionConfig{
+			WorkerEnabled:      getEnv("SUBSCRIPTION_WORKER_ENABLED", "true") == "true",
+			WorkerInterval:     getEnvAsDuration("SUBSCRIPTION_WORKER_INTERVAL", 15*time.Minute),
+			InvoiceExpiry:      getEnvAsDuration("SUBSCRIPTION_INVOICE_EXPIRY", 72*time.Hour),
+			MaxRenewalAttempts: getEnvAsInt("SUBSCRIPTION_MAX_RENEWAL_ATTEMPTS", 3),
+			BatchSize:          getEnvAsInt("SUBSCRIPTION_WORKER_BATCH_SIZE", 100),
+		},
+		Confirmation: ConfirmationConfig{
+			WorkerEnabled:  getEnv("CONFIRMATION_RETRY_ENABLED", "true") == "true",
+			WorkerInterval: getEnvAsDuration("CONFIRMATION_RETRY_INTERVAL", time.Minute),
+		},
+		DevTools: DevToolsConfig{
+			WebhookSimulator: getEnv("DEV_WEBHOOK_SIMULATOR_ENABLED", "false") == "true",
+		},
 	}
 }
 
@@ -105,3 +206,28 @@ func getEnvAsInt(key string, defaultValue int) int {
 	}
 	return value
 }
+
+// getEnvAsPositiveInt gets environment variable as positive integer with default value
+// Values that aren't integers or aren't above zero fall back to the default
+func getEnvAsPositiveInt(key string, defaultValue int) int {
+	value := getEnvAsInt(key, defaultValue)
+	if value <= 0 {
+		log.Printf("Warning: Non-positive value for %s, using default: %d", key, defaultValue)
+		return defaultValue
+	}
+	return value
+}
+
+// getEnvAsDuration gets environment variable as duration (e.g. "720h") with default value
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+	value, err := time.ParseDuration(valueStr)
+	if err != nil || value <= 0 {
+		log.Printf("Warning: Invalid duration value for %s, using default: %v", key, defaultValue)
+		return defaultValue
+	}
+	return value
+}
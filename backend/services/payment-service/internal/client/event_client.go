@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/event"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/faultinject"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/interceptor"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/serviceauth"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// EventClient handles gRPC communication with Event Service
+// Used to switch organizer plans when subscriptions start, change or end
+type EventClient struct {
+	client pb.EventServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewEventClient creates new event gRPC client instance
+// Connection is lazy and will auto-reconnect if service is unavailable
+// maxRecvMsgSize and maxSendMsgSize limit the size of a single gRPC message (bytes)
+// faults injects configured latency and errors into calls outside production, may be nil
+// identity signs the service token attached to every call, may be nil
+func NewEventClient(grpcURL string, maxRecvMsgSize, maxSendMsgSize int, faults *faultinject.Injector, identity *serviceauth.Identity) (*EventClient, error) {
+	// Use TLS for Cloud Run services (production) or insecure for localhost and docker-compose (development)
+	var creds credentials.TransportCredentials
+	if grpcURL == "localhost:8082" || grpcURL == "127.0.0.1:8082" || grpcURL == "event-service:8082" {
+		creds = insecure.NewCredentials()
+		log.Printf("[EventGRPC] Using insecure connection for local development")
+	} else {
+		// Use TLS for Cloud Run
+		creds = credentials.NewClientTLSFromCert(nil, "")
+		log.Printf("[EventGRPC] Using TLS connection for Cloud Run")
+	}
+
+	conn, err := grpc.NewClient(
+		grpcURL,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(maxRecvMsgSize),
+			grpc.MaxCallSendMsgSize(maxSendMsgSize),
+		),
+		interceptor.UnaryClient(),
+		interceptor.StreamClient(),
+		faults.DialOption(faultinject.TargetEvent),
+		identity.DialOption(serviceauth.ServiceEvent),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event client: %w", err)
+	}
+
+	log.Printf("[EventGRPC] Event client initialized for %s (lazy connection with auto-reconnect)", grpcURL)
+
+	return &EventClient{
+		client: pb.NewEventServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// AssignOrganizerPlan puts an organizer on a plan via gRPC
+func (c *EventClient) AssignOrganizerPlan(ctx context.Context, organizerID, planCode string) error {
+	callCtx, cancel := timeout.WithRPCTimeout(ctx)
+	defer cancel()
+
+	if _, err := c.client.AssignOrganizerPlan(callCtx, &pb.AssignOrganizerPlanRequest{
+		OrganizerId: organizerID,
+		PlanCode:    planCode,
+	}); err != nil {
+		return fmt.Errorf("gRPC call failed: %w", err)
+	}
+
+	log.Printf("[EventGRPC] Organizer %s moved to plan %s", organizerID, planCode)
+
+	return nil
+}
+
+// Close closes the gRPC connection
+func (c *EventClient) Close() error {
+	if c.conn != nil {
+		return c.conn.Close()
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/notification"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/faultinject"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/interceptor"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/serviceauth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// NotificationClient handles gRPC communication with Notification Service
+type NotificationClient struct {
+	client pb.NotificationServiceClient
+	conn   *grpc.ClientConn
+}
+
+// DunningEmailRequest represents a failed subscription renewal to tell the organizer about
+type DunningEmailRequest struct {
+	RecipientEmail   string
+	RecipientName    string
+	PlanName         string
+	Amount           money.Money
+	InvoiceURL       string
+	InvoiceExpiresAt *time.Time
+	Attempt          int
+	MaxAttempts      int
+	Canceled         bool
+}
+
+// NewNotificationClient creates new notification gRPC client instance
+// Connection is lazy and will auto-reconnect if service is unavailable
+// maxRecvMsgSize and maxSendMsgSize limit the size of a single gRPC message (bytes)
+// faults injects configured latency and errors into calls outside production, may be nil
+// identity signs the service token attached to every call, may be nil
+func NewNotificationClient(grpcURL string, maxRecvMsgSize, maxSendMsgSize int, faults *faultinject.Injector, identity *serviceauth.Identity) (*NotificationClient, error) {
+	// Use TLS for Cloud Run services (production) or insecure for localhost (development)
+	var creds credentials.TransportCredentials
+	if grpcURL == "localhost:50055" || grpcURL == "127.0.0.1:50055" {
+		creds = insecure.NewCredentials()
+		log.Printf("[NotificationGRPC] Using insecure connection for local development")
+	} else {
+		// Use TLS for Cloud Run
+		creds = credentials.NewClientTLSFromCert(nil, "")
+		log.Printf("[NotificationGRPC] Using TLS connection for Cloud Run")
+	}
+
+	conn, err := grpc.NewClient(
+		grpcURL,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(maxRecvMsgSize),
+			grpc.MaxCallSendMsgSize(maxSendMsgSize),
+		),
+		interceptor.UnaryClient(),
+		interceptor.StreamClient(),
+		faults.DialOption(faultinject.TargetNotification),
+		identity.DialOption(serviceauth.ServiceNotification),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create notification client: %w", err)
+	}
+
+	log.Printf("[NotificationGRPC] Notification client initialized for %s (lazy connection with auto-reconnect)", grpcURL)
+
+	return &NotificationClient{
+		client: pb.NewNotificationServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// SendDunningEmail sends a failed subscription renewal email via gRPC
+func (c *NotificationClient) SendDunningEmail(ctx context.Context, req *DunningEmailRequest) error {
+	callCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.SendSubscriptionDunningEmailRequest{
+		RecipientEmail: req.RecipientEmail,
+		RecipientName:  req.RecipientName,
+		PlanName:       req.PlanName,
+		Amount:         req.Amount.Float64(),
+		InvoiceUrl:     req.InvoiceURL,
+		Attempt:        int32(req.Attempt),
+		MaxAttempts:    int32(req.MaxAttempts),
+		Canceled:       req.Canceled,
+	}
+	if req.InvoiceExpiresAt != nil {
+		grpcReq.InvoiceExpiresAt = req.InvoiceExpiresAt.Format(time.RFC3339)
+	}
+
+	resp, err := c.client.SendSubscriptionDunningEmail(callCtx, grpcReq)
+	if err != nil {
+		return fmt.Errorf("gRPC call failed: %w", err)
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("dunning email failed: %s", resp.Message)
+	}
+
+	return nil
+}
+
+// Close closes the gRPC connection
+func (c *NotificationClient) Close() error {
+	if c.conn != nil {
+		return c.conn.Close()
+	}
+	return nil
+}
@@ -2,14 +2,30 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
-	"time"
 
 	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/ticketing"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/faultinject"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/interceptor"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/serviceauth"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// Errors of ticketing service refusing to refund an order
+var (
+	ErrOrderNotFound         = errors.New("order not found")
+	ErrOrderNotOwned         = errors.New("order belongs to another user")
+	ErrOrderNotRefundable    = errors.New("order is not refundable")
+	ErrRefundWindowClosed    = errors.New("refund window of the event's refund policy has closed")
+	ErrOrderRefundInProgress = errors.New("order payment is already flagged for refund")
 )
 
 // TicketingClient handles gRPC communication with Ticketing Service
@@ -20,14 +36,52 @@ type TicketingClient struct {
 
 // ConfirmPaymentRequest represents request to confirm payment
 type ConfirmPaymentRequest struct {
-	PaymentID     string  `json:"payment_id"`
-	PaymentMethod string  `json:"payment_method"`
-	Amount        float64 `json:"amount"`
+	PaymentID     string      `json:"payment_id"`
+	PaymentMethod string      `json:"payment_method"`
+	Amount        money.Money `json:"amount"`
+	Currency      string      `json:"currency"`
+	// Paid before the invoice expired, lets a payment racing reservation expiry reinstate the order
+	InvoicePending bool `json:"invoice_pending"`
+}
+
+// ConfirmPaymentResponse represents how ticketing service applied a payment to its order
+type ConfirmPaymentResponse struct {
+	Outcome          string // One of the Confirmation* outcomes
+	RefundReason     string // Why a ConfirmationRefundFlagged payment can't be applied
+	TicketsGenerated int
+}
+
+// Payment confirmation outcomes and refund reasons reported by ticketing service
+const (
+	ConfirmationConfirmed     = "confirmed"
+	ConfirmationAlreadyPaid   = "already_paid"
+	ConfirmationRefundFlagged = "refund_flagged"
+
+	RefundReasonOrderCancelled = "order_cancelled" // Paid after the buyer cancelled the order
+	RefundReasonSoldOut        = "sold_out"        // Paid as the reservation expired, but the tickets sold out meanwhile
+)
+
+// RefundOrderRequest represents request to refund a buyer's order
+type RefundOrderRequest struct {
+	OrderID  string
+	UserID   string
+	RefundID string
+	Reason   string
+}
+
+// RefundOrderResponse represents an order refunded by ticketing service
+type RefundOrderResponse struct {
+	PaymentID     string
+	Amount        money.Money
+	TicketsVoided int
 }
 
 // NewTicketingClient creates new ticketing gRPC client instance
 // Connection is non-blocking and will auto-reconnect when ticketing service becomes available
-func NewTicketingClient(grpcURL string) (*TicketingClient, error) {
+// maxRecvMsgSize and maxSendMsgSize limit the size of a single gRPC message (bytes)
+// faults injects configured latency and errors into calls outside production, may be nil
+// identity signs the service token attached to every call, may be nil
+func NewTicketingClient(grpcURL string, maxRecvMsgSize, maxSendMsgSize int, faults *faultinject.Injector, identity *serviceauth.Identity) (*TicketingClient, error) {
 	// Use TLS for Cloud Run services (production) or insecure for localhost (development)
 	var creds credentials.TransportCredentials
 	if grpcURL == "localhost:50052" || grpcURL == "127.0.0.1:50052" {
@@ -44,6 +98,14 @@ func NewTicketingClient(grpcURL string) (*TicketingClient, error) {
 	conn, err := grpc.NewClient(
 		grpcURL,
 		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(maxRecvMsgSize),
+			grpc.MaxCallSendMsgSize(maxSendMsgSize),
+		),
+		interceptor.UnaryClient(),
+		interceptor.StreamClient(),
+		faults.DialOption(faultinject.TargetTicketing),
+		identity.DialOption(serviceauth.ServiceTicketing),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create ticketing client: %w", err)
@@ -59,34 +121,117 @@ func NewTicketingClient(grpcURL string) (*TicketingClient, error) {
 }
 
 // ConfirmPayment confirms payment via gRPC
-func (c *TicketingClient) ConfirmPayment(orderID string, req *ConfirmPaymentRequest) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+// Payments ticketing service cannot apply to the order are reported with ConfirmationRefundFlagged
+func (c *TicketingClient) ConfirmPayment(ctx context.Context, orderID string, req *ConfirmPaymentRequest) (*ConfirmPaymentResponse, error) {
+	ctx, cancel := timeout.WithRPCTimeout(ctx)
 	defer cancel()
 
 	// Convert to gRPC request
 	grpcReq := &pb.ConfirmPaymentRequest{
-		OrderId:       orderID,
-		PaymentId:     req.PaymentID,
-		PaymentMethod: req.PaymentMethod,
-		Amount:        req.Amount,
+		OrderId:        orderID,
+		PaymentId:      req.PaymentID,
+		PaymentMethod:  req.PaymentMethod,
+		Amount:         req.Amount.Float64(),
+		Currency:       req.Currency,
+		InvoicePending: req.InvoicePending,
 	}
 
 	// Call gRPC service
 	resp, err := c.client.ConfirmPayment(ctx, grpcReq)
 	if err != nil {
-		return fmt.Errorf("gRPC call failed: %w", err)
+		return nil, fmt.Errorf("gRPC call failed: %w", err)
 	}
 
 	// Check response success
 	if !resp.Success {
-		return fmt.Errorf("payment confirmation failed: %s", resp.Message)
+		return nil, fmt.Errorf("payment confirmation failed: %s", resp.Message)
 	}
 
 	log.Printf("[TicketingGRPC] Payment confirmed for order %s, %d tickets generated", orderID, resp.TicketsGenerated)
 
+	return &ConfirmPaymentResponse{
+		Outcome:          resp.Outcome,
+		RefundReason:     resp.RefundReason,
+		TicketsGenerated: int(resp.TicketsGenerated),
+	}, nil
+}
+
+// RefundOrder marks the order refunded and voids its tickets via gRPC
+// Refusals of ticketing service are returned as the Err* errors
+func (c *TicketingClient) RefundOrder(ctx context.Context, req *RefundOrderRequest) (*RefundOrderResponse, error) {
+	ctx, cancel := timeout.WithRPCTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.client.RefundOrder(ctx, &pb.RefundOrderRequest{
+		OrderId:  req.OrderID,
+		UserId:   req.UserID,
+		RefundId: req.RefundID,
+		Reason:   req.Reason,
+	})
+	if err != nil {
+		return nil, refundError(err)
+	}
+
+	log.Printf("[TicketingGRPC] Order %s refunded, %d tickets voided", resp.OrderId, resp.TicketsVoided)
+
+	return &RefundOrderResponse{
+		PaymentID:     resp.PaymentId,
+		Amount:        money.FromFloat(resp.Amount),
+		TicketsVoided: int(resp.TicketsVoided),
+	}, nil
+}
+
+// CheckRefundEligibility checks via gRPC that the buyer may refund the order, without refunding it
+// Refusals of ticketing service are returned as the Err* errors, like RefundOrder
+func (c *TicketingClient) CheckRefundEligibility(ctx context.Context, orderID, userID string) error {
+	ctx, cancel := timeout.WithRPCTimeout(ctx)
+	defer cancel()
+
+	if _, err := c.client.CheckRefundEligibility(ctx, &pb.CheckRefundEligibilityRequest{
+		OrderId: orderID,
+		UserId:  userID,
+	}); err != nil {
+		return refundError(err)
+	}
+
 	return nil
 }
 
+// RecordPaymentRefund reports via gRPC that a payment ticketing service flagged for refund was refunded
+// Returns whether ticketing service marked a refund request refunded
+func (c *TicketingClient) RecordPaymentRefund(ctx context.Context, orderID, paymentID string) (bool, error) {
+	ctx, cancel := timeout.WithRPCTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.client.RecordPaymentRefund(ctx, &pb.RecordPaymentRefundRequest{
+		OrderId:   orderID,
+		PaymentId: paymentID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("gRPC call failed: %w", err)
+	}
+
+	return resp.Recorded, nil
+}
+
+// refundError converts ticketing service's refusal of a refund to its Err* error
+func refundError(err error) error {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.NotFound:
+		return fmt.Errorf("%w: %s", ErrOrderNotFound, st.Message())
+	case codes.PermissionDenied:
+		return ErrOrderNotOwned
+	case codes.FailedPrecondition:
+		return fmt.Errorf("%w: %s", ErrOrderNotRefundable, st.Message())
+	case codes.OutOfRange:
+		return ErrRefundWindowClosed
+	case codes.AlreadyExists:
+		return ErrOrderRefundInProgress
+	}
+	return fmt.Errorf("gRPC call failed: %w", err)
+}
+
 // Close closes the gRPC connection
 func (c *TicketingClient) Close() error {
 	if c.conn != nil {
@@ -122,6 +122,98 @@ func (c *XenditClient) GetInvoice(invoiceID string) (*response.XenditInvoiceResp
 	return &invoiceResp, nil
 }
 
+// ExpireInvoice expires an unpaid invoice in Xendit, it can no longer be paid afterwards
+func (c *XenditClient) ExpireInvoice(invoiceID string) (*response.XenditInvoiceResponse, error) {
+	url := fmt.Sprintf("%s/invoices/%s/expire!", c.baseURL, invoiceID)
+
+	// Create HTTP request
+	httpReq, err := http.NewRequest("POST", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Set headers
+	httpReq.Header.Set("Authorization", c.getAuthHeader())
+
+	// Send request
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	// Check status code
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("invoice not found")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("xendit API error: %s - %s", resp.Status, string(body))
+	}
+
+	// Parse response
+	var invoiceResp response.XenditInvoiceResponse
+	if err := json.Unmarshal(body, &invoiceResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &invoiceResp, nil
+}
+
+// CreateRefund refunds a paid invoice in Xendit
+// The reference ID is sent as idempotency key, so a retried refund isn't paid out twice
+func (c *XenditClient) CreateRefund(req *request.XenditCreateRefundRequest) (*response.XenditRefundResponse, error) {
+	url := fmt.Sprintf("%s/refunds", c.baseURL)
+
+	// Marshal request body
+	jsonData, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	// Create HTTP request
+	httpReq, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Set headers
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", c.getAuthHeader())
+	httpReq.Header.Set("Idempotency-key", req.ReferenceID)
+
+	// Send request
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	// Check status code
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("xendit API error: %s - %s", resp.Status, string(body))
+	}
+
+	// Parse response
+	var refundResp response.XenditRefundResponse
+	if err := json.Unmarshal(body, &refundResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &refundResp, nil
+}
+
 // getAuthHeader returns Basic Auth header for Xendit API
 func (c *XenditClient) getAuthHeader() string {
 	// Xendit uses Basic Auth with API key as username and empty password
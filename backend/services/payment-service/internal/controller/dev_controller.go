@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/service"
+)
+
+// DevController handles local development helpers, only routed outside production
+type DevController struct {
+	simulator         service.WebhookSimulator
+	webhookController *WebhookController
+}
+
+// NewDevController creates new dev controller instance
+func NewDevController(simulator service.WebhookSimulator, webhookController *WebhookController) *DevController {
+	return &DevController{
+		simulator:         simulator,
+		webhookController: webhookController,
+	}
+}
+
+// SimulateWebhook handles POST /dev/simulate-webhook - Fabricate a Xendit callback for an order
+// The callback goes through the real webhook handler, token verification and idempotency included
+func (c *DevController) SimulateWebhook(ctx *gin.Context) {
+	var req request.SimulateWebhookRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	webhook, err := c.simulator.Simulate(ctx.Request.Context(), &req)
+	if err != nil {
+		log.Printf("[ERROR] SimulateWebhook failed for order %s: %v", req.OrderID, err)
+
+		statusCode := http.StatusInternalServerError
+		errorMessage := message.ErrInternalServer
+		errorCode := sharedresponse.CodeInternal
+
+		if errors.Is(err, service.ErrPaymentNotFound) {
+			statusCode = http.StatusNotFound
+			errorMessage = message.ErrPaymentNotFound
+			errorCode = sharedresponse.CodePaymentNotFound
+		} else if errors.Is(err, service.ErrSandboxUnavailable) {
+			statusCode = http.StatusConflict
+			errorMessage = message.ErrSandboxUnavailable
+			errorCode = sharedresponse.CodeConflict
+		}
+
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
+		return
+	}
+
+	log.Printf("[INFO] Simulating %s webhook %s for order %s", req.Status, webhook.WebhookID, req.OrderID)
+
+	// Replace the request with the fabricated callback and hand it to the webhook handler
+	callback, err := http.NewRequestWithContext(ctx.Request.Context(), http.MethodPost, "/api/v1/webhooks/xendit", bytes.NewReader(webhook.Payload))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, err.Error()))
+		return
+	}
+	callback.Header.Set("Content-Type", "application/json")
+	callback.Header.Set("x-callback-token", webhook.CallbackToken)
+	callback.Header.Set("webhook-id", webhook.WebhookID)
+	ctx.Request = callback
+
+	c.webhookController.HandleXenditWebhook(ctx)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"log"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
@@ -28,7 +29,7 @@ func NewPaymentController(paymentService service.PaymentService) *PaymentControl
 func (c *PaymentController) CreateInvoice(ctx *gin.Context) {
 	var req request.CreateInvoiceRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, sharedresponse.Error(message.ErrInvalidRequest, err.Error()))
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
 		return
 	}
 
@@ -39,16 +40,19 @@ func (c *PaymentController) CreateInvoice(ctx *gin.Context) {
 
 		statusCode := http.StatusInternalServerError
 		errorMessage := message.ErrInternalServer
+		errorCode := sharedresponse.CodeInternal
 
 		if errors.Is(err, service.ErrPaymentAlreadyPaid) {
 			statusCode = http.StatusConflict
 			errorMessage = message.ErrPaymentAlreadyPaid
+			errorCode = sharedresponse.CodePaymentAlreadyPaid
 		} else if errors.Is(err, service.ErrXenditAPIError) {
 			statusCode = http.StatusBadGateway
 			errorMessage = message.ErrXenditAPIError
+			errorCode = sharedresponse.CodePaymentProviderError
 		}
 
-		ctx.JSON(statusCode, sharedresponse.Error(errorMessage, err.Error()))
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
 		return
 	}
 
@@ -65,15 +69,44 @@ func (c *PaymentController) GetInvoice(ctx *gin.Context) {
 
 		statusCode := http.StatusInternalServerError
 		errorMessage := message.ErrInternalServer
+		errorCode := sharedresponse.CodeInternal
 
 		if errors.Is(err, service.ErrPaymentNotFound) {
 			statusCode = http.StatusNotFound
 			errorMessage = message.ErrPaymentNotFound
+			errorCode = sharedresponse.CodePaymentNotFound
 		}
 
-		ctx.JSON(statusCode, sharedresponse.Error(errorMessage, err.Error()))
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
 		return
 	}
 
 	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgInvoiceRetrieved, invoice))
 }
+
+// GetPaymentStatus handles GET /status/:orderId - Payment status for checkout page polling
+// Served from cache without calling Xendit; refresh=true syncs with Xendit first (throttled per order)
+func (c *PaymentController) GetPaymentStatus(ctx *gin.Context) {
+	orderID := ctx.Param("orderId")
+	refresh, _ := strconv.ParseBool(ctx.Query("refresh"))
+
+	status, err := c.paymentService.GetPaymentStatus(ctx.Request.Context(), orderID, refresh)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		errorMessage := message.ErrInternalServer
+		errorCode := sharedresponse.CodeInternal
+
+		if errors.Is(err, service.ErrPaymentNotFound) {
+			statusCode = http.StatusNotFound
+			errorMessage = message.ErrPaymentNotFound
+			errorCode = sharedresponse.CodePaymentNotFound
+		} else {
+			log.Printf("[ERROR] GetPaymentStatus failed for order %s: %v", orderID, err)
+		}
+
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, nil))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgPaymentStatusRetrieved, status))
+}
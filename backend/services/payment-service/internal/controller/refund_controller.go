@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedauth "github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/service"
+)
+
+// RefundController handles HTTP requests for buyer refunds
+type RefundController struct {
+	refundService service.RefundService
+}
+
+// NewRefundController creates new refund controller instance
+func NewRefundController(refundService service.RefundService) *RefundController {
+	return &RefundController{
+		refundService: refundService,
+	}
+}
+
+// CreateRefund handles POST /refunds - Refund the buyer's paid order through Xendit
+func (c *RefundController) CreateRefund(ctx *gin.Context) {
+	var req request.RefundRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	refund, err := c.refundService.CreateRefund(ctx.Request.Context(), ctx.GetString(sharedauth.ContextUserID), &req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		errorMessage := message.ErrInternalServer
+		errorCode := sharedresponse.CodeInternal
+
+		if errors.Is(err, service.ErrPaymentNotFound) {
+			statusCode = http.StatusNotFound
+			errorMessage = message.ErrPaymentNotFound
+			errorCode = sharedresponse.CodePaymentNotFound
+		} else if errors.Is(err, service.ErrOrderNotFound) {
+			statusCode = http.StatusNotFound
+			errorMessage = message.ErrOrderNotFound
+			errorCode = sharedresponse.CodeOrderNotFound
+		} else if errors.Is(err, service.ErrRefundForbidden) {
+			statusCode = http.StatusForbidden
+			errorMessage = message.ErrUnauthorized
+			errorCode = sharedresponse.CodeForbidden
+		} else if errors.Is(err, service.ErrRefundNotAllowed) {
+			statusCode = http.StatusConflict
+			errorMessage = message.ErrRefundNotAllowed
+			errorCode = sharedresponse.CodeRefundNotAllowed
+		} else if errors.Is(err, service.ErrRefundWindowClosed) {
+			statusCode = http.StatusConflict
+			errorMessage = message.ErrRefundWindowClosed
+			errorCode = sharedresponse.CodeRefundWindowClosed
+		} else if errors.Is(err, service.ErrRefundAlreadyRequested) {
+			statusCode = http.StatusConflict
+			errorMessage = message.ErrRefundRequested
+			errorCode = sharedresponse.CodeRefundRequested
+		} else if errors.Is(err, service.ErrSandboxUnavailable) {
+			statusCode = http.StatusConflict
+			errorMessage = message.ErrSandboxUnavailable
+			errorCode = sharedresponse.CodeConflict
+		} else if errors.Is(err, service.ErrXenditAPIError) {
+			statusCode = http.StatusBadGateway
+			errorMessage = message.ErrXenditAPIError
+			errorCode = sharedresponse.CodePaymentProviderError
+		}
+		if statusCode >= http.StatusInternalServerError {
+			log.Printf("[ERROR] CreateRefund failed for order %s: %v", req.OrderID, err)
+		}
+
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, sharedresponse.Success(message.MsgRefundRequested, refund))
+}
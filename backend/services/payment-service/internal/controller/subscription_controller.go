@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedauth "github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/service"
+)
+
+// SubscriptionController handles HTTP requests for organizer plan subscriptions
+type SubscriptionController struct {
+	subscriptionService service.SubscriptionService
+}
+
+// NewSubscriptionController creates new subscription controller instance
+func NewSubscriptionController(subscriptionService service.SubscriptionService) *SubscriptionController {
+	return &SubscriptionController{
+		subscriptionService: subscriptionService,
+	}
+}
+
+// ListPlans handles GET /subscriptions/plans - Paid plans and their monthly price
+func (c *SubscriptionController) ListPlans(ctx *gin.Context) {
+	plans, err := c.subscriptionService.ListPlans(ctx.Request.Context())
+	if err != nil {
+		log.Printf("[ERROR] ListPlans failed: %v", err)
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgSubscriptionPlansRetrieved, plans))
+}
+
+// GetSubscription handles GET /subscriptions - Organizer's subscription and latest invoice
+func (c *SubscriptionController) GetSubscription(ctx *gin.Context) {
+	subscription, err := c.subscriptionService.GetSubscription(ctx.Request.Context(), ctx.GetString(sharedauth.ContextUserID))
+	if err != nil {
+		c.respondSubscriptionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgSubscriptionRetrieved, subscription))
+}
+
+// Subscribe handles POST /subscriptions - Subscribe to a paid plan, returns the invoice to pay
+func (c *SubscriptionController) Subscribe(ctx *gin.Context) {
+	var req request.SubscribeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	// Invoices are sent to the organizer's account email
+	subscription, err := c.subscriptionService.Subscribe(
+		ctx.Request.Context(),
+		ctx.GetString(sharedauth.ContextUserID),
+		ctx.GetString(sharedauth.ContextEmail),
+		ctx.GetString(sharedauth.ContextName),
+		&req,
+	)
+	if err != nil {
+		c.respondSubscriptionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, sharedresponse.Success(message.MsgSubscriptionCreated, subscription))
+}
+
+// ChangePlan handles PUT /subscriptions/plan - Upgrade (prorated invoice) or downgrade (at renewal)
+func (c *SubscriptionController) ChangePlan(ctx *gin.Context) {
+	var req request.ChangeSubscriptionPlanRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	subscription, err := c.subscriptionService.ChangePlan(ctx.Request.Context(), ctx.GetString(sharedauth.ContextUserID), &req)
+	if err != nil {
+		c.respondSubscriptionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgSubscriptionPlanChanged, subscription))
+}
+
+// Cancel handles DELETE /subscriptions - Cancel at the end of the paid period
+func (c *SubscriptionController) Cancel(ctx *gin.Context) {
+	subscription, err := c.subscriptionService.Cancel(ctx.Request.Context(), ctx.GetString(sharedauth.ContextUserID))
+	if err != nil {
+		c.respondSubscriptionError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgSubscriptionCanceled, subscription))
+}
+
+// respondSubscriptionError maps subscription errors to HTTP responses
+func (c *SubscriptionController) respondSubscriptionError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrPlanNotBillable):
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrPlanNotBillable, sharedresponse.CodePlanNotBillable, nil))
+	case errors.Is(err, service.ErrSubscriptionNotFound):
+		ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrSubscriptionNotFound, sharedresponse.CodeSubscriptionNotFound, nil))
+	case errors.Is(err, service.ErrSubscriptionAlreadyActive):
+		ctx.JSON(http.StatusConflict, sharedresponse.ErrorWithCode(message.ErrSubscriptionAlreadyActive, sharedresponse.CodeSubscriptionAlreadyActive, nil))
+	case errors.Is(err, service.ErrSubscriptionNotActive):
+		ctx.JSON(http.StatusConflict, sharedresponse.ErrorWithCode(message.ErrSubscriptionNotActive, sharedresponse.CodeSubscriptionNotActive, nil))
+	case errors.Is(err, service.ErrSubscriptionPastDue):
+		ctx.JSON(http.StatusConflict, sharedresponse.ErrorWithCode(message.ErrSubscriptionPastDue, sharedresponse.CodeSubscriptionPastDue, nil))
+	case errors.Is(err, service.ErrXenditAPIError):
+		log.Printf("[ERROR] Subscription invoice failed: %v", err)
+		ctx.JSON(http.StatusBadGateway, sharedresponse.ErrorWithCode(message.ErrXenditAPIError, sharedresponse.CodePaymentProviderError, nil))
+	default:
+		log.Printf("[ERROR] Subscription request failed: %v", err)
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+	}
+}
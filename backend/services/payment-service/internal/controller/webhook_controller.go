@@ -5,46 +5,54 @@ import (
 	"io"
 	"log"
 	"net/http"
-	"strings"
 
 	"github.com/gin-gonic/gin"
+	sharedauth "github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
 	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/serviceauth"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/config"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/message"
-	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/repository"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/request"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/service"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/utility"
 )
 
 // WebhookController handles HTTP requests for webhooks
 type WebhookController struct {
-	webhookService service.WebhookService
-	webhookToken   string
+	webhookService   service.WebhookService
+	webhookToken     string
+	testWebhookToken string // Xendit test mode token, empty when sandbox payments are disabled
 }
 
 // NewWebhookController creates new webhook controller instance
 func NewWebhookController(webhookService service.WebhookService, cfg *config.Config) *WebhookController {
 	return &WebhookController{
-		webhookService: webhookService,
-		webhookToken:   cfg.Xendit.WebhookToken,
+		webhookService:   webhookService,
+		webhookToken:     cfg.Xendit.WebhookToken,
+		testWebhookToken: cfg.Xendit.TestWebhookToken,
 	}
 }
 
 // HandleXenditWebhook handles POST /webhooks/xendit - Xendit webhook callback
+// Callbacks of Xendit test mode carry the test token and only settle sandbox payments
 func (c *WebhookController) HandleXenditWebhook(ctx *gin.Context) {
 	// Step 1: Verify callback token (Xendit uses x-callback-token header)
 	callbackToken := ctx.GetHeader("x-callback-token")
+	process := c.webhookService.ProcessWebhook
 	if err := utility.VerifyCallbackToken(callbackToken, c.webhookToken); err != nil {
-		log.Printf("[ERROR] Invalid webhook signature/token")
-		ctx.JSON(http.StatusUnauthorized, sharedresponse.Error(message.ErrInvalidSignature, err.Error()))
-		return
+		if c.testWebhookToken == "" || utility.VerifyCallbackToken(callbackToken, c.testWebhookToken) != nil {
+			log.Printf("[ERROR] Invalid webhook signature/token")
+			ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrInvalidSignature, sharedresponse.CodeInvalidSignature, err.Error()))
+			return
+		}
+		process = c.webhookService.ProcessSandboxWebhook
 	}
 
 	// Step 2: Read request body
 	body, err := io.ReadAll(ctx.Request.Body)
 	if err != nil {
 		log.Printf("[ERROR] Failed to read webhook body: %v", err)
-		ctx.JSON(http.StatusBadRequest, sharedresponse.Error(message.ErrInvalidRequest, err.Error()))
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
 		return
 	}
 
@@ -62,12 +70,11 @@ func (c *WebhookController) HandleXenditWebhook(ctx *gin.Context) {
 		}
 	}
 
-	// Event type from Xendit is in the body (status field)
-	// We'll determine it in service layer based on status
-	eventType := "invoice.paid" // Default, will be determined by service
+	// Event type from Xendit is in the body (event field, or told apart by the callback's fields)
+	eventType := service.WebhookEventType(body)
 
 	// Step 4: Process webhook
-	if err := c.webhookService.ProcessWebhook(ctx.Request.Context(), webhookID, eventType, body); err != nil {
+	if err := process(ctx.Request.Context(), webhookID, eventType, body); err != nil {
 		// Handle duplicate webhooks (idempotency)
 		if errors.Is(err, service.ErrDuplicateWebhook) {
 			log.Printf("[INFO] Duplicate webhook: %s", webhookID)
@@ -75,10 +82,11 @@ func (c *WebhookController) HandleXenditWebhook(ctx *gin.Context) {
 			return
 		}
 
-		// Handle payment not found (test webhooks or race conditions)
-		if errors.Is(err, repository.ErrPaymentNotFound) || strings.Contains(err.Error(), "payment not found") {
-			log.Printf("[WARN] Payment not found for webhook %s - possibly test webhook or race condition", webhookID)
-			ctx.JSON(http.StatusOK, sharedresponse.Success("Webhook received but payment not found (possibly test webhook)", nil))
+		// Unknown event types and webhooks matching no payment (test webhooks or race conditions)
+		// are kept for admins to replay or dismiss
+		if errors.Is(err, service.ErrWebhookQuarantined) {
+			log.Printf("[WARN] Webhook %s quarantined: %v", webhookID, err)
+			ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgWebhookQuarantined, nil))
 			return
 		}
 
@@ -94,3 +102,149 @@ func (c *WebhookController) HandleXenditWebhook(ctx *gin.Context) {
 	// Step 5: Return success response
 	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgWebhookProcessed, nil))
 }
+
+// ListQuarantined handles GET /admin/webhooks/quarantine - Webhooks that matched no payment, newest first
+func (c *WebhookController) ListQuarantined(ctx *gin.Context) {
+	var req request.ListQuarantinedWebhooksRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	webhooks, err := c.webhookService.ListQuarantined(ctx.Request.Context(), &req)
+	if err != nil {
+		log.Printf("[ERROR] ListQuarantined failed: %v", err)
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, err.Error()))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgQuarantineRetrieved, webhooks))
+}
+
+// ReplayQuarantined handles POST /admin/webhooks/quarantine/:id/replay - Process a quarantined webhook again
+func (c *WebhookController) ReplayQuarantined(ctx *gin.Context) {
+	webhook, err := c.webhookService.ReplayQuarantined(ctx.Request.Context(), ctx.GetString(sharedauth.ContextUserID), ctx.Param("id"))
+	if err != nil {
+		c.handleQuarantineError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgWebhookReplayed, webhook))
+}
+
+// DismissQuarantined handles POST /admin/webhooks/quarantine/:id/dismiss - Drop a quarantined webhook
+func (c *WebhookController) DismissQuarantined(ctx *gin.Context) {
+	webhook, err := c.webhookService.DismissQuarantined(ctx.Request.Context(), ctx.GetString(sharedauth.ContextUserID), ctx.Param("id"))
+	if err != nil {
+		c.handleQuarantineError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgWebhookDismissed, webhook))
+}
+
+// handleQuarantineError maps errors of replaying or dismissing quarantined webhooks to responses
+func (c *WebhookController) handleQuarantineError(ctx *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorMessage := message.ErrInternalServer
+	errorCode := sharedresponse.CodeInternal
+
+	if errors.Is(err, service.ErrWebhookNotFound) {
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrWebhookNotFound
+		errorCode = sharedresponse.CodeWebhookNotFound
+	} else if errors.Is(err, service.ErrWebhookNotQuarantined) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrWebhookNotQuarantined
+		errorCode = sharedresponse.CodeWebhookNotQuarantined
+	} else if errors.Is(err, service.ErrWebhookUnmatched) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrWebhookUnmatched
+		errorCode = sharedresponse.CodeWebhookUnmatched
+	} else if errors.Is(err, service.ErrSandboxMismatch) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrWebhookSandboxMismatch
+		errorCode = sharedresponse.CodeConflict
+	}
+	if statusCode >= http.StatusInternalServerError {
+		log.Printf("[ERROR] Quarantined webhook %s failed: %v", ctx.Param("id"), err)
+	}
+
+	ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
+}
+
+// ReplayConfirmation handles POST /admin/payments/:id/replay and POST /internal/payments/:id/replay - Confirm the order of a paid payment again
+func (c *WebhookController) ReplayConfirmation(ctx *gin.Context) {
+	// Support replays through the admin route, internal tooling through the internal route
+	replayedBy := ctx.GetString(sharedauth.ContextUserID)
+	if replayedBy == "" {
+		replayedBy = serviceauth.Caller(ctx.Request.Context())
+	}
+
+	confirmation, err := c.webhookService.ReplayConfirmation(ctx.Request.Context(), replayedBy, ctx.Param("id"))
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		errorMessage := message.ErrInternalServer
+		errorCode := sharedresponse.CodeInternal
+
+		if errors.Is(err, service.ErrPaymentNotFound) {
+			statusCode = http.StatusNotFound
+			errorMessage = message.ErrPaymentNotFound
+			errorCode = sharedresponse.CodePaymentNotFound
+		} else if errors.Is(err, service.ErrPaymentNotPaid) {
+			statusCode = http.StatusConflict
+			errorMessage = message.ErrPaymentNotPaid
+			errorCode = sharedresponse.CodePaymentNotPaid
+		} else if errors.Is(err, service.ErrPaymentCompensated) {
+			statusCode = http.StatusConflict
+			errorMessage = message.ErrPaymentCompensated
+			errorCode = sharedresponse.CodePaymentCompensated
+		} else if errors.Is(err, service.ErrConfirmationFailed) {
+			statusCode = http.StatusBadGateway
+			errorMessage = message.ErrConfirmationFailed
+			errorCode = sharedresponse.CodeConfirmationFailed
+		}
+		if statusCode >= http.StatusInternalServerError {
+			log.Printf("[ERROR] Replaying confirmation of payment %s failed: %v", ctx.Param("id"), err)
+		}
+
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgConfirmationReplayed, confirmation))
+}
+
+// ListSagas handles GET /admin/sagas - Where paid payments sit in the paid-order saga, most recently updated first
+func (c *WebhookController) ListSagas(ctx *gin.Context) {
+	var req request.ListPaymentSagasRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	sagas, err := c.webhookService.ListSagas(ctx.Request.Context(), &req)
+	if err != nil {
+		log.Printf("[ERROR] ListSagas failed: %v", err)
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, err.Error()))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgSagasRetrieved, sagas))
+}
+
+// GetSaga handles GET /admin/sagas/:id - Saga of one payment
+func (c *WebhookController) GetSaga(ctx *gin.Context) {
+	saga, err := c.webhookService.GetSaga(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		if errors.Is(err, service.ErrSagaNotFound) {
+			ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrSagaNotFound, sharedresponse.CodeSagaNotFound, err.Error()))
+			return
+		}
+		log.Printf("[ERROR] GetSaga failed for payment %s: %v", ctx.Param("id"), err)
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, err.Error()))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgSagaRetrieved, saga))
+}
@@ -7,20 +7,33 @@ import (
 	"time"
 
 	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/payment"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/serviceauth"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/request"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/service"
 )
 
+// ServiceAuthPolicy lists the services allowed to call each method
+var ServiceAuthPolicy = serviceauth.Policy{
+	"/payment.PaymentService/CreateInvoice":     {serviceauth.ServiceTicketing},
+	"/payment.PaymentService/GetPaymentStatus":  {serviceauth.ServiceTicketing},
+	"/payment.PaymentService/ExpireInvoice":     {serviceauth.ServiceTicketing},
+	"/payment.PaymentService/RecordTicketEmail": {serviceauth.ServiceTicketing},
+}
+
 // PaymentGRPCServer implements the gRPC PaymentService interface
 type PaymentGRPCServer struct {
 	pb.UnimplementedPaymentServiceServer
 	paymentService service.PaymentService
+	webhookService service.WebhookService
 }
 
 // NewPaymentGRPCServer creates new gRPC server instance
-func NewPaymentGRPCServer(paymentService service.PaymentService) *PaymentGRPCServer {
+// webhookService records the saga steps ticketing service reports
+func NewPaymentGRPCServer(paymentService service.PaymentService, webhookService service.WebhookService) *PaymentGRPCServer {
 	return &PaymentGRPCServer{
 		paymentService: paymentService,
+		webhookService: webhookService,
 	}
 }
 
@@ -31,11 +44,12 @@ func (s *PaymentGRPCServer) CreateInvoice(ctx context.Context, req *pb.CreateInv
 	// Create internal request (map gRPC request to service request)
 	createInvoiceReq := &request.CreateInvoiceRequest{
 		OrderID:            req.OrderId,
-		Amount:             req.Amount,
+		Amount:             money.FromFloat(req.Amount),
 		PayerEmail:         req.Email,
 		Description:        req.Description,
 		SuccessRedirectURL: "",
 		FailureRedirectURL: "",
+		Sandbox:            req.Sandbox,
 	}
 
 	// Call service layer
@@ -56,7 +70,7 @@ func (s *PaymentGRPCServer) CreateInvoice(ctx context.Context, req *pb.CreateInv
 		InvoiceId:  invoiceResp.ExternalID, // Using external ID as invoice ID
 		InvoiceUrl: invoiceResp.InvoiceURL,
 		ExternalId: invoiceResp.ExternalID,
-		Amount:     invoiceResp.Amount,
+		Amount:     invoiceResp.Amount.Float64(),
 		Status:     invoiceResp.Status,
 		ExpiresAt:  expiresAt,
 		CreatedAt:  invoiceResp.CreatedAt.Format(time.RFC3339),
@@ -79,12 +93,17 @@ func (s *PaymentGRPCServer) GetPaymentStatus(ctx context.Context, req *pb.GetPay
 
 	// Convert to protobuf response
 	response := &pb.GetPaymentStatusResponse{
-		PaymentId: invoice.ID,
-		OrderId:   invoice.OrderID,
-		InvoiceId: invoice.ExternalID,
-		Amount:    invoice.Amount,
-		Status:    invoice.Status,
-		CreatedAt: invoice.CreatedAt.Format(time.RFC3339),
+		PaymentId:  invoice.ID,
+		OrderId:    invoice.OrderID,
+		InvoiceId:  invoice.ExternalID,
+		Amount:     invoice.Amount.Float64(),
+		Status:     invoice.Status,
+		CreatedAt:  invoice.CreatedAt.Format(time.RFC3339),
+		InvoiceUrl: invoice.InvoiceURL,
+	}
+
+	if invoice.ExpiresAt != nil {
+		response.ExpiresAt = invoice.ExpiresAt.Format(time.RFC3339)
 	}
 
 	// Note: PaymentMethod and PaidAt are not in InvoiceResponse
@@ -93,3 +112,32 @@ func (s *PaymentGRPCServer) GetPaymentStatus(ctx context.Context, req *pb.GetPay
 	log.Printf("[gRPC] GetPaymentStatus success for order %s - Status: %s", req.OrderId, invoice.Status)
 	return response, nil
 }
+
+// ExpireInvoice expires the open invoices of a cancelled order (gRPC endpoint)
+func (s *PaymentGRPCServer) ExpireInvoice(ctx context.Context, req *pb.ExpireInvoiceRequest) (*pb.ExpireInvoiceResponse, error) {
+	log.Printf("[gRPC] ExpireInvoice request for order: %s (reason: %s)", req.OrderId, req.Reason)
+
+	result, err := s.paymentService.ExpireInvoice(ctx, req.OrderId)
+	if err != nil {
+		log.Printf("[gRPC] ExpireInvoice failed for order %s: %v", req.OrderId, err)
+		return nil, fmt.Errorf("failed to expire invoice: %w", err)
+	}
+
+	log.Printf("[gRPC] ExpireInvoice success for order %s - %d invoices expired, already paid: %t", req.OrderId, result.InvoicesExpired, result.AlreadyPaid)
+	return &pb.ExpireInvoiceResponse{
+		OrderId:         result.OrderID,
+		InvoicesExpired: int32(result.InvoicesExpired),
+		AlreadyPaid:     result.AlreadyPaid,
+	}, nil
+}
+
+// RecordTicketEmail records that the tickets of a paid order were emailed (gRPC endpoint)
+func (s *PaymentGRPCServer) RecordTicketEmail(ctx context.Context, req *pb.RecordTicketEmailRequest) (*pb.RecordTicketEmailResponse, error) {
+	recorded, err := s.webhookService.RecordTicketEmail(ctx, req.OrderId, req.PaymentId)
+	if err != nil {
+		log.Printf("[gRPC] RecordTicketEmail failed for order %s: %v", req.OrderId, err)
+		return nil, fmt.Errorf("failed to record ticket email: %w", err)
+	}
+
+	return &pb.RecordTicketEmailResponse{Recorded: recorded}, nil
+}
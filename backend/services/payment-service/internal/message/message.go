@@ -7,6 +7,29 @@ const (
 	MsgWebhookProcessed   = "Webhook processed successfully"
 	MsgRefundRequested    = "Refund requested successfully"
 	MsgRefundCompleted    = "Refund completed successfully"
+
+	// Checkout page polling
+	MsgPaymentStatusRetrieved = "Payment status retrieved successfully"
+
+	// Webhook quarantine
+	MsgWebhookQuarantined  = "Webhook received but matched no payment, quarantined for review"
+	MsgQuarantineRetrieved = "Quarantined webhooks retrieved successfully"
+	MsgWebhookReplayed     = "Webhook replayed successfully"
+	MsgWebhookDismissed    = "Webhook dismissed successfully"
+
+	// Order confirmation retries
+	MsgConfirmationReplayed = "Payment confirmation replayed successfully"
+
+	// Paid-order saga
+	MsgSagasRetrieved = "Payment sagas retrieved successfully"
+	MsgSagaRetrieved  = "Payment saga retrieved successfully"
+
+	// Plan subscriptions
+	MsgSubscriptionPlansRetrieved = "Subscription plans retrieved successfully"
+	MsgSubscriptionRetrieved      = "Subscription retrieved successfully"
+	MsgSubscriptionCreated        = "Subscription created, pay the invoice to activate it"
+	MsgSubscriptionPlanChanged    = "Subscription plan changed successfully"
+	MsgSubscriptionCanceled       = "Subscription canceled successfully"
 )
 
 // Error messages
@@ -22,5 +45,29 @@ const (
 	ErrPaymentAlreadyPaid  = "Payment already completed"
 	ErrPaymentExpired      = "Payment has expired"
 	ErrRefundNotAllowed    = "Refund not allowed for this order"
+	ErrRefundWindowClosed  = "Refund window of the event's refund policy has closed"
+	ErrRefundRequested     = "Refund already requested for this order"
+	ErrOrderNotFound       = "Order not found"
 	ErrXenditAPIError      = "Xendit API error"
+	ErrSandboxUnavailable  = "Sandbox payments are not configured"
+
+	// Webhook quarantine
+	ErrWebhookNotQuarantined  = "Webhook is not quarantined"
+	ErrWebhookUnmatched       = "Webhook still matches no payment, it stays quarantined"
+	ErrWebhookSandboxMismatch = "Webhook mode does not match the payment"
+
+	// Order confirmation retries
+	ErrPaymentNotPaid     = "Payment is not paid, there is nothing to confirm"
+	ErrConfirmationFailed = "Ticketing service did not confirm the order, the confirmation will be retried"
+	ErrPaymentCompensated = "Payment is refunded instead of applied to its order"
+
+	// Paid-order saga
+	ErrSagaNotFound = "Payment saga not found"
+
+	// Plan subscriptions
+	ErrPlanNotBillable           = "Plan can't be subscribed to"
+	ErrSubscriptionNotFound      = "Subscription not found"
+	ErrSubscriptionAlreadyActive = "Organizer already has an active subscription, change its plan instead"
+	ErrSubscriptionNotActive     = "Subscription is not active"
+	ErrSubscriptionPastDue       = "Subscription renewal is unpaid, pay the open invoice first"
 )
@@ -1,21 +1,30 @@
 package entity
 
-import "time"
+import (
+	"fmt"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
 
 // PaymentTransaction represents a payment transaction record
+// Each payment attempt of an order is its own transaction, numbered from 1
 type PaymentTransaction struct {
 	ID            string
 	OrderID       string
-	ExternalID    string // ORDER-{order_id}
+	AttemptNumber int
+	ExternalID    string // ORDER-{order_id}, ORDER-{order_id}-{attempt_number} for retries
 	InvoiceID     *string
 	InvoiceURL    *string
-	Amount        float64
+	Amount        money.Money
+	Currency      string // ISO 4217 code of the invoice, replaced by the currency the provider reports paid
 	PaymentMethod *string
 	Status        string // pending, paid, expired, failed
 	PaidAt        *time.Time
 	ExpiresAt     *time.Time
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
+	IsSandbox     bool // Invoiced with the test keys for a sandbox event's test order
 }
 
 // Payment status constants
@@ -41,3 +50,12 @@ func (p *PaymentTransaction) IsExpired() bool {
 	}
 	return time.Now().After(*p.ExpiresAt)
 }
+
+// PaymentExternalID returns the Xendit external ID of an order's payment attempt
+// The first attempt keeps the ORDER-{order_id} format of payments made before attempts were numbered
+func PaymentExternalID(orderID string, attemptNumber int) string {
+	if attemptNumber <= 1 {
+		return fmt.Sprintf("ORDER-%s", orderID)
+	}
+	return fmt.Sprintf("ORDER-%s-%d", orderID, attemptNumber)
+}
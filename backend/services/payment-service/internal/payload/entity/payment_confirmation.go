@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// PaymentConfirmation tracks the order confirmation of a paid payment with ticketing service
+// Confirmations that failed are retried by the confirmation retry worker
+type PaymentConfirmation struct {
+	PaymentID         string
+	OrderID           string
+	ProviderPaymentID string // Xendit invoice or payment ID, sent to ticketing service as payment ID
+	PaymentMethod     *string
+	Amount            money.Money // Paid amount
+	Currency          *string
+	Status            string  // pending, confirmed, failed
+	Outcome           *string // Confirmation outcome reported by ticketing service
+	Attempts          int     // Confirmation attempts so far, including the current one
+	NextAttemptAt     time.Time
+	LastError         *string
+	ConfirmedAt       *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// Payment confirmation status constants
+const (
+	ConfirmationStatusPending   = "pending" // Not confirmed yet, retried at NextAttemptAt
+	ConfirmationStatusConfirmed = "confirmed"
+	ConfirmationStatusFailed    = "failed" // Gave up after the last attempt, replayed by support
+)
+
+// IsConfirmed checks if ticketing service confirmed the order
+func (c *PaymentConfirmation) IsConfirmed() bool {
+	return c.Status == ConfirmationStatusConfirmed
+}
@@ -0,0 +1,49 @@
+package entity
+
+import "time"
+
+// PaymentSaga tracks a paid payment through the paid-order flow
+// Steps complete in order: payment captured, order confirmed, tickets generated, email sent.
+// A payment that can't be applied to its order is compensated by refunding it
+type PaymentSaga struct {
+	PaymentID          string
+	OrderID            string
+	ProviderPaymentID  string // Payment ID ticketing service records on the order
+	Step               string // Last completed step
+	Status             string // running, completed, compensating, compensated, failed
+	TicketsGenerated   int
+	CompensationReason *string // Why the payment is refunded instead of applied
+	LastError          *string
+	CapturedAt         time.Time
+	OrderConfirmedAt   *time.Time
+	TicketsGeneratedAt *time.Time
+	EmailSentAt        *time.Time // Set when ticketing service reported the email, even ahead of the tickets step
+	CompensatedAt      *time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// Payment saga steps, in order
+const (
+	SagaStepPaymentCaptured  = "payment_captured"
+	SagaStepOrderConfirmed   = "order_confirmed"
+	SagaStepTicketsGenerated = "tickets_generated"
+	SagaStepEmailSent        = "email_sent"
+)
+
+// Payment saga status constants
+const (
+	SagaStatusRunning      = "running"
+	SagaStatusCompleted    = "completed"    // Buyer got their tickets by email
+	SagaStatusCompensating = "compensating" // Refund requested, or queued for support to refund manually
+	SagaStatusCompensated  = "compensated"  // Payment refunded
+	SagaStatusFailed       = "failed"       // Compensation failed, support refunds manually
+)
+
+// CompensationConfirmationFailed is the compensation reason of payments ticketing service never confirmed
+const CompensationConfirmationFailed = "confirmation_failed"
+
+// IsCompensated checks if the payment is, or is being, refunded instead of applied to its order
+func (s *PaymentSaga) IsCompensated() bool {
+	return s.Status == SagaStatusCompensating || s.Status == SagaStatusCompensated
+}
@@ -1,24 +1,32 @@
 package entity
 
-import "time"
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
 
 // Refund represents a refund transaction
 type Refund struct {
 	ID                   string
 	OrderID              string
 	PaymentTransactionID string
-	Amount               float64
+	Amount               money.Money
 	Reason               string
 	Status               string // pending, processing, completed, failed
 	DisbursementID       *string
+	RequestedBy          *string // Buyer who requested the refund
+	ProviderRefundID     *string // Xendit refund ID
+	FailureReason        *string
 	ProcessedAt          *time.Time
 	CreatedAt            time.Time
+	UpdatedAt            time.Time
 }
 
 // Refund status constants
 const (
-	RefundStatusPending    = "pending"
-	RefundStatusProcessing = "processing"
+	RefundStatusPending    = "pending"    // Order being refunded by ticketing service
+	RefundStatusProcessing = "processing" // Refund sent to Xendit, waiting for the payment channel
 	RefundStatusCompleted  = "completed"
 	RefundStatusFailed     = "failed"
 )
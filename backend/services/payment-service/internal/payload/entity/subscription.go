@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// PlanPrice represents the monthly price of a paid organizer plan
+type PlanPrice struct {
+	PlanCode     string
+	PlanName     string
+	MonthlyPrice money.Money
+	UpdatedAt    time.Time
+}
+
+// Subscription represents an organizer's paid plan subscription
+type Subscription struct {
+	ID                 string
+	OrganizerID        string
+	PlanCode           string
+	PendingPlanCode    *string // Downgrade applied at the next renewal
+	BillingEmail       string
+	BillingName        string
+	Status             string // pending, active, past_due, canceled
+	CurrentPeriodStart *time.Time
+	CurrentPeriodEnd   *time.Time
+	CancelAtPeriodEnd  bool
+	CanceledAt         *time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// Subscription status constants
+const (
+	SubscriptionStatusPending  = "pending" // Waiting for the first invoice to be paid
+	SubscriptionStatusActive   = "active"
+	SubscriptionStatusPastDue  = "past_due" // Renewal failed, dunning in progress
+	SubscriptionStatusCanceled = "canceled"
+)
+
+// IsCurrent checks if the organizer is on the subscribed plan
+// Past due subscriptions keep the plan until dunning gives up
+func (s *Subscription) IsCurrent() bool {
+	return s.Status == SubscriptionStatusActive || s.Status == SubscriptionStatusPastDue
+}
+
+// SubscriptionInvoice represents a Xendit invoice of a subscription
+type SubscriptionInvoice struct {
+	ID             string
+	SubscriptionID string
+	Kind           string // initial, renewal, proration
+	PlanCode       string // Plan the organizer gets once paid
+	ExternalID     string // SUB-{id}
+	InvoiceID      *string
+	InvoiceURL     *string
+	Amount         money.Money
+	PeriodStart    time.Time
+	PeriodEnd      time.Time
+	Attempt        int
+	Status         string // pending, paid, expired
+	PaidAt         *time.Time
+	ExpiresAt      *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Subscription invoice kind constants
+const (
+	SubscriptionInvoiceInitial   = "initial"
+	SubscriptionInvoiceRenewal   = "renewal"
+	SubscriptionInvoiceProration = "proration" // Upgrade for the rest of the current period
+)
+
+// Subscription invoice status constants
+const (
+	SubscriptionInvoiceStatusPending = "pending"
+	SubscriptionInvoiceStatusPaid    = "paid"
+	SubscriptionInvoiceStatusExpired = "expired"
+)
+
+// SubscriptionExternalIDPrefix marks Xendit invoices of subscriptions, ticket payments use ORDER-
+const SubscriptionExternalIDPrefix = "SUB-"
+
+// IsOpen checks if the invoice can still be paid
+func (i *SubscriptionInvoice) IsOpen(now time.Time) bool {
+	if i.Status != SubscriptionInvoiceStatusPending {
+		return false
+	}
+	return i.ExpiresAt == nil || now.Before(*i.ExpiresAt)
+}
@@ -4,20 +4,26 @@ import "time"
 
 // WebhookEvent represents a webhook event for idempotency tracking
 type WebhookEvent struct {
-	ID          string
-	WebhookID   string // Unique ID from Xendit
-	EventType   string // invoice.paid, invoice.expired, etc.
-	Payload     string // JSONB - full webhook payload
-	ProcessedAt *time.Time
-	Status      string // pending, processed, failed
-	CreatedAt   time.Time
+	ID               string
+	WebhookID        string // Unique ID from Xendit
+	EventType        string // invoice.paid, invoice.expired, etc.
+	Payload          string // JSONB - full webhook payload
+	ProcessedAt      *time.Time
+	Status           string  // pending, processed, failed, quarantined, dismissed
+	Sandbox          bool    // Sent with the test webhook token
+	QuarantineReason *string // Why the webhook could not be matched
+	ResolvedBy       *string // Admin who replayed or dismissed the quarantined webhook
+	ResolvedAt       *time.Time
+	CreatedAt        time.Time
 }
 
 // Webhook status constants
 const (
-	WebhookStatusPending   = "pending"
-	WebhookStatusProcessed = "processed"
-	WebhookStatusFailed    = "failed"
+	WebhookStatusPending     = "pending"
+	WebhookStatusProcessed   = "processed"
+	WebhookStatusFailed      = "failed"
+	WebhookStatusQuarantined = "quarantined" // Unknown event type or no matching payment, waiting for an admin
+	WebhookStatusDismissed   = "dismissed"   // Quarantined webhook an admin decided to drop
 )
 
 // Event type constants
@@ -25,9 +31,24 @@ const (
 	EventTypeInvoicePaid    = "invoice.paid"
 	EventTypeInvoiceExpired = "invoice.expired"
 	EventTypeInvoiceFailed  = "invoice.failed"
+
+	// Payment method callbacks
+	EventTypeVirtualAccountCreated = "virtual_account.created" // Fixed virtual account created or updated
+	EventTypeQRPaid                = "qr.payment"              // QRIS payment succeeded
+	EventTypeCardFailed            = "card.failed"             // Card charge declined
+	EventTypeRefundSucceeded       = "refund.succeeded"
+	EventTypeRefundFailed          = "refund.failed"
+
+	// EventTypeUnknown is a callback none of the handlers understand, it is quarantined
+	EventTypeUnknown = "unknown"
 )
 
 // IsProcessed checks if webhook has been processed
 func (w *WebhookEvent) IsProcessed() bool {
 	return w.Status == WebhookStatusProcessed
 }
+
+// IsQuarantined checks if webhook is waiting for an admin to replay or dismiss it
+func (w *WebhookEvent) IsQuarantined() bool {
+	return w.Status == WebhookStatusQuarantined
+}
@@ -1,19 +1,23 @@
 package request
 
+import "github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+
 // CreateInvoiceRequest represents request to create payment invoice
 type CreateInvoiceRequest struct {
 	OrderID       string  `json:"order_id" binding:"required,uuid"`
-	Amount        float64 `json:"amount" binding:"required,min=0"`
+	Amount        money.Money `json:"amount" binding:"required,min=0"`
 	PayerEmail    string  `json:"payer_email" binding:"required,email"`
 	Description   string  `json:"description" binding:"required"`
 	SuccessRedirectURL string `json:"success_redirect_url,omitempty"`
 	FailureRedirectURL string `json:"failure_redirect_url,omitempty"`
+	Sandbox            bool   `json:"sandbox"` // Test order of a sandbox event, invoiced with the test keys
+	PaymentMethod      string `json:"payment_method,omitempty"` // Method the buyer retries with (e.g. BCA, OVO, CREDIT_CARD), any when empty
 }
 
 // XenditCreateInvoiceRequest represents Xendit API create invoice request
 type XenditCreateInvoiceRequest struct {
 	ExternalID         string   `json:"external_id"`
-	Amount             float64  `json:"amount"`
+	Amount             money.Money  `json:"amount"`
 	PayerEmail         string   `json:"payer_email"`
 	Description        string   `json:"description"`
 	InvoiceDuration    int      `json:"invoice_duration"` // in seconds
@@ -21,18 +25,55 @@ type XenditCreateInvoiceRequest struct {
 	FailureRedirectURL string   `json:"failure_redirect_url,omitempty"`
 	Currency           string   `json:"currency"`
 	Items              []XenditInvoiceItem `json:"items,omitempty"`
+	PaymentMethods     []string `json:"payment_methods,omitempty"` // Methods the invoice page offers, all when empty
 }
 
 // XenditInvoiceItem represents an item in Xendit invoice
 type XenditInvoiceItem struct {
 	Name     string  `json:"name"`
 	Quantity int     `json:"quantity"`
-	Price    float64 `json:"price"`
+	Price    money.Money `json:"price"`
 	Category string  `json:"category,omitempty"`
 }
 
 // RefundRequest represents request to create refund
 type RefundRequest struct {
 	OrderID string `json:"order_id" binding:"required,uuid"`
-	Reason  string `json:"reason"`
+	Reason  string `json:"reason" binding:"max=255"`
+}
+
+// XenditCreateRefundRequest represents Xendit API create refund request
+type XenditCreateRefundRequest struct {
+	InvoiceID   string      `json:"invoice_id"`
+	ReferenceID string      `json:"reference_id"` // Refund ID, also sent as idempotency key
+	Amount      money.Money `json:"amount"`
+	Currency    string      `json:"currency"`
+	Reason      string      `json:"reason"` // FRAUDULENT, DUPLICATE, REQUESTED_BY_CUSTOMER, CANCELLATION or OTHERS
+}
+
+// SimulateWebhookRequest represents request to simulate a Xendit invoice callback (development only)
+type SimulateWebhookRequest struct {
+	OrderID       string `json:"order_id" binding:"required,uuid"`
+	Status        string `json:"status" binding:"required,oneof=PAID EXPIRED"`
+	PaymentMethod string `json:"payment_method"` // Defaults to BANK_TRANSFER for PAID
+}
+
+// DefaultQuarantineLimit is the number of quarantined webhooks listed when no limit is given
+const DefaultQuarantineLimit = 50
+
+// ListQuarantinedWebhooksRequest represents the query of the webhook quarantine list
+type ListQuarantinedWebhooksRequest struct {
+	EventType string `form:"event_type"`
+	Limit     int    `form:"limit" binding:"omitempty,min=1,max=200"`
+}
+
+// DefaultSagaLimit is the number of payment sagas listed when no limit is given
+const DefaultSagaLimit = 50
+
+// ListPaymentSagasRequest represents the query of the payment saga list
+type ListPaymentSagasRequest struct {
+	Status  string `form:"status" binding:"omitempty,oneof=running completed compensating compensated failed"`
+	Step    string `form:"step" binding:"omitempty,oneof=payment_captured order_confirmed tickets_generated email_sent"`
+	OrderID string `form:"order_id" binding:"omitempty,uuid"`
+	Limit   int    `form:"limit" binding:"omitempty,min=1,max=200"`
 }
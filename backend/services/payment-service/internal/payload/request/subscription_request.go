@@ -0,0 +1,12 @@
+package request
+
+// SubscribeRequest represents request to subscribe to a paid organizer plan
+type SubscribeRequest struct {
+	Plan string `json:"plan" binding:"required,max=20"`
+}
+
+// ChangeSubscriptionPlanRequest represents request to move a subscription to another paid plan
+// Upgrades are invoiced prorated for the rest of the period, downgrades apply at the next renewal
+type ChangeSubscriptionPlanRequest struct {
+	Plan string `json:"plan" binding:"required,max=20"`
+}
@@ -3,6 +3,7 @@ package response
 import (
 	"time"
 
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
 )
 
@@ -12,10 +13,25 @@ type InvoiceResponse struct {
 	OrderID       string     `json:"order_id"`
 	ExternalID    string     `json:"external_id"`
 	InvoiceURL    string     `json:"invoice_url"`
-	Amount        float64    `json:"amount"`
+	Amount        money.Money    `json:"amount"`
 	Status        string     `json:"status"`
 	ExpiresAt     *time.Time `json:"expires_at"`
 	CreatedAt     time.Time  `json:"created_at"`
+	AttemptNumber int        `json:"attempt_number"`
+	PaymentMethod *string    `json:"payment_method,omitempty"`
+	Attempts      []PaymentAttemptResponse `json:"attempts,omitempty"` // All payment attempts of the order, oldest first
+}
+
+// PaymentAttemptResponse represents one payment attempt in an invoice's history
+type PaymentAttemptResponse struct {
+	AttemptNumber int         `json:"attempt_number"`
+	ExternalID    string      `json:"external_id"`
+	PaymentMethod *string     `json:"payment_method,omitempty"`
+	Status        string      `json:"status"` // pending, paid, expired, failed
+	Amount        money.Money `json:"amount"`
+	PaidAt        *time.Time  `json:"paid_at,omitempty"`
+	ExpiresAt     *time.Time  `json:"expires_at,omitempty"`
+	CreatedAt     time.Time   `json:"created_at"`
 }
 
 // XenditInvoiceResponse represents Xendit API invoice response
@@ -25,7 +41,7 @@ type XenditInvoiceResponse struct {
 	UserID                 string       `json:"user_id"`
 	Status                 string       `json:"status"`
 	MerchantName           string       `json:"merchant_name"`
-	Amount                 float64      `json:"amount"`
+	Amount                 money.Money      `json:"amount"`
 	PayerEmail             string       `json:"payer_email"`
 	Description            string       `json:"description"`
 	ExpiryDate             time.Time    `json:"expiry_date"`
@@ -55,8 +71,9 @@ type XenditWebhookPayload struct {
 	ExternalID        string    `json:"external_id"`
 	UserID            string    `json:"user_id"`
 	Status            string    `json:"status"`
-	Amount            float64   `json:"amount"`
-	PaidAmount        float64   `json:"paid_amount,omitempty"`
+	Amount            money.Money   `json:"amount"`
+	PaidAmount        money.Money   `json:"paid_amount,omitempty"`
+	Currency          string    `json:"currency,omitempty"`
 	PayerEmail        string    `json:"payer_email"`
 	Description       string    `json:"description"`
 	PaymentMethod     string    `json:"payment_method,omitempty"`
@@ -83,25 +100,102 @@ func ToInvoiceResponse(payment *entity.PaymentTransaction) *InvoiceResponse {
 		Status:     payment.Status,
 		ExpiresAt:  payment.ExpiresAt,
 		CreatedAt:  payment.CreatedAt,
+
+		AttemptNumber: payment.AttemptNumber,
+		PaymentMethod: payment.PaymentMethod,
 	}
 }
 
+// ToPaymentAttemptResponses converts the payment attempts of an order to their history
+func ToPaymentAttemptResponses(attempts []*entity.PaymentTransaction) []PaymentAttemptResponse {
+	responses := make([]PaymentAttemptResponse, 0, len(attempts))
+	for _, attempt := range attempts {
+		responses = append(responses, PaymentAttemptResponse{
+			AttemptNumber: attempt.AttemptNumber,
+			ExternalID:    attempt.ExternalID,
+			PaymentMethod: attempt.PaymentMethod,
+			Status:        attempt.Status,
+			Amount:        attempt.Amount,
+			PaidAt:        attempt.PaidAt,
+			ExpiresAt:     attempt.ExpiresAt,
+			CreatedAt:     attempt.CreatedAt,
+		})
+	}
+	return responses
+}
+
+// PaymentStatusResponse represents the payment status polled by checkout pages
+// Served from Redis or the database, kept current by webhooks
+type PaymentStatusResponse struct {
+	OrderID    string      `json:"order_id"`
+	Status     string      `json:"status"` // pending, paid, expired, failed
+	InvoiceURL string      `json:"invoice_url,omitempty"`
+	Amount     money.Money `json:"amount"`
+	PaidAt     *time.Time  `json:"paid_at,omitempty"`
+	ExpiresAt  *time.Time  `json:"expires_at,omitempty"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+}
+
+// Pending checks if the payment may still change
+func (r *PaymentStatusResponse) Pending() bool {
+	return r.Status == entity.PaymentStatusPending
+}
+
+// ToPaymentStatusResponse converts PaymentTransaction entity to payment status response
+func ToPaymentStatusResponse(payment *entity.PaymentTransaction) *PaymentStatusResponse {
+	invoiceURL := ""
+	if payment.InvoiceURL != nil {
+		invoiceURL = *payment.InvoiceURL
+	}
+
+	return &PaymentStatusResponse{
+		OrderID:    payment.OrderID,
+		Status:     payment.Status,
+		InvoiceURL: invoiceURL,
+		Amount:     payment.Amount,
+		PaidAt:     payment.PaidAt,
+		ExpiresAt:  payment.ExpiresAt,
+		UpdatedAt:  payment.UpdatedAt,
+	}
+}
+
+// ExpireInvoiceResponse represents the invoices of a cancelled order expired at Xendit
+type ExpireInvoiceResponse struct {
+	OrderID         string `json:"order_id"`
+	InvoicesExpired int    `json:"invoices_expired"`
+	AlreadyPaid     bool   `json:"already_paid"` // An invoice was paid before it could be expired
+}
+
 // RefundResponse represents refund response
 type RefundResponse struct {
-	ID        string    `json:"id"`
-	OrderID   string    `json:"order_id"`
-	Amount    float64   `json:"amount"`
-	Status    string    `json:"status"`
-	CreatedAt time.Time `json:"created_at"`
+	ID          string      `json:"id"`
+	OrderID     string      `json:"order_id"`
+	Amount      money.Money `json:"amount"`
+	Status      string      `json:"status"`
+	ProcessedAt *time.Time  `json:"processed_at,omitempty"`
+	CreatedAt   time.Time   `json:"created_at"`
 }
 
 // ToRefundResponse converts Refund entity to response
 func ToRefundResponse(refund *entity.Refund) *RefundResponse {
 	return &RefundResponse{
-		ID:        refund.ID,
-		OrderID:   refund.OrderID,
-		Amount:    refund.Amount,
-		Status:    refund.Status,
-		CreatedAt: refund.CreatedAt,
+		ID:          refund.ID,
+		OrderID:     refund.OrderID,
+		Amount:      refund.Amount,
+		Status:      refund.Status,
+		ProcessedAt: refund.ProcessedAt,
+		CreatedAt:   refund.CreatedAt,
 	}
 }
+
+// XenditRefundResponse represents Xendit API refund response
+type XenditRefundResponse struct {
+	ID          string      `json:"id"`
+	InvoiceID   string      `json:"invoice_id"`
+	ReferenceID string      `json:"reference_id"`
+	Amount      money.Money `json:"amount"`
+	Currency    string      `json:"currency"`
+	Status      string      `json:"status"` // PENDING, SUCCEEDED or FAILED
+	FailureCode *string     `json:"failure_code"`
+	Reason      string      `json:"reason"`
+}
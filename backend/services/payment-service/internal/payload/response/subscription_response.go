@@ -0,0 +1,93 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
+)
+
+// PlanPriceResponse represents a paid organizer plan that can be subscribed to
+type PlanPriceResponse struct {
+	Plan         string      `json:"plan"`
+	Name         string      `json:"name"`
+	MonthlyPrice money.Money `json:"monthly_price"`
+	Currency     string      `json:"currency"`
+}
+
+// SubscriptionResponse represents an organizer's plan subscription
+type SubscriptionResponse struct {
+	ID                 string                       `json:"id"`
+	OrganizerID        string                       `json:"organizer_id"`
+	Plan               string                       `json:"plan"`
+	PendingPlan        *string                      `json:"pending_plan,omitempty"`
+	Status             string                       `json:"status"`
+	CurrentPeriodStart *time.Time                   `json:"current_period_start"`
+	CurrentPeriodEnd   *time.Time                   `json:"current_period_end"`
+	CancelAtPeriodEnd  bool                         `json:"cancel_at_period_end"`
+	CanceledAt         *time.Time                   `json:"canceled_at,omitempty"`
+	LatestInvoice      *SubscriptionInvoiceResponse `json:"latest_invoice,omitempty"`
+}
+
+// SubscriptionInvoiceResponse represents an invoice of a subscription
+type SubscriptionInvoiceResponse struct {
+	ID          string      `json:"id"`
+	Kind        string      `json:"kind"`
+	Plan        string      `json:"plan"`
+	InvoiceURL  string      `json:"invoice_url"`
+	Amount      money.Money `json:"amount"`
+	PeriodStart time.Time   `json:"period_start"`
+	PeriodEnd   time.Time   `json:"period_end"`
+	Attempt     int         `json:"attempt"`
+	Status      string      `json:"status"`
+	PaidAt      *time.Time  `json:"paid_at,omitempty"`
+	ExpiresAt   *time.Time  `json:"expires_at"`
+}
+
+// ToPlanPriceResponse converts PlanPrice entity to response
+func ToPlanPriceResponse(price *entity.PlanPrice) *PlanPriceResponse {
+	return &PlanPriceResponse{
+		Plan:         price.PlanCode,
+		Name:         price.PlanName,
+		MonthlyPrice: price.MonthlyPrice,
+		Currency:     "IDR",
+	}
+}
+
+// ToSubscriptionResponse converts Subscription entity and its latest invoice (may be nil) to response
+func ToSubscriptionResponse(subscription *entity.Subscription, invoice *entity.SubscriptionInvoice) *SubscriptionResponse {
+	resp := &SubscriptionResponse{
+		ID:                 subscription.ID,
+		OrganizerID:        subscription.OrganizerID,
+		Plan:               subscription.PlanCode,
+		PendingPlan:        subscription.PendingPlanCode,
+		Status:             subscription.Status,
+		CurrentPeriodStart: subscription.CurrentPeriodStart,
+		CurrentPeriodEnd:   subscription.CurrentPeriodEnd,
+		CancelAtPeriodEnd:  subscription.CancelAtPeriodEnd,
+		CanceledAt:         subscription.CanceledAt,
+	}
+
+	if invoice != nil {
+		invoiceURL := ""
+		if invoice.InvoiceURL != nil {
+			invoiceURL = *invoice.InvoiceURL
+		}
+
+		resp.LatestInvoice = &SubscriptionInvoiceResponse{
+			ID:          invoice.ID,
+			Kind:        invoice.Kind,
+			Plan:        invoice.PlanCode,
+			InvoiceURL:  invoiceURL,
+			Amount:      invoice.Amount,
+			PeriodStart: invoice.PeriodStart,
+			PeriodEnd:   invoice.PeriodEnd,
+			Attempt:     invoice.Attempt,
+			Status:      invoice.Status,
+			PaidAt:      invoice.PaidAt,
+			ExpiresAt:   invoice.ExpiresAt,
+		}
+	}
+
+	return resp
+}
@@ -0,0 +1,161 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
+)
+
+// XenditVirtualAccountPayload represents the Xendit fixed virtual account created/updated callback
+type XenditVirtualAccountPayload struct {
+	ID             string      `json:"id"`
+	OwnerID        string      `json:"owner_id"`
+	ExternalID     string      `json:"external_id"` // External ID of the payment
+	BankCode       string      `json:"bank_code"`
+	MerchantCode   string      `json:"merchant_code"`
+	AccountNumber  string      `json:"account_number"`
+	Name           string      `json:"name"`
+	ExpectedAmount money.Money `json:"expected_amount,omitempty"`
+	IsClosed       bool        `json:"is_closed"`
+	ExpirationDate time.Time   `json:"expiration_date"`
+	Status         string      `json:"status"` // PENDING, ACTIVE or INACTIVE
+}
+
+// XenditQRPaymentPayload represents the Xendit QR code payment callback
+type XenditQRPaymentPayload struct {
+	Event      string          `json:"event"` // qr.payment
+	APIVersion string          `json:"api_version"`
+	Created    time.Time       `json:"created"`
+	Data       XenditQRPayment `json:"data"`
+}
+
+// XenditQRPayment represents the payment of a QR code callback
+type XenditQRPayment struct {
+	ID          string      `json:"id"`
+	QRID        string      `json:"qr_id"`
+	ReferenceID string      `json:"reference_id"` // External ID of the payment
+	Amount      money.Money `json:"amount"`
+	Currency    string      `json:"currency"`
+	ChannelCode string      `json:"channel_code"`
+	Status      string      `json:"status"` // SUCCEEDED
+	Created     time.Time   `json:"created"`
+}
+
+// XenditCardChargePayload represents the Xendit card charge callback
+type XenditCardChargePayload struct {
+	ID               string      `json:"id"`
+	ExternalID       string      `json:"external_id"` // External ID of the payment
+	Status           string      `json:"status"`      // AUTHORIZED, CAPTURED or FAILED
+	CaptureAmount    money.Money `json:"capture_amount"`
+	Currency         string      `json:"currency"`
+	MaskedCardNumber string      `json:"masked_card_number"`
+	CardBrand        string      `json:"card_brand"`
+	FailureReason    string      `json:"failure_reason"` // e.g. CARD_DECLINED, INSUFFICIENT_BALANCE
+	Created          time.Time   `json:"created"`
+}
+
+// XenditRefundPayload represents the Xendit refund succeeded/failed callback
+type XenditRefundPayload struct {
+	Event   string               `json:"event"` // refund.succeeded or refund.failed
+	Created time.Time            `json:"created"`
+	Data    XenditRefundResponse `json:"data"`
+}
+
+// WebhookEventResponse represents a quarantined webhook for admins
+type WebhookEventResponse struct {
+	ID               string          `json:"id"`
+	WebhookID        string          `json:"webhook_id"`
+	EventType        string          `json:"event_type"`
+	Status           string          `json:"status"`
+	Sandbox          bool            `json:"sandbox"`
+	QuarantineReason *string         `json:"quarantine_reason,omitempty"`
+	Payload          json.RawMessage `json:"payload"`
+	ResolvedBy       *string         `json:"resolved_by,omitempty"`
+	ResolvedAt       *time.Time      `json:"resolved_at,omitempty"`
+	CreatedAt        time.Time       `json:"created_at"`
+}
+
+// ToWebhookEventResponse converts WebhookEvent entity to response
+func ToWebhookEventResponse(webhook *entity.WebhookEvent) *WebhookEventResponse {
+	payload := json.RawMessage(webhook.Payload)
+	if !json.Valid(payload) {
+		payload = json.RawMessage("null")
+	}
+
+	return &WebhookEventResponse{
+		ID:               webhook.ID,
+		WebhookID:        webhook.WebhookID,
+		EventType:        webhook.EventType,
+		Status:           webhook.Status,
+		Sandbox:          webhook.Sandbox,
+		QuarantineReason: webhook.QuarantineReason,
+		Payload:          payload,
+		ResolvedBy:       webhook.ResolvedBy,
+		ResolvedAt:       webhook.ResolvedAt,
+		CreatedAt:        webhook.CreatedAt,
+	}
+}
+
+// PaymentConfirmationResponse represents the order confirmation of a paid payment for admins
+type PaymentConfirmationResponse struct {
+	PaymentID   string     `json:"payment_id"`
+	OrderID     string     `json:"order_id"`
+	Status      string     `json:"status"`
+	Outcome     *string    `json:"outcome,omitempty"`
+	Attempts    int        `json:"attempts"`
+	LastError   *string    `json:"last_error,omitempty"`
+	ConfirmedAt *time.Time `json:"confirmed_at,omitempty"`
+}
+
+// ToPaymentConfirmationResponse converts PaymentConfirmation entity to response
+func ToPaymentConfirmationResponse(confirmation *entity.PaymentConfirmation) *PaymentConfirmationResponse {
+	return &PaymentConfirmationResponse{
+		PaymentID:   confirmation.PaymentID,
+		OrderID:     confirmation.OrderID,
+		Status:      confirmation.Status,
+		Outcome:     confirmation.Outcome,
+		Attempts:    confirmation.Attempts,
+		LastError:   confirmation.LastError,
+		ConfirmedAt: confirmation.ConfirmedAt,
+	}
+}
+
+// PaymentSagaResponse represents where a paid payment sits in the paid-order saga for admins
+type PaymentSagaResponse struct {
+	PaymentID          string     `json:"payment_id"`
+	OrderID            string     `json:"order_id"`
+	ProviderPaymentID  string     `json:"provider_payment_id"`
+	Step               string     `json:"step"`
+	Status             string     `json:"status"`
+	TicketsGenerated   int        `json:"tickets_generated"`
+	CompensationReason *string    `json:"compensation_reason,omitempty"`
+	LastError          *string    `json:"last_error,omitempty"`
+	CapturedAt         time.Time  `json:"captured_at"`
+	OrderConfirmedAt   *time.Time `json:"order_confirmed_at,omitempty"`
+	TicketsGeneratedAt *time.Time `json:"tickets_generated_at,omitempty"`
+	EmailSentAt        *time.Time `json:"email_sent_at,omitempty"`
+	CompensatedAt      *time.Time `json:"compensated_at,omitempty"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+}
+
+// ToPaymentSagaResponse converts PaymentSaga entity to response
+func ToPaymentSagaResponse(saga *entity.PaymentSaga) *PaymentSagaResponse {
+	return &PaymentSagaResponse{
+		PaymentID:          saga.PaymentID,
+		OrderID:            saga.OrderID,
+		ProviderPaymentID:  saga.ProviderPaymentID,
+		Step:               saga.Step,
+		Status:             saga.Status,
+		TicketsGenerated:   saga.TicketsGenerated,
+		CompensationReason: saga.CompensationReason,
+		LastError:          saga.LastError,
+		CapturedAt:         saga.CapturedAt,
+		OrderConfirmedAt:   saga.OrderConfirmedAt,
+		TicketsGeneratedAt: saga.TicketsGeneratedAt,
+		EmailSentAt:        saga.EmailSentAt,
+		CompensatedAt:      saga.CompensatedAt,
+		UpdatedAt:          saga.UpdatedAt,
+	}
+}
@@ -0,0 +1,262 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
+)
+
+var (
+	ErrConfirmationNotFound = errors.New("payment confirmation not found")
+)
+
+// PaymentConfirmationRepository defines interface for payment confirmation data operations
+type PaymentConfirmationRepository interface {
+	Create(ctx context.Context, confirmation *entity.PaymentConfirmation) error
+	TrackUntracked(ctx context.Context, paidAfter, paidBefore time.Time, limit int) (int, error)
+	GetByPaymentID(ctx context.Context, paymentID string) (*entity.PaymentConfirmation, error)
+	ClaimDue(ctx context.Context, limit int, lockedUntil time.Time) ([]*entity.PaymentConfirmation, error)
+	MarkConfirmed(ctx context.Context, paymentID, outcome string) error
+	Retry(ctx context.Context, paymentID, lastError string, nextAttemptAt time.Time) error
+	MarkFailed(ctx context.Context, paymentID, lastError string) error
+}
+
+// paymentConfirmationRepository implements PaymentConfirmationRepository interface
+type paymentConfirmationRepository struct {
+	db *sql.DB
+}
+
+// NewPaymentConfirmationRepository creates new payment confirmation repository instance
+func NewPaymentConfirmationRepository(db *sql.DB) PaymentConfirmationRepository {
+	return &paymentConfirmationRepository{db: db}
+}
+
+const paymentConfirmationColumns = `
+	payment_id, order_id, provider_payment_id, payment_method, amount, currency,
+	status, outcome, attempts, next_attempt_at, last_error, confirmed_at, created_at, updated_at
+`
+
+// Create starts tracking the confirmation of a paid payment
+// A payment is tracked once: later calls, such as provider retries, are ignored
+func (r *paymentConfirmationRepository) Create(ctx context.Context, confirmation *entity.PaymentConfirmation) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO payment_confirmations (
+			payment_id, order_id, provider_payment_id, payment_method, amount, currency,
+			status, attempts, next_attempt_at, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
+		ON CONFLICT (payment_id) DO NOTHING
+	`
+
+	if confirmation.Status == "" {
+		confirmation.Status = entity.ConfirmationStatusPending
+	}
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		confirmation.PaymentID,
+		confirmation.OrderID,
+		confirmation.ProviderPaymentID,
+		confirmation.PaymentMethod,
+		confirmation.Amount,
+		confirmation.Currency,
+		confirmation.Status,
+		confirmation.Attempts,
+		confirmation.NextAttemptAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create payment confirmation: %w", err)
+	}
+
+	return nil
+}
+
+// TrackUntracked starts tracking up to limit paid payments, paid between paidAfter and paidBefore, that
+// have no confirmation, e.g. because the webhook crashed before tracking it. They are due right away
+// Payments whose saga is being compensated are refunded instead and left alone
+func (r *paymentConfirmationRepository) TrackUntracked(ctx context.Context, paidAfter, paidBefore time.Time, limit int) (int, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO payment_confirmations (
+			payment_id, order_id, provider_payment_id, payment_method, amount, currency,
+			status, attempts, next_attempt_at, created_at, updated_at
+		)
+		SELECT p.id, p.order_id, COALESCE(p.invoice_id, p.external_id), p.payment_method, p.amount, p.currency,
+			$1::varchar, 0, NOW(), NOW(), NOW()
+		FROM payment_transactions p
+		WHERE p.status = $2 AND p.paid_at > $3 AND p.paid_at <= $4
+			AND NOT EXISTS (SELECT 1 FROM payment_confirmations c WHERE c.payment_id = p.id)
+			AND NOT EXISTS (
+				SELECT 1 FROM payment_sagas s
+				WHERE s.payment_id = p.id AND s.status IN ($5, $6, $7)
+			)
+		ORDER BY p.paid_at ASC
+		LIMIT $8
+		ON CONFLICT (payment_id) DO NOTHING
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		entity.ConfirmationStatusPending, entity.PaymentStatusPaid, paidAfter, paidBefore,
+		entity.SagaStatusCompensating, entity.SagaStatusCompensated, entity.SagaStatusFailed, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to track untracked payment confirmations: %w", err)
+	}
+
+	tracked, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count tracked payment confirmations: %w", err)
+	}
+
+	return int(tracked), nil
+}
+
+// GetByPaymentID retrieves the confirmation of a payment
+func (r *paymentConfirmationRepository) GetByPaymentID(ctx context.Context, paymentID string) (*entity.PaymentConfirmation, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + paymentConfirmationColumns + ` FROM payment_confirmations WHERE payment_id = $1`
+
+	confirmation, err := scanPaymentConfirmation(r.db.QueryRowContext(ctx, query, paymentID))
+	if err == sql.ErrNoRows {
+		return nil, ErrConfirmationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment confirmation: %w", err)
+	}
+
+	return confirmation, nil
+}
+
+// ClaimDue leases up to limit due confirmations to the caller until lockedUntil, oldest first
+// The lease is the next attempt time, so confirmations of a crashed worker are due again after it
+// SKIP LOCKED lets multiple instances claim concurrently without taking the same confirmation
+func (r *paymentConfirmationRepository) ClaimDue(ctx context.Context, limit int, lockedUntil time.Time) ([]*entity.PaymentConfirmation, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE payment_confirmations
+		SET attempts = attempts + 1, next_attempt_at = $1, updated_at = NOW()
+		WHERE payment_id IN (
+			SELECT payment_id
+			FROM payment_confirmations
+			WHERE status = $2 AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at ASC
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + paymentConfirmationColumns
+
+	rows, err := r.db.QueryContext(ctx, query, lockedUntil, entity.ConfirmationStatusPending, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim due payment confirmations: %w", err)
+	}
+	defer rows.Close()
+
+	confirmations := []*entity.PaymentConfirmation{}
+	for rows.Next() {
+		confirmation, err := scanPaymentConfirmation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan payment confirmation: %w", err)
+		}
+		confirmations = append(confirmations, confirmation)
+	}
+
+	return confirmations, rows.Err()
+}
+
+// MarkConfirmed records that ticketing service confirmed the order, whatever the confirmation's status
+func (r *paymentConfirmationRepository) MarkConfirmed(ctx context.Context, paymentID, outcome string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE payment_confirmations
+		SET status = $2, outcome = $3, last_error = NULL, confirmed_at = COALESCE(confirmed_at, NOW()), updated_at = NOW()
+		WHERE payment_id = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, paymentID, entity.ConfirmationStatusConfirmed, outcome); err != nil {
+		return fmt.Errorf("failed to mark payment confirmed: %w", err)
+	}
+
+	return nil
+}
+
+// Retry schedules another attempt of a confirmation that failed
+// A failed confirmation is retried again, a confirmed one is left alone
+func (r *paymentConfirmationRepository) Retry(ctx context.Context, paymentID, lastError string, nextAttemptAt time.Time) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE payment_confirmations
+		SET status = $2, next_attempt_at = $3, last_error = $4, updated_at = NOW()
+		WHERE payment_id = $1 AND status <> $5
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		paymentID, entity.ConfirmationStatusPending, nextAttemptAt, lastError, entity.ConfirmationStatusConfirmed)
+	if err != nil {
+		return fmt.Errorf("failed to reschedule payment confirmation: %w", err)
+	}
+
+	return nil
+}
+
+// MarkFailed records that a pending confirmation failed for the last time
+func (r *paymentConfirmationRepository) MarkFailed(ctx context.Context, paymentID, lastError string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE payment_confirmations
+		SET status = $2, last_error = $3, updated_at = NOW()
+		WHERE payment_id = $1 AND status = $4
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		paymentID, entity.ConfirmationStatusFailed, lastError, entity.ConfirmationStatusPending)
+	if err != nil {
+		return fmt.Errorf("failed to mark payment confirmation failed: %w", err)
+	}
+
+	return nil
+}
+
+// scanPaymentConfirmation scans a row of paymentConfirmationColumns
+func scanPaymentConfirmation(row rowScanner) (*entity.PaymentConfirmation, error) {
+	confirmation := &entity.PaymentConfirmation{}
+	err := row.Scan(
+		&confirmation.PaymentID,
+		&confirmation.OrderID,
+		&confirmation.ProviderPaymentID,
+		&confirmation.PaymentMethod,
+		&confirmation.Amount,
+		&confirmation.Currency,
+		&confirmation.Status,
+		&confirmation.Outcome,
+		&confirmation.Attempts,
+		&confirmation.NextAttemptAt,
+		&confirmation.LastError,
+		&confirmation.ConfirmedAt,
+		&confirmation.CreatedAt,
+		&confirmation.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return confirmation, nil
+}
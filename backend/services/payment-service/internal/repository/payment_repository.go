@@ -7,11 +7,15 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
 )
 
 var (
-	ErrPaymentNotFound = errors.New("payment transaction not found")
+	ErrPaymentNotFound      = errors.New("payment transaction not found")
+	ErrPaymentAttemptExists = errors.New("payment attempt already exists")
+	ErrOrderAlreadyPaid     = errors.New("order already paid by another payment attempt")
 )
 
 // PaymentRepository defines interface for payment data operations
@@ -19,9 +23,11 @@ type PaymentRepository interface {
 	Create(ctx context.Context, payment *entity.PaymentTransaction) error
 	GetByID(ctx context.Context, id string) (*entity.PaymentTransaction, error)
 	GetByOrderID(ctx context.Context, orderID string) (*entity.PaymentTransaction, error)
+	ListByOrderID(ctx context.Context, orderID string) ([]*entity.PaymentTransaction, error)
 	GetByExternalID(ctx context.Context, externalID string) (*entity.PaymentTransaction, error)
 	GetByInvoiceID(ctx context.Context, invoiceID string) (*entity.PaymentTransaction, error)
 	Update(ctx context.Context, payment *entity.PaymentTransaction) error
+	MarkPaid(ctx context.Context, payment *entity.PaymentTransaction) error
 	BeginTx(ctx context.Context) (*sql.Tx, error)
 }
 
@@ -37,13 +43,16 @@ func NewPaymentRepository(db *sql.DB) PaymentRepository {
 
 // Create inserts new payment transaction
 func (r *paymentRepository) Create(ctx context.Context, payment *entity.PaymentTransaction) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		INSERT INTO payment_transactions (
 			id, order_id, external_id, invoice_id, invoice_url,
 			amount, payment_method, status, paid_at, expires_at,
-			created_at, updated_at
+			is_sandbox, attempt_number, currency, created_at, updated_at
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW(), NOW())
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, NOW(), NOW())
 		RETURNING id, created_at, updated_at
 	`
 
@@ -62,9 +71,16 @@ func (r *paymentRepository) Create(ctx context.Context, payment *entity.PaymentT
 		payment.Status,
 		payment.PaidAt,
 		payment.ExpiresAt,
+		payment.IsSandbox,
+		payment.AttemptNumber,
+		payment.Currency,
 	).Scan(&payment.ID, &payment.CreatedAt, &payment.UpdatedAt)
 
 	if err != nil {
+		// Another attempt with the same number was created concurrently
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return ErrPaymentAttemptExists
+		}
 		return fmt.Errorf("failed to create payment transaction: %w", err)
 	}
 
@@ -73,10 +89,13 @@ func (r *paymentRepository) Create(ctx context.Context, payment *entity.PaymentT
 
 // GetByID retrieves payment transaction by ID
 func (r *paymentRepository) GetByID(ctx context.Context, id string) (*entity.PaymentTransaction, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT id, order_id, external_id, invoice_id, invoice_url,
 		       amount, payment_method, status, paid_at, expires_at,
-		       created_at, updated_at
+		       created_at, updated_at, is_sandbox, attempt_number, currency
 		FROM payment_transactions
 		WHERE id = $1
 	`
@@ -95,6 +114,9 @@ func (r *paymentRepository) GetByID(ctx context.Context, id string) (*entity.Pay
 		&payment.ExpiresAt,
 		&payment.CreatedAt,
 		&payment.UpdatedAt,
+		&payment.IsSandbox,
+		&payment.AttemptNumber,
+		&payment.Currency,
 	)
 
 	if err == sql.ErrNoRows {
@@ -108,15 +130,18 @@ func (r *paymentRepository) GetByID(ctx context.Context, id string) (*entity.Pay
 	return payment, nil
 }
 
-// GetByOrderID retrieves payment transaction by order ID
+// GetByOrderID retrieves the payment attempt of an order: the paid attempt, or else the latest one
 func (r *paymentRepository) GetByOrderID(ctx context.Context, orderID string) (*entity.PaymentTransaction, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT id, order_id, external_id, invoice_id, invoice_url,
 		       amount, payment_method, status, paid_at, expires_at,
-		       created_at, updated_at
+		       created_at, updated_at, is_sandbox, attempt_number, currency
 		FROM payment_transactions
 		WHERE order_id = $1
-		ORDER BY created_at DESC
+		ORDER BY (status = 'paid') DESC, attempt_number DESC
 		LIMIT 1
 	`
 
@@ -134,6 +159,9 @@ func (r *paymentRepository) GetByOrderID(ctx context.Context, orderID string) (*
 		&payment.ExpiresAt,
 		&payment.CreatedAt,
 		&payment.UpdatedAt,
+		&payment.IsSandbox,
+		&payment.AttemptNumber,
+		&payment.Currency,
 	)
 
 	if err == sql.ErrNoRows {
@@ -147,12 +175,67 @@ func (r *paymentRepository) GetByOrderID(ctx context.Context, orderID string) (*
 	return payment, nil
 }
 
+// ListByOrderID retrieves all payment attempts of an order, oldest first
+func (r *paymentRepository) ListByOrderID(ctx context.Context, orderID string) ([]*entity.PaymentTransaction, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, order_id, external_id, invoice_id, invoice_url,
+		       amount, payment_method, status, paid_at, expires_at,
+		       created_at, updated_at, is_sandbox, attempt_number, currency
+		FROM payment_transactions
+		WHERE order_id = $1
+		ORDER BY attempt_number
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payment attempts: %w", err)
+	}
+	defer rows.Close()
+
+	var payments []*entity.PaymentTransaction
+	for rows.Next() {
+		payment := &entity.PaymentTransaction{}
+		if err := rows.Scan(
+			&payment.ID,
+			&payment.OrderID,
+			&payment.ExternalID,
+			&payment.InvoiceID,
+			&payment.InvoiceURL,
+			&payment.Amount,
+			&payment.PaymentMethod,
+			&payment.Status,
+			&payment.PaidAt,
+			&payment.ExpiresAt,
+			&payment.CreatedAt,
+			&payment.UpdatedAt,
+			&payment.IsSandbox,
+			&payment.AttemptNumber,
+			&payment.Currency,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan payment attempt: %w", err)
+		}
+		payments = append(payments, payment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list payment attempts: %w", err)
+	}
+
+	return payments, nil
+}
+
 // GetByExternalID retrieves payment transaction by external ID
 func (r *paymentRepository) GetByExternalID(ctx context.Context, externalID string) (*entity.PaymentTransaction, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT id, order_id, external_id, invoice_id, invoice_url,
 		       amount, payment_method, status, paid_at, expires_at,
-		       created_at, updated_at
+		       created_at, updated_at, is_sandbox, attempt_number, currency
 		FROM payment_transactions
 		WHERE external_id = $1
 	`
@@ -171,6 +254,9 @@ func (r *paymentRepository) GetByExternalID(ctx context.Context, externalID stri
 		&payment.ExpiresAt,
 		&payment.CreatedAt,
 		&payment.UpdatedAt,
+		&payment.IsSandbox,
+		&payment.AttemptNumber,
+		&payment.Currency,
 	)
 
 	if err == sql.ErrNoRows {
@@ -186,10 +272,13 @@ func (r *paymentRepository) GetByExternalID(ctx context.Context, externalID stri
 
 // GetByInvoiceID retrieves payment transaction by invoice ID
 func (r *paymentRepository) GetByInvoiceID(ctx context.Context, invoiceID string) (*entity.PaymentTransaction, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT id, order_id, external_id, invoice_id, invoice_url,
 		       amount, payment_method, status, paid_at, expires_at,
-		       created_at, updated_at
+		       created_at, updated_at, is_sandbox, attempt_number, currency
 		FROM payment_transactions
 		WHERE invoice_id = $1
 	`
@@ -208,6 +297,9 @@ func (r *paymentRepository) GetByInvoiceID(ctx context.Context, invoiceID string
 		&payment.ExpiresAt,
 		&payment.CreatedAt,
 		&payment.UpdatedAt,
+		&payment.IsSandbox,
+		&payment.AttemptNumber,
+		&payment.Currency,
 	)
 
 	if err == sql.ErrNoRows {
@@ -223,6 +315,9 @@ func (r *paymentRepository) GetByInvoiceID(ctx context.Context, invoiceID string
 
 // Update updates payment transaction
 func (r *paymentRepository) Update(ctx context.Context, payment *entity.PaymentTransaction) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		UPDATE payment_transactions
 		SET invoice_id = $1, invoice_url = $2, payment_method = $3,
@@ -257,6 +352,45 @@ func (r *paymentRepository) Update(ctx context.Context, payment *entity.PaymentT
 	return nil
 }
 
+// MarkPaid moves a payment attempt to paid with its paid_at, payment method and paid currency
+// Only one attempt per order can be paid: ErrOrderAlreadyPaid is returned when another attempt of
+// the order already is, the partial unique index on paid attempts settles concurrent callbacks
+func (r *paymentRepository) MarkPaid(ctx context.Context, payment *entity.PaymentTransaction) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE payment_transactions
+		SET status = 'paid', paid_at = $1, payment_method = $2, currency = $3, updated_at = NOW()
+		WHERE id = $4
+		  AND status <> 'paid'
+		  AND NOT EXISTS (
+		      SELECT 1 FROM payment_transactions paid
+		      WHERE paid.order_id = $5 AND paid.status = 'paid'
+		  )
+	`
+
+	result, err := r.db.ExecContext(ctx, query, payment.PaidAt, payment.PaymentMethod, payment.Currency, payment.ID, payment.OrderID)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return ErrOrderAlreadyPaid
+		}
+		return fmt.Errorf("failed to mark payment as paid: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrOrderAlreadyPaid
+	}
+
+	payment.Status = entity.PaymentStatusPaid
+	return nil
+}
+
 // BeginTx starts a new database transaction
 func (r *paymentRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
 	return r.db.BeginTx(ctx, nil)
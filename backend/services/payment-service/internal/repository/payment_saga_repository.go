@@ -0,0 +1,262 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
+)
+
+var (
+	ErrSagaNotFound = errors.New("payment saga not found")
+)
+
+// PaymentSagaRepository defines interface for paid-order saga data operations
+// Every transition is a guarded update, so steps only move forward and retried or
+// out-of-order reports leave the saga as it is
+type PaymentSagaRepository interface {
+	Start(ctx context.Context, saga *entity.PaymentSaga) error
+	GetByPaymentID(ctx context.Context, paymentID string) (*entity.PaymentSaga, error)
+	List(ctx context.Context, status, step, orderID string, limit int) ([]*entity.PaymentSaga, error)
+	MarkOrderConfirmed(ctx context.Context, paymentID string, ticketsGenerated int) error
+	MarkEmailSent(ctx context.Context, orderID, providerPaymentID string) (bool, error)
+	MarkCompensating(ctx context.Context, paymentID, reason, lastError string) error
+	MarkCompensated(ctx context.Context, paymentID string) error
+	MarkFailed(ctx context.Context, paymentID, lastError string) error
+}
+
+// paymentSagaRepository implements PaymentSagaRepository interface
+type paymentSagaRepository struct {
+	db *sql.DB
+}
+
+// NewPaymentSagaRepository creates new payment saga repository instance
+func NewPaymentSagaRepository(db *sql.DB) PaymentSagaRepository {
+	return &paymentSagaRepository{db: db}
+}
+
+const paymentSagaColumns = `
+	payment_id, order_id, provider_payment_id, step, status, tickets_generated,
+	compensation_reason, last_error, captured_at, order_confirmed_at, tickets_generated_at,
+	email_sent_at, compensated_at, created_at, updated_at
+`
+
+// Start records a captured payment as the first step of its saga
+// A payment has one saga: later calls, such as provider retries, are ignored
+func (r *paymentSagaRepository) Start(ctx context.Context, saga *entity.PaymentSaga) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO payment_sagas (payment_id, order_id, provider_payment_id, step, status, captured_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), NOW(), NOW())
+		ON CONFLICT (payment_id) DO NOTHING
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		saga.PaymentID, saga.OrderID, saga.ProviderPaymentID, entity.SagaStepPaymentCaptured, entity.SagaStatusRunning)
+	if err != nil {
+		return fmt.Errorf("failed to start payment saga: %w", err)
+	}
+
+	return nil
+}
+
+// GetByPaymentID retrieves the saga of a payment
+func (r *paymentSagaRepository) GetByPaymentID(ctx context.Context, paymentID string) (*entity.PaymentSaga, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + paymentSagaColumns + ` FROM payment_sagas WHERE payment_id = $1`
+
+	saga, err := scanPaymentSaga(r.db.QueryRowContext(ctx, query, paymentID))
+	if err == sql.ErrNoRows {
+		return nil, ErrSagaNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment saga: %w", err)
+	}
+
+	return saga, nil
+}
+
+// List lists sagas, most recently updated first, optionally of one status, step or order
+func (r *paymentSagaRepository) List(ctx context.Context, status, step, orderID string, limit int) ([]*entity.PaymentSaga, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + paymentSagaColumns + `
+		FROM payment_sagas
+		WHERE ($1 = '' OR status = $1)
+			AND ($2 = '' OR step = $2)
+			AND ($3 = '' OR order_id::text = $3)
+		ORDER BY updated_at DESC
+		LIMIT $4
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, status, step, orderID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payment sagas: %w", err)
+	}
+	defer rows.Close()
+
+	sagas := []*entity.PaymentSaga{}
+	for rows.Next() {
+		saga, err := scanPaymentSaga(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan payment saga: %w", err)
+		}
+		sagas = append(sagas, saga)
+	}
+
+	return sagas, rows.Err()
+}
+
+// MarkOrderConfirmed records that ticketing service confirmed the order and generated ticketsGenerated tickets
+// Confirmations without tickets stay at order_confirmed until a retry generates them. An email reported
+// before the tickets step completes the saga right away. A saga whose refund failed can still be confirmed
+func (r *paymentSagaRepository) MarkOrderConfirmed(ctx context.Context, paymentID string, ticketsGenerated int) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE payment_sagas
+		SET order_confirmed_at = COALESCE(order_confirmed_at, NOW()),
+			tickets_generated = $2,
+			tickets_generated_at = CASE WHEN $2 > 0 THEN NOW() END,
+			step = CASE
+				WHEN $2 = 0 THEN 'order_confirmed'
+				WHEN email_sent_at IS NULL THEN 'tickets_generated'
+				ELSE 'email_sent'
+			END,
+			status = CASE WHEN $2 > 0 AND email_sent_at IS NOT NULL THEN 'completed' ELSE 'running' END,
+			last_error = NULL,
+			updated_at = NOW()
+		WHERE payment_id = $1
+			AND status IN ('running', 'failed')
+			AND step IN ('payment_captured', 'order_confirmed')
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, paymentID, ticketsGenerated); err != nil {
+		return fmt.Errorf("failed to mark payment saga order confirmed: %w", err)
+	}
+
+	return nil
+}
+
+// MarkEmailSent records that the buyer got the ticket email of the order paid with providerPaymentID
+// It completes a saga whose tickets are generated; earlier steps only keep the time for MarkOrderConfirmed.
+// Reports false when no running saga matches, e.g. orders marked paid by support
+func (r *paymentSagaRepository) MarkEmailSent(ctx context.Context, orderID, providerPaymentID string) (bool, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE payment_sagas
+		SET email_sent_at = COALESCE(email_sent_at, NOW()),
+			status = CASE WHEN step = 'tickets_generated' THEN 'completed' ELSE status END,
+			step = CASE WHEN step = 'tickets_generated' THEN 'email_sent' ELSE step END,
+			updated_at = NOW()
+		WHERE order_id = $1 AND provider_payment_id = $2 AND status = 'running'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, orderID, providerPaymentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark payment saga email sent: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// MarkCompensating records that the payment is refunded instead of applied to its order
+// Sagas already compensating or completed are left alone
+func (r *paymentSagaRepository) MarkCompensating(ctx context.Context, paymentID, reason, lastError string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE payment_sagas
+		SET status = $2, compensation_reason = $3, last_error = NULLIF($4, ''), updated_at = NOW()
+		WHERE payment_id = $1 AND status IN ('running', 'failed')
+	`
+
+	_, err := r.db.ExecContext(ctx, query, paymentID, entity.SagaStatusCompensating, reason, lastError)
+	if err != nil {
+		return fmt.Errorf("failed to mark payment saga compensating: %w", err)
+	}
+
+	return nil
+}
+
+// MarkCompensated records that a compensating payment was refunded
+func (r *paymentSagaRepository) MarkCompensated(ctx context.Context, paymentID string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE payment_sagas
+		SET status = $2, compensated_at = NOW(), updated_at = NOW()
+		WHERE payment_id = $1 AND status = $3
+	`
+
+	_, err := r.db.ExecContext(ctx, query, paymentID, entity.SagaStatusCompensated, entity.SagaStatusCompensating)
+	if err != nil {
+		return fmt.Errorf("failed to mark payment saga compensated: %w", err)
+	}
+
+	return nil
+}
+
+// MarkFailed records that the refund of a compensating payment failed
+func (r *paymentSagaRepository) MarkFailed(ctx context.Context, paymentID, lastError string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE payment_sagas
+		SET status = $2, last_error = $3, updated_at = NOW()
+		WHERE payment_id = $1 AND status = $4
+	`
+
+	_, err := r.db.ExecContext(ctx, query, paymentID, entity.SagaStatusFailed, lastError, entity.SagaStatusCompensating)
+	if err != nil {
+		return fmt.Errorf("failed to mark payment saga failed: %w", err)
+	}
+
+	return nil
+}
+
+// scanPaymentSaga scans a row of paymentSagaColumns
+func scanPaymentSaga(row rowScanner) (*entity.PaymentSaga, error) {
+	saga := &entity.PaymentSaga{}
+	err := row.Scan(
+		&saga.PaymentID,
+		&saga.OrderID,
+		&saga.ProviderPaymentID,
+		&saga.Step,
+		&saga.Status,
+		&saga.TicketsGenerated,
+		&saga.CompensationReason,
+		&saga.LastError,
+		&saga.CapturedAt,
+		&saga.OrderConfirmedAt,
+		&saga.TicketsGeneratedAt,
+		&saga.EmailSentAt,
+		&saga.CompensatedAt,
+		&saga.CreatedAt,
+		&saga.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return saga, nil
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestQueryPlans_PaymentByInvoiceIDUsesIndex guards the webhook lookup path
+// (GetByInvoiceID is called for every Xendit callback)
+func TestQueryPlans_PaymentByInvoiceIDUsesIndex(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	// Setup
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	// Test tables are tiny, disable sequential scans so the planner reveals index availability
+	_, err = tx.ExecContext(ctx, "SET LOCAL enable_seqscan = off")
+	require.NoError(t, err)
+
+	rows, err := tx.QueryContext(ctx, "EXPLAIN SELECT id FROM payment_transactions WHERE invoice_id = $1", "inv-test")
+	require.NoError(t, err, "Failed to explain query")
+	defer rows.Close()
+
+	lines := []string{}
+	for rows.Next() {
+		var line string
+		require.NoError(t, rows.Scan(&line))
+		lines = append(lines, line)
+	}
+	require.NoError(t, rows.Err())
+	plan := strings.Join(lines, "\n")
+
+	assert.Contains(t, plan, "payment_transactions_invoice_id_key", "Query plan should use invoice_id unique index:\n%s", plan)
+	assert.NotContains(t, plan, "Seq Scan", "Query plan should not fall back to sequential scan:\n%s", plan)
+
+	t.Logf("✅ Payment lookup by invoice_id uses index scan")
+}
@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
+)
+
+var (
+	ErrRefundNotFound      = errors.New("refund not found")
+	ErrRefundAlreadyExists = errors.New("payment already has an active refund")
+)
+
+// RefundRepository defines interface for refund data operations
+type RefundRepository interface {
+	Create(ctx context.Context, refund *entity.Refund) error
+	GetByID(ctx context.Context, id string) (*entity.Refund, error)
+	Update(ctx context.Context, refund *entity.Refund) error
+}
+
+// refundRepository implements RefundRepository interface
+type refundRepository struct {
+	db *sql.DB
+}
+
+// NewRefundRepository creates new refund repository instance
+func NewRefundRepository(db *sql.DB) RefundRepository {
+	return &refundRepository{db: db}
+}
+
+// Create inserts new refund
+// A payment has one active refund: ErrRefundAlreadyExists unless its earlier refunds failed
+func (r *refundRepository) Create(ctx context.Context, refund *entity.Refund) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO refunds (
+			id, order_id, payment_transaction_id, amount, reason, status,
+			requested_by, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`
+
+	refund.ID = uuid.New().String()
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		refund.ID,
+		refund.OrderID,
+		refund.PaymentTransactionID,
+		refund.Amount,
+		refund.Reason,
+		refund.Status,
+		refund.RequestedBy,
+	).Scan(&refund.CreatedAt, &refund.UpdatedAt)
+
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return ErrRefundAlreadyExists
+		}
+		return fmt.Errorf("failed to create refund: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves refund by ID, which is the reference ID of its Xendit refund
+func (r *refundRepository) GetByID(ctx context.Context, id string) (*entity.Refund, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, ErrRefundNotFound
+	}
+
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, order_id, payment_transaction_id, amount, COALESCE(reason, ''), status,
+		       disbursement_id, requested_by, provider_refund_id, failure_reason,
+		       processed_at, created_at, updated_at
+		FROM refunds
+		WHERE id = $1
+	`
+
+	refund := &entity.Refund{}
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&refund.ID,
+		&refund.OrderID,
+		&refund.PaymentTransactionID,
+		&refund.Amount,
+		&refund.Reason,
+		&refund.Status,
+		&refund.DisbursementID,
+		&refund.RequestedBy,
+		&refund.ProviderRefundID,
+		&refund.FailureReason,
+		&refund.ProcessedAt,
+		&refund.CreatedAt,
+		&refund.UpdatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, ErrRefundNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get refund: %w", err)
+	}
+
+	return refund, nil
+}
+
+// Update updates refund status and provider result
+func (r *refundRepository) Update(ctx context.Context, refund *entity.Refund) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE refunds
+		SET status = $1, provider_refund_id = $2, failure_reason = $3,
+		    processed_at = $4, updated_at = NOW()
+		WHERE id = $5
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		refund.Status,
+		refund.ProviderRefundID,
+		refund.FailureReason,
+		refund.ProcessedAt,
+		refund.ID,
+	).Scan(&refund.UpdatedAt)
+
+	if err == sql.ErrNoRows {
+		return ErrRefundNotFound
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to update refund: %w", err)
+	}
+
+	return nil
+}
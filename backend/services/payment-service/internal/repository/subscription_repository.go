@@ -0,0 +1,476 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
+)
+
+var (
+	ErrPlanPriceNotFound            = errors.New("plan price not found")
+	ErrSubscriptionNotFound         = errors.New("subscription not found")
+	ErrSubscriptionInvoiceNotFound  = errors.New("subscription invoice not found")
+	ErrDuplicateSubscriptionInvoice = errors.New("subscription invoice already exists")
+)
+
+// SubscriptionRepository defines interface for plan subscription data operations
+type SubscriptionRepository interface {
+	// Plan prices
+	ListPlanPrices(ctx context.Context) ([]entity.PlanPrice, error)
+	GetPlanPrice(ctx context.Context, planCode string) (*entity.PlanPrice, error)
+
+	// Subscriptions
+	Create(ctx context.Context, subscription *entity.Subscription) error
+	GetByOrganizerID(ctx context.Context, organizerID string) (*entity.Subscription, error)
+	GetByID(ctx context.Context, id string) (*entity.Subscription, error)
+	Update(ctx context.Context, subscription *entity.Subscription) error
+	ListDueForRenewal(ctx context.Context, now time.Time, limit int) ([]entity.Subscription, error)
+
+	// Invoices
+	CreateInvoice(ctx context.Context, invoice *entity.SubscriptionInvoice) error
+	GetInvoiceByExternalID(ctx context.Context, externalID string) (*entity.SubscriptionInvoice, error)
+	GetLatestInvoice(ctx context.Context, subscriptionID string) (*entity.SubscriptionInvoice, error)
+	UpdateInvoice(ctx context.Context, invoice *entity.SubscriptionInvoice) error
+	DeleteInvoice(ctx context.Context, id string) error
+	ListFailedRenewals(ctx context.Context, now time.Time, limit int) ([]entity.SubscriptionInvoice, error)
+}
+
+// subscriptionRepository implements SubscriptionRepository interface
+type subscriptionRepository struct {
+	db *sql.DB
+}
+
+// NewSubscriptionRepository creates new subscription repository instance
+func NewSubscriptionRepository(db *sql.DB) SubscriptionRepository {
+	return &subscriptionRepository{db: db}
+}
+
+const subscriptionColumns = `
+	id, organizer_id, plan_code, pending_plan_code, billing_email, billing_name,
+	status, current_period_start, current_period_end, cancel_at_period_end, canceled_at,
+	created_at, updated_at
+`
+
+const subscriptionInvoiceColumns = `
+	id, subscription_id, kind, plan_code, external_id, invoice_id, invoice_url,
+	amount, period_start, period_end, attempt, status, paid_at, expires_at,
+	created_at, updated_at
+`
+
+// ListPlanPrices retrieves prices of all paid plans, cheapest first
+func (r *subscriptionRepository) ListPlanPrices(ctx context.Context) ([]entity.PlanPrice, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT pp.plan_code, op.name, pp.monthly_price, pp.updated_at
+		FROM plan_prices pp
+		JOIN organizer_plans op ON op.code = pp.plan_code
+		ORDER BY pp.monthly_price, pp.plan_code
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plan prices: %w", err)
+	}
+	defer rows.Close()
+
+	var prices []entity.PlanPrice
+	for rows.Next() {
+		var price entity.PlanPrice
+		if err := rows.Scan(&price.PlanCode, &price.PlanName, &price.MonthlyPrice, &price.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan plan price: %w", err)
+		}
+		prices = append(prices, price)
+	}
+
+	return prices, rows.Err()
+}
+
+// GetPlanPrice retrieves the price of a paid plan
+func (r *subscriptionRepository) GetPlanPrice(ctx context.Context, planCode string) (*entity.PlanPrice, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT pp.plan_code, op.name, pp.monthly_price, pp.updated_at
+		FROM plan_prices pp
+		JOIN organizer_plans op ON op.code = pp.plan_code
+		WHERE pp.plan_code = $1
+	`
+
+	var price entity.PlanPrice
+	err := r.db.QueryRowContext(ctx, query, planCode).Scan(&price.PlanCode, &price.PlanName, &price.MonthlyPrice, &price.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, ErrPlanPriceNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plan price: %w", err)
+	}
+
+	return &price, nil
+}
+
+// Create inserts new subscription
+func (r *subscriptionRepository) Create(ctx context.Context, subscription *entity.Subscription) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO plan_subscriptions (
+			id, organizer_id, plan_code, pending_plan_code, billing_email, billing_name,
+			status, current_period_start, current_period_end, cancel_at_period_end, canceled_at,
+			created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`
+
+	subscription.ID = uuid.New().String()
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		subscription.ID,
+		subscription.OrganizerID,
+		subscription.PlanCode,
+		subscription.PendingPlanCode,
+		subscription.BillingEmail,
+		subscription.BillingName,
+		subscription.Status,
+		subscription.CurrentPeriodStart,
+		subscription.CurrentPeriodEnd,
+		subscription.CancelAtPeriodEnd,
+		subscription.CanceledAt,
+	).Scan(&subscription.CreatedAt, &subscription.UpdatedAt)
+
+	if err != nil {
+		return fmt.Errorf("failed to create subscription: %w", err)
+	}
+
+	return nil
+}
+
+// GetByOrganizerID retrieves the subscription of an organizer
+func (r *subscriptionRepository) GetByOrganizerID(ctx context.Context, organizerID string) (*entity.Subscription, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + subscriptionColumns + ` FROM plan_subscriptions WHERE organizer_id = $1`
+	return scanSubscription(r.db.QueryRowContext(ctx, query, organizerID))
+}
+
+// GetByID retrieves subscription by ID
+func (r *subscriptionRepository) GetByID(ctx context.Context, id string) (*entity.Subscription, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + subscriptionColumns + ` FROM plan_subscriptions WHERE id = $1`
+	return scanSubscription(r.db.QueryRowContext(ctx, query, id))
+}
+
+// Update updates subscription
+func (r *subscriptionRepository) Update(ctx context.Context, subscription *entity.Subscription) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE plan_subscriptions
+		SET plan_code = $2, pending_plan_code = $3, billing_email = $4, billing_name = $5,
+		    status = $6, current_period_start = $7, current_period_end = $8,
+		    cancel_at_period_end = $9, canceled_at = $10, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		subscription.ID,
+		subscription.PlanCode,
+		subscription.PendingPlanCode,
+		subscription.BillingEmail,
+		subscription.BillingName,
+		subscription.Status,
+		subscription.CurrentPeriodStart,
+		subscription.CurrentPeriodEnd,
+		subscription.CancelAtPeriodEnd,
+		subscription.CanceledAt,
+	).Scan(&subscription.UpdatedAt)
+
+	if err == sql.ErrNoRows {
+		return ErrSubscriptionNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update subscription: %w", err)
+	}
+
+	return nil
+}
+
+// ListDueForRenewal retrieves active subscriptions whose period has ended without a renewal invoice
+func (r *subscriptionRepository) ListDueForRenewal(ctx context.Context, now time.Time, limit int) ([]entity.Subscription, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + subscriptionColumns + `
+		FROM plan_subscriptions s
+		WHERE s.status = 'active'
+		  AND s.current_period_end <= $1
+		  AND NOT EXISTS (
+			SELECT 1 FROM subscription_invoices i
+			WHERE i.subscription_id = s.id
+			  AND i.kind = 'renewal'
+			  AND i.period_start = s.current_period_end
+		  )
+		ORDER BY s.current_period_end
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subscriptions due for renewal: %w", err)
+	}
+	defer rows.Close()
+
+	var subscriptions []entity.Subscription
+	for rows.Next() {
+		subscription, err := scanSubscription(rows)
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, *subscription)
+	}
+
+	return subscriptions, rows.Err()
+}
+
+// CreateInvoice inserts new subscription invoice
+// The external ID is derived from the generated ID so it's known before the Xendit invoice exists
+func (r *subscriptionRepository) CreateInvoice(ctx context.Context, invoice *entity.SubscriptionInvoice) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO subscription_invoices (
+			id, subscription_id, kind, plan_code, external_id, invoice_id, invoice_url,
+			amount, period_start, period_end, attempt, status, paid_at, expires_at,
+			created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`
+
+	invoice.ID = uuid.New().String()
+	invoice.ExternalID = entity.SubscriptionExternalIDPrefix + invoice.ID
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		invoice.ID,
+		invoice.SubscriptionID,
+		invoice.Kind,
+		invoice.PlanCode,
+		invoice.ExternalID,
+		invoice.InvoiceID,
+		invoice.InvoiceURL,
+		invoice.Amount,
+		invoice.PeriodStart,
+		invoice.PeriodEnd,
+		invoice.Attempt,
+		invoice.Status,
+		invoice.PaidAt,
+		invoice.ExpiresAt,
+	).Scan(&invoice.CreatedAt, &invoice.UpdatedAt)
+
+	if err != nil {
+		// Renewal attempt already invoiced by another worker
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return ErrDuplicateSubscriptionInvoice
+		}
+		return fmt.Errorf("failed to create subscription invoice: %w", err)
+	}
+
+	return nil
+}
+
+// GetInvoiceByExternalID retrieves subscription invoice by external ID (SUB-{id})
+func (r *subscriptionRepository) GetInvoiceByExternalID(ctx context.Context, externalID string) (*entity.SubscriptionInvoice, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + subscriptionInvoiceColumns + ` FROM subscription_invoices WHERE external_id = $1`
+	return scanSubscriptionInvoice(r.db.QueryRowContext(ctx, query, externalID))
+}
+
+// GetLatestInvoice retrieves the most recent invoice of a subscription
+func (r *subscriptionRepository) GetLatestInvoice(ctx context.Context, subscriptionID string) (*entity.SubscriptionInvoice, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + subscriptionInvoiceColumns + `
+		FROM subscription_invoices
+		WHERE subscription_id = $1
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
+	return scanSubscriptionInvoice(r.db.QueryRowContext(ctx, query, subscriptionID))
+}
+
+// UpdateInvoice updates subscription invoice
+func (r *subscriptionRepository) UpdateInvoice(ctx context.Context, invoice *entity.SubscriptionInvoice) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE subscription_invoices
+		SET invoice_id = $2, invoice_url = $3, status = $4, paid_at = $5, expires_at = $6, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		invoice.ID,
+		invoice.InvoiceID,
+		invoice.InvoiceURL,
+		invoice.Status,
+		invoice.PaidAt,
+		invoice.ExpiresAt,
+	).Scan(&invoice.UpdatedAt)
+
+	if err == sql.ErrNoRows {
+		return ErrSubscriptionInvoiceNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update subscription invoice: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteInvoice deletes a subscription invoice that couldn't be created in Xendit
+func (r *subscriptionRepository) DeleteInvoice(ctx context.Context, id string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM subscription_invoices WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to delete subscription invoice: %w", err)
+	}
+	return nil
+}
+
+// ListFailedRenewals retrieves the latest renewal invoice of subscriptions whose renewal is unpaid
+// and has expired, either reported by Xendit or past its expiry time
+func (r *subscriptionRepository) ListFailedRenewals(ctx context.Context, now time.Time, limit int) ([]entity.SubscriptionInvoice, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + subscriptionInvoiceColumns + `
+		FROM (
+			SELECT DISTINCT ON (i.subscription_id) i.*
+			FROM subscription_invoices i
+			JOIN plan_subscriptions s ON s.id = i.subscription_id
+			WHERE i.kind = 'renewal'
+			  AND s.status IN ('active', 'past_due')
+			  AND i.period_start = s.current_period_end
+			ORDER BY i.subscription_id, i.attempt DESC
+		) latest
+		WHERE latest.status = 'expired'
+		   OR (latest.status = 'pending' AND latest.expires_at <= $1)
+		ORDER BY latest.expires_at
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list failed renewals: %w", err)
+	}
+	defer rows.Close()
+
+	var invoices []entity.SubscriptionInvoice
+	for rows.Next() {
+		invoice, err := scanSubscriptionInvoice(rows)
+		if err != nil {
+			return nil, err
+		}
+		invoices = append(invoices, *invoice)
+	}
+
+	return invoices, rows.Err()
+}
+
+// rowScanner is satisfied by *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanSubscription scans a row selected with subscriptionColumns
+func scanSubscription(row rowScanner) (*entity.Subscription, error) {
+	var subscription entity.Subscription
+	err := row.Scan(
+		&subscription.ID,
+		&subscription.OrganizerID,
+		&subscription.PlanCode,
+		&subscription.PendingPlanCode,
+		&subscription.BillingEmail,
+		&subscription.BillingName,
+		&subscription.Status,
+		&subscription.CurrentPeriodStart,
+		&subscription.CurrentPeriodEnd,
+		&subscription.CancelAtPeriodEnd,
+		&subscription.CanceledAt,
+		&subscription.CreatedAt,
+		&subscription.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, ErrSubscriptionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan subscription: %w", err)
+	}
+
+	return &subscription, nil
+}
+
+// scanSubscriptionInvoice scans a row selected with subscriptionInvoiceColumns
+func scanSubscriptionInvoice(row rowScanner) (*entity.SubscriptionInvoice, error) {
+	var invoice entity.SubscriptionInvoice
+	err := row.Scan(
+		&invoice.ID,
+		&invoice.SubscriptionID,
+		&invoice.Kind,
+		&invoice.PlanCode,
+		&invoice.ExternalID,
+		&invoice.InvoiceID,
+		&invoice.InvoiceURL,
+		&invoice.Amount,
+		&invoice.PeriodStart,
+		&invoice.PeriodEnd,
+		&invoice.Attempt,
+		&invoice.Status,
+		&invoice.PaidAt,
+		&invoice.ExpiresAt,
+		&invoice.CreatedAt,
+		&invoice.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, ErrSubscriptionInvoiceNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan subscription invoice: %w", err)
+	}
+
+	return &invoice, nil
+}
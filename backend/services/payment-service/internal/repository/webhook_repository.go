@@ -5,23 +5,33 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
 )
 
 var (
-	ErrWebhookNotFound      = errors.New("webhook event not found")
-	ErrDuplicateWebhook     = errors.New("webhook already processed")
+	ErrWebhookNotFound       = errors.New("webhook event not found")
+	ErrDuplicateWebhook      = errors.New("webhook already processed")
+	ErrWebhookNotQuarantined = errors.New("webhook is not quarantined")
 )
 
 // WebhookRepository defines interface for webhook data operations
 type WebhookRepository interface {
 	Create(ctx context.Context, webhook *entity.WebhookEvent) error
+	GetByID(ctx context.Context, id string) (*entity.WebhookEvent, error)
 	GetByWebhookID(ctx context.Context, webhookID string) (*entity.WebhookEvent, error)
 	MarkAsProcessed(ctx context.Context, webhookID string) error
 	MarkAsFailed(ctx context.Context, webhookID string) error
+	MarkAsQuarantined(ctx context.Context, webhookID string, reason string) error
+	ListQuarantined(ctx context.Context, eventType string, limit int) ([]*entity.WebhookEvent, error)
+	Resolve(ctx context.Context, id string, status string, resolvedBy string) error
+	AnonymizeBefore(ctx context.Context, before time.Time, batchSize int) (int64, error)
+	SoftDeleteBefore(ctx context.Context, before time.Time, batchSize int) (int64, error)
+	PurgeDeletedBefore(ctx context.Context, before time.Time, batchSize int) (int64, error)
 }
 
 // webhookRepository implements WebhookRepository interface
@@ -36,11 +46,14 @@ func NewWebhookRepository(db *sql.DB) WebhookRepository {
 
 // Create inserts new webhook event (idempotency check via unique constraint)
 func (r *webhookRepository) Create(ctx context.Context, webhook *entity.WebhookEvent) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		INSERT INTO webhook_events (
-			id, webhook_id, event_type, payload, status, created_at
+			id, webhook_id, event_type, payload, status, sandbox, created_at
 		)
-		VALUES ($1, $2, $3, $4, $5, NOW())
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
 		RETURNING id, created_at
 	`
 
@@ -54,6 +67,7 @@ func (r *webhookRepository) Create(ctx context.Context, webhook *entity.WebhookE
 		webhook.EventType,
 		webhook.Payload,
 		webhook.Status,
+		webhook.Sandbox,
 	).Scan(&webhook.ID, &webhook.CreatedAt)
 
 	if err != nil {
@@ -67,25 +81,62 @@ func (r *webhookRepository) Create(ctx context.Context, webhook *entity.WebhookE
 	return nil
 }
 
-// GetByWebhookID retrieves webhook event by webhook ID
-func (r *webhookRepository) GetByWebhookID(ctx context.Context, webhookID string) (*entity.WebhookEvent, error) {
-	query := `
-		SELECT id, webhook_id, event_type, payload, processed_at, status, created_at
-		FROM webhook_events
-		WHERE webhook_id = $1
-	`
+// webhookColumns are the columns scanned by scanWebhook
+const webhookColumns = `
+	id, webhook_id, event_type, payload, processed_at, status,
+	sandbox, quarantine_reason, resolved_by, resolved_at, created_at
+`
 
+// scanWebhook scans a row selected with webhookColumns
+func scanWebhook(row rowScanner) (*entity.WebhookEvent, error) {
 	webhook := &entity.WebhookEvent{}
-	err := r.db.QueryRowContext(ctx, query, webhookID).Scan(
+	err := row.Scan(
 		&webhook.ID,
 		&webhook.WebhookID,
 		&webhook.EventType,
 		&webhook.Payload,
 		&webhook.ProcessedAt,
 		&webhook.Status,
+		&webhook.Sandbox,
+		&webhook.QuarantineReason,
+		&webhook.ResolvedBy,
+		&webhook.ResolvedAt,
 		&webhook.CreatedAt,
 	)
+	return webhook, err
+}
 
+// GetByID retrieves webhook event by ID
+func (r *webhookRepository) GetByID(ctx context.Context, id string) (*entity.WebhookEvent, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, ErrWebhookNotFound
+	}
+
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + webhookColumns + ` FROM webhook_events WHERE id = $1`
+
+	webhook, err := scanWebhook(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrWebhookNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook event: %w", err)
+	}
+
+	return webhook, nil
+}
+
+// GetByWebhookID retrieves webhook event by webhook ID
+func (r *webhookRepository) GetByWebhookID(ctx context.Context, webhookID string) (*entity.WebhookEvent, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + webhookColumns + ` FROM webhook_events WHERE webhook_id = $1`
+
+	webhook, err := scanWebhook(r.db.QueryRowContext(ctx, query, webhookID))
 	if err == sql.ErrNoRows {
 		return nil, ErrWebhookNotFound
 	}
@@ -99,6 +150,9 @@ func (r *webhookRepository) GetByWebhookID(ctx context.Context, webhookID string
 
 // MarkAsProcessed marks webhook as successfully processed
 func (r *webhookRepository) MarkAsProcessed(ctx context.Context, webhookID string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		UPDATE webhook_events
 		SET status = $1, processed_at = NOW()
@@ -124,6 +178,9 @@ func (r *webhookRepository) MarkAsProcessed(ctx context.Context, webhookID strin
 
 // MarkAsFailed marks webhook as failed
 func (r *webhookRepository) MarkAsFailed(ctx context.Context, webhookID string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		UPDATE webhook_events
 		SET status = $1
@@ -146,3 +203,191 @@ func (r *webhookRepository) MarkAsFailed(ctx context.Context, webhookID string)
 
 	return nil
 }
+
+// MarkAsQuarantined marks webhook as quarantined for an admin to replay or dismiss
+// A webhook quarantined again after a replay gets the new reason
+func (r *webhookRepository) MarkAsQuarantined(ctx context.Context, webhookID string, reason string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE webhook_events
+		SET status = $1, quarantine_reason = $2
+		WHERE webhook_id = $3
+	`
+
+	result, err := r.db.ExecContext(ctx, query, entity.WebhookStatusQuarantined, reason, webhookID)
+	if err != nil {
+		return fmt.Errorf("failed to mark webhook as quarantined: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+// ListQuarantined lists quarantined webhooks, newest first, optionally of one event type
+func (r *webhookRepository) ListQuarantined(ctx context.Context, eventType string, limit int) ([]*entity.WebhookEvent, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM webhook_events
+		WHERE status = $1
+		  AND ($2 = '' OR event_type = $2)
+		ORDER BY created_at DESC
+		LIMIT $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, entity.WebhookStatusQuarantined, eventType, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quarantined webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	webhooks := []*entity.WebhookEvent{}
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook event: %w", err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list quarantined webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// Resolve moves a quarantined webhook to status (processed after a replay, or dismissed)
+// Returns ErrWebhookNotQuarantined when it was resolved in the meantime
+func (r *webhookRepository) Resolve(ctx context.Context, id string, status string, resolvedBy string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE webhook_events
+		SET status = $1, resolved_by = $2, resolved_at = NOW(),
+		    processed_at = CASE WHEN $1 = 'processed' THEN NOW() ELSE processed_at END
+		WHERE id = $3 AND status = $4
+	`
+
+	result, err := r.db.ExecContext(ctx, query, status, resolvedBy, id, entity.WebhookStatusQuarantined)
+	if err != nil {
+		return fmt.Errorf("failed to resolve webhook: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrWebhookNotQuarantined
+	}
+
+	return nil
+}
+
+// AnonymizeBefore strips PII from payloads of finished webhooks created before the cutoff
+// Only fields needed for reconciliation are kept (payer email, description, etc. are dropped)
+// Pending and quarantined webhooks are never touched
+func (r *webhookRepository) AnonymizeBefore(ctx context.Context, before time.Time, batchSize int) (int64, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE webhook_events
+		SET payload = jsonb_strip_nulls(jsonb_build_object(
+		        'id', payload->'id',
+		        'external_id', payload->'external_id',
+		        'status', payload->'status',
+		        'amount', payload->'amount',
+		        'paid_amount', payload->'paid_amount',
+		        'payment_method', payload->'payment_method',
+		        'payment_channel', payload->'payment_channel',
+		        'paid_at', payload->'paid_at',
+		        'created', payload->'created',
+		        'updated', payload->'updated'
+		    )),
+		    anonymized_at = NOW()
+		WHERE id IN (
+			SELECT id FROM webhook_events
+			WHERE status IN ($1, $2, $3)
+			  AND anonymized_at IS NULL
+			  AND deleted_at IS NULL
+			  AND created_at < $4
+			LIMIT $5
+			FOR UPDATE SKIP LOCKED
+		)
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		entity.WebhookStatusProcessed, entity.WebhookStatusFailed, entity.WebhookStatusDismissed, before, batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to anonymize webhook events: %w", err)
+	}
+
+	return result.RowsAffected()
+}
+
+// SoftDeleteBefore marks finished webhooks created before the cutoff as deleted
+// Rows are kept (with webhook_id) so duplicate deliveries are still rejected until purge
+func (r *webhookRepository) SoftDeleteBefore(ctx context.Context, before time.Time, batchSize int) (int64, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE webhook_events
+		SET payload = '{}'::jsonb, deleted_at = NOW()
+		WHERE id IN (
+			SELECT id FROM webhook_events
+			WHERE status IN ($1, $2, $3)
+			  AND deleted_at IS NULL
+			  AND created_at < $4
+			LIMIT $5
+			FOR UPDATE SKIP LOCKED
+		)
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		entity.WebhookStatusProcessed, entity.WebhookStatusFailed, entity.WebhookStatusDismissed, before, batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to soft delete webhook events: %w", err)
+	}
+
+	return result.RowsAffected()
+}
+
+// PurgeDeletedBefore permanently removes webhooks soft-deleted before the cutoff
+func (r *webhookRepository) PurgeDeletedBefore(ctx context.Context, before time.Time, batchSize int) (int64, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		DELETE FROM webhook_events
+		WHERE id IN (
+			SELECT id FROM webhook_events
+			WHERE deleted_at IS NOT NULL AND deleted_at < $1
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, before, batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge webhook events: %w", err)
+	}
+
+	return result.RowsAffected()
+}
@@ -4,10 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/config"
-	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/client"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/request"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/response"
@@ -18,49 +19,93 @@ var (
 	ErrPaymentNotFound    = errors.New("payment transaction not found")
 	ErrPaymentAlreadyPaid = errors.New("payment already completed")
 	ErrXenditAPIError     = errors.New("xendit API error")
+	ErrSandboxUnavailable = errors.New("sandbox payments are not configured")
 )
 
 // PaymentService handles payment operations
 type PaymentService interface {
 	CreateInvoice(ctx context.Context, req *request.CreateInvoiceRequest) (*response.InvoiceResponse, error)
 	GetInvoice(ctx context.Context, orderID string) (*response.InvoiceResponse, error)
+	GetPaymentStatus(ctx context.Context, orderID string, refresh bool) (*response.PaymentStatusResponse, error)
+	ExpireInvoice(ctx context.Context, orderID string) (*response.ExpireInvoiceResponse, error)
+}
+
+// XenditClient defines interface for Xendit API communication
+type XenditClient interface {
+	CreateInvoice(req *request.XenditCreateInvoiceRequest) (*response.XenditInvoiceResponse, error)
+	GetInvoice(invoiceID string) (*response.XenditInvoiceResponse, error)
+	ExpireInvoice(invoiceID string) (*response.XenditInvoiceResponse, error)
+	CreateRefund(req *request.XenditCreateRefundRequest) (*response.XenditRefundResponse, error)
 }
 
 // paymentService implements PaymentService interface
 type paymentService struct {
-	paymentRepo   repository.PaymentRepository
-	xenditClient  *client.XenditClient
-	invoiceExpiry int
+	paymentRepo      repository.PaymentRepository
+	xenditClient     XenditClient
+	testXenditClient XenditClient // Test keys for sandbox payments, nil when not configured
+	statusCache      *paymentStatusCache
+	invoiceExpiry    int
 }
 
 // NewPaymentService creates new payment service instance
+// testXenditClient uses the test keys for sandbox events' test orders, may be nil
+// redisClient caches the payment status polled by checkout pages, may be nil
 func NewPaymentService(
 	paymentRepo repository.PaymentRepository,
-	xenditClient *client.XenditClient,
+	xenditClient XenditClient,
+	testXenditClient XenditClient,
+	redisClient cache.RedisClient,
 	cfg *config.Config,
 ) PaymentService {
 	return &paymentService{
-		paymentRepo:   paymentRepo,
-		xenditClient:  xenditClient,
-		invoiceExpiry: cfg.Xendit.InvoiceExpiry,
+		paymentRepo:      paymentRepo,
+		xenditClient:     xenditClient,
+		testXenditClient: testXenditClient,
+		statusCache:      newPaymentStatusCache(redisClient),
+		invoiceExpiry:    cfg.Xendit.InvoiceExpiry,
+	}
+}
+
+// clientFor returns the Xendit client of live or sandbox payments
+func (s *paymentService) clientFor(sandbox bool) (XenditClient, error) {
+	if !sandbox {
+		return s.xenditClient, nil
+	}
+	if s.testXenditClient == nil {
+		return nil, ErrSandboxUnavailable
 	}
+	return s.testXenditClient, nil
 }
 
 // CreateInvoice creates a new payment invoice via Xendit
+// Sandbox invoices are created with the test keys, so test orders are paid without real money
+// A pending invoice of the order is returned as is, unless the buyer asks for another payment method;
+// a new payment attempt is started when the buyer retries after the last one expired or failed
 func (s *paymentService) CreateInvoice(ctx context.Context, req *request.CreateInvoiceRequest) (*response.InvoiceResponse, error) {
+	xenditClient, err := s.clientFor(req.Sandbox)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if payment already exists for this order
+	attemptNumber := 1
 	existingPayment, err := s.paymentRepo.GetByOrderID(ctx, req.OrderID)
 	if err == nil {
 		// Payment exists
 		if existingPayment.IsPaid() {
 			return nil, ErrPaymentAlreadyPaid
 		}
-		// If pending, return existing invoice
-		return response.ToInvoiceResponse(existingPayment), nil
+		// If pending for the requested method, return existing invoice
+		if existingPayment.Status == entity.PaymentStatusPending && !existingPayment.IsExpired() && !changesPaymentMethod(existingPayment, req.PaymentMethod) {
+			return response.ToInvoiceResponse(existingPayment), nil
+		}
+		attemptNumber = existingPayment.AttemptNumber + 1
+	} else if !errors.Is(err, repository.ErrPaymentNotFound) {
+		return nil, fmt.Errorf("failed to get payment: %w", err)
 	}
 
-	// Create external ID (format: ORDER-{order_id})
-	externalID := fmt.Sprintf("ORDER-%s", req.OrderID)
+	// Create external ID (format: ORDER-{order_id}, ORDER-{order_id}-{attempt} for retries)
+	externalID := entity.PaymentExternalID(req.OrderID, attemptNumber)
 
 	// Prepare Xendit invoice request
 	xenditReq := &request.XenditCreateInvoiceRequest{
@@ -74,8 +119,14 @@ func (s *paymentService) CreateInvoice(ctx context.Context, req *request.CreateI
 		Currency:           "IDR",
 	}
 
+	var paymentMethod *string
+	if req.PaymentMethod != "" {
+		xenditReq.PaymentMethods = []string{req.PaymentMethod}
+		paymentMethod = &req.PaymentMethod
+	}
+
 	// Create invoice in Xendit
-	xenditResp, err := s.xenditClient.CreateInvoice(xenditReq)
+	xenditResp, err := xenditClient.CreateInvoice(xenditReq)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrXenditAPIError, err)
 	}
@@ -86,23 +137,36 @@ func (s *paymentService) CreateInvoice(ctx context.Context, req *request.CreateI
 	expiresAt := xenditResp.ExpiryDate
 
 	payment := &entity.PaymentTransaction{
-		OrderID:    req.OrderID,
-		ExternalID: externalID,
-		InvoiceID:  &invoiceID,
-		InvoiceURL: &invoiceURL,
-		Amount:     req.Amount,
-		Status:     entity.PaymentStatusPending,
-		ExpiresAt:  &expiresAt,
+		OrderID:       req.OrderID,
+		AttemptNumber: attemptNumber,
+		ExternalID:    externalID,
+		InvoiceID:     &invoiceID,
+		InvoiceURL:    &invoiceURL,
+		Amount:        req.Amount,
+		Currency:      xenditReq.Currency,
+		PaymentMethod: paymentMethod,
+		Status:        entity.PaymentStatusPending,
+		ExpiresAt:     &expiresAt,
+		IsSandbox:     req.Sandbox,
 	}
 
 	if err := s.paymentRepo.Create(ctx, payment); err != nil {
 		return nil, fmt.Errorf("failed to save payment transaction: %w", err)
 	}
+	s.statusCache.Invalidate(ctx, payment.OrderID)
 
 	return response.ToInvoiceResponse(payment), nil
 }
 
-// GetInvoice retrieves invoice by order ID
+// changesPaymentMethod checks if the buyer asks to pay a pending payment with another method
+func changesPaymentMethod(payment *entity.PaymentTransaction, paymentMethod string) bool {
+	if paymentMethod == "" {
+		return false
+	}
+	return payment.PaymentMethod == nil || !strings.EqualFold(*payment.PaymentMethod, paymentMethod)
+}
+
+// GetInvoice retrieves invoice by order ID, with the history of the order's payment attempts
 func (s *paymentService) GetInvoice(ctx context.Context, orderID string) (*response.InvoiceResponse, error) {
 	payment, err := s.paymentRepo.GetByOrderID(ctx, orderID)
 	if err != nil {
@@ -112,24 +176,131 @@ func (s *paymentService) GetInvoice(ctx context.Context, orderID string) (*respo
 		return nil, fmt.Errorf("failed to get payment: %w", err)
 	}
 
-	// If payment is pending, sync with Xendit to get latest status
-	if payment.Status == entity.PaymentStatusPending && payment.InvoiceID != nil {
-		xenditInvoice, err := s.xenditClient.GetInvoice(*payment.InvoiceID)
-		if err == nil {
-			// Update local status based on Xendit response
-			if xenditInvoice.Status == "PAID" && payment.Status != entity.PaymentStatusPaid {
-				paidAt := time.Now()
-				payment.Status = entity.PaymentStatusPaid
-				payment.PaidAt = &paidAt
-				paymentMethod := xenditInvoice.Status
-				payment.PaymentMethod = &paymentMethod
-				s.paymentRepo.Update(ctx, payment)
-			} else if xenditInvoice.Status == "EXPIRED" && payment.Status != entity.PaymentStatusExpired {
-				payment.Status = entity.PaymentStatusExpired
-				s.paymentRepo.Update(ctx, payment)
+	s.syncWithXendit(ctx, payment)
+
+	attempts, err := s.paymentRepo.ListByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payment attempts: %w", err)
+	}
+
+	// The listed attempt misses the status just synced with Xendit
+	for i, attempt := range attempts {
+		if attempt.ID == payment.ID {
+			attempts[i] = payment
+		}
+	}
+
+	invoice := response.ToInvoiceResponse(payment)
+	invoice.Attempts = response.ToPaymentAttemptResponses(attempts)
+	return invoice, nil
+}
+
+// GetPaymentStatus returns the payment status of an order for checkout page polling
+// Served from the cache or the database without calling Xendit; refresh syncs with Xendit first,
+// at most once per paymentStatusRefreshInterval per order
+func (s *paymentService) GetPaymentStatus(ctx context.Context, orderID string, refresh bool) (*response.PaymentStatusResponse, error) {
+	refresh = refresh && s.statusCache.AllowRefresh(ctx, orderID)
+	if !refresh {
+		if cached := s.statusCache.Get(ctx, orderID); cached != nil {
+			return cached, nil
+		}
+	}
+
+	payment, err := s.paymentRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPaymentNotFound) {
+			return nil, ErrPaymentNotFound
+		}
+		return nil, fmt.Errorf("failed to get payment: %w", err)
+	}
+
+	if refresh {
+		s.syncWithXendit(ctx, payment)
+	}
+
+	status := response.ToPaymentStatusResponse(payment)
+	s.statusCache.Set(ctx, status)
+	return status, nil
+}
+
+// ExpireInvoice expires the pending invoices of a cancelled order at Xendit, so the buyer can no longer pay them
+// An invoice Xendit refuses to expire because it was paid meanwhile is reported as AlreadyPaid,
+// its paid webhook refunds the payment once ticketing service refuses it for the cancelled order
+func (s *paymentService) ExpireInvoice(ctx context.Context, orderID string) (*response.ExpireInvoiceResponse, error) {
+	attempts, err := s.paymentRepo.ListByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payment attempts: %w", err)
+	}
+
+	result := &response.ExpireInvoiceResponse{OrderID: orderID}
+	for _, payment := range attempts {
+		if payment.IsPaid() {
+			result.AlreadyPaid = true
+			continue
+		}
+		if payment.Status != entity.PaymentStatusPending || payment.InvoiceID == nil {
+			continue
+		}
+
+		xenditClient, err := s.clientFor(payment.IsSandbox)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := xenditClient.ExpireInvoice(*payment.InvoiceID); err != nil {
+			// Xendit refuses to expire paid invoices
+			xenditInvoice, getErr := xenditClient.GetInvoice(*payment.InvoiceID)
+			if getErr == nil && (xenditInvoice.Status == "PAID" || xenditInvoice.Status == "SETTLED") {
+				result.AlreadyPaid = true
+				continue
 			}
+			return nil, fmt.Errorf("%w: %v", ErrXenditAPIError, err)
 		}
+
+		payment.Status = entity.PaymentStatusExpired
+		if err := s.paymentRepo.Update(ctx, payment); err != nil {
+			return nil, fmt.Errorf("failed to update payment status: %w", err)
+		}
+		s.statusCache.Invalidate(ctx, payment.OrderID)
+		result.InvoicesExpired++
 	}
 
-	return response.ToInvoiceResponse(payment), nil
+	return result, nil
+}
+
+// syncWithXendit updates a pending payment with its invoice status at Xendit
+// Xendit errors are ignored, the payment keeps its local status
+func (s *paymentService) syncWithXendit(ctx context.Context, payment *entity.PaymentTransaction) {
+	if payment.Status != entity.PaymentStatusPending || payment.InvoiceID == nil {
+		return
+	}
+
+	xenditClient, err := s.clientFor(payment.IsSandbox)
+	if err != nil {
+		return
+	}
+
+	xenditInvoice, err := xenditClient.GetInvoice(*payment.InvoiceID)
+	if err != nil {
+		return
+	}
+
+	// Update local status based on Xendit response
+	if xenditInvoice.Status == "PAID" && payment.Status != entity.PaymentStatusPaid {
+		paid := *payment
+		paidAt := time.Now()
+		paid.PaidAt = &paidAt
+		paymentMethod := xenditInvoice.Status
+		paid.PaymentMethod = &paymentMethod
+		// When another attempt of the order is paid already, the paid webhook flags the double payment
+		if err := s.paymentRepo.MarkPaid(ctx, &paid); err != nil {
+			return
+		}
+		*payment = paid
+		s.statusCache.Invalidate(ctx, payment.OrderID)
+	} else if xenditInvoice.Status == "EXPIRED" && payment.Status != entity.PaymentStatusExpired {
+		payment.Status = entity.PaymentStatusExpired
+		s.paymentRepo.Update(ctx, payment)
+		s.statusCache.Invalidate(ctx, payment.OrderID)
+	}
 }
@@ -0,0 +1,377 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/config"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/repository"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryRedis keeps string keys in memory, expirations are ignored
+type memoryRedis struct {
+	cache.RedisClient
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func newMemoryRedis() *memoryRedis {
+	return &memoryRedis{values: map[string]string{}}
+}
+
+func (r *memoryRedis) Get(ctx context.Context, key string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.values[key], nil
+}
+
+func (r *memoryRedis) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.values[key] = value.(string)
+	return nil
+}
+
+func (r *memoryRedis) SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.values[key]; ok {
+		return false, nil
+	}
+	r.values[key] = value.(string)
+	return true, nil
+}
+
+func (r *memoryRedis) Del(ctx context.Context, keys ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, key := range keys {
+		delete(r.values, key)
+	}
+	return nil
+}
+
+// GetByOrderID returns a copy, so only Update changes the stored payment
+func (r *stubPaymentRepo) GetByOrderID(ctx context.Context, orderID string) (*entity.PaymentTransaction, error) {
+	if r.payment == nil || r.payment.OrderID != orderID {
+		return nil, repository.ErrPaymentNotFound
+	}
+	payment := *r.payment
+	return &payment, nil
+}
+
+func TestGetPaymentStatus_PollsAreServedFromCache(t *testing.T) {
+	_, paymentRepo := newWebhookFixture()
+	xendit := &testutil.XenditClient{}
+	svc := NewPaymentService(paymentRepo, xendit, nil, newMemoryRedis(), &config.Config{})
+
+	status, err := svc.GetPaymentStatus(context.Background(), "order-1", false)
+	require.NoError(t, err)
+	assert.Equal(t, entity.PaymentStatusPending, status.Status)
+
+	// A change that skipped invalidation isn't seen until the cache entry expires
+	paymentRepo.payment.Status = entity.PaymentStatusFailed
+	status, err = svc.GetPaymentStatus(context.Background(), "order-1", false)
+	require.NoError(t, err)
+	assert.Equal(t, entity.PaymentStatusPending, status.Status)
+
+	assert.Empty(t, xendit.GetInvoiceCalls())
+
+	_, err = svc.GetPaymentStatus(context.Background(), "order-2", false)
+	assert.ErrorIs(t, err, ErrPaymentNotFound)
+}
+
+func TestGetPaymentStatus_RefreshSyncsWithXenditOncePerInterval(t *testing.T) {
+	_, paymentRepo := newWebhookFixture()
+	xendit := &testutil.XenditClient{
+		GetInvoiceFunc: func(invoiceID string) (*response.XenditInvoiceResponse, error) {
+			return &response.XenditInvoiceResponse{ID: invoiceID, Status: "PAID"}, nil
+		},
+	}
+	svc := NewPaymentService(paymentRepo, xendit, nil, newMemoryRedis(), &config.Config{})
+
+	status, err := svc.GetPaymentStatus(context.Background(), "order-1", true)
+	require.NoError(t, err)
+	assert.Equal(t, entity.PaymentStatusPaid, status.Status)
+	assert.Equal(t, entity.PaymentStatusPaid, paymentRepo.payment.Status)
+
+	// Refreshes within the interval are answered like plain polls
+	status, err = svc.GetPaymentStatus(context.Background(), "order-1", true)
+	require.NoError(t, err)
+	assert.Equal(t, entity.PaymentStatusPaid, status.Status)
+	assert.Equal(t, []string{"inv-123"}, xendit.GetInvoiceCalls())
+}
+
+func TestGetPaymentStatus_WebhookInvalidatesCache(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	redis := newMemoryRedis()
+	payments := NewPaymentService(paymentRepo, &testutil.XenditClient{}, nil, redis, &config.Config{})
+	webhooks := NewWebhookService(webhookRepo, paymentRepo, nil, nil, nil, nil, &testutil.TicketingClient{}, nil, redis)
+
+	status, err := payments.GetPaymentStatus(context.Background(), "order-1", false)
+	require.NoError(t, err)
+	require.Equal(t, entity.PaymentStatusPending, status.Status)
+
+	require.NoError(t, webhooks.ProcessWebhook(context.Background(), "wh-1", entity.EventTypeInvoicePaid, paidPayload(t)))
+
+	status, err = payments.GetPaymentStatus(context.Background(), "order-1", false)
+	require.NoError(t, err)
+	assert.Equal(t, entity.PaymentStatusPaid, status.Status)
+	assert.NotNil(t, status.PaidAt)
+}
+
+// attemptPaymentRepo keeps the payment attempts of orders in memory
+type attemptPaymentRepo struct {
+	repository.PaymentRepository
+	attempts []*entity.PaymentTransaction
+}
+
+func (r *attemptPaymentRepo) Create(ctx context.Context, payment *entity.PaymentTransaction) error {
+	for _, attempt := range r.attempts {
+		if attempt.OrderID == payment.OrderID && attempt.AttemptNumber == payment.AttemptNumber {
+			return repository.ErrPaymentAttemptExists
+		}
+	}
+	payment.ID = fmt.Sprintf("pay-%d", len(r.attempts)+1)
+	r.attempts = append(r.attempts, payment)
+	return nil
+}
+
+func (r *attemptPaymentRepo) GetByOrderID(ctx context.Context, orderID string) (*entity.PaymentTransaction, error) {
+	var latest *entity.PaymentTransaction
+	for _, attempt := range r.attempts {
+		if attempt.OrderID != orderID {
+			continue
+		}
+		if attempt.IsPaid() {
+			return attempt, nil
+		}
+		if latest == nil || attempt.AttemptNumber > latest.AttemptNumber {
+			latest = attempt
+		}
+	}
+	if latest == nil {
+		return nil, repository.ErrPaymentNotFound
+	}
+	return latest, nil
+}
+
+func (r *attemptPaymentRepo) ListByOrderID(ctx context.Context, orderID string) ([]*entity.PaymentTransaction, error) {
+	var attempts []*entity.PaymentTransaction
+	for _, attempt := range r.attempts {
+		if attempt.OrderID == orderID {
+			attempts = append(attempts, attempt)
+		}
+	}
+	return attempts, nil
+}
+
+func (r *attemptPaymentRepo) GetByInvoiceID(ctx context.Context, invoiceID string) (*entity.PaymentTransaction, error) {
+	for _, attempt := range r.attempts {
+		if attempt.InvoiceID != nil && *attempt.InvoiceID == invoiceID {
+			return attempt, nil
+		}
+	}
+	return nil, repository.ErrPaymentNotFound
+}
+
+func (r *attemptPaymentRepo) Update(ctx context.Context, payment *entity.PaymentTransaction) error {
+	return nil
+}
+
+func (r *attemptPaymentRepo) MarkPaid(ctx context.Context, payment *entity.PaymentTransaction) error {
+	for _, attempt := range r.attempts {
+		if attempt.OrderID == payment.OrderID && attempt.IsPaid() {
+			return repository.ErrOrderAlreadyPaid
+		}
+	}
+	payment.Status = entity.PaymentStatusPaid
+	return nil
+}
+
+func newInvoiceRequest(paymentMethod string) *request.CreateInvoiceRequest {
+	return &request.CreateInvoiceRequest{
+		OrderID:       "order-1",
+		Amount:        money.New(150000),
+		PayerEmail:    "buyer@example.com",
+		Description:   "Order order-1",
+		PaymentMethod: paymentMethod,
+	}
+}
+
+func TestCreateInvoice_RetriesAsNewAttempts(t *testing.T) {
+	ctx := context.Background()
+	paymentRepo := &attemptPaymentRepo{}
+	xendit := &testutil.XenditClient{}
+	svc := NewPaymentService(paymentRepo, xendit, nil, nil, &config.Config{})
+
+	first, err := svc.CreateInvoice(ctx, newInvoiceRequest(""))
+	require.NoError(t, err)
+	assert.Equal(t, 1, first.AttemptNumber)
+	assert.Equal(t, "ORDER-order-1", first.ExternalID)
+
+	// A pending invoice is reused while the buyer keeps the method
+	again, err := svc.CreateInvoice(ctx, newInvoiceRequest(""))
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, again.ID)
+
+	// A declined card is retried with another method as the next attempt
+	paymentRepo.attempts[0].Status = entity.PaymentStatusFailed
+	retry, err := svc.CreateInvoice(ctx, newInvoiceRequest("OVO"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, retry.AttemptNumber)
+	assert.Equal(t, "ORDER-order-1-2", retry.ExternalID)
+	require.NotNil(t, retry.PaymentMethod)
+	assert.Equal(t, "OVO", *retry.PaymentMethod)
+
+	// Asking for another method while pending starts another attempt
+	switched, err := svc.CreateInvoice(ctx, newInvoiceRequest("BCA"))
+	require.NoError(t, err)
+	assert.Equal(t, 3, switched.AttemptNumber)
+
+	calls := xendit.CreateInvoiceCalls()
+	require.Len(t, calls, 3)
+	assert.Empty(t, calls[0].PaymentMethods)
+	assert.Equal(t, []string{"OVO"}, calls[1].PaymentMethods)
+	assert.Equal(t, []string{"BCA"}, calls[2].PaymentMethods)
+
+	invoice, err := svc.GetInvoice(ctx, "order-1")
+	require.NoError(t, err)
+	require.Len(t, invoice.Attempts, 3)
+	assert.Equal(t, entity.PaymentStatusFailed, invoice.Attempts[0].Status)
+	assert.Equal(t, 3, invoice.Attempts[2].AttemptNumber)
+}
+
+func TestCreateInvoice_PaidAttemptBlocksRetries(t *testing.T) {
+	ctx := context.Background()
+	paymentRepo := &attemptPaymentRepo{}
+	svc := NewPaymentService(paymentRepo, &testutil.XenditClient{}, nil, nil, &config.Config{})
+
+	_, err := svc.CreateInvoice(ctx, newInvoiceRequest(""))
+	require.NoError(t, err)
+	_, err = svc.CreateInvoice(ctx, newInvoiceRequest("OVO"))
+	require.NoError(t, err)
+
+	// The buyer still paid the first invoice
+	paymentRepo.attempts[0].Status = entity.PaymentStatusPaid
+
+	_, err = svc.CreateInvoice(ctx, newInvoiceRequest("BCA"))
+	assert.ErrorIs(t, err, ErrPaymentAlreadyPaid)
+
+	invoice, err := svc.GetInvoice(ctx, "order-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, invoice.AttemptNumber, "the paid attempt is the order's invoice")
+}
+
+func TestProcessWebhook_SecondPaidAttemptIsNotConfirmed(t *testing.T) {
+	ctx := context.Background()
+	paymentRepo := &attemptPaymentRepo{}
+	ticketing := &testutil.TicketingClient{}
+	payments := NewPaymentService(paymentRepo, &testutil.XenditClient{}, nil, nil, &config.Config{})
+	webhookRepo, _ := newWebhookFixture()
+	webhooks := NewWebhookService(webhookRepo, paymentRepo, nil, nil, nil, nil, ticketing, nil, nil)
+
+	_, err := payments.CreateInvoice(ctx, newInvoiceRequest(""))
+	require.NoError(t, err)
+	_, err = payments.CreateInvoice(ctx, newInvoiceRequest("OVO"))
+	require.NoError(t, err)
+
+	for i, attempt := range paymentRepo.attempts {
+		payload, err := json.Marshal(response.XenditWebhookPayload{
+			ID:         *attempt.InvoiceID,
+			ExternalID: attempt.ExternalID,
+			Status:     "PAID",
+			PaidAmount: money.New(150000),
+			PaidAt:     time.Now(),
+		})
+		require.NoError(t, err)
+		require.NoError(t, webhooks.ProcessWebhook(ctx, fmt.Sprintf("wh-%d", i), entity.EventTypeInvoicePaid, payload))
+	}
+
+	assert.Equal(t, entity.PaymentStatusPaid, paymentRepo.attempts[0].Status)
+	assert.Equal(t, entity.PaymentStatusPending, paymentRepo.attempts[1].Status)
+	assert.Len(t, ticketing.ConfirmPaymentCalls(), 1, "only the first paid attempt confirms the order")
+}
+
+func TestExpireInvoice(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("expires pending attempts", func(t *testing.T) {
+		paymentRepo := &attemptPaymentRepo{}
+		xendit := &testutil.XenditClient{}
+		svc := NewPaymentService(paymentRepo, xendit, nil, nil, &config.Config{})
+
+		_, err := svc.CreateInvoice(ctx, newInvoiceRequest(""))
+		require.NoError(t, err)
+		paymentRepo.attempts[0].Status = entity.PaymentStatusFailed
+		_, err = svc.CreateInvoice(ctx, newInvoiceRequest("OVO"))
+		require.NoError(t, err)
+
+		result, err := svc.ExpireInvoice(ctx, "order-1")
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.InvoicesExpired)
+		assert.False(t, result.AlreadyPaid)
+		assert.Equal(t, []string{*paymentRepo.attempts[1].InvoiceID}, xendit.ExpireInvoiceCalls())
+		assert.Equal(t, entity.PaymentStatusFailed, paymentRepo.attempts[0].Status)
+		assert.Equal(t, entity.PaymentStatusExpired, paymentRepo.attempts[1].Status)
+
+		// Cancelling again finds nothing left to expire
+		result, err = svc.ExpireInvoice(ctx, "order-1")
+		require.NoError(t, err)
+		assert.Zero(t, result.InvoicesExpired)
+	})
+
+	t.Run("invoice paid before it could be expired", func(t *testing.T) {
+		paymentRepo := &attemptPaymentRepo{}
+		xendit := &testutil.XenditClient{
+			ExpireInvoiceFunc: func(invoiceID string) (*response.XenditInvoiceResponse, error) {
+				return nil, errors.New("xendit API error: 400")
+			},
+			GetInvoiceFunc: func(invoiceID string) (*response.XenditInvoiceResponse, error) {
+				return &response.XenditInvoiceResponse{ID: invoiceID, Status: "PAID"}, nil
+			},
+		}
+		svc := NewPaymentService(paymentRepo, xendit, nil, nil, &config.Config{})
+
+		_, err := svc.CreateInvoice(ctx, newInvoiceRequest(""))
+		require.NoError(t, err)
+
+		result, err := svc.ExpireInvoice(ctx, "order-1")
+		require.NoError(t, err)
+		assert.True(t, result.AlreadyPaid)
+		assert.Zero(t, result.InvoicesExpired)
+		assert.Equal(t, entity.PaymentStatusPending, paymentRepo.attempts[0].Status, "the webhook settles the payment")
+	})
+
+	t.Run("provider error", func(t *testing.T) {
+		paymentRepo := &attemptPaymentRepo{}
+		xendit := &testutil.XenditClient{
+			ExpireInvoiceFunc: func(invoiceID string) (*response.XenditInvoiceResponse, error) {
+				return nil, errors.New("xendit API error: 500")
+			},
+			GetInvoiceFunc: func(invoiceID string) (*response.XenditInvoiceResponse, error) {
+				return nil, errors.New("xendit API error: 500")
+			},
+		}
+		svc := NewPaymentService(paymentRepo, xendit, nil, nil, &config.Config{})
+
+		_, err := svc.CreateInvoice(ctx, newInvoiceRequest(""))
+		require.NoError(t, err)
+
+		_, err = svc.ExpireInvoice(ctx, "order-1")
+		assert.ErrorIs(t, err, ErrXenditAPIError)
+	})
+}
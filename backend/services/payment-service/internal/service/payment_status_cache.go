@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/response"
+)
+
+// Payment status cache settings
+const (
+	paymentStatusPendingTTL      = 30 * time.Second // Webhooks invalidate it sooner, the TTL bounds missed invalidations
+	paymentStatusFinalTTL        = 10 * time.Minute // Paid, expired and failed payments don't change anymore
+	paymentStatusRefreshInterval = 10 * time.Second // Min time between Xendit syncs of one order
+)
+
+// paymentStatusCache keeps the payment status polled by checkout pages in Redis
+// Without Redis every poll reads the database and refreshes are not throttled
+type paymentStatusCache struct {
+	redis cache.RedisClient
+}
+
+// newPaymentStatusCache creates new payment status cache, redisClient may be nil
+func newPaymentStatusCache(redisClient cache.RedisClient) *paymentStatusCache {
+	return &paymentStatusCache{redis: redisClient}
+}
+
+// paymentStatusKey returns the cache key of an order's payment status
+func paymentStatusKey(orderID string) string {
+	return fmt.Sprintf("payment:status:%s", orderID)
+}
+
+// Get returns the cached payment status of an order, nil on a miss
+func (c *paymentStatusCache) Get(ctx context.Context, orderID string) *response.PaymentStatusResponse {
+	if c.redis == nil {
+		return nil
+	}
+
+	cached, err := c.redis.Get(ctx, paymentStatusKey(orderID))
+	if err != nil || cached == "" {
+		return nil
+	}
+
+	var status response.PaymentStatusResponse
+	if err := json.Unmarshal([]byte(cached), &status); err != nil {
+		return nil
+	}
+	return &status
+}
+
+// Set caches the payment status of an order
+func (c *paymentStatusCache) Set(ctx context.Context, status *response.PaymentStatusResponse) {
+	if c.redis == nil {
+		return
+	}
+
+	ttl := paymentStatusFinalTTL
+	if status.Pending() {
+		ttl = paymentStatusPendingTTL
+	}
+
+	data, err := json.Marshal(status)
+	if err != nil {
+		return
+	}
+	if err := c.redis.Set(ctx, paymentStatusKey(status.OrderID), string(data), ttl); err != nil {
+		log.Printf("[PaymentStatusCache] Failed to cache payment status of order %s: %v", status.OrderID, err)
+	}
+}
+
+// Invalidate drops the cached payment status of an order after it changed
+func (c *paymentStatusCache) Invalidate(ctx context.Context, orderID string) {
+	if c.redis == nil {
+		return
+	}
+
+	if err := c.redis.Del(ctx, paymentStatusKey(orderID)); err != nil {
+		log.Printf("[PaymentStatusCache] Failed to invalidate payment status of order %s: %v", orderID, err)
+	}
+}
+
+// AllowRefresh reports whether the order's payment may be synced with Xendit now
+// At most one sync per paymentStatusRefreshInterval, across replicas
+func (c *paymentStatusCache) AllowRefresh(ctx context.Context, orderID string) bool {
+	if c.redis == nil {
+		return true
+	}
+
+	ok, err := c.redis.SetNX(ctx, paymentStatusKey(orderID)+":refresh", "1", paymentStatusRefreshInterval)
+	if err != nil {
+		// Redis trouble shouldn't block a buyer's explicit refresh
+		return true
+	}
+	return ok
+}
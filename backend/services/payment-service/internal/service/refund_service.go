@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/repository"
+)
+
+var (
+	ErrOrderNotFound          = errors.New("order not found")
+	ErrRefundForbidden        = errors.New("order belongs to another user")
+	ErrRefundNotAllowed       = errors.New("refund not allowed for this order")
+	ErrRefundWindowClosed     = errors.New("refund window of the event's refund policy has closed")
+	ErrRefundAlreadyRequested = errors.New("refund already requested for this order")
+	ErrTicketingUnavailable   = errors.New("ticketing service client is not available")
+)
+
+// Xendit refund reasons of buyer refunds and of payments for cancelled orders
+const (
+	xenditRefundReason             = "REQUESTED_BY_CUSTOMER"
+	xenditCancellationRefundReason = "CANCELLATION"
+	xenditOtherRefundReason        = "OTHERS"
+)
+
+// Reasons recorded on refunds of payments that were never applied to their order
+const (
+	cancelledOrderRefundReason     = "order_cancelled"
+	soldOutOrderRefundReason       = "sold_out"
+	unconfirmedPaymentRefundReason = entity.CompensationConfirmationFailed
+)
+
+// RefundService handles buyer refunds of paid orders
+type RefundService interface {
+	CreateRefund(ctx context.Context, userID string, req *request.RefundRequest) (*response.RefundResponse, error)
+	// RefundCancelledOrder refunds a payment that arrived after its order was cancelled
+	RefundCancelledOrder(ctx context.Context, payment *entity.PaymentTransaction) (*response.RefundResponse, error)
+	// RefundSoldOutOrder refunds a payment that raced its reservation's expiry after the tickets sold out
+	RefundSoldOutOrder(ctx context.Context, payment *entity.PaymentTransaction) (*response.RefundResponse, error)
+	// RefundUnconfirmedPayment refunds a payment ticketing service never confirmed
+	RefundUnconfirmedPayment(ctx context.Context, payment *entity.PaymentTransaction) (*response.RefundResponse, error)
+}
+
+// refundService implements RefundService interface
+type refundService struct {
+	paymentRepo      repository.PaymentRepository
+	refundRepo       repository.RefundRepository
+	ticketingClient  TicketingClient
+	xenditClient     XenditClient
+	testXenditClient XenditClient // Test keys for sandbox payments, nil when not configured
+}
+
+// NewRefundService creates new refund service instance
+// testXenditClient refunds sandbox payments with the test keys, may be nil
+func NewRefundService(
+	paymentRepo repository.PaymentRepository,
+	refundRepo repository.RefundRepository,
+	ticketingClient TicketingClient,
+	xenditClient XenditClient,
+	testXenditClient XenditClient,
+) RefundService {
+	return &refundService{
+		paymentRepo:      paymentRepo,
+		refundRepo:       refundRepo,
+		ticketingClient:  ticketingClient,
+		xenditClient:     xenditClient,
+		testXenditClient: testXenditClient,
+	}
+}
+
+// CreateRefund refunds the buyer's paid order in full
+// Ticketing service checks the order against its event's refund policy (event not started, refund
+// window open, tickets unused), marks it refunded, voids its tickets and releases their seats. Only
+// then is the invoice refunded through Xendit, so a refused refund never pays money back
+func (s *refundService) CreateRefund(ctx context.Context, userID string, req *request.RefundRequest) (*response.RefundResponse, error) {
+	// Orders can't be refunded without ticketing service voiding their tickets
+	if s.ticketingClient == nil {
+		return nil, ErrTicketingUnavailable
+	}
+
+	payment, err := s.paymentRepo.GetByOrderID(ctx, req.OrderID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPaymentNotFound) {
+			return nil, ErrPaymentNotFound
+		}
+		return nil, fmt.Errorf("failed to get payment: %w", err)
+	}
+	if !payment.IsPaid() || payment.InvoiceID == nil {
+		return nil, ErrRefundNotAllowed
+	}
+
+	// Only the order's buyer may claim its payment, so ownership and the refund policy are checked
+	// before the pending refund is recorded; RefundOrder checks them again below
+	if err := s.ticketingClient.CheckRefundEligibility(ctx, payment.OrderID, userID); err != nil {
+		return nil, refundOrderError(err)
+	}
+
+	xenditClient, err := s.clientFor(payment)
+	if err != nil {
+		return nil, err
+	}
+
+	// The pending refund claims the payment, concurrent requests get ErrRefundAlreadyRequested
+	refund := &entity.Refund{
+		OrderID:              payment.OrderID,
+		PaymentTransactionID: payment.ID,
+		Amount:               payment.Amount,
+		Reason:               req.Reason,
+		Status:               entity.RefundStatusPending,
+		RequestedBy:          &userID,
+	}
+	if err := s.refundRepo.Create(ctx, refund); err != nil {
+		if errors.Is(err, repository.ErrRefundAlreadyExists) {
+			return nil, ErrRefundAlreadyRequested
+		}
+		return nil, fmt.Errorf("failed to create refund: %w", err)
+	}
+
+	if _, err := s.ticketingClient.RefundOrder(ctx, &client.RefundOrderRequest{
+		OrderID:  payment.OrderID,
+		UserID:   userID,
+		RefundID: refund.ID,
+		Reason:   req.Reason,
+	}); err != nil {
+		s.fail(ctx, refund, err.Error())
+		return nil, refundOrderError(err)
+	}
+
+	// The order is refunded from here on, a failed provider refund is paid back by support
+	if err := s.refundThroughXendit(ctx, xenditClient, payment, refund, xenditRefundReason); err != nil {
+		return nil, err
+	}
+
+	return response.ToRefundResponse(refund), nil
+}
+
+// RefundCancelledOrder refunds a payment that arrived after its order was cancelled
+// Ticketing service already refused the payment, so the invoice is refunded through Xendit without
+// touching the order; concurrent or retried webhooks get ErrRefundAlreadyRequested
+func (s *refundService) RefundCancelledOrder(ctx context.Context, payment *entity.PaymentTransaction) (*response.RefundResponse, error) {
+	return s.refundUnappliedPayment(ctx, payment, cancelledOrderRefundReason, xenditCancellationRefundReason)
+}
+
+// RefundSoldOutOrder refunds a payment that arrived as its reservation expired, after the tickets were sold
+// to someone else during the grace period. It is refunded the same way as for a cancelled order
+func (s *refundService) RefundSoldOutOrder(ctx context.Context, payment *entity.PaymentTransaction) (*response.RefundResponse, error) {
+	return s.refundUnappliedPayment(ctx, payment, soldOutOrderRefundReason, xenditOtherRefundReason)
+}
+
+// RefundUnconfirmedPayment refunds a payment whose order confirmation permanently failed
+// The buyer got no tickets for it, so the invoice is refunded the same way as for a cancelled order
+func (s *refundService) RefundUnconfirmedPayment(ctx context.Context, payment *entity.PaymentTransaction) (*response.RefundResponse, error) {
+	return s.refundUnappliedPayment(ctx, payment, unconfirmedPaymentRefundReason, xenditOtherRefundReason)
+}
+
+// refundUnappliedPayment refunds the invoice of a payment that was never applied to its order in full
+func (s *refundService) refundUnappliedPayment(ctx context.Context, payment *entity.PaymentTransaction, reason, xenditReason string) (*response.RefundResponse, error) {
+	if !payment.IsPaid() || payment.InvoiceID == nil {
+		return nil, ErrRefundNotAllowed
+	}
+
+	xenditClient, err := s.clientFor(payment)
+	if err != nil {
+		return nil, err
+	}
+
+	refund := &entity.Refund{
+		OrderID:              payment.OrderID,
+		PaymentTransactionID: payment.ID,
+		Amount:               payment.Amount,
+		Reason:               reason,
+		Status:               entity.RefundStatusPending,
+	}
+	if err := s.refundRepo.Create(ctx, refund); err != nil {
+		if errors.Is(err, repository.ErrRefundAlreadyExists) {
+			return nil, ErrRefundAlreadyRequested
+		}
+		return nil, fmt.Errorf("failed to create refund: %w", err)
+	}
+
+	if err := s.refundThroughXendit(ctx, xenditClient, payment, refund, xenditReason); err != nil {
+		return nil, err
+	}
+
+	return response.ToRefundResponse(refund), nil
+}
+
+// clientFor returns the Xendit client the payment was paid with
+func (s *refundService) clientFor(payment *entity.PaymentTransaction) (XenditClient, error) {
+	// Sandbox payments were paid with the test keys and are refunded with them
+	if !payment.IsSandbox {
+		return s.xenditClient, nil
+	}
+	if s.testXenditClient == nil {
+		return nil, ErrSandboxUnavailable
+	}
+	return s.testXenditClient, nil
+}
+
+// refundThroughXendit refunds the payment's invoice in full in its paid currency and records the provider's answer on refund
+// A refund Xendit refuses or fails is marked failed and logged for a manual refund
+func (s *refundService) refundThroughXendit(ctx context.Context, xenditClient XenditClient, payment *entity.PaymentTransaction, refund *entity.Refund, reason string) error {
+	xenditResp, err := xenditClient.CreateRefund(&request.XenditCreateRefundRequest{
+		InvoiceID:   *payment.InvoiceID,
+		ReferenceID: refund.ID,
+		Amount:      refund.Amount,
+		Currency:    payment.Currency,
+		Reason:      reason,
+	})
+	if err != nil {
+		log.Printf("[WARNING] Xendit refund %s failed for refunded order %s, it needs a manual refund: %v", refund.ID, refund.OrderID, err)
+		s.fail(ctx, refund, err.Error())
+		return fmt.Errorf("%w: %v", ErrXenditAPIError, err)
+	}
+
+	refund.ProviderRefundID = &xenditResp.ID
+	switch xenditResp.Status {
+	case "SUCCEEDED":
+		now := time.Now()
+		refund.Status = entity.RefundStatusCompleted
+		refund.ProcessedAt = &now
+	case "FAILED":
+		log.Printf("[WARNING] Xendit refund %s failed for refunded order %s, it needs a manual refund", refund.ID, refund.OrderID)
+		refund.Status = entity.RefundStatusFailed
+		refund.FailureReason = xenditResp.FailureCode
+	default:
+		refund.Status = entity.RefundStatusProcessing
+	}
+
+	if err := s.refundRepo.Update(ctx, refund); err != nil {
+		log.Printf("[ERROR] Failed to save Xendit refund %s (%s) of order %s: %v", refund.ID, xenditResp.ID, refund.OrderID, err)
+	}
+
+	return nil
+}
+
+// fail marks a refund failed with reason, so the payment can be refunded again
+func (s *refundService) fail(ctx context.Context, refund *entity.Refund, reason string) {
+	refund.Status = entity.RefundStatusFailed
+	refund.FailureReason = &reason
+	if err := s.refundRepo.Update(ctx, refund); err != nil {
+		log.Printf("[ERROR] Failed to mark refund %s of order %s failed: %v", refund.ID, refund.OrderID, err)
+	}
+}
+
+// refundOrderError maps ticketing service refusals to service errors
+func refundOrderError(err error) error {
+	switch {
+	case errors.Is(err, client.ErrOrderNotFound):
+		return ErrOrderNotFound
+	case errors.Is(err, client.ErrOrderNotOwned):
+		return ErrRefundForbidden
+	case errors.Is(err, client.ErrRefundWindowClosed):
+		return ErrRefundWindowClosed
+	case errors.Is(err, client.ErrOrderNotRefundable):
+		return fmt.Errorf("%w: %v", ErrRefundNotAllowed, err)
+	case errors.Is(err, client.ErrOrderRefundInProgress):
+		return ErrRefundAlreadyRequested
+	}
+	return fmt.Errorf("failed to refund order: %w", err)
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/repository"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubRefundRepo keeps refunds in memory with one active refund per payment
+type stubRefundRepo struct {
+	refunds []*entity.Refund
+}
+
+func (r *stubRefundRepo) Create(ctx context.Context, refund *entity.Refund) error {
+	for _, existing := range r.refunds {
+		if existing.PaymentTransactionID == refund.PaymentTransactionID && existing.Status != entity.RefundStatusFailed {
+			return repository.ErrRefundAlreadyExists
+		}
+	}
+	refund.ID = fmt.Sprintf("refund-%d", len(r.refunds)+1)
+	r.refunds = append(r.refunds, refund)
+	return nil
+}
+
+func (r *stubRefundRepo) GetByID(ctx context.Context, id string) (*entity.Refund, error) {
+	for _, refund := range r.refunds {
+		if refund.ID == id {
+			return refund, nil
+		}
+	}
+	return nil, repository.ErrRefundNotFound
+}
+
+func (r *stubRefundRepo) Update(ctx context.Context, refund *entity.Refund) error {
+	return nil
+}
+
+func newRefundFixture() (*stubRefundRepo, *testutil.TicketingClient, *testutil.XenditClient, RefundService) {
+	_, paymentRepo := newWebhookFixture()
+	paymentRepo.payment.Status = entity.PaymentStatusPaid
+	refundRepo := &stubRefundRepo{}
+	ticketing := &testutil.TicketingClient{}
+	xendit := &testutil.XenditClient{}
+	return refundRepo, ticketing, xendit, NewRefundService(paymentRepo, refundRepo, ticketing, xendit, nil)
+}
+
+func TestCreateRefund(t *testing.T) {
+	ctx := context.Background()
+	req := &request.RefundRequest{OrderID: "order-1", Reason: "Can't attend"}
+
+	t.Run("refunds the order then the invoice", func(t *testing.T) {
+		refundRepo, ticketing, xendit, svc := newRefundFixture()
+		xendit.CreateRefundFunc = func(req *request.XenditCreateRefundRequest) (*response.XenditRefundResponse, error) {
+			return &response.XenditRefundResponse{ID: "rfd-1", Status: "SUCCEEDED"}, nil
+		}
+
+		refund, err := svc.CreateRefund(ctx, "user-1", req)
+		require.NoError(t, err)
+		assert.Equal(t, entity.RefundStatusCompleted, refund.Status)
+		assert.Equal(t, money.New(150000), refund.Amount)
+		assert.NotNil(t, refund.ProcessedAt)
+
+		calls := ticketing.RefundOrderCalls()
+		require.Len(t, calls, 1)
+		assert.Equal(t, "user-1", calls[0].UserID)
+		assert.Equal(t, refund.ID, calls[0].RefundID)
+
+		refunds := xendit.CreateRefundCalls()
+		require.Len(t, refunds, 1)
+		assert.Equal(t, "inv-123", refunds[0].InvoiceID)
+		assert.Equal(t, refund.ID, refunds[0].ReferenceID)
+		assert.Equal(t, money.New(150000), refunds[0].Amount)
+		assert.Equal(t, "IDR", refunds[0].Currency)
+
+		// The payment is refunded once
+		_, err = svc.CreateRefund(ctx, "user-1", req)
+		assert.ErrorIs(t, err, ErrRefundAlreadyRequested)
+		assert.Len(t, refundRepo.refunds, 1)
+	})
+
+	t.Run("refunds in the currency the payment was paid in", func(t *testing.T) {
+		_, paymentRepo := newWebhookFixture()
+		paymentRepo.payment.Status = entity.PaymentStatusPaid
+		paymentRepo.payment.Currency = "USD"
+		xendit := &testutil.XenditClient{}
+		svc := NewRefundService(paymentRepo, &stubRefundRepo{}, &testutil.TicketingClient{}, xendit, nil)
+
+		_, err := svc.CreateRefund(ctx, "user-1", req)
+		require.NoError(t, err)
+
+		refunds := xendit.CreateRefundCalls()
+		require.Len(t, refunds, 1)
+		assert.Equal(t, "USD", refunds[0].Currency)
+	})
+
+	t.Run("pending provider refund is processing", func(t *testing.T) {
+		_, _, _, svc := newRefundFixture()
+
+		refund, err := svc.CreateRefund(ctx, "user-1", req)
+		require.NoError(t, err)
+		assert.Equal(t, entity.RefundStatusProcessing, refund.Status)
+	})
+
+	t.Run("refused by ticketing is not refunded", func(t *testing.T) {
+		refundRepo, ticketing, xendit, svc := newRefundFixture()
+		ticketing.RefundOrderFunc = func(req *client.RefundOrderRequest) (*client.RefundOrderResponse, error) {
+			return nil, client.ErrRefundWindowClosed
+		}
+
+		_, err := svc.CreateRefund(ctx, "user-1", req)
+		assert.ErrorIs(t, err, ErrRefundWindowClosed)
+		assert.Empty(t, xendit.CreateRefundCalls())
+		require.Len(t, refundRepo.refunds, 1)
+		assert.Equal(t, entity.RefundStatusFailed, refundRepo.refunds[0].Status)
+
+		// A failed refund can be requested again
+		ticketing.RefundOrderFunc = nil
+		_, err = svc.CreateRefund(ctx, "user-1", req)
+		require.NoError(t, err)
+	})
+
+	t.Run("another buyer's order is refused before the refund is recorded", func(t *testing.T) {
+		refundRepo, ticketing, xendit, svc := newRefundFixture()
+		ticketing.CheckRefundFunc = func(orderID, userID string) error {
+			if userID != "user-1" {
+				return client.ErrOrderNotOwned
+			}
+			return nil
+		}
+
+		_, err := svc.CreateRefund(ctx, "user-2", req)
+		assert.ErrorIs(t, err, ErrRefundForbidden)
+		assert.Empty(t, refundRepo.refunds)
+		assert.Empty(t, ticketing.RefundOrderCalls())
+		assert.Empty(t, xendit.CreateRefundCalls())
+
+		// The payment is not claimed, its buyer can still refund it
+		_, err = svc.CreateRefund(ctx, "user-1", req)
+		require.NoError(t, err)
+		assert.Equal(t, 2, ticketing.CheckRefundCalls())
+	})
+
+	t.Run("provider error", func(t *testing.T) {
+		refundRepo, _, xendit, svc := newRefundFixture()
+		xendit.CreateRefundFunc = func(req *request.XenditCreateRefundRequest) (*response.XenditRefundResponse, error) {
+			return nil, errors.New("xendit API error: 500")
+		}
+
+		_, err := svc.CreateRefund(ctx, "user-1", req)
+		assert.ErrorIs(t, err, ErrXenditAPIError)
+		require.Len(t, refundRepo.refunds, 1)
+		assert.Equal(t, entity.RefundStatusFailed, refundRepo.refunds[0].Status)
+	})
+
+	t.Run("unpaid order", func(t *testing.T) {
+		_, paymentRepo := newWebhookFixture()
+		ticketing := &testutil.TicketingClient{}
+		svc := NewRefundService(paymentRepo, &stubRefundRepo{}, ticketing, &testutil.XenditClient{}, nil)
+
+		_, err := svc.CreateRefund(ctx, "user-1", req)
+		assert.ErrorIs(t, err, ErrRefundNotAllowed)
+		assert.Empty(t, ticketing.RefundOrderCalls())
+	})
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/repository"
+)
+
+// maxRetentionBatchesPerStep caps work per step so a large backlog is drained over several runs
+const maxRetentionBatchesPerStep = 50
+
+// Retention metrics (cumulative since process start, exposed via /debug/vars)
+var (
+	webhookAnonymizedTotal  = expvar.NewInt("webhook_retention_anonymized_total")
+	webhookSoftDeletedTotal = expvar.NewInt("webhook_retention_soft_deleted_total")
+	webhookPurgedTotal      = expvar.NewInt("webhook_retention_purged_total")
+	webhookRetentionErrors  = expvar.NewInt("webhook_retention_errors_total")
+)
+
+// RetentionPolicy defines how long webhook events are kept at each stage
+type RetentionPolicy struct {
+	AnonymizeAfter  time.Duration // Strip PII from payload
+	SoftDeleteAfter time.Duration // Clear payload and mark as deleted
+	PurgeAfter      time.Duration // Hard delete, measured from soft deletion
+	BatchSize       int
+}
+
+// RetentionResult holds number of rows affected by a retention run
+type RetentionResult struct {
+	Anonymized  int64
+	SoftDeleted int64
+	Purged      int64
+}
+
+// RetentionService handles retention policy for stored webhook events
+type RetentionService interface {
+	RunRetention(ctx context.Context) (*RetentionResult, error)
+}
+
+// retentionService implements RetentionService interface
+type retentionService struct {
+	webhookRepo repository.WebhookRepository
+	policy      RetentionPolicy
+}
+
+// NewRetentionService creates new retention service instance
+func NewRetentionService(
+	webhookRepo repository.WebhookRepository,
+	policy RetentionPolicy,
+) RetentionService {
+	return &retentionService{
+		webhookRepo: webhookRepo,
+		policy:      policy,
+	}
+}
+
+// RunRetention anonymizes, soft-deletes and purges finished webhook events
+// Steps run from newest to oldest cutoff so every row passes through each stage
+func (s *retentionService) RunRetention(ctx context.Context) (*RetentionResult, error) {
+	now := time.Now()
+	result := &RetentionResult{}
+
+	anonymized, err := s.runInBatches(ctx, now.Add(-s.policy.AnonymizeAfter), s.webhookRepo.AnonymizeBefore)
+	result.Anonymized = anonymized
+	webhookAnonymizedTotal.Add(anonymized)
+	if err != nil {
+		webhookRetentionErrors.Add(1)
+		return result, err
+	}
+
+	softDeleted, err := s.runInBatches(ctx, now.Add(-s.policy.SoftDeleteAfter), s.webhookRepo.SoftDeleteBefore)
+	result.SoftDeleted = softDeleted
+	webhookSoftDeletedTotal.Add(softDeleted)
+	if err != nil {
+		webhookRetentionErrors.Add(1)
+		return result, err
+	}
+
+	purged, err := s.runInBatches(ctx, now.Add(-s.policy.PurgeAfter), s.webhookRepo.PurgeDeletedBefore)
+	result.Purged = purged
+	webhookPurgedTotal.Add(purged)
+	if err != nil {
+		webhookRetentionErrors.Add(1)
+		return result, err
+	}
+
+	if result.Anonymized > 0 || result.SoftDeleted > 0 || result.Purged > 0 {
+		log.Printf("[RetentionService] Webhook events anonymized: %d, soft deleted: %d, purged: %d",
+			result.Anonymized, result.SoftDeleted, result.Purged)
+	}
+
+	return result, nil
+}
+
+// runInBatches repeats a retention step until a batch comes back partially filled
+func (s *retentionService) runInBatches(
+	ctx context.Context,
+	before time.Time,
+	step func(ctx context.Context, before time.Time, batchSize int) (int64, error),
+) (int64, error) {
+	var total int64
+
+	for i := 0; i < maxRetentionBatchesPerStep; i++ {
+		count, err := step(ctx, before, s.policy.BatchSize)
+		if err != nil {
+			return total, fmt.Errorf("retention step failed: %w", err)
+		}
+
+		total += count
+		if count < int64(s.policy.BatchSize) {
+			break
+		}
+	}
+
+	return total, nil
+}
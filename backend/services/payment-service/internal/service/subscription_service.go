@@ -0,0 +1,739 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/repository"
+)
+
+var (
+	ErrPlanNotBillable             = errors.New("plan can't be subscribed to")
+	ErrSubscriptionNotFound        = errors.New("subscription not found")
+	ErrSubscriptionAlreadyActive   = errors.New("organizer already has an active subscription")
+	ErrSubscriptionNotActive       = errors.New("subscription is not active")
+	ErrSubscriptionPastDue         = errors.New("subscription renewal is unpaid")
+	ErrSubscriptionInvoiceNotFound = errors.New("subscription invoice not found")
+)
+
+// freePlanCode is the plan organizers fall back to when their subscription ends
+const freePlanCode = "free"
+
+// Subscription metrics (cumulative since process start, exposed via /debug/vars)
+var (
+	subscriptionRenewalsInvoiced = expvar.NewInt("subscription_renewals_invoiced_total")
+	subscriptionRenewalsRetried  = expvar.NewInt("subscription_renewals_retried_total")
+	subscriptionsCanceledUnpaid  = expvar.NewInt("subscriptions_canceled_unpaid_total")
+	subscriptionRenewalErrors    = expvar.NewInt("subscription_renewal_errors_total")
+)
+
+// SubscriptionPolicy defines how subscription invoices are issued and retried
+type SubscriptionPolicy struct {
+	InvoiceExpiry      time.Duration // How long an invoice can be paid, also the dunning retry interval
+	MaxRenewalAttempts int           // Renewal invoices that may expire before the subscription is canceled
+	BatchSize          int           // Subscriptions handled per renewal step and run
+}
+
+// RenewalResult holds number of subscriptions handled by a renewal run
+type RenewalResult struct {
+	Invoiced int // Renewal invoices issued for ended periods
+	Ended    int // Subscriptions canceled at period end by the organizer
+	Retried  int // Failed renewals invoiced again, with a dunning email
+	Canceled int // Subscriptions canceled after the last renewal attempt failed
+}
+
+// SubscriptionService handles organizer plan subscriptions, billed separately from ticket payments
+type SubscriptionService interface {
+	// Organizer subscription management
+	ListPlans(ctx context.Context) ([]response.PlanPriceResponse, error)
+	GetSubscription(ctx context.Context, organizerID string) (*response.SubscriptionResponse, error)
+	Subscribe(ctx context.Context, organizerID, email, name string, req *request.SubscribeRequest) (*response.SubscriptionResponse, error)
+	ChangePlan(ctx context.Context, organizerID string, req *request.ChangeSubscriptionPlanRequest) (*response.SubscriptionResponse, error)
+	Cancel(ctx context.Context, organizerID string) (*response.SubscriptionResponse, error)
+
+	// Xendit webhooks of SUB- invoices
+	HandleInvoiceWebhook(ctx context.Context, payload *response.XenditWebhookPayload) error
+
+	// Background renewal and dunning
+	ProcessRenewals(ctx context.Context) (*RenewalResult, error)
+}
+
+// EventClient defines interface for switching organizer plans in event service
+type EventClient interface {
+	AssignOrganizerPlan(ctx context.Context, organizerID, planCode string) error
+}
+
+// NotificationClient defines interface for sending dunning emails via notification service
+type NotificationClient interface {
+	SendDunningEmail(ctx context.Context, req *client.DunningEmailRequest) error
+}
+
+// subscriptionService implements SubscriptionService interface
+type subscriptionService struct {
+	subscriptionRepo   repository.SubscriptionRepository
+	xenditClient       XenditClient
+	eventClient        EventClient
+	notificationClient NotificationClient
+	policy             SubscriptionPolicy
+}
+
+// NewSubscriptionService creates new subscription service instance
+// eventClient and notificationClient may be nil; plans then aren't switched and dunning emails aren't sent
+func NewSubscriptionService(
+	subscriptionRepo repository.SubscriptionRepository,
+	xenditClient XenditClient,
+	eventClient EventClient,
+	notificationClient NotificationClient,
+	policy SubscriptionPolicy,
+) SubscriptionService {
+	return &subscriptionService{
+		subscriptionRepo:   subscriptionRepo,
+		xenditClient:       xenditClient,
+		eventClient:        eventClient,
+		notificationClient: notificationClient,
+		policy:             policy,
+	}
+}
+
+// ListPlans retrieves the paid plans organizers can subscribe to
+func (s *subscriptionService) ListPlans(ctx context.Context) ([]response.PlanPriceResponse, error) {
+	prices, err := s.subscriptionRepo.ListPlanPrices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	planResponses := make([]response.PlanPriceResponse, 0, len(prices))
+	for _, price := range prices {
+		planResponses = append(planResponses, *response.ToPlanPriceResponse(&price))
+	}
+
+	return planResponses, nil
+}
+
+// GetSubscription retrieves the subscription of an organizer with its latest invoice
+func (s *subscriptionService) GetSubscription(ctx context.Context, organizerID string) (*response.SubscriptionResponse, error) {
+	subscription, err := s.getSubscription(ctx, organizerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toResponse(ctx, subscription)
+}
+
+// Subscribe starts a subscription and issues its first invoice
+// The organizer is moved to the plan and the first period starts once the invoice is paid;
+// an unpaid first invoice for the same plan is returned again instead of issuing another
+func (s *subscriptionService) Subscribe(ctx context.Context, organizerID, email, name string, req *request.SubscribeRequest) (*response.SubscriptionResponse, error) {
+	price, err := s.planPrice(ctx, req.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	subscription, err := s.subscriptionRepo.GetByOrganizerID(ctx, organizerID)
+	switch {
+	case errors.Is(err, repository.ErrSubscriptionNotFound):
+		subscription = &entity.Subscription{
+			OrganizerID:  organizerID,
+			PlanCode:     price.PlanCode,
+			BillingEmail: email,
+			BillingName:  name,
+			Status:       entity.SubscriptionStatusPending,
+		}
+		if err := s.subscriptionRepo.Create(ctx, subscription); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	case subscription.IsCurrent():
+		return nil, ErrSubscriptionAlreadyActive
+	default:
+		if subscription.Status == entity.SubscriptionStatusPending {
+			latest, err := s.subscriptionRepo.GetLatestInvoice(ctx, subscription.ID)
+			if err == nil && latest.Kind == entity.SubscriptionInvoiceInitial && latest.PlanCode == price.PlanCode && latest.IsOpen(now) {
+				return response.ToSubscriptionResponse(subscription, latest), nil
+			}
+		}
+
+		// Pending with another plan or an expired invoice, or subscribing again after cancellation
+		subscription.PlanCode = price.PlanCode
+		subscription.PendingPlanCode = nil
+		subscription.BillingEmail = email
+		subscription.BillingName = name
+		subscription.Status = entity.SubscriptionStatusPending
+		subscription.CurrentPeriodStart = nil
+		subscription.CurrentPeriodEnd = nil
+		subscription.CancelAtPeriodEnd = false
+		subscription.CanceledAt = nil
+		if err := s.subscriptionRepo.Update(ctx, subscription); err != nil {
+			return nil, err
+		}
+	}
+
+	// Period is provisional, it's moved to start at payment
+	invoice, err := s.issueInvoice(ctx, subscription, &entity.SubscriptionInvoice{
+		Kind:        entity.SubscriptionInvoiceInitial,
+		PlanCode:    price.PlanCode,
+		Amount:      price.MonthlyPrice,
+		PeriodStart: now,
+		PeriodEnd:   nextPeriodEnd(now),
+		Attempt:     1,
+	}, fmt.Sprintf("Langganan paket %s (1 bulan)", price.PlanName))
+	if err != nil {
+		return nil, err
+	}
+
+	return response.ToSubscriptionResponse(subscription, invoice), nil
+}
+
+// ChangePlan moves an active subscription to another paid plan
+// Upgrades are invoiced for the price difference over the rest of the current period and apply
+// once paid; downgrades apply at the next renewal as the current period is already paid.
+// Changing to the current plan drops a scheduled downgrade or cancellation
+func (s *subscriptionService) ChangePlan(ctx context.Context, organizerID string, req *request.ChangeSubscriptionPlanRequest) (*response.SubscriptionResponse, error) {
+	subscription, err := s.getSubscription(ctx, organizerID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch subscription.Status {
+	case entity.SubscriptionStatusActive:
+	case entity.SubscriptionStatusPastDue:
+		return nil, ErrSubscriptionPastDue
+	default:
+		return nil, ErrSubscriptionNotActive
+	}
+
+	price, err := s.planPrice(ctx, req.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	if price.PlanCode == subscription.PlanCode {
+		subscription.PendingPlanCode = nil
+		subscription.CancelAtPeriodEnd = false
+		if err := s.subscriptionRepo.Update(ctx, subscription); err != nil {
+			return nil, err
+		}
+		return s.toResponse(ctx, subscription)
+	}
+
+	// Current plan may have lost its price since; it's then treated as free
+	var currentPrice money.Money
+	if current, err := s.planPrice(ctx, subscription.PlanCode); err == nil {
+		currentPrice = current.MonthlyPrice
+	} else if !errors.Is(err, ErrPlanNotBillable) {
+		return nil, err
+	}
+
+	if price.MonthlyPrice <= currentPrice {
+		subscription.PendingPlanCode = &price.PlanCode
+		subscription.CancelAtPeriodEnd = false
+		if err := s.subscriptionRepo.Update(ctx, subscription); err != nil {
+			return nil, err
+		}
+		return s.toResponse(ctx, subscription)
+	}
+
+	now := time.Now()
+	amount := prorate(currentPrice, price.MonthlyPrice, now, *subscription.CurrentPeriodStart, *subscription.CurrentPeriodEnd)
+
+	// Nothing left to charge this period, upgrade right away
+	if amount <= 0 {
+		subscription.PlanCode = price.PlanCode
+		subscription.PendingPlanCode = nil
+		if err := s.subscriptionRepo.Update(ctx, subscription); err != nil {
+			return nil, err
+		}
+		if err := s.assignPlan(ctx, subscription); err != nil {
+			return nil, err
+		}
+		return s.toResponse(ctx, subscription)
+	}
+
+	// Reuse an unpaid upgrade invoice to the same plan
+	if latest, err := s.subscriptionRepo.GetLatestInvoice(ctx, subscription.ID); err == nil &&
+		latest.Kind == entity.SubscriptionInvoiceProration && latest.PlanCode == price.PlanCode && latest.IsOpen(now) {
+		return response.ToSubscriptionResponse(subscription, latest), nil
+	}
+
+	invoice, err := s.issueInvoice(ctx, subscription, &entity.SubscriptionInvoice{
+		Kind:        entity.SubscriptionInvoiceProration,
+		PlanCode:    price.PlanCode,
+		Amount:      amount,
+		PeriodStart: now,
+		PeriodEnd:   *subscription.CurrentPeriodEnd,
+		Attempt:     1,
+	}, fmt.Sprintf("Upgrade ke paket %s (sisa periode berjalan)", price.PlanName))
+	if err != nil {
+		return nil, err
+	}
+
+	return response.ToSubscriptionResponse(subscription, invoice), nil
+}
+
+// Cancel ends an active subscription at the end of the paid period
+// The organizer keeps the plan until then; ChangePlan to the same plan undoes it
+func (s *subscriptionService) Cancel(ctx context.Context, organizerID string) (*response.SubscriptionResponse, error) {
+	subscription, err := s.getSubscription(ctx, organizerID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	switch {
+	case subscription.Status == entity.SubscriptionStatusActive && now.Before(*subscription.CurrentPeriodEnd):
+		subscription.CancelAtPeriodEnd = true
+		subscription.PendingPlanCode = nil
+	case subscription.Status != entity.SubscriptionStatusCanceled:
+		// Pending, past due, or waiting for the renewal of an ended period: nothing is paid, it ends now
+		if err := s.endSubscription(ctx, subscription, now); err != nil {
+			return nil, err
+		}
+		return s.toResponse(ctx, subscription)
+	default:
+		return nil, ErrSubscriptionNotActive
+	}
+
+	if err := s.subscriptionRepo.Update(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	return s.toResponse(ctx, subscription)
+}
+
+// HandleInvoiceWebhook applies a Xendit webhook of a subscription invoice
+// Paid first invoices start the subscription, paid renewals extend it and paid upgrades switch the plan;
+// expired invoices are only recorded, failed renewals are followed up by ProcessRenewals
+func (s *subscriptionService) HandleInvoiceWebhook(ctx context.Context, payload *response.XenditWebhookPayload) error {
+	invoice, err := s.subscriptionRepo.GetInvoiceByExternalID(ctx, payload.ExternalID)
+	if err != nil {
+		if errors.Is(err, repository.ErrSubscriptionInvoiceNotFound) {
+			return ErrSubscriptionInvoiceNotFound
+		}
+		return err
+	}
+
+	switch strings.ToUpper(payload.Status) {
+	case "PAID", "SETTLED":
+		return s.handleInvoicePaid(ctx, invoice, payload)
+	case "EXPIRED":
+		if invoice.Status != entity.SubscriptionInvoiceStatusPending {
+			return nil
+		}
+		invoice.Status = entity.SubscriptionInvoiceStatusExpired
+		return s.subscriptionRepo.UpdateInvoice(ctx, invoice)
+	default:
+		log.Printf("[INFO] Unhandled subscription invoice status %s for %s", payload.Status, payload.ExternalID)
+		return nil
+	}
+}
+
+// handleInvoicePaid applies a paid subscription invoice
+func (s *subscriptionService) handleInvoicePaid(ctx context.Context, invoice *entity.SubscriptionInvoice, payload *response.XenditWebhookPayload) error {
+	// Provider retry
+	if invoice.Status == entity.SubscriptionInvoiceStatusPaid {
+		return nil
+	}
+
+	paidAt := payload.PaidAt
+	if paidAt.IsZero() {
+		paidAt = time.Now()
+	}
+
+	invoice.Status = entity.SubscriptionInvoiceStatusPaid
+	invoice.PaidAt = &paidAt
+	if err := s.subscriptionRepo.UpdateInvoice(ctx, invoice); err != nil {
+		return err
+	}
+
+	subscription, err := s.subscriptionRepo.GetByID(ctx, invoice.SubscriptionID)
+	if err != nil {
+		return fmt.Errorf("failed to get subscription of invoice %s: %w", invoice.ID, err)
+	}
+
+	switch invoice.Kind {
+	case entity.SubscriptionInvoiceInitial:
+		if subscription.IsCurrent() {
+			log.Printf("[WARNING] Subscription %s already active, invoice %s paid twice and needs a manual refund", subscription.ID, invoice.ID)
+			return nil
+		}
+		periodEnd := nextPeriodEnd(paidAt)
+		subscription.Status = entity.SubscriptionStatusActive
+		subscription.PlanCode = invoice.PlanCode
+		subscription.CurrentPeriodStart = &paidAt
+		subscription.CurrentPeriodEnd = &periodEnd
+		subscription.CanceledAt = nil
+
+	case entity.SubscriptionInvoiceRenewal:
+		if !subscription.IsCurrent() || subscription.CurrentPeriodEnd == nil || !invoice.PeriodStart.Equal(*subscription.CurrentPeriodEnd) {
+			log.Printf("[WARNING] Renewal invoice %s paid for a period subscription %s is not in, needs a manual refund", invoice.ID, subscription.ID)
+			return nil
+		}
+		periodStart, periodEnd := invoice.PeriodStart, invoice.PeriodEnd
+		subscription.Status = entity.SubscriptionStatusActive
+		subscription.PlanCode = invoice.PlanCode
+		subscription.PendingPlanCode = nil
+		subscription.CurrentPeriodStart = &periodStart
+		subscription.CurrentPeriodEnd = &periodEnd
+
+	case entity.SubscriptionInvoiceProration:
+		if !subscription.IsCurrent() {
+			log.Printf("[WARNING] Upgrade invoice %s paid for inactive subscription %s, needs a manual refund", invoice.ID, subscription.ID)
+			return nil
+		}
+		subscription.PlanCode = invoice.PlanCode
+		subscription.PendingPlanCode = nil
+	}
+
+	if err := s.subscriptionRepo.Update(ctx, subscription); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Subscription %s of organizer %s paid (%s invoice), plan: %s", subscription.ID, subscription.OrganizerID, invoice.Kind, subscription.PlanCode)
+
+	return s.assignPlan(ctx, subscription)
+}
+
+// ProcessRenewals invoices subscriptions whose period ended and runs dunning for unpaid renewals
+// A failed renewal keeps the plan while it's retried with a new invoice and a dunning email;
+// after MaxRenewalAttempts expired invoices the organizer is moved back to the free plan
+func (s *subscriptionService) ProcessRenewals(ctx context.Context) (*RenewalResult, error) {
+	now := time.Now()
+	result := &RenewalResult{}
+
+	due, err := s.subscriptionRepo.ListDueForRenewal(ctx, now, s.policy.BatchSize)
+	if err != nil {
+		subscriptionRenewalErrors.Add(1)
+		return result, err
+	}
+
+	for i := range due {
+		subscription := &due[i]
+
+		if subscription.CancelAtPeriodEnd {
+			if err := s.endSubscription(ctx, subscription, now); err != nil {
+				log.Printf("[ERROR] Failed to end subscription %s: %v", subscription.ID, err)
+				subscriptionRenewalErrors.Add(1)
+				continue
+			}
+			result.Ended++
+			continue
+		}
+
+		if err := s.invoiceRenewal(ctx, subscription, now); err != nil {
+			if errors.Is(err, repository.ErrDuplicateSubscriptionInvoice) {
+				continue
+			}
+			log.Printf("[ERROR] Failed to invoice renewal of subscription %s: %v", subscription.ID, err)
+			subscriptionRenewalErrors.Add(1)
+			continue
+		}
+		result.Invoiced++
+		subscriptionRenewalsInvoiced.Add(1)
+	}
+
+	failed, err := s.subscriptionRepo.ListFailedRenewals(ctx, now, s.policy.BatchSize)
+	if err != nil {
+		subscriptionRenewalErrors.Add(1)
+		return result, err
+	}
+
+	for i := range failed {
+		canceled, err := s.retryRenewal(ctx, &failed[i], now)
+		if err != nil {
+			if errors.Is(err, repository.ErrDuplicateSubscriptionInvoice) {
+				continue
+			}
+			log.Printf("[ERROR] Failed to retry renewal invoice %s: %v", failed[i].ID, err)
+			subscriptionRenewalErrors.Add(1)
+			continue
+		}
+		if canceled {
+			result.Canceled++
+			subscriptionsCanceledUnpaid.Add(1)
+		} else {
+			result.Retried++
+			subscriptionRenewalsRetried.Add(1)
+		}
+	}
+
+	return result, nil
+}
+
+// invoiceRenewal issues the first renewal invoice of the next period, at the price of the plan it renews into
+func (s *subscriptionService) invoiceRenewal(ctx context.Context, subscription *entity.Subscription, now time.Time) error {
+	planCode := subscription.PlanCode
+	if subscription.PendingPlanCode != nil {
+		planCode = *subscription.PendingPlanCode
+	}
+
+	price, err := s.planPrice(ctx, planCode)
+	if errors.Is(err, ErrPlanNotBillable) {
+		// Plan is no longer sold, the subscription ends with the paid period
+		log.Printf("[WARNING] Plan %s of subscription %s has no price anymore, ending subscription", planCode, subscription.ID)
+		return s.endSubscription(ctx, subscription, now)
+	}
+	if err != nil {
+		return err
+	}
+
+	periodStart := *subscription.CurrentPeriodEnd
+	_, err = s.issueInvoice(ctx, subscription, &entity.SubscriptionInvoice{
+		Kind:        entity.SubscriptionInvoiceRenewal,
+		PlanCode:    price.PlanCode,
+		Amount:      price.MonthlyPrice,
+		PeriodStart: periodStart,
+		PeriodEnd:   nextPeriodEnd(periodStart),
+		Attempt:     1,
+	}, fmt.Sprintf("Perpanjangan paket %s (1 bulan)", price.PlanName))
+
+	return err
+}
+
+// retryRenewal follows up an expired renewal invoice with a new one, or cancels the subscription
+// after the last attempt; returns whether the subscription was canceled
+func (s *subscriptionService) retryRenewal(ctx context.Context, failed *entity.SubscriptionInvoice, now time.Time) (bool, error) {
+	subscription, err := s.subscriptionRepo.GetByID(ctx, failed.SubscriptionID)
+	if err != nil {
+		return false, err
+	}
+
+	// Expired by time, Xendit's webhook may still be on its way
+	if failed.Status == entity.SubscriptionInvoiceStatusPending {
+		failed.Status = entity.SubscriptionInvoiceStatusExpired
+		if err := s.subscriptionRepo.UpdateInvoice(ctx, failed); err != nil {
+			return false, err
+		}
+	}
+
+	planName := failed.PlanCode
+	if price, err := s.planPrice(ctx, failed.PlanCode); err == nil {
+		planName = price.PlanName
+	}
+
+	if failed.Attempt >= s.policy.MaxRenewalAttempts {
+		if err := s.endSubscription(ctx, subscription, now); err != nil {
+			return false, err
+		}
+		log.Printf("[INFO] Subscription %s canceled after %d unpaid renewal invoices", subscription.ID, failed.Attempt)
+
+		s.sendDunningEmail(ctx, subscription, &client.DunningEmailRequest{
+			PlanName:    planName,
+			Amount:      failed.Amount,
+			Attempt:     failed.Attempt,
+			MaxAttempts: s.policy.MaxRenewalAttempts,
+			Canceled:    true,
+		})
+		return true, nil
+	}
+
+	retry, err := s.issueInvoice(ctx, subscription, &entity.SubscriptionInvoice{
+		Kind:        entity.SubscriptionInvoiceRenewal,
+		PlanCode:    failed.PlanCode,
+		Amount:      failed.Amount,
+		PeriodStart: failed.PeriodStart,
+		PeriodEnd:   failed.PeriodEnd,
+		Attempt:     failed.Attempt + 1,
+	}, fmt.Sprintf("Perpanjangan paket %s (1 bulan)", planName))
+	if err != nil {
+		return false, err
+	}
+
+	subscription.Status = entity.SubscriptionStatusPastDue
+	if err := s.subscriptionRepo.Update(ctx, subscription); err != nil {
+		return false, err
+	}
+
+	invoiceURL := ""
+	if retry.InvoiceURL != nil {
+		invoiceURL = *retry.InvoiceURL
+	}
+	s.sendDunningEmail(ctx, subscription, &client.DunningEmailRequest{
+		PlanName:         planName,
+		Amount:           retry.Amount,
+		InvoiceURL:       invoiceURL,
+		InvoiceExpiresAt: retry.ExpiresAt,
+		Attempt:          failed.Attempt,
+		MaxAttempts:      s.policy.MaxRenewalAttempts,
+	})
+
+	return false, nil
+}
+
+// endSubscription cancels a subscription now and moves the organizer back to the free plan
+func (s *subscriptionService) endSubscription(ctx context.Context, subscription *entity.Subscription, now time.Time) error {
+	wasCurrent := subscription.IsCurrent()
+
+	subscription.Status = entity.SubscriptionStatusCanceled
+	subscription.PendingPlanCode = nil
+	subscription.CancelAtPeriodEnd = false
+	subscription.CanceledAt = &now
+	if err := s.subscriptionRepo.Update(ctx, subscription); err != nil {
+		return err
+	}
+
+	// Pending subscriptions never switched the plan
+	if !wasCurrent {
+		return nil
+	}
+
+	return s.assignPlan(ctx, subscription)
+}
+
+// issueInvoice stores a subscription invoice and creates it in Xendit
+// The stored invoice is removed when Xendit fails so the same renewal attempt can be invoiced again
+func (s *subscriptionService) issueInvoice(ctx context.Context, subscription *entity.Subscription, invoice *entity.SubscriptionInvoice, description string) (*entity.SubscriptionInvoice, error) {
+	invoice.SubscriptionID = subscription.ID
+	invoice.Status = entity.SubscriptionInvoiceStatusPending
+
+	if err := s.subscriptionRepo.CreateInvoice(ctx, invoice); err != nil {
+		return nil, err
+	}
+
+	xenditResp, err := s.xenditClient.CreateInvoice(&request.XenditCreateInvoiceRequest{
+		ExternalID:      invoice.ExternalID,
+		Amount:          invoice.Amount,
+		PayerEmail:      subscription.BillingEmail,
+		Description:     description,
+		InvoiceDuration: int(s.policy.InvoiceExpiry.Seconds()),
+		Currency:        "IDR",
+		Items: []request.XenditInvoiceItem{{
+			Name:     description,
+			Quantity: 1,
+			Price:    invoice.Amount,
+			Category: "subscription",
+		}},
+	})
+	if err != nil {
+		if delErr := s.subscriptionRepo.DeleteInvoice(ctx, invoice.ID); delErr != nil {
+			log.Printf("[ERROR] Failed to remove subscription invoice %s after Xendit error: %v", invoice.ID, delErr)
+		}
+		return nil, fmt.Errorf("%w: %v", ErrXenditAPIError, err)
+	}
+
+	invoiceID := xenditResp.ID
+	invoiceURL := xenditResp.InvoiceURL
+	expiresAt := xenditResp.ExpiryDate
+	if expiresAt.IsZero() {
+		expiresAt = time.Now().Add(s.policy.InvoiceExpiry)
+	}
+
+	invoice.InvoiceID = &invoiceID
+	invoice.InvoiceURL = &invoiceURL
+	invoice.ExpiresAt = &expiresAt
+	if err := s.subscriptionRepo.UpdateInvoice(ctx, invoice); err != nil {
+		return nil, err
+	}
+
+	return invoice, nil
+}
+
+// assignPlan moves the organizer to the plan their subscription grants in event service
+func (s *subscriptionService) assignPlan(ctx context.Context, subscription *entity.Subscription) error {
+	planCode := subscription.PlanCode
+	if !subscription.IsCurrent() {
+		planCode = freePlanCode
+	}
+
+	if s.eventClient == nil {
+		log.Printf("[WARNING] Event Service gRPC client not available, organizer %s must be moved to plan %s manually", subscription.OrganizerID, planCode)
+		return nil
+	}
+
+	if err := s.eventClient.AssignOrganizerPlan(ctx, subscription.OrganizerID, planCode); err != nil {
+		return fmt.Errorf("failed to move organizer %s to plan %s: %w", subscription.OrganizerID, planCode, err)
+	}
+
+	return nil
+}
+
+// sendDunningEmail tells the organizer about a failed renewal; failures are only logged
+func (s *subscriptionService) sendDunningEmail(ctx context.Context, subscription *entity.Subscription, req *client.DunningEmailRequest) {
+	if s.notificationClient == nil {
+		log.Printf("[WARNING] Notification Service gRPC client not available, dunning email for subscription %s not sent", subscription.ID)
+		return
+	}
+
+	req.RecipientEmail = subscription.BillingEmail
+	req.RecipientName = subscription.BillingName
+	if err := s.notificationClient.SendDunningEmail(ctx, req); err != nil {
+		log.Printf("[ERROR] Failed to send dunning email for subscription %s: %v", subscription.ID, err)
+	}
+}
+
+// getSubscription retrieves the subscription of an organizer
+func (s *subscriptionService) getSubscription(ctx context.Context, organizerID string) (*entity.Subscription, error) {
+	subscription, err := s.subscriptionRepo.GetByOrganizerID(ctx, organizerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrSubscriptionNotFound) {
+			return nil, ErrSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+	return subscription, nil
+}
+
+// planPrice retrieves the price of a paid plan
+func (s *subscriptionService) planPrice(ctx context.Context, planCode string) (*entity.PlanPrice, error) {
+	price, err := s.subscriptionRepo.GetPlanPrice(ctx, planCode)
+	if err != nil {
+		if errors.Is(err, repository.ErrPlanPriceNotFound) {
+			return nil, ErrPlanNotBillable
+		}
+		return nil, fmt.Errorf("failed to get plan price: %w", err)
+	}
+	return price, nil
+}
+
+// toResponse converts a subscription with its latest invoice to response
+func (s *subscriptionService) toResponse(ctx context.Context, subscription *entity.Subscription) (*response.SubscriptionResponse, error) {
+	invoice, err := s.subscriptionRepo.GetLatestInvoice(ctx, subscription.ID)
+	if err != nil {
+		if !errors.Is(err, repository.ErrSubscriptionInvoiceNotFound) {
+			return nil, err
+		}
+		invoice = nil
+	}
+
+	return response.ToSubscriptionResponse(subscription, invoice), nil
+}
+
+// prorate returns the price difference of an upgrade for the rest of a billing period
+// Rounded to whole rupiah as IDR invoices have no minor units
+func prorate(currentPrice, newPrice money.Money, now, periodStart, periodEnd time.Time) money.Money {
+	remaining := periodEnd.Sub(now)
+	total := periodEnd.Sub(periodStart)
+	if remaining <= 0 || total <= 0 {
+		return 0
+	}
+	if remaining > total {
+		remaining = total
+	}
+
+	wholeRupiah := newPrice.Sub(currentPrice).MulRatio(int64(remaining/time.Second), int64(total/time.Second)*money.Scale)
+	return money.New(int64(wholeRupiah))
+}
+
+// nextPeriodEnd returns the end of a one-month billing period starting at start
+// Periods starting on a day the next month doesn't have end on that month's last day
+func nextPeriodEnd(start time.Time) time.Time {
+	end := start.AddDate(0, 1, 0)
+	if end.Day() != start.Day() {
+		// AddDate normalized e.g. Jan 31 to Mar 3, step back to the last day of February
+		end = end.AddDate(0, 0, -end.Day())
+	}
+	return end
+}
@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/repository"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubSubscriptionRepo keeps subscriptions and invoices in memory; unused methods panic via the nil interface
+type stubSubscriptionRepo struct {
+	repository.SubscriptionRepository
+	prices        map[string]entity.PlanPrice
+	subscriptions map[string]*entity.Subscription
+	invoices      []*entity.SubscriptionInvoice
+}
+
+func newStubSubscriptionRepo() *stubSubscriptionRepo {
+	return &stubSubscriptionRepo{
+		prices: map[string]entity.PlanPrice{
+			"pro":      {PlanCode: "pro", PlanName: "Pro", MonthlyPrice: money.New(300000)},
+			"business": {PlanCode: "business", PlanName: "Business", MonthlyPrice: money.New(900000)},
+		},
+		subscriptions: map[string]*entity.Subscription{},
+	}
+}
+
+func (r *stubSubscriptionRepo) GetPlanPrice(ctx context.Context, planCode string) (*entity.PlanPrice, error) {
+	price, ok := r.prices[planCode]
+	if !ok {
+		return nil, repository.ErrPlanPriceNotFound
+	}
+	return &price, nil
+}
+
+func (r *stubSubscriptionRepo) Create(ctx context.Context, subscription *entity.Subscription) error {
+	subscription.ID = "sub-" + subscription.OrganizerID
+	r.subscriptions[subscription.ID] = subscription
+	return nil
+}
+
+func (r *stubSubscriptionRepo) GetByOrganizerID(ctx context.Context, organizerID string) (*entity.Subscription, error) {
+	for _, subscription := range r.subscriptions {
+		if subscription.OrganizerID == organizerID {
+			return subscription, nil
+		}
+	}
+	return nil, repository.ErrSubscriptionNotFound
+}
+
+func (r *stubSubscriptionRepo) GetByID(ctx context.Context, id string) (*entity.Subscription, error) {
+	subscription, ok := r.subscriptions[id]
+	if !ok {
+		return nil, repository.ErrSubscriptionNotFound
+	}
+	return subscription, nil
+}
+
+func (r *stubSubscriptionRepo) Update(ctx context.Context, subscription *entity.Subscription) error {
+	r.subscriptions[subscription.ID] = subscription
+	return nil
+}
+
+func (r *stubSubscriptionRepo) ListDueForRenewal(ctx context.Context, now time.Time, limit int) ([]entity.Subscription, error) {
+	var due []entity.Subscription
+	for _, subscription := range r.subscriptions {
+		if subscription.Status != entity.SubscriptionStatusActive || subscription.CurrentPeriodEnd.After(now) {
+			continue
+		}
+		if r.hasRenewal(subscription.ID, *subscription.CurrentPeriodEnd) {
+			continue
+		}
+		due = append(due, *subscription)
+	}
+	return due, nil
+}
+
+func (r *stubSubscriptionRepo) hasRenewal(subscriptionID string, periodStart time.Time) bool {
+	for _, invoice := range r.invoices {
+		if invoice.SubscriptionID == subscriptionID && invoice.Kind == entity.SubscriptionInvoiceRenewal && invoice.PeriodStart.Equal(periodStart) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *stubSubscriptionRepo) CreateInvoice(ctx context.Context, invoice *entity.SubscriptionInvoice) error {
+	invoice.ID = "si-" + strconv.Itoa(len(r.invoices)+1)
+	invoice.ExternalID = entity.SubscriptionExternalIDPrefix + invoice.ID
+	r.invoices = append(r.invoices, invoice)
+	return nil
+}
+
+func (r *stubSubscriptionRepo) GetInvoiceByExternalID(ctx context.Context, externalID string) (*entity.SubscriptionInvoice, error) {
+	for _, invoice := range r.invoices {
+		if invoice.ExternalID == externalID {
+			return invoice, nil
+		}
+	}
+	return nil, repository.ErrSubscriptionInvoiceNotFound
+}
+
+func (r *stubSubscriptionRepo) GetLatestInvoice(ctx context.Context, subscriptionID string) (*entity.SubscriptionInvoice, error) {
+	for i := len(r.invoices) - 1; i >= 0; i-- {
+		if r.invoices[i].SubscriptionID == subscriptionID {
+			return r.invoices[i], nil
+		}
+	}
+	return nil, repository.ErrSubscriptionInvoiceNotFound
+}
+
+func (r *stubSubscriptionRepo) UpdateInvoice(ctx context.Context, invoice *entity.SubscriptionInvoice) error {
+	return nil
+}
+
+func (r *stubSubscriptionRepo) ListFailedRenewals(ctx context.Context, now time.Time, limit int) ([]entity.SubscriptionInvoice, error) {
+	latest := map[string]*entity.SubscriptionInvoice{}
+	for _, invoice := range r.invoices {
+		if invoice.Kind == entity.SubscriptionInvoiceRenewal {
+			latest[invoice.SubscriptionID] = invoice
+		}
+	}
+
+	var failed []entity.SubscriptionInvoice
+	for _, invoice := range latest {
+		if invoice.Status == entity.SubscriptionInvoiceStatusExpired ||
+			(invoice.Status == entity.SubscriptionInvoiceStatusPending && !invoice.IsOpen(now)) {
+			if r.subscriptions[invoice.SubscriptionID].IsCurrent() {
+				failed = append(failed, *invoice)
+			}
+		}
+	}
+	return failed, nil
+}
+
+type subscriptionFixture struct {
+	repo         *stubSubscriptionRepo
+	xendit       *testutil.XenditClient
+	events       *testutil.EventClient
+	notification *testutil.NotificationClient
+	svc          SubscriptionService
+}
+
+func newSubscriptionFixture() *subscriptionFixture {
+	f := &subscriptionFixture{
+		repo:         newStubSubscriptionRepo(),
+		xendit:       &testutil.XenditClient{},
+		events:       &testutil.EventClient{},
+		notification: &testutil.NotificationClient{},
+	}
+	f.svc = NewSubscriptionService(f.repo, f.xendit, f.events, f.notification, SubscriptionPolicy{
+		InvoiceExpiry:      72 * time.Hour,
+		MaxRenewalAttempts: 3,
+		BatchSize:          100,
+	})
+	return f
+}
+
+// activeSubscription stores an active pro subscription of org-1 for the given period
+func (f *subscriptionFixture) activeSubscription(periodStart, periodEnd time.Time) *entity.Subscription {
+	subscription := &entity.Subscription{
+		ID:                 "sub-org-1",
+		OrganizerID:        "org-1",
+		PlanCode:           "pro",
+		BillingEmail:       "org@example.com",
+		BillingName:        "Organizer",
+		Status:             entity.SubscriptionStatusActive,
+		CurrentPeriodStart: &periodStart,
+		CurrentPeriodEnd:   &periodEnd,
+	}
+	f.repo.subscriptions[subscription.ID] = subscription
+	return subscription
+}
+
+func paidInvoicePayload(externalID string) *response.XenditWebhookPayload {
+	return &response.XenditWebhookPayload{
+		ExternalID: externalID,
+		Status:     "PAID",
+		PaidAt:     time.Now(),
+	}
+}
+
+func TestSubscribe_PaidInvoiceActivatesPlan(t *testing.T) {
+	f := newSubscriptionFixture()
+	ctx := context.Background()
+
+	resp, err := f.svc.Subscribe(ctx, "org-1", "org@example.com", "Organizer", &request.SubscribeRequest{Plan: "pro"})
+	require.NoError(t, err)
+	assert.Equal(t, entity.SubscriptionStatusPending, resp.Status)
+	require.NotNil(t, resp.LatestInvoice)
+
+	calls := f.xendit.CreateInvoiceCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, money.New(300000), calls[0].Amount)
+	assert.Equal(t, "org@example.com", calls[0].PayerEmail)
+	assert.Empty(t, f.events.AssignOrganizerPlanCalls())
+
+	// Subscribing again before paying returns the same invoice
+	_, err = f.svc.Subscribe(ctx, "org-1", "org@example.com", "Organizer", &request.SubscribeRequest{Plan: "pro"})
+	require.NoError(t, err)
+	assert.Len(t, f.xendit.CreateInvoiceCalls(), 1)
+
+	require.NoError(t, f.svc.HandleInvoiceWebhook(ctx, paidInvoicePayload(calls[0].ExternalID)))
+
+	subscription := f.repo.subscriptions["sub-org-1"]
+	assert.Equal(t, entity.SubscriptionStatusActive, subscription.Status)
+	require.NotNil(t, subscription.CurrentPeriodEnd)
+	assert.Equal(t, []testutil.AssignPlanCall{{OrganizerID: "org-1", PlanCode: "pro"}}, f.events.AssignOrganizerPlanCalls())
+
+	_, err = f.svc.Subscribe(ctx, "org-1", "org@example.com", "Organizer", &request.SubscribeRequest{Plan: "pro"})
+	assert.ErrorIs(t, err, ErrSubscriptionAlreadyActive)
+}
+
+func TestSubscribe_FreePlanNotBillable(t *testing.T) {
+	f := newSubscriptionFixture()
+
+	_, err := f.svc.Subscribe(context.Background(), "org-1", "org@example.com", "Organizer", &request.SubscribeRequest{Plan: "free"})
+	assert.ErrorIs(t, err, ErrPlanNotBillable)
+	assert.Empty(t, f.xendit.CreateInvoiceCalls())
+}
+
+func TestChangePlan_UpgradeInvoicesProratedDifference(t *testing.T) {
+	f := newSubscriptionFixture()
+	ctx := context.Background()
+	now := time.Now()
+	f.activeSubscription(now.Add(-15*24*time.Hour), now.Add(15*24*time.Hour))
+
+	_, err := f.svc.ChangePlan(ctx, "org-1", &request.ChangeSubscriptionPlanRequest{Plan: "business"})
+	require.NoError(t, err)
+
+	// Half of the period is left: (900.000 - 300.000) / 2
+	calls := f.xendit.CreateInvoiceCalls()
+	require.Len(t, calls, 1)
+	assert.InDelta(t, 300000, calls[0].Amount.Float64(), 1)
+	assert.Equal(t, "pro", f.repo.subscriptions["sub-org-1"].PlanCode)
+
+	require.NoError(t, f.svc.HandleInvoiceWebhook(ctx, paidInvoicePayload(calls[0].ExternalID)))
+	assert.Equal(t, "business", f.repo.subscriptions["sub-org-1"].PlanCode)
+	assert.Equal(t, []testutil.AssignPlanCall{{OrganizerID: "org-1", PlanCode: "business"}}, f.events.AssignOrganizerPlanCalls())
+}
+
+func TestChangePlan_DowngradeAppliesAtRenewal(t *testing.T) {
+	f := newSubscriptionFixture()
+	ctx := context.Background()
+	now := time.Now()
+	subscription := f.activeSubscription(now.Add(-10*24*time.Hour), now.Add(20*24*time.Hour))
+	subscription.PlanCode = "business"
+
+	_, err := f.svc.ChangePlan(ctx, "org-1", &request.ChangeSubscriptionPlanRequest{Plan: "pro"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "business", subscription.PlanCode)
+	require.NotNil(t, subscription.PendingPlanCode)
+	assert.Equal(t, "pro", *subscription.PendingPlanCode)
+	assert.Empty(t, f.xendit.CreateInvoiceCalls())
+	assert.Empty(t, f.events.AssignOrganizerPlanCalls())
+}
+
+func TestCancel_EndsAtPeriodEnd(t *testing.T) {
+	f := newSubscriptionFixture()
+	ctx := context.Background()
+	now := time.Now()
+	subscription := f.activeSubscription(now.Add(-10*24*time.Hour), now.Add(20*24*time.Hour))
+
+	_, err := f.svc.Cancel(ctx, "org-1")
+	require.NoError(t, err)
+	assert.True(t, subscription.CancelAtPeriodEnd)
+	assert.Equal(t, entity.SubscriptionStatusActive, subscription.Status)
+
+	// Period ends, the renewal run ends the subscription instead of invoicing it
+	ended := now.Add(-time.Minute)
+	subscription.CurrentPeriodEnd = &ended
+
+	result, err := f.svc.ProcessRenewals(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Ended)
+	assert.Equal(t, entity.SubscriptionStatusCanceled, f.repo.subscriptions["sub-org-1"].Status)
+	assert.Empty(t, f.xendit.CreateInvoiceCalls())
+	assert.Equal(t, []testutil.AssignPlanCall{{OrganizerID: "org-1", PlanCode: "free"}}, f.events.AssignOrganizerPlanCalls())
+}
+
+func TestProcessRenewals_DunningThenCancel(t *testing.T) {
+	f := newSubscriptionFixture()
+	ctx := context.Background()
+	now := time.Now()
+	f.activeSubscription(now.Add(-31*24*time.Hour), now.Add(-time.Hour))
+
+	result, err := f.svc.ProcessRenewals(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Invoiced)
+	require.Len(t, f.repo.invoices, 1)
+
+	for attempt := 1; attempt < 3; attempt++ {
+		f.repo.invoices[len(f.repo.invoices)-1].Status = entity.SubscriptionInvoiceStatusExpired
+
+		result, err := f.svc.ProcessRenewals(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Retried)
+		assert.Equal(t, entity.SubscriptionStatusPastDue, f.repo.subscriptions["sub-org-1"].Status)
+		assert.Equal(t, attempt+1, f.repo.invoices[len(f.repo.invoices)-1].Attempt)
+	}
+
+	emails := f.notification.SendDunningEmailCalls()
+	require.Len(t, emails, 2)
+	assert.Equal(t, "org@example.com", emails[0].RecipientEmail)
+	assert.NotEmpty(t, emails[0].InvoiceURL)
+	assert.False(t, emails[1].Canceled)
+	assert.Empty(t, f.events.AssignOrganizerPlanCalls())
+
+	// Last attempt expires, the organizer is moved back to free
+	f.repo.invoices[len(f.repo.invoices)-1].Status = entity.SubscriptionInvoiceStatusExpired
+	result, err = f.svc.ProcessRenewals(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Canceled)
+	assert.Equal(t, entity.SubscriptionStatusCanceled, f.repo.subscriptions["sub-org-1"].Status)
+	assert.Equal(t, []testutil.AssignPlanCall{{OrganizerID: "org-1", PlanCode: "free"}}, f.events.AssignOrganizerPlanCalls())
+
+	emails = f.notification.SendDunningEmailCalls()
+	require.Len(t, emails, 3)
+	assert.True(t, emails[2].Canceled)
+}
+
+func TestNextPeriodEnd_ClampsToMonthEnd(t *testing.T) {
+	start := time.Date(2026, time.January, 31, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, time.February, 28, 10, 0, 0, 0, time.UTC), nextPeriodEnd(start))
+
+	start = time.Date(2026, time.March, 15, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, time.April, 15, 10, 0, 0, 0, time.UTC), nextPeriodEnd(start))
+}
+
+func TestProrate_RoundsToWholeRupiah(t *testing.T) {
+	start := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(30 * 24 * time.Hour)
+
+	assert.Equal(t, money.New(200000), prorate(money.New(300000), money.New(900000), start.Add(20*24*time.Hour), start, end))
+	assert.Equal(t, money.New(0), prorate(money.New(300000), money.New(900000), end, start, end))
+	assert.Equal(t, money.New(33333), prorate(money.Money(0), money.New(100000), start.Add(20*24*time.Hour), start, end))
+}
@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/repository"
+)
+
+const (
+	// confirmationBatchSize limits how many confirmations one retry pass claims
+	confirmationBatchSize = 50
+	// confirmationLease keeps a claimed confirmation away from other replicas while it is retried
+	confirmationLease = 2 * time.Minute
+	// confirmationRetryDelay is the delay before the first retry, doubled on every further attempt
+	confirmationRetryDelay = time.Minute
+	// confirmationMaxRetryDelay caps the retry delay
+	confirmationMaxRetryDelay = time.Hour
+	// confirmationMaxAttempts is how often a confirmation is tried before the payment is refunded
+	confirmationMaxAttempts = 10
+	// untrackedConfirmationDelay leaves webhooks that are still running time to track their payment
+	untrackedConfirmationDelay = 5 * time.Minute
+	// untrackedConfirmationWindow is how far back payments without a confirmation are looked for
+	untrackedConfirmationWindow = 7 * 24 * time.Hour
+)
+
+// Order confirmation retry metrics, exposed on /debug/vars
+var (
+	confirmationRetriesConfirmed = expvar.NewInt("payment_confirmation_retries_confirmed_total")
+	confirmationRetriesFailed    = expvar.NewInt("payment_confirmation_retries_failed_total")
+	confirmationsDeadLettered    = expvar.NewInt("payment_confirmations_dead_lettered_total")
+)
+
+// confirmationRetryBackoff returns the delay before the retry after the given attempt
+func confirmationRetryBackoff(attempts int) time.Duration {
+	delay := confirmationRetryDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= confirmationMaxRetryDelay {
+			return confirmationMaxRetryDelay
+		}
+	}
+	return delay
+}
+
+// trackConfirmation starts tracking the order confirmation of a paid payment
+// A failure is only logged: the payment is paid either way and the confirmation is attempted right after
+func (s *webhookService) trackConfirmation(ctx context.Context, confirmation *entity.PaymentConfirmation) {
+	if s.confirmationRepo == nil {
+		return
+	}
+
+	if err := s.confirmationRepo.Create(ctx, confirmation); err != nil {
+		log.Printf("[WARNING] Failed to track confirmation of payment %s, it won't be retried: %v", confirmation.PaymentID, err)
+	}
+}
+
+// confirmOrder asks ticketing service to confirm the order of a paid payment and generate its tickets
+func (s *webhookService) confirmOrder(ctx context.Context, payment *entity.PaymentTransaction, confirmation *entity.PaymentConfirmation) error {
+	if s.ticketingClient == nil {
+		return ErrTicketingUnavailable
+	}
+
+	confirmReq := &client.ConfirmPaymentRequest{
+		PaymentID: confirmation.ProviderPaymentID,
+		Amount:    confirmation.Amount,
+	}
+	if confirmation.PaymentMethod != nil {
+		confirmReq.PaymentMethod = *confirmation.PaymentMethod
+	}
+	if confirmation.Currency != nil {
+		confirmReq.Currency = *confirmation.Currency
+	}
+	confirmReq.InvoicePending = invoicePending(payment)
+
+	result, err := s.ticketingClient.ConfirmPayment(ctx, payment.OrderID, confirmReq)
+	if err != nil {
+		return err
+	}
+
+	if s.confirmationRepo != nil {
+		if err := s.confirmationRepo.MarkConfirmed(ctx, payment.ID, result.Outcome); err != nil {
+			log.Printf("[WARNING] Failed to mark confirmation of payment %s as confirmed: %v", payment.ID, err)
+		}
+	}
+
+	// Ticketing service refused the payment, the saga is compensated by refunding it
+	// Sold out orders are refunded here but flagged there, ticketing service is told once the refund went through
+	if result.Outcome == client.ConfirmationRefundFlagged {
+		if s.compensate(ctx, payment, result.RefundReason, "") && result.RefundReason == client.RefundReasonSoldOut {
+			s.recordTicketingRefund(ctx, payment, confirmation.ProviderPaymentID)
+		}
+		return nil
+	}
+	s.sagaOrderConfirmed(ctx, payment.ID, result.TicketsGenerated)
+
+	log.Printf("[INFO] Successfully confirmed payment with ticketing service (order: %s, outcome: %s)", payment.OrderID, result.Outcome)
+	return nil
+}
+
+// invoicePending checks if a paid payment was paid before its invoice expired
+// Ticketing service only reinstates an order expired just before such a payment
+func invoicePending(payment *entity.PaymentTransaction) bool {
+	if payment.PaidAt == nil {
+		return false
+	}
+	return payment.ExpiresAt == nil || !payment.PaidAt.After(*payment.ExpiresAt)
+}
+
+// scheduleConfirmationRetry records a failed confirmation attempt, it is retried at nextAttemptAt
+func (s *webhookService) scheduleConfirmationRetry(ctx context.Context, paymentID string, cause error, nextAttemptAt time.Time) {
+	if s.confirmationRepo == nil {
+		log.Printf("[WARNING] Confirmation of payment %s is not tracked, tickets need to be generated manually", paymentID)
+		return
+	}
+
+	if err := s.confirmationRepo.Retry(ctx, paymentID, cause.Error(), nextAttemptAt); err != nil {
+		log.Printf("[ERROR] Failed to schedule confirmation retry of payment %s: %v", paymentID, err)
+	}
+}
+
+// RetryConfirmations retries the order confirmations that are due and returns how many got confirmed
+// Paid payments that were never tracked, e.g. when the webhook crashed right after marking them paid, are
+// tracked first and confirmed in the same pass
+// A confirmation that still fails after confirmationMaxAttempts is marked failed and reported, and its payment refunded
+func (s *webhookService) RetryConfirmations(ctx context.Context) (int, error) {
+	if s.confirmationRepo == nil {
+		return 0, nil
+	}
+
+	now := time.Now()
+	tracked, err := s.confirmationRepo.TrackUntracked(ctx,
+		now.Add(-untrackedConfirmationWindow), now.Add(-untrackedConfirmationDelay), confirmationBatchSize)
+	if err != nil {
+		log.Printf("[ERROR] Failed to track paid payments without confirmation: %v", err)
+	} else if tracked > 0 {
+		log.Printf("[WARNING] Tracked %d paid payments without confirmation, confirming them", tracked)
+	}
+
+	due, err := s.confirmationRepo.ClaimDue(ctx, confirmationBatchSize, time.Now().Add(confirmationLease))
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim due confirmations: %w", err)
+	}
+
+	confirmed := 0
+	for _, confirmation := range due {
+		payment, err := s.paymentRepo.GetByID(ctx, confirmation.PaymentID)
+		if err != nil {
+			err = fmt.Errorf("failed to load payment: %w", err)
+		} else {
+			err = s.confirmOrder(ctx, payment, confirmation)
+		}
+		if err == nil {
+			confirmed++
+			confirmationRetriesConfirmed.Add(1)
+			continue
+		}
+
+		confirmationRetriesFailed.Add(1)
+		if confirmation.Attempts >= confirmationMaxAttempts {
+			s.deadLetterConfirmation(ctx, confirmation, payment, err)
+			continue
+		}
+		log.Printf("[WARNING] Confirmation of payment %s failed (attempt %d), will retry: %v", confirmation.PaymentID, confirmation.Attempts, err)
+		s.scheduleConfirmationRetry(ctx, confirmation.PaymentID, err, time.Now().Add(confirmationRetryBackoff(confirmation.Attempts)))
+	}
+
+	return confirmed, nil
+}
+
+// deadLetterConfirmation gives up on a confirmation, a paid order without tickets must never go unnoticed
+// The saga is compensated: the buyer gets their money back instead of the tickets (payment may be nil)
+func (s *webhookService) deadLetterConfirmation(ctx context.Context, confirmation *entity.PaymentConfirmation, payment *entity.PaymentTransaction, cause error) {
+	confirmationsDeadLettered.Add(1)
+
+	if err := s.confirmationRepo.MarkFailed(ctx, confirmation.PaymentID, cause.Error()); err != nil {
+		log.Printf("[ERROR] Failed to mark confirmation of payment %s as failed: %v", confirmation.PaymentID, err)
+	}
+
+	log.Printf("[ERROR] Gave up confirming payment %s of order %s after %d attempts: %v",
+		confirmation.PaymentID, confirmation.OrderID, confirmation.Attempts, cause)
+	errreport.CaptureMessage("payment confirmation failed permanently", map[string]string{
+		"payment_id": confirmation.PaymentID,
+		"order_id":   confirmation.OrderID,
+	})
+
+	if payment != nil {
+		s.compensate(ctx, payment, entity.CompensationConfirmationFailed, cause.Error())
+	}
+}
+
+// ReplayConfirmation confirms the order of a paid payment with ticketing service right away, e.g. once
+// a confirmation gave up or the payment was never tracked. Ticketing service ignores payments it already applied.
+// A replay that fails again is retried by the worker and returns ErrConfirmationFailed. Payments being
+// refunded instead (compensated saga) return ErrPaymentCompensated
+func (s *webhookService) ReplayConfirmation(ctx context.Context, adminID string, paymentID string) (*response.PaymentConfirmationResponse, error) {
+	payment, err := s.paymentRepo.GetByID(ctx, paymentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPaymentNotFound) {
+			return nil, ErrPaymentNotFound
+		}
+		return nil, err
+	}
+	if !payment.IsPaid() {
+		return nil, ErrPaymentNotPaid
+	}
+	if s.sagaCompensated(ctx, payment.ID) {
+		return nil, ErrPaymentCompensated
+	}
+
+	confirmation, err := s.replayableConfirmation(ctx, payment)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.confirmOrder(ctx, payment, confirmation); err != nil {
+		log.Printf("[ERROR] Replayed confirmation of payment %s failed: %v", payment.ID, err)
+		s.scheduleConfirmationRetry(ctx, payment.ID, err, time.Now().Add(confirmationRetryBackoff(1)))
+		return nil, fmt.Errorf("%w: %v", ErrConfirmationFailed, err)
+	}
+
+	log.Printf("[INFO] Confirmation of payment %s replayed by %s", payment.ID, adminID)
+
+	if s.confirmationRepo != nil {
+		if reloaded, err := s.confirmationRepo.GetByPaymentID(ctx, payment.ID); err == nil {
+			confirmation = reloaded
+		}
+	}
+	return response.ToPaymentConfirmationResponse(confirmation), nil
+}
+
+// replayableConfirmation returns the tracked confirmation of a payment
+// A payment paid before confirmations were tracked gets one built from the payment itself
+func (s *webhookService) replayableConfirmation(ctx context.Context, payment *entity.PaymentTransaction) (*entity.PaymentConfirmation, error) {
+	if s.confirmationRepo != nil {
+		confirmation, err := s.confirmationRepo.GetByPaymentID(ctx, payment.ID)
+		if err == nil {
+			return confirmation, nil
+		}
+		if !errors.Is(err, repository.ErrConfirmationNotFound) {
+			return nil, err
+		}
+	}
+
+	confirmation := &entity.PaymentConfirmation{
+		PaymentID:     payment.ID,
+		OrderID:       payment.OrderID,
+		PaymentMethod: payment.PaymentMethod,
+		Amount:        payment.Amount,
+		Status:        entity.ConfirmationStatusPending,
+		Attempts:      1,
+		NextAttemptAt: time.Now().Add(confirmationRetryBackoff(1)),
+	}
+	if payment.InvoiceID != nil {
+		confirmation.ProviderPaymentID = *payment.InvoiceID
+	}
+	s.trackConfirmation(ctx, confirmation)
+	return confirmation, nil
+}
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/response"
+)
+
+// WebhookEventType derives the event type of a Xendit callback from its payload
+// Xendit sends no event type header: QR code and refund callbacks name it in their event field,
+// invoice, fixed virtual account and card charge callbacks are told apart by their fields.
+// Callbacks none of the handlers understand are EventTypeUnknown
+func WebhookEventType(body []byte) string {
+	var probe struct {
+		Event            string `json:"event"`
+		Status           string `json:"status"`
+		AccountNumber    string `json:"account_number"`
+		PaymentID        string `json:"payment_id"`
+		MaskedCardNumber string `json:"masked_card_number"`
+	}
+	if err := json.Unmarshal(body, &probe); err != nil {
+		return entity.EventTypeUnknown
+	}
+
+	switch {
+	case probe.Event != "":
+		switch probe.Event {
+		case entity.EventTypeQRPaid, entity.EventTypeRefundSucceeded, entity.EventTypeRefundFailed:
+			return probe.Event
+		}
+		return entity.EventTypeUnknown
+	case probe.AccountNumber != "":
+		// Payments into the account carry the payment ID, they are settled through the invoice
+		if probe.PaymentID == "" {
+			return entity.EventTypeVirtualAccountCreated
+		}
+		return entity.EventTypeUnknown
+	case probe.MaskedCardNumber != "":
+		if probe.Status == "FAILED" {
+			return entity.EventTypeCardFailed
+		}
+		return entity.EventTypeUnknown
+	}
+
+	switch probe.Status {
+	case "PAID", "SETTLED":
+		return entity.EventTypeInvoicePaid
+	case "EXPIRED":
+		return entity.EventTypeInvoiceExpired
+	}
+	return entity.EventTypeUnknown
+}
+
+// handleVirtualAccountCreated records the bank of the virtual account the buyer pays a pending payment into
+// The checkout page's status polling then shows the bank until the payment is settled
+func (s *webhookService) handleVirtualAccountCreated(ctx context.Context, payload *response.XenditVirtualAccountPayload, sandbox bool) error {
+	log.Printf("[INFO] Processing virtual_account.created webhook for %s (bank: %s)", payload.ExternalID, payload.BankCode)
+
+	payment, err := s.paymentRepo.GetByExternalID(ctx, payload.ExternalID)
+	if err != nil {
+		return fmt.Errorf("payment not found for virtual account %s: %w", payload.ID, err)
+	}
+	if payment.IsSandbox != sandbox {
+		return fmt.Errorf("virtual account %s: %w", payload.ID, ErrSandboxMismatch)
+	}
+
+	if payment.Status != entity.PaymentStatusPending {
+		log.Printf("[INFO] Payment %s is %s, virtual account %s not recorded", payment.ID, payment.Status, payload.ID)
+		return nil
+	}
+
+	bankCode := payload.BankCode
+	payment.PaymentMethod = &bankCode
+	if err := s.paymentRepo.Update(ctx, payment); err != nil {
+		return fmt.Errorf("failed to update payment method: %w", err)
+	}
+	s.statusCache.Invalidate(ctx, payment.OrderID)
+
+	return nil
+}
+
+// handleQRPaid settles the payment of a successful QR code payment like a paid invoice
+func (s *webhookService) handleQRPaid(ctx context.Context, payload *response.XenditQRPaymentPayload, sandbox bool) error {
+	qr := payload.Data
+	log.Printf("[INFO] Processing qr.payment webhook for %s (status: %s)", qr.ReferenceID, qr.Status)
+
+	if qr.Status != "SUCCEEDED" {
+		return fmt.Errorf("%w: QR payment %s with status %s", errUnhandledEventType, qr.ID, qr.Status)
+	}
+
+	payment, err := s.paymentRepo.GetByExternalID(ctx, qr.ReferenceID)
+	if err != nil {
+		return fmt.Errorf("payment not found for QR payment %s: %w", qr.ID, err)
+	}
+	if payment.IsSandbox != sandbox {
+		return fmt.Errorf("QR payment %s: %w", qr.ID, ErrSandboxMismatch)
+	}
+
+	paidAt := qr.Created
+	if paidAt.IsZero() {
+		paidAt = time.Now()
+	}
+
+	return s.settlePayment(ctx, payment, paidDetails{
+		ProviderID: qr.ID,
+		Method:     "QRIS",
+		Amount:     qr.Amount,
+		Currency:   qr.Currency,
+		PaidAt:     paidAt,
+	})
+}
+
+// handleCardFailed marks a pending payment failed when its card charge was declined
+// The buyer can still pay the invoice another way, a later paid callback settles the payment
+func (s *webhookService) handleCardFailed(ctx context.Context, payload *response.XenditCardChargePayload, sandbox bool) error {
+	log.Printf("[INFO] Processing card.failed webhook for %s (reason: %s)", payload.ExternalID, payload.FailureReason)
+
+	payment, err := s.paymentRepo.GetByExternalID(ctx, payload.ExternalID)
+	if err != nil {
+		return fmt.Errorf("payment not found for card charge %s: %w", payload.ID, err)
+	}
+	if payment.IsSandbox != sandbox {
+		return fmt.Errorf("card charge %s: %w", payload.ID, ErrSandboxMismatch)
+	}
+
+	// Only update if still pending
+	if payment.Status == entity.PaymentStatusPending {
+		payment.Status = entity.PaymentStatusFailed
+		if err := s.paymentRepo.Update(ctx, payment); err != nil {
+			return fmt.Errorf("failed to update payment status: %w", err)
+		}
+		s.statusCache.Invalidate(ctx, payment.OrderID)
+		log.Printf("[INFO] Payment marked as failed: %s (order: %s, card %s declined: %s)",
+			payment.ID, payment.OrderID, payload.MaskedCardNumber, payload.FailureReason)
+	}
+
+	return nil
+}
+
+// handleRefundUpdate completes or fails a refund waiting for its payment channel
+// Refunds are found by reference ID, which is the refund ID sent when it was created
+func (s *webhookService) handleRefundUpdate(ctx context.Context, eventType string, payload *response.XenditRefundPayload, sandbox bool) error {
+	data := payload.Data
+	log.Printf("[INFO] Processing %s webhook for refund %s", eventType, data.ReferenceID)
+
+	if s.refundRepo == nil {
+		return fmt.Errorf("refund repository not available for refund %s", data.ReferenceID)
+	}
+
+	refund, err := s.refundRepo.GetByID(ctx, data.ReferenceID)
+	if err != nil {
+		return fmt.Errorf("refund not found for Xendit refund %s: %w", data.ID, err)
+	}
+
+	payment, err := s.paymentRepo.GetByID(ctx, refund.PaymentTransactionID)
+	if err != nil {
+		return fmt.Errorf("payment not found for refund %s: %w", refund.ID, err)
+	}
+	if payment.IsSandbox != sandbox {
+		return fmt.Errorf("refund %s: %w", refund.ID, ErrSandboxMismatch)
+	}
+
+	// Completed and failed refunds are final, a retried callback changes nothing
+	if refund.Status == entity.RefundStatusCompleted || refund.Status == entity.RefundStatusFailed {
+		log.Printf("[INFO] Refund %s already %s", refund.ID, refund.Status)
+		return nil
+	}
+
+	if refund.ProviderRefundID == nil {
+		refund.ProviderRefundID = &data.ID
+	}
+	if eventType == entity.EventTypeRefundSucceeded {
+		now := time.Now()
+		refund.Status = entity.RefundStatusCompleted
+		refund.ProcessedAt = &now
+	} else {
+		log.Printf("[WARNING] Xendit refund %s failed for refunded order %s, it needs a manual refund", refund.ID, refund.OrderID)
+		refund.Status = entity.RefundStatusFailed
+		refund.FailureReason = data.FailureCode
+	}
+
+	if err := s.refundRepo.Update(ctx, refund); err != nil {
+		return fmt.Errorf("failed to update refund: %w", err)
+	}
+
+	log.Printf("[INFO] Refund %s of order %s marked as %s", refund.ID, refund.OrderID, refund.Status)
+	return nil
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/repository"
+)
+
+// ListQuarantined lists quarantined webhooks, newest first
+func (s *webhookService) ListQuarantined(ctx context.Context, req *request.ListQuarantinedWebhooksRequest) ([]*response.WebhookEventResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = request.DefaultQuarantineLimit
+	}
+
+	webhooks, err := s.webhookRepo.ListQuarantined(ctx, req.EventType, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*response.WebhookEventResponse, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		result = append(result, response.ToWebhookEventResponse(webhook))
+	}
+	return result, nil
+}
+
+// ReplayQuarantined processes a quarantined webhook again, e.g. once its payment was created or
+// a handler for its event type was deployed. The event type is derived from the payload again.
+// A webhook that still matches nothing stays quarantined with the new reason (ErrWebhookUnmatched)
+func (s *webhookService) ReplayQuarantined(ctx context.Context, adminID string, id string) (*response.WebhookEventResponse, error) {
+	webhook, err := s.quarantinedWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	eventType := WebhookEventType([]byte(webhook.Payload))
+	if err := s.handle(ctx, eventType, []byte(webhook.Payload), webhook.Sandbox); err != nil {
+		if isUnmatchedWebhook(err) {
+			if qErr := s.webhookRepo.MarkAsQuarantined(ctx, webhook.WebhookID, err.Error()); qErr != nil {
+				log.Printf("[ERROR] Failed to update quarantine reason of webhook %s: %v", webhook.WebhookID, qErr)
+			}
+			return nil, fmt.Errorf("%w: %v", ErrWebhookUnmatched, err)
+		}
+		return nil, fmt.Errorf("failed to replay webhook %s: %w", webhook.WebhookID, err)
+	}
+
+	if err := s.resolve(ctx, webhook, entity.WebhookStatusProcessed, adminID); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Quarantined webhook %s replayed as %s by %s", webhook.WebhookID, eventType, adminID)
+	return response.ToWebhookEventResponse(webhook), nil
+}
+
+// DismissQuarantined drops a quarantined webhook that needs no processing, e.g. a test callback
+func (s *webhookService) DismissQuarantined(ctx context.Context, adminID string, id string) (*response.WebhookEventResponse, error) {
+	webhook, err := s.quarantinedWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.resolve(ctx, webhook, entity.WebhookStatusDismissed, adminID); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Quarantined webhook %s dismissed by %s", webhook.WebhookID, adminID)
+	return response.ToWebhookEventResponse(webhook), nil
+}
+
+// quarantinedWebhook returns the webhook with ID id, ErrWebhookNotQuarantined when it is not quarantined
+func (s *webhookService) quarantinedWebhook(ctx context.Context, id string) (*entity.WebhookEvent, error) {
+	webhook, err := s.webhookRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrWebhookNotFound) {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+	if !webhook.IsQuarantined() {
+		return nil, ErrWebhookNotQuarantined
+	}
+	return webhook, nil
+}
+
+// resolve moves a quarantined webhook to status on behalf of adminID
+func (s *webhookService) resolve(ctx context.Context, webhook *entity.WebhookEvent, status string, adminID string) error {
+	if err := s.webhookRepo.Resolve(ctx, webhook.ID, status, adminID); err != nil {
+		if errors.Is(err, repository.ErrWebhookNotQuarantined) {
+			return ErrWebhookNotQuarantined
+		}
+		return fmt.Errorf("failed to resolve webhook: %w", err)
+	}
+
+	now := time.Now()
+	webhook.Status = status
+	webhook.ResolvedBy = &adminID
+	webhook.ResolvedAt = &now
+	return nil
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"log"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/repository"
+)
+
+// Paid-order saga compensation metrics, exposed on /debug/vars
+var (
+	sagaCompensations        = expvar.NewInt("payment_saga_compensations_total")
+	sagaCompensationFailures = expvar.NewInt("payment_saga_compensation_failures_total")
+)
+
+// errRefundUnavailable is recorded on sagas that could not be compensated without a refund service
+var errRefundUnavailable = errors.New("refund service is not available")
+
+// The paid-order saga runs payment captured -> order confirmed -> tickets generated -> email sent.
+// Saga bookkeeping never fails the payment flow itself: errors are logged and the repository's
+// guarded transitions keep the steps in order when a later report catches up
+
+// startSaga records a captured payment, the first step of its saga
+func (s *webhookService) startSaga(ctx context.Context, payment *entity.PaymentTransaction, providerPaymentID string) {
+	if s.sagaRepo == nil {
+		return
+	}
+
+	saga := &entity.PaymentSaga{
+		PaymentID:         payment.ID,
+		OrderID:           payment.OrderID,
+		ProviderPaymentID: providerPaymentID,
+	}
+	if err := s.sagaRepo.Start(ctx, saga); err != nil {
+		log.Printf("[WARNING] Failed to start saga of payment %s: %v", payment.ID, err)
+	}
+}
+
+// sagaCompensated reports whether the payment is, or is being, refunded instead of applied
+func (s *webhookService) sagaCompensated(ctx context.Context, paymentID string) bool {
+	if s.sagaRepo == nil {
+		return false
+	}
+
+	saga, err := s.sagaRepo.GetByPaymentID(ctx, paymentID)
+	if err != nil {
+		if !errors.Is(err, repository.ErrSagaNotFound) {
+			log.Printf("[WARNING] Failed to get saga of payment %s: %v", paymentID, err)
+		}
+		return false
+	}
+	return saga.IsCompensated()
+}
+
+// sagaOrderConfirmed records that ticketing service confirmed the order and generated its tickets
+func (s *webhookService) sagaOrderConfirmed(ctx context.Context, paymentID string, ticketsGenerated int) {
+	if s.sagaRepo == nil {
+		return
+	}
+
+	if err := s.sagaRepo.MarkOrderConfirmed(ctx, paymentID, ticketsGenerated); err != nil {
+		log.Printf("[WARNING] Failed to record confirmed order in saga of payment %s: %v", paymentID, err)
+	}
+}
+
+// compensate refunds a payment that can't be applied to its order and reports whether it was refunded
+// Payments of cancelled orders, of orders whose tickets sold out as their reservation expired, and
+// payments whose confirmation permanently failed are refunded through Xendit right away. Ticketing
+// service queued the others (expired order, duplicate payment) for support to refund manually, their
+// saga stays compensating. A failed refund is logged for a manual refund
+func (s *webhookService) compensate(ctx context.Context, payment *entity.PaymentTransaction, reason, cause string) bool {
+	sagaCompensations.Add(1)
+	if s.sagaRepo != nil {
+		if err := s.sagaRepo.MarkCompensating(ctx, payment.ID, reason, cause); err != nil {
+			log.Printf("[WARNING] Failed to record compensation in saga of payment %s: %v", payment.ID, err)
+		}
+	}
+
+	var refund func(ctx context.Context, payment *entity.PaymentTransaction) (*response.RefundResponse, error)
+	switch reason {
+	case client.RefundReasonOrderCancelled:
+		if s.refundService != nil {
+			refund = s.refundService.RefundCancelledOrder
+		}
+	case client.RefundReasonSoldOut:
+		if s.refundService != nil {
+			refund = s.refundService.RefundSoldOutOrder
+		}
+	case entity.CompensationConfirmationFailed:
+		if s.refundService != nil {
+			refund = s.refundService.RefundUnconfirmedPayment
+		}
+	default:
+		log.Printf("[INFO] Payment %s of order %s flagged for refund by ticketing service (%s), support refunds it", payment.ID, payment.OrderID, reason)
+		return false
+	}
+
+	if refund == nil {
+		log.Printf("[WARNING] Refund service not available, payment %s of order %s (%s) needs a manual refund", payment.ID, payment.OrderID, reason)
+		s.sagaCompensationFailed(ctx, payment, errRefundUnavailable)
+		return false
+	}
+
+	result, err := refund(ctx, payment)
+	if err != nil {
+		if errors.Is(err, ErrRefundAlreadyRequested) {
+			log.Printf("[INFO] Payment %s of order %s is already being refunded", payment.ID, payment.OrderID)
+			return false
+		}
+		log.Printf("[WARNING] Failed to refund payment %s of order %s (%s), it needs a manual refund: %v", payment.ID, payment.OrderID, reason, err)
+		s.sagaCompensationFailed(ctx, payment, err)
+		return false
+	}
+
+	log.Printf("[INFO] Payment %s of order %s refunded (%s, refund %s: %s)", payment.ID, payment.OrderID, reason, result.ID, result.Status)
+	if s.sagaRepo != nil {
+		if err := s.sagaRepo.MarkCompensated(ctx, payment.ID); err != nil {
+			log.Printf("[WARNING] Failed to record refund in saga of payment %s: %v", payment.ID, err)
+		}
+	}
+	return true
+}
+
+// recordTicketingRefund reports a refunded payment to ticketing service, which flagged it for refund,
+// so support doesn't pay it back a second time. A failure is only logged: support sees the refund here
+func (s *webhookService) recordTicketingRefund(ctx context.Context, payment *entity.PaymentTransaction, providerPaymentID string) {
+	recorded, err := s.ticketingClient.RecordPaymentRefund(ctx, payment.OrderID, providerPaymentID)
+	if err != nil {
+		log.Printf("[WARNING] Failed to record refund of payment %s with ticketing service, its refund request stays pending: %v", payment.ID, err)
+		return
+	}
+	if !recorded {
+		log.Printf("[WARNING] Ticketing service has no pending refund request for payment %s of order %s", payment.ID, payment.OrderID)
+	}
+}
+
+// sagaCompensationFailed records a refund that failed, support refunds the payment manually
+func (s *webhookService) sagaCompensationFailed(ctx context.Context, payment *entity.PaymentTransaction, cause error) {
+	sagaCompensationFailures.Add(1)
+	errreport.CaptureMessage("payment saga compensation failed", map[string]string{
+		"payment_id": payment.ID,
+		"order_id":   payment.OrderID,
+	})
+
+	if s.sagaRepo == nil {
+		return
+	}
+	if err := s.sagaRepo.MarkFailed(ctx, payment.ID, cause.Error()); err != nil {
+		log.Printf("[WARNING] Failed to record failed refund in saga of payment %s: %v", payment.ID, err)
+	}
+}
+
+// RecordTicketEmail records that ticketing service emailed the tickets of the order paid with providerPaymentID
+// Reports whether a saga was updated; orders without a running saga (e.g. marked paid by support) are ignored
+func (s *webhookService) RecordTicketEmail(ctx context.Context, orderID, providerPaymentID string) (bool, error) {
+	if s.sagaRepo == nil {
+		return false, nil
+	}
+
+	return s.sagaRepo.MarkEmailSent(ctx, orderID, providerPaymentID)
+}
+
+// ListSagas lists payment sagas, most recently updated first
+func (s *webhookService) ListSagas(ctx context.Context, req *request.ListPaymentSagasRequest) ([]*response.PaymentSagaResponse, error) {
+	result := []*response.PaymentSagaResponse{}
+	if s.sagaRepo == nil {
+		return result, nil
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = request.DefaultSagaLimit
+	}
+
+	sagas, err := s.sagaRepo.List(ctx, req.Status, req.Step, req.OrderID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, saga := range sagas {
+		result = append(result, response.ToPaymentSagaResponse(saga))
+	}
+	return result, nil
+}
+
+// GetSaga returns the saga of a payment
+func (s *webhookService) GetSaga(ctx context.Context, paymentID string) (*response.PaymentSagaResponse, error) {
+	if s.sagaRepo == nil {
+		return nil, ErrSagaNotFound
+	}
+
+	saga, err := s.sagaRepo.GetByPaymentID(ctx, paymentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrSagaNotFound) {
+			return nil, ErrSagaNotFound
+		}
+		return nil, err
+	}
+	return response.ToPaymentSagaResponse(saga), nil
+}
@@ -6,51 +6,128 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/client"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/request"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/response"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/repository"
 )
 
 var (
-	ErrDuplicateWebhook = errors.New("webhook already processed")
-	ErrWebhookNotFound  = errors.New("webhook event not found")
+	ErrDuplicateWebhook      = errors.New("webhook already processed")
+	ErrWebhookNotFound       = errors.New("webhook event not found")
+	ErrSandboxMismatch       = errors.New("webhook mode does not match the payment")
+	ErrWebhookQuarantined    = errors.New("webhook quarantined for review")
+	ErrWebhookNotQuarantined = errors.New("webhook is not quarantined")
+	ErrWebhookUnmatched      = errors.New("webhook still matches no payment")
+	ErrPaymentNotPaid        = errors.New("payment is not paid")
+	ErrConfirmationFailed    = errors.New("ticketing service did not confirm the order")
+	ErrPaymentCompensated    = errors.New("payment is refunded instead of applied to its order")
+	ErrSagaNotFound          = errors.New("payment saga not found")
 )
 
+// errUnhandledEventType is returned for callbacks no handler understands, they are quarantined
+var errUnhandledEventType = errors.New("unhandled webhook event type")
+
 // WebhookService handles webhook event processing
 type WebhookService interface {
 	ProcessWebhook(ctx context.Context, webhookID string, eventType string, payload []byte) error
+	ProcessSandboxWebhook(ctx context.Context, webhookID string, eventType string, payload []byte) error
+
+	// Quarantine of webhooks that matched no payment (support:manage)
+	ListQuarantined(ctx context.Context, req *request.ListQuarantinedWebhooksRequest) ([]*response.WebhookEventResponse, error)
+	ReplayQuarantined(ctx context.Context, adminID string, id string) (*response.WebhookEventResponse, error)
+	DismissQuarantined(ctx context.Context, adminID string, id string) (*response.WebhookEventResponse, error)
+
+	// Order confirmations that did not get through to ticketing service
+	RetryConfirmations(ctx context.Context) (int, error)
+	ReplayConfirmation(ctx context.Context, adminID string, paymentID string) (*response.PaymentConfirmationResponse, error)
+
+	// Paid-order saga (payment captured -> order confirmed -> tickets generated -> email sent)
+	RecordTicketEmail(ctx context.Context, orderID, providerPaymentID string) (bool, error)
+	ListSagas(ctx context.Context, req *request.ListPaymentSagasRequest) ([]*response.PaymentSagaResponse, error)
+	GetSaga(ctx context.Context, paymentID string) (*response.PaymentSagaResponse, error)
+}
+
+// TicketingClient defines interface for ticketing service communication
+type TicketingClient interface {
+	ConfirmPayment(ctx context.Context, orderID string, req *client.ConfirmPaymentRequest) (*client.ConfirmPaymentResponse, error)
+	RefundOrder(ctx context.Context, req *client.RefundOrderRequest) (*client.RefundOrderResponse, error)
+	CheckRefundEligibility(ctx context.Context, orderID, userID string) error
+	RecordPaymentRefund(ctx context.Context, orderID, paymentID string) (bool, error)
 }
 
 // webhookService implements WebhookService interface
 type webhookService struct {
-	webhookRepo      repository.WebhookRepository
-	paymentRepo      repository.PaymentRepository
-	ticketingClient  *client.TicketingClient
+	webhookRepo         repository.WebhookRepository
+	paymentRepo         repository.PaymentRepository
+	refundRepo          repository.RefundRepository
+	confirmationRepo    repository.PaymentConfirmationRepository
+	sagaRepo            repository.PaymentSagaRepository
+	refundService       RefundService
+	ticketingClient     TicketingClient
+	subscriptionService SubscriptionService
+	statusCache         *paymentStatusCache
 }
 
 // NewWebhookService creates new webhook service instance
+// refundRepo holds the refunds that refund callbacks complete, may be nil
+// confirmationRepo tracks order confirmations so failed ones are retried, may be nil
+// sagaRepo tracks each paid payment through the paid-order saga, may be nil
+// refundService refunds payments that can't be applied to their order, may be nil
+// subscriptionService handles invoices of plan subscriptions (SUB- external IDs), may be nil
+// redisClient holds the cached payment statuses that webhooks invalidate, may be nil
 func NewWebhookService(
 	webhookRepo repository.WebhookRepository,
 	paymentRepo repository.PaymentRepository,
-	ticketingClient *client.TicketingClient,
+	refundRepo repository.RefundRepository,
+	confirmationRepo repository.PaymentConfirmationRepository,
+	sagaRepo repository.PaymentSagaRepository,
+	refundService RefundService,
+	ticketingClient TicketingClient,
+	subscriptionService SubscriptionService,
+	redisClient cache.RedisClient,
 ) WebhookService {
 	return &webhookService{
-		webhookRepo:     webhookRepo,
-		paymentRepo:     paymentRepo,
-		ticketingClient: ticketingClient,
+		webhookRepo:         webhookRepo,
+		paymentRepo:         paymentRepo,
+		refundRepo:          refundRepo,
+		confirmationRepo:    confirmationRepo,
+		sagaRepo:            sagaRepo,
+		refundService:       refundService,
+		ticketingClient:     ticketingClient,
+		subscriptionService: subscriptionService,
+		statusCache:         newPaymentStatusCache(redisClient),
 	}
 }
 
 // ProcessWebhook processes incoming webhook with idempotency
 func (s *webhookService) ProcessWebhook(ctx context.Context, webhookID string, eventType string, payload []byte) error {
+	return s.processWebhook(ctx, webhookID, eventType, payload, false)
+}
+
+// ProcessSandboxWebhook processes a webhook sent with the test webhook token
+// Only invoices of sandbox payments are handled, so test mode can never mark a real order paid
+func (s *webhookService) ProcessSandboxWebhook(ctx context.Context, webhookID string, eventType string, payload []byte) error {
+	return s.processWebhook(ctx, webhookID, eventType, payload, true)
+}
+
+// processWebhook processes a live or sandbox webhook with idempotency
+// Webhooks of unknown event types, or that match no payment, refund or subscription invoice, are
+// quarantined for admins to replay or dismiss; ErrWebhookQuarantined is returned
+func (s *webhookService) processWebhook(ctx context.Context, webhookID string, eventType string, payload []byte, sandbox bool) error {
 	// Step 1: Idempotency check - Save webhook event (will fail if duplicate)
 	webhookEvent := &entity.WebhookEvent{
 		WebhookID: webhookID,
 		EventType: eventType,
 		Payload:   string(payload),
 		Status:    entity.WebhookStatusPending,
+		Sandbox:   sandbox,
 	}
 
 	if err := s.webhookRepo.Create(ctx, webhookEvent); err != nil {
@@ -61,27 +138,19 @@ func (s *webhookService) ProcessWebhook(ctx context.Context, webhookID string, e
 		return fmt.Errorf("failed to save webhook event: %w", err)
 	}
 
-	// Step 2: Parse webhook payload
-	var webhookPayload response.XenditWebhookPayload
-	if err := json.Unmarshal(payload, &webhookPayload); err != nil {
-		s.webhookRepo.MarkAsFailed(ctx, webhookID)
-		return fmt.Errorf("failed to parse webhook payload: %w", err)
-	}
-
-	// Step 3: Process based on event type
-	var err error
-	switch eventType {
-	case entity.EventTypeInvoicePaid:
-		err = s.handleInvoicePaid(ctx, &webhookPayload)
-	case entity.EventTypeInvoiceExpired:
-		err = s.handleInvoiceExpired(ctx, &webhookPayload)
-	default:
-		log.Printf("[INFO] Unhandled webhook event type: %s", eventType)
-		err = nil // Not an error, just ignore
-	}
+	// Step 2: Parse and process based on event type
+	err := s.handle(ctx, eventType, payload, sandbox)
 
-	// Step 4: Mark webhook as processed or failed
+	// Step 3: Mark webhook as processed, quarantined or failed
 	if err != nil {
+		if isUnmatchedWebhook(err) {
+			log.Printf("[WARN] Quarantining webhook %s (type: %s): %v", webhookID, eventType, err)
+			if qErr := s.webhookRepo.MarkAsQuarantined(ctx, webhookID, err.Error()); qErr != nil {
+				return fmt.Errorf("failed to quarantine webhook: %w", qErr)
+			}
+			return fmt.Errorf("%w: %v", ErrWebhookQuarantined, err)
+		}
+
 		log.Printf("[ERROR] Failed to process webhook %s: %v", webhookID, err)
 		s.webhookRepo.MarkAsFailed(ctx, webhookID)
 		return err
@@ -95,8 +164,71 @@ func (s *webhookService) ProcessWebhook(ctx context.Context, webhookID string, e
 	return nil
 }
 
+// handle parses the payload of the event type and passes it to its handler
+func (s *webhookService) handle(ctx context.Context, eventType string, payload []byte, sandbox bool) error {
+	switch eventType {
+	case entity.EventTypeInvoicePaid, entity.EventTypeInvoiceExpired:
+		var invoice response.XenditWebhookPayload
+		if err := json.Unmarshal(payload, &invoice); err != nil {
+			return fmt.Errorf("failed to parse webhook payload: %w", err)
+		}
+
+		// Subscription invoices are told apart by external ID and never reach ticket payment handling
+		if strings.HasPrefix(invoice.ExternalID, entity.SubscriptionExternalIDPrefix) {
+			// Subscriptions are never invoiced with the test keys
+			if sandbox {
+				return fmt.Errorf("subscription invoice %s: %w", invoice.ExternalID, ErrSandboxMismatch)
+			}
+			return s.handleSubscriptionInvoice(ctx, &invoice)
+		}
+		if eventType == entity.EventTypeInvoicePaid {
+			return s.handleInvoicePaid(ctx, &invoice, sandbox)
+		}
+		return s.handleInvoiceExpired(ctx, &invoice, sandbox)
+
+	case entity.EventTypeVirtualAccountCreated:
+		var account response.XenditVirtualAccountPayload
+		if err := json.Unmarshal(payload, &account); err != nil {
+			return fmt.Errorf("failed to parse virtual account payload: %w", err)
+		}
+		return s.handleVirtualAccountCreated(ctx, &account, sandbox)
+
+	case entity.EventTypeQRPaid:
+		var qr response.XenditQRPaymentPayload
+		if err := json.Unmarshal(payload, &qr); err != nil {
+			return fmt.Errorf("failed to parse QR payment payload: %w", err)
+		}
+		return s.handleQRPaid(ctx, &qr, sandbox)
+
+	case entity.EventTypeCardFailed:
+		var charge response.XenditCardChargePayload
+		if err := json.Unmarshal(payload, &charge); err != nil {
+			return fmt.Errorf("failed to parse card charge payload: %w", err)
+		}
+		return s.handleCardFailed(ctx, &charge, sandbox)
+
+	case entity.EventTypeRefundSucceeded, entity.EventTypeRefundFailed:
+		var refund response.XenditRefundPayload
+		if err := json.Unmarshal(payload, &refund); err != nil {
+			return fmt.Errorf("failed to parse refund payload: %w", err)
+		}
+		return s.handleRefundUpdate(ctx, eventType, &refund, sandbox)
+	}
+
+	return fmt.Errorf("%w: %s", errUnhandledEventType, eventType)
+}
+
+// isUnmatchedWebhook reports whether err means the webhook is not understood or belongs to nothing
+// we know of, rather than failed while being processed
+func isUnmatchedWebhook(err error) bool {
+	return errors.Is(err, errUnhandledEventType) ||
+		errors.Is(err, repository.ErrPaymentNotFound) ||
+		errors.Is(err, repository.ErrRefundNotFound) ||
+		errors.Is(err, ErrSubscriptionInvoiceNotFound)
+}
+
 // handleInvoicePaid handles invoice.paid webhook event
-func (s *webhookService) handleInvoicePaid(ctx context.Context, payload *response.XenditWebhookPayload) error {
+func (s *webhookService) handleInvoicePaid(ctx context.Context, payload *response.XenditWebhookPayload, sandbox bool) error {
 	log.Printf("[INFO] Processing invoice.paid webhook for invoice: %s", payload.ID)
 
 	// Step 1: Get payment transaction by invoice ID
@@ -104,60 +236,108 @@ func (s *webhookService) handleInvoicePaid(ctx context.Context, payload *respons
 	if err != nil {
 		return fmt.Errorf("payment not found for invoice %s: %w", payload.ID, err)
 	}
-
-	// Step 2: Check if already paid (double webhook prevention)
-	if payment.IsPaid() {
-		log.Printf("[INFO] Payment already marked as paid: %s", payment.ID)
-		return nil
+	if payment.IsSandbox != sandbox {
+		return fmt.Errorf("invoice %s: %w", payload.ID, ErrSandboxMismatch)
 	}
 
-	// Step 3: Update payment status to paid
-	paidAt := payload.PaidAt
 	paymentMethod := payload.PaymentMethod
 	if paymentMethod == "" {
 		paymentMethod = payload.PaymentChannel
 	}
 
-	payment.Status = entity.PaymentStatusPaid
-	payment.PaidAt = &paidAt
-	payment.PaymentMethod = &paymentMethod
+	return s.settlePayment(ctx, payment, paidDetails{
+		ProviderID: payload.ID,
+		Method:     paymentMethod,
+		Amount:     payload.PaidAmount,
+		Currency:   payload.Currency,
+		PaidAt:     payload.PaidAt,
+	})
+}
 
-	if err := s.paymentRepo.Update(ctx, payment); err != nil {
-		return fmt.Errorf("failed to update payment status: %w", err)
-	}
+// paidDetails is what a paid callback tells about the payment, whatever its payment method
+type paidDetails struct {
+	ProviderID string // Xendit invoice or payment ID, sent to ticketing service as payment ID
+	Method     string
+	Amount     money.Money
+	Currency   string
+	PaidAt     time.Time
+}
 
-	log.Printf("[INFO] Payment marked as paid: %s (order: %s)", payment.ID, payment.OrderID)
+// settlePayment marks a payment paid and confirms its order with ticketing service
+func (s *webhookService) settlePayment(ctx context.Context, payment *entity.PaymentTransaction, paid paidDetails) error {
+	// Step 1: Update payment status to paid
+	// A payment already paid is a provider retry: it is not updated again, but confirmation is
+	// re-sent (idempotent per payment in ticketing-service) in case the first one did not get through
+	// A payment marked failed by a declined card is still settled by a later successful payment
+	// Only one payment attempt of an order is paid: another attempt paid as well is not confirmed
+	if payment.IsPaid() {
+		log.Printf("[INFO] Payment already marked as paid: %s, re-sending confirmation", payment.ID)
+	} else {
+		paidAt := paid.PaidAt
+		payment.PaidAt = &paidAt
+		payment.PaymentMethod = &paid.Method
+		// Refunds go out in the currency the provider reports paid, invoices without one keep theirs
+		if paid.Currency != "" {
+			payment.Currency = strings.ToUpper(paid.Currency)
+		}
 
-	// Step 4: Call Ticketing Service to confirm payment and generate tickets
-	confirmReq := &client.ConfirmPaymentRequest{
-		PaymentID:     payload.ID,
-		PaymentMethod: paymentMethod,
-		Amount:        payload.PaidAmount,
+		if err := s.paymentRepo.MarkPaid(ctx, payment); err != nil {
+			if errors.Is(err, repository.ErrOrderAlreadyPaid) {
+				log.Printf("[WARNING] Order %s was already paid by another attempt, payment %s (attempt %d, %s) needs a manual refund",
+					payment.OrderID, paid.ProviderID, payment.AttemptNumber, paid.Method)
+				return nil
+			}
+			return fmt.Errorf("failed to update payment status: %w", err)
+		}
+		s.statusCache.Invalidate(ctx, payment.OrderID)
+
+		log.Printf("[INFO] Payment marked as paid: %s (order: %s)", payment.ID, payment.OrderID)
 	}
+	s.startSaga(ctx, payment, paid.ProviderID)
 
-	// Check if ticketing client is available
-	if s.ticketingClient == nil {
-		log.Printf("[WARNING] Ticketing Service gRPC client not available, cannot confirm payment for order %s", payment.OrderID)
-		log.Printf("[WARNING] Payment is marked as paid, but tickets need to be generated manually or via retry")
-		// Payment is already marked as paid - this should be retried via background job
-		// TODO: Add to retry queue
+	// A payment that is being refunded must never confirm its order afterwards
+	if s.sagaCompensated(ctx, payment.ID) {
+		log.Printf("[INFO] Payment %s of order %s is refunded instead of applied, confirmation not re-sent", payment.ID, payment.OrderID)
 		return nil
 	}
 
-	if err := s.ticketingClient.ConfirmPayment(payment.OrderID, confirmReq); err != nil {
-		log.Printf("[ERROR] Failed to confirm payment with ticketing service: %v", err)
-		// Don't return error - payment is already marked as paid
-		// This should be retried via background job
-		// TODO: Add to retry queue
-		return nil
+	// Step 2: Call Ticketing Service to confirm payment and generate tickets
+	// The confirmation is tracked first, so the confirmation retry worker picks it up if it doesn't get through
+	confirmation := &entity.PaymentConfirmation{
+		PaymentID:         payment.ID,
+		OrderID:           payment.OrderID,
+		ProviderPaymentID: paid.ProviderID,
+		PaymentMethod:     &paid.Method,
+		Amount:            paid.Amount,
+		Attempts:          1,
+		NextAttemptAt:     time.Now().Add(confirmationRetryBackoff(1)),
+	}
+	if paid.Currency != "" {
+		confirmation.Currency = &paid.Currency
 	}
+	s.trackConfirmation(ctx, confirmation)
 
-	log.Printf("[INFO] Successfully confirmed payment with ticketing service (order: %s)", payment.OrderID)
+	if err := s.confirmOrder(ctx, payment, confirmation); err != nil {
+		// Don't return error - payment is already marked as paid
+		log.Printf("[ERROR] Failed to confirm payment %s with ticketing service, will retry: %v", payment.ID, err)
+		s.scheduleConfirmationRetry(ctx, payment.ID, err, time.Now().Add(confirmationRetryBackoff(1)))
+	}
 	return nil
 }
 
+// handleSubscriptionInvoice passes a plan subscription invoice webhook to the subscription service
+func (s *webhookService) handleSubscriptionInvoice(ctx context.Context, payload *response.XenditWebhookPayload) error {
+	log.Printf("[INFO] Processing subscription invoice webhook: %s (status: %s)", payload.ExternalID, payload.Status)
+
+	if s.subscriptionService == nil {
+		return fmt.Errorf("subscription service not available for invoice %s", payload.ExternalID)
+	}
+
+	return s.subscriptionService.HandleInvoiceWebhook(ctx, payload)
+}
+
 // handleInvoiceExpired handles invoice.expired webhook event
-func (s *webhookService) handleInvoiceExpired(ctx context.Context, payload *response.XenditWebhookPayload) error {
+func (s *webhookService) handleInvoiceExpired(ctx context.Context, payload *response.XenditWebhookPayload, sandbox bool) error {
 	log.Printf("[INFO] Processing invoice.expired webhook for invoice: %s", payload.ID)
 
 	// Get payment transaction by invoice ID
@@ -165,6 +345,9 @@ func (s *webhookService) handleInvoiceExpired(ctx context.Context, payload *resp
 	if err != nil {
 		return fmt.Errorf("payment not found for invoice %s: %w", payload.ID, err)
 	}
+	if payment.IsSandbox != sandbox {
+		return fmt.Errorf("invoice %s: %w", payload.ID, ErrSandboxMismatch)
+	}
 
 	// Only update if still pending
 	if payment.Status == entity.PaymentStatusPending {
@@ -172,6 +355,7 @@ func (s *webhookService) handleInvoiceExpired(ctx context.Context, payload *resp
 		if err := s.paymentRepo.Update(ctx, payment); err != nil {
 			return fmt.Errorf("failed to update payment status: %w", err)
 		}
+		s.statusCache.Invalidate(ctx, payment.OrderID)
 		log.Printf("[INFO] Payment marked as expired: %s (order: %s)", payment.ID, payment.OrderID)
 	}
 
@@ -0,0 +1,1096 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/repository"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubWebhookRepo keeps webhook status in memory; unused methods panic via the nil interface
+type stubWebhookRepo struct {
+	repository.WebhookRepository
+	status map[string]string
+	events map[string]*entity.WebhookEvent // By webhook ID
+}
+
+func (r *stubWebhookRepo) Create(ctx context.Context, webhook *entity.WebhookEvent) error {
+	if _, ok := r.status[webhook.WebhookID]; ok {
+		return repository.ErrDuplicateWebhook
+	}
+	webhook.ID = "id-" + webhook.WebhookID
+	r.status[webhook.WebhookID] = webhook.Status
+	stored := *webhook
+	r.events[webhook.WebhookID] = &stored
+	return nil
+}
+
+func (r *stubWebhookRepo) GetByID(ctx context.Context, id string) (*entity.WebhookEvent, error) {
+	for webhookID, event := range r.events {
+		if event.ID == id {
+			webhook := *event
+			webhook.Status = r.status[webhookID]
+			return &webhook, nil
+		}
+	}
+	return nil, repository.ErrWebhookNotFound
+}
+
+func (r *stubWebhookRepo) MarkAsQuarantined(ctx context.Context, webhookID string, reason string) error {
+	r.status[webhookID] = entity.WebhookStatusQuarantined
+	r.events[webhookID].QuarantineReason = &reason
+	return nil
+}
+
+func (r *stubWebhookRepo) Resolve(ctx context.Context, id string, status string, resolvedBy string) error {
+	webhookID := strings.TrimPrefix(id, "id-")
+	if r.status[webhookID] != entity.WebhookStatusQuarantined {
+		return repository.ErrWebhookNotQuarantined
+	}
+	r.status[webhookID] = status
+	return nil
+}
+
+func (r *stubWebhookRepo) MarkAsProcessed(ctx context.Context, webhookID string) error {
+	r.status[webhookID] = entity.WebhookStatusProcessed
+	return nil
+}
+
+func (r *stubWebhookRepo) MarkAsFailed(ctx context.Context, webhookID string) error {
+	r.status[webhookID] = entity.WebhookStatusFailed
+	return nil
+}
+
+// stubPaymentRepo serves a single payment transaction
+type stubPaymentRepo struct {
+	repository.PaymentRepository
+	payment *entity.PaymentTransaction
+}
+
+func (r *stubPaymentRepo) GetByInvoiceID(ctx context.Context, invoiceID string) (*entity.PaymentTransaction, error) {
+	if r.payment.InvoiceID == nil || *r.payment.InvoiceID != invoiceID {
+		return nil, repository.ErrPaymentNotFound
+	}
+	return r.payment, nil
+}
+
+func (r *stubPaymentRepo) GetByExternalID(ctx context.Context, externalID string) (*entity.PaymentTransaction, error) {
+	if r.payment.ExternalID != externalID {
+		return nil, repository.ErrPaymentNotFound
+	}
+	return r.payment, nil
+}
+
+func (r *stubPaymentRepo) GetByID(ctx context.Context, id string) (*entity.PaymentTransaction, error) {
+	if r.payment.ID != id {
+		return nil, repository.ErrPaymentNotFound
+	}
+	return r.payment, nil
+}
+
+func (r *stubPaymentRepo) Update(ctx context.Context, payment *entity.PaymentTransaction) error {
+	r.payment = payment
+	return nil
+}
+
+func (r *stubPaymentRepo) MarkPaid(ctx context.Context, payment *entity.PaymentTransaction) error {
+	payment.Status = entity.PaymentStatusPaid
+	r.payment = payment
+	return nil
+}
+
+func newWebhookFixture() (*stubWebhookRepo, *stubPaymentRepo) {
+	invoiceID := "inv-123"
+	webhookRepo := &stubWebhookRepo{status: map[string]string{}, events: map[string]*entity.WebhookEvent{}}
+	paymentRepo := &stubPaymentRepo{payment: &entity.PaymentTransaction{
+		ID:         "pay-1",
+		OrderID:    "order-1",
+		ExternalID: "ORDER-order-1",
+		InvoiceID:  &invoiceID,
+		Amount:     money.New(150000),
+		Currency:   "IDR",
+		Status:     entity.PaymentStatusPending,
+	}}
+	return webhookRepo, paymentRepo
+}
+
+func paidPayload(t *testing.T) []byte {
+	t.Helper()
+	payload, err := json.Marshal(response.XenditWebhookPayload{
+		ID:            "inv-123",
+		ExternalID:    "ORDER-order-1",
+		Status:        "PAID",
+		Amount:        money.New(150000),
+		PaidAmount:    money.New(150000),
+		Currency:      "IDR",
+		PaymentMethod: "BANK_TRANSFER",
+		PaidAt:        time.Now(),
+	})
+	require.NoError(t, err)
+	return payload
+}
+
+func TestProcessWebhook_InvoicePaidConfirmsPayment(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	ticketing := &testutil.TicketingClient{}
+	svc := NewWebhookService(webhookRepo, paymentRepo, nil, nil, nil, nil, ticketing, nil, nil)
+
+	err := svc.ProcessWebhook(context.Background(), "wh-1", entity.EventTypeInvoicePaid, paidPayload(t))
+	require.NoError(t, err)
+
+	assert.Equal(t, entity.PaymentStatusPaid, paymentRepo.payment.Status)
+	assert.Equal(t, entity.WebhookStatusProcessed, webhookRepo.status["wh-1"])
+
+	calls := ticketing.ConfirmPaymentCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "order-1", calls[0].OrderID)
+	assert.Equal(t, "inv-123", calls[0].Request.PaymentID)
+	assert.Equal(t, "BANK_TRANSFER", calls[0].Request.PaymentMethod)
+	assert.Equal(t, money.New(150000), calls[0].Request.Amount)
+	assert.Equal(t, "IDR", calls[0].Request.Currency)
+}
+
+func TestProcessWebhook_DuplicateSkipsConfirmation(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	ticketing := &testutil.TicketingClient{}
+	svc := NewWebhookService(webhookRepo, paymentRepo, nil, nil, nil, nil, ticketing, nil, nil)
+
+	require.NoError(t, svc.ProcessWebhook(context.Background(), "wh-1", entity.EventTypeInvoicePaid, paidPayload(t)))
+	err := svc.ProcessWebhook(context.Background(), "wh-1", entity.EventTypeInvoicePaid, paidPayload(t))
+
+	assert.ErrorIs(t, err, ErrDuplicateWebhook)
+	assert.Len(t, ticketing.ConfirmPaymentCalls(), 1)
+}
+
+func TestProcessSandboxWebhook_RejectsLivePayment(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	ticketing := &testutil.TicketingClient{}
+	svc := NewWebhookService(webhookRepo, paymentRepo, nil, nil, nil, nil, ticketing, nil, nil)
+
+	err := svc.ProcessSandboxWebhook(context.Background(), "wh-1", entity.EventTypeInvoicePaid, paidPayload(t))
+
+	assert.ErrorIs(t, err, ErrSandboxMismatch)
+	assert.Equal(t, entity.PaymentStatusPending, paymentRepo.payment.Status)
+	assert.Empty(t, ticketing.ConfirmPaymentCalls())
+}
+
+func TestProcessSandboxWebhook_ConfirmsSandboxPayment(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	paymentRepo.payment.IsSandbox = true
+	ticketing := &testutil.TicketingClient{}
+	svc := NewWebhookService(webhookRepo, paymentRepo, nil, nil, nil, nil, ticketing, nil, nil)
+
+	err := svc.ProcessSandboxWebhook(context.Background(), "wh-1", entity.EventTypeInvoicePaid, paidPayload(t))
+	require.NoError(t, err)
+
+	assert.Equal(t, entity.PaymentStatusPaid, paymentRepo.payment.Status)
+	assert.Len(t, ticketing.ConfirmPaymentCalls(), 1)
+}
+
+func TestProcessWebhook_TicketingFailureKeepsPaymentPaid(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	ticketing := &testutil.TicketingClient{
+		ConfirmPaymentFunc: func(orderID string, req *client.ConfirmPaymentRequest) (*client.ConfirmPaymentResponse, error) {
+			return nil, errors.New("ticketing unavailable")
+		},
+	}
+	svc := NewWebhookService(webhookRepo, paymentRepo, nil, nil, nil, nil, ticketing, nil, nil)
+
+	err := svc.ProcessWebhook(context.Background(), "wh-1", entity.EventTypeInvoicePaid, paidPayload(t))
+	require.NoError(t, err)
+
+	assert.Equal(t, entity.PaymentStatusPaid, paymentRepo.payment.Status)
+	assert.Equal(t, entity.WebhookStatusProcessed, webhookRepo.status["wh-1"])
+	assert.Len(t, ticketing.ConfirmPaymentCalls(), 1)
+}
+
+func TestProcessWebhook_NilTicketingClient(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	svc := NewWebhookService(webhookRepo, paymentRepo, nil, nil, nil, nil, nil, nil, nil)
+
+	err := svc.ProcessWebhook(context.Background(), "wh-1", entity.EventTypeInvoicePaid, paidPayload(t))
+	require.NoError(t, err)
+	assert.Equal(t, entity.PaymentStatusPaid, paymentRepo.payment.Status)
+}
+
+func TestProcessWebhook_RetryForPaidPaymentResendsConfirmation(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	ticketing := &testutil.TicketingClient{}
+	svc := NewWebhookService(webhookRepo, paymentRepo, nil, nil, nil, nil, ticketing, nil, nil)
+
+	require.NoError(t, svc.ProcessWebhook(context.Background(), "wh-1", entity.EventTypeInvoicePaid, paidPayload(t)))
+	paidAt := paymentRepo.payment.PaidAt
+
+	// Provider retry delivered under a new webhook ID
+	err := svc.ProcessWebhook(context.Background(), "wh-2", entity.EventTypeInvoicePaid, paidPayload(t))
+	require.NoError(t, err)
+
+	assert.Equal(t, entity.WebhookStatusProcessed, webhookRepo.status["wh-2"])
+	assert.Equal(t, paidAt, paymentRepo.payment.PaidAt)
+
+	calls := ticketing.ConfirmPaymentCalls()
+	require.Len(t, calls, 2)
+	assert.Equal(t, calls[0].Request.PaymentID, calls[1].Request.PaymentID)
+}
+
+func TestWebhookEventType(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"paid invoice", `{"id":"inv-1","status":"PAID"}`, entity.EventTypeInvoicePaid},
+		{"settled invoice", `{"id":"inv-1","status":"SETTLED"}`, entity.EventTypeInvoicePaid},
+		{"expired invoice", `{"id":"inv-1","status":"EXPIRED"}`, entity.EventTypeInvoiceExpired},
+		{"pending invoice", `{"id":"inv-1","status":"PENDING"}`, entity.EventTypeUnknown},
+		{"virtual account created", `{"id":"va-1","account_number":"88089999","bank_code":"BCA","status":"ACTIVE"}`, entity.EventTypeVirtualAccountCreated},
+		{"virtual account paid", `{"id":"va-1","account_number":"88089999","payment_id":"p-1"}`, entity.EventTypeUnknown},
+		{"QR payment", `{"event":"qr.payment","data":{"status":"SUCCEEDED"}}`, entity.EventTypeQRPaid},
+		{"card declined", `{"id":"ch-1","masked_card_number":"400000XXXXXX0002","status":"FAILED"}`, entity.EventTypeCardFailed},
+		{"card captured", `{"id":"ch-1","masked_card_number":"400000XXXXXX0002","status":"CAPTURED"}`, entity.EventTypeUnknown},
+		{"refund succeeded", `{"event":"refund.succeeded","data":{}}`, entity.EventTypeRefundSucceeded},
+		{"refund failed", `{"event":"refund.failed","data":{}}`, entity.EventTypeRefundFailed},
+		{"other event", `{"event":"ewallet.capture","data":{}}`, entity.EventTypeUnknown},
+		{"not JSON", `status=PAID`, entity.EventTypeUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, WebhookEventType([]byte(tt.body)))
+		})
+	}
+}
+
+func TestProcessWebhook_QRPaymentConfirmsPayment(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	ticketing := &testutil.TicketingClient{}
+	svc := NewWebhookService(webhookRepo, paymentRepo, nil, nil, nil, nil, ticketing, nil, nil)
+
+	payload := []byte(`{"event":"qr.payment","data":{"id":"qrpy-1","reference_id":"ORDER-order-1","amount":150000,"currency":"IDR","status":"SUCCEEDED","created":"2026-10-16T10:00:00Z"}}`)
+	require.NoError(t, svc.ProcessWebhook(context.Background(), "wh-1", WebhookEventType(payload), payload))
+
+	assert.Equal(t, entity.PaymentStatusPaid, paymentRepo.payment.Status)
+	assert.Equal(t, "QRIS", *paymentRepo.payment.PaymentMethod)
+
+	calls := ticketing.ConfirmPaymentCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "qrpy-1", calls[0].Request.PaymentID)
+	assert.Equal(t, money.New(150000), calls[0].Request.Amount)
+}
+
+func TestProcessWebhook_CardFailedThenPaidInvoice(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	ticketing := &testutil.TicketingClient{}
+	svc := NewWebhookService(webhookRepo, paymentRepo, nil, nil, nil, nil, ticketing, nil, nil)
+
+	payload := []byte(`{"id":"ch-1","external_id":"ORDER-order-1","status":"FAILED","masked_card_number":"400000XXXXXX0002","failure_reason":"CARD_DECLINED"}`)
+	require.NoError(t, svc.ProcessWebhook(context.Background(), "wh-1", WebhookEventType(payload), payload))
+	assert.Equal(t, entity.PaymentStatusFailed, paymentRepo.payment.Status)
+	assert.Empty(t, ticketing.ConfirmPaymentCalls())
+
+	// The buyer paid the invoice another way
+	require.NoError(t, svc.ProcessWebhook(context.Background(), "wh-2", entity.EventTypeInvoicePaid, paidPayload(t)))
+	assert.Equal(t, entity.PaymentStatusPaid, paymentRepo.payment.Status)
+	assert.Len(t, ticketing.ConfirmPaymentCalls(), 1)
+}
+
+func TestProcessWebhook_RefundSucceededCompletesRefund(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	refundRepo := &stubRefundRepo{refunds: []*entity.Refund{{
+		ID:                   "refund-1",
+		OrderID:              "order-1",
+		PaymentTransactionID: "pay-1",
+		Status:               entity.RefundStatusProcessing,
+	}}}
+	svc := NewWebhookService(webhookRepo, paymentRepo, refundRepo, nil, nil, nil, nil, nil, nil)
+
+	payload := []byte(`{"event":"refund.succeeded","data":{"id":"rfd-1","reference_id":"refund-1","status":"SUCCEEDED"}}`)
+	require.NoError(t, svc.ProcessWebhook(context.Background(), "wh-1", WebhookEventType(payload), payload))
+
+	refund := refundRepo.refunds[0]
+	assert.Equal(t, entity.RefundStatusCompleted, refund.Status)
+	assert.NotNil(t, refund.ProcessedAt)
+	assert.Equal(t, "rfd-1", *refund.ProviderRefundID)
+
+	// A failed callback for a refund that completed changes nothing
+	payload = []byte(`{"event":"refund.failed","data":{"id":"rfd-1","reference_id":"refund-1","failure_code":"REFUND_FAILED"}}`)
+	require.NoError(t, svc.ProcessWebhook(context.Background(), "wh-2", WebhookEventType(payload), payload))
+	assert.Equal(t, entity.RefundStatusCompleted, refund.Status)
+}
+
+func TestProcessWebhook_UnmatchedIsQuarantinedUntilReplayed(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	ticketing := &testutil.TicketingClient{}
+	svc := NewWebhookService(webhookRepo, paymentRepo, nil, nil, nil, nil, ticketing, nil, nil)
+	ctx := context.Background()
+
+	// The callback arrives before the payment has its invoice ID
+	*paymentRepo.payment.InvoiceID = "inv-other"
+	err := svc.ProcessWebhook(ctx, "wh-1", entity.EventTypeInvoicePaid, paidPayload(t))
+	assert.ErrorIs(t, err, ErrWebhookQuarantined)
+	assert.Equal(t, entity.WebhookStatusQuarantined, webhookRepo.status["wh-1"])
+	assert.Contains(t, *webhookRepo.events["wh-1"].QuarantineReason, "inv-123")
+
+	_, err = svc.ReplayQuarantined(ctx, "admin-1", "id-wh-1")
+	assert.ErrorIs(t, err, ErrWebhookUnmatched)
+	assert.Equal(t, entity.WebhookStatusQuarantined, webhookRepo.status["wh-1"])
+
+	*paymentRepo.payment.InvoiceID = "inv-123"
+	webhook, err := svc.ReplayQuarantined(ctx, "admin-1", "id-wh-1")
+	require.NoError(t, err)
+	assert.Equal(t, entity.WebhookStatusProcessed, webhook.Status)
+	assert.Equal(t, "admin-1", *webhook.ResolvedBy)
+	assert.Equal(t, entity.PaymentStatusPaid, paymentRepo.payment.Status)
+	assert.Len(t, ticketing.ConfirmPaymentCalls(), 1)
+
+	_, err = svc.DismissQuarantined(ctx, "admin-1", "id-wh-1")
+	assert.ErrorIs(t, err, ErrWebhookNotQuarantined)
+	_, err = svc.DismissQuarantined(ctx, "admin-1", "id-missing")
+	assert.ErrorIs(t, err, ErrWebhookNotFound)
+}
+
+func TestProcessWebhook_UnknownEventIsQuarantined(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	svc := NewWebhookService(webhookRepo, paymentRepo, nil, nil, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	payload := []byte(`{"event":"ewallet.capture","data":{"reference_id":"ORDER-order-1"}}`)
+	err := svc.ProcessWebhook(ctx, "wh-1", WebhookEventType(payload), payload)
+	assert.ErrorIs(t, err, ErrWebhookQuarantined)
+	assert.Equal(t, entity.PaymentStatusPending, paymentRepo.payment.Status)
+
+	webhook, err := svc.DismissQuarantined(ctx, "admin-1", "id-wh-1")
+	require.NoError(t, err)
+	assert.Equal(t, entity.WebhookStatusDismissed, webhook.Status)
+	assert.Equal(t, entity.WebhookStatusDismissed, webhookRepo.status["wh-1"])
+}
+
+func TestProcessWebhook_PaymentOfCancelledOrderIsRefunded(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	refundRepo := &stubRefundRepo{}
+	xendit := &testutil.XenditClient{}
+	ticketing := &testutil.TicketingClient{
+		ConfirmPaymentFunc: func(orderID string, req *client.ConfirmPaymentRequest) (*client.ConfirmPaymentResponse, error) {
+			return &client.ConfirmPaymentResponse{
+				Outcome:      client.ConfirmationRefundFlagged,
+				RefundReason: client.RefundReasonOrderCancelled,
+			}, nil
+		},
+	}
+	refunds := NewRefundService(paymentRepo, refundRepo, ticketing, xendit, nil)
+	svc := NewWebhookService(webhookRepo, paymentRepo, refundRepo, nil, nil, refunds, ticketing, nil, nil)
+
+	require.NoError(t, svc.ProcessWebhook(context.Background(), "wh-1", entity.EventTypeInvoicePaid, paidPayload(t)))
+	assert.Equal(t, entity.PaymentStatusPaid, paymentRepo.payment.Status)
+
+	calls := xendit.CreateRefundCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "inv-123", calls[0].InvoiceID)
+	assert.Equal(t, money.New(150000), calls[0].Amount)
+	assert.Equal(t, "CANCELLATION", calls[0].Reason)
+	require.Len(t, refundRepo.refunds, 1)
+	assert.Equal(t, "order_cancelled", refundRepo.refunds[0].Reason)
+	assert.Empty(t, ticketing.RefundOrderCalls(), "the cancelled order is not refunded again")
+
+	// Provider retry doesn't refund the payment twice
+	require.NoError(t, svc.ProcessWebhook(context.Background(), "wh-2", entity.EventTypeInvoicePaid, paidPayload(t)))
+	assert.Len(t, xendit.CreateRefundCalls(), 1)
+	assert.Len(t, refundRepo.refunds, 1)
+}
+
+func TestProcessWebhook_PaymentOfSoldOutOrderIsRefunded(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	refundRepo := &stubRefundRepo{}
+	xendit := &testutil.XenditClient{}
+	ticketing := &testutil.TicketingClient{
+		ConfirmPaymentFunc: func(orderID string, req *client.ConfirmPaymentRequest) (*client.ConfirmPaymentResponse, error) {
+			return &client.ConfirmPaymentResponse{
+				Outcome:      client.ConfirmationRefundFlagged,
+				RefundReason: client.RefundReasonSoldOut,
+			}, nil
+		},
+	}
+	refunds := NewRefundService(paymentRepo, refundRepo, ticketing, xendit, nil)
+	svc := NewWebhookService(webhookRepo, paymentRepo, refundRepo, nil, nil, refunds, ticketing, nil, nil)
+
+	require.NoError(t, svc.ProcessWebhook(context.Background(), "wh-1", entity.EventTypeInvoicePaid, paidPayload(t)))
+
+	confirmations := ticketing.ConfirmPaymentCalls()
+	require.Len(t, confirmations, 1)
+	assert.True(t, confirmations[0].Request.InvoicePending, "paid before the invoice expired")
+
+	calls := xendit.CreateRefundCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "inv-123", calls[0].InvoiceID)
+	assert.Equal(t, "OTHERS", calls[0].Reason)
+	require.Len(t, refundRepo.refunds, 1)
+	assert.Equal(t, "sold_out", refundRepo.refunds[0].Reason)
+	assert.Equal(t, []string{"inv-123"}, ticketing.RecordRefundCalls())
+}
+
+func TestProcessWebhook_FailedSoldOutRefundIsNotRecorded(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	xendit := &testutil.XenditClient{
+		CreateRefundFunc: func(req *request.XenditCreateRefundRequest) (*response.XenditRefundResponse, error) {
+			return nil, errors.New("xendit unavailable")
+		},
+	}
+	ticketing := &testutil.TicketingClient{
+		ConfirmPaymentFunc: func(orderID string, req *client.ConfirmPaymentRequest) (*client.ConfirmPaymentResponse, error) {
+			return &client.ConfirmPaymentResponse{
+				Outcome:      client.ConfirmationRefundFlagged,
+				RefundReason: client.RefundReasonSoldOut,
+			}, nil
+		},
+	}
+	refundRepo := &stubRefundRepo{}
+	refunds := NewRefundService(paymentRepo, refundRepo, ticketing, xendit, nil)
+	svc := NewWebhookService(webhookRepo, paymentRepo, refundRepo, nil, nil, refunds, ticketing, nil, nil)
+
+	require.NoError(t, svc.ProcessWebhook(context.Background(), "wh-1", entity.EventTypeInvoicePaid, paidPayload(t)))
+
+	assert.Len(t, xendit.CreateRefundCalls(), 1)
+	assert.Empty(t, ticketing.RecordRefundCalls(), "the refund request stays pending for a manual refund")
+}
+
+func TestInvoicePending(t *testing.T) {
+	expiresAt := time.Now()
+	before := expiresAt.Add(-time.Minute)
+	after := expiresAt.Add(time.Minute)
+
+	assert.True(t, invoicePending(&entity.PaymentTransaction{PaidAt: &before, ExpiresAt: &expiresAt}))
+	assert.False(t, invoicePending(&entity.PaymentTransaction{PaidAt: &after, ExpiresAt: &expiresAt}))
+	assert.True(t, invoicePending(&entity.PaymentTransaction{PaidAt: &before}), "invoices without an expiry never lapse")
+	assert.False(t, invoicePending(&entity.PaymentTransaction{ExpiresAt: &expiresAt}), "not paid yet")
+}
+
+// stubConfirmationRepo keeps order confirmations in memory
+type stubConfirmationRepo struct {
+	repository.PaymentConfirmationRepository
+	confirmations map[string]*entity.PaymentConfirmation // By payment ID
+	payments      []*entity.PaymentTransaction           // Looked through by TrackUntracked
+}
+
+func newStubConfirmationRepo() *stubConfirmationRepo {
+	return &stubConfirmationRepo{confirmations: map[string]*entity.PaymentConfirmation{}}
+}
+
+func (r *stubConfirmationRepo) Create(ctx context.Context, confirmation *entity.PaymentConfirmation) error {
+	if _, ok := r.confirmations[confirmation.PaymentID]; ok {
+		return nil
+	}
+	stored := *confirmation
+	stored.Status = entity.ConfirmationStatusPending
+	r.confirmations[confirmation.PaymentID] = &stored
+	return nil
+}
+
+func (r *stubConfirmationRepo) TrackUntracked(ctx context.Context, paidAfter, paidBefore time.Time, limit int) (int, error) {
+	tracked := 0
+	for _, payment := range r.payments {
+		if !payment.IsPaid() || payment.PaidAt == nil || !payment.PaidAt.After(paidAfter) || payment.PaidAt.After(paidBefore) {
+			continue
+		}
+		if _, ok := r.confirmations[payment.ID]; ok || tracked == limit {
+			continue
+		}
+		currency := payment.Currency
+		r.confirmations[payment.ID] = &entity.PaymentConfirmation{
+			PaymentID:         payment.ID,
+			OrderID:           payment.OrderID,
+			ProviderPaymentID: *payment.InvoiceID,
+			PaymentMethod:     payment.PaymentMethod,
+			Amount:            payment.Amount,
+			Currency:          &currency,
+			Status:            entity.ConfirmationStatusPending,
+			NextAttemptAt:     time.Now(),
+		}
+		tracked++
+	}
+	return tracked, nil
+}
+
+func (r *stubConfirmationRepo) GetByPaymentID(ctx context.Context, paymentID string) (*entity.PaymentConfirmation, error) {
+	confirmation, ok := r.confirmations[paymentID]
+	if !ok {
+		return nil, repository.ErrConfirmationNotFound
+	}
+	found := *confirmation
+	return &found, nil
+}
+
+func (r *stubConfirmationRepo) ClaimDue(ctx context.Context, limit int, lockedUntil time.Time) ([]*entity.PaymentConfirmation, error) {
+	var due []*entity.PaymentConfirmation
+	for _, confirmation := range r.confirmations {
+		if confirmation.Status == entity.ConfirmationStatusPending && !confirmation.NextAttemptAt.After(time.Now()) {
+			confirmation.Attempts++
+			confirmation.NextAttemptAt = lockedUntil
+			claimed := *confirmation
+			due = append(due, &claimed)
+		}
+	}
+	return due, nil
+}
+
+func (r *stubConfirmationRepo) MarkConfirmed(ctx context.Context, paymentID, outcome string) error {
+	confirmation := r.confirmations[paymentID]
+	confirmation.Status = entity.ConfirmationStatusConfirmed
+	confirmation.Outcome = &outcome
+	now := time.Now()
+	confirmation.ConfirmedAt = &now
+	return nil
+}
+
+func (r *stubConfirmationRepo) Retry(ctx context.Context, paymentID, lastError string, nextAttemptAt time.Time) error {
+	confirmation := r.confirmations[paymentID]
+	if confirmation.Status != entity.ConfirmationStatusConfirmed {
+		confirmation.Status = entity.ConfirmationStatusPending
+	}
+	confirmation.LastError = &lastError
+	confirmation.NextAttemptAt = nextAttemptAt
+	return nil
+}
+
+func (r *stubConfirmationRepo) MarkFailed(ctx context.Context, paymentID, lastError string) error {
+	confirmation := r.confirmations[paymentID]
+	confirmation.Status = entity.ConfirmationStatusFailed
+	confirmation.LastError = &lastError
+	return nil
+}
+
+func TestProcessWebhook_TicketingFailureIsRetried(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	confirmationRepo := newStubConfirmationRepo()
+	available := false
+	ticketing := &testutil.TicketingClient{
+		ConfirmPaymentFunc: func(orderID string, req *client.ConfirmPaymentRequest) (*client.ConfirmPaymentResponse, error) {
+			if !available {
+				return nil, errors.New("ticketing unavailable")
+			}
+			return &client.ConfirmPaymentResponse{Outcome: client.ConfirmationConfirmed}, nil
+		},
+	}
+	svc := NewWebhookService(webhookRepo, paymentRepo, nil, confirmationRepo, nil, nil, ticketing, nil, nil)
+
+	require.NoError(t, svc.ProcessWebhook(context.Background(), "wh-1", entity.EventTypeInvoicePaid, paidPayload(t)))
+
+	confirmation := confirmationRepo.confirmations["pay-1"]
+	require.NotNil(t, confirmation)
+	assert.Equal(t, entity.ConfirmationStatusPending, confirmation.Status)
+	assert.Equal(t, 1, confirmation.Attempts)
+	require.NotNil(t, confirmation.LastError)
+	assert.Contains(t, *confirmation.LastError, "ticketing unavailable")
+	assert.True(t, confirmation.NextAttemptAt.After(time.Now()))
+
+	// Not due yet
+	confirmed, err := svc.RetryConfirmations(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, confirmed)
+
+	available = true
+	confirmation.NextAttemptAt = time.Now().Add(-time.Second)
+	confirmed, err = svc.RetryConfirmations(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, confirmed)
+
+	assert.Equal(t, entity.ConfirmationStatusConfirmed, confirmation.Status)
+	assert.Equal(t, 2, confirmation.Attempts)
+	calls := ticketing.ConfirmPaymentCalls()
+	require.Len(t, calls, 2)
+	assert.Equal(t, "inv-123", calls[1].Request.PaymentID)
+	assert.Equal(t, "BANK_TRANSFER", calls[1].Request.PaymentMethod)
+	assert.Equal(t, "IDR", calls[1].Request.Currency)
+	assert.Equal(t, money.New(150000), calls[1].Request.Amount)
+}
+
+func TestProcessWebhook_ConfirmedPaymentIsNotRetried(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	confirmationRepo := newStubConfirmationRepo()
+	ticketing := &testutil.TicketingClient{}
+	svc := NewWebhookService(webhookRepo, paymentRepo, nil, confirmationRepo, nil, nil, ticketing, nil, nil)
+
+	require.NoError(t, svc.ProcessWebhook(context.Background(), "wh-1", entity.EventTypeInvoicePaid, paidPayload(t)))
+
+	confirmation := confirmationRepo.confirmations["pay-1"]
+	require.NotNil(t, confirmation)
+	assert.True(t, confirmation.IsConfirmed())
+	require.NotNil(t, confirmation.Outcome)
+	assert.Equal(t, client.ConfirmationConfirmed, *confirmation.Outcome)
+
+	confirmation.NextAttemptAt = time.Now().Add(-time.Second)
+	confirmed, err := svc.RetryConfirmations(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, confirmed)
+	assert.Len(t, ticketing.ConfirmPaymentCalls(), 1)
+}
+
+func TestRetryConfirmations_GivesUpAfterMaxAttempts(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	paymentRepo.payment.Status = entity.PaymentStatusPaid
+	confirmationRepo := newStubConfirmationRepo()
+	confirmationRepo.confirmations["pay-1"] = &entity.PaymentConfirmation{
+		PaymentID:         "pay-1",
+		OrderID:           "order-1",
+		ProviderPaymentID: "inv-123",
+		Amount:            money.New(150000),
+		Status:            entity.ConfirmationStatusPending,
+		Attempts:          confirmationMaxAttempts - 1,
+		NextAttemptAt:     time.Now().Add(-time.Second),
+	}
+	ticketing := &testutil.TicketingClient{
+		ConfirmPaymentFunc: func(orderID string, req *client.ConfirmPaymentRequest) (*client.ConfirmPaymentResponse, error) {
+			return nil, errors.New("ticketing unavailable")
+		},
+	}
+	svc := NewWebhookService(webhookRepo, paymentRepo, nil, confirmationRepo, nil, nil, ticketing, nil, nil)
+
+	confirmed, err := svc.RetryConfirmations(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, confirmed)
+
+	confirmation := confirmationRepo.confirmations["pay-1"]
+	assert.Equal(t, entity.ConfirmationStatusFailed, confirmation.Status)
+	assert.Equal(t, confirmationMaxAttempts, confirmation.Attempts)
+	require.NotNil(t, confirmation.LastError)
+	assert.Contains(t, *confirmation.LastError, "ticketing unavailable")
+}
+
+func TestRetryConfirmations_TracksUntrackedPayments(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	paidAt := time.Now().Add(-time.Hour)
+	paymentRepo.payment.Status = entity.PaymentStatusPaid
+	paymentRepo.payment.PaidAt = &paidAt
+	paymentRepo.payment.Currency = "IDR"
+	confirmationRepo := newStubConfirmationRepo()
+	confirmationRepo.payments = []*entity.PaymentTransaction{paymentRepo.payment}
+	ticketing := &testutil.TicketingClient{}
+	svc := NewWebhookService(webhookRepo, paymentRepo, nil, confirmationRepo, nil, nil, ticketing, nil, nil)
+
+	// The webhook marked the payment paid but crashed before tracking its confirmation
+	confirmed, err := svc.RetryConfirmations(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, confirmed)
+
+	confirmation := confirmationRepo.confirmations["pay-1"]
+	require.NotNil(t, confirmation)
+	assert.Equal(t, entity.ConfirmationStatusConfirmed, confirmation.Status)
+	calls := ticketing.ConfirmPaymentCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "order-1", calls[0].OrderID)
+	assert.Equal(t, "inv-123", calls[0].Request.PaymentID)
+	assert.Equal(t, "IDR", calls[0].Request.Currency)
+
+	// Tracked once
+	confirmed, err = svc.RetryConfirmations(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, confirmed)
+	assert.Len(t, ticketing.ConfirmPaymentCalls(), 1)
+}
+
+func TestRetryConfirmations_LeavesRunningWebhooksAlone(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	paidAt := time.Now()
+	paymentRepo.payment.Status = entity.PaymentStatusPaid
+	paymentRepo.payment.PaidAt = &paidAt
+	confirmationRepo := newStubConfirmationRepo()
+	confirmationRepo.payments = []*entity.PaymentTransaction{paymentRepo.payment}
+	ticketing := &testutil.TicketingClient{}
+	svc := NewWebhookService(webhookRepo, paymentRepo, nil, confirmationRepo, nil, nil, ticketing, nil, nil)
+
+	// Just paid, the webhook may still be tracking it
+	confirmed, err := svc.RetryConfirmations(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, confirmed)
+	assert.Empty(t, confirmationRepo.confirmations)
+	assert.Empty(t, ticketing.ConfirmPaymentCalls())
+}
+
+func TestReplayConfirmation(t *testing.T) {
+	t.Run("failed confirmation is confirmed again", func(t *testing.T) {
+		webhookRepo, paymentRepo := newWebhookFixture()
+		paymentRepo.payment.Status = entity.PaymentStatusPaid
+		confirmationRepo := newStubConfirmationRepo()
+		confirmationRepo.confirmations["pay-1"] = &entity.PaymentConfirmation{
+			PaymentID:         "pay-1",
+			OrderID:           "order-1",
+			ProviderPaymentID: "inv-123",
+			Amount:            money.New(150000),
+			Status:            entity.ConfirmationStatusFailed,
+			Attempts:          confirmationMaxAttempts,
+		}
+		ticketing := &testutil.TicketingClient{}
+		svc := NewWebhookService(webhookRepo, paymentRepo, nil, confirmationRepo, nil, nil, ticketing, nil, nil)
+
+		result, err := svc.ReplayConfirmation(context.Background(), "admin-1", "pay-1")
+		require.NoError(t, err)
+		assert.Equal(t, entity.ConfirmationStatusConfirmed, result.Status)
+		require.NotNil(t, result.ConfirmedAt)
+		require.Len(t, ticketing.ConfirmPaymentCalls(), 1)
+		assert.Equal(t, "inv-123", ticketing.ConfirmPaymentCalls()[0].Request.PaymentID)
+	})
+
+	t.Run("untracked payment is confirmed from the payment", func(t *testing.T) {
+		webhookRepo, paymentRepo := newWebhookFixture()
+		paymentRepo.payment.Status = entity.PaymentStatusPaid
+		confirmationRepo := newStubConfirmationRepo()
+		ticketing := &testutil.TicketingClient{}
+		svc := NewWebhookService(webhookRepo, paymentRepo, nil, confirmationRepo, nil, nil, ticketing, nil, nil)
+
+		result, err := svc.ReplayConfirmation(context.Background(), "admin-1", "pay-1")
+		require.NoError(t, err)
+		assert.Equal(t, "order-1", result.OrderID)
+		assert.Equal(t, entity.ConfirmationStatusConfirmed, result.Status)
+		require.Len(t, ticketing.ConfirmPaymentCalls(), 1)
+		assert.Equal(t, "order-1", ticketing.ConfirmPaymentCalls()[0].OrderID)
+		assert.Equal(t, "inv-123", ticketing.ConfirmPaymentCalls()[0].Request.PaymentID)
+	})
+
+	t.Run("failure is scheduled for retry", func(t *testing.T) {
+		webhookRepo, paymentRepo := newWebhookFixture()
+		paymentRepo.payment.Status = entity.PaymentStatusPaid
+		confirmationRepo := newStubConfirmationRepo()
+		ticketing := &testutil.TicketingClient{
+			ConfirmPaymentFunc: func(orderID string, req *client.ConfirmPaymentRequest) (*client.ConfirmPaymentResponse, error) {
+				return nil, errors.New("ticketing unavailable")
+			},
+		}
+		svc := NewWebhookService(webhookRepo, paymentRepo, nil, confirmationRepo, nil, nil, ticketing, nil, nil)
+
+		_, err := svc.ReplayConfirmation(context.Background(), "admin-1", "pay-1")
+		assert.ErrorIs(t, err, ErrConfirmationFailed)
+
+		confirmation := confirmationRepo.confirmations["pay-1"]
+		require.NotNil(t, confirmation)
+		assert.Equal(t, entity.ConfirmationStatusPending, confirmation.Status)
+		assert.True(t, confirmation.NextAttemptAt.After(time.Now()))
+	})
+
+	t.Run("unpaid payment", func(t *testing.T) {
+		webhookRepo, paymentRepo := newWebhookFixture()
+		ticketing := &testutil.TicketingClient{}
+		svc := NewWebhookService(webhookRepo, paymentRepo, nil, newStubConfirmationRepo(), nil, nil, ticketing, nil, nil)
+
+		_, err := svc.ReplayConfirmation(context.Background(), "admin-1", "pay-1")
+		assert.ErrorIs(t, err, ErrPaymentNotPaid)
+		assert.Empty(t, ticketing.ConfirmPaymentCalls())
+	})
+
+	t.Run("unknown payment", func(t *testing.T) {
+		webhookRepo, paymentRepo := newWebhookFixture()
+		svc := NewWebhookService(webhookRepo, paymentRepo, nil, newStubConfirmationRepo(), nil, nil, &testutil.TicketingClient{}, nil, nil)
+
+		_, err := svc.ReplayConfirmation(context.Background(), "admin-1", "pay-missing")
+		assert.ErrorIs(t, err, ErrPaymentNotFound)
+	})
+}
+
+// stubSagaRepo keeps payment sagas in memory, with the transition guards of the SQL repository
+type stubSagaRepo struct {
+	repository.PaymentSagaRepository
+	sagas map[string]*entity.PaymentSaga // By payment ID
+}
+
+func newStubSagaRepo() *stubSagaRepo {
+	return &stubSagaRepo{sagas: map[string]*entity.PaymentSaga{}}
+}
+
+func (r *stubSagaRepo) Start(ctx context.Context, saga *entity.PaymentSaga) error {
+	if _, ok := r.sagas[saga.PaymentID]; ok {
+		return nil
+	}
+	stored := *saga
+	stored.Step = entity.SagaStepPaymentCaptured
+	stored.Status = entity.SagaStatusRunning
+	stored.CapturedAt = time.Now()
+	r.sagas[saga.PaymentID] = &stored
+	return nil
+}
+
+func (r *stubSagaRepo) GetByPaymentID(ctx context.Context, paymentID string) (*entity.PaymentSaga, error) {
+	saga, ok := r.sagas[paymentID]
+	if !ok {
+		return nil, repository.ErrSagaNotFound
+	}
+	found := *saga
+	return &found, nil
+}
+
+func (r *stubSagaRepo) MarkOrderConfirmed(ctx context.Context, paymentID string, ticketsGenerated int) error {
+	saga, ok := r.sagas[paymentID]
+	if !ok || (saga.Status != entity.SagaStatusRunning && saga.Status != entity.SagaStatusFailed) ||
+		(saga.Step != entity.SagaStepPaymentCaptured && saga.Step != entity.SagaStepOrderConfirmed) {
+		return nil
+	}
+	now := time.Now()
+	saga.OrderConfirmedAt = &now
+	saga.TicketsGenerated = ticketsGenerated
+	saga.Status = entity.SagaStatusRunning
+	switch {
+	case ticketsGenerated == 0:
+		saga.Step = entity.SagaStepOrderConfirmed
+	case saga.EmailSentAt == nil:
+		saga.Step = entity.SagaStepTicketsGenerated
+		saga.TicketsGeneratedAt = &now
+	default:
+		saga.Step = entity.SagaStepEmailSent
+		saga.Status = entity.SagaStatusCompleted
+		saga.TicketsGeneratedAt = &now
+	}
+	return nil
+}
+
+func (r *stubSagaRepo) MarkEmailSent(ctx context.Context, orderID, providerPaymentID string) (bool, error) {
+	recorded := false
+	for _, saga := range r.sagas {
+		if saga.OrderID != orderID || saga.ProviderPaymentID != providerPaymentID || saga.Status != entity.SagaStatusRunning {
+			continue
+		}
+		now := time.Now()
+		saga.EmailSentAt = &now
+		if saga.Step == entity.SagaStepTicketsGenerated {
+			saga.Step = entity.SagaStepEmailSent
+			saga.Status = entity.SagaStatusCompleted
+		}
+		recorded = true
+	}
+	return recorded, nil
+}
+
+func (r *stubSagaRepo) MarkCompensating(ctx context.Context, paymentID, reason, lastError string) error {
+	saga, ok := r.sagas[paymentID]
+	if !ok || (saga.Status != entity.SagaStatusRunning && saga.Status != entity.SagaStatusFailed) {
+		return nil
+	}
+	saga.Status = entity.SagaStatusCompensating
+	saga.CompensationReason = &reason
+	if lastError != "" {
+		saga.LastError = &lastError
+	}
+	return nil
+}
+
+func (r *stubSagaRepo) MarkCompensated(ctx context.Context, paymentID string) error {
+	if saga, ok := r.sagas[paymentID]; ok && saga.Status == entity.SagaStatusCompensating {
+		now := time.Now()
+		saga.Status = entity.SagaStatusCompensated
+		saga.CompensatedAt = &now
+	}
+	return nil
+}
+
+func (r *stubSagaRepo) MarkFailed(ctx context.Context, paymentID, lastError string) error {
+	if saga, ok := r.sagas[paymentID]; ok && saga.Status == entity.SagaStatusCompensating {
+		saga.Status = entity.SagaStatusFailed
+		saga.LastError = &lastError
+	}
+	return nil
+}
+
+func TestPaymentSaga_StepsCompleteInOrder(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	sagaRepo := newStubSagaRepo()
+	ticketing := &testutil.TicketingClient{
+		ConfirmPaymentFunc: func(orderID string, req *client.ConfirmPaymentRequest) (*client.ConfirmPaymentResponse, error) {
+			return &client.ConfirmPaymentResponse{Outcome: client.ConfirmationConfirmed, TicketsGenerated: 2}, nil
+		},
+	}
+	svc := NewWebhookService(webhookRepo, paymentRepo, nil, nil, sagaRepo, nil, ticketing, nil, nil)
+
+	require.NoError(t, svc.ProcessWebhook(context.Background(), "wh-1", entity.EventTypeInvoicePaid, paidPayload(t)))
+
+	saga, err := svc.GetSaga(context.Background(), "pay-1")
+	require.NoError(t, err)
+	assert.Equal(t, entity.SagaStepTicketsGenerated, saga.Step)
+	assert.Equal(t, entity.SagaStatusRunning, saga.Status)
+	assert.Equal(t, 2, saga.TicketsGenerated)
+	assert.Equal(t, "inv-123", saga.ProviderPaymentID)
+	assert.NotNil(t, saga.OrderConfirmedAt)
+	assert.Nil(t, saga.EmailSentAt)
+
+	recorded, err := svc.RecordTicketEmail(context.Background(), "order-1", "inv-123")
+	require.NoError(t, err)
+	assert.True(t, recorded)
+
+	saga, err = svc.GetSaga(context.Background(), "pay-1")
+	require.NoError(t, err)
+	assert.Equal(t, entity.SagaStepEmailSent, saga.Step)
+	assert.Equal(t, entity.SagaStatusCompleted, saga.Status)
+
+	// Provider retry doesn't move the saga back
+	require.NoError(t, svc.ProcessWebhook(context.Background(), "wh-2", entity.EventTypeInvoicePaid, paidPayload(t)))
+	assert.Equal(t, entity.SagaStepEmailSent, sagaRepo.sagas["pay-1"].Step)
+	assert.Equal(t, entity.SagaStatusCompleted, sagaRepo.sagas["pay-1"].Status)
+}
+
+func TestPaymentSaga_EmailReportedBeforeConfirmationAnswer(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	sagaRepo := newStubSagaRepo()
+	var svc WebhookService
+	ticketing := &testutil.TicketingClient{
+		ConfirmPaymentFunc: func(orderID string, req *client.ConfirmPaymentRequest) (*client.ConfirmPaymentResponse, error) {
+			// Ticketing service emails the tickets asynchronously, the report can beat the answer
+			recorded, err := svc.RecordTicketEmail(context.Background(), orderID, req.PaymentID)
+			require.NoError(t, err)
+			assert.True(t, recorded)
+			assert.Equal(t, entity.SagaStepPaymentCaptured, sagaRepo.sagas["pay-1"].Step, "the email step waits for the tickets")
+			return &client.ConfirmPaymentResponse{Outcome: client.ConfirmationConfirmed, TicketsGenerated: 1}, nil
+		},
+	}
+	svc = NewWebhookService(webhookRepo, paymentRepo, nil, nil, sagaRepo, nil, ticketing, nil, nil)
+
+	require.NoError(t, svc.ProcessWebhook(context.Background(), "wh-1", entity.EventTypeInvoicePaid, paidPayload(t)))
+
+	saga := sagaRepo.sagas["pay-1"]
+	assert.Equal(t, entity.SagaStepEmailSent, saga.Step)
+	assert.Equal(t, entity.SagaStatusCompleted, saga.Status)
+}
+
+func TestPaymentSaga_UnknownOrderEmailIsNotRecorded(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	svc := NewWebhookService(webhookRepo, paymentRepo, nil, nil, newStubSagaRepo(), nil, &testutil.TicketingClient{}, nil, nil)
+
+	recorded, err := svc.RecordTicketEmail(context.Background(), "order-1", "manual:TRF-001")
+	require.NoError(t, err)
+	assert.False(t, recorded)
+
+	_, err = svc.GetSaga(context.Background(), "pay-1")
+	assert.ErrorIs(t, err, ErrSagaNotFound)
+}
+
+func TestPaymentSaga_CancelledOrderIsCompensated(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	refundRepo := &stubRefundRepo{}
+	sagaRepo := newStubSagaRepo()
+	xendit := &testutil.XenditClient{}
+	ticketing := &testutil.TicketingClient{
+		ConfirmPaymentFunc: func(orderID string, req *client.ConfirmPaymentRequest) (*client.ConfirmPaymentResponse, error) {
+			return &client.ConfirmPaymentResponse{
+				Outcome:      client.ConfirmationRefundFlagged,
+				RefundReason: client.RefundReasonOrderCancelled,
+			}, nil
+		},
+	}
+	refunds := NewRefundService(paymentRepo, refundRepo, ticketing, xendit, nil)
+	svc := NewWebhookService(webhookRepo, paymentRepo, refundRepo, nil, sagaRepo, refunds, ticketing, nil, nil)
+
+	require.NoError(t, svc.ProcessWebhook(context.Background(), "wh-1", entity.EventTypeInvoicePaid, paidPayload(t)))
+
+	saga := sagaRepo.sagas["pay-1"]
+	assert.Equal(t, entity.SagaStatusCompensated, saga.Status)
+	assert.Equal(t, entity.SagaStepPaymentCaptured, saga.Step)
+	require.NotNil(t, saga.CompensationReason)
+	assert.Equal(t, client.RefundReasonOrderCancelled, *saga.CompensationReason)
+	assert.NotNil(t, saga.CompensatedAt)
+	assert.Len(t, xendit.CreateRefundCalls(), 1)
+
+	// A compensated payment never confirms its order afterwards
+	require.NoError(t, svc.ProcessWebhook(context.Background(), "wh-2", entity.EventTypeInvoicePaid, paidPayload(t)))
+	assert.Len(t, ticketing.ConfirmPaymentCalls(), 1)
+
+	_, err := svc.ReplayConfirmation(context.Background(), "admin-1", "pay-1")
+	assert.ErrorIs(t, err, ErrPaymentCompensated)
+	assert.Len(t, ticketing.ConfirmPaymentCalls(), 1)
+}
+
+func TestPaymentSaga_ManualRefundStaysCompensating(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	sagaRepo := newStubSagaRepo()
+	xendit := &testutil.XenditClient{}
+	ticketing := &testutil.TicketingClient{
+		ConfirmPaymentFunc: func(orderID string, req *client.ConfirmPaymentRequest) (*client.ConfirmPaymentResponse, error) {
+			return &client.ConfirmPaymentResponse{Outcome: client.ConfirmationRefundFlagged, RefundReason: "order_expired"}, nil
+		},
+	}
+	refunds := NewRefundService(paymentRepo, &stubRefundRepo{}, ticketing, xendit, nil)
+	svc := NewWebhookService(webhookRepo, paymentRepo, nil, nil, sagaRepo, refunds, ticketing, nil, nil)
+
+	require.NoError(t, svc.ProcessWebhook(context.Background(), "wh-1", entity.EventTypeInvoicePaid, paidPayload(t)))
+
+	saga := sagaRepo.sagas["pay-1"]
+	assert.Equal(t, entity.SagaStatusCompensating, saga.Status)
+	require.NotNil(t, saga.CompensationReason)
+	assert.Equal(t, "order_expired", *saga.CompensationReason)
+	assert.Empty(t, xendit.CreateRefundCalls(), "ticketing service queued the payment for a manual refund")
+}
+
+func TestPaymentSaga_PermanentlyFailedConfirmationIsRefunded(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	paymentRepo.payment.Status = entity.PaymentStatusPaid
+	refundRepo := &stubRefundRepo{}
+	confirmationRepo := newStubConfirmationRepo()
+	confirmationRepo.confirmations["pay-1"] = &entity.PaymentConfirmation{
+		PaymentID:         "pay-1",
+		OrderID:           "order-1",
+		ProviderPaymentID: "inv-123",
+		Amount:            money.New(150000),
+		Status:            entity.ConfirmationStatusPending,
+		Attempts:          confirmationMaxAttempts - 1,
+		NextAttemptAt:     time.Now().Add(-time.Second),
+	}
+	sagaRepo := newStubSagaRepo()
+	require.NoError(t, sagaRepo.Start(context.Background(), &entity.PaymentSaga{PaymentID: "pay-1", OrderID: "order-1", ProviderPaymentID: "inv-123"}))
+	xendit := &testutil.XenditClient{}
+	ticketing := &testutil.TicketingClient{
+		ConfirmPaymentFunc: func(orderID string, req *client.ConfirmPaymentRequest) (*client.ConfirmPaymentResponse, error) {
+			return nil, errors.New("ticketing unavailable")
+		},
+	}
+	refunds := NewRefundService(paymentRepo, refundRepo, ticketing, xendit, nil)
+	svc := NewWebhookService(webhookRepo, paymentRepo, refundRepo, confirmationRepo, sagaRepo, refunds, ticketing, nil, nil)
+
+	_, err := svc.RetryConfirmations(context.Background())
+	require.NoError(t, err)
+
+	saga := sagaRepo.sagas["pay-1"]
+	assert.Equal(t, entity.SagaStatusCompensated, saga.Status)
+	require.NotNil(t, saga.CompensationReason)
+	assert.Equal(t, entity.CompensationConfirmationFailed, *saga.CompensationReason)
+	require.NotNil(t, saga.LastError)
+	assert.Contains(t, *saga.LastError, "ticketing unavailable")
+
+	calls := xendit.CreateRefundCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "OTHERS", calls[0].Reason)
+	require.Len(t, refundRepo.refunds, 1)
+	assert.Equal(t, entity.CompensationConfirmationFailed, refundRepo.refunds[0].Reason)
+}
+
+func TestPaymentSaga_FailedRefundIsMarkedFailed(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	sagaRepo := newStubSagaRepo()
+	xendit := &testutil.XenditClient{
+		CreateRefundFunc: func(req *request.XenditCreateRefundRequest) (*response.XenditRefundResponse, error) {
+			return nil, errors.New("xendit down")
+		},
+	}
+	ticketing := &testutil.TicketingClient{
+		ConfirmPaymentFunc: func(orderID string, req *client.ConfirmPaymentRequest) (*client.ConfirmPaymentResponse, error) {
+			return &client.ConfirmPaymentResponse{
+				Outcome:      client.ConfirmationRefundFlagged,
+				RefundReason: client.RefundReasonOrderCancelled,
+			}, nil
+		},
+	}
+	refundRepo := &stubRefundRepo{}
+	refunds := NewRefundService(paymentRepo, refundRepo, ticketing, xendit, nil)
+	svc := NewWebhookService(webhookRepo, paymentRepo, refundRepo, nil, sagaRepo, refunds, ticketing, nil, nil)
+
+	require.NoError(t, svc.ProcessWebhook(context.Background(), "wh-1", entity.EventTypeInvoicePaid, paidPayload(t)))
+
+	saga := sagaRepo.sagas["pay-1"]
+	assert.Equal(t, entity.SagaStatusFailed, saga.Status)
+	require.NotNil(t, saga.LastError)
+	assert.Contains(t, *saga.LastError, "xendit down")
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/config"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/repository"
+)
+
+// Default payment method of simulated PAID callbacks
+const simulatedPaymentMethod = "BANK_TRANSFER"
+
+// SimulatedWebhook is a fabricated Xendit callback, sent as-is to the webhook endpoint
+type SimulatedWebhook struct {
+	WebhookID     string
+	CallbackToken string
+	Payload       []byte
+}
+
+// WebhookSimulator fabricates Xendit invoice callbacks for local development,
+// where Xendit can't reach the webhook endpoint
+type WebhookSimulator interface {
+	Simulate(ctx context.Context, req *request.SimulateWebhookRequest) (*SimulatedWebhook, error)
+}
+
+// webhookSimulator implements WebhookSimulator interface
+type webhookSimulator struct {
+	paymentRepo      repository.PaymentRepository
+	webhookToken     string
+	testWebhookToken string
+}
+
+// NewWebhookSimulator creates new webhook simulator instance
+func NewWebhookSimulator(paymentRepo repository.PaymentRepository, cfg *config.Config) WebhookSimulator {
+	return &webhookSimulator{
+		paymentRepo:      paymentRepo,
+		webhookToken:     cfg.Xendit.WebhookToken,
+		testWebhookToken: cfg.Xendit.TestWebhookToken,
+	}
+}
+
+// Simulate builds the callback Xendit would send for the order's invoice
+// The callback carries the token of the payment's mode, so it passes verification like a real one
+func (s *webhookSimulator) Simulate(ctx context.Context, req *request.SimulateWebhookRequest) (*SimulatedWebhook, error) {
+	payment, err := s.paymentRepo.GetByOrderID(ctx, req.OrderID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPaymentNotFound) {
+			return nil, ErrPaymentNotFound
+		}
+		return nil, fmt.Errorf("failed to get payment: %w", err)
+	}
+	if payment.InvoiceID == nil {
+		return nil, ErrPaymentNotFound
+	}
+
+	token := s.webhookToken
+	if payment.IsSandbox {
+		if s.testWebhookToken == "" {
+			return nil, ErrSandboxUnavailable
+		}
+		token = s.testWebhookToken
+	}
+
+	now := time.Now()
+	webhookPayload := response.XenditWebhookPayload{
+		ID:          *payment.InvoiceID,
+		ExternalID:  payment.ExternalID,
+		Status:      req.Status,
+		Amount:      payment.Amount,
+		Currency:    "IDR",
+		Description: "Simulated webhook",
+		Updated:     now,
+		Created:     payment.CreatedAt,
+	}
+	if req.Status == "PAID" {
+		webhookPayload.PaidAmount = payment.Amount
+		webhookPayload.PaymentMethod = req.PaymentMethod
+		if webhookPayload.PaymentMethod == "" {
+			webhookPayload.PaymentMethod = simulatedPaymentMethod
+		}
+		webhookPayload.PaidAt = now
+	}
+
+	body, err := json.Marshal(webhookPayload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode simulated webhook: %w", err)
+	}
+
+	return &SimulatedWebhook{
+		WebhookID:     "SIMULATED-" + uuid.New().String(),
+		CallbackToken: token,
+		Payload:       body,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/config"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSimulatorConfig() *config.Config {
+	return &config.Config{Xendit: config.XenditConfig{WebhookToken: "live-token", TestWebhookToken: "test-token"}}
+}
+
+func TestWebhookSimulator_PaidPayloadConfirmsPayment(t *testing.T) {
+	webhookRepo, paymentRepo := newWebhookFixture()
+	simulator := NewWebhookSimulator(paymentRepo, newSimulatorConfig())
+
+	webhook, err := simulator.Simulate(context.Background(), &request.SimulateWebhookRequest{OrderID: "order-1", Status: "PAID"})
+	require.NoError(t, err)
+	assert.Equal(t, "live-token", webhook.CallbackToken)
+
+	var payload response.XenditWebhookPayload
+	require.NoError(t, json.Unmarshal(webhook.Payload, &payload))
+	assert.Equal(t, "inv-123", payload.ID)
+	assert.Equal(t, money.New(150000), payload.PaidAmount)
+	assert.Equal(t, "BANK_TRANSFER", payload.PaymentMethod)
+
+	// The fabricated payload is accepted by the real webhook pipeline
+	ticketing := &testutil.TicketingClient{}
+	svc := NewWebhookService(webhookRepo, paymentRepo, nil, nil, nil, nil, ticketing, nil, nil)
+	require.NoError(t, svc.ProcessWebhook(context.Background(), webhook.WebhookID, entity.EventTypeInvoicePaid, webhook.Payload))
+	assert.Equal(t, entity.PaymentStatusPaid, paymentRepo.payment.Status)
+	assert.Len(t, ticketing.ConfirmPaymentCalls(), 1)
+}
+
+func TestWebhookSimulator_SandboxPaymentUsesTestToken(t *testing.T) {
+	_, paymentRepo := newWebhookFixture()
+	paymentRepo.payment.IsSandbox = true
+
+	webhook, err := NewWebhookSimulator(paymentRepo, newSimulatorConfig()).Simulate(context.Background(), &request.SimulateWebhookRequest{OrderID: "order-1", Status: "EXPIRED"})
+	require.NoError(t, err)
+	assert.Equal(t, "test-token", webhook.CallbackToken)
+
+	var payload response.XenditWebhookPayload
+	require.NoError(t, json.Unmarshal(webhook.Payload, &payload))
+	assert.Equal(t, "EXPIRED", payload.Status)
+	assert.Empty(t, payload.PaymentMethod)
+}
+
+func TestWebhookSimulator_UnknownOrder(t *testing.T) {
+	_, paymentRepo := newWebhookFixture()
+
+	_, err := NewWebhookSimulator(paymentRepo, newSimulatorConfig()).Simulate(context.Background(), &request.SimulateWebhookRequest{OrderID: "order-2", Status: "PAID"})
+	assert.ErrorIs(t, err, ErrPaymentNotFound)
+}
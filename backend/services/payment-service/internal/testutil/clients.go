@@ -0,0 +1,288 @@
+// Package testutil provides test doubles for payment-service dependencies
+// so services can be unit tested without live gRPC or Xendit connections.
+package testutil
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/payload/response"
+)
+
+// ConfirmPaymentCall is a recorded TicketingClient.ConfirmPayment call
+type ConfirmPaymentCall struct {
+	OrderID string
+	Request *client.ConfirmPaymentRequest
+}
+
+// TicketingClient is a test double for service.TicketingClient
+// Calls are recorded; the Func fields override the default results
+type TicketingClient struct {
+	ConfirmPaymentFunc func(orderID string, req *client.ConfirmPaymentRequest) (*client.ConfirmPaymentResponse, error)
+	RefundOrderFunc    func(req *client.RefundOrderRequest) (*client.RefundOrderResponse, error)
+	CheckRefundFunc    func(orderID, userID string) error
+	RecordRefundFunc   func(orderID, paymentID string) (bool, error)
+
+	mu                sync.Mutex
+	calls             []ConfirmPaymentCall
+	refundOrderCalls  []*client.RefundOrderRequest
+	checkRefundCalls  int
+	recordRefundCalls []string
+}
+
+// ConfirmPayment records the call and returns ConfirmPaymentFunc's result
+// Defaults to a confirmed order
+func (m *TicketingClient) ConfirmPayment(ctx context.Context, orderID string, req *client.ConfirmPaymentRequest) (*client.ConfirmPaymentResponse, error) {
+	m.mu.Lock()
+	m.calls = append(m.calls, ConfirmPaymentCall{OrderID: orderID, Request: req})
+	m.mu.Unlock()
+
+	if m.ConfirmPaymentFunc != nil {
+		return m.ConfirmPaymentFunc(orderID, req)
+	}
+	return &client.ConfirmPaymentResponse{Outcome: client.ConfirmationConfirmed}, nil
+}
+
+// ConfirmPaymentCalls returns recorded ConfirmPayment calls
+func (m *TicketingClient) ConfirmPaymentCalls() []ConfirmPaymentCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]ConfirmPaymentCall(nil), m.calls...)
+}
+
+// RefundOrder records the request and returns RefundOrderFunc's result
+// Defaults to a refunded order without tickets
+func (m *TicketingClient) RefundOrder(ctx context.Context, req *client.RefundOrderRequest) (*client.RefundOrderResponse, error) {
+	m.mu.Lock()
+	m.refundOrderCalls = append(m.refundOrderCalls, req)
+	m.mu.Unlock()
+
+	if m.RefundOrderFunc != nil {
+		return m.RefundOrderFunc(req)
+	}
+	return &client.RefundOrderResponse{}, nil
+}
+
+// RefundOrderCalls returns recorded RefundOrder requests
+func (m *TicketingClient) RefundOrderCalls() []*client.RefundOrderRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*client.RefundOrderRequest(nil), m.refundOrderCalls...)
+}
+
+// CheckRefundEligibility counts the call and returns CheckRefundFunc's result
+// Defaults to an order the buyer may refund
+func (m *TicketingClient) CheckRefundEligibility(ctx context.Context, orderID, userID string) error {
+	m.mu.Lock()
+	m.checkRefundCalls++
+	m.mu.Unlock()
+
+	if m.CheckRefundFunc != nil {
+		return m.CheckRefundFunc(orderID, userID)
+	}
+	return nil
+}
+
+// CheckRefundCalls returns how often CheckRefundEligibility was called
+func (m *TicketingClient) CheckRefundCalls() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.checkRefundCalls
+}
+
+// RecordPaymentRefund records the payment ID and returns RecordRefundFunc's result
+// Defaults to a recorded refund
+func (m *TicketingClient) RecordPaymentRefund(ctx context.Context, orderID, paymentID string) (bool, error) {
+	m.mu.Lock()
+	m.recordRefundCalls = append(m.recordRefundCalls, paymentID)
+	m.mu.Unlock()
+
+	if m.RecordRefundFunc != nil {
+		return m.RecordRefundFunc(orderID, paymentID)
+	}
+	return true, nil
+}
+
+// RecordRefundCalls returns the payment IDs RecordPaymentRefund was called with
+func (m *TicketingClient) RecordRefundCalls() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.recordRefundCalls...)
+}
+
+// XenditClient is a test double for service.XenditClient
+// Calls are recorded; the Func fields override the default results
+type XenditClient struct {
+	CreateInvoiceFunc func(req *request.XenditCreateInvoiceRequest) (*response.XenditInvoiceResponse, error)
+	GetInvoiceFunc    func(invoiceID string) (*response.XenditInvoiceResponse, error)
+	ExpireInvoiceFunc func(invoiceID string) (*response.XenditInvoiceResponse, error)
+	CreateRefundFunc  func(req *request.XenditCreateRefundRequest) (*response.XenditRefundResponse, error)
+
+	mu                 sync.Mutex
+	createInvoiceCalls []*request.XenditCreateInvoiceRequest
+	getInvoiceCalls    []string
+	expireInvoiceCalls []string
+	createRefundCalls  []*request.XenditCreateRefundRequest
+}
+
+// CreateInvoice records the request and returns CreateInvoiceFunc's result
+// Defaults to a pending invoice echoing the request, expiring in a day
+func (m *XenditClient) CreateInvoice(req *request.XenditCreateInvoiceRequest) (*response.XenditInvoiceResponse, error) {
+	m.mu.Lock()
+	m.createInvoiceCalls = append(m.createInvoiceCalls, req)
+	m.mu.Unlock()
+
+	if m.CreateInvoiceFunc != nil {
+		return m.CreateInvoiceFunc(req)
+	}
+	return &response.XenditInvoiceResponse{
+		ID:          "inv-" + req.ExternalID,
+		ExternalID:  req.ExternalID,
+		Status:      "PENDING",
+		Amount:      req.Amount,
+		PayerEmail:  req.PayerEmail,
+		Description: req.Description,
+		InvoiceURL:  "https://checkout.example.com/" + req.ExternalID,
+		ExpiryDate:  time.Now().Add(24 * time.Hour), // Xendit's default invoice duration
+	}, nil
+}
+
+// GetInvoice records the invoice ID and returns GetInvoiceFunc's result
+// Defaults to a pending invoice
+func (m *XenditClient) GetInvoice(invoiceID string) (*response.XenditInvoiceResponse, error) {
+	m.mu.Lock()
+	m.getInvoiceCalls = append(m.getInvoiceCalls, invoiceID)
+	m.mu.Unlock()
+
+	if m.GetInvoiceFunc != nil {
+		return m.GetInvoiceFunc(invoiceID)
+	}
+	return &response.XenditInvoiceResponse{ID: invoiceID, Status: "PENDING"}, nil
+}
+
+// ExpireInvoice records the invoice ID and returns ExpireInvoiceFunc's result
+// Defaults to an expired invoice
+func (m *XenditClient) ExpireInvoice(invoiceID string) (*response.XenditInvoiceResponse, error) {
+	m.mu.Lock()
+	m.expireInvoiceCalls = append(m.expireInvoiceCalls, invoiceID)
+	m.mu.Unlock()
+
+	if m.ExpireInvoiceFunc != nil {
+		return m.ExpireInvoiceFunc(invoiceID)
+	}
+	return &response.XenditInvoiceResponse{ID: invoiceID, Status: "EXPIRED"}, nil
+}
+
+// CreateRefund records the request and returns CreateRefundFunc's result
+// Defaults to a pending refund echoing the request
+func (m *XenditClient) CreateRefund(req *request.XenditCreateRefundRequest) (*response.XenditRefundResponse, error) {
+	m.mu.Lock()
+	m.createRefundCalls = append(m.createRefundCalls, req)
+	m.mu.Unlock()
+
+	if m.CreateRefundFunc != nil {
+		return m.CreateRefundFunc(req)
+	}
+	return &response.XenditRefundResponse{
+		ID:          "rfd-" + req.ReferenceID,
+		InvoiceID:   req.InvoiceID,
+		ReferenceID: req.ReferenceID,
+		Amount:      req.Amount,
+		Currency:    req.Currency,
+		Status:      "PENDING",
+		Reason:      req.Reason,
+	}, nil
+}
+
+// CreateInvoiceCalls returns recorded CreateInvoice requests
+func (m *XenditClient) CreateInvoiceCalls() []*request.XenditCreateInvoiceRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*request.XenditCreateInvoiceRequest(nil), m.createInvoiceCalls...)
+}
+
+// GetInvoiceCalls returns recorded GetInvoice invoice IDs
+func (m *XenditClient) GetInvoiceCalls() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.getInvoiceCalls...)
+}
+
+// ExpireInvoiceCalls returns recorded ExpireInvoice invoice IDs
+func (m *XenditClient) ExpireInvoiceCalls() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.expireInvoiceCalls...)
+}
+
+// CreateRefundCalls returns recorded CreateRefund requests
+func (m *XenditClient) CreateRefundCalls() []*request.XenditCreateRefundRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*request.XenditCreateRefundRequest(nil), m.createRefundCalls...)
+}
+
+// AssignPlanCall is a recorded EventClient.AssignOrganizerPlan call
+type AssignPlanCall struct {
+	OrganizerID string
+	PlanCode    string
+}
+
+// EventClient is a test double for service.EventClient
+// Calls are recorded; AssignOrganizerPlanFunc overrides the default nil result
+type EventClient struct {
+	AssignOrganizerPlanFunc func(organizerID, planCode string) error
+
+	mu    sync.Mutex
+	calls []AssignPlanCall
+}
+
+// AssignOrganizerPlan records the call and returns AssignOrganizerPlanFunc's result
+func (m *EventClient) AssignOrganizerPlan(ctx context.Context, organizerID, planCode string) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, AssignPlanCall{OrganizerID: organizerID, PlanCode: planCode})
+	m.mu.Unlock()
+
+	if m.AssignOrganizerPlanFunc != nil {
+		return m.AssignOrganizerPlanFunc(organizerID, planCode)
+	}
+	return nil
+}
+
+// AssignOrganizerPlanCalls returns recorded AssignOrganizerPlan calls
+func (m *EventClient) AssignOrganizerPlanCalls() []AssignPlanCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]AssignPlanCall(nil), m.calls...)
+}
+
+// NotificationClient is a test double for service.NotificationClient
+// Calls are recorded; SendDunningEmailFunc overrides the default nil result
+type NotificationClient struct {
+	SendDunningEmailFunc func(req *client.DunningEmailRequest) error
+
+	mu    sync.Mutex
+	calls []*client.DunningEmailRequest
+}
+
+// SendDunningEmail records the request and returns SendDunningEmailFunc's result
+func (m *NotificationClient) SendDunningEmail(ctx context.Context, req *client.DunningEmailRequest) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, req)
+	m.mu.Unlock()
+
+	if m.SendDunningEmailFunc != nil {
+		return m.SendDunningEmailFunc(req)
+	}
+	return nil
+}
+
+// SendDunningEmailCalls returns recorded SendDunningEmail requests
+func (m *NotificationClient) SendDunningEmailCalls() []*client.DunningEmailRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*client.DunningEmailRequest(nil), m.calls...)
+}
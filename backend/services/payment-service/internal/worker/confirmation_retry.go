@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/service"
+)
+
+// ConfirmationRetryWorker periodically retries order confirmations of paid payments that didn't reach ticketing service
+type ConfirmationRetryWorker struct {
+	webhookService service.WebhookService
+	interval       time.Duration
+	stopChan       chan struct{}
+}
+
+// NewConfirmationRetryWorker creates new confirmation retry worker instance
+func NewConfirmationRetryWorker(
+	webhookService service.WebhookService,
+	interval time.Duration,
+) *ConfirmationRetryWorker {
+	return &ConfirmationRetryWorker{
+		webhookService: webhookService,
+		interval:       interval,
+		stopChan:       make(chan struct{}),
+	}
+}
+
+// Start begins the confirmation retry worker
+func (w *ConfirmationRetryWorker) Start(ctx context.Context) {
+	log.Printf("[Worker] Confirmation retry worker started (interval: %v)", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	// Retry confirmations immediately on start
+	w.runRetries(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			w.runRetries(ctx)
+		case <-w.stopChan:
+			log.Println("[Worker] Confirmation retry worker stopped")
+			return
+		case <-ctx.Done():
+			log.Println("[Worker] Confirmation retry worker stopped due to context cancellation")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the confirmation retry worker
+func (w *ConfirmationRetryWorker) Stop() {
+	close(w.stopChan)
+}
+
+// runRetries executes one retry pass
+func (w *ConfirmationRetryWorker) runRetries(ctx context.Context) {
+	defer errreport.Recover("confirmation_retry_worker")
+
+	startTime := time.Now()
+	confirmed, err := w.webhookService.RetryConfirmations(ctx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		log.Printf("[Worker] Confirmation retries failed: %v (duration: %v)", err, duration)
+		return
+	}
+
+	if confirmed > 0 {
+		log.Printf("[Worker] Confirmation retries completed: confirmed=%d (duration: %v)", confirmed, duration)
+	}
+}
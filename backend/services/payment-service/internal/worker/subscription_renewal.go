@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/service"
+)
+
+// SubscriptionRenewalWorker periodically invoices plan subscription renewals and runs dunning
+type SubscriptionRenewalWorker struct {
+	subscriptionService service.SubscriptionService
+	interval            time.Duration
+	stopChan            chan struct{}
+}
+
+// NewSubscriptionRenewalWorker creates new subscription renewal worker instance
+func NewSubscriptionRenewalWorker(
+	subscriptionService service.SubscriptionService,
+	interval time.Duration,
+) *SubscriptionRenewalWorker {
+	return &SubscriptionRenewalWorker{
+		subscriptionService: subscriptionService,
+		interval:            interval,
+		stopChan:            make(chan struct{}),
+	}
+}
+
+// Start begins the renewal worker
+func (w *SubscriptionRenewalWorker) Start(ctx context.Context) {
+	log.Printf("[Worker] Subscription renewal worker started (interval: %v)", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	// Run renewals immediately on start
+	w.runRenewals(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			w.runRenewals(ctx)
+		case <-w.stopChan:
+			log.Println("[Worker] Subscription renewal worker stopped")
+			return
+		case <-ctx.Done():
+			log.Println("[Worker] Subscription renewal worker stopped due to context cancellation")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the renewal worker
+func (w *SubscriptionRenewalWorker) Stop() {
+	close(w.stopChan)
+}
+
+// runRenewals executes one renewal pass
+func (w *SubscriptionRenewalWorker) runRenewals(ctx context.Context) {
+	defer errreport.Recover("subscription_renewal_worker")
+
+	startTime := time.Now()
+	result, err := w.subscriptionService.ProcessRenewals(ctx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		log.Printf("[Worker] Subscription renewals failed: %v (duration: %v)", err, duration)
+		return
+	}
+
+	log.Printf("[Worker] Subscription renewals completed: invoiced=%d ended=%d retried=%d canceled=%d (duration: %v)",
+		result.Invoiced, result.Ended, result.Retried, result.Canceled, duration)
+}
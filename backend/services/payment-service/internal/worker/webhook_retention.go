@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/service"
+)
+
+// WebhookRetentionWorker periodically applies retention policy to webhook events
+type WebhookRetentionWorker struct {
+	retentionService service.RetentionService
+	interval         time.Duration
+	stopChan         chan struct{}
+}
+
+// NewWebhookRetentionWorker creates new retention worker instance
+func NewWebhookRetentionWorker(
+	retentionService service.RetentionService,
+	interval time.Duration,
+) *WebhookRetentionWorker {
+	return &WebhookRetentionWorker{
+		retentionService: retentionService,
+		interval:         interval,
+		stopChan:         make(chan struct{}),
+	}
+}
+
+// Start begins the retention worker
+func (w *WebhookRetentionWorker) Start(ctx context.Context) {
+	log.Printf("[Worker] Webhook retention worker started (interval: %v)", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	// Run retention immediately on start
+	w.runRetention(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			w.runRetention(ctx)
+		case <-w.stopChan:
+			log.Println("[Worker] Webhook retention worker stopped")
+			return
+		case <-ctx.Done():
+			log.Println("[Worker] Webhook retention worker stopped due to context cancellation")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the retention worker
+func (w *WebhookRetentionWorker) Stop() {
+	close(w.stopChan)
+}
+
+// runRetention executes one retention pass
+func (w *WebhookRetentionWorker) runRetention(ctx context.Context) {
+	defer errreport.Recover("webhook_retention_worker")
+
+	startTime := time.Now()
+	result, err := w.retentionService.RunRetention(ctx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		log.Printf("[Worker] Webhook retention failed: %v (duration: %v)", err, duration)
+		return
+	}
+
+	log.Printf("[Worker] Webhook retention completed: anonymized=%d soft_deleted=%d purged=%d (duration: %v)",
+		result.Anonymized, result.SoftDeleted, result.Purged, duration)
+}
@@ -1,20 +1,30 @@
 package router
 
 import (
+	"expvar"
+
 	"github.com/gin-gonic/gin"
-	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/config"
+	sharedauth "github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/buildinfo"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/serviceauth"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/internal/controller"
-	"github.com/raflibima25/event-ticketing-platform/backend/services/payment-service/middleware"
 )
 
 // SetupRouter configures all routes for the payment service
+// services authenticates the callers of internal routes, which are rejected when it is nil
 func SetupRouter(
-	cfg *config.Config,
+	keys *sharedauth.KeySet,
+	services *serviceauth.Verifier,
 	paymentController *controller.PaymentController,
 	webhookController *controller.WebhookController,
+	subscriptionController *controller.SubscriptionController,
+	refundController *controller.RefundController,
+	devController *controller.DevController,
 ) *gin.Engine {
 	// Create Gin router
-	router := gin.Default()
+	router := gin.New()
+	router.Use(gin.Logger(), errreport.GinRecovery()) // Panics are reported to Sentry
 
 	// Health check endpoint
 	router.GET("/health", func(c *gin.Context) {
@@ -24,15 +34,55 @@ func SetupRouter(
 		})
 	})
 
+	// Build info (version, git SHA, build time)
+	router.GET("/version", buildinfo.Handler("payment-service"))
+
+	// Runtime metrics (expvar: memstats, webhook retention counters)
+	router.GET("/debug/vars", gin.WrapH(expvar.Handler()))
+
 	// API v1 routes
 	v1 := router.Group("/api/v1")
 	{
 		// Payment routes (protected with JWT)
 		payments := v1.Group("/payments")
-		payments.Use(middleware.JWTAuth(&cfg.JWT))
+		payments.Use(sharedauth.Middleware(keys))
 		{
 			payments.POST("/invoices", paymentController.CreateInvoice)
 			payments.GET("/invoices/:orderId", paymentController.GetInvoice)
+			payments.GET("/status/:orderId", paymentController.GetPaymentStatus) // Polling, cached
+			payments.POST("/refunds", refundController.CreateRefund)             // Buyer refund of a paid order
+		}
+
+		// Organizer plan subscription routes (events:write), billed separately from ticket payments
+		subscriptions := v1.Group("/subscriptions")
+		subscriptions.Use(sharedauth.Middleware(keys), sharedauth.RequireScope(sharedauth.PermEventsWrite))
+		{
+			subscriptions.GET("/plans", subscriptionController.ListPlans) // Paid plans and prices
+			subscriptions.GET("", subscriptionController.GetSubscription) // Organizer's subscription
+			subscriptions.POST("", subscriptionController.Subscribe)      // Subscribe, returns invoice
+			subscriptions.PUT("/plan", subscriptionController.ChangePlan) // Upgrade or downgrade
+			subscriptions.DELETE("", subscriptionController.Cancel)       // Cancel at period end
+		}
+
+		// Webhook quarantine (support:manage): callbacks that matched no payment, refund or subscription
+		// Confirmation replay (support:manage): paid payments whose order ticketing service didn't confirm
+		// Paid-order saga (support:manage): where each paid payment sits, and its compensation
+		admin := v1.Group("/admin")
+		admin.Use(sharedauth.Middleware(keys), sharedauth.RequireScope(sharedauth.PermSupportManage))
+		{
+			admin.GET("/webhooks/quarantine", webhookController.ListQuarantined)                 // Quarantined webhooks (?event_type=&limit=)
+			admin.POST("/webhooks/quarantine/:id/replay", webhookController.ReplayQuarantined)   // Process again once its payment exists
+			admin.POST("/webhooks/quarantine/:id/dismiss", webhookController.DismissQuarantined) // Drop, e.g. test callbacks
+			admin.POST("/payments/:id/replay", webhookController.ReplayConfirmation)             // Confirm the order with ticketing service again
+			admin.GET("/sagas", webhookController.ListSagas)                                     // Payment sagas (?status=&step=&order_id=&limit=)
+			admin.GET("/sagas/:id", webhookController.GetSaga)                                   // Saga of one payment
+		}
+
+		// Internal routes, called by other services with their service token (SERVICE_AUTH_PUBLIC_KEYS)
+		internal := v1.Group("/internal")
+		internal.Use(services.GinMiddleware())
+		{
+			internal.POST("/payments/:id/replay", webhookController.ReplayConfirmation) // Confirmation replay, like the admin route
 		}
 
 		// Webhook routes (public - no JWT, uses signature verification)
@@ -42,5 +92,13 @@ func SetupRouter(
 		}
 	}
 
+	// Development helpers, only set up outside production (see config.WebhookSimulatorEnabled)
+	if devController != nil {
+		dev := router.Group("/dev")
+		{
+			dev.POST("/simulate-webhook", devController.SimulateWebhook) // Fabricate a signed Xendit callback
+		}
+	}
+
 	return router
 }
@@ -14,11 +14,20 @@ import (
 	"github.com/joho/godotenv"
 	"github.com/soheilhy/cmux"
 	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/ticketing"
+	sharedauth "github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/buildinfo"
 	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/faultinject"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/featureflags"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/hotreload"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/interceptor"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/serviceauth"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/config"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/client"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/controller"
 	grpcHandler "github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/grpc"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/router"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
@@ -40,6 +49,13 @@ func main() {
 	// Load configuration
 	cfg := config.Load()
 
+	// Error reporting: panics and alarms go to Sentry when SENTRY_DSN is set, otherwise they are only logged
+	if err := errreport.Init(errreport.ConfigFromEnv("ticketing-service")); err != nil {
+		log.Printf("⚠️  Warning: Error reporting disabled: %v", err)
+	}
+	defer errreport.Flush(2 * time.Second)
+	log.Printf("Build: %s", buildinfo.Get("ticketing-service"))
+
 	log.Printf("Starting Ticketing Service on port %s...", cfg.Port)
 	log.Printf("Environment: %s", cfg.Environment)
 	log.Printf("Reservation timeout: %v", cfg.Reservation.Timeout)
@@ -77,14 +93,20 @@ func main() {
 	// - development: TCP client for local Docker Redis
 	// - production: REST client for Upstash Redis
 	redisClient, err := cache.NewRedisClient()
+	var locker cache.Locker
 	if err != nil {
 		log.Printf("⚠️  Warning: Failed to connect to Redis: %v", err)
-		log.Println("⚠️  Continuing without Redis (distributed locking disabled)")
-		log.Println("⚠️  NOTE: Do not run multiple instances without Redis!")
-		redisClient = nil
+		log.Println("⚠️  Continuing without Redis (distributed locking via PostgreSQL advisory locks)")
 	} else {
 		log.Printf("✓ Redis connected successfully (Environment: %s)", cfg.Environment)
 		defer redisClient.Close()
+		locker = cache.NewRedisLocker(redisClient)
+	}
+
+	// Feature flags (event_sourced_orders selects the organizers whose orders are event-sourced)
+	flags, err := featureflags.NewFromEnv(redisClient)
+	if err != nil {
+		log.Printf("⚠️  Warning: Feature flags unavailable, using defaults: %v", err)
 	}
 
 	// Initialize repositories
@@ -94,11 +116,48 @@ func main() {
 	ticketTierRepo := repository.NewTicketTierRepository(db)
 	eventRepo := repository.NewEventRepository(db)
 	userRepo := repository.NewUserRepository(db)
+	archiveRepo := repository.NewArchiveRepository(db)
+	shareRepo := repository.NewTicketShareRepository(db)
+	scanRepo := repository.NewTicketScanRepository(db)
+	zoneRepo := repository.NewZoneRepository(db)
+	availabilityRepo := repository.NewAvailabilityRepository(db)
+	tenantRepo := repository.NewTenantRepository(db)
+	eventReplicaRepo := repository.NewEventReplicaRepository(db)
+	issueRepo := repository.NewOrderIssueRepository(db)
+	refundRepo := repository.NewOrderRefundRepository(db)
+	orderAuditRepo := repository.NewOrderAuditRepository(db)
+	consistencyRepo := repository.NewConsistencyRepository(db)
+	kioskRepo := repository.NewCheckinKioskRepository(db)
+	insuranceRepo := repository.NewInsurancePolicyRepository(db)
+	accommodationRepo := repository.NewAccommodationRepository(db)
+	policyDocumentRepo := repository.NewPolicyDocumentRepository(db)
+	scheduledJobRepo := repository.NewScheduledJobRepository(db)
+	notificationOutboxRepo := repository.NewNotificationOutboxRepository(db)
+	invitationRepo := repository.NewEventInvitationRepository(db)
+	partnerAPIKeyRepo := repository.NewPartnerAPIKeyRepository(db)
+	integrationRepo := repository.NewIntegrationRepository(db)
+	inventoryMovementRepo := repository.NewInventoryMovementRepository(db)
+	waitlistRepo := repository.NewWaitlistRepository(db)
 
 	log.Println("Repositories initialized")
 
+	// Fault injection for inter-service calls (FAULT_INJECTION, refused in production)
+	faults, err := faultinject.NewFromEnv()
+	if err != nil {
+		log.Printf("⚠️  Warning: Fault injection disabled: %v", err)
+	}
+
+	// Service identity signing the tokens of outgoing gRPC calls (SERVICE_AUTH_PRIVATE_KEY_FILE)
+	serviceIdentity, err := serviceauth.IdentityFromEnv(serviceauth.ServiceTicketing)
+	if err != nil {
+		log.Fatalf("Failed to load service identity: %v", err)
+	}
+	if serviceIdentity == nil {
+		log.Println("⚠️  Warning: SERVICE_AUTH_PRIVATE_KEY_FILE not set, gRPC calls carry no service token")
+	}
+
 	// Initialize payment gRPC client (with auto-reconnect)
-	paymentClient, err := client.NewPaymentClient(cfg.PaymentService.GRPCAddress)
+	paymentClient, err := client.NewPaymentClient(cfg.PaymentService.GRPCAddress, cfg.GRPC.MaxRecvMsgSize, cfg.GRPC.MaxSendMsgSize, faults, serviceIdentity)
 	if err != nil {
 		log.Fatalf("Failed to create payment client: %v", err)
 	}
@@ -106,43 +165,227 @@ func main() {
 	log.Println("✓ Payment client initialized (will auto-reconnect if service unavailable)")
 
 	// Initialize notification gRPC client (with auto-reconnect)
-	notificationClient, err := client.NewNotificationClient(cfg.NotificationService.GRPCAddress)
+	notificationClient, err := client.NewNotificationClient(cfg.NotificationService.GRPCAddress, cfg.GRPC.MaxRecvMsgSize, cfg.GRPC.MaxSendMsgSize, faults, serviceIdentity)
 	if err != nil {
 		log.Fatalf("Failed to create notification client: %v", err)
 	}
 	defer notificationClient.Close()
 	log.Println("✓ Notification client initialized (will auto-reconnect if service unavailable)")
 
+	// Initialize event gRPC client (with auto-reconnect)
+	var eventClient *client.EventClient
+	if cfg.EventService.ReadsEnabled || cfg.Replication.Enabled {
+		eventClient, err = client.NewEventClient(cfg.EventService.GRPCAddress, cfg.GRPC.MaxRecvMsgSize, cfg.GRPC.MaxSendMsgSize, faults, serviceIdentity)
+		if err != nil {
+			log.Fatalf("Failed to create event client: %v", err)
+		}
+		defer eventClient.Close()
+		log.Println("✓ Event client initialized (will auto-reconnect if service unavailable)")
+	}
+
+	// Read events and ticket tiers through event service (owner of those tables)
+	// Inventory (locked reads, sold_count updates) stays on the reservation transaction
+	if cfg.EventService.ReadsEnabled {
+		eventRepo = repository.NewRemoteEventRepository(eventClient)
+		ticketTierRepo = repository.NewRemoteTicketTierRepository(eventClient, ticketTierRepo)
+		log.Println("✓ Event/tier reads via event service")
+	}
+
+	// Organizer plans are owned by event service too
+	var planRepo repository.OrganizerPlanRepository = repository.NewOrganizerPlanRepository(db)
+	if cfg.EventService.ReadsEnabled {
+		planRepo = repository.NewRemoteOrganizerPlanRepository(eventClient)
+	}
+
+	// Confirmation emails read the local event replica first
+	confirmationEventRepo, confirmationTierRepo := eventRepo, ticketTierRepo
+	if cfg.Replication.Enabled {
+		confirmationEventRepo = repository.NewReplicatedEventRepository(eventReplicaRepo, eventRepo)
+		confirmationTierRepo = repository.NewReplicatedTicketTierRepository(eventReplicaRepo, ticketTierRepo)
+	}
+
 	// Initialize services with dependency injection
 	ticketService := service.NewTicketService(
 		ticketRepo,
 		orderRepo,
 		orderItemRepo,
+		eventRepo,
+		ticketTierRepo,
+		shareRepo,
+		userRepo,
+		scanRepo,
+		zoneRepo,
+		accommodationRepo,
+		redisClient,
+	)
+
+	shareService := service.NewShareService(
+		shareRepo,
+		ticketRepo,
+		eventRepo,
+		ticketTierRepo,
+		cfg.Share.Secret,
+		cfg.Share.TTL,
+		cfg.Share.BaseURL,
+	)
+
+	badgeService := service.NewBadgeService(
+		ticketRepo,
+		orderRepo,
+		eventRepo,
+		ticketTierRepo,
+		userRepo,
+		tenantRepo,
+		kioskRepo,
+		ticketService,
+		notificationClient,
+		cfg.Kiosk.Secret,
+	)
+
+	announcementService := service.NewAnnouncementService(
+		ticketRepo,
+		eventRepo,
+		planRepo,
+		tenantRepo,
+		notificationClient,
+	)
+
+	notificationPreferenceService := service.NewNotificationPreferenceService(notificationClient)
+
+	availabilityService := service.NewAvailabilityService(availabilityRepo)
+
+	// Policies already sold stay claimable when new sales of insurance are disabled
+	insuranceService := service.NewInsuranceService(
+		insuranceRepo,
+		orderRepo,
+		ticketRepo,
+		refundRepo,
+		client.NewInsuranceClient(cfg.Insurance.APIURL, cfg.Insurance.APIKey, cfg.Insurance.Timeout),
+		cfg.Insurance.Provider,
+		cfg.Payment.Currency,
 	)
 
+	var checkoutInsurance service.InsuranceService
+	if cfg.Insurance.Enabled {
+		checkoutInsurance = insuranceService
+		log.Printf("✓ Ticket insurance enabled (provider: %s)", cfg.Insurance.Provider)
+	}
+
+	// Deadline and slow logging for transactions holding ticket tier and order row locks
+	txWatchdog := repository.NewTxWatchdog(cfg.Database.TxTimeout, cfg.Database.TxSlowThreshold)
+
 	reservationService := service.NewReservationService(
 		orderRepo,
 		orderItemRepo,
 		ticketTierRepo,
-		redisClient,
+		eventRepo,
+		tenantRepo,
+		accommodationRepo,
+		policyDocumentRepo,
+		scheduledJobRepo,
+		waitlistRepo,
+		locker,
+		cache.NewPGAdvisoryLock(),
+		txWatchdog,
 		paymentClient,
+		availabilityService,
+		checkoutInsurance,
+		flags,
+		cfg.Reservation.Timeout,
+		cfg.Reservation.ReminderBefore,
+	)
+
+	// Seats of sold-out tiers freed by expiries and cancellations are offered to the waitlist in line order
+	waitlistService := service.NewWaitlistService(
+		waitlistRepo,
+		orderRepo,
+		ticketTierRepo,
+		eventRepo,
+		userRepo,
+		tenantRepo,
+		txWatchdog,
+		notificationClient,
+		availabilityService,
+		cfg.Waitlist.OfferWindow,
+		cfg.Waitlist.BaseURL,
+	)
+
+	invitationService := service.NewInvitationService(
+		invitationRepo,
+		orderRepo,
+		orderItemRepo,
+		ticketTierRepo,
+		eventRepo,
+		userRepo,
+		tenantRepo,
+		scheduledJobRepo,
+		txWatchdog,
+		ticketService,
+		paymentClient,
+		notificationClient,
+		availabilityService,
+		flags,
+		cfg.Invitation.Secret,
+		cfg.Invitation.BaseURL,
 		cfg.Reservation.Timeout,
 	)
 
 	orderService := service.NewOrderService(
 		orderRepo,
 		orderItemRepo,
+		eventRepo,
+		ticketTierRepo,
 		reservationService,
+		paymentClient,
+		cfg.Payment.Currency,
 	)
 
 	confirmationService := service.NewConfirmationService(
 		orderRepo,
 		orderItemRepo,
-		ticketTierRepo,
-		eventRepo,
+		ticketRepo,
+		refundRepo,
+		orderAuditRepo,
+		confirmationTierRepo,
+		accommodationRepo,
+		policyDocumentRepo,
+		confirmationEventRepo,
 		userRepo,
+		tenantRepo,
 		ticketService,
 		notificationClient,
+		notificationOutboxRepo,
+		paymentClient,
+		availabilityService,
+		txWatchdog,
+		cfg.Payment.Currency,
+		cfg.Payment.AmountTolerance,
+		cfg.Payment.ExpiryGracePeriod,
+	)
+
+	issueService := service.NewIssueService(
+		issueRepo,
+		orderRepo,
+		orderAuditRepo,
+		orderService,
+	)
+
+	refundService := service.NewRefundService(refundRepo, orderRepo, ticketRepo, eventRepo, reservationService, cfg.Payment.Currency)
+
+	archiveService := service.NewArchiveService(
+		archiveRepo,
+		time.Duration(cfg.Archive.RetentionMonths)*30*24*time.Hour,
+		cfg.Archive.BatchSize,
+	)
+
+	consistencyService := service.NewConsistencyService(
+		consistencyRepo,
+		ticketService,
+		service.ConsistencyPolicy{
+			GracePeriod: cfg.Consistency.GracePeriod,
+			Limit:       cfg.Consistency.Limit,
+			AutoHeal:    cfg.Consistency.AutoHeal,
+		},
 	)
 
 	log.Println("Services initialized")
@@ -156,24 +399,126 @@ func main() {
 
 	ticketController := controller.NewTicketController(
 		ticketService,
+		shareService,
+	)
+
+	issueController := controller.NewIssueController(issueService)
+	insuranceController := controller.NewInsuranceController(insuranceService)
+	accommodationController := controller.NewAccommodationController(
+		service.NewAccommodationService(accommodationRepo, eventRepo),
+	)
+	policyController := controller.NewPolicyController(
+		service.NewPolicyDocumentService(policyDocumentRepo, eventRepo, orderRepo),
+	)
+	refundController := controller.NewRefundController(refundService)
+	badgeController := controller.NewBadgeController(badgeService)
+	announcementController := controller.NewAnnouncementController(announcementService)
+	invitationController := controller.NewInvitationController(invitationService)
+	waitlistController := controller.NewWaitlistController(waitlistService)
+	integrationController := controller.NewIntegrationController(
+		service.NewIntegrationService(partnerAPIKeyRepo, integrationRepo, eventRepo, userRepo, ticketService, invitationService, cfg.Integration.Secret),
+	)
+	inventoryController := controller.NewInventoryController(
+		service.NewInventoryService(inventoryMovementRepo, ticketTierRepo, eventRepo),
+	)
+	sandboxController := controller.NewSandboxController(
+		service.NewSandboxService(orderRepo, ticketRepo, eventRepo, reservationService),
+	)
+	orderReplayController := controller.NewOrderReplayController(
+		service.NewOrderReplayService(orderRepo, repository.NewOrderEventRepository(db)),
+	)
+	ticketReissueService := service.NewTicketReissueService(
+		repository.NewTicketReissueRepository(db),
+		ticketRepo,
+		shareRepo,
+		zoneRepo,
+		eventRepo,
+		orderRepo,
+		scheduledJobRepo,
+		confirmationService,
+	)
+	ticketReissueController := controller.NewTicketReissueController(ticketReissueService)
+	notificationPreferenceController := controller.NewNotificationPreferenceController(notificationPreferenceService)
+	// Gate scans are counted by ticketService in the same Redis; without Redis the live view is unavailable
+	checkinMonitorController := controller.NewCheckinMonitorController(
+		service.NewCheckinMonitorService(eventRepo, zoneRepo, redisClient),
 	)
 
 	log.Println("Controllers initialized")
 
+	// Load JWT keys for local token validation
+	jwtKeys, err := sharedauth.KeySetFromEnv(cfg.JWTSecret)
+	if err != nil {
+		log.Fatalf("Failed to load JWT keys: %v", err)
+	}
+
+	// Hot config reload: SIGHUP, POST /admin/config/reload or a Redis generation bump from any service
+	// Reservation timeout and reminder lead time apply to reservations created afterwards
+	configWatcher := hotreload.NewWatcher(hotreload.Config{Service: "ticketing-service", EnvFile: envPath}, redisClient)
+	reservationSettings := cfg.Reservation
+	configWatcher.Register("reservation", func() error {
+		reservation := config.LoadReservation()
+		if err := reservation.Validate(); err != nil {
+			return err
+		}
+		reservationService.UpdateSettings(reservation.Timeout, reservation.ReminderBefore)
+		invitationService.UpdateReservationTimeout(reservation.Timeout)
+		reservationSettings = reservation
+		return nil
+	}, func() any {
+		return map[string]string{"timeout": reservationSettings.Timeout.String(), "reminder_before": reservationSettings.ReminderBefore.String()}
+	})
+	configWatcher.Register("feature_flags", func() error {
+		flags.Invalidate()
+		return nil
+	}, func() any { return flags.Definitions(context.Background()) })
+
 	// Setup router
 	r := router.SetupRouter(
 		orderController,
 		ticketController,
-		cfg.JWTSecret,
+		issueController,
+		refundController,
+		badgeController,
+		announcementController,
+		insuranceController,
+		accommodationController,
+		policyController,
+		invitationController,
+		integrationController,
+		inventoryController,
+		sandboxController,
+		orderReplayController,
+		ticketReissueController,
+		notificationPreferenceController,
+		checkinMonitorController,
+		waitlistController,
+		jwtKeys,
+		configWatcher,
 	)
 
 	log.Println("Router configured")
 
+	// Authenticate and authorize callers of the gRPC server (SERVICE_AUTH_PUBLIC_KEYS)
+	serviceVerifier, err := serviceauth.VerifierFromEnv(serviceauth.ServiceTicketing, grpcHandler.ServiceAuthPolicy)
+	if err != nil {
+		log.Fatalf("Failed to load service auth keys: %v", err)
+	}
+	if serviceVerifier == nil {
+		log.Println("⚠️  Warning: SERVICE_AUTH_PUBLIC_KEYS not set, gRPC callers are not authenticated")
+	}
+
 	// Initialize gRPC server
-	grpcServer := grpc.NewServer()
-	ticketingGRPCServer := grpcHandler.NewTicketingGRPCServer(confirmationService)
+	grpcServer := grpc.NewServer(
+		interceptor.UnaryServer(serviceVerifier.UnaryServerInterceptor()),
+		interceptor.StreamServer(serviceVerifier.StreamServerInterceptor()),
+		grpc.MaxRecvMsgSize(cfg.GRPC.MaxRecvMsgSize),
+		grpc.MaxSendMsgSize(cfg.GRPC.MaxSendMsgSize),
+	)
+	ticketingGRPCServer := grpcHandler.NewTicketingGRPCServer(confirmationService, refundService)
 	pb.RegisterTicketingServiceServer(grpcServer, ticketingGRPCServer)
 	reflection.Register(grpcServer)
+	buildinfo.RegisterGRPC(grpcServer, "ticketing-service")
 
 	log.Println("gRPC server initialized")
 
@@ -189,6 +534,92 @@ func main() {
 	// Start worker in goroutine
 	go cleanupWorker.Start(ctx)
 
+	// Start config watcher
+	go configWatcher.Start(ctx)
+
+	// Start background worker for order archival
+	var archivalWorker *worker.OrderArchivalWorker
+	if cfg.Archive.Enabled {
+		archivalWorker = worker.NewOrderArchivalWorker(
+			archiveService,
+			cfg.Archive.Interval,
+		)
+		go archivalWorker.Start(ctx)
+	}
+
+	// Start background worker for event availability summary refresh
+	availabilityWorker := worker.NewAvailabilityRefreshWorker(
+		availabilityService,
+		cfg.Availability.RefreshInterval,
+	)
+	go availabilityWorker.Start(ctx)
+
+	// Start background worker for event replica sync
+	var replicationWorker *worker.EventReplicationWorker
+	if cfg.Replication.Enabled {
+		replicationWorker = worker.NewEventReplicationWorker(
+			service.NewEventReplicationService(eventClient, eventReplicaRepo, cfg.Replication.BatchSize),
+			cfg.Replication.Interval,
+		)
+		go replicationWorker.Start(ctx)
+	}
+
+	// Start background worker for data consistency checks
+	var consistencyWorker *worker.ConsistencyCheckWorker
+	if cfg.Consistency.Enabled {
+		consistencyWorker = worker.NewConsistencyCheckWorker(
+			consistencyService,
+			cfg.Consistency.Interval,
+		)
+		go consistencyWorker.Start(ctx)
+	}
+
+	// Start background worker for insurance policy issuance
+	var insuranceWorker *worker.InsuranceIssuanceWorker
+	if cfg.Insurance.Enabled {
+		insuranceWorker = worker.NewInsuranceIssuanceWorker(
+			insuranceService,
+			cfg.Insurance.IssueInterval,
+		)
+		go insuranceWorker.Start(ctx)
+	}
+
+	// Start background worker for waitlist offers of sold-out ticket tiers
+	waitlistWorker := worker.NewWaitlistOfferWorker(
+		waitlistService,
+		cfg.Waitlist.Interval,
+	)
+	go waitlistWorker.Start(ctx)
+
+	// Start background worker for delayed jobs (reservation payment reminders, invitation emails and releases,
+	// ticket reissue batches)
+	scheduledJobWorker := worker.NewScheduledJobWorker(
+		service.NewScheduledJobService(scheduledJobRepo, map[string]service.JobHandler{
+			entity.JobTypeReservationReminder: service.NewReservationReminderHandler(
+				orderRepo,
+				confirmationEventRepo,
+				userRepo,
+				tenantRepo,
+				paymentClient,
+				notificationClient,
+			),
+			entity.JobTypeInvitationEmails:  invitationService.SendInvitationEmails,
+			entity.JobTypeInvitationRelease: invitationService.ReleaseInvitations,
+			entity.JobTypeTicketReissue:     ticketReissueService.ReissueBatch,
+		}),
+		cfg.ScheduledJobs.Interval,
+	)
+	go scheduledJobWorker.Start(ctx)
+
+	// Start background worker retrying ticket emails that failed to send
+	notificationOutboxWorker := worker.NewNotificationOutboxWorker(
+		service.NewNotificationOutboxService(notificationOutboxRepo, map[string]service.NotificationHandler{
+			entity.NotificationTypeTicketEmail: confirmationService.RetryTicketEmail,
+		}, cfg.NotificationOutbox.MaxAttempts),
+		cfg.NotificationOutbox.Interval,
+	)
+	go notificationOutboxWorker.Start(ctx)
+
 	log.Println("Background worker started")
 
 	// Create HTTP server (without Addr - will use cmux listener)
@@ -257,8 +688,24 @@ func main() {
 	// Close multiplexer listener
 	listener.Close()
 
-	// Stop background worker
+	// Stop background workers
 	cleanupWorker.Stop()
+	if archivalWorker != nil {
+		archivalWorker.Stop()
+	}
+	availabilityWorker.Stop()
+	if replicationWorker != nil {
+		replicationWorker.Stop()
+	}
+	if consistencyWorker != nil {
+		consistencyWorker.Stop()
+	}
+	if insuranceWorker != nil {
+		insuranceWorker.Stop()
+	}
+	waitlistWorker.Stop()
+	scheduledJobWorker.Stop()
+	notificationOutboxWorker.Stop()
 
 	log.Println("✅ Ticketing service stopped gracefully")
 }
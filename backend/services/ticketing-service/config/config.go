@@ -2,9 +2,13 @@ package config
 
 import (
 	"fmt"
+	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
 )
 
 // Config holds application configuration
@@ -15,12 +19,34 @@ type Config struct {
 	Redis               RedisConfig
 	JWTSecret           string
 	Reservation         ReservationConfig
+	ScheduledJobs       ScheduledJobConfig
+	Archive             ArchiveConfig
+	Consistency         ConsistencyConfig
+	Availability        AvailabilityConfig
+	Replication         ReplicationConfig
+	Share               ShareConfig
+	Kiosk               KioskConfig
+	Invitation          InvitationConfig
+	Waitlist            WaitlistConfig
+	NotificationOutbox  NotificationOutboxConfig
+	Integration         IntegrationConfig
+	Insurance           InsuranceConfig
+	Payment             PaymentConfig
 	PaymentService      PaymentServiceConfig
 	NotificationService NotificationServiceConfig
+	EventService        EventServiceConfig
 	AuthService         AuthServiceConfig
+	GRPC                GRPCConfig
 	Environment         string
 }
 
+// GRPCConfig holds gRPC message size limits (in bytes)
+// Applied to the gRPC server and to every outgoing gRPC client
+type GRPCConfig struct {
+	MaxRecvMsgSize int // Default: 4 MB (gRPC default)
+	MaxSendMsgSize int // Default: 4 MB
+}
+
 // PaymentServiceConfig holds payment service gRPC configuration
 type PaymentServiceConfig struct {
 	GRPCAddress string
@@ -31,6 +57,13 @@ type NotificationServiceConfig struct {
 	GRPCAddress string
 }
 
+// EventServiceConfig holds event service gRPC configuration
+// When ReadsEnabled, event and ticket tier reads go through event service instead of its tables
+type EventServiceConfig struct {
+	GRPCAddress  string
+	ReadsEnabled bool
+}
+
 // AuthServiceConfig holds auth service HTTP configuration
 type AuthServiceConfig struct {
 	BaseURL string
@@ -44,6 +77,9 @@ type DatabaseConfig struct {
 	Password string
 	Name     string
 	SSLMode  string
+
+	TxTimeout       time.Duration // Critical path transactions are rolled back after this, default: 10 seconds, 0 disables
+	TxSlowThreshold time.Duration // Transactions at least this slow are logged, default: 1 second, 0 disables
 }
 
 // RedisConfig holds Redis configuration
@@ -58,10 +94,101 @@ type RedisConfig struct {
 type ReservationConfig struct {
 	Timeout         time.Duration // Default: 15 minutes
 	CleanupInterval time.Duration // Background job interval
+	ReminderBefore  time.Duration // Payment reminder email this long before expiry, default: 5 minutes, 0 disables
 }
 
-// Load loads configuration from environment variables
-func Load() *Config {
+// ScheduledJobConfig holds delayed job worker configuration
+type ScheduledJobConfig struct {
+	Interval time.Duration // How often due jobs are claimed, default: 15 seconds
+}
+
+// PaymentConfig holds payment verification settings used on confirmation
+type PaymentConfig struct {
+	Currency          string        // Expected ISO 4217 currency, default: IDR
+	AmountTolerance   money.Money   // Max accepted |paid - grand total|, default: 1 (provider rounding)
+	ExpiryGracePeriod time.Duration // Payments for orders expired this recently are still confirmed, default: 5 minutes
+}
+
+// AvailabilityConfig holds event availability summary refresh configuration
+type AvailabilityConfig struct {
+	RefreshInterval time.Duration // Max staleness of listing availability after sold_count changes, default: 5 seconds
+}
+
+// ReplicationConfig holds event replica sync configuration
+// The replica serves event and tier lookups for confirmation emails
+type ReplicationConfig struct {
+	Enabled   bool
+	Interval  time.Duration // Max replica staleness after an event change, default: 10 seconds
+	BatchSize int           // Changes read per call, default: 100
+}
+
+// ShareConfig holds public ticket share link configuration
+type ShareConfig struct {
+	Secret  string        // HMAC key for share tokens, default: JWT secret
+	TTL     time.Duration // Link lifetime, capped at event end, default: 72 hours
+	BaseURL string        // Frontend page that renders a shared ticket; the token is appended
+}
+
+// KioskConfig holds check-in kiosk configuration
+type KioskConfig struct {
+	Secret string // HMAC key for kiosk device tokens, default: JWT secret
+}
+
+// InvitationConfig holds invite-only guest list configuration
+type InvitationConfig struct {
+	Secret  string // HMAC key for invitation claim tokens, default: JWT secret
+	BaseURL string // Frontend page where invitees claim their tickets; the token is appended
+}
+
+// WaitlistConfig holds sold-out tier waitlist configuration
+type WaitlistConfig struct {
+	OfferWindow time.Duration // How long offered seats are held for a waitlisted buyer, default: 30 minutes
+	Interval    time.Duration // How often freed seats are offered and unused offers expired, default: 30 seconds
+	BaseURL     string        // Frontend event page linked in offer emails; the event ID is appended
+}
+
+// NotificationOutboxConfig holds failed email retry configuration
+type NotificationOutboxConfig struct {
+	Interval    time.Duration // How often due emails are retried, default: 30 seconds
+	MaxAttempts int           // Send attempts before an email is dead-lettered, default: 8
+}
+
+// IntegrationConfig holds partner integration (Zapier, Make) configuration
+type IntegrationConfig struct {
+	Secret string // HMAC key for partner API keys, default: JWT secret
+}
+
+// InsuranceConfig holds refund-protection insurance partner configuration
+// Disabled by default; orders asking for insurance are rejected until a partner is configured
+type InsuranceConfig struct {
+	Enabled       bool
+	Provider      string // Partner name recorded on policies
+	APIURL        string // Partner API base URL
+	APIKey        string
+	Timeout       time.Duration // Per partner call, default: 10 seconds
+	IssueInterval time.Duration // How often quoted policies of paid orders are issued, default: 1 minute
+}
+
+// ArchiveConfig holds order archival configuration
+type ArchiveConfig struct {
+	Enabled         bool
+	RetentionMonths int           // Default: 6 months after last update
+	Interval        time.Duration // Background job interval
+	BatchSize       int           // Orders moved per transaction
+}
+
+// ConsistencyConfig holds data consistency checker configuration
+type ConsistencyConfig struct {
+	Enabled     bool
+	Interval    time.Duration // Default: 24 hours (nightly)
+	GracePeriod time.Duration // Orders and payments updated more recently are skipped, default: 15 minutes
+	Limit       int           // Max discrepancies reported per check, default: 100
+	AutoHeal    bool          // Fix known-safe discrepancies, default: false (report only)
+}
+
+// LoadReservation loads the reservation configuration
+// Also called on config reload: Timeout and ReminderBefore are hot-reloadable, CleanupInterval is not
+func LoadReservation() ReservationConfig {
 	// Parse reservation timeout (default 15 minutes)
 	timeout := 15 * time.Minute
 	if timeoutStr := os.Getenv("RESERVATION_TIMEOUT"); timeoutStr != "" {
@@ -78,6 +205,192 @@ func Load() *Config {
 		}
 	}
 
+	// Parse reservation reminder lead time (default 5 minutes, 0 disables)
+	reminderBefore := 5 * time.Minute
+	if beforeStr := os.Getenv("RESERVATION_REMINDER_BEFORE"); beforeStr != "" {
+		if d, err := time.ParseDuration(beforeStr); err == nil && d >= 0 {
+			reminderBefore = d
+		}
+	}
+
+	return ReservationConfig{
+		Timeout:         timeout,
+		CleanupInterval: cleanupInterval,
+		ReminderBefore:  reminderBefore,
+	}
+}
+
+// Validate validates reservation configuration
+func (c ReservationConfig) Validate() error {
+	if c.Timeout <= 0 {
+		return fmt.Errorf("invalid RESERVATION_TIMEOUT %s (expected a positive duration)", c.Timeout)
+	}
+	return nil
+}
+
+// Load loads configuration from environment variables
+func Load() *Config {
+	// Parse scheduled job interval (default 15 seconds)
+	scheduledJobInterval := 15 * time.Second
+	if intervalStr := os.Getenv("SCHEDULED_JOB_INTERVAL"); intervalStr != "" {
+		if d, err := time.ParseDuration(intervalStr); err == nil && d > 0 {
+			scheduledJobInterval = d
+		}
+	}
+
+	// Parse archive settings (default: enabled, 6 months retention, daily, 500 per batch)
+	archiveRetentionMonths := 6
+	if monthsStr := os.Getenv("ARCHIVE_RETENTION_MONTHS"); monthsStr != "" {
+		if months, err := strconv.Atoi(monthsStr); err == nil && months > 0 {
+			archiveRetentionMonths = months
+		}
+	}
+
+	archiveInterval := 24 * time.Hour
+	if intervalStr := os.Getenv("ARCHIVE_INTERVAL"); intervalStr != "" {
+		if d, err := time.ParseDuration(intervalStr); err == nil && d > 0 {
+			archiveInterval = d
+		}
+	}
+
+	archiveBatchSize := 500
+	if sizeStr := os.Getenv("ARCHIVE_BATCH_SIZE"); sizeStr != "" {
+		if size, err := strconv.Atoi(sizeStr); err == nil && size > 0 {
+			archiveBatchSize = size
+		}
+	}
+
+	// Parse consistency check settings (default: enabled, daily, 15 minutes grace, 100 per check)
+	consistencyInterval := 24 * time.Hour
+	if intervalStr := os.Getenv("CONSISTENCY_CHECK_INTERVAL"); intervalStr != "" {
+		if d, err := time.ParseDuration(intervalStr); err == nil && d > 0 {
+			consistencyInterval = d
+		}
+	}
+
+	consistencyGracePeriod := 15 * time.Minute
+	if graceStr := os.Getenv("CONSISTENCY_CHECK_GRACE_PERIOD"); graceStr != "" {
+		if d, err := time.ParseDuration(graceStr); err == nil && d >= 0 {
+			consistencyGracePeriod = d
+		}
+	}
+
+	consistencyLimit := 100
+	if limitStr := os.Getenv("CONSISTENCY_CHECK_LIMIT"); limitStr != "" {
+		if limit, err := strconv.Atoi(limitStr); err == nil && limit > 0 {
+			consistencyLimit = limit
+		}
+	}
+
+	// Parse availability refresh interval (default 5 seconds)
+	availabilityRefreshInterval := 5 * time.Second
+	if intervalStr := os.Getenv("AVAILABILITY_REFRESH_INTERVAL"); intervalStr != "" {
+		if d, err := time.ParseDuration(intervalStr); err == nil && d > 0 {
+			availabilityRefreshInterval = d
+		}
+	}
+
+	// Parse event replication settings (default: every 10 seconds, 100 changes per call)
+	replicationInterval := 10 * time.Second
+	if intervalStr := os.Getenv("EVENT_REPLICATION_INTERVAL"); intervalStr != "" {
+		if d, err := time.ParseDuration(intervalStr); err == nil && d > 0 {
+			replicationInterval = d
+		}
+	}
+
+	replicationBatchSize := 100
+	if sizeStr := os.Getenv("EVENT_REPLICATION_BATCH_SIZE"); sizeStr != "" {
+		if size, err := strconv.Atoi(sizeStr); err == nil && size > 0 {
+			replicationBatchSize = size
+		}
+	}
+
+	// Parse share link TTL (default 72 hours)
+	shareTTL := 72 * time.Hour
+	if ttlStr := os.Getenv("TICKET_SHARE_TTL"); ttlStr != "" {
+		if d, err := time.ParseDuration(ttlStr); err == nil && d > 0 {
+			shareTTL = d
+		}
+	}
+
+	// Parse insurance partner settings (default: 10 seconds per call, issued every minute)
+	insuranceTimeout := 10 * time.Second
+	if timeoutStr := os.Getenv("INSURANCE_TIMEOUT"); timeoutStr != "" {
+		if d, err := time.ParseDuration(timeoutStr); err == nil && d > 0 {
+			insuranceTimeout = d
+		}
+	}
+
+	insuranceIssueInterval := 1 * time.Minute
+	if intervalStr := os.Getenv("INSURANCE_ISSUE_INTERVAL"); intervalStr != "" {
+		if d, err := time.ParseDuration(intervalStr); err == nil && d > 0 {
+			insuranceIssueInterval = d
+		}
+	}
+
+	// Parse waitlist settings (default: offers held 30 minutes, processed every 30 seconds)
+	waitlistOfferWindow := 30 * time.Minute
+	if windowStr := os.Getenv("WAITLIST_OFFER_WINDOW"); windowStr != "" {
+		if d, err := time.ParseDuration(windowStr); err == nil && d > 0 {
+			waitlistOfferWindow = d
+		}
+	}
+
+	waitlistInterval := 30 * time.Second
+	if intervalStr := os.Getenv("WAITLIST_INTERVAL"); intervalStr != "" {
+		if d, err := time.ParseDuration(intervalStr); err == nil && d > 0 {
+			waitlistInterval = d
+		}
+	}
+
+	// Parse notification outbox settings (default: every 30 seconds, 8 attempts)
+	outboxInterval := 30 * time.Second
+	if intervalStr := os.Getenv("NOTIFICATION_OUTBOX_INTERVAL"); intervalStr != "" {
+		if d, err := time.ParseDuration(intervalStr); err == nil && d > 0 {
+			outboxInterval = d
+		}
+	}
+
+	outboxMaxAttempts := 8
+	if attemptsStr := os.Getenv("NOTIFICATION_OUTBOX_MAX_ATTEMPTS"); attemptsStr != "" {
+		if attempts, err := strconv.Atoi(attemptsStr); err == nil && attempts > 0 {
+			outboxMaxAttempts = attempts
+		}
+	}
+
+	jwtSecret := getEnv("JWT_SECRET", "your-secret-key")
+
+	// Parse payment amount tolerance (default 1 rupiah)
+	amountTolerance := money.New(1)
+	if toleranceStr := os.Getenv("PAYMENT_AMOUNT_TOLERANCE"); toleranceStr != "" {
+		if t, err := money.Parse(toleranceStr); err == nil && !t.IsNegative() {
+			amountTolerance = t
+		}
+	}
+
+	// Parse payment expiry grace period (default 5 minutes, 0 disables)
+	expiryGracePeriod := 5 * time.Minute
+	if graceStr := os.Getenv("PAYMENT_EXPIRY_GRACE_PERIOD"); graceStr != "" {
+		if d, err := time.ParseDuration(graceStr); err == nil && d >= 0 {
+			expiryGracePeriod = d
+		}
+	}
+
+	// Parse transaction watchdog settings (default: 10 second deadline, 1 second slow threshold, 0 disables)
+	txTimeout := 10 * time.Second
+	if timeoutStr := os.Getenv("DB_TX_TIMEOUT"); timeoutStr != "" {
+		if d, err := time.ParseDuration(timeoutStr); err == nil && d >= 0 {
+			txTimeout = d
+		}
+	}
+
+	txSlowThreshold := 1 * time.Second
+	if thresholdStr := os.Getenv("DB_TX_SLOW_THRESHOLD"); thresholdStr != "" {
+		if d, err := time.ParseDuration(thresholdStr); err == nil && d >= 0 {
+			txSlowThreshold = d
+		}
+	}
+
 	// Parse Redis DB (default 0)
 	redisDB := 0
 	if dbStr := os.Getenv("REDIS_DB"); dbStr != "" {
@@ -96,6 +409,9 @@ func Load() *Config {
 			Password: getEnv("DB_PASSWORD", "postgres"),
 			Name:     getEnv("DB_NAME", "ticketing_platform"),
 			SSLMode:  getEnv("DB_SSL_MODE", "disable"),
+
+			TxTimeout:       txTimeout,
+			TxSlowThreshold: txSlowThreshold,
 		},
 		Redis: RedisConfig{
 			Host:     getEnv("REDIS_HOST", "localhost"),
@@ -103,10 +419,68 @@ func Load() *Config {
 			Password: getEnv("REDIS_PASSWORD", ""),
 			DB:       redisDB,
 		},
-		JWTSecret: getEnv("JWT_SECRET", "your-secret-key"),
-		Reservation: ReservationConfig{
-			Timeout:         timeout,
-			CleanupInterval: cleanupInterval,
+		JWTSecret:   jwtSecret,
+		Reservation: LoadReservation(),
+		ScheduledJobs: ScheduledJobConfig{
+			Interval: scheduledJobInterval,
+		},
+		Archive: ArchiveConfig{
+			Enabled:         getEnv("ARCHIVE_ENABLED", "true") == "true",
+			RetentionMonths: archiveRetentionMonths,
+			Interval:        archiveInterval,
+			BatchSize:       archiveBatchSize,
+		},
+		Consistency: ConsistencyConfig{
+			Enabled:     getEnv("CONSISTENCY_CHECK_ENABLED", "true") == "true",
+			Interval:    consistencyInterval,
+			GracePeriod: consistencyGracePeriod,
+			Limit:       consistencyLimit,
+			AutoHeal:    getEnv("CONSISTENCY_AUTO_HEAL", "false") == "true",
+		},
+		Availability: AvailabilityConfig{
+			RefreshInterval: availabilityRefreshInterval,
+		},
+		Replication: ReplicationConfig{
+			Enabled:   getEnv("EVENT_REPLICATION_ENABLED", "true") == "true",
+			Interval:  replicationInterval,
+			BatchSize: replicationBatchSize,
+		},
+		Share: ShareConfig{
+			Secret:  getEnv("TICKET_SHARE_SECRET", jwtSecret),
+			TTL:     shareTTL,
+			BaseURL: getEnv("TICKET_SHARE_BASE_URL", "http://localhost:3000/shared-tickets"),
+		},
+		Kiosk: KioskConfig{
+			Secret: getEnv("CHECKIN_KIOSK_SECRET", jwtSecret),
+		},
+		Invitation: InvitationConfig{
+			Secret:  getEnv("INVITATION_SECRET", jwtSecret),
+			BaseURL: getEnv("INVITATION_CLAIM_BASE_URL", "http://localhost:3000/invitations"),
+		},
+		Waitlist: WaitlistConfig{
+			OfferWindow: waitlistOfferWindow,
+			Interval:    waitlistInterval,
+			BaseURL:     getEnv("WAITLIST_PURCHASE_BASE_URL", "http://localhost:3000/events"),
+		},
+		NotificationOutbox: NotificationOutboxConfig{
+			Interval:    outboxInterval,
+			MaxAttempts: outboxMaxAttempts,
+		},
+		Integration: IntegrationConfig{
+			Secret: getEnv("PARTNER_API_KEY_SECRET", jwtSecret),
+		},
+		Insurance: InsuranceConfig{
+			Enabled:       getEnv("INSURANCE_ENABLED", "false") == "true",
+			Provider:      getEnv("INSURANCE_PROVIDER", "default"),
+			APIURL:        getEnv("INSURANCE_API_URL", ""),
+			APIKey:        getEnv("INSURANCE_API_KEY", ""),
+			Timeout:       insuranceTimeout,
+			IssueInterval: insuranceIssueInterval,
+		},
+		Payment: PaymentConfig{
+			Currency:          strings.ToUpper(getEnv("PAYMENT_CURRENCY", "IDR")),
+			AmountTolerance:   amountTolerance,
+			ExpiryGracePeriod: expiryGracePeriod,
 		},
 		PaymentService: PaymentServiceConfig{
 			GRPCAddress: getEnv("PAYMENT_SERVICE_GRPC_ADDR", "localhost:50054"),
@@ -114,6 +488,14 @@ func Load() *Config {
 		NotificationService: NotificationServiceConfig{
 			GRPCAddress: getEnv("NOTIFICATION_SERVICE_GRPC_ADDR", "localhost:50055"),
 		},
+		EventService: EventServiceConfig{
+			GRPCAddress:  getEnv("EVENT_SERVICE_GRPC_ADDR", "localhost:8082"),
+			ReadsEnabled: getEnv("EVENT_GRPC_READS_ENABLED", "true") == "true",
+		},
+		GRPC: GRPCConfig{
+			MaxRecvMsgSize: getEnvAsPositiveInt("GRPC_MAX_RECV_MSG_SIZE", 4*1024*1024), // 4 MB default
+			MaxSendMsgSize: getEnvAsPositiveInt("GRPC_MAX_SEND_MSG_SIZE", 4*1024*1024), // 4 MB default
+		},
 		Environment: getEnv("ENVIRONMENT", "development"),
 	}
 }
@@ -159,3 +541,28 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// getEnvAsInt gets environment variable as integer with default value
+func getEnvAsInt(key string, defaultValue int) int {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+	value, err := strconv.Atoi(valueStr)
+	if err != nil {
+		log.Printf("Warning: Invalid integer value for %s, using default: %d", key, defaultValue)
+		return defaultValue
+	}
+	return value
+}
+
+// getEnvAsPositiveInt gets environment variable as positive integer with default value
+// Values that aren't integers or aren't above zero fall back to the default
+func getEnvAsPositiveInt(key string, defaultValue int) int {
+	value := getEnvAsInt(key, defaultValue)
+	if value <= 0 {
+		log.Printf("Warning: Non-positive value for %s, using default: %d", key, defaultValue)
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,279 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/event"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/faultinject"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/interceptor"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/serviceauth"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// eventCallTimeout bounds a single read from event service
+const eventCallTimeout = 5 * time.Second
+
+// EventClient reads events and ticket tiers from event service via gRPC
+// It implements repository.EventReader and repository.TierReader
+type EventClient struct {
+	client pb.EventServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewEventClient creates new event gRPC client
+// Connection is lazy and will auto-reconnect if service is unavailable
+// maxRecvMsgSize and maxSendMsgSize limit the size of a single gRPC message (bytes)
+// faults injects configured latency and errors into calls outside production, may be nil
+// identity signs the service token attached to every call, may be nil
+func NewEventClient(grpcURL string, maxRecvMsgSize, maxSendMsgSize int, faults *faultinject.Injector, identity *serviceauth.Identity) (*EventClient, error) {
+	// Use TLS for Cloud Run services (production) or insecure for localhost and docker-compose (development)
+	var creds credentials.TransportCredentials
+	if grpcURL == "localhost:8082" || grpcURL == "127.0.0.1:8082" || grpcURL == "event-service:8082" {
+		creds = insecure.NewCredentials()
+		log.Printf("[EventGRPC] Using insecure connection for local development")
+	} else {
+		// Use TLS for Cloud Run
+		creds = credentials.NewClientTLSFromCert(nil, "")
+		log.Printf("[EventGRPC] Using TLS connection for Cloud Run")
+	}
+
+	conn, err := grpc.NewClient(
+		grpcURL,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(maxRecvMsgSize),
+			grpc.MaxCallSendMsgSize(maxSendMsgSize),
+		),
+		interceptor.UnaryClient(),
+		interceptor.StreamClient(),
+		faults.DialOption(faultinject.TargetEvent),
+		identity.DialOption(serviceauth.ServiceEvent),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event client: %w", err)
+	}
+
+	log.Printf("[EventGRPC] Event client initialized for %s (lazy connection with auto-reconnect)", grpcURL)
+
+	return newEventClient(pb.NewEventServiceClient(conn), conn), nil
+}
+
+func newEventClient(client pb.EventServiceClient, conn *grpc.ClientConn) *EventClient {
+	return &EventClient{client: client, conn: conn}
+}
+
+// Close closes the gRPC connection
+func (c *EventClient) Close() error {
+	if c.conn != nil {
+		return c.conn.Close()
+	}
+	return nil
+}
+
+// GetEvent retrieves an event by ID
+func (c *EventClient) GetEvent(ctx context.Context, id string) (*entity.Event, error) {
+	callCtx, cancel := context.WithTimeout(ctx, eventCallTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetEvent(callCtx, &pb.GetEventRequest{Id: id})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, repository.ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	return toEvent(resp)
+}
+
+// GetEvents retrieves events by IDs in a single call
+// Missing IDs are skipped rather than reported as errors
+func (c *EventClient) GetEvents(ctx context.Context, ids []string) ([]entity.Event, error) {
+	events := []entity.Event{}
+	if len(ids) == 0 {
+		return events, nil
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, eventCallTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetEvents(callCtx, &pb.GetEventsRequest{Ids: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+
+	for _, e := range resp.Events {
+		event, err := toEvent(e)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, *event)
+	}
+
+	return events, nil
+}
+
+// GetTier retrieves a ticket tier by ID
+func (c *EventClient) GetTier(ctx context.Context, id string) (*entity.TicketTier, error) {
+	callCtx, cancel := context.WithTimeout(ctx, eventCallTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetTier(callCtx, &pb.GetTierRequest{Id: id})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, repository.ErrTicketTierNotFound
+		}
+		return nil, fmt.Errorf("failed to get ticket tier: %w", err)
+	}
+
+	tier := toTicketTier(resp)
+	return &tier, nil
+}
+
+// GetTiers retrieves ticket tiers by IDs in a single call
+// Missing IDs are skipped rather than reported as errors
+func (c *EventClient) GetTiers(ctx context.Context, ids []string) ([]entity.TicketTier, error) {
+	if len(ids) == 0 {
+		return []entity.TicketTier{}, nil
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, eventCallTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetTiers(callCtx, &pb.GetTiersRequest{Ids: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket tiers: %w", err)
+	}
+
+	return toTicketTiers(resp.Tiers), nil
+}
+
+// ListTiersByEvent retrieves all ticket tiers of an event
+func (c *EventClient) ListTiersByEvent(ctx context.Context, eventID string) ([]entity.TicketTier, error) {
+	callCtx, cancel := context.WithTimeout(ctx, eventCallTimeout)
+	defer cancel()
+
+	resp, err := c.client.ListTiersByEvent(callCtx, &pb.ListTiersByEventRequest{EventId: eventID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ticket tiers: %w", err)
+	}
+
+	return toTicketTiers(resp.Tiers), nil
+}
+
+// GetOrganizerPlan retrieves the plan limits of an organizer
+func (c *EventClient) GetOrganizerPlan(ctx context.Context, organizerID string) (*entity.OrganizerPlan, error) {
+	callCtx, cancel := context.WithTimeout(ctx, eventCallTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetOrganizerPlan(callCtx, &pb.GetOrganizerPlanRequest{OrganizerId: organizerID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organizer plan: %w", err)
+	}
+
+	return &entity.OrganizerPlan{
+		Code:                       resp.Code,
+		Name:                       resp.Name,
+		AnnouncementEmailsPerMonth: int(resp.AnnouncementEmailsPerMonth),
+	}, nil
+}
+
+// EventChange represents an entry of event service's change feed
+type EventChange struct {
+	Seq     int64
+	EventID string
+}
+
+// ListChanges retrieves up to limit changes after afterSeq in sequence order
+func (c *EventClient) ListChanges(ctx context.Context, afterSeq int64, limit int) ([]EventChange, error) {
+	callCtx, cancel := context.WithTimeout(ctx, eventCallTimeout)
+	defer cancel()
+
+	resp, err := c.client.ListChanges(callCtx, &pb.ListChangesRequest{AfterSeq: afterSeq, Limit: int32(limit)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list event changes: %w", err)
+	}
+
+	changes := make([]EventChange, len(resp.Changes))
+	for i, change := range resp.Changes {
+		changes[i] = EventChange{Seq: change.Seq, EventID: change.EventId}
+	}
+	return changes, nil
+}
+
+// toEvent converts a gRPC event to the ticketing entity
+func toEvent(e *pb.Event) (*entity.Event, error) {
+	event := &entity.Event{
+		ID:          e.Id,
+		Name:        e.Title,
+		Slug:        e.Slug,
+		Description: e.Description,
+		Location:    e.Location,
+		Timezone:    e.Timezone,
+		ScanPolicy:  e.ScanPolicy,
+		CategoryID:  e.Category,
+		OrganizerID: e.OrganizerId,
+		TenantID:    e.TenantId,
+		Status:      e.Status,
+		IsSandbox:   e.IsSandbox,
+		DynamicQR:   e.DynamicQr,
+	}
+	if e.BannerUrl != "" {
+		bannerURL := e.BannerUrl
+		event.BannerURL = &bannerURL
+	}
+	if e.RefundPolicy != "" {
+		refundPolicy := e.RefundPolicy
+		event.RefundPolicy = &refundPolicy
+	}
+
+	timestamps := []struct {
+		value string
+		dest  *time.Time
+	}{
+		{e.StartDate, &event.StartDate},
+		{e.EndDate, &event.EndDate},
+		{e.CreatedAt, &event.CreatedAt},
+		{e.UpdatedAt, &event.UpdatedAt},
+	}
+	for _, ts := range timestamps {
+		parsed, err := time.Parse(time.RFC3339, ts.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp %q for event %s: %w", ts.value, e.Id, err)
+		}
+		*ts.dest = parsed
+	}
+
+	return event, nil
+}
+
+// toTicketTier converts a gRPC ticket tier to the ticketing entity
+func toTicketTier(t *pb.TicketTier) entity.TicketTier {
+	return entity.TicketTier{
+		ID:          t.Id,
+		EventID:     t.EventId,
+		Name:        t.Name,
+		Price:       money.FromFloat(t.Price),
+		Quota:       int(t.Quota),
+		SoldCount:   int(t.SoldCount),
+		MaxPerOrder: int(t.MaxPerOrder),
+	}
+}
+
+func toTicketTiers(tiers []*pb.TicketTier) []entity.TicketTier {
+	result := make([]entity.TicketTier, len(tiers))
+	for i, t := range tiers {
+		result[i] = toTicketTier(t)
+	}
+	return result
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/event"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stubEventServiceClient serves a single event and tier
+type stubEventServiceClient struct {
+	pb.EventServiceClient
+	event *pb.Event
+	tier  *pb.TicketTier
+}
+
+func (c *stubEventServiceClient) GetEvent(ctx context.Context, req *pb.GetEventRequest, opts ...grpc.CallOption) (*pb.Event, error) {
+	if req.Id != c.event.Id {
+		return nil, status.Error(codes.NotFound, "event not found")
+	}
+	return c.event, nil
+}
+
+func (c *stubEventServiceClient) GetTier(ctx context.Context, req *pb.GetTierRequest, opts ...grpc.CallOption) (*pb.TicketTier, error) {
+	if req.Id != c.tier.Id {
+		return nil, status.Error(codes.NotFound, "ticket tier not found")
+	}
+	return c.tier, nil
+}
+
+func (c *stubEventServiceClient) GetTiers(ctx context.Context, req *pb.GetTiersRequest, opts ...grpc.CallOption) (*pb.ListTiersResponse, error) {
+	return nil, status.Error(codes.Unavailable, "connection refused")
+}
+
+func TestEventClient(t *testing.T) {
+	ctx := context.Background()
+	c := newEventClient(&stubEventServiceClient{
+		event: &pb.Event{
+			Id:           "event-1",
+			Title:        "Jazz Night",
+			Location:     "Jakarta",
+			StartDate:    "2026-12-05T19:00:00+07:00",
+			EndDate:      "2026-12-05T23:00:00+07:00",
+			ScanPolicy:   "reentry",
+			RefundPolicy: "seven_day",
+			IsSandbox:    true,
+			TenantId:     "tenant-1",
+			Status:       "published",
+			CreatedAt:    "2026-10-01T00:00:00Z",
+			UpdatedAt:    "2026-10-02T00:00:00Z",
+		},
+		tier: &pb.TicketTier{Id: "tier-1", EventId: "event-1", Name: "VIP", Price: 250000, Quota: 100, SoldCount: 40, MaxPerOrder: 4},
+	}, nil)
+
+	event, err := c.GetEvent(ctx, "event-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Jazz Night", event.Name)
+	assert.Equal(t, "tenant-1", event.TenantID)
+	assert.Nil(t, event.BannerURL)
+	require.NotNil(t, event.RefundPolicy)
+	assert.Equal(t, "seven_day", *event.RefundPolicy)
+	assert.True(t, event.IsSandbox)
+	assert.True(t, event.StartDate.Equal(time.Date(2026, 12, 5, 12, 0, 0, 0, time.UTC)))
+
+	_, err = c.GetEvent(ctx, "event-2")
+	assert.ErrorIs(t, err, repository.ErrEventNotFound)
+
+	tier, err := c.GetTier(ctx, "tier-1")
+	require.NoError(t, err)
+	assert.Equal(t, money.New(250000), tier.Price)
+	assert.Equal(t, 60, tier.GetAvailableQuota())
+
+	_, err = c.GetTier(ctx, "tier-2")
+	assert.ErrorIs(t, err, repository.ErrTicketTierNotFound)
+
+	// Other failures are not reported as missing data
+	_, err = c.GetTiers(ctx, []string{"tier-1"})
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, repository.ErrTicketTierNotFound)
+}
@@ -0,0 +1,147 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// ErrInsuranceRejected is returned when the insurance partner refuses a request (4xx other than
+// rate limiting), retrying the same request won't succeed
+var ErrInsuranceRejected = errors.New("insurance partner rejected the request")
+
+// InsuranceClient handles communication with the refund-protection insurance partner API
+type InsuranceClient struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewInsuranceClient creates new insurance partner client instance
+func NewInsuranceClient(baseURL, apiKey string, timeout time.Duration) *InsuranceClient {
+	return &InsuranceClient{
+		baseURL: baseURL,
+		apiKey:  apiKey,
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// InsuranceQuoteRequest contains the order to insure
+type InsuranceQuoteRequest struct {
+	EventID     string      `json:"event_id"`
+	EventStart  time.Time   `json:"event_start"`
+	TicketCount int         `json:"ticket_count"`
+	Coverage    money.Money `json:"coverage"` // Ticket value refunded on a claim
+	Currency    string      `json:"currency"`
+}
+
+// InsuranceQuote is the premium offered by the partner for a coverage
+type InsuranceQuote struct {
+	QuoteID   string      `json:"quote_id"`
+	Premium   money.Money `json:"premium"`
+	Coverage  money.Money `json:"coverage"`
+	Currency  string      `json:"currency"`
+	ExpiresAt time.Time   `json:"expires_at"`
+}
+
+// IssuePolicyRequest binds a quote to a paid order
+type IssuePolicyRequest struct {
+	QuoteID   string `json:"quote_id"`
+	Reference string `json:"reference"` // Order ID, also the idempotency key of the partner
+}
+
+// IssuedPolicy is a policy issued by the partner
+type IssuedPolicy struct {
+	PolicyNumber string    `json:"policy_number"`
+	IssuedAt     time.Time `json:"issued_at"`
+}
+
+// InsuranceClaimRequest contains a buyer's claim on a policy
+type InsuranceClaimRequest struct {
+	PolicyNumber string      `json:"policy_number"`
+	Reference    string      `json:"reference"`
+	Amount       money.Money `json:"amount"`
+	Reason       string      `json:"reason"`
+}
+
+// InsuranceClaim is a claim registered by the partner
+type InsuranceClaim struct {
+	ClaimID string `json:"claim_id"`
+	Status  string `json:"status"`
+}
+
+// Quote requests the premium of a coverage
+func (c *InsuranceClient) Quote(ctx context.Context, req *InsuranceQuoteRequest) (*InsuranceQuote, error) {
+	var quote InsuranceQuote
+	if err := c.post(ctx, "/v1/quotes", req, &quote); err != nil {
+		return nil, err
+	}
+	return &quote, nil
+}
+
+// IssuePolicy issues the policy of a quote
+func (c *InsuranceClient) IssuePolicy(ctx context.Context, req *IssuePolicyRequest) (*IssuedPolicy, error) {
+	var policy IssuedPolicy
+	if err := c.post(ctx, "/v1/policies", req, &policy); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// FileClaim files a claim on an issued policy
+func (c *InsuranceClient) FileClaim(ctx context.Context, req *InsuranceClaimRequest) (*InsuranceClaim, error) {
+	var claim InsuranceClaim
+	if err := c.post(ctx, "/v1/claims", req, &claim); err != nil {
+		return nil, err
+	}
+	return &claim, nil
+}
+
+// post sends a JSON request to the partner API and decodes the response into out
+func (c *InsuranceClient) post(ctx context.Context, path string, in, out interface{}) error {
+	jsonData, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+c.apiKey)
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+		return fmt.Errorf("%w: %s - %s", ErrInsuranceRejected, resp.Status, string(body))
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("insurance API error: %s - %s", resp.Status, string(body))
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return nil
+}
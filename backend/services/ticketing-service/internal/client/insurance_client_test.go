@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsuranceClient_Quote(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/quotes", r.URL.Path)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		var req InsuranceQuoteRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, money.New(300000), req.Coverage)
+
+		w.Write([]byte(`{"quote_id":"q-1","premium":15000,"coverage":300000,"currency":"IDR"}`))
+	}))
+	defer server.Close()
+
+	c := NewInsuranceClient(server.URL, "secret", time.Second)
+	quote, err := c.Quote(context.Background(), &InsuranceQuoteRequest{Coverage: money.New(300000), Currency: "IDR"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "q-1", quote.QuoteID)
+	assert.Equal(t, money.New(15000), quote.Premium)
+}
+
+func TestInsuranceClient_Errors(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		wantRejected bool
+	}{
+		{"rejected", http.StatusUnprocessableEntity, true},
+		{"rate limited", http.StatusTooManyRequests, false},
+		{"partner down", http.StatusBadGateway, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			c := NewInsuranceClient(server.URL, "secret", time.Second)
+			_, err := c.IssuePolicy(context.Background(), &IssuePolicyRequest{QuoteID: "q-1", Reference: "order-1"})
+			require.Error(t, err)
+			assert.Equal(t, tt.wantRejected, errors.Is(err, ErrInsuranceRejected))
+		})
+	}
+}
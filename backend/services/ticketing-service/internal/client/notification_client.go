@@ -2,25 +2,46 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
 
 	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/notification"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/faultinject"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/interceptor"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/serviceauth"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// ErrAnnouncementQuotaExceeded is returned when an announcement exceeds the organizer's monthly email quota
+	ErrAnnouncementQuotaExceeded = errors.New("announcement email quota exceeded")
+	// ErrInvalidDigestFrequency is returned for a digest frequency notification service doesn't know
+	ErrInvalidDigestFrequency = errors.New("invalid digest frequency")
+	// ErrDigestsDisabled is returned when digest preferences are changed while notification service has digests disabled
+	ErrDigestsDisabled = errors.New("notification digests are disabled")
 )
 
 // NotificationClient handles gRPC communication with Notification Service
 type NotificationClient struct {
-	client pb.NotificationServiceClient
-	conn   *grpc.ClientConn
+	client         pb.NotificationServiceClient
+	conn           *grpc.ClientConn
+	maxSendMsgSize int
 }
 
 // NewNotificationClient creates new notification gRPC client instance
 // Connection is lazy and will auto-reconnect if service is unavailable
-func NewNotificationClient(grpcURL string) (*NotificationClient, error) {
+// maxRecvMsgSize and maxSendMsgSize limit the size of a single gRPC message (bytes)
+// faults injects configured latency and errors into calls outside production, may be nil
+// identity signs the service token attached to every call, may be nil
+func NewNotificationClient(grpcURL string, maxRecvMsgSize, maxSendMsgSize int, faults *faultinject.Injector, identity *serviceauth.Identity) (*NotificationClient, error) {
 	// Use grpc.NewClient for lazy connection with auto-reconnect
 	// No WithBlock() - this allows the client to connect lazily and reconnect automatically
 
@@ -38,6 +59,14 @@ func NewNotificationClient(grpcURL string) (*NotificationClient, error) {
 	conn, err := grpc.NewClient(
 		grpcURL,
 		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(maxRecvMsgSize),
+			grpc.MaxCallSendMsgSize(maxSendMsgSize),
+		),
+		interceptor.UnaryClient(),
+		interceptor.StreamClient(),
+		faults.DialOption(faultinject.TargetNotification),
+		identity.DialOption(serviceauth.ServiceNotification),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create notification client: %w", err)
@@ -48,8 +77,9 @@ func NewNotificationClient(grpcURL string) (*NotificationClient, error) {
 	log.Printf("[NotificationGRPC] Notification client initialized for %s (lazy connection with auto-reconnect)", grpcURL)
 
 	return &NotificationClient{
-		client: client,
-		conn:   conn,
+		client:         client,
+		conn:           conn,
+		maxSendMsgSize: maxSendMsgSize,
 	}, nil
 }
 
@@ -61,9 +91,50 @@ type SendTicketEmailRequest struct {
 	EventName      string
 	EventLocation  string
 	EventStartTime string
-	TotalAmount    float64
+	TotalAmount    money.Money
 	PaymentMethod  string
 	Tickets        []TicketInfo
+	Branding       *EmailBranding // Tenant branding, nil for the platform defaults
+	// Policy versions accepted with the order, stated on the receipt
+	AcceptedPolicies []AcceptedPolicy
+	// Refund policy accepted with the order, rendered on the receipt and e-tickets; empty if none
+	RefundPolicyTitle string
+	RefundPolicy      string
+	// Test order of a sandbox event, the email and e-tickets are marked TEST
+	IsSandbox bool
+	// Tickets reissued with new QR codes, the email says the codes sent before no longer work
+	IsReissue bool
+	// Event organizer's e-ticket layout, nil for the platform layout
+	TicketTemplate *TicketTemplate
+}
+
+// AcceptedPolicy represents a policy version the buyer accepted with the order
+type AcceptedPolicy struct {
+	Title       string
+	VersionHash string
+	AcceptedAt  time.Time
+}
+
+// EmailBranding represents a tenant's white-label email branding
+// The sender (from and reply-to) is the organizer's own once its domain is verified
+type EmailBranding struct {
+	BrandName    string
+	FromName     string
+	FromEmail    string
+	LogoURL      string
+	PrimaryColor string
+	SupportEmail string
+	ReplyTo      string
+}
+
+// TicketTemplate represents an organizer's customized e-ticket PDF layout
+// Empty fields keep the platform layout
+type TicketTemplate struct {
+	LogoURL       string
+	PrimaryColor  string
+	AccentColor   string
+	SponsorFooter string
+	TermsText     string
 }
 
 // TicketInfo represents ticket information for email
@@ -71,10 +142,11 @@ type TicketInfo struct {
 	TicketID string
 	QRCode   string
 	TierName string
-	Price    float64
+	Price    money.Money
 }
 
 // SendTicketEmail sends e-ticket email via gRPC
+// Large orders that would exceed the max message size are sent via StreamTicketEmail
 func (c *NotificationClient) SendTicketEmail(ctx context.Context, req *SendTicketEmailRequest) error {
 	callCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
@@ -86,39 +158,467 @@ func (c *NotificationClient) SendTicketEmail(ctx context.Context, req *SendTicke
 			TicketId: ticket.TicketID,
 			QrCode:   ticket.QRCode,
 			TierName: ticket.TierName,
-			Price:    ticket.Price,
+			Price:    ticket.Price.Float64(),
 		}
 	}
 
 	// Convert to gRPC request
 	grpcReq := &pb.SendTicketEmailRequest{
+		OrderId:           req.OrderID,
+		RecipientEmail:    req.RecipientEmail,
+		RecipientName:     req.RecipientName,
+		EventName:         req.EventName,
+		EventLocation:     req.EventLocation,
+		EventStartTime:    req.EventStartTime,
+		TotalAmount:       req.TotalAmount.Float64(),
+		PaymentMethod:     req.PaymentMethod,
+		Tickets:           pbTickets,
+		RefundPolicyTitle: req.RefundPolicyTitle,
+		RefundPolicy:      req.RefundPolicy,
+		IsSandbox:         req.IsSandbox,
+		IsReissue:         req.IsReissue,
+	}
+	for _, policy := range req.AcceptedPolicies {
+		grpcReq.AcceptedPolicies = append(grpcReq.AcceptedPolicies, &pb.AcceptedPolicy{
+			Title:       policy.Title,
+			VersionHash: policy.VersionHash,
+			AcceptedAt:  policy.AcceptedAt.Format(time.RFC3339),
+		})
+	}
+	if b := req.Branding; b != nil {
+		grpcReq.Branding = &pb.EmailBranding{
+			BrandName:    b.BrandName,
+			FromName:     b.FromName,
+			FromEmail:    b.FromEmail,
+			LogoUrl:      b.LogoURL,
+			PrimaryColor: b.PrimaryColor,
+			SupportEmail: b.SupportEmail,
+			ReplyTo:      b.ReplyTo,
+		}
+	}
+	if t := req.TicketTemplate; t != nil {
+		grpcReq.TicketTemplate = &pb.TicketTemplate{
+			LogoUrl:       t.LogoURL,
+			PrimaryColor:  t.PrimaryColor,
+			AccentColor:   t.AccentColor,
+			SponsorFooter: t.SponsorFooter,
+			TermsText:     t.TermsText,
+		}
+	}
+
+	// Use streaming when the unary request would exceed the max message size
+	var resp *pb.SendTicketEmailResponse
+	var err error
+	if proto.Size(grpcReq) > c.maxSendMsgSize {
+		log.Printf("[NotificationGRPC] Order %s has %d tickets (%d bytes), using StreamTicketEmail",
+			req.OrderID, len(pbTickets), proto.Size(grpcReq))
+		resp, err = c.streamTicketEmail(callCtx, grpcReq)
+	} else {
+		resp, err = c.client.SendTicketEmail(callCtx, grpcReq)
+	}
+	if err != nil {
+		return fmt.Errorf("gRPC call failed: %w", err)
+	}
+
+	// Check response success
+	if !resp.Success {
+		return fmt.Errorf("failed to send email: %s", resp.Message)
+	}
+
+	log.Printf("[NotificationGRPC] Email sent successfully for order %s, email ID: %s", req.OrderID, resp.EmailId)
+
+	return nil
+}
+
+// streamTicketEmail sends header first, then tickets in chunks that each fit maxSendMsgSize
+func (c *NotificationClient) streamTicketEmail(ctx context.Context, req *pb.SendTicketEmailRequest) (*pb.SendTicketEmailResponse, error) {
+	stream, err := c.client.StreamTicketEmail(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open stream: %w", err)
+	}
+
+	// Header carries order and recipient details without tickets
+	header := proto.Clone(req).(*pb.SendTicketEmailRequest)
+	header.Tickets = nil
+	if err := stream.Send(&pb.TicketEmailChunk{Header: header}); err != nil {
+		return nil, fmt.Errorf("failed to send header chunk: %w", err)
+	}
+
+	for _, tickets := range ticketChunks(req.Tickets, c.maxSendMsgSize) {
+		if err := stream.Send(&pb.TicketEmailChunk{Tickets: tickets}); err != nil {
+			return nil, fmt.Errorf("failed to send tickets chunk: %w", err)
+		}
+	}
+
+	return stream.CloseAndRecv()
+}
+
+// ticketChunks splits tickets into chunks whose TicketEmailChunk message stays within maxSize bytes
+// Tickets carry QR images of varying size, so chunks are sized by their encoding, not their count.
+// A single ticket larger than maxSize gets a chunk of its own, which gRPC then rejects
+func ticketChunks(tickets []*pb.Ticket, maxSize int) [][]*pb.Ticket {
+	var chunks [][]*pb.Ticket
+	start, size := 0, 0
+	for i, ticket := range tickets {
+		// Repeated fields encode element by element, so a chunk's size is the sum of its tickets' sizes
+		ticketSize := proto.Size(&pb.TicketEmailChunk{Tickets: []*pb.Ticket{ticket}})
+		if i > start && size+ticketSize > maxSize {
+			chunks = append(chunks, tickets[start:i])
+			start, size = i, 0
+		}
+		size += ticketSize
+	}
+	if start < len(tickets) {
+		chunks = append(chunks, tickets[start:])
+	}
+	return chunks
+}
+
+// GenerateBadgePDFRequest represents request to render attendee badges of an event
+type GenerateBadgePDFRequest struct {
+	EventName      string
+	EventStartTime string
+	BrandName      string // Tenant brand name, empty for the platform default
+	Badges         []BadgeInfo
+}
+
+// BadgeInfo represents one attendee badge
+type BadgeInfo struct {
+	TicketID     string
+	TicketNumber string
+	AttendeeName string
+	Company      string
+	TierName     string
+	QRCode       string
+}
+
+// GenerateBadgePDF renders badges into one printable PDF via gRPC
+func (c *NotificationClient) GenerateBadgePDF(ctx context.Context, req *GenerateBadgePDFRequest) ([]byte, error) {
+	callCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	pbBadges := make([]*pb.Badge, len(req.Badges))
+	for i, badge := range req.Badges {
+		pbBadges[i] = &pb.Badge{
+			TicketId:     badge.TicketID,
+			TicketNumber: badge.TicketNumber,
+			AttendeeName: badge.AttendeeName,
+			Company:      badge.Company,
+			TierName:     badge.TierName,
+			QrCode:       badge.QRCode,
+		}
+	}
+
+	resp, err := c.client.GenerateBadgePDF(callCtx, &pb.GenerateBadgePDFRequest{
+		EventName:      req.EventName,
+		EventStartTime: req.EventStartTime,
+		BrandName:      req.BrandName,
+		Badges:         pbBadges,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("gRPC call failed: %w", err)
+	}
+
+	log.Printf("[NotificationGRPC] Badge PDF generated for %s, badges: %d, size: %d bytes", req.EventName, resp.BadgeCount, len(resp.Pdf))
+
+	return resp.Pdf, nil
+}
+
+// SendAnnouncementEmailRequest represents an organizer's announcement to event attendees
+type SendAnnouncementEmailRequest struct {
+	OrganizerID       string
+	EventID           string
+	EventName         string
+	Subject           string
+	Message           string
+	RecipientEmails   []string
+	Plan              string
+	MonthlyEmailQuota int // 0 = unlimited
+	Branding          *EmailBranding
+	Urgent            bool // Sent right away, even to recipients who get a digest
+}
+
+// SendAnnouncementEmailResponse represents the outcome of an announcement
+type SendAnnouncementEmailResponse struct {
+	SentCount     int
+	FailedCount   int
+	QuotaUsed     int
+	QuotaLimit    int
+	DigestedCount int // Recipients who get the announcement in their next digest
+}
+
+// SendAnnouncementEmail sends an announcement email to each recipient via gRPC
+// Returns ErrAnnouncementQuotaExceeded, with notification service's explanation, when the recipients don't fit the quota
+func (c *NotificationClient) SendAnnouncementEmail(ctx context.Context, req *SendAnnouncementEmailRequest) (*SendAnnouncementEmailResponse, error) {
+	callCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	grpcReq := &pb.SendAnnouncementEmailRequest{
+		OrganizerId:       req.OrganizerID,
+		EventId:           req.EventID,
+		EventName:         req.EventName,
+		Subject:           req.Subject,
+		Message:           req.Message,
+		RecipientEmails:   req.RecipientEmails,
+		Plan:              req.Plan,
+		MonthlyEmailQuota: int32(req.MonthlyEmailQuota),
+		Urgent:            req.Urgent,
+	}
+	if b := req.Branding; b != nil {
+		grpcReq.Branding = &pb.EmailBranding{
+			BrandName:    b.BrandName,
+			FromName:     b.FromName,
+			FromEmail:    b.FromEmail,
+			LogoUrl:      b.LogoURL,
+			PrimaryColor: b.PrimaryColor,
+			SupportEmail: b.SupportEmail,
+			ReplyTo:      b.ReplyTo,
+		}
+	}
+
+	resp, err := c.client.SendAnnouncementEmail(callCtx, grpcReq)
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.ResourceExhausted {
+			return nil, fmt.Errorf("%w: %s", ErrAnnouncementQuotaExceeded, st.Message())
+		}
+		return nil, fmt.Errorf("gRPC call failed: %w", err)
+	}
+
+	log.Printf("[NotificationGRPC] Announcement sent for event %s, sent: %d, digested: %d, failed: %d", req.EventID, resp.SentCount, resp.DigestedCount, resp.FailedCount)
+
+	return &SendAnnouncementEmailResponse{
+		SentCount:     int(resp.SentCount),
+		FailedCount:   int(resp.FailedCount),
+		QuotaUsed:     int(resp.QuotaUsed),
+		QuotaLimit:    int(resp.QuotaLimit),
+		DigestedCount: int(resp.DigestedCount),
+	}, nil
+}
+
+// SendReservationReminderEmailRequest represents an unpaid reservation about to expire
+type SendReservationReminderEmailRequest struct {
+	OrderID        string
+	RecipientEmail string
+	RecipientName  string
+	EventName      string
+	GrandTotal     money.Money
+	PaymentURL     string
+	ExpiresAt      time.Time
+	Branding       *EmailBranding // Tenant branding, nil for the platform defaults
+}
+
+// SendReservationReminderEmail sends the payment reminder of a reservation via gRPC
+func (c *NotificationClient) SendReservationReminderEmail(ctx context.Context, req *SendReservationReminderEmailRequest) error {
+	callCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.SendReservationReminderEmailRequest{
 		OrderId:        req.OrderID,
 		RecipientEmail: req.RecipientEmail,
 		RecipientName:  req.RecipientName,
 		EventName:      req.EventName,
+		GrandTotal:     req.GrandTotal.Float64(),
+		PaymentUrl:     req.PaymentURL,
+		ExpiresAt:      req.ExpiresAt.Format(time.RFC3339),
+	}
+	if b := req.Branding; b != nil {
+		grpcReq.Branding = &pb.EmailBranding{
+			BrandName:    b.BrandName,
+			FromName:     b.FromName,
+			FromEmail:    b.FromEmail,
+			LogoUrl:      b.LogoURL,
+			PrimaryColor: b.PrimaryColor,
+			SupportEmail: b.SupportEmail,
+			ReplyTo:      b.ReplyTo,
+		}
+	}
+
+	resp, err := c.client.SendReservationReminderEmail(callCtx, grpcReq)
+	if err != nil {
+		return fmt.Errorf("gRPC call failed: %w", err)
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("failed to send reminder email: %s", resp.Message)
+	}
+
+	log.Printf("[NotificationGRPC] Reservation reminder sent for order %s", req.OrderID)
+
+	return nil
+}
+
+// SendInvitationEmailRequest represents an event invitation to be claimed by the invitee
+type SendInvitationEmailRequest struct {
+	InvitationID   string
+	RecipientEmail string
+	RecipientName  string
+	EventName      string
+	EventLocation  string
+	EventStartTime time.Time
+	TierName       string
+	Quantity       int
+	Price          money.Money // Unit price, zero for complimentary tickets
+	ClaimURL       string
+	ClaimDeadline  time.Time
+	Branding       *EmailBranding // Tenant branding, nil for the platform defaults
+}
+
+// SendInvitationEmail sends the claim link of an event invitation via gRPC
+func (c *NotificationClient) SendInvitationEmail(ctx context.Context, req *SendInvitationEmailRequest) error {
+	callCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.SendInvitationEmailRequest{
+		InvitationId:   req.InvitationID,
+		RecipientEmail: req.RecipientEmail,
+		RecipientName:  req.RecipientName,
+		EventName:      req.EventName,
 		EventLocation:  req.EventLocation,
-		EventStartTime: req.EventStartTime,
-		TotalAmount:    req.TotalAmount,
-		PaymentMethod:  req.PaymentMethod,
-		Tickets:        pbTickets,
+		EventStartTime: req.EventStartTime.Format(time.RFC3339),
+		TierName:       req.TierName,
+		Quantity:       int32(req.Quantity),
+		Price:          req.Price.Float64(),
+		ClaimUrl:       req.ClaimURL,
+		ClaimDeadline:  req.ClaimDeadline.Format(time.RFC3339),
+	}
+	if b := req.Branding; b != nil {
+		grpcReq.Branding = &pb.EmailBranding{
+			BrandName:    b.BrandName,
+			FromName:     b.FromName,
+			FromEmail:    b.FromEmail,
+			LogoUrl:      b.LogoURL,
+			PrimaryColor: b.PrimaryColor,
+			SupportEmail: b.SupportEmail,
+			ReplyTo:      b.ReplyTo,
+		}
 	}
 
-	// Call gRPC service
-	resp, err := c.client.SendTicketEmail(callCtx, grpcReq)
+	resp, err := c.client.SendInvitationEmail(callCtx, grpcReq)
 	if err != nil {
 		return fmt.Errorf("gRPC call failed: %w", err)
 	}
 
-	// Check response success
 	if !resp.Success {
-		return fmt.Errorf("failed to send email: %s", resp.Message)
+		return fmt.Errorf("failed to send invitation email: %s", resp.Message)
 	}
 
-	log.Printf("[NotificationGRPC] Email sent successfully for order %s, email ID: %s", req.OrderID, resp.EmailId)
+	log.Printf("[NotificationGRPC] Invitation email sent for invitation %s", req.InvitationID)
 
 	return nil
 }
 
+// SendWaitlistOfferEmailRequest represents seats of a sold-out tier held for a waitlisted buyer
+type SendWaitlistOfferEmailRequest struct {
+	EntryID        string
+	RecipientEmail string
+	RecipientName  string
+	EventName      string
+	EventLocation  string
+	EventStartTime time.Time
+	TierName       string
+	Quantity       int
+	Price          money.Money // Unit price of the tier
+	PurchaseURL    string
+	OfferExpiresAt time.Time
+	Branding       *EmailBranding // Tenant branding, nil for the platform defaults
+}
+
+// SendWaitlistOfferEmail tells a waitlisted buyer about their purchase window via gRPC
+func (c *NotificationClient) SendWaitlistOfferEmail(ctx context.Context, req *SendWaitlistOfferEmailRequest) error {
+	callCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.SendWaitlistOfferEmailRequest{
+		EntryId:        req.EntryID,
+		RecipientEmail: req.RecipientEmail,
+		RecipientName:  req.RecipientName,
+		EventName:      req.EventName,
+		EventLocation:  req.EventLocation,
+		EventStartTime: req.EventStartTime.Format(time.RFC3339),
+		TierName:       req.TierName,
+		Quantity:       int32(req.Quantity),
+		Price:          req.Price.Float64(),
+		PurchaseUrl:    req.PurchaseURL,
+		OfferExpiresAt: req.OfferExpiresAt.Format(time.RFC3339),
+	}
+	if b := req.Branding; b != nil {
+		grpcReq.Branding = &pb.EmailBranding{
+			BrandName:    b.BrandName,
+			FromName:     b.FromName,
+			FromEmail:    b.FromEmail,
+			LogoUrl:      b.LogoURL,
+			PrimaryColor: b.PrimaryColor,
+			SupportEmail: b.SupportEmail,
+			ReplyTo:      b.ReplyTo,
+		}
+	}
+
+	resp, err := c.client.SendWaitlistOfferEmail(callCtx, grpcReq)
+	if err != nil {
+		return fmt.Errorf("gRPC call failed: %w", err)
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("failed to send waitlist offer email: %s", resp.Message)
+	}
+
+	log.Printf("[NotificationGRPC] Waitlist offer email sent for waitlist entry %s", req.EntryID)
+
+	return nil
+}
+
+// DigestPreference represents how often a recipient gets non-urgent notifications
+type DigestPreference struct {
+	Email        string
+	Frequency    string   // immediate, daily or weekly
+	DigestTypes  []string // Notification types that wait for the digest
+	PendingCount int
+	NextDigestAt *time.Time // Nil when nothing is waiting
+}
+
+// GetDigestPreference returns the digest preference of a recipient via gRPC
+func (c *NotificationClient) GetDigestPreference(ctx context.Context, email string) (*DigestPreference, error) {
+	resp, err := c.client.GetDigestPreference(ctx, &pb.GetDigestPreferenceRequest{Email: email})
+	if err != nil {
+		return nil, fmt.Errorf("gRPC call failed: %w", err)
+	}
+	return digestPreferenceFromProto(resp), nil
+}
+
+// SetDigestPreference changes the digest frequency of a recipient via gRPC
+// Returns ErrInvalidDigestFrequency or ErrDigestsDisabled with notification service's explanation
+func (c *NotificationClient) SetDigestPreference(ctx context.Context, email, frequency string) (*DigestPreference, error) {
+	resp, err := c.client.SetDigestPreference(ctx, &pb.SetDigestPreferenceRequest{Email: email, Frequency: frequency})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.InvalidArgument:
+				return nil, fmt.Errorf("%w: %s", ErrInvalidDigestFrequency, st.Message())
+			case codes.FailedPrecondition:
+				return nil, fmt.Errorf("%w: %s", ErrDigestsDisabled, st.Message())
+			}
+		}
+		return nil, fmt.Errorf("gRPC call failed: %w", err)
+	}
+
+	log.Printf("[NotificationGRPC] Digest frequency of %s set to %s", email, resp.Frequency)
+
+	return digestPreferenceFromProto(resp), nil
+}
+
+func digestPreferenceFromProto(resp *pb.DigestPreference) *DigestPreference {
+	preference := &DigestPreference{
+		Email:        resp.Email,
+		Frequency:    resp.Frequency,
+		DigestTypes:  resp.DigestTypes,
+		PendingCount: int(resp.PendingCount),
+	}
+	if t, err := time.Parse(time.RFC3339, resp.NextDigestAt); err == nil {
+		preference.NextDigestAt = &t
+	}
+	return preference
+}
+
 // Close closes the gRPC connection
 func (c *NotificationClient) Close() error {
 	if c.conn != nil {
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/notification"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTicketChunks(t *testing.T) {
+	newTickets := func(n, qrSize int) []*pb.Ticket {
+		tickets := make([]*pb.Ticket, n)
+		for i := range tickets {
+			tickets[i] = &pb.Ticket{TicketId: fmt.Sprintf("ticket-%d", i), QrCode: strings.Repeat("q", qrSize), TierName: "VIP"}
+		}
+		return tickets
+	}
+
+	t.Run("every chunk fits the message size", func(t *testing.T) {
+		tickets := newTickets(50, 3000)
+		maxSize := 10 * 1024
+
+		chunks := ticketChunks(tickets, maxSize)
+		assert.Greater(t, len(chunks), 1)
+
+		total := 0
+		for _, chunk := range chunks {
+			assert.LessOrEqual(t, proto.Size(&pb.TicketEmailChunk{Tickets: chunk}), maxSize)
+			total += len(chunk)
+		}
+		assert.Equal(t, len(tickets), total)
+	})
+
+	t.Run("small tickets share one chunk", func(t *testing.T) {
+		chunks := ticketChunks(newTickets(50, 100), 4*1024*1024)
+		assert.Len(t, chunks, 1)
+		assert.Len(t, chunks[0], 50)
+	})
+
+	t.Run("ticket larger than the message size gets its own chunk", func(t *testing.T) {
+		tickets := append(newTickets(1, 100), newTickets(1, 5000)...)
+
+		chunks := ticketChunks(tickets, 1024)
+		assert.Len(t, chunks, 2)
+	})
+
+	t.Run("no tickets", func(t *testing.T) {
+		assert.Empty(t, ticketChunks(nil, 1024))
+	})
+}
@@ -7,6 +7,11 @@ import (
 	"time"
 
 	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/payment"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/faultinject"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/interceptor"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/serviceauth"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
@@ -20,7 +25,10 @@ type PaymentClient struct {
 
 // NewPaymentClient creates new payment gRPC client
 // Connection is lazy and will auto-reconnect if service is unavailable
-func NewPaymentClient(grpcURL string) (*PaymentClient, error) {
+// maxRecvMsgSize and maxSendMsgSize limit the size of a single gRPC message (bytes)
+// faults injects configured latency and errors into calls outside production, may be nil
+// identity signs the service token attached to every call, may be nil
+func NewPaymentClient(grpcURL string, maxRecvMsgSize, maxSendMsgSize int, faults *faultinject.Injector, identity *serviceauth.Identity) (*PaymentClient, error) {
 	// Use grpc.NewClient for lazy connection with auto-reconnect
 	// No WithBlock() - this allows the client to connect lazily and reconnect automatically
 
@@ -38,6 +46,14 @@ func NewPaymentClient(grpcURL string) (*PaymentClient, error) {
 	conn, err := grpc.NewClient(
 		grpcURL,
 		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(maxRecvMsgSize),
+			grpc.MaxCallSendMsgSize(maxSendMsgSize),
+		),
+		interceptor.UnaryClient(),
+		interceptor.StreamClient(),
+		faults.DialOption(faultinject.TargetPayment),
+		identity.DialOption(serviceauth.ServicePayment),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create payment client: %w", err)
@@ -66,16 +82,17 @@ type CreateInvoiceRequest struct {
 	UserID       string
 	Email        string
 	CustomerName string
-	Amount       float64
+	Amount       money.Money
 	Description  string
 	Items        []InvoiceItem
+	Sandbox      bool // Invoiced with the payment gateway's test keys
 }
 
 // InvoiceItem represents a line item
 type InvoiceItem struct {
 	Name     string
 	Quantity int
-	Price    float64
+	Price    money.Money
 }
 
 // CreateInvoiceResponse contains invoice creation result
@@ -84,7 +101,7 @@ type CreateInvoiceResponse struct {
 	InvoiceID  string
 	InvoiceURL string
 	ExternalID string
-	Amount     float64
+	Amount     money.Money
 	Status     string
 	ExpiresAt  time.Time
 	CreatedAt  time.Time
@@ -98,7 +115,7 @@ func (c *PaymentClient) CreateInvoice(ctx context.Context, req *CreateInvoiceReq
 		pbItems[i] = &pb.InvoiceItem{
 			Name:     item.Name,
 			Quantity: int32(item.Quantity),
-			Price:    item.Price,
+			Price:    item.Price.Float64(),
 		}
 	}
 
@@ -108,9 +125,10 @@ func (c *PaymentClient) CreateInvoice(ctx context.Context, req *CreateInvoiceReq
 		UserId:       req.UserID,
 		Email:        req.Email,
 		CustomerName: req.CustomerName,
-		Amount:       req.Amount,
+		Amount:       req.Amount.Float64(),
 		Description:  req.Description,
 		Items:        pbItems,
+		Sandbox:      req.Sandbox,
 	}
 
 	// Call gRPC endpoint with timeout
@@ -132,7 +150,7 @@ func (c *PaymentClient) CreateInvoice(ctx context.Context, req *CreateInvoiceReq
 		InvoiceID:  resp.InvoiceId,
 		InvoiceURL: resp.InvoiceUrl,
 		ExternalID: resp.ExternalId,
-		Amount:     resp.Amount,
+		Amount:     money.FromFloat(resp.Amount),
 		Status:     resp.Status,
 		ExpiresAt:  expiresAt,
 		CreatedAt:  createdAt,
@@ -145,7 +163,7 @@ func (c *PaymentClient) GetPaymentStatus(ctx context.Context, orderID string) (*
 		OrderId: orderID,
 	}
 
-	callCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	callCtx, cancel := timeout.WithRPCTimeout(ctx)
 	defer cancel()
 
 	resp, err := c.client.GetPaymentStatus(callCtx, grpcReq)
@@ -154,14 +172,61 @@ func (c *PaymentClient) GetPaymentStatus(ctx context.Context, orderID string) (*
 	}
 
 	createdAt, _ := time.Parse(time.RFC3339, resp.CreatedAt)
-	paidAt, _ := time.Parse(time.RFC3339, resp.PaidAt)
+	expiresAt, _ := time.Parse(time.RFC3339, resp.ExpiresAt)
 
 	return &CreateInvoiceResponse{
 		PaymentID:  resp.PaymentId,
 		InvoiceID:  resp.InvoiceId,
-		Amount:     resp.Amount,
+		InvoiceURL: resp.InvoiceUrl,
+		Amount:     money.FromFloat(resp.Amount),
 		Status:     resp.Status,
 		CreatedAt:  createdAt,
-		ExpiresAt:  paidAt,
+		ExpiresAt:  expiresAt,
 	}, nil
 }
+
+// ExpireInvoiceResponse contains the result of expiring a cancelled order's invoices
+type ExpireInvoiceResponse struct {
+	InvoicesExpired int
+	AlreadyPaid     bool // A payment settled before the invoice could be expired
+}
+
+// ExpireInvoice expires the pending invoices of a cancelled order via gRPC
+func (c *PaymentClient) ExpireInvoice(ctx context.Context, orderID, reason string) (*ExpireInvoiceResponse, error) {
+	grpcReq := &pb.ExpireInvoiceRequest{
+		OrderId: orderID,
+		Reason:  reason,
+	}
+
+	callCtx, cancel := timeout.WithRPCTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.client.ExpireInvoice(callCtx, grpcReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expire invoice via gRPC: %w", err)
+	}
+
+	return &ExpireInvoiceResponse{
+		InvoicesExpired: int(resp.InvoicesExpired),
+		AlreadyPaid:     resp.AlreadyPaid,
+	}, nil
+}
+
+// RecordTicketEmail reports that the tickets of an order paid with paymentID were emailed via gRPC
+// Returns whether payment service had a payment saga to complete
+func (c *PaymentClient) RecordTicketEmail(ctx context.Context, orderID, paymentID string) (bool, error) {
+	grpcReq := &pb.RecordTicketEmailRequest{
+		OrderId:   orderID,
+		PaymentId: paymentID,
+	}
+
+	callCtx, cancel := timeout.WithRPCTimeout(ctx)
+	defer cancel()
+
+	resp, err := c.client.RecordTicketEmail(callCtx, grpcReq)
+	if err != nil {
+		return false, fmt.Errorf("failed to record ticket email via gRPC: %w", err)
+	}
+
+	return resp.Recorded, nil
+}
@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// AccommodationController handles HTTP requests for attendee lists and accessibility accommodations
+type AccommodationController struct {
+	accommodationService service.AccommodationService
+}
+
+// NewAccommodationController creates new accommodation controller instance
+func NewAccommodationController(accommodationService service.AccommodationService) *AccommodationController {
+	return &AccommodationController{accommodationService: accommodationService}
+}
+
+// ListAttendees handles GET /attendees?event_id= - Ticket holders of an event with their accommodations
+// accommodations_only=true lists only tickets with an accommodation request
+func (c *AccommodationController) ListAttendees(ctx *gin.Context) {
+	eventID := ctx.Query("event_id")
+	if eventID == "" {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrEventIDRequired, sharedresponse.CodeInvalidRequest, nil))
+		return
+	}
+
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	// Parse pagination parameters
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "50"))
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	accommodationsOnly, _ := strconv.ParseBool(ctx.Query("accommodations_only"))
+
+	attendees, total, err := c.accommodationService.ListAttendees(ctx.Request.Context(), userID.(string), ctx.GetString("role"), eventID, accommodationsOnly, page, limit)
+	if err != nil {
+		c.respondAccommodationError(ctx, err)
+		return
+	}
+
+	// Calculate pagination metadata
+	totalPages := int(total) / limit
+	if int(total)%limit > 0 {
+		totalPages++
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.SuccessWithPagination(
+		message.MsgAttendeesRetrieved,
+		attendees,
+		sharedresponse.PaginationMeta{
+			CurrentPage: page,
+			PerPage:     limit,
+			Total:       int(total),
+			TotalPages:  totalPages,
+		},
+	))
+}
+
+// GetSummary handles GET /attendees/accommodations?event_id= - Accommodation requests by type and accessible seating per tier
+func (c *AccommodationController) GetSummary(ctx *gin.Context) {
+	eventID := ctx.Query("event_id")
+	if eventID == "" {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrEventIDRequired, sharedresponse.CodeInvalidRequest, nil))
+		return
+	}
+
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	summary, err := c.accommodationService.GetSummary(ctx.Request.Context(), userID.(string), ctx.GetString("role"), eventID)
+	if err != nil {
+		c.respondAccommodationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgAccommodationsSummary, summary))
+}
+
+// respondAccommodationError maps attendee list errors to HTTP responses
+func (c *AccommodationController) respondAccommodationError(ctx *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorMessage := message.ErrInternalServer
+	errorCode := sharedresponse.CodeInternal
+
+	if errors.Is(err, service.ErrEventNotFound) {
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrEventNotFound
+		errorCode = sharedresponse.CodeEventNotFound
+	} else if errors.Is(err, service.ErrUnauthorized) {
+		statusCode = http.StatusForbidden
+		errorMessage = message.ErrForbidden
+		errorCode = sharedresponse.CodeForbidden
+	}
+
+	ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, nil))
+}
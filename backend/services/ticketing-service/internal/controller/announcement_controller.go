@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// AnnouncementController handles HTTP requests for organizer announcements
+type AnnouncementController struct {
+	announcementService service.AnnouncementService
+}
+
+// NewAnnouncementController creates new announcement controller instance
+func NewAnnouncementController(announcementService service.AnnouncementService) *AnnouncementController {
+	return &AnnouncementController{announcementService: announcementService}
+}
+
+// SendAnnouncement handles POST /announcements - Email an announcement to an event's ticket holders
+func (c *AnnouncementController) SendAnnouncement(ctx *gin.Context) {
+	var req request.SendAnnouncementRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	result, err := c.announcementService.SendAnnouncement(ctx.Request.Context(), userID.(string), ctx.GetString("role"), &req)
+	if err != nil {
+		log.Printf("[ERROR] SendAnnouncement failed for user %s, event %s: %v", userID.(string), req.EventID, err)
+
+		switch {
+		case errors.Is(err, service.ErrUnauthorized):
+			ctx.JSON(http.StatusForbidden, sharedresponse.ErrorWithCode(message.ErrForbidden, sharedresponse.CodeForbidden, nil))
+		case errors.Is(err, service.ErrEventNotFound):
+			ctx.JSON(http.StatusNotFound, sharedresponse.ErrorWithCode(message.ErrEventNotFound, sharedresponse.CodeEventNotFound, nil))
+		case errors.Is(err, service.ErrNoAnnouncementRecipients):
+			ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrNoAnnouncementRecipients, sharedresponse.CodeNoAnnouncementRecipients, nil))
+		case errors.Is(err, service.ErrAnnouncementQuotaExceeded):
+			// Nothing was sent; the error explains the organizer's usage and limit
+			ctx.JSON(http.StatusForbidden, sharedresponse.ErrorWithCode(message.ErrAnnouncementQuotaExceeded, sharedresponse.CodeAnnouncementQuotaExceeded, err.Error()))
+		default:
+			ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgAnnouncementSent, result))
+}
@@ -0,0 +1,201 @@
+package controller
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// KioskTokenHeader carries the device token of a check-in kiosk
+const KioskTokenHeader = "X-Kiosk-Token"
+
+// BadgeController handles HTTP requests for badges and check-in kiosks
+type BadgeController struct {
+	badgeService service.BadgeService
+}
+
+// NewBadgeController creates new badge controller instance
+func NewBadgeController(badgeService service.BadgeService) *BadgeController {
+	return &BadgeController{badgeService: badgeService}
+}
+
+// GetBadge handles GET /tickets/:id/badge - Badge-ready data of a ticket (organizer or admin)
+func (c *BadgeController) GetBadge(ctx *gin.Context) {
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	badge, err := c.badgeService.GetBadge(ctx.Request.Context(), userID.(string), ctx.GetString("role"), ctx.Param("id"))
+	if err != nil {
+		log.Printf("[ERROR] GetBadge failed for user %s, ticket %s: %v", userID.(string), ctx.Param("id"), err)
+		c.respondBadgeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgBadgeRetrieved, badge))
+}
+
+// GenerateBadgePDF handles POST /badges/pdf - Printable badges of an event as one PDF
+func (c *BadgeController) GenerateBadgePDF(ctx *gin.Context) {
+	var req request.BadgePDFRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	pdf, err := c.badgeService.GenerateBadgePDF(ctx.Request.Context(), userID.(string), ctx.GetString("role"), &req)
+	if err != nil {
+		log.Printf("[ERROR] GenerateBadgePDF failed for user %s, event %s: %v", userID.(string), req.EventID, err)
+		c.respondBadgeError(ctx, err)
+		return
+	}
+
+	ctx.Header("Content-Disposition", `attachment; filename="`+pdf.Filename+`"`)
+	ctx.Header("X-Badge-Count", strconv.Itoa(pdf.BadgeCount))
+	ctx.Data(http.StatusOK, "application/pdf", pdf.Content)
+}
+
+// RegisterKiosk handles POST /kiosks - Register a check-in kiosk for an event
+func (c *BadgeController) RegisterKiosk(ctx *gin.Context) {
+	var req request.RegisterKioskRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	kiosk, err := c.badgeService.RegisterKiosk(ctx.Request.Context(), userID.(string), ctx.GetString("role"), &req)
+	if err != nil {
+		c.respondBadgeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, sharedresponse.Success(message.MsgKioskRegistered, kiosk))
+}
+
+// ListKiosks handles GET /kiosks?event_id= - Kiosks registered for an event
+func (c *BadgeController) ListKiosks(ctx *gin.Context) {
+	eventID := ctx.Query("event_id")
+	if eventID == "" {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrEventIDRequired, sharedresponse.CodeInvalidRequest, nil))
+		return
+	}
+
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	kiosks, err := c.badgeService.ListKiosks(ctx.Request.Context(), userID.(string), ctx.GetString("role"), eventID)
+	if err != nil {
+		c.respondBadgeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgKiosksRetrieved, kiosks))
+}
+
+// RevokeKiosk handles DELETE /kiosks/:id - Revoke a kiosk's device token
+func (c *BadgeController) RevokeKiosk(ctx *gin.Context) {
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	kiosk, err := c.badgeService.RevokeKiosk(ctx.Request.Context(), userID.(string), ctx.GetString("role"), ctx.Param("id"))
+	if err != nil {
+		c.respondBadgeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgKioskRevoked, kiosk))
+}
+
+// KioskCheckIn handles POST /kiosk/checkin - Check in a ticket at a kiosk and return its badge
+// Authenticated by the X-Kiosk-Token header instead of a user JWT
+func (c *BadgeController) KioskCheckIn(ctx *gin.Context) {
+	token := ctx.GetHeader(KioskTokenHeader)
+	if token == "" {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrKioskInvalid, sharedresponse.CodeKioskInvalid, nil))
+		return
+	}
+
+	var req request.KioskCheckInRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	result, err := c.badgeService.KioskCheckIn(ctx.Request.Context(), token, &req)
+	if err != nil {
+		c.respondBadgeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgKioskCheckedIn, result))
+}
+
+// respondBadgeError maps badge and kiosk errors to HTTP responses
+// Ticket scan errors of kiosk check-ins are mapped like gate scans
+func (c *BadgeController) respondBadgeError(ctx *gin.Context, err error) {
+	var statusCode int
+	var errorMessage, errorCode string
+
+	switch {
+	case errors.Is(err, service.ErrUnauthorized):
+		statusCode = http.StatusForbidden
+		errorMessage = message.ErrForbidden
+		errorCode = sharedresponse.CodeForbidden
+	case errors.Is(err, service.ErrEventNotFound):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrEventNotFound
+		errorCode = sharedresponse.CodeEventNotFound
+	case errors.Is(err, service.ErrKioskNotFound):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrKioskNotFound
+		errorCode = sharedresponse.CodeKioskNotFound
+	case errors.Is(err, service.ErrKioskInvalid):
+		statusCode = http.StatusUnauthorized
+		errorMessage = message.ErrKioskInvalid
+		errorCode = sharedresponse.CodeKioskInvalid
+	case errors.Is(err, service.ErrTicketWrongEvent):
+		statusCode = http.StatusBadRequest
+		errorMessage = message.ErrTicketWrongEvent
+		errorCode = sharedresponse.CodeTicketWrongEvent
+	case errors.Is(err, service.ErrNoBadges):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrNoBadges
+		errorCode = sharedresponse.CodeNoBadges
+	case errors.Is(err, service.ErrTooManyBadges):
+		statusCode = http.StatusBadRequest
+		errorMessage = message.ErrTooManyBadges
+		errorCode = sharedresponse.CodeTooManyBadges
+	default:
+		respondValidateError(ctx, err)
+		return
+	}
+
+	ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, nil))
+}
@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// CheckinMonitorController handles HTTP requests for scanner heartbeats and live entrance throughput
+type CheckinMonitorController struct {
+	monitorService service.CheckinMonitorService
+}
+
+// NewCheckinMonitorController creates new check-in monitor controller instance
+func NewCheckinMonitorController(monitorService service.CheckinMonitorService) *CheckinMonitorController {
+	return &CheckinMonitorController{monitorService: monitorService}
+}
+
+// Heartbeat handles POST /public/scanners/heartbeat - Scanner app reports it is online at a gate
+func (c *CheckinMonitorController) Heartbeat(ctx *gin.Context) {
+	var req request.ScannerHeartbeatRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	staffID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	result, err := c.monitorService.Heartbeat(ctx.Request.Context(), staffID.(string), &req)
+	if err != nil {
+		c.respondMonitorError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgScannerHeartbeat, result))
+}
+
+// GetLive handles GET /organizer/events/:id/checkins/live - Scans per gate and minute, with scanners
+// ?minutes= sets the window (default 15, at most 60)
+func (c *CheckinMonitorController) GetLive(ctx *gin.Context) {
+	var req request.CheckinLiveRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	result, err := c.monitorService.GetLive(ctx.Request.Context(), userID.(string), ctx.GetString("role"), ctx.Param("id"), &req)
+	if err != nil {
+		c.respondMonitorError(ctx, err)
+		return
+	}
+
+	// Dashboards poll the view; a cached copy would hide the queue building up
+	ctx.Header("Cache-Control", "no-store")
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgCheckinLive, result))
+}
+
+// respondMonitorError maps check-in monitor errors to HTTP responses
+func (c *CheckinMonitorController) respondMonitorError(ctx *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorMessage := message.ErrInternalServer
+	errorCode := sharedresponse.CodeInternal
+
+	switch {
+	case errors.Is(err, service.ErrCheckinMetricsDisabled):
+		statusCode = http.StatusServiceUnavailable
+		errorMessage = message.ErrCheckinMetricsDisabled
+		errorCode = sharedresponse.CodeCheckinMetricsDisabled
+	case errors.Is(err, service.ErrEventNotFound):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrEventNotFound
+		errorCode = sharedresponse.CodeEventNotFound
+	case errors.Is(err, service.ErrZoneNotFound):
+		statusCode = http.StatusBadRequest
+		errorMessage = message.ErrZoneNotFound
+		errorCode = sharedresponse.CodeZoneNotFound
+	case errors.Is(err, service.ErrUnauthorized):
+		statusCode = http.StatusForbidden
+		errorMessage = message.ErrForbidden
+		errorCode = sharedresponse.CodeForbidden
+	default:
+		log.Printf("[CheckinMonitorController] Check-in monitor operation failed: %v", err)
+	}
+
+	ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, nil))
+}
@@ -0,0 +1,97 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// InsuranceController handles HTTP requests for ticket insurance
+type InsuranceController struct {
+	insuranceService service.InsuranceService
+}
+
+// NewInsuranceController creates new insurance controller instance
+func NewInsuranceController(insuranceService service.InsuranceService) *InsuranceController {
+	return &InsuranceController{insuranceService: insuranceService}
+}
+
+// GetOrderInsurance handles GET /orders/:id/insurance - Get the ticket insurance of an order
+func (c *InsuranceController) GetOrderInsurance(ctx *gin.Context) {
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	policy, err := c.insuranceService.GetOrderPolicy(ctx.Request.Context(), userID.(string), ctx.Param("id"))
+	if err != nil {
+		c.respondInsuranceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgInsuranceRetrieved, policy))
+}
+
+// FileClaim handles POST /orders/:id/insurance/claim - Claim the ticket insurance of an order
+func (c *InsuranceController) FileClaim(ctx *gin.Context) {
+	var req request.InsuranceClaimRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	policy, err := c.insuranceService.FileClaim(ctx.Request.Context(), userID.(string), ctx.Param("id"), &req)
+	if err != nil {
+		c.respondInsuranceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgInsuranceClaimed, policy))
+}
+
+// respondInsuranceError maps ticket insurance errors to HTTP responses
+func (c *InsuranceController) respondInsuranceError(ctx *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorMessage := message.ErrInternalServer
+	errorCode := sharedresponse.CodeInternal
+
+	if errors.Is(err, service.ErrOrderNotFound) {
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrOrderNotFound
+		errorCode = sharedresponse.CodeOrderNotFound
+	} else if errors.Is(err, service.ErrUnauthorized) {
+		statusCode = http.StatusForbidden
+		errorMessage = message.ErrForbidden
+		errorCode = sharedresponse.CodeForbidden
+	} else if errors.Is(err, service.ErrInsuranceNotFound) {
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrInsuranceNotFound
+		errorCode = sharedresponse.CodeInsuranceNotFound
+	} else if errors.Is(err, service.ErrInsuranceNotActive) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrInsuranceNotActive
+		errorCode = sharedresponse.CodeInsuranceNotActive
+	} else if errors.Is(err, service.ErrInsuranceClaimNotAllowed) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrInsuranceClaimNotAllowed
+		errorCode = sharedresponse.CodeInsuranceClaimNotAllowed
+	} else if errors.Is(err, service.ErrInsuranceClaimRejected) {
+		statusCode = http.StatusUnprocessableEntity
+		errorMessage = message.ErrInsuranceClaimRejected
+		errorCode = sharedresponse.CodeInsuranceClaimRejected
+	}
+
+	ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, nil))
+}
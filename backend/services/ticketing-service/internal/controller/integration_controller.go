@@ -0,0 +1,256 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// APIKeyHeader carries the partner API key of automation platforms
+const APIKeyHeader = "X-API-Key"
+
+// IntegrationController handles HTTP requests for partner API keys and integrations (Zapier, Make)
+// Integration endpoints answer with bare JSON arrays (triggers) and objects (actions) instead of
+// the response envelope, as automation platforms expect; errors keep the envelope
+type IntegrationController struct {
+	integrationService service.IntegrationService
+}
+
+// NewIntegrationController creates new integration controller instance
+func NewIntegrationController(integrationService service.IntegrationService) *IntegrationController {
+	return &IntegrationController{integrationService: integrationService}
+}
+
+// CreateAPIKey handles POST /api-keys - Create a partner API key
+func (c *IntegrationController) CreateAPIKey(ctx *gin.Context) {
+	var req request.CreateAPIKeyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	key, err := c.integrationService.CreateAPIKey(ctx.Request.Context(), userID.(string), ctx.GetString("role"), &req)
+	if err != nil {
+		c.respondIntegrationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, sharedresponse.Success(message.MsgAPIKeyCreated, key))
+}
+
+// ListAPIKeys handles GET /api-keys - The user's partner API keys
+func (c *IntegrationController) ListAPIKeys(ctx *gin.Context) {
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	keys, err := c.integrationService.ListAPIKeys(ctx.Request.Context(), userID.(string))
+	if err != nil {
+		c.respondIntegrationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgAPIKeysRetrieved, keys))
+}
+
+// RevokeAPIKey handles DELETE /api-keys/:id - Revoke a partner API key
+func (c *IntegrationController) RevokeAPIKey(ctx *gin.Context) {
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	key, err := c.integrationService.RevokeAPIKey(ctx.Request.Context(), userID.(string), ctx.GetString("role"), ctx.Param("id"))
+	if err != nil {
+		c.respondIntegrationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgAPIKeyRevoked, key))
+}
+
+// GetAccount handles GET /integrations/me - Organizer behind the API key (connection test)
+func (c *IntegrationController) GetAccount(ctx *gin.Context) {
+	partner, ok := c.authenticate(ctx)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.integrationService.GetAccount(partner))
+}
+
+// ListOrders handles GET /integrations/triggers/orders - Orders updated since ?updated_since=, newest first
+func (c *IntegrationController) ListOrders(ctx *gin.Context) {
+	partner, req, ok := c.poll(ctx)
+	if !ok {
+		return
+	}
+
+	orders, err := c.integrationService.ListOrders(ctx.Request.Context(), partner, req)
+	if err != nil {
+		c.respondIntegrationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, orders)
+}
+
+// ListAttendees handles GET /integrations/triggers/attendees - Tickets issued since ?updated_since=, newest first
+func (c *IntegrationController) ListAttendees(ctx *gin.Context) {
+	partner, req, ok := c.poll(ctx)
+	if !ok {
+		return
+	}
+
+	attendees, err := c.integrationService.ListAttendees(ctx.Request.Context(), partner, req)
+	if err != nil {
+		c.respondIntegrationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, attendees)
+}
+
+// ListCheckIns handles GET /integrations/triggers/checkins - Entry scans since ?updated_since=, newest first
+func (c *IntegrationController) ListCheckIns(ctx *gin.Context) {
+	partner, req, ok := c.poll(ctx)
+	if !ok {
+		return
+	}
+
+	checkIns, err := c.integrationService.ListCheckIns(ctx.Request.Context(), partner, req)
+	if err != nil {
+		c.respondIntegrationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, checkIns)
+}
+
+// CheckIn handles POST /integrations/actions/checkins - Check in a ticket by its QR data
+func (c *IntegrationController) CheckIn(ctx *gin.Context) {
+	partner, ok := c.authenticate(ctx)
+	if !ok {
+		return
+	}
+
+	var req request.IntegrationCheckInRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	ticket, err := c.integrationService.CheckIn(ctx.Request.Context(), partner, &req)
+	if err != nil {
+		c.respondIntegrationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ticket)
+}
+
+// InviteGuest handles POST /integrations/actions/invitations - Invite one guest to an event
+func (c *IntegrationController) InviteGuest(ctx *gin.Context) {
+	partner, ok := c.authenticate(ctx)
+	if !ok {
+		return
+	}
+
+	var req request.InviteGuestRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	invitation, err := c.integrationService.InviteGuest(ctx.Request.Context(), partner, &req)
+	if err != nil {
+		c.respondIntegrationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, invitation)
+}
+
+// authenticate resolves the partner of the X-API-Key header, responding 401 if it is missing or invalid
+func (c *IntegrationController) authenticate(ctx *gin.Context) (*service.Partner, bool) {
+	apiKey := ctx.GetHeader(APIKeyHeader)
+	if apiKey == "" {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrAPIKeyInvalid, sharedresponse.CodeAPIKeyInvalid, nil))
+		return nil, false
+	}
+
+	partner, err := c.integrationService.Authenticate(ctx.Request.Context(), apiKey)
+	if err != nil {
+		c.respondIntegrationError(ctx, err)
+		return nil, false
+	}
+
+	return partner, true
+}
+
+// poll authenticates a polling trigger request and binds its query parameters
+func (c *IntegrationController) poll(ctx *gin.Context) (*service.Partner, *request.IntegrationPollRequest, bool) {
+	partner, ok := c.authenticate(ctx)
+	if !ok {
+		return nil, nil, false
+	}
+
+	var req request.IntegrationPollRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return nil, nil, false
+	}
+
+	return partner, &req, true
+}
+
+// respondIntegrationError maps integration errors to HTTP responses
+// Check-in and invitation errors of partner actions are mapped like the organizer's own requests
+func (c *IntegrationController) respondIntegrationError(ctx *gin.Context, err error) {
+	var statusCode int
+	var errorMessage, errorCode string
+
+	switch {
+	case errors.Is(err, service.ErrAPIKeyInvalid):
+		statusCode = http.StatusUnauthorized
+		errorMessage = message.ErrAPIKeyInvalid
+		errorCode = sharedresponse.CodeAPIKeyInvalid
+	case errors.Is(err, service.ErrAPIKeyNotFound):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrAPIKeyNotFound
+		errorCode = sharedresponse.CodeAPIKeyNotFound
+	case errors.Is(err, service.ErrUnauthorized):
+		statusCode = http.StatusForbidden
+		errorMessage = message.ErrForbidden
+		errorCode = sharedresponse.CodeForbidden
+	case errors.Is(err, service.ErrEventNotFound):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrEventNotFound
+		errorCode = sharedresponse.CodeEventNotFound
+	case errors.Is(err, service.ErrInvalidInvitation),
+		errors.Is(err, service.ErrInvitationsClosed),
+		errors.Is(err, service.ErrInvalidClaimDeadline),
+		errors.Is(err, service.ErrInsufficientQuota):
+		respondInvitationError(ctx, err)
+		return
+	default:
+		respondValidateError(ctx, err)
+		return
+	}
+
+	ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, nil))
+}
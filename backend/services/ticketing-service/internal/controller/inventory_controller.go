@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// InventoryController handles HTTP requests for ticket tier inventory reports
+type InventoryController struct {
+	inventoryService service.InventoryService
+}
+
+// NewInventoryController creates new inventory controller instance
+func NewInventoryController(inventoryService service.InventoryService) *InventoryController {
+	return &InventoryController{inventoryService: inventoryService}
+}
+
+// GetHistory handles GET /organizer/ticket-tiers/:id/inventory-history - Seats sold and released per interval
+// ?interval=minute|hour|day (default hour), ?from= and ?to= in RFC 3339 (default the last 24 hours)
+func (c *InventoryController) GetHistory(ctx *gin.Context) {
+	var req request.InventoryHistoryRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	history, err := c.inventoryService.GetHistory(ctx.Request.Context(), userID.(string), ctx.GetString("role"), ctx.Param("id"), &req)
+	if err != nil {
+		c.respondInventoryError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgInventoryHistory, history))
+}
+
+// respondInventoryError maps inventory report errors to HTTP responses
+func (c *InventoryController) respondInventoryError(ctx *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorMessage := message.ErrInternalServer
+	errorCode := sharedresponse.CodeInternal
+
+	switch {
+	case errors.Is(err, service.ErrInvalidInventoryRange):
+		statusCode = http.StatusBadRequest
+		errorMessage = message.ErrInvalidInventoryRange
+		errorCode = sharedresponse.CodeInvalidDateRange
+	case errors.Is(err, service.ErrTicketTierNotFound):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrTicketTierNotFound
+		errorCode = sharedresponse.CodeTicketTierNotFound
+	case errors.Is(err, service.ErrEventNotFound):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrEventNotFound
+		errorCode = sharedresponse.CodeEventNotFound
+	case errors.Is(err, service.ErrUnauthorized):
+		statusCode = http.StatusForbidden
+		errorMessage = message.ErrForbidden
+		errorCode = sharedresponse.CodeForbidden
+	}
+
+	ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, nil))
+}
@@ -0,0 +1,169 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// InvitationController handles HTTP requests for invite-only guest lists
+type InvitationController struct {
+	invitationService service.InvitationService
+}
+
+// NewInvitationController creates new invitation controller instance
+func NewInvitationController(invitationService service.InvitationService) *InvitationController {
+	return &InvitationController{invitationService: invitationService}
+}
+
+// ImportInvitations handles POST /invitations/import?event_id=&claim_deadline= - Invite the guests of a CSV file
+// With dry_run=true the file is only validated
+func (c *InvitationController) ImportInvitations(ctx *gin.Context) {
+	var req request.ImportInvitationsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	result, err := c.invitationService.ImportInvitations(ctx.Request.Context(), userID.(string), ctx.GetString("role"), &req, ctx.Request.Body)
+	if err != nil {
+		respondInvitationError(ctx, err)
+		return
+	}
+
+	if len(result.Errors) > 0 {
+		ctx.JSON(http.StatusUnprocessableEntity, sharedresponse.ErrorWithData(message.ErrInvitationsInvalidRows, sharedresponse.CodeValidationFailed, result))
+		return
+	}
+
+	if result.DryRun {
+		ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgInvitationsImportValid, result))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, sharedresponse.Success(message.MsgInvitationsImported, result))
+}
+
+// ListInvitations handles GET /invitations?event_id= - Invitations of an event with their claim status
+func (c *InvitationController) ListInvitations(ctx *gin.Context) {
+	eventID := ctx.Query("event_id")
+	if eventID == "" {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrEventIDRequired, sharedresponse.CodeInvalidRequest, nil))
+		return
+	}
+
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	invitations, err := c.invitationService.ListInvitations(ctx.Request.Context(), userID.(string), ctx.GetString("role"), eventID)
+	if err != nil {
+		respondInvitationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgInvitationsRetrieved, invitations))
+}
+
+// GetInvitation handles GET /public/invitations/:token - What a claim link offers (no auth)
+func (c *InvitationController) GetInvitation(ctx *gin.Context) {
+	invitation, err := c.invitationService.GetInvitation(ctx.Request.Context(), ctx.Param("token"))
+	if err != nil {
+		respondInvitationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgInvitationRetrieved, invitation))
+}
+
+// ClaimInvitation handles POST /invitations/claim - Claim an invitation as the signed-in user
+func (c *InvitationController) ClaimInvitation(ctx *gin.Context) {
+	var req request.ClaimInvitationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	claimed, err := c.invitationService.ClaimInvitation(ctx.Request.Context(), userID.(string), &req)
+	if err != nil {
+		respondInvitationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, sharedresponse.Success(message.MsgInvitationClaimed, claimed))
+}
+
+// respondInvitationError maps invitation service errors to HTTP responses
+// Shared by the organizer's endpoints and partner invitation actions
+func respondInvitationError(ctx *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorMessage := message.ErrInternalServer
+	errorCode := sharedresponse.CodeInternal
+	var details interface{}
+
+	switch {
+	case errors.Is(err, service.ErrEventNotFound):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrEventNotFound
+		errorCode = sharedresponse.CodeEventNotFound
+	case errors.Is(err, service.ErrUnauthorized):
+		statusCode = http.StatusForbidden
+		errorMessage = message.ErrForbidden
+		errorCode = sharedresponse.CodeForbidden
+	case errors.Is(err, service.ErrInvalidInvitationsFile):
+		statusCode = http.StatusBadRequest
+		errorMessage = message.ErrInvalidInvitationsFile
+		errorCode = sharedresponse.CodeInvalidRequest
+		details = err.Error()
+	case errors.Is(err, service.ErrInvalidInvitation):
+		statusCode = http.StatusUnprocessableEntity
+		errorMessage = message.ErrInvalidInvitation
+		errorCode = sharedresponse.CodeValidationFailed
+		details = err.Error()
+	case errors.Is(err, service.ErrInvitationsClosed):
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrInvitationsClosed
+		errorCode = sharedresponse.CodeInvitationsClosed
+	case errors.Is(err, service.ErrInvalidClaimDeadline):
+		statusCode = http.StatusBadRequest
+		errorMessage = message.ErrInvalidClaimDeadline
+		errorCode = sharedresponse.CodeInvalidClaimDeadline
+	case errors.Is(err, service.ErrInsufficientQuota):
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrInsufficientQuota
+		errorCode = sharedresponse.CodeTicketTierSoldOut
+	case errors.Is(err, service.ErrInvitationInvalid):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrInvitationInvalid
+		errorCode = sharedresponse.CodeInvitationInvalid
+	case errors.Is(err, service.ErrInvitationClaimed):
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrInvitationClaimed
+		errorCode = sharedresponse.CodeInvitationClaimed
+	case errors.Is(err, service.ErrInvitationExpired):
+		statusCode = http.StatusGone
+		errorMessage = message.ErrInvitationExpired
+		errorCode = sharedresponse.CodeInvitationExpired
+	}
+
+	ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, details))
+}
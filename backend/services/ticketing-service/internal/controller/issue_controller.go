@@ -0,0 +1,196 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// IssueController handles HTTP requests for order issues
+type IssueController struct {
+	issueService service.IssueService
+}
+
+// NewIssueController creates new issue controller instance
+func NewIssueController(issueService service.IssueService) *IssueController {
+	return &IssueController{issueService: issueService}
+}
+
+// ReportIssue handles POST /orders/:id/issues - Report a problem with an order
+func (c *IssueController) ReportIssue(ctx *gin.Context) {
+	var req request.CreateOrderIssueRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	issue, err := c.issueService.ReportIssue(ctx.Request.Context(), userID.(string), ctx.Param("id"), &req)
+	if err != nil {
+		c.respondIssueError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, sharedresponse.Success(message.MsgIssueReported, issue))
+}
+
+// GetOrderIssues handles GET /orders/:id/issues - Get issues reported on an order
+func (c *IssueController) GetOrderIssues(ctx *gin.Context) {
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	issues, err := c.issueService.GetOrderIssues(ctx.Request.Context(), userID.(string), ctx.Param("id"))
+	if err != nil {
+		c.respondIssueError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgIssuesRetrieved, issues))
+}
+
+// ListIssues handles GET /admin/issues - Support queue, unresolved issues unless status is given
+func (c *IssueController) ListIssues(ctx *gin.Context) {
+	status := ctx.Query("status")
+	switch status {
+	case "", entity.IssueStatusOpen, entity.IssueStatusInProgress, entity.IssueStatusResolved:
+	default:
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, "invalid status"))
+		return
+	}
+
+	// Parse pagination parameters
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "20"))
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	issues, total, err := c.issueService.ListIssues(ctx.Request.Context(), status, page, limit)
+	if err != nil {
+		c.respondIssueError(ctx, err)
+		return
+	}
+
+	// Calculate pagination metadata
+	totalPages := int(total) / limit
+	if int(total)%limit > 0 {
+		totalPages++
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.SuccessWithPagination(
+		message.MsgIssuesRetrieved,
+		issues,
+		sharedresponse.PaginationMeta{
+			CurrentPage: page,
+			PerPage:     limit,
+			Total:       int(total),
+			TotalPages:  totalPages,
+		},
+	))
+}
+
+// GetAdminOrder handles GET /admin/orders/:id - Support view of an order with its issues
+func (c *IssueController) GetAdminOrder(ctx *gin.Context) {
+	order, err := c.issueService.GetAdminOrder(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		c.respondIssueError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgOrderRetrieved, order))
+}
+
+// ReplyToIssue handles POST /admin/issues/:id/replies - Answer an issue
+func (c *IssueController) ReplyToIssue(ctx *gin.Context) {
+	var req request.ReplyOrderIssueRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	adminID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	issue, err := c.issueService.ReplyToIssue(ctx.Request.Context(), adminID.(string), ctx.Param("id"), &req)
+	if err != nil {
+		c.respondIssueError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgIssueReplied, issue))
+}
+
+// ResolveIssue handles POST /admin/issues/:id/resolve - Close an issue with a resolution
+func (c *IssueController) ResolveIssue(ctx *gin.Context) {
+	var req request.ResolveOrderIssueRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	adminID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	issue, err := c.issueService.ResolveIssue(ctx.Request.Context(), adminID.(string), ctx.Param("id"), &req)
+	if err != nil {
+		c.respondIssueError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgIssueResolved, issue))
+}
+
+// respondIssueError maps order issue errors to HTTP responses
+func (c *IssueController) respondIssueError(ctx *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorMessage := message.ErrInternalServer
+	errorCode := sharedresponse.CodeInternal
+
+	if errors.Is(err, service.ErrOrderNotFound) {
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrOrderNotFound
+		errorCode = sharedresponse.CodeOrderNotFound
+	} else if errors.Is(err, service.ErrUnauthorized) {
+		statusCode = http.StatusForbidden
+		errorMessage = message.ErrForbidden
+		errorCode = sharedresponse.CodeForbidden
+	} else if errors.Is(err, service.ErrIssueNotFound) {
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrIssueNotFound
+		errorCode = sharedresponse.CodeIssueNotFound
+	} else if errors.Is(err, service.ErrIssueAlreadyOpen) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrIssueAlreadyOpen
+		errorCode = sharedresponse.CodeIssueAlreadyOpen
+	} else if errors.Is(err, service.ErrIssueResolved) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrIssueResolved
+		errorCode = sharedresponse.CodeIssueResolved
+	}
+
+	ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, nil))
+}
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedauth "github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// NotificationPreferenceController handles HTTP requests for the notification preferences of the signed-in user
+type NotificationPreferenceController struct {
+	preferenceService service.NotificationPreferenceService
+}
+
+// NewNotificationPreferenceController creates new notification preference controller instance
+func NewNotificationPreferenceController(preferenceService service.NotificationPreferenceService) *NotificationPreferenceController {
+	return &NotificationPreferenceController{preferenceService: preferenceService}
+}
+
+// GetPreference handles GET /notification-preferences - Get the user's digest frequency
+func (c *NotificationPreferenceController) GetPreference(ctx *gin.Context) {
+	email := ctx.GetString(sharedauth.ContextEmail)
+
+	result, err := c.preferenceService.GetPreference(ctx.Request.Context(), email)
+	if err != nil {
+		log.Printf("[ERROR] GetPreference failed for %s: %v", email, err)
+		c.respondPreferenceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgNotificationPrefs, result))
+}
+
+// UpdatePreference handles PUT /notification-preferences - Change the user's digest frequency
+func (c *NotificationPreferenceController) UpdatePreference(ctx *gin.Context) {
+	var req request.UpdateNotificationPreferenceRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	email := ctx.GetString(sharedauth.ContextEmail)
+
+	result, err := c.preferenceService.UpdatePreference(ctx.Request.Context(), email, &req)
+	if err != nil {
+		log.Printf("[ERROR] UpdatePreference failed for %s: %v", email, err)
+		c.respondPreferenceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgNotificationPrefsSet, result))
+}
+
+// respondPreferenceError maps notification preference errors to HTTP responses
+func (c *NotificationPreferenceController) respondPreferenceError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrUnauthorized):
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+	case errors.Is(err, service.ErrDigestsDisabled):
+		ctx.JSON(http.StatusServiceUnavailable, sharedresponse.ErrorWithCode(message.ErrDigestsDisabled, sharedresponse.CodeDigestsDisabled, nil))
+	default:
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+	}
+}
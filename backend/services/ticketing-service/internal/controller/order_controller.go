@@ -37,14 +37,14 @@ func NewOrderController(
 func (c *OrderController) CreateOrder(ctx *gin.Context) {
 	var req request.CreateOrderRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, sharedresponse.Error(message.ErrInvalidRequest, err.Error()))
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
 		return
 	}
 
 	// Get user ID from context (set by auth middleware)
 	userID, exists := ctx.Get("user_id")
 	if !exists {
-		ctx.JSON(http.StatusUnauthorized, sharedresponse.Error(message.ErrUnauthorized, nil))
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
 		return
 	}
 
@@ -72,32 +72,97 @@ func (c *OrderController) CreateOrder(ctx *gin.Context) {
 		// Log the actual error for debugging
 		log.Printf("[ERROR] CreateOrder failed for user %s: %v", userID.(string), err)
 
-		statusCode := http.StatusInternalServerError
-		errorMessage := message.ErrInternalServer
+		statusCode, errorMessage, errorCode := reservationErrorResponse(err)
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
+		return
+	}
 
-		// Handle specific errors
-		if errors.Is(err, service.ErrInsufficientQuota) {
-			statusCode = http.StatusConflict
-			errorMessage = message.ErrInsufficientQuota
-		} else if errors.Is(err, service.ErrInvalidQuantity) {
-			statusCode = http.StatusBadRequest
-			errorMessage = message.ErrInvalidQuantity
-		} else if errors.Is(err, service.ErrMaxPerOrderExceeded) {
-			statusCode = http.StatusBadRequest
-			errorMessage = message.ErrMaxPerOrderExceeded
-		} else if errors.Is(err, service.ErrLockAcquisitionFailed) {
-			statusCode = http.StatusConflict
-			errorMessage = message.ErrLockAcquisitionFailed
-		} else if errors.Is(err, service.ErrTicketTierNotFound) {
-			statusCode = http.StatusNotFound
-			errorMessage = message.ErrTicketTierNotFound
-		}
+	ctx.JSON(http.StatusCreated, sharedresponse.Success(message.MsgOrderCreated, order))
+}
 
-		ctx.JSON(statusCode, sharedresponse.Error(errorMessage, err.Error()))
+// QuoteOrder handles POST /orders/quote - Price an order without reserving tickets
+func (c *OrderController) QuoteOrder(ctx *gin.Context) {
+	var req request.QuoteOrderRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
 		return
 	}
 
-	ctx.JSON(http.StatusCreated, sharedresponse.Success(message.MsgOrderCreated, order))
+	quote, err := c.reservationService.QuoteOrder(ctx.Request.Context(), &req)
+	if err != nil {
+		statusCode, errorMessage, errorCode := reservationErrorResponse(err)
+		if statusCode == http.StatusInternalServerError {
+			log.Printf("[ERROR] QuoteOrder failed: %v", err)
+		}
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgOrderQuoted, quote))
+}
+
+// reservationErrorResponse maps reservation and quote errors to HTTP status, message and error code
+func reservationErrorResponse(err error) (int, string, string) {
+	statusCode := http.StatusInternalServerError
+	errorMessage := message.ErrInternalServer
+	errorCode := sharedresponse.CodeInternal
+
+	// Handle specific errors
+	if errors.Is(err, service.ErrInsufficientQuota) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrInsufficientQuota
+		errorCode = sharedresponse.CodeTicketTierSoldOut
+	} else if errors.Is(err, service.ErrInvalidQuantity) {
+		statusCode = http.StatusBadRequest
+		errorMessage = message.ErrInvalidQuantity
+		errorCode = sharedresponse.CodeInvalidQuantity
+	} else if errors.Is(err, service.ErrMaxPerOrderExceeded) {
+		statusCode = http.StatusBadRequest
+		errorMessage = message.ErrMaxPerOrderExceeded
+		errorCode = sharedresponse.CodeMaxPerOrderExceeded
+	} else if errors.Is(err, service.ErrLockAcquisitionFailed) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrLockAcquisitionFailed
+		errorCode = sharedresponse.CodeLockNotAcquired
+	} else if errors.Is(err, service.ErrTicketTierNotFound) {
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrTicketTierNotFound
+		errorCode = sharedresponse.CodeTicketTierNotFound
+	} else if errors.Is(err, service.ErrEventNotFound) {
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrEventNotFound
+		errorCode = sharedresponse.CodeEventNotFound
+	} else if errors.Is(err, service.ErrEventNotOnSale) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrEventNotOnSale
+		errorCode = sharedresponse.CodeEventNotOnSale
+	} else if errors.Is(err, request.ErrInvalidMetadata) {
+		statusCode = http.StatusBadRequest
+		errorMessage = err.Error()
+		errorCode = sharedresponse.CodeInvalidOrderMetadata
+	} else if errors.Is(err, request.ErrInvalidAccommodations) {
+		statusCode = http.StatusBadRequest
+		errorMessage = err.Error()
+		errorCode = sharedresponse.CodeInvalidAccommodations
+	} else if errors.Is(err, service.ErrAccessibleSeatingUnavailable) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrAccessibleSeatingUnavailable
+		errorCode = sharedresponse.CodeAccessibleSeatingSoldOut
+	} else if errors.Is(err, service.ErrInsuranceUnavailable) {
+		statusCode = http.StatusServiceUnavailable
+		errorMessage = message.ErrInsuranceUnavailable
+		errorCode = sharedresponse.CodeInsuranceUnavailable
+	} else if errors.Is(err, service.ErrInsuranceCoverageChanged) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrInsuranceCoverageChanged
+		errorCode = sharedresponse.CodeInsuranceCoverageChanged
+	} else if errors.Is(err, service.ErrPolicyAcceptanceRequired) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrPolicyAcceptanceRequired
+		errorCode = sharedresponse.CodePolicyAcceptanceRequired
+	}
+
+	return statusCode, errorMessage, errorCode
 }
 
 // GetOrder handles GET /orders/:id - Get order by ID
@@ -107,7 +172,7 @@ func (c *OrderController) GetOrder(ctx *gin.Context) {
 	// Get user ID from context
 	userID, exists := ctx.Get("user_id")
 	if !exists {
-		ctx.JSON(http.StatusUnauthorized, sharedresponse.Error(message.ErrUnauthorized, nil))
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
 		return
 	}
 
@@ -116,16 +181,19 @@ func (c *OrderController) GetOrder(ctx *gin.Context) {
 	if err != nil {
 		statusCode := http.StatusInternalServerError
 		errorMessage := message.ErrInternalServer
+		errorCode := sharedresponse.CodeInternal
 
 		if errors.Is(err, service.ErrOrderNotFound) {
 			statusCode = http.StatusNotFound
 			errorMessage = message.ErrOrderNotFound
+			errorCode = sharedresponse.CodeOrderNotFound
 		} else if errors.Is(err, service.ErrUnauthorized) {
 			statusCode = http.StatusForbidden
 			errorMessage = message.ErrForbidden
+			errorCode = sharedresponse.CodeForbidden
 		}
 
-		ctx.JSON(statusCode, sharedresponse.Error(errorMessage, err.Error()))
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
 		return
 	}
 
@@ -137,7 +205,7 @@ func (c *OrderController) GetUserOrders(ctx *gin.Context) {
 	// Get user ID from context
 	userID, exists := ctx.Get("user_id")
 	if !exists {
-		ctx.JSON(http.StatusUnauthorized, sharedresponse.Error(message.ErrUnauthorized, nil))
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
 		return
 	}
 
@@ -148,7 +216,55 @@ func (c *OrderController) GetUserOrders(ctx *gin.Context) {
 	// Get orders
 	orders, total, err := c.orderService.GetUserOrders(ctx.Request.Context(), userID.(string), page, limit)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, sharedresponse.Error(message.ErrInternalServer, err.Error()))
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, err.Error()))
+		return
+	}
+
+	// Calculate pagination metadata
+	totalPages := int(total) / limit
+	if int(total)%limit > 0 {
+		totalPages++
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.SuccessWithPagination(
+		message.MsgOrdersRetrieved,
+		orders,
+		sharedresponse.PaginationMeta{
+			CurrentPage: page,
+			PerPage:     limit,
+			Total:       int(total),
+			TotalPages:  totalPages,
+		},
+	))
+}
+
+// SearchOrders handles GET /admin/orders - Support search of orders by metadata
+// metadata_key is required; metadata_value narrows to an exact value match
+func (c *OrderController) SearchOrders(ctx *gin.Context) {
+	key := ctx.Query("metadata_key")
+	if key == "" {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, "metadata_key is required"))
+		return
+	}
+
+	var value *string
+	if v, ok := ctx.GetQuery("metadata_value"); ok {
+		value = &v
+	}
+
+	// Parse pagination parameters
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "20"))
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	orders, total, err := c.orderService.SearchOrdersByMetadata(ctx.Request.Context(), key, value, page, limit)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, err.Error()))
 		return
 	}
 
@@ -177,7 +293,7 @@ func (c *OrderController) CancelOrder(ctx *gin.Context) {
 	// Get user ID from context
 	userID, exists := ctx.Get("user_id")
 	if !exists {
-		ctx.JSON(http.StatusUnauthorized, sharedresponse.Error(message.ErrUnauthorized, nil))
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
 		return
 	}
 
@@ -185,19 +301,23 @@ func (c *OrderController) CancelOrder(ctx *gin.Context) {
 	if err := c.orderService.CancelOrder(ctx.Request.Context(), userID.(string), orderID); err != nil {
 		statusCode := http.StatusInternalServerError
 		errorMessage := message.ErrInternalServer
+		errorCode := sharedresponse.CodeInternal
 
 		if errors.Is(err, service.ErrOrderNotFound) {
 			statusCode = http.StatusNotFound
 			errorMessage = message.ErrOrderNotFound
+			errorCode = sharedresponse.CodeOrderNotFound
 		} else if errors.Is(err, service.ErrUnauthorized) {
 			statusCode = http.StatusForbidden
 			errorMessage = message.ErrForbidden
+			errorCode = sharedresponse.CodeForbidden
 		} else if errors.Is(err, service.ErrCannotCancelOrder) {
 			statusCode = http.StatusBadRequest
 			errorMessage = message.ErrCannotCancelOrder
+			errorCode = sharedresponse.CodeOrderNotCancellable
 		}
 
-		ctx.JSON(statusCode, sharedresponse.Error(errorMessage, err.Error()))
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
 		return
 	}
 
@@ -209,7 +329,7 @@ func (c *OrderController) ConfirmPayment(ctx *gin.Context) {
 	// Get order ID from URL path parameter
 	orderID := ctx.Param("id")
 	if orderID == "" {
-		ctx.JSON(http.StatusBadRequest, sharedresponse.Error("Order ID is required", nil))
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode("Order ID is required", sharedresponse.CodeInvalidRequest, nil))
 		return
 	}
 
@@ -217,7 +337,7 @@ func (c *OrderController) ConfirmPayment(ctx *gin.Context) {
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		log.Printf("[DEBUG] ConfirmPayment - Bind JSON failed. Error: %v", err)
 		log.Printf("[DEBUG] Request body: %+v", req)
-		ctx.JSON(http.StatusBadRequest, sharedresponse.Error(message.ErrInvalidRequest, err.Error()))
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
 		return
 	}
 
@@ -227,29 +347,165 @@ func (c *OrderController) ConfirmPayment(ctx *gin.Context) {
 	req.OrderID = orderID
 
 	// Confirm payment and generate tickets
-	if err := c.confirmationService.ConfirmPayment(ctx.Request.Context(), &req); err != nil {
+	result, err := c.confirmationService.ConfirmPayment(ctx.Request.Context(), &req)
+	if err != nil {
 		log.Printf("[ERROR] ConfirmPayment failed for order %s: %v", orderID, err)
+		respondConfirmationError(ctx, err)
+		return
+	}
+
+	// Retries and payments flagged for refund are handled too, so the caller stops retrying
+	ctx.JSON(http.StatusOK, sharedresponse.Success(confirmationMessage(result.Outcome), result))
+}
+
+// MarkPaid handles POST /admin/orders/:id/mark-paid - Confirm a bank transfer verified by finance
+func (c *OrderController) MarkPaid(ctx *gin.Context) {
+	var req request.MarkPaidRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
 
+	adminID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	result, err := c.confirmationService.MarkPaid(ctx.Request.Context(), adminID.(string), ctx.Param("id"), &req)
+	if err != nil {
+		log.Printf("[ERROR] MarkPaid failed for order %s: %v", ctx.Param("id"), err)
+		respondConfirmationError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(confirmationMessage(result.Outcome), result))
+}
+
+// confirmationMessage describes the outcome of a payment confirmation
+func confirmationMessage(outcome string) string {
+	switch outcome {
+	case service.ConfirmationAlreadyPaid:
+		return message.MsgOrderAlreadyConfirmed
+	case service.ConfirmationRefundFlagged:
+		return message.MsgPaymentRefundFlagged
+	default:
+		return message.MsgOrderConfirmed
+	}
+}
+
+// respondConfirmationError maps payment confirmation errors to HTTP responses
+func respondConfirmationError(ctx *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorMessage := message.ErrInternalServer
+	errorCode := sharedresponse.CodeInternal
+
+	if errors.Is(err, service.ErrOrderNotFound) {
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrOrderNotFound
+		errorCode = sharedresponse.CodeOrderNotFound
+	} else if errors.Is(err, service.ErrOrderExpired) {
+		statusCode = http.StatusBadRequest
+		errorMessage = message.ErrOrderExpired
+		errorCode = sharedresponse.CodeOrderExpired
+	} else if errors.Is(err, service.ErrOrderNotInReservedStatus) {
+		statusCode = http.StatusBadRequest
+		errorMessage = "Order is not in reserved status"
+		errorCode = sharedresponse.CodeOrderNotReserved
+	} else if errors.Is(err, service.ErrAmountMismatch) {
+		statusCode = http.StatusBadRequest
+		errorMessage = err.Error()
+		errorCode = sharedresponse.CodeAmountMismatch
+	} else if errors.Is(err, service.ErrCurrencyMismatch) {
+		statusCode = http.StatusBadRequest
+		errorMessage = err.Error()
+		errorCode = sharedresponse.CodeCurrencyMismatch
+	} else if errors.Is(err, service.ErrPaymentAlreadyApplied) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrPaymentAlreadyApplied
+		errorCode = sharedresponse.CodePaymentAlreadyApplied
+	} else if errors.Is(err, service.ErrOrderAlreadyPaid) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrOrderAlreadyPaid
+		errorCode = sharedresponse.CodeOrderAlreadyPaid
+	}
+
+	ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
+}
+
+// GetOrderV2 handles GET /api/v2/orders/:id - Get order with event, fee and payment details
+func (c *OrderController) GetOrderV2(ctx *gin.Context) {
+	orderID := ctx.Param("id")
+
+	// Get user ID from context
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	order, err := c.orderService.GetOrderByIDV2(ctx.Request.Context(), userID.(string), orderID)
+	if err != nil {
 		statusCode := http.StatusInternalServerError
 		errorMessage := message.ErrInternalServer
+		errorCode := sharedresponse.CodeInternal
 
 		if errors.Is(err, service.ErrOrderNotFound) {
 			statusCode = http.StatusNotFound
 			errorMessage = message.ErrOrderNotFound
-		} else if errors.Is(err, service.ErrOrderExpired) {
-			statusCode = http.StatusBadRequest
-			errorMessage = message.ErrOrderExpired
-		} else if errors.Is(err, service.ErrOrderNotInReservedStatus) {
-			statusCode = http.StatusBadRequest
-			errorMessage = "Order is not in reserved status"
-		} else if errors.Is(err, service.ErrAmountMismatch) {
-			statusCode = http.StatusBadRequest
-			errorMessage = err.Error()
+			errorCode = sharedresponse.CodeOrderNotFound
+		} else if errors.Is(err, service.ErrUnauthorized) {
+			statusCode = http.StatusForbidden
+			errorMessage = message.ErrForbidden
+			errorCode = sharedresponse.CodeForbidden
 		}
 
-		ctx.JSON(statusCode, sharedresponse.Error(errorMessage, err.Error()))
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgOrderRetrieved, order))
+}
+
+// GetUserOrdersV2 handles GET /api/v2/orders - Get user's orders with event, fee and payment details
+func (c *OrderController) GetUserOrdersV2(ctx *gin.Context) {
+	// Get user ID from context
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	// Parse pagination parameters
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+
+	orders, total, err := c.orderService.GetUserOrdersV2(ctx.Request.Context(), userID.(string), page, limit)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, err.Error()))
 		return
 	}
 
-	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgOrderConfirmed, nil))
+	// Calculate pagination metadata
+	totalPages := int(total) / limit
+	if int(total)%limit > 0 {
+		totalPages++
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.SuccessWithPagination(
+		message.MsgOrdersRetrieved,
+		orders,
+		sharedresponse.PaginationMeta{
+			CurrentPage: page,
+			PerPage:     limit,
+			Total:       int(total),
+			TotalPages:  totalPages,
+		},
+	))
 }
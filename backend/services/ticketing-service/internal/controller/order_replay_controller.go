@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// OrderReplayController handles HTTP requests for the event streams of event-sourced orders
+type OrderReplayController struct {
+	orderReplayService service.OrderReplayService
+}
+
+// NewOrderReplayController creates new order replay controller instance
+func NewOrderReplayController(orderReplayService service.OrderReplayService) *OrderReplayController {
+	return &OrderReplayController{orderReplayService: orderReplayService}
+}
+
+// GetOrderEvents handles GET /admin/orders/:id/events - Event stream of an order
+func (c *OrderReplayController) GetOrderEvents(ctx *gin.Context) {
+	events, err := c.orderReplayService.GetOrderEvents(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		c.respondOrderReplayError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgOrderEventsRetrieved, events))
+}
+
+// ReplayOrder handles POST /admin/orders/:id/replay - Rebuild an order from its event stream
+func (c *OrderReplayController) ReplayOrder(ctx *gin.Context) {
+	result, err := c.orderReplayService.ReplayOrder(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		c.respondOrderReplayError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgOrderReplayed, result))
+}
+
+// ReplayAll handles POST /admin/orders/replay - Rebuild every event-sourced order from its stream
+func (c *OrderReplayController) ReplayAll(ctx *gin.Context) {
+	summary, err := c.orderReplayService.ReplayAll(ctx.Request.Context())
+	if err != nil {
+		c.respondOrderReplayError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgOrdersReplayed, summary))
+}
+
+// respondOrderReplayError maps order replay errors to HTTP responses
+func (c *OrderReplayController) respondOrderReplayError(ctx *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorMessage := message.ErrInternalServer
+	errorCode := sharedresponse.CodeInternal
+
+	switch {
+	case errors.Is(err, service.ErrOrderNotEventSourced):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrOrderNotEventSourced
+		errorCode = sharedresponse.CodeOrderNotEventSourced
+	case errors.Is(err, service.ErrOrderNotFound):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrOrderNotFound
+		errorCode = sharedresponse.CodeOrderNotFound
+	}
+
+	ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, nil))
+}
@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// PolicyController handles HTTP requests for platform terms and event policies
+type PolicyController struct {
+	policyDocumentService service.PolicyDocumentService
+}
+
+// NewPolicyController creates new policy controller instance
+func NewPolicyController(policyDocumentService service.PolicyDocumentService) *PolicyController {
+	return &PolicyController{policyDocumentService: policyDocumentService}
+}
+
+// GetCurrentPolicies handles GET /public/policies?event_id= - Current platform terms and event policies to accept
+func (c *PolicyController) GetCurrentPolicies(ctx *gin.Context) {
+	policies, err := c.policyDocumentService.GetCurrentPolicies(ctx.Request.Context(), ctx.Query("event_id"))
+	if err != nil {
+		c.respondPolicyError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgPoliciesRetrieved, policies))
+}
+
+// GetPolicy handles GET /public/policies/:id - A policy version, current or not
+func (c *PolicyController) GetPolicy(ctx *gin.Context) {
+	policy, err := c.policyDocumentService.GetPolicy(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		c.respondPolicyError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgPolicyRetrieved, policy))
+}
+
+// PublishPolicy handles POST /policies - Publish a new version of the platform terms or an event policy
+func (c *PolicyController) PublishPolicy(ctx *gin.Context) {
+	var req request.PublishPolicyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	policy, err := c.policyDocumentService.PublishPolicy(ctx.Request.Context(), userID.(string), ctx.GetString("role"), &req)
+	if err != nil {
+		c.respondPolicyError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, sharedresponse.Success(message.MsgPolicyPublished, policy))
+}
+
+// GetOrderPolicies handles GET /orders/:id/policies - Policy versions accepted with the buyer's order
+func (c *PolicyController) GetOrderPolicies(ctx *gin.Context) {
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	acceptances, err := c.policyDocumentService.GetOrderPolicies(ctx.Request.Context(), userID.(string), ctx.Param("id"))
+	if err != nil {
+		c.respondPolicyError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgOrderPoliciesAccepted, acceptances))
+}
+
+// respondPolicyError maps policy errors to HTTP responses
+func (c *PolicyController) respondPolicyError(ctx *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorMessage := message.ErrInternalServer
+	errorCode := sharedresponse.CodeInternal
+
+	if errors.Is(err, service.ErrPolicyNotFound) {
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrPolicyNotFound
+		errorCode = sharedresponse.CodePolicyNotFound
+	} else if errors.Is(err, service.ErrEventNotFound) {
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrEventNotFound
+		errorCode = sharedresponse.CodeEventNotFound
+	} else if errors.Is(err, service.ErrOrderNotFound) {
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrOrderNotFound
+		errorCode = sharedresponse.CodeOrderNotFound
+	} else if errors.Is(err, service.ErrUnauthorized) {
+		statusCode = http.StatusForbidden
+		errorMessage = message.ErrForbidden
+		errorCode = sharedresponse.CodeForbidden
+	} else if errors.Is(err, service.ErrInvalidPolicyScope) {
+		statusCode = http.StatusBadRequest
+		errorMessage = message.ErrInvalidPolicyScope
+		errorCode = sharedresponse.CodeInvalidPolicyScope
+	} else if errors.Is(err, service.ErrRefundPolicyTemplate) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrRefundPolicyTemplate
+		errorCode = sharedresponse.CodeRefundPolicyTemplate
+	}
+
+	ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, nil))
+}
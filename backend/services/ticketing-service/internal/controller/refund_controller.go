@@ -0,0 +1,141 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// RefundController handles HTTP requests for payments flagged for manual refund and buyer refunds
+type RefundController struct {
+	refundService service.RefundService
+}
+
+// NewRefundController creates new refund controller instance
+func NewRefundController(refundService service.RefundService) *RefundController {
+	return &RefundController{refundService: refundService}
+}
+
+// ListRefunds handles GET /admin/refunds - Refund queue, pending requests unless status is given
+func (c *RefundController) ListRefunds(ctx *gin.Context) {
+	status := ctx.Query("status")
+	switch status {
+	case "", entity.RefundStatusPending, entity.RefundStatusRefunded:
+	default:
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, "invalid status"))
+		return
+	}
+
+	// Parse pagination parameters
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "20"))
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	refunds, total, err := c.refundService.ListRefunds(ctx.Request.Context(), status, page, limit)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, nil))
+		return
+	}
+
+	// Calculate pagination metadata
+	totalPages := int(total) / limit
+	if int(total)%limit > 0 {
+		totalPages++
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.SuccessWithPagination(
+		message.MsgRefundsRetrieved,
+		refunds,
+		sharedresponse.PaginationMeta{
+			CurrentPage: page,
+			PerPage:     limit,
+			Total:       int(total),
+			TotalPages:  totalPages,
+		},
+	))
+}
+
+// MarkRefunded handles POST /admin/refunds/:id/complete - Record a refund done outside the platform
+func (c *RefundController) MarkRefunded(ctx *gin.Context) {
+	adminID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	refund, err := c.refundService.MarkRefunded(ctx.Request.Context(), adminID.(string), ctx.Param("id"))
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		errorMessage := message.ErrInternalServer
+		errorCode := sharedresponse.CodeInternal
+
+		if errors.Is(err, service.ErrRefundNotFound) {
+			statusCode = http.StatusNotFound
+			errorMessage = message.ErrRefundNotFound
+			errorCode = sharedresponse.CodeRefundNotFound
+		} else if errors.Is(err, service.ErrRefundAlreadyRefunded) {
+			statusCode = http.StatusConflict
+			errorMessage = message.ErrRefundAlreadyRefunded
+			errorCode = sharedresponse.CodeRefundAlreadyRefunded
+		}
+
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, nil))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgRefundMarked, refund))
+}
+
+// RequestRefund handles POST /orders/:id/refund - Refund an order under its event's refund policy
+func (c *RefundController) RequestRefund(ctx *gin.Context) {
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	refund, err := c.refundService.RequestRefund(ctx.Request.Context(), userID.(string), ctx.Param("id"))
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		errorMessage := message.ErrInternalServer
+		errorCode := sharedresponse.CodeInternal
+
+		if errors.Is(err, service.ErrOrderNotFound) {
+			statusCode = http.StatusNotFound
+			errorMessage = message.ErrOrderNotFound
+			errorCode = sharedresponse.CodeOrderNotFound
+		} else if errors.Is(err, service.ErrUnauthorized) {
+			statusCode = http.StatusForbidden
+			errorMessage = message.ErrForbidden
+			errorCode = sharedresponse.CodeForbidden
+		} else if errors.Is(err, service.ErrRefundNotAllowed) {
+			statusCode = http.StatusConflict
+			errorMessage = message.ErrRefundNotAllowed
+			errorCode = sharedresponse.CodeRefundNotAllowed
+		} else if errors.Is(err, service.ErrRefundWindowClosed) {
+			statusCode = http.StatusConflict
+			errorMessage = message.ErrRefundWindowClosed
+			errorCode = sharedresponse.CodeRefundWindowClosed
+		} else if errors.Is(err, service.ErrRefundAlreadyRequested) {
+			statusCode = http.StatusConflict
+			errorMessage = message.ErrRefundRequested
+			errorCode = sharedresponse.CodeRefundRequested
+		}
+
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, nil))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, sharedresponse.Success(message.MsgRefundRequested, refund))
+}
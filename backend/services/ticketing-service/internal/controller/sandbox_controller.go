@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// SandboxController handles HTTP requests for sandbox events' test orders
+type SandboxController struct {
+	sandboxService service.SandboxService
+}
+
+// NewSandboxController creates new sandbox controller instance
+func NewSandboxController(sandboxService service.SandboxService) *SandboxController {
+	return &SandboxController{sandboxService: sandboxService}
+}
+
+// ClearTestOrders handles POST /organizer/events/:id/sandbox/reset - Clear test orders before going live
+func (c *SandboxController) ClearTestOrders(ctx *gin.Context) {
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	result, err := c.sandboxService.ClearTestOrders(ctx.Request.Context(), userID.(string), ctx.GetString("role"), ctx.Param("id"))
+	if err != nil {
+		c.respondSandboxError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgSandboxCleared, result))
+}
+
+// respondSandboxError maps sandbox errors to HTTP responses
+func (c *SandboxController) respondSandboxError(ctx *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorMessage := message.ErrInternalServer
+	errorCode := sharedresponse.CodeInternal
+
+	switch {
+	case errors.Is(err, service.ErrEventNotSandbox):
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrEventNotSandbox
+		errorCode = sharedresponse.CodeEventNotSandbox
+	case errors.Is(err, service.ErrEventNotFound):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrEventNotFound
+		errorCode = sharedresponse.CodeEventNotFound
+	case errors.Is(err, service.ErrUnauthorized):
+		statusCode = http.StatusForbidden
+		errorMessage = message.ErrForbidden
+		errorCode = sharedresponse.CodeForbidden
+	}
+
+	ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, nil))
+}
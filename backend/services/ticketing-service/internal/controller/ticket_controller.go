@@ -15,12 +15,14 @@ import (
 // TicketController handles HTTP requests for tickets
 type TicketController struct {
 	ticketService service.TicketService
+	shareService  service.ShareService
 }
 
 // NewTicketController creates new ticket controller instance
-func NewTicketController(ticketService service.TicketService) *TicketController {
+func NewTicketController(ticketService service.TicketService, shareService service.ShareService) *TicketController {
 	return &TicketController{
 		ticketService: ticketService,
+		shareService:  shareService,
 	}
 }
 
@@ -31,7 +33,7 @@ func (c *TicketController) GetTicket(ctx *gin.Context) {
 	// Get user ID from context
 	userID, exists := ctx.Get("user_id")
 	if !exists {
-		ctx.JSON(http.StatusUnauthorized, sharedresponse.Error(message.ErrUnauthorized, nil))
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
 		return
 	}
 
@@ -42,16 +44,19 @@ func (c *TicketController) GetTicket(ctx *gin.Context) {
 
 		statusCode := http.StatusInternalServerError
 		errorMessage := message.ErrInternalServer
+		errorCode := sharedresponse.CodeInternal
 
 		if errors.Is(err, service.ErrTicketNotFound) {
 			statusCode = http.StatusNotFound
 			errorMessage = message.ErrTicketNotFound
+			errorCode = sharedresponse.CodeTicketNotFound
 		} else if errors.Is(err, service.ErrUnauthorized) {
 			statusCode = http.StatusForbidden
 			errorMessage = message.ErrForbidden
+			errorCode = sharedresponse.CodeForbidden
 		}
 
-		ctx.JSON(statusCode, sharedresponse.Error(errorMessage, err.Error()))
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
 		return
 	}
 
@@ -63,7 +68,7 @@ func (c *TicketController) GetUserTickets(ctx *gin.Context) {
 	// Get user ID from context
 	userID, exists := ctx.Get("user_id")
 	if !exists {
-		ctx.JSON(http.StatusUnauthorized, sharedresponse.Error(message.ErrUnauthorized, nil))
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
 		return
 	}
 
@@ -72,7 +77,7 @@ func (c *TicketController) GetUserTickets(ctx *gin.Context) {
 	if err != nil {
 		log.Printf("[ERROR] GetUserTickets failed for user %s: %v", userID.(string), err)
 
-		ctx.JSON(http.StatusInternalServerError, sharedresponse.Error(message.ErrInternalServer, err.Error()))
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, err.Error()))
 		return
 	}
 
@@ -80,33 +85,356 @@ func (c *TicketController) GetUserTickets(ctx *gin.Context) {
 }
 
 // ValidateTicket handles POST /tickets/validate - Validate ticket at event entrance
+// With ?mode=verify the ticket is only checked and not marked as used
 func (c *TicketController) ValidateTicket(ctx *gin.Context) {
 	var req request.ValidateTicketRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, sharedresponse.Error(message.ErrInvalidRequest, err.Error()))
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	switch ctx.DefaultQuery("mode", request.ValidateModeScan) {
+	case request.ValidateModeScan:
+	case request.ValidateModeVerify:
+		c.verifyTicket(ctx, &req)
+		return
+	default:
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidValidateMode, sharedresponse.CodeInvalidRequest, nil))
 		return
 	}
 
 	// Validate ticket
 	ticket, err := c.ticketService.ValidateTicket(ctx.Request.Context(), &req)
 	if err != nil {
+		respondValidateError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgTicketValidated, ticket))
+}
+
+// RegenerateQR handles POST /tickets/:id/regenerate - Rotate the ticket QR code
+func (c *TicketController) RegenerateQR(ctx *gin.Context) {
+	ticketID := ctx.Param("id")
+
+	// Get user ID from context
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	ticket, err := c.ticketService.RegenerateQR(ctx.Request.Context(), userID.(string), ticketID)
+	if err != nil {
+		log.Printf("[ERROR] RegenerateQR failed for user %s, ticket %s: %v", userID.(string), ticketID, err)
+		c.respondTicketError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgTicketQRRegenerated, ticket))
+}
+
+// GetDynamicQR handles GET /tickets/:id/qr - Current rotating QR code of a dynamic QR event ticket (owner)
+// The buyer's app fetches it right before entry and again when it expires
+func (c *TicketController) GetDynamicQR(ctx *gin.Context) {
+	ticketID := ctx.Param("id")
+
+	// Get user ID from context
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	qr, err := c.ticketService.GetDynamicQR(ctx.Request.Context(), userID.(string), ticketID)
+	if err != nil {
+		log.Printf("[ERROR] GetDynamicQR failed for user %s, ticket %s: %v", userID.(string), ticketID, err)
+		c.respondTicketError(ctx, err)
+		return
+	}
+
+	// Codes rotate, a cached one would be refused at the gate
+	ctx.Header("Cache-Control", "no-store")
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgDynamicQRGenerated, qr))
+}
+
+// VoidTicket handles POST /tickets/:id/revoke - Void a ticket (organizer or admin)
+func (c *TicketController) VoidTicket(ctx *gin.Context) {
+	ticketID := ctx.Param("id")
+
+	// Get user ID from context
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	var req request.VoidTicketRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	ticket, err := c.ticketService.VoidTicket(ctx.Request.Context(), userID.(string), ctx.GetString("role"), ticketID, &req)
+	if err != nil {
+		log.Printf("[ERROR] VoidTicket failed for user %s, ticket %s: %v", userID.(string), ticketID, err)
+		c.respondTicketError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgTicketVoided, ticket))
+}
+
+// respondTicketError maps ticket service errors to HTTP responses
+func (c *TicketController) respondTicketError(ctx *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorMessage := message.ErrInternalServer
+	errorCode := sharedresponse.CodeInternal
+	var details interface{}
+
+	switch {
+	case errors.Is(err, service.ErrTicketNotFound):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrTicketNotFound
+		errorCode = sharedresponse.CodeTicketNotFound
+	case errors.Is(err, service.ErrUnauthorized):
+		statusCode = http.StatusForbidden
+		errorMessage = message.ErrForbidden
+		errorCode = sharedresponse.CodeForbidden
+	case errors.Is(err, service.ErrTicketVoid):
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrTicketVoid
+		errorCode = sharedresponse.CodeTicketVoid
+	case errors.Is(err, service.ErrTicketInvalid):
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrTicketInvalid
+		errorCode = sharedresponse.CodeTicketInvalid
+	case errors.Is(err, service.ErrDynamicQRDisabled):
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrDynamicQRDisabled
+		errorCode = sharedresponse.CodeDynamicQRDisabled
+	default:
+		details = err.Error()
+	}
+
+	ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, details))
+}
+
+// verifyTicket handles the verify-only mode of POST /tickets/validate
+func (c *TicketController) verifyTicket(ctx *gin.Context, req *request.ValidateTicketRequest) {
+	verification, err := c.ticketService.VerifyTicket(ctx.Request.Context(), req)
+	if err != nil {
+		respondValidateError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgTicketVerified, verification))
+}
+
+// respondValidateError maps ticket validation errors to HTTP responses
+// Shared by the gate scanner and kiosk check-in
+func respondValidateError(ctx *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorMessage := message.ErrInternalServer
+	errorCode := sharedresponse.CodeInternal
+
+	if errors.Is(err, service.ErrTicketNotFound) {
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrTicketNotFound
+		errorCode = sharedresponse.CodeTicketNotFound
+	} else if errors.Is(err, service.ErrTicketAlreadyUsed) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrTicketAlreadyUsed
+		errorCode = sharedresponse.CodeTicketAlreadyUsed
+	} else if errors.Is(err, service.ErrTicketVoid) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrTicketVoid
+		errorCode = sharedresponse.CodeTicketVoid
+	} else if errors.Is(err, service.ErrTicketAlreadyInside) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrTicketAlreadyInside
+		errorCode = sharedresponse.CodeTicketAlreadyInside
+	} else if errors.Is(err, service.ErrTicketNotInside) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrTicketNotInside
+		errorCode = sharedresponse.CodeTicketNotInside
+	} else if errors.Is(err, service.ErrTicketAlreadyUsedToday) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrTicketAlreadyUsedToday
+		errorCode = sharedresponse.CodeTicketAlreadyUsedToday
+	} else if errors.Is(err, service.ErrTicketNotValidToday) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrTicketNotValidToday
+		errorCode = sharedresponse.CodeTicketNotValidToday
+	} else if errors.Is(err, service.ErrExitScanNotAllowed) {
+		statusCode = http.StatusBadRequest
+		errorMessage = message.ErrExitScanNotAllowed
+		errorCode = sharedresponse.CodeExitScanNotAllowed
+	} else if errors.Is(err, service.ErrCheckInClosed) {
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrCheckInClosed
+		errorCode = sharedresponse.CodeCheckInClosed
+	} else if errors.Is(err, service.ErrTicketZoneNotAllowed) {
+		statusCode = http.StatusForbidden
+		errorMessage = message.ErrTicketZoneNotAllowed
+		errorCode = sharedresponse.CodeTicketZoneNotAllowed
+	} else if errors.Is(err, service.ErrDynamicQRRequired) {
+		statusCode = http.StatusForbidden
+		errorMessage = message.ErrDynamicQRRequired
+		errorCode = sharedresponse.CodeDynamicQRRequired
+	} else if errors.Is(err, service.ErrZoneNotFound) {
+		statusCode = http.StatusBadRequest
+		errorMessage = message.ErrZoneNotFound
+		errorCode = sharedresponse.CodeZoneNotFound
+	} else if errors.Is(err, service.ErrTicketInvalid) {
+		statusCode = http.StatusBadRequest
+		errorMessage = message.ErrTicketInvalid
+		errorCode = sharedresponse.CodeTicketInvalid
+	}
+
+	ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
+}
+
+// GetTicketV2 handles GET /api/v2/tickets/:id - Get ticket with event and tier details
+func (c *TicketController) GetTicketV2(ctx *gin.Context) {
+	ticketID := ctx.Param("id")
+
+	// Get user ID from context
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	ticket, err := c.ticketService.GetTicketV2(ctx.Request.Context(), userID.(string), ticketID)
+	if err != nil {
+		log.Printf("[ERROR] GetTicketV2 failed for user %s, ticket %s: %v", userID.(string), ticketID, err)
+
 		statusCode := http.StatusInternalServerError
 		errorMessage := message.ErrInternalServer
+		errorCode := sharedresponse.CodeInternal
 
 		if errors.Is(err, service.ErrTicketNotFound) {
 			statusCode = http.StatusNotFound
 			errorMessage = message.ErrTicketNotFound
-		} else if errors.Is(err, service.ErrTicketAlreadyUsed) {
-			statusCode = http.StatusConflict
-			errorMessage = message.ErrTicketAlreadyUsed
-		} else if errors.Is(err, service.ErrTicketInvalid) {
-			statusCode = http.StatusBadRequest
-			errorMessage = message.ErrTicketInvalid
+			errorCode = sharedresponse.CodeTicketNotFound
+		} else if errors.Is(err, service.ErrUnauthorized) {
+			statusCode = http.StatusForbidden
+			errorMessage = message.ErrForbidden
+			errorCode = sharedresponse.CodeForbidden
 		}
 
-		ctx.JSON(statusCode, sharedresponse.Error(errorMessage, err.Error()))
+		ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, err.Error()))
 		return
 	}
 
-	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgTicketValidated, ticket))
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgTicketRetrieved, ticket))
+}
+
+// GetUserTicketsV2 handles GET /api/v2/tickets - Get user's tickets with event and tier details
+func (c *TicketController) GetUserTicketsV2(ctx *gin.Context) {
+	// Get user ID from context
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	tickets, err := c.ticketService.GetUserTicketsV2(ctx.Request.Context(), userID.(string))
+	if err != nil {
+		log.Printf("[ERROR] GetUserTicketsV2 failed for user %s: %v", userID.(string), err)
+
+		ctx.JSON(http.StatusInternalServerError, sharedresponse.ErrorWithCode(message.ErrInternalServer, sharedresponse.CodeInternal, err.Error()))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgTicketsRetrieved, tickets))
+}
+
+// CreateShareLink handles GET /tickets/:id/share - Create a public share link for a ticket
+func (c *TicketController) CreateShareLink(ctx *gin.Context) {
+	ticketID := ctx.Param("id")
+
+	// Get user ID from context
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	share, err := c.shareService.CreateShareLink(ctx.Request.Context(), userID.(string), ticketID)
+	if err != nil {
+		log.Printf("[ERROR] CreateShareLink failed for user %s, ticket %s: %v", userID.(string), ticketID, err)
+		c.respondShareError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgShareLinkCreated, share))
+}
+
+// RevokeShareLinks handles DELETE /tickets/:id/share - Revoke all share links of a ticket
+func (c *TicketController) RevokeShareLinks(ctx *gin.Context) {
+	ticketID := ctx.Param("id")
+
+	// Get user ID from context
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	result, err := c.shareService.RevokeShareLinks(ctx.Request.Context(), userID.(string), ticketID)
+	if err != nil {
+		log.Printf("[ERROR] RevokeShareLinks failed for user %s, ticket %s: %v", userID.(string), ticketID, err)
+		c.respondShareError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgShareLinksRevoked, result))
+}
+
+// GetSharedTicket handles GET /public/tickets/shared/:token - Public view of a shared ticket
+func (c *TicketController) GetSharedTicket(ctx *gin.Context) {
+	ticket, err := c.shareService.GetSharedTicket(ctx.Request.Context(), ctx.Param("token"))
+	if err != nil {
+		c.respondShareError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgSharedTicket, ticket))
+}
+
+// respondShareError maps share service errors to HTTP responses
+func (c *TicketController) respondShareError(ctx *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorMessage := message.ErrInternalServer
+	errorCode := sharedresponse.CodeInternal
+	var details interface{}
+
+	switch {
+	case errors.Is(err, service.ErrTicketNotFound):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrTicketNotFound
+		errorCode = sharedresponse.CodeTicketNotFound
+	case errors.Is(err, service.ErrUnauthorized):
+		statusCode = http.StatusForbidden
+		errorMessage = message.ErrForbidden
+		errorCode = sharedresponse.CodeForbidden
+	case errors.Is(err, service.ErrTicketInvalid):
+		statusCode = http.StatusBadRequest
+		errorMessage = message.ErrTicketInvalid
+		errorCode = sharedresponse.CodeTicketInvalid
+	case errors.Is(err, service.ErrShareLinkInvalid):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrShareLinkInvalid
+		errorCode = sharedresponse.CodeShareLinkInvalid
+	case errors.Is(err, service.ErrShareLinkExpired):
+		statusCode = http.StatusGone
+		errorMessage = message.ErrShareLinkExpired
+		errorCode = sharedresponse.CodeShareLinkExpired
+	default:
+		details = err.Error()
+	}
+
+	ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, details))
 }
@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// TicketReissueController handles HTTP requests for bulk ticket reissues
+type TicketReissueController struct {
+	reissueService service.TicketReissueService
+}
+
+// NewTicketReissueController creates new ticket reissue controller instance
+func NewTicketReissueController(reissueService service.TicketReissueService) *TicketReissueController {
+	return &TicketReissueController{reissueService: reissueService}
+}
+
+// StartReissue handles POST /organizer/events/:id/ticket-reissues - Replace the QR codes of all unused tickets
+func (c *TicketReissueController) StartReissue(ctx *gin.Context) {
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	var req request.ReissueTicketsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	reissue, err := c.reissueService.StartReissue(ctx.Request.Context(), userID.(string), ctx.GetString("role"), ctx.Param("id"), &req)
+	if err != nil {
+		c.respondReissueError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, sharedresponse.Success(message.MsgTicketReissueStarted, reissue))
+}
+
+// GetReissue handles GET /organizer/events/:id/ticket-reissues/:reissueId - Reissue progress
+func (c *TicketReissueController) GetReissue(ctx *gin.Context) {
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	reissue, err := c.reissueService.GetReissue(ctx.Request.Context(), userID.(string), ctx.GetString("role"), ctx.Param("id"), ctx.Param("reissueId"))
+	if err != nil {
+		c.respondReissueError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgTicketReissue, reissue))
+}
+
+// respondReissueError maps ticket reissue errors to HTTP responses
+func (c *TicketReissueController) respondReissueError(ctx *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorMessage := message.ErrInternalServer
+	errorCode := sharedresponse.CodeInternal
+
+	switch {
+	case errors.Is(err, service.ErrNoTicketsToReissue):
+		statusCode = http.StatusBadRequest
+		errorMessage = message.ErrNoTicketsToReissue
+		errorCode = sharedresponse.CodeNoTicketsToReissue
+	case errors.Is(err, service.ErrTicketReissueInProgress):
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrTicketReissueInProgress
+		errorCode = sharedresponse.CodeTicketReissueInProgress
+	case errors.Is(err, service.ErrTicketReissueNotFound):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrTicketReissueNotFound
+		errorCode = sharedresponse.CodeTicketReissueNotFound
+	case errors.Is(err, service.ErrEventNotFound):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrEventNotFound
+		errorCode = sharedresponse.CodeEventNotFound
+	case errors.Is(err, service.ErrUnauthorized):
+		statusCode = http.StatusForbidden
+		errorMessage = message.ErrForbidden
+		errorCode = sharedresponse.CodeForbidden
+	}
+
+	ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, nil))
+}
@@ -0,0 +1,118 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	sharedresponse "github.com/raflibima25/event-ticketing-platform/backend/pkg/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/message"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// WaitlistController handles HTTP requests for waitlists of sold-out ticket tiers
+type WaitlistController struct {
+	waitlistService service.WaitlistService
+}
+
+// NewWaitlistController creates new waitlist controller instance
+func NewWaitlistController(waitlistService service.WaitlistService) *WaitlistController {
+	return &WaitlistController{waitlistService: waitlistService}
+}
+
+// JoinWaitlist handles POST /ticket-tiers/:id/waitlist - Wait for seats of a sold-out tier
+func (c *WaitlistController) JoinWaitlist(ctx *gin.Context) {
+	var req request.JoinWaitlistRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, sharedresponse.ErrorWithCode(message.ErrInvalidRequest, sharedresponse.CodeInvalidRequest, err.Error()))
+		return
+	}
+
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	entry, err := c.waitlistService.JoinWaitlist(ctx.Request.Context(), userID.(string), ctx.Param("id"), &req)
+	if err != nil {
+		respondWaitlistError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, sharedresponse.Success(message.MsgWaitlistJoined, entry))
+}
+
+// GetWaitlistEntry handles GET /ticket-tiers/:id/waitlist - The user's place in line or their offer
+func (c *WaitlistController) GetWaitlistEntry(ctx *gin.Context) {
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	entry, err := c.waitlistService.GetWaitlistEntry(ctx.Request.Context(), userID.(string), ctx.Param("id"))
+	if err != nil {
+		respondWaitlistError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgWaitlistEntry, entry))
+}
+
+// LeaveWaitlist handles DELETE /ticket-tiers/:id/waitlist - Leave the waitlist, giving up an offer
+func (c *WaitlistController) LeaveWaitlist(ctx *gin.Context) {
+	userID, exists := ctx.Get("user_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, sharedresponse.ErrorWithCode(message.ErrUnauthorized, sharedresponse.CodeUnauthorized, nil))
+		return
+	}
+
+	if err := c.waitlistService.LeaveWaitlist(ctx.Request.Context(), userID.(string), ctx.Param("id")); err != nil {
+		respondWaitlistError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sharedresponse.Success(message.MsgWaitlistLeft, nil))
+}
+
+// respondWaitlistError maps waitlist service errors to HTTP responses
+func respondWaitlistError(ctx *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorMessage := message.ErrInternalServer
+	errorCode := sharedresponse.CodeInternal
+
+	switch {
+	case errors.Is(err, service.ErrTicketTierNotFound):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrTicketTierNotFound
+		errorCode = sharedresponse.CodeTicketTierNotFound
+	case errors.Is(err, service.ErrEventNotFound):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrEventNotFound
+		errorCode = sharedresponse.CodeEventNotFound
+	case errors.Is(err, service.ErrEventNotOnSale):
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrEventNotOnSale
+		errorCode = sharedresponse.CodeEventNotOnSale
+	case errors.Is(err, service.ErrMaxPerOrderExceeded):
+		statusCode = http.StatusBadRequest
+		errorMessage = message.ErrMaxPerOrderExceeded
+		errorCode = sharedresponse.CodeMaxPerOrderExceeded
+	case errors.Is(err, service.ErrTierNotSoldOut):
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrTierNotSoldOut
+		errorCode = sharedresponse.CodeTierNotSoldOut
+	case errors.Is(err, service.ErrAlreadyOnWaitlist):
+		statusCode = http.StatusConflict
+		errorMessage = message.ErrAlreadyOnWaitlist
+		errorCode = sharedresponse.CodeAlreadyOnWaitlist
+	case errors.Is(err, service.ErrWaitlistEntryNotFound):
+		statusCode = http.StatusNotFound
+		errorMessage = message.ErrWaitlistEntryNotFound
+		errorCode = sharedresponse.CodeWaitlistEntryNotFound
+	}
+
+	ctx.JSON(statusCode, sharedresponse.ErrorWithCode(errorMessage, errorCode, nil))
+}
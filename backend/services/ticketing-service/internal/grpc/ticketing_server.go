@@ -2,23 +2,39 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"log"
 
 	pb "github.com/raflibima25/event-ticketing-platform/backend/pb/ticketing"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/serviceauth"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/message"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// ServiceAuthPolicy lists the services allowed to call each method
+// Only payment-service confirms payments, from its verified webhooks, and refunds orders and payments
+var ServiceAuthPolicy = serviceauth.Policy{
+	"/ticketing.TicketingService/ConfirmPayment":      {serviceauth.ServicePayment},
+	"/ticketing.TicketingService/RefundOrder":         {serviceauth.ServicePayment},
+	"/ticketing.TicketingService/RecordPaymentRefund": {serviceauth.ServicePayment},
+}
+
 // TicketingGRPCServer implements ticketing gRPC service
 type TicketingGRPCServer struct {
 	pb.UnimplementedTicketingServiceServer
 	confirmationService service.ConfirmationService
+	refundService       service.RefundService
 }
 
 // NewTicketingGRPCServer creates new ticketing gRPC server instance
-func NewTicketingGRPCServer(confirmationService service.ConfirmationService) *TicketingGRPCServer {
+func NewTicketingGRPCServer(confirmationService service.ConfirmationService, refundService service.RefundService) *TicketingGRPCServer {
 	return &TicketingGRPCServer{
 		confirmationService: confirmationService,
+		refundService:       refundService,
 	}
 }
 
@@ -28,14 +44,17 @@ func (s *TicketingGRPCServer) ConfirmPayment(ctx context.Context, req *pb.Confir
 
 	// Convert gRPC request to internal request
 	confirmReq := &request.ConfirmOrderRequest{
-		OrderID:       req.OrderId,
-		PaymentID:     req.PaymentId,
-		PaymentMethod: req.PaymentMethod,
-		Amount:        req.Amount,
+		OrderID:        req.OrderId,
+		PaymentID:      req.PaymentId,
+		PaymentMethod:  req.PaymentMethod,
+		Amount:         money.FromFloat(req.Amount),
+		Currency:       req.Currency,
+		InvoicePending: req.InvoicePending,
 	}
 
 	// Call confirmation service
-	if err := s.confirmationService.ConfirmPayment(ctx, confirmReq); err != nil {
+	result, err := s.confirmationService.ConfirmPayment(ctx, confirmReq)
+	if err != nil {
 		log.Printf("[gRPC] ConfirmPayment failed for order %s: %v", req.OrderId, err)
 		return &pb.ConfirmPaymentResponse{
 			Success: false,
@@ -43,11 +62,84 @@ func (s *TicketingGRPCServer) ConfirmPayment(ctx context.Context, req *pb.Confir
 		}, nil // Return nil error to avoid gRPC error, but set success=false
 	}
 
-	log.Printf("[gRPC] Payment confirmed successfully for order %s", req.OrderId)
+	log.Printf("[gRPC] Payment handled for order %s: %s", req.OrderId, result.Outcome)
+
+	// Retries and payments flagged for refund succeed too, so the webhook is not retried
+	responseMessage := "Payment confirmed and tickets generated"
+	switch result.Outcome {
+	case service.ConfirmationAlreadyPaid:
+		responseMessage = message.MsgOrderAlreadyConfirmed
+	case service.ConfirmationRefundFlagged:
+		responseMessage = message.MsgPaymentRefundFlagged
+	}
 
 	return &pb.ConfirmPaymentResponse{
 		Success:          true,
-		Message:          "Payment confirmed and tickets generated",
-		TicketsGenerated: 0, // TODO: Return actual ticket count
+		Message:          responseMessage,
+		TicketsGenerated: int32(len(result.TicketIDs)), // Retries report the tickets of the first confirmation
+		Outcome:          result.Outcome,
+		RefundReason:     result.RefundReason, // Payment service refunds payments of cancelled and sold out orders
+	}, nil
+}
+
+// RefundOrder marks a paid order refunded, voids its tickets and returns its seats
+// Refusals are reported with status codes so payment service doesn't refund the payment
+func (s *TicketingGRPCServer) RefundOrder(ctx context.Context, req *pb.RefundOrderRequest) (*pb.RefundOrderResponse, error) {
+	log.Printf("[gRPC] RefundOrder called for order: %s, refund: %s, reason: %q", req.OrderId, req.RefundId, req.Reason)
+
+	result, err := s.refundService.RefundOrder(ctx, req.UserId, req.OrderId, req.RefundId)
+	if err != nil {
+		log.Printf("[gRPC] RefundOrder failed for order %s: %v", req.OrderId, err)
+		return nil, refundStatusError(err, "failed to refund order")
+	}
+
+	return &pb.RefundOrderResponse{
+		OrderId:       result.OrderID,
+		PaymentId:     result.PaymentID,
+		Amount:        result.Amount.Float64(),
+		TicketsVoided: int32(result.TicketsVoided),
+	}, nil
+}
+
+// CheckRefundEligibility checks that the buyer may refund a paid order, without changing it
+// Refusals are reported with the status codes of RefundOrder
+func (s *TicketingGRPCServer) CheckRefundEligibility(ctx context.Context, req *pb.CheckRefundEligibilityRequest) (*pb.CheckRefundEligibilityResponse, error) {
+	result, err := s.refundService.CheckRefundEligibility(ctx, req.UserId, req.OrderId)
+	if err != nil {
+		log.Printf("[gRPC] Refund of order %s refused: %v", req.OrderId, err)
+		return nil, refundStatusError(err, "failed to check refund eligibility")
+	}
+
+	return &pb.CheckRefundEligibilityResponse{
+		OrderId:   result.OrderID,
+		PaymentId: result.PaymentID,
 	}, nil
 }
+
+// RecordPaymentRefund marks the refund request of a flagged payment refunded once payment service refunded it
+func (s *TicketingGRPCServer) RecordPaymentRefund(ctx context.Context, req *pb.RecordPaymentRefundRequest) (*pb.RecordPaymentRefundResponse, error) {
+	recorded, err := s.refundService.RecordPaymentRefund(ctx, req.OrderId, req.PaymentId)
+	if err != nil {
+		log.Printf("[gRPC] RecordPaymentRefund failed for order %s: %v", req.OrderId, err)
+		return nil, status.Error(codes.Internal, "failed to record payment refund")
+	}
+
+	return &pb.RecordPaymentRefundResponse{Recorded: recorded}, nil
+}
+
+// refundStatusError converts a refused refund to its gRPC status, other errors are reported as internalMessage
+func refundStatusError(err error, internalMessage string) error {
+	switch {
+	case errors.Is(err, service.ErrOrderNotFound), errors.Is(err, service.ErrEventNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrUnauthorized):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrRefundAlreadyRequested):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrRefundNotAllowed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrRefundWindowClosed):
+		return status.Error(codes.OutOfRange, err.Error()) // Past the refund policy's window
+	}
+	return status.Error(codes.Internal, internalMessage)
+}
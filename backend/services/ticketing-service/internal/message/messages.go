@@ -8,6 +8,7 @@ const (
 	MsgCartCleared        = "Cart cleared successfully"
 	MsgCartRetrieved      = "Cart retrieved successfully"
 	MsgOrderCreated       = "Order created successfully"
+	MsgOrderQuoted        = "Order quoted successfully"
 	MsgOrderRetrieved     = "Order retrieved successfully"
 	MsgOrdersRetrieved    = "Orders retrieved successfully"
 	MsgOrderCancelled     = "Order cancelled successfully"
@@ -16,6 +17,58 @@ const (
 	MsgTicketsRetrieved   = "Tickets retrieved successfully"
 	MsgTicketValidated    = "Ticket validated successfully"
 	MsgAvailabilityChecked = "Availability checked successfully"
+	MsgShareLinkCreated   = "Share link created successfully"
+	MsgShareLinksRevoked  = "Share links revoked successfully"
+	MsgSharedTicket       = "Shared ticket retrieved successfully"
+	MsgTicketQRRegenerated = "Ticket QR code regenerated successfully"
+	MsgDynamicQRGenerated = "Rotating QR code generated successfully"
+	MsgTicketVoided       = "Ticket voided successfully"
+	MsgTicketVerified     = "Ticket verified successfully"
+	MsgIssueReported      = "Issue reported successfully"
+	MsgIssuesRetrieved    = "Issues retrieved successfully"
+	MsgIssueReplied       = "Reply sent successfully"
+	MsgIssueResolved      = "Issue resolved successfully"
+	MsgOrderAlreadyConfirmed = "Order already confirmed by this payment"
+	MsgPaymentRefundFlagged  = "Payment cannot be applied to the order and is flagged for manual refund"
+	MsgRefundsRetrieved      = "Refund requests retrieved successfully"
+	MsgRefundMarked          = "Refund request marked as refunded"
+	MsgRefundRequested       = "Refund requested, the amount paid will be refunded to your payment method"
+	MsgBadgeRetrieved        = "Badge retrieved successfully"
+	MsgKioskRegistered       = "Kiosk registered successfully"
+	MsgKiosksRetrieved       = "Kiosks retrieved successfully"
+	MsgKioskRevoked          = "Kiosk revoked successfully"
+	MsgKioskCheckedIn        = "Attendee checked in successfully"
+	MsgAnnouncementSent      = "Announcement sent successfully"
+	MsgInsuranceRetrieved    = "Ticket insurance retrieved successfully"
+	MsgInsuranceClaimed      = "Insurance claim filed, the covered amount will be refunded"
+	MsgAttendeesRetrieved    = "Attendees retrieved successfully"
+	MsgAccommodationsSummary = "Accommodation summary retrieved successfully"
+	MsgPoliciesRetrieved     = "Policies retrieved successfully"
+	MsgPolicyRetrieved       = "Policy retrieved successfully"
+	MsgPolicyPublished       = "Policy published successfully"
+	MsgOrderPoliciesAccepted = "Accepted policies retrieved successfully"
+	MsgInvitationsImported   = "Invitations imported successfully, claim links are being emailed"
+	MsgInvitationsImportValid = "Invitations file is valid, nothing was imported (dry run)"
+	MsgInvitationsRetrieved  = "Invitations retrieved successfully"
+	MsgInvitationRetrieved   = "Invitation retrieved successfully"
+	MsgInvitationClaimed     = "Invitation claimed successfully"
+	MsgAPIKeyCreated         = "API key created successfully, store it now as it is not shown again"
+	MsgAPIKeysRetrieved      = "API keys retrieved successfully"
+	MsgAPIKeyRevoked         = "API key revoked successfully"
+	MsgInventoryHistory      = "Inventory history retrieved successfully"
+	MsgSandboxCleared        = "Test orders cleared successfully, the event can go live"
+	MsgOrderEventsRetrieved  = "Order events retrieved successfully"
+	MsgOrderReplayed         = "Order replayed successfully"
+	MsgOrdersReplayed        = "Event-sourced orders replayed successfully"
+	MsgTicketReissueStarted  = "Ticket reissue started, new tickets are emailed in batches"
+	MsgTicketReissue         = "Ticket reissue retrieved successfully"
+	MsgNotificationPrefs     = "Notification preferences retrieved successfully"
+	MsgNotificationPrefsSet  = "Notification preferences updated successfully"
+	MsgScannerHeartbeat      = "Scanner heartbeat recorded"
+	MsgCheckinLive           = "Live check-ins retrieved successfully"
+	MsgWaitlistJoined        = "Joined the waitlist, you will be emailed when tickets are held for you"
+	MsgWaitlistEntry         = "Waitlist entry retrieved successfully"
+	MsgWaitlistLeft          = "Left the waitlist successfully"
 )
 
 // Error messages
@@ -35,9 +88,73 @@ const (
 	ErrOrderExpired          = "Order has expired"
 	ErrOrderAlreadyPaid      = "Order has already been paid"
 	ErrOrderAlreadyCancelled = "Order has already been cancelled"
+	ErrPaymentAlreadyApplied = "Payment has already been applied to another order"
 	ErrCannotCancelOrder     = "Cannot cancel order at this stage"
 	ErrTicketAlreadyUsed     = "Ticket has already been used"
 	ErrTicketInvalid         = "Ticket is invalid"
 	ErrLockAcquisitionFailed = "Failed to acquire lock, please try again"
 	ErrEventNotFound         = "Event not found"
+	ErrEventNotOnSale        = "Tickets for this event are not on sale"
+	ErrShareLinkInvalid      = "Share link is invalid or has been revoked"
+	ErrShareLinkExpired      = "Share link has expired"
+	ErrTicketVoid            = "Ticket has been voided"
+	ErrInvalidValidateMode   = "Invalid validation mode, expected scan or verify"
+	ErrTicketAlreadyInside   = "Ticket holder is already inside, scan an exit first"
+	ErrTicketNotInside       = "Ticket has no entry to exit from"
+	ErrTicketAlreadyUsedToday = "Ticket has already been used today"
+	ErrTicketNotValidToday   = "Ticket is not valid today"
+	ErrExitScanNotAllowed    = "Exit scans are not used for this event"
+	ErrCheckInClosed         = "Check-in is closed, the event has ended"
+	ErrZoneNotFound          = "Gate zone not found for this event"
+	ErrTicketZoneNotAllowed  = "Ticket is not allowed in this zone"
+	ErrDynamicQRRequired     = "This event only admits the rotating QR code from the ticket app"
+	ErrDynamicQRDisabled     = "This event does not use rotating QR codes"
+	ErrIssueNotFound         = "Issue not found"
+	ErrIssueAlreadyOpen      = "An unresolved issue in this category already exists for the order"
+	ErrIssueResolved         = "Issue is already resolved"
+	ErrRefundNotFound        = "Refund request not found"
+	ErrRefundAlreadyRefunded = "Refund request is already refunded"
+	ErrRefundNotAllowed      = "Order is not refundable under the event's refund policy, or a ticket was already used"
+	ErrRefundWindowClosed    = "The refund window of the event's refund policy has closed"
+	ErrRefundRequested       = "A refund was already requested for this order"
+	ErrKioskNotFound         = "Kiosk not found"
+	ErrKioskInvalid          = "Kiosk token is invalid or the kiosk has been revoked"
+	ErrTicketWrongEvent      = "Ticket is for another event"
+	ErrNoBadges              = "No valid tickets to print badges for"
+	ErrTooManyBadges         = "Too many badges for one PDF, select at most 200 tickets"
+	ErrEventIDRequired       = "event_id query parameter is required"
+	ErrNoAnnouncementRecipients  = "Event has no ticket holders to send the announcement to"
+	ErrAnnouncementQuotaExceeded = "Announcement exceeds the monthly email quota of your plan"
+	ErrInsuranceUnavailable      = "Ticket insurance is not available right now, order without insurance or retry later"
+	ErrInsuranceCoverageChanged  = "Ticket prices changed since the insurance was quoted, please review the order and retry"
+	ErrInsuranceNotFound         = "Order has no ticket insurance"
+	ErrInsuranceNotActive        = "Ticket insurance is not active, it is issued shortly after payment or was already claimed"
+	ErrInsuranceClaimNotAllowed  = "Order can no longer be claimed, its event has ended, a ticket was used or the order was refunded"
+	ErrInsuranceClaimRejected    = "Insurance claim was rejected by the insurance partner"
+	ErrAccessibleSeatingUnavailable = "Not enough accessible seating left in this ticket tier"
+	ErrPolicyNotFound            = "Policy not found"
+	ErrInvalidPolicyScope        = "Terms are published without an event, refund and health policies for an event"
+	ErrRefundPolicyTemplate      = "Event uses a standard refund policy, choose custom on the event to publish your own"
+	ErrPolicyAcceptanceRequired  = "Accept the current terms and event policies to order, see GET /api/v1/public/policies?event_id="
+	ErrInvalidInvitationsFile    = "Invalid invitations file"
+	ErrInvitationsInvalidRows    = "Invitations file has invalid rows, nothing was imported"
+	ErrInvitationsClosed         = "Event is cancelled or has ended and no longer accepts invitations"
+	ErrInvalidClaimDeadline      = "claim_deadline must be in the future and no later than the end of the event"
+	ErrInvitationInvalid         = "Invitation link is invalid"
+	ErrInvitationClaimed         = "Invitation has already been claimed"
+	ErrInvitationExpired         = "Invitation has expired, its tickets were released"
+	ErrInvalidInvitation         = "Invalid invitation"
+	ErrAPIKeyNotFound            = "API key not found"
+	ErrAPIKeyInvalid             = "API key is invalid or has been revoked"
+	ErrInvalidInventoryRange     = "Invalid range, from must be before to and the window at most 1440 intervals long"
+	ErrEventNotSandbox           = "Event is not in sandbox mode, it has no test orders"
+	ErrOrderNotEventSourced      = "Order is not event-sourced, it has no event stream"
+	ErrNoTicketsToReissue        = "Event has no unused tickets to reissue"
+	ErrTicketReissueInProgress   = "A ticket reissue of this event is still in progress"
+	ErrTicketReissueNotFound     = "Ticket reissue not found"
+	ErrDigestsDisabled           = "Notification digests are not available right now, notifications are emailed right away"
+	ErrCheckinMetricsDisabled    = "Live check-in metrics are not available right now, scanning is not affected"
+	ErrTierNotSoldOut            = "Ticket tier still has enough tickets on sale, order them instead"
+	ErrAlreadyOnWaitlist         = "You are already on the waitlist of this ticket tier"
+	ErrWaitlistEntryNotFound     = "You are not on the waitlist of this ticket tier"
 )
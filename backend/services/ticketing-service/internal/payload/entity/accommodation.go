@@ -0,0 +1,70 @@
+package entity
+
+import "time"
+
+// Accommodation types buyers may request at checkout
+const (
+	AccommodationWheelchair    = "wheelchair"
+	AccommodationCompanionSeat = "companion_seat"
+	AccommodationHearing       = "hearing_assistance"
+	AccommodationVisual        = "visual_assistance"
+	AccommodationStepFree      = "step_free_access"
+	AccommodationOther         = "other"
+)
+
+// AccommodationTypes lists every accommodation type in display order
+var AccommodationTypes = []string{
+	AccommodationWheelchair,
+	AccommodationCompanionSeat,
+	AccommodationHearing,
+	AccommodationVisual,
+	AccommodationStepFree,
+	AccommodationOther,
+}
+
+// AccommodationNeedsAccessibleSeat checks if an accommodation type takes a seat
+// from the tier's accessible inventory
+func AccommodationNeedsAccessibleSeat(accommodationType string) bool {
+	return accommodationType == AccommodationWheelchair || accommodationType == AccommodationCompanionSeat
+}
+
+// Accommodation is an accessibility need a buyer flagged for one ticket of an order
+type Accommodation struct {
+	ID             string    `db:"id"`
+	OrderID        string    `db:"order_id"`
+	TenantID       string    `db:"tenant_id"`
+	EventID        string    `db:"event_id"`
+	TicketTierID   string    `db:"ticket_tier_id"`
+	TicketID       *string   `db:"ticket_id"` // Set once the order's tickets are issued
+	Type           string    `db:"type"`
+	Notes          *string   `db:"notes"`
+	AccessibleSeat bool      `db:"accessible_seat"` // Holds a seat of the tier's accessible inventory
+	CreatedAt      time.Time `db:"created_at"`
+}
+
+// AccommodationCount is the number of issued tickets of an event with an accommodation type
+type AccommodationCount struct {
+	Type  string `db:"type"`
+	Count int    `db:"count"`
+}
+
+// AccessibleInventory is the accessible seating of a ticket tier
+type AccessibleInventory struct {
+	TicketTierID        string `db:"id"`
+	TierName            string `db:"name"`
+	AccessibleQuota     int    `db:"accessible_quota"`
+	AccessibleSoldCount int    `db:"accessible_sold_count"` // Includes seats of unpaid reservations
+}
+
+// Attendee is a valid or used ticket of an event with its holder
+type Attendee struct {
+	TicketID     string     `db:"ticket_id"`
+	TicketNumber string     `db:"ticket_number"`
+	OrderID      string     `db:"order_id"`
+	TicketTierID string     `db:"ticket_tier_id"`
+	TierName     string     `db:"tier_name"`
+	Status       string     `db:"status"`
+	UsedAt       *time.Time `db:"validated_at"`
+	HolderName   string     `db:"holder_name"`
+	HolderEmail  string     `db:"holder_email"`
+}
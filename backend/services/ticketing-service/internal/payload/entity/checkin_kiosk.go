@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+// CheckinKiosk is a self-service check-in device registered for one event
+type CheckinKiosk struct {
+	ID           string     `db:"id"`
+	TenantID     string     `db:"tenant_id"`
+	EventID      string     `db:"event_id"`
+	Name         string     `db:"name"`
+	RegisteredBy string     `db:"registered_by"`
+	LastSeenAt   *time.Time `db:"last_seen_at"` // Last check-in made by the device
+	RevokedAt    *time.Time `db:"revoked_at"`
+	CreatedAt    time.Time  `db:"created_at"`
+}
+
+// IsActive checks if the kiosk may still check in attendees
+func (k *CheckinKiosk) IsActive() bool {
+	return k.RevokedAt == nil
+}
@@ -0,0 +1,20 @@
+package entity
+
+// Consistency check constants
+const (
+	CheckPaidOrderWithoutTickets     = "paid_order_without_tickets"      // Paid order has no tickets
+	CheckTicketCountMismatch         = "ticket_count_mismatch"           // Tickets of an order differ from its item quantities
+	CheckSoldCountMismatch           = "sold_count_mismatch"             // Tier sold_count differs from quantities held by orders
+	CheckPaidPaymentWithoutPaidOrder = "paid_payment_without_paid_order" // Paid payment whose order is not paid
+	CheckInventoryLedgerMismatch     = "inventory_ledger_mismatch"       // Tier sold_count differs from the sum of its inventory ledger
+)
+
+// Discrepancy is a record breaking a data consistency invariant
+type Discrepancy struct {
+	Check    string `db:"-" json:"check"`
+	EntityID string `db:"entity_id" json:"entity_id"` // Order, ticket tier or payment ID depending on the check
+	Expected int64  `db:"expected" json:"expected"`
+	Actual   int64  `db:"actual" json:"actual"`
+	Detail   string `db:"detail" json:"detail,omitempty"`
+	Healed   bool   `db:"-" json:"healed"`
+}
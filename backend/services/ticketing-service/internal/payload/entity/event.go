@@ -4,17 +4,25 @@ import "time"
 
 // Event represents event data from event service
 type Event struct {
-	ID          string    `db:"id"`
-	Name        string    `db:"title"`
-	Description string    `db:"description"`
-	Location    string    `db:"location"`
-	StartDate   time.Time `db:"start_date"`
-	EndDate     time.Time `db:"end_date"`
-	CategoryID  string    `db:"category"`
-	OrganizerID string    `db:"organizer_id"`
-	Status      string    `db:"status"`
-	CreatedAt   time.Time `db:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at"`
+	ID           string    `db:"id"`
+	Name         string    `db:"title"`
+	Slug         string    `db:"slug"`
+	Description  string    `db:"description"`
+	Location     string    `db:"location"`
+	StartDate    time.Time `db:"start_date"`
+	EndDate      time.Time `db:"end_date"`
+	Timezone     string    `db:"timezone"`
+	ScanPolicy   string    `db:"scan_policy"`   // single_use, reentry, per_day
+	RefundPolicy *string   `db:"refund_policy"` // no_refunds, seven_day, flexible; nil for the organizer's own policy
+	IsSandbox    bool      `db:"is_sandbox"`    // Rehearsal event, its orders are test orders
+	DynamicQR    bool      `db:"dynamic_qr"`    // Entry needs the rotating QR token, static ticket QR codes are refused
+	BannerURL    *string   `db:"banner_url"`
+	CategoryID   string    `db:"category"`
+	OrganizerID  string    `db:"organizer_id"`
+	TenantID     string    `db:"tenant_id"`
+	Status       string    `db:"status"`
+	CreatedAt    time.Time `db:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at"`
 }
 
 // Event status constants
@@ -25,6 +33,20 @@ const (
 	EventStatusCompleted = "completed"
 )
 
+// Scan policy constants (how tickets are scanned at the entrance)
+const (
+	ScanPolicySingleUse = "single_use" // One entry per ticket
+	ScanPolicyReentry   = "reentry"    // Re-entry allowed after an exit scan
+	ScanPolicyPerDay    = "per_day"    // One entry per event day (multi-day events)
+)
+
+// Refund policy constants (standard templates chosen by the organizer)
+const (
+	RefundPolicyNoRefunds = "no_refunds" // Tickets are never refunded by the buyer
+	RefundPolicySevenDay  = "seven_day"  // Refundable within 7 days of payment, until the event starts
+	RefundPolicyFlexible  = "flexible"   // Refundable until 24 hours before the event starts
+)
+
 // IsActive checks if event is currently active
 func (e *Event) IsActive() bool {
 	return e.Status == EventStatusPublished
@@ -35,6 +57,11 @@ func (e *Event) IsCancelled() bool {
 	return e.Status == EventStatusCancelled
 }
 
+// IsCompleted checks if event has ended and was completed by event service
+func (e *Event) IsCompleted() bool {
+	return e.Status == EventStatusCompleted
+}
+
 // HasStarted checks if event has started
 func (e *Event) HasStarted() bool {
 	return time.Now().After(e.StartDate)
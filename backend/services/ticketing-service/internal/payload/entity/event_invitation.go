@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// EventInvitation is a guest list entry of an event, claimed through a personal link
+// While pending it holds Quantity seats of the tier; the claim's order holds them afterwards
+type EventInvitation struct {
+	ID            string      `db:"id"`
+	BatchID       string      `db:"batch_id"` // Import the invitation was created by
+	EventID       string      `db:"event_id"`
+	TicketTierID  string      `db:"ticket_tier_id"`
+	Email         string      `db:"email"`
+	Name          string      `db:"name"`
+	Quantity      int         `db:"quantity"`
+	Price         money.Money `db:"price"`  // Unit price the invitee pays, 0 for comps
+	Status        string      `db:"status"` // pending, claimed, released
+	ClaimDeadline time.Time   `db:"claim_deadline"`
+	OrderID       *string     `db:"order_id"`
+	ClaimedBy     *string     `db:"claimed_by"`
+	ClaimedAt     *time.Time  `db:"claimed_at"`
+	ReleasedAt    *time.Time  `db:"released_at"`
+	EmailSentAt   *time.Time  `db:"email_sent_at"`
+	CreatedBy     string      `db:"created_by"`
+	CreatedAt     time.Time   `db:"created_at"`
+	UpdatedAt     time.Time   `db:"updated_at"`
+}
+
+// Invitation status constants
+const (
+	InvitationStatusPending  = "pending"  // Seats held until claimed or released
+	InvitationStatusClaimed  = "claimed"  // Order created for the invitee
+	InvitationStatusReleased = "released" // Not claimed before the deadline, seats back on sale
+)
+
+// IsComp checks if the invitation is free of charge
+func (i *EventInvitation) IsComp() bool {
+	return i.Price.IsZero()
+}
+
+// IsClaimable checks if the invitation can still be claimed at now
+func (i *EventInvitation) IsClaimable(now time.Time) bool {
+	return i.Status == InvitationStatusPending && now.Before(i.ClaimDeadline)
+}
+
+// InvitationSummary counts the invitations of an event by status
+type InvitationSummary struct {
+	Pending  int `db:"pending"`
+	Claimed  int `db:"claimed"`
+	Released int `db:"released"`
+	Unsent   int `db:"unsent"` // Pending invitations whose email was not sent yet
+}
@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// InsurancePolicy is the refund-protection insurance bought with an order
+type InsurancePolicy struct {
+	ID           string      `db:"id"`
+	OrderID      string      `db:"order_id"`
+	TenantID     string      `db:"tenant_id"`
+	Provider     string      `db:"provider"`
+	QuoteID      string      `db:"quote_id"`      // Partner quote the premium was charged from
+	PolicyNumber *string     `db:"policy_number"` // Set once the partner issued the policy
+	Premium      money.Money `db:"premium"`
+	Coverage     money.Money `db:"coverage"` // Refunded to the buyer on an accepted claim
+	Currency     string      `db:"currency"`
+	Status       string      `db:"status"` // quoted, active, failed, claimed
+	ClaimID      *string     `db:"claim_id"`
+	ClaimReason  *string     `db:"claim_reason"`
+	IssuedAt     *time.Time  `db:"issued_at"`
+	ClaimedAt    *time.Time  `db:"claimed_at"`
+	CreatedAt    time.Time   `db:"created_at"`
+	UpdatedAt    time.Time   `db:"updated_at"`
+}
+
+// Insurance policy status constants
+const (
+	InsuranceStatusQuoted  = "quoted"  // Charged with the order, waiting for payment to be issued
+	InsuranceStatusActive  = "active"  // Issued by the partner, claims may be filed
+	InsuranceStatusFailed  = "failed"  // Partner refused to issue, premium flagged for refund
+	InsuranceStatusClaimed = "claimed" // Claim filed, coverage flagged for refund
+)
+
+// IsActive checks if claims may be filed on the policy
+func (p *InsurancePolicy) IsActive() bool {
+	return p.Status == InsuranceStatusActive
+}
@@ -0,0 +1,44 @@
+package entity
+
+import "time"
+
+// Inventory movement reasons, one ledger entry per change of a ticket tier's sold_count
+const (
+	InventoryReasonReserve = "reserve" // Seats held by a new reservation, invitation import or waitlist offer
+	InventoryReasonConfirm = "confirm" // Seats of an expired order taken back by its late payment
+	InventoryReasonRelease = "release" // Seats returned by an expired or cancelled reservation, or an expired invitation or waitlist offer
+	InventoryReasonAdjust  = "adjust"  // sold_count recomputed by the consistency checker, or the opening balance
+)
+
+// InventoryCause is why a ticket tier's sold_count changes, recorded in the ledger with the change
+type InventoryCause struct {
+	Reason  string
+	OrderID *string // Order behind the change, nil for invitations and waitlist offers
+}
+
+// OrderInventoryCause is a sold_count change made for an order
+func OrderInventoryCause(reason, orderID string) InventoryCause {
+	return InventoryCause{Reason: reason, OrderID: &orderID}
+}
+
+// Inventory history bucket sizes, named after the date_trunc fields they map to
+const (
+	InventoryIntervalMinute = "minute"
+	InventoryIntervalHour   = "hour"
+	InventoryIntervalDay    = "day"
+)
+
+// InventoryIntervals maps each inventory history bucket size to its duration
+var InventoryIntervals = map[string]time.Duration{
+	InventoryIntervalMinute: time.Minute,
+	InventoryIntervalHour:   time.Hour,
+	InventoryIntervalDay:    24 * time.Hour,
+}
+
+// InventoryBucket is the sold_count movement of a ticket tier within one time bucket
+type InventoryBucket struct {
+	BucketStart time.Time `db:"bucket_start"` // UTC
+	Sold        int       `db:"sold"`
+	Released    int       `db:"released"`
+	Adjusted    int       `db:"adjusted"` // Net change of adjustments
+}
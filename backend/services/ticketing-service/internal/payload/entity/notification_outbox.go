@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+// NotificationOutbox is an email of an order that failed to send, retried by the notification outbox worker
+type NotificationOutbox struct {
+	ID            string     `db:"id"`
+	Type          string     `db:"type"`
+	OrderID       string     `db:"order_id"`
+	Status        string     `db:"status"`   // pending, sending, sent, dead
+	Attempts      int        `db:"attempts"` // Send attempts so far, including the failed send that queued it
+	NextAttemptAt time.Time  `db:"next_attempt_at"`
+	LastError     *string    `db:"last_error"`
+	LockedUntil   *time.Time `db:"locked_until"` // Lease of the worker sending the message
+	SentAt        *time.Time `db:"sent_at"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+}
+
+// Notification outbox type constants
+const (
+	NotificationTypeTicketEmail = "ticket_email" // E-ticket email of a paid order
+)
+
+// Notification outbox status constants
+const (
+	OutboxStatusPending = "pending" // Waiting for NextAttemptAt
+	OutboxStatusSending = "sending" // Claimed by a worker until LockedUntil
+	OutboxStatusSent    = "sent"
+	OutboxStatusDead    = "dead" // Dead-lettered after the last attempt
+)
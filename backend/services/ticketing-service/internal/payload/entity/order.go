@@ -1,23 +1,76 @@
 package entity
 
-import "time"
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
 
 // Order represents a ticket order
 type Order struct {
-	ID                   string     `db:"id"`
-	UserID               string     `db:"user_id"`
-	EventID              string     `db:"event_id"`
-	TotalAmount          float64    `db:"total_amount"`
-	PlatformFee          float64    `db:"platform_fee"`
-	ServiceFee           float64    `db:"service_fee"`
-	GrandTotal           float64    `db:"grand_total"`
-	Status               string     `db:"status"` // reserved, paid, expired, cancelled, completed
-	PaymentID            *string    `db:"payment_id"`
-	PaymentMethod        *string    `db:"payment_method"`
-	ReservationExpiresAt *time.Time `db:"reservation_expires_at"`
-	CreatedAt            time.Time  `db:"created_at"`
-	UpdatedAt            time.Time  `db:"updated_at"`
-	CompletedAt          *time.Time `db:"completed_at"`
+	ID                   string        `db:"id"`
+	UserID               string        `db:"user_id"`
+	EventID              string        `db:"event_id"`
+	TenantID             string        `db:"tenant_id"`
+	TotalAmount          money.Money   `db:"total_amount"`
+	PlatformFee          money.Money   `db:"platform_fee"`
+	ServiceFee           money.Money   `db:"service_fee"`
+	GrandTotal           money.Money   `db:"grand_total"`
+	Status               string        `db:"status"` // reserved, paid, expired, cancelled, completed
+	PaymentID            *string       `db:"payment_id"`
+	PaymentMethod        *string       `db:"payment_method"`
+	PaidAmount           *money.Money  `db:"paid_amount"`        // Amount reported by payment provider
+	PaidCurrency         *string       `db:"paid_currency"`      // Currency reported by payment provider
+	AmountDiscrepancy    *money.Money  `db:"amount_discrepancy"` // PaidAmount - GrandTotal, set when accepted within tolerance
+	ReservationExpiresAt *time.Time    `db:"reservation_expires_at"`
+	CreatedAt            time.Time     `db:"created_at"`
+	UpdatedAt            time.Time     `db:"updated_at"`
+	CompletedAt          *time.Time    `db:"completed_at"`
+	Metadata             OrderMetadata `db:"metadata"`      // Partner references set at creation
+	IsSandbox            bool          `db:"is_sandbox"`    // Test order of a sandbox event, paid with test keys
+	EventSourced         bool          `db:"event_sourced"` // Changes are appended to order_events, see OrderEvent
+}
+
+// OrderMetadata holds partner-defined string key/value pairs of an order (JSONB)
+type OrderMetadata map[string]string
+
+// Scan implements sql.Scanner for JSONB columns
+func (m *OrderMetadata) Scan(src interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case nil:
+		*m = OrderMetadata{}
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into OrderMetadata", src)
+	}
+
+	metadata := OrderMetadata{}
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return fmt.Errorf("failed to decode order metadata: %w", err)
+	}
+	*m = metadata
+	return nil
+}
+
+// Value implements driver.Valuer, nil is stored as an empty object
+// Encoded as string: lib/pq would send []byte as bytea
+func (m OrderMetadata) Value() (driver.Value, error) {
+	if m == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode order metadata: %w", err)
+	}
+	return string(data), nil
 }
 
 // Order status constants
@@ -27,6 +80,7 @@ const (
 	OrderStatusExpired   = "expired"   // Reservation timeout reached
 	OrderStatusCancelled = "cancelled" // Manually cancelled by user
 	OrderStatusCompleted = "completed" // Event finished, tickets used
+	OrderStatusRefunded  = "refunded"  // Refunded through payment service, tickets voided
 )
 
 // IsExpired checks if order reservation has expired
@@ -42,7 +96,7 @@ func (o *Order) IsExpired() bool {
 
 // CanBeCancelled checks if order can be cancelled
 // Only reserved orders can be cancelled
-// Paid orders require refund process (handled separately)
+// Paid orders are refunded through payment service instead
 func (o *Order) CanBeCancelled() bool {
 	return o.Status == OrderStatusReserved
 }
@@ -51,3 +105,11 @@ func (o *Order) CanBeCancelled() bool {
 func (o *Order) IsPaid() bool {
 	return o.Status == OrderStatusPaid || o.Status == OrderStatusCompleted
 }
+
+// PaidCurrencyOr returns the currency the order was paid in, or fallback for orders paid before it was recorded
+func (o *Order) PaidCurrencyOr(fallback string) string {
+	if o.PaidCurrency != nil && *o.PaidCurrency != "" {
+		return *o.PaidCurrency
+	}
+	return fallback
+}
@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+// OrderAuditEntry records an action support took on an order outside the normal flow
+type OrderAuditEntry struct {
+	ID        string    `db:"id"`
+	OrderID   string    `db:"order_id"`
+	TenantID  string    `db:"tenant_id"`
+	Action    string    `db:"action"`
+	ActorID   string    `db:"actor_id"`
+	Reference *string   `db:"reference"` // e.g. the bank transfer reference of a manual payment
+	Reason    string    `db:"reason"`
+	Outcome   *string   `db:"outcome"` // Result of the action, e.g. the confirmation outcome
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// Order audit action constants
+const (
+	AuditActionMarkPaid = "mark_paid" // Payment confirmed by finance without a provider webhook
+)
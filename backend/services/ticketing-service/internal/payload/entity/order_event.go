@@ -0,0 +1,155 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// OrderEvent is one entry of an event-sourced order's stream
+// Events are appended with the order change they record; the orders row is their projection
+type OrderEvent struct {
+	ID        int64           `db:"id"`
+	OrderID   string          `db:"order_id"`
+	Version   int             `db:"version"` // Position in the stream, from 1
+	EventType string          `db:"event_type"`
+	Data      json.RawMessage `db:"data"`
+	CreatedAt time.Time       `db:"created_at"`
+}
+
+// Order event types
+// Changes after creation are named after the status the order has afterwards, e.g. order_paid
+const (
+	OrderEventCreated   = "order_created"
+	OrderEventReserved  = "order_" + OrderStatusReserved
+	OrderEventPaid      = "order_" + OrderStatusPaid
+	OrderEventExpired   = "order_" + OrderStatusExpired
+	OrderEventCancelled = "order_" + OrderStatusCancelled
+	OrderEventCompleted = "order_" + OrderStatusCompleted
+)
+
+// orderCreatedData is the data of an order_created event: the whole order as created
+type orderCreatedData struct {
+	UserID               string        `json:"user_id"`
+	EventID              string        `json:"event_id"`
+	TenantID             string        `json:"tenant_id"`
+	TotalAmount          money.Money   `json:"total_amount"`
+	PlatformFee          money.Money   `json:"platform_fee"`
+	ServiceFee           money.Money   `json:"service_fee"`
+	GrandTotal           money.Money   `json:"grand_total"`
+	Status               string        `json:"status"`
+	ReservationExpiresAt *time.Time    `json:"reservation_expires_at,omitempty"`
+	Metadata             OrderMetadata `json:"metadata,omitempty"`
+	IsSandbox            bool          `json:"is_sandbox,omitempty"`
+}
+
+// orderChangedData is the data of the events after creation: the fields an order update sets
+type orderChangedData struct {
+	Status            string       `json:"status"`
+	PaymentID         *string      `json:"payment_id,omitempty"`
+	PaymentMethod     *string      `json:"payment_method,omitempty"`
+	PaidAmount        *money.Money `json:"paid_amount,omitempty"`
+	PaidCurrency      *string      `json:"paid_currency,omitempty"`
+	AmountDiscrepancy *money.Money `json:"amount_discrepancy,omitempty"`
+	CompletedAt       *time.Time   `json:"completed_at,omitempty"`
+}
+
+// NewOrderCreatedEvent records the creation of order
+func NewOrderCreatedEvent(order *Order) (*OrderEvent, error) {
+	return newOrderEvent(order.ID, OrderEventCreated, orderCreatedData{
+		UserID:               order.UserID,
+		EventID:              order.EventID,
+		TenantID:             order.TenantID,
+		TotalAmount:          order.TotalAmount,
+		PlatformFee:          order.PlatformFee,
+		ServiceFee:           order.ServiceFee,
+		GrandTotal:           order.GrandTotal,
+		Status:               order.Status,
+		ReservationExpiresAt: order.ReservationExpiresAt,
+		Metadata:             order.Metadata,
+		IsSandbox:            order.IsSandbox,
+	})
+}
+
+// NewOrderChangedEvent records an update of order's status and payment fields
+func NewOrderChangedEvent(order *Order) (*OrderEvent, error) {
+	return newOrderEvent(order.ID, "order_"+order.Status, orderChangedData{
+		Status:            order.Status,
+		PaymentID:         order.PaymentID,
+		PaymentMethod:     order.PaymentMethod,
+		PaidAmount:        order.PaidAmount,
+		PaidCurrency:      order.PaidCurrency,
+		AmountDiscrepancy: order.AmountDiscrepancy,
+		CompletedAt:       order.CompletedAt,
+	})
+}
+
+func newOrderEvent(orderID, eventType string, data interface{}) (*OrderEvent, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s event: %w", eventType, err)
+	}
+	return &OrderEvent{OrderID: orderID, EventType: eventType, Data: encoded}, nil
+}
+
+// ProjectOrder folds an order's stream, oldest first, into the order it describes
+func ProjectOrder(events []OrderEvent) (*Order, error) {
+	if len(events) == 0 || events[0].EventType != OrderEventCreated {
+		return nil, fmt.Errorf("order stream must start with %s", OrderEventCreated)
+	}
+
+	order := &Order{ID: events[0].OrderID, EventSourced: true}
+	for i, event := range events {
+		if event.Version != i+1 {
+			return nil, fmt.Errorf("order %s stream has version %d at position %d", order.ID, event.Version, i+1)
+		}
+		if err := order.apply(event); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// apply sets the fields event records on o
+func (o *Order) apply(event OrderEvent) error {
+	if event.EventType == OrderEventCreated {
+		if event.Version != 1 {
+			return fmt.Errorf("order %s created again at version %d", o.ID, event.Version)
+		}
+
+		var data orderCreatedData
+		if err := json.Unmarshal(event.Data, &data); err != nil {
+			return fmt.Errorf("failed to decode %s event %d: %w", event.EventType, event.Version, err)
+		}
+		o.UserID = data.UserID
+		o.EventID = data.EventID
+		o.TenantID = data.TenantID
+		o.TotalAmount = data.TotalAmount
+		o.PlatformFee = data.PlatformFee
+		o.ServiceFee = data.ServiceFee
+		o.GrandTotal = data.GrandTotal
+		o.Status = data.Status
+		o.ReservationExpiresAt = data.ReservationExpiresAt
+		o.Metadata = data.Metadata
+		o.IsSandbox = data.IsSandbox
+		o.CreatedAt = event.CreatedAt
+		o.UpdatedAt = event.CreatedAt
+		return nil
+	}
+
+	var data orderChangedData
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		return fmt.Errorf("failed to decode %s event %d: %w", event.EventType, event.Version, err)
+	}
+	o.Status = data.Status
+	o.PaymentID = data.PaymentID
+	o.PaymentMethod = data.PaymentMethod
+	o.PaidAmount = data.PaidAmount
+	o.PaidCurrency = data.PaidCurrency
+	o.AmountDiscrepancy = data.AmountDiscrepancy
+	o.CompletedAt = data.CompletedAt
+	o.UpdatedAt = event.CreatedAt
+	return nil
+}
@@ -0,0 +1,49 @@
+package entity
+
+import "time"
+
+// OrderIssue is a problem reported by a buyer on one of their orders
+type OrderIssue struct {
+	ID          string     `db:"id"`
+	OrderID     string     `db:"order_id"`
+	UserID      string     `db:"user_id"`
+	TenantID    string     `db:"tenant_id"`
+	Category    string     `db:"category"`
+	Description string     `db:"description"`
+	Status      string     `db:"status"` // open, in_progress, resolved
+	Resolution  *string    `db:"resolution"`
+	ResolvedBy  *string    `db:"resolved_by"`
+	ResolvedAt  *time.Time `db:"resolved_at"`
+	CreatedAt   time.Time  `db:"created_at"`
+	UpdatedAt   time.Time  `db:"updated_at"`
+}
+
+// OrderIssueReply is a message in the conversation of an order issue
+type OrderIssueReply struct {
+	ID        string    `db:"id"`
+	IssueID   string    `db:"issue_id"`
+	AuthorID  string    `db:"author_id"`
+	Message   string    `db:"message"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// Order issue category constants
+const (
+	IssueCategoryEmailNotReceived  = "email_not_received"
+	IssueCategoryWrongEventDetails = "wrong_event_details"
+	IssueCategoryPaymentProblem    = "payment_problem"
+	IssueCategoryTicketProblem     = "ticket_problem"
+	IssueCategoryOther             = "other"
+)
+
+// Order issue status constants
+const (
+	IssueStatusOpen       = "open"        // Reported, not answered yet
+	IssueStatusInProgress = "in_progress" // Support replied
+	IssueStatusResolved   = "resolved"    // Closed with a resolution
+)
+
+// IsResolved checks if issue was resolved by support
+func (i *OrderIssue) IsResolved() bool {
+	return i.Status == IssueStatusResolved
+}
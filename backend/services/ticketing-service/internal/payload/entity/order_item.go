@@ -1,6 +1,10 @@
 package entity
 
-import "time"
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
 
 // OrderItem represents an item in an order
 type OrderItem struct {
@@ -8,13 +12,13 @@ type OrderItem struct {
 	OrderID      string    `db:"order_id"`
 	TicketTierID string    `db:"ticket_tier_id"`
 	Quantity     int       `db:"quantity"`
-	Price        float64   `db:"price"` // Price per ticket at time of purchase
-	Subtotal     float64   `db:"subtotal"` // Price * Quantity
+	Price        money.Money `db:"price"`    // Price per ticket at time of purchase
+	Subtotal     money.Money `db:"subtotal"` // Price * Quantity
 	CreatedAt    time.Time `db:"created_at"`
 	UpdatedAt    time.Time `db:"updated_at"`
 }
 
 // CalculateSubtotal calculates subtotal for the order item
-func (oi *OrderItem) CalculateSubtotal() float64 {
-	return oi.Price * float64(oi.Quantity)
+func (oi *OrderItem) CalculateSubtotal() money.Money {
+	return oi.Price.Mul(oi.Quantity)
 }
@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// OrderRefundRequest is a payment that could not be applied to its order and must be refunded manually
+// Insurance claims, refused insurance premiums and buyer refunds are refunded the same way
+type OrderRefundRequest struct {
+	ID            string      `db:"id"`
+	OrderID       string      `db:"order_id"`
+	TenantID      string      `db:"tenant_id"`
+	PaymentID     string      `db:"payment_id"`
+	PaymentMethod *string     `db:"payment_method"`
+	Amount        money.Money `db:"amount"`
+	Currency      string      `db:"currency"`
+	Reason        string      `db:"reason"`
+	Status        string      `db:"status"` // pending, refunded
+	RefundedBy    *string     `db:"refunded_by"`
+	RefundedAt    *time.Time  `db:"refunded_at"`
+	CreatedAt     time.Time   `db:"created_at"`
+}
+
+// Refund reason constants
+const (
+	RefundReasonOrderExpired     = "order_expired"        // Paid after the reservation expired
+	RefundReasonOrderCancelled   = "order_cancelled"      // Paid after the buyer cancelled
+	RefundReasonDuplicatePayment = "duplicate_payment"    // Order was already paid with another payment
+	RefundReasonInsuranceClaim   = "insurance_claim"      // Buyer claimed the order's refund-protection insurance
+	RefundReasonInsuranceFailed  = "insurance_not_issued" // Partner refused the paid insurance, premium only
+	RefundReasonBuyerRequest     = "buyer_request"        // Buyer refunded the order under the event's refund policy
+	RefundReasonSoldOut          = "sold_out"             // Paid within the expiry grace period, but the tickets sold out meanwhile
+)
+
+// Refund request status constants
+const (
+	RefundStatusPending  = "pending"  // Waiting for support to refund
+	RefundStatusRefunded = "refunded" // Refunded outside the platform and marked by support, or by payment service without RefundedBy
+)
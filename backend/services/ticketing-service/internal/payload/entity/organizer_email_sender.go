@@ -0,0 +1,10 @@
+package entity
+
+// OrganizerEmailSender is an organizer's own sender for the emails of its events, configured in event service
+// Only senders whose domain is verified with the email provider are used
+type OrganizerEmailSender struct {
+	OrganizerID string  `db:"organizer_id"`
+	FromName    string  `db:"from_name"`
+	FromEmail   string  `db:"from_email"`
+	ReplyTo     *string `db:"reply_to"`
+}
@@ -0,0 +1,9 @@
+package entity
+
+// OrganizerPlan represents the limits of an organizer's plan, owned by event service
+// A limit of 0 means unlimited
+type OrganizerPlan struct {
+	Code                       string `db:"code"`
+	Name                       string `db:"name"`
+	AnnouncementEmailsPerMonth int    `db:"announcement_emails_per_month"`
+}
@@ -0,0 +1,12 @@
+package entity
+
+// OrganizerTicketTemplate is an organizer's e-ticket PDF layout for the tickets of its events, configured in event service
+// Unset fields keep the platform layout
+type OrganizerTicketTemplate struct {
+	OrganizerID   string  `db:"organizer_id"`
+	LogoURL       *string `db:"logo_url"`
+	PrimaryColor  *string `db:"primary_color"`
+	AccentColor   *string `db:"accent_color"`
+	SponsorFooter *string `db:"sponsor_footer"`
+	TermsText     *string `db:"terms_text"`
+}
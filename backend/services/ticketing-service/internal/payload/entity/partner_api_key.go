@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// PartnerAPIKey is an organizer's key for automation platforms (Zapier, Make)
+// Requests made with the key act as the organizer and only see the events they organize
+type PartnerAPIKey struct {
+	ID          string     `db:"id"`
+	TenantID    string     `db:"tenant_id"`
+	OrganizerID string     `db:"organizer_id"`
+	Name        string     `db:"name"`
+	LastUsedAt  *time.Time `db:"last_used_at"`
+	RevokedAt   *time.Time `db:"revoked_at"`
+	CreatedAt   time.Time  `db:"created_at"`
+}
+
+// IsActive checks if the key may still be used
+func (k *PartnerAPIKey) IsActive() bool {
+	return k.RevokedAt == nil
+}
+
+// IntegrationOrder is an order as seen by a polling trigger, with its event and buyer
+type IntegrationOrder struct {
+	ID            string      `db:"id"`
+	EventID       string      `db:"event_id"`
+	EventName     string      `db:"event_name"`
+	Status        string      `db:"status"`
+	TotalAmount   money.Money `db:"total_amount"`
+	GrandTotal    money.Money `db:"grand_total"`
+	PaymentMethod *string     `db:"payment_method"`
+	Tickets       int         `db:"tickets"`
+	BuyerName     string      `db:"buyer_name"`
+	BuyerEmail    string      `db:"buyer_email"`
+	CreatedAt     time.Time   `db:"created_at"`
+	UpdatedAt     time.Time   `db:"updated_at"`
+}
+
+// IntegrationAttendee is an issued ticket with its holder, as seen by a polling trigger
+type IntegrationAttendee struct {
+	TicketID     string    `db:"ticket_id"`
+	TicketNumber string    `db:"ticket_number"`
+	OrderID      string    `db:"order_id"`
+	EventID      string    `db:"event_id"`
+	EventName    string    `db:"event_name"`
+	TierName     string    `db:"tier_name"`
+	Status       string    `db:"status"`
+	HolderName   string    `db:"holder_name"`
+	HolderEmail  string    `db:"holder_email"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+// IntegrationCheckIn is an entry scan with its ticket holder, as seen by a polling trigger
+type IntegrationCheckIn struct {
+	ScanID       string    `db:"scan_id"`
+	TicketID     string    `db:"ticket_id"`
+	TicketNumber string    `db:"ticket_number"`
+	EventID      string    `db:"event_id"`
+	EventName    string    `db:"event_name"`
+	TierName     string    `db:"tier_name"`
+	Zone         *string   `db:"zone"`
+	HolderName   string    `db:"holder_name"`
+	HolderEmail  string    `db:"holder_email"`
+	ScannedAt    time.Time `db:"scanned_at"`
+}
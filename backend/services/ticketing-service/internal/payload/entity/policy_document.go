@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// Policy document types
+const (
+	PolicyTypeTerms  = "terms"  // Platform terms and conditions of the tenant
+	PolicyTypeRefund = "refund" // Refund policy of an event
+	PolicyTypeHealth = "health" // Health and safety policy of an event (e.g. COVID-19 rules)
+)
+
+// IsEventPolicyType checks if a policy type is published per event rather than platform wide
+func IsEventPolicyType(policyType string) bool {
+	return policyType == PolicyTypeRefund || policyType == PolicyTypeHealth
+}
+
+// PolicyDocument is one published version of a policy buyers accept at checkout
+// Versions are immutable; the newest version of a type is the current one
+type PolicyDocument struct {
+	ID          string    `db:"id"`
+	TenantID    string    `db:"tenant_id"`
+	EventID     *string   `db:"event_id"` // Nil for the platform terms
+	Type        string    `db:"type"`
+	Title       string    `db:"title"`
+	Content     string    `db:"content"`
+	VersionHash string    `db:"version_hash"`
+	PublishedBy string    `db:"published_by"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// PolicyVersionHash returns the version hash of a policy text, hex encoded SHA-256 of title and content
+func PolicyVersionHash(title, content string) string {
+	sum := sha256.Sum256([]byte(title + "\x00" + content))
+	return hex.EncodeToString(sum[:])
+}
+
+// PolicyAcceptance is a policy version a buyer accepted with an order
+type PolicyAcceptance struct {
+	OrderID          string    `db:"order_id"`
+	PolicyDocumentID string    `db:"policy_document_id"`
+	UserID           string    `db:"user_id"`
+	AcceptedAt       time.Time `db:"accepted_at"`
+
+	// Accepted version, read from the policy document
+	Type        string  `db:"type"`
+	Title       string  `db:"title"`
+	VersionHash string  `db:"version_hash"`
+	EventID     *string `db:"event_id"`
+}
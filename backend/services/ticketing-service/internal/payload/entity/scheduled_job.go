@@ -0,0 +1,33 @@
+package entity
+
+import "time"
+
+// ScheduledJob is a unit of work due at RunAt, run by the scheduled job worker
+type ScheduledJob struct {
+	ID          string     `db:"id"`
+	Type        string     `db:"type"`
+	ReferenceID string     `db:"reference_id"` // What the job is about, e.g. the order of a reservation reminder
+	RunAt       time.Time  `db:"run_at"`
+	Status      string     `db:"status"`   // pending, running, done, failed
+	Attempts    int        `db:"attempts"` // Claims so far, including the current one
+	LastError   *string    `db:"last_error"`
+	LockedUntil *time.Time `db:"locked_until"` // Lease of the worker running the job
+	CreatedAt   time.Time  `db:"created_at"`
+	UpdatedAt   time.Time  `db:"updated_at"`
+}
+
+// Scheduled job type constants
+const (
+	JobTypeReservationReminder = "reservation_reminder" // Payment reminder before a reservation expires
+	JobTypeInvitationEmails    = "invitation_emails"    // Claim link emails of an invitation import, by batch
+	JobTypeInvitationRelease   = "invitation_release"   // Releases unclaimed invitations of a batch at the claim deadline
+	JobTypeTicketReissue       = "ticket_reissue"       // Rotates the QR codes of a reissue batch and emails its orders
+)
+
+// Scheduled job status constants
+const (
+	JobStatusPending = "pending" // Waiting for RunAt, or for a retry
+	JobStatusRunning = "running" // Claimed by a worker until LockedUntil
+	JobStatusDone    = "done"
+	JobStatusFailed  = "failed" // Gave up after the last attempt
+)
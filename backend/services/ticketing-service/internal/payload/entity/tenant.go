@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/pricing"
+)
+
+// Tenant represents the branding and fee configuration of a white-label sub-platform
+type Tenant struct {
+	ID                 string      `db:"id"`
+	Name               string      `db:"name"`
+	LogoURL            *string     `db:"logo_url"`
+	PrimaryColor       *string     `db:"primary_color"`
+	SupportEmail       *string     `db:"support_email"`
+	EmailFromName      *string     `db:"email_from_name"`
+	EmailFromAddress   *string     `db:"email_from_address"`
+	PlatformFeePercent int         `db:"platform_fee_percent"`
+	ServiceFee         money.Money `db:"service_fee"`
+}
+
+// PricingPolicy returns the fees the tenant charges on its orders
+func (t *Tenant) PricingPolicy() pricing.Policy {
+	return pricing.Policy{
+		PlatformFeePercent: int64(t.PlatformFeePercent),
+		ServiceFee:         t.ServiceFee,
+	}
+}
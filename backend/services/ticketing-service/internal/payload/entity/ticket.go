@@ -13,8 +13,12 @@ type Ticket struct {
 	TicketNumber string     `db:"ticket_number"` // Unique ticket number (for display)
 	QRCode       string     `db:"qr_code"` // Base64 encoded QR code
 	QRData       string     `db:"qr_data"` // Data encoded in QR (for validation)
-	Status       string     `db:"status"` // valid, used, cancelled, expired
+	QRSecret     *string    `db:"qr_secret"` // Key of the rotating QR codes, set once the buyer's app first fetches one
+	Status       string     `db:"status"` // valid, used, cancelled, expired, void
 	UsedAt       *time.Time `db:"validated_at"`
+	VoidReason   *string    `db:"void_reason"` // Why an organizer or admin voided the ticket
+	VoidedBy     *string    `db:"voided_by"`
+	VoidedAt     *time.Time `db:"voided_at"`
 	CreatedAt    time.Time  `db:"created_at"`
 	UpdatedAt    time.Time  `db:"updated_at"`
 }
@@ -25,6 +29,7 @@ const (
 	TicketStatusUsed      = "used"      // Ticket has been scanned and used
 	TicketStatusCancelled = "cancelled" // Ticket cancelled (refund)
 	TicketStatusExpired   = "expired"   // Event has passed
+	TicketStatusVoid      = "void"      // Revoked by an organizer or admin
 )
 
 // CanBeUsed checks if ticket can be used (scanned at event)
@@ -36,3 +41,8 @@ func (t *Ticket) CanBeUsed() bool {
 func (t *Ticket) IsUsed() bool {
 	return t.Status == TicketStatusUsed
 }
+
+// IsVoid checks if ticket has been revoked by an organizer or admin
+func (t *Ticket) IsVoid() bool {
+	return t.Status == TicketStatusVoid
+}
@@ -0,0 +1,39 @@
+package entity
+
+import "time"
+
+// TicketReissue is a bulk replacement of the QR codes of an event's unused tickets
+type TicketReissue struct {
+	ID          string    `db:"id"`
+	EventID     string    `db:"event_id"`
+	Reason      string    `db:"reason"`
+	RequestedBy string    `db:"requested_by"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// TicketReissueItem is one ticket of a reissue
+// Items of the same order share a batch, whose scheduled job rotates their codes and emails the order
+type TicketReissueItem struct {
+	TicketID string `db:"ticket_id"`
+	OrderID  string `db:"order_id"`
+	BatchID  string `db:"batch_id"`
+}
+
+// Ticket reissue item status constants
+const (
+	TicketReissuePending  = "pending"  // Code not rotated yet
+	TicketReissueReissued = "reissued" // Code rotated, emailed once emailed_at is set
+	TicketReissueSkipped  = "skipped"  // Ticket was used or voided before its code was rotated
+)
+
+// TicketReissueProgress counts the tickets and batches of a reissue by state
+type TicketReissueProgress struct {
+	Tickets       int `db:"tickets"`
+	Pending       int `db:"pending"`
+	Reissued      int `db:"reissued"`
+	Skipped       int `db:"skipped"`
+	Emailed       int `db:"emailed"` // Reissued tickets whose order was emailed
+	Batches       int `db:"batches"`
+	ActiveBatches int `db:"active_batches"` // Batches whose job is still pending or running
+	FailedBatches int `db:"failed_batches"` // Batches whose job gave up, see their last error
+}
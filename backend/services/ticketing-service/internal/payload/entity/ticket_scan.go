@@ -0,0 +1,24 @@
+package entity
+
+import "time"
+
+// TicketScan represents one scan of a ticket at the event entrance
+type TicketScan struct {
+	ID        string    `db:"id"`
+	TicketID  string    `db:"ticket_id"`
+	EventID   string    `db:"event_id"`
+	Direction string    `db:"direction"` // entry, exit
+	Zone      *string   `db:"zone"`      // Gate zone code, nil when the gate has no zone
+	ScannedAt time.Time `db:"scanned_at"`
+}
+
+// Scan direction constants
+const (
+	ScanDirectionEntry = "entry"
+	ScanDirectionExit  = "exit"
+)
+
+// IsEntry checks if the scan let the holder in
+func (s *TicketScan) IsEntry() bool {
+	return s.Direction == ScanDirectionEntry
+}
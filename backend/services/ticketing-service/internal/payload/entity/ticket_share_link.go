@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+// TicketShareLink is a revocable, expiring public link to a single ticket
+type TicketShareLink struct {
+	ID        string     `db:"id"`
+	TicketID  string     `db:"ticket_id"`
+	CreatedBy string     `db:"created_by"` // Ticket owner who shared it
+	ExpiresAt time.Time  `db:"expires_at"`
+	RevokedAt *time.Time `db:"revoked_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+// IsActive checks if the link can still be opened
+func (l *TicketShareLink) IsActive(now time.Time) bool {
+	return l.RevokedAt == nil && now.Before(l.ExpiresAt)
+}
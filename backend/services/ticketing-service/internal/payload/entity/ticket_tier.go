@@ -1,25 +1,51 @@
 package entity
 
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
 // TicketTier represents ticket tier data (read-only from event service)
 type TicketTier struct {
-	ID          string  `db:"id"`
-	EventID     string  `db:"event_id"`
-	Name        string  `db:"name"`
-	Price       float64 `db:"price"`
-	Quota       int     `db:"quota"`
-	SoldCount   int     `db:"sold_count"`
-	MaxPerOrder int     `db:"max_per_order"`
+	ID          string      `db:"id"`
+	EventID     string      `db:"event_id"`
+	Name        string      `db:"name"`
+	Price       money.Money `db:"price"`
+	Quota       int         `db:"quota"`
+	SoldCount   int         `db:"sold_count"`
+	MaxPerOrder int         `db:"max_per_order"`
+	ArchivedAt  *time.Time  `db:"archived_at"` // Archived tiers are hidden from sale
+
+	// Seats held back within Quota for accessible seating requests, counted in SoldCount too
+	AccessibleQuota     int `db:"accessible_quota"`
+	AccessibleSoldCount int `db:"accessible_sold_count"`
 }
 
-// GetAvailableQuota returns remaining ticket quota
+// GetAvailableQuota returns remaining ticket quota on general sale
+// Accessible seats are held back, see GetAvailableAccessibleQuota
 func (tt *TicketTier) GetAvailableQuota() int {
-	remaining := tt.Quota - tt.SoldCount
+	remaining := (tt.Quota - tt.AccessibleQuota) - (tt.SoldCount - tt.AccessibleSoldCount)
 	if remaining < 0 {
 		return 0
 	}
 	return remaining
 }
 
+// GetAvailableAccessibleQuota returns remaining accessible seats
+func (tt *TicketTier) GetAvailableAccessibleQuota() int {
+	remaining := tt.AccessibleQuota - tt.AccessibleSoldCount
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// IsArchived checks if tier was archived by the organizer
+func (tt *TicketTier) IsArchived() bool {
+	return tt.ArchivedAt != nil
+}
+
 // IsSoldOut checks if all tickets are sold
 func (tt *TicketTier) IsSoldOut() bool {
 	return tt.SoldCount >= tt.Quota
@@ -0,0 +1,38 @@
+package entity
+
+import "time"
+
+// WaitlistEntry is a buyer waiting for seats of a sold-out ticket tier
+// While offered it holds Quantity seats of the tier; the buyer's order holds them afterwards
+type WaitlistEntry struct {
+	ID             string     `db:"id"`
+	EventID        string     `db:"event_id"`
+	TicketTierID   string     `db:"ticket_tier_id"`
+	UserID         string     `db:"user_id"`
+	Quantity       int        `db:"quantity"`
+	Status         string     `db:"status"` // waiting, offered, purchased, expired, cancelled
+	OfferedAt      *time.Time `db:"offered_at"`
+	OfferExpiresAt *time.Time `db:"offer_expires_at"`
+	OrderID        *string    `db:"order_id"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+}
+
+// Waitlist entry status constants
+const (
+	WaitlistStatusWaiting   = "waiting"   // In line for seats
+	WaitlistStatusOffered   = "offered"   // Seats held until the offer expires
+	WaitlistStatusPurchased = "purchased" // Offered seats ordered by the buyer
+	WaitlistStatusExpired   = "expired"   // Offer not used in time, seats offered to the next entry
+	WaitlistStatusCancelled = "cancelled" // Left by the buyer, or the tier went off sale
+)
+
+// IsOpen checks if the entry is still waiting for or holding seats
+func (w *WaitlistEntry) IsOpen() bool {
+	return w.Status == WaitlistStatusWaiting || w.Status == WaitlistStatusOffered
+}
+
+// HasOffer checks if the entry holds seats the buyer can still order at now
+func (w *WaitlistEntry) HasOffer(now time.Time) bool {
+	return w.Status == WaitlistStatusOffered && w.OfferExpiresAt != nil && now.Before(*w.OfferExpiresAt)
+}
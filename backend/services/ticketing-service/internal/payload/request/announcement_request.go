@@ -0,0 +1,9 @@
+package request
+
+// SendAnnouncementRequest represents an organizer's announcement emailed to an event's ticket holders
+type SendAnnouncementRequest struct {
+	EventID string `json:"event_id" binding:"required,uuid"`
+	Subject string `json:"subject" binding:"required,max=150"`
+	Message string `json:"message" binding:"required,max=5000"` // Plain text, line breaks are kept
+	Urgent  bool   `json:"urgent"`                              // Email right away, also to holders who get a daily or weekly digest
+}
@@ -0,0 +1,22 @@
+package request
+
+// MaxBadgesPerPDF caps one batch badge PDF; larger events are printed in several batches
+const MaxBadgesPerPDF = 200
+
+// BadgePDFRequest represents a batch of badges to print for an event
+// Without ticket IDs every valid or used ticket of the event is printed
+type BadgePDFRequest struct {
+	EventID   string   `json:"event_id" binding:"required,uuid"`
+	TicketIDs []string `json:"ticket_ids" binding:"omitempty,max=200,dive,uuid"`
+}
+
+// RegisterKioskRequest represents an organizer registering a check-in kiosk for an event
+type RegisterKioskRequest struct {
+	EventID string `json:"event_id" binding:"required,uuid"`
+	Name    string `json:"name" binding:"required,max=100"`
+}
+
+// KioskCheckInRequest represents an attendee scanning their ticket at a kiosk
+type KioskCheckInRequest struct {
+	QRData string `json:"qr_data" binding:"required"`
+}
@@ -0,0 +1,21 @@
+package request
+
+// Live check-in window (?minutes= on the live view): the last 15 minutes by default, at most an hour
+const (
+	DefaultCheckinLiveMinutes = 15
+	MaxCheckinLiveMinutes     = 60
+)
+
+// ScannerHeartbeatRequest represents a gate scanner reporting that it is online
+// Scanner apps send it every 30 seconds while they are open
+type ScannerHeartbeatRequest struct {
+	EventID      string `json:"event_id" binding:"required,uuid"`
+	ScannerID    string `json:"scanner_id" binding:"required,max=64"` // Device ID chosen by the scanner app, stable across restarts
+	Zone         string `json:"zone" binding:"omitempty,max=30"`      // Zone code of the gate; empty when the gate has no zone
+	PendingScans int    `json:"pending_scans" binding:"min=0"`        // Scans taken offline and not uploaded yet
+}
+
+// CheckinLiveRequest represents the query parameters of an event's live check-in view
+type CheckinLiveRequest struct {
+	Minutes int `form:"minutes" binding:"omitempty,min=1,max=60"` // Defaults to DefaultCheckinLiveMinutes
+}
@@ -0,0 +1,27 @@
+package request
+
+import "time"
+
+// Page size of polling triggers; Zapier only reads the first page, newest first
+const (
+	DefaultIntegrationLimit = 50
+	MaxIntegrationLimit     = 100
+)
+
+// CreateAPIKeyRequest represents an organizer creating a partner API key
+type CreateAPIKeyRequest struct {
+	Name string `json:"name" binding:"required,max=100"` // Shown in the key list, e.g. "Zapier"
+}
+
+// IntegrationPollRequest represents the query parameters of a polling trigger
+type IntegrationPollRequest struct {
+	UpdatedSince *time.Time `form:"updated_since" time_format:"2006-01-02T15:04:05Z07:00"` // Records changed at or after
+	EventID      string     `form:"event_id" binding:"omitempty,uuid"`
+	Status       string     `form:"status" binding:"omitempty,max=20"` // Order status (orders) or ticket status (attendees)
+	Limit        int        `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// IntegrationCheckInRequest represents a check-in action with the scanned QR data of a ticket
+type IntegrationCheckInRequest struct {
+	QRData string `json:"qr_data" binding:"required"`
+}
@@ -0,0 +1,17 @@
+package request
+
+import "time"
+
+// Inventory history window: 24 hours by default, at most MaxInventoryBuckets buckets
+// (a day of minutes, two months of hours)
+const (
+	DefaultInventoryWindow = 24 * time.Hour
+	MaxInventoryBuckets    = 1440
+)
+
+// InventoryHistoryRequest represents the query parameters of a ticket tier's inventory history
+type InventoryHistoryRequest struct {
+	From     *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"` // Defaults to 24 hours before to
+	To       *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`   // Defaults to now
+	Interval string     `form:"interval" binding:"omitempty,oneof=minute hour day"`
+}
@@ -0,0 +1,38 @@
+package request
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// MaxImportInvitations caps the invitees of one import file
+const MaxImportInvitations = 1000
+
+// InvitationCSVColumns is the header of invitee CSV files, one row per invitee
+// tier is a ticket tier name (case-insensitive) or ID; quantity defaults to 1 and price to 0 (complimentary)
+var InvitationCSVColumns = []string{"email", "name", "tier", "quantity", "price"}
+
+// ImportInvitationsRequest represents import invitations query parameters
+type ImportInvitationsRequest struct {
+	EventID       string    `form:"event_id" binding:"required,uuid"`
+	ClaimDeadline time.Time `form:"claim_deadline" binding:"required" time_format:"2006-01-02T15:04:05Z07:00"` // Unclaimed tickets are released afterwards
+	DryRun        bool      `form:"dry_run"`
+}
+
+// InviteGuestRequest represents one invitee, e.g. from a partner integration action
+// Fields follow the columns of an import file
+type InviteGuestRequest struct {
+	EventID       string      `json:"event_id" binding:"required,uuid"`
+	Email         string      `json:"email" binding:"required,email,max=255"`
+	Name          string      `json:"name" binding:"max=255"`
+	Tier          string      `json:"tier" binding:"required"`            // Ticket tier name (case-insensitive) or ID
+	Quantity      int         `json:"quantity" binding:"omitempty,min=1"` // Default 1
+	Price         money.Money `json:"price" binding:"min=0"`              // Unit price the invitee pays, default 0 (complimentary)
+	ClaimDeadline time.Time   `json:"claim_deadline" binding:"required"`
+}
+
+// ClaimInvitationRequest represents a request to claim an invitation with its emailed token
+type ClaimInvitationRequest struct {
+	Token string `json:"token" binding:"required"`
+}
@@ -0,0 +1,17 @@
+package request
+
+// CreateOrderIssueRequest represents a problem reported by a buyer on their order
+type CreateOrderIssueRequest struct {
+	Category    string `json:"category" binding:"required,oneof=email_not_received wrong_event_details payment_problem ticket_problem other"`
+	Description string `json:"description" binding:"required,max=2000"`
+}
+
+// ReplyOrderIssueRequest represents a support reply to an order issue
+type ReplyOrderIssueRequest struct {
+	Message string `json:"message" binding:"required,max=2000"`
+}
+
+// ResolveOrderIssueRequest represents closing an order issue
+type ResolveOrderIssueRequest struct {
+	Resolution string `json:"resolution" binding:"required,max=2000"`
+}
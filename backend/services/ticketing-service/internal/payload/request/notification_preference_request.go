@@ -0,0 +1,7 @@
+package request
+
+// UpdateNotificationPreferenceRequest changes how often the user gets non-urgent notifications
+// E-tickets, payment reminders and invitations are always emailed right away
+type UpdateNotificationPreferenceRequest struct {
+	DigestFrequency string `json:"digest_frequency" binding:"required,oneof=immediate daily weekly"`
+}
@@ -1,12 +1,102 @@
 package request
 
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+)
+
+// Order metadata limits
+const (
+	MaxMetadataKeys        = 20
+	MaxMetadataKeyLength   = 40
+	MaxMetadataValueLength = 500
+	MaxMetadataBytes       = 4096 // Encoded JSON object
+)
+
+// metadataKeyPattern keeps keys usable as admin search parameters
+var metadataKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// ErrInvalidMetadata is returned for order metadata exceeding the limits
+var ErrInvalidMetadata = errors.New("invalid order metadata")
+
+// ErrInvalidAccommodations is returned for accommodations that don't match the ordered tickets
+var ErrInvalidAccommodations = errors.New("invalid accommodations")
+
 // CreateOrderRequest represents create order from cart or direct purchase
 type CreateOrderRequest struct {
-	EventID       string      `json:"event_id" binding:"required,uuid"`
-	Items         []OrderItem `json:"items" binding:"required,min=1,dive"`
-	Email         string      `json:"email,omitempty"`          // Optional - will use user profile if not provided
-	CustomerName  string      `json:"customer_name,omitempty"`  // Optional - will use user profile if not provided
-	PaymentMethod string      `json:"payment_method,omitempty"` // Will be set later before payment
+	EventID       string            `json:"event_id" binding:"required,uuid"`
+	Items         []OrderItem       `json:"items" binding:"required,min=1,dive"`
+	Email         string            `json:"email,omitempty"`          // Optional - will use user profile if not provided
+	CustomerName  string            `json:"customer_name,omitempty"`  // Optional - will use user profile if not provided
+	PaymentMethod string            `json:"payment_method,omitempty"` // Will be set later before payment
+	Metadata      map[string]string `json:"metadata,omitempty"`       // Partner references, returned on reads and searchable by support
+	Insurance     bool              `json:"insurance,omitempty"`      // Add refund-protection insurance, premium charged with the order
+	// Accessibility needs, one entry per ticket; wheelchair and companion seats come from the tier's accessible seating
+	Accommodations []AccommodationRequest `json:"accommodations,omitempty" binding:"omitempty,max=50,dive"`
+	// Version hashes of the policies the buyer accepted (GET /public/policies?event_id=), required for each current policy
+	AcceptedPolicies []string `json:"accepted_policies,omitempty" binding:"omitempty,max=20,dive,len=64,hexadecimal"`
+}
+
+// Validate checks metadata against the order metadata limits and
+// accommodations against the ordered tickets
+func (r *CreateOrderRequest) Validate() error {
+	if err := r.validateAccommodations(); err != nil {
+		return err
+	}
+
+	if len(r.Metadata) > MaxMetadataKeys {
+		return fmt.Errorf("%w: at most %d keys allowed", ErrInvalidMetadata, MaxMetadataKeys)
+	}
+
+	for key, value := range r.Metadata {
+		if len(key) > MaxMetadataKeyLength || !metadataKeyPattern.MatchString(key) {
+			return fmt.Errorf("%w: key %q must be 1-%d letters, digits, '_', '.' or '-'", ErrInvalidMetadata, key, MaxMetadataKeyLength)
+		}
+		if len(value) > MaxMetadataValueLength {
+			return fmt.Errorf("%w: value of %q exceeds %d bytes", ErrInvalidMetadata, key, MaxMetadataValueLength)
+		}
+	}
+
+	encoded, err := json.Marshal(r.Metadata)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidMetadata, err)
+	}
+	if len(encoded) > MaxMetadataBytes {
+		return fmt.Errorf("%w: exceeds %d bytes", ErrInvalidMetadata, MaxMetadataBytes)
+	}
+
+	return nil
+}
+
+// validateAccommodations checks every accommodation is for an ordered tier,
+// with at most one accommodation per ticket
+func (r *CreateOrderRequest) validateAccommodations() error {
+	if len(r.Accommodations) == 0 {
+		return nil
+	}
+
+	quantities := make(map[string]int, len(r.Items))
+	for _, item := range r.Items {
+		quantities[item.TicketTierID] += item.Quantity
+	}
+
+	requested := make(map[string]int, len(quantities))
+	for _, accommodation := range r.Accommodations {
+		quantity, ok := quantities[accommodation.TicketTierID]
+		if !ok {
+			return fmt.Errorf("%w: ticket tier %s is not in the order", ErrInvalidAccommodations, accommodation.TicketTierID)
+		}
+		requested[accommodation.TicketTierID]++
+		if requested[accommodation.TicketTierID] > quantity {
+			return fmt.Errorf("%w: more accommodations than tickets of tier %s", ErrInvalidAccommodations, accommodation.TicketTierID)
+		}
+	}
+
+	return nil
 }
 
 // OrderItem represents an item to order
@@ -15,12 +105,35 @@ type OrderItem struct {
 	Quantity     int    `json:"quantity" binding:"required,min=1"`
 }
 
+// AccommodationRequest represents an accessibility need for one ticket of a tier in the order
+type AccommodationRequest struct {
+	TicketTierID string `json:"ticket_tier_id" binding:"required,uuid"`
+	Type         string `json:"type" binding:"required,oneof=wheelchair companion_seat hearing_assistance visual_assistance step_free_access other"`
+	Notes        string `json:"notes,omitempty" binding:"max=500"`
+}
+
+// QuoteOrderRequest represents an order to price without reserving tickets
+type QuoteOrderRequest struct {
+	EventID   string      `json:"event_id" binding:"required,uuid"`
+	Items     []OrderItem `json:"items" binding:"required,min=1,dive"`
+	Insurance bool        `json:"insurance,omitempty"`
+}
+
 // ConfirmOrderRequest represents payment confirmation (from webhook)
 type ConfirmOrderRequest struct {
-	OrderID       string  `json:"order_id"` // Set from URL path parameter, not required in body
-	PaymentID     string  `json:"payment_id" binding:"required"`
-	PaymentMethod string  `json:"payment_method" binding:"required"`
-	Amount        float64 `json:"amount" binding:"required,min=0"`
+	OrderID       string      `json:"order_id"` // Set from URL path parameter, not required in body
+	PaymentID     string      `json:"payment_id" binding:"required"`
+	PaymentMethod string      `json:"payment_method" binding:"required"`
+	Amount        money.Money `json:"amount" binding:"required,min=0"`
+	Currency      string      `json:"currency" binding:"omitempty,len=3"` // Optional for legacy callers, assumed to be the platform currency
+	// Paid before the invoice expired; only such payments reinstate an order expired within the grace period
+	InvoicePending bool `json:"invoice_pending"`
+}
+
+// MarkPaidRequest represents finance confirming a bank transfer that never produced a webhook
+type MarkPaidRequest struct {
+	ReferenceNumber string `json:"reference_number" binding:"required,max=100"` // Bank transfer reference
+	Reason          string `json:"reason" binding:"required,max=2000"`
 }
 
 // CancelOrderRequest represents order cancellation
@@ -30,5 +143,28 @@ type CancelOrderRequest struct {
 
 // ValidateTicketRequest represents ticket validation at event entrance
 type ValidateTicketRequest struct {
-	QRData string `json:"qr_data" binding:"required"`
+	QRData    string `json:"qr_data" binding:"required"`
+	Direction string `json:"direction" binding:"omitempty,oneof=entry exit"` // Defaults to entry; exit is only used by re-entry events
+	Zone      string `json:"zone" binding:"omitempty,max=30"`                // Zone code of the scanning gate; empty when the gate has no zone
+}
+
+// Ticket validation modes (?mode= on the validate endpoint)
+const (
+	ValidateModeScan   = "scan"   // Check the ticket and mark it as used (default)
+	ValidateModeVerify = "verify" // Check the ticket only, e.g. re-entry or support desk
+)
+
+// VoidTicketRequest represents an organizer or admin revoking a ticket
+type VoidTicketRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+// ReissueTicketsRequest represents an organizer or admin replacing the QR codes of an event's unused tickets
+type ReissueTicketsRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
+}
+
+// InsuranceClaimRequest represents a buyer claiming the refund-protection insurance of an order
+type InsuranceClaimRequest struct {
+	Reason string `json:"reason" binding:"required,max=500"`
 }
@@ -0,0 +1,10 @@
+package request
+
+// PublishPolicyRequest represents a new version of a purchase policy
+// Without an event ID it publishes the tenant's platform terms (admins only)
+type PublishPolicyRequest struct {
+	EventID string `json:"event_id" binding:"omitempty,uuid"`
+	Type    string `json:"type" binding:"required,oneof=terms refund health"`
+	Title   string `json:"title" binding:"required,max=200"`
+	Content string `json:"content" binding:"required,max=100000"`
+}
@@ -0,0 +1,6 @@
+package request
+
+// JoinWaitlistRequest represents a request to wait for seats of a sold-out ticket tier
+type JoinWaitlistRequest struct {
+	Quantity int `json:"quantity" binding:"omitempty,min=1"` // Seats wanted, default 1, at most the tier's maximum per order
+}
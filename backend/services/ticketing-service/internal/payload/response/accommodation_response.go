@@ -0,0 +1,93 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// AccommodationResponse represents an accessibility need flagged for one ticket
+type AccommodationResponse struct {
+	ID             string    `json:"id"`
+	TicketTierID   string    `json:"ticket_tier_id"`
+	TicketID       *string   `json:"ticket_id,omitempty"` // Set once the order's tickets are issued
+	Type           string    `json:"type"`
+	Notes          *string   `json:"notes,omitempty"`
+	AccessibleSeat bool      `json:"accessible_seat"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// AttendeeResponse represents a ticket holder in an event's attendee list
+type AttendeeResponse struct {
+	TicketID       string                  `json:"ticket_id"`
+	TicketNumber   string                  `json:"ticket_number"`
+	OrderID        string                  `json:"order_id"`
+	TicketTierID   string                  `json:"ticket_tier_id"`
+	TierName       string                  `json:"tier_name"`
+	Status         string                  `json:"status"`
+	UsedAt         *time.Time              `json:"used_at,omitempty"`
+	HolderName     string                  `json:"holder_name"`
+	HolderEmail    string                  `json:"holder_email"`
+	Accommodations []AccommodationResponse `json:"accommodations"`
+}
+
+// AccommodationSummaryResponse represents the accommodation requests and accessible seating of an event
+type AccommodationSummaryResponse struct {
+	EventID           string                       `json:"event_id"`
+	TotalRequests     int                          `json:"total_requests"`
+	ByType            []AccommodationCountResponse `json:"by_type"`
+	AccessibleSeating []AccessibleSeatingResponse  `json:"accessible_seating"`
+}
+
+// AccommodationCountResponse represents the number of tickets with an accommodation type
+type AccommodationCountResponse struct {
+	Type  string `json:"type"`
+	Count int    `json:"count"`
+}
+
+// AccessibleSeatingResponse represents the accessible seating of a ticket tier
+type AccessibleSeatingResponse struct {
+	TicketTierID        string `json:"ticket_tier_id"`
+	TierName            string `json:"tier_name"`
+	AccessibleQuota     int    `json:"accessible_quota"`
+	AccessibleSoldCount int    `json:"accessible_sold_count"` // Includes seats of unpaid reservations
+	Available           int    `json:"available_count"`
+}
+
+// ToAccommodationResponse converts accommodation entity to response
+func ToAccommodationResponse(accommodation *entity.Accommodation) *AccommodationResponse {
+	return &AccommodationResponse{
+		ID:             accommodation.ID,
+		TicketTierID:   accommodation.TicketTierID,
+		TicketID:       accommodation.TicketID,
+		Type:           accommodation.Type,
+		Notes:          accommodation.Notes,
+		AccessibleSeat: accommodation.AccessibleSeat,
+		CreatedAt:      accommodation.CreatedAt,
+	}
+}
+
+// ToAccommodationResponses converts accommodation entities to responses
+func ToAccommodationResponses(accommodations []entity.Accommodation) []AccommodationResponse {
+	responses := make([]AccommodationResponse, len(accommodations))
+	for i := range accommodations {
+		responses[i] = *ToAccommodationResponse(&accommodations[i])
+	}
+	return responses
+}
+
+// ToAttendeeResponse converts attendee entity and its ticket's accommodations to response
+func ToAttendeeResponse(attendee *entity.Attendee, accommodations []entity.Accommodation) *AttendeeResponse {
+	return &AttendeeResponse{
+		TicketID:       attendee.TicketID,
+		TicketNumber:   attendee.TicketNumber,
+		OrderID:        attendee.OrderID,
+		TicketTierID:   attendee.TicketTierID,
+		TierName:       attendee.TierName,
+		Status:         attendee.Status,
+		UsedAt:         attendee.UsedAt,
+		HolderName:     attendee.HolderName,
+		HolderEmail:    attendee.HolderEmail,
+		Accommodations: ToAccommodationResponses(accommodations),
+	}
+}
@@ -0,0 +1,14 @@
+package response
+
+// AnnouncementResponse represents the outcome of an announcement
+// Failed emails are not counted towards the organizer's monthly quota, digested ones are
+type AnnouncementResponse struct {
+	EventID    string `json:"event_id"`
+	Plan       string `json:"plan"`
+	Recipients int    `json:"recipients"`
+	Sent       int    `json:"sent"`
+	Digested   int    `json:"digested"` // Holders who get the announcement in their next digest
+	Failed     int    `json:"failed"`
+	QuotaUsed  int    `json:"quota_used"`  // Announcement emails sent this month (UTC)
+	QuotaLimit int    `json:"quota_limit"` // 0 = unlimited
+}
@@ -0,0 +1,57 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// BadgeResponse represents the badge-ready data of one ticket
+// Company comes from the order's "company" metadata and is empty when not collected
+type BadgeResponse struct {
+	TicketID     string                `json:"ticket_id"`
+	TicketNumber string                `json:"ticket_number"`
+	Name         string                `json:"name"`
+	Company      string                `json:"company"`
+	TierName     string                `json:"tier_name"`
+	QRCode       string                `json:"qr_code"` // Base64 encoded
+	Status       string                `json:"status"`
+	Event        *EventSummaryResponse `json:"event"`
+}
+
+// KioskResponse represents a registered check-in kiosk
+type KioskResponse struct {
+	ID         string     `json:"id"`
+	EventID    string     `json:"event_id"`
+	Name       string     `json:"name"`
+	Active     bool       `json:"active"`
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// ToKioskResponse converts kiosk entity to response
+func ToKioskResponse(kiosk *entity.CheckinKiosk) *KioskResponse {
+	return &KioskResponse{
+		ID:         kiosk.ID,
+		EventID:    kiosk.EventID,
+		Name:       kiosk.Name,
+		Active:     kiosk.IsActive(),
+		LastSeenAt: kiosk.LastSeenAt,
+		RevokedAt:  kiosk.RevokedAt,
+		CreatedAt:  kiosk.CreatedAt,
+	}
+}
+
+// KioskRegistrationResponse represents a newly registered kiosk
+// The device token is only returned here; the kiosk sends it as X-Kiosk-Token
+type KioskRegistrationResponse struct {
+	KioskResponse
+	Token string `json:"token"`
+}
+
+// KioskCheckInResponse represents an accepted kiosk check-in with the badge to print
+type KioskCheckInResponse struct {
+	Ticket TicketResponse `json:"ticket"`
+	Badge  BadgeResponse  `json:"badge"`
+}
@@ -0,0 +1,44 @@
+package response
+
+import "time"
+
+// CheckinLiveResponse represents the entrance throughput of an event over the last minutes
+type CheckinLiveResponse struct {
+	EventID     string                   `json:"event_id"`
+	Minutes     int                      `json:"minutes"`
+	GeneratedAt time.Time                `json:"generated_at"`
+	Entries     int                      `json:"entries"` // Accepted entry scans in the window, all gates
+	Exits       int                      `json:"exits"`
+	Rejected    int                      `json:"rejected"`
+	Gates       []GateThroughputResponse `json:"gates"`
+	Scanners    []ScannerStatusResponse  `json:"scanners"`
+}
+
+// GateThroughputResponse represents the scans of one gate, identified by its zone code
+type GateThroughputResponse struct {
+	Zone           string                `json:"zone"` // Empty for gates without a zone
+	Entries        int                   `json:"entries"`
+	Exits          int                   `json:"exits"`
+	Rejected       int                   `json:"rejected"`
+	ScansPerMinute float64               `json:"scans_per_minute"` // Accepted scans per minute over the last 5 full minutes
+	ScannersOnline int                   `json:"scanners_online"`
+	PendingScans   int                   `json:"pending_scans"` // Offline scans of the gate's online scanners not uploaded yet
+	PerMinute      []MinuteScansResponse `json:"per_minute"`    // Oldest first, the last minute is still running
+}
+
+// MinuteScansResponse represents the scans of a gate in one minute
+type MinuteScansResponse struct {
+	Minute   time.Time `json:"minute"`
+	Entries  int       `json:"entries"`
+	Exits    int       `json:"exits"`
+	Rejected int       `json:"rejected"`
+}
+
+// ScannerStatusResponse represents the last heartbeat of a gate scanner
+type ScannerStatusResponse struct {
+	ScannerID    string    `json:"scanner_id"`
+	Zone         string    `json:"zone"`
+	PendingScans int       `json:"pending_scans"`
+	LastSeenAt   time.Time `json:"last_seen_at"`
+	Online       bool      `json:"online"` // Heartbeat received in the last 90 seconds
+}
@@ -0,0 +1,13 @@
+package response
+
+import "time"
+
+// DynamicQRResponse represents the current rotating QR code of a ticket
+// The buyer's app shows it at the gate and fetches a new one before ExpiresAt
+type DynamicQRResponse struct {
+	TicketID       string    `json:"ticket_id"`
+	QRData         string    `json:"qr_data"`
+	QRCode         string    `json:"qr_code"` // Base64 encoded
+	ExpiresAt      time.Time `json:"expires_at"`
+	RefreshSeconds int       `json:"refresh_seconds"` // Rotation interval
+}
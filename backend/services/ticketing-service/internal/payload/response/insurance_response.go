@@ -0,0 +1,40 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// InsurancePolicyResponse represents the refund-protection insurance of an order
+type InsurancePolicyResponse struct {
+	OrderID      string      `json:"order_id"`
+	Provider     string      `json:"provider"`
+	PolicyNumber *string     `json:"policy_number,omitempty"`
+	Premium      money.Money `json:"premium"`
+	Coverage     money.Money `json:"coverage"`
+	Currency     string      `json:"currency"`
+	Status       string      `json:"status"`
+	ClaimID      *string     `json:"claim_id,omitempty"`
+	IssuedAt     *time.Time  `json:"issued_at,omitempty"`
+	ClaimedAt    *time.Time  `json:"claimed_at,omitempty"`
+	CreatedAt    time.Time   `json:"created_at"`
+}
+
+// ToInsurancePolicyResponse converts insurance policy entity to response
+func ToInsurancePolicyResponse(policy *entity.InsurancePolicy) *InsurancePolicyResponse {
+	return &InsurancePolicyResponse{
+		OrderID:      policy.OrderID,
+		Provider:     policy.Provider,
+		PolicyNumber: policy.PolicyNumber,
+		Premium:      policy.Premium,
+		Coverage:     policy.Coverage,
+		Currency:     policy.Currency,
+		Status:       policy.Status,
+		ClaimID:      policy.ClaimID,
+		IssuedAt:     policy.IssuedAt,
+		ClaimedAt:    policy.ClaimedAt,
+		CreatedAt:    policy.CreatedAt,
+	}
+}
@@ -0,0 +1,143 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// APIKeyResponse represents a partner API key
+type APIKeyResponse struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Active     bool       `json:"active"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// ToAPIKeyResponse converts partner API key entity to response
+func ToAPIKeyResponse(key *entity.PartnerAPIKey) *APIKeyResponse {
+	return &APIKeyResponse{
+		ID:         key.ID,
+		Name:       key.Name,
+		Active:     key.IsActive(),
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+		CreatedAt:  key.CreatedAt,
+	}
+}
+
+// APIKeyCreatedResponse represents a newly created partner API key
+// The key is only returned here; partners send it as X-API-Key
+type APIKeyCreatedResponse struct {
+	APIKeyResponse
+	Key string `json:"key"`
+}
+
+// The integration responses below are flat objects with an id, as Zapier and Make expect
+// from triggers (deduplicated by id) and actions
+
+// IntegrationAccountResponse identifies the organizer behind a partner API key (connection test and label)
+type IntegrationAccountResponse struct {
+	ID             string `json:"id"` // Key ID
+	KeyName        string `json:"key_name"`
+	OrganizerID    string `json:"organizer_id"`
+	OrganizerName  string `json:"organizer_name"`
+	OrganizerEmail string `json:"organizer_email"`
+}
+
+// IntegrationOrderResponse represents an order in the orders trigger
+type IntegrationOrderResponse struct {
+	ID            string      `json:"id"`
+	EventID       string      `json:"event_id"`
+	EventName     string      `json:"event_name"`
+	Status        string      `json:"status"`
+	TotalAmount   money.Money `json:"total_amount"`
+	GrandTotal    money.Money `json:"grand_total"`
+	PaymentMethod *string     `json:"payment_method,omitempty"`
+	Tickets       int         `json:"tickets"`
+	BuyerName     string      `json:"buyer_name"`
+	BuyerEmail    string      `json:"buyer_email"`
+	CreatedAt     time.Time   `json:"created_at"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+}
+
+// ToIntegrationOrderResponse converts integration order entity to response
+func ToIntegrationOrderResponse(order *entity.IntegrationOrder) IntegrationOrderResponse {
+	return IntegrationOrderResponse{
+		ID:            order.ID,
+		EventID:       order.EventID,
+		EventName:     order.EventName,
+		Status:        order.Status,
+		TotalAmount:   order.TotalAmount,
+		GrandTotal:    order.GrandTotal,
+		PaymentMethod: order.PaymentMethod,
+		Tickets:       order.Tickets,
+		BuyerName:     order.BuyerName,
+		BuyerEmail:    order.BuyerEmail,
+		CreatedAt:     order.CreatedAt,
+		UpdatedAt:     order.UpdatedAt,
+	}
+}
+
+// IntegrationAttendeeResponse represents an issued ticket in the attendees trigger
+type IntegrationAttendeeResponse struct {
+	ID           string    `json:"id"` // Ticket ID
+	TicketNumber string    `json:"ticket_number"`
+	OrderID      string    `json:"order_id"`
+	EventID      string    `json:"event_id"`
+	EventName    string    `json:"event_name"`
+	TierName     string    `json:"tier_name"`
+	Status       string    `json:"status"`
+	HolderName   string    `json:"holder_name"`
+	HolderEmail  string    `json:"holder_email"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// ToIntegrationAttendeeResponse converts integration attendee entity to response
+func ToIntegrationAttendeeResponse(attendee *entity.IntegrationAttendee) IntegrationAttendeeResponse {
+	return IntegrationAttendeeResponse{
+		ID:           attendee.TicketID,
+		TicketNumber: attendee.TicketNumber,
+		OrderID:      attendee.OrderID,
+		EventID:      attendee.EventID,
+		EventName:    attendee.EventName,
+		TierName:     attendee.TierName,
+		Status:       attendee.Status,
+		HolderName:   attendee.HolderName,
+		HolderEmail:  attendee.HolderEmail,
+		CreatedAt:    attendee.CreatedAt,
+	}
+}
+
+// IntegrationCheckInResponse represents an entry scan in the check-ins trigger
+type IntegrationCheckInResponse struct {
+	ID           string    `json:"id"` // Scan ID
+	TicketID     string    `json:"ticket_id"`
+	TicketNumber string    `json:"ticket_number"`
+	EventID      string    `json:"event_id"`
+	EventName    string    `json:"event_name"`
+	TierName     string    `json:"tier_name"`
+	Zone         *string   `json:"zone,omitempty"`
+	HolderName   string    `json:"holder_name"`
+	HolderEmail  string    `json:"holder_email"`
+	ScannedAt    time.Time `json:"scanned_at"`
+}
+
+// ToIntegrationCheckInResponse converts integration check-in entity to response
+func ToIntegrationCheckInResponse(checkIn *entity.IntegrationCheckIn) IntegrationCheckInResponse {
+	return IntegrationCheckInResponse{
+		ID:           checkIn.ScanID,
+		TicketID:     checkIn.TicketID,
+		TicketNumber: checkIn.TicketNumber,
+		EventID:      checkIn.EventID,
+		EventName:    checkIn.EventName,
+		TierName:     checkIn.TierName,
+		Zone:         checkIn.Zone,
+		HolderName:   checkIn.HolderName,
+		HolderEmail:  checkIn.HolderEmail,
+		ScannedAt:    checkIn.ScannedAt,
+	}
+}
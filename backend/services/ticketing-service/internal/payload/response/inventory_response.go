@@ -0,0 +1,43 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// InventoryHistoryResponse represents the sold_count movements of a ticket tier over time
+type InventoryHistoryResponse struct {
+	TicketTierID  string                   `json:"ticket_tier_id"`
+	EventID       string                   `json:"event_id"`
+	TierName      string                   `json:"tier_name"`
+	Quota         int                      `json:"quota"`
+	SoldCount     int                      `json:"sold_count"` // Current, includes seats of unpaid reservations
+	Interval      string                   `json:"interval"`
+	From          time.Time                `json:"from"`
+	To            time.Time                `json:"to"`
+	TotalSold     int                      `json:"total_sold"`
+	TotalReleased int                      `json:"total_released"`
+	TotalAdjusted int                      `json:"total_adjusted"`
+	Points        []InventoryPointResponse `json:"points"`
+}
+
+// InventoryPointResponse represents the movements of one time bucket, buckets without movements included
+type InventoryPointResponse struct {
+	BucketStart time.Time `json:"bucket_start"`
+	Sold        int       `json:"sold"`
+	Released    int       `json:"released"`
+	Adjusted    int       `json:"adjusted"` // Net correction by the consistency checker
+	Net         int       `json:"net"`      // sold - released + adjusted
+}
+
+// ToInventoryPointResponse converts inventory bucket entity to response
+func ToInventoryPointResponse(bucket *entity.InventoryBucket) InventoryPointResponse {
+	return InventoryPointResponse{
+		BucketStart: bucket.BucketStart,
+		Sold:        bucket.Sold,
+		Released:    bucket.Released,
+		Adjusted:    bucket.Adjusted,
+		Net:         bucket.Sold - bucket.Released + bucket.Adjusted,
+	}
+}
@@ -0,0 +1,102 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// ImportInvitationsResponse represents the result of an invitee import
+// Invitations are only created when no row has errors; a dry run never creates them
+type ImportInvitationsResponse struct {
+	DryRun        bool             `json:"dry_run"`
+	Invitations   int              `json:"invitations"` // Invitees in the file
+	Tickets       int              `json:"tickets"`     // Seats held for them
+	BatchID       string           `json:"batch_id,omitempty"`
+	ClaimDeadline time.Time        `json:"claim_deadline"`
+	Errors        []ImportRowError `json:"errors,omitempty"`
+}
+
+// ImportRowError represents a problem with one row of an import file
+// Row is the CSV line, the header is line 1
+type ImportRowError struct {
+	Row     int    `json:"row"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// InvitationResponse represents an invitation as seen by the organizer
+type InvitationResponse struct {
+	ID            string      `json:"id"`
+	BatchID       string      `json:"batch_id"`
+	Email         string      `json:"email"`
+	Name          string      `json:"name"`
+	TicketTierID  string      `json:"ticket_tier_id"`
+	TierName      string      `json:"tier_name"`
+	Quantity      int         `json:"quantity"`
+	Price         money.Money `json:"price"`
+	Status        string      `json:"status"`
+	ClaimDeadline time.Time   `json:"claim_deadline"`
+	OrderID       *string     `json:"order_id,omitempty"`
+	EmailSentAt   *time.Time  `json:"email_sent_at,omitempty"`
+	ClaimedAt     *time.Time  `json:"claimed_at,omitempty"`
+	ReleasedAt    *time.Time  `json:"released_at,omitempty"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
+
+// InvitationListResponse represents the invitations of an event with counts by status
+type InvitationListResponse struct {
+	Summary     InvitationSummaryResponse `json:"summary"`
+	Invitations []InvitationResponse      `json:"invitations"`
+}
+
+// InvitationSummaryResponse counts the invitations of an event by status
+type InvitationSummaryResponse struct {
+	Pending  int `json:"pending"`
+	Claimed  int `json:"claimed"`
+	Released int `json:"released"`
+	Unsent   int `json:"unsent"` // Pending invitations whose email was not sent yet
+}
+
+// InvitationPreviewResponse is the public view of an invitation behind a claim link
+// Deliberately omits the invitee's email, the link may have been forwarded
+type InvitationPreviewResponse struct {
+	Event         *EventSummaryResponse `json:"event"`
+	Name          string                `json:"name"`
+	TierName      string                `json:"tier_name"`
+	Quantity      int                   `json:"quantity"`
+	Price         money.Money           `json:"price"` // Unit price, 0 for complimentary tickets
+	Status        string                `json:"status"`
+	Claimable     bool                  `json:"claimable"`
+	ClaimDeadline time.Time             `json:"claim_deadline"`
+}
+
+// ClaimInvitationResponse represents a claimed invitation
+// Complimentary invitations are paid on claim and come with their tickets; discounted ones
+// are reserved orders with an invoice to pay before the reservation expires
+type ClaimInvitationResponse struct {
+	Order   *OrderResponse   `json:"order"`
+	Tickets []TicketResponse `json:"tickets,omitempty"`
+}
+
+// ToInvitationResponse converts EventInvitation entity to InvitationResponse
+func ToInvitationResponse(invitation *entity.EventInvitation, tierName string) InvitationResponse {
+	return InvitationResponse{
+		ID:            invitation.ID,
+		BatchID:       invitation.BatchID,
+		Email:         invitation.Email,
+		Name:          invitation.Name,
+		TicketTierID:  invitation.TicketTierID,
+		TierName:      tierName,
+		Quantity:      invitation.Quantity,
+		Price:         invitation.Price,
+		Status:        invitation.Status,
+		ClaimDeadline: invitation.ClaimDeadline,
+		OrderID:       invitation.OrderID,
+		EmailSentAt:   invitation.EmailSentAt,
+		ClaimedAt:     invitation.ClaimedAt,
+		ReleasedAt:    invitation.ReleasedAt,
+		CreatedAt:     invitation.CreatedAt,
+	}
+}
@@ -0,0 +1,89 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// OrderIssueResponse represents an order issue with its conversation
+type OrderIssueResponse struct {
+	ID          string                    `json:"id"`
+	OrderID     string                    `json:"order_id"`
+	UserID      string                    `json:"user_id"`
+	Category    string                    `json:"category"`
+	Description string                    `json:"description"`
+	Status      string                    `json:"status"`
+	Replies     []OrderIssueReplyResponse `json:"replies"`
+	Resolution  *string                   `json:"resolution,omitempty"`
+	ResolvedAt  *time.Time                `json:"resolved_at,omitempty"`
+	CreatedAt   time.Time                 `json:"created_at"`
+	UpdatedAt   time.Time                 `json:"updated_at"`
+}
+
+// OrderIssueReplyResponse represents a support reply to an order issue
+type OrderIssueReplyResponse struct {
+	ID        string    `json:"id"`
+	AuthorID  string    `json:"author_id"`
+	Message   string    `json:"message"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AdminOrderResponse is the support view of an order with the issues reported on it
+// and the actions support took on it
+type AdminOrderResponse struct {
+	Order  *OrderV2Response          `json:"order"`
+	Issues []OrderIssueResponse      `json:"issues"`
+	Audit  []OrderAuditEntryResponse `json:"audit"`
+}
+
+// OrderAuditEntryResponse represents an action support took on an order
+type OrderAuditEntryResponse struct {
+	ID        string    `json:"id"`
+	Action    string    `json:"action"`
+	ActorID   string    `json:"actor_id"`
+	Reference *string   `json:"reference,omitempty"`
+	Reason    string    `json:"reason"`
+	Outcome   *string   `json:"outcome,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ToOrderAuditEntryResponse converts order audit entry entity to response
+func ToOrderAuditEntryResponse(entry *entity.OrderAuditEntry) OrderAuditEntryResponse {
+	return OrderAuditEntryResponse{
+		ID:        entry.ID,
+		Action:    entry.Action,
+		ActorID:   entry.ActorID,
+		Reference: entry.Reference,
+		Reason:    entry.Reason,
+		Outcome:   entry.Outcome,
+		CreatedAt: entry.CreatedAt,
+	}
+}
+
+// ToOrderIssueResponse converts order issue entity with its replies to response
+func ToOrderIssueResponse(issue *entity.OrderIssue, replies []entity.OrderIssueReply) *OrderIssueResponse {
+	replyResponses := make([]OrderIssueReplyResponse, 0, len(replies))
+	for _, reply := range replies {
+		replyResponses = append(replyResponses, OrderIssueReplyResponse{
+			ID:        reply.ID,
+			AuthorID:  reply.AuthorID,
+			Message:   reply.Message,
+			CreatedAt: reply.CreatedAt,
+		})
+	}
+
+	return &OrderIssueResponse{
+		ID:          issue.ID,
+		OrderID:     issue.OrderID,
+		UserID:      issue.UserID,
+		Category:    issue.Category,
+		Description: issue.Description,
+		Status:      issue.Status,
+		Replies:     replyResponses,
+		Resolution:  issue.Resolution,
+		ResolvedAt:  issue.ResolvedAt,
+		CreatedAt:   issue.CreatedAt,
+		UpdatedAt:   issue.UpdatedAt,
+	}
+}
@@ -0,0 +1,12 @@
+package response
+
+import "time"
+
+// NotificationPreferenceResponse represents how often the user gets non-urgent notifications
+type NotificationPreferenceResponse struct {
+	Email           string     `json:"email"`
+	DigestFrequency string     `json:"digest_frequency"` // immediate, daily or weekly
+	DigestTypes     []string   `json:"digest_types"`     // Notification types collected in the digest, e.g. announcement
+	PendingCount    int        `json:"pending_count"`    // Notifications waiting for the next digest
+	NextDigestAt    *time.Time `json:"next_digest_at,omitempty"`
+}
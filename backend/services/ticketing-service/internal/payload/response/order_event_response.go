@@ -0,0 +1,46 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// OrderEventResponse represents one event of an event-sourced order's stream
+type OrderEventResponse struct {
+	Version   int             `json:"version"`
+	EventType string          `json:"event_type"`
+	Data      json.RawMessage `json:"data"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// ToOrderEventResponses converts an order's stream to responses, oldest first
+func ToOrderEventResponses(events []entity.OrderEvent) []OrderEventResponse {
+	responses := make([]OrderEventResponse, len(events))
+	for i, event := range events {
+		responses[i] = OrderEventResponse{
+			Version:   event.Version,
+			EventType: event.EventType,
+			Data:      event.Data,
+			CreatedAt: event.CreatedAt,
+		}
+	}
+	return responses
+}
+
+// OrderReplayResponse represents an order rebuilt from its stream
+type OrderReplayResponse struct {
+	OrderID string `json:"order_id"`
+	Events  int    `json:"events"`
+	Status  string `json:"status"`  // Status replayed from the stream
+	Changed bool   `json:"changed"` // The orders row differed from the stream and was rewritten
+}
+
+// OrderReplaySummaryResponse represents a replay of every event-sourced order
+type OrderReplaySummaryResponse struct {
+	Replayed int      `json:"replayed"`
+	Changed  int      `json:"changed"`
+	Skipped  int      `json:"skipped"` // Archived orders, their streams are kept as audit trail
+	Failed   []string `json:"failed"`  // IDs of orders whose stream could not be replayed
+}
@@ -3,37 +3,71 @@ package response
 import (
 	"time"
 
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
 )
 
 // OrderResponse represents order information in response
 type OrderResponse struct {
-	ID                   string              `json:"id"`
-	UserID               string              `json:"user_id"`
-	EventID              string              `json:"event_id"`
-	Items                []OrderItemResponse `json:"items"`
-	TotalAmount          float64             `json:"total_amount"`
-	PlatformFee          float64             `json:"platform_fee"`
-	ServiceFee           float64             `json:"service_fee"`
-	GrandTotal           float64             `json:"grand_total"`
-	Status               string              `json:"status"`
-	PaymentID            *string             `json:"payment_id,omitempty"`
-	PaymentMethod        *string             `json:"payment_method,omitempty"`
-	InvoiceURL           *string             `json:"invoice_url,omitempty"`
-	ReservationExpiresAt *time.Time          `json:"reservation_expires_at,omitempty"`
-	CreatedAt            time.Time           `json:"created_at"`
-	UpdatedAt            time.Time           `json:"updated_at"`
-	CompletedAt          *time.Time          `json:"completed_at,omitempty"`
+	ID                   string                     `json:"id"`
+	UserID               string                     `json:"user_id"`
+	EventID              string                     `json:"event_id"`
+	Items                []OrderItemResponse        `json:"items"`
+	TotalAmount          money.Money                `json:"total_amount"`
+	PlatformFee          money.Money                `json:"platform_fee"`
+	ServiceFee           money.Money                `json:"service_fee"`
+	GrandTotal           money.Money                `json:"grand_total"`
+	Status               string                     `json:"status"`
+	PaymentID            *string                    `json:"payment_id,omitempty"`
+	PaymentMethod        *string                    `json:"payment_method,omitempty"`
+	PaidAmount           *money.Money               `json:"paid_amount,omitempty"`
+	PaidCurrency         *string                    `json:"paid_currency,omitempty"`
+	AmountDiscrepancy    *money.Money               `json:"amount_discrepancy,omitempty"`
+	InvoiceURL           *string                    `json:"invoice_url,omitempty"`
+	Insurance            *InsurancePolicyResponse   `json:"insurance,omitempty"`
+	Accommodations       []AccommodationResponse    `json:"accommodations,omitempty"`
+	AcceptedPolicies     []PolicyAcceptanceResponse `json:"accepted_policies,omitempty"`
+	ReservationExpiresAt *time.Time                 `json:"reservation_expires_at,omitempty"`
+	ExpiresInSeconds     *int                       `json:"expires_in_seconds,omitempty"` // Countdown to reservation expiry, set while reserved
+	CreatedAt            time.Time                  `json:"created_at"`
+	UpdatedAt            time.Time                  `json:"updated_at"`
+	CompletedAt          *time.Time                 `json:"completed_at,omitempty"`
+	IsSandbox            bool                       `json:"is_sandbox"` // Test order, its tickets are marked TEST
 }
 
 // OrderItemResponse represents order item in response
 type OrderItemResponse struct {
-	ID           string  `json:"id"`
-	TicketTierID string  `json:"ticket_tier_id"`
-	TierName     string  `json:"tier_name,omitempty"`
-	Quantity     int     `json:"quantity"`
-	Price        float64 `json:"price"`
-	Subtotal     float64 `json:"subtotal"`
+	ID           string      `json:"id"`
+	TicketTierID string      `json:"ticket_tier_id"`
+	TierName     string      `json:"tier_name,omitempty"`
+	Quantity     int         `json:"quantity"`
+	Price        money.Money `json:"price"`
+	Subtotal     money.Money `json:"subtotal"`
+}
+
+// OrderQuoteResponse represents the price of an order before it's placed
+// Nothing is reserved; the amounts match the order as long as prices and fees don't change
+type OrderQuoteResponse struct {
+	EventID                   string                   `json:"event_id"`
+	Items                     []OrderQuoteItemResponse `json:"items"`
+	TotalAmount               money.Money              `json:"total_amount"`
+	PlatformFee               money.Money              `json:"platform_fee"`
+	ServiceFee                money.Money              `json:"service_fee"`
+	InsurancePremium          *money.Money             `json:"insurance_premium,omitempty"` // Set when insurance was requested, included in the grand total
+	GrandTotal                money.Money              `json:"grand_total"`
+	Available                 bool                     `json:"available"`                   // Every item fits the remaining quota right now
+	ReservationTimeoutSeconds int                      `json:"reservation_timeout_seconds"` // How long a placed order holds the tickets
+}
+
+// OrderQuoteItemResponse represents a priced item of an order quote
+type OrderQuoteItemResponse struct {
+	TicketTierID string      `json:"ticket_tier_id"`
+	TierName     string      `json:"tier_name"`
+	Quantity     int         `json:"quantity"`
+	Price        money.Money `json:"price"`
+	Subtotal     money.Money `json:"subtotal"`
+	Remaining    int         `json:"remaining"` // Tickets left in the tier
+	Available    bool        `json:"available"` // Quantity fits the remaining quota
 }
 
 // TicketResponse represents ticket information
@@ -78,6 +112,7 @@ func ToOrderResponse(order *entity.Order, items []entity.OrderItem) *OrderRespon
 		UserID:               order.UserID,
 		EventID:              order.EventID,
 		Items:                itemResponses,
+		IsSandbox:            order.IsSandbox,
 		TotalAmount:          order.TotalAmount,
 		PlatformFee:          order.PlatformFee,
 		ServiceFee:           order.ServiceFee,
@@ -85,13 +120,32 @@ func ToOrderResponse(order *entity.Order, items []entity.OrderItem) *OrderRespon
 		Status:               order.Status,
 		PaymentID:            order.PaymentID,
 		PaymentMethod:        order.PaymentMethod,
+		PaidAmount:           order.PaidAmount,
+		PaidCurrency:         order.PaidCurrency,
+		AmountDiscrepancy:    order.AmountDiscrepancy,
 		ReservationExpiresAt: order.ReservationExpiresAt,
+		ExpiresInSeconds:     expiresInSeconds(order),
 		CreatedAt:            order.CreatedAt,
 		UpdatedAt:            order.UpdatedAt,
 		CompletedAt:          order.CompletedAt,
 	}
 }
 
+// expiresInSeconds returns the seconds left before a reserved order's tickets are released
+// Clients count down from it instead of comparing reservation_expires_at against a possibly skewed clock;
+// nil unless the order is waiting for payment
+func expiresInSeconds(order *entity.Order) *int {
+	if order.Status != entity.OrderStatusReserved || order.ReservationExpiresAt == nil {
+		return nil
+	}
+
+	seconds := int(time.Until(*order.ReservationExpiresAt).Seconds())
+	if seconds < 0 {
+		seconds = 0
+	}
+	return &seconds
+}
+
 // ToTicketResponse converts Ticket entity to TicketResponse
 func ToTicketResponse(ticket *entity.Ticket) *TicketResponse {
 	return &TicketResponse{
@@ -106,3 +160,67 @@ func ToTicketResponse(ticket *entity.Ticket) *TicketResponse {
 		CreatedAt:    ticket.CreatedAt,
 	}
 }
+
+// VoidTicketResponse represents a ticket revoked by an organizer or admin
+type VoidTicketResponse struct {
+	ID           string     `json:"id"`
+	TicketNumber string     `json:"ticket_number"`
+	Status       string     `json:"status"`
+	VoidReason   string     `json:"void_reason"`
+	VoidedAt     *time.Time `json:"voided_at"`
+}
+
+// ToVoidTicketResponse converts a voided Ticket entity to VoidTicketResponse
+func ToVoidTicketResponse(ticket *entity.Ticket) *VoidTicketResponse {
+	resp := &VoidTicketResponse{
+		ID:           ticket.ID,
+		TicketNumber: ticket.TicketNumber,
+		Status:       ticket.Status,
+		VoidedAt:     ticket.VoidedAt,
+	}
+	if ticket.VoidReason != nil {
+		resp.VoidReason = *ticket.VoidReason
+	}
+	return resp
+}
+
+// TicketVerificationResponse represents a verify-only ticket scan
+// Valid tells whether an entry scan would be accepted right now
+type TicketVerificationResponse struct {
+	Valid      bool                  `json:"valid"`
+	Ticket     TicketResponse        `json:"ticket"`
+	HolderName string                `json:"holder_name"`
+	TierName   string                `json:"tier_name"`
+	Event      *EventSummaryResponse `json:"event"`
+	ScanPolicy string                `json:"scan_policy,omitempty"`
+	Zones      []string              `json:"zones"` // Zones the ticket may enter, empty when unrestricted
+	Scans      []TicketScanResponse  `json:"scans"`
+	VoidReason *string               `json:"void_reason,omitempty"`
+}
+
+// TicketScanResponse represents one entry in a ticket's scan history
+type TicketScanResponse struct {
+	Direction string    `json:"direction"`
+	Zone      *string   `json:"zone,omitempty"`
+	ScannedAt time.Time `json:"scanned_at"`
+}
+
+// ToTicketVerificationResponse converts Ticket entity and its scan history to TicketVerificationResponse
+func ToTicketVerificationResponse(ticket *entity.Ticket, scans []entity.TicketScan) *TicketVerificationResponse {
+	scanResponses := make([]TicketScanResponse, len(scans))
+	for i, scan := range scans {
+		scanResponses[i] = TicketScanResponse{
+			Direction: scan.Direction,
+			Zone:      scan.Zone,
+			ScannedAt: scan.ScannedAt,
+		}
+	}
+
+	return &TicketVerificationResponse{
+		Valid:      ticket.CanBeUsed(),
+		Ticket:     *ToTicketResponse(ticket),
+		Zones:      []string{},
+		Scans:      scanResponses,
+		VoidReason: ticket.VoidReason,
+	}
+}
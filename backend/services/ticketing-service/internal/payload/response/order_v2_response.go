@@ -0,0 +1,210 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// Payment status values exposed in API v2 order responses
+const (
+	PaymentStatusPending   = "pending"
+	PaymentStatusPaid      = "paid"
+	PaymentStatusExpired   = "expired"
+	PaymentStatusCancelled = "cancelled"
+)
+
+// OrderV2Response represents order information in API v2
+// Unlike OrderResponse it embeds event details, a fee breakdown and payment info
+type OrderV2Response struct {
+	ID                   string                `json:"id"`
+	UserID               string                `json:"user_id"`
+	Status               string                `json:"status"`
+	Event                *EventSummaryResponse `json:"event"`
+	Items                []OrderItemV2Response `json:"items"`
+	Fees                 FeeBreakdownResponse  `json:"fees"`
+	Payment              PaymentInfoResponse   `json:"payment"`
+	ReservationExpiresAt *time.Time            `json:"reservation_expires_at,omitempty"`
+	ExpiresInSeconds     *int                  `json:"expires_in_seconds,omitempty"` // Countdown to reservation expiry, set while reserved
+	CreatedAt            time.Time             `json:"created_at"`
+	UpdatedAt            time.Time             `json:"updated_at"`
+	CompletedAt          *time.Time            `json:"completed_at,omitempty"`
+	Metadata             map[string]string     `json:"metadata"`
+	IsSandbox            bool                  `json:"is_sandbox"` // Test order, its tickets are marked TEST
+}
+
+// EventSummaryResponse represents event details embedded in API v2 responses
+type EventSummaryResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	Location  string    `json:"location"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	BannerURL *string   `json:"banner_url,omitempty"`
+	Status    string    `json:"status"`
+}
+
+// OrderItemV2Response represents order item in API v2
+type OrderItemV2Response struct {
+	ID           string      `json:"id"`
+	TicketTierID string      `json:"ticket_tier_id"`
+	TierName     string      `json:"tier_name"`
+	Quantity     int         `json:"quantity"`
+	Price        money.Money `json:"price"`
+	Subtotal     money.Money `json:"subtotal"`
+}
+
+// FeeBreakdownResponse represents how the order grand total is composed
+type FeeBreakdownResponse struct {
+	Subtotal    money.Money `json:"subtotal"`
+	PlatformFee money.Money `json:"platform_fee"`
+	ServiceFee  money.Money `json:"service_fee"`
+	TotalFees   money.Money `json:"total_fees"`
+	GrandTotal  money.Money `json:"grand_total"`
+	Currency    string      `json:"currency"`
+}
+
+// PaymentInfoResponse represents payment state of an order
+type PaymentInfoResponse struct {
+	Status            string       `json:"status"`
+	PaymentID         *string      `json:"payment_id,omitempty"`
+	Method            *string      `json:"method,omitempty"`
+	PaidAmount        *money.Money `json:"paid_amount,omitempty"`
+	PaidCurrency      *string      `json:"paid_currency,omitempty"`
+	AmountDiscrepancy *money.Money `json:"amount_discrepancy,omitempty"`
+	InvoiceURL        *string      `json:"invoice_url,omitempty"`
+	InvoiceExpiresAt  *time.Time   `json:"invoice_expires_at,omitempty"`
+}
+
+// TicketV2Response represents ticket information in API v2
+type TicketV2Response struct {
+	ID           string                `json:"id"`
+	OrderID      string                `json:"order_id"`
+	TicketNumber string                `json:"ticket_number"`
+	QRCode       string                `json:"qr_code"` // Base64 encoded
+	Status       string                `json:"status"`
+	Event        *EventSummaryResponse `json:"event"`
+	Tier         TierSummaryResponse   `json:"tier"`
+	UsedAt       *time.Time            `json:"used_at,omitempty"`
+	VoidReason   *string               `json:"void_reason,omitempty"`
+	VoidedAt     *time.Time            `json:"voided_at,omitempty"`
+	CreatedAt    time.Time             `json:"created_at"`
+}
+
+// TierSummaryResponse represents ticket tier details embedded in API v2 responses
+type TierSummaryResponse struct {
+	ID    string      `json:"id"`
+	Name  string      `json:"name"`
+	Price money.Money `json:"price"`
+}
+
+// ToEventSummaryResponse converts Event entity to EventSummaryResponse
+// Returns nil when the event is unknown (e.g. deleted)
+func ToEventSummaryResponse(event *entity.Event) *EventSummaryResponse {
+	if event == nil {
+		return nil
+	}
+
+	return &EventSummaryResponse{
+		ID:        event.ID,
+		Name:      event.Name,
+		Slug:      event.Slug,
+		Location:  event.Location,
+		StartDate: event.StartDate,
+		EndDate:   event.EndDate,
+		BannerURL: event.BannerURL,
+		Status:    event.Status,
+	}
+}
+
+// ToOrderV2Response converts Order entity to OrderV2Response
+// event may be nil and tiers may miss entries; affected fields are left empty
+func ToOrderV2Response(order *entity.Order, items []entity.OrderItem, event *entity.Event, tiers map[string]entity.TicketTier, currency string) *OrderV2Response {
+	itemResponses := make([]OrderItemV2Response, 0, len(items))
+	for _, item := range items {
+		itemResponses = append(itemResponses, OrderItemV2Response{
+			ID:           item.ID,
+			TicketTierID: item.TicketTierID,
+			TierName:     tiers[item.TicketTierID].Name,
+			Quantity:     item.Quantity,
+			Price:        item.Price,
+			Subtotal:     item.Subtotal,
+		})
+	}
+
+	// Always an object so clients need not handle null
+	metadata := map[string]string(order.Metadata)
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+
+	return &OrderV2Response{
+		ID:     order.ID,
+		UserID: order.UserID,
+		Status: order.Status,
+		Event:  ToEventSummaryResponse(event),
+		Items:  itemResponses,
+		Fees: FeeBreakdownResponse{
+			Subtotal:    order.TotalAmount,
+			PlatformFee: order.PlatformFee,
+			ServiceFee:  order.ServiceFee,
+			TotalFees:   order.PlatformFee.Add(order.ServiceFee),
+			GrandTotal:  order.GrandTotal,
+			Currency:    currency,
+		},
+		Payment: PaymentInfoResponse{
+			Status:            paymentStatusForOrder(order),
+			PaymentID:         order.PaymentID,
+			Method:            order.PaymentMethod,
+			PaidAmount:        order.PaidAmount,
+			PaidCurrency:      order.PaidCurrency,
+			AmountDiscrepancy: order.AmountDiscrepancy,
+		},
+		ReservationExpiresAt: order.ReservationExpiresAt,
+		ExpiresInSeconds:     expiresInSeconds(order),
+		CreatedAt:            order.CreatedAt,
+		UpdatedAt:            order.UpdatedAt,
+		CompletedAt:          order.CompletedAt,
+		Metadata:             metadata,
+		IsSandbox:            order.IsSandbox,
+	}
+}
+
+// ToTicketV2Response converts Ticket entity to TicketV2Response
+func ToTicketV2Response(ticket *entity.Ticket, event *entity.Event, tier *entity.TicketTier) *TicketV2Response {
+	tierSummary := TierSummaryResponse{ID: ticket.TicketTierID}
+	if tier != nil {
+		tierSummary.Name = tier.Name
+		tierSummary.Price = tier.Price
+	}
+
+	return &TicketV2Response{
+		ID:           ticket.ID,
+		OrderID:      ticket.OrderID,
+		TicketNumber: ticket.TicketNumber,
+		QRCode:       ticket.QRCode,
+		Status:       ticket.Status,
+		Event:        ToEventSummaryResponse(event),
+		Tier:         tierSummary,
+		UsedAt:       ticket.UsedAt,
+		VoidReason:   ticket.VoidReason,
+		VoidedAt:     ticket.VoidedAt,
+		CreatedAt:    ticket.CreatedAt,
+	}
+}
+
+// paymentStatusForOrder derives payment status from order status
+func paymentStatusForOrder(order *entity.Order) string {
+	switch {
+	case order.IsPaid():
+		return PaymentStatusPaid
+	case order.Status == entity.OrderStatusExpired:
+		return PaymentStatusExpired
+	case order.Status == entity.OrderStatusCancelled:
+		return PaymentStatusCancelled
+	default:
+		return PaymentStatusPending
+	}
+}
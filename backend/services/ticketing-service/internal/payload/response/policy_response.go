@@ -0,0 +1,57 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// PolicyDocumentResponse represents one version of a purchase policy
+type PolicyDocumentResponse struct {
+	ID          string    `json:"id"`
+	EventID     *string   `json:"event_id,omitempty"` // Omitted for the platform terms
+	Type        string    `json:"type"`
+	Title       string    `json:"title"`
+	Content     string    `json:"content"`
+	VersionHash string    `json:"version_hash"` // Sent back in accepted_policies when ordering
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// PolicyAcceptanceResponse represents a policy version accepted with an order
+type PolicyAcceptanceResponse struct {
+	PolicyID    string    `json:"policy_id"`
+	EventID     *string   `json:"event_id,omitempty"`
+	Type        string    `json:"type"`
+	Title       string    `json:"title"`
+	VersionHash string    `json:"version_hash"`
+	AcceptedAt  time.Time `json:"accepted_at"`
+}
+
+// ToPolicyDocumentResponse converts policy document entity to response
+func ToPolicyDocumentResponse(document *entity.PolicyDocument) *PolicyDocumentResponse {
+	return &PolicyDocumentResponse{
+		ID:          document.ID,
+		EventID:     document.EventID,
+		Type:        document.Type,
+		Title:       document.Title,
+		Content:     document.Content,
+		VersionHash: document.VersionHash,
+		PublishedAt: document.CreatedAt,
+	}
+}
+
+// ToPolicyAcceptanceResponses converts policy acceptance entities to responses
+func ToPolicyAcceptanceResponses(acceptances []entity.PolicyAcceptance) []PolicyAcceptanceResponse {
+	responses := make([]PolicyAcceptanceResponse, len(acceptances))
+	for i, acceptance := range acceptances {
+		responses[i] = PolicyAcceptanceResponse{
+			PolicyID:    acceptance.PolicyDocumentID,
+			EventID:     acceptance.EventID,
+			Type:        acceptance.Type,
+			Title:       acceptance.Title,
+			VersionHash: acceptance.VersionHash,
+			AcceptedAt:  acceptance.AcceptedAt,
+		}
+	}
+	return responses
+}
@@ -0,0 +1,71 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// ConfirmPaymentResponse represents the outcome of a payment confirmation
+// Retries of a payment get the result of its first confirmation
+type ConfirmPaymentResponse struct {
+	Outcome   string   `json:"outcome"` // confirmed, already_paid, refund_flagged
+	OrderID   string   `json:"order_id"`
+	PaymentID string   `json:"payment_id"`
+	TicketIDs []string `json:"ticket_ids,omitempty"` // Tickets of the confirmed order
+
+	RefundReason string `json:"refund_reason,omitempty"` // Why a refund_flagged payment can't be applied
+}
+
+// MarkPaidResponse represents a payment confirmed by finance with its audit entry
+type MarkPaidResponse struct {
+	ConfirmPaymentResponse
+	AuditEntry OrderAuditEntryResponse `json:"audit_entry"`
+}
+
+// RefundOrderResponse represents an order refunded through payment service
+type RefundOrderResponse struct {
+	OrderID       string      `json:"order_id"`
+	PaymentID     string      `json:"payment_id"`
+	Amount        money.Money `json:"amount"` // Grand total paid for the order
+	TicketsVoided int         `json:"tickets_voided"`
+}
+
+// RefundEligibilityResponse represents an order its buyer may refund through payment service
+type RefundEligibilityResponse struct {
+	OrderID   string `json:"order_id"`
+	PaymentID string `json:"payment_id"`
+}
+
+// OrderRefundResponse represents a payment flagged for manual refund
+type OrderRefundResponse struct {
+	ID            string      `json:"id"`
+	OrderID       string      `json:"order_id"`
+	PaymentID     string      `json:"payment_id"`
+	PaymentMethod *string     `json:"payment_method,omitempty"`
+	Amount        money.Money `json:"amount"`
+	Currency      string      `json:"currency"`
+	Reason        string      `json:"reason"`
+	Status        string      `json:"status"`
+	RefundedBy    *string     `json:"refunded_by,omitempty"`
+	RefundedAt    *time.Time  `json:"refunded_at,omitempty"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
+
+// ToOrderRefundResponse converts refund request entity to response
+func ToOrderRefundResponse(refund *entity.OrderRefundRequest) *OrderRefundResponse {
+	return &OrderRefundResponse{
+		ID:            refund.ID,
+		OrderID:       refund.OrderID,
+		PaymentID:     refund.PaymentID,
+		PaymentMethod: refund.PaymentMethod,
+		Amount:        refund.Amount,
+		Currency:      refund.Currency,
+		Reason:        refund.Reason,
+		Status:        refund.Status,
+		RefundedBy:    refund.RefundedBy,
+		RefundedAt:    refund.RefundedAt,
+		CreatedAt:     refund.CreatedAt,
+	}
+}
@@ -0,0 +1,8 @@
+package response
+
+// SandboxResetResponse represents the test orders cleared from a sandbox event
+type SandboxResetResponse struct {
+	EventID       string `json:"event_id"`
+	OrdersCleared int    `json:"orders_cleared"` // Reserved and paid test orders cancelled, their seats returned
+	TicketsVoided int    `json:"tickets_voided"`
+}
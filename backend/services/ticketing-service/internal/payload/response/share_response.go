@@ -0,0 +1,27 @@
+package response
+
+import "time"
+
+// TicketShareResponse represents a created share link
+type TicketShareResponse struct {
+	ShareURL  string    `json:"share_url"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// SharedTicketResponse is the public view of a shared ticket
+// Deliberately omits the owner, order and payment details
+type SharedTicketResponse struct {
+	TicketNumber string                `json:"ticket_number"`
+	QRCode       string                `json:"qr_code"` // Base64 encoded
+	Status       string                `json:"status"`
+	Event        *EventSummaryResponse `json:"event"`
+	TierName     string                `json:"tier_name"`
+	UsedAt       *time.Time            `json:"used_at,omitempty"`
+	ExpiresAt    time.Time             `json:"expires_at"` // When the share link stops working
+}
+
+// RevokeShareResponse represents the result of revoking share links
+type RevokeShareResponse struct {
+	Revoked int64 `json:"revoked"`
+}
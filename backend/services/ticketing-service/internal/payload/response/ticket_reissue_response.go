@@ -0,0 +1,59 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// Ticket reissue status constants
+const (
+	TicketReissueInProgress = "in_progress"
+	TicketReissueCompleted  = "completed"
+	TicketReissueFailed     = "failed" // Some batches gave up, their tickets are left as counted
+)
+
+// TicketReissueResponse represents a bulk ticket reissue and its progress
+type TicketReissueResponse struct {
+	ID            string    `json:"id"`
+	EventID       string    `json:"event_id"`
+	Reason        string    `json:"reason"`
+	RequestedBy   string    `json:"requested_by"`
+	Status        string    `json:"status"`
+	Tickets       int       `json:"tickets"`
+	Pending       int       `json:"pending"`  // Codes not rotated yet
+	Reissued      int       `json:"reissued"` // Codes rotated, the previous ones no longer validate
+	Skipped       int       `json:"skipped"`  // Used or voided before their code was rotated
+	Emailed       int       `json:"emailed"`  // Reissued tickets sent to their holder
+	Batches       int       `json:"batches"`
+	FailedBatches int       `json:"failed_batches"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// ToTicketReissueResponse converts a reissue and its progress to TicketReissueResponse
+func ToTicketReissueResponse(reissue *entity.TicketReissue, progress *entity.TicketReissueProgress) *TicketReissueResponse {
+	status := TicketReissueInProgress
+	switch {
+	case progress.ActiveBatches > 0:
+	case progress.Pending == 0 && progress.Emailed == progress.Reissued:
+		status = TicketReissueCompleted
+	case progress.FailedBatches > 0:
+		status = TicketReissueFailed
+	}
+
+	return &TicketReissueResponse{
+		ID:            reissue.ID,
+		EventID:       reissue.EventID,
+		Reason:        reissue.Reason,
+		RequestedBy:   reissue.RequestedBy,
+		Status:        status,
+		Tickets:       progress.Tickets,
+		Pending:       progress.Pending,
+		Reissued:      progress.Reissued,
+		Skipped:       progress.Skipped,
+		Emailed:       progress.Emailed,
+		Batches:       progress.Batches,
+		FailedBatches: progress.FailedBatches,
+		CreatedAt:     reissue.CreatedAt,
+	}
+}
@@ -0,0 +1,34 @@
+package response
+
+import (
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// WaitlistEntryResponse represents a buyer's place on the waitlist of a ticket tier
+// Position counts from 1 while waiting; an offered entry holds its seats until OfferExpiresAt
+type WaitlistEntryResponse struct {
+	ID             string     `json:"id"`
+	EventID        string     `json:"event_id"`
+	TicketTierID   string     `json:"ticket_tier_id"`
+	Quantity       int        `json:"quantity"`
+	Status         string     `json:"status"`
+	Position       int        `json:"position,omitempty"`
+	OfferExpiresAt *time.Time `json:"offer_expires_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// ToWaitlistEntryResponse converts WaitlistEntry entity to response
+func ToWaitlistEntryResponse(entry *entity.WaitlistEntry, position int) *WaitlistEntryResponse {
+	return &WaitlistEntryResponse{
+		ID:             entry.ID,
+		EventID:        entry.EventID,
+		TicketTierID:   entry.TicketTierID,
+		Quantity:       entry.Quantity,
+		Status:         entry.Status,
+		Position:       position,
+		OfferExpiresAt: entry.OfferExpiresAt,
+		CreatedAt:      entry.CreatedAt,
+	}
+}
@@ -0,0 +1,221 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// accommodationColumns lists the columns selected for an accommodation
+const accommodationColumns = `id, order_id, tenant_id, event_id, ticket_tier_id, ticket_id, type, notes, accessible_seat, created_at`
+
+// AccommodationRepository defines interface for accessibility accommodation data operations
+type AccommodationRepository interface {
+	CreateBatchWithTx(ctx context.Context, tx *sql.Tx, accommodations []entity.Accommodation) error
+	GetByOrderID(ctx context.Context, orderID string) ([]entity.Accommodation, error)
+	GetByTicketIDs(ctx context.Context, ticketIDs []string) ([]entity.Accommodation, error)
+	AssignTicketsWithTx(ctx context.Context, tx *sql.Tx, accommodations []entity.Accommodation) error
+	CountByEventID(ctx context.Context, eventID string) ([]entity.AccommodationCount, error)
+	GetAccessibleInventory(ctx context.Context, eventID string) ([]entity.AccessibleInventory, error)
+	ListAttendees(ctx context.Context, eventID string, accommodationsOnly bool, limit, offset int) ([]entity.Attendee, int64, error)
+}
+
+// accommodationRepository implements AccommodationRepository interface
+type accommodationRepository struct {
+	db *sqlx.DB
+}
+
+// NewAccommodationRepository creates new accommodation repository instance
+func NewAccommodationRepository(db *sqlx.DB) AccommodationRepository {
+	return &accommodationRepository{db: db}
+}
+
+// CreateBatchWithTx inserts the accommodations requested with an order (must be called within a transaction)
+func (r *accommodationRepository) CreateBatchWithTx(ctx context.Context, tx *sql.Tx, accommodations []entity.Accommodation) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO order_accommodations (
+			id, order_id, tenant_id, event_id, ticket_tier_id, type, notes, accessible_seat, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+		RETURNING created_at
+	`
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to prepare statement: %w", err)
+	}
+	defer stmt.Close()
+
+	for i := range accommodations {
+		accommodations[i].ID = uuid.New().String()
+
+		err := stmt.QueryRowContext(ctx,
+			accommodations[i].ID,
+			accommodations[i].OrderID,
+			accommodations[i].TenantID,
+			accommodations[i].EventID,
+			accommodations[i].TicketTierID,
+			accommodations[i].Type,
+			accommodations[i].Notes,
+			accommodations[i].AccessibleSeat,
+		).Scan(&accommodations[i].CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to insert accommodation: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetByOrderID retrieves the accommodations requested with an order in request order
+func (r *accommodationRepository) GetByOrderID(ctx context.Context, orderID string) ([]entity.Accommodation, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + accommodationColumns + ` FROM order_accommodations WHERE order_id = $1 ORDER BY created_at, id`
+
+	accommodations := []entity.Accommodation{}
+	if err := r.db.SelectContext(ctx, &accommodations, query, orderID); err != nil {
+		return nil, fmt.Errorf("failed to get order accommodations: %w", err)
+	}
+
+	return accommodations, nil
+}
+
+// GetByTicketIDs retrieves the accommodations assigned to tickets
+func (r *accommodationRepository) GetByTicketIDs(ctx context.Context, ticketIDs []string) ([]entity.Accommodation, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	accommodations := []entity.Accommodation{}
+	if len(ticketIDs) == 0 {
+		return accommodations, nil
+	}
+
+	query := `SELECT ` + accommodationColumns + ` FROM order_accommodations WHERE ticket_id = ANY($1::uuid[])`
+
+	if err := r.db.SelectContext(ctx, &accommodations, query, pq.Array(ticketIDs)); err != nil {
+		return nil, fmt.Errorf("failed to get ticket accommodations: %w", err)
+	}
+
+	return accommodations, nil
+}
+
+// AssignTicketsWithTx records the ticket each accommodation was issued with (must be called within a transaction)
+// Accommodations already assigned keep their ticket
+func (r *accommodationRepository) AssignTicketsWithTx(ctx context.Context, tx *sql.Tx, accommodations []entity.Accommodation) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `UPDATE order_accommodations SET ticket_id = $2 WHERE id = $1 AND ticket_id IS NULL`
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to prepare statement: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, accommodation := range accommodations {
+		if accommodation.TicketID == nil {
+			continue
+		}
+		if _, err := stmt.ExecContext(ctx, accommodation.ID, *accommodation.TicketID); err != nil {
+			return fmt.Errorf("failed to assign accommodation ticket: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// CountByEventID counts the accommodations of an event's valid and used tickets by type
+func (r *accommodationRepository) CountByEventID(ctx context.Context, eventID string) ([]entity.AccommodationCount, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT a.type, COUNT(*) AS count
+		FROM order_accommodations a
+		JOIN tickets t ON t.id = a.ticket_id
+		WHERE a.event_id = $1 AND t.status IN ($2, $3)
+		GROUP BY a.type
+	`
+
+	counts := []entity.AccommodationCount{}
+	err := r.db.SelectContext(ctx, &counts, query, eventID, entity.TicketStatusValid, entity.TicketStatusUsed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count accommodations: %w", err)
+	}
+
+	return counts, nil
+}
+
+// GetAccessibleInventory retrieves the accessible seating of an event's tiers that hold any
+// Read from the local database like the other inventory operations
+func (r *accommodationRepository) GetAccessibleInventory(ctx context.Context, eventID string) ([]entity.AccessibleInventory, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, name, accessible_quota, accessible_sold_count
+		FROM ticket_tiers
+		WHERE event_id = $1 AND (accessible_quota > 0 OR accessible_sold_count > 0)
+		ORDER BY price ASC
+	`
+
+	inventory := []entity.AccessibleInventory{}
+	if err := r.db.SelectContext(ctx, &inventory, query, eventID); err != nil {
+		return nil, fmt.Errorf("failed to get accessible inventory: %w", err)
+	}
+
+	return inventory, nil
+}
+
+// ListAttendees retrieves the valid and used tickets of an event with their holders, ordered by ticket number
+// With accommodationsOnly only tickets with an accommodation request are returned
+func (r *accommodationRepository) ListAttendees(ctx context.Context, eventID string, accommodationsOnly bool, limit, offset int) ([]entity.Attendee, int64, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	filter := `
+		WHERE t.event_id = $1 AND t.status IN ($2, $3)
+		  AND (NOT $4 OR EXISTS (SELECT 1 FROM order_accommodations a WHERE a.ticket_id = t.id))
+	`
+
+	var total int64
+	countQuery := `SELECT COUNT(*) FROM tickets t` + filter
+	err := r.db.GetContext(ctx, &total, countQuery,
+		eventID, entity.TicketStatusValid, entity.TicketStatusUsed, accommodationsOnly)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count attendees: %w", err)
+	}
+
+	query := `
+		SELECT t.id AS ticket_id, t.ticket_number, t.order_id, t.ticket_tier_id,
+		       COALESCE(tt.name, '') AS tier_name, t.status, t.validated_at,
+		       COALESCE(u.full_name, '') AS holder_name, COALESCE(u.email, '') AS holder_email
+		FROM tickets t
+		LEFT JOIN ticket_tiers tt ON tt.id = t.ticket_tier_id
+		LEFT JOIN users u ON u.id = t.user_id
+	` + filter + `
+		ORDER BY t.ticket_number ASC
+		LIMIT $5 OFFSET $6
+	`
+
+	attendees := []entity.Attendee{}
+	err = r.db.SelectContext(ctx, &attendees, query,
+		eventID, entity.TicketStatusValid, entity.TicketStatusUsed, accommodationsOnly, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list attendees: %w", err)
+	}
+
+	return attendees, total, nil
+}
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// ArchivableOrderStatuses are terminal order statuses eligible for archival
+var ArchivableOrderStatuses = []string{
+	entity.OrderStatusExpired,
+	entity.OrderStatusCancelled,
+	entity.OrderStatusCompleted,
+	entity.OrderStatusRefunded,
+}
+
+// ArchiveRepository defines interface for moving old orders to archive tables
+type ArchiveRepository interface {
+	ArchiveOrders(ctx context.Context, before time.Time, batchSize int) (int, error)
+}
+
+// archiveRepository implements ArchiveRepository interface
+type archiveRepository struct {
+	db *sqlx.DB
+}
+
+// NewArchiveRepository creates new archive repository instance
+func NewArchiveRepository(db *sqlx.DB) ArchiveRepository {
+	return &archiveRepository{db: db}
+}
+
+// ArchiveOrders moves one batch of terminal orders last updated before the cutoff
+// (with their order items and tickets) into the archive tables
+// Runs in a single transaction: rows are either fully archived or left untouched
+// SKIP LOCKED lets multiple instances archive concurrently without blocking each other
+func (r *archiveRepository) ArchiveOrders(ctx context.Context, before time.Time, batchSize int) (int, error) {
+	tx, err := r.db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	selectQuery := `
+		SELECT id
+		FROM orders
+		WHERE status = ANY($1) AND updated_at < $2
+		ORDER BY updated_at ASC
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, err := tx.QueryContext(ctx, selectQuery, pq.Array(ArchivableOrderStatuses), before, batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to select orders to archive: %w", err)
+	}
+
+	orderIDs := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan order id: %w", err)
+		}
+		orderIDs = append(orderIDs, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to iterate orders to archive: %w", err)
+	}
+
+	if len(orderIDs) == 0 {
+		return 0, nil
+	}
+
+	ids := pq.Array(orderIDs)
+
+	archives := []struct {
+		table  string
+		filter string
+		desc   string
+	}{
+		{"orders", "id = ANY($1)", "archive orders"},
+		{"order_items", "order_id = ANY($1)", "archive order items"},
+		{"tickets", "order_id = ANY($1)", "archive tickets"},
+	}
+	for _, archive := range archives {
+		columns, err := liveColumns(ctx, tx, archive.table)
+		if err != nil {
+			return 0, err
+		}
+		query := fmt.Sprintf(`INSERT INTO %s_archive (%s, archived_at) SELECT %s, NOW() FROM %s WHERE %s`,
+			archive.table, columns, columns, archive.table, archive.filter)
+		if _, err := tx.ExecContext(ctx, query, ids); err != nil {
+			return 0, fmt.Errorf("failed to %s: %w", archive.desc, err)
+		}
+	}
+
+	statements := []struct {
+		query string
+		desc  string
+	}{
+		{`DELETE FROM tickets WHERE order_id = ANY($1)`, "delete archived tickets"},
+		{`DELETE FROM order_items WHERE order_id = ANY($1)`, "delete archived order items"},
+		{`DELETE FROM orders WHERE id = ANY($1)`, "delete archived orders"},
+	}
+
+	for _, stmt := range statements {
+		if _, err := tx.ExecContext(ctx, stmt.query, ids); err != nil {
+			return 0, fmt.Errorf("failed to %s: %w", stmt.desc, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit archival: %w", err)
+	}
+
+	return len(orderIDs), nil
+}
+
+// liveColumns returns the quoted, comma separated columns of a live table
+// Rows are archived by column name, so archive tables only need the same columns, in any order;
+// a column missing from the archive table fails the archival instead of being dropped
+func liveColumns(ctx context.Context, tx *sql.Tx, table string) (string, error) {
+	query := `
+		SELECT column_name
+		FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = $1
+		ORDER BY ordinal_position
+	`
+
+	rows, err := tx.QueryContext(ctx, query, table)
+	if err != nil {
+		return "", fmt.Errorf("failed to get columns of %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	columns := []string{}
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return "", fmt.Errorf("failed to scan column of %s: %w", table, err)
+		}
+		columns = append(columns, pq.QuoteIdentifier(column))
+	}
+	if err := rows.Err(); err != nil {
+		return "", fmt.Errorf("failed to get columns of %s: %w", table, err)
+	}
+	if len(columns) == 0 {
+		return "", fmt.Errorf("table %s has no columns", table)
+	}
+
+	return strings.Join(columns, ", "), nil
+}
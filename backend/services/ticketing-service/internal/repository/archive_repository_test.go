@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestArchiveOrders_MovesOnlyOldTerminalOrders tests that archival moves old terminal
+// orders out of the live table while keeping them readable through OrderRepository
+func TestArchiveOrders_MovesOnlyOldTerminalOrders(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	// Setup
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+
+	TruncateTables(t, db, "orders_archive", "order_items_archive", "tickets_archive", "orders", "events")
+
+	archiveRepo := NewArchiveRepository(db)
+	orderRepo := NewOrderRepository(db)
+	ctx := context.Background()
+
+	eventID := CreateTestEvent(t, db)
+	now := time.Now()
+
+	// Order 1: Expired 1 year ago (should be archived)
+	oldExpired := createTestOrder(t, db, eventID, now.Add(-365*24*time.Hour))
+	_, err := db.Exec("UPDATE orders SET status = $1, updated_at = $2 WHERE id = $3",
+		entity.OrderStatusExpired, now.Add(-365*24*time.Hour), oldExpired)
+	require.NoError(t, err)
+
+	// Order 2: Paid 1 year ago (should NOT be archived, not terminal)
+	oldPaid := createTestOrder(t, db, eventID, now.Add(-365*24*time.Hour))
+	_, err = db.Exec("UPDATE orders SET status = $1, updated_at = $2 WHERE id = $3",
+		entity.OrderStatusPaid, now.Add(-365*24*time.Hour), oldPaid)
+	require.NoError(t, err)
+
+	// Order 3: Cancelled today (should NOT be archived, too recent)
+	recentCancelled := createTestOrder(t, db, eventID, now)
+	_, err = db.Exec("UPDATE orders SET status = $1 WHERE id = $2",
+		entity.OrderStatusCancelled, recentCancelled)
+	require.NoError(t, err)
+
+	// Archive orders older than 6 months
+	count, err := archiveRepo.ArchiveOrders(ctx, now.Add(-180*24*time.Hour), 100)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count, "Only the old expired order should be archived")
+
+	var liveCount int
+	err = db.Get(&liveCount, "SELECT COUNT(*) FROM orders WHERE id = $1", oldExpired)
+	require.NoError(t, err)
+	assert.Equal(t, 0, liveCount, "Archived order must be removed from live table")
+
+	// Archived order is still readable through order repository
+	order, err := orderRepo.GetByID(ctx, oldExpired)
+	require.NoError(t, err)
+	assert.Equal(t, entity.OrderStatusExpired, order.Status)
+
+	// Running again must not archive anything else
+	count, err = archiveRepo.ArchiveOrders(ctx, now.Add(-180*24*time.Hour), 100)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	t.Logf("✅ Archival moved only old terminal orders and kept them readable")
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+)
+
+// AvailabilityRepository defines interface for the event availability summary
+// (event_availability materialized view read by event-service listing)
+type AvailabilityRepository interface {
+	Refresh(ctx context.Context) error
+}
+
+// availabilityRepository implements AvailabilityRepository interface
+type availabilityRepository struct {
+	db *sqlx.DB
+}
+
+// NewAvailabilityRepository creates new availability repository instance
+func NewAvailabilityRepository(db *sqlx.DB) AvailabilityRepository {
+	return &availabilityRepository{db: db}
+}
+
+// Refresh rebuilds per-event total quota, sold count and price range
+// CONCURRENTLY keeps the view readable for listing queries during refresh
+func (r *availabilityRepository) Refresh(ctx context.Context) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	if _, err := r.db.ExecContext(ctx, "REFRESH MATERIALIZED VIEW CONCURRENTLY event_availability"); err != nil {
+		return fmt.Errorf("failed to refresh event availability: %w", err)
+	}
+	return nil
+}
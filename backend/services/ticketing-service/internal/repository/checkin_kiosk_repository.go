@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+var (
+	ErrKioskNotFound = errors.New("kiosk not found")
+)
+
+// CheckinKioskRepository defines interface for check-in kiosk data operations
+type CheckinKioskRepository interface {
+	Create(ctx context.Context, kiosk *entity.CheckinKiosk) error
+	GetByID(ctx context.Context, id string) (*entity.CheckinKiosk, error)
+	ListByEventID(ctx context.Context, eventID string) ([]entity.CheckinKiosk, error)
+	Revoke(ctx context.Context, id string) error
+	TouchLastSeen(ctx context.Context, id string) error
+}
+
+// checkinKioskRepository implements CheckinKioskRepository interface
+type checkinKioskRepository struct {
+	db *sqlx.DB
+}
+
+// NewCheckinKioskRepository creates new check-in kiosk repository instance
+func NewCheckinKioskRepository(db *sqlx.DB) CheckinKioskRepository {
+	return &checkinKioskRepository{db: db}
+}
+
+// Create inserts a new kiosk
+func (r *checkinKioskRepository) Create(ctx context.Context, kiosk *entity.CheckinKiosk) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO checkin_kiosks (id, tenant_id, event_id, name, registered_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		RETURNING created_at
+	`
+
+	if kiosk.ID == "" {
+		kiosk.ID = uuid.New().String()
+	}
+
+	err := r.db.QueryRowContext(ctx, query, kiosk.ID, kiosk.TenantID, kiosk.EventID, kiosk.Name, kiosk.RegisteredBy).Scan(&kiosk.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create kiosk: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves kiosk by ID
+func (r *checkinKioskRepository) GetByID(ctx context.Context, id string) (*entity.CheckinKiosk, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, tenant_id, event_id, name, registered_by, last_seen_at, revoked_at, created_at
+		FROM checkin_kiosks
+		WHERE id = $1
+	`
+
+	kiosk := &entity.CheckinKiosk{}
+	err := r.db.GetContext(ctx, kiosk, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrKioskNotFound
+		}
+		return nil, fmt.Errorf("failed to get kiosk: %w", err)
+	}
+
+	return kiosk, nil
+}
+
+// ListByEventID retrieves every kiosk of an event, revoked ones included
+func (r *checkinKioskRepository) ListByEventID(ctx context.Context, eventID string) ([]entity.CheckinKiosk, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, tenant_id, event_id, name, registered_by, last_seen_at, revoked_at, created_at
+		FROM checkin_kiosks
+		WHERE event_id = $1
+		ORDER BY created_at ASC
+	`
+
+	kiosks := []entity.CheckinKiosk{}
+	if err := r.db.SelectContext(ctx, &kiosks, query, eventID); err != nil {
+		return nil, fmt.Errorf("failed to list kiosks: %w", err)
+	}
+
+	return kiosks, nil
+}
+
+// Revoke revokes an active kiosk; its device token stops working immediately
+func (r *checkinKioskRepository) Revoke(ctx context.Context, id string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE checkin_kiosks
+		SET revoked_at = NOW()
+		WHERE id = $1 AND revoked_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to revoke kiosk: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrKioskNotFound
+	}
+
+	return nil
+}
+
+// TouchLastSeen records that the kiosk just checked in an attendee
+func (r *checkinKioskRepository) TouchLastSeen(ctx context.Context, id string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `UPDATE checkin_kiosks SET last_seen_at = NOW() WHERE id = $1`
+
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to update kiosk last seen: %w", err)
+	}
+
+	return nil
+}
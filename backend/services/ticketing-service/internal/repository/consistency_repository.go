@@ -0,0 +1,251 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// HeldOrderStatuses are order statuses whose items count towards ticket tier sold_count
+// Expiry and cancellation release the quantity; voided tickets keep it
+var HeldOrderStatuses = []string{
+	entity.OrderStatusReserved,
+	entity.OrderStatusPaid,
+	entity.OrderStatusCompleted,
+}
+
+// ConsistencyRepository defines interface for finding and healing data inconsistencies
+// Payments are read from payment-service's payment_transactions table in the shared database
+type ConsistencyRepository interface {
+	FindPaidOrdersWithoutTickets(ctx context.Context, settledBefore time.Time, limit int) ([]entity.Discrepancy, error)
+	FindTicketCountMismatches(ctx context.Context, settledBefore time.Time, limit int) ([]entity.Discrepancy, error)
+	FindSoldCountMismatches(ctx context.Context, limit int) ([]entity.Discrepancy, error)
+	FindPaidPaymentsWithoutPaidOrder(ctx context.Context, settledBefore time.Time, limit int) ([]entity.Discrepancy, error)
+	FindInventoryLedgerMismatches(ctx context.Context, limit int) ([]entity.Discrepancy, error)
+	HealSoldCount(ctx context.Context, tierID string) (bool, error)
+}
+
+// consistencyRepository implements ConsistencyRepository interface
+type consistencyRepository struct {
+	db *sqlx.DB
+}
+
+// NewConsistencyRepository creates new consistency repository instance
+func NewConsistencyRepository(db *sqlx.DB) ConsistencyRepository {
+	return &consistencyRepository{db: db}
+}
+
+// FindPaidOrdersWithoutTickets finds paid orders last updated before the cutoff that have no tickets
+func (r *consistencyRepository) FindPaidOrdersWithoutTickets(ctx context.Context, settledBefore time.Time, limit int) ([]entity.Discrepancy, error) {
+	query := `
+		SELECT o.id AS entity_id, SUM(oi.quantity) AS expected, 0 AS actual, '' AS detail
+		FROM orders o
+		JOIN order_items oi ON oi.order_id = o.id
+		WHERE o.status = $1 AND o.updated_at < $2
+		  AND NOT EXISTS (SELECT 1 FROM tickets t WHERE t.order_id = o.id)
+		GROUP BY o.id
+		ORDER BY o.id
+		LIMIT $3
+	`
+
+	discrepancies := []entity.Discrepancy{}
+	if err := r.db.SelectContext(ctx, &discrepancies, query, entity.OrderStatusPaid, settledBefore, limit); err != nil {
+		return nil, fmt.Errorf("failed to find paid orders without tickets: %w", err)
+	}
+
+	return withCheck(discrepancies, entity.CheckPaidOrderWithoutTickets), nil
+}
+
+// FindTicketCountMismatches finds paid and completed orders whose ticket count differs from their item quantities
+// Orders without any ticket are reported by FindPaidOrdersWithoutTickets
+func (r *consistencyRepository) FindTicketCountMismatches(ctx context.Context, settledBefore time.Time, limit int) ([]entity.Discrepancy, error) {
+	query := `
+		SELECT o.id AS entity_id, items.quantity AS expected, tickets.count AS actual, o.status AS detail
+		FROM orders o
+		JOIN (
+			SELECT order_id, SUM(quantity) AS quantity FROM order_items GROUP BY order_id
+		) items ON items.order_id = o.id
+		JOIN (
+			SELECT order_id, COUNT(*) AS count FROM tickets GROUP BY order_id
+		) tickets ON tickets.order_id = o.id
+		WHERE o.status = ANY($1) AND o.updated_at < $2
+		  AND items.quantity <> tickets.count
+		ORDER BY o.id
+		LIMIT $3
+	`
+
+	statuses := []string{entity.OrderStatusPaid, entity.OrderStatusCompleted}
+
+	discrepancies := []entity.Discrepancy{}
+	if err := r.db.SelectContext(ctx, &discrepancies, query, pq.Array(statuses), settledBefore, limit); err != nil {
+		return nil, fmt.Errorf("failed to find ticket count mismatches: %w", err)
+	}
+
+	return withCheck(discrepancies, entity.CheckTicketCountMismatch), nil
+}
+
+// FindSoldCountMismatches finds ticket tiers whose sold_count differs from the quantities
+// held by reserved, paid and completed orders (live and archived), pending invitations and waitlist offers
+func (r *consistencyRepository) FindSoldCountMismatches(ctx context.Context, limit int) ([]entity.Discrepancy, error) {
+	query := `
+		WITH held AS (
+			SELECT oi.ticket_tier_id, oi.quantity
+			FROM order_items oi
+			JOIN orders o ON o.id = oi.order_id
+			WHERE o.status = ANY($1)
+			UNION ALL
+			SELECT oi.ticket_tier_id, oi.quantity
+			FROM order_items_archive oi
+			JOIN orders_archive o ON o.id = oi.order_id
+			WHERE o.status = ANY($1)
+			UNION ALL
+			SELECT ticket_tier_id, quantity
+			FROM event_invitations
+			WHERE status = $3
+			UNION ALL
+			SELECT ticket_tier_id, quantity
+			FROM waitlist_entries
+			WHERE status = $4
+		)
+		SELECT tt.id AS entity_id, COALESCE(SUM(held.quantity), 0) AS expected, tt.sold_count AS actual, '' AS detail
+		FROM ticket_tiers tt
+		LEFT JOIN held ON held.ticket_tier_id = tt.id
+		GROUP BY tt.id, tt.sold_count
+		HAVING tt.sold_count <> COALESCE(SUM(held.quantity), 0)
+		ORDER BY tt.id
+		LIMIT $2
+	`
+
+	discrepancies := []entity.Discrepancy{}
+	if err := r.db.SelectContext(ctx, &discrepancies, query, pq.Array(HeldOrderStatuses), limit,
+		entity.InvitationStatusPending, entity.WaitlistStatusOffered); err != nil {
+		return nil, fmt.Errorf("failed to find sold count mismatches: %w", err)
+	}
+
+	return withCheck(discrepancies, entity.CheckSoldCountMismatch), nil
+}
+
+// FindPaidPaymentsWithoutPaidOrder finds paid payments last updated before the cutoff
+// whose order (live or archived) is neither paid nor completed
+func (r *consistencyRepository) FindPaidPaymentsWithoutPaidOrder(ctx context.Context, settledBefore time.Time, limit int) ([]entity.Discrepancy, error) {
+	query := `
+		SELECT p.id AS entity_id, 0 AS expected, 0 AS actual,
+		       'order ' || p.order_id || ' is ' || COALESCE(o.status, ao.status, 'missing') AS detail
+		FROM payment_transactions p
+		LEFT JOIN orders o ON o.id = p.order_id
+		LEFT JOIN orders_archive ao ON ao.id = p.order_id
+		WHERE p.status = 'paid' AND p.updated_at < $1
+		  AND COALESCE(o.status, ao.status, '') <> ALL($2)
+		ORDER BY p.id
+		LIMIT $3
+	`
+
+	statuses := []string{entity.OrderStatusPaid, entity.OrderStatusCompleted}
+
+	discrepancies := []entity.Discrepancy{}
+	if err := r.db.SelectContext(ctx, &discrepancies, query, settledBefore, pq.Array(statuses), limit); err != nil {
+		return nil, fmt.Errorf("failed to find paid payments without paid order: %w", err)
+	}
+
+	return withCheck(discrepancies, entity.CheckPaidPaymentWithoutPaidOrder), nil
+}
+
+// FindInventoryLedgerMismatches finds ticket tiers whose sold_count differs from the sum of their
+// inventory_movements, i.e. sold_count was changed without appending to the ledger
+func (r *consistencyRepository) FindInventoryLedgerMismatches(ctx context.Context, limit int) ([]entity.Discrepancy, error) {
+	query := `
+		SELECT tt.id AS entity_id, COALESCE(SUM(m.delta), 0) AS expected, tt.sold_count AS actual, '' AS detail
+		FROM ticket_tiers tt
+		LEFT JOIN inventory_movements m ON m.ticket_tier_id = tt.id
+		GROUP BY tt.id, tt.sold_count
+		HAVING tt.sold_count <> COALESCE(SUM(m.delta), 0)
+		ORDER BY tt.id
+		LIMIT $1
+	`
+
+	discrepancies := []entity.Discrepancy{}
+	if err := r.db.SelectContext(ctx, &discrepancies, query, limit); err != nil {
+		return nil, fmt.Errorf("failed to find inventory ledger mismatches: %w", err)
+	}
+
+	return withCheck(discrepancies, entity.CheckInventoryLedgerMismatch), nil
+}
+
+// HealSoldCount recomputes a tier's sold_count from the quantities held by orders, pending invitations and waitlist offers
+// The tier row is locked first, so reservations and releases (which update the same row)
+// are either fully counted or not started; a result above quota is left for manual review
+// The correction is appended to the inventory ledger as an adjustment
+// Returns whether sold_count was changed
+func (r *consistencyRepository) HealSoldCount(ctx context.Context, tierID string) (bool, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var soldCount, quota int64
+	lockQuery := `SELECT sold_count, quota FROM ticket_tiers WHERE id = $1 FOR UPDATE`
+	if err := tx.QueryRowxContext(ctx, lockQuery, tierID).Scan(&soldCount, &quota); err != nil {
+		return false, fmt.Errorf("failed to lock ticket tier: %w", err)
+	}
+
+	// Runs after the lock is held, so it sees every order committed by a previous lock holder
+	heldQuery := `
+		SELECT COALESCE(SUM(quantity), 0) FROM (
+			SELECT oi.quantity
+			FROM order_items oi
+			JOIN orders o ON o.id = oi.order_id
+			WHERE oi.ticket_tier_id = $1 AND o.status = ANY($2)
+			UNION ALL
+			SELECT oi.quantity
+			FROM order_items_archive oi
+			JOIN orders_archive o ON o.id = oi.order_id
+			WHERE oi.ticket_tier_id = $1 AND o.status = ANY($2)
+			UNION ALL
+			SELECT quantity
+			FROM event_invitations
+			WHERE ticket_tier_id = $1 AND status = $3
+			UNION ALL
+			SELECT quantity
+			FROM waitlist_entries
+			WHERE ticket_tier_id = $1 AND status = $4
+		) held
+	`
+
+	var held int64
+	if err := tx.QueryRowxContext(ctx, heldQuery, tierID, pq.Array(HeldOrderStatuses), entity.InvitationStatusPending, entity.WaitlistStatusOffered).Scan(&held); err != nil {
+		return false, fmt.Errorf("failed to sum held quantities: %w", err)
+	}
+
+	if held == soldCount || held > quota {
+		return false, nil
+	}
+
+	updateQuery := `UPDATE ticket_tiers SET sold_count = $1, updated_at = NOW() WHERE id = $2`
+	if _, err := tx.ExecContext(ctx, updateQuery, held, tierID); err != nil {
+		return false, fmt.Errorf("failed to update sold count: %w", err)
+	}
+
+	ledgerQuery := `INSERT INTO inventory_movements (ticket_tier_id, delta, reason) VALUES ($1, $2, $3)`
+	if _, err := tx.ExecContext(ctx, ledgerQuery, tierID, held-soldCount, entity.InventoryReasonAdjust); err != nil {
+		return false, fmt.Errorf("failed to record sold count adjustment: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+// withCheck sets the check name on discrepancies read from the database
+func withCheck(discrepancies []entity.Discrepancy, check string) []entity.Discrepancy {
+	for i := range discrepancies {
+		discrepancies[i].Check = check
+	}
+	return discrepancies
+}
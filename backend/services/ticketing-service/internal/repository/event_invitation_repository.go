@@ -0,0 +1,326 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+var (
+	ErrInvitationNotFound = errors.New("invitation not found")
+)
+
+// invitationColumns lists the columns selected for an invitation
+const invitationColumns = `id, batch_id, event_id, ticket_tier_id, email, name, quantity, price, status,
+	claim_deadline, order_id, claimed_by, claimed_at, released_at, email_sent_at, created_by, created_at, updated_at`
+
+// EventInvitationRepository defines interface for event invitation data operations
+type EventInvitationRepository interface {
+	CreateBatchWithTx(ctx context.Context, tx *sql.Tx, invitations []entity.EventInvitation) error
+	GetByID(ctx context.Context, id string) (*entity.EventInvitation, error)
+	GetByIDWithLock(ctx context.Context, tx *sql.Tx, id string) (*entity.EventInvitation, error)
+	ListByEventID(ctx context.Context, eventID string) ([]entity.EventInvitation, error)
+	CountByEventID(ctx context.Context, eventID string) (*entity.InvitationSummary, error)
+	FindOpenEmails(ctx context.Context, eventID string, emails []string) ([]string, error)
+	ListUnsentByBatch(ctx context.Context, batchID string) ([]entity.EventInvitation, error)
+	MarkEmailSent(ctx context.Context, id string) error
+	ClaimWithTx(ctx context.Context, tx *sql.Tx, id, userID, orderID string) error
+	UnclaimWithTx(ctx context.Context, tx *sql.Tx, id string) error
+	ReleaseExpiredByBatchWithTx(ctx context.Context, tx *sql.Tx, batchID string, now time.Time) ([]entity.EventInvitation, error)
+}
+
+// eventInvitationRepository implements EventInvitationRepository interface
+type eventInvitationRepository struct {
+	db *sqlx.DB
+}
+
+// NewEventInvitationRepository creates new event invitation repository instance
+func NewEventInvitationRepository(db *sqlx.DB) EventInvitationRepository {
+	return &eventInvitationRepository{db: db}
+}
+
+// CreateBatchWithTx inserts the invitations of an import (must be called within a transaction)
+func (r *eventInvitationRepository) CreateBatchWithTx(ctx context.Context, tx *sql.Tx, invitations []entity.EventInvitation) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO event_invitations (
+			id, batch_id, event_id, ticket_tier_id, email, name, quantity, price, status,
+			claim_deadline, created_by, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to prepare statement: %w", err)
+	}
+	defer stmt.Close()
+
+	for i := range invitations {
+		invitations[i].ID = uuid.New().String()
+		invitations[i].Status = entity.InvitationStatusPending
+
+		err := stmt.QueryRowContext(ctx,
+			invitations[i].ID,
+			invitations[i].BatchID,
+			invitations[i].EventID,
+			invitations[i].TicketTierID,
+			invitations[i].Email,
+			invitations[i].Name,
+			invitations[i].Quantity,
+			invitations[i].Price,
+			invitations[i].Status,
+			invitations[i].ClaimDeadline,
+			invitations[i].CreatedBy,
+		).Scan(&invitations[i].CreatedAt, &invitations[i].UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to insert invitation: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetByID retrieves invitation by ID
+func (r *eventInvitationRepository) GetByID(ctx context.Context, id string) (*entity.EventInvitation, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + invitationColumns + ` FROM event_invitations WHERE id = $1`
+
+	invitation := &entity.EventInvitation{}
+	if err := r.db.GetContext(ctx, invitation, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvitationNotFound
+		}
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+
+	return invitation, nil
+}
+
+// GetByIDWithLock retrieves invitation by ID with row-level lock (must be called within a transaction)
+func (r *eventInvitationRepository) GetByIDWithLock(ctx context.Context, tx *sql.Tx, id string) (*entity.EventInvitation, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + invitationColumns + ` FROM event_invitations WHERE id = $1 FOR UPDATE`
+
+	invitation := &entity.EventInvitation{}
+	err := tx.QueryRowContext(ctx, query, id).Scan(
+		&invitation.ID,
+		&invitation.BatchID,
+		&invitation.EventID,
+		&invitation.TicketTierID,
+		&invitation.Email,
+		&invitation.Name,
+		&invitation.Quantity,
+		&invitation.Price,
+		&invitation.Status,
+		&invitation.ClaimDeadline,
+		&invitation.OrderID,
+		&invitation.ClaimedBy,
+		&invitation.ClaimedAt,
+		&invitation.ReleasedAt,
+		&invitation.EmailSentAt,
+		&invitation.CreatedBy,
+		&invitation.CreatedAt,
+		&invitation.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvitationNotFound
+		}
+		return nil, fmt.Errorf("failed to get invitation with lock: %w", err)
+	}
+
+	return invitation, nil
+}
+
+// ListByEventID retrieves the invitations of an event, newest import first
+func (r *eventInvitationRepository) ListByEventID(ctx context.Context, eventID string) ([]entity.EventInvitation, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + invitationColumns + ` FROM event_invitations WHERE event_id = $1 ORDER BY created_at DESC, email`
+
+	invitations := []entity.EventInvitation{}
+	if err := r.db.SelectContext(ctx, &invitations, query, eventID); err != nil {
+		return nil, fmt.Errorf("failed to list invitations: %w", err)
+	}
+
+	return invitations, nil
+}
+
+// CountByEventID counts the invitations of an event by status
+func (r *eventInvitationRepository) CountByEventID(ctx context.Context, eventID string) (*entity.InvitationSummary, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT
+			COUNT(*) FILTER (WHERE status = $2) AS pending,
+			COUNT(*) FILTER (WHERE status = $3) AS claimed,
+			COUNT(*) FILTER (WHERE status = $4) AS released,
+			COUNT(*) FILTER (WHERE status = $2 AND email_sent_at IS NULL) AS unsent
+		FROM event_invitations
+		WHERE event_id = $1
+	`
+
+	summary := &entity.InvitationSummary{}
+	err := r.db.GetContext(ctx, summary, query, eventID,
+		entity.InvitationStatusPending, entity.InvitationStatusClaimed, entity.InvitationStatusReleased)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count invitations: %w", err)
+	}
+
+	return summary, nil
+}
+
+// FindOpenEmails returns which of the emails (lowercase) already have a pending or claimed invitation to the event
+func (r *eventInvitationRepository) FindOpenEmails(ctx context.Context, eventID string, emails []string) ([]string, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	open := []string{}
+	if len(emails) == 0 {
+		return open, nil
+	}
+
+	query := `
+		SELECT DISTINCT LOWER(email)
+		FROM event_invitations
+		WHERE event_id = $1 AND LOWER(email) = ANY($2) AND status IN ($3, $4)
+	`
+
+	err := r.db.SelectContext(ctx, &open, query, eventID, pq.Array(emails),
+		entity.InvitationStatusPending, entity.InvitationStatusClaimed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find open invitations: %w", err)
+	}
+
+	return open, nil
+}
+
+// ListUnsentByBatch retrieves the pending invitations of an import whose email was not sent yet
+func (r *eventInvitationRepository) ListUnsentByBatch(ctx context.Context, batchID string) ([]entity.EventInvitation, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + invitationColumns + `
+		FROM event_invitations
+		WHERE batch_id = $1 AND status = $2 AND email_sent_at IS NULL
+		ORDER BY created_at, id
+	`
+
+	invitations := []entity.EventInvitation{}
+	if err := r.db.SelectContext(ctx, &invitations, query, batchID, entity.InvitationStatusPending); err != nil {
+		return nil, fmt.Errorf("failed to list unsent invitations: %w", err)
+	}
+
+	return invitations, nil
+}
+
+// MarkEmailSent records that the invitation email was sent
+func (r *eventInvitationRepository) MarkEmailSent(ctx context.Context, id string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `UPDATE event_invitations SET email_sent_at = NOW(), updated_at = NOW() WHERE id = $1`
+
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to mark invitation email sent: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimWithTx marks a pending invitation claimed by the user's order (must be called within a transaction)
+func (r *eventInvitationRepository) ClaimWithTx(ctx context.Context, tx *sql.Tx, id, userID, orderID string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE event_invitations
+		SET status = $2, claimed_by = $3, order_id = $4, claimed_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND status = $5
+	`
+
+	result, err := tx.ExecContext(ctx, query, id, entity.InvitationStatusClaimed, userID, orderID, entity.InvitationStatusPending)
+	if err != nil {
+		return fmt.Errorf("failed to claim invitation: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrInvitationNotFound
+	}
+
+	return nil
+}
+
+// UnclaimWithTx returns a claimed invitation to pending, e.g. when its order could not be invoiced
+// (must be called within a transaction)
+func (r *eventInvitationRepository) UnclaimWithTx(ctx context.Context, tx *sql.Tx, id string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE event_invitations
+		SET status = $2, claimed_by = NULL, order_id = NULL, claimed_at = NULL, updated_at = NOW()
+		WHERE id = $1 AND status = $3
+	`
+
+	if _, err := tx.ExecContext(ctx, query, id, entity.InvitationStatusPending, entity.InvitationStatusClaimed); err != nil {
+		return fmt.Errorf("failed to unclaim invitation: %w", err)
+	}
+
+	return nil
+}
+
+// ReleaseExpiredByBatchWithTx marks the pending invitations of an import whose deadline passed
+// as released and returns them (must be called within a transaction)
+// Invitations locked by a concurrent claim are re-checked once the claim commits
+func (r *eventInvitationRepository) ReleaseExpiredByBatchWithTx(ctx context.Context, tx *sql.Tx, batchID string, now time.Time) ([]entity.EventInvitation, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE event_invitations
+		SET status = $2, released_at = NOW(), updated_at = NOW()
+		WHERE batch_id = $1 AND status = $3 AND claim_deadline <= $4
+		RETURNING id, ticket_tier_id, quantity
+	`
+
+	rows, err := tx.QueryContext(ctx, query, batchID, entity.InvitationStatusReleased, entity.InvitationStatusPending, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to release invitations: %w", err)
+	}
+	defer rows.Close()
+
+	released := []entity.EventInvitation{}
+	for rows.Next() {
+		invitation := entity.EventInvitation{BatchID: batchID, Status: entity.InvitationStatusReleased}
+		if err := rows.Scan(&invitation.ID, &invitation.TicketTierID, &invitation.Quantity); err != nil {
+			return nil, fmt.Errorf("failed to scan released invitation: %w", err)
+		}
+		released = append(released, invitation)
+	}
+
+	return released, rows.Err()
+}
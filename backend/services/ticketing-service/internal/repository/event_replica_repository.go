@@ -0,0 +1,224 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// EventReplicaRepository defines interface for the local copy of events and their tiers
+// kept up to date from event service's change feed (see migration 000017)
+type EventReplicaRepository interface {
+	GetEvent(ctx context.Context, id string) (*entity.Event, error)
+	GetTier(ctx context.Context, id string) (*entity.TicketTier, error)
+	Replace(ctx context.Context, event *entity.Event, tiers []entity.TicketTier) error
+	Delete(ctx context.Context, eventID string) error
+	GetCheckpoint(ctx context.Context, consumer string) (int64, error)
+	SaveCheckpoint(ctx context.Context, consumer string, seq int64) error
+}
+
+// eventReplicaRepository implements EventReplicaRepository interface
+type eventReplicaRepository struct {
+	db *sqlx.DB
+}
+
+// NewEventReplicaRepository creates new event replica repository instance
+func NewEventReplicaRepository(db *sqlx.DB) EventReplicaRepository {
+	return &eventReplicaRepository{db: db}
+}
+
+// GetEvent retrieves a replicated event by ID
+func (r *eventReplicaRepository) GetEvent(ctx context.Context, id string) (*entity.Event, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	var event entity.Event
+	query := `
+		SELECT id, title, slug, description, location, start_date, end_date, timezone,
+		       scan_policy, refund_policy, is_sandbox, dynamic_qr, banner_url, category, organizer_id, tenant_id, status, created_at, updated_at
+		FROM event_replicas
+		WHERE id = $1
+	`
+
+	if err := r.db.GetContext(ctx, &event, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event replica: %w", err)
+	}
+
+	return &event, nil
+}
+
+// GetTier retrieves a replicated ticket tier by ID
+// Only display fields (name, price) are replicated; inventory stays on ticket_tiers
+func (r *eventReplicaRepository) GetTier(ctx context.Context, id string) (*entity.TicketTier, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	var tier entity.TicketTier
+	query := `
+		SELECT id, event_id, name, price
+		FROM ticket_tier_replicas
+		WHERE id = $1
+	`
+
+	if err := r.db.GetContext(ctx, &tier, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTicketTierNotFound
+		}
+		return nil, fmt.Errorf("failed to get ticket tier replica: %w", err)
+	}
+
+	return &tier, nil
+}
+
+// Replace upserts an event and replaces its tiers in one transaction
+func (r *eventReplicaRepository) Replace(ctx context.Context, event *entity.Event, tiers []entity.TicketTier) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.NamedExecContext(ctx, `
+		INSERT INTO event_replicas (
+			id, tenant_id, organizer_id, title, slug, description, category, location,
+			start_date, end_date, timezone, scan_policy, refund_policy, is_sandbox, dynamic_qr, banner_url, status, created_at, updated_at
+		) VALUES (
+			:id, :tenant_id, :organizer_id, :title, :slug, :description, :category, :location,
+			:start_date, :end_date, :timezone, :scan_policy, :refund_policy, :is_sandbox, :dynamic_qr, :banner_url, :status, :created_at, :updated_at
+		)
+		ON CONFLICT (id) DO UPDATE SET
+			tenant_id = EXCLUDED.tenant_id,
+			organizer_id = EXCLUDED.organizer_id,
+			title = EXCLUDED.title,
+			slug = EXCLUDED.slug,
+			description = EXCLUDED.description,
+			category = EXCLUDED.category,
+			location = EXCLUDED.location,
+			start_date = EXCLUDED.start_date,
+			end_date = EXCLUDED.end_date,
+			timezone = EXCLUDED.timezone,
+			scan_policy = EXCLUDED.scan_policy,
+			refund_policy = EXCLUDED.refund_policy,
+			is_sandbox = EXCLUDED.is_sandbox,
+			dynamic_qr = EXCLUDED.dynamic_qr,
+			banner_url = EXCLUDED.banner_url,
+			status = EXCLUDED.status,
+			created_at = EXCLUDED.created_at,
+			updated_at = EXCLUDED.updated_at,
+			replicated_at = NOW()
+	`, event)
+	if err != nil {
+		return fmt.Errorf("failed to upsert event replica: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM ticket_tier_replicas WHERE event_id = $1`, event.ID); err != nil {
+		return fmt.Errorf("failed to clear ticket tier replicas: %w", err)
+	}
+
+	for _, tier := range tiers {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO ticket_tier_replicas (id, event_id, name, price)
+			VALUES ($1, $2, $3, $4)
+		`, tier.ID, event.ID, tier.Name, tier.Price)
+		if err != nil {
+			return fmt.Errorf("failed to insert ticket tier replica: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Delete removes a replicated event and its tiers
+func (r *eventReplicaRepository) Delete(ctx context.Context, eventID string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM event_replicas WHERE id = $1`, eventID); err != nil {
+		return fmt.Errorf("failed to delete event replica: %w", err)
+	}
+	return nil
+}
+
+// GetCheckpoint returns the last change sequence applied by consumer, 0 if none
+func (r *eventReplicaRepository) GetCheckpoint(ctx context.Context, consumer string) (int64, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	var seq int64
+	err := r.db.GetContext(ctx, &seq, `SELECT last_seq FROM replication_checkpoints WHERE consumer = $1`, consumer)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get replication checkpoint: %w", err)
+	}
+	return seq, nil
+}
+
+// SaveCheckpoint records seq as the last change sequence applied by consumer
+func (r *eventReplicaRepository) SaveCheckpoint(ctx context.Context, consumer string, seq int64) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO replication_checkpoints (consumer, last_seq, updated_at)
+		VALUES ($1, $2, NOW())
+		ON CONFLICT (consumer) DO UPDATE SET last_seq = EXCLUDED.last_seq, updated_at = NOW()
+	`, consumer, seq)
+	if err != nil {
+		return fmt.Errorf("failed to save replication checkpoint: %w", err)
+	}
+	return nil
+}
+
+// replicatedEventRepository serves event lookups from the replica and falls back
+// for events not replicated yet (created within the last replication interval)
+type replicatedEventRepository struct {
+	EventRepository
+	replica EventReplicaRepository
+}
+
+// NewReplicatedEventRepository creates an event repository reading the replica first
+func NewReplicatedEventRepository(replica EventReplicaRepository, fallback EventRepository) EventRepository {
+	return &replicatedEventRepository{EventRepository: fallback, replica: replica}
+}
+
+// GetByID retrieves event by ID from the replica, or from the fallback if missing
+func (r *replicatedEventRepository) GetByID(ctx context.Context, id string) (*entity.Event, error) {
+	if event, err := r.replica.GetEvent(ctx, id); err == nil {
+		return event, nil
+	}
+	return r.EventRepository.GetByID(ctx, id)
+}
+
+// replicatedTicketTierRepository serves tier display lookups from the replica and falls back
+// for tiers not replicated yet; every other operation goes to the fallback
+type replicatedTicketTierRepository struct {
+	TicketTierRepository
+	replica EventReplicaRepository
+}
+
+// NewReplicatedTicketTierRepository creates a ticket tier repository reading the replica first
+// GetByID only carries display fields (name, price) when served from the replica
+func NewReplicatedTicketTierRepository(replica EventReplicaRepository, fallback TicketTierRepository) TicketTierRepository {
+	return &replicatedTicketTierRepository{TicketTierRepository: fallback, replica: replica}
+}
+
+// GetByID retrieves ticket tier by ID from the replica, or from the fallback if missing
+func (r *replicatedTicketTierRepository) GetByID(ctx context.Context, id string) (*entity.TicketTier, error) {
+	if tier, err := r.replica.GetTier(ctx, id); err == nil {
+		return tier, nil
+	}
+	return r.TicketTierRepository.GetByID(ctx, id)
+}
@@ -3,8 +3,11 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
 )
 
@@ -15,6 +18,7 @@ var (
 // EventRepository defines interface for event data operations
 type EventRepository interface {
 	GetByID(ctx context.Context, id string) (*entity.Event, error)
+	GetByIDs(ctx context.Context, ids []string) ([]entity.Event, error)
 }
 
 // eventRepository implements EventRepository interface
@@ -29,12 +33,15 @@ func NewEventRepository(db *sqlx.DB) EventRepository {
 
 // GetByID retrieves event by ID using sqlx
 func (r *eventRepository) GetByID(ctx context.Context, id string) (*entity.Event, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	var event entity.Event
 	query := `
-		SELECT id, title, description,
+		SELECT id, title, slug, description,
 		       COALESCE(venue, location) as location,
-		       start_date, end_date,
-		       category, organizer_id, status, created_at, updated_at
+		       start_date, end_date, timezone, scan_policy, refund_policy, is_sandbox, dynamic_qr, banner_url,
+		       category, organizer_id, tenant_id, status, created_at, updated_at
 		FROM events
 		WHERE id = $1
 	`
@@ -49,3 +56,30 @@ func (r *eventRepository) GetByID(ctx context.Context, id string) (*entity.Event
 
 	return &event, nil
 }
+
+// GetByIDs retrieves events by IDs in a single query
+// Missing IDs are skipped rather than reported as errors
+func (r *eventRepository) GetByIDs(ctx context.Context, ids []string) ([]entity.Event, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	events := []entity.Event{}
+	if len(ids) == 0 {
+		return events, nil
+	}
+
+	query := `
+		SELECT id, title, slug, description,
+		       COALESCE(venue, location) as location,
+		       start_date, end_date, timezone, scan_policy, refund_policy, is_sandbox, dynamic_qr, banner_url,
+		       category, organizer_id, tenant_id, status, created_at, updated_at
+		FROM events
+		WHERE id = ANY($1)
+	`
+
+	if err := r.db.SelectContext(ctx, &events, query, pq.Array(ids)); err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+var (
+	ErrInsurancePolicyNotFound  = errors.New("insurance policy not found")
+	ErrInsurancePolicyNotActive = errors.New("insurance policy is not active")
+)
+
+// insurancePolicyColumns lists the columns selected for an insurance policy
+const insurancePolicyColumns = `id, order_id, tenant_id, provider, quote_id, policy_number, premium, coverage, currency,
+	       status, claim_id, claim_reason, issued_at, claimed_at, created_at, updated_at`
+
+// InsurancePolicyRepository defines interface for order insurance policy data operations
+type InsurancePolicyRepository interface {
+	CreateWithTx(ctx context.Context, tx *sql.Tx, policy *entity.InsurancePolicy) error
+	GetByOrderID(ctx context.Context, orderID string) (*entity.InsurancePolicy, error)
+	ListIssuable(ctx context.Context, limit int) ([]entity.InsurancePolicy, error)
+	MarkIssued(ctx context.Context, id, policyNumber string, issuedAt time.Time) error
+	MarkFailed(ctx context.Context, id string) error
+	MarkClaimed(ctx context.Context, id, claimID, reason string) error
+}
+
+// insurancePolicyRepository implements InsurancePolicyRepository interface
+type insurancePolicyRepository struct {
+	db *sqlx.DB
+}
+
+// NewInsurancePolicyRepository creates new insurance policy repository instance
+func NewInsurancePolicyRepository(db *sqlx.DB) InsurancePolicyRepository {
+	return &insurancePolicyRepository{db: db}
+}
+
+// CreateWithTx inserts a quoted policy with its order (must be called within a transaction)
+func (r *insurancePolicyRepository) CreateWithTx(ctx context.Context, tx *sql.Tx, policy *entity.InsurancePolicy) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO order_insurance_policies (
+			id, order_id, tenant_id, provider, quote_id, premium, coverage, currency, status, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`
+
+	if policy.ID == "" {
+		policy.ID = uuid.New().String()
+	}
+	policy.Status = entity.InsuranceStatusQuoted
+
+	err := tx.QueryRowContext(ctx, query,
+		policy.ID, policy.OrderID, policy.TenantID, policy.Provider, policy.QuoteID,
+		policy.Premium, policy.Coverage, policy.Currency, policy.Status,
+	).Scan(&policy.CreatedAt, &policy.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create insurance policy: %w", err)
+	}
+
+	return nil
+}
+
+// GetByOrderID retrieves the insurance policy of an order
+func (r *insurancePolicyRepository) GetByOrderID(ctx context.Context, orderID string) (*entity.InsurancePolicy, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + insurancePolicyColumns + ` FROM order_insurance_policies WHERE order_id = $1`
+
+	policy := &entity.InsurancePolicy{}
+	if err := r.db.GetContext(ctx, policy, query, orderID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInsurancePolicyNotFound
+		}
+		return nil, fmt.Errorf("failed to get insurance policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// ListIssuable retrieves quoted policies of paid orders, oldest first
+// Policies of orders that expired or were cancelled are never issued
+func (r *insurancePolicyRepository) ListIssuable(ctx context.Context, limit int) ([]entity.InsurancePolicy, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT p.id, p.order_id, p.tenant_id, p.provider, p.quote_id, p.policy_number, p.premium, p.coverage,
+		       p.currency, p.status, p.claim_id, p.claim_reason, p.issued_at, p.claimed_at, p.created_at, p.updated_at
+		FROM order_insurance_policies p
+		JOIN orders o ON o.id = p.order_id
+		WHERE p.status = $1 AND o.status IN ($2, $3)
+		ORDER BY p.created_at
+		LIMIT $4
+	`
+
+	policies := []entity.InsurancePolicy{}
+	err := r.db.SelectContext(ctx, &policies, query,
+		entity.InsuranceStatusQuoted, entity.OrderStatusPaid, entity.OrderStatusCompleted, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list issuable insurance policies: %w", err)
+	}
+
+	return policies, nil
+}
+
+// MarkIssued records the policy number the partner issued for a quoted policy
+func (r *insurancePolicyRepository) MarkIssued(ctx context.Context, id, policyNumber string, issuedAt time.Time) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE order_insurance_policies
+		SET status = $2, policy_number = $3, issued_at = $4, updated_at = NOW()
+		WHERE id = $1 AND status = $5
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, entity.InsuranceStatusActive, policyNumber, issuedAt, entity.InsuranceStatusQuoted); err != nil {
+		return fmt.Errorf("failed to mark insurance policy issued: %w", err)
+	}
+
+	return nil
+}
+
+// MarkFailed records that the partner refused to issue a quoted policy
+func (r *insurancePolicyRepository) MarkFailed(ctx context.Context, id string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE order_insurance_policies
+		SET status = $2, updated_at = NOW()
+		WHERE id = $1 AND status = $3
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, entity.InsuranceStatusFailed, entity.InsuranceStatusQuoted); err != nil {
+		return fmt.Errorf("failed to mark insurance policy failed: %w", err)
+	}
+
+	return nil
+}
+
+// MarkClaimed records a claim filed on an active policy
+// Returns ErrInsurancePolicyNotActive if the policy was claimed meanwhile
+func (r *insurancePolicyRepository) MarkClaimed(ctx context.Context, id, claimID, reason string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE order_insurance_policies
+		SET status = $2, claim_id = $3, claim_reason = $4, claimed_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND status = $5
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, entity.InsuranceStatusClaimed, claimID, reason, entity.InsuranceStatusActive)
+	if err != nil {
+		return fmt.Errorf("failed to mark insurance policy claimed: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return ErrInsurancePolicyNotActive
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// IntegrationFilter selects the records returned by a polling trigger
+type IntegrationFilter struct {
+	OrganizerID  string
+	EventID      string    // Optional, every event of the organizer when empty
+	Status       string    // Optional, order status (orders) or ticket status (attendees)
+	UpdatedSince time.Time // Optional, records changed at or after
+	Limit        int
+}
+
+// IntegrationRepository defines interface for the polling triggers of partner integrations
+// Every list is scoped to the events of one organizer and returned newest first
+type IntegrationRepository interface {
+	ListOrders(ctx context.Context, filter IntegrationFilter) ([]entity.IntegrationOrder, error)
+	ListAttendees(ctx context.Context, filter IntegrationFilter) ([]entity.IntegrationAttendee, error)
+	ListCheckIns(ctx context.Context, filter IntegrationFilter) ([]entity.IntegrationCheckIn, error)
+}
+
+// integrationRepository implements IntegrationRepository interface
+type integrationRepository struct {
+	db *sqlx.DB
+}
+
+// NewIntegrationRepository creates new integration repository instance
+func NewIntegrationRepository(db *sqlx.DB) IntegrationRepository {
+	return &integrationRepository{db: db}
+}
+
+// ListOrders retrieves the organizer's orders updated since the filter time
+// Archived orders are old and final, they are not listed
+func (r *integrationRepository) ListOrders(ctx context.Context, filter IntegrationFilter) ([]entity.IntegrationOrder, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	where, args := integrationConditions(filter, "o.event_id", "o.status", "o.updated_at")
+	query := fmt.Sprintf(`
+		SELECT o.id, o.event_id, e.title AS event_name, o.status, o.total_amount, o.grand_total,
+		       o.payment_method,
+		       COALESCE((SELECT SUM(oi.quantity) FROM order_items oi WHERE oi.order_id = o.id), 0) AS tickets,
+		       COALESCE(u.full_name, '') AS buyer_name, COALESCE(u.email, '') AS buyer_email,
+		       o.created_at, o.updated_at
+		FROM orders o
+		JOIN events e ON e.id = o.event_id
+		LEFT JOIN users u ON u.id = o.user_id
+		WHERE %s
+		ORDER BY o.updated_at DESC, o.id DESC
+		LIMIT $%d
+	`, where, len(args)+1)
+
+	orders := []entity.IntegrationOrder{}
+	if err := r.db.SelectContext(ctx, &orders, query, append(args, filter.Limit)...); err != nil {
+		return nil, fmt.Errorf("failed to list integration orders: %w", err)
+	}
+
+	return orders, nil
+}
+
+// ListAttendees retrieves the organizer's tickets issued since the filter time with their holders
+func (r *integrationRepository) ListAttendees(ctx context.Context, filter IntegrationFilter) ([]entity.IntegrationAttendee, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	where, args := integrationConditions(filter, "t.event_id", "t.status", "t.created_at")
+	query := fmt.Sprintf(`
+		SELECT t.id AS ticket_id, t.ticket_number, t.order_id, t.event_id, e.title AS event_name,
+		       COALESCE(tt.name, '') AS tier_name, t.status,
+		       COALESCE(u.full_name, '') AS holder_name, COALESCE(u.email, '') AS holder_email,
+		       t.created_at
+		FROM tickets t
+		JOIN events e ON e.id = t.event_id
+		LEFT JOIN ticket_tiers tt ON tt.id = t.ticket_tier_id
+		LEFT JOIN users u ON u.id = t.user_id
+		WHERE %s
+		ORDER BY t.created_at DESC, t.id DESC
+		LIMIT $%d
+	`, where, len(args)+1)
+
+	attendees := []entity.IntegrationAttendee{}
+	if err := r.db.SelectContext(ctx, &attendees, query, append(args, filter.Limit)...); err != nil {
+		return nil, fmt.Errorf("failed to list integration attendees: %w", err)
+	}
+
+	return attendees, nil
+}
+
+// ListCheckIns retrieves the organizer's entry scans since the filter time; exits are not check-ins
+func (r *integrationRepository) ListCheckIns(ctx context.Context, filter IntegrationFilter) ([]entity.IntegrationCheckIn, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	filter.Status = ""
+	where, args := integrationConditions(filter, "s.event_id", "", "s.scanned_at")
+	args = append(args, entity.ScanDirectionEntry)
+	query := fmt.Sprintf(`
+		SELECT s.id AS scan_id, s.ticket_id, t.ticket_number, s.event_id, e.title AS event_name,
+		       COALESCE(tt.name, '') AS tier_name, s.zone,
+		       COALESCE(u.full_name, '') AS holder_name, COALESCE(u.email, '') AS holder_email,
+		       s.scanned_at
+		FROM ticket_scans s
+		JOIN events e ON e.id = s.event_id
+		JOIN tickets t ON t.id = s.ticket_id
+		LEFT JOIN ticket_tiers tt ON tt.id = t.ticket_tier_id
+		LEFT JOIN users u ON u.id = t.user_id
+		WHERE %s AND s.direction = $%d
+		ORDER BY s.scanned_at DESC, s.id DESC
+		LIMIT $%d
+	`, where, len(args), len(args)+1)
+
+	checkIns := []entity.IntegrationCheckIn{}
+	if err := r.db.SelectContext(ctx, &checkIns, query, append(args, filter.Limit)...); err != nil {
+		return nil, fmt.Errorf("failed to list integration check-ins: %w", err)
+	}
+
+	return checkIns, nil
+}
+
+// integrationConditions builds the WHERE clause of a trigger query from the filter
+// The organizer condition is on the joined events table e
+func integrationConditions(filter IntegrationFilter, eventColumn, statusColumn, timeColumn string) (string, []interface{}) {
+	conditions := []string{"e.organizer_id = $1"}
+	args := []interface{}{filter.OrganizerID}
+
+	if filter.EventID != "" {
+		args = append(args, filter.EventID)
+		conditions = append(conditions, fmt.Sprintf("%s = $%d", eventColumn, len(args)))
+	}
+	if filter.Status != "" && statusColumn != "" {
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("%s = $%d", statusColumn, len(args)))
+	}
+	if !filter.UpdatedSince.IsZero() {
+		args = append(args, filter.UpdatedSince)
+		conditions = append(conditions, fmt.Sprintf("%s >= $%d", timeColumn, len(args)))
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
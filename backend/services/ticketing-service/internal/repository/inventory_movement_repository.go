@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// InventoryMovementRepository defines interface for reading the ticket tier inventory ledger
+// Movements are appended by TicketTierRepository and HealSoldCount together with the sold_count change
+type InventoryMovementRepository interface {
+	GetHistory(ctx context.Context, tierID, interval string, from, to time.Time) ([]entity.InventoryBucket, error)
+}
+
+// inventoryMovementRepository implements InventoryMovementRepository interface
+type inventoryMovementRepository struct {
+	db *sqlx.DB
+}
+
+// NewInventoryMovementRepository creates new inventory movement repository instance
+func NewInventoryMovementRepository(db *sqlx.DB) InventoryMovementRepository {
+	return &inventoryMovementRepository{db: db}
+}
+
+// GetHistory sums a tier's movements in [from, to) per UTC bucket of the interval (one of entity.InventoryIntervals)
+// Adjustments are summed apart from seats sold and released; buckets without movements are omitted
+// Movements of sandbox orders are test sales and left out
+func (r *inventoryMovementRepository) GetHistory(ctx context.Context, tierID, interval string, from, to time.Time) ([]entity.InventoryBucket, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT
+			date_trunc($2, created_at AT TIME ZONE 'UTC') AS bucket_start,
+			COALESCE(SUM(delta) FILTER (WHERE reason <> 'adjust' AND delta > 0), 0) AS sold,
+			COALESCE(-SUM(delta) FILTER (WHERE reason <> 'adjust' AND delta < 0), 0) AS released,
+			COALESCE(SUM(delta) FILTER (WHERE reason = 'adjust'), 0) AS adjusted
+		FROM inventory_movements m
+		WHERE ticket_tier_id = $1 AND created_at >= $3 AND created_at < $4
+		  AND NOT EXISTS (SELECT 1 FROM orders o WHERE o.id = m.order_id AND o.is_sandbox)
+		  AND NOT EXISTS (SELECT 1 FROM orders_archive o WHERE o.id = m.order_id AND o.is_sandbox)
+		GROUP BY bucket_start
+		ORDER BY bucket_start
+	`
+
+	buckets := []entity.InventoryBucket{}
+	if err := r.db.SelectContext(ctx, &buckets, query, tierID, interval, from, to); err != nil {
+		return nil, fmt.Errorf("failed to get inventory history: %w", err)
+	}
+
+	return buckets, nil
+}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// NotificationOutboxRepository defines interface for notification outbox data operations
+type NotificationOutboxRepository interface {
+	Enqueue(ctx context.Context, message *entity.NotificationOutbox) error
+	ClaimDue(ctx context.Context, limit int, lockedUntil time.Time) ([]entity.NotificationOutbox, error)
+	MarkSent(ctx context.Context, id string) error
+	Retry(ctx context.Context, id, lastError string, nextAttemptAt time.Time) error
+	MarkDead(ctx context.Context, id, lastError string) error
+}
+
+// notificationOutboxRepository implements NotificationOutboxRepository interface
+type notificationOutboxRepository struct {
+	db *sqlx.DB
+}
+
+// NewNotificationOutboxRepository creates new notification outbox repository instance
+func NewNotificationOutboxRepository(db *sqlx.DB) NotificationOutboxRepository {
+	return &notificationOutboxRepository{db: db}
+}
+
+// Enqueue queues a failed email of an order for retry
+// A message of the same type and order is queued once while pending; a sent or dead message is queued again
+func (r *notificationOutboxRepository) Enqueue(ctx context.Context, message *entity.NotificationOutbox) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO notification_outbox (id, type, order_id, status, attempts, next_attempt_at, last_error, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		ON CONFLICT (type, order_id) DO UPDATE
+		SET status = EXCLUDED.status, attempts = EXCLUDED.attempts, next_attempt_at = EXCLUDED.next_attempt_at,
+		    last_error = EXCLUDED.last_error, locked_until = NULL, sent_at = NULL, updated_at = NOW()
+		WHERE notification_outbox.status IN ($8, $9)
+	`
+
+	if message.ID == "" {
+		message.ID = uuid.New().String()
+	}
+	message.Status = entity.OutboxStatusPending
+
+	_, err := r.db.ExecContext(ctx, query,
+		message.ID, message.Type, message.OrderID, message.Status, message.Attempts, message.NextAttemptAt, message.LastError,
+		entity.OutboxStatusSent, entity.OutboxStatusDead)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue notification: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimDue leases up to limit due messages to the caller until lockedUntil, oldest first
+// Messages whose lease ran out (the worker crashed) are due again
+// SKIP LOCKED lets multiple instances claim concurrently without taking the same message
+func (r *notificationOutboxRepository) ClaimDue(ctx context.Context, limit int, lockedUntil time.Time) ([]entity.NotificationOutbox, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE notification_outbox
+		SET status = $1, attempts = attempts + 1, locked_until = $2, updated_at = NOW()
+		WHERE id IN (
+			SELECT id
+			FROM notification_outbox
+			WHERE (status = $3 AND next_attempt_at <= NOW()) OR (status = $1 AND locked_until < NOW())
+			ORDER BY next_attempt_at ASC
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, type, order_id, status, attempts, next_attempt_at, last_error, locked_until, sent_at, created_at, updated_at
+	`
+
+	messages := []entity.NotificationOutbox{}
+	err := r.db.SelectContext(ctx, &messages, query, entity.OutboxStatusSending, lockedUntil, entity.OutboxStatusPending, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim due notifications: %w", err)
+	}
+
+	return messages, nil
+}
+
+// MarkSent records that a claimed message was sent
+func (r *notificationOutboxRepository) MarkSent(ctx context.Context, id string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE notification_outbox
+		SET status = $2, sent_at = NOW(), locked_until = NULL, updated_at = NOW()
+		WHERE id = $1 AND status = $3
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, entity.OutboxStatusSent, entity.OutboxStatusSending); err != nil {
+		return fmt.Errorf("failed to mark notification sent: %w", err)
+	}
+
+	return nil
+}
+
+// Retry puts a claimed message that failed back in the outbox until nextAttemptAt
+func (r *notificationOutboxRepository) Retry(ctx context.Context, id, lastError string, nextAttemptAt time.Time) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE notification_outbox
+		SET status = $2, next_attempt_at = $3, last_error = $4, locked_until = NULL, updated_at = NOW()
+		WHERE id = $1 AND status = $5
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, entity.OutboxStatusPending, nextAttemptAt, lastError, entity.OutboxStatusSending); err != nil {
+		return fmt.Errorf("failed to reschedule notification: %w", err)
+	}
+
+	return nil
+}
+
+// MarkDead dead-letters a claimed message that failed for the last time
+func (r *notificationOutboxRepository) MarkDead(ctx context.Context, id, lastError string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE notification_outbox
+		SET status = $2, last_error = $3, locked_until = NULL, updated_at = NOW()
+		WHERE id = $1 AND status = $4
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, entity.OutboxStatusDead, lastError, entity.OutboxStatusSending); err != nil {
+		return fmt.Errorf("failed to dead-letter notification: %w", err)
+	}
+
+	return nil
+}
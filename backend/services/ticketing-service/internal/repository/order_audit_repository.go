@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// orderAuditColumns lists the columns selected for an order audit entry
+const orderAuditColumns = `id, order_id, tenant_id, action, actor_id, reference, reason, outcome, created_at`
+
+// OrderAuditRepository defines interface for order audit entry data operations
+type OrderAuditRepository interface {
+	Create(ctx context.Context, entry *entity.OrderAuditEntry) error
+	GetByOrderID(ctx context.Context, orderID string) ([]entity.OrderAuditEntry, error)
+}
+
+// orderAuditRepository implements OrderAuditRepository interface
+type orderAuditRepository struct {
+	db *sqlx.DB
+}
+
+// NewOrderAuditRepository creates new order audit repository instance
+func NewOrderAuditRepository(db *sqlx.DB) OrderAuditRepository {
+	return &orderAuditRepository{db: db}
+}
+
+// Create records an audit entry
+func (r *orderAuditRepository) Create(ctx context.Context, entry *entity.OrderAuditEntry) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO order_audit_entries (id, order_id, tenant_id, action, actor_id, reference, reason, outcome, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+		RETURNING created_at
+	`
+
+	if entry.ID == "" {
+		entry.ID = uuid.New().String()
+	}
+
+	err := r.db.QueryRowContext(ctx, query,
+		entry.ID, entry.OrderID, entry.TenantID, entry.Action, entry.ActorID, entry.Reference, entry.Reason, entry.Outcome,
+	).Scan(&entry.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create order audit entry: %w", err)
+	}
+
+	return nil
+}
+
+// GetByOrderID retrieves the audit entries of an order, newest first
+func (r *orderAuditRepository) GetByOrderID(ctx context.Context, orderID string) ([]entity.OrderAuditEntry, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + orderAuditColumns + ` FROM order_audit_entries WHERE order_id = $1 ORDER BY created_at DESC`
+
+	entries := []entity.OrderAuditEntry{}
+	if err := r.db.SelectContext(ctx, &entries, query, orderID); err != nil {
+		return nil, fmt.Errorf("failed to get order audit entries: %w", err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// OrderEventRepository defines interface for the streams of event-sourced orders
+// Events are appended by OrderRepository together with the order change they record
+type OrderEventRepository interface {
+	GetStream(ctx context.Context, orderID string) ([]entity.OrderEvent, error)
+	GetStreamOrderIDs(ctx context.Context, afterOrderID string, limit int) ([]string, error)
+	Project(ctx context.Context, order *entity.Order) error
+}
+
+// orderEventRepository implements OrderEventRepository interface
+type orderEventRepository struct {
+	db *sqlx.DB
+}
+
+// NewOrderEventRepository creates new order event repository instance
+func NewOrderEventRepository(db *sqlx.DB) OrderEventRepository {
+	return &orderEventRepository{db: db}
+}
+
+// rowQuerier is a transaction an order event is appended in
+type rowQuerier interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// appendOrderEvent appends event to its order's stream as the next version
+// Concurrent appends to one stream fail on uq_order_events_version instead of interleaving
+func appendOrderEvent(ctx context.Context, tx rowQuerier, event *entity.OrderEvent) error {
+	query := `
+		INSERT INTO order_events (order_id, version, event_type, data, created_at)
+		SELECT $1, COALESCE(MAX(version), 0) + 1, $2, $3, NOW()
+		FROM order_events
+		WHERE order_id = $1
+		RETURNING id, version, created_at
+	`
+
+	// Encoded as string: lib/pq would send []byte as bytea
+	err := tx.QueryRowContext(ctx, query, event.OrderID, event.EventType, string(event.Data)).
+		Scan(&event.ID, &event.Version, &event.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to append %s event: %w", event.EventType, err)
+	}
+
+	return nil
+}
+
+// appendOrderChangedEvent appends the update of an event-sourced order to its stream
+func appendOrderChangedEvent(ctx context.Context, tx rowQuerier, order *entity.Order) error {
+	event, err := entity.NewOrderChangedEvent(order)
+	if err != nil {
+		return err
+	}
+	return appendOrderEvent(ctx, tx, event)
+}
+
+// GetStream retrieves the events of an order, oldest first
+func (r *orderEventRepository) GetStream(ctx context.Context, orderID string) ([]entity.OrderEvent, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, order_id, version, event_type, data, created_at
+		FROM order_events
+		WHERE order_id = $1
+		ORDER BY version
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []entity.OrderEvent{}
+	for rows.Next() {
+		var event entity.OrderEvent
+		var data []byte
+		if err := rows.Scan(&event.ID, &event.OrderID, &event.Version, &event.EventType, &data, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan order event: %w", err)
+		}
+		event.Data = json.RawMessage(data)
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate order events: %w", err)
+	}
+
+	return events, nil
+}
+
+// GetStreamOrderIDs retrieves the IDs of orders with a stream, ordered, starting after afterOrderID
+// Pass an empty afterOrderID for the first page
+func (r *orderEventRepository) GetStreamOrderIDs(ctx context.Context, afterOrderID string, limit int) ([]string, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT DISTINCT order_id::text
+		FROM order_events
+		WHERE order_id::text > $1
+		ORDER BY order_id::text
+		LIMIT $2
+	`
+
+	orderIDs := []string{}
+	if err := r.db.SelectContext(ctx, &orderIDs, query, afterOrderID, limit); err != nil {
+		return nil, fmt.Errorf("failed to get order stream IDs: %w", err)
+	}
+
+	return orderIDs, nil
+}
+
+// Project overwrites an event-sourced order's row with the state replayed from its stream
+// Returns ErrOrderNotFound when the order was archived (or never event-sourced)
+func (r *orderEventRepository) Project(ctx context.Context, order *entity.Order) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE orders
+		SET user_id = :user_id, event_id = :event_id, tenant_id = :tenant_id,
+		    total_amount = :total_amount, platform_fee = :platform_fee, service_fee = :service_fee,
+		    grand_total = :grand_total, status = :status, payment_id = :payment_id,
+		    payment_method = :payment_method, paid_amount = :paid_amount, paid_currency = :paid_currency,
+		    amount_discrepancy = :amount_discrepancy, reservation_expires_at = :reservation_expires_at,
+		    metadata = :metadata, is_sandbox = :is_sandbox, created_at = :created_at,
+		    updated_at = :updated_at, completed_at = :completed_at
+		WHERE id = :id AND event_sourced
+	`
+
+	result, err := r.db.NamedExecContext(ctx, query, order)
+	if err != nil {
+		return fmt.Errorf("failed to project order: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrOrderNotFound
+	}
+
+	return nil
+}
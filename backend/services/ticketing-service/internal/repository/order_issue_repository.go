@@ -0,0 +1,238 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+var (
+	ErrOrderIssueNotFound    = errors.New("order issue not found")
+	ErrOrderIssueAlreadyOpen = errors.New("order already has an unresolved issue in this category")
+	ErrOrderIssueResolved    = errors.New("order issue is already resolved")
+)
+
+// orderIssueColumns lists the columns selected for an order issue
+const orderIssueColumns = `id, order_id, user_id, tenant_id, category, description, status,
+	       resolution, resolved_by, resolved_at, created_at, updated_at`
+
+// OrderIssueRepository defines interface for order issue data operations
+type OrderIssueRepository interface {
+	Create(ctx context.Context, issue *entity.OrderIssue) error
+	GetByID(ctx context.Context, id string) (*entity.OrderIssue, error)
+	GetByOrderID(ctx context.Context, orderID string) ([]entity.OrderIssue, error)
+	List(ctx context.Context, tenantID, status string, limit, offset int) ([]entity.OrderIssue, int64, error)
+	GetReplies(ctx context.Context, issueIDs []string) ([]entity.OrderIssueReply, error)
+	AddReply(ctx context.Context, reply *entity.OrderIssueReply) error
+	Resolve(ctx context.Context, id, resolvedBy, resolution string) error
+}
+
+// orderIssueRepository implements OrderIssueRepository interface
+type orderIssueRepository struct {
+	db *sqlx.DB
+}
+
+// NewOrderIssueRepository creates new order issue repository instance
+func NewOrderIssueRepository(db *sqlx.DB) OrderIssueRepository {
+	return &orderIssueRepository{db: db}
+}
+
+// Create inserts a new open order issue
+func (r *orderIssueRepository) Create(ctx context.Context, issue *entity.OrderIssue) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO order_issues (id, order_id, user_id, tenant_id, category, description, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`
+
+	if issue.ID == "" {
+		issue.ID = uuid.New().String()
+	}
+	issue.Status = entity.IssueStatusOpen
+
+	err := r.db.QueryRowContext(ctx, query,
+		issue.ID, issue.OrderID, issue.UserID, issue.TenantID, issue.Category, issue.Description, issue.Status,
+	).Scan(&issue.CreatedAt, &issue.UpdatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return ErrOrderIssueAlreadyOpen
+		}
+		return fmt.Errorf("failed to create order issue: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves order issue by ID
+func (r *orderIssueRepository) GetByID(ctx context.Context, id string) (*entity.OrderIssue, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + orderIssueColumns + ` FROM order_issues WHERE id = $1`
+
+	issue := &entity.OrderIssue{}
+	if err := r.db.GetContext(ctx, issue, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOrderIssueNotFound
+		}
+		return nil, fmt.Errorf("failed to get order issue: %w", err)
+	}
+
+	return issue, nil
+}
+
+// GetByOrderID retrieves all issues of an order, newest first
+func (r *orderIssueRepository) GetByOrderID(ctx context.Context, orderID string) ([]entity.OrderIssue, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + orderIssueColumns + ` FROM order_issues WHERE order_id = $1 ORDER BY created_at DESC`
+
+	issues := []entity.OrderIssue{}
+	if err := r.db.SelectContext(ctx, &issues, query, orderID); err != nil {
+		return nil, fmt.Errorf("failed to get order issues: %w", err)
+	}
+
+	return issues, nil
+}
+
+// List retrieves issues of a tenant with pagination, oldest first so the queue is worked in order
+// An empty status lists unresolved issues
+func (r *orderIssueRepository) List(ctx context.Context, tenantID, status string, limit, offset int) ([]entity.OrderIssue, int64, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	statusCondition := `status <> 'resolved'`
+	args := []interface{}{tenantID}
+	if status != "" {
+		statusCondition = `status = $2`
+		args = append(args, status)
+	}
+
+	var total int64
+	countQuery := `SELECT COUNT(*) FROM order_issues WHERE tenant_id = $1 AND ` + statusCondition
+	if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to count order issues: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM order_issues
+		WHERE tenant_id = $1 AND %s
+		ORDER BY created_at
+		LIMIT $%d OFFSET $%d
+	`, orderIssueColumns, statusCondition, len(args)+1, len(args)+2)
+
+	issues := []entity.OrderIssue{}
+	if err := r.db.SelectContext(ctx, &issues, query, append(args, limit, offset)...); err != nil {
+		return nil, 0, fmt.Errorf("failed to list order issues: %w", err)
+	}
+
+	return issues, total, nil
+}
+
+// GetReplies retrieves the replies of the given issues in conversation order
+func (r *orderIssueRepository) GetReplies(ctx context.Context, issueIDs []string) ([]entity.OrderIssueReply, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	replies := []entity.OrderIssueReply{}
+	if len(issueIDs) == 0 {
+		return replies, nil
+	}
+
+	query := `
+		SELECT id, issue_id, author_id, message, created_at
+		FROM order_issue_replies
+		WHERE issue_id = ANY($1)
+		ORDER BY created_at
+	`
+
+	if err := r.db.SelectContext(ctx, &replies, query, pq.Array(issueIDs)); err != nil {
+		return nil, fmt.Errorf("failed to get order issue replies: %w", err)
+	}
+
+	return replies, nil
+}
+
+// AddReply appends a support reply and moves an open issue to in progress
+// Resolved issues take no more replies
+func (r *orderIssueRepository) AddReply(ctx context.Context, reply *entity.OrderIssueReply) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE order_issues
+		SET status = $2, updated_at = NOW()
+		WHERE id = $1 AND status <> 'resolved'
+	`, reply.IssueID, entity.IssueStatusInProgress)
+	if err != nil {
+		return fmt.Errorf("failed to update order issue: %w", err)
+	}
+	if err := r.checkIssueUpdated(ctx, result, reply.IssueID); err != nil {
+		return err
+	}
+
+	if reply.ID == "" {
+		reply.ID = uuid.New().String()
+	}
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO order_issue_replies (id, issue_id, author_id, message, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		RETURNING created_at
+	`, reply.ID, reply.IssueID, reply.AuthorID, reply.Message).Scan(&reply.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create order issue reply: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// Resolve closes an unresolved issue with a resolution
+func (r *orderIssueRepository) Resolve(ctx context.Context, id, resolvedBy, resolution string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE order_issues
+		SET status = $2, resolution = $3, resolved_by = $4, resolved_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND status <> 'resolved'
+	`, id, entity.IssueStatusResolved, resolution, resolvedBy)
+	if err != nil {
+		return fmt.Errorf("failed to resolve order issue: %w", err)
+	}
+
+	return r.checkIssueUpdated(ctx, result, id)
+}
+
+// checkIssueUpdated tells a missing issue from a resolved one when an update matched no rows
+func (r *orderIssueRepository) checkIssueUpdated(ctx context.Context, result sql.Result, id string) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows > 0 {
+		return nil
+	}
+
+	if _, err := r.GetByID(ctx, id); err != nil {
+		return err
+	}
+	return ErrOrderIssueResolved
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
 )
 
@@ -35,6 +36,9 @@ func NewOrderItemRepository(db *sqlx.DB) OrderItemRepository {
 
 // Create inserts new order item (must be called within a transaction)
 func (r *orderItemRepository) Create(ctx context.Context, tx *sql.Tx, item *entity.OrderItem) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		INSERT INTO order_items (id, order_id, ticket_tier_id, quantity, price, subtotal, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
@@ -64,6 +68,9 @@ func (r *orderItemRepository) Create(ctx context.Context, tx *sql.Tx, item *enti
 
 // CreateBatch inserts multiple order items in one transaction
 func (r *orderItemRepository) CreateBatch(ctx context.Context, tx *sql.Tx, items []entity.OrderItem) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		INSERT INTO order_items (id, order_id, ticket_tier_id, quantity, price, subtotal, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
@@ -97,11 +104,19 @@ func (r *orderItemRepository) CreateBatch(ctx context.Context, tx *sql.Tx, items
 }
 
 // GetByOrderID retrieves all items for an order using sqlx
+// Includes archived items so archived orders can still be displayed
 func (r *orderItemRepository) GetByOrderID(ctx context.Context, orderID string) ([]entity.OrderItem, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT id, order_id, ticket_tier_id, quantity, price, subtotal, created_at, updated_at
 		FROM order_items
 		WHERE order_id = $1
+		UNION ALL
+		SELECT id, order_id, ticket_tier_id, quantity, price, subtotal, created_at, updated_at
+		FROM order_items_archive
+		WHERE order_id = $1
 		ORDER BY created_at ASC
 	`
 
@@ -116,6 +131,9 @@ func (r *orderItemRepository) GetByOrderID(ctx context.Context, orderID string)
 
 // GetByID retrieves order item by ID using sqlx
 func (r *orderItemRepository) GetByID(ctx context.Context, id string) (*entity.OrderItem, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT id, order_id, ticket_tier_id, quantity, price, subtotal, created_at, updated_at
 		FROM order_items
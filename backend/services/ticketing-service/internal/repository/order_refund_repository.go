@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+var (
+	ErrRefundRequestNotFound        = errors.New("refund request not found")
+	ErrRefundRequestAlreadyRefunded = errors.New("refund request is already refunded")
+)
+
+// orderRefundColumns lists the columns selected for a refund request
+const orderRefundColumns = `id, order_id, tenant_id, payment_id, payment_method, amount, currency, reason,
+	       status, refunded_by, refunded_at, created_at`
+
+// OrderRefundRepository defines interface for payments flagged for manual refund
+type OrderRefundRepository interface {
+	Create(ctx context.Context, refund *entity.OrderRefundRequest) (bool, error)
+	GetByPaymentID(ctx context.Context, paymentID string) (*entity.OrderRefundRequest, error)
+	List(ctx context.Context, tenantID, status string, limit, offset int) ([]entity.OrderRefundRequest, int64, error)
+	MarkRefunded(ctx context.Context, id, tenantID, refundedBy string) (*entity.OrderRefundRequest, error)
+	MarkPaymentRefunded(ctx context.Context, orderID, paymentID string) (bool, error)
+}
+
+// orderRefundRepository implements OrderRefundRepository interface
+type orderRefundRepository struct {
+	db *sqlx.DB
+}
+
+// NewOrderRefundRepository creates new order refund repository instance
+func NewOrderRefundRepository(db *sqlx.DB) OrderRefundRepository {
+	return &orderRefundRepository{db: db}
+}
+
+// Create flags a payment for refund; a payment already flagged is left as is
+// Requests are pending unless created with another status (refunded by payment service)
+// Returns whether a new refund request was created
+func (r *orderRefundRepository) Create(ctx context.Context, refund *entity.OrderRefundRequest) (bool, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO order_refund_requests (id, order_id, tenant_id, payment_id, payment_method, amount, currency, reason, status, refunded_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW())
+		ON CONFLICT (payment_id) DO NOTHING
+		RETURNING created_at
+	`
+
+	if refund.ID == "" {
+		refund.ID = uuid.New().String()
+	}
+	if refund.Status == "" {
+		refund.Status = entity.RefundStatusPending
+	}
+
+	err := r.db.QueryRowContext(ctx, query,
+		refund.ID, refund.OrderID, refund.TenantID, refund.PaymentID, refund.PaymentMethod,
+		refund.Amount, refund.Currency, refund.Reason, refund.Status, refund.RefundedAt,
+	).Scan(&refund.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to create refund request: %w", err)
+	}
+
+	return true, nil
+}
+
+// GetByPaymentID retrieves the refund request of a payment
+func (r *orderRefundRepository) GetByPaymentID(ctx context.Context, paymentID string) (*entity.OrderRefundRequest, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + orderRefundColumns + ` FROM order_refund_requests WHERE payment_id = $1`
+
+	refund := &entity.OrderRefundRequest{}
+	if err := r.db.GetContext(ctx, refund, query, paymentID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRefundRequestNotFound
+		}
+		return nil, fmt.Errorf("failed to get refund request: %w", err)
+	}
+
+	return refund, nil
+}
+
+// List retrieves refund requests of a tenant with pagination, oldest first
+// An empty status lists pending requests
+func (r *orderRefundRepository) List(ctx context.Context, tenantID, status string, limit, offset int) ([]entity.OrderRefundRequest, int64, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	if status == "" {
+		status = entity.RefundStatusPending
+	}
+
+	var total int64
+	countQuery := `SELECT COUNT(*) FROM order_refund_requests WHERE tenant_id = $1 AND status = $2`
+	if err := r.db.GetContext(ctx, &total, countQuery, tenantID, status); err != nil {
+		return nil, 0, fmt.Errorf("failed to count refund requests: %w", err)
+	}
+
+	query := `
+		SELECT ` + orderRefundColumns + `
+		FROM order_refund_requests
+		WHERE tenant_id = $1 AND status = $2
+		ORDER BY created_at
+		LIMIT $3 OFFSET $4
+	`
+
+	refunds := []entity.OrderRefundRequest{}
+	if err := r.db.SelectContext(ctx, &refunds, query, tenantID, status, limit, offset); err != nil {
+		return nil, 0, fmt.Errorf("failed to list refund requests: %w", err)
+	}
+
+	return refunds, total, nil
+}
+
+// MarkRefunded records that support refunded a pending request of the tenant
+func (r *orderRefundRepository) MarkRefunded(ctx context.Context, id, tenantID, refundedBy string) (*entity.OrderRefundRequest, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE order_refund_requests
+		SET status = $3, refunded_by = $4, refunded_at = NOW()
+		WHERE id = $1 AND tenant_id = $2 AND status = $5
+		RETURNING ` + orderRefundColumns
+
+	refund := &entity.OrderRefundRequest{}
+	err := r.db.GetContext(ctx, refund, query, id, tenantID, entity.RefundStatusRefunded, refundedBy, entity.RefundStatusPending)
+	if err == nil {
+		return refund, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to mark refund request refunded: %w", err)
+	}
+
+	// Nothing updated: unknown (or other tenant's) request, or already refunded
+	var exists bool
+	existsQuery := `SELECT EXISTS(SELECT 1 FROM order_refund_requests WHERE id = $1 AND tenant_id = $2)`
+	if err := r.db.GetContext(ctx, &exists, existsQuery, id, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to check refund request: %w", err)
+	}
+	if !exists {
+		return nil, ErrRefundRequestNotFound
+	}
+
+	return nil, ErrRefundRequestAlreadyRefunded
+}
+
+// MarkPaymentRefunded records that payment service refunded the pending request of a payment
+// Reports whether a request was updated; refunded_by stays empty for refunds made by payment service
+func (r *orderRefundRepository) MarkPaymentRefunded(ctx context.Context, orderID, paymentID string) (bool, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE order_refund_requests
+		SET status = $3, refunded_at = NOW()
+		WHERE payment_id = $1 AND order_id = $2 AND status = $4
+	`
+
+	result, err := r.db.ExecContext(ctx, query, paymentID, orderID, entity.RefundStatusRefunded, entity.RefundStatusPending)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark payment refunded: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
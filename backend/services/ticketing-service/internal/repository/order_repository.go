@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
 )
 
@@ -19,12 +20,16 @@ var (
 // OrderRepository defines interface for order data operations
 type OrderRepository interface {
 	Create(ctx context.Context, order *entity.Order) error
+	CreateWithTx(ctx context.Context, tx *sql.Tx, order *entity.Order) error
 	GetByID(ctx context.Context, id string) (*entity.Order, error)
 	GetByIDWithLock(ctx context.Context, tx *sql.Tx, id string) (*entity.Order, error)
+	GetByPaymentID(ctx context.Context, paymentID string) (*entity.Order, error)
 	GetByUserID(ctx context.Context, userID string, limit, offset int) ([]entity.Order, int64, error)
 	Update(ctx context.Context, order *entity.Order) error
 	UpdateWithTx(ctx context.Context, tx *sql.Tx, order *entity.Order) error
 	GetExpiredReservations(ctx context.Context) ([]entity.Order, error)
+	GetSandboxByEventID(ctx context.Context, eventID string) ([]entity.Order, error)
+	SearchByMetadata(ctx context.Context, tenantID, key string, value *string, limit, offset int) ([]entity.Order, int64, error)
 	BeginTx(ctx context.Context) (*sql.Tx, error)
 }
 
@@ -45,30 +50,72 @@ func (r *orderRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
 }
 
 // Create inserts new order into database using sqlx
+// Event-sourced orders are created with their order_created event, in one transaction
 func (r *orderRepository) Create(ctx context.Context, order *entity.Order) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	if !order.EventSourced {
+		return r.insert(ctx, r.db, order)
+	}
+
+	tx, err := r.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := r.CreateWithTx(ctx, tx, order); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// CreateWithTx inserts new order within a transaction
+// The order is only created if tx commits, event-sourced orders with their order_created event
+func (r *orderRepository) CreateWithTx(ctx context.Context, tx *sql.Tx, order *entity.Order) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	if err := r.insert(ctx, tx, order); err != nil {
+		return err
+	}
+	if !order.EventSourced {
+		return nil
+	}
+
+	event, err := entity.NewOrderCreatedEvent(order)
+	if err != nil {
+		return err
+	}
+	return appendOrderEvent(ctx, tx, event)
+}
+
+// insert inserts order through db, a connection or a transaction
+func (r *orderRepository) insert(ctx context.Context, db rowQuerier, order *entity.Order) error {
+	if order.ID == "" {
+		order.ID = uuid.New().String()
+	}
+
 	query := `
 		INSERT INTO orders (
-			id, user_id, event_id, total_amount, platform_fee, service_fee,
-			grand_total, status, reservation_expires_at, created_at, updated_at
+			id, user_id, event_id, tenant_id, total_amount, platform_fee, service_fee,
+			grand_total, status, reservation_expires_at, metadata, is_sandbox, event_sourced, created_at, updated_at
 		)
-		VALUES (:id, :user_id, :event_id, :total_amount, :platform_fee, :service_fee,
-		        :grand_total, :status, :reservation_expires_at, NOW(), NOW())
+		VALUES (:id, :user_id, :event_id, :tenant_id, :total_amount, :platform_fee, :service_fee,
+		        :grand_total, :status, :reservation_expires_at, :metadata, :is_sandbox, :event_sourced, NOW(), NOW())
 		RETURNING created_at, updated_at
 	`
 
-	order.ID = uuid.New().String()
-
-	rows, err := r.db.NamedQueryContext(ctx, query, order)
+	// Bound here so plain *sql.Tx callers get the named parameters too
+	query, args, err := r.db.BindNamed(query, order)
 	if err != nil {
-		return fmt.Errorf("failed to create order: %w", err)
+		return fmt.Errorf("failed to bind order: %w", err)
 	}
-	defer rows.Close()
 
-	if rows.Next() {
-		err = rows.Scan(&order.CreatedAt, &order.UpdatedAt)
-		if err != nil {
-			return fmt.Errorf("failed to scan created order: %w", err)
-		}
+	if err := db.QueryRowContext(ctx, query, args...).Scan(&order.CreatedAt, &order.UpdatedAt); err != nil {
+		return fmt.Errorf("failed to create order: %w", err)
 	}
 
 	return nil
@@ -76,11 +123,15 @@ func (r *orderRepository) Create(ctx context.Context, order *entity.Order) error
 
 // GetByID retrieves order by ID using sqlx
 func (r *orderRepository) GetByID(ctx context.Context, id string) (*entity.Order, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	var order entity.Order
 	query := `
-		SELECT id, user_id, event_id, total_amount, platform_fee, service_fee,
-		       grand_total, status, payment_id, payment_method, reservation_expires_at,
-		       created_at, updated_at, completed_at
+		SELECT id, user_id, event_id, tenant_id, total_amount, platform_fee, service_fee,
+		       grand_total, status, payment_id, payment_method, paid_amount, paid_currency,
+		       amount_discrepancy, reservation_expires_at, created_at, updated_at, completed_at,
+		       metadata, is_sandbox, event_sourced
 		FROM orders
 		WHERE id = $1
 	`
@@ -88,7 +139,7 @@ func (r *orderRepository) GetByID(ctx context.Context, id string) (*entity.Order
 	err := r.db.GetContext(ctx, &order, query, id)
 	if err != nil {
 		if err.Error() == "sql: no rows in result set" {
-			return nil, ErrOrderNotFound
+			return r.getArchivedByID(ctx, id)
 		}
 		return nil, fmt.Errorf("failed to get order: %w", err)
 	}
@@ -96,14 +147,42 @@ func (r *orderRepository) GetByID(ctx context.Context, id string) (*entity.Order
 	return &order, nil
 }
 
+// getArchivedByID retrieves order from orders_archive
+// Used as fallback so archived orders stay visible in user history
+func (r *orderRepository) getArchivedByID(ctx context.Context, id string) (*entity.Order, error) {
+	var order entity.Order
+	query := `
+		SELECT id, user_id, event_id, tenant_id, total_amount, platform_fee, service_fee,
+		       grand_total, status, payment_id, payment_method, paid_amount, paid_currency,
+		       amount_discrepancy, reservation_expires_at, created_at, updated_at, completed_at,
+		       metadata, is_sandbox, event_sourced
+		FROM orders_archive
+		WHERE id = $1
+	`
+
+	err := r.db.GetContext(ctx, &order, query, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to get archived order: %w", err)
+	}
+
+	return &order, nil
+}
+
 // GetByIDWithLock retrieves order by ID with row-level lock (SELECT FOR UPDATE)
 // CRITICAL PATH: Uses raw SQL transaction for explicit control
 // MUST be called within a transaction
 func (r *orderRepository) GetByIDWithLock(ctx context.Context, tx *sql.Tx, id string) (*entity.Order, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
-		SELECT id, user_id, event_id, total_amount, platform_fee, service_fee,
-		       grand_total, status, payment_id, payment_method, reservation_expires_at,
-		       created_at, updated_at, completed_at
+		SELECT id, user_id, event_id, tenant_id, total_amount, platform_fee, service_fee,
+		       grand_total, status, payment_id, payment_method, paid_amount, paid_currency,
+		       amount_discrepancy, reservation_expires_at, created_at, updated_at, completed_at,
+		       metadata, is_sandbox, event_sourced
 		FROM orders
 		WHERE id = $1
 		FOR UPDATE
@@ -114,6 +193,7 @@ func (r *orderRepository) GetByIDWithLock(ctx context.Context, tx *sql.Tx, id st
 		&order.ID,
 		&order.UserID,
 		&order.EventID,
+		&order.TenantID,
 		&order.TotalAmount,
 		&order.PlatformFee,
 		&order.ServiceFee,
@@ -121,10 +201,16 @@ func (r *orderRepository) GetByIDWithLock(ctx context.Context, tx *sql.Tx, id st
 		&order.Status,
 		&order.PaymentID,
 		&order.PaymentMethod,
+		&order.PaidAmount,
+		&order.PaidCurrency,
+		&order.AmountDiscrepancy,
 		&order.ReservationExpiresAt,
 		&order.CreatedAt,
 		&order.UpdatedAt,
 		&order.CompletedAt,
+		&order.Metadata,
+		&order.IsSandbox,
+		&order.EventSourced,
 	)
 
 	if err == sql.ErrNoRows {
@@ -138,22 +224,63 @@ func (r *orderRepository) GetByIDWithLock(ctx context.Context, tx *sql.Tx, id st
 	return order, nil
 }
 
+// GetByPaymentID retrieves the order a payment was applied to
+func (r *orderRepository) GetByPaymentID(ctx context.Context, paymentID string) (*entity.Order, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	var order entity.Order
+	query := `
+		SELECT id, user_id, event_id, tenant_id, total_amount, platform_fee, service_fee,
+		       grand_total, status, payment_id, payment_method, paid_amount, paid_currency,
+		       amount_discrepancy, reservation_expires_at, created_at, updated_at, completed_at,
+		       metadata, is_sandbox, event_sourced
+		FROM orders
+		WHERE payment_id = $1
+	`
+
+	err := r.db.GetContext(ctx, &order, query, paymentID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to get order by payment: %w", err)
+	}
+
+	return &order, nil
+}
+
 // GetByUserID retrieves all orders for a user with pagination using sqlx
+// Includes archived orders so history is complete after archival
 func (r *orderRepository) GetByUserID(ctx context.Context, userID string, limit, offset int) ([]entity.Order, int64, error) {
-	// Get total count
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	// Get total count (live + archived)
 	var total int64
-	countQuery := `SELECT COUNT(*) FROM orders WHERE user_id = $1`
+	countQuery := `
+		SELECT (SELECT COUNT(*) FROM orders WHERE user_id = $1) +
+		       (SELECT COUNT(*) FROM orders_archive WHERE user_id = $1)
+	`
 	if err := r.db.GetContext(ctx, &total, countQuery, userID); err != nil {
 		return nil, 0, fmt.Errorf("failed to count orders: %w", err)
 	}
 
 	// Get orders using sqlx Select
 	query := `
-		SELECT id, user_id, event_id, total_amount, platform_fee, service_fee,
-		       grand_total, status, payment_id, payment_method, reservation_expires_at,
-		       created_at, updated_at, completed_at
+		SELECT id, user_id, event_id, tenant_id, total_amount, platform_fee, service_fee,
+		       grand_total, status, payment_id, payment_method, paid_amount, paid_currency,
+		       amount_discrepancy, reservation_expires_at, created_at, updated_at, completed_at,
+		       metadata, is_sandbox, event_sourced
 		FROM orders
 		WHERE user_id = $1
+		UNION ALL
+		SELECT id, user_id, event_id, tenant_id, total_amount, platform_fee, service_fee,
+		       grand_total, status, payment_id, payment_method, paid_amount, paid_currency,
+		       amount_discrepancy, reservation_expires_at, created_at, updated_at, completed_at,
+		       metadata, is_sandbox, event_sourced
+		FROM orders_archive
+		WHERE user_id = $1
 		ORDER BY created_at DESC
 		LIMIT $2 OFFSET $3
 	`
@@ -168,15 +295,41 @@ func (r *orderRepository) GetByUserID(ctx context.Context, userID string, limit,
 }
 
 // Update updates order information using sqlx
+// Changes of event-sourced orders are appended to their stream in the same transaction
 func (r *orderRepository) Update(ctx context.Context, order *entity.Order) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	if !order.EventSourced {
+		return r.update(ctx, r.db, order)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := r.update(ctx, tx, order); err != nil {
+		return err
+	}
+	if err := appendOrderChangedEvent(ctx, tx, order); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *orderRepository) update(ctx context.Context, db sqlx.ExtContext, order *entity.Order) error {
 	query := `
 		UPDATE orders
 		SET status = :status, payment_id = :payment_id, payment_method = :payment_method,
-		    completed_at = :completed_at, updated_at = NOW()
+		    paid_amount = :paid_amount, paid_currency = :paid_currency,
+		    amount_discrepancy = :amount_discrepancy, completed_at = :completed_at, updated_at = NOW()
 		WHERE id = :id
 	`
 
-	result, err := r.db.NamedExecContext(ctx, query, order)
+	result, err := sqlx.NamedExecContext(ctx, db, query, order)
 	if err != nil {
 		return fmt.Errorf("failed to update order: %w", err)
 	}
@@ -195,12 +348,17 @@ func (r *orderRepository) Update(ctx context.Context, order *entity.Order) error
 
 // UpdateWithTx updates order within a transaction
 // CRITICAL PATH: Uses raw SQL for explicit transaction control
+// Changes of event-sourced orders are appended to their stream within tx
 func (r *orderRepository) UpdateWithTx(ctx context.Context, tx *sql.Tx, order *entity.Order) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		UPDATE orders
 		SET status = $1, payment_id = $2, payment_method = $3,
-		    completed_at = $4, updated_at = NOW()
-		WHERE id = $5
+		    paid_amount = $4, paid_currency = $5, amount_discrepancy = $6,
+		    completed_at = $7, updated_at = NOW()
+		WHERE id = $8
 	`
 
 	result, err := tx.ExecContext(
@@ -209,6 +367,9 @@ func (r *orderRepository) UpdateWithTx(ctx context.Context, tx *sql.Tx, order *e
 		order.Status,
 		order.PaymentID,
 		order.PaymentMethod,
+		order.PaidAmount,
+		order.PaidCurrency,
+		order.AmountDiscrepancy,
 		order.CompletedAt,
 		order.ID,
 	)
@@ -226,16 +387,23 @@ func (r *orderRepository) UpdateWithTx(ctx context.Context, tx *sql.Tx, order *e
 		return ErrOrderNotFound
 	}
 
+	if order.EventSourced {
+		return appendOrderChangedEvent(ctx, tx, order)
+	}
 	return nil
 }
 
 // GetExpiredReservations retrieves all orders with expired reservations using sqlx
 // Used by background worker to release inventory
 func (r *orderRepository) GetExpiredReservations(ctx context.Context) ([]entity.Order, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
-		SELECT id, user_id, event_id, total_amount, platform_fee, service_fee,
-		       grand_total, status, payment_id, payment_method, reservation_expires_at,
-		       created_at, updated_at, completed_at
+		SELECT id, user_id, event_id, tenant_id, total_amount, platform_fee, service_fee,
+		       grand_total, status, payment_id, payment_method, paid_amount, paid_currency,
+		       amount_discrepancy, reservation_expires_at, created_at, updated_at, completed_at,
+		       metadata, is_sandbox, event_sourced
 		FROM orders
 		WHERE status = $1 AND reservation_expires_at < $2
 		ORDER BY reservation_expires_at ASC
@@ -250,3 +418,81 @@ func (r *orderRepository) GetExpiredReservations(ctx context.Context) ([]entity.
 
 	return orders, nil
 }
+
+// GetSandboxByEventID retrieves the test orders of a sandbox event still holding seats (reserved or paid)
+// Archived orders are final and don't hold seats, they are not listed
+func (r *orderRepository) GetSandboxByEventID(ctx context.Context, eventID string) ([]entity.Order, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, user_id, event_id, tenant_id, total_amount, platform_fee, service_fee,
+		       grand_total, status, payment_id, payment_method, paid_amount, paid_currency,
+		       amount_discrepancy, reservation_expires_at, created_at, updated_at, completed_at,
+		       metadata, is_sandbox, event_sourced
+		FROM orders
+		WHERE event_id = $1 AND is_sandbox AND status IN ($2, $3)
+		ORDER BY created_at ASC
+	`
+
+	orders := []entity.Order{}
+	err := r.db.SelectContext(ctx, &orders, query, eventID, entity.OrderStatusReserved, entity.OrderStatusPaid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sandbox orders: %w", err)
+	}
+
+	return orders, nil
+}
+
+// SearchByMetadata retrieves a tenant's orders having metadata key, with exactly value if given
+// Includes archived orders; both tables have a GIN index on metadata
+func (r *orderRepository) SearchByMetadata(ctx context.Context, tenantID, key string, value *string, limit, offset int) ([]entity.Order, int64, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	// Key only: ? (key exists), key and value: @> (containment)
+	condition := "metadata ? $2"
+	args := []interface{}{tenantID, key}
+	if value != nil {
+		match, err := entity.OrderMetadata{key: *value}.Value()
+		if err != nil {
+			return nil, 0, err
+		}
+		condition = "metadata @> $2::jsonb"
+		args = []interface{}{tenantID, match}
+	}
+
+	var total int64
+	countQuery := fmt.Sprintf(`
+		SELECT (SELECT COUNT(*) FROM orders WHERE tenant_id = $1 AND %[1]s) +
+		       (SELECT COUNT(*) FROM orders_archive WHERE tenant_id = $1 AND %[1]s)
+	`, condition)
+	if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to count orders by metadata: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, user_id, event_id, tenant_id, total_amount, platform_fee, service_fee,
+		       grand_total, status, payment_id, payment_method, paid_amount, paid_currency,
+		       amount_discrepancy, reservation_expires_at, created_at, updated_at, completed_at,
+		       metadata, is_sandbox, event_sourced
+		FROM orders
+		WHERE tenant_id = $1 AND %[1]s
+		UNION ALL
+		SELECT id, user_id, event_id, tenant_id, total_amount, platform_fee, service_fee,
+		       grand_total, status, payment_id, payment_method, paid_amount, paid_currency,
+		       amount_discrepancy, reservation_expires_at, created_at, updated_at, completed_at,
+		       metadata, is_sandbox, event_sourced
+		FROM orders_archive
+		WHERE tenant_id = $1 AND %[1]s
+		ORDER BY created_at DESC
+		LIMIT $3 OFFSET $4
+	`, condition)
+
+	orders := []entity.Order{}
+	if err := r.db.SelectContext(ctx, &orders, query, append(args, limit, offset)...); err != nil {
+		return nil, 0, fmt.Errorf("failed to search orders by metadata: %w", err)
+	}
+
+	return orders, total, nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -119,7 +120,7 @@ func TestReservationTimeout_ReleaseInventory(t *testing.T) {
 
 	// 2. Simulate reservation: Update sold_count
 	tx, _ := db.DB.BeginTx(ctx, nil)
-	err := tierRepo.UpdateSoldCount(ctx, tx, tierID, quantity)
+	err := tierRepo.UpdateSoldCount(ctx, tx, tierID, quantity, testReserve)
 	require.NoError(t, err)
 	tx.Commit()
 
@@ -143,7 +144,7 @@ func TestReservationTimeout_ReleaseInventory(t *testing.T) {
 	require.NoError(t, err)
 
 	// Release tickets
-	err = tierRepo.ReleaseSoldCount(ctx, tx, tierID, quantity)
+	err = tierRepo.ReleaseSoldCount(ctx, tx, tierID, quantity, testRelease)
 	require.NoError(t, err)
 
 	tx.Commit()
@@ -199,6 +200,66 @@ func TestReservationTimeout_NoDoubleRelease(t *testing.T) {
 	t.Logf("✅ Double release prevention works correctly")
 }
 
+// TestCreateWithTx_RollbackLeavesNoOrder tests that an event-sourced order is created with its
+// order_created event only if the caller's transaction commits, e.g. not when the reservation fails
+func TestCreateWithTx_RollbackLeavesNoOrder(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	// Setup
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+
+	TruncateTables(t, db, "order_events", "orders", "events")
+
+	repo := NewOrderRepository(db)
+	ctx := context.Background()
+	eventID := CreateTestEvent(t, db)
+
+	// Borrow the user of a plain order
+	var userID string
+	require.NoError(t, db.Get(&userID, "SELECT user_id FROM orders WHERE id = $1", createTestOrder(t, db, eventID, time.Now().Add(time.Hour))))
+
+	countOf := func(orderID string) (orders, events int) {
+		require.NoError(t, db.Get(&orders, "SELECT COUNT(*) FROM orders WHERE id = $1", orderID))
+		require.NoError(t, db.Get(&events, "SELECT COUNT(*) FROM order_events WHERE order_id = $1", orderID))
+		return orders, events
+	}
+	newOrder := func() *entity.Order {
+		expiresAt := time.Now().Add(15 * time.Minute)
+		return &entity.Order{
+			UserID:               userID,
+			EventID:              eventID,
+			TenantID:             tenant.DefaultID,
+			Status:               entity.OrderStatusReserved,
+			ReservationExpiresAt: &expiresAt,
+			EventSourced:         true,
+		}
+	}
+
+	// The reservation fails after the order was created
+	tx, err := repo.BeginTx(ctx)
+	require.NoError(t, err)
+	rolledBack := newOrder()
+	require.NoError(t, repo.CreateWithTx(ctx, tx, rolledBack))
+	require.NoError(t, tx.Rollback())
+
+	orders, events := countOf(rolledBack.ID)
+	assert.Zero(t, orders, "Rolled back order should not exist")
+	assert.Zero(t, events, "Rolled back order should have no events")
+
+	tx, err = repo.BeginTx(ctx)
+	require.NoError(t, err)
+	committed := newOrder()
+	require.NoError(t, repo.CreateWithTx(ctx, tx, committed))
+	require.NoError(t, tx.Commit())
+
+	orders, events = countOf(committed.ID)
+	assert.Equal(t, 1, orders)
+	assert.Equal(t, 1, events, "Committed order should have its order_created event")
+}
+
 // Helper function to create test order
 func createTestOrder(t *testing.T, db *sqlx.DB, eventID string, expiresAt time.Time) string {
 	t.Helper()
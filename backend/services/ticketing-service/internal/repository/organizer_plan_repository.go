@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// freePlanCode is the plan of organizers without an assignment
+const freePlanCode = "free"
+
+// OrganizerPlanRepository defines interface for reading organizer plan limits
+type OrganizerPlanRepository interface {
+	GetForOrganizer(ctx context.Context, organizerID string) (*entity.OrganizerPlan, error)
+}
+
+// organizerPlanRepository implements OrganizerPlanRepository interface
+type organizerPlanRepository struct {
+	db *sqlx.DB
+}
+
+// NewOrganizerPlanRepository creates new organizer plan repository instance
+func NewOrganizerPlanRepository(db *sqlx.DB) OrganizerPlanRepository {
+	return &organizerPlanRepository{db: db}
+}
+
+// GetForOrganizer retrieves the plan assigned to an organizer, the free plan if none is
+func (r *organizerPlanRepository) GetForOrganizer(ctx context.Context, organizerID string) (*entity.OrganizerPlan, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	var plan entity.OrganizerPlan
+	query := `
+		SELECT p.code, p.name, p.announcement_emails_per_month
+		FROM organizer_plans p
+		WHERE p.code = COALESCE(
+			(SELECT plan_code FROM organizer_plan_assignments WHERE organizer_id = $1),
+			$2
+		)
+	`
+
+	if err := r.db.GetContext(ctx, &plan, query, organizerID, freePlanCode); err != nil {
+		return nil, fmt.Errorf("failed to get organizer plan: %w", err)
+	}
+
+	return &plan, nil
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+var (
+	ErrPartnerAPIKeyNotFound = errors.New("partner API key not found")
+)
+
+// PartnerAPIKeyRepository defines interface for partner API key data operations
+type PartnerAPIKeyRepository interface {
+	Create(ctx context.Context, key *entity.PartnerAPIKey) error
+	GetByID(ctx context.Context, id string) (*entity.PartnerAPIKey, error)
+	ListByOrganizerID(ctx context.Context, organizerID string) ([]entity.PartnerAPIKey, error)
+	Revoke(ctx context.Context, id string) error
+	TouchLastUsed(ctx context.Context, id string) error
+}
+
+// partnerAPIKeyRepository implements PartnerAPIKeyRepository interface
+type partnerAPIKeyRepository struct {
+	db *sqlx.DB
+}
+
+// NewPartnerAPIKeyRepository creates new partner API key repository instance
+func NewPartnerAPIKeyRepository(db *sqlx.DB) PartnerAPIKeyRepository {
+	return &partnerAPIKeyRepository{db: db}
+}
+
+// Create inserts a new key
+func (r *partnerAPIKeyRepository) Create(ctx context.Context, key *entity.PartnerAPIKey) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO partner_api_keys (id, tenant_id, organizer_id, name, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		RETURNING created_at
+	`
+
+	if key.ID == "" {
+		key.ID = uuid.New().String()
+	}
+
+	err := r.db.QueryRowContext(ctx, query, key.ID, key.TenantID, key.OrganizerID, key.Name).Scan(&key.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create partner API key: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves key by ID
+func (r *partnerAPIKeyRepository) GetByID(ctx context.Context, id string) (*entity.PartnerAPIKey, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, tenant_id, organizer_id, name, last_used_at, revoked_at, created_at
+		FROM partner_api_keys
+		WHERE id = $1
+	`
+
+	key := &entity.PartnerAPIKey{}
+	err := r.db.GetContext(ctx, key, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPartnerAPIKeyNotFound
+		}
+		return nil, fmt.Errorf("failed to get partner API key: %w", err)
+	}
+
+	return key, nil
+}
+
+// ListByOrganizerID retrieves every key of an organizer, revoked ones included
+func (r *partnerAPIKeyRepository) ListByOrganizerID(ctx context.Context, organizerID string) ([]entity.PartnerAPIKey, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, tenant_id, organizer_id, name, last_used_at, revoked_at, created_at
+		FROM partner_api_keys
+		WHERE organizer_id = $1
+		ORDER BY created_at ASC
+	`
+
+	keys := []entity.PartnerAPIKey{}
+	if err := r.db.SelectContext(ctx, &keys, query, organizerID); err != nil {
+		return nil, fmt.Errorf("failed to list partner API keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+// Revoke revokes an active key; requests made with it are rejected immediately
+func (r *partnerAPIKeyRepository) Revoke(ctx context.Context, id string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE partner_api_keys
+		SET revoked_at = NOW()
+		WHERE id = $1 AND revoked_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to revoke partner API key: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrPartnerAPIKeyNotFound
+	}
+
+	return nil
+}
+
+// TouchLastUsed records that the key was just used
+func (r *partnerAPIKeyRepository) TouchLastUsed(ctx context.Context, id string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `UPDATE partner_api_keys SET last_used_at = NOW() WHERE id = $1`
+
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to update partner API key last used: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+var (
+	ErrPolicyDocumentNotFound = errors.New("policy document not found")
+)
+
+// policyDocumentColumns lists the columns selected for a policy document
+const policyDocumentColumns = `id, tenant_id, event_id, type, title, content, version_hash, published_by, created_at`
+
+// PolicyDocumentRepository defines interface for purchase policy data operations
+type PolicyDocumentRepository interface {
+	Create(ctx context.Context, document *entity.PolicyDocument) error
+	GetByID(ctx context.Context, id string) (*entity.PolicyDocument, error)
+	GetCurrent(ctx context.Context, tenantID, eventID string) ([]entity.PolicyDocument, error)
+	CreateAcceptancesWithTx(ctx context.Context, tx *sql.Tx, acceptances []entity.PolicyAcceptance) error
+	GetAcceptancesByOrderID(ctx context.Context, orderID string) ([]entity.PolicyAcceptance, error)
+}
+
+// policyDocumentRepository implements PolicyDocumentRepository interface
+type policyDocumentRepository struct {
+	db *sqlx.DB
+}
+
+// NewPolicyDocumentRepository creates new policy document repository instance
+func NewPolicyDocumentRepository(db *sqlx.DB) PolicyDocumentRepository {
+	return &policyDocumentRepository{db: db}
+}
+
+// Create inserts a new policy version
+func (r *policyDocumentRepository) Create(ctx context.Context, document *entity.PolicyDocument) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO policy_documents (id, tenant_id, event_id, type, title, content, version_hash, published_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+		RETURNING created_at
+	`
+
+	if document.ID == "" {
+		document.ID = uuid.New().String()
+	}
+
+	err := r.db.QueryRowContext(ctx, query,
+		document.ID,
+		document.TenantID,
+		document.EventID,
+		document.Type,
+		document.Title,
+		document.Content,
+		document.VersionHash,
+		document.PublishedBy,
+	).Scan(&document.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create policy document: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a policy version by ID
+func (r *policyDocumentRepository) GetByID(ctx context.Context, id string) (*entity.PolicyDocument, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `SELECT ` + policyDocumentColumns + ` FROM policy_documents WHERE id = $1`
+
+	document := &entity.PolicyDocument{}
+	err := r.db.GetContext(ctx, document, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPolicyDocumentNotFound
+		}
+		return nil, fmt.Errorf("failed to get policy document: %w", err)
+	}
+
+	return document, nil
+}
+
+// GetCurrent retrieves the current version of each policy of a tenant's platform terms
+// and, if eventID is set, of the event's policies
+func (r *policyDocumentRepository) GetCurrent(ctx context.Context, tenantID, eventID string) ([]entity.PolicyDocument, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT DISTINCT ON (type) ` + policyDocumentColumns + `
+		FROM policy_documents
+		WHERE tenant_id = $1 AND (event_id IS NULL OR event_id = NULLIF($2, '')::uuid)
+		ORDER BY type, created_at DESC
+	`
+
+	documents := []entity.PolicyDocument{}
+	if err := r.db.SelectContext(ctx, &documents, query, tenantID, eventID); err != nil {
+		return nil, fmt.Errorf("failed to get current policy documents: %w", err)
+	}
+
+	return documents, nil
+}
+
+// CreateAcceptancesWithTx records the policy versions accepted with an order (must be called within a transaction)
+func (r *policyDocumentRepository) CreateAcceptancesWithTx(ctx context.Context, tx *sql.Tx, acceptances []entity.PolicyAcceptance) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO order_policy_acceptances (order_id, policy_document_id, user_id, accepted_at)
+		VALUES ($1, $2, $3, NOW())
+		RETURNING accepted_at
+	`
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to prepare statement: %w", err)
+	}
+	defer stmt.Close()
+
+	for i := range acceptances {
+		err := stmt.QueryRowContext(ctx,
+			acceptances[i].OrderID,
+			acceptances[i].PolicyDocumentID,
+			acceptances[i].UserID,
+		).Scan(&acceptances[i].AcceptedAt)
+		if err != nil {
+			return fmt.Errorf("failed to insert policy acceptance: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetAcceptancesByOrderID retrieves the policy versions accepted with an order, platform terms first
+func (r *policyDocumentRepository) GetAcceptancesByOrderID(ctx context.Context, orderID string) ([]entity.PolicyAcceptance, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT a.order_id, a.policy_document_id, a.user_id, a.accepted_at,
+		       d.type, d.title, d.version_hash, d.event_id
+		FROM order_policy_acceptances a
+		JOIN policy_documents d ON d.id = a.policy_document_id
+		WHERE a.order_id = $1
+		ORDER BY d.event_id NULLS FIRST, d.type
+	`
+
+	acceptances := []entity.PolicyAcceptance{}
+	if err := r.db.SelectContext(ctx, &acceptances, query, orderID); err != nil {
+		return nil, fmt.Errorf("failed to get policy acceptances: %w", err)
+	}
+
+	return acceptances, nil
+}
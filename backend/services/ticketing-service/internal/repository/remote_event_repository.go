@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// EventReader reads events from their owner, event service
+type EventReader interface {
+	GetEvent(ctx context.Context, id string) (*entity.Event, error)
+	GetEvents(ctx context.Context, ids []string) ([]entity.Event, error)
+}
+
+// TierReader reads ticket tiers from their owner, event service
+type TierReader interface {
+	GetTier(ctx context.Context, id string) (*entity.TicketTier, error)
+	GetTiers(ctx context.Context, ids []string) ([]entity.TicketTier, error)
+	ListTiersByEvent(ctx context.Context, eventID string) ([]entity.TicketTier, error)
+}
+
+// PlanReader reads organizer plans from their owner, event service
+type PlanReader interface {
+	GetOrganizerPlan(ctx context.Context, organizerID string) (*entity.OrganizerPlan, error)
+}
+
+// remoteEventRepository implements EventRepository on top of event service
+type remoteEventRepository struct {
+	reader EventReader
+}
+
+// NewRemoteEventRepository creates an event repository that reads through event service
+// instead of querying the events table directly
+func NewRemoteEventRepository(reader EventReader) EventRepository {
+	return &remoteEventRepository{reader: reader}
+}
+
+// GetByID retrieves event by ID from event service
+func (r *remoteEventRepository) GetByID(ctx context.Context, id string) (*entity.Event, error) {
+	return r.reader.GetEvent(ctx, id)
+}
+
+// GetByIDs retrieves events by IDs from event service
+func (r *remoteEventRepository) GetByIDs(ctx context.Context, ids []string) ([]entity.Event, error) {
+	return r.reader.GetEvents(ctx, ids)
+}
+
+// remoteTicketTierRepository implements TicketTierRepository with reads served by
+// event service and inventory operations kept on the local database
+type remoteTicketTierRepository struct {
+	reader    TierReader
+	inventory TicketTierRepository
+}
+
+// NewRemoteTicketTierRepository creates a ticket tier repository that reads through event service
+// Locked reads and sold_count updates stay on inventory: they must share the reservation transaction
+func NewRemoteTicketTierRepository(reader TierReader, inventory TicketTierRepository) TicketTierRepository {
+	return &remoteTicketTierRepository{reader: reader, inventory: inventory}
+}
+
+// GetByID retrieves ticket tier by ID from event service
+func (r *remoteTicketTierRepository) GetByID(ctx context.Context, id string) (*entity.TicketTier, error) {
+	return r.reader.GetTier(ctx, id)
+}
+
+// GetByIDs retrieves ticket tiers by IDs from event service
+func (r *remoteTicketTierRepository) GetByIDs(ctx context.Context, ids []string) ([]entity.TicketTier, error) {
+	return r.reader.GetTiers(ctx, ids)
+}
+
+// GetByEventID retrieves all ticket tiers for an event from event service
+func (r *remoteTicketTierRepository) GetByEventID(ctx context.Context, eventID string) ([]entity.TicketTier, error) {
+	return r.reader.ListTiersByEvent(ctx, eventID)
+}
+
+// GetByIDWithLock locks the tier row within tx on the local database
+func (r *remoteTicketTierRepository) GetByIDWithLock(ctx context.Context, tx *sql.Tx, id string) (*entity.TicketTier, error) {
+	return r.inventory.GetByIDWithLock(ctx, tx, id)
+}
+
+// CheckAvailability checks the remaining quota on the local database
+func (r *remoteTicketTierRepository) CheckAvailability(ctx context.Context, tierID string, quantity int) (bool, error) {
+	return r.inventory.CheckAvailability(ctx, tierID, quantity)
+}
+
+// UpdateSoldCount increments sold_count within tx on the local database
+func (r *remoteTicketTierRepository) UpdateSoldCount(ctx context.Context, tx *sql.Tx, tierID string, quantity int, cause entity.InventoryCause) error {
+	return r.inventory.UpdateSoldCount(ctx, tx, tierID, quantity, cause)
+}
+
+// ReleaseSoldCount decrements sold_count within tx on the local database
+func (r *remoteTicketTierRepository) ReleaseSoldCount(ctx context.Context, tx *sql.Tx, tierID string, quantity int, cause entity.InventoryCause) error {
+	return r.inventory.ReleaseSoldCount(ctx, tx, tierID, quantity, cause)
+}
+
+// UpdateAccessibleSoldCount increments sold_count and accessible_sold_count within tx on the local database
+func (r *remoteTicketTierRepository) UpdateAccessibleSoldCount(ctx context.Context, tx *sql.Tx, tierID string, quantity int, cause entity.InventoryCause) error {
+	return r.inventory.UpdateAccessibleSoldCount(ctx, tx, tierID, quantity, cause)
+}
+
+// ReleaseAccessibleSoldCount decrements sold_count and accessible_sold_count within tx on the local database
+func (r *remoteTicketTierRepository) ReleaseAccessibleSoldCount(ctx context.Context, tx *sql.Tx, tierID string, quantity int, cause entity.InventoryCause) error {
+	return r.inventory.ReleaseAccessibleSoldCount(ctx, tx, tierID, quantity, cause)
+}
+
+// remoteOrganizerPlanRepository implements OrganizerPlanRepository on top of event service
+type remoteOrganizerPlanRepository struct {
+	reader PlanReader
+}
+
+// NewRemoteOrganizerPlanRepository creates an organizer plan repository that reads through event service
+func NewRemoteOrganizerPlanRepository(reader PlanReader) OrganizerPlanRepository {
+	return &remoteOrganizerPlanRepository{reader: reader}
+}
+
+// GetForOrganizer retrieves the organizer's plan from event service
+func (r *remoteOrganizerPlanRepository) GetForOrganizer(ctx context.Context, organizerID string) (*entity.OrganizerPlan, error) {
+	return r.reader.GetOrganizerPlan(ctx, organizerID)
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// ScheduledJobRepository defines interface for delayed job data operations
+type ScheduledJobRepository interface {
+	CreateWithTx(ctx context.Context, tx *sql.Tx, job *entity.ScheduledJob) error
+	ClaimDue(ctx context.Context, limit int, lockedUntil time.Time) ([]entity.ScheduledJob, error)
+	MarkDone(ctx context.Context, id string) error
+	Retry(ctx context.Context, id, lastError string, runAt time.Time) error
+	MarkFailed(ctx context.Context, id, lastError string) error
+}
+
+// scheduledJobRepository implements ScheduledJobRepository interface
+type scheduledJobRepository struct {
+	db *sqlx.DB
+}
+
+// NewScheduledJobRepository creates new scheduled job repository instance
+func NewScheduledJobRepository(db *sqlx.DB) ScheduledJobRepository {
+	return &scheduledJobRepository{db: db}
+}
+
+// CreateWithTx schedules a job with the change it belongs to (must be called within a transaction)
+// A job of the same type and reference is scheduled once, later calls are ignored
+func (r *scheduledJobRepository) CreateWithTx(ctx context.Context, tx *sql.Tx, job *entity.ScheduledJob) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO scheduled_jobs (id, type, reference_id, run_at, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		ON CONFLICT (type, reference_id) DO NOTHING
+	`
+
+	if job.ID == "" {
+		job.ID = uuid.New().String()
+	}
+	job.Status = entity.JobStatusPending
+
+	if _, err := tx.ExecContext(ctx, query, job.ID, job.Type, job.ReferenceID, job.RunAt, job.Status); err != nil {
+		return fmt.Errorf("failed to schedule job: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimDue leases up to limit due jobs to the caller until lockedUntil, oldest first
+// Running jobs whose lease ran out (the worker crashed) are due again
+// SKIP LOCKED lets multiple instances claim concurrently without taking the same job
+func (r *scheduledJobRepository) ClaimDue(ctx context.Context, limit int, lockedUntil time.Time) ([]entity.ScheduledJob, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE scheduled_jobs
+		SET status = $1, attempts = attempts + 1, locked_until = $2, updated_at = NOW()
+		WHERE id IN (
+			SELECT id
+			FROM scheduled_jobs
+			WHERE (status = $3 AND run_at <= NOW()) OR (status = $1 AND locked_until < NOW())
+			ORDER BY run_at ASC
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, type, reference_id, run_at, status, attempts, last_error, locked_until, created_at, updated_at
+	`
+
+	jobs := []entity.ScheduledJob{}
+	err := r.db.SelectContext(ctx, &jobs, query, entity.JobStatusRunning, lockedUntil, entity.JobStatusPending, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim due jobs: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// MarkDone records that a claimed job finished
+func (r *scheduledJobRepository) MarkDone(ctx context.Context, id string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE scheduled_jobs
+		SET status = $2, locked_until = NULL, updated_at = NOW()
+		WHERE id = $1 AND status = $3
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, entity.JobStatusDone, entity.JobStatusRunning); err != nil {
+		return fmt.Errorf("failed to mark job done: %w", err)
+	}
+
+	return nil
+}
+
+// Retry puts a claimed job that failed back in the queue until runAt
+func (r *scheduledJobRepository) Retry(ctx context.Context, id, lastError string, runAt time.Time) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE scheduled_jobs
+		SET status = $2, run_at = $3, last_error = $4, locked_until = NULL, updated_at = NOW()
+		WHERE id = $1 AND status = $5
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, entity.JobStatusPending, runAt, lastError, entity.JobStatusRunning); err != nil {
+		return fmt.Errorf("failed to reschedule job: %w", err)
+	}
+
+	return nil
+}
+
+// MarkFailed records that a claimed job failed for the last time
+func (r *scheduledJobRepository) MarkFailed(ctx context.Context, id, lastError string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE scheduled_jobs
+		SET status = $2, last_error = $3, locked_until = NULL, updated_at = NOW()
+		WHERE id = $1 AND status = $4
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, entity.JobStatusFailed, lastError, entity.JobStatusRunning); err != nil {
+		return fmt.Errorf("failed to mark job failed: %w", err)
+	}
+
+	return nil
+}
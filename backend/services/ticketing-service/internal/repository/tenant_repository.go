@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+var (
+	ErrTenantNotFound         = errors.New("tenant not found")
+	ErrEmailSenderNotFound    = errors.New("verified email sender not found")
+	ErrTicketTemplateNotFound = errors.New("ticket template not found")
+)
+
+// TenantRepository defines interface for tenant data operations
+type TenantRepository interface {
+	GetByID(ctx context.Context, id string) (*entity.Tenant, error)
+
+	// White-label senders of organizers, only verified ones
+	GetVerifiedSender(ctx context.Context, organizerID string) (*entity.OrganizerEmailSender, error)
+
+	// E-ticket PDF layouts of organizers
+	GetTicketTemplate(ctx context.Context, organizerID string) (*entity.OrganizerTicketTemplate, error)
+}
+
+// tenantRepository implements TenantRepository interface
+type tenantRepository struct {
+	db *sqlx.DB
+}
+
+// NewTenantRepository creates new tenant repository instance
+func NewTenantRepository(db *sqlx.DB) TenantRepository {
+	return &tenantRepository{db: db}
+}
+
+// GetByID retrieves tenant by ID using sqlx
+func (r *tenantRepository) GetByID(ctx context.Context, id string) (*entity.Tenant, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	var tenant entity.Tenant
+	query := `
+		SELECT id, name, logo_url, primary_color, support_email, email_from_name,
+		       email_from_address, platform_fee_percent, service_fee
+		FROM tenants
+		WHERE id = $1
+	`
+
+	err := r.db.GetContext(ctx, &tenant, query, id)
+	if err != nil {
+		if err.Error() == "sql: no rows in result set" {
+			return nil, ErrTenantNotFound
+		}
+		return nil, err
+	}
+
+	return &tenant, nil
+}
+
+// GetVerifiedSender retrieves the organizer's own email sender if its domain is verified
+func (r *tenantRepository) GetVerifiedSender(ctx context.Context, organizerID string) (*entity.OrganizerEmailSender, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	var sender entity.OrganizerEmailSender
+	query := `
+		SELECT organizer_id, from_name, from_email, reply_to
+		FROM organizer_email_senders
+		WHERE organizer_id = $1 AND status = 'verified'
+	`
+
+	err := r.db.GetContext(ctx, &sender, query, organizerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrEmailSenderNotFound
+		}
+		return nil, err
+	}
+
+	return &sender, nil
+}
+
+// GetTicketTemplate retrieves the organizer's e-ticket template
+func (r *tenantRepository) GetTicketTemplate(ctx context.Context, organizerID string) (*entity.OrganizerTicketTemplate, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	var tpl entity.OrganizerTicketTemplate
+	query := `
+		SELECT organizer_id, logo_url, primary_color, accent_color, sponsor_footer, terms_text
+		FROM organizer_ticket_templates
+		WHERE organizer_id = $1
+	`
+
+	err := r.db.GetContext(ctx, &tpl, query, organizerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTicketTemplateNotFound
+		}
+		return nil, err
+	}
+
+	return &tpl, nil
+}
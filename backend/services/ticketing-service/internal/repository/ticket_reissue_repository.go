@@ -0,0 +1,260 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+var (
+	ErrTicketReissueNotFound = errors.New("ticket reissue not found")
+)
+
+// TicketReissueRepository defines interface for bulk ticket reissue data operations
+type TicketReissueRepository interface {
+	GetReissuableItems(ctx context.Context, eventID string) ([]entity.TicketReissueItem, error)
+	CreateWithTx(ctx context.Context, tx *sql.Tx, reissue *entity.TicketReissue, items []entity.TicketReissueItem) error
+	GetByID(ctx context.Context, id string) (*entity.TicketReissue, error)
+	HasActive(ctx context.Context, eventID string) (bool, error)
+	GetProgress(ctx context.Context, reissueID string) (*entity.TicketReissueProgress, error)
+	GetPendingTickets(ctx context.Context, batchID string) ([]entity.Ticket, error)
+	MarkTicket(ctx context.Context, batchID, ticketID, status string) error
+	GetUnemailedOrderIDs(ctx context.Context, batchID string) ([]string, error)
+	MarkOrderEmailed(ctx context.Context, batchID, orderID string) error
+}
+
+// ticketReissueRepository implements TicketReissueRepository interface
+type ticketReissueRepository struct {
+	db *sqlx.DB
+}
+
+// NewTicketReissueRepository creates new ticket reissue repository instance
+func NewTicketReissueRepository(db *sqlx.DB) TicketReissueRepository {
+	return &ticketReissueRepository{db: db}
+}
+
+// GetReissuableItems retrieves the valid tickets of an event, grouped by order
+// BatchID is left empty for the caller to assign
+func (r *ticketReissueRepository) GetReissuableItems(ctx context.Context, eventID string) ([]entity.TicketReissueItem, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id AS ticket_id, order_id
+		FROM tickets
+		WHERE event_id = $1 AND status = $2
+		ORDER BY order_id, ticket_number
+	`
+
+	items := []entity.TicketReissueItem{}
+	if err := r.db.SelectContext(ctx, &items, query, eventID, entity.TicketStatusValid); err != nil {
+		return nil, fmt.Errorf("failed to get reissuable tickets: %w", err)
+	}
+
+	return items, nil
+}
+
+// CreateWithTx inserts a reissue with its tickets (must be called within a transaction)
+func (r *ticketReissueRepository) CreateWithTx(ctx context.Context, tx *sql.Tx, reissue *entity.TicketReissue, items []entity.TicketReissueItem) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	reissue.ID = uuid.New().String()
+
+	query := `
+		INSERT INTO ticket_reissues (id, event_id, reason, requested_by, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		RETURNING created_at
+	`
+
+	err := tx.QueryRowContext(ctx, query, reissue.ID, reissue.EventID, reissue.Reason, reissue.RequestedBy).
+		Scan(&reissue.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert ticket reissue: %w", err)
+	}
+
+	ticketIDs := make([]string, len(items))
+	orderIDs := make([]string, len(items))
+	batchIDs := make([]string, len(items))
+	for i, item := range items {
+		ticketIDs[i], orderIDs[i], batchIDs[i] = item.TicketID, item.OrderID, item.BatchID
+	}
+
+	query = `
+		INSERT INTO ticket_reissue_items (reissue_id, ticket_id, order_id, batch_id, status, updated_at)
+		SELECT $1, ticket_id, order_id, batch_id, $5, NOW()
+		FROM UNNEST($2::uuid[], $3::uuid[], $4::uuid[]) AS item(ticket_id, order_id, batch_id)
+	`
+
+	_, err = tx.ExecContext(ctx, query, reissue.ID, pq.Array(ticketIDs), pq.Array(orderIDs), pq.Array(batchIDs), entity.TicketReissuePending)
+	if err != nil {
+		return fmt.Errorf("failed to insert ticket reissue items: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a reissue by ID
+func (r *ticketReissueRepository) GetByID(ctx context.Context, id string) (*entity.TicketReissue, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, event_id, reason, requested_by, created_at
+		FROM ticket_reissues
+		WHERE id = $1
+	`
+
+	var reissue entity.TicketReissue
+	if err := r.db.GetContext(ctx, &reissue, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTicketReissueNotFound
+		}
+		return nil, fmt.Errorf("failed to get ticket reissue: %w", err)
+	}
+
+	return &reissue, nil
+}
+
+// HasActive reports whether a reissue of the event still has work left in batches that didn't fail
+func (r *ticketReissueRepository) HasActive(ctx context.Context, eventID string) (bool, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM ticket_reissue_items i
+			JOIN ticket_reissues r ON r.id = i.reissue_id
+			JOIN scheduled_jobs j ON j.type = $2 AND j.reference_id = i.batch_id
+			WHERE r.event_id = $1
+			  AND (i.status = $3 OR (i.status = $4 AND i.emailed_at IS NULL))
+			  AND j.status <> $5
+		)
+	`
+
+	var active bool
+	err := r.db.GetContext(ctx, &active, query,
+		eventID, entity.JobTypeTicketReissue, entity.TicketReissuePending, entity.TicketReissueReissued, entity.JobStatusFailed)
+	if err != nil {
+		return false, fmt.Errorf("failed to check active ticket reissues: %w", err)
+	}
+
+	return active, nil
+}
+
+// GetProgress counts the tickets and batches of a reissue by state
+func (r *ticketReissueRepository) GetProgress(ctx context.Context, reissueID string) (*entity.TicketReissueProgress, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT COUNT(*) AS tickets,
+		       COUNT(*) FILTER (WHERE i.status = $2) AS pending,
+		       COUNT(*) FILTER (WHERE i.status = $3) AS reissued,
+		       COUNT(*) FILTER (WHERE i.status = $4) AS skipped,
+		       COUNT(*) FILTER (WHERE i.emailed_at IS NOT NULL) AS emailed,
+		       COUNT(DISTINCT i.batch_id) AS batches,
+		       COUNT(DISTINCT i.batch_id) FILTER (WHERE j.status IN ($7, $8)) AS active_batches,
+		       COUNT(DISTINCT i.batch_id) FILTER (WHERE j.status = $5) AS failed_batches
+		FROM ticket_reissue_items i
+		LEFT JOIN scheduled_jobs j ON j.type = $6 AND j.reference_id = i.batch_id
+		WHERE i.reissue_id = $1
+	`
+
+	var progress entity.TicketReissueProgress
+	err := r.db.GetContext(ctx, &progress, query, reissueID,
+		entity.TicketReissuePending, entity.TicketReissueReissued, entity.TicketReissueSkipped,
+		entity.JobStatusFailed, entity.JobTypeTicketReissue, entity.JobStatusPending, entity.JobStatusRunning)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket reissue progress: %w", err)
+	}
+
+	return &progress, nil
+}
+
+// GetPendingTickets retrieves the tickets of a batch whose code is not rotated yet
+func (r *ticketReissueRepository) GetPendingTickets(ctx context.Context, batchID string) ([]entity.Ticket, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT t.id, t.order_id, t.order_item_id, t.ticket_tier_id, t.event_id, t.user_id,
+		       t.ticket_number, t.qr_code, t.qr_data, t.status, t.validated_at,
+		       t.void_reason, t.voided_by, t.voided_at, t.created_at, t.updated_at
+		FROM ticket_reissue_items i
+		JOIN tickets t ON t.id = i.ticket_id
+		WHERE i.batch_id = $1 AND i.status = $2
+		ORDER BY t.order_id, t.ticket_number
+	`
+
+	tickets := []entity.Ticket{}
+	if err := r.db.SelectContext(ctx, &tickets, query, batchID, entity.TicketReissuePending); err != nil {
+		return nil, fmt.Errorf("failed to get pending reissue tickets: %w", err)
+	}
+
+	return tickets, nil
+}
+
+// MarkTicket records the outcome of rotating a ticket's code
+func (r *ticketReissueRepository) MarkTicket(ctx context.Context, batchID, ticketID, status string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE ticket_reissue_items
+		SET status = $3, updated_at = NOW()
+		WHERE batch_id = $1 AND ticket_id = $2
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, batchID, ticketID, status); err != nil {
+		return fmt.Errorf("failed to mark reissue ticket: %w", err)
+	}
+
+	return nil
+}
+
+// GetUnemailedOrderIDs retrieves the orders of a batch with reissued tickets not emailed yet
+func (r *ticketReissueRepository) GetUnemailedOrderIDs(ctx context.Context, batchID string) ([]string, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT DISTINCT order_id
+		FROM ticket_reissue_items
+		WHERE batch_id = $1 AND status = $2 AND emailed_at IS NULL
+		ORDER BY order_id
+	`
+
+	orderIDs := []string{}
+	if err := r.db.SelectContext(ctx, &orderIDs, query, batchID, entity.TicketReissueReissued); err != nil {
+		return nil, fmt.Errorf("failed to get unemailed reissue orders: %w", err)
+	}
+
+	return orderIDs, nil
+}
+
+// MarkOrderEmailed records that the reissued tickets of an order in a batch were emailed
+func (r *ticketReissueRepository) MarkOrderEmailed(ctx context.Context, batchID, orderID string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE ticket_reissue_items
+		SET emailed_at = NOW(), updated_at = NOW()
+		WHERE batch_id = $1 AND order_id = $2 AND status = $3
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, batchID, orderID, entity.TicketReissueReissued); err != nil {
+		return fmt.Errorf("failed to mark reissue order emailed: %w", err)
+	}
+
+	return nil
+}
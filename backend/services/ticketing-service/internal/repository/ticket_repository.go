@@ -9,11 +9,14 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
 )
 
 var (
 	ErrTicketNotFound = errors.New("ticket not found")
+	ErrTicketNotValid = errors.New("ticket not found or no longer valid")
 )
 
 // TicketRepository defines interface for ticket data operations
@@ -23,8 +26,13 @@ type TicketRepository interface {
 	GetByID(ctx context.Context, id string) (*entity.Ticket, error)
 	GetByOrderID(ctx context.Context, orderID string) ([]entity.Ticket, error)
 	GetByUserID(ctx context.Context, userID string) ([]entity.Ticket, error)
+	GetAdmittableByEventID(ctx context.Context, eventID string, ticketIDs []string, limit int) ([]entity.Ticket, error)
+	GetHolderEmailsByEventID(ctx context.Context, eventID string) ([]string, error)
 	Update(ctx context.Context, ticket *entity.Ticket) error
 	MarkAsUsed(ctx context.Context, ticketID string) error
+	UpdateQR(ctx context.Context, ticketID, qrData, qrCode string) error
+	EnsureQRSecret(ctx context.Context, ticketID, secret string) (string, error)
+	Void(ctx context.Context, ticketID, reason, voidedBy string) error
 }
 
 // ticketRepository implements TicketRepository interface
@@ -39,6 +47,9 @@ func NewTicketRepository(db *sqlx.DB) TicketRepository {
 
 // Create inserts new ticket (must be called within a transaction)
 func (r *ticketRepository) Create(ctx context.Context, tx *sql.Tx, ticket *entity.Ticket) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		INSERT INTO tickets (
 			id, order_id, order_item_id, ticket_tier_id, event_id, user_id,
@@ -74,6 +85,9 @@ func (r *ticketRepository) Create(ctx context.Context, tx *sql.Tx, ticket *entit
 
 // CreateBatch inserts multiple tickets in one transaction
 func (r *ticketRepository) CreateBatch(ctx context.Context, tx *sql.Tx, tickets []entity.Ticket) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		INSERT INTO tickets (
 			id, order_id, order_item_id, ticket_tier_id, event_id, user_id,
@@ -118,9 +132,13 @@ func (r *ticketRepository) CreateBatch(ctx context.Context, tx *sql.Tx, tickets
 
 // GetByID retrieves ticket by ID using sqlx
 func (r *ticketRepository) GetByID(ctx context.Context, id string) (*entity.Ticket, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT id, order_id, order_item_id, ticket_tier_id, event_id, user_id,
-		       ticket_number, qr_code, qr_data, status, validated_at, created_at, updated_at
+		       ticket_number, qr_code, qr_data, qr_secret, status, validated_at,
+		       void_reason, voided_by, voided_at, created_at, updated_at
 		FROM tickets
 		WHERE id = $1
 	`
@@ -138,12 +156,23 @@ func (r *ticketRepository) GetByID(ctx context.Context, id string) (*entity.Tick
 }
 
 // GetByOrderID retrieves all tickets for an order using sqlx
+// Includes archived tickets (read-only history)
 func (r *ticketRepository) GetByOrderID(ctx context.Context, orderID string) ([]entity.Ticket, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT id, order_id, order_item_id, ticket_tier_id, event_id, user_id,
-		       ticket_number, qr_code, qr_data, status, validated_at, created_at, updated_at
+		       ticket_number, qr_code, qr_data, status, validated_at,
+		       void_reason, voided_by, voided_at, created_at, updated_at
 		FROM tickets
 		WHERE order_id = $1
+		UNION ALL
+		SELECT id, order_id, order_item_id, ticket_tier_id, event_id, user_id,
+		       ticket_number, qr_code, qr_data, status, validated_at,
+		       void_reason, voided_by, voided_at, created_at, updated_at
+		FROM tickets_archive
+		WHERE order_id = $1
 		ORDER BY created_at ASC
 	`
 
@@ -157,12 +186,23 @@ func (r *ticketRepository) GetByOrderID(ctx context.Context, orderID string) ([]
 }
 
 // GetByUserID retrieves all tickets for a user using sqlx
+// Includes archived tickets (read-only history)
 func (r *ticketRepository) GetByUserID(ctx context.Context, userID string) ([]entity.Ticket, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		SELECT id, order_id, order_item_id, ticket_tier_id, event_id, user_id,
-		       ticket_number, qr_code, qr_data, status, validated_at, created_at, updated_at
+		       ticket_number, qr_code, qr_data, status, validated_at,
+		       void_reason, voided_by, voided_at, created_at, updated_at
 		FROM tickets
 		WHERE user_id = $1
+		UNION ALL
+		SELECT id, order_id, order_item_id, ticket_tier_id, event_id, user_id,
+		       ticket_number, qr_code, qr_data, status, validated_at,
+		       void_reason, voided_by, voided_at, created_at, updated_at
+		FROM tickets_archive
+		WHERE user_id = $1
 		ORDER BY created_at DESC
 	`
 
@@ -175,8 +215,63 @@ func (r *ticketRepository) GetByUserID(ctx context.Context, userID string) ([]en
 	return tickets, nil
 }
 
+// GetAdmittableByEventID retrieves valid and used tickets of an event ordered by ticket number
+// When ticketIDs is not empty only those tickets are returned. Archived tickets are skipped,
+// their events are long over.
+func (r *ticketRepository) GetAdmittableByEventID(ctx context.Context, eventID string, ticketIDs []string, limit int) ([]entity.Ticket, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, order_id, order_item_id, ticket_tier_id, event_id, user_id,
+		       ticket_number, qr_code, qr_data, status, validated_at,
+		       void_reason, voided_by, voided_at, created_at, updated_at
+		FROM tickets
+		WHERE event_id = $1
+		  AND status IN ($2, $3)
+		  AND (COALESCE(cardinality($4::uuid[]), 0) = 0 OR id = ANY($4::uuid[]))
+		ORDER BY ticket_number ASC
+		LIMIT $5
+	`
+
+	tickets := []entity.Ticket{}
+	err := r.db.SelectContext(ctx, &tickets, query,
+		eventID, entity.TicketStatusValid, entity.TicketStatusUsed, pq.Array(ticketIDs), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event tickets: %w", err)
+	}
+
+	return tickets, nil
+}
+
+// GetHolderEmailsByEventID retrieves the distinct emails of holders of valid and used tickets of an event
+func (r *ticketRepository) GetHolderEmailsByEventID(ctx context.Context, eventID string) ([]string, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT DISTINCT u.email
+		FROM tickets t
+		JOIN users u ON u.id = t.user_id
+		WHERE t.event_id = $1
+		  AND t.status IN ($2, $3)
+		ORDER BY u.email ASC
+	`
+
+	emails := []string{}
+	err := r.db.SelectContext(ctx, &emails, query, eventID, entity.TicketStatusValid, entity.TicketStatusUsed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket holder emails: %w", err)
+	}
+
+	return emails, nil
+}
+
 // Update updates ticket information using sqlx
 func (r *ticketRepository) Update(ctx context.Context, ticket *entity.Ticket) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		UPDATE tickets
 		SET status = $1, validated_at = $2, updated_at = NOW()
@@ -209,6 +304,9 @@ func (r *ticketRepository) Update(ctx context.Context, ticket *entity.Ticket) er
 
 // MarkAsUsed marks a ticket as used (scanned at event entrance) using sqlx
 func (r *ticketRepository) MarkAsUsed(ctx context.Context, ticketID string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
 		UPDATE tickets
 		SET status = $1, validated_at = $2, updated_at = NOW()
@@ -240,3 +338,94 @@ func (r *ticketRepository) MarkAsUsed(ctx context.Context, ticketID string) erro
 
 	return nil
 }
+
+// UpdateQR replaces the QR payload and image of a valid ticket
+// The previous payload stops validating as soon as this commits, and so do
+// the rotating QR codes of the previous secret
+func (r *ticketRepository) UpdateQR(ctx context.Context, ticketID, qrData, qrCode string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE tickets
+		SET qr_data = $1, qr_code = $2, qr_secret = NULL, updated_at = NOW()
+		WHERE id = $3 AND status = $4
+	`
+
+	result, err := r.db.ExecContext(ctx, query, qrData, qrCode, ticketID, entity.TicketStatusValid)
+	if err != nil {
+		return fmt.Errorf("failed to update ticket QR: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrTicketNotValid
+	}
+
+	return nil
+}
+
+// EnsureQRSecret sets the rotating QR secret of a valid ticket unless it already has one
+// Returns the ticket's secret, so concurrent first fetches agree on it
+func (r *ticketRepository) EnsureQRSecret(ctx context.Context, ticketID, secret string) (string, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE tickets
+		SET qr_secret = COALESCE(qr_secret, $1), updated_at = NOW()
+		WHERE id = $2 AND status = $3
+		RETURNING qr_secret
+	`
+
+	var stored string
+	err := r.db.QueryRowContext(ctx, query, secret, ticketID, entity.TicketStatusValid).Scan(&stored)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrTicketNotValid
+		}
+		return "", fmt.Errorf("failed to set ticket QR secret: %w", err)
+	}
+
+	return stored, nil
+}
+
+// Void marks a valid ticket as void with the reason and the user who revoked it
+func (r *ticketRepository) Void(ctx context.Context, ticketID, reason, voidedBy string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE tickets
+		SET status = $1, void_reason = $2, voided_by = $3, voided_at = NOW(), updated_at = NOW()
+		WHERE id = $4 AND status = $5
+	`
+
+	result, err := r.db.ExecContext(
+		ctx,
+		query,
+		entity.TicketStatusVoid,
+		reason,
+		voidedBy,
+		ticketID,
+		entity.TicketStatusValid,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to void ticket: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrTicketNotValid
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+// ScanCheck decides whether a scan is allowed for the locked ticket and its scan history
+// (newest first) and returns the scan to record
+type ScanCheck func(ticket *entity.Ticket, history []entity.TicketScan) (*entity.TicketScan, error)
+
+// TicketScanRepository defines interface for ticket scan history data operations
+type TicketScanRepository interface {
+	RecordScan(ctx context.Context, ticketID string, check ScanCheck) (*entity.TicketScan, error)
+	ListByTicketID(ctx context.Context, ticketID string) ([]entity.TicketScan, error)
+}
+
+// ticketScanRepository implements TicketScanRepository interface
+type ticketScanRepository struct {
+	db *sqlx.DB
+}
+
+// NewTicketScanRepository creates new ticket scan repository instance
+func NewTicketScanRepository(db *sqlx.DB) TicketScanRepository {
+	return &ticketScanRepository{db: db}
+}
+
+// RecordScan stores a scan approved by check in one transaction
+// The ticket row is locked (SELECT FOR UPDATE) so concurrent scans of the same ticket
+// are decided one after another; the first entry scan also marks the ticket as used.
+// Errors returned by check are passed through unchanged.
+func (r *ticketScanRepository) RecordScan(ctx context.Context, ticketID string, check ScanCheck) (*entity.TicketScan, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	ticket := &entity.Ticket{}
+	err = tx.GetContext(ctx, ticket, `
+		SELECT id, order_id, order_item_id, ticket_tier_id, event_id, user_id,
+		       ticket_number, qr_code, qr_data, status, validated_at,
+		       void_reason, voided_by, voided_at, created_at, updated_at
+		FROM tickets
+		WHERE id = $1
+		FOR UPDATE
+	`, ticketID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTicketNotFound
+		}
+		return nil, fmt.Errorf("failed to lock ticket: %w", err)
+	}
+
+	history := []entity.TicketScan{}
+	err = tx.SelectContext(ctx, &history, `
+		SELECT id, ticket_id, event_id, direction, zone, scanned_at
+		FROM ticket_scans
+		WHERE ticket_id = $1
+		ORDER BY scanned_at DESC
+	`, ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan history: %w", err)
+	}
+
+	scan, err := check(ticket, history)
+	if err != nil {
+		return nil, err
+	}
+
+	if scan.ID == "" {
+		scan.ID = uuid.New().String()
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO ticket_scans (id, ticket_id, event_id, direction, zone, scanned_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, scan.ID, scan.TicketID, scan.EventID, scan.Direction, scan.Zone, scan.ScannedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record scan: %w", err)
+	}
+
+	if scan.IsEntry() && ticket.CanBeUsed() {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE tickets
+			SET status = $1, validated_at = $2, updated_at = NOW()
+			WHERE id = $3
+		`, entity.TicketStatusUsed, scan.ScannedAt, ticketID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to mark ticket as used: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return scan, nil
+}
+
+// ListByTicketID retrieves the scan history of a ticket, newest first
+func (r *ticketScanRepository) ListByTicketID(ctx context.Context, ticketID string) ([]entity.TicketScan, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, ticket_id, event_id, direction, zone, scanned_at
+		FROM ticket_scans
+		WHERE ticket_id = $1
+		ORDER BY scanned_at DESC
+	`
+
+	scans := []entity.TicketScan{}
+	if err := r.db.SelectContext(ctx, &scans, query, ticketID); err != nil {
+		return nil, fmt.Errorf("failed to get ticket scans: %w", err)
+	}
+
+	return scans, nil
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+var (
+	ErrShareLinkNotFound = errors.New("share link not found")
+)
+
+// TicketShareRepository defines interface for ticket share link data operations
+type TicketShareRepository interface {
+	Create(ctx context.Context, link *entity.TicketShareLink) error
+	GetByID(ctx context.Context, id string) (*entity.TicketShareLink, error)
+	RevokeByTicketID(ctx context.Context, ticketID string) (int64, error)
+}
+
+// ticketShareRepository implements TicketShareRepository interface
+type ticketShareRepository struct {
+	db *sqlx.DB
+}
+
+// NewTicketShareRepository creates new ticket share repository instance
+func NewTicketShareRepository(db *sqlx.DB) TicketShareRepository {
+	return &ticketShareRepository{db: db}
+}
+
+// Create inserts a new share link
+func (r *ticketShareRepository) Create(ctx context.Context, link *entity.TicketShareLink) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO ticket_share_links (id, ticket_id, created_by, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		RETURNING created_at
+	`
+
+	if link.ID == "" {
+		link.ID = uuid.New().String()
+	}
+
+	err := r.db.QueryRowContext(ctx, query, link.ID, link.TicketID, link.CreatedBy, link.ExpiresAt).Scan(&link.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create share link: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves share link by ID
+func (r *ticketShareRepository) GetByID(ctx context.Context, id string) (*entity.TicketShareLink, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT id, ticket_id, created_by, expires_at, revoked_at, created_at
+		FROM ticket_share_links
+		WHERE id = $1
+	`
+
+	link := &entity.TicketShareLink{}
+	err := r.db.GetContext(ctx, link, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrShareLinkNotFound
+		}
+		return nil, fmt.Errorf("failed to get share link: %w", err)
+	}
+
+	return link, nil
+}
+
+// RevokeByTicketID revokes all active share links of a ticket
+// Returns the number of links revoked
+func (r *ticketShareRepository) RevokeByTicketID(ctx context.Context, ticketID string) (int64, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE ticket_share_links
+		SET revoked_at = NOW()
+		WHERE ticket_id = $1 AND revoked_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, ticketID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke share links: %w", err)
+	}
+
+	return result.RowsAffected()
+}
@@ -5,24 +5,41 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/metrics"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
 )
 
 var (
 	ErrTicketTierNotFound = errors.New("ticket tier not found")
 	ErrInsufficientQuota  = errors.New("insufficient ticket quota")
+
+	ErrInsufficientAccessibleQuota = errors.New("insufficient accessible seating quota")
+)
+
+// Ticket tier metrics (exposed via /debug/vars)
+var (
+	tierRowLockWait = metrics.NewHistogram("ticket_tier_row_lock_wait", metrics.DefaultWaitBuckets)
+	// Raised when a locked tier reads sold_count above quota or an update hits no_overselling;
+	// the conditional updates should make both impossible, so any occurrence needs investigation
+	tierOversoldAlarm = metrics.NewAlarm("ticket_tier_oversold_alarm")
 )
 
 // TicketTierRepository defines interface for ticket tier operations
 type TicketTierRepository interface {
 	GetByID(ctx context.Context, id string) (*entity.TicketTier, error)
+	GetByIDs(ctx context.Context, ids []string) ([]entity.TicketTier, error)
 	GetByIDWithLock(ctx context.Context, tx *sql.Tx, id string) (*entity.TicketTier, error)
 	GetByEventID(ctx context.Context, eventID string) ([]entity.TicketTier, error)
 	CheckAvailability(ctx context.Context, tierID string, quantity int) (bool, error)
-	UpdateSoldCount(ctx context.Context, tx *sql.Tx, tierID string, quantity int) error
-	ReleaseSoldCount(ctx context.Context, tx *sql.Tx, tierID string, quantity int) error
+	UpdateSoldCount(ctx context.Context, tx *sql.Tx, tierID string, quantity int, cause entity.InventoryCause) error
+	ReleaseSoldCount(ctx context.Context, tx *sql.Tx, tierID string, quantity int, cause entity.InventoryCause) error
+	UpdateAccessibleSoldCount(ctx context.Context, tx *sql.Tx, tierID string, quantity int, cause entity.InventoryCause) error
+	ReleaseAccessibleSoldCount(ctx context.Context, tx *sql.Tx, tierID string, quantity int, cause entity.InventoryCause) error
 }
 
 // ticketTierRepository implements TicketTierRepository interface
@@ -37,9 +54,12 @@ func NewTicketTierRepository(db *sqlx.DB) TicketTierRepository {
 
 // GetByID retrieves ticket tier by ID using sqlx
 func (r *ticketTierRepository) GetByID(ctx context.Context, id string) (*entity.TicketTier, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	var tier entity.TicketTier
 	query := `
-		SELECT id, event_id, name, price, quota, sold_count, max_per_order
+		SELECT id, event_id, name, price, quota, sold_count, max_per_order, accessible_quota, accessible_sold_count
 		FROM ticket_tiers
 		WHERE id = $1
 	`
@@ -55,19 +75,48 @@ func (r *ticketTierRepository) GetByID(ctx context.Context, id string) (*entity.
 	return &tier, nil
 }
 
+// GetByIDs retrieves ticket tiers by IDs in a single query
+// Missing IDs are skipped rather than reported as errors
+func (r *ticketTierRepository) GetByIDs(ctx context.Context, ids []string) ([]entity.TicketTier, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	tiers := []entity.TicketTier{}
+	if len(ids) == 0 {
+		return tiers, nil
+	}
+
+	query := `
+		SELECT id, event_id, name, price, quota, sold_count, max_per_order, accessible_quota, accessible_sold_count
+		FROM ticket_tiers
+		WHERE id = ANY($1)
+	`
+
+	if err := r.db.SelectContext(ctx, &tiers, query, pq.Array(ids)); err != nil {
+		return nil, fmt.Errorf("failed to get ticket tiers: %w", err)
+	}
+
+	return tiers, nil
+}
+
 // GetByIDWithLock retrieves ticket tier with row-level lock (SELECT FOR UPDATE)
 // CRITICAL PATH: Uses raw SQL for explicit locking control
 // PREVENTS RACE CONDITIONS in concurrent reservations
 // MUST be called within a transaction
 func (r *ticketTierRepository) GetByIDWithLock(ctx context.Context, tx *sql.Tx, id string) (*entity.TicketTier, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
-		SELECT id, event_id, name, price, quota, sold_count, max_per_order
+		SELECT id, event_id, name, price, quota, sold_count, max_per_order, archived_at,
+		       accessible_quota, accessible_sold_count
 		FROM ticket_tiers
 		WHERE id = $1
 		FOR UPDATE
 	`
 
 	tier := &entity.TicketTier{}
+	lockStart := time.Now()
 	err := tx.QueryRowContext(ctx, query, id).Scan(
 		&tier.ID,
 		&tier.EventID,
@@ -76,7 +125,11 @@ func (r *ticketTierRepository) GetByIDWithLock(ctx context.Context, tx *sql.Tx,
 		&tier.Quota,
 		&tier.SoldCount,
 		&tier.MaxPerOrder,
+		&tier.ArchivedAt,
+		&tier.AccessibleQuota,
+		&tier.AccessibleSoldCount,
 	)
+	tierRowLockWait.ObserveSince(lockStart)
 
 	if err == sql.ErrNoRows {
 		return nil, ErrTicketTierNotFound
@@ -86,13 +139,20 @@ func (r *ticketTierRepository) GetByIDWithLock(ctx context.Context, tx *sql.Tx,
 		return nil, fmt.Errorf("failed to get ticket tier with lock: %w", err)
 	}
 
+	if tier.SoldCount > tier.Quota {
+		tierOversoldAlarm.Raise("tier %s has sold_count %d above quota %d", tier.ID, tier.SoldCount, tier.Quota)
+	}
+
 	return tier, nil
 }
 
 // GetByEventID retrieves all ticket tiers for an event using sqlx
 func (r *ticketTierRepository) GetByEventID(ctx context.Context, eventID string) ([]entity.TicketTier, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
-		SELECT id, event_id, name, price, quota, sold_count, max_per_order
+		SELECT id, event_id, name, price, quota, sold_count, max_per_order, accessible_quota, accessible_sold_count
 		FROM ticket_tiers
 		WHERE event_id = $1
 		ORDER BY price ASC
@@ -109,9 +169,12 @@ func (r *ticketTierRepository) GetByEventID(ctx context.Context, eventID string)
 
 // CheckAvailability checks if requested quantity is available using sqlx
 func (r *ticketTierRepository) CheckAvailability(ctx context.Context, tierID string, quantity int) (bool, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	var available bool
 	query := `
-		SELECT (quota - sold_count) >= $1 as available
+		SELECT ((quota - accessible_quota) - (sold_count - accessible_sold_count)) >= $1 as available
 		FROM ticket_tiers
 		WHERE id = $2
 	`
@@ -130,16 +193,30 @@ func (r *ticketTierRepository) CheckAvailability(ctx context.Context, tierID str
 // UpdateSoldCount increments sold count (for reservation/payment)
 // CRITICAL PATH: Uses raw SQL transaction for atomic operation
 // Database constraint prevents overselling: (sold_count + $1) <= quota
+// Seats held back for accessible seating stay out of reach: general sale ends at quota - accessible_quota
+// The change is appended to the inventory_movements ledger by the same statement
 // MUST be called within a transaction with row-level lock
-func (r *ticketTierRepository) UpdateSoldCount(ctx context.Context, tx *sql.Tx, tierID string, quantity int) error {
+func (r *ticketTierRepository) UpdateSoldCount(ctx context.Context, tx *sql.Tx, tierID string, quantity int, cause entity.InventoryCause) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
-		UPDATE ticket_tiers
-		SET sold_count = sold_count + $1, updated_at = NOW()
-		WHERE id = $2 AND (sold_count + $1) <= quota
+		WITH updated AS (
+			UPDATE ticket_tiers
+			SET sold_count = sold_count + $1, updated_at = NOW()
+			WHERE id = $2 AND (sold_count - accessible_sold_count + $1) <= (quota - accessible_quota)
+			RETURNING id
+		)
+		INSERT INTO inventory_movements (ticket_tier_id, order_id, delta, reason, accessible)
+		SELECT id, $3, $1, $4, FALSE FROM updated
 	`
 
-	result, err := tx.ExecContext(ctx, query, quantity, tierID)
+	result, err := tx.ExecContext(ctx, query, quantity, tierID, cause.OrderID, cause.Reason)
 	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Constraint == "no_overselling" {
+			tierOversoldAlarm.Raise("reserving %d of tier %s violated no_overselling", quantity, tierID)
+			return ErrInsufficientQuota
+		}
 		return fmt.Errorf("failed to update sold count: %w", err)
 	}
 
@@ -155,7 +232,7 @@ func (r *ticketTierRepository) UpdateSoldCount(ctx context.Context, tx *sql.Tx,
 			return err
 		}
 
-		if tier.SoldCount+quantity > tier.Quota {
+		if tier.GetAvailableQuota() < quantity {
 			return ErrInsufficientQuota
 		}
 
@@ -167,15 +244,28 @@ func (r *ticketTierRepository) UpdateSoldCount(ctx context.Context, tx *sql.Tx,
 
 // ReleaseSoldCount decrements sold count (for cancellation/expiration)
 // CRITICAL PATH: Uses raw SQL transaction for atomic operation
+// The change is appended to the inventory_movements ledger by the same statement, with the
+// actual decrement: a release clamped at zero records less than quantity
 // MUST be called within a transaction
-func (r *ticketTierRepository) ReleaseSoldCount(ctx context.Context, tx *sql.Tx, tierID string, quantity int) error {
+func (r *ticketTierRepository) ReleaseSoldCount(ctx context.Context, tx *sql.Tx, tierID string, quantity int, cause entity.InventoryCause) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	query := `
-		UPDATE ticket_tiers
-		SET sold_count = GREATEST(sold_count - $1, 0), updated_at = NOW()
-		WHERE id = $2
+		WITH previous AS (
+			SELECT id, sold_count FROM ticket_tiers WHERE id = $2 FOR UPDATE
+		), updated AS (
+			UPDATE ticket_tiers tt
+			SET sold_count = GREATEST(tt.sold_count - $1, 0), updated_at = NOW()
+			FROM previous
+			WHERE tt.id = previous.id
+			RETURNING tt.id, tt.sold_count - previous.sold_count AS delta
+		)
+		INSERT INTO inventory_movements (ticket_tier_id, order_id, delta, reason, accessible)
+		SELECT id, $3, delta, $4, FALSE FROM updated
 	`
 
-	result, err := tx.ExecContext(ctx, query, quantity, tierID)
+	result, err := tx.ExecContext(ctx, query, quantity, tierID, cause.OrderID, cause.Reason)
 	if err != nil {
 		return fmt.Errorf("failed to release sold count: %w", err)
 	}
@@ -191,3 +281,93 @@ func (r *ticketTierRepository) ReleaseSoldCount(ctx context.Context, tx *sql.Tx,
 
 	return nil
 }
+
+// UpdateAccessibleSoldCount increments sold count and accessible sold count (for accessible seating reservations)
+// Database constraint prevents overselling: (accessible_sold_count + $1) <= accessible_quota
+// The change is appended to the inventory_movements ledger by the same statement
+// MUST be called within a transaction with row-level lock
+func (r *ticketTierRepository) UpdateAccessibleSoldCount(ctx context.Context, tx *sql.Tx, tierID string, quantity int, cause entity.InventoryCause) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		WITH updated AS (
+			UPDATE ticket_tiers
+			SET sold_count = sold_count + $1, accessible_sold_count = accessible_sold_count + $1, updated_at = NOW()
+			WHERE id = $2 AND (accessible_sold_count + $1) <= accessible_quota
+			RETURNING id
+		)
+		INSERT INTO inventory_movements (ticket_tier_id, order_id, delta, reason, accessible)
+		SELECT id, $3, $1, $4, TRUE FROM updated
+	`
+
+	result, err := tx.ExecContext(ctx, query, quantity, tierID, cause.OrderID, cause.Reason)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && (pqErr.Constraint == "no_overselling" || pqErr.Constraint == "no_accessible_overselling") {
+			tierOversoldAlarm.Raise("reserving %d accessible seats of tier %s violated %s", quantity, tierID, pqErr.Constraint)
+			return ErrInsufficientAccessibleQuota
+		}
+		return fmt.Errorf("failed to update accessible sold count: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		// Check if tier exists or accessible quota exceeded
+		tier, err := r.GetByID(ctx, tierID)
+		if err != nil {
+			return err
+		}
+
+		if tier.GetAvailableAccessibleQuota() < quantity {
+			return ErrInsufficientAccessibleQuota
+		}
+
+		return ErrTicketTierNotFound
+	}
+
+	return nil
+}
+
+// ReleaseAccessibleSoldCount decrements sold count and accessible sold count (for cancellation/expiration)
+// The change is appended to the inventory_movements ledger by the same statement, with the actual decrement
+// MUST be called within a transaction
+func (r *ticketTierRepository) ReleaseAccessibleSoldCount(ctx context.Context, tx *sql.Tx, tierID string, quantity int, cause entity.InventoryCause) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		WITH previous AS (
+			SELECT id, sold_count FROM ticket_tiers WHERE id = $2 FOR UPDATE
+		), updated AS (
+			UPDATE ticket_tiers tt
+			SET sold_count = GREATEST(tt.sold_count - $1, 0),
+			    accessible_sold_count = GREATEST(tt.accessible_sold_count - $1, 0),
+			    updated_at = NOW()
+			FROM previous
+			WHERE tt.id = previous.id
+			RETURNING tt.id, tt.sold_count - previous.sold_count AS delta
+		)
+		INSERT INTO inventory_movements (ticket_tier_id, order_id, delta, reason, accessible)
+		SELECT id, $3, delta, $4, TRUE FROM updated
+	`
+
+	result, err := tx.ExecContext(ctx, query, quantity, tierID, cause.OrderID, cause.Reason)
+	if err != nil {
+		return fmt.Errorf("failed to release accessible sold count: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrTicketTierNotFound
+	}
+
+	return nil
+}
@@ -6,10 +6,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+// Ledger causes of sold_count changes made by the tests
+var (
+	testReserve = entity.InventoryCause{Reason: entity.InventoryReasonReserve}
+	testRelease = entity.InventoryCause{Reason: entity.InventoryReasonRelease}
+)
+
 // TestConcurrentPurchase_NoOverselling tests that concurrent purchases don't cause overselling
 // This is the MOST CRITICAL test for the ticketing system
 // MUST PASS before production deployment
@@ -81,7 +88,7 @@ func TestConcurrentPurchase_NoOverselling(t *testing.T) {
 			time.Sleep(10 * time.Millisecond)
 
 			// CRITICAL: Update sold count with database constraint check
-			err = repo.UpdateSoldCount(ctx, tx, tierID, 1)
+			err = repo.UpdateSoldCount(ctx, tx, tierID, 1, testReserve)
 			if err != nil {
 				t.Logf("Buyer %d: ❌ Failed to update sold count: %v", buyerID, err)
 				mu.Lock()
@@ -164,13 +171,13 @@ func TestUpdateSoldCount_DatabaseConstraintPreventsOverselling(t *testing.T) {
 	// First, sell 4 tickets (should succeed)
 	ctx := context.Background()
 	tx, _ := db.DB.BeginTx(ctx, nil)
-	err := repo.UpdateSoldCount(ctx, tx, tierID, 4)
+	err := repo.UpdateSoldCount(ctx, tx, tierID, 4, testReserve)
 	require.NoError(t, err, "Selling 4 tickets should succeed")
 	tx.Commit()
 
 	// Now try to sell 2 more tickets (should fail - only 1 left)
 	tx, _ = db.DB.BeginTx(ctx, nil)
-	err = repo.UpdateSoldCount(ctx, tx, tierID, 2)
+	err = repo.UpdateSoldCount(ctx, tx, tierID, 2, testReserve)
 	tx.Rollback()
 
 	// CRITICAL ASSERTION: This MUST fail
@@ -207,7 +214,7 @@ func TestReleaseSoldCount(t *testing.T) {
 
 	// Sell 5 tickets
 	tx, _ := db.DB.BeginTx(ctx, nil)
-	err := repo.UpdateSoldCount(ctx, tx, tierID, 5)
+	err := repo.UpdateSoldCount(ctx, tx, tierID, 5, testReserve)
 	require.NoError(t, err)
 	tx.Commit()
 
@@ -217,7 +224,7 @@ func TestReleaseSoldCount(t *testing.T) {
 
 	// Release 2 tickets (reservation timeout or cancellation)
 	tx, _ = db.DB.BeginTx(ctx, nil)
-	err = repo.ReleaseSoldCount(ctx, tx, tierID, 2)
+	err = repo.ReleaseSoldCount(ctx, tx, tierID, 2, testRelease)
 	require.NoError(t, err)
 	tx.Commit()
 
@@ -227,13 +234,19 @@ func TestReleaseSoldCount(t *testing.T) {
 
 	// Test: Release more than sold (should not go negative)
 	tx, _ = db.DB.BeginTx(ctx, nil)
-	err = repo.ReleaseSoldCount(ctx, tx, tierID, 10)
+	err = repo.ReleaseSoldCount(ctx, tx, tierID, 10, testRelease)
 	require.NoError(t, err)
 	tx.Commit()
 
 	tier, _ = repo.GetByID(ctx, tierID)
 	assert.Equal(t, 0, tier.SoldCount, "Sold count should not go below 0")
 
+	// The ledger records the actual decrement of the clamped release and sums to sold_count
+	var deltas []int
+	err = db.SelectContext(ctx, &deltas, `SELECT delta FROM inventory_movements WHERE ticket_tier_id = $1 ORDER BY created_at`, tierID)
+	require.NoError(t, err)
+	assert.Equal(t, []int{5, -2, -3}, deltas)
+
 	t.Logf("✅ Release sold count works correctly")
 }
 
@@ -253,7 +266,7 @@ func BenchmarkConcurrentPurchase(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		tx, _ := db.DB.BeginTx(ctx, nil)
 		_, _ = repo.GetByIDWithLock(ctx, tx, tierID)
-		_ = repo.UpdateSoldCount(ctx, tx, tierID, 1)
+		_ = repo.UpdateSoldCount(ctx, tx, tierID, 1, testReserve)
 		tx.Commit()
 	}
 }
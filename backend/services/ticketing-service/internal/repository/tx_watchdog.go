@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/metrics"
+)
+
+// Transaction metrics (exposed via /debug/vars)
+var (
+	txDuration = metrics.NewHistogram("db_transaction_duration", []time.Duration{
+		10 * time.Millisecond, 50 * time.Millisecond, 250 * time.Millisecond, time.Second, 5 * time.Second, 30 * time.Second,
+	})
+	txSlow     = metrics.NewCounterVec("db_transaction_slow_total")     // By transaction name
+	txTimeouts = metrics.NewCounterVec("db_transaction_timeouts_total") // By transaction name
+)
+
+// TxWatchdog bounds how long critical path transactions may hold row locks
+// A transaction stalled past its deadline (e.g. a network hiccup during SELECT FOR UPDATE)
+// is cancelled and rolled back instead of blocking the sale
+type TxWatchdog struct {
+	timeout       time.Duration
+	slowThreshold time.Duration
+}
+
+// NewTxWatchdog creates new transaction watchdog
+// timeout 0 disables the deadline, slowThreshold 0 disables slow transaction logging
+func NewTxWatchdog(timeout, slowThreshold time.Duration) *TxWatchdog {
+	return &TxWatchdog{timeout: timeout, slowThreshold: slowThreshold}
+}
+
+// Watch starts watching the transaction name
+// The returned context carries the deadline: BeginTx and every statement of the transaction must use it,
+// so a stalled statement is cancelled and database/sql rolls the transaction back when the deadline passes.
+// done must be called once the transaction committed or rolled back; later calls are ignored.
+// A nil watchdog returns ctx unchanged
+func (w *TxWatchdog) Watch(ctx context.Context, name string) (txCtx context.Context, done func()) {
+	if w == nil {
+		return ctx, func() {}
+	}
+
+	cancel := context.CancelFunc(func() {})
+	txCtx = ctx
+	if w.timeout > 0 {
+		txCtx, cancel = context.WithTimeout(ctx, w.timeout)
+	}
+
+	start := time.Now()
+	var once sync.Once
+	return txCtx, func() {
+		once.Do(func() {
+			elapsed := time.Since(start)
+			timedOut := errors.Is(txCtx.Err(), context.DeadlineExceeded)
+			cancel()
+
+			txDuration.Observe(elapsed)
+			switch {
+			case timedOut:
+				txTimeouts.Inc(name)
+				log.Printf("[TxWatchdog] Transaction %s exceeded its %s deadline and was rolled back", name, w.timeout)
+			case w.slowThreshold > 0 && elapsed >= w.slowThreshold:
+				txSlow.Inc(name)
+				log.Printf("[TxWatchdog] Slow transaction %s took %s (threshold %s)", name, elapsed, w.slowThreshold)
+			}
+		})
+	}
+}
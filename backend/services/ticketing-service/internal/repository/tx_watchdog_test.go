@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTxWatchdog_StalledTransactionReleasesLocks tests that a transaction stuck waiting on
+// SELECT FOR UPDATE is cancelled at its deadline and rolls back the locks it already holds
+func TestTxWatchdog_StalledTransactionReleasesLocks(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	// Setup
+	db := SetupTestDB(t)
+	defer CleanupTestDB(t, db)
+
+	TruncateTables(t, db, "ticket_tiers", "events")
+
+	eventID := CreateTestEvent(t, db)
+	heldTierID := CreateTestTicketTier(t, db, eventID, 10)
+	stalledTierID := CreateTestTicketTier(t, db, eventID, 10)
+
+	repo := NewTicketTierRepository(db)
+	ctx := context.Background()
+
+	// Another transaction holds the lock the watched transaction will wait on
+	blocker, err := db.DB.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	defer blocker.Rollback()
+	_, err = repo.GetByIDWithLock(ctx, blocker, stalledTierID)
+	require.NoError(t, err)
+
+	watchdog := NewTxWatchdog(200*time.Millisecond, 0)
+	before := txTimeouts.Value("test_stalled")
+
+	txCtx, done := watchdog.Watch(ctx, "test_stalled")
+	tx, err := db.DB.BeginTx(txCtx, nil)
+	require.NoError(t, err)
+	_, err = repo.GetByIDWithLock(txCtx, tx, heldTierID)
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = repo.GetByIDWithLock(txCtx, tx, stalledTierID)
+	assert.Error(t, err, "stalled lock wait must be cancelled at the deadline")
+	assert.Less(t, time.Since(start), 5*time.Second)
+	done()
+	assert.Equal(t, before+1, txTimeouts.Value("test_stalled"))
+
+	// The lock held by the stalled transaction was released by the rollback
+	other, err := db.DB.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	defer other.Rollback()
+	lockCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	_, err = repo.GetByIDWithLock(lockCtx, other, heldTierID)
+	assert.NoError(t, err, "rolled back transaction must not keep its row locks")
+}
+
+func TestTxWatchdog_Metrics(t *testing.T) {
+	watchdog := NewTxWatchdog(20*time.Millisecond, 5*time.Millisecond)
+
+	slowBefore := txSlow.Value("test_metrics")
+	timeoutsBefore := txTimeouts.Value("test_metrics")
+
+	// Fast transaction: neither slow nor timed out
+	_, done := watchdog.Watch(context.Background(), "test_metrics")
+	done()
+	assert.Equal(t, slowBefore, txSlow.Value("test_metrics"))
+
+	// Slow transaction within the deadline
+	_, done = watchdog.Watch(context.Background(), "test_metrics")
+	time.Sleep(10 * time.Millisecond)
+	done()
+	assert.Equal(t, slowBefore+1, txSlow.Value("test_metrics"))
+
+	// Transaction past its deadline; done is idempotent
+	txCtx, done := watchdog.Watch(context.Background(), "test_metrics")
+	<-txCtx.Done()
+	done()
+	done()
+	assert.Equal(t, timeoutsBefore+1, txTimeouts.Value("test_metrics"))
+	assert.Equal(t, slowBefore+1, txSlow.Value("test_metrics"), "timeouts are not counted as slow")
+
+	// A nil watchdog leaves the context untouched
+	var disabled *TxWatchdog
+	ctx := context.Background()
+	txCtx, done = disabled.Watch(ctx, "test_metrics")
+	done()
+	assert.Equal(t, ctx, txCtx)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
 )
 
@@ -29,6 +30,9 @@ func NewUserRepository(db *sqlx.DB) UserRepository {
 
 // GetByID retrieves user by ID using sqlx
 func (r *userRepository) GetByID(ctx context.Context, id string) (*entity.User, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
 	var user entity.User
 	query := `
 		SELECT id, email, full_name, phone, role, created_at, updated_at
@@ -0,0 +1,296 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+var (
+	ErrWaitlistEntryNotFound = errors.New("waitlist entry not found")
+	ErrAlreadyOnWaitlist     = errors.New("user is already on the waitlist of this ticket tier")
+)
+
+// waitlistColumns lists the columns selected for a waitlist entry
+const waitlistColumns = `id, event_id, ticket_tier_id, user_id, quantity, status, offered_at, offer_expires_at,
+	order_id, created_at, updated_at`
+
+// WaitlistRepository defines interface for ticket tier waitlist data operations
+type WaitlistRepository interface {
+	Create(ctx context.Context, entry *entity.WaitlistEntry) error
+	GetOpenByUser(ctx context.Context, tierID, userID string) (*entity.WaitlistEntry, error)
+	GetOpenByUserWithLock(ctx context.Context, tx *sql.Tx, tierID, userID string) (*entity.WaitlistEntry, error)
+	CountAhead(ctx context.Context, entry *entity.WaitlistEntry) (int, error)
+	ListTiersToProcess(ctx context.Context, now time.Time, limit int) ([]string, error)
+	ListOpenByTierWithLock(ctx context.Context, tx *sql.Tx, tierID string) ([]entity.WaitlistEntry, error)
+	OfferWithTx(ctx context.Context, tx *sql.Tx, id string, expiresAt time.Time) error
+	PurchaseWithTx(ctx context.Context, tx *sql.Tx, id, orderID string) error
+	CloseWithTx(ctx context.Context, tx *sql.Tx, id, status string) error
+}
+
+// waitlistRepository implements WaitlistRepository interface
+type waitlistRepository struct {
+	db *sqlx.DB
+}
+
+// NewWaitlistRepository creates new waitlist repository instance
+func NewWaitlistRepository(db *sqlx.DB) WaitlistRepository {
+	return &waitlistRepository{db: db}
+}
+
+// Create puts a buyer on the waitlist of a ticket tier
+// Returns ErrAlreadyOnWaitlist when the buyer already has an open entry for the tier
+func (r *waitlistRepository) Create(ctx context.Context, entry *entity.WaitlistEntry) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		INSERT INTO waitlist_entries (id, event_id, ticket_tier_id, user_id, quantity, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		RETURNING created_at, updated_at
+	`
+
+	if entry.ID == "" {
+		entry.ID = uuid.New().String()
+	}
+	entry.Status = entity.WaitlistStatusWaiting
+
+	err := r.db.QueryRowContext(ctx, query,
+		entry.ID, entry.EventID, entry.TicketTierID, entry.UserID, entry.Quantity, entry.Status,
+	).Scan(&entry.CreatedAt, &entry.UpdatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return ErrAlreadyOnWaitlist
+		}
+		return fmt.Errorf("failed to create waitlist entry: %w", err)
+	}
+
+	return nil
+}
+
+// GetOpenByUser retrieves the waiting or offered entry of a buyer for a ticket tier
+func (r *waitlistRepository) GetOpenByUser(ctx context.Context, tierID, userID string) (*entity.WaitlistEntry, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + waitlistColumns + `
+		FROM waitlist_entries
+		WHERE ticket_tier_id = $1 AND user_id = $2 AND status IN ($3, $4)
+	`
+
+	entry := &entity.WaitlistEntry{}
+	err := r.db.GetContext(ctx, entry, query, tierID, userID, entity.WaitlistStatusWaiting, entity.WaitlistStatusOffered)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrWaitlistEntryNotFound
+		}
+		return nil, fmt.Errorf("failed to get waitlist entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+// GetOpenByUserWithLock retrieves the waiting or offered entry of a buyer for a ticket tier with
+// row-level lock (must be called within a transaction, after locking the tier)
+func (r *waitlistRepository) GetOpenByUserWithLock(ctx context.Context, tx *sql.Tx, tierID, userID string) (*entity.WaitlistEntry, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + waitlistColumns + `
+		FROM waitlist_entries
+		WHERE ticket_tier_id = $1 AND user_id = $2 AND status IN ($3, $4)
+		FOR UPDATE
+	`
+
+	entry, err := scanWaitlistEntry(tx.QueryRowContext(ctx, query, tierID, userID, entity.WaitlistStatusWaiting, entity.WaitlistStatusOffered))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrWaitlistEntryNotFound
+		}
+		return nil, fmt.Errorf("failed to get waitlist entry with lock: %w", err)
+	}
+
+	return entry, nil
+}
+
+// CountAhead counts the waiting entries of the tier that joined before entry
+func (r *waitlistRepository) CountAhead(ctx context.Context, entry *entity.WaitlistEntry) (int, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT COUNT(*)
+		FROM waitlist_entries
+		WHERE ticket_tier_id = $1 AND status = $2 AND (created_at, id) < ($3, $4)
+	`
+
+	var ahead int
+	err := r.db.GetContext(ctx, &ahead, query, entry.TicketTierID, entity.WaitlistStatusWaiting, entry.CreatedAt, entry.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count waitlist entries ahead: %w", err)
+	}
+
+	return ahead, nil
+}
+
+// ListTiersToProcess returns the ticket tiers whose waitlist needs work at now, longest waiting first:
+// offers that expired, or waiting entries of a tier with seats on general sale, archived or no longer on sale
+func (r *waitlistRepository) ListTiersToProcess(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT w.ticket_tier_id
+		FROM waitlist_entries w
+		JOIN ticket_tiers tt ON tt.id = w.ticket_tier_id
+		JOIN events e ON e.id = w.event_id
+		WHERE (w.status = $1 AND w.offer_expires_at <= $3)
+		   OR (w.status = $2 AND (
+		        (tt.quota - tt.accessible_quota) - (tt.sold_count - tt.accessible_sold_count) > 0
+		        OR tt.archived_at IS NOT NULL
+		        OR e.status <> $4
+		        OR e.end_date <= $3
+		   ))
+		GROUP BY w.ticket_tier_id
+		ORDER BY MIN(w.created_at)
+		LIMIT $5
+	`
+
+	tierIDs := []string{}
+	err := r.db.SelectContext(ctx, &tierIDs, query,
+		entity.WaitlistStatusOffered, entity.WaitlistStatusWaiting, now, entity.EventStatusPublished, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list waitlisted tiers: %w", err)
+	}
+
+	return tierIDs, nil
+}
+
+// ListOpenByTierWithLock retrieves the waiting and offered entries of a ticket tier in line order
+// with row-level locks (must be called within a transaction, after locking the tier)
+func (r *waitlistRepository) ListOpenByTierWithLock(ctx context.Context, tx *sql.Tx, tierID string) ([]entity.WaitlistEntry, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		SELECT ` + waitlistColumns + `
+		FROM waitlist_entries
+		WHERE ticket_tier_id = $1 AND status IN ($2, $3)
+		ORDER BY created_at, id
+		FOR UPDATE
+	`
+
+	rows, err := tx.QueryContext(ctx, query, tierID, entity.WaitlistStatusWaiting, entity.WaitlistStatusOffered)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list waitlist entries: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []entity.WaitlistEntry{}
+	for rows.Next() {
+		entry, err := scanWaitlistEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan waitlist entry: %w", err)
+		}
+		entries = append(entries, *entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// OfferWithTx marks a waiting entry offered until expiresAt (must be called within a transaction)
+func (r *waitlistRepository) OfferWithTx(ctx context.Context, tx *sql.Tx, id string, expiresAt time.Time) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE waitlist_entries
+		SET status = $2, offered_at = NOW(), offer_expires_at = $3, updated_at = NOW()
+		WHERE id = $1 AND status = $4
+	`
+
+	return r.updateWithTx(ctx, tx, "offer", query, id, entity.WaitlistStatusOffered, expiresAt, entity.WaitlistStatusWaiting)
+}
+
+// PurchaseWithTx marks an offered entry purchased by the buyer's order (must be called within a transaction)
+func (r *waitlistRepository) PurchaseWithTx(ctx context.Context, tx *sql.Tx, id, orderID string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE waitlist_entries
+		SET status = $2, order_id = $3, updated_at = NOW()
+		WHERE id = $1 AND status = $4
+	`
+
+	return r.updateWithTx(ctx, tx, "purchase", query, id, entity.WaitlistStatusPurchased, orderID, entity.WaitlistStatusOffered)
+}
+
+// CloseWithTx moves an open entry to status, expired or cancelled (must be called within a transaction)
+func (r *waitlistRepository) CloseWithTx(ctx context.Context, tx *sql.Tx, id, status string) error {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	query := `
+		UPDATE waitlist_entries
+		SET status = $2, updated_at = NOW()
+		WHERE id = $1 AND status IN ($3, $4)
+	`
+
+	return r.updateWithTx(ctx, tx, "close", query, id, status, entity.WaitlistStatusWaiting, entity.WaitlistStatusOffered)
+}
+
+// updateWithTx runs an entry status update, ErrWaitlistEntryNotFound when the entry was not in the expected status
+func (r *waitlistRepository) updateWithTx(ctx context.Context, tx *sql.Tx, action, query string, args ...interface{}) error {
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to %s waitlist entry: %w", action, err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrWaitlistEntryNotFound
+	}
+
+	return nil
+}
+
+// waitlistScanner is a *sql.Row or *sql.Rows positioned on a waitlist entry
+type waitlistScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanWaitlistEntry scans the waitlistColumns of a row
+func scanWaitlistEntry(row waitlistScanner) (*entity.WaitlistEntry, error) {
+	entry := &entity.WaitlistEntry{}
+	err := row.Scan(
+		&entry.ID,
+		&entry.EventID,
+		&entry.TicketTierID,
+		&entry.UserID,
+		&entry.Quantity,
+		&entry.Status,
+		&entry.OfferedAt,
+		&entry.OfferExpiresAt,
+		&entry.OrderID,
+		&entry.CreatedAt,
+		&entry.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+)
+
+// ZoneRepository defines interface for event access zone read operations
+// Zones are managed by event-service; ticketing only reads them
+type ZoneRepository interface {
+	GetCodesByTierIDs(ctx context.Context, tierIDs []string) (map[string][]string, error)
+	ExistsForEvent(ctx context.Context, eventID, code string) (bool, error)
+}
+
+// zoneRepository implements ZoneRepository interface
+type zoneRepository struct {
+	db *sqlx.DB
+}
+
+// NewZoneRepository creates new zone repository instance
+func NewZoneRepository(db *sqlx.DB) ZoneRepository {
+	return &zoneRepository{db: db}
+}
+
+// GetCodesByTierIDs returns the zone codes each ticket tier may enter, keyed by tier ID
+// Tiers without zones are missing from the map
+func (r *zoneRepository) GetCodesByTierIDs(ctx context.Context, tierIDs []string) (map[string][]string, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	zones := make(map[string][]string)
+	if len(tierIDs) == 0 {
+		return zones, nil
+	}
+
+	query := `
+		SELECT tz.ticket_tier_id, z.code
+		FROM ticket_tier_zones tz
+		JOIN event_zones z ON z.id = tz.zone_id
+		WHERE tz.ticket_tier_id = ANY($1)
+		ORDER BY z.code ASC
+	`
+
+	rows := []struct {
+		TicketTierID string `db:"ticket_tier_id"`
+		Code         string `db:"code"`
+	}{}
+	if err := r.db.SelectContext(ctx, &rows, query, pq.Array(tierIDs)); err != nil {
+		return nil, fmt.Errorf("failed to get ticket tier zones: %w", err)
+	}
+
+	for _, row := range rows {
+		zones[row.TicketTierID] = append(zones[row.TicketTierID], row.Code)
+	}
+
+	return zones, nil
+}
+
+// ExistsForEvent checks whether the event has a zone with the given code
+func (r *zoneRepository) ExistsForEvent(ctx context.Context, eventID, code string) (bool, error) {
+	ctx, cancel := timeout.WithDBTimeout(ctx)
+	defer cancel()
+
+	var exists bool
+	query := `SELECT EXISTS(SELECT 1 FROM event_zones WHERE event_id = $1 AND code = $2)`
+
+	if err := r.db.GetContext(ctx, &exists, query, eventID, code); err != nil {
+		return false, fmt.Errorf("failed to check zone: %w", err)
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,26 @@
+package router
+
+import (
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	sharedauth "github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/contract"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGatewayContract checks every gateway route proxied to this service has a matching handler
+func TestGatewayContract(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	routes, err := contract.RoutesFor(contract.ServiceTicketing)
+	require.NoError(t, err)
+	require.NotEmpty(t, routes, "no gateway routes proxy to %s", contract.ServiceTicketing)
+
+	// Controllers are nil: routes are matched, handlers never run
+	engine := SetupRouter(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, sharedauth.NewHMACKeySet("contract-test-secret"), nil)
+
+	for _, route := range contract.Unhandled(engine, routes) {
+		t.Errorf("gateway route not handled by %s: %s", contract.ServiceTicketing, route)
+	}
+}
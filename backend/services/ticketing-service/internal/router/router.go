@@ -1,18 +1,42 @@
 package router
 
 import (
+	"expvar"
+
 	"github.com/gin-gonic/gin"
+	sharedauth "github.com/raflibima25/event-ticketing-platform/backend/pkg/auth"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/buildinfo"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/hotreload"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/controller"
-	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/middleware"
 )
 
 // SetupRouter configures all routes
 func SetupRouter(
 	orderController *controller.OrderController,
 	ticketController *controller.TicketController,
-	jwtSecret string,
+	issueController *controller.IssueController,
+	refundController *controller.RefundController,
+	badgeController *controller.BadgeController,
+	announcementController *controller.AnnouncementController,
+	insuranceController *controller.InsuranceController,
+	accommodationController *controller.AccommodationController,
+	policyController *controller.PolicyController,
+	invitationController *controller.InvitationController,
+	integrationController *controller.IntegrationController,
+	inventoryController *controller.InventoryController,
+	sandboxController *controller.SandboxController,
+	orderReplayController *controller.OrderReplayController,
+	ticketReissueController *controller.TicketReissueController,
+	notificationPreferenceController *controller.NotificationPreferenceController,
+	checkinMonitorController *controller.CheckinMonitorController,
+	waitlistController *controller.WaitlistController,
+	keys *sharedauth.KeySet,
+	configWatcher *hotreload.Watcher,
 ) *gin.Engine {
-	r := gin.Default()
+	r := gin.New()
+	r.Use(gin.Logger(), errreport.GinRecovery()) // Panics are reported to Sentry
 
 	// Health check
 	r.GET("/health", func(c *gin.Context) {
@@ -22,20 +46,44 @@ func SetupRouter(
 		})
 	})
 
+	// Build info (version, git SHA, build time)
+	r.GET("/version", buildinfo.Handler("ticketing-service"))
+
+	// Runtime metrics (expvar: memstats, reservation and consistency check counters)
+	r.GET("/debug/vars", gin.WrapH(expvar.Handler()))
+
+	// Hot config reload (config:manage); nil watcher leaves the routes out
+	if configWatcher != nil {
+		configAdmin := r.Group("/admin/config")
+		configAdmin.Use(sharedauth.Middleware(keys), sharedauth.RequireScope(sharedauth.PermConfigManage))
+		{
+			configAdmin.GET("", configWatcher.StatusHandler())         // Settings in effect and last reload
+			configAdmin.POST("/reload", configWatcher.ReloadHandler()) // Reload every replica of every service
+		}
+	}
+
 	// API v1 routes
 	v1 := r.Group("/api/v1")
 	{
 		// Protected routes (require authentication)
+		// Orders are created for the tenant resolved by the gateway (X-Tenant-ID)
 		protected := v1.Group("")
-		protected.Use(middleware.AuthMiddleware(jwtSecret))
+		protected.Use(tenant.Middleware(), sharedauth.Middleware(keys))
 		{
 			// Order endpoints
 			orders := protected.Group("/orders")
 			{
 				orders.POST("", orderController.CreateOrder)           // Create order (reserve tickets)
+				orders.POST("/quote", orderController.QuoteOrder)      // Price order without reserving tickets
 				orders.GET("", orderController.GetUserOrders)          // Get user's orders
 				orders.GET("/:id", orderController.GetOrder)           // Get order detail
 				orders.POST("/:id/cancel", orderController.CancelOrder) // Cancel order
+				orders.POST("/:id/issues", issueController.ReportIssue)    // Report a problem with the order
+				orders.GET("/:id/issues", issueController.GetOrderIssues)  // Get order's reported issues
+				orders.GET("/:id/insurance", insuranceController.GetOrderInsurance)   // Get order's ticket insurance
+				orders.POST("/:id/insurance/claim", insuranceController.FileClaim)    // Claim ticket insurance (refund)
+				orders.POST("/:id/refund", refundController.RequestRefund)            // Refund under the event's refund policy
+				orders.GET("/:id/policies", policyController.GetOrderPolicies)        // Get policy versions accepted with the order
 			}
 
 			// Ticket endpoints
@@ -43,6 +91,110 @@ func SetupRouter(
 			{
 				tickets.GET("", ticketController.GetUserTickets)      // Get user's tickets
 				tickets.GET("/:id", ticketController.GetTicket)       // Get ticket detail
+				tickets.GET("/:id/share", ticketController.CreateShareLink)     // Create share link
+				tickets.DELETE("/:id/share", ticketController.RevokeShareLinks) // Revoke share links
+				tickets.POST("/:id/regenerate", ticketController.RegenerateQR)  // Rotate QR code (owner)
+				tickets.GET("/:id/qr", ticketController.GetDynamicQR)           // Current rotating QR code of a dynamic QR event (owner)
+				tickets.POST("/:id/revoke", sharedauth.RequireScope(sharedauth.PermOrdersRefund), ticketController.VoidTicket) // Void ticket (orders:refund)
+				tickets.GET("/:id/badge", sharedauth.RequireScope(sharedauth.PermCheckinScan), badgeController.GetBadge)       // Badge-ready data (checkin:scan)
+			}
+
+			// Digest frequency of non-urgent notifications (the signed-in user's own, by email)
+			preferences := protected.Group("/notification-preferences")
+			{
+				preferences.GET("", notificationPreferenceController.GetPreference)    // Get digest frequency and waiting notifications
+				preferences.PUT("", notificationPreferenceController.UpdatePreference) // Set immediate, daily or weekly
+			}
+
+			// Conference badges and check-in kiosks (events:write, organizer of the event or admin)
+			badges := protected.Group("/badges")
+			badges.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite))
+			{
+				badges.POST("/pdf", badgeController.GenerateBadgePDF) // Batch badge PDF
+			}
+
+			kiosks := protected.Group("/kiosks")
+			kiosks.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite))
+			{
+				kiosks.POST("", badgeController.RegisterKiosk)       // Register kiosk, returns device token
+				kiosks.GET("", badgeController.ListKiosks)           // List event kiosks (?event_id=)
+				kiosks.DELETE("/:id", badgeController.RevokeKiosk)   // Revoke kiosk
+			}
+
+			// Attendee lists with accessibility accommodations (events:write, organizer of the event or admin)
+			attendees := protected.Group("/attendees")
+			attendees.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite))
+			{
+				attendees.GET("", accommodationController.ListAttendees)             // Ticket holders with accommodations (?event_id=)
+				attendees.GET("/accommodations", accommodationController.GetSummary) // Requests by type, accessible seating per tier
+			}
+
+			// Terms and event policies (events:write; platform terms by admins, event policies by the organizer of the event)
+			policies := protected.Group("/policies")
+			policies.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite))
+			{
+				policies.POST("", policyController.PublishPolicy) // Publish new policy version
+			}
+
+			// Announcement emails to ticket holders (events:write, organizer of the event or admin)
+			announcements := protected.Group("/announcements")
+			announcements.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite))
+			{
+				announcements.POST("", announcementController.SendAnnouncement) // Send announcement (counted against plan quota)
+			}
+
+			// Invite-only guest lists: imports and claim status (events:write, organizer of the event or admin),
+			// claims by any signed-in invitee
+			invitations := protected.Group("/invitations")
+			{
+				invitations.POST("/import", sharedauth.RequireScope(sharedauth.PermEventsWrite), invitationController.ImportInvitations) // Invite guests of a CSV file (?event_id=&claim_deadline=)
+				invitations.GET("", sharedauth.RequireScope(sharedauth.PermEventsWrite), invitationController.ListInvitations)           // Invitations with claim status (?event_id=)
+				invitations.POST("/claim", invitationController.ClaimInvitation)                                                         // Claim with the emailed token
+			}
+
+			// Waitlists of sold-out ticket tiers, one entry per signed-in buyer and tier
+			waitlists := protected.Group("/ticket-tiers/:id/waitlist")
+			{
+				waitlists.POST("", waitlistController.JoinWaitlist)    // Wait for seats of a sold-out tier
+				waitlists.GET("", waitlistController.GetWaitlistEntry) // Position in line or offer
+				waitlists.DELETE("", waitlistController.LeaveWaitlist) // Leave, giving up an offer
+			}
+
+			// Partner API keys for automation platforms (events:write, the organizer's own keys)
+			apiKeys := protected.Group("/api-keys")
+			apiKeys.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite))
+			{
+				apiKeys.POST("", integrationController.CreateAPIKey)       // Create key, returns the key once
+				apiKeys.GET("", integrationController.ListAPIKeys)         // List own keys
+				apiKeys.DELETE("/:id", integrationController.RevokeAPIKey) // Revoke key
+			}
+
+			// Ticket tier inventory reports, sandbox test orders, ticket reissues and live check-ins (events:write, organizer of the event or admin)
+			organizer := protected.Group("/organizer")
+			organizer.Use(sharedauth.RequireScope(sharedauth.PermEventsWrite))
+			{
+				organizer.GET("/ticket-tiers/:id/inventory-history", inventoryController.GetHistory)        // Seats sold/released per interval (?interval=&from=&to=)
+				organizer.POST("/events/:id/sandbox/reset", sandboxController.ClearTestOrders)              // Cancel test orders, void their tickets (before going live)
+				organizer.POST("/events/:id/ticket-reissues", ticketReissueController.StartReissue)         // Replace QR codes of all unused tickets, email them again
+				organizer.GET("/events/:id/ticket-reissues/:reissueId", ticketReissueController.GetReissue) // Reissue progress
+				organizer.GET("/events/:id/checkins/live", checkinMonitorController.GetLive)                 // Scans per gate and minute, scanners online (?minutes=)
+			}
+
+			// Support endpoints (support:manage)
+			admin := protected.Group("/admin")
+			admin.Use(sharedauth.RequireScope(sharedauth.PermSupportManage))
+			{
+				admin.GET("/issues", issueController.ListIssues)                      // Unresolved issues queue
+				admin.GET("/orders", orderController.SearchOrders)                    // Search orders by metadata
+				admin.GET("/orders/:id", issueController.GetAdminOrder)               // Order with payment and issues
+				admin.POST("/issues/:id/replies", issueController.ReplyToIssue)       // Reply to buyer
+				admin.POST("/issues/:id/resolve", issueController.ResolveIssue)       // Resolve issue
+				admin.GET("/refunds", refundController.ListRefunds)                   // Payments flagged for manual refund
+				admin.POST("/refunds/:id/complete", refundController.MarkRefunded)    // Mark refunded
+				admin.POST("/orders/:id/mark-paid", orderController.MarkPaid)         // Confirm bank transfer without webhook
+				admin.GET("/orders/:id/events", orderReplayController.GetOrderEvents) // Event stream of an event-sourced order
+				admin.POST("/orders/:id/replay", orderReplayController.ReplayOrder)   // Rebuild order from its stream
+				admin.POST("/orders/replay", orderReplayController.ReplayAll)         // Rebuild every event-sourced order
 			}
 		}
 
@@ -53,11 +205,55 @@ func SetupRouter(
 			internal.POST("/orders/:id/confirm", orderController.ConfirmPayment) // Confirm payment
 		}
 
-		// Public endpoints (for event staff to validate tickets)
-		// In production, this should be protected by staff authentication
+		// Partner integrations (Zapier, Make), authenticated by the X-API-Key header instead of a user JWT
+		// and scoped to the events of the key's organizer
+		integrations := v1.Group("/integrations")
+		{
+			integrations.GET("/me", integrationController.GetAccount)                    // Connection test
+			integrations.GET("/triggers/orders", integrationController.ListOrders)       // Orders (?updated_since=&event_id=&status=&limit=)
+			integrations.GET("/triggers/attendees", integrationController.ListAttendees) // Issued tickets with holders
+			integrations.GET("/triggers/checkins", integrationController.ListCheckIns)   // Entry scans
+			integrations.POST("/actions/checkins", integrationController.CheckIn)        // Check in ticket by QR data
+			integrations.POST("/actions/invitations", integrationController.InviteGuest) // Invite one guest
+		}
+
+		// Public endpoints
+		// Ticket validation and scanner heartbeats are for gate staff and require the checkin:scan permission;
+		// kiosk check-in is authenticated by the kiosk device token
 		public := v1.Group("/public")
+		public.Use(tenant.Middleware())
 		{
-			public.POST("/tickets/validate", ticketController.ValidateTicket) // Validate ticket at entrance
+			public.POST("/tickets/validate", sharedauth.Middleware(keys), sharedauth.RequireScope(sharedauth.PermCheckinScan), ticketController.ValidateTicket) // Validate ticket at entrance
+			public.POST("/scanners/heartbeat", sharedauth.Middleware(keys), sharedauth.RequireScope(sharedauth.PermCheckinScan), checkinMonitorController.Heartbeat) // Scanner online at a gate (every 30s)
+			public.GET("/tickets/shared/:token", ticketController.GetSharedTicket) // Shared ticket view (signed link)
+			public.POST("/kiosk/checkin", badgeController.KioskCheckIn)            // Kiosk check-in (X-Kiosk-Token)
+			public.GET("/policies", policyController.GetCurrentPolicies)           // Current terms and event policies (?event_id=)
+			public.GET("/policies/:id", policyController.GetPolicy)                // Policy version
+			public.GET("/invitations/:token", invitationController.GetInvitation)  // Invitation behind a claim link
+		}
+	}
+
+	// API v2 routes
+	// v1 response shapes are frozen; richer views are only exposed here.
+	// Endpoints without a v2 variant are served from v1 by the gateway.
+	v2 := r.Group("/api/v2")
+	{
+		protected := v2.Group("")
+		protected.Use(tenant.Middleware(), sharedauth.Middleware(keys))
+		{
+			// Order endpoints
+			orders := protected.Group("/orders")
+			{
+				orders.GET("", orderController.GetUserOrdersV2) // Get user's orders with event, fees and payment
+				orders.GET("/:id", orderController.GetOrderV2)  // Get order detail with event, fees and payment
+			}
+
+			// Ticket endpoints
+			tickets := protected.Group("/tickets")
+			{
+				tickets.GET("", ticketController.GetUserTicketsV2) // Get user's tickets with event and tier
+				tickets.GET("/:id", ticketController.GetTicketV2)  // Get ticket detail with event and tier
+			}
 		}
 	}
 
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+)
+
+// ErrAccessibleSeatingUnavailable is returned when a tier has too few accessible seats left
+var ErrAccessibleSeatingUnavailable = errors.New("not enough accessible seating available")
+
+// AccommodationService handles the accessibility accommodations buyers request at checkout
+// Attendee lists and summaries are for the event's organizer or an admin
+type AccommodationService interface {
+	ListAttendees(ctx context.Context, userID, role, eventID string, accommodationsOnly bool, page, limit int) ([]response.AttendeeResponse, int64, error)
+	GetSummary(ctx context.Context, userID, role, eventID string) (*response.AccommodationSummaryResponse, error)
+}
+
+// accommodationService implements AccommodationService interface
+type accommodationService struct {
+	accommodationRepo repository.AccommodationRepository
+	eventRepo         repository.EventRepository
+}
+
+// NewAccommodationService creates new accommodation service instance
+func NewAccommodationService(accommodationRepo repository.AccommodationRepository, eventRepo repository.EventRepository) AccommodationService {
+	return &accommodationService{
+		accommodationRepo: accommodationRepo,
+		eventRepo:         eventRepo,
+	}
+}
+
+// ListAttendees lists the holders of an event's valid and used tickets with the accommodations of each ticket
+func (s *accommodationService) ListAttendees(ctx context.Context, userID, role, eventID string, accommodationsOnly bool, page, limit int) ([]response.AttendeeResponse, int64, error) {
+	if _, err := managedEvent(ctx, s.eventRepo, userID, role, eventID); err != nil {
+		return nil, 0, err
+	}
+
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 50
+	}
+
+	attendees, total, err := s.accommodationRepo.ListAttendees(ctx, eventID, accommodationsOnly, limit, (page-1)*limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ticketIDs := make([]string, len(attendees))
+	for i, attendee := range attendees {
+		ticketIDs[i] = attendee.TicketID
+	}
+
+	accommodations, err := s.accommodationRepo.GetByTicketIDs(ctx, ticketIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	byTicket := make(map[string][]entity.Accommodation, len(accommodations))
+	for _, accommodation := range accommodations {
+		byTicket[*accommodation.TicketID] = append(byTicket[*accommodation.TicketID], accommodation)
+	}
+
+	attendeeResponses := make([]response.AttendeeResponse, len(attendees))
+	for i := range attendees {
+		attendeeResponses[i] = *response.ToAttendeeResponse(&attendees[i], byTicket[attendees[i].TicketID])
+	}
+
+	return attendeeResponses, total, nil
+}
+
+// GetSummary counts the accommodations of an event's valid and used tickets by type
+// and reports the accessible seating left per tier
+func (s *accommodationService) GetSummary(ctx context.Context, userID, role, eventID string) (*response.AccommodationSummaryResponse, error) {
+	if _, err := managedEvent(ctx, s.eventRepo, userID, role, eventID); err != nil {
+		return nil, err
+	}
+
+	counts, err := s.accommodationRepo.CountByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory, err := s.accommodationRepo.GetAccessibleInventory(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	countByType := make(map[string]int, len(counts))
+	for _, count := range counts {
+		countByType[count.Type] = count.Count
+	}
+
+	// Every type is listed, zero counts included, so the summary keeps its shape
+	summary := &response.AccommodationSummaryResponse{
+		EventID:           eventID,
+		ByType:            make([]response.AccommodationCountResponse, len(entity.AccommodationTypes)),
+		AccessibleSeating: make([]response.AccessibleSeatingResponse, len(inventory)),
+	}
+	for i, accommodationType := range entity.AccommodationTypes {
+		summary.ByType[i] = response.AccommodationCountResponse{Type: accommodationType, Count: countByType[accommodationType]}
+		summary.TotalRequests += countByType[accommodationType]
+	}
+	for i, tier := range inventory {
+		summary.AccessibleSeating[i] = response.AccessibleSeatingResponse{
+			TicketTierID:        tier.TicketTierID,
+			TierName:            tier.TierName,
+			AccessibleQuota:     tier.AccessibleQuota,
+			AccessibleSoldCount: tier.AccessibleSoldCount,
+			Available:           max(tier.AccessibleQuota-tier.AccessibleSoldCount, 0),
+		}
+	}
+
+	return summary, nil
+}
+
+// managedEvent returns the event if the user may manage it
+// Admins manage every event, organizers only their own
+func managedEvent(ctx context.Context, eventRepo repository.EventRepository, userID, role, eventID string) (*entity.Event, error) {
+	if role != entity.UserRoleAdmin && role != entity.UserRoleOrganizer {
+		return nil, ErrUnauthorized
+	}
+
+	event, err := eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return nil, ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	if role == entity.UserRoleOrganizer && event.OrganizerID != userID {
+		return nil, ErrUnauthorized
+	}
+
+	return event, nil
+}
+
+// newAccommodations builds the accommodations requested with an order, OrderID is set by the caller
+func newAccommodations(tenantID, eventID string, reqs []request.AccommodationRequest) []entity.Accommodation {
+	accommodations := make([]entity.Accommodation, len(reqs))
+	for i, req := range reqs {
+		accommodations[i] = entity.Accommodation{
+			TenantID:       tenantID,
+			EventID:        eventID,
+			TicketTierID:   req.TicketTierID,
+			Type:           req.Type,
+			AccessibleSeat: entity.AccommodationNeedsAccessibleSeat(req.Type),
+		}
+		if req.Notes != "" {
+			notes := req.Notes
+			accommodations[i].Notes = &notes
+		}
+	}
+	return accommodations
+}
+
+// accessibleSeatsByTier counts the accessible seats accommodations hold per ticket tier
+func accessibleSeatsByTier(accommodations []entity.Accommodation) map[string]int {
+	seats := make(map[string]int)
+	for _, accommodation := range accommodations {
+		if accommodation.AccessibleSeat {
+			seats[accommodation.TicketTierID]++
+		}
+	}
+	return seats
+}
+
+// takeAccessibleSeats splits quantity tickets of a tier into general and accessible seats,
+// taking the accessible ones from seats so tiers listed twice in an order aren't counted twice
+func takeAccessibleSeats(seats map[string]int, tierID string, quantity int) (general, accessible int) {
+	accessible = min(seats[tierID], quantity)
+	seats[tierID] -= accessible
+	return quantity - accessible, accessible
+}
+
+// assignAccommodationTickets pairs the unassigned accommodations of an order with tickets of their tier
+// Returns the accommodations that were assigned a ticket
+func assignAccommodationTickets(accommodations []entity.Accommodation, tickets []entity.Ticket) []entity.Accommodation {
+	taken := make(map[string]bool, len(accommodations))
+	for _, accommodation := range accommodations {
+		if accommodation.TicketID != nil {
+			taken[*accommodation.TicketID] = true
+		}
+	}
+
+	assigned := make([]entity.Accommodation, 0, len(accommodations))
+	for _, accommodation := range accommodations {
+		if accommodation.TicketID != nil {
+			continue
+		}
+		for _, ticket := range tickets {
+			if ticket.TicketTierID != accommodation.TicketTierID || taken[ticket.ID] {
+				continue
+			}
+			ticketID := ticket.ID
+			accommodation.TicketID = &ticketID
+			taken[ticket.ID] = true
+			assigned = append(assigned, accommodation)
+			break
+		}
+	}
+
+	return assigned
+}
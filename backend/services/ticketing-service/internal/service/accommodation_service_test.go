@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubAccommodationRepo struct {
+	repository.AccommodationRepository
+	accommodations []entity.Accommodation
+	counts         []entity.AccommodationCount
+	inventory      []entity.AccessibleInventory
+}
+
+func (r *stubAccommodationRepo) GetByOrderID(ctx context.Context, orderID string) ([]entity.Accommodation, error) {
+	var found []entity.Accommodation
+	for _, accommodation := range r.accommodations {
+		if accommodation.OrderID == orderID {
+			found = append(found, accommodation)
+		}
+	}
+	return found, nil
+}
+
+func (r *stubAccommodationRepo) CountByEventID(ctx context.Context, eventID string) ([]entity.AccommodationCount, error) {
+	return r.counts, nil
+}
+
+func (r *stubAccommodationRepo) GetAccessibleInventory(ctx context.Context, eventID string) ([]entity.AccessibleInventory, error) {
+	return r.inventory, nil
+}
+
+func TestTakeAccessibleSeats(t *testing.T) {
+	accommodations := newAccommodations("tenant-1", "event-1", []request.AccommodationRequest{
+		{TicketTierID: "tier-vip", Type: entity.AccommodationWheelchair},
+		{TicketTierID: "tier-vip", Type: entity.AccommodationCompanionSeat, Notes: "Next to the wheelchair space"},
+		{TicketTierID: "tier-vip", Type: entity.AccommodationHearing},
+		{TicketTierID: "tier-reg", Type: entity.AccommodationStepFree},
+	})
+	require.Len(t, accommodations, 4)
+	assert.True(t, accommodations[0].AccessibleSeat)
+	assert.Equal(t, "Next to the wheelchair space", *accommodations[1].Notes)
+	assert.False(t, accommodations[2].AccessibleSeat)
+	assert.Nil(t, accommodations[2].Notes)
+
+	seats := accessibleSeatsByTier(accommodations)
+	assert.Equal(t, map[string]int{"tier-vip": 2}, seats)
+
+	// The tier is listed twice: seats are taken once
+	general, accessible := takeAccessibleSeats(seats, "tier-vip", 1)
+	assert.Equal(t, 0, general)
+	assert.Equal(t, 1, accessible)
+
+	general, accessible = takeAccessibleSeats(seats, "tier-vip", 3)
+	assert.Equal(t, 2, general)
+	assert.Equal(t, 1, accessible)
+
+	general, accessible = takeAccessibleSeats(seats, "tier-reg", 2)
+	assert.Equal(t, 2, general)
+	assert.Equal(t, 0, accessible)
+}
+
+func TestAssignAccommodationTickets(t *testing.T) {
+	issued := "ticket-vip-1"
+	accommodations := []entity.Accommodation{
+		{ID: "acc-1", TicketTierID: "tier-vip", TicketID: &issued},
+		{ID: "acc-2", TicketTierID: "tier-vip"},
+		{ID: "acc-3", TicketTierID: "tier-reg"},
+		{ID: "acc-4", TicketTierID: "tier-reg"},
+	}
+	tickets := []entity.Ticket{
+		{ID: "ticket-vip-1", TicketTierID: "tier-vip"},
+		{ID: "ticket-vip-2", TicketTierID: "tier-vip"},
+		{ID: "ticket-reg-1", TicketTierID: "tier-reg"},
+	}
+
+	assigned := assignAccommodationTickets(accommodations, tickets)
+
+	// Already assigned accommodations keep their ticket; accommodations beyond the tickets stay unassigned
+	require.Len(t, assigned, 2)
+	assert.Equal(t, "acc-2", assigned[0].ID)
+	assert.Equal(t, "ticket-vip-2", *assigned[0].TicketID)
+	assert.Equal(t, "acc-3", assigned[1].ID)
+	assert.Equal(t, "ticket-reg-1", *assigned[1].TicketID)
+}
+
+func TestAccommodationService_GetSummary(t *testing.T) {
+	repo := &stubAccommodationRepo{
+		counts: []entity.AccommodationCount{
+			{Type: entity.AccommodationWheelchair, Count: 3},
+			{Type: entity.AccommodationHearing, Count: 2},
+		},
+		inventory: []entity.AccessibleInventory{
+			{TicketTierID: "tier-vip", TierName: "VIP", AccessibleQuota: 4, AccessibleSoldCount: 3},
+		},
+	}
+	svc := NewAccommodationService(repo, &stubEventRepo{event: &entity.Event{ID: "event-1", OrganizerID: "organizer-1"}})
+
+	summary, err := svc.GetSummary(context.Background(), "organizer-1", entity.UserRoleOrganizer, "event-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, summary.TotalRequests)
+	require.Len(t, summary.ByType, len(entity.AccommodationTypes))
+	assert.Equal(t, entity.AccommodationWheelchair, summary.ByType[0].Type)
+	assert.Equal(t, 3, summary.ByType[0].Count)
+	assert.Equal(t, 0, summary.ByType[1].Count)
+	require.Len(t, summary.AccessibleSeating, 1)
+	assert.Equal(t, 1, summary.AccessibleSeating[0].Available)
+
+	_, err = svc.GetSummary(context.Background(), "organizer-2", entity.UserRoleOrganizer, "event-1")
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	_, err = svc.GetSummary(context.Background(), "organizer-1", entity.UserRoleCustomer, "event-1")
+	assert.ErrorIs(t, err, ErrUnauthorized)
+}
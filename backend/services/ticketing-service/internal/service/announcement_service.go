@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+)
+
+var (
+	ErrNoAnnouncementRecipients = errors.New("event has no ticket holders to announce to")
+
+	// ErrAnnouncementQuotaExceeded carries notification service's explanation of the organizer's usage
+	ErrAnnouncementQuotaExceeded = client.ErrAnnouncementQuotaExceeded
+)
+
+// AnnouncementSender defines interface for emailing announcements (notification service)
+type AnnouncementSender interface {
+	SendAnnouncementEmail(ctx context.Context, req *client.SendAnnouncementEmailRequest) (*client.SendAnnouncementEmailResponse, error)
+}
+
+// AnnouncementService handles organizer announcements to the ticket holders of an event
+type AnnouncementService interface {
+	SendAnnouncement(ctx context.Context, userID, role string, req *request.SendAnnouncementRequest) (*response.AnnouncementResponse, error)
+}
+
+// announcementService implements AnnouncementService interface
+type announcementService struct {
+	ticketRepo repository.TicketRepository
+	eventRepo  repository.EventRepository
+	planRepo   repository.OrganizerPlanRepository
+	tenantRepo repository.TenantRepository
+	sender     AnnouncementSender
+}
+
+// NewAnnouncementService creates new announcement service instance
+// Announcements count towards the monthly email quota of the event organizer's plan, enforced by notification service
+func NewAnnouncementService(
+	ticketRepo repository.TicketRepository,
+	eventRepo repository.EventRepository,
+	planRepo repository.OrganizerPlanRepository,
+	tenantRepo repository.TenantRepository,
+	sender AnnouncementSender,
+) AnnouncementService {
+	return &announcementService{
+		ticketRepo: ticketRepo,
+		eventRepo:  eventRepo,
+		planRepo:   planRepo,
+		tenantRepo: tenantRepo,
+		sender:     sender,
+	}
+}
+
+// SendAnnouncement emails an announcement to every holder of a valid or used ticket of the event
+// Admins may announce for any event, organizers only for their own
+func (s *announcementService) SendAnnouncement(ctx context.Context, userID, role string, req *request.SendAnnouncementRequest) (*response.AnnouncementResponse, error) {
+	if role != entity.UserRoleAdmin && role != entity.UserRoleOrganizer {
+		return nil, ErrUnauthorized
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, req.EventID)
+	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return nil, ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	if role == entity.UserRoleOrganizer && event.OrganizerID != userID {
+		return nil, ErrUnauthorized
+	}
+
+	recipients, err := s.ticketRepo.GetHolderEmailsByEventID(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(recipients) == 0 {
+		return nil, ErrNoAnnouncementRecipients
+	}
+
+	// The quota is the organizer's, also when an admin announces on their behalf
+	plan, err := s.planRepo.GetForOrganizer(ctx, event.OrganizerID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.sender.SendAnnouncementEmail(ctx, &client.SendAnnouncementEmailRequest{
+		OrganizerID:       event.OrganizerID,
+		EventID:           event.ID,
+		EventName:         event.Name,
+		Subject:           req.Subject,
+		Message:           req.Message,
+		RecipientEmails:   recipients,
+		Plan:              plan.Code,
+		MonthlyEmailQuota: plan.AnnouncementEmailsPerMonth,
+		Branding:          tenantEmailBranding(ctx, s.tenantRepo, event.TenantID, event.OrganizerID),
+		Urgent:            req.Urgent,
+	})
+	if err != nil {
+		if errors.Is(err, client.ErrAnnouncementQuotaExceeded) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to send announcement: %w", err)
+	}
+
+	return &response.AnnouncementResponse{
+		EventID:    event.ID,
+		Plan:       plan.Code,
+		Recipients: len(recipients),
+		Sent:       result.SentCount,
+		Digested:   result.DigestedCount,
+		Failed:     result.FailedCount,
+		QuotaUsed:  result.QuotaUsed,
+		QuotaLimit: result.QuotaLimit,
+	}, nil
+}
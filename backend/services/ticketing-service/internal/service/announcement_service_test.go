@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// GetHolderEmailsByEventID returns one address per holder of valid and used tickets of the event
+func (r *stubTicketRepo) GetHolderEmailsByEventID(ctx context.Context, eventID string) ([]string, error) {
+	emails := []string{}
+	for _, ticket := range r.tickets {
+		if ticket.EventID != eventID || (!ticket.CanBeUsed() && !ticket.IsUsed()) {
+			continue
+		}
+		email := ticket.UserID + "@example.com"
+		if !slices.Contains(emails, email) {
+			emails = append(emails, email)
+		}
+	}
+	slices.Sort(emails)
+	return emails, nil
+}
+
+// stubPlanRepo returns the same plan for every organizer
+type stubPlanRepo struct {
+	plan *entity.OrganizerPlan
+}
+
+func (r *stubPlanRepo) GetForOrganizer(ctx context.Context, organizerID string) (*entity.OrganizerPlan, error) {
+	return r.plan, nil
+}
+
+func newAnnouncementFixture() (*announcementService, *stubTicketRepo, *testutil.NotificationClient) {
+	ticketSvc, tickets, _ := newTicketFixture()
+	tickets.tickets["ticket-2"] = &entity.Ticket{ID: "ticket-2", UserID: "owner", EventID: "event-1", Status: entity.TicketStatusValid}
+	tickets.tickets["ticket-other"] = &entity.Ticket{ID: "ticket-other", UserID: "guest", EventID: "event-1", Status: entity.TicketStatusValid}
+	tickets.tickets["ticket-void"] = &entity.Ticket{ID: "ticket-void", UserID: "voided", EventID: "event-1", Status: entity.TicketStatusVoid}
+
+	sender := &testutil.NotificationClient{}
+	svc := NewAnnouncementService(
+		tickets,
+		ticketSvc.eventRepo,
+		&stubPlanRepo{plan: &entity.OrganizerPlan{Code: "free", Name: "Free", AnnouncementEmailsPerMonth: 1000}},
+		&stubTenantRepo{},
+		sender,
+	).(*announcementService)
+	return svc, tickets, sender
+}
+
+func TestAnnouncementService_SendAnnouncement(t *testing.T) {
+	svc, _, sender := newAnnouncementFixture()
+	ctx := context.Background()
+	req := &request.SendAnnouncementRequest{EventID: "event-1", Subject: "Gate change", Message: "Use gate B"}
+
+	result, err := svc.SendAnnouncement(ctx, "organizer-1", entity.UserRoleOrganizer, req)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Recipients)
+	assert.Equal(t, 2, result.Sent)
+	assert.Equal(t, "free", result.Plan)
+	assert.Equal(t, 1000, result.QuotaLimit)
+
+	// Holders with several tickets get one email; voided tickets get none
+	calls := sender.SendAnnouncementEmailCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, []string{"guest@example.com", "owner@example.com"}, calls[0].RecipientEmails)
+	assert.Equal(t, "organizer-1", calls[0].OrganizerID)
+	assert.Equal(t, 1000, calls[0].MonthlyEmailQuota)
+
+	// Organizers only announce for their own events
+	_, err = svc.SendAnnouncement(ctx, "organizer-2", entity.UserRoleOrganizer, req)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+	_, err = svc.SendAnnouncement(ctx, "owner", entity.UserRoleCustomer, req)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	// Admins announce on the organizer's quota
+	_, err = svc.SendAnnouncement(ctx, "admin-1", entity.UserRoleAdmin, req)
+	require.NoError(t, err)
+	assert.Equal(t, "organizer-1", sender.SendAnnouncementEmailCalls()[1].OrganizerID)
+}
+
+func TestAnnouncementService_QuotaExceeded(t *testing.T) {
+	svc, tickets, sender := newAnnouncementFixture()
+	ctx := context.Background()
+	req := &request.SendAnnouncementRequest{EventID: "event-1", Subject: "Gate change", Message: "Use gate B"}
+
+	sender.SendAnnouncementEmailFunc = func(ctx context.Context, req *client.SendAnnouncementEmailRequest) (*client.SendAnnouncementEmailResponse, error) {
+		return nil, fmt.Errorf("%w: 999 of 1000 used", client.ErrAnnouncementQuotaExceeded)
+	}
+
+	_, err := svc.SendAnnouncement(ctx, "organizer-1", entity.UserRoleOrganizer, req)
+	assert.ErrorIs(t, err, ErrAnnouncementQuotaExceeded)
+	assert.Contains(t, err.Error(), "999 of 1000 used")
+
+	// Events without ticket holders never reach notification service
+	for _, ticket := range tickets.tickets {
+		ticket.Status = entity.TicketStatusVoid
+	}
+	_, err = svc.SendAnnouncement(ctx, "organizer-1", entity.UserRoleOrganizer, req)
+	assert.ErrorIs(t, err, ErrNoAnnouncementRecipients)
+	assert.Len(t, sender.SendAnnouncementEmailCalls(), 1)
+}
+
+func TestAnnouncementService_Digested(t *testing.T) {
+	svc, _, sender := newAnnouncementFixture()
+	ctx := context.Background()
+
+	// Holders who chose a digest get the announcement there, still counted against the quota
+	sender.SendAnnouncementEmailFunc = func(ctx context.Context, req *client.SendAnnouncementEmailRequest) (*client.SendAnnouncementEmailResponse, error) {
+		digested := 1
+		if req.Urgent {
+			digested = 0
+		}
+		recipients := len(req.RecipientEmails)
+		return &client.SendAnnouncementEmailResponse{SentCount: recipients - digested, DigestedCount: digested, QuotaUsed: recipients}, nil
+	}
+
+	result, err := svc.SendAnnouncement(ctx, "organizer-1", entity.UserRoleOrganizer,
+		&request.SendAnnouncementRequest{EventID: "event-1", Subject: "Merch", Message: "New shirts"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Sent)
+	assert.Equal(t, 1, result.Digested)
+	assert.Equal(t, 2, result.QuotaUsed)
+
+	// Urgent announcements bypass digests
+	result, err = svc.SendAnnouncement(ctx, "organizer-1", entity.UserRoleOrganizer,
+		&request.SendAnnouncementRequest{EventID: "event-1", Subject: "Gate change", Message: "Use gate B", Urgent: true})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Sent)
+	assert.Zero(t, result.Digested)
+	assert.True(t, sender.SendAnnouncementEmailCalls()[1].Urgent)
+}
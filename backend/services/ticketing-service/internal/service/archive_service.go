@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+)
+
+// maxArchiveBatchesPerRun caps work per run so a large backlog is drained over several runs
+const maxArchiveBatchesPerRun = 50
+
+// ArchiveService handles archival of old terminal orders
+type ArchiveService interface {
+	ArchiveOldOrders(ctx context.Context) (int, error)
+}
+
+// archiveService implements ArchiveService interface
+type archiveService struct {
+	archiveRepo     repository.ArchiveRepository
+	retentionPeriod time.Duration
+	batchSize       int
+}
+
+// NewArchiveService creates new archive service instance
+func NewArchiveService(
+	archiveRepo repository.ArchiveRepository,
+	retentionPeriod time.Duration,
+	batchSize int,
+) ArchiveService {
+	return &archiveService{
+		archiveRepo:     archiveRepo,
+		retentionPeriod: retentionPeriod,
+		batchSize:       batchSize,
+	}
+}
+
+// ArchiveOldOrders moves expired, cancelled and completed orders older than
+// the retention period (with items and tickets) into archive tables
+// Returns total number of orders archived
+func (s *archiveService) ArchiveOldOrders(ctx context.Context) (int, error) {
+	cutoff := time.Now().Add(-s.retentionPeriod)
+	total := 0
+
+	for i := 0; i < maxArchiveBatchesPerRun; i++ {
+		count, err := s.archiveRepo.ArchiveOrders(ctx, cutoff, s.batchSize)
+		if err != nil {
+			return total, fmt.Errorf("failed to archive orders: %w", err)
+		}
+
+		total += count
+		if count < s.batchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		log.Printf("[ArchiveService] Archived %d orders last updated before %s", total, cutoff.Format(time.RFC3339))
+	}
+
+	return total, nil
+}
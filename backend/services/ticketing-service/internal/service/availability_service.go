@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+)
+
+// AvailabilityService keeps the event availability summary in sync with sold_count changes
+// Changes only mark the summary stale, the refresh worker coalesces them into one refresh per interval
+type AvailabilityService interface {
+	MarkStale()
+	RefreshIfStale(ctx context.Context) (bool, error)
+}
+
+// availabilityService implements AvailabilityService interface
+type availabilityService struct {
+	availabilityRepo repository.AvailabilityRepository
+	stale            atomic.Bool
+}
+
+// NewAvailabilityService creates new availability service instance
+// The summary starts stale so it is refreshed once on startup
+func NewAvailabilityService(availabilityRepo repository.AvailabilityRepository) AvailabilityService {
+	s := &availabilityService{availabilityRepo: availabilityRepo}
+	s.stale.Store(true)
+	return s
+}
+
+// MarkStale records that sold_count changed since the last refresh
+func (s *availabilityService) MarkStale() {
+	s.stale.Store(true)
+}
+
+// RefreshIfStale refreshes the summary when sold_count changed since the last refresh
+// Returns true if a refresh was performed
+func (s *availabilityService) RefreshIfStale(ctx context.Context) (bool, error) {
+	if !s.stale.Swap(false) {
+		return false, nil
+	}
+
+	if err := s.availabilityRepo.Refresh(ctx); err != nil {
+		// Keep stale so the next run retries
+		s.stale.Store(true)
+		return false, fmt.Errorf("failed to refresh availability: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,386 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/utility"
+)
+
+var (
+	ErrKioskNotFound    = errors.New("kiosk not found")
+	ErrKioskInvalid     = errors.New("kiosk token is invalid or revoked")
+	ErrTicketWrongEvent = errors.New("ticket is for another event")
+	ErrNoBadges         = errors.New("no tickets to print badges for")
+	ErrTooManyBadges    = errors.New("too many badges for one PDF")
+)
+
+// BadgeCompanyMetadataKey is the order metadata key holding the attendee's company
+const BadgeCompanyMetadataKey = "company"
+
+// BadgeRenderer defines interface for rendering printable badges (notification service)
+type BadgeRenderer interface {
+	GenerateBadgePDF(ctx context.Context, req *client.GenerateBadgePDFRequest) ([]byte, error)
+}
+
+// BadgePDF is a rendered batch of badges
+type BadgePDF struct {
+	Filename   string
+	Content    []byte
+	BadgeCount int
+}
+
+// BadgeService handles conference badges and self-service check-in kiosks
+// Badges and kiosks are managed by the event's organizer or an admin
+type BadgeService interface {
+	GetBadge(ctx context.Context, userID, role, ticketID string) (*response.BadgeResponse, error)
+	GenerateBadgePDF(ctx context.Context, userID, role string, req *request.BadgePDFRequest) (*BadgePDF, error)
+
+	RegisterKiosk(ctx context.Context, userID, role string, req *request.RegisterKioskRequest) (*response.KioskRegistrationResponse, error)
+	ListKiosks(ctx context.Context, userID, role, eventID string) ([]response.KioskResponse, error)
+	RevokeKiosk(ctx context.Context, userID, role, kioskID string) (*response.KioskResponse, error)
+	KioskCheckIn(ctx context.Context, token string, req *request.KioskCheckInRequest) (*response.KioskCheckInResponse, error)
+}
+
+// badgeService implements BadgeService interface
+type badgeService struct {
+	ticketRepo     repository.TicketRepository
+	orderRepo      repository.OrderRepository
+	eventRepo      repository.EventRepository
+	ticketTierRepo repository.TicketTierRepository
+	userRepo       repository.UserRepository
+	tenantRepo     repository.TenantRepository
+	kioskRepo      repository.CheckinKioskRepository
+	ticketService  TicketService
+	renderer       BadgeRenderer
+	kioskSecret    string
+}
+
+// NewBadgeService creates new badge service instance
+// Kiosk check-ins go through TicketService, so the event scan policy and scan history apply
+func NewBadgeService(
+	ticketRepo repository.TicketRepository,
+	orderRepo repository.OrderRepository,
+	eventRepo repository.EventRepository,
+	ticketTierRepo repository.TicketTierRepository,
+	userRepo repository.UserRepository,
+	tenantRepo repository.TenantRepository,
+	kioskRepo repository.CheckinKioskRepository,
+	ticketService TicketService,
+	renderer BadgeRenderer,
+	kioskSecret string,
+) BadgeService {
+	return &badgeService{
+		ticketRepo:     ticketRepo,
+		orderRepo:      orderRepo,
+		eventRepo:      eventRepo,
+		ticketTierRepo: ticketTierRepo,
+		userRepo:       userRepo,
+		tenantRepo:     tenantRepo,
+		kioskRepo:      kioskRepo,
+		ticketService:  ticketService,
+		renderer:       renderer,
+		kioskSecret:    kioskSecret,
+	}
+}
+
+// GetBadge returns the badge-ready data of a ticket
+func (s *badgeService) GetBadge(ctx context.Context, userID, role, ticketID string) (*response.BadgeResponse, error) {
+	ticket, err := s.ticketRepo.GetByID(ctx, ticketID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketNotFound) {
+			return nil, ErrTicketNotFound
+		}
+		return nil, fmt.Errorf("failed to get ticket: %w", err)
+	}
+
+	event, err := s.managedEvent(ctx, userID, role, ticket.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Attendees already inside still get a badge; voided, cancelled and expired tickets do not
+	if !ticket.CanBeUsed() && !ticket.IsUsed() {
+		if ticket.IsVoid() {
+			return nil, ErrTicketVoid
+		}
+		return nil, ErrTicketInvalid
+	}
+
+	badges, err := s.toBadgeResponses(ctx, event, []entity.Ticket{*ticket})
+	if err != nil {
+		return nil, err
+	}
+
+	return &badges[0], nil
+}
+
+// GenerateBadgePDF renders the badges of an event's valid and used tickets into one PDF
+func (s *badgeService) GenerateBadgePDF(ctx context.Context, userID, role string, req *request.BadgePDFRequest) (*BadgePDF, error) {
+	event, err := s.managedEvent(ctx, userID, role, req.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// One extra row tells a full event apart from one that exceeds the cap
+	tickets, err := s.ticketRepo.GetAdmittableByEventID(ctx, event.ID, req.TicketIDs, request.MaxBadgesPerPDF+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(tickets) == 0 {
+		return nil, ErrNoBadges
+	}
+	if len(tickets) > request.MaxBadgesPerPDF {
+		return nil, ErrTooManyBadges
+	}
+
+	badges, err := s.toBadgeResponses(ctx, event, tickets)
+	if err != nil {
+		return nil, err
+	}
+
+	renderReq := &client.GenerateBadgePDFRequest{
+		EventName:      event.Name,
+		EventStartTime: event.StartDate.In(eventLocation(event)).Format("Monday, 02 Jan 2006 15:04 MST"),
+		BrandName:      s.brandName(ctx, event.TenantID),
+		Badges:         make([]client.BadgeInfo, len(badges)),
+	}
+	for i, badge := range badges {
+		renderReq.Badges[i] = client.BadgeInfo{
+			TicketID:     badge.TicketID,
+			TicketNumber: badge.TicketNumber,
+			AttendeeName: badge.Name,
+			Company:      badge.Company,
+			TierName:     badge.TierName,
+			QRCode:       badge.QRCode,
+		}
+	}
+
+	pdf, err := s.renderer.GenerateBadgePDF(ctx, renderReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render badges: %w", err)
+	}
+
+	return &BadgePDF{
+		Filename:   fmt.Sprintf("badges-%s.pdf", event.Slug),
+		Content:    pdf,
+		BadgeCount: len(badges),
+	}, nil
+}
+
+// RegisterKiosk registers a check-in kiosk for an event and returns its device token
+func (s *badgeService) RegisterKiosk(ctx context.Context, userID, role string, req *request.RegisterKioskRequest) (*response.KioskRegistrationResponse, error) {
+	event, err := s.managedEvent(ctx, userID, role, req.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	kiosk := &entity.CheckinKiosk{
+		TenantID:     event.TenantID,
+		EventID:      event.ID,
+		Name:         req.Name,
+		RegisteredBy: userID,
+	}
+	if err := s.kioskRepo.Create(ctx, kiosk); err != nil {
+		return nil, err
+	}
+
+	return &response.KioskRegistrationResponse{
+		KioskResponse: *response.ToKioskResponse(kiosk),
+		Token:         utility.GenerateKioskToken(s.kioskSecret, kiosk.ID),
+	}, nil
+}
+
+// ListKiosks lists the kiosks registered for an event
+func (s *badgeService) ListKiosks(ctx context.Context, userID, role, eventID string) ([]response.KioskResponse, error) {
+	if _, err := s.managedEvent(ctx, userID, role, eventID); err != nil {
+		return nil, err
+	}
+
+	kiosks, err := s.kioskRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	kioskResponses := make([]response.KioskResponse, len(kiosks))
+	for i := range kiosks {
+		kioskResponses[i] = *response.ToKioskResponse(&kiosks[i])
+	}
+
+	return kioskResponses, nil
+}
+
+// RevokeKiosk revokes a kiosk; its device token stops working immediately
+func (s *badgeService) RevokeKiosk(ctx context.Context, userID, role, kioskID string) (*response.KioskResponse, error) {
+	kiosk, err := s.kioskRepo.GetByID(ctx, kioskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrKioskNotFound) {
+			return nil, ErrKioskNotFound
+		}
+		return nil, err
+	}
+
+	if _, err := s.managedEvent(ctx, userID, role, kiosk.EventID); err != nil {
+		return nil, err
+	}
+
+	// Revoking twice is a no-op
+	if kiosk.IsActive() {
+		if err := s.kioskRepo.Revoke(ctx, kiosk.ID); err != nil && !errors.Is(err, repository.ErrKioskNotFound) {
+			return nil, err
+		}
+		if kiosk, err = s.kioskRepo.GetByID(ctx, kiosk.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return response.ToKioskResponse(kiosk), nil
+}
+
+// KioskCheckIn admits the attendee whose ticket was scanned at a kiosk and returns their badge
+// Only tickets of the kiosk's event are accepted
+func (s *badgeService) KioskCheckIn(ctx context.Context, token string, req *request.KioskCheckInRequest) (*response.KioskCheckInResponse, error) {
+	kioskID, err := utility.ParseKioskToken(s.kioskSecret, token)
+	if err != nil {
+		return nil, ErrKioskInvalid
+	}
+
+	kiosk, err := s.kioskRepo.GetByID(ctx, kioskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrKioskNotFound) {
+			return nil, ErrKioskInvalid
+		}
+		return nil, err
+	}
+	if !kiosk.IsActive() {
+		return nil, ErrKioskInvalid
+	}
+
+	claims, err := utility.ParseTicketQRData(req.QRData)
+	if err != nil {
+		return nil, ErrTicketInvalid
+	}
+	if claims.EventID != kiosk.EventID {
+		return nil, ErrTicketWrongEvent
+	}
+
+	ticketResp, err := s.ticketService.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: req.QRData})
+	if err != nil {
+		return nil, err
+	}
+
+	// Last seen is informational; the check-in already succeeded
+	if err := s.kioskRepo.TouchLastSeen(ctx, kiosk.ID); err != nil {
+		log.Printf("[BadgeService] Failed to update last seen of kiosk %s: %v", kiosk.ID, err)
+	}
+
+	ticket, err := s.ticketRepo.GetByID(ctx, ticketResp.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket: %w", err)
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, ticket.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	badges, err := s.toBadgeResponses(ctx, event, []entity.Ticket{*ticket})
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.KioskCheckInResponse{
+		Ticket: *ticketResp,
+		Badge:  badges[0],
+	}, nil
+}
+
+// managedEvent returns the event if the user may manage its badges and kiosks
+func (s *badgeService) managedEvent(ctx context.Context, userID, role, eventID string) (*entity.Event, error) {
+	return managedEvent(ctx, s.eventRepo, userID, role, eventID)
+}
+
+// toBadgeResponses loads holders, tiers and order metadata for tickets of one event
+// Missing holders or tiers leave the field empty rather than failing the batch
+func (s *badgeService) toBadgeResponses(ctx context.Context, event *entity.Event, tickets []entity.Ticket) ([]response.BadgeResponse, error) {
+	tierIDs := make([]string, 0, len(tickets))
+	for _, ticket := range tickets {
+		tierIDs = append(tierIDs, ticket.TicketTierID)
+	}
+
+	tiers, err := s.ticketTierRepo.GetByIDs(ctx, tierIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket tiers: %w", err)
+	}
+	tierNames := make(map[string]string, len(tiers))
+	for _, tier := range tiers {
+		tierNames[tier.ID] = tier.Name
+	}
+
+	holderNames := make(map[string]string)
+	companies := make(map[string]string)
+	eventSummary := response.ToEventSummaryResponse(event)
+
+	badges := make([]response.BadgeResponse, len(tickets))
+	for i, ticket := range tickets {
+		name, ok := holderNames[ticket.UserID]
+		if !ok {
+			user, err := s.userRepo.GetByID(ctx, ticket.UserID)
+			if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
+				return nil, fmt.Errorf("failed to get ticket holder: %w", err)
+			}
+			if user != nil {
+				name = user.FullName
+			}
+			holderNames[ticket.UserID] = name
+		}
+
+		company, ok := companies[ticket.OrderID]
+		if !ok {
+			order, err := s.orderRepo.GetByID(ctx, ticket.OrderID)
+			if err != nil && !errors.Is(err, repository.ErrOrderNotFound) {
+				return nil, fmt.Errorf("failed to get order: %w", err)
+			}
+			if order != nil {
+				company = order.Metadata[BadgeCompanyMetadataKey]
+			}
+			companies[ticket.OrderID] = company
+		}
+
+		badges[i] = response.BadgeResponse{
+			TicketID:     ticket.ID,
+			TicketNumber: ticket.TicketNumber,
+			Name:         name,
+			Company:      company,
+			TierName:     tierNames[ticket.TicketTierID],
+			QRCode:       ticket.QRCode,
+			Status:       ticket.Status,
+			Event:        eventSummary,
+		}
+	}
+
+	return badges, nil
+}
+
+// brandName returns the tenant brand printed on badges, empty for the platform default
+func (s *badgeService) brandName(ctx context.Context, tenantID string) string {
+	if tenantID == "" || tenantID == tenant.DefaultID {
+		return ""
+	}
+
+	found, err := s.tenantRepo.GetByID(ctx, tenantID)
+	if err != nil {
+		log.Printf("[BadgeService] Failed to get tenant %s for badge branding: %v", tenantID, err)
+		return ""
+	}
+
+	return found.Name
+}
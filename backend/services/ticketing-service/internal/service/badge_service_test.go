@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// GetAdmittableByEventID returns valid and used tickets of the event, optionally filtered by ID
+func (r *stubTicketRepo) GetAdmittableByEventID(ctx context.Context, eventID string, ticketIDs []string, limit int) ([]entity.Ticket, error) {
+	tickets := []entity.Ticket{}
+	for _, ticket := range r.tickets {
+		if ticket.EventID != eventID || (!ticket.CanBeUsed() && !ticket.IsUsed()) {
+			continue
+		}
+		if len(ticketIDs) > 0 && !slices.Contains(ticketIDs, ticket.ID) {
+			continue
+		}
+		tickets = append(tickets, *ticket)
+	}
+	slices.SortFunc(tickets, func(a, b entity.Ticket) int { return strings.Compare(a.TicketNumber, b.TicketNumber) })
+	if len(tickets) > limit {
+		tickets = tickets[:limit]
+	}
+	return tickets, nil
+}
+
+func (r *stubTicketTierRepo) GetByIDs(ctx context.Context, ids []string) ([]entity.TicketTier, error) {
+	tiers := []entity.TicketTier{}
+	for _, id := range ids {
+		if tier, ok := r.tiers[id]; ok {
+			tiers = append(tiers, *tier)
+		}
+	}
+	return tiers, nil
+}
+
+// stubKioskRepo keeps kiosks in memory
+type stubKioskRepo struct {
+	kiosks map[string]*entity.CheckinKiosk
+}
+
+func (r *stubKioskRepo) Create(ctx context.Context, kiosk *entity.CheckinKiosk) error {
+	kiosk.ID = "kiosk-" + string(rune('a'+len(r.kiosks)))
+	kiosk.CreatedAt = time.Now()
+	r.kiosks[kiosk.ID] = kiosk
+	return nil
+}
+
+func (r *stubKioskRepo) GetByID(ctx context.Context, id string) (*entity.CheckinKiosk, error) {
+	kiosk, ok := r.kiosks[id]
+	if !ok {
+		return nil, repository.ErrKioskNotFound
+	}
+	copied := *kiosk
+	return &copied, nil
+}
+
+func (r *stubKioskRepo) ListByEventID(ctx context.Context, eventID string) ([]entity.CheckinKiosk, error) {
+	kiosks := []entity.CheckinKiosk{}
+	for _, kiosk := range r.kiosks {
+		if kiosk.EventID == eventID {
+			kiosks = append(kiosks, *kiosk)
+		}
+	}
+	return kiosks, nil
+}
+
+func (r *stubKioskRepo) Revoke(ctx context.Context, id string) error {
+	kiosk, ok := r.kiosks[id]
+	if !ok || kiosk.RevokedAt != nil {
+		return repository.ErrKioskNotFound
+	}
+	now := time.Now()
+	kiosk.RevokedAt = &now
+	return nil
+}
+
+func (r *stubKioskRepo) TouchLastSeen(ctx context.Context, id string) error {
+	if kiosk, ok := r.kiosks[id]; ok {
+		now := time.Now()
+		kiosk.LastSeenAt = &now
+	}
+	return nil
+}
+
+func newBadgeFixture() (*badgeService, *stubTicketRepo, *testutil.NotificationClient) {
+	ticketSvc, tickets, _ := newTicketFixture()
+	for _, ticket := range tickets.tickets {
+		ticket.OrderID = "order-1"
+		ticket.TicketTierID = "tier-vip"
+		ticket.TicketNumber = "TKT-" + ticket.ID
+	}
+	tickets.tickets["ticket-void"] = &entity.Ticket{ID: "ticket-void", OrderID: "order-1", UserID: "owner", EventID: "event-1", Status: entity.TicketStatusVoid}
+
+	renderer := &testutil.NotificationClient{}
+	svc := NewBadgeService(
+		tickets,
+		&stubOrderRepo{order: &entity.Order{ID: "order-1", Metadata: entity.OrderMetadata{"company": "Acme Corp"}}},
+		ticketSvc.eventRepo,
+		&stubTicketTierRepo{tiers: map[string]*entity.TicketTier{
+			"tier-vip": {ID: "tier-vip", Name: "VIP"},
+		}},
+		&stubUserRepo{user: &entity.User{ID: "owner", FullName: "Ticket Owner"}},
+		&stubTenantRepo{},
+		&stubKioskRepo{kiosks: map[string]*entity.CheckinKiosk{}},
+		ticketSvc,
+		renderer,
+		"kiosk-secret",
+	).(*badgeService)
+	return svc, tickets, renderer
+}
+
+func TestBadgeService_GetBadge(t *testing.T) {
+	svc, _, _ := newBadgeFixture()
+	ctx := context.Background()
+
+	badge, err := svc.GetBadge(ctx, "organizer-1", entity.UserRoleOrganizer, "ticket-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Ticket Owner", badge.Name)
+	assert.Equal(t, "Acme Corp", badge.Company)
+	assert.Equal(t, "VIP", badge.TierName)
+	require.NotNil(t, badge.Event)
+	assert.Equal(t, "event-1", badge.Event.ID)
+
+	// Organizers only see badges of their own events; customers never do
+	_, err = svc.GetBadge(ctx, "organizer-2", entity.UserRoleOrganizer, "ticket-1")
+	assert.ErrorIs(t, err, ErrUnauthorized)
+	_, err = svc.GetBadge(ctx, "owner", entity.UserRoleCustomer, "ticket-1")
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	_, err = svc.GetBadge(ctx, "admin-1", entity.UserRoleAdmin, "ticket-void")
+	assert.ErrorIs(t, err, ErrTicketVoid)
+}
+
+func TestBadgeService_GenerateBadgePDFSkipsVoidTickets(t *testing.T) {
+	svc, _, renderer := newBadgeFixture()
+	ctx := context.Background()
+
+	pdf, err := svc.GenerateBadgePDF(ctx, "organizer-1", entity.UserRoleOrganizer, &request.BadgePDFRequest{EventID: "event-1"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, pdf.BadgeCount)
+	assert.NotEmpty(t, pdf.Content)
+
+	calls := renderer.GenerateBadgePDFCalls()
+	require.Len(t, calls, 1)
+	require.Len(t, calls[0].Badges, 2)
+	assert.Equal(t, "ticket-1", calls[0].Badges[0].TicketID)
+	assert.Equal(t, "ticket-used", calls[0].Badges[1].TicketID)
+	assert.Equal(t, "Acme Corp", calls[0].Badges[0].Company)
+	assert.Empty(t, calls[0].BrandName)
+
+	_, err = svc.GenerateBadgePDF(ctx, "organizer-1", entity.UserRoleOrganizer, &request.BadgePDFRequest{EventID: "event-1", TicketIDs: []string{"ticket-void"}})
+	assert.ErrorIs(t, err, ErrNoBadges)
+}
+
+func TestBadgeService_KioskCheckIn(t *testing.T) {
+	svc, tickets, _ := newBadgeFixture()
+	ctx := context.Background()
+
+	kiosk, err := svc.RegisterKiosk(ctx, "organizer-1", entity.UserRoleOrganizer, &request.RegisterKioskRequest{EventID: "event-1", Name: "Lobby 1"})
+	require.NoError(t, err)
+	require.NotEmpty(t, kiosk.Token)
+
+	// Tickets of other events are rejected before anything is scanned
+	_, err = svc.KioskCheckIn(ctx, kiosk.Token, &request.KioskCheckInRequest{QRData: "TICKET|ticket-9|event-2|nonce"})
+	assert.ErrorIs(t, err, ErrTicketWrongEvent)
+
+	_, err = svc.KioskCheckIn(ctx, kiosk.Token+"x", &request.KioskCheckInRequest{QRData: tickets.tickets["ticket-1"].QRData})
+	assert.ErrorIs(t, err, ErrKioskInvalid)
+
+	result, err := svc.KioskCheckIn(ctx, kiosk.Token, &request.KioskCheckInRequest{QRData: tickets.tickets["ticket-1"].QRData})
+	require.NoError(t, err)
+	assert.Equal(t, entity.TicketStatusUsed, result.Ticket.Status)
+	assert.Equal(t, "Ticket Owner", result.Badge.Name)
+	assert.Equal(t, "Acme Corp", result.Badge.Company)
+
+	// Scan policy still applies at kiosks
+	_, err = svc.KioskCheckIn(ctx, kiosk.Token, &request.KioskCheckInRequest{QRData: tickets.tickets["ticket-1"].QRData})
+	assert.ErrorIs(t, err, ErrTicketAlreadyUsed)
+
+	_, err = svc.RevokeKiosk(ctx, "organizer-2", entity.UserRoleOrganizer, kiosk.ID)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	revoked, err := svc.RevokeKiosk(ctx, "organizer-1", entity.UserRoleOrganizer, kiosk.ID)
+	require.NoError(t, err)
+	assert.False(t, revoked.Active)
+
+	_, err = svc.KioskCheckIn(ctx, kiosk.Token, &request.KioskCheckInRequest{QRData: tickets.tickets["ticket-used"].QRData})
+	assert.ErrorIs(t, err, ErrKioskInvalid)
+}
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+)
+
+// Kinds of gate scans counted per minute
+const (
+	gateScanEntry    = "entry"
+	gateScanExit     = "exit"
+	gateScanRejected = "rejected"
+)
+
+const (
+	// checkinMetricsTTL keeps per-minute scan counters a while longer than the widest live window
+	checkinMetricsTTL = 2 * time.Hour
+	// scannerStatusTTL drops the scanners of an event a day after their last heartbeat
+	scannerStatusTTL = 24 * time.Hour
+)
+
+// recordGateScanScript counts a scan in field ARGV[1] of the minute's hash and keeps it for ARGV[2] seconds
+const recordGateScanScript = `
+redis.call('HINCRBY', KEYS[1], ARGV[1], 1)
+redis.call('EXPIRE', KEYS[1], ARGV[2])
+return 1
+`
+
+// scannerHeartbeatScript stores scanner ARGV[1]'s status ARGV[2] and keeps the event's scanners for ARGV[3] seconds
+const scannerHeartbeatScript = `
+redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+redis.call('EXPIRE', KEYS[1], ARGV[3])
+return 1
+`
+
+// liveCheckinsScript returns the scanners of KEYS[1] followed by the counters of the minutes KEYS[2..],
+// each as a flat field/value array, in one round trip
+const liveCheckinsScript = `
+local replies = {}
+for i, key in ipairs(KEYS) do
+  replies[i] = redis.call('HGETALL', key)
+end
+return replies
+`
+
+// scannerStatus is the last heartbeat of a scanner device
+type scannerStatus struct {
+	ScannerID    string    `json:"scanner_id"`
+	Zone         string    `json:"zone"`
+	PendingScans int       `json:"pending_scans"`
+	StaffID      string    `json:"staff_id"`
+	LastSeenAt   time.Time `json:"last_seen_at"`
+}
+
+// gateScanCounts are the scans of one gate in one minute
+type gateScanCounts struct {
+	Entries  int
+	Exits    int
+	Rejected int
+}
+
+// checkinMetrics counts gate scans per minute and keeps scanner heartbeats in Redis
+// A nil checkinMetrics is disabled: scans are not counted and there is no live view
+type checkinMetrics struct {
+	redis cache.RedisClient
+	now   func() time.Time
+}
+
+// newCheckinMetrics creates new check-in metrics, nil when redisClient is nil
+func newCheckinMetrics(redisClient cache.RedisClient) *checkinMetrics {
+	if redisClient == nil {
+		return nil
+	}
+	return &checkinMetrics{
+		redis: redisClient,
+		now:   time.Now,
+	}
+}
+
+// Record counts a scan at the gate of zone (empty for gates without a zone)
+// Counting is best effort, a failure is logged and never fails the scan
+func (m *checkinMetrics) Record(ctx context.Context, eventID, zone, direction string, accepted bool) {
+	if m == nil {
+		return
+	}
+
+	kind := gateScanRejected
+	if accepted {
+		kind = gateScanEntry
+		if direction == gateScanExit {
+			kind = gateScanExit
+		}
+	}
+
+	key := gateScansKey(eventID, m.now())
+	if _, err := m.redis.Eval(ctx, recordGateScanScript, []string{key}, kind+":"+zone, int(checkinMetricsTTL.Seconds())); err != nil {
+		log.Printf("[CheckinMetrics] Failed to count scan of event %s: %v", eventID, err)
+	}
+}
+
+// Heartbeat stores the status of a scanner
+func (m *checkinMetrics) Heartbeat(ctx context.Context, eventID string, status scannerStatus) error {
+	data, err := json.Marshal(status)
+	if err != nil {
+		return fmt.Errorf("failed to encode scanner status: %w", err)
+	}
+
+	if _, err := m.redis.Eval(ctx, scannerHeartbeatScript, []string{scannersKey(eventID)},
+		status.ScannerID, string(data), int(scannerStatusTTL.Seconds())); err != nil {
+		return fmt.Errorf("failed to store scanner heartbeat: %w", err)
+	}
+	return nil
+}
+
+// Live returns the event's scanners and the scans per gate of each of the minutes, oldest first
+func (m *checkinMetrics) Live(ctx context.Context, eventID string, minutes []time.Time) ([]scannerStatus, []map[string]gateScanCounts, error) {
+	keys := make([]string, 0, len(minutes)+1)
+	keys = append(keys, scannersKey(eventID))
+	for _, minute := range minutes {
+		keys = append(keys, gateScansKey(eventID, minute))
+	}
+
+	result, err := m.redis.Eval(ctx, liveCheckinsScript, keys)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get live check-ins: %w", err)
+	}
+	replies, ok := result.([]interface{})
+	if !ok || len(replies) != len(keys) {
+		return nil, nil, fmt.Errorf("unexpected live check-ins %v", result)
+	}
+
+	var scanners []scannerStatus
+	for _, data := range hashValues(replies[0]) {
+		var status scannerStatus
+		if err := json.Unmarshal([]byte(data), &status); err != nil {
+			log.Printf("[CheckinMetrics] Dropping unreadable scanner status of event %s: %v", eventID, err)
+			continue
+		}
+		scanners = append(scanners, status)
+	}
+
+	counts := make([]map[string]gateScanCounts, len(minutes))
+	for i, reply := range replies[1:] {
+		counts[i] = parseGateScans(reply)
+	}
+	return scanners, counts, nil
+}
+
+// parseGateScans converts the kind:zone counters of a minute into counts per zone
+func parseGateScans(reply interface{}) map[string]gateScanCounts {
+	fields := evalStrings(reply)
+	gates := make(map[string]gateScanCounts)
+	for i := 0; i+1 < len(fields); i += 2 {
+		kind, zone, ok := strings.Cut(fields[i], ":")
+		if !ok {
+			continue
+		}
+		n, _ := strconv.Atoi(fields[i+1])
+
+		counts := gates[zone]
+		switch kind {
+		case gateScanEntry:
+			counts.Entries += n
+		case gateScanExit:
+			counts.Exits += n
+		case gateScanRejected:
+			counts.Rejected += n
+		default:
+			continue
+		}
+		gates[zone] = counts
+	}
+	return gates
+}
+
+// hashValues returns the values of a flat field/value array reply
+func hashValues(reply interface{}) []string {
+	fields := evalStrings(reply)
+	values := make([]string, 0, len(fields)/2)
+	for i := 1; i < len(fields); i += 2 {
+		values = append(values, fields[i])
+	}
+	return values
+}
+
+// evalStrings converts an array reply of a script; integers are formatted as strings
+func evalStrings(result interface{}) []string {
+	values, _ := result.([]interface{})
+	strs := make([]string, 0, len(values))
+	for _, value := range values {
+		switch v := value.(type) {
+		case string:
+			strs = append(strs, v)
+		case int64:
+			strs = append(strs, strconv.FormatInt(v, 10))
+		}
+	}
+	return strs
+}
+
+func gateScansKey(eventID string, at time.Time) string {
+	return "checkins:" + eventID + ":" + strconv.FormatInt(at.Unix()/60, 10)
+}
+
+func scannersKey(eventID string) string {
+	return "checkins:" + eventID + ":scanners"
+}
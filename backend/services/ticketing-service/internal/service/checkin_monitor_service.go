@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+)
+
+// ErrCheckinMetricsDisabled is returned by the live view and heartbeats when Redis is unavailable
+var ErrCheckinMetricsDisabled = errors.New("live check-in metrics are disabled")
+
+const (
+	// scannerOfflineAfter marks a scanner offline when three 30 second heartbeats were missed
+	scannerOfflineAfter = 90 * time.Second
+	// gateRateMinutes is the number of full minutes the scan rate of a gate is averaged over
+	gateRateMinutes = 5
+)
+
+// CheckinMonitorService reports the entrance throughput of events while check-in is open
+// Gate scans are counted per minute by ValidateTicket, scanners report themselves with heartbeats
+type CheckinMonitorService interface {
+	Heartbeat(ctx context.Context, staffID string, req *request.ScannerHeartbeatRequest) (*response.ScannerStatusResponse, error)
+	GetLive(ctx context.Context, userID, role, eventID string, req *request.CheckinLiveRequest) (*response.CheckinLiveResponse, error)
+}
+
+// checkinMonitorService implements CheckinMonitorService interface
+type checkinMonitorService struct {
+	eventRepo repository.EventRepository
+	zoneRepo  repository.ZoneRepository
+	metrics   *checkinMetrics
+}
+
+// NewCheckinMonitorService creates new check-in monitor service instance
+// Without Redis (redisClient nil) heartbeats and the live view return ErrCheckinMetricsDisabled
+func NewCheckinMonitorService(
+	eventRepo repository.EventRepository,
+	zoneRepo repository.ZoneRepository,
+	redisClient cache.RedisClient,
+) CheckinMonitorService {
+	return &checkinMonitorService{
+		eventRepo: eventRepo,
+		zoneRepo:  zoneRepo,
+		metrics:   newCheckinMetrics(redisClient),
+	}
+}
+
+// Heartbeat records that a scanner is online at a gate of the event
+func (s *checkinMonitorService) Heartbeat(ctx context.Context, staffID string, req *request.ScannerHeartbeatRequest) (*response.ScannerStatusResponse, error) {
+	if s.metrics == nil {
+		return nil, ErrCheckinMetricsDisabled
+	}
+
+	if _, err := s.eventRepo.GetByID(ctx, req.EventID); err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return nil, ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	zone := normalizeZone(req.Zone)
+	if zone != "" {
+		exists, err := s.zoneRepo.ExistsForEvent(ctx, req.EventID, zone)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, ErrZoneNotFound
+		}
+	}
+
+	status := scannerStatus{
+		ScannerID:    req.ScannerID,
+		Zone:         zone,
+		PendingScans: req.PendingScans,
+		StaffID:      staffID,
+		LastSeenAt:   s.metrics.now().UTC(),
+	}
+	if err := s.metrics.Heartbeat(ctx, req.EventID, status); err != nil {
+		return nil, err
+	}
+
+	return &response.ScannerStatusResponse{
+		ScannerID:    status.ScannerID,
+		Zone:         status.Zone,
+		PendingScans: status.PendingScans,
+		LastSeenAt:   status.LastSeenAt,
+		Online:       true,
+	}, nil
+}
+
+// GetLive returns the scans per gate and minute of an event over the last minutes, with its scanners
+// Gates are listed by zone code; a gate appears once it has scans in the window or a scanner
+func (s *checkinMonitorService) GetLive(ctx context.Context, userID, role, eventID string, req *request.CheckinLiveRequest) (*response.CheckinLiveResponse, error) {
+	event, err := managedEvent(ctx, s.eventRepo, userID, role, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if s.metrics == nil {
+		return nil, ErrCheckinMetricsDisabled
+	}
+
+	window := req.Minutes
+	if window <= 0 {
+		window = request.DefaultCheckinLiveMinutes
+	}
+
+	// The rate needs gateRateMinutes full minutes before the running one, even in a shorter window
+	now := s.metrics.now()
+	span := max(window, gateRateMinutes+1)
+	current := now.Truncate(time.Minute)
+	minutes := make([]time.Time, span)
+	for i := range minutes {
+		minutes[i] = current.Add(-time.Duration(span-1-i) * time.Minute)
+	}
+
+	scanners, counts, err := s.metrics.Live(ctx, event.ID, minutes)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &response.CheckinLiveResponse{
+		EventID:     event.ID,
+		Minutes:     window,
+		GeneratedAt: now.UTC(),
+		Gates:       []response.GateThroughputResponse{},
+		Scanners:    []response.ScannerStatusResponse{},
+	}
+
+	gates := make(map[string]*response.GateThroughputResponse)
+	gate := func(zone string) *response.GateThroughputResponse {
+		if g, ok := gates[zone]; ok {
+			return g
+		}
+		g := &response.GateThroughputResponse{Zone: zone, PerMinute: make([]response.MinuteScansResponse, window)}
+		for i := range g.PerMinute {
+			g.PerMinute[i].Minute = minutes[span-window+i].UTC()
+		}
+		gates[zone] = g
+		return g
+	}
+
+	for i, minute := range counts {
+		inWindow := i >= span-window
+		inRate := i >= span-1-gateRateMinutes && i < span-1
+		for zone, c := range minute {
+			if !inWindow && !inRate {
+				continue
+			}
+			g := gate(zone)
+			if inRate {
+				g.ScansPerMinute += float64(c.Entries+c.Exits) / gateRateMinutes
+			}
+			if inWindow {
+				g.Entries += c.Entries
+				g.Exits += c.Exits
+				g.Rejected += c.Rejected
+				g.PerMinute[i-(span-window)] = response.MinuteScansResponse{
+					Minute:   minutes[i].UTC(),
+					Entries:  c.Entries,
+					Exits:    c.Exits,
+					Rejected: c.Rejected,
+				}
+			}
+		}
+	}
+
+	for _, scanner := range scanners {
+		online := now.Sub(scanner.LastSeenAt) <= scannerOfflineAfter
+		g := gate(scanner.Zone)
+		if online {
+			g.ScannersOnline++
+			g.PendingScans += scanner.PendingScans
+		}
+		result.Scanners = append(result.Scanners, response.ScannerStatusResponse{
+			ScannerID:    scanner.ScannerID,
+			Zone:         scanner.Zone,
+			PendingScans: scanner.PendingScans,
+			LastSeenAt:   scanner.LastSeenAt,
+			Online:       online,
+		})
+	}
+	sort.Slice(result.Scanners, func(i, j int) bool {
+		a, b := result.Scanners[i], result.Scanners[j]
+		if a.Zone != b.Zone {
+			return a.Zone < b.Zone
+		}
+		return a.ScannerID < b.ScannerID
+	})
+
+	for _, g := range gates {
+		g.ScansPerMinute = math.Round(g.ScansPerMinute*10) / 10
+		result.Entries += g.Entries
+		result.Exits += g.Exits
+		result.Rejected += g.Rejected
+		result.Gates = append(result.Gates, *g)
+	}
+	sort.Slice(result.Gates, func(i, j int) bool {
+		return result.Gates[i].Zone < result.Gates[j].Zone
+	})
+
+	return result, nil
+}
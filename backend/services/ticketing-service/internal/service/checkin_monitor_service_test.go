@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRedis keeps hashes in memory and implements the check-in metrics scripts
+type fakeRedis struct {
+	cache.RedisClient
+	mu     sync.Mutex
+	hashes map[string]map[string]string
+}
+
+func newFakeRedis() *fakeRedis {
+	return &fakeRedis{hashes: map[string]map[string]string{}}
+}
+
+func (r *fakeRedis) hash(key string) map[string]string {
+	if r.hashes[key] == nil {
+		r.hashes[key] = map[string]string{}
+	}
+	return r.hashes[key]
+}
+
+func (r *fakeRedis) Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch script {
+	case recordGateScanScript:
+		field := fmt.Sprint(args[0])
+		n, _ := strconv.Atoi(r.hash(keys[0])[field])
+		r.hash(keys[0])[field] = strconv.Itoa(n + 1)
+		return int64(1), nil
+	case scannerHeartbeatScript:
+		r.hash(keys[0])[fmt.Sprint(args[0])] = fmt.Sprint(args[1])
+		return int64(1), nil
+	case liveCheckinsScript:
+		replies := make([]interface{}, len(keys))
+		for i, key := range keys {
+			fields := []interface{}{}
+			for field, value := range r.hashes[key] {
+				fields = append(fields, field, value)
+			}
+			replies[i] = fields
+		}
+		return replies, nil
+	}
+	return nil, fmt.Errorf("unexpected script")
+}
+
+func newCheckinMonitorFixture(now time.Time) (*ticketService, *checkinMonitorService, *stubTicketRepo) {
+	redis := newFakeRedis()
+	clock := func() time.Time { return now }
+
+	svc, tickets, _ := newTicketFixture()
+	svc.now = clock
+	svc.checkins = &checkinMetrics{redis: redis, now: clock}
+	svc.zoneRepo = &stubZoneRepo{eventZones: map[string][]string{"event-1": {"VIP"}}}
+
+	monitor := NewCheckinMonitorService(svc.eventRepo, svc.zoneRepo, redis).(*checkinMonitorService)
+	monitor.metrics.now = clock
+	return svc, monitor, tickets
+}
+
+func TestCheckinMonitor_CountsScansPerGate(t *testing.T) {
+	now := time.Date(2026, 11, 2, 18, 0, 30, 0, time.UTC)
+	svc, monitor, tickets := newCheckinMonitorFixture(now)
+	ctx := context.Background()
+
+	tickets.tickets["ticket-2"] = &entity.Ticket{ID: "ticket-2", UserID: "owner", EventID: "event-1", QRData: "TICKET|ticket-2|event-1", Status: entity.TicketStatusValid}
+
+	_, err := svc.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: "TICKET|ticket-1|event-1|nonce-a", Zone: "vip"})
+	require.NoError(t, err)
+	_, err = svc.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: "TICKET|ticket-2|event-1"})
+	require.NoError(t, err)
+	_, err = svc.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: "TICKET|ticket-used|event-1", Zone: "VIP"})
+	assert.ErrorIs(t, err, ErrTicketAlreadyUsed)
+
+	_, err = monitor.Heartbeat(ctx, "staff-1", &request.ScannerHeartbeatRequest{EventID: "event-1", ScannerID: "gate-a-1", Zone: "vip", PendingScans: 3})
+	require.NoError(t, err)
+
+	live, err := monitor.GetLive(ctx, "organizer-1", entity.UserRoleOrganizer, "event-1", &request.CheckinLiveRequest{Minutes: 5})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, live.Entries)
+	assert.Equal(t, 1, live.Rejected)
+	require.Len(t, live.Gates, 2)
+
+	unzoned, vip := live.Gates[0], live.Gates[1]
+	assert.Equal(t, "", unzoned.Zone)
+	assert.Equal(t, 1, unzoned.Entries)
+	assert.Equal(t, 0, unzoned.ScannersOnline)
+
+	assert.Equal(t, "VIP", vip.Zone)
+	assert.Equal(t, 1, vip.Entries)
+	assert.Equal(t, 1, vip.Rejected)
+	assert.Equal(t, 1, vip.ScannersOnline)
+	assert.Equal(t, 3, vip.PendingScans)
+
+	// Scans of the running minute are the last of the series and not yet in the rate
+	require.Len(t, vip.PerMinute, 5)
+	assert.Equal(t, now.Truncate(time.Minute), vip.PerMinute[4].Minute)
+	assert.Equal(t, 1, vip.PerMinute[4].Entries)
+	assert.Zero(t, vip.ScansPerMinute)
+
+	require.Len(t, live.Scanners, 1)
+	assert.True(t, live.Scanners[0].Online)
+}
+
+func TestCheckinMonitor_RateAndOfflineScanners(t *testing.T) {
+	start := time.Date(2026, 11, 2, 18, 0, 0, 0, time.UTC)
+	now := start
+	redis := newFakeRedis()
+	metrics := &checkinMetrics{redis: redis, now: func() time.Time { return now }}
+	monitor := &checkinMonitorService{
+		eventRepo: &stubEventRepo{event: &entity.Event{ID: "event-1", OrganizerID: "organizer-1"}},
+		zoneRepo:  &stubZoneRepo{},
+		metrics:   metrics,
+	}
+	ctx := context.Background()
+
+	_, err := monitor.Heartbeat(ctx, "staff-1", &request.ScannerHeartbeatRequest{EventID: "event-1", ScannerID: "main-1"})
+	require.NoError(t, err)
+
+	// 10 entries a minute for five minutes
+	for minute := 0; minute < 5; minute++ {
+		now = start.Add(time.Duration(minute) * time.Minute)
+		for i := 0; i < 10; i++ {
+			metrics.Record(ctx, "event-1", "", entity.ScanDirectionEntry, true)
+		}
+	}
+
+	now = start.Add(5*time.Minute + 10*time.Second)
+	live, err := monitor.GetLive(ctx, "organizer-1", entity.UserRoleOrganizer, "event-1", &request.CheckinLiveRequest{Minutes: 2})
+	require.NoError(t, err)
+
+	require.Len(t, live.Gates, 1)
+	assert.Equal(t, 10.0, live.Gates[0].ScansPerMinute)
+	assert.Equal(t, 10, live.Entries) // Only the window: the last full minute and the running one
+	assert.Equal(t, 0, live.Gates[0].ScannersOnline)
+
+	require.Len(t, live.Scanners, 1)
+	assert.False(t, live.Scanners[0].Online)
+}
+
+func TestCheckinMonitor_ChecksOwnershipAndRedis(t *testing.T) {
+	_, monitor, _ := newCheckinMonitorFixture(time.Now())
+	ctx := context.Background()
+
+	_, err := monitor.GetLive(ctx, "organizer-2", entity.UserRoleOrganizer, "event-1", &request.CheckinLiveRequest{})
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	_, err = monitor.Heartbeat(ctx, "staff-1", &request.ScannerHeartbeatRequest{EventID: "event-1", ScannerID: "gate-b-1", Zone: "BACKSTAGE"})
+	assert.ErrorIs(t, err, ErrZoneNotFound)
+
+	disabled := NewCheckinMonitorService(monitor.eventRepo, monitor.zoneRepo, nil)
+	_, err = disabled.GetLive(ctx, "organizer-1", entity.UserRoleOrganizer, "event-1", &request.CheckinLiveRequest{})
+	assert.ErrorIs(t, err, ErrCheckinMetricsDisabled)
+	_, err = disabled.Heartbeat(ctx, "staff-1", &request.ScannerHeartbeatRequest{EventID: "event-1", ScannerID: "gate-a-1"})
+	assert.ErrorIs(t, err, ErrCheckinMetricsDisabled)
+}
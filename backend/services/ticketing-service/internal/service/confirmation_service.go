@@ -2,11 +2,19 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/pricing"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/client"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
@@ -17,79 +25,388 @@ import (
 var (
 	ErrOrderNotInReservedStatus = errors.New("order is not in reserved status")
 	ErrAmountMismatch           = errors.New("payment amount mismatch")
+	ErrCurrencyMismatch         = errors.New("payment currency mismatch")
+	ErrPaymentAlreadyApplied    = errors.New("payment was already applied to another order")
+)
+
+// Confirmation outcome constants
+const (
+	ConfirmationConfirmed     = "confirmed"      // Order paid and tickets generated
+	ConfirmationAlreadyPaid   = "already_paid"   // Retry for a payment that already confirmed the order
+	ConfirmationRefundFlagged = "refund_flagged" // Payment cannot be applied to the order, flagged for manual refund
 )
 
 // ConfirmationService handles order confirmation after payment
 type ConfirmationService interface {
-	ConfirmPayment(ctx context.Context, req *request.ConfirmOrderRequest) error
+	// ConfirmPayment is idempotent per payment ID: retries get the result of the first confirmation
+	// with one of the Confirmation* outcomes
+	ConfirmPayment(ctx context.Context, req *request.ConfirmOrderRequest) (*response.ConfirmPaymentResponse, error)
+	MarkPaid(ctx context.Context, adminID, orderID string, req *request.MarkPaidRequest) (*response.MarkPaidResponse, error)
+	SendReissuedTickets(ctx context.Context, orderID string) error
+	RetryTicketEmail(ctx context.Context, message *entity.NotificationOutbox) error
+}
+
+// Payments confirmed by finance are keyed by their bank transfer reference
+const (
+	manualPaymentPrefix = "manual:"
+	manualPaymentMethod = "manual_transfer"
+)
+
+// NotificationClient defines interface for notification service communication
+type NotificationClient interface {
+	SendTicketEmail(ctx context.Context, req *client.SendTicketEmailRequest) error
 }
 
 // confirmationService implements ConfirmationService interface
 type confirmationService struct {
 	orderRepo          repository.OrderRepository
 	orderItemRepo      repository.OrderItemRepository
+	ticketRepo         repository.TicketRepository
+	refundRepo         repository.OrderRefundRepository
+	auditRepo          repository.OrderAuditRepository
 	ticketTierRepo     repository.TicketTierRepository
+	accommodationRepo  repository.AccommodationRepository
+	policyDocumentRepo repository.PolicyDocumentRepository
 	eventRepo          repository.EventRepository
 	userRepo           repository.UserRepository
+	tenantRepo         repository.TenantRepository
 	ticketService      TicketService
-	notificationClient *client.NotificationClient
+	notificationClient NotificationClient
+	outboxRepo         repository.NotificationOutboxRepository
+	paymentClient      PaymentClient // Told when the ticket email went out, the last step of the payment saga
+	availability       AvailabilityService
+	txWatchdog         *repository.TxWatchdog
+	currency           string        // Expected payment currency
+	amountTolerance    money.Money   // Max accepted difference between paid amount and grand total
+	expiryGracePeriod  time.Duration // Payments for orders expired this recently are still applied
 }
 
 // NewConfirmationService creates new confirmation service instance
 func NewConfirmationService(
 	orderRepo repository.OrderRepository,
 	orderItemRepo repository.OrderItemRepository,
+	ticketRepo repository.TicketRepository,
+	refundRepo repository.OrderRefundRepository,
+	auditRepo repository.OrderAuditRepository,
 	ticketTierRepo repository.TicketTierRepository,
+	accommodationRepo repository.AccommodationRepository,
+	policyDocumentRepo repository.PolicyDocumentRepository,
 	eventRepo repository.EventRepository,
 	userRepo repository.UserRepository,
+	tenantRepo repository.TenantRepository,
 	ticketService TicketService,
-	notificationClient *client.NotificationClient,
+	notificationClient NotificationClient,
+	outboxRepo repository.NotificationOutboxRepository,
+	paymentClient PaymentClient,
+	availability AvailabilityService,
+	txWatchdog *repository.TxWatchdog,
+	currency string,
+	amountTolerance money.Money,
+	expiryGracePeriod time.Duration,
 ) ConfirmationService {
 	return &confirmationService{
 		orderRepo:          orderRepo,
 		orderItemRepo:      orderItemRepo,
+		ticketRepo:         ticketRepo,
+		refundRepo:         refundRepo,
+		auditRepo:          auditRepo,
 		ticketTierRepo:     ticketTierRepo,
+		accommodationRepo:  accommodationRepo,
+		policyDocumentRepo: policyDocumentRepo,
 		eventRepo:          eventRepo,
 		userRepo:           userRepo,
+		tenantRepo:         tenantRepo,
 		ticketService:      ticketService,
 		notificationClient: notificationClient,
+		outboxRepo:         outboxRepo,
+		paymentClient:      paymentClient,
+		availability:       availability,
+		txWatchdog:         txWatchdog,
+		currency:           currency,
+		amountTolerance:    amountTolerance,
+		expiryGracePeriod:  expiryGracePeriod,
 	}
 }
 
 // ConfirmPayment confirms payment and generates tickets
 // This is called by Payment Service after successful payment
-func (s *confirmationService) ConfirmPayment(ctx context.Context, req *request.ConfirmOrderRequest) error {
+// Retries of a payment (provider webhook and manual retry) return the result of its first confirmation,
+// a payment already applied to another order is rejected. Payments arriving after the order expired
+// or was cancelled, or a second payment for a paid order, are flagged for manual refund.
+// Payments racing the cleanup worker are still applied within the expiry grace period, see applyPayment
+func (s *confirmationService) ConfirmPayment(ctx context.Context, req *request.ConfirmOrderRequest) (*response.ConfirmPaymentResponse, error) {
+	// Answer retries without locking the order
+	if result, err := s.previousResult(ctx, req); err != nil || result != nil {
+		return result, err
+	}
+
+	// Row locks are held until commit, the watchdog rolls back a stalled transaction
+	txCtx, txDone := s.txWatchdog.Watch(ctx, "confirm_payment")
+	defer txDone()
+
 	// Start transaction
-	tx, err := s.orderRepo.BeginTx(ctx)
+	tx, err := s.orderRepo.BeginTx(txCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	// No-op after commit; releases the order lock on every early return
+	defer tx.Rollback()
+
+	// Get order with lock
+	order, err := s.orderRepo.GetByIDWithLock(txCtx, tx, req.OrderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	// Anything but a confirmation leaves the transaction to be rolled back
+	reinstated := order.Status == entity.OrderStatusExpired
+	outcome, reason, err := s.applyPayment(txCtx, tx, order, req)
+	if err != nil {
+		return nil, err
+	}
+	switch outcome {
+	case ConfirmationAlreadyPaid:
+		// A concurrent retry confirmed the order while this one waited for the lock
+		tx.Rollback()
+		txDone()
+		return s.paidResult(ctx, order)
+	case ConfirmationRefundFlagged:
+		result := confirmationResult(outcome, order, req.PaymentID, nil)
+		result.RefundReason = reason
+		return result, nil
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	txDone()
+
+	// Reinstating an expired order took its tickets back, listing availability summary needs refresh
+	if reinstated {
+		s.availability.MarkStale()
+	}
+
+	// Generate e-tickets (outside transaction for better performance)
+	tickets, err := s.issueTickets(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	return confirmationResult(ConfirmationConfirmed, order, req.PaymentID, tickets), nil
+}
+
+// MarkPaid confirms a bank transfer finance verified but that never produced a webhook
+// The normal confirmation flow runs with the transfer reference as payment ID, so marking the same
+// transfer again returns the first result, and the buyer gets the e-ticket email as for any payment.
+// Every call is recorded in the order's audit entries
+func (s *confirmationService) MarkPaid(ctx context.Context, adminID, orderID string, req *request.MarkPaidRequest) (*response.MarkPaidResponse, error) {
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, repository.ErrOrderNotFound) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	// Orders of other tenants are not visible to this tenant's support
+	if order.TenantID != tenantFromContext(ctx) {
+		return nil, ErrOrderNotFound
+	}
+
+	// Confirming would flag the transfer as a duplicate payment for refund
+	paymentID := manualPaymentPrefix + req.ReferenceNumber
+	if order.IsPaid() && (order.PaymentID == nil || *order.PaymentID != paymentID) {
+		return nil, ErrOrderAlreadyPaid
+	}
+
+	result, err := s.ConfirmPayment(ctx, &request.ConfirmOrderRequest{
+		OrderID:       order.ID,
+		PaymentID:     paymentID,
+		PaymentMethod: manualPaymentMethod,
+		Amount:        order.GrandTotal,
+		Currency:      s.currency,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &entity.OrderAuditEntry{
+		OrderID:   order.ID,
+		TenantID:  order.TenantID,
+		Action:    entity.AuditActionMarkPaid,
+		ActorID:   adminID,
+		Reference: &req.ReferenceNumber,
+		Reason:    req.Reason,
+		Outcome:   &result.Outcome,
+	}
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[ConfirmationService] Order %s marked paid by %s with transfer %s: %s", order.ID, adminID, req.ReferenceNumber, result.Outcome)
+
+	return &response.MarkPaidResponse{
+		ConfirmPaymentResponse: *result,
+		AuditEntry:             response.ToOrderAuditEntryResponse(entry),
+	}, nil
+}
+
+// previousResult returns the result of an earlier confirmation of the payment, nil if there was none
+// Payments are keyed by ID: the order they confirmed, or the refund request they were flagged with
+func (s *confirmationService) previousResult(ctx context.Context, req *request.ConfirmOrderRequest) (*response.ConfirmPaymentResponse, error) {
+	order, err := s.orderRepo.GetByPaymentID(ctx, req.PaymentID)
+	if err == nil {
+		if order.ID != req.OrderID {
+			return nil, fmt.Errorf("%w: order %s", ErrPaymentAlreadyApplied, order.ID)
+		}
+		if !order.IsPaid() {
+			// Paid, then changed by support; left to the checks under the order lock
+			return nil, nil
+		}
+		log.Printf("[ConfirmationService] Order %s already confirmed by payment %s", order.ID, req.PaymentID)
+		return s.paidResult(ctx, order)
+	}
+	if !errors.Is(err, repository.ErrOrderNotFound) {
+		return nil, fmt.Errorf("failed to get order by payment: %w", err)
+	}
+
+	refund, err := s.refundRepo.GetByPaymentID(ctx, req.PaymentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRefundRequestNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get refund request: %w", err)
+	}
+	if refund.OrderID != req.OrderID {
+		return nil, fmt.Errorf("%w: order %s", ErrPaymentAlreadyApplied, refund.OrderID)
+	}
+
+	return &response.ConfirmPaymentResponse{
+		Outcome:      ConfirmationRefundFlagged,
+		OrderID:      refund.OrderID,
+		PaymentID:    refund.PaymentID,
+		RefundReason: refund.Reason,
+	}, nil
+}
+
+// paidResult is the result for a retry of the payment that confirmed the order
+// Tickets that failed to generate after the first confirmation are generated now
+func (s *confirmationService) paidResult(ctx context.Context, order *entity.Order) (*response.ConfirmPaymentResponse, error) {
+	tickets, err := s.ticketRepo.GetByOrderID(ctx, order.ID)
 	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, fmt.Errorf("failed to get order tickets: %w", err)
 	}
 
-	defer func() {
+	if len(tickets) == 0 {
+		log.Printf("[ConfirmationService] Order %s was confirmed without tickets, generating them", order.ID)
+		generated, err := s.issueTickets(ctx, order)
 		if err != nil {
-			tx.Rollback()
+			return nil, err
 		}
-	}()
+		return confirmationResult(ConfirmationAlreadyPaid, order, *order.PaymentID, generated), nil
+	}
 
-	// Get order with lock
-	order, err := s.orderRepo.GetByIDWithLock(ctx, tx, req.OrderID)
+	result := confirmationResult(ConfirmationAlreadyPaid, order, *order.PaymentID, nil)
+	for _, ticket := range tickets {
+		result.TicketIDs = append(result.TicketIDs, ticket.ID)
+	}
+	return result, nil
+}
+
+// issueTickets generates the tickets of a paid order and emails them to the buyer
+func (s *confirmationService) issueTickets(ctx context.Context, order *entity.Order) ([]response.TicketResponse, error) {
+	tickets, err := s.ticketService.GenerateTickets(ctx, order.ID)
 	if err != nil {
-		return fmt.Errorf("failed to get order: %w", err)
+		// The order stays paid; tickets are generated on the payment's retry or by the consistency check
+		return nil, fmt.Errorf("warning: failed to generate tickets: %w", err)
+	}
+
+	log.Printf("[ConfirmationService] Generated %d tickets for order %s", len(tickets), order.ID)
+
+	// Send e-ticket email via notification service (async with auto-reconnect)
+	emailCtx, cancel := timeout.Detach(ctx)
+	errreport.Go("send_ticket_email", func() {
+		defer cancel()
+		s.sendTicketEmail(emailCtx, order, tickets)
+	})
+
+	return tickets, nil
+}
+
+// confirmationResult builds the result of a payment confirmation
+func confirmationResult(outcome string, order *entity.Order, paymentID string, tickets []response.TicketResponse) *response.ConfirmPaymentResponse {
+	result := &response.ConfirmPaymentResponse{
+		Outcome:   outcome,
+		OrderID:   order.ID,
+		PaymentID: paymentID,
+	}
+	for _, ticket := range tickets {
+		result.TicketIDs = append(result.TicketIDs, ticket.ID)
+	}
+	return result
+}
+
+// applyPayment verifies the payment against the locked order and marks the order paid within tx
+// An order expired less than the grace period ago (the payment landed as the cleanup worker ran) is
+// reinstated when its invoice was still pending and its tickets can be reserved again; when they sold
+// out meanwhile the payment is flagged for a refund payment service makes, otherwise for a manual one
+// refundReason is set for ConfirmationRefundFlagged
+func (s *confirmationService) applyPayment(ctx context.Context, tx *sql.Tx, order *entity.Order, req *request.ConfirmOrderRequest) (outcome, refundReason string, err error) {
+	// Payments that cannot be applied leave the order untouched
+	if order.IsPaid() && order.PaymentID != nil && *order.PaymentID == req.PaymentID {
+		log.Printf("[ConfirmationService] Order %s already confirmed by payment %s", order.ID, req.PaymentID)
+		return ConfirmationAlreadyPaid, "", nil
 	}
 
-	// Verify order is in reserved status
-	if order.Status != entity.OrderStatusReserved {
-		return ErrOrderNotInReservedStatus
+	reinstate := order.Status == entity.OrderStatusExpired
+	if reason := orderRefundReason(order); reason != "" && !(reason == entity.RefundReasonOrderExpired && s.withinExpiryGrace(order, req)) {
+		if err := s.flagForRefund(ctx, order, req, reason); err != nil {
+			return "", "", err
+		}
+		return ConfirmationRefundFlagged, reason, nil
 	}
 
-	// Verify order hasn't expired
-	if order.IsExpired() {
-		return ErrOrderExpired
+	// Verify order is in reserved status (or was expired by the cleanup worker within the grace period)
+	if order.Status != entity.OrderStatusReserved && !reinstate {
+		return "", "", ErrOrderNotInReservedStatus
 	}
 
-	// Verify amount matches
-	if req.Amount != order.GrandTotal {
-		return fmt.Errorf("%w: expected %.2f, got %.2f", ErrAmountMismatch, order.GrandTotal, req.Amount)
+	// Verify paid currency (legacy callers without currency are assumed to pay in platform currency)
+	currency := strings.ToUpper(req.Currency)
+	if currency == "" {
+		log.Printf("[ConfirmationService] No currency reported for order %s, assuming %s", req.OrderID, s.currency)
+		currency = s.currency
+	}
+	if currency != s.currency {
+		return "", "", fmt.Errorf("%w: expected %s, got %s", ErrCurrencyMismatch, s.currency, currency)
+	}
+
+	// Verify amount matches, accepting provider rounding within tolerance
+	discrepancy, ok := pricing.Reconcile(order.GrandTotal, req.Amount, s.amountTolerance)
+	if !ok {
+		return "", "", fmt.Errorf("%w: expected %s, got %s", ErrAmountMismatch, order.GrandTotal, req.Amount)
+	}
+	if !discrepancy.IsZero() {
+		log.Printf("[ConfirmationService] Accepting order %s with amount discrepancy %s (expected %s, got %s)",
+			req.OrderID, discrepancy, order.GrandTotal, req.Amount)
+		order.AmountDiscrepancy = &discrepancy
+	}
+
+	// The cleanup worker released the inventory; take it back or refund when it was sold meanwhile
+	if reinstate {
+		if err := s.reserveAgain(ctx, tx, order.ID); err != nil {
+			if !errors.Is(err, repository.ErrInsufficientQuota) && !errors.Is(err, repository.ErrInsufficientAccessibleQuota) {
+				return "", "", err
+			}
+			log.Printf("[ConfirmationService] Tickets of expired order %s sold out during grace period", order.ID)
+			if err := s.flagForRefund(ctx, order, req, entity.RefundReasonSoldOut); err != nil {
+				return "", "", err
+			}
+			return ConfirmationRefundFlagged, entity.RefundReasonSoldOut, nil
+		}
+		log.Printf("[ConfirmationService] Reinstated order %s expired at %s for late payment %s",
+			order.ID, order.ReservationExpiresAt.Format(time.RFC3339), req.PaymentID)
 	}
 
 	// Update order status to paid
@@ -100,40 +417,265 @@ func (s *confirmationService) ConfirmPayment(ctx context.Context, req *request.C
 	order.Status = entity.OrderStatusPaid
 	order.PaymentID = &paymentID
 	order.PaymentMethod = &paymentMethod
+	order.PaidAmount = &req.Amount
+	order.PaidCurrency = &currency
 	order.CompletedAt = &completedAt
 
 	if err := s.orderRepo.UpdateWithTx(ctx, tx, order); err != nil {
-		return fmt.Errorf("failed to update order: %w", err)
+		return "", "", fmt.Errorf("failed to update order: %w", err)
 	}
 
-	// Commit transaction
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+	return ConfirmationConfirmed, "", nil
+}
+
+// withinExpiryGrace checks if an expired order's reservation ended less than the grace period ago
+// and the payment was made while its invoice was still pending
+func (s *confirmationService) withinExpiryGrace(order *entity.Order, req *request.ConfirmOrderRequest) bool {
+	if !req.InvoicePending || order.ReservationExpiresAt == nil {
+		return false
 	}
+	return time.Since(*order.ReservationExpiresAt) <= s.expiryGracePeriod
+}
 
-	// Generate e-tickets (outside transaction for better performance)
-	tickets, err := s.ticketService.GenerateTickets(ctx, req.OrderID)
+// reserveAgain takes back the quantities an expired order released, all or nothing within tx
+// Tiers are updated in ID order so concurrent reservations can't deadlock on them; accessible
+// seats are taken from the tier's accessible seating as on the original reservation
+func (s *confirmationService) reserveAgain(ctx context.Context, tx *sql.Tx, orderID string) error {
+	items, err := s.orderItemRepo.GetByOrderID(ctx, orderID)
 	if err != nil {
-		// Log error but don't fail - tickets can be regenerated later
-		// TODO: Add to retry queue
-		return fmt.Errorf("warning: failed to generate tickets: %w", err)
+		return fmt.Errorf("failed to get order items: %w", err)
 	}
 
-	log.Printf("[ConfirmationService] Generated %d tickets for order %s", len(tickets), req.OrderID)
+	accommodations, err := s.accommodationRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return fmt.Errorf("failed to get order accommodations: %w", err)
+	}
+	seats := accessibleSeatsByTier(accommodations)
 
-	// Send e-ticket email via notification service (async with auto-reconnect)
-	go s.sendTicketEmail(context.Background(), order, tickets)
+	quantities := make(map[string]int)
+	tierIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		if _, ok := quantities[item.TicketTierID]; !ok {
+			tierIDs = append(tierIDs, item.TicketTierID)
+		}
+		quantities[item.TicketTierID] += item.Quantity
+	}
+	sort.Strings(tierIDs)
+
+	for _, tierID := range tierIDs {
+		general, accessible := takeAccessibleSeats(seats, tierID, quantities[tierID])
+		if general > 0 {
+			if err := s.ticketTierRepo.UpdateSoldCount(ctx, tx, tierID, general, entity.OrderInventoryCause(entity.InventoryReasonConfirm, orderID)); err != nil {
+				if errors.Is(err, repository.ErrInsufficientQuota) {
+					return err
+				}
+				return fmt.Errorf("failed to reserve ticket tier %s: %w", tierID, err)
+			}
+		}
+		if accessible > 0 {
+			if err := s.ticketTierRepo.UpdateAccessibleSoldCount(ctx, tx, tierID, accessible, entity.OrderInventoryCause(entity.InventoryReasonConfirm, orderID)); err != nil {
+				if errors.Is(err, repository.ErrInsufficientAccessibleQuota) {
+					return err
+				}
+				return fmt.Errorf("failed to reserve accessible seats of ticket tier %s: %w", tierID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// orderRefundReason returns why a payment cannot be applied to the order, empty if it can
+// Called after retries of the confirming payment are ruled out
+func orderRefundReason(order *entity.Order) string {
+	switch {
+	case order.IsPaid():
+		return entity.RefundReasonDuplicatePayment
+	case order.Status == entity.OrderStatusExpired || order.IsExpired():
+		return entity.RefundReasonOrderExpired
+	case order.Status == entity.OrderStatusCancelled:
+		return entity.RefundReasonOrderCancelled
+	default:
+		return ""
+	}
+}
+
+// flagForRefund records a payment that must be refunded manually; retries flag it once
+// Provider payments for cancelled orders are refunded by payment service as soon as it learns the reason,
+// they are recorded refunded so support doesn't pay them back a second time. Payments for tickets that
+// sold out during the grace period are refunded by payment service too, which records them refunded
+// once the refund went through (RecordPaymentRefund)
+func (s *confirmationService) flagForRefund(ctx context.Context, order *entity.Order, req *request.ConfirmOrderRequest, reason string) error {
+	currency := strings.ToUpper(req.Currency)
+	if currency == "" {
+		currency = s.currency
+	}
+
+	refund := &entity.OrderRefundRequest{
+		OrderID:   order.ID,
+		TenantID:  order.TenantID,
+		PaymentID: req.PaymentID,
+		Amount:    req.Amount,
+		Currency:  currency,
+		Reason:    reason,
+	}
+	if req.PaymentMethod != "" {
+		refund.PaymentMethod = &req.PaymentMethod
+	}
+	autoRefunded := reason == entity.RefundReasonOrderCancelled && !strings.HasPrefix(req.PaymentID, manualPaymentPrefix)
+	if autoRefunded {
+		now := time.Now()
+		refund.Status = entity.RefundStatusRefunded
+		refund.RefundedAt = &now
+	}
+
+	created, err := s.refundRepo.Create(ctx, refund)
+	if err != nil {
+		return fmt.Errorf("failed to flag payment for refund: %w", err)
+	}
+
+	if created && autoRefunded {
+		log.Printf("[ConfirmationService] Payment %s for cancelled order %s left to payment service to refund: %s %s",
+			req.PaymentID, order.ID, req.Amount, currency)
+	} else if created && reason == entity.RefundReasonSoldOut {
+		log.Printf("[ConfirmationService] Payment %s for sold out order %s left to payment service to refund: %s %s",
+			req.PaymentID, order.ID, req.Amount, currency)
+	} else if created {
+		log.Printf("[ConfirmationService] Payment %s for order %s (%s) flagged for manual refund: %s %s",
+			req.PaymentID, order.ID, order.Status, req.Amount, currency)
+	}
 
 	return nil
 }
 
 // sendTicketEmail sends e-ticket email asynchronously
+// Emails that fail are queued in the notification outbox and retried by its worker
 func (s *confirmationService) sendTicketEmail(ctx context.Context, order *entity.Order, tickets []response.TicketResponse) {
+	emailReq, err := s.ticketEmailRequest(ctx, order, tickets)
+	if err != nil {
+		log.Printf("[ConfirmationService] Failed to prepare ticket email for order %s: %v", order.ID, err)
+		s.queueTicketEmail(ctx, order.ID, err)
+		return
+	}
+
+	log.Printf("[ConfirmationService] 📧 Sending email to: %s (%s) for event: %s at %s", emailReq.RecipientEmail, emailReq.RecipientName, emailReq.EventName, emailReq.EventLocation)
+
+	if err := s.notificationClient.SendTicketEmail(ctx, emailReq); err != nil {
+		log.Printf("[ConfirmationService] Failed to send ticket email for order %s: %v", order.ID, err)
+		s.queueTicketEmail(ctx, order.ID, err)
+	} else {
+		log.Printf("[ConfirmationService] ✅ Ticket email sent for order %s", order.ID)
+		s.recordTicketEmail(ctx, order)
+	}
+}
+
+// recordTicketEmail tells payment service the ticket email of a paid order was sent, completing its payment saga
+// Best effort: the buyer has their tickets either way, a failed report leaves the saga at tickets_generated
+func (s *confirmationService) recordTicketEmail(ctx context.Context, order *entity.Order) {
+	if s.paymentClient == nil || order.PaymentID == nil {
+		return
+	}
+
+	if _, err := s.paymentClient.RecordTicketEmail(ctx, order.ID, *order.PaymentID); err != nil {
+		log.Printf("[WARNING] Failed to record ticket email of order %s in its payment saga: %v", order.ID, err)
+	}
+}
+
+// queueTicketEmail queues the ticket email of an order for retry after its first send failed
+func (s *confirmationService) queueTicketEmail(ctx context.Context, orderID string, sendErr error) {
+	if s.outboxRepo == nil {
+		log.Printf("[WARNING] Notification outbox not available, ticket email for order %s is not retried", orderID)
+		return
+	}
+
+	lastError := sendErr.Error()
+	message := &entity.NotificationOutbox{
+		Type:          entity.NotificationTypeTicketEmail,
+		OrderID:       orderID,
+		Attempts:      1,
+		NextAttemptAt: time.Now().Add(outboxRetryBackoff(1)),
+		LastError:     &lastError,
+	}
+	if err := s.outboxRepo.Enqueue(ctx, message); err != nil {
+		log.Printf("[ERROR] Failed to queue ticket email for order %s, buyer did not get their tickets: %v", orderID, err)
+		return
+	}
+	log.Printf("[ConfirmationService] Ticket email for order %s queued for retry", orderID)
+}
+
+// RetryTicketEmail sends the ticket email of an order queued in the notification outbox
+// Only tickets still valid are sent; an order left without any (refunded since) has nothing to send
+func (s *confirmationService) RetryTicketEmail(ctx context.Context, message *entity.NotificationOutbox) error {
+	order, tickets, err := s.validTickets(ctx, message.OrderID)
+	if err != nil {
+		return err
+	}
+	if len(tickets) == 0 {
+		return nil
+	}
+
+	emailReq, err := s.ticketEmailRequest(ctx, order, tickets)
+	if err != nil {
+		return err
+	}
+
+	if err := s.notificationClient.SendTicketEmail(ctx, emailReq); err != nil {
+		return fmt.Errorf("failed to send ticket email: %w", err)
+	}
+	s.recordTicketEmail(ctx, order)
+	return nil
+}
+
+// SendReissuedTickets emails the valid tickets of an order again after their QR codes were rotated
+// Unlike the purchase email, failures are returned so the reissue job can retry them
+func (s *confirmationService) SendReissuedTickets(ctx context.Context, orderID string) error {
+	order, valid, err := s.validTickets(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	if len(valid) == 0 {
+		return nil
+	}
+
+	emailReq, err := s.ticketEmailRequest(ctx, order, valid)
+	if err != nil {
+		return err
+	}
+	emailReq.IsReissue = true
+
+	if err := s.notificationClient.SendTicketEmail(ctx, emailReq); err != nil {
+		return fmt.Errorf("failed to send reissued tickets: %w", err)
+	}
+	return nil
+}
+
+// validTickets retrieves an order with its tickets that can still be used
+func (s *confirmationService) validTickets(ctx context.Context, orderID string) (*entity.Order, []response.TicketResponse, error) {
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	tickets, err := s.ticketRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get tickets: %w", err)
+	}
+	valid := []response.TicketResponse{}
+	for i := range tickets {
+		if tickets[i].CanBeUsed() {
+			valid = append(valid, *response.ToTicketResponse(&tickets[i]))
+		}
+	}
+	return order, valid, nil
+}
+
+// ticketEmailRequest builds the e-ticket email of an order
+// Missing event and user details fall back to placeholders rather than failing the email
+func (s *confirmationService) ticketEmailRequest(ctx context.Context, order *entity.Order, tickets []response.TicketResponse) (*client.SendTicketEmailRequest, error) {
 	// Get order items
 	orderItems, err := s.orderItemRepo.GetByOrderID(ctx, order.ID)
 	if err != nil {
-		log.Printf("[ConfirmationService] Failed to get order items for email: %v", err)
-		return
+		return nil, fmt.Errorf("failed to get order items: %w", err)
 	}
 
 	// Get event details
@@ -155,7 +697,7 @@ func (s *confirmationService) sendTicketEmail(ctx context.Context, order *entity
 	eventStartTime := event.StartDate.Format("Monday, 02 Jan 2006 15:04 WIB")
 
 	// Create maps for tier prices and names from order items
-	tierPrices := make(map[string]float64)
+	tierPrices := make(map[string]money.Money)
 	tierNames := make(map[string]string)
 
 	for _, item := range orderItems {
@@ -215,25 +757,127 @@ func (s *confirmationService) sendTicketEmail(ctx context.Context, order *entity
 		paymentMethod = *order.PaymentMethod
 	}
 
+	// The receipt states the policy versions accepted with the order
+	acceptances, err := s.policyDocumentRepo.GetAcceptancesByOrderID(ctx, order.ID)
+	if err != nil {
+		log.Printf("[ConfirmationService] Warning: Failed to get accepted policies for order %s: %v", order.ID, err)
+	}
+	acceptedPolicies := make([]client.AcceptedPolicy, len(acceptances))
+	var refundPolicy *entity.PolicyDocument
+	for i, acceptance := range acceptances {
+		acceptedPolicies[i] = client.AcceptedPolicy{
+			Title:       acceptance.Title,
+			VersionHash: acceptance.VersionHash,
+			AcceptedAt:  acceptance.AcceptedAt,
+		}
+
+		// The refund policy text is rendered in full, as accepted
+		if acceptance.Type == entity.PolicyTypeRefund {
+			refundPolicy, err = s.policyDocumentRepo.GetByID(ctx, acceptance.PolicyDocumentID)
+			if err != nil {
+				log.Printf("[ConfirmationService] Warning: Failed to get refund policy of order %s: %v", order.ID, err)
+			}
+		}
+	}
+
 	// Send email request
 	emailReq := &client.SendTicketEmailRequest{
-		OrderID:        order.ID,
-		RecipientEmail: recipientEmail,
-		RecipientName:  recipientName,
-		EventName:      eventName,
-		EventLocation:  eventLocation,
-		EventStartTime: eventStartTime,
-		TotalAmount:    order.GrandTotal,
-		PaymentMethod:  paymentMethod,
-		Tickets:        ticketInfos,
+		OrderID:          order.ID,
+		RecipientEmail:   recipientEmail,
+		RecipientName:    recipientName,
+		EventName:        eventName,
+		EventLocation:    eventLocation,
+		EventStartTime:   eventStartTime,
+		TotalAmount:      order.GrandTotal,
+		PaymentMethod:    paymentMethod,
+		Tickets:          ticketInfos,
+		Branding:         s.emailBranding(ctx, order.TenantID, event.OrganizerID),
+		TicketTemplate:   s.ticketTemplate(ctx, event.OrganizerID),
+		AcceptedPolicies: acceptedPolicies,
+		IsSandbox:        order.IsSandbox,
+	}
+	if refundPolicy != nil {
+		emailReq.RefundPolicyTitle = refundPolicy.Title
+		emailReq.RefundPolicy = refundPolicy.Content
 	}
 
-	log.Printf("[ConfirmationService] 📧 Sending email to: %s (%s) for event: %s at %s", recipientEmail, recipientName, eventName, eventLocation)
+	return emailReq, nil
+}
 
-	if err := s.notificationClient.SendTicketEmail(ctx, emailReq); err != nil {
-		log.Printf("[ConfirmationService] Failed to send ticket email for order %s: %v", order.ID, err)
-		// TODO: Add to retry queue
-	} else {
-		log.Printf("[ConfirmationService] ✅ Ticket email sent for order %s", order.ID)
+// emailBranding returns the white-label branding of the order's tenant and the event organizer's sender
+// Without one the notification service uses the platform defaults
+func (s *confirmationService) emailBranding(ctx context.Context, tenantID, organizerID string) *client.EmailBranding {
+	return tenantEmailBranding(ctx, s.tenantRepo, tenantID, organizerID)
+}
+
+// ticketTemplate returns the event organizer's e-ticket layout, nil for the platform layout
+func (s *confirmationService) ticketTemplate(ctx context.Context, organizerID string) *client.TicketTemplate {
+	if organizerID == "" {
+		return nil
+	}
+
+	tpl, err := s.tenantRepo.GetTicketTemplate(ctx, organizerID)
+	if err != nil {
+		if !errors.Is(err, repository.ErrTicketTemplateNotFound) {
+			log.Printf("[ConfirmationService] Warning: Failed to get ticket template of organizer %s: %v", organizerID, err)
+		}
+		return nil
+	}
+
+	return &client.TicketTemplate{
+		LogoURL:       stringValue(tpl.LogoURL),
+		PrimaryColor:  stringValue(tpl.PrimaryColor),
+		AccentColor:   stringValue(tpl.AccentColor),
+		SponsorFooter: stringValue(tpl.SponsorFooter),
+		TermsText:     stringValue(tpl.TermsText),
+	}
+}
+
+// tenantEmailBranding returns the white-label email branding of a tenant, nil for the platform defaults
+// The event organizer's own sender replaces the tenant's while its domain is verified; an unverified
+// one is ignored, so emails keep being sent by the tenant or platform sender
+func tenantEmailBranding(ctx context.Context, tenantRepo repository.TenantRepository, tenantID, organizerID string) *client.EmailBranding {
+	var branding *client.EmailBranding
+	if tenantID != "" && tenantID != tenant.DefaultID {
+		found, err := tenantRepo.GetByID(ctx, tenantID)
+		if err != nil {
+			log.Printf("[EmailBranding] Failed to get tenant %s for email branding: %v", tenantID, err)
+		} else {
+			branding = &client.EmailBranding{
+				BrandName:    found.Name,
+				FromName:     stringValue(found.EmailFromName),
+				FromEmail:    stringValue(found.EmailFromAddress),
+				LogoURL:      stringValue(found.LogoURL),
+				PrimaryColor: stringValue(found.PrimaryColor),
+				SupportEmail: stringValue(found.SupportEmail),
+			}
+		}
+	}
+
+	if organizerID == "" {
+		return branding
+	}
+	sender, err := tenantRepo.GetVerifiedSender(ctx, organizerID)
+	if err != nil {
+		if !errors.Is(err, repository.ErrEmailSenderNotFound) {
+			log.Printf("[EmailBranding] Failed to get email sender of organizer %s: %v", organizerID, err)
+		}
+		return branding
+	}
+
+	if branding == nil {
+		branding = &client.EmailBranding{}
+	}
+	branding.FromName = sender.FromName
+	branding.FromEmail = sender.FromEmail
+	branding.ReplyTo = stringValue(sender.ReplyTo)
+	return branding
+}
+
+// stringValue returns the pointed-to string, or "" for nil
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
 	}
+	return *s
 }
@@ -0,0 +1,705 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Stub repositories only implement the lookups used by sendTicketEmail
+
+type stubOrderItemRepo struct {
+	repository.OrderItemRepository
+	items []entity.OrderItem
+}
+
+func (r *stubOrderItemRepo) GetByOrderID(ctx context.Context, orderID string) ([]entity.OrderItem, error) {
+	return r.items, nil
+}
+
+type stubEventRepo struct {
+	repository.EventRepository
+	event *entity.Event
+}
+
+func (r *stubEventRepo) GetByID(ctx context.Context, id string) (*entity.Event, error) {
+	if r.event == nil {
+		return nil, errors.New("event not found")
+	}
+	return r.event, nil
+}
+
+type stubTicketTierRepo struct {
+	repository.TicketTierRepository
+	tiers  map[string]*entity.TicketTier
+	causes []entity.InventoryCause // Of sold_count changes
+}
+
+func (r *stubTicketTierRepo) GetByID(ctx context.Context, id string) (*entity.TicketTier, error) {
+	tier, ok := r.tiers[id]
+	if !ok {
+		return nil, errors.New("tier not found")
+	}
+	return tier, nil
+}
+
+type stubUserRepo struct {
+	repository.UserRepository
+	user *entity.User
+}
+
+func (r *stubUserRepo) GetByID(ctx context.Context, id string) (*entity.User, error) {
+	if r.user == nil {
+		return nil, errors.New("user not found")
+	}
+	return r.user, nil
+}
+
+type stubTenantRepo struct {
+	tenant *entity.Tenant
+	sender *entity.OrganizerEmailSender // Verified sender of its organizer
+	ticket *entity.OrganizerTicketTemplate
+}
+
+func (r *stubTenantRepo) GetByID(ctx context.Context, id string) (*entity.Tenant, error) {
+	if r.tenant == nil || r.tenant.ID != id {
+		return nil, repository.ErrTenantNotFound
+	}
+	return r.tenant, nil
+}
+
+func (r *stubTenantRepo) GetVerifiedSender(ctx context.Context, organizerID string) (*entity.OrganizerEmailSender, error) {
+	if r.sender == nil || r.sender.OrganizerID != organizerID {
+		return nil, repository.ErrEmailSenderNotFound
+	}
+	return r.sender, nil
+}
+
+func (r *stubTenantRepo) GetTicketTemplate(ctx context.Context, organizerID string) (*entity.OrganizerTicketTemplate, error) {
+	if r.ticket == nil || r.ticket.OrganizerID != organizerID {
+		return nil, repository.ErrTicketTemplateNotFound
+	}
+	return r.ticket, nil
+}
+
+func newEmailFixture(notification NotificationClient) (*confirmationService, *entity.Order, []response.TicketResponse) {
+	svc := &confirmationService{
+		orderItemRepo: &stubOrderItemRepo{items: []entity.OrderItem{
+			{OrderID: "order-1", TicketTierID: "tier-vip", Quantity: 2, Price: money.New(250000)},
+		}},
+		eventRepo: &stubEventRepo{event: &entity.Event{
+			ID:        "event-1",
+			Name:      "Jazz Night",
+			Location:  "Jakarta",
+			StartDate: time.Date(2026, 12, 5, 19, 0, 0, 0, time.UTC),
+		}},
+		ticketTierRepo: &stubTicketTierRepo{tiers: map[string]*entity.TicketTier{
+			"tier-vip": {ID: "tier-vip", Name: "VIP"},
+		}},
+		userRepo:           &stubUserRepo{user: &entity.User{ID: "user-1", Email: "buyer@example.com", FullName: "Buyer"}},
+		notificationClient: notification,
+		policyDocumentRepo: &stubPolicyDocumentRepo{acceptances: []entity.PolicyAcceptance{
+			{OrderID: "order-1", Title: "Terms of Service", VersionHash: entity.PolicyVersionHash("Terms of Service", "v1"), AcceptedAt: time.Date(2026, 11, 1, 10, 0, 0, 0, time.UTC)},
+		}},
+	}
+
+	order := &entity.Order{ID: "order-1", UserID: "user-1", EventID: "event-1", GrandTotal: money.New(500000)}
+	tickets := []response.TicketResponse{
+		{ID: "ticket-1", OrderID: "order-1", TicketTierID: "tier-vip", QRCode: "qr-1"},
+		{ID: "ticket-2", OrderID: "order-1", TicketTierID: "tier-vip", QRCode: "qr-2"},
+	}
+	return svc, order, tickets
+}
+
+func TestSendTicketEmail_BuildsRequest(t *testing.T) {
+	notification := &testutil.NotificationClient{}
+	svc, order, tickets := newEmailFixture(notification)
+
+	svc.sendTicketEmail(context.Background(), order, tickets)
+
+	calls := notification.SendTicketEmailCalls()
+	require.Len(t, calls, 1)
+	req := calls[0]
+	assert.Equal(t, "order-1", req.OrderID)
+	assert.Equal(t, "buyer@example.com", req.RecipientEmail)
+	assert.Equal(t, "Buyer", req.RecipientName)
+	assert.Equal(t, "Jazz Night", req.EventName)
+	assert.Equal(t, "Jakarta", req.EventLocation)
+	assert.Equal(t, money.New(500000), req.TotalAmount)
+	assert.Equal(t, "QRIS", req.PaymentMethod)
+
+	require.Len(t, req.Tickets, 2)
+	for _, ticket := range req.Tickets {
+		assert.Equal(t, "VIP", ticket.TierName)
+		assert.Equal(t, money.New(250000), ticket.Price)
+	}
+
+	require.Len(t, req.AcceptedPolicies, 1)
+	assert.Equal(t, "Terms of Service", req.AcceptedPolicies[0].Title)
+	assert.Equal(t, entity.PolicyVersionHash("Terms of Service", "v1"), req.AcceptedPolicies[0].VersionHash)
+}
+
+func TestSendTicketEmail_QueuesFailedEmailForRetry(t *testing.T) {
+	notification := &testutil.NotificationClient{
+		SendTicketEmailFunc: func(ctx context.Context, req *client.SendTicketEmailRequest) error {
+			return errors.New("notification service unavailable")
+		},
+	}
+	svc, order, tickets := newEmailFixture(notification)
+	outboxRepo := &stubOutboxRepo{}
+	svc.outboxRepo = outboxRepo
+	payment := &testutil.PaymentClient{}
+	svc.paymentClient = payment
+	paymentID := "inv-1"
+	order.PaymentID = &paymentID
+
+	before := time.Now()
+	svc.sendTicketEmail(context.Background(), order, tickets)
+
+	assert.Empty(t, payment.RecordTicketEmailCalls(), "the payment saga waits for the email")
+	require.Len(t, outboxRepo.queued, 1)
+	message := outboxRepo.queued[0]
+	assert.Equal(t, entity.NotificationTypeTicketEmail, message.Type)
+	assert.Equal(t, "order-1", message.OrderID)
+	assert.Equal(t, 1, message.Attempts, "the failed send is the first attempt")
+	assert.WithinDuration(t, before.Add(outboxRetryDelay), message.NextAttemptAt, time.Second)
+	require.NotNil(t, message.LastError)
+	assert.Contains(t, *message.LastError, "notification service unavailable")
+
+	// The worker sends the tickets still valid once notification service is back
+	notification.SendTicketEmailFunc = nil
+	svc.orderRepo = &stubOrderRepo{order: order}
+	svc.ticketRepo = &stubTicketRepo{tickets: map[string]*entity.Ticket{
+		"ticket-1": {ID: "ticket-1", OrderID: "order-1", TicketTierID: "tier-vip", Status: entity.TicketStatusValid},
+		"ticket-2": {ID: "ticket-2", OrderID: "order-1", TicketTierID: "tier-vip", Status: entity.TicketStatusVoid},
+	}}
+	require.NoError(t, svc.RetryTicketEmail(context.Background(), message))
+
+	calls := notification.SendTicketEmailCalls()
+	require.Len(t, calls, 2)
+	require.Len(t, calls[1].Tickets, 1)
+	assert.Equal(t, "ticket-1", calls[1].Tickets[0].TicketID)
+	assert.Equal(t, []string{"order-1"}, payment.RecordTicketEmailCalls())
+}
+
+func TestSendTicketEmail_FallsBackWhenLookupsFail(t *testing.T) {
+	notification := &testutil.NotificationClient{}
+	svc, order, tickets := newEmailFixture(notification)
+	svc.eventRepo = &stubEventRepo{}
+	svc.userRepo = &stubUserRepo{}
+	svc.ticketTierRepo = &stubTicketTierRepo{}
+
+	svc.sendTicketEmail(context.Background(), order, tickets)
+
+	calls := notification.SendTicketEmailCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "Event", calls[0].EventName)
+	assert.Equal(t, "Customer", calls[0].RecipientName)
+	assert.Equal(t, "Unknown Tier", calls[0].Tickets[0].TierName)
+}
+
+func TestSendTicketEmail_MarksSandboxOrders(t *testing.T) {
+	notification := &testutil.NotificationClient{}
+	svc, order, tickets := newEmailFixture(notification)
+
+	svc.sendTicketEmail(context.Background(), order, tickets)
+	order.IsSandbox = true
+	svc.sendTicketEmail(context.Background(), order, tickets)
+
+	calls := notification.SendTicketEmailCalls()
+	require.Len(t, calls, 2)
+	assert.False(t, calls[0].IsSandbox)
+	assert.True(t, calls[1].IsSandbox, "test orders are marked TEST")
+}
+
+func TestSendTicketEmail_TenantBranding(t *testing.T) {
+	notification := &testutil.NotificationClient{}
+	svc, order, tickets := newEmailFixture(notification)
+	fromName, color := "Brand Tickets", "#ff6600"
+	svc.tenantRepo = &stubTenantRepo{tenant: &entity.Tenant{
+		ID:            "6f1c2a4e-8d0b-4c55-9a77-3e2f1b0c9d11",
+		Name:          "Brand",
+		EmailFromName: &fromName,
+		PrimaryColor:  &color,
+	}}
+
+	// Orders of the default tenant use the platform branding
+	svc.sendTicketEmail(context.Background(), order, tickets)
+
+	order.TenantID = "6f1c2a4e-8d0b-4c55-9a77-3e2f1b0c9d11"
+	svc.sendTicketEmail(context.Background(), order, tickets)
+
+	calls := notification.SendTicketEmailCalls()
+	require.Len(t, calls, 2)
+	assert.Nil(t, calls[0].Branding)
+	require.NotNil(t, calls[1].Branding)
+	assert.Equal(t, "Brand", calls[1].Branding.BrandName)
+	assert.Equal(t, "Brand Tickets", calls[1].Branding.FromName)
+	assert.Equal(t, "#ff6600", calls[1].Branding.PrimaryColor)
+	assert.Empty(t, calls[1].Branding.FromEmail)
+}
+
+func TestSendTicketEmail_OrganizerSender(t *testing.T) {
+	notification := &testutil.NotificationClient{}
+	svc, order, tickets := newEmailFixture(notification)
+	replyTo := "halo@konserku.id"
+	svc.tenantRepo = &stubTenantRepo{sender: &entity.OrganizerEmailSender{
+		OrganizerID: "organizer-1",
+		FromName:    "Konserku",
+		FromEmail:   "tiket@mail.konserku.id",
+		ReplyTo:     &replyTo,
+	}}
+
+	// Organizers without a verified sender use the platform sender
+	svc.sendTicketEmail(context.Background(), order, tickets)
+
+	svc.eventRepo.(*stubEventRepo).event.OrganizerID = "organizer-1"
+	svc.sendTicketEmail(context.Background(), order, tickets)
+
+	calls := notification.SendTicketEmailCalls()
+	require.Len(t, calls, 2)
+	assert.Nil(t, calls[0].Branding)
+	require.NotNil(t, calls[1].Branding)
+	assert.Equal(t, "Konserku", calls[1].Branding.FromName)
+	assert.Equal(t, "tiket@mail.konserku.id", calls[1].Branding.FromEmail)
+	assert.Equal(t, "halo@konserku.id", calls[1].Branding.ReplyTo)
+	assert.Empty(t, calls[1].Branding.BrandName, "default tenant keeps the platform branding")
+}
+
+func TestSendTicketEmail_OrganizerTicketTemplate(t *testing.T) {
+	notification := &testutil.NotificationClient{}
+	svc, order, tickets := newEmailFixture(notification)
+	logo, color, footer := "https://cdn.konserku.id/logo.png", "#1a73e8", "Didukung oleh Bank Nusantara"
+	svc.tenantRepo = &stubTenantRepo{ticket: &entity.OrganizerTicketTemplate{
+		OrganizerID:   "organizer-1",
+		LogoURL:       &logo,
+		PrimaryColor:  &color,
+		SponsorFooter: &footer,
+	}}
+
+	// Organizers without a template get the platform layout
+	svc.sendTicketEmail(context.Background(), order, tickets)
+
+	svc.eventRepo.(*stubEventRepo).event.OrganizerID = "organizer-1"
+	svc.sendTicketEmail(context.Background(), order, tickets)
+
+	calls := notification.SendTicketEmailCalls()
+	require.Len(t, calls, 2)
+	assert.Nil(t, calls[0].TicketTemplate)
+	require.NotNil(t, calls[1].TicketTemplate)
+	assert.Equal(t, logo, calls[1].TicketTemplate.LogoURL)
+	assert.Equal(t, color, calls[1].TicketTemplate.PrimaryColor)
+	assert.Equal(t, footer, calls[1].TicketTemplate.SponsorFooter)
+	assert.Empty(t, calls[1].TicketTemplate.AccentColor)
+	assert.Empty(t, calls[1].TicketTemplate.TermsText)
+}
+
+// stubRefundRepo flags each payment once, like the unique payment_id index
+type stubRefundRepo struct {
+	repository.OrderRefundRepository
+	refunds map[string]*entity.OrderRefundRequest
+}
+
+func (r *stubRefundRepo) Create(ctx context.Context, refund *entity.OrderRefundRequest) (bool, error) {
+	if _, ok := r.refunds[refund.PaymentID]; ok {
+		return false, nil
+	}
+	r.refunds[refund.PaymentID] = refund
+	return true, nil
+}
+
+func (r *stubRefundRepo) MarkPaymentRefunded(ctx context.Context, orderID, paymentID string) (bool, error) {
+	refund, ok := r.refunds[paymentID]
+	if !ok || refund.OrderID != orderID || refund.Status == entity.RefundStatusRefunded {
+		return false, nil
+	}
+	now := time.Now()
+	refund.Status = entity.RefundStatusRefunded
+	refund.RefundedAt = &now
+	return true, nil
+}
+
+func TestOrderRefundReason(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Minute)
+
+	tests := []struct {
+		name  string
+		order *entity.Order
+		want  string
+	}{
+		{"reserved", &entity.Order{Status: entity.OrderStatusReserved, ReservationExpiresAt: &future}, ""},
+		{"reservation past expiry", &entity.Order{Status: entity.OrderStatusReserved, ReservationExpiresAt: &past}, entity.RefundReasonOrderExpired},
+		{"expired", &entity.Order{Status: entity.OrderStatusExpired}, entity.RefundReasonOrderExpired},
+		{"cancelled", &entity.Order{Status: entity.OrderStatusCancelled}, entity.RefundReasonOrderCancelled},
+		{"paid by another payment", &entity.Order{Status: entity.OrderStatusPaid}, entity.RefundReasonDuplicatePayment},
+		{"completed by another payment", &entity.Order{Status: entity.OrderStatusCompleted}, entity.RefundReasonDuplicatePayment},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, orderRefundReason(tt.order))
+		})
+	}
+}
+
+func TestFlagForRefund_RetriesFlagOnce(t *testing.T) {
+	refundRepo := &stubRefundRepo{refunds: map[string]*entity.OrderRefundRequest{}}
+	svc := &confirmationService{refundRepo: refundRepo, currency: "IDR"}
+	order := &entity.Order{ID: "order-1", TenantID: "tenant-1", Status: entity.OrderStatusExpired}
+	req := &request.ConfirmOrderRequest{OrderID: "order-1", PaymentID: "inv-123", PaymentMethod: "QRIS", Amount: money.New(500000)}
+
+	require.NoError(t, svc.flagForRefund(context.Background(), order, req, entity.RefundReasonOrderExpired))
+	require.NoError(t, svc.flagForRefund(context.Background(), order, req, entity.RefundReasonOrderExpired))
+
+	require.Len(t, refundRepo.refunds, 1)
+	refund := refundRepo.refunds["inv-123"]
+	assert.Equal(t, "tenant-1", refund.TenantID)
+	assert.Equal(t, money.New(500000), refund.Amount)
+	assert.Equal(t, "IDR", refund.Currency)
+	assert.Equal(t, entity.RefundReasonOrderExpired, refund.Reason)
+}
+
+func (r *stubOrderRepo) UpdateWithTx(ctx context.Context, tx *sql.Tx, order *entity.Order) error {
+	r.order = order
+	return nil
+}
+
+// UpdateSoldCount reserves quantity like the quota-guarded UPDATE
+func (r *stubTicketTierRepo) UpdateSoldCount(ctx context.Context, tx *sql.Tx, tierID string, quantity int, cause entity.InventoryCause) error {
+	tier, ok := r.tiers[tierID]
+	if !ok {
+		return repository.ErrTicketTierNotFound
+	}
+	if tier.GetAvailableQuota() < quantity {
+		return repository.ErrInsufficientQuota
+	}
+	tier.SoldCount += quantity
+	r.causes = append(r.causes, cause)
+	return nil
+}
+
+// UpdateAccessibleSoldCount reserves accessible seats like the accessible quota-guarded UPDATE
+func (r *stubTicketTierRepo) UpdateAccessibleSoldCount(ctx context.Context, tx *sql.Tx, tierID string, quantity int, cause entity.InventoryCause) error {
+	tier, ok := r.tiers[tierID]
+	if !ok {
+		return repository.ErrTicketTierNotFound
+	}
+	if tier.GetAvailableAccessibleQuota() < quantity {
+		return repository.ErrInsufficientAccessibleQuota
+	}
+	tier.SoldCount += quantity
+	tier.AccessibleSoldCount += quantity
+	r.causes = append(r.causes, cause)
+	return nil
+}
+
+// TestApplyPayment_ExpiryRace covers payments landing as the cleanup worker expires the order
+func TestApplyPayment_ExpiryRace(t *testing.T) {
+	ctx := context.Background()
+	req := &request.ConfirmOrderRequest{OrderID: "order-1", PaymentID: "inv-123", PaymentMethod: "QRIS", Amount: money.New(500000), Currency: "IDR", InvoicePending: true}
+
+	newFixture := func(status string, expiredFor time.Duration, soldCount int) (*confirmationService, *stubOrderRepo, *stubTicketTierRepo, *stubRefundRepo) {
+		expiresAt := time.Now().Add(-expiredFor)
+		orderRepo := &stubOrderRepo{order: &entity.Order{
+			ID: "order-1", TenantID: "tenant-1", Status: status,
+			GrandTotal: money.New(500000), ReservationExpiresAt: &expiresAt,
+		}}
+		tierRepo := &stubTicketTierRepo{tiers: map[string]*entity.TicketTier{
+			"tier-vip": {ID: "tier-vip", Quota: 10, SoldCount: soldCount},
+		}}
+		refundRepo := &stubRefundRepo{refunds: map[string]*entity.OrderRefundRequest{}}
+		svc := &confirmationService{
+			orderRepo: orderRepo,
+			orderItemRepo: &stubOrderItemRepo{items: []entity.OrderItem{
+				{OrderID: "order-1", TicketTierID: "tier-vip", Quantity: 2, Price: money.New(250000)},
+			}},
+			ticketTierRepo:    tierRepo,
+			accommodationRepo: &stubAccommodationRepo{},
+			refundRepo:        refundRepo,
+			currency:          "IDR",
+			expiryGracePeriod: 5 * time.Minute,
+		}
+		return svc, orderRepo, tierRepo, refundRepo
+	}
+
+	t.Run("cleanup not yet run within grace", func(t *testing.T) {
+		svc, orderRepo, tierRepo, refundRepo := newFixture(entity.OrderStatusReserved, time.Minute, 8)
+
+		outcome, _, err := svc.applyPayment(ctx, nil, orderRepo.order, req)
+		require.NoError(t, err)
+		assert.Equal(t, ConfirmationConfirmed, outcome)
+		assert.Equal(t, entity.OrderStatusPaid, orderRepo.order.Status)
+		assert.Equal(t, 8, tierRepo.tiers["tier-vip"].SoldCount, "reservation still holds its tickets")
+		assert.Empty(t, refundRepo.refunds)
+	})
+
+	t.Run("expired within grace reserves tickets again", func(t *testing.T) {
+		svc, orderRepo, tierRepo, refundRepo := newFixture(entity.OrderStatusExpired, time.Minute, 6)
+
+		outcome, _, err := svc.applyPayment(ctx, nil, orderRepo.order, req)
+		require.NoError(t, err)
+		assert.Equal(t, ConfirmationConfirmed, outcome)
+		assert.Equal(t, entity.OrderStatusPaid, orderRepo.order.Status)
+		assert.Equal(t, 8, tierRepo.tiers["tier-vip"].SoldCount)
+		assert.Equal(t, []entity.InventoryCause{entity.OrderInventoryCause(entity.InventoryReasonConfirm, "order-1")}, tierRepo.causes)
+		assert.Empty(t, refundRepo.refunds)
+	})
+
+	t.Run("expired within grace but sold out", func(t *testing.T) {
+		svc, orderRepo, tierRepo, refundRepo := newFixture(entity.OrderStatusExpired, time.Minute, 9)
+
+		outcome, reason, err := svc.applyPayment(ctx, nil, orderRepo.order, req)
+		require.NoError(t, err)
+		assert.Equal(t, ConfirmationRefundFlagged, outcome)
+		assert.Equal(t, entity.RefundReasonSoldOut, reason)
+		assert.Equal(t, entity.OrderStatusExpired, orderRepo.order.Status)
+		assert.Equal(t, 9, tierRepo.tiers["tier-vip"].SoldCount)
+		require.Contains(t, refundRepo.refunds, "inv-123")
+		assert.Equal(t, entity.RefundReasonSoldOut, refundRepo.refunds["inv-123"].Reason)
+		// Pending until payment service reports the refund went through
+		assert.NotEqual(t, entity.RefundStatusRefunded, refundRepo.refunds["inv-123"].Status)
+		assert.Nil(t, refundRepo.refunds["inv-123"].RefundedAt)
+	})
+
+	t.Run("expired within grace but paid after the invoice expired", func(t *testing.T) {
+		svc, orderRepo, tierRepo, refundRepo := newFixture(entity.OrderStatusExpired, time.Minute, 6)
+		late := *req
+		late.InvoicePending = false
+
+		outcome, reason, err := svc.applyPayment(ctx, nil, orderRepo.order, &late)
+		require.NoError(t, err)
+		assert.Equal(t, ConfirmationRefundFlagged, outcome)
+		assert.Equal(t, entity.RefundReasonOrderExpired, reason)
+		assert.Equal(t, entity.OrderStatusExpired, orderRepo.order.Status)
+		assert.Equal(t, 6, tierRepo.tiers["tier-vip"].SoldCount, "tickets are not reserved again")
+		assert.Equal(t, entity.RefundReasonOrderExpired, refundRepo.refunds["inv-123"].Reason)
+	})
+
+	t.Run("expired within grace takes accessible seats again", func(t *testing.T) {
+		svc, orderRepo, tierRepo, refundRepo := newFixture(entity.OrderStatusExpired, time.Minute, 6)
+		tierRepo.tiers["tier-vip"].AccessibleQuota = 2
+		svc.accommodationRepo = &stubAccommodationRepo{accommodations: []entity.Accommodation{
+			{OrderID: "order-1", TicketTierID: "tier-vip", Type: entity.AccommodationWheelchair, AccessibleSeat: true},
+		}}
+
+		outcome, _, err := svc.applyPayment(ctx, nil, orderRepo.order, req)
+		require.NoError(t, err)
+		assert.Equal(t, ConfirmationConfirmed, outcome)
+		assert.Equal(t, 8, tierRepo.tiers["tier-vip"].SoldCount)
+		assert.Equal(t, 1, tierRepo.tiers["tier-vip"].AccessibleSoldCount)
+		assert.Empty(t, refundRepo.refunds)
+	})
+
+	t.Run("expired within grace but accessible seating sold out", func(t *testing.T) {
+		svc, orderRepo, tierRepo, refundRepo := newFixture(entity.OrderStatusExpired, time.Minute, 6)
+		tierRepo.tiers["tier-vip"].AccessibleQuota = 1
+		tierRepo.tiers["tier-vip"].AccessibleSoldCount = 1
+		svc.accommodationRepo = &stubAccommodationRepo{accommodations: []entity.Accommodation{
+			{OrderID: "order-1", TicketTierID: "tier-vip", Type: entity.AccommodationWheelchair, AccessibleSeat: true},
+		}}
+
+		outcome, _, err := svc.applyPayment(ctx, nil, orderRepo.order, req)
+		require.NoError(t, err)
+		assert.Equal(t, ConfirmationRefundFlagged, outcome)
+		assert.Contains(t, refundRepo.refunds, "inv-123")
+	})
+
+	t.Run("expired beyond grace", func(t *testing.T) {
+		svc, orderRepo, tierRepo, refundRepo := newFixture(entity.OrderStatusExpired, 10*time.Minute, 6)
+
+		outcome, _, err := svc.applyPayment(ctx, nil, orderRepo.order, req)
+		require.NoError(t, err)
+		assert.Equal(t, ConfirmationRefundFlagged, outcome)
+		assert.Equal(t, 6, tierRepo.tiers["tier-vip"].SoldCount)
+		assert.Contains(t, refundRepo.refunds, "inv-123")
+	})
+
+	t.Run("expired within grace with amount mismatch", func(t *testing.T) {
+		svc, orderRepo, tierRepo, _ := newFixture(entity.OrderStatusExpired, time.Minute, 6)
+		short := *req
+		short.Amount = money.New(400000)
+
+		_, _, err := svc.applyPayment(ctx, nil, orderRepo.order, &short)
+		assert.ErrorIs(t, err, ErrAmountMismatch)
+		assert.Equal(t, 6, tierRepo.tiers["tier-vip"].SoldCount)
+	})
+
+	t.Run("cancelled within grace", func(t *testing.T) {
+		svc, orderRepo, _, refundRepo := newFixture(entity.OrderStatusCancelled, time.Minute, 6)
+
+		outcome, _, err := svc.applyPayment(ctx, nil, orderRepo.order, req)
+		require.NoError(t, err)
+		assert.Equal(t, ConfirmationRefundFlagged, outcome)
+		assert.Equal(t, entity.RefundReasonOrderCancelled, refundRepo.refunds["inv-123"].Reason)
+		// Payment service refunds payments of cancelled orders itself
+		assert.Equal(t, entity.RefundStatusRefunded, refundRepo.refunds["inv-123"].Status)
+		assert.NotNil(t, refundRepo.refunds["inv-123"].RefundedAt)
+	})
+}
+
+func (r *stubOrderRepo) GetByPaymentID(ctx context.Context, paymentID string) (*entity.Order, error) {
+	if r.order == nil || r.order.PaymentID == nil || *r.order.PaymentID != paymentID {
+		return nil, repository.ErrOrderNotFound
+	}
+	return r.order, nil
+}
+
+func (r *stubRefundRepo) GetByPaymentID(ctx context.Context, paymentID string) (*entity.OrderRefundRequest, error) {
+	refund, ok := r.refunds[paymentID]
+	if !ok {
+		return nil, repository.ErrRefundRequestNotFound
+	}
+	return refund, nil
+}
+
+// TestConfirmPayment_Retries covers confirmations retried with the same payment ID
+func TestConfirmPayment_Retries(t *testing.T) {
+	ctx := context.Background()
+	paymentID := "inv-123"
+
+	newFixture := func(order *entity.Order) (*confirmationService, *stubTicketRepo, *stubTicketGenerator, *stubRefundRepo) {
+		svc, _, _ := newEmailFixture(&testutil.NotificationClient{})
+		ticketRepo := &stubTicketRepo{tickets: map[string]*entity.Ticket{}}
+		generator := &stubTicketGenerator{}
+		refundRepo := &stubRefundRepo{refunds: map[string]*entity.OrderRefundRequest{}}
+		svc.orderRepo = &stubOrderRepo{order: order}
+		svc.ticketRepo = ticketRepo
+		svc.ticketService = generator
+		svc.refundRepo = refundRepo
+		return svc, ticketRepo, generator, refundRepo
+	}
+	paidOrder := func() *entity.Order {
+		id := paymentID
+		return &entity.Order{ID: "order-1", UserID: "user-1", EventID: "event-1", Status: entity.OrderStatusPaid, PaymentID: &id}
+	}
+
+	t.Run("returns the tickets of the first confirmation", func(t *testing.T) {
+		svc, ticketRepo, generator, _ := newFixture(paidOrder())
+		ticketRepo.tickets["ticket-1"] = &entity.Ticket{ID: "ticket-1", OrderID: "order-1"}
+		ticketRepo.tickets["ticket-2"] = &entity.Ticket{ID: "ticket-2", OrderID: "order-1"}
+
+		result, err := svc.ConfirmPayment(ctx, &request.ConfirmOrderRequest{OrderID: "order-1", PaymentID: paymentID})
+		require.NoError(t, err)
+		assert.Equal(t, ConfirmationAlreadyPaid, result.Outcome)
+		assert.Equal(t, "order-1", result.OrderID)
+		assert.Equal(t, paymentID, result.PaymentID)
+		assert.Equal(t, []string{"ticket-1", "ticket-2"}, result.TicketIDs)
+		assert.Empty(t, generator.orderIDs, "tickets are not generated again")
+	})
+
+	t.Run("generates tickets that failed after the first confirmation", func(t *testing.T) {
+		svc, _, generator, _ := newFixture(paidOrder())
+
+		result, err := svc.ConfirmPayment(ctx, &request.ConfirmOrderRequest{OrderID: "order-1", PaymentID: paymentID})
+		require.NoError(t, err)
+		assert.Equal(t, ConfirmationAlreadyPaid, result.Outcome)
+		assert.Equal(t, []string{"order-1"}, generator.orderIDs)
+	})
+
+	t.Run("payment applied to another order", func(t *testing.T) {
+		svc, _, _, _ := newFixture(paidOrder())
+
+		_, err := svc.ConfirmPayment(ctx, &request.ConfirmOrderRequest{OrderID: "order-2", PaymentID: paymentID})
+		assert.ErrorIs(t, err, ErrPaymentAlreadyApplied)
+	})
+
+	t.Run("payment flagged for refund", func(t *testing.T) {
+		svc, _, _, refundRepo := newFixture(&entity.Order{ID: "order-1", Status: entity.OrderStatusCancelled})
+		refundRepo.refunds[paymentID] = &entity.OrderRefundRequest{OrderID: "order-1", PaymentID: paymentID, Reason: entity.RefundReasonOrderCancelled}
+
+		result, err := svc.ConfirmPayment(ctx, &request.ConfirmOrderRequest{OrderID: "order-1", PaymentID: paymentID})
+		require.NoError(t, err)
+		assert.Equal(t, ConfirmationRefundFlagged, result.Outcome)
+		assert.Equal(t, "order-1", result.OrderID)
+		assert.Equal(t, entity.RefundReasonOrderCancelled, result.RefundReason)
+		assert.Empty(t, result.TicketIDs)
+
+		_, err = svc.ConfirmPayment(ctx, &request.ConfirmOrderRequest{OrderID: "order-2", PaymentID: paymentID})
+		assert.ErrorIs(t, err, ErrPaymentAlreadyApplied)
+	})
+
+	t.Run("first confirmation of a payment", func(t *testing.T) {
+		svc, _, _, _ := newFixture(&entity.Order{ID: "order-1", Status: entity.OrderStatusReserved})
+
+		result, err := svc.previousResult(ctx, &request.ConfirmOrderRequest{OrderID: "order-1", PaymentID: paymentID})
+		require.NoError(t, err)
+		assert.Nil(t, result, "confirmed under the order lock")
+	})
+}
+
+// stubAuditRepo records created audit entries
+type stubAuditRepo struct {
+	repository.OrderAuditRepository
+	entries []*entity.OrderAuditEntry
+}
+
+func (r *stubAuditRepo) Create(ctx context.Context, entry *entity.OrderAuditEntry) error {
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func TestMarkPaid(t *testing.T) {
+	ctx := context.Background()
+	req := &request.MarkPaidRequest{ReferenceNumber: "TRF-001", Reason: "Transfer received, webhook missing"}
+
+	newFixture := func(order *entity.Order) (*confirmationService, *stubAuditRepo) {
+		svc, _, _ := newEmailFixture(&testutil.NotificationClient{})
+		auditRepo := &stubAuditRepo{}
+		svc.orderRepo = &stubOrderRepo{order: order}
+		svc.ticketRepo = &stubTicketRepo{tickets: map[string]*entity.Ticket{
+			"ticket-1": {ID: "ticket-1", OrderID: "order-1"},
+		}}
+		svc.ticketService = &stubTicketGenerator{}
+		svc.auditRepo = auditRepo
+		return svc, auditRepo
+	}
+	paidOrder := func(paymentID string) *entity.Order {
+		return &entity.Order{ID: "order-1", TenantID: tenant.DefaultID, Status: entity.OrderStatusPaid, PaymentID: &paymentID}
+	}
+
+	t.Run("order of another tenant", func(t *testing.T) {
+		svc, auditRepo := newFixture(&entity.Order{ID: "order-1", TenantID: "tenant-2", Status: entity.OrderStatusReserved})
+
+		_, err := svc.MarkPaid(ctx, "admin-1", "order-1", req)
+		assert.ErrorIs(t, err, ErrOrderNotFound)
+		assert.Empty(t, auditRepo.entries)
+	})
+
+	t.Run("order paid by another payment", func(t *testing.T) {
+		svc, auditRepo := newFixture(paidOrder("inv-123"))
+
+		_, err := svc.MarkPaid(ctx, "admin-1", "order-1", req)
+		assert.ErrorIs(t, err, ErrOrderAlreadyPaid)
+		assert.Empty(t, auditRepo.entries)
+	})
+
+	t.Run("retried with the same reference", func(t *testing.T) {
+		svc, auditRepo := newFixture(paidOrder("manual:TRF-001"))
+
+		result, err := svc.MarkPaid(ctx, "admin-1", "order-1", req)
+		require.NoError(t, err)
+		assert.Equal(t, ConfirmationAlreadyPaid, result.Outcome)
+		assert.Equal(t, []string{"ticket-1"}, result.TicketIDs)
+
+		require.Len(t, auditRepo.entries, 1)
+		entry := auditRepo.entries[0]
+		assert.Equal(t, entity.AuditActionMarkPaid, entry.Action)
+		assert.Equal(t, "admin-1", entry.ActorID)
+		assert.Equal(t, "TRF-001", *entry.Reference)
+		assert.Equal(t, ConfirmationAlreadyPaid, *entry.Outcome)
+	})
+}
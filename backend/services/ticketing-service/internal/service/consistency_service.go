@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+)
+
+// Consistency metrics (exposed via /debug/vars)
+var (
+	consistencyRunsTotal     = expvar.NewInt("consistency_check_runs_total")
+	consistencyErrorsTotal   = expvar.NewInt("consistency_check_errors_total")
+	consistencyHealedTotal   = expvar.NewInt("consistency_check_healed_total")
+	consistencyDiscrepancies = expvar.NewMap("consistency_check_discrepancies") // By check, as of the last run
+)
+
+// ConsistencyPolicy defines how the consistency checker runs
+type ConsistencyPolicy struct {
+	GracePeriod time.Duration // Orders and payments updated more recently may still be in flight and are skipped
+	Limit       int           // Max discrepancies reported per check
+	AutoHeal    bool          // Fix known-safe discrepancies
+}
+
+// ConsistencyReport holds the result of one consistency check run
+type ConsistencyReport struct {
+	StartedAt     time.Time            `json:"started_at"`
+	Duration      time.Duration        `json:"duration"`
+	Counts        map[string]int       `json:"counts"`
+	Healed        int                  `json:"healed"`
+	Discrepancies []entity.Discrepancy `json:"discrepancies"`
+}
+
+// ConsistencyService verifies invariants between orders, tickets, ticket tiers and payments
+type ConsistencyService interface {
+	RunChecks(ctx context.Context) (*ConsistencyReport, error)
+}
+
+// consistencyService implements ConsistencyService interface
+type consistencyService struct {
+	consistencyRepo repository.ConsistencyRepository
+	ticketService   TicketService
+	policy          ConsistencyPolicy
+}
+
+// NewConsistencyService creates new consistency service instance
+func NewConsistencyService(
+	consistencyRepo repository.ConsistencyRepository,
+	ticketService TicketService,
+	policy ConsistencyPolicy,
+) ConsistencyService {
+	return &consistencyService{
+		consistencyRepo: consistencyRepo,
+		ticketService:   ticketService,
+		policy:          policy,
+	}
+}
+
+// RunChecks runs every check, heals known-safe discrepancies when enabled and logs the report
+// Known-safe: paid orders without tickets (tickets are generated) and sold_count drift (recomputed).
+// Ticket count, payment and inventory ledger mismatches need a person to decide and are only reported.
+func (s *consistencyService) RunChecks(ctx context.Context) (*ConsistencyReport, error) {
+	report := &ConsistencyReport{
+		StartedAt: time.Now(),
+		Counts:    map[string]int{},
+	}
+	settledBefore := report.StartedAt.Add(-s.policy.GracePeriod)
+
+	consistencyRunsTotal.Add(1)
+
+	checks := []struct {
+		name string
+		find func() ([]entity.Discrepancy, error)
+	}{
+		{entity.CheckPaidOrderWithoutTickets, func() ([]entity.Discrepancy, error) {
+			return s.consistencyRepo.FindPaidOrdersWithoutTickets(ctx, settledBefore, s.policy.Limit)
+		}},
+		{entity.CheckTicketCountMismatch, func() ([]entity.Discrepancy, error) {
+			return s.consistencyRepo.FindTicketCountMismatches(ctx, settledBefore, s.policy.Limit)
+		}},
+		{entity.CheckSoldCountMismatch, func() ([]entity.Discrepancy, error) {
+			return s.consistencyRepo.FindSoldCountMismatches(ctx, s.policy.Limit)
+		}},
+		{entity.CheckPaidPaymentWithoutPaidOrder, func() ([]entity.Discrepancy, error) {
+			return s.consistencyRepo.FindPaidPaymentsWithoutPaidOrder(ctx, settledBefore, s.policy.Limit)
+		}},
+		{entity.CheckInventoryLedgerMismatch, func() ([]entity.Discrepancy, error) {
+			return s.consistencyRepo.FindInventoryLedgerMismatches(ctx, s.policy.Limit)
+		}},
+	}
+
+	for _, check := range checks {
+		discrepancies, err := check.find()
+		if err != nil {
+			consistencyErrorsTotal.Add(1)
+			return report, fmt.Errorf("consistency check %s failed: %w", check.name, err)
+		}
+
+		if s.policy.AutoHeal {
+			for i := range discrepancies {
+				discrepancies[i].Healed = s.heal(ctx, &discrepancies[i])
+				if discrepancies[i].Healed {
+					report.Healed++
+				}
+			}
+		}
+
+		report.Counts[check.name] = len(discrepancies)
+		report.Discrepancies = append(report.Discrepancies, discrepancies...)
+
+		found := new(expvar.Int)
+		found.Set(int64(len(discrepancies)))
+		consistencyDiscrepancies.Set(check.name, found)
+	}
+
+	report.Duration = time.Since(report.StartedAt)
+	consistencyHealedTotal.Add(int64(report.Healed))
+
+	for _, d := range report.Discrepancies {
+		log.Printf("[ConsistencyService] %s: %s expected=%d actual=%d healed=%t %s",
+			d.Check, d.EntityID, d.Expected, d.Actual, d.Healed, d.Detail)
+	}
+	log.Printf("[ConsistencyService] Found %d discrepancies (%v), healed %d",
+		len(report.Discrepancies), report.Counts, report.Healed)
+
+	return report, nil
+}
+
+// heal fixes a known-safe discrepancy, returns whether it was fixed
+func (s *consistencyService) heal(ctx context.Context, d *entity.Discrepancy) bool {
+	switch d.Check {
+	case entity.CheckPaidOrderWithoutTickets:
+		// Ticket numbers are unique per order, so a concurrent generation makes this fail instead of duplicating
+		if _, err := s.ticketService.GenerateTickets(ctx, d.EntityID); err != nil {
+			log.Printf("[ConsistencyService] Failed to generate tickets for order %s: %v", d.EntityID, err)
+			return false
+		}
+		return true
+	case entity.CheckSoldCountMismatch:
+		healed, err := s.consistencyRepo.HealSoldCount(ctx, d.EntityID)
+		if err != nil {
+			log.Printf("[ConsistencyService] Failed to heal sold count of tier %s: %v", d.EntityID, err)
+			return false
+		}
+		return healed
+	default:
+		return false
+	}
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubConsistencyRepo returns one discrepancy per check and records healed tiers
+type stubConsistencyRepo struct {
+	settledBefore time.Time
+	healedTiers   []string
+	soldCountErr  error
+}
+
+func (r *stubConsistencyRepo) FindPaidOrdersWithoutTickets(ctx context.Context, settledBefore time.Time, limit int) ([]entity.Discrepancy, error) {
+	r.settledBefore = settledBefore
+	return []entity.Discrepancy{{Check: entity.CheckPaidOrderWithoutTickets, EntityID: "order-1", Expected: 2}}, nil
+}
+
+func (r *stubConsistencyRepo) FindTicketCountMismatches(ctx context.Context, settledBefore time.Time, limit int) ([]entity.Discrepancy, error) {
+	return []entity.Discrepancy{{Check: entity.CheckTicketCountMismatch, EntityID: "order-2", Expected: 3, Actual: 2}}, nil
+}
+
+func (r *stubConsistencyRepo) FindSoldCountMismatches(ctx context.Context, limit int) ([]entity.Discrepancy, error) {
+	if r.soldCountErr != nil {
+		return nil, r.soldCountErr
+	}
+	return []entity.Discrepancy{{Check: entity.CheckSoldCountMismatch, EntityID: "tier-1", Expected: 5, Actual: 7}}, nil
+}
+
+func (r *stubConsistencyRepo) FindPaidPaymentsWithoutPaidOrder(ctx context.Context, settledBefore time.Time, limit int) ([]entity.Discrepancy, error) {
+	return []entity.Discrepancy{{Check: entity.CheckPaidPaymentWithoutPaidOrder, EntityID: "payment-1", Detail: "order order-3 is expired"}}, nil
+}
+
+func (r *stubConsistencyRepo) FindInventoryLedgerMismatches(ctx context.Context, limit int) ([]entity.Discrepancy, error) {
+	return []entity.Discrepancy{{Check: entity.CheckInventoryLedgerMismatch, EntityID: "tier-2", Expected: 4, Actual: 5}}, nil
+}
+
+func (r *stubConsistencyRepo) HealSoldCount(ctx context.Context, tierID string) (bool, error) {
+	r.healedTiers = append(r.healedTiers, tierID)
+	return true, nil
+}
+
+type stubTicketGenerator struct {
+	TicketService
+	orderIDs []string
+}
+
+func (s *stubTicketGenerator) GenerateTickets(ctx context.Context, orderID string) ([]response.TicketResponse, error) {
+	s.orderIDs = append(s.orderIDs, orderID)
+	return []response.TicketResponse{}, nil
+}
+
+func TestConsistencyService_RunChecks(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("report only", func(t *testing.T) {
+		repo := &stubConsistencyRepo{}
+		tickets := &stubTicketGenerator{}
+		svc := NewConsistencyService(repo, tickets, ConsistencyPolicy{GracePeriod: 15 * time.Minute, Limit: 100})
+
+		report, err := svc.RunChecks(ctx)
+		require.NoError(t, err)
+		assert.Len(t, report.Discrepancies, 5)
+		assert.Equal(t, 1, report.Counts[entity.CheckSoldCountMismatch])
+		assert.Zero(t, report.Healed)
+		assert.Empty(t, tickets.orderIDs)
+		assert.Empty(t, repo.healedTiers)
+		assert.WithinDuration(t, report.StartedAt.Add(-15*time.Minute), repo.settledBefore, time.Second)
+	})
+
+	t.Run("auto heal fixes only known-safe discrepancies", func(t *testing.T) {
+		repo := &stubConsistencyRepo{}
+		tickets := &stubTicketGenerator{}
+		svc := NewConsistencyService(repo, tickets, ConsistencyPolicy{Limit: 100, AutoHeal: true})
+
+		report, err := svc.RunChecks(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, report.Healed)
+		assert.Equal(t, []string{"order-1"}, tickets.orderIDs)
+		assert.Equal(t, []string{"tier-1"}, repo.healedTiers)
+
+		for _, d := range report.Discrepancies {
+			healable := d.Check == entity.CheckPaidOrderWithoutTickets || d.Check == entity.CheckSoldCountMismatch
+			assert.Equal(t, healable, d.Healed, d.Check)
+		}
+	})
+
+	t.Run("failed check", func(t *testing.T) {
+		repo := &stubConsistencyRepo{soldCountErr: errors.New("connection reset")}
+		svc := NewConsistencyService(repo, &stubTicketGenerator{}, ConsistencyPolicy{Limit: 100})
+
+		report, err := svc.RunChecks(ctx)
+		assert.Error(t, err)
+		assert.Len(t, report.Discrepancies, 2)
+	})
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/utility"
+)
+
+var (
+	ErrDynamicQRDisabled = errors.New("event does not use rotating QR codes")
+	ErrDynamicQRRequired = errors.New("event only admits the rotating QR code from the ticket app")
+)
+
+// GetDynamicQR returns the current rotating QR code of the owner's ticket
+// Only tickets of dynamic QR events have one; the ticket's secret is created on the first request
+func (s *ticketService) GetDynamicQR(ctx context.Context, userID, ticketID string) (*response.DynamicQRResponse, error) {
+	ticket, err := s.ticketRepo.GetByID(ctx, ticketID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketNotFound) {
+			return nil, ErrTicketNotFound
+		}
+		return nil, fmt.Errorf("failed to get ticket: %w", err)
+	}
+
+	// Check authorization
+	if ticket.UserID != userID {
+		return nil, ErrUnauthorized
+	}
+
+	if !ticket.CanBeUsed() {
+		if ticket.IsVoid() {
+			return nil, ErrTicketVoid
+		}
+		return nil, ErrTicketInvalid
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, ticket.EventID)
+	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return nil, ErrTicketInvalid
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if !event.DynamicQR {
+		return nil, ErrDynamicQRDisabled
+	}
+
+	secret := ""
+	if ticket.QRSecret != nil {
+		secret = *ticket.QRSecret
+	} else {
+		secret, err = s.ticketRepo.EnsureQRSecret(ctx, ticket.ID, utility.GenerateQRSecret())
+		if err != nil {
+			// Ticket was used or voided after it was loaded
+			if errors.Is(err, repository.ErrTicketNotValid) {
+				return nil, ErrTicketInvalid
+			}
+			return nil, fmt.Errorf("failed to set ticket QR secret: %w", err)
+		}
+	}
+
+	zones, err := s.ticketZones(ctx, ticket.TicketTierID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	qrData := utility.GenerateDynamicTicketQRData(ticket.ID, ticket.EventID, utility.DynamicQRCode(secret, now), zones)
+	qrCode, err := utility.GenerateQRCode(qrData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate QR code: %w", err)
+	}
+
+	return &response.DynamicQRResponse{
+		TicketID:       ticket.ID,
+		QRData:         qrData,
+		QRCode:         qrCode,
+		ExpiresAt:      utility.DynamicQRExpiresAt(now),
+		RefreshSeconds: int(utility.DynamicQRStep.Seconds()),
+	}, nil
+}
+
+// checkScannedQR checks scanned QR claims against the ticket they resolved to
+// Static payloads must match the ticket's latest QR data; rotating codes are
+// verified against the ticket's secret at the scan time
+func (s *ticketService) checkScannedQR(ticket *entity.Ticket, claims *utility.TicketQRClaims, qrData string) bool {
+	if !claims.Dynamic {
+		return subtle.ConstantTimeCompare([]byte(qrData), []byte(ticket.QRData)) == 1
+	}
+	if ticket.QRSecret == nil {
+		return false
+	}
+	return utility.VerifyDynamicQRCode(*ticket.QRSecret, claims.Code, s.now())
+}
+
+// checkQRMode refuses static QR codes at events that admit rotating codes only
+func checkQRMode(event *entity.Event, claims *utility.TicketQRClaims) error {
+	if event.DynamicQR && !claims.Dynamic {
+		return ErrDynamicQRRequired
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTicketService_DynamicQR(t *testing.T) {
+	svc, tickets, _ := newTicketFixture()
+	ctx := context.Background()
+	svc.eventRepo.(*stubEventRepo).event.DynamicQR = true
+	staticQR := tickets.tickets["ticket-1"].QRData
+	issuedAt := svc.now()
+
+	qr, err := svc.GetDynamicQR(ctx, "owner", "ticket-1")
+	require.NoError(t, err)
+	assert.NotEmpty(t, qr.QRCode)
+	assert.Equal(t, issuedAt.Truncate(utility.DynamicQRStep).Add(utility.DynamicQRStep), qr.ExpiresAt)
+	require.NotNil(t, tickets.tickets["ticket-1"].QRSecret)
+
+	// The secret is kept, so the app gets the same code within a time step
+	again, err := svc.GetDynamicQR(ctx, "owner", "ticket-1")
+	require.NoError(t, err)
+	assert.Equal(t, qr.QRData, again.QRData)
+
+	// Screenshots of the e-ticket QR code are refused
+	_, err = svc.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: staticQR})
+	assert.ErrorIs(t, err, ErrDynamicQRRequired)
+
+	verification, err := svc.VerifyTicket(ctx, &request.ValidateTicketRequest{QRData: staticQR})
+	require.NoError(t, err)
+	assert.False(t, verification.Valid)
+
+	// A code two steps old has expired
+	svc.now = func() time.Time { return issuedAt.Add(2 * utility.DynamicQRStep) }
+	_, err = svc.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: qr.QRData})
+	assert.ErrorIs(t, err, ErrTicketInvalid)
+
+	// One step of skew is accepted
+	svc.now = func() time.Time { return issuedAt.Add(utility.DynamicQRStep) }
+	validated, err := svc.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: qr.QRData})
+	require.NoError(t, err)
+	assert.Equal(t, entity.TicketStatusUsed, validated.Status)
+}
+
+func TestTicketService_DynamicQRForgedOrRegenerated(t *testing.T) {
+	svc, tickets, _ := newTicketFixture()
+	ctx := context.Background()
+	svc.eventRepo.(*stubEventRepo).event.DynamicQR = true
+
+	qr, err := svc.GetDynamicQR(ctx, "owner", "ticket-1")
+	require.NoError(t, err)
+
+	_, err = svc.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: "DTICKET|ticket-1|event-1|00000000"})
+	assert.ErrorIs(t, err, ErrTicketInvalid)
+
+	// Regenerating the QR replaces the secret, earlier codes stop validating
+	_, err = svc.RegenerateQR(ctx, "owner", "ticket-1")
+	require.NoError(t, err)
+	assert.Nil(t, tickets.tickets["ticket-1"].QRSecret)
+
+	_, err = svc.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: qr.QRData})
+	assert.ErrorIs(t, err, ErrTicketInvalid)
+}
+
+func TestTicketService_GetDynamicQRChecks(t *testing.T) {
+	svc, _, _ := newTicketFixture()
+	ctx := context.Background()
+
+	_, err := svc.GetDynamicQR(ctx, "owner", "ticket-1")
+	assert.ErrorIs(t, err, ErrDynamicQRDisabled)
+
+	svc.eventRepo.(*stubEventRepo).event.DynamicQR = true
+
+	_, err = svc.GetDynamicQR(ctx, "someone-else", "ticket-1")
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	_, err = svc.GetDynamicQR(ctx, "owner", "ticket-used")
+	assert.ErrorIs(t, err, ErrTicketInvalid)
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+)
+
+// replicationConsumer names this service's checkpoint in the change feed
+const replicationConsumer = "ticketing-service"
+
+// EventChangeSource defines the event service calls used to replicate events
+type EventChangeSource interface {
+	ListChanges(ctx context.Context, afterSeq int64, limit int) ([]client.EventChange, error)
+	GetEvent(ctx context.Context, id string) (*entity.Event, error)
+	ListTiersByEvent(ctx context.Context, eventID string) ([]entity.TicketTier, error)
+}
+
+// EventReplicationService keeps the local event replica in sync with event service
+type EventReplicationService interface {
+	Sync(ctx context.Context) (int, error)
+}
+
+// eventReplicationService implements EventReplicationService interface
+type eventReplicationService struct {
+	source      EventChangeSource
+	replicaRepo repository.EventReplicaRepository
+	batchSize   int
+}
+
+// NewEventReplicationService creates new event replication service instance
+func NewEventReplicationService(source EventChangeSource, replicaRepo repository.EventReplicaRepository, batchSize int) EventReplicationService {
+	return &eventReplicationService{
+		source:      source,
+		replicaRepo: replicaRepo,
+		batchSize:   batchSize,
+	}
+}
+
+// Sync applies all pending changes from the feed and returns the number of events replicated
+// Each changed event is re-read as a whole, so applying a change twice is harmless; the
+// checkpoint only moves after a batch is fully applied, a failed batch is retried next run
+func (s *eventReplicationService) Sync(ctx context.Context) (int, error) {
+	afterSeq, err := s.replicaRepo.GetCheckpoint(ctx, replicationConsumer)
+	if err != nil {
+		return 0, err
+	}
+
+	replicated := 0
+	for {
+		changes, err := s.source.ListChanges(ctx, afterSeq, s.batchSize)
+		if err != nil {
+			return replicated, err
+		}
+		if len(changes) == 0 {
+			return replicated, nil
+		}
+
+		// An event edited several times in the batch is replicated once
+		applied := make(map[string]bool, len(changes))
+		for _, change := range changes {
+			if applied[change.EventID] {
+				continue
+			}
+			if err := s.replicate(ctx, change.EventID); err != nil {
+				return replicated, err
+			}
+			applied[change.EventID] = true
+			replicated++
+		}
+
+		afterSeq = changes[len(changes)-1].Seq
+		if err := s.replicaRepo.SaveCheckpoint(ctx, replicationConsumer, afterSeq); err != nil {
+			return replicated, err
+		}
+
+		if len(changes) < s.batchSize {
+			return replicated, nil
+		}
+	}
+}
+
+// replicate copies the current state of an event and its tiers, or drops a deleted event
+func (s *eventReplicationService) replicate(ctx context.Context, eventID string) error {
+	event, err := s.source.GetEvent(ctx, eventID)
+	if errors.Is(err, repository.ErrEventNotFound) {
+		return s.replicaRepo.Delete(ctx, eventID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to replicate event %s: %w", eventID, err)
+	}
+
+	tiers, err := s.source.ListTiersByEvent(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to replicate tiers of event %s: %w", eventID, err)
+	}
+
+	return s.replicaRepo.Replace(ctx, event, tiers)
+}
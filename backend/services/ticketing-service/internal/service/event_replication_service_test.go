@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubChangeSource serves a fixed change feed and the current events
+type stubChangeSource struct {
+	changes   []client.EventChange
+	events    map[string]*entity.Event
+	failEvent string
+}
+
+func (s *stubChangeSource) ListChanges(ctx context.Context, afterSeq int64, limit int) ([]client.EventChange, error) {
+	result := []client.EventChange{}
+	for _, change := range s.changes {
+		if change.Seq > afterSeq && len(result) < limit {
+			result = append(result, change)
+		}
+	}
+	return result, nil
+}
+
+func (s *stubChangeSource) GetEvent(ctx context.Context, id string) (*entity.Event, error) {
+	if id == s.failEvent {
+		return nil, errors.New("event service unavailable")
+	}
+	event, ok := s.events[id]
+	if !ok {
+		return nil, repository.ErrEventNotFound
+	}
+	return event, nil
+}
+
+func (s *stubChangeSource) ListTiersByEvent(ctx context.Context, eventID string) ([]entity.TicketTier, error) {
+	return []entity.TicketTier{{ID: "tier-" + eventID, EventID: eventID, Name: "GA"}}, nil
+}
+
+// memoryReplicaRepo keeps the replica in memory
+type memoryReplicaRepo struct {
+	repository.EventReplicaRepository
+	events     map[string]*entity.Event
+	tiers      map[string][]entity.TicketTier
+	checkpoint int64
+}
+
+func newMemoryReplicaRepo() *memoryReplicaRepo {
+	return &memoryReplicaRepo{events: map[string]*entity.Event{}, tiers: map[string][]entity.TicketTier{}}
+}
+
+func (r *memoryReplicaRepo) Replace(ctx context.Context, event *entity.Event, tiers []entity.TicketTier) error {
+	r.events[event.ID] = event
+	r.tiers[event.ID] = tiers
+	return nil
+}
+
+func (r *memoryReplicaRepo) Delete(ctx context.Context, eventID string) error {
+	delete(r.events, eventID)
+	delete(r.tiers, eventID)
+	return nil
+}
+
+func (r *memoryReplicaRepo) GetCheckpoint(ctx context.Context, consumer string) (int64, error) {
+	return r.checkpoint, nil
+}
+
+func (r *memoryReplicaRepo) SaveCheckpoint(ctx context.Context, consumer string, seq int64) error {
+	r.checkpoint = seq
+	return nil
+}
+
+func TestEventReplication_Sync(t *testing.T) {
+	ctx := context.Background()
+	source := &stubChangeSource{
+		changes: []client.EventChange{
+			{Seq: 1, EventID: "event-1"},
+			{Seq: 2, EventID: "event-2"},
+			{Seq: 3, EventID: "event-1"},
+			{Seq: 4, EventID: "event-3"},
+		},
+		events: map[string]*entity.Event{
+			"event-1": {ID: "event-1", Name: "Jazz Night"},
+			"event-3": {ID: "event-3", Name: "Rock Fest"},
+		},
+	}
+	replica := newMemoryReplicaRepo()
+	replica.events["event-2"] = &entity.Event{ID: "event-2", Name: "Deleted"}
+	svc := NewEventReplicationService(source, replica, 3)
+
+	replicated, err := svc.Sync(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, replicated, "event-1 is replicated once per batch")
+	assert.EqualValues(t, 4, replica.checkpoint)
+	assert.Equal(t, "Jazz Night", replica.events["event-1"].Name)
+	assert.Equal(t, "Rock Fest", replica.events["event-3"].Name)
+	assert.NotContains(t, replica.events, "event-2", "deleted events are dropped from the replica")
+	assert.Len(t, replica.tiers["event-1"], 1)
+
+	// Nothing new to apply
+	replicated, err = svc.Sync(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, replicated)
+}
+
+func TestEventReplication_FailedBatchIsRetried(t *testing.T) {
+	ctx := context.Background()
+	source := &stubChangeSource{
+		changes:   []client.EventChange{{Seq: 1, EventID: "event-1"}, {Seq: 2, EventID: "event-2"}},
+		events:    map[string]*entity.Event{"event-1": {ID: "event-1"}, "event-2": {ID: "event-2"}},
+		failEvent: "event-2",
+	}
+	replica := newMemoryReplicaRepo()
+	svc := NewEventReplicationService(source, replica, 10)
+
+	_, err := svc.Sync(ctx)
+	require.Error(t, err)
+	assert.Zero(t, replica.checkpoint, "checkpoint must not move past an unapplied change")
+
+	source.failEvent = ""
+	_, err = svc.Sync(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, replica.checkpoint)
+	assert.Contains(t, replica.events, "event-2")
+}
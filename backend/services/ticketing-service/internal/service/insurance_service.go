@@ -0,0 +1,318 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+)
+
+var (
+	ErrInsuranceUnavailable     = errors.New("ticket insurance is not available")
+	ErrInsuranceCoverageChanged = errors.New("ticket prices changed since the insurance was quoted")
+	ErrInsuranceNotFound        = errors.New("order has no ticket insurance")
+	ErrInsuranceNotActive       = errors.New("ticket insurance is not active")
+	ErrInsuranceClaimNotAllowed = errors.New("order can no longer be claimed")
+	ErrInsuranceClaimRejected   = errors.New("insurance claim rejected by the partner")
+)
+
+const (
+	insuranceLineRef          = "insurance"         // Pricing line reference of the premium
+	insuranceLineName         = "Refund Protection" // Invoice line name of the premium
+	insuranceTicketVoidReason = "insurance_claim"   // Void reason of the tickets of a claimed order
+	insuranceIssueBatchSize   = 100                 // Policies issued per worker run
+)
+
+// InsuranceProvider is the refund-protection insurance partner
+// Implemented by client.InsuranceClient; stubbed in tests
+type InsuranceProvider interface {
+	Quote(ctx context.Context, req *client.InsuranceQuoteRequest) (*client.InsuranceQuote, error)
+	IssuePolicy(ctx context.Context, req *client.IssuePolicyRequest) (*client.IssuedPolicy, error)
+	FileClaim(ctx context.Context, req *client.InsuranceClaimRequest) (*client.InsuranceClaim, error)
+}
+
+// InsuranceService handles refund-protection insurance bought with orders
+type InsuranceService interface {
+	// Checkout, used by ReservationService
+	Quote(ctx context.Context, tenantID string, event *entity.Event, coverage money.Money, ticketCount int) (*entity.InsurancePolicy, error)
+	CreatePolicyWithTx(ctx context.Context, tx *sql.Tx, policy *entity.InsurancePolicy) error
+
+	// Buyer operations
+	GetOrderPolicy(ctx context.Context, userID, orderID string) (*response.InsurancePolicyResponse, error)
+	FileClaim(ctx context.Context, userID, orderID string, req *request.InsuranceClaimRequest) (*response.InsurancePolicyResponse, error)
+
+	// IssuePaidPolicies issues the quoted policies of paid orders, run by the insurance worker
+	IssuePaidPolicies(ctx context.Context) (int, error)
+}
+
+// insuranceService implements InsuranceService interface
+type insuranceService struct {
+	policyRepo   repository.InsurancePolicyRepository
+	orderRepo    repository.OrderRepository
+	ticketRepo   repository.TicketRepository
+	refundRepo   repository.OrderRefundRepository
+	provider     InsuranceProvider
+	providerName string
+	currency     string
+}
+
+// NewInsuranceService creates new insurance service instance
+func NewInsuranceService(
+	policyRepo repository.InsurancePolicyRepository,
+	orderRepo repository.OrderRepository,
+	ticketRepo repository.TicketRepository,
+	refundRepo repository.OrderRefundRepository,
+	provider InsuranceProvider,
+	providerName string,
+	currency string,
+) InsuranceService {
+	return &insuranceService{
+		policyRepo:   policyRepo,
+		orderRepo:    orderRepo,
+		ticketRepo:   ticketRepo,
+		refundRepo:   refundRepo,
+		provider:     provider,
+		providerName: providerName,
+		currency:     currency,
+	}
+}
+
+// Quote requests the premium of insuring coverage and returns the unsaved policy
+// Partner errors are reported as ErrInsuranceUnavailable, the buyer can order without insurance
+func (s *insuranceService) Quote(ctx context.Context, tenantID string, event *entity.Event, coverage money.Money, ticketCount int) (*entity.InsurancePolicy, error) {
+	quote, err := s.provider.Quote(ctx, &client.InsuranceQuoteRequest{
+		EventID:     event.ID,
+		EventStart:  event.StartDate,
+		TicketCount: ticketCount,
+		Coverage:    coverage,
+		Currency:    s.currency,
+	})
+	if err != nil {
+		log.Printf("[InsuranceService] Failed to quote insurance for event %s: %v", event.ID, err)
+		return nil, ErrInsuranceUnavailable
+	}
+
+	// A quote for another amount or currency can't be charged as is
+	if quote.Coverage != coverage || quote.Currency != s.currency || quote.Premium.IsNegative() {
+		log.Printf("[InsuranceService] Unexpected insurance quote %s for event %s: coverage %s %s, premium %s",
+			quote.QuoteID, event.ID, quote.Coverage, quote.Currency, quote.Premium)
+		return nil, ErrInsuranceUnavailable
+	}
+
+	return &entity.InsurancePolicy{
+		TenantID: tenantID,
+		Provider: s.providerName,
+		QuoteID:  quote.QuoteID,
+		Premium:  quote.Premium,
+		Coverage: quote.Coverage,
+		Currency: quote.Currency,
+		Status:   entity.InsuranceStatusQuoted,
+	}, nil
+}
+
+// CreatePolicyWithTx stores the quoted policy of an order being reserved
+func (s *insuranceService) CreatePolicyWithTx(ctx context.Context, tx *sql.Tx, policy *entity.InsurancePolicy) error {
+	return s.policyRepo.CreateWithTx(ctx, tx, policy)
+}
+
+// GetOrderPolicy retrieves the insurance policy of the buyer's order
+func (s *insuranceService) GetOrderPolicy(ctx context.Context, userID, orderID string) (*response.InsurancePolicyResponse, error) {
+	if _, err := s.getOwnedOrder(ctx, userID, orderID); err != nil {
+		return nil, err
+	}
+
+	policy, err := s.getPolicy(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.ToInsurancePolicyResponse(policy), nil
+}
+
+// FileClaim files a claim on the buyer's insured order with the partner
+// The coverage is flagged for refund through the manual refund queue and the order's unused
+// tickets are voided, so a claimed order can't be used at the entrance anymore
+func (s *insuranceService) FileClaim(ctx context.Context, userID, orderID string, req *request.InsuranceClaimRequest) (*response.InsurancePolicyResponse, error) {
+	order, err := s.getOwnedOrder(ctx, userID, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := s.getPolicy(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.IsActive() {
+		return nil, ErrInsuranceNotActive
+	}
+
+	// Orders of finished events (completed) aren't covered, nor orders with a used ticket
+	if order.Status != entity.OrderStatusPaid || order.PaymentID == nil {
+		return nil, ErrInsuranceClaimNotAllowed
+	}
+
+	tickets, err := s.ticketRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order tickets: %w", err)
+	}
+	for _, ticket := range tickets {
+		if ticket.IsUsed() || ticket.UsedAt != nil {
+			return nil, ErrInsuranceClaimNotAllowed
+		}
+	}
+
+	// Orders the buyer already refunded under the event's refund policy aren't covered anymore
+	if _, err := s.refundRepo.GetByPaymentID(ctx, *order.PaymentID); err == nil {
+		return nil, ErrInsuranceClaimNotAllowed
+	} else if !errors.Is(err, repository.ErrRefundRequestNotFound) {
+		return nil, fmt.Errorf("failed to get refund request: %w", err)
+	}
+
+	claim, err := s.provider.FileClaim(ctx, &client.InsuranceClaimRequest{
+		PolicyNumber: *policy.PolicyNumber,
+		Reference:    order.ID,
+		Amount:       policy.Coverage,
+		Reason:       req.Reason,
+	})
+	if err != nil {
+		if errors.Is(err, client.ErrInsuranceRejected) {
+			return nil, ErrInsuranceClaimRejected
+		}
+		return nil, fmt.Errorf("failed to file insurance claim: %w", err)
+	}
+
+	if err := s.policyRepo.MarkClaimed(ctx, policy.ID, claim.ClaimID, req.Reason); err != nil {
+		if errors.Is(err, repository.ErrInsurancePolicyNotActive) {
+			return nil, ErrInsuranceNotActive
+		}
+		return nil, err
+	}
+
+	s.flagForRefund(ctx, order, policy.Coverage, entity.RefundReasonInsuranceClaim)
+
+	for _, ticket := range tickets {
+		if !ticket.CanBeUsed() {
+			continue
+		}
+		if err := s.ticketRepo.Void(ctx, ticket.ID, insuranceTicketVoidReason, userID); err != nil {
+			log.Printf("[InsuranceService] Failed to void ticket %s of claimed order %s: %v", ticket.ID, order.ID, err)
+		}
+	}
+
+	claimed, err := s.getPolicy(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[InsuranceService] Claim %s filed for order %s", claim.ClaimID, order.ID)
+	return response.ToInsurancePolicyResponse(claimed), nil
+}
+
+// IssuePaidPolicies issues the quoted policies of paid orders and returns how many were issued
+// Policies the partner refuses are marked failed and their premium is flagged for refund;
+// other partner errors leave the policy quoted for the next run
+func (s *insuranceService) IssuePaidPolicies(ctx context.Context) (int, error) {
+	policies, err := s.policyRepo.ListIssuable(ctx, insuranceIssueBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	issued := 0
+	for i := range policies {
+		policy := &policies[i]
+
+		result, err := s.provider.IssuePolicy(ctx, &client.IssuePolicyRequest{
+			QuoteID:   policy.QuoteID,
+			Reference: policy.OrderID,
+		})
+		if err != nil {
+			if !errors.Is(err, client.ErrInsuranceRejected) {
+				log.Printf("[InsuranceService] Failed to issue policy of order %s, will retry: %v", policy.OrderID, err)
+				continue
+			}
+
+			log.Printf("[InsuranceService] Partner refused policy of order %s: %v", policy.OrderID, err)
+			if err := s.policyRepo.MarkFailed(ctx, policy.ID); err != nil {
+				return issued, err
+			}
+			if order, err := s.orderRepo.GetByID(ctx, policy.OrderID); err != nil {
+				log.Printf("[InsuranceService] Failed to get order %s to refund its premium: %v", policy.OrderID, err)
+			} else {
+				s.flagForRefund(ctx, order, policy.Premium, entity.RefundReasonInsuranceFailed)
+			}
+			continue
+		}
+
+		if err := s.policyRepo.MarkIssued(ctx, policy.ID, result.PolicyNumber, result.IssuedAt); err != nil {
+			return issued, err
+		}
+		issued++
+	}
+
+	return issued, nil
+}
+
+// flagForRefund adds amount of the order's payment to the manual refund queue
+// Failures are logged: the partner already recorded the claim or refusal, support can find it on the order
+func (s *insuranceService) flagForRefund(ctx context.Context, order *entity.Order, amount money.Money, reason string) {
+	if order.PaymentID == nil {
+		log.Printf("[InsuranceService] Order %s has no payment to refund %s from", order.ID, reason)
+		return
+	}
+
+	refund := &entity.OrderRefundRequest{
+		OrderID:       order.ID,
+		TenantID:      order.TenantID,
+		PaymentID:     *order.PaymentID,
+		PaymentMethod: order.PaymentMethod,
+		Amount:        amount,
+		Currency:      order.PaidCurrencyOr(s.currency),
+		Reason:        reason,
+	}
+
+	created, err := s.refundRepo.Create(ctx, refund)
+	if err != nil {
+		log.Printf("[InsuranceService] Failed to flag order %s for %s refund: %v", order.ID, reason, err)
+		return
+	}
+	if !created {
+		log.Printf("[InsuranceService] Payment %s of order %s is already flagged for refund", *order.PaymentID, order.ID)
+	}
+}
+
+// getOwnedOrder retrieves an order of the buyer
+func (s *insuranceService) getOwnedOrder(ctx context.Context, userID, orderID string) (*entity.Order, error) {
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, repository.ErrOrderNotFound) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	if order.UserID != userID {
+		return nil, ErrUnauthorized
+	}
+
+	return order, nil
+}
+
+// getPolicy retrieves the insurance policy of an order
+func (s *insuranceService) getPolicy(ctx context.Context, orderID string) (*entity.InsurancePolicy, error) {
+	policy, err := s.policyRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, repository.ErrInsurancePolicyNotFound) {
+			return nil, ErrInsuranceNotFound
+		}
+		return nil, err
+	}
+	return policy, nil
+}
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/pricing"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (r *stubTicketRepo) GetByOrderID(ctx context.Context, orderID string) ([]entity.Ticket, error) {
+	tickets := []entity.Ticket{}
+	for _, ticket := range r.tickets {
+		if ticket.OrderID == orderID {
+			tickets = append(tickets, *ticket)
+		}
+	}
+	sort.Slice(tickets, func(i, j int) bool { return tickets[i].ID < tickets[j].ID })
+	return tickets, nil
+}
+
+// stubInsuranceProvider answers like the partner API; err fails every call
+type stubInsuranceProvider struct {
+	premium money.Money
+	err     error
+	claims  []client.InsuranceClaimRequest
+}
+
+func (p *stubInsuranceProvider) Quote(ctx context.Context, req *client.InsuranceQuoteRequest) (*client.InsuranceQuote, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &client.InsuranceQuote{QuoteID: "quote-1", Premium: p.premium, Coverage: req.Coverage, Currency: req.Currency}, nil
+}
+
+func (p *stubInsuranceProvider) IssuePolicy(ctx context.Context, req *client.IssuePolicyRequest) (*client.IssuedPolicy, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &client.IssuedPolicy{PolicyNumber: "POL-" + req.Reference, IssuedAt: time.Now()}, nil
+}
+
+func (p *stubInsuranceProvider) FileClaim(ctx context.Context, req *client.InsuranceClaimRequest) (*client.InsuranceClaim, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	p.claims = append(p.claims, *req)
+	return &client.InsuranceClaim{ClaimID: "claim-1", Status: "submitted"}, nil
+}
+
+// stubInsurancePolicyRepo keeps policies in memory by order ID
+type stubInsurancePolicyRepo struct {
+	repository.InsurancePolicyRepository
+	policies map[string]*entity.InsurancePolicy
+}
+
+func (r *stubInsurancePolicyRepo) GetByOrderID(ctx context.Context, orderID string) (*entity.InsurancePolicy, error) {
+	policy, ok := r.policies[orderID]
+	if !ok {
+		return nil, repository.ErrInsurancePolicyNotFound
+	}
+	copied := *policy
+	return &copied, nil
+}
+
+func (r *stubInsurancePolicyRepo) ListIssuable(ctx context.Context, limit int) ([]entity.InsurancePolicy, error) {
+	policies := []entity.InsurancePolicy{}
+	for _, policy := range r.policies {
+		if policy.Status == entity.InsuranceStatusQuoted {
+			policies = append(policies, *policy)
+		}
+	}
+	return policies, nil
+}
+
+func (r *stubInsurancePolicyRepo) MarkIssued(ctx context.Context, id, policyNumber string, issuedAt time.Time) error {
+	policy := r.byID(id)
+	policy.Status = entity.InsuranceStatusActive
+	policy.PolicyNumber = &policyNumber
+	policy.IssuedAt = &issuedAt
+	return nil
+}
+
+func (r *stubInsurancePolicyRepo) MarkFailed(ctx context.Context, id string) error {
+	r.byID(id).Status = entity.InsuranceStatusFailed
+	return nil
+}
+
+func (r *stubInsurancePolicyRepo) MarkClaimed(ctx context.Context, id, claimID, reason string) error {
+	policy := r.byID(id)
+	if policy.Status != entity.InsuranceStatusActive {
+		return repository.ErrInsurancePolicyNotActive
+	}
+	policy.Status = entity.InsuranceStatusClaimed
+	policy.ClaimID = &claimID
+	policy.ClaimReason = &reason
+	return nil
+}
+
+func (r *stubInsurancePolicyRepo) byID(id string) *entity.InsurancePolicy {
+	for _, policy := range r.policies {
+		if policy.ID == id {
+			return policy
+		}
+	}
+	return nil
+}
+
+func TestInsuranceService_Quote(t *testing.T) {
+	event := &entity.Event{ID: "event-1", StartDate: time.Now().Add(72 * time.Hour)}
+
+	t.Run("quotes coverage", func(t *testing.T) {
+		svc := NewInsuranceService(nil, nil, nil, nil, &stubInsuranceProvider{premium: money.New(15000)}, "protectco", "IDR")
+
+		policy, err := svc.Quote(context.Background(), tenant.DefaultID, event, money.New(300000), 2)
+		require.NoError(t, err)
+		assert.Equal(t, money.New(15000), policy.Premium)
+		assert.Equal(t, money.New(300000), policy.Coverage)
+		assert.Equal(t, "protectco", policy.Provider)
+		assert.Equal(t, entity.InsuranceStatusQuoted, policy.Status)
+	})
+
+	t.Run("partner down", func(t *testing.T) {
+		svc := NewInsuranceService(nil, nil, nil, nil, &stubInsuranceProvider{err: errors.New("connection refused")}, "protectco", "IDR")
+
+		_, err := svc.Quote(context.Background(), tenant.DefaultID, event, money.New(300000), 2)
+		assert.ErrorIs(t, err, ErrInsuranceUnavailable)
+	})
+}
+
+func TestInsuranceService_IssuePaidPolicies(t *testing.T) {
+	paymentID := "pay-1"
+	order := &entity.Order{ID: "order-1", UserID: "user-1", TenantID: tenant.DefaultID, Status: entity.OrderStatusPaid, PaymentID: &paymentID}
+	newPolicyRepo := func() *stubInsurancePolicyRepo {
+		return &stubInsurancePolicyRepo{policies: map[string]*entity.InsurancePolicy{
+			"order-1": {ID: "policy-1", OrderID: "order-1", Premium: money.New(15000), Coverage: money.New(300000), Status: entity.InsuranceStatusQuoted},
+		}}
+	}
+
+	t.Run("issues policy", func(t *testing.T) {
+		policyRepo := newPolicyRepo()
+		svc := NewInsuranceService(policyRepo, &stubOrderRepo{order: order}, nil, nil, &stubInsuranceProvider{}, "protectco", "IDR")
+
+		issued, err := svc.IssuePaidPolicies(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, issued)
+		assert.Equal(t, entity.InsuranceStatusActive, policyRepo.policies["order-1"].Status)
+		assert.Equal(t, "POL-order-1", *policyRepo.policies["order-1"].PolicyNumber)
+	})
+
+	t.Run("refused policy refunds the premium", func(t *testing.T) {
+		policyRepo := newPolicyRepo()
+		refundRepo := &stubRefundRepo{refunds: map[string]*entity.OrderRefundRequest{}}
+		provider := &stubInsuranceProvider{err: client.ErrInsuranceRejected}
+		svc := NewInsuranceService(policyRepo, &stubOrderRepo{order: order}, nil, refundRepo, provider, "protectco", "IDR")
+
+		issued, err := svc.IssuePaidPolicies(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, issued)
+		assert.Equal(t, entity.InsuranceStatusFailed, policyRepo.policies["order-1"].Status)
+		require.Contains(t, refundRepo.refunds, "pay-1")
+		assert.Equal(t, money.New(15000), refundRepo.refunds["pay-1"].Amount)
+		assert.Equal(t, entity.RefundReasonInsuranceFailed, refundRepo.refunds["pay-1"].Reason)
+	})
+
+	t.Run("partner down retries next run", func(t *testing.T) {
+		policyRepo := newPolicyRepo()
+		svc := NewInsuranceService(policyRepo, &stubOrderRepo{order: order}, nil, nil, &stubInsuranceProvider{err: errors.New("502 Bad Gateway")}, "protectco", "IDR")
+
+		issued, err := svc.IssuePaidPolicies(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, issued)
+		assert.Equal(t, entity.InsuranceStatusQuoted, policyRepo.policies["order-1"].Status)
+	})
+}
+
+func TestInsuranceService_FileClaim(t *testing.T) {
+	paymentID := "pay-1"
+	policyNumber := "POL-order-1"
+	req := &request.InsuranceClaimRequest{Reason: "Sick on the event day"}
+
+	type fixture struct {
+		svc        InsuranceService
+		policyRepo *stubInsurancePolicyRepo
+		ticketRepo *stubTicketRepo
+		refundRepo *stubRefundRepo
+		provider   *stubInsuranceProvider
+	}
+	newFixture := func(orderStatus, policyStatus, secondTicketStatus string) *fixture {
+		f := &fixture{
+			policyRepo: &stubInsurancePolicyRepo{policies: map[string]*entity.InsurancePolicy{
+				"order-1": {ID: "policy-1", OrderID: "order-1", PolicyNumber: &policyNumber, Coverage: money.New(300000), Status: policyStatus},
+			}},
+			ticketRepo: &stubTicketRepo{tickets: map[string]*entity.Ticket{
+				"ticket-1": {ID: "ticket-1", OrderID: "order-1", Status: entity.TicketStatusValid},
+				"ticket-2": {ID: "ticket-2", OrderID: "order-1", Status: secondTicketStatus},
+			}},
+			refundRepo: &stubRefundRepo{refunds: map[string]*entity.OrderRefundRequest{}},
+			provider:   &stubInsuranceProvider{},
+		}
+		order := &entity.Order{ID: "order-1", UserID: "user-1", TenantID: tenant.DefaultID, Status: orderStatus, PaymentID: &paymentID}
+		f.svc = NewInsuranceService(f.policyRepo, &stubOrderRepo{order: order}, f.ticketRepo, f.refundRepo, f.provider, "protectco", "IDR")
+		return f
+	}
+
+	t.Run("claim refunds coverage and voids tickets", func(t *testing.T) {
+		f := newFixture(entity.OrderStatusPaid, entity.InsuranceStatusActive, entity.TicketStatusValid)
+
+		policy, err := f.svc.FileClaim(context.Background(), "user-1", "order-1", req)
+		require.NoError(t, err)
+		assert.Equal(t, entity.InsuranceStatusClaimed, policy.Status)
+		assert.Equal(t, "claim-1", *policy.ClaimID)
+
+		require.Len(t, f.provider.claims, 1)
+		assert.Equal(t, money.New(300000), f.provider.claims[0].Amount)
+
+		require.Contains(t, f.refundRepo.refunds, "pay-1")
+		assert.Equal(t, money.New(300000), f.refundRepo.refunds["pay-1"].Amount)
+		assert.Equal(t, entity.RefundReasonInsuranceClaim, f.refundRepo.refunds["pay-1"].Reason)
+
+		for _, ticket := range f.ticketRepo.tickets {
+			assert.Equal(t, entity.TicketStatusVoid, ticket.Status)
+		}
+	})
+
+	t.Run("other buyer's order", func(t *testing.T) {
+		f := newFixture(entity.OrderStatusPaid, entity.InsuranceStatusActive, entity.TicketStatusValid)
+
+		_, err := f.svc.FileClaim(context.Background(), "user-2", "order-1", req)
+		assert.ErrorIs(t, err, ErrUnauthorized)
+	})
+
+	t.Run("policy not issued yet", func(t *testing.T) {
+		f := newFixture(entity.OrderStatusPaid, entity.InsuranceStatusQuoted, entity.TicketStatusValid)
+
+		_, err := f.svc.FileClaim(context.Background(), "user-1", "order-1", req)
+		assert.ErrorIs(t, err, ErrInsuranceNotActive)
+	})
+
+	t.Run("ticket already used", func(t *testing.T) {
+		f := newFixture(entity.OrderStatusPaid, entity.InsuranceStatusActive, entity.TicketStatusUsed)
+
+		_, err := f.svc.FileClaim(context.Background(), "user-1", "order-1", req)
+		assert.ErrorIs(t, err, ErrInsuranceClaimNotAllowed)
+		assert.Empty(t, f.provider.claims)
+	})
+
+	t.Run("event finished", func(t *testing.T) {
+		f := newFixture(entity.OrderStatusCompleted, entity.InsuranceStatusActive, entity.TicketStatusValid)
+
+		_, err := f.svc.FileClaim(context.Background(), "user-1", "order-1", req)
+		assert.ErrorIs(t, err, ErrInsuranceClaimNotAllowed)
+	})
+
+	t.Run("partner rejects claim", func(t *testing.T) {
+		f := newFixture(entity.OrderStatusPaid, entity.InsuranceStatusActive, entity.TicketStatusValid)
+		f.provider.err = client.ErrInsuranceRejected
+
+		_, err := f.svc.FileClaim(context.Background(), "user-1", "order-1", req)
+		assert.ErrorIs(t, err, ErrInsuranceClaimRejected)
+		assert.Empty(t, f.refundRepo.refunds)
+		assert.Equal(t, entity.InsuranceStatusActive, f.policyRepo.policies["order-1"].Status)
+	})
+}
+
+func TestLinesCoverage(t *testing.T) {
+	lines := []pricing.Line{
+		{Ref: "tier-1", UnitPrice: money.New(100000), Quantity: 2},
+		{Ref: "tier-2", UnitPrice: money.New(50000), Quantity: 1},
+		insuranceLine(&entity.InsurancePolicy{Premium: money.New(12500)}),
+	}
+
+	coverage, tickets := linesCoverage(lines)
+	assert.Equal(t, money.New(250000), coverage)
+	assert.Equal(t, 3, tickets)
+}
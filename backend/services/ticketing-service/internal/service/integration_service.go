@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/utility"
+)
+
+var (
+	ErrAPIKeyNotFound = errors.New("API key not found")
+	ErrAPIKeyInvalid  = errors.New("API key is invalid or revoked")
+)
+
+// Partner is a request authenticated by a partner API key, acting as the key's organizer
+type Partner struct {
+	Key       *entity.PartnerAPIKey
+	Organizer *entity.User
+}
+
+// IntegrationService handles partner API keys and the endpoints automation platforms
+// (Zapier, Make) call with them: polling triggers over the organizer's events and actions
+type IntegrationService interface {
+	CreateAPIKey(ctx context.Context, userID, role string, req *request.CreateAPIKeyRequest) (*response.APIKeyCreatedResponse, error)
+	ListAPIKeys(ctx context.Context, userID string) ([]response.APIKeyResponse, error)
+	RevokeAPIKey(ctx context.Context, userID, role, keyID string) (*response.APIKeyResponse, error)
+
+	Authenticate(ctx context.Context, apiKey string) (*Partner, error)
+	GetAccount(partner *Partner) *response.IntegrationAccountResponse
+	ListOrders(ctx context.Context, partner *Partner, req *request.IntegrationPollRequest) ([]response.IntegrationOrderResponse, error)
+	ListAttendees(ctx context.Context, partner *Partner, req *request.IntegrationPollRequest) ([]response.IntegrationAttendeeResponse, error)
+	ListCheckIns(ctx context.Context, partner *Partner, req *request.IntegrationPollRequest) ([]response.IntegrationCheckInResponse, error)
+	CheckIn(ctx context.Context, partner *Partner, req *request.IntegrationCheckInRequest) (*response.TicketResponse, error)
+	InviteGuest(ctx context.Context, partner *Partner, req *request.InviteGuestRequest) (*response.InvitationResponse, error)
+}
+
+// integrationService implements IntegrationService interface
+type integrationService struct {
+	keyRepo           repository.PartnerAPIKeyRepository
+	integrationRepo   repository.IntegrationRepository
+	eventRepo         repository.EventRepository
+	userRepo          repository.UserRepository
+	ticketService     TicketService
+	invitationService InvitationService
+	keySecret         string
+}
+
+// NewIntegrationService creates new integration service instance
+// Check-ins go through TicketService and invitations through InvitationService, so partner
+// actions follow the same rules as the organizer's own requests
+func NewIntegrationService(
+	keyRepo repository.PartnerAPIKeyRepository,
+	integrationRepo repository.IntegrationRepository,
+	eventRepo repository.EventRepository,
+	userRepo repository.UserRepository,
+	ticketService TicketService,
+	invitationService InvitationService,
+	keySecret string,
+) IntegrationService {
+	return &integrationService{
+		keyRepo:           keyRepo,
+		integrationRepo:   integrationRepo,
+		eventRepo:         eventRepo,
+		userRepo:          userRepo,
+		ticketService:     ticketService,
+		invitationService: invitationService,
+		keySecret:         keySecret,
+	}
+}
+
+// CreateAPIKey creates a partner API key for the organizer and returns its secret
+func (s *integrationService) CreateAPIKey(ctx context.Context, userID, role string, req *request.CreateAPIKeyRequest) (*response.APIKeyCreatedResponse, error) {
+	if role != entity.UserRoleAdmin && role != entity.UserRoleOrganizer {
+		return nil, ErrUnauthorized
+	}
+
+	key := &entity.PartnerAPIKey{
+		TenantID:    tenantFromContext(ctx),
+		OrganizerID: userID,
+		Name:        req.Name,
+	}
+	if err := s.keyRepo.Create(ctx, key); err != nil {
+		return nil, err
+	}
+
+	return &response.APIKeyCreatedResponse{
+		APIKeyResponse: *response.ToAPIKeyResponse(key),
+		Key:            utility.GeneratePartnerAPIKey(s.keySecret, key.ID),
+	}, nil
+}
+
+// ListAPIKeys lists the organizer's partner API keys
+func (s *integrationService) ListAPIKeys(ctx context.Context, userID string) ([]response.APIKeyResponse, error) {
+	keys, err := s.keyRepo.ListByOrganizerID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	keyResponses := make([]response.APIKeyResponse, len(keys))
+	for i := range keys {
+		keyResponses[i] = *response.ToAPIKeyResponse(&keys[i])
+	}
+
+	return keyResponses, nil
+}
+
+// RevokeAPIKey revokes a partner API key of the organizer (or any key, for admins)
+func (s *integrationService) RevokeAPIKey(ctx context.Context, userID, role, keyID string) (*response.APIKeyResponse, error) {
+	key, err := s.keyRepo.GetByID(ctx, keyID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPartnerAPIKeyNotFound) {
+			return nil, ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+
+	if role != entity.UserRoleAdmin && key.OrganizerID != userID {
+		return nil, ErrAPIKeyNotFound
+	}
+
+	// Revoking twice is a no-op
+	if key.IsActive() {
+		if err := s.keyRepo.Revoke(ctx, key.ID); err != nil && !errors.Is(err, repository.ErrPartnerAPIKeyNotFound) {
+			return nil, err
+		}
+		if key, err = s.keyRepo.GetByID(ctx, key.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return response.ToAPIKeyResponse(key), nil
+}
+
+// Authenticate verifies a partner API key and loads the organizer it acts as
+// Keys of users who are no longer organizers or admins stop working
+func (s *integrationService) Authenticate(ctx context.Context, apiKey string) (*Partner, error) {
+	keyID, err := utility.ParsePartnerAPIKey(s.keySecret, apiKey)
+	if err != nil {
+		return nil, ErrAPIKeyInvalid
+	}
+
+	key, err := s.keyRepo.GetByID(ctx, keyID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPartnerAPIKeyNotFound) {
+			return nil, ErrAPIKeyInvalid
+		}
+		return nil, err
+	}
+	if !key.IsActive() {
+		return nil, ErrAPIKeyInvalid
+	}
+
+	organizer, err := s.userRepo.GetByID(ctx, key.OrganizerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrAPIKeyInvalid
+		}
+		return nil, fmt.Errorf("failed to get organizer: %w", err)
+	}
+	if organizer.Role != entity.UserRoleAdmin && organizer.Role != entity.UserRoleOrganizer {
+		return nil, ErrAPIKeyInvalid
+	}
+
+	// Last used is informational; the request is already authenticated
+	if err := s.keyRepo.TouchLastUsed(ctx, key.ID); err != nil {
+		log.Printf("[IntegrationService] Failed to update last used of API key %s: %v", key.ID, err)
+	}
+
+	return &Partner{Key: key, Organizer: organizer}, nil
+}
+
+// GetAccount identifies the organizer behind the key, used by partners to test the connection
+func (s *integrationService) GetAccount(partner *Partner) *response.IntegrationAccountResponse {
+	return &response.IntegrationAccountResponse{
+		ID:             partner.Key.ID,
+		KeyName:        partner.Key.Name,
+		OrganizerID:    partner.Organizer.ID,
+		OrganizerName:  partner.Organizer.FullName,
+		OrganizerEmail: partner.Organizer.Email,
+	}
+}
+
+// ListOrders lists the orders of the organizer's events updated since the request time
+func (s *integrationService) ListOrders(ctx context.Context, partner *Partner, req *request.IntegrationPollRequest) ([]response.IntegrationOrderResponse, error) {
+	orders, err := s.integrationRepo.ListOrders(ctx, integrationFilter(partner, req))
+	if err != nil {
+		return nil, err
+	}
+
+	orderResponses := make([]response.IntegrationOrderResponse, len(orders))
+	for i := range orders {
+		orderResponses[i] = response.ToIntegrationOrderResponse(&orders[i])
+	}
+
+	return orderResponses, nil
+}
+
+// ListAttendees lists the tickets issued for the organizer's events since the request time
+func (s *integrationService) ListAttendees(ctx context.Context, partner *Partner, req *request.IntegrationPollRequest) ([]response.IntegrationAttendeeResponse, error) {
+	attendees, err := s.integrationRepo.ListAttendees(ctx, integrationFilter(partner, req))
+	if err != nil {
+		return nil, err
+	}
+
+	attendeeResponses := make([]response.IntegrationAttendeeResponse, len(attendees))
+	for i := range attendees {
+		attendeeResponses[i] = response.ToIntegrationAttendeeResponse(&attendees[i])
+	}
+
+	return attendeeResponses, nil
+}
+
+// ListCheckIns lists the entry scans at the organizer's events since the request time
+func (s *integrationService) ListCheckIns(ctx context.Context, partner *Partner, req *request.IntegrationPollRequest) ([]response.IntegrationCheckInResponse, error) {
+	checkIns, err := s.integrationRepo.ListCheckIns(ctx, integrationFilter(partner, req))
+	if err != nil {
+		return nil, err
+	}
+
+	checkInResponses := make([]response.IntegrationCheckInResponse, len(checkIns))
+	for i := range checkIns {
+		checkInResponses[i] = response.ToIntegrationCheckInResponse(&checkIns[i])
+	}
+
+	return checkInResponses, nil
+}
+
+// CheckIn admits the holder of a ticket of one of the organizer's events, like a gate scan
+func (s *integrationService) CheckIn(ctx context.Context, partner *Partner, req *request.IntegrationCheckInRequest) (*response.TicketResponse, error) {
+	claims, err := utility.ParseTicketQRData(req.QRData)
+	if err != nil {
+		return nil, ErrTicketInvalid
+	}
+
+	if _, err := managedEvent(ctx, s.eventRepo, partner.Organizer.ID, partner.Organizer.Role, claims.EventID); err != nil {
+		return nil, err
+	}
+
+	return s.ticketService.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: req.QRData})
+}
+
+// InviteGuest invites one guest to one of the organizer's events
+func (s *integrationService) InviteGuest(ctx context.Context, partner *Partner, req *request.InviteGuestRequest) (*response.InvitationResponse, error) {
+	return s.invitationService.InviteGuest(ctx, partner.Organizer.ID, partner.Organizer.Role, req)
+}
+
+// integrationFilter scopes a polling request to the partner's organizer
+func integrationFilter(partner *Partner, req *request.IntegrationPollRequest) repository.IntegrationFilter {
+	filter := repository.IntegrationFilter{
+		OrganizerID: partner.Organizer.ID,
+		EventID:     req.EventID,
+		Status:      req.Status,
+		Limit:       req.Limit,
+	}
+	if req.UpdatedSince != nil {
+		filter.UpdatedSince = *req.UpdatedSince
+	}
+	if filter.Limit <= 0 || filter.Limit > request.MaxIntegrationLimit {
+		filter.Limit = request.DefaultIntegrationLimit
+	}
+	return filter
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubAPIKeyRepo keeps partner API keys in memory
+type stubAPIKeyRepo struct {
+	repository.PartnerAPIKeyRepository
+	keys map[string]*entity.PartnerAPIKey
+}
+
+func (r *stubAPIKeyRepo) GetByID(ctx context.Context, id string) (*entity.PartnerAPIKey, error) {
+	key, ok := r.keys[id]
+	if !ok {
+		return nil, repository.ErrPartnerAPIKeyNotFound
+	}
+	copied := *key
+	return &copied, nil
+}
+
+func (r *stubAPIKeyRepo) Revoke(ctx context.Context, id string) error {
+	key, ok := r.keys[id]
+	if !ok || !key.IsActive() {
+		return repository.ErrPartnerAPIKeyNotFound
+	}
+	now := time.Now()
+	key.RevokedAt = &now
+	return nil
+}
+
+func (r *stubAPIKeyRepo) TouchLastUsed(ctx context.Context, id string) error {
+	now := time.Now()
+	r.keys[id].LastUsedAt = &now
+	return nil
+}
+
+// stubIntegrationRepo records the filter of the last trigger query
+type stubIntegrationRepo struct {
+	repository.IntegrationRepository
+	filter repository.IntegrationFilter
+}
+
+func (r *stubIntegrationRepo) ListOrders(ctx context.Context, filter repository.IntegrationFilter) ([]entity.IntegrationOrder, error) {
+	r.filter = filter
+	return []entity.IntegrationOrder{{ID: "order-1", EventID: "event-1", Status: entity.OrderStatusPaid, Tickets: 2}}, nil
+}
+
+const partnerKeySecret = "partner-secret"
+
+func newIntegrationFixture() (*integrationService, *stubAPIKeyRepo, *stubUserRepo) {
+	keyRepo := &stubAPIKeyRepo{keys: map[string]*entity.PartnerAPIKey{
+		"key-1": {ID: "key-1", OrganizerID: "organizer-1", Name: "Zapier"},
+	}}
+	userRepo := &stubUserRepo{user: &entity.User{ID: "organizer-1", FullName: "Rina", Email: "rina@example.com", Role: entity.UserRoleOrganizer}}
+	svc := &integrationService{
+		keyRepo:         keyRepo,
+		integrationRepo: &stubIntegrationRepo{},
+		eventRepo: &stubEventRepo{event: &entity.Event{
+			ID:          "event-1",
+			OrganizerID: "organizer-1",
+			Status:      entity.EventStatusPublished,
+		}},
+		userRepo:  userRepo,
+		keySecret: partnerKeySecret,
+	}
+	return svc, keyRepo, userRepo
+}
+
+func TestAuthenticate(t *testing.T) {
+	svc, keyRepo, _ := newIntegrationFixture()
+	apiKey := utility.GeneratePartnerAPIKey(partnerKeySecret, "key-1")
+
+	partner, err := svc.Authenticate(context.Background(), apiKey)
+	require.NoError(t, err)
+	assert.Equal(t, "organizer-1", partner.Organizer.ID)
+	assert.NotNil(t, keyRepo.keys["key-1"].LastUsedAt, "use is recorded")
+
+	account := svc.GetAccount(partner)
+	assert.Equal(t, "key-1", account.ID)
+	assert.Equal(t, "rina@example.com", account.OrganizerEmail)
+}
+
+func TestAuthenticate_Rejected(t *testing.T) {
+	tests := []struct {
+		name   string
+		apiKey string
+		mutate func(keyRepo *stubAPIKeyRepo, userRepo *stubUserRepo)
+	}{
+		{name: "malformed key", apiKey: "not-a-key"},
+		{name: "tampered signature", apiKey: utility.GeneratePartnerAPIKey(partnerKeySecret, "key-1") + "x"},
+		{name: "signed with another secret", apiKey: utility.GeneratePartnerAPIKey("other-secret", "key-1")},
+		{name: "unknown key", apiKey: utility.GeneratePartnerAPIKey(partnerKeySecret, "key-2")},
+		{
+			name:   "revoked key",
+			apiKey: utility.GeneratePartnerAPIKey(partnerKeySecret, "key-1"),
+			mutate: func(keyRepo *stubAPIKeyRepo, userRepo *stubUserRepo) {
+				now := time.Now()
+				keyRepo.keys["key-1"].RevokedAt = &now
+			},
+		},
+		{
+			name:   "organizer demoted to customer",
+			apiKey: utility.GeneratePartnerAPIKey(partnerKeySecret, "key-1"),
+			mutate: func(keyRepo *stubAPIKeyRepo, userRepo *stubUserRepo) {
+				userRepo.user.Role = entity.UserRoleCustomer
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, keyRepo, userRepo := newIntegrationFixture()
+			if tt.mutate != nil {
+				tt.mutate(keyRepo, userRepo)
+			}
+
+			_, err := svc.Authenticate(context.Background(), tt.apiKey)
+			assert.ErrorIs(t, err, ErrAPIKeyInvalid)
+		})
+	}
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	svc, keyRepo, _ := newIntegrationFixture()
+
+	_, err := svc.RevokeAPIKey(context.Background(), "organizer-2", entity.UserRoleOrganizer, "key-1")
+	assert.ErrorIs(t, err, ErrAPIKeyNotFound, "other organizers' keys are hidden")
+	assert.True(t, keyRepo.keys["key-1"].IsActive())
+
+	revoked, err := svc.RevokeAPIKey(context.Background(), "organizer-1", entity.UserRoleOrganizer, "key-1")
+	require.NoError(t, err)
+	assert.False(t, revoked.Active)
+
+	_, err = svc.RevokeAPIKey(context.Background(), "organizer-1", entity.UserRoleOrganizer, "key-1")
+	assert.NoError(t, err, "revoking twice is a no-op")
+}
+
+func TestListOrders_ScopedToOrganizer(t *testing.T) {
+	svc, _, userRepo := newIntegrationFixture()
+	partner := &Partner{Key: &entity.PartnerAPIKey{ID: "key-1"}, Organizer: userRepo.user}
+	since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	orders, err := svc.ListOrders(context.Background(), partner, &request.IntegrationPollRequest{UpdatedSince: &since, Status: entity.OrderStatusPaid})
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	assert.Equal(t, "order-1", orders[0].ID)
+
+	filter := svc.integrationRepo.(*stubIntegrationRepo).filter
+	assert.Equal(t, "organizer-1", filter.OrganizerID)
+	assert.Equal(t, since, filter.UpdatedSince)
+	assert.Equal(t, entity.OrderStatusPaid, filter.Status)
+	assert.Equal(t, request.DefaultIntegrationLimit, filter.Limit)
+
+	_, err = svc.ListOrders(context.Background(), partner, &request.IntegrationPollRequest{Limit: 10})
+	require.NoError(t, err)
+	filter = svc.integrationRepo.(*stubIntegrationRepo).filter
+	assert.True(t, filter.UpdatedSince.IsZero())
+	assert.Equal(t, 10, filter.Limit)
+}
+
+func TestIntegrationCheckIn_OtherOrganizersEvent(t *testing.T) {
+	svc, _, _ := newIntegrationFixture()
+	partner := &Partner{
+		Key:       &entity.PartnerAPIKey{ID: "key-2"},
+		Organizer: &entity.User{ID: "organizer-2", Role: entity.UserRoleOrganizer},
+	}
+
+	_, err := svc.CheckIn(context.Background(), partner, &request.IntegrationCheckInRequest{QRData: "TICKET|ticket-1|event-1|nonce"})
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	_, err = svc.CheckIn(context.Background(), partner, &request.IntegrationCheckInRequest{QRData: "garbage"})
+	assert.ErrorIs(t, err, ErrTicketInvalid)
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+)
+
+// ErrInvalidInventoryRange is returned when an inventory history window is empty or has too many buckets
+var ErrInvalidInventoryRange = errors.New("invalid inventory history range")
+
+// InventoryService reports how a ticket tier's sold_count moved over time
+// Reports are for the organizer of the tier's event or an admin
+type InventoryService interface {
+	GetHistory(ctx context.Context, userID, role, tierID string, req *request.InventoryHistoryRequest) (*response.InventoryHistoryResponse, error)
+}
+
+// inventoryService implements InventoryService interface
+type inventoryService struct {
+	movementRepo   repository.InventoryMovementRepository
+	ticketTierRepo repository.TicketTierRepository
+	eventRepo      repository.EventRepository
+	now            func() time.Time
+}
+
+// NewInventoryService creates new inventory service instance
+func NewInventoryService(
+	movementRepo repository.InventoryMovementRepository,
+	ticketTierRepo repository.TicketTierRepository,
+	eventRepo repository.EventRepository,
+) InventoryService {
+	return &inventoryService{
+		movementRepo:   movementRepo,
+		ticketTierRepo: ticketTierRepo,
+		eventRepo:      eventRepo,
+		now:            time.Now,
+	}
+}
+
+// GetHistory returns the seats sold and released per time bucket of the requested window
+// The window is aligned to UTC bucket boundaries and every bucket is listed, so the series can be charted as is
+func (s *inventoryService) GetHistory(ctx context.Context, userID, role, tierID string, req *request.InventoryHistoryRequest) (*response.InventoryHistoryResponse, error) {
+	tier, err := s.ticketTierRepo.GetByID(ctx, tierID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketTierNotFound) {
+			return nil, ErrTicketTierNotFound
+		}
+		return nil, fmt.Errorf("failed to get ticket tier: %w", err)
+	}
+
+	if _, err := managedEvent(ctx, s.eventRepo, userID, role, tier.EventID); err != nil {
+		return nil, err
+	}
+
+	interval, from, to, err := s.historyWindow(req)
+	if err != nil {
+		return nil, err
+	}
+	step := entity.InventoryIntervals[interval]
+
+	buckets, err := s.movementRepo.GetHistory(ctx, tier.ID, interval, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	byStart := make(map[int64]*entity.InventoryBucket, len(buckets))
+	for i := range buckets {
+		byStart[buckets[i].BucketStart.Unix()] = &buckets[i]
+	}
+
+	history := &response.InventoryHistoryResponse{
+		TicketTierID: tier.ID,
+		EventID:      tier.EventID,
+		TierName:     tier.Name,
+		Quota:        tier.Quota,
+		SoldCount:    tier.SoldCount,
+		Interval:     interval,
+		From:         from,
+		To:           to,
+		Points:       make([]response.InventoryPointResponse, 0, int(to.Sub(from)/step)),
+	}
+	for start := from; start.Before(to); start = start.Add(step) {
+		bucket, ok := byStart[start.Unix()]
+		if !ok {
+			bucket = &entity.InventoryBucket{BucketStart: start}
+		}
+		history.Points = append(history.Points, response.ToInventoryPointResponse(bucket))
+		history.TotalSold += bucket.Sold
+		history.TotalReleased += bucket.Released
+		history.TotalAdjusted += bucket.Adjusted
+	}
+
+	return history, nil
+}
+
+// historyWindow resolves the interval and the bucket-aligned [from, to) window of a history request
+func (s *inventoryService) historyWindow(req *request.InventoryHistoryRequest) (string, time.Time, time.Time, error) {
+	interval := req.Interval
+	if interval == "" {
+		interval = entity.InventoryIntervalHour
+	}
+	step, ok := entity.InventoryIntervals[interval]
+	if !ok {
+		return "", time.Time{}, time.Time{}, ErrInvalidInventoryRange
+	}
+
+	to := s.now()
+	if req.To != nil {
+		to = *req.To
+	}
+	from := to.Add(-request.DefaultInventoryWindow)
+	if req.From != nil {
+		from = *req.From
+	}
+
+	// Truncate aligns to UTC boundaries; to is rounded up so its bucket is included
+	from = from.UTC().Truncate(step)
+	to = to.UTC()
+	if aligned := to.Truncate(step); !aligned.Equal(to) {
+		to = aligned.Add(step)
+	}
+
+	if !from.Before(to) || to.Sub(from)/step > request.MaxInventoryBuckets {
+		return "", time.Time{}, time.Time{}, ErrInvalidInventoryRange
+	}
+
+	return interval, from, to, nil
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubMovementRepo returns fixed buckets and records the queried window
+type stubMovementRepo struct {
+	repository.InventoryMovementRepository
+	buckets  []entity.InventoryBucket
+	interval string
+	from, to time.Time
+}
+
+func (r *stubMovementRepo) GetHistory(ctx context.Context, tierID, interval string, from, to time.Time) ([]entity.InventoryBucket, error) {
+	r.interval, r.from, r.to = interval, from, to
+	return r.buckets, nil
+}
+
+func newInventoryFixture(now time.Time) (*inventoryService, *stubMovementRepo) {
+	movementRepo := &stubMovementRepo{}
+	return &inventoryService{
+		movementRepo: movementRepo,
+		ticketTierRepo: &stubTicketTierRepo{tiers: map[string]*entity.TicketTier{
+			"tier-1": {ID: "tier-1", EventID: "event-1", Name: "Presale", Quota: 100, SoldCount: 40},
+		}},
+		eventRepo: &stubEventRepo{event: &entity.Event{ID: "event-1", OrganizerID: "organizer-1"}},
+		now:       func() time.Time { return now },
+	}, movementRepo
+}
+
+func TestInventoryHistory_FillsBuckets(t *testing.T) {
+	now := time.Date(2026, 10, 15, 10, 30, 0, 0, time.UTC)
+	svc, movementRepo := newInventoryFixture(now)
+	movementRepo.buckets = []entity.InventoryBucket{
+		{BucketStart: time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC), Sold: 50, Released: 5},
+		{BucketStart: time.Date(2026, 10, 15, 10, 0, 0, 0, time.UTC), Sold: 3, Released: 8},
+	}
+	from := time.Date(2026, 10, 15, 8, 15, 0, 0, time.UTC)
+
+	history, err := svc.GetHistory(context.Background(), "organizer-1", entity.UserRoleOrganizer, "tier-1", &request.InventoryHistoryRequest{From: &from})
+	require.NoError(t, err)
+
+	assert.Equal(t, entity.InventoryIntervalHour, movementRepo.interval, "hourly by default")
+	assert.Equal(t, time.Date(2026, 10, 15, 8, 0, 0, 0, time.UTC), movementRepo.from, "from is aligned down")
+	assert.Equal(t, time.Date(2026, 10, 15, 11, 0, 0, 0, time.UTC), movementRepo.to, "the bucket of to is included")
+
+	require.Len(t, history.Points, 3)
+	assert.Equal(t, 0, history.Points[0].Sold, "buckets without movements are listed")
+	assert.Equal(t, 45, history.Points[1].Net)
+	assert.Equal(t, -5, history.Points[2].Net)
+	assert.Equal(t, 53, history.TotalSold)
+	assert.Equal(t, 13, history.TotalReleased)
+	assert.Equal(t, 40, history.SoldCount)
+}
+
+func TestInventoryHistory_Rejected(t *testing.T) {
+	now := time.Date(2026, 10, 15, 10, 30, 0, 0, time.UTC)
+	twoDaysAgo := now.Add(-48 * time.Hour)
+	later := now.Add(time.Hour)
+
+	tests := []struct {
+		name   string
+		userID string
+		tierID string
+		req    request.InventoryHistoryRequest
+		want   error
+	}{
+		{name: "other organizer's tier", userID: "organizer-2", tierID: "tier-1", want: ErrUnauthorized},
+		{name: "from after to", userID: "organizer-1", tierID: "tier-1", req: request.InventoryHistoryRequest{From: &later}, want: ErrInvalidInventoryRange},
+		{
+			name:   "too many buckets",
+			userID: "organizer-1",
+			tierID: "tier-1",
+			req:    request.InventoryHistoryRequest{From: &twoDaysAgo, Interval: entity.InventoryIntervalMinute},
+			want:   ErrInvalidInventoryRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _ := newInventoryFixture(now)
+
+			_, err := svc.GetHistory(context.Background(), tt.userID, entity.UserRoleOrganizer, tt.tierID, &tt.req)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
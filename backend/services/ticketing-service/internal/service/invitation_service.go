@@ -0,0 +1,930 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/mail"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/featureflags"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/pricing"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/utility"
+)
+
+var (
+	ErrInvalidInvitationsFile = errors.New("invalid invitations file")
+	ErrInvalidInvitation      = errors.New("invalid invitation")
+	ErrInvitationsClosed      = errors.New("event no longer accepts invitations")
+	ErrInvalidClaimDeadline   = errors.New("claim deadline must be in the future and before the event ends")
+	ErrInvitationInvalid      = errors.New("invitation link is invalid")
+	ErrInvitationClaimed      = errors.New("invitation has already been claimed")
+	ErrInvitationExpired      = errors.New("invitation has expired")
+)
+
+// invitationPaymentMethod is recorded on the orders of complimentary invitations
+const invitationPaymentMethod = "invitation"
+
+// InvitationSender defines interface for emailing invitation claim links (notification service)
+type InvitationSender interface {
+	SendInvitationEmail(ctx context.Context, req *client.SendInvitationEmailRequest) error
+}
+
+// InvitationService handles invite-only guest lists: CSV imports, claim links and unclaimed releases
+// A pending invitation holds its tickets in the tier's sold_count from import until it is claimed
+// (its order holds them from then on) or released at the claim deadline
+type InvitationService interface {
+	ImportInvitations(ctx context.Context, userID, role string, req *request.ImportInvitationsRequest, body io.Reader) (*response.ImportInvitationsResponse, error)
+	InviteGuest(ctx context.Context, userID, role string, req *request.InviteGuestRequest) (*response.InvitationResponse, error)
+	ListInvitations(ctx context.Context, userID, role, eventID string) (*response.InvitationListResponse, error)
+	GetInvitation(ctx context.Context, token string) (*response.InvitationPreviewResponse, error)
+	ClaimInvitation(ctx context.Context, userID string, req *request.ClaimInvitationRequest) (*response.ClaimInvitationResponse, error)
+	// SendInvitationEmails and ReleaseInvitations handle the import's entity.JobTypeInvitationEmails
+	// and entity.JobTypeInvitationRelease jobs, referencing the import batch
+	SendInvitationEmails(ctx context.Context, job *entity.ScheduledJob) error
+	ReleaseInvitations(ctx context.Context, job *entity.ScheduledJob) error
+	UpdateReservationTimeout(timeout time.Duration)
+}
+
+// invitationService implements InvitationService interface
+type invitationService struct {
+	invitationRepo     repository.EventInvitationRepository
+	orderRepo          repository.OrderRepository
+	orderItemRepo      repository.OrderItemRepository
+	ticketTierRepo     repository.TicketTierRepository
+	eventRepo          repository.EventRepository
+	userRepo           repository.UserRepository
+	tenantRepo         repository.TenantRepository
+	scheduledJobRepo   repository.ScheduledJobRepository
+	txWatchdog         *repository.TxWatchdog // nil leaves transactions without deadline
+	ticketService      TicketService
+	paymentClient      PaymentClient
+	sender             InvitationSender
+	availability       AvailabilityService
+	flags              *featureflags.Client // Selects event-sourced orders, nil creates CRUD orders
+	secret             string
+	baseURL            string
+	reservationTimeout atomic.Int64 // Payment window of discounted claims, swapped on config reload
+	now                func() time.Time
+}
+
+// NewInvitationService creates new invitation service instance
+// Claim links are baseURL + "/" + token, signed with secret
+func NewInvitationService(
+	invitationRepo repository.EventInvitationRepository,
+	orderRepo repository.OrderRepository,
+	orderItemRepo repository.OrderItemRepository,
+	ticketTierRepo repository.TicketTierRepository,
+	eventRepo repository.EventRepository,
+	userRepo repository.UserRepository,
+	tenantRepo repository.TenantRepository,
+	scheduledJobRepo repository.ScheduledJobRepository,
+	txWatchdog *repository.TxWatchdog,
+	ticketService TicketService,
+	paymentClient PaymentClient,
+	sender InvitationSender,
+	availability AvailabilityService,
+	flags *featureflags.Client,
+	secret string,
+	baseURL string,
+	reservationTimeout time.Duration,
+) InvitationService {
+	s := &invitationService{
+		invitationRepo:   invitationRepo,
+		orderRepo:        orderRepo,
+		orderItemRepo:    orderItemRepo,
+		ticketTierRepo:   ticketTierRepo,
+		eventRepo:        eventRepo,
+		userRepo:         userRepo,
+		tenantRepo:       tenantRepo,
+		scheduledJobRepo: scheduledJobRepo,
+		txWatchdog:       txWatchdog,
+		ticketService:    ticketService,
+		paymentClient:    paymentClient,
+		sender:           sender,
+		availability:     availability,
+		flags:            flags,
+		secret:           secret,
+		baseURL:          strings.TrimRight(baseURL, "/"),
+		now:              time.Now,
+	}
+	s.UpdateReservationTimeout(reservationTimeout)
+	return s
+}
+
+// UpdateReservationTimeout swaps the payment window of discounted claims, e.g. on config reload
+func (s *invitationService) UpdateReservationTimeout(timeout time.Duration) {
+	s.reservationTimeout.Store(int64(timeout))
+}
+
+// ImportInvitations creates the invitations of an invitee CSV file and holds their tickets
+// Every row is validated first; invitations are only created when no row has errors, and never
+// on a dry run. Claim link emails are sent by a scheduled job after the import commits.
+func (s *invitationService) ImportInvitations(ctx context.Context, userID, role string, req *request.ImportInvitationsRequest, body io.Reader) (*response.ImportInvitationsResponse, error) {
+	event, err := s.invitableEvent(ctx, userID, role, req.EventID, req.ClaimDeadline)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, rowErrors, err := parseInvitationsCSV(body)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: no invitees", ErrInvalidInvitationsFile)
+	}
+	if len(rows) > request.MaxImportInvitations {
+		return nil, fmt.Errorf("%w: %d invitees, at most %d per import", ErrInvalidInvitationsFile, len(rows), request.MaxImportInvitations)
+	}
+
+	tiers, err := s.ticketTierRepo.GetByEventID(ctx, event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket tiers: %w", err)
+	}
+
+	invitations, validationErrors, err := s.validateInvitations(ctx, event.ID, rows, tiers)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &response.ImportInvitationsResponse{
+		DryRun:        req.DryRun,
+		Invitations:   len(rows),
+		ClaimDeadline: req.ClaimDeadline,
+		Errors:        rowErrors,
+	}
+	for _, row := range rows {
+		result.Tickets += row.quantity
+	}
+
+	// A value that failed to parse is reported once, not again by validation
+	reported := make(map[response.ImportRowError]bool, len(rowErrors))
+	for _, rowError := range rowErrors {
+		reported[response.ImportRowError{Row: rowError.Row, Field: rowError.Field}] = true
+	}
+	for _, validationError := range validationErrors {
+		if !reported[response.ImportRowError{Row: validationError.Row, Field: validationError.Field}] {
+			result.Errors = append(result.Errors, validationError)
+		}
+	}
+	sort.SliceStable(result.Errors, func(i, j int) bool { return result.Errors[i].Row < result.Errors[j].Row })
+
+	if len(result.Errors) > 0 || req.DryRun {
+		return result, nil
+	}
+
+	batchID := uuid.New().String()
+	for i := range invitations {
+		invitations[i].BatchID = batchID
+		invitations[i].EventID = event.ID
+		invitations[i].ClaimDeadline = req.ClaimDeadline
+		invitations[i].CreatedBy = userID
+	}
+	if err := s.createInvitations(ctx, batchID, req.ClaimDeadline, invitations); err != nil {
+		return nil, err
+	}
+
+	result.BatchID = batchID
+	log.Printf("[InvitationService] Imported %d invitations (%d tickets) for event %s, batch %s", len(invitations), result.Tickets, event.ID, batchID)
+
+	return result, nil
+}
+
+// InviteGuest invites one guest, e.g. from a partner integration
+// The guest is validated like a row of an import file and gets an import batch of their own
+func (s *invitationService) InviteGuest(ctx context.Context, userID, role string, req *request.InviteGuestRequest) (*response.InvitationResponse, error) {
+	event, err := s.invitableEvent(ctx, userID, role, req.EventID, req.ClaimDeadline)
+	if err != nil {
+		return nil, err
+	}
+
+	tiers, err := s.ticketTierRepo.GetByEventID(ctx, event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket tiers: %w", err)
+	}
+
+	row := invitationRow{email: req.Email, name: req.Name, tier: req.Tier, quantity: req.Quantity, price: req.Price}
+	if row.quantity == 0 {
+		row.quantity = 1
+	}
+	invitations, rowErrors, err := s.validateInvitations(ctx, event.ID, []invitationRow{row}, tiers)
+	if err != nil {
+		return nil, err
+	}
+	if len(rowErrors) > 0 {
+		return nil, fmt.Errorf("%w: %s %s", ErrInvalidInvitation, rowErrors[0].Field, rowErrors[0].Message)
+	}
+
+	invitation := &invitations[0]
+	invitation.BatchID = uuid.New().String()
+	invitation.EventID = event.ID
+	invitation.ClaimDeadline = req.ClaimDeadline
+	invitation.CreatedBy = userID
+	if err := s.createInvitations(ctx, invitation.BatchID, req.ClaimDeadline, invitations); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[InvitationService] Invited %s (%d tickets) to event %s, batch %s", invitation.ID, invitation.Quantity, event.ID, invitation.BatchID)
+
+	tierName := ""
+	if tier := findInvitationTier(tiers, invitation.TicketTierID); tier != nil {
+		tierName = tier.Name
+	}
+	invitationResponse := response.ToInvitationResponse(invitation, tierName)
+	return &invitationResponse, nil
+}
+
+// invitableEvent returns the event if the user may invite guests to it until deadline
+func (s *invitationService) invitableEvent(ctx context.Context, userID, role, eventID string, deadline time.Time) (*entity.Event, error) {
+	event, err := managedEvent(ctx, s.eventRepo, userID, role, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.IsCancelled() || event.IsCompleted() || event.HasEnded() {
+		return nil, ErrInvitationsClosed
+	}
+	if !deadline.After(s.now()) || deadline.After(event.EndDate) {
+		return nil, ErrInvalidClaimDeadline
+	}
+	return event, nil
+}
+
+// createInvitations holds the tickets of the invitations and inserts them with their email
+// and release jobs, all or nothing
+// Tiers are locked in ID order so concurrent imports can't deadlock on them
+func (s *invitationService) createInvitations(ctx context.Context, batchID string, deadline time.Time, invitations []entity.EventInvitation) (err error) {
+	quantities := map[string]int{}
+	for _, invitation := range invitations {
+		quantities[invitation.TicketTierID] += invitation.Quantity
+	}
+	tierIDs := make([]string, 0, len(quantities))
+	for tierID := range quantities {
+		tierIDs = append(tierIDs, tierID)
+	}
+	sort.Strings(tierIDs)
+
+	// Row locks are held until commit, the watchdog rolls back a stalled transaction
+	txCtx, txDone := s.txWatchdog.Watch(ctx, "import_invitations")
+	defer txDone()
+
+	tx, err := s.orderRepo.BeginTx(txCtx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// Quota was checked without locks during validation, check it again now that it can't change
+	for _, tierID := range tierIDs {
+		tier, err := s.ticketTierRepo.GetByIDWithLock(txCtx, tx, tierID)
+		if err != nil {
+			return fmt.Errorf("failed to get ticket tier: %w", err)
+		}
+		if tier.GetAvailableQuota() < quantities[tierID] {
+			return ErrInsufficientQuota
+		}
+		if err := s.ticketTierRepo.UpdateSoldCount(txCtx, tx, tierID, quantities[tierID], entity.InventoryCause{Reason: entity.InventoryReasonReserve}); err != nil {
+			if errors.Is(err, repository.ErrInsufficientQuota) {
+				return ErrInsufficientQuota
+			}
+			return fmt.Errorf("failed to update sold count: %w", err)
+		}
+	}
+
+	if err = s.invitationRepo.CreateBatchWithTx(txCtx, tx, invitations); err != nil {
+		return err
+	}
+
+	jobs := []*entity.ScheduledJob{
+		{Type: entity.JobTypeInvitationEmails, ReferenceID: batchID, RunAt: s.now()},
+		{Type: entity.JobTypeInvitationRelease, ReferenceID: batchID, RunAt: deadline},
+	}
+	for _, job := range jobs {
+		if err = s.scheduledJobRepo.CreateWithTx(txCtx, tx, job); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	txDone()
+
+	// Sold count changed, listing availability summary needs refresh
+	s.availability.MarkStale()
+
+	return nil
+}
+
+// validateInvitations resolves the tier of each row and checks it against the tier and the
+// invitations already sent, returning the invitations to create
+func (s *invitationService) validateInvitations(ctx context.Context, eventID string, rows []invitationRow, tiers []entity.TicketTier) ([]entity.EventInvitation, []response.ImportRowError, error) {
+	emails := make([]string, 0, len(rows))
+	for _, row := range rows {
+		emails = append(emails, strings.ToLower(row.email))
+	}
+	open, err := s.invitationRepo.FindOpenEmails(ctx, eventID, emails)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rowErrors []response.ImportRowError
+	fail := func(row int, field, message string) {
+		rowErrors = append(rowErrors, response.ImportRowError{Row: row, Field: field, Message: message})
+	}
+
+	invitations := make([]entity.EventInvitation, 0, len(rows))
+	seen := map[string]int{}
+	held := map[string]int{}
+	firstRow := map[string]int{}
+	for _, row := range rows {
+		email := strings.ToLower(row.email)
+		if line, ok := seen[email]; ok {
+			fail(row.line, "email", fmt.Sprintf("is also invited on line %d", line))
+		} else {
+			seen[email] = row.line
+		}
+		if slices.Contains(open, email) {
+			fail(row.line, "email", "already has an invitation to this event")
+		}
+
+		tier := findInvitationTier(tiers, row.tier)
+		if tier == nil {
+			fail(row.line, "tier", fmt.Sprintf("no ticket tier %q on sale for this event", row.tier))
+			continue
+		}
+		if tier.MaxPerOrder > 0 && row.quantity > tier.MaxPerOrder {
+			fail(row.line, "quantity", fmt.Sprintf("must be at most %d, the tier's maximum per order", tier.MaxPerOrder))
+		}
+		if row.price > tier.Price {
+			fail(row.line, "price", fmt.Sprintf("must not exceed the tier price of %s", tier.Price))
+		}
+
+		if _, ok := firstRow[tier.ID]; !ok {
+			firstRow[tier.ID] = row.line
+		}
+		held[tier.ID] += row.quantity
+
+		invitations = append(invitations, entity.EventInvitation{
+			TicketTierID: tier.ID,
+			Email:        row.email,
+			Name:         row.name,
+			Quantity:     row.quantity,
+			Price:        row.price,
+		})
+	}
+
+	for _, tier := range tiers {
+		if quantity, ok := held[tier.ID]; ok && tier.GetAvailableQuota() < quantity {
+			fail(firstRow[tier.ID], "quantity", fmt.Sprintf("tier %q has %d tickets left, the file invites %d", tier.Name, tier.GetAvailableQuota(), quantity))
+		}
+	}
+
+	return invitations, rowErrors, nil
+}
+
+// findInvitationTier returns the tier a row refers to by ID or name (case-insensitive)
+// Archived tiers are off sale and can't be invited to
+func findInvitationTier(tiers []entity.TicketTier, ref string) *entity.TicketTier {
+	for i := range tiers {
+		if tiers[i].IsArchived() {
+			continue
+		}
+		if tiers[i].ID == ref || strings.EqualFold(tiers[i].Name, ref) {
+			return &tiers[i]
+		}
+	}
+	return nil
+}
+
+// ListInvitations returns the invitations of a managed event with counts by status
+func (s *invitationService) ListInvitations(ctx context.Context, userID, role, eventID string) (*response.InvitationListResponse, error) {
+	if _, err := managedEvent(ctx, s.eventRepo, userID, role, eventID); err != nil {
+		return nil, err
+	}
+
+	invitations, err := s.invitationRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := s.invitationRepo.CountByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	tiers, err := s.ticketTierRepo.GetByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket tiers: %w", err)
+	}
+	tierNames := make(map[string]string, len(tiers))
+	for _, tier := range tiers {
+		tierNames[tier.ID] = tier.Name
+	}
+
+	result := &response.InvitationListResponse{
+		Summary: response.InvitationSummaryResponse{
+			Pending:  summary.Pending,
+			Claimed:  summary.Claimed,
+			Released: summary.Released,
+			Unsent:   summary.Unsent,
+		},
+		Invitations: make([]response.InvitationResponse, 0, len(invitations)),
+	}
+	for i := range invitations {
+		result.Invitations = append(result.Invitations, response.ToInvitationResponse(&invitations[i], tierNames[invitations[i].TicketTierID]))
+	}
+
+	return result, nil
+}
+
+// GetInvitation returns the public view of the invitation behind a claim link
+func (s *invitationService) GetInvitation(ctx context.Context, token string) (*response.InvitationPreviewResponse, error) {
+	invitation, err := s.invitationByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, invitation.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	tier, err := s.ticketTierRepo.GetByID(ctx, invitation.TicketTierID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket tier: %w", err)
+	}
+
+	return &response.InvitationPreviewResponse{
+		Event:         response.ToEventSummaryResponse(event),
+		Name:          invitation.Name,
+		TierName:      tier.Name,
+		Quantity:      invitation.Quantity,
+		Price:         invitation.Price,
+		Status:        invitation.Status,
+		Claimable:     invitation.IsClaimable(s.now()) && !event.IsCancelled() && !event.HasEnded(),
+		ClaimDeadline: invitation.ClaimDeadline,
+	}, nil
+}
+
+// ClaimInvitation turns a pending invitation into an order of the signed-in user
+// Complimentary invitations are paid on claim and their tickets issued; discounted ones are
+// reserved at the invitation price and invoiced like any reservation. The invitation's held
+// tickets move to the order, so sold_count is not changed.
+func (s *invitationService) ClaimInvitation(ctx context.Context, userID string, req *request.ClaimInvitationRequest) (*response.ClaimInvitationResponse, error) {
+	invitation, err := s.invitationByToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkClaimable(invitation); err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, invitation.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if event.IsCancelled() || event.IsCompleted() || event.HasEnded() {
+		return nil, ErrInvitationsClosed
+	}
+	tier, err := s.ticketTierRepo.GetByID(ctx, invitation.TicketTierID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket tier: %w", err)
+	}
+
+	// Discounted claims are priced with the fees of the event's tenant, complimentary ones are free
+	lines := []pricing.Line{{Ref: tier.ID, Name: tier.Name, UnitPrice: invitation.Price, Quantity: invitation.Quantity}}
+	breakdown := &pricing.Breakdown{Lines: lines}
+	if !invitation.IsComp() {
+		tenantConfig, err := s.tenantRepo.GetByID(ctx, event.TenantID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tenant: %w", err)
+		}
+		breakdown, err = pricing.Calculate(lines, tenantConfig.PricingPolicy())
+		if err != nil {
+			return nil, fmt.Errorf("failed to price order: %w", err)
+		}
+	}
+
+	expiresAt := s.now().Add(time.Duration(s.reservationTimeout.Load()))
+	order := &entity.Order{
+		UserID:               userID,
+		EventID:              event.ID,
+		TenantID:             event.TenantID,
+		TotalAmount:          breakdown.Subtotal,
+		PlatformFee:          breakdown.PlatformFee,
+		ServiceFee:           breakdown.ServiceFee,
+		GrandTotal:           breakdown.GrandTotal,
+		Status:               entity.OrderStatusReserved,
+		ReservationExpiresAt: &expiresAt,
+		IsSandbox:            event.IsSandbox,
+		EventSourced:         eventSourcedOrders(ctx, s.flags, userID, event),
+	}
+	items := []entity.OrderItem{{TicketTierID: tier.ID, Quantity: invitation.Quantity, Price: invitation.Price}}
+
+	if err := s.claim(ctx, invitation.ID, userID, order, items); err != nil {
+		return nil, err
+	}
+
+	result := &response.ClaimInvitationResponse{Order: response.ToOrderResponse(order, items)}
+
+	if invitation.IsComp() {
+		// Paid orders without tickets are healed by the consistency check, the claim stands
+		tickets, err := s.ticketService.GenerateTickets(ctx, order.ID)
+		if err != nil {
+			log.Printf("[InvitationService] Failed to generate tickets for order %s of invitation %s: %v", order.ID, invitation.ID, err)
+			return result, nil
+		}
+		result.Tickets = tickets
+		return result, nil
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		s.revertClaim(ctx, invitation.ID, order.ID)
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	invoice, err := s.paymentClient.CreateInvoice(ctx, &client.CreateInvoiceRequest{
+		OrderID:      order.ID,
+		UserID:       userID,
+		Email:        user.Email,
+		CustomerName: user.FullName,
+		Amount:       breakdown.GrandTotal,
+		Description:  fmt.Sprintf("Tiket Event - Order #%s", order.ID[:8]),
+		Items:        []client.InvoiceItem{{Name: tier.Name, Quantity: invitation.Quantity, Price: invitation.Price}},
+		Sandbox:      order.IsSandbox,
+	})
+	if err != nil {
+		log.Printf("[ERROR] Failed to create invoice for order %s of invitation %s: %v", order.ID, invitation.ID, err)
+		s.revertClaim(ctx, invitation.ID, order.ID)
+		return nil, fmt.Errorf("failed to create payment invoice: %w", err)
+	}
+	result.Order.InvoiceURL = &invoice.InvoiceURL
+
+	return result, nil
+}
+
+// claim creates the order of an invitation and marks the invitation claimed, all or nothing
+// Complimentary orders are marked paid within the same transaction
+func (s *invitationService) claim(ctx context.Context, invitationID, userID string, order *entity.Order, items []entity.OrderItem) (err error) {
+	// Row locks are held until commit, the watchdog rolls back a stalled transaction
+	txCtx, txDone := s.txWatchdog.Watch(ctx, "claim_invitation")
+	defer txDone()
+
+	tx, err := s.orderRepo.BeginTx(txCtx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// The release job and concurrent claims wait for this lock, then see the invitation claimed
+	invitation, err := s.invitationRepo.GetByIDWithLock(txCtx, tx, invitationID)
+	if err != nil {
+		return fmt.Errorf("failed to get invitation: %w", err)
+	}
+	if err = s.checkClaimable(invitation); err != nil {
+		return err
+	}
+
+	if err = s.orderRepo.CreateWithTx(txCtx, tx, order); err != nil {
+		return fmt.Errorf("failed to create order: %w", err)
+	}
+	for i := range items {
+		items[i].OrderID = order.ID
+	}
+	if err = s.orderItemRepo.CreateBatch(txCtx, tx, items); err != nil {
+		return fmt.Errorf("failed to create order items: %w", err)
+	}
+
+	if invitation.IsComp() {
+		paymentMethod := invitationPaymentMethod
+		completedAt := s.now()
+		order.Status = entity.OrderStatusPaid
+		order.PaymentMethod = &paymentMethod
+		order.CompletedAt = &completedAt
+		order.ReservationExpiresAt = nil
+		if err = s.orderRepo.UpdateWithTx(txCtx, tx, order); err != nil {
+			return fmt.Errorf("failed to update order: %w", err)
+		}
+	}
+
+	if err = s.invitationRepo.ClaimWithTx(txCtx, tx, invitationID, userID, order.ID); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// revertClaim cancels the order of a claim that could not be invoiced and returns the invitation
+// to pending, so the invitee can retry; the held tickets stay with the invitation
+func (s *invitationService) revertClaim(ctx context.Context, invitationID, orderID string) {
+	// Even if the client went away meanwhile
+	ctx, cancel := timeout.Detach(ctx)
+	defer cancel()
+
+	txCtx, txDone := s.txWatchdog.Watch(ctx, "revert_invitation_claim")
+	defer txDone()
+
+	err := func() error {
+		tx, err := s.orderRepo.BeginTx(txCtx)
+		if err != nil {
+			return fmt.Errorf("failed to begin transaction: %w", err)
+		}
+		defer tx.Rollback()
+
+		order, err := s.orderRepo.GetByIDWithLock(txCtx, tx, orderID)
+		if err != nil {
+			return fmt.Errorf("failed to get order: %w", err)
+		}
+		if order.Status != entity.OrderStatusReserved {
+			return fmt.Errorf("order is not in reserved status")
+		}
+
+		order.Status = entity.OrderStatusCancelled
+		if err := s.orderRepo.UpdateWithTx(txCtx, tx, order); err != nil {
+			return fmt.Errorf("failed to cancel order: %w", err)
+		}
+		if err := s.invitationRepo.UnclaimWithTx(txCtx, tx, invitationID); err != nil {
+			return err
+		}
+
+		return tx.Commit()
+	}()
+	if err != nil {
+		log.Printf("[ERROR] Failed to revert claim of invitation %s (order %s): %v", invitationID, orderID, err)
+	}
+}
+
+// checkClaimable maps an invitation that can't be claimed to its error
+func (s *invitationService) checkClaimable(invitation *entity.EventInvitation) error {
+	switch {
+	case invitation.Status == entity.InvitationStatusClaimed:
+		return ErrInvitationClaimed
+	case !invitation.IsClaimable(s.now()):
+		return ErrInvitationExpired
+	}
+	return nil
+}
+
+// invitationByToken verifies a claim token and returns its invitation
+func (s *invitationService) invitationByToken(ctx context.Context, token string) (*entity.EventInvitation, error) {
+	invitationID, err := utility.ParseInvitationToken(s.secret, token)
+	if err != nil {
+		return nil, ErrInvitationInvalid
+	}
+
+	invitation, err := s.invitationRepo.GetByID(ctx, invitationID)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvitationNotFound) {
+			return nil, ErrInvitationInvalid
+		}
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+// SendInvitationEmails emails the claim links of the job's import batch
+// Sent invitations are recorded one by one, so a retry after a failure only sends the rest
+func (s *invitationService) SendInvitationEmails(ctx context.Context, job *entity.ScheduledJob) error {
+	invitations, err := s.invitationRepo.ListUnsentByBatch(ctx, job.ReferenceID)
+	if err != nil {
+		return err
+	}
+	if len(invitations) == 0 {
+		return nil
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, invitations[0].EventID)
+	if err != nil {
+		return fmt.Errorf("failed to get event: %w", err)
+	}
+	if event.IsCancelled() {
+		log.Printf("[InvitationService] Event %s was cancelled, skipping %d invitation emails", event.ID, len(invitations))
+		return nil
+	}
+	tiers, err := s.ticketTierRepo.GetByEventID(ctx, event.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get ticket tiers: %w", err)
+	}
+	tierNames := make(map[string]string, len(tiers))
+	for _, tier := range tiers {
+		tierNames[tier.ID] = tier.Name
+	}
+	branding := tenantEmailBranding(ctx, s.tenantRepo, event.TenantID, event.OrganizerID)
+
+	failed := 0
+	for _, invitation := range invitations {
+		if !invitation.IsClaimable(s.now()) {
+			continue
+		}
+
+		token := utility.GenerateInvitationToken(s.secret, invitation.ID, invitation.ClaimDeadline)
+		err := s.sender.SendInvitationEmail(ctx, &client.SendInvitationEmailRequest{
+			InvitationID:   invitation.ID,
+			RecipientEmail: invitation.Email,
+			RecipientName:  invitation.Name,
+			EventName:      event.Name,
+			EventLocation:  event.Location,
+			EventStartTime: event.StartDate,
+			TierName:       tierNames[invitation.TicketTierID],
+			Quantity:       invitation.Quantity,
+			Price:          invitation.Price,
+			ClaimURL:       s.baseURL + "/" + url.PathEscape(token),
+			ClaimDeadline:  invitation.ClaimDeadline,
+			Branding:       branding,
+		})
+		if err != nil {
+			log.Printf("[InvitationService] Failed to email invitation %s: %v", invitation.ID, err)
+			failed++
+			continue
+		}
+
+		if err := s.invitationRepo.MarkEmailSent(ctx, invitation.ID); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to email %d of %d invitations", failed, len(invitations))
+	}
+
+	return nil
+}
+
+// ReleaseInvitations releases the unclaimed invitations of the job's import batch once their
+// deadline passed, returning their tickets to sale
+func (s *invitationService) ReleaseInvitations(ctx context.Context, job *entity.ScheduledJob) (err error) {
+	// Row locks are held until commit, the watchdog rolls back a stalled transaction
+	txCtx, txDone := s.txWatchdog.Watch(ctx, "release_invitations")
+	defer txDone()
+
+	tx, err := s.orderRepo.BeginTx(txCtx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	released, err := s.invitationRepo.ReleaseExpiredByBatchWithTx(txCtx, tx, job.ReferenceID, s.now())
+	if err != nil {
+		return err
+	}
+	if len(released) == 0 {
+		return tx.Commit()
+	}
+
+	quantities := map[string]int{}
+	for _, invitation := range released {
+		quantities[invitation.TicketTierID] += invitation.Quantity
+	}
+	tierIDs := make([]string, 0, len(quantities))
+	for tierID := range quantities {
+		tierIDs = append(tierIDs, tierID)
+	}
+	sort.Strings(tierIDs)
+
+	for _, tierID := range tierIDs {
+		if err = s.ticketTierRepo.ReleaseSoldCount(txCtx, tx, tierID, quantities[tierID], entity.InventoryCause{Reason: entity.InventoryReasonRelease}); err != nil {
+			return fmt.Errorf("failed to release sold count: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	txDone()
+
+	// Sold count changed, listing availability summary needs refresh
+	s.availability.MarkStale()
+	log.Printf("[InvitationService] Released %d unclaimed invitations of batch %s", len(released), job.ReferenceID)
+
+	return nil
+}
+
+// invitationRow is one invitee of an invitations CSV file
+type invitationRow struct {
+	line     int
+	email    string
+	name     string
+	tier     string
+	quantity int
+	price    money.Money
+}
+
+// parseInvitationsCSV reads an invitee CSV file
+// Values that can't be parsed are returned as row errors; a malformed file is an error
+func parseInvitationsCSV(body io.Reader) ([]invitationRow, []response.ImportRowError, error) {
+	reader := csv.NewReader(body)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, fmt.Errorf("%w: empty file", ErrInvalidInvitationsFile)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidInvitationsFile, err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if !slices.Contains(request.InvitationCSVColumns, name) {
+			return nil, nil, fmt.Errorf("%w: unknown column %q", ErrInvalidInvitationsFile, name)
+		}
+		if _, ok := columns[name]; ok {
+			return nil, nil, fmt.Errorf("%w: duplicate column %q", ErrInvalidInvitationsFile, name)
+		}
+		columns[name] = i
+	}
+	for _, required := range []string{"email", "tier"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, fmt.Errorf("%w: missing column %q", ErrInvalidInvitationsFile, required)
+		}
+	}
+
+	var (
+		rows      []invitationRow
+		rowErrors []response.ImportRowError
+	)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrInvalidInvitationsFile, err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		value := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+		fail := func(column, message string) {
+			rowErrors = append(rowErrors, response.ImportRowError{Row: line, Field: column, Message: message})
+		}
+
+		row := invitationRow{line: line, email: value("email"), name: value("name"), tier: value("tier"), quantity: 1}
+		if row.email == "" {
+			fail("email", "is required")
+		} else if address, err := mail.ParseAddress(row.email); err != nil || address.Address != row.email {
+			fail("email", "must be an email address")
+		}
+		if row.tier == "" {
+			fail("tier", "is required")
+		}
+		if raw := value("quantity"); raw != "" {
+			quantity, err := strconv.Atoi(raw)
+			if err != nil || quantity < 1 {
+				fail("quantity", "must be a whole number of at least 1")
+			}
+			row.quantity = quantity
+		}
+		if raw := value("price"); raw != "" {
+			price, err := money.Parse(raw)
+			if err != nil || price < 0 {
+				fail("price", "must be an amount with at most 2 decimals, e.g. 150000 or 99.50")
+			}
+			row.price = price
+		}
+		if len(row.name) > 255 {
+			fail("name", "must be at most 255 characters")
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, rowErrors, nil
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubInvitationRepo keeps invitations in memory
+type stubInvitationRepo struct {
+	repository.EventInvitationRepository
+	invitations map[string]*entity.EventInvitation
+	openEmails  []string
+}
+
+func (r *stubInvitationRepo) GetByID(ctx context.Context, id string) (*entity.EventInvitation, error) {
+	invitation, ok := r.invitations[id]
+	if !ok {
+		return nil, repository.ErrInvitationNotFound
+	}
+	copied := *invitation
+	return &copied, nil
+}
+
+func (r *stubInvitationRepo) FindOpenEmails(ctx context.Context, eventID string, emails []string) ([]string, error) {
+	return r.openEmails, nil
+}
+
+func (r *stubTicketTierRepo) GetByEventID(ctx context.Context, eventID string) ([]entity.TicketTier, error) {
+	var tiers []entity.TicketTier
+	for _, tier := range r.tiers {
+		if tier.EventID == eventID {
+			tiers = append(tiers, *tier)
+		}
+	}
+	return tiers, nil
+}
+
+const invitationSecret = "invitation-secret"
+
+func newInvitationFixture() (*invitationService, *stubInvitationRepo) {
+	now := time.Now()
+	invitationRepo := &stubInvitationRepo{invitations: map[string]*entity.EventInvitation{}}
+	svc := &invitationService{
+		invitationRepo: invitationRepo,
+		eventRepo: &stubEventRepo{event: &entity.Event{
+			ID:          "event-1",
+			Name:        "Private Gala",
+			OrganizerID: "organizer-1",
+			Status:      entity.EventStatusPublished,
+			StartDate:   now.Add(7 * 24 * time.Hour),
+			EndDate:     now.Add(8 * 24 * time.Hour),
+		}},
+		ticketTierRepo: &stubTicketTierRepo{tiers: map[string]*entity.TicketTier{
+			"tier-vip": {ID: "tier-vip", EventID: "event-1", Name: "VIP", Price: money.New(500000), Quota: 10, SoldCount: 6, MaxPerOrder: 4},
+		}},
+		secret:  invitationSecret,
+		baseURL: "https://tickets.example/invitations",
+		now:     func() time.Time { return now },
+	}
+	return svc, invitationRepo
+}
+
+func importRequest(dryRun bool) *request.ImportInvitationsRequest {
+	return &request.ImportInvitationsRequest{
+		EventID:       "event-1",
+		ClaimDeadline: time.Now().Add(3 * 24 * time.Hour),
+		DryRun:        dryRun,
+	}
+}
+
+func TestImportInvitations_DryRunValidFile(t *testing.T) {
+	svc, _ := newInvitationFixture()
+
+	csv := "\ufeffemail,name,tier,quantity,price\n" +
+		"ana@example.com,Ana,VIP,2,0\n" +
+		"budi@example.com,Budi,vip,,250000\n"
+
+	result, err := svc.ImportInvitations(context.Background(), "organizer-1", entity.UserRoleOrganizer, importRequest(true), strings.NewReader(csv))
+	require.NoError(t, err)
+
+	assert.True(t, result.DryRun)
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, 2, result.Invitations)
+	assert.Equal(t, 3, result.Tickets, "quantity defaults to 1")
+	assert.Empty(t, result.BatchID, "dry runs create nothing")
+}
+
+func TestImportInvitations_RowErrors(t *testing.T) {
+	svc, invitationRepo := newInvitationFixture()
+	invitationRepo.openEmails = []string{"invited@example.com"}
+	svc.ticketTierRepo.(*stubTicketTierRepo).tiers["tier-vip"].SoldCount = 0
+
+	csv := "email,tier,quantity,price\n" +
+		"not-an-email,VIP,1,0\n" + // line 2
+		"ana@example.com,VIP,1,0\n" + // line 3
+		"ANA@example.com,VIP,1,0\n" + // line 4
+		"invited@example.com,VIP,1,0\n" + // line 5
+		"budi@example.com,Regular,1,0\n" + // line 6
+		"citra@example.com,VIP,1,600000\n" + // line 7
+		"dewi@example.com,VIP,zero,0\n" // line 8
+
+	result, err := svc.ImportInvitations(context.Background(), "organizer-1", entity.UserRoleOrganizer, importRequest(false), strings.NewReader(csv))
+	require.NoError(t, err)
+
+	failures := map[int]string{}
+	for _, rowError := range result.Errors {
+		failures[rowError.Row] = rowError.Field
+	}
+	assert.Equal(t, map[int]string{2: "email", 4: "email", 5: "email", 6: "tier", 7: "price", 8: "quantity"}, failures)
+	assert.Len(t, result.Errors, 6, "a value that failed to parse is reported once")
+	assert.Empty(t, result.BatchID)
+}
+
+func TestImportInvitations_TierQuota(t *testing.T) {
+	svc, _ := newInvitationFixture()
+
+	// VIP has 4 tickets left
+	csv := "email,tier,quantity\n" +
+		"ana@example.com,VIP,3\n" +
+		"budi@example.com,VIP,2\n"
+
+	result, err := svc.ImportInvitations(context.Background(), "organizer-1", entity.UserRoleOrganizer, importRequest(true), strings.NewReader(csv))
+	require.NoError(t, err)
+
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, 2, result.Errors[0].Row, "reported on the tier's first row")
+	assert.Equal(t, "quantity", result.Errors[0].Field)
+}
+
+func TestImportInvitations_Rejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		userID  string
+		mutate  func(svc *invitationService, req *request.ImportInvitationsRequest)
+		csv     string
+		wantErr error
+	}{
+		{
+			name:    "other organizer's event",
+			userID:  "organizer-2",
+			csv:     "email,tier\nana@example.com,VIP\n",
+			wantErr: ErrUnauthorized,
+		},
+		{
+			name: "cancelled event",
+			mutate: func(svc *invitationService, req *request.ImportInvitationsRequest) {
+				svc.eventRepo.(*stubEventRepo).event.Status = entity.EventStatusCancelled
+			},
+			csv:     "email,tier\nana@example.com,VIP\n",
+			wantErr: ErrInvitationsClosed,
+		},
+		{
+			name: "deadline after the event ends",
+			mutate: func(svc *invitationService, req *request.ImportInvitationsRequest) {
+				req.ClaimDeadline = time.Now().Add(30 * 24 * time.Hour)
+			},
+			csv:     "email,tier\nana@example.com,VIP\n",
+			wantErr: ErrInvalidClaimDeadline,
+		},
+		{
+			name: "deadline in the past",
+			mutate: func(svc *invitationService, req *request.ImportInvitationsRequest) {
+				req.ClaimDeadline = time.Now().Add(-time.Hour)
+			},
+			csv:     "email,tier\nana@example.com,VIP\n",
+			wantErr: ErrInvalidClaimDeadline,
+		},
+		{
+			name:    "unknown column",
+			csv:     "email,tier,seat\nana@example.com,VIP,A1\n",
+			wantErr: ErrInvalidInvitationsFile,
+		},
+		{
+			name:    "missing tier column",
+			csv:     "email,name\nana@example.com,Ana\n",
+			wantErr: ErrInvalidInvitationsFile,
+		},
+		{
+			name:    "no invitees",
+			csv:     "email,tier\n",
+			wantErr: ErrInvalidInvitationsFile,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _ := newInvitationFixture()
+			req := importRequest(true)
+			if tt.mutate != nil {
+				tt.mutate(svc, req)
+			}
+			userID := tt.userID
+			if userID == "" {
+				userID = "organizer-1"
+			}
+
+			_, err := svc.ImportInvitations(context.Background(), userID, entity.UserRoleOrganizer, req, strings.NewReader(tt.csv))
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestGetInvitation(t *testing.T) {
+	svc, invitationRepo := newInvitationFixture()
+	deadline := svc.now().Add(24 * time.Hour)
+	invitationRepo.invitations["inv-1"] = &entity.EventInvitation{
+		ID: "inv-1", EventID: "event-1", TicketTierID: "tier-vip", Name: "Ana",
+		Quantity: 2, Status: entity.InvitationStatusPending, ClaimDeadline: deadline,
+	}
+
+	token := utility.GenerateInvitationToken(invitationSecret, "inv-1", deadline)
+
+	preview, err := svc.GetInvitation(context.Background(), token)
+	require.NoError(t, err)
+	assert.Equal(t, "VIP", preview.TierName)
+	assert.Equal(t, 2, preview.Quantity)
+	assert.True(t, preview.Claimable)
+
+	invitationRepo.invitations["inv-1"].Status = entity.InvitationStatusReleased
+	preview, err = svc.GetInvitation(context.Background(), token)
+	require.NoError(t, err)
+	assert.False(t, preview.Claimable, "released invitations can't be claimed")
+
+	_, err = svc.GetInvitation(context.Background(), token+"x")
+	assert.ErrorIs(t, err, ErrInvitationInvalid, "tampered token")
+
+	_, err = svc.GetInvitation(context.Background(), utility.GenerateInvitationToken("other-secret", "inv-1", deadline))
+	assert.ErrorIs(t, err, ErrInvitationInvalid, "token signed with another secret")
+
+	_, err = svc.GetInvitation(context.Background(), utility.GenerateInvitationToken(invitationSecret, "inv-2", deadline))
+	assert.ErrorIs(t, err, ErrInvitationInvalid, "unknown invitation")
+}
+
+func TestCheckClaimable(t *testing.T) {
+	svc, _ := newInvitationFixture()
+	now := svc.now()
+
+	pending := &entity.EventInvitation{Status: entity.InvitationStatusPending, ClaimDeadline: now.Add(time.Hour)}
+	assert.NoError(t, svc.checkClaimable(pending))
+
+	claimed := &entity.EventInvitation{Status: entity.InvitationStatusClaimed, ClaimDeadline: now.Add(time.Hour)}
+	assert.ErrorIs(t, svc.checkClaimable(claimed), ErrInvitationClaimed)
+
+	overdue := &entity.EventInvitation{Status: entity.InvitationStatusPending, ClaimDeadline: now.Add(-time.Minute)}
+	assert.ErrorIs(t, svc.checkClaimable(overdue), ErrInvitationExpired)
+
+	released := &entity.EventInvitation{Status: entity.InvitationStatusReleased, ClaimDeadline: now.Add(-time.Minute)}
+	assert.ErrorIs(t, svc.checkClaimable(released), ErrInvitationExpired)
+}
@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+)
+
+var (
+	ErrIssueNotFound    = errors.New("order issue not found")
+	ErrIssueAlreadyOpen = errors.New("order already has an unresolved issue in this category")
+	ErrIssueResolved    = errors.New("order issue is already resolved")
+)
+
+// IssueService handles problems reported by buyers on their orders
+type IssueService interface {
+	// Buyer operations
+	ReportIssue(ctx context.Context, userID, orderID string, req *request.CreateOrderIssueRequest) (*response.OrderIssueResponse, error)
+	GetOrderIssues(ctx context.Context, userID, orderID string) ([]response.OrderIssueResponse, error)
+
+	// Support operations, scoped to the request tenant
+	ListIssues(ctx context.Context, status string, page, limit int) ([]response.OrderIssueResponse, int64, error)
+	GetAdminOrder(ctx context.Context, orderID string) (*response.AdminOrderResponse, error)
+	ReplyToIssue(ctx context.Context, adminID, issueID string, req *request.ReplyOrderIssueRequest) (*response.OrderIssueResponse, error)
+	ResolveIssue(ctx context.Context, adminID, issueID string, req *request.ResolveOrderIssueRequest) (*response.OrderIssueResponse, error)
+}
+
+// issueService implements IssueService interface
+type issueService struct {
+	issueRepo    repository.OrderIssueRepository
+	orderRepo    repository.OrderRepository
+	auditRepo    repository.OrderAuditRepository
+	orderService OrderService
+}
+
+// NewIssueService creates new issue service instance
+func NewIssueService(
+	issueRepo repository.OrderIssueRepository,
+	orderRepo repository.OrderRepository,
+	auditRepo repository.OrderAuditRepository,
+	orderService OrderService,
+) IssueService {
+	return &issueService{
+		issueRepo:    issueRepo,
+		orderRepo:    orderRepo,
+		auditRepo:    auditRepo,
+		orderService: orderService,
+	}
+}
+
+// ReportIssue opens an issue on the buyer's order
+func (s *issueService) ReportIssue(ctx context.Context, userID, orderID string, req *request.CreateOrderIssueRequest) (*response.OrderIssueResponse, error) {
+	order, err := s.getOwnedOrder(ctx, userID, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	issue := &entity.OrderIssue{
+		OrderID:     order.ID,
+		UserID:      userID,
+		TenantID:    order.TenantID,
+		Category:    req.Category,
+		Description: req.Description,
+	}
+
+	if err := s.issueRepo.Create(ctx, issue); err != nil {
+		if errors.Is(err, repository.ErrOrderIssueAlreadyOpen) {
+			return nil, ErrIssueAlreadyOpen
+		}
+		return nil, fmt.Errorf("failed to create order issue: %w", err)
+	}
+
+	return response.ToOrderIssueResponse(issue, nil), nil
+}
+
+// GetOrderIssues retrieves the issues of the buyer's order with support replies
+func (s *issueService) GetOrderIssues(ctx context.Context, userID, orderID string) ([]response.OrderIssueResponse, error) {
+	if _, err := s.getOwnedOrder(ctx, userID, orderID); err != nil {
+		return nil, err
+	}
+
+	issues, err := s.issueRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order issues: %w", err)
+	}
+
+	return s.toIssueResponses(ctx, issues)
+}
+
+// ListIssues retrieves issues of the request tenant by status, unresolved ones if status is empty
+func (s *issueService) ListIssues(ctx context.Context, status string, page, limit int) ([]response.OrderIssueResponse, int64, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+
+	offset := (page - 1) * limit
+
+	issues, total, err := s.issueRepo.List(ctx, tenantFromContext(ctx), status, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list order issues: %w", err)
+	}
+
+	issueResponses, err := s.toIssueResponses(ctx, issues)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return issueResponses, total, nil
+}
+
+// GetAdminOrder retrieves the support view of an order with the issues reported on it and its audit entries
+func (s *issueService) GetAdminOrder(ctx context.Context, orderID string) (*response.AdminOrderResponse, error) {
+	order, err := s.orderService.GetOrderForSupport(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	issues, err := s.issueRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order issues: %w", err)
+	}
+
+	issueResponses, err := s.toIssueResponses(ctx, issues)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.auditRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order audit entries: %w", err)
+	}
+
+	auditResponses := make([]response.OrderAuditEntryResponse, 0, len(entries))
+	for i := range entries {
+		auditResponses = append(auditResponses, response.ToOrderAuditEntryResponse(&entries[i]))
+	}
+
+	return &response.AdminOrderResponse{Order: order, Issues: issueResponses, Audit: auditResponses}, nil
+}
+
+// ReplyToIssue adds a support reply; an open issue moves to in progress
+func (s *issueService) ReplyToIssue(ctx context.Context, adminID, issueID string, req *request.ReplyOrderIssueRequest) (*response.OrderIssueResponse, error) {
+	if _, err := s.getTenantIssue(ctx, issueID); err != nil {
+		return nil, err
+	}
+
+	reply := &entity.OrderIssueReply{
+		IssueID:  issueID,
+		AuthorID: adminID,
+		Message:  req.Message,
+	}
+	if err := s.issueRepo.AddReply(ctx, reply); err != nil {
+		return nil, mapIssueUpdateError(err)
+	}
+
+	return s.getIssueResponse(ctx, issueID)
+}
+
+// ResolveIssue closes an issue with a resolution; resolved issues are final
+func (s *issueService) ResolveIssue(ctx context.Context, adminID, issueID string, req *request.ResolveOrderIssueRequest) (*response.OrderIssueResponse, error) {
+	if _, err := s.getTenantIssue(ctx, issueID); err != nil {
+		return nil, err
+	}
+
+	if err := s.issueRepo.Resolve(ctx, issueID, adminID, req.Resolution); err != nil {
+		return nil, mapIssueUpdateError(err)
+	}
+
+	return s.getIssueResponse(ctx, issueID)
+}
+
+// getOwnedOrder retrieves an order of the buyer
+func (s *issueService) getOwnedOrder(ctx context.Context, userID, orderID string) (*entity.Order, error) {
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, repository.ErrOrderNotFound) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	if order.UserID != userID {
+		return nil, ErrUnauthorized
+	}
+
+	return order, nil
+}
+
+// getTenantIssue retrieves an issue of the request tenant
+func (s *issueService) getTenantIssue(ctx context.Context, issueID string) (*entity.OrderIssue, error) {
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		if errors.Is(err, repository.ErrOrderIssueNotFound) {
+			return nil, ErrIssueNotFound
+		}
+		return nil, fmt.Errorf("failed to get order issue: %w", err)
+	}
+
+	if issue.TenantID != tenantFromContext(ctx) {
+		return nil, ErrIssueNotFound
+	}
+
+	return issue, nil
+}
+
+// getIssueResponse reloads an issue with its replies
+func (s *issueService) getIssueResponse(ctx context.Context, issueID string) (*response.OrderIssueResponse, error) {
+	issue, err := s.getTenantIssue(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	issueResponses, err := s.toIssueResponses(ctx, []entity.OrderIssue{*issue})
+	if err != nil {
+		return nil, err
+	}
+
+	return &issueResponses[0], nil
+}
+
+// toIssueResponses loads the replies of all issues in one query and converts them
+func (s *issueService) toIssueResponses(ctx context.Context, issues []entity.OrderIssue) ([]response.OrderIssueResponse, error) {
+	issueIDs := make([]string, len(issues))
+	for i, issue := range issues {
+		issueIDs[i] = issue.ID
+	}
+
+	replies, err := s.issueRepo.GetReplies(ctx, issueIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order issue replies: %w", err)
+	}
+
+	repliesByIssue := make(map[string][]entity.OrderIssueReply, len(issues))
+	for _, reply := range replies {
+		repliesByIssue[reply.IssueID] = append(repliesByIssue[reply.IssueID], reply)
+	}
+
+	issueResponses := make([]response.OrderIssueResponse, 0, len(issues))
+	for i := range issues {
+		issueResponses = append(issueResponses, *response.ToOrderIssueResponse(&issues[i], repliesByIssue[issues[i].ID]))
+	}
+
+	return issueResponses, nil
+}
+
+// mapIssueUpdateError maps repository errors of reply and resolve to service errors
+func mapIssueUpdateError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrOrderIssueNotFound):
+		return ErrIssueNotFound
+	case errors.Is(err, repository.ErrOrderIssueResolved):
+		return ErrIssueResolved
+	default:
+		return fmt.Errorf("failed to update order issue: %w", err)
+	}
+}
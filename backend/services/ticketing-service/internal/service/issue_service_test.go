@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubOrderRepo struct {
+	repository.OrderRepository
+	order *entity.Order
+}
+
+func (r *stubOrderRepo) GetByID(ctx context.Context, id string) (*entity.Order, error) {
+	if r.order == nil || r.order.ID != id {
+		return nil, repository.ErrOrderNotFound
+	}
+	return r.order, nil
+}
+
+// stubIssueRepo keeps issues in memory; createErr simulates the unique open issue index
+type stubIssueRepo struct {
+	repository.OrderIssueRepository
+	issues    map[string]*entity.OrderIssue
+	replies   []entity.OrderIssueReply
+	createErr error
+}
+
+func (r *stubIssueRepo) Create(ctx context.Context, issue *entity.OrderIssue) error {
+	if r.createErr != nil {
+		return r.createErr
+	}
+	issue.ID = "issue-new"
+	issue.Status = entity.IssueStatusOpen
+	r.issues[issue.ID] = issue
+	return nil
+}
+
+func (r *stubIssueRepo) GetByID(ctx context.Context, id string) (*entity.OrderIssue, error) {
+	issue, ok := r.issues[id]
+	if !ok {
+		return nil, repository.ErrOrderIssueNotFound
+	}
+	return issue, nil
+}
+
+func (r *stubIssueRepo) GetReplies(ctx context.Context, issueIDs []string) ([]entity.OrderIssueReply, error) {
+	return r.replies, nil
+}
+
+func (r *stubIssueRepo) AddReply(ctx context.Context, reply *entity.OrderIssueReply) error {
+	issue := r.issues[reply.IssueID]
+	if issue.IsResolved() {
+		return repository.ErrOrderIssueResolved
+	}
+	issue.Status = entity.IssueStatusInProgress
+	r.replies = append(r.replies, *reply)
+	return nil
+}
+
+func TestIssueService_ReportIssue(t *testing.T) {
+	order := &entity.Order{ID: "order-1", UserID: "user-1", TenantID: tenant.DefaultID}
+	req := &request.CreateOrderIssueRequest{
+		Category:    entity.IssueCategoryEmailNotReceived,
+		Description: "No confirmation email",
+	}
+
+	t.Run("opens issue on own order", func(t *testing.T) {
+		issueRepo := &stubIssueRepo{issues: map[string]*entity.OrderIssue{}}
+		svc := NewIssueService(issueRepo, &stubOrderRepo{order: order}, nil, nil)
+
+		issue, err := svc.ReportIssue(context.Background(), "user-1", "order-1", req)
+		require.NoError(t, err)
+		assert.Equal(t, entity.IssueStatusOpen, issue.Status)
+		assert.Equal(t, tenant.DefaultID, issueRepo.issues["issue-new"].TenantID)
+	})
+
+	t.Run("other buyer's order", func(t *testing.T) {
+		svc := NewIssueService(&stubIssueRepo{issues: map[string]*entity.OrderIssue{}}, &stubOrderRepo{order: order}, nil, nil)
+
+		_, err := svc.ReportIssue(context.Background(), "user-2", "order-1", req)
+		assert.ErrorIs(t, err, ErrUnauthorized)
+	})
+
+	t.Run("unknown order", func(t *testing.T) {
+		svc := NewIssueService(&stubIssueRepo{issues: map[string]*entity.OrderIssue{}}, &stubOrderRepo{order: order}, nil, nil)
+
+		_, err := svc.ReportIssue(context.Background(), "user-1", "order-2", req)
+		assert.ErrorIs(t, err, ErrOrderNotFound)
+	})
+
+	t.Run("unresolved issue in same category", func(t *testing.T) {
+		issueRepo := &stubIssueRepo{issues: map[string]*entity.OrderIssue{}, createErr: repository.ErrOrderIssueAlreadyOpen}
+		svc := NewIssueService(issueRepo, &stubOrderRepo{order: order}, nil, nil)
+
+		_, err := svc.ReportIssue(context.Background(), "user-1", "order-1", req)
+		assert.ErrorIs(t, err, ErrIssueAlreadyOpen)
+	})
+}
+
+func TestIssueService_ReplyToIssue(t *testing.T) {
+	const brandTenant = "6f1c2a4e-8d0b-4c55-9a77-3e2f1b0c9d11"
+	newRepo := func(status string) *stubIssueRepo {
+		return &stubIssueRepo{issues: map[string]*entity.OrderIssue{
+			"issue-1": {ID: "issue-1", OrderID: "order-1", TenantID: tenant.DefaultID, Status: status},
+		}}
+	}
+	req := &request.ReplyOrderIssueRequest{Message: "We have resent your tickets"}
+
+	t.Run("open issue moves to in progress", func(t *testing.T) {
+		issueRepo := newRepo(entity.IssueStatusOpen)
+		svc := NewIssueService(issueRepo, nil, nil, nil)
+
+		issue, err := svc.ReplyToIssue(tenant.NewContext(context.Background(), tenant.DefaultID), "admin-1", "issue-1", req)
+		require.NoError(t, err)
+		assert.Equal(t, entity.IssueStatusInProgress, issue.Status)
+		require.Len(t, issue.Replies, 1)
+		assert.Equal(t, "admin-1", issue.Replies[0].AuthorID)
+	})
+
+	t.Run("resolved issue", func(t *testing.T) {
+		svc := NewIssueService(newRepo(entity.IssueStatusResolved), nil, nil, nil)
+
+		_, err := svc.ReplyToIssue(tenant.NewContext(context.Background(), tenant.DefaultID), "admin-1", "issue-1", req)
+		assert.ErrorIs(t, err, ErrIssueResolved)
+	})
+
+	t.Run("issue of another tenant", func(t *testing.T) {
+		issueRepo := newRepo(entity.IssueStatusOpen)
+		svc := NewIssueService(issueRepo, nil, nil, nil)
+
+		_, err := svc.ReplyToIssue(tenant.NewContext(context.Background(), brandTenant), "admin-1", "issue-1", req)
+		assert.ErrorIs(t, err, ErrIssueNotFound)
+		assert.Empty(t, issueRepo.replies)
+	})
+}
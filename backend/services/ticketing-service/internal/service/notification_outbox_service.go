@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+)
+
+// Notification outbox limits
+const (
+	outboxBatchSize       = 50              // Messages claimed per run
+	outboxLease           = 2 * time.Minute // How long a claimed message is left to its worker
+	outboxRetryDelay      = time.Minute     // Doubled with each failed attempt
+	outboxMaxRetryDelay   = time.Hour
+	defaultOutboxAttempts = 8 // Attempts before a message is dead-lettered, when not configured
+)
+
+// NotificationHandler sends a claimed outbox message of one type
+// Returning an error retries the message later, until it runs out of attempts
+type NotificationHandler func(ctx context.Context, message *entity.NotificationOutbox) error
+
+// NotificationOutboxService retries emails that failed to send
+type NotificationOutboxService interface {
+	SendDue(ctx context.Context) (int, error)
+}
+
+// notificationOutboxService implements NotificationOutboxService interface
+type notificationOutboxService struct {
+	outboxRepo  repository.NotificationOutboxRepository
+	handlers    map[string]NotificationHandler
+	maxAttempts int
+}
+
+// NewNotificationOutboxService creates new notification outbox service instance
+// handlers maps a message type to the handler that sends it; maxAttempts <= 0 uses the default of 8
+func NewNotificationOutboxService(outboxRepo repository.NotificationOutboxRepository, handlers map[string]NotificationHandler, maxAttempts int) NotificationOutboxService {
+	if maxAttempts <= 0 {
+		maxAttempts = defaultOutboxAttempts
+	}
+	return &notificationOutboxService{
+		outboxRepo:  outboxRepo,
+		handlers:    handlers,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// SendDue claims one batch of due messages and sends them, returning how many were sent
+// Failed messages are retried with exponential backoff and dead-lettered after the last attempt
+func (s *notificationOutboxService) SendDue(ctx context.Context) (int, error) {
+	messages, err := s.outboxRepo.ClaimDue(ctx, outboxBatchSize, time.Now().Add(outboxLease))
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for i := range messages {
+		message := &messages[i]
+
+		handler, ok := s.handlers[message.Type]
+		if !ok {
+			if err := s.deadLetter(ctx, message, fmt.Sprintf("no handler for notification type %s", message.Type)); err != nil {
+				return sent, err
+			}
+			continue
+		}
+
+		if err := handler(ctx, message); err != nil {
+			if message.Attempts >= s.maxAttempts {
+				if err := s.deadLetter(ctx, message, err.Error()); err != nil {
+					return sent, err
+				}
+				continue
+			}
+
+			log.Printf("[NotificationOutbox] %s of order %s failed (attempt %d), will retry: %v", message.Type, message.OrderID, message.Attempts, err)
+			if err := s.outboxRepo.Retry(ctx, message.ID, err.Error(), time.Now().Add(outboxRetryBackoff(message.Attempts))); err != nil {
+				return sent, err
+			}
+			continue
+		}
+
+		if err := s.outboxRepo.MarkSent(ctx, message.ID); err != nil {
+			return sent, err
+		}
+		log.Printf("[NotificationOutbox] %s of order %s sent on attempt %d", message.Type, message.OrderID, message.Attempts)
+		sent++
+	}
+
+	return sent, nil
+}
+
+// deadLetter gives up on a message and reports it, so the buyer is reached by support instead
+func (s *notificationOutboxService) deadLetter(ctx context.Context, message *entity.NotificationOutbox, lastError string) error {
+	log.Printf("[ERROR] [NotificationOutbox] %s of order %s dead-lettered after %d attempts: %s", message.Type, message.OrderID, message.Attempts, lastError)
+	errreport.CaptureMessage("notification dead-lettered: "+lastError, map[string]string{
+		"notification_type": message.Type,
+		"order_id":          message.OrderID,
+	})
+	return s.outboxRepo.MarkDead(ctx, message.ID, lastError)
+}
+
+// outboxRetryBackoff returns how long a message waits after its nth failed attempt
+func outboxRetryBackoff(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	if attempts > 7 { // outboxRetryDelay << 6 already exceeds the max delay
+		return outboxMaxRetryDelay
+	}
+	delay := outboxRetryDelay << (attempts - 1)
+	if delay > outboxMaxRetryDelay {
+		return outboxMaxRetryDelay
+	}
+	return delay
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubOutboxRepo records queued messages, hands out its due messages once and records how each ended
+type stubOutboxRepo struct {
+	repository.NotificationOutboxRepository
+	queued  []*entity.NotificationOutbox
+	due     []entity.NotificationOutbox
+	sent    []string
+	retries map[string]time.Time
+	dead    map[string]string
+}
+
+func (r *stubOutboxRepo) Enqueue(ctx context.Context, message *entity.NotificationOutbox) error {
+	message.Status = entity.OutboxStatusPending
+	r.queued = append(r.queued, message)
+	return nil
+}
+
+func (r *stubOutboxRepo) ClaimDue(ctx context.Context, limit int, lockedUntil time.Time) ([]entity.NotificationOutbox, error) {
+	messages := r.due
+	r.due = nil
+	return messages, nil
+}
+
+func (r *stubOutboxRepo) MarkSent(ctx context.Context, id string) error {
+	r.sent = append(r.sent, id)
+	return nil
+}
+
+func (r *stubOutboxRepo) Retry(ctx context.Context, id, lastError string, nextAttemptAt time.Time) error {
+	r.retries[id] = nextAttemptAt
+	return nil
+}
+
+func (r *stubOutboxRepo) MarkDead(ctx context.Context, id, lastError string) error {
+	r.dead[id] = lastError
+	return nil
+}
+
+func TestNotificationOutboxService_SendDue(t *testing.T) {
+	repo := &stubOutboxRepo{
+		due: []entity.NotificationOutbox{
+			{ID: "msg-ok", Type: "ok", OrderID: "order-1", Attempts: 2},
+			{ID: "msg-retry", Type: "flaky", OrderID: "order-2", Attempts: 3},
+			{ID: "msg-last", Type: "flaky", OrderID: "order-3", Attempts: 4},
+			{ID: "msg-unknown", Type: "unknown", OrderID: "order-4", Attempts: 2},
+		},
+		retries: map[string]time.Time{},
+		dead:    map[string]string{},
+	}
+	svc := NewNotificationOutboxService(repo, map[string]NotificationHandler{
+		"ok": func(ctx context.Context, message *entity.NotificationOutbox) error { return nil },
+		"flaky": func(ctx context.Context, message *entity.NotificationOutbox) error {
+			return errors.New("notification service unavailable")
+		},
+	}, 4)
+
+	before := time.Now()
+	sent, err := svc.SendDue(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, []string{"msg-ok"}, repo.sent)
+
+	// The third failed attempt waits four times the retry delay
+	require.Contains(t, repo.retries, "msg-retry")
+	assert.WithinDuration(t, before.Add(4*outboxRetryDelay), repo.retries["msg-retry"], time.Second)
+
+	assert.Equal(t, "notification service unavailable", repo.dead["msg-last"])
+	assert.Equal(t, "no handler for notification type unknown", repo.dead["msg-unknown"])
+}
+
+func TestOutboxRetryBackoff(t *testing.T) {
+	assert.Equal(t, outboxRetryDelay, outboxRetryBackoff(0))
+	assert.Equal(t, outboxRetryDelay, outboxRetryBackoff(1))
+	assert.Equal(t, 8*outboxRetryDelay, outboxRetryBackoff(4))
+	assert.Equal(t, outboxMaxRetryDelay, outboxRetryBackoff(7), "capped at the max delay")
+	assert.Equal(t, outboxMaxRetryDelay, outboxRetryBackoff(100))
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+)
+
+// ErrDigestsDisabled is returned when notification service can't collect notifications in digests
+var ErrDigestsDisabled = client.ErrDigestsDisabled
+
+// DigestPreferenceClient defines interface for digest preferences (notification service)
+type DigestPreferenceClient interface {
+	GetDigestPreference(ctx context.Context, email string) (*client.DigestPreference, error)
+	SetDigestPreference(ctx context.Context, email, frequency string) (*client.DigestPreference, error)
+}
+
+// NotificationPreferenceService handles the notification preferences of users
+// Preferences are kept by notification service, keyed by email address
+type NotificationPreferenceService interface {
+	GetPreference(ctx context.Context, email string) (*response.NotificationPreferenceResponse, error)
+	UpdatePreference(ctx context.Context, email string, req *request.UpdateNotificationPreferenceRequest) (*response.NotificationPreferenceResponse, error)
+}
+
+// notificationPreferenceService implements NotificationPreferenceService interface
+type notificationPreferenceService struct {
+	digests DigestPreferenceClient
+}
+
+// NewNotificationPreferenceService creates new notification preference service instance
+func NewNotificationPreferenceService(digests DigestPreferenceClient) NotificationPreferenceService {
+	return &notificationPreferenceService{digests: digests}
+}
+
+// GetPreference returns the user's digest frequency and waiting notifications
+// Returns ErrUnauthorized for tokens without an email address
+func (s *notificationPreferenceService) GetPreference(ctx context.Context, email string) (*response.NotificationPreferenceResponse, error) {
+	if email == "" {
+		return nil, ErrUnauthorized
+	}
+
+	preference, err := s.digests.GetDigestPreference(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get digest preference: %w", err)
+	}
+	return notificationPreferenceResponse(preference), nil
+}
+
+// UpdatePreference changes the user's digest frequency
+// Notifications already waiting are sent on the new schedule, right away when switching to immediate
+func (s *notificationPreferenceService) UpdatePreference(ctx context.Context, email string, req *request.UpdateNotificationPreferenceRequest) (*response.NotificationPreferenceResponse, error) {
+	if email == "" {
+		return nil, ErrUnauthorized
+	}
+
+	preference, err := s.digests.SetDigestPreference(ctx, email, req.DigestFrequency)
+	if err != nil {
+		if errors.Is(err, client.ErrDigestsDisabled) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to set digest preference: %w", err)
+	}
+	return notificationPreferenceResponse(preference), nil
+}
+
+func notificationPreferenceResponse(preference *client.DigestPreference) *response.NotificationPreferenceResponse {
+	digestTypes := preference.DigestTypes
+	if digestTypes == nil {
+		digestTypes = []string{}
+	}
+	return &response.NotificationPreferenceResponse{
+		Email:           preference.Email,
+		DigestFrequency: preference.Frequency,
+		DigestTypes:     digestTypes,
+		PendingCount:    preference.PendingCount,
+		NextDigestAt:    preference.NextDigestAt,
+	}
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationPreferenceService_UpdatePreference(t *testing.T) {
+	notifications := &testutil.NotificationClient{}
+	svc := NewNotificationPreferenceService(notifications)
+	ctx := context.Background()
+
+	preference, err := svc.GetPreference(ctx, "buyer@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "immediate", preference.DigestFrequency)
+	assert.Equal(t, []string{"announcement"}, preference.DigestTypes)
+
+	preference, err = svc.UpdatePreference(ctx, "buyer@example.com", &request.UpdateNotificationPreferenceRequest{DigestFrequency: "daily"})
+	require.NoError(t, err)
+	assert.Equal(t, "daily", preference.DigestFrequency)
+
+	preference, err = svc.GetPreference(ctx, "buyer@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "daily", preference.DigestFrequency)
+
+	// Preferences are keyed by email, tokens without one can't have them
+	_, err = svc.GetPreference(ctx, "")
+	assert.ErrorIs(t, err, ErrUnauthorized)
+}
+
+func TestNotificationPreferenceService_DigestsDisabled(t *testing.T) {
+	notifications := &testutil.NotificationClient{
+		SetDigestPreferenceFunc: func(ctx context.Context, email, frequency string) (*client.DigestPreference, error) {
+			return nil, fmt.Errorf("%w: notification digests are disabled", client.ErrDigestsDisabled)
+		},
+	}
+	svc := NewNotificationPreferenceService(notifications)
+
+	_, err := svc.UpdatePreference(context.Background(), "buyer@example.com", &request.UpdateNotificationPreferenceRequest{DigestFrequency: "weekly"})
+	assert.ErrorIs(t, err, ErrDigestsDisabled)
+}
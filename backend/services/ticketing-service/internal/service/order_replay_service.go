@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/featureflags"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+)
+
+var (
+	ErrOrderNotEventSourced = errors.New("order is not event-sourced")
+)
+
+// orderReplayBatchSize is how many streams ReplayAll loads at once
+const orderReplayBatchSize = 100
+
+// OrderReplayService reads and replays the streams of event-sourced orders
+type OrderReplayService interface {
+	GetOrderEvents(ctx context.Context, orderID string) ([]response.OrderEventResponse, error)
+	ReplayOrder(ctx context.Context, orderID string) (*response.OrderReplayResponse, error)
+	ReplayAll(ctx context.Context) (*response.OrderReplaySummaryResponse, error)
+}
+
+// orderReplayService implements OrderReplayService interface
+type orderReplayService struct {
+	orderRepo      repository.OrderRepository
+	orderEventRepo repository.OrderEventRepository
+}
+
+// NewOrderReplayService creates new order replay service instance
+func NewOrderReplayService(orderRepo repository.OrderRepository, orderEventRepo repository.OrderEventRepository) OrderReplayService {
+	return &orderReplayService{
+		orderRepo:      orderRepo,
+		orderEventRepo: orderEventRepo,
+	}
+}
+
+// eventSourcedOrders reports whether a new order of userID for event is event-sourced
+// Decided once, at creation, by the event_sourced_orders flag of the event's organizer
+func eventSourcedOrders(ctx context.Context, flags *featureflags.Client, userID string, event *entity.Event) bool {
+	target := featureflags.Target{UserID: userID, OrganizerID: event.OrganizerID}
+	return flags.Bool(ctx, featureflags.EventSourcedOrders, target, false)
+}
+
+// GetOrderEvents returns the stream of an event-sourced order, oldest first
+// Streams of archived orders are kept, so they stay readable as audit trail
+func (s *orderReplayService) GetOrderEvents(ctx context.Context, orderID string) ([]response.OrderEventResponse, error) {
+	events, err := s.orderEventRepo.GetStream(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, ErrOrderNotEventSourced
+	}
+	return response.ToOrderEventResponses(events), nil
+}
+
+// ReplayOrder rebuilds an event-sourced order from its stream
+// The orders row is only rewritten when it differs from the replayed state
+func (s *orderReplayService) ReplayOrder(ctx context.Context, orderID string) (*response.OrderReplayResponse, error) {
+	events, err := s.orderEventRepo.GetStream(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, ErrOrderNotEventSourced
+	}
+
+	replayed, err := entity.ProjectOrder(events)
+	if err != nil {
+		return nil, fmt.Errorf("failed to replay order %s: %w", orderID, err)
+	}
+
+	current, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil && !errors.Is(err, repository.ErrOrderNotFound) {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	result := &response.OrderReplayResponse{OrderID: orderID, Events: len(events), Status: replayed.Status}
+	if current != nil && sameOrderState(current, replayed) {
+		return result, nil
+	}
+
+	if err := s.orderEventRepo.Project(ctx, replayed); err != nil {
+		if errors.Is(err, repository.ErrOrderNotFound) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, err
+	}
+
+	log.Printf("[OrderReplay] Order %s rebuilt from %d events (status %s)", orderID, len(events), replayed.Status)
+	result.Changed = true
+	return result, nil
+}
+
+// ReplayAll rebuilds every event-sourced order still in the orders table
+// An order that fails is reported and skipped, the others are still replayed
+func (s *orderReplayService) ReplayAll(ctx context.Context) (*response.OrderReplaySummaryResponse, error) {
+	summary := &response.OrderReplaySummaryResponse{Failed: []string{}}
+
+	after := ""
+	for {
+		orderIDs, err := s.orderEventRepo.GetStreamOrderIDs(ctx, after, orderReplayBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, orderID := range orderIDs {
+			result, err := s.ReplayOrder(ctx, orderID)
+			switch {
+			case errors.Is(err, ErrOrderNotFound):
+				summary.Skipped++
+			case err != nil:
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				log.Printf("[OrderReplay] Failed to replay order %s: %v", orderID, err)
+				summary.Failed = append(summary.Failed, orderID)
+			default:
+				summary.Replayed++
+				if result.Changed {
+					summary.Changed++
+				}
+			}
+		}
+
+		if len(orderIDs) < orderReplayBatchSize {
+			return summary, nil
+		}
+		after = orderIDs[len(orderIDs)-1]
+	}
+}
+
+// sameOrderState reports whether two orders have the same recorded state
+func sameOrderState(a, b *entity.Order) bool {
+	return a.UserID == b.UserID &&
+		a.EventID == b.EventID &&
+		a.TenantID == b.TenantID &&
+		a.TotalAmount == b.TotalAmount &&
+		a.PlatformFee == b.PlatformFee &&
+		a.ServiceFee == b.ServiceFee &&
+		a.GrandTotal == b.GrandTotal &&
+		a.Status == b.Status &&
+		a.IsSandbox == b.IsSandbox &&
+		equalPtr(a.PaymentID, b.PaymentID) &&
+		equalPtr(a.PaymentMethod, b.PaymentMethod) &&
+		equalPtr(a.PaidAmount, b.PaidAmount) &&
+		equalPtr(a.PaidCurrency, b.PaidCurrency) &&
+		equalPtr(a.AmountDiscrepancy, b.AmountDiscrepancy) &&
+		equalTimePtr(a.ReservationExpiresAt, b.ReservationExpiresAt) &&
+		equalTimePtr(a.CompletedAt, b.CompletedAt) &&
+		len(a.Metadata) == len(b.Metadata) &&
+		sameMetadata(a.Metadata, b.Metadata)
+}
+
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func equalTimePtr(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func sameMetadata(a, b entity.OrderMetadata) bool {
+	for key, value := range a {
+		if other, ok := b[key]; !ok || other != value {
+			return false
+		}
+	}
+	return true
+}
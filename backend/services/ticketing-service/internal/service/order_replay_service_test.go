@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubReplayOrderRepo serves fixed orders rows
+type stubReplayOrderRepo struct {
+	repository.OrderRepository
+	orders map[string]*entity.Order
+}
+
+func (r *stubReplayOrderRepo) GetByID(ctx context.Context, id string) (*entity.Order, error) {
+	order, ok := r.orders[id]
+	if !ok {
+		return nil, repository.ErrOrderNotFound
+	}
+	copied := *order
+	return &copied, nil
+}
+
+// stubOrderEventRepo serves fixed streams and records projections into the orders rows
+type stubOrderEventRepo struct {
+	streams   map[string][]entity.OrderEvent
+	orders    *stubReplayOrderRepo
+	projected []string
+}
+
+func (r *stubOrderEventRepo) GetStream(ctx context.Context, orderID string) ([]entity.OrderEvent, error) {
+	return r.streams[orderID], nil
+}
+
+func (r *stubOrderEventRepo) GetStreamOrderIDs(ctx context.Context, afterOrderID string, limit int) ([]string, error) {
+	orderIDs := []string{}
+	for _, orderID := range []string{"order-1", "order-2", "order-3"} {
+		if _, ok := r.streams[orderID]; ok && orderID > afterOrderID && len(orderIDs) < limit {
+			orderIDs = append(orderIDs, orderID)
+		}
+	}
+	return orderIDs, nil
+}
+
+func (r *stubOrderEventRepo) Project(ctx context.Context, order *entity.Order) error {
+	if _, ok := r.orders.orders[order.ID]; !ok {
+		return repository.ErrOrderNotFound
+	}
+	r.projected = append(r.projected, order.ID)
+	r.orders.orders[order.ID] = order
+	return nil
+}
+
+// paidOrderStream records an order reserved and then paid
+func paidOrderStream(t *testing.T, orderID string) []entity.OrderEvent {
+	t.Helper()
+	createdAt := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	order := &entity.Order{
+		ID:          orderID,
+		UserID:      "user-1",
+		EventID:     "event-1",
+		TotalAmount: money.New(100000),
+		GrandTotal:  money.New(100000),
+		Status:      entity.OrderStatusReserved,
+		Metadata:    entity.OrderMetadata{"partner_ref": "abc"},
+	}
+	created, err := entity.NewOrderCreatedEvent(order)
+	require.NoError(t, err)
+	created.Version, created.CreatedAt = 1, createdAt
+
+	paymentID := "payment-1"
+	paidAmount := money.New(100000)
+	order.Status = entity.OrderStatusPaid
+	order.PaymentID = &paymentID
+	order.PaidAmount = &paidAmount
+	paid, err := entity.NewOrderChangedEvent(order)
+	require.NoError(t, err)
+	paid.Version, paid.CreatedAt = 2, createdAt.Add(5*time.Minute)
+
+	return []entity.OrderEvent{*created, *paid}
+}
+
+func newReplayFixture(t *testing.T) (*orderReplayService, *stubOrderEventRepo) {
+	orderRepo := &stubReplayOrderRepo{orders: map[string]*entity.Order{}}
+	eventRepo := &stubOrderEventRepo{streams: map[string][]entity.OrderEvent{}, orders: orderRepo}
+	for _, orderID := range []string{"order-1", "order-2", "order-3"} {
+		stream := paidOrderStream(t, orderID)
+		eventRepo.streams[orderID] = stream
+		projected, err := entity.ProjectOrder(stream)
+		require.NoError(t, err)
+		orderRepo.orders[orderID] = projected
+	}
+	return &orderReplayService{orderRepo: orderRepo, orderEventRepo: eventRepo}, eventRepo
+}
+
+func TestProjectOrder(t *testing.T) {
+	order, err := entity.ProjectOrder(paidOrderStream(t, "order-1"))
+	require.NoError(t, err)
+
+	assert.Equal(t, entity.OrderStatusPaid, order.Status)
+	assert.Equal(t, "payment-1", *order.PaymentID)
+	assert.Equal(t, money.New(100000), *order.PaidAmount)
+	assert.Equal(t, "abc", order.Metadata["partner_ref"])
+	assert.True(t, order.EventSourced)
+	assert.Equal(t, time.Date(2026, 3, 1, 10, 5, 0, 0, time.UTC), order.UpdatedAt)
+
+	stream := paidOrderStream(t, "order-1")
+	_, err = entity.ProjectOrder(stream[1:])
+	assert.Error(t, err, "a stream must start with order_created")
+
+	stream[1].Version = 3
+	_, err = entity.ProjectOrder(stream)
+	assert.Error(t, err, "a stream must not skip versions")
+}
+
+func TestReplayOrder_RewritesDriftedRow(t *testing.T) {
+	svc, eventRepo := newReplayFixture(t)
+	eventRepo.orders.orders["order-1"].Status = entity.OrderStatusExpired // e.g. written by a buggy job
+
+	result, err := svc.ReplayOrder(context.Background(), "order-1")
+	require.NoError(t, err)
+
+	assert.True(t, result.Changed)
+	assert.Equal(t, entity.OrderStatusPaid, result.Status)
+	assert.Equal(t, []string{"order-1"}, eventRepo.projected)
+	assert.Equal(t, entity.OrderStatusPaid, eventRepo.orders.orders["order-1"].Status)
+
+	result, err = svc.ReplayOrder(context.Background(), "order-1")
+	require.NoError(t, err)
+	assert.False(t, result.Changed, "a row matching its stream is left as it is")
+}
+
+func TestReplayOrder_Rejected(t *testing.T) {
+	svc, eventRepo := newReplayFixture(t)
+
+	_, err := svc.ReplayOrder(context.Background(), "crud-order")
+	assert.ErrorIs(t, err, ErrOrderNotEventSourced)
+
+	delete(eventRepo.orders.orders, "order-1") // archived
+	_, err = svc.ReplayOrder(context.Background(), "order-1")
+	assert.ErrorIs(t, err, ErrOrderNotFound)
+}
+
+func TestReplayAll(t *testing.T) {
+	svc, eventRepo := newReplayFixture(t)
+	eventRepo.orders.orders["order-2"].Status = entity.OrderStatusCancelled
+	delete(eventRepo.orders.orders, "order-3")
+	eventRepo.streams["order-1"][1].Version = 5
+
+	summary, err := svc.ReplayAll(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, summary.Replayed)
+	assert.Equal(t, 1, summary.Changed)
+	assert.Equal(t, 1, summary.Skipped)
+	assert.Equal(t, []string{"order-1"}, summary.Failed)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
 )
@@ -23,25 +25,45 @@ type OrderService interface {
 	GetOrderByID(ctx context.Context, userID, orderID string) (*response.OrderResponse, error)
 	GetUserOrders(ctx context.Context, userID string, page, limit int) ([]response.OrderResponse, int64, error)
 	CancelOrder(ctx context.Context, userID, orderID string) error
+
+	// API v2 views with event details, fee breakdown and payment info
+	GetOrderByIDV2(ctx context.Context, userID, orderID string) (*response.OrderV2Response, error)
+	GetUserOrdersV2(ctx context.Context, userID string, page, limit int) ([]response.OrderV2Response, int64, error)
+
+	// Support view of any order of the request tenant
+	GetOrderForSupport(ctx context.Context, orderID string) (*response.OrderV2Response, error)
+	SearchOrdersByMetadata(ctx context.Context, key string, value *string, page, limit int) ([]response.OrderV2Response, int64, error)
 }
 
 // orderService implements OrderService interface
 type orderService struct {
-	orderRepo         repository.OrderRepository
-	orderItemRepo     repository.OrderItemRepository
+	orderRepo          repository.OrderRepository
+	orderItemRepo      repository.OrderItemRepository
+	eventRepo          repository.EventRepository
+	ticketTierRepo     repository.TicketTierRepository
 	reservationService ReservationService
+	paymentClient      PaymentClient
+	currency           string
 }
 
 // NewOrderService creates new order service instance
 func NewOrderService(
 	orderRepo repository.OrderRepository,
 	orderItemRepo repository.OrderItemRepository,
+	eventRepo repository.EventRepository,
+	ticketTierRepo repository.TicketTierRepository,
 	reservationService ReservationService,
+	paymentClient PaymentClient,
+	currency string,
 ) OrderService {
 	return &orderService{
-		orderRepo:         orderRepo,
-		orderItemRepo:     orderItemRepo,
+		orderRepo:          orderRepo,
+		orderItemRepo:      orderItemRepo,
+		eventRepo:          eventRepo,
+		ticketTierRepo:     ticketTierRepo,
 		reservationService: reservationService,
+		paymentClient:      paymentClient,
+		currency:           currency,
 	}
 }
 
@@ -128,13 +150,179 @@ func (s *orderService) CancelOrder(ctx context.Context, userID, orderID string)
 		return fmt.Errorf("failed to release reservation: %w", err)
 	}
 
+	// Expire the order's invoice so the buyer can no longer pay it (best effort)
+	// A payment that still settles is refunded by Payment Service when its webhook arrives
+	if s.paymentClient != nil {
+		result, err := s.paymentClient.ExpireInvoice(ctx, orderID, "cancelled")
+		if err != nil {
+			log.Printf("[WARNING] Failed to expire invoice of cancelled order %s: %v", orderID, err)
+		} else if result.AlreadyPaid {
+			log.Printf("[INFO] Cancelled order %s was already paid, payment will be refunded", orderID)
+		}
+	}
+
 	// NOTE: Paid orders cannot be cancelled via this endpoint
-	// Refund requests must go through Payment Service which will:
-	// 1. Validate refund eligibility (event date, refund policy)
-	// 2. Process refund via Xendit
-	// 3. Update order status to 'refunded'
-	// 4. Cancel tickets
-	// 5. Adjust inventory if needed
+	// Buyers refund them through Payment Service, which refunds the payment via Xendit
+	// after RefundService.RefundOrder marked the order refunded and voided its tickets
 
 	return nil
 }
+
+// GetOrderByIDV2 retrieves order by ID with event details, fee breakdown and payment info
+func (s *orderService) GetOrderByIDV2(ctx context.Context, userID, orderID string) (*response.OrderV2Response, error) {
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, repository.ErrOrderNotFound) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	// Check authorization
+	if order.UserID != userID {
+		return nil, ErrUnauthorized
+	}
+
+	return s.toOrderV2DetailResponse(ctx, order)
+}
+
+// GetOrderForSupport retrieves any order of the request tenant with event details, fee breakdown and payment info
+func (s *orderService) GetOrderForSupport(ctx context.Context, orderID string) (*response.OrderV2Response, error) {
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, repository.ErrOrderNotFound) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	// Orders of other tenants are not visible to this tenant's support
+	if order.TenantID != tenantFromContext(ctx) {
+		return nil, ErrOrderNotFound
+	}
+
+	return s.toOrderV2DetailResponse(ctx, order)
+}
+
+// SearchOrdersByMetadata retrieves orders of the request tenant having metadata key, with exactly value if given
+func (s *orderService) SearchOrdersByMetadata(ctx context.Context, key string, value *string, page, limit int) ([]response.OrderV2Response, int64, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+
+	offset := (page - 1) * limit
+
+	orders, total, err := s.orderRepo.SearchByMetadata(ctx, tenantFromContext(ctx), key, value, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search orders: %w", err)
+	}
+
+	orderResponses, err := s.toOrderV2Responses(ctx, orders)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return orderResponses, total, nil
+}
+
+// toOrderV2DetailResponse converts a single order, enriched with invoice details from Payment Service
+func (s *orderService) toOrderV2DetailResponse(ctx context.Context, order *entity.Order) (*response.OrderV2Response, error) {
+	orderID := order.ID
+	orderResponses, err := s.toOrderV2Responses(ctx, []entity.Order{*order})
+	if err != nil {
+		return nil, err
+	}
+	orderResp := &orderResponses[0]
+
+	// Enrich with invoice details from Payment Service (best effort)
+	if s.paymentClient != nil {
+		payment, err := s.paymentClient.GetPaymentStatus(ctx, orderID)
+		if err != nil {
+			log.Printf("[WARNING] Failed to get payment status for order %s: %v", orderID, err)
+		} else {
+			if payment.InvoiceURL != "" {
+				orderResp.Payment.InvoiceURL = &payment.InvoiceURL
+			}
+			if !payment.ExpiresAt.IsZero() {
+				orderResp.Payment.InvoiceExpiresAt = &payment.ExpiresAt
+			}
+		}
+	}
+
+	return orderResp, nil
+}
+
+// GetUserOrdersV2 retrieves all orders for a user with pagination, including event details
+// Payment Service is not queried per order; payment info comes from the order record
+func (s *orderService) GetUserOrdersV2(ctx context.Context, userID string, page, limit int) ([]response.OrderV2Response, int64, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+
+	offset := (page - 1) * limit
+
+	orders, total, err := s.orderRepo.GetByUserID(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get user orders: %w", err)
+	}
+
+	orderResponses, err := s.toOrderV2Responses(ctx, orders)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return orderResponses, total, nil
+}
+
+// toOrderV2Responses loads items, events and ticket tiers for orders and converts them
+// Events and tiers are fetched in one query each regardless of the number of orders
+func (s *orderService) toOrderV2Responses(ctx context.Context, orders []entity.Order) ([]response.OrderV2Response, error) {
+	itemsByOrder := make(map[string][]entity.OrderItem, len(orders))
+	eventIDs := make([]string, 0, len(orders))
+	tierIDs := []string{}
+
+	for _, order := range orders {
+		items, err := s.orderItemRepo.GetByOrderID(ctx, order.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get order items: %w", err)
+		}
+		itemsByOrder[order.ID] = items
+
+		eventIDs = append(eventIDs, order.EventID)
+		for _, item := range items {
+			tierIDs = append(tierIDs, item.TicketTierID)
+		}
+	}
+
+	events, err := s.eventRepo.GetByIDs(ctx, eventIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+	eventsByID := make(map[string]*entity.Event, len(events))
+	for i := range events {
+		eventsByID[events[i].ID] = &events[i]
+	}
+
+	tiers, err := s.ticketTierRepo.GetByIDs(ctx, tierIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket tiers: %w", err)
+	}
+	tiersByID := make(map[string]entity.TicketTier, len(tiers))
+	for _, tier := range tiers {
+		tiersByID[tier.ID] = tier
+	}
+
+	orderResponses := make([]response.OrderV2Response, 0, len(orders))
+	for i := range orders {
+		order := &orders[i]
+		orderResponses = append(orderResponses, *response.ToOrderV2Response(order, itemsByOrder[order.ID], eventsByID[order.EventID], tiersByID, s.currency))
+	}
+
+	return orderResponses, nil
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+)
+
+var (
+	ErrPolicyNotFound           = errors.New("policy not found")
+	ErrInvalidPolicyScope       = errors.New("terms are platform wide, refund and health policies belong to an event")
+	ErrPolicyAcceptanceRequired = errors.New("current policies must be accepted to order")
+)
+
+// PolicyDocumentService handles the terms and event policies buyers accept at checkout
+// Platform terms are published by admins, event policies by the event's organizer
+type PolicyDocumentService interface {
+	GetCurrentPolicies(ctx context.Context, eventID string) ([]response.PolicyDocumentResponse, error)
+	GetPolicy(ctx context.Context, id string) (*response.PolicyDocumentResponse, error)
+	PublishPolicy(ctx context.Context, userID, role string, req *request.PublishPolicyRequest) (*response.PolicyDocumentResponse, error)
+	GetOrderPolicies(ctx context.Context, userID, orderID string) ([]response.PolicyAcceptanceResponse, error)
+}
+
+// policyDocumentService implements PolicyDocumentService interface
+type policyDocumentService struct {
+	policyDocumentRepo repository.PolicyDocumentRepository
+	eventRepo          repository.EventRepository
+	orderRepo          repository.OrderRepository
+}
+
+// NewPolicyDocumentService creates new policy document service instance
+func NewPolicyDocumentService(
+	policyDocumentRepo repository.PolicyDocumentRepository,
+	eventRepo repository.EventRepository,
+	orderRepo repository.OrderRepository,
+) PolicyDocumentService {
+	return &policyDocumentService{
+		policyDocumentRepo: policyDocumentRepo,
+		eventRepo:          eventRepo,
+		orderRepo:          orderRepo,
+	}
+}
+
+// GetCurrentPolicies retrieves the current platform terms of the request tenant
+// and, if eventID is set, the current policies of the event including its standard refund policy
+func (s *policyDocumentService) GetCurrentPolicies(ctx context.Context, eventID string) ([]response.PolicyDocumentResponse, error) {
+	tenantID := tenantFromContext(ctx)
+
+	var event *entity.Event
+	if eventID != "" {
+		var err error
+		event, err = s.eventRepo.GetByID(ctx, eventID)
+		if err != nil {
+			if errors.Is(err, repository.ErrEventNotFound) {
+				return nil, ErrEventNotFound
+			}
+			return nil, fmt.Errorf("failed to get event: %w", err)
+		}
+		if event.TenantID != tenantID {
+			return nil, ErrEventNotFound
+		}
+	}
+
+	documents, err := currentPolicies(ctx, s.policyDocumentRepo, tenantID, event)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]response.PolicyDocumentResponse, len(documents))
+	for i := range documents {
+		policies[i] = *response.ToPolicyDocumentResponse(&documents[i])
+	}
+
+	return policies, nil
+}
+
+// GetPolicy retrieves a policy version of the request tenant, current or not,
+// so the version accepted with an order can be looked up from its receipt
+func (s *policyDocumentService) GetPolicy(ctx context.Context, id string) (*response.PolicyDocumentResponse, error) {
+	document, err := s.policyDocumentRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrPolicyDocumentNotFound) {
+			return nil, ErrPolicyNotFound
+		}
+		return nil, err
+	}
+
+	if document.TenantID != tenantFromContext(ctx) {
+		return nil, ErrPolicyNotFound
+	}
+
+	return response.ToPolicyDocumentResponse(document), nil
+}
+
+// PublishPolicy publishes a new version of a policy, which buyers must accept from then on
+// Publishing the current text again returns the current version unchanged
+func (s *policyDocumentService) PublishPolicy(ctx context.Context, userID, role string, req *request.PublishPolicyRequest) (*response.PolicyDocumentResponse, error) {
+	if (req.EventID == "") != (req.Type == entity.PolicyTypeTerms) {
+		return nil, ErrInvalidPolicyScope
+	}
+
+	document := &entity.PolicyDocument{
+		TenantID:    tenantFromContext(ctx),
+		Type:        req.Type,
+		Title:       req.Title,
+		Content:     req.Content,
+		VersionHash: entity.PolicyVersionHash(req.Title, req.Content),
+		PublishedBy: userID,
+	}
+
+	if req.EventID == "" {
+		if role != entity.UserRoleAdmin {
+			return nil, ErrUnauthorized
+		}
+	} else {
+		event, err := managedEvent(ctx, s.eventRepo, userID, role, req.EventID)
+		if err != nil {
+			return nil, err
+		}
+		// The refund policy of an event with a standard template is the template's text
+		if req.Type == entity.PolicyTypeRefund && event.RefundPolicy != nil {
+			return nil, ErrRefundPolicyTemplate
+		}
+		document.TenantID = event.TenantID
+		document.EventID = &event.ID
+	}
+
+	current, err := s.policyDocumentRepo.GetCurrent(ctx, document.TenantID, req.EventID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range current {
+		if current[i].Type == document.Type && current[i].VersionHash == document.VersionHash {
+			return response.ToPolicyDocumentResponse(&current[i]), nil
+		}
+	}
+
+	if err := s.policyDocumentRepo.Create(ctx, document); err != nil {
+		return nil, err
+	}
+
+	return response.ToPolicyDocumentResponse(document), nil
+}
+
+// GetOrderPolicies retrieves the policy versions the buyer accepted with their order
+func (s *policyDocumentService) GetOrderPolicies(ctx context.Context, userID, orderID string) ([]response.PolicyAcceptanceResponse, error) {
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, repository.ErrOrderNotFound) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	if order.UserID != userID {
+		return nil, ErrUnauthorized
+	}
+
+	acceptances, err := s.policyDocumentRepo.GetAcceptancesByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.ToPolicyAcceptanceResponses(acceptances), nil
+}
+
+// policyAcceptances builds the acceptances of every current policy for an order, OrderID is set by the caller
+// Returns ErrPolicyAcceptanceRequired if a current version is missing from the accepted hashes
+func policyAcceptances(current []entity.PolicyDocument, acceptedHashes []string, userID string) ([]entity.PolicyAcceptance, error) {
+	accepted := make(map[string]bool, len(acceptedHashes))
+	for _, hash := range acceptedHashes {
+		accepted[strings.ToLower(hash)] = true
+	}
+
+	acceptances := make([]entity.PolicyAcceptance, len(current))
+	for i, document := range current {
+		if !accepted[document.VersionHash] {
+			return nil, fmt.Errorf("%w: %s %q version %s", ErrPolicyAcceptanceRequired, document.Type, document.Title, document.VersionHash)
+		}
+		acceptances[i] = entity.PolicyAcceptance{
+			PolicyDocumentID: document.ID,
+			UserID:           userID,
+			Type:             document.Type,
+			Title:            document.Title,
+			VersionHash:      document.VersionHash,
+			EventID:          document.EventID,
+		}
+	}
+
+	return acceptances, nil
+}
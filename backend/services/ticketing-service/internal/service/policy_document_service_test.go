@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubPolicyDocumentRepo keeps policy versions in memory, newest last
+type stubPolicyDocumentRepo struct {
+	repository.PolicyDocumentRepository
+	documents   []entity.PolicyDocument
+	acceptances []entity.PolicyAcceptance
+}
+
+func (r *stubPolicyDocumentRepo) Create(ctx context.Context, document *entity.PolicyDocument) error {
+	document.ID = "policy-" + document.VersionHash[:8]
+	document.CreatedAt = time.Now()
+	r.documents = append(r.documents, *document)
+	return nil
+}
+
+func (r *stubPolicyDocumentRepo) GetCurrent(ctx context.Context, tenantID, eventID string) ([]entity.PolicyDocument, error) {
+	byType := map[string]entity.PolicyDocument{}
+	for _, document := range r.documents {
+		if document.TenantID == tenantID && (document.EventID == nil || *document.EventID == eventID) {
+			byType[document.Type] = document
+		}
+	}
+
+	current := []entity.PolicyDocument{}
+	for _, policyType := range []string{entity.PolicyTypeHealth, entity.PolicyTypeRefund, entity.PolicyTypeTerms} {
+		if document, ok := byType[policyType]; ok {
+			current = append(current, document)
+		}
+	}
+	return current, nil
+}
+
+func (r *stubPolicyDocumentRepo) GetAcceptancesByOrderID(ctx context.Context, orderID string) ([]entity.PolicyAcceptance, error) {
+	var found []entity.PolicyAcceptance
+	for _, acceptance := range r.acceptances {
+		if acceptance.OrderID == orderID {
+			found = append(found, acceptance)
+		}
+	}
+	return found, nil
+}
+
+func TestPolicyVersionHash(t *testing.T) {
+	hash := entity.PolicyVersionHash("Refund Policy", "No refunds after the event starts")
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, entity.PolicyVersionHash("Refund Policy", "No refunds after the event starts"))
+	assert.NotEqual(t, hash, entity.PolicyVersionHash("Refund Policy", "No refunds"))
+	assert.NotEqual(t, hash, entity.PolicyVersionHash("Refunds", "No refunds after the event starts"))
+}
+
+func TestPolicyAcceptances(t *testing.T) {
+	eventID := "event-1"
+	current := []entity.PolicyDocument{
+		{ID: "terms-v2", Type: entity.PolicyTypeTerms, Title: "Terms", VersionHash: entity.PolicyVersionHash("Terms", "v2")},
+		{ID: "refund-v1", EventID: &eventID, Type: entity.PolicyTypeRefund, Title: "Refunds", VersionHash: entity.PolicyVersionHash("Refunds", "v1")},
+	}
+
+	t.Run("every current version accepted", func(t *testing.T) {
+		// Hashes are accepted in either case
+		acceptances, err := policyAcceptances(current, []string{strings.ToUpper(current[0].VersionHash), current[1].VersionHash}, "user-1")
+		require.NoError(t, err)
+		require.Len(t, acceptances, 2)
+		assert.Equal(t, "terms-v2", acceptances[0].PolicyDocumentID)
+		assert.Equal(t, "user-1", acceptances[0].UserID)
+		assert.Equal(t, &eventID, acceptances[1].EventID)
+	})
+
+	t.Run("outdated version", func(t *testing.T) {
+		_, err := policyAcceptances(current, []string{entity.PolicyVersionHash("Terms", "v1"), current[1].VersionHash}, "user-1")
+		assert.ErrorIs(t, err, ErrPolicyAcceptanceRequired)
+	})
+
+	t.Run("event policy missing", func(t *testing.T) {
+		_, err := policyAcceptances(current, []string{current[0].VersionHash}, "user-1")
+		assert.ErrorIs(t, err, ErrPolicyAcceptanceRequired)
+	})
+
+	t.Run("nothing published", func(t *testing.T) {
+		acceptances, err := policyAcceptances(nil, nil, "user-1")
+		require.NoError(t, err)
+		assert.Empty(t, acceptances)
+	})
+}
+
+func TestPolicyDocumentService_PublishPolicy(t *testing.T) {
+	repo := &stubPolicyDocumentRepo{}
+	event := &entity.Event{ID: "event-1", OrganizerID: "organizer-1", TenantID: tenant.DefaultID}
+	svc := NewPolicyDocumentService(repo, &stubEventRepo{event: event}, nil)
+	ctx := context.Background()
+
+	refund := &request.PublishPolicyRequest{EventID: "event-1", Type: entity.PolicyTypeRefund, Title: "Refunds", Content: "Full refund until 7 days before the event"}
+
+	published, err := svc.PublishPolicy(ctx, "organizer-1", entity.UserRoleOrganizer, refund)
+	require.NoError(t, err)
+	assert.Equal(t, entity.PolicyVersionHash(refund.Title, refund.Content), published.VersionHash)
+	assert.Equal(t, "event-1", *published.EventID)
+
+	// Publishing the current text again keeps the current version
+	again, err := svc.PublishPolicy(ctx, "organizer-1", entity.UserRoleOrganizer, refund)
+	require.NoError(t, err)
+	assert.Equal(t, published.ID, again.ID)
+	assert.Len(t, repo.documents, 1)
+
+	changed := *refund
+	changed.Content = "Full refund until 3 days before the event"
+	updated, err := svc.PublishPolicy(ctx, "organizer-1", entity.UserRoleOrganizer, &changed)
+	require.NoError(t, err)
+	assert.NotEqual(t, published.VersionHash, updated.VersionHash)
+	assert.Len(t, repo.documents, 2)
+
+	_, err = svc.PublishPolicy(ctx, "organizer-2", entity.UserRoleOrganizer, refund)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	terms := &request.PublishPolicyRequest{Type: entity.PolicyTypeTerms, Title: "Terms", Content: "Platform terms"}
+	_, err = svc.PublishPolicy(ctx, "organizer-1", entity.UserRoleOrganizer, terms)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	_, err = svc.PublishPolicy(ctx, "admin-1", entity.UserRoleAdmin, terms)
+	require.NoError(t, err)
+
+	current, err := svc.GetCurrentPolicies(ctx, "event-1")
+	require.NoError(t, err)
+	require.Len(t, current, 2)
+	assert.Equal(t, updated.VersionHash, current[0].VersionHash)
+	assert.Equal(t, entity.PolicyTypeTerms, current[1].Type)
+
+	// Terms are platform wide, refund and health policies belong to an event
+	_, err = svc.PublishPolicy(ctx, "admin-1", entity.UserRoleAdmin, &request.PublishPolicyRequest{EventID: "event-1", Type: entity.PolicyTypeTerms, Title: "Terms", Content: "x"})
+	assert.ErrorIs(t, err, ErrInvalidPolicyScope)
+	_, err = svc.PublishPolicy(ctx, "admin-1", entity.UserRoleAdmin, &request.PublishPolicyRequest{Type: entity.PolicyTypeHealth, Title: "Health", Content: "x"})
+	assert.ErrorIs(t, err, ErrInvalidPolicyScope)
+}
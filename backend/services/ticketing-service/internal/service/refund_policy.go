@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+)
+
+var (
+	ErrRefundNotAllowed       = errors.New("order is not refundable under the event's refund policy")
+	ErrRefundWindowClosed     = errors.New("refund window of the event's refund policy has closed")
+	ErrRefundAlreadyRequested = errors.New("refund already requested for this order")
+	ErrRefundPolicyTemplate   = errors.New("event uses a standard refund policy")
+)
+
+const (
+	sevenDayRefundWindow   = 7 * 24 * time.Hour // seven_day: refundable this long after payment
+	flexibleRefundCutoff   = 24 * time.Hour     // flexible: refundable until this long before the event starts
+	refundTicketVoidReason = "refund_requested" // Void reason of the tickets of a refunded order
+)
+
+// refundPolicyTemplate is the text of a standard refund policy, published as the event's
+// refund policy so buyers accept it at checkout like a policy written by the organizer
+type refundPolicyTemplate struct {
+	Title   string
+	Content string
+}
+
+// refundPolicyTemplates holds the standard refund policies by entity.RefundPolicy* value
+// Changing a text publishes a new version that buyers accept from then on
+var refundPolicyTemplates = map[string]refundPolicyTemplate{
+	entity.RefundPolicyNoRefunds: {
+		Title:   "Kebijakan Refund: Tanpa Refund",
+		Content: "Tiket yang sudah dibayar tidak dapat dibatalkan atau di-refund.",
+	},
+	entity.RefundPolicySevenDay: {
+		Title: "Kebijakan Refund: 7 Hari",
+		Content: "Refund penuh sebesar total pembayaran dapat diajukan dalam 7 hari sejak pembayaran, selama event belum dimulai " +
+			"dan belum ada tiket pesanan yang digunakan. Tiket pesanan yang di-refund dibatalkan dan tidak dapat digunakan lagi.",
+	},
+	entity.RefundPolicyFlexible: {
+		Title: "Kebijakan Refund: Fleksibel",
+		Content: "Refund penuh sebesar total pembayaran dapat diajukan hingga 24 jam sebelum event dimulai, selama belum ada " +
+			"tiket pesanan yang digunakan. Tiket pesanan yang di-refund dibatalkan dan tidak dapat digunakan lagi.",
+	},
+}
+
+// refundPolicyDocument builds the refund policy document of an event's standard template,
+// nil if the event has none. ID and CreatedAt are set once it is published
+func refundPolicyDocument(event *entity.Event) *entity.PolicyDocument {
+	if event.RefundPolicy == nil {
+		return nil
+	}
+	template, ok := refundPolicyTemplates[*event.RefundPolicy]
+	if !ok {
+		return nil
+	}
+
+	eventID := event.ID
+	return &entity.PolicyDocument{
+		TenantID:    event.TenantID,
+		EventID:     &eventID,
+		Type:        entity.PolicyTypeRefund,
+		Title:       template.Title,
+		Content:     template.Content,
+		VersionHash: entity.PolicyVersionHash(template.Title, template.Content),
+		PublishedBy: event.OrganizerID,
+	}
+}
+
+// currentPolicies retrieves the current platform terms of tenantID and, if event is set, the current
+// policies of the event. An event's standard refund policy is published as its current refund policy
+// the first time it is read after the organizer chose it
+func currentPolicies(ctx context.Context, policyDocumentRepo repository.PolicyDocumentRepository, tenantID string, event *entity.Event) ([]entity.PolicyDocument, error) {
+	eventID := ""
+	if event != nil {
+		eventID = event.ID
+	}
+
+	documents, err := policyDocumentRepo.GetCurrent(ctx, tenantID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return documents, nil
+	}
+
+	template := refundPolicyDocument(event)
+	if template == nil {
+		return documents, nil
+	}
+
+	for i := range documents {
+		if documents[i].Type != entity.PolicyTypeRefund {
+			continue
+		}
+		if documents[i].VersionHash != template.VersionHash {
+			if err := policyDocumentRepo.Create(ctx, template); err != nil {
+				return nil, fmt.Errorf("failed to publish refund policy template: %w", err)
+			}
+			documents[i] = *template
+		}
+		return documents, nil
+	}
+
+	if err := policyDocumentRepo.Create(ctx, template); err != nil {
+		return nil, fmt.Errorf("failed to publish refund policy template: %w", err)
+	}
+	return append(documents, *template), nil
+}
+
+// checkRefundEligibility applies the event's refund policy to a buyer refund request at now
+// Only paid orders whose tickets are all unused can be refunded, under any policy
+func checkRefundEligibility(event *entity.Event, order *entity.Order, tickets []entity.Ticket, now time.Time) error {
+	if event.RefundPolicy == nil || *event.RefundPolicy == entity.RefundPolicyNoRefunds {
+		return ErrRefundNotAllowed
+	}
+
+	if order.Status != entity.OrderStatusPaid || order.PaymentID == nil || order.CompletedAt == nil {
+		return ErrRefundNotAllowed
+	}
+	for _, ticket := range tickets {
+		if !ticket.CanBeUsed() || ticket.UsedAt != nil {
+			return ErrRefundNotAllowed
+		}
+	}
+
+	switch *event.RefundPolicy {
+	case entity.RefundPolicySevenDay:
+		if now.After(order.CompletedAt.Add(sevenDayRefundWindow)) || !now.Before(event.StartDate) {
+			return ErrRefundWindowClosed
+		}
+	case entity.RefundPolicyFlexible:
+		if !now.Before(event.StartDate.Add(-flexibleRefundCutoff)) {
+			return ErrRefundWindowClosed
+		}
+	default:
+		return ErrRefundNotAllowed
+	}
+
+	return nil
+}
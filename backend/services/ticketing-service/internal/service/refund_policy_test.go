@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckRefundEligibility(t *testing.T) {
+	start := time.Date(2026, 12, 5, 19, 0, 0, 0, time.UTC)
+	paidAt := start.Add(-30 * 24 * time.Hour)
+	paymentID := "pay-1"
+	paid := &entity.Order{ID: "order-1", Status: entity.OrderStatusPaid, PaymentID: &paymentID, CompletedAt: &paidAt}
+	valid := []entity.Ticket{{ID: "ticket-1", Status: entity.TicketStatusValid}}
+	used := []entity.Ticket{{ID: "ticket-1", Status: entity.TicketStatusValid}, {ID: "ticket-2", Status: entity.TicketStatusUsed}}
+	event := func(policy string) *entity.Event {
+		e := &entity.Event{ID: "event-1", StartDate: start, EndDate: start.Add(4 * time.Hour)}
+		if policy != "" {
+			e.RefundPolicy = &policy
+		}
+		return e
+	}
+
+	tests := []struct {
+		name    string
+		policy  string
+		order   *entity.Order
+		tickets []entity.Ticket
+		now     time.Time
+		want    error
+	}{
+		{"custom policy", "", paid, valid, paidAt.Add(time.Hour), ErrRefundNotAllowed},
+		{"no refunds", entity.RefundPolicyNoRefunds, paid, valid, paidAt.Add(time.Hour), ErrRefundNotAllowed},
+		{"seven day within window", entity.RefundPolicySevenDay, paid, valid, paidAt.Add(6 * 24 * time.Hour), nil},
+		{"seven day after window", entity.RefundPolicySevenDay, paid, valid, paidAt.Add(8 * 24 * time.Hour), ErrRefundWindowClosed},
+		{"seven day event started", entity.RefundPolicySevenDay, &entity.Order{Status: entity.OrderStatusPaid, PaymentID: &paymentID, CompletedAt: &start}, valid, start.Add(time.Hour), ErrRefundWindowClosed},
+		{"flexible before cutoff", entity.RefundPolicyFlexible, paid, valid, start.Add(-25 * time.Hour), nil},
+		{"flexible after cutoff", entity.RefundPolicyFlexible, paid, valid, start.Add(-23 * time.Hour), ErrRefundWindowClosed},
+		{"ticket used", entity.RefundPolicyFlexible, paid, used, paidAt.Add(time.Hour), ErrRefundNotAllowed},
+		{"order not paid", entity.RefundPolicyFlexible, &entity.Order{Status: entity.OrderStatusReserved}, valid, paidAt, ErrRefundNotAllowed},
+		{"invitation without payment", entity.RefundPolicyFlexible, &entity.Order{Status: entity.OrderStatusPaid, CompletedAt: &paidAt}, valid, paidAt, ErrRefundNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkRefundEligibility(event(tt.policy), tt.order, tt.tickets, tt.now)
+			if tt.want == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCurrentPolicies_RefundTemplate(t *testing.T) {
+	ctx := context.Background()
+	repo := &stubPolicyDocumentRepo{}
+	policy := entity.RefundPolicyFlexible
+	event := &entity.Event{ID: "event-1", OrganizerID: "organizer-1", TenantID: tenant.DefaultID, RefundPolicy: &policy}
+
+	// The template is published as the event's refund policy on first read
+	current, err := currentPolicies(ctx, repo, tenant.DefaultID, event)
+	require.NoError(t, err)
+	require.Len(t, current, 1)
+	assert.Equal(t, entity.PolicyTypeRefund, current[0].Type)
+	assert.Equal(t, refundPolicyTemplates[policy].Title, current[0].Title)
+	assert.Equal(t, "organizer-1", current[0].PublishedBy)
+	assert.NotEmpty(t, current[0].ID)
+
+	again, err := currentPolicies(ctx, repo, tenant.DefaultID, event)
+	require.NoError(t, err)
+	assert.Equal(t, current[0].ID, again[0].ID)
+	assert.Len(t, repo.documents, 1)
+
+	// Switching templates publishes a new version
+	policy = entity.RefundPolicySevenDay
+	switched, err := currentPolicies(ctx, repo, tenant.DefaultID, event)
+	require.NoError(t, err)
+	require.Len(t, switched, 1)
+	assert.Equal(t, refundPolicyTemplates[policy].Title, switched[0].Title)
+	assert.Len(t, repo.documents, 2)
+
+	// Organizers don't publish their own refund policy next to a template
+	svc := NewPolicyDocumentService(repo, &stubEventRepo{event: event}, nil)
+	_, err = svc.PublishPolicy(ctx, "organizer-1", entity.UserRoleOrganizer, &request.PublishPolicyRequest{EventID: "event-1", Type: entity.PolicyTypeRefund, Title: "Refunds", Content: "x"})
+	assert.ErrorIs(t, err, ErrRefundPolicyTemplate)
+}
+
+func TestRefundService_RequestRefund(t *testing.T) {
+	ctx := context.Background()
+	policy := entity.RefundPolicyFlexible
+	event := &entity.Event{ID: "event-1", TenantID: tenant.DefaultID, StartDate: time.Now().Add(72 * time.Hour), RefundPolicy: &policy}
+	paidAt := time.Now().Add(-time.Hour)
+	paymentID := "pay-1"
+	paidCurrency := "USD"
+	order := &entity.Order{
+		ID: "order-1", UserID: "user-1", EventID: "event-1", TenantID: tenant.DefaultID,
+		Status: entity.OrderStatusPaid, PaymentID: &paymentID, CompletedAt: &paidAt, GrandTotal: money.New(310000),
+		PaidCurrency: &paidCurrency,
+	}
+	ticketRepo := &stubTicketRepo{tickets: map[string]*entity.Ticket{
+		"ticket-1": {ID: "ticket-1", OrderID: "order-1", Status: entity.TicketStatusValid},
+		"ticket-2": {ID: "ticket-2", OrderID: "order-1", Status: entity.TicketStatusValid},
+	}}
+	refundRepo := &stubRefundRepo{refunds: map[string]*entity.OrderRefundRequest{}}
+	svc := NewRefundService(refundRepo, &stubOrderRepo{order: order}, ticketRepo, &stubEventRepo{event: event}, nil, "IDR")
+
+	_, err := svc.RequestRefund(ctx, "user-2", "order-1")
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	refund, err := svc.RequestRefund(ctx, "user-1", "order-1")
+	require.NoError(t, err)
+	assert.Equal(t, money.New(310000), refund.Amount)
+	assert.Equal(t, entity.RefundReasonBuyerRequest, refund.Reason)
+	require.Contains(t, refundRepo.refunds, "pay-1")
+	// Refunded in the currency the order was paid in, not the configured one
+	assert.Equal(t, "USD", refundRepo.refunds["pay-1"].Currency)
+
+	// The order's tickets can't be used anymore
+	for _, ticket := range ticketRepo.tickets {
+		assert.True(t, ticket.IsVoid())
+		assert.Equal(t, refundTicketVoidReason, *ticket.VoidReason)
+	}
+
+	// Voided tickets make the order ineligible for another refund
+	_, err = svc.RequestRefund(ctx, "user-1", "order-1")
+	assert.ErrorIs(t, err, ErrRefundNotAllowed)
+}
+
+func TestRefundService_RecordPaymentRefund(t *testing.T) {
+	ctx := context.Background()
+	refundRepo := &stubRefundRepo{refunds: map[string]*entity.OrderRefundRequest{
+		"inv-123": {OrderID: "order-1", PaymentID: "inv-123", Reason: entity.RefundReasonSoldOut, Status: entity.RefundStatusPending},
+	}}
+	svc := NewRefundService(refundRepo, &stubOrderRepo{}, &stubTicketRepo{}, &stubEventRepo{}, nil, "IDR")
+
+	// Another order's payment is left alone
+	recorded, err := svc.RecordPaymentRefund(ctx, "order-2", "inv-123")
+	require.NoError(t, err)
+	assert.False(t, recorded)
+
+	recorded, err = svc.RecordPaymentRefund(ctx, "order-1", "inv-123")
+	require.NoError(t, err)
+	assert.True(t, recorded)
+	assert.Equal(t, entity.RefundStatusRefunded, refundRepo.refunds["inv-123"].Status)
+	assert.NotNil(t, refundRepo.refunds["inv-123"].RefundedAt)
+	assert.Nil(t, refundRepo.refunds["inv-123"].RefundedBy)
+
+	// Retries record it once
+	recorded, err = svc.RecordPaymentRefund(ctx, "order-1", "inv-123")
+	require.NoError(t, err)
+	assert.False(t, recorded)
+}
+
+// stubRefundReleaser marks released orders refunded like ReservationService
+type stubRefundReleaser struct {
+	order *entity.Order
+}
+
+func (r *stubRefundReleaser) ReleaseRefundedOrder(ctx context.Context, orderID string) error {
+	if r.order.Status != entity.OrderStatusPaid {
+		return ErrRefundNotAllowed
+	}
+	r.order.Status = entity.OrderStatusRefunded
+	return nil
+}
+
+func TestRefundService_RefundOrder(t *testing.T) {
+	ctx := context.Background()
+	policy := entity.RefundPolicySevenDay
+	event := &entity.Event{ID: "event-1", TenantID: tenant.DefaultID, StartDate: time.Now().Add(72 * time.Hour), RefundPolicy: &policy}
+	paidAt := time.Now().Add(-time.Hour)
+	paymentID := "pay-1"
+	newFixture := func() (*entity.Order, *stubTicketRepo, *stubRefundRepo, RefundService) {
+		order := &entity.Order{
+			ID: "order-1", UserID: "user-1", EventID: "event-1", TenantID: tenant.DefaultID,
+			Status: entity.OrderStatusPaid, PaymentID: &paymentID, CompletedAt: &paidAt, GrandTotal: money.New(310000),
+		}
+		ticketRepo := &stubTicketRepo{tickets: map[string]*entity.Ticket{
+			"ticket-1": {ID: "ticket-1", OrderID: "order-1", Status: entity.TicketStatusValid},
+			"ticket-2": {ID: "ticket-2", OrderID: "order-1", Status: entity.TicketStatusValid},
+		}}
+		refundRepo := &stubRefundRepo{refunds: map[string]*entity.OrderRefundRequest{}}
+		svc := NewRefundService(refundRepo, &stubOrderRepo{order: order}, ticketRepo, &stubEventRepo{event: event}, &stubRefundReleaser{order: order}, "IDR")
+		return order, ticketRepo, refundRepo, svc
+	}
+
+	t.Run("refunds the order", func(t *testing.T) {
+		order, ticketRepo, refundRepo, svc := newFixture()
+
+		_, err := svc.RefundOrder(ctx, "user-2", "order-1", "refund-1")
+		assert.ErrorIs(t, err, ErrUnauthorized)
+
+		result, err := svc.RefundOrder(ctx, "user-1", "order-1", "refund-1")
+		require.NoError(t, err)
+		assert.Equal(t, "pay-1", result.PaymentID)
+		assert.Equal(t, money.New(310000), result.Amount)
+		assert.Equal(t, 2, result.TicketsVoided)
+		assert.Equal(t, entity.OrderStatusRefunded, order.Status)
+		for _, ticket := range ticketRepo.tickets {
+			assert.True(t, ticket.IsVoid())
+		}
+
+		// Refunded through the provider, not the manual queue
+		assert.Empty(t, refundRepo.refunds)
+
+		_, err = svc.RefundOrder(ctx, "user-1", "order-1", "refund-2")
+		assert.ErrorIs(t, err, ErrRefundNotAllowed)
+	})
+
+	t.Run("payment flagged for manual refund", func(t *testing.T) {
+		order, _, refundRepo, svc := newFixture()
+		refundRepo.refunds["pay-1"] = &entity.OrderRefundRequest{PaymentID: "pay-1", Reason: entity.RefundReasonInsuranceClaim}
+
+		_, err := svc.RefundOrder(ctx, "user-1", "order-1", "refund-1")
+		assert.ErrorIs(t, err, ErrRefundAlreadyRequested)
+		assert.Equal(t, entity.OrderStatusPaid, order.Status)
+	})
+
+	t.Run("checks eligibility without refunding", func(t *testing.T) {
+		order, ticketRepo, refundRepo, svc := newFixture()
+
+		_, err := svc.CheckRefundEligibility(ctx, "user-2", "order-1")
+		assert.ErrorIs(t, err, ErrUnauthorized)
+
+		result, err := svc.CheckRefundEligibility(ctx, "user-1", "order-1")
+		require.NoError(t, err)
+		assert.Equal(t, "order-1", result.OrderID)
+		assert.Equal(t, "pay-1", result.PaymentID)
+		assert.Equal(t, entity.OrderStatusPaid, order.Status)
+		for _, ticket := range ticketRepo.tickets {
+			assert.False(t, ticket.IsVoid())
+		}
+
+		refundRepo.refunds["pay-1"] = &entity.OrderRefundRequest{PaymentID: "pay-1", Reason: entity.RefundReasonBuyerRequest}
+		_, err = svc.CheckRefundEligibility(ctx, "user-1", "order-1")
+		assert.ErrorIs(t, err, ErrRefundAlreadyRequested)
+	})
+}
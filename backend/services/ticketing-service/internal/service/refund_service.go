@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+)
+
+var (
+	ErrRefundNotFound        = errors.New("refund request not found")
+	ErrRefundAlreadyRefunded = errors.New("refund request is already refunded")
+)
+
+// RefundService handles payments flagged for manual refund, scoped to the request tenant
+type RefundService interface {
+	ListRefunds(ctx context.Context, status string, page, limit int) ([]response.OrderRefundResponse, int64, error)
+	MarkRefunded(ctx context.Context, adminID, refundID string) (*response.OrderRefundResponse, error)
+
+	// Buyer operations
+	RequestRefund(ctx context.Context, userID, orderID string) (*response.OrderRefundResponse, error)
+
+	// RefundOrder applies a buyer refund requested through payment service, which refunds the payment
+	RefundOrder(ctx context.Context, userID, orderID, refundID string) (*response.RefundOrderResponse, error)
+	// CheckRefundEligibility checks a buyer refund before payment service records it, changing nothing
+	CheckRefundEligibility(ctx context.Context, userID, orderID string) (*response.RefundEligibilityResponse, error)
+	// RecordPaymentRefund marks the refund request of a flagged payment refunded once payment service refunded it
+	RecordPaymentRefund(ctx context.Context, orderID, paymentID string) (bool, error)
+}
+
+// refundOrderReleaser marks a paid order refunded and returns its seats (implemented by ReservationService)
+type refundOrderReleaser interface {
+	ReleaseRefundedOrder(ctx context.Context, orderID string) error
+}
+
+// refundService implements RefundService interface
+type refundService struct {
+	refundRepo repository.OrderRefundRepository
+	orderRepo  repository.OrderRepository
+	ticketRepo repository.TicketRepository
+	eventRepo  repository.EventRepository
+	releaser   refundOrderReleaser
+	currency   string
+}
+
+// NewRefundService creates new refund service instance
+func NewRefundService(
+	refundRepo repository.OrderRefundRepository,
+	orderRepo repository.OrderRepository,
+	ticketRepo repository.TicketRepository,
+	eventRepo repository.EventRepository,
+	releaser refundOrderReleaser,
+	currency string,
+) RefundService {
+	return &refundService{
+		refundRepo: refundRepo,
+		orderRepo:  orderRepo,
+		ticketRepo: ticketRepo,
+		eventRepo:  eventRepo,
+		releaser:   releaser,
+		currency:   currency,
+	}
+}
+
+// ListRefunds retrieves refund requests of the request tenant by status, pending ones if status is empty
+func (s *refundService) ListRefunds(ctx context.Context, status string, page, limit int) ([]response.OrderRefundResponse, int64, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+
+	offset := (page - 1) * limit
+
+	refunds, total, err := s.refundRepo.List(ctx, tenantFromContext(ctx), status, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list refund requests: %w", err)
+	}
+
+	refundResponses := make([]response.OrderRefundResponse, 0, len(refunds))
+	for i := range refunds {
+		refundResponses = append(refundResponses, *response.ToOrderRefundResponse(&refunds[i]))
+	}
+
+	return refundResponses, total, nil
+}
+
+// MarkRefunded records that support refunded the payment outside the platform
+func (s *refundService) MarkRefunded(ctx context.Context, adminID, refundID string) (*response.OrderRefundResponse, error) {
+	refund, err := s.refundRepo.MarkRefunded(ctx, refundID, tenantFromContext(ctx), adminID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRefundRequestNotFound) {
+			return nil, ErrRefundNotFound
+		}
+		if errors.Is(err, repository.ErrRefundRequestAlreadyRefunded) {
+			return nil, ErrRefundAlreadyRefunded
+		}
+		return nil, fmt.Errorf("failed to mark refund request: %w", err)
+	}
+
+	return response.ToOrderRefundResponse(refund), nil
+}
+
+// RecordPaymentRefund marks the pending refund request of a payment refunded, e.g. a payment for tickets
+// that sold out during the expiry grace period, so support doesn't pay it back a second time
+// Reports whether a request was updated: retries, and payments support already refunded, are ignored
+func (s *refundService) RecordPaymentRefund(ctx context.Context, orderID, paymentID string) (bool, error) {
+	recorded, err := s.refundRepo.MarkPaymentRefunded(ctx, orderID, paymentID)
+	if err != nil {
+		return false, err
+	}
+
+	if recorded {
+		log.Printf("[RefundService] Payment %s of order %s refunded by payment service", paymentID, orderID)
+	}
+	return recorded, nil
+}
+
+// RequestRefund refunds the buyer's order under its event's standard refund policy
+// The amount paid is flagged for refund through the manual refund queue and the order's
+// tickets are voided, so a refunded order can't be used at the entrance anymore
+func (s *refundService) RequestRefund(ctx context.Context, userID, orderID string) (*response.OrderRefundResponse, error) {
+	order, tickets, err := s.refundableOrder(ctx, userID, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	refund := &entity.OrderRefundRequest{
+		OrderID:       order.ID,
+		TenantID:      order.TenantID,
+		PaymentID:     *order.PaymentID,
+		PaymentMethod: order.PaymentMethod,
+		Amount:        order.GrandTotal,
+		Currency:      order.PaidCurrencyOr(s.currency),
+		Reason:        entity.RefundReasonBuyerRequest,
+	}
+
+	// A payment is refunded once, whether requested before or claimed on its insurance
+	created, err := s.refundRepo.Create(ctx, refund)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create refund request: %w", err)
+	}
+	if !created {
+		return nil, ErrRefundAlreadyRequested
+	}
+
+	for _, ticket := range tickets {
+		if err := s.ticketRepo.Void(ctx, ticket.ID, refundTicketVoidReason, userID); err != nil {
+			log.Printf("[RefundService] Failed to void ticket %s of refunded order %s: %v", ticket.ID, order.ID, err)
+		}
+	}
+
+	log.Printf("[RefundService] Refund %s requested for order %s", refund.ID, order.ID)
+	return response.ToOrderRefundResponse(refund), nil
+}
+
+// RefundOrder marks the buyer's order refunded under its event's standard refund policy, returns its seats
+// and voids its tickets. Payment service calls it before refunding the payment through the provider,
+// so the order can't be refunded twice. Payments already flagged for refund are refused
+func (s *refundService) RefundOrder(ctx context.Context, userID, orderID, refundID string) (*response.RefundOrderResponse, error) {
+	order, tickets, err := s.providerRefundableOrder(ctx, userID, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The order is locked and checked paid again, concurrent refunds release its seats once
+	if err := s.releaser.ReleaseRefundedOrder(ctx, order.ID); err != nil {
+		if errors.Is(err, ErrRefundNotAllowed) {
+			return nil, ErrRefundNotAllowed
+		}
+		return nil, fmt.Errorf("failed to release refunded order: %w", err)
+	}
+
+	voided := 0
+	for _, ticket := range tickets {
+		if err := s.ticketRepo.Void(ctx, ticket.ID, refundTicketVoidReason, userID); err != nil {
+			log.Printf("[RefundService] Failed to void ticket %s of refunded order %s: %v", ticket.ID, order.ID, err)
+			continue
+		}
+		voided++
+	}
+
+	log.Printf("[RefundService] Order %s refunded by payment refund %s", order.ID, refundID)
+	return &response.RefundOrderResponse{
+		OrderID:       order.ID,
+		PaymentID:     *order.PaymentID,
+		Amount:        order.GrandTotal,
+		TicketsVoided: voided,
+	}, nil
+}
+
+// CheckRefundEligibility checks that the buyer may refund the order through payment service now
+// Payment service checks before it records the refund, so only the order's buyer can claim its payment.
+// RefundOrder checks again, the order may change in between
+func (s *refundService) CheckRefundEligibility(ctx context.Context, userID, orderID string) (*response.RefundEligibilityResponse, error) {
+	order, _, err := s.providerRefundableOrder(ctx, userID, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.RefundEligibilityResponse{
+		OrderID:   order.ID,
+		PaymentID: *order.PaymentID,
+	}, nil
+}
+
+// providerRefundableOrder retrieves the buyer's refundable order with its tickets, unless its payment
+// was flagged for manual refund or claimed on its insurance: support refunds those
+func (s *refundService) providerRefundableOrder(ctx context.Context, userID, orderID string) (*entity.Order, []entity.Ticket, error) {
+	order, tickets, err := s.refundableOrder(ctx, userID, orderID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := s.refundRepo.GetByPaymentID(ctx, *order.PaymentID); err == nil {
+		return nil, nil, ErrRefundAlreadyRequested
+	} else if !errors.Is(err, repository.ErrRefundRequestNotFound) {
+		return nil, nil, fmt.Errorf("failed to get refund request: %w", err)
+	}
+
+	return order, tickets, nil
+}
+
+// refundableOrder retrieves the buyer's order with its tickets if the event's refund policy allows a refund now
+func (s *refundService) refundableOrder(ctx context.Context, userID, orderID string) (*entity.Order, []entity.Ticket, error) {
+	order, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, repository.ErrOrderNotFound) {
+			return nil, nil, ErrOrderNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get order: %w", err)
+	}
+	if order.UserID != userID {
+		return nil, nil, ErrUnauthorized
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, order.EventID)
+	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return nil, nil, ErrEventNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	tickets, err := s.ticketRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get order tickets: %w", err)
+	}
+
+	if err := checkRefundEligibility(event, order, tickets, time.Now()); err != nil {
+		return nil, nil, err
+	}
+
+	return order, tickets, nil
+}
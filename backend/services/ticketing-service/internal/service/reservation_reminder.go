@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+)
+
+// ReminderSender defines interface for emailing reservation payment reminders (notification service)
+type ReminderSender interface {
+	SendReservationReminderEmail(ctx context.Context, req *client.SendReservationReminderEmailRequest) error
+}
+
+// reservationReminder sends the payment reminder of an unpaid reservation
+type reservationReminder struct {
+	orderRepo     repository.OrderRepository
+	eventRepo     repository.EventRepository
+	userRepo      repository.UserRepository
+	tenantRepo    repository.TenantRepository
+	paymentClient PaymentClient
+	sender        ReminderSender
+}
+
+// NewReservationReminderHandler creates the handler of entity.JobTypeReservationReminder jobs
+// The reminder links to the order's invoice; orders paid, cancelled or expired in the meantime are skipped
+func NewReservationReminderHandler(
+	orderRepo repository.OrderRepository,
+	eventRepo repository.EventRepository,
+	userRepo repository.UserRepository,
+	tenantRepo repository.TenantRepository,
+	paymentClient PaymentClient,
+	sender ReminderSender,
+) JobHandler {
+	reminder := &reservationReminder{
+		orderRepo:     orderRepo,
+		eventRepo:     eventRepo,
+		userRepo:      userRepo,
+		tenantRepo:    tenantRepo,
+		paymentClient: paymentClient,
+		sender:        sender,
+	}
+	return reminder.handle
+}
+
+// handle emails the reminder of the job's order
+func (r *reservationReminder) handle(ctx context.Context, job *entity.ScheduledJob) error {
+	order, err := r.orderRepo.GetByID(ctx, job.ReferenceID)
+	if err != nil {
+		if errors.Is(err, repository.ErrOrderNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to get order: %w", err)
+	}
+
+	if order.Status != entity.OrderStatusReserved || order.IsExpired() || order.ReservationExpiresAt == nil {
+		return nil
+	}
+
+	payment, err := r.paymentClient.GetPaymentStatus(ctx, order.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get payment link: %w", err)
+	}
+	if payment.InvoiceURL == "" {
+		log.Printf("[ReservationReminder] Order %s has no invoice to pay, skipping reminder", order.ID)
+		return nil
+	}
+
+	event, err := r.eventRepo.GetByID(ctx, order.EventID)
+	if err != nil {
+		return fmt.Errorf("failed to get event: %w", err)
+	}
+
+	user, err := r.userRepo.GetByID(ctx, order.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	recipientName := user.FullName
+	if recipientName == "" {
+		recipientName = "Customer"
+	}
+
+	return r.sender.SendReservationReminderEmail(ctx, &client.SendReservationReminderEmailRequest{
+		OrderID:        order.ID,
+		RecipientEmail: user.Email,
+		RecipientName:  recipientName,
+		EventName:      event.Name,
+		GrandTotal:     order.GrandTotal,
+		PaymentURL:     payment.InvoiceURL,
+		ExpiresAt:      *order.ReservationExpiresAt,
+		Branding:       tenantEmailBranding(ctx, r.tenantRepo, order.TenantID, event.OrganizerID),
+	})
+}
+
+// reservationReminderAt returns when the reminder of a reservation expiring at expiresAt is due
+// No reminder is sent when before is disabled (0) or not shorter than the reservation timeout
+func reservationReminderAt(expiresAt time.Time, timeout, before time.Duration) (time.Time, bool) {
+	if before <= 0 || before >= timeout {
+		return time.Time{}, false
+	}
+	return expiresAt.Add(-before), true
+}
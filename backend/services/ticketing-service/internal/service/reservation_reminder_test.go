@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newReminderFixture(order *entity.Order) (JobHandler, *testutil.NotificationClient, *testutil.PaymentClient) {
+	notification := &testutil.NotificationClient{}
+	payment := &testutil.PaymentClient{
+		GetPaymentStatusFunc: func(ctx context.Context, orderID string) (*client.CreateInvoiceResponse, error) {
+			return &client.CreateInvoiceResponse{InvoiceURL: "https://checkout.example.com/" + orderID, Status: "PENDING"}, nil
+		},
+	}
+	handler := NewReservationReminderHandler(
+		&stubOrderRepo{order: order},
+		&stubEventRepo{event: &entity.Event{ID: "event-1", Name: "Jazz Night"}},
+		&stubUserRepo{user: &entity.User{ID: "user-1", Email: "buyer@example.com", FullName: "Buyer"}},
+		&stubTenantRepo{},
+		payment,
+		notification,
+	)
+	return handler, notification, payment
+}
+
+func TestReservationReminder_SendsPaymentLink(t *testing.T) {
+	expiresAt := time.Now().Add(5 * time.Minute)
+	order := &entity.Order{
+		ID:                   "order-1",
+		UserID:               "user-1",
+		EventID:              "event-1",
+		GrandTotal:           money.New(500000),
+		Status:               entity.OrderStatusReserved,
+		ReservationExpiresAt: &expiresAt,
+	}
+	handler, notification, _ := newReminderFixture(order)
+
+	err := handler(context.Background(), &entity.ScheduledJob{Type: entity.JobTypeReservationReminder, ReferenceID: "order-1"})
+	require.NoError(t, err)
+
+	calls := notification.SendReservationReminderEmailCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "buyer@example.com", calls[0].RecipientEmail)
+	assert.Equal(t, "Jazz Night", calls[0].EventName)
+	assert.Equal(t, "https://checkout.example.com/order-1", calls[0].PaymentURL)
+	assert.Equal(t, money.New(500000), calls[0].GrandTotal)
+	assert.True(t, expiresAt.Equal(calls[0].ExpiresAt))
+}
+
+func TestReservationReminder_SkipsSettledOrders(t *testing.T) {
+	expired := time.Now().Add(-time.Minute)
+	pending := time.Now().Add(5 * time.Minute)
+
+	tests := []struct {
+		name  string
+		order *entity.Order
+	}{
+		{"paid", &entity.Order{ID: "order-1", Status: entity.OrderStatusPaid, ReservationExpiresAt: &pending}},
+		{"cancelled", &entity.Order{ID: "order-1", Status: entity.OrderStatusCancelled, ReservationExpiresAt: &pending}},
+		{"expired", &entity.Order{ID: "order-1", Status: entity.OrderStatusReserved, ReservationExpiresAt: &expired}},
+		{"archived", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, notification, payment := newReminderFixture(tt.order)
+
+			err := handler(context.Background(), &entity.ScheduledJob{Type: entity.JobTypeReservationReminder, ReferenceID: "order-1"})
+			require.NoError(t, err)
+			assert.Empty(t, notification.SendReservationReminderEmailCalls())
+			assert.Empty(t, payment.GetPaymentStatusCalls())
+		})
+	}
+}
+
+func TestReservationReminder_RetriesWhenSendFails(t *testing.T) {
+	expiresAt := time.Now().Add(5 * time.Minute)
+	handler, notification, _ := newReminderFixture(&entity.Order{
+		ID: "order-1", UserID: "user-1", EventID: "event-1", Status: entity.OrderStatusReserved, ReservationExpiresAt: &expiresAt,
+	})
+	notification.SendReservationReminderEmailFunc = func(ctx context.Context, req *client.SendReservationReminderEmailRequest) error {
+		return errors.New("notification service unavailable")
+	}
+
+	err := handler(context.Background(), &entity.ScheduledJob{Type: entity.JobTypeReservationReminder, ReferenceID: "order-1"})
+	assert.Error(t, err)
+}
+
+func TestReservationReminderAt(t *testing.T) {
+	expiresAt := time.Date(2026, 11, 1, 10, 15, 0, 0, time.UTC)
+
+	remindAt, ok := reservationReminderAt(expiresAt, 15*time.Minute, 5*time.Minute)
+	require.True(t, ok)
+	assert.Equal(t, time.Date(2026, 11, 1, 10, 10, 0, 0, time.UTC), remindAt)
+
+	// Disabled, or no shorter than the reservation itself
+	_, ok = reservationReminderAt(expiresAt, 15*time.Minute, 0)
+	assert.False(t, ok)
+	_, ok = reservationReminderAt(expiresAt, 15*time.Minute, 15*time.Minute)
+	assert.False(t, ok)
+}
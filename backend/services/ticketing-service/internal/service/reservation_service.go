@@ -2,12 +2,21 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"log"
+	"sync/atomic"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/featureflags"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/metrics"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/pricing"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/timeout"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/client"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
@@ -21,28 +30,70 @@ var (
 	ErrMaxPerOrderExceeded   = errors.New("maximum tickets per order exceeded")
 	ErrLockAcquisitionFailed = errors.New("failed to acquire lock, please try again")
 	ErrTicketTierNotFound    = errors.New("ticket tier not found")
+	ErrEventNotFound         = errors.New("event not found")
+	ErrEventNotOnSale        = errors.New("event is not on sale")
+)
+
+// lockWaitTimeout bounds how long a reservation waits for ticket tier locks
+const lockWaitTimeout = 5 * time.Second
+
+// Lock failure reasons of reservation_lock_failures_total
+const (
+	lockFailureContended   = "contended"   // Redis lock held by another reservation
+	lockFailureUnavailable = "unavailable" // Redis down and no advisory lock fallback
+	lockFailureAdvisory    = "advisory"    // Advisory lock not acquired within lockWaitTimeout
+)
+
+// Reservation metrics (exposed via /debug/vars)
+var (
+	reservationLockFailures      = metrics.NewCounterVec("reservation_lock_failures_total") // By reason
+	reservationInsufficientQuota = metrics.NewCounter("reservation_insufficient_quota_total")
 )
 
 // ReservationService handles ticket reservation with distributed locking
 type ReservationService interface {
 	CreateReservation(ctx context.Context, userID string, req *request.CreateOrderRequest) (*response.OrderResponse, error)
+	QuoteOrder(ctx context.Context, req *request.QuoteOrderRequest) (*response.OrderQuoteResponse, error)
 	ReleaseReservation(ctx context.Context, orderID string, newStatus string) error
+	ReleaseSandboxOrder(ctx context.Context, orderID string) error
+	ReleaseRefundedOrder(ctx context.Context, orderID string) error
 	CleanupExpiredReservations(ctx context.Context) (int, error)
+	UpdateSettings(timeout, reminderBefore time.Duration)
 }
 
 // reservationService implements ReservationService interface
 type reservationService struct {
-	orderRepo      repository.OrderRepository
-	orderItemRepo  repository.OrderItemRepository
-	ticketTierRepo repository.TicketTierRepository
-	redisClient    *cache.DistributedLockClient
-	paymentClient  PaymentClient
+	orderRepo          repository.OrderRepository
+	orderItemRepo      repository.OrderItemRepository
+	ticketTierRepo     repository.TicketTierRepository
+	eventRepo          repository.EventRepository
+	tenantRepo         repository.TenantRepository
+	accommodationRepo  repository.AccommodationRepository
+	policyDocumentRepo repository.PolicyDocumentRepository
+	scheduledJobRepo   repository.ScheduledJobRepository
+	waitlistRepo       repository.WaitlistRepository // nil leaves waitlist offers unused until they expire
+	locker             cache.Locker
+	pgLock             *cache.PGAdvisoryLock
+	txWatchdog         *repository.TxWatchdog // nil leaves transactions without deadline
+	paymentClient      PaymentClient
+	availability       AvailabilityService
+	insurance          InsuranceService     // nil when ticket insurance is disabled
+	flags              *featureflags.Client // Selects event-sourced orders, nil creates CRUD orders
+	settings           atomic.Pointer[reservationSettings]
+}
+
+// reservationSettings holds the reservation settings swapped on config reload
+type reservationSettings struct {
 	timeout        time.Duration
+	reminderBefore time.Duration // Payment reminder lead time before expiry, 0 disables
 }
 
 // PaymentClient defines interface for payment service communication
 type PaymentClient interface {
 	CreateInvoice(ctx context.Context, req *client.CreateInvoiceRequest) (*client.CreateInvoiceResponse, error)
+	GetPaymentStatus(ctx context.Context, orderID string) (*client.CreateInvoiceResponse, error)
+	ExpireInvoice(ctx context.Context, orderID, reason string) (*client.ExpireInvoiceResponse, error)
+	RecordTicketEmail(ctx context.Context, orderID, paymentID string) (bool, error)
 }
 
 // NewReservationService creates new reservation service instance
@@ -50,24 +101,104 @@ func NewReservationService(
 	orderRepo repository.OrderRepository,
 	orderItemRepo repository.OrderItemRepository,
 	ticketTierRepo repository.TicketTierRepository,
-	redisClient cache.RedisClient,
+	eventRepo repository.EventRepository,
+	tenantRepo repository.TenantRepository,
+	accommodationRepo repository.AccommodationRepository,
+	policyDocumentRepo repository.PolicyDocumentRepository,
+	scheduledJobRepo repository.ScheduledJobRepository,
+	waitlistRepo repository.WaitlistRepository,
+	locker cache.Locker,
+	pgLock *cache.PGAdvisoryLock,
+	txWatchdog *repository.TxWatchdog,
 	paymentClient PaymentClient,
+	availability AvailabilityService,
+	insurance InsuranceService,
+	flags *featureflags.Client,
 	timeout time.Duration,
+	reminderBefore time.Duration,
 ) ReservationService {
-	// Wrap RedisClient with distributed lock convenience methods
-	var lockClient *cache.DistributedLockClient
-	if redisClient != nil {
-		lockClient = cache.NewDistributedLockClient(redisClient)
+	s := &reservationService{
+		orderRepo:          orderRepo,
+		orderItemRepo:      orderItemRepo,
+		ticketTierRepo:     ticketTierRepo,
+		eventRepo:          eventRepo,
+		tenantRepo:         tenantRepo,
+		accommodationRepo:  accommodationRepo,
+		policyDocumentRepo: policyDocumentRepo,
+		scheduledJobRepo:   scheduledJobRepo,
+		waitlistRepo:       waitlistRepo,
+		locker:             locker,
+		pgLock:             pgLock,
+		txWatchdog:         txWatchdog,
+		paymentClient:      paymentClient,
+		availability:       availability,
+		insurance:          insurance,
+		flags:              flags,
 	}
+	s.UpdateSettings(timeout, reminderBefore)
+	return s
+}
+
+// UpdateSettings swaps the reservation timeout and reminder lead time, e.g. on config reload
+// Existing reservations keep the expiry they were created with
+func (s *reservationService) UpdateSettings(timeout, reminderBefore time.Duration) {
+	s.settings.Store(&reservationSettings{timeout: timeout, reminderBefore: reminderBefore})
+}
 
-	return &reservationService{
-		orderRepo:      orderRepo,
-		orderItemRepo:  orderItemRepo,
-		ticketTierRepo: ticketTierRepo,
-		redisClient:    lockClient,
-		paymentClient:  paymentClient,
-		timeout:        timeout,
+// currentSettings returns the settings in effect
+func (s *reservationService) currentSettings() reservationSettings {
+	if settings := s.settings.Load(); settings != nil {
+		return *settings
 	}
+	return reservationSettings{}
+}
+
+// acquireTierLocks acquires the Redis locks for keys
+// When no Redis locker is configured or Redis fails, it reports that the caller
+// must take PostgreSQL advisory locks in its transaction instead. release is
+// always safe to call.
+func (s *reservationService) acquireTierLocks(ctx context.Context, keys []string) (useAdvisoryLock bool, release func(), err error) {
+	release = func() {}
+	if s.locker == nil {
+		return s.pgLock != nil, release, nil
+	}
+
+	// Try to acquire all locks with timeout
+	lockCtx, cancel := context.WithTimeout(ctx, lockWaitTimeout)
+	defer cancel()
+
+	// Only locks taken here are released; tokens keep other owners' locks intact
+	locks := make([]*cache.Lock, 0, len(keys))
+	// Locks are released even when the request was cancelled, so they don't linger until expiry
+	releaseAll := func() {
+		releaseCtx, cancel := timeout.Detach(ctx)
+		defer cancel()
+		for _, lock := range locks {
+			s.locker.Release(releaseCtx, lock)
+		}
+	}
+
+	for _, key := range keys {
+		lock, err := s.locker.Acquire(lockCtx, key, 10*time.Second)
+		if errors.Is(err, cache.ErrLockNotAcquired) {
+			releaseAll()
+			reservationLockFailures.Inc(lockFailureContended)
+			return false, release, ErrLockAcquisitionFailed
+		}
+		if err != nil {
+			// Redis outage: fall back instead of rejecting every reservation
+			releaseAll()
+			if s.pgLock == nil {
+				reservationLockFailures.Inc(lockFailureUnavailable)
+				return false, release, ErrLockAcquisitionFailed
+			}
+			log.Printf("[WARN] Redis lock failed, falling back to PostgreSQL advisory locks: %v", err)
+			return true, release, nil
+		}
+		locks = append(locks, lock)
+	}
+
+	return false, releaseAll, nil
 }
 
 // CreateReservation creates a ticket reservation with distributed + database locking
@@ -77,41 +208,53 @@ func (s *reservationService) CreateReservation(ctx context.Context, userID strin
 	if len(req.Items) == 0 {
 		return nil, ErrInvalidQuantity
 	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
 
-	// Step 2: Acquire distributed locks for all ticket tiers (Redis)
-	// Skip if Redis is not available (development mode)
-	var lockKeys []string
-	if s.redisClient != nil {
-		lockKeys = make([]string, len(req.Items))
-		for i, item := range req.Items {
-			lockKeys[i] = fmt.Sprintf("lock:tier:%s", item.TicketTierID)
-		}
+	tenantID := tenantFromContext(ctx)
+	event, tenantConfig, err := s.getSaleTenant(ctx, tenantID, req.EventID)
+	if err != nil {
+		return nil, err
+	}
 
-		// Try to acquire all locks with timeout
-		lockCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
-		defer cancel()
+	// The buyer accepts the current platform terms and event policies with the order
+	policies, err := currentPolicies(ctx, s.policyDocumentRepo, tenantID, event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get policies: %w", err)
+	}
+	acceptances, err := policyAcceptances(policies, req.AcceptedPolicies, userID)
+	if err != nil {
+		return nil, err
+	}
 
-		for _, key := range lockKeys {
-			acquired, err := s.redisClient.AcquireLock(lockCtx, key, 10*time.Second)
-			if err != nil || !acquired {
-				// Release any acquired locks
-				for _, k := range lockKeys {
-					s.redisClient.ReleaseLock(ctx, k)
-				}
-				return nil, ErrLockAcquisitionFailed
-			}
+	// Insurance is quoted before any lock is taken, the partner call must not hold up other reservations
+	var policy *entity.InsurancePolicy
+	if req.Insurance {
+		policy, err = s.quoteReservationInsurance(ctx, tenantID, event, req)
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		// Ensure locks are released when done
-		defer func() {
-			for _, key := range lockKeys {
-				s.redisClient.ReleaseLock(context.Background(), key)
-			}
-		}()
+	// Step 2: Acquire distributed locks for all ticket tiers
+	lockKeys := make([]string, len(req.Items))
+	for i, item := range req.Items {
+		lockKeys[i] = fmt.Sprintf("lock:tier:%s", item.TicketTierID)
+	}
+
+	useAdvisoryLock, release, err := s.acquireTierLocks(ctx, lockKeys)
+	if err != nil {
+		return nil, err
 	}
+	defer release()
+
+	// Row locks are held until commit, the watchdog rolls back a stalled transaction
+	txCtx, txDone := s.txWatchdog.Watch(ctx, "create_reservation")
+	defer txDone()
 
 	// Step 3: Start database transaction
-	tx, err := s.orderRepo.BeginTx(ctx)
+	tx, err := s.orderRepo.BeginTx(txCtx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -123,14 +266,29 @@ func (s *reservationService) CreateReservation(ctx context.Context, userID strin
 		}
 	}()
 
-	// Step 4: Calculate totals and validate availability
-	var totalAmount float64
-	tierPrices := make(map[string]float64) // Store tier prices
-	tierNames := make(map[string]string)   // Store tier names for invoice
+	// Redis unavailable: lock the tiers inside the transaction instead
+	if useAdvisoryLock {
+		lockCtx, cancel := context.WithTimeout(txCtx, lockWaitTimeout)
+		err = s.pgLock.LockTx(lockCtx, tx, lockKeys...)
+		cancel()
+		if err != nil {
+			log.Printf("[WARN] Advisory lock for reservation failed: %v", err)
+			reservationLockFailures.Inc(lockFailureAdvisory)
+			return nil, ErrLockAcquisitionFailed
+		}
+	}
 
+	// Step 4: Validate items and availability
+	// Wheelchair and companion seats are taken from the tier's accessible seating, the rest from general sale
+	// The order ID is assigned up front so the inventory ledger records which order took the seats
+	orderID := uuid.New().String()
+	reserve := entity.OrderInventoryCause(entity.InventoryReasonReserve, orderID)
+	accommodations := newAccommodations(tenantID, req.EventID, req.Accommodations)
+	seats := accessibleSeatsByTier(accommodations)
+	lines := make([]pricing.Line, 0, len(req.Items))
 	for _, item := range req.Items {
 		// Get tier with row-level lock (SELECT FOR UPDATE)
-		tier, err := s.ticketTierRepo.GetByIDWithLock(ctx, tx, item.TicketTierID)
+		tier, err := s.ticketTierRepo.GetByIDWithLock(txCtx, tx, item.TicketTierID)
 		if err != nil {
 			if errors.Is(err, repository.ErrTicketTierNotFound) {
 				return nil, ErrTicketTierNotFound
@@ -138,90 +296,171 @@ func (s *reservationService) CreateReservation(ctx context.Context, userID strin
 			return nil, fmt.Errorf("failed to get ticket tier: %w", err)
 		}
 
-		// Validate quantity
-		if item.Quantity <= 0 {
-			return nil, ErrInvalidQuantity
+		if err := checkOrderItem(req.EventID, tier, item); err != nil {
+			return nil, err
 		}
 
-		// Check max per order
-		if item.Quantity > tier.MaxPerOrder {
-			return nil, ErrMaxPerOrderExceeded
+		// Seats held for the buyer by a waitlist offer are theirs to reserve
+		if s.waitlistRepo != nil {
+			if err := s.takeWaitlistOffer(txCtx, tx, tier, userID, orderID); err != nil {
+				return nil, err
+			}
 		}
 
 		// Check availability
-		available := tier.Quota - tier.SoldCount
-		if available < item.Quantity {
+		general, accessible := takeAccessibleSeats(seats, item.TicketTierID, item.Quantity)
+		if tier.GetAvailableQuota() < general {
+			reservationInsufficientQuota.Inc()
 			return nil, ErrInsufficientQuota
 		}
-
-		// Calculate subtotal
-		subtotal := tier.Price * float64(item.Quantity)
-		totalAmount += subtotal
-		tierPrices[item.TicketTierID] = tier.Price
-		tierNames[item.TicketTierID] = tier.Name
+		if tier.GetAvailableAccessibleQuota() < accessible {
+			return nil, ErrAccessibleSeatingUnavailable
+		}
 
 		// Update sold count (reserve inventory)
-		if err := s.ticketTierRepo.UpdateSoldCount(ctx, tx, item.TicketTierID, item.Quantity); err != nil {
-			if errors.Is(err, repository.ErrInsufficientQuota) {
-				return nil, ErrInsufficientQuota
+		if general > 0 {
+			if err := s.ticketTierRepo.UpdateSoldCount(txCtx, tx, item.TicketTierID, general, reserve); err != nil {
+				if errors.Is(err, repository.ErrInsufficientQuota) {
+					reservationInsufficientQuota.Inc()
+					return nil, ErrInsufficientQuota
+				}
+				return nil, fmt.Errorf("failed to update sold count: %w", err)
 			}
-			return nil, fmt.Errorf("failed to update sold count: %w", err)
 		}
+		if accessible > 0 {
+			if err := s.ticketTierRepo.UpdateAccessibleSoldCount(txCtx, tx, item.TicketTierID, accessible, reserve); err != nil {
+				if errors.Is(err, repository.ErrInsufficientAccessibleQuota) {
+					return nil, ErrAccessibleSeatingUnavailable
+				}
+				return nil, fmt.Errorf("failed to update accessible sold count: %w", err)
+			}
+		}
+
+		lines = append(lines, tierLine(tier, item.Quantity))
 	}
 
-	// Step 5: Calculate fees
-	platformFee := totalAmount * 0.05 // 5% platform fee
-	serviceFee := 2500.0              // Rp 2,500 service fee
-	grandTotal := totalAmount + platformFee + serviceFee
+	// The premium covers the ticket prices it was quoted for
+	if policy != nil {
+		if coverage, _ := linesCoverage(lines); coverage != policy.Coverage {
+			err = ErrInsuranceCoverageChanged
+			return nil, err
+		}
+		lines = append(lines, insuranceLine(policy))
+	}
+
+	// Step 5: Calculate totals and fees
+	breakdown, err := pricing.Calculate(lines, tenantConfig.PricingPolicy())
+	if err != nil {
+		return nil, fmt.Errorf("failed to price order: %w", err)
+	}
 
 	// Step 6: Create order
-	expiresAt := time.Now().Add(s.timeout)
+	settings := s.currentSettings()
+	expiresAt := time.Now().Add(settings.timeout)
 	order := &entity.Order{
+		ID:                   orderID,
 		UserID:               userID,
 		EventID:              req.EventID,
-		TotalAmount:          totalAmount,
-		PlatformFee:          platformFee,
-		ServiceFee:           serviceFee,
-		GrandTotal:           grandTotal,
+		TenantID:             tenantID,
+		TotalAmount:          breakdown.Subtotal,
+		PlatformFee:          breakdown.PlatformFee,
+		ServiceFee:           breakdown.ServiceFee,
+		GrandTotal:           breakdown.GrandTotal,
 		Status:               entity.OrderStatusReserved,
 		ReservationExpiresAt: &expiresAt,
+		Metadata:             req.Metadata,
+		IsSandbox:            event.IsSandbox,
+		EventSourced:         eventSourcedOrders(ctx, s.flags, userID, event),
 	}
 
-	if err := s.orderRepo.Create(ctx, order); err != nil {
+	if err = s.orderRepo.CreateWithTx(txCtx, tx, order); err != nil {
 		return nil, fmt.Errorf("failed to create order: %w", err)
 	}
 
-	// Step 7: Create order items
-	orderItems := make([]entity.OrderItem, len(req.Items))
-	for i, item := range req.Items {
-		orderItems[i] = entity.OrderItem{
-			OrderID:      order.ID,
-			TicketTierID: item.TicketTierID,
-			Quantity:     item.Quantity,
-			Price:        tierPrices[item.TicketTierID],
+	// Step 7: Create order items (the insurance premium is recorded on the policy)
+	orderItems := make([]entity.OrderItem, 0, len(breakdown.Lines))
+	for _, line := range breakdown.Lines {
+		if line.AddOn {
+			continue
 		}
+		orderItems = append(orderItems, entity.OrderItem{
+			OrderID:      order.ID,
+			TicketTierID: line.Ref,
+			Quantity:     line.Quantity,
+			Price:        line.UnitPrice,
+		})
 	}
 
-	if err := s.orderItemRepo.CreateBatch(ctx, tx, orderItems); err != nil {
+	if err := s.orderItemRepo.CreateBatch(txCtx, tx, orderItems); err != nil {
 		return nil, fmt.Errorf("failed to create order items: %w", err)
 	}
 
+	if policy != nil {
+		policy.OrderID = order.ID
+		if err = s.insurance.CreatePolicyWithTx(txCtx, tx, policy); err != nil {
+			return nil, fmt.Errorf("failed to create insurance policy: %w", err)
+		}
+	}
+
+	if len(accommodations) > 0 {
+		for i := range accommodations {
+			accommodations[i].OrderID = order.ID
+		}
+		if err = s.accommodationRepo.CreateBatchWithTx(txCtx, tx, accommodations); err != nil {
+			return nil, fmt.Errorf("failed to create accommodations: %w", err)
+		}
+	}
+
+	if len(acceptances) > 0 {
+		for i := range acceptances {
+			acceptances[i].OrderID = order.ID
+		}
+		if err = s.policyDocumentRepo.CreateAcceptancesWithTx(txCtx, tx, acceptances); err != nil {
+			return nil, fmt.Errorf("failed to record policy acceptances: %w", err)
+		}
+	}
+
+	// Remind the buyer to pay shortly before the reservation is released
+	if remindAt, ok := reservationReminderAt(expiresAt, settings.timeout, settings.reminderBefore); ok {
+		reminder := &entity.ScheduledJob{
+			Type:        entity.JobTypeReservationReminder,
+			ReferenceID: order.ID,
+			RunAt:       remindAt,
+		}
+		if err = s.scheduledJobRepo.CreateWithTx(txCtx, tx, reminder); err != nil {
+			return nil, fmt.Errorf("failed to schedule reservation reminder: %w", err)
+		}
+	}
+
 	// Step 8: Commit transaction
 	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
+	txDone()
+
+	// Sold count changed, listing availability summary needs refresh
+	s.availability.MarkStale()
 
 	// Step 9: Create payment invoice via gRPC (if payment client available)
 	orderResp := response.ToOrderResponse(order, orderItems)
+	if policy != nil {
+		orderResp.Insurance = response.ToInsurancePolicyResponse(policy)
+	}
+	if len(accommodations) > 0 {
+		orderResp.Accommodations = response.ToAccommodationResponses(accommodations)
+	}
+	if len(acceptances) > 0 {
+		orderResp.AcceptedPolicies = response.ToPolicyAcceptanceResponses(acceptances)
+	}
 
 	if s.paymentClient != nil {
-		// Prepare invoice items
-		invoiceItems := make([]client.InvoiceItem, len(orderItems))
-		for i, item := range orderItems {
+		// Prepare invoice items, the insurance premium included
+		invoiceItems := make([]client.InvoiceItem, len(breakdown.Lines))
+		for i, line := range breakdown.Lines {
 			invoiceItems[i] = client.InvoiceItem{
-				Name:     tierNames[item.TicketTierID], // Use tier name from earlier fetch
-				Quantity: item.Quantity,
-				Price:    item.Price,
+				Name:     line.Name, // Use tier name from earlier fetch
+				Quantity: line.Quantity,
+				Price:    line.UnitPrice,
 			}
 		}
 
@@ -231,9 +470,10 @@ func (s *reservationService) CreateReservation(ctx context.Context, userID strin
 			UserID:       userID,
 			Email:        req.Email,
 			CustomerName: req.CustomerName,
-			Amount:       grandTotal,
+			Amount:       breakdown.GrandTotal,
 			Description:  fmt.Sprintf("Tiket Event - Order #%s", order.ID[:8]),
 			Items:        invoiceItems,
+			Sandbox:      order.IsSandbox,
 		}
 
 		// Call payment service
@@ -244,8 +484,11 @@ func (s *reservationService) CreateReservation(ctx context.Context, userID strin
 			// CRITICAL: Rollback order creation - release inventory and delete order
 			log.Printf("[INFO] Rolling back order %s due to invoice creation failure", order.ID)
 
-			// Release the reservation (will restore inventory)
-			if rollbackErr := s.ReleaseReservation(context.Background(), order.ID, entity.OrderStatusCancelled); rollbackErr != nil {
+			// Release the reservation (will restore inventory), even if the client went away meanwhile
+			rollbackCtx, cancel := timeout.Detach(ctx)
+			rollbackErr := s.ReleaseReservation(rollbackCtx, order.ID, entity.OrderStatusCancelled)
+			cancel()
+			if rollbackErr != nil {
 				log.Printf("[ERROR] Failed to rollback order %s: %v", order.ID, rollbackErr)
 			}
 
@@ -261,11 +504,274 @@ func (s *reservationService) CreateReservation(ctx context.Context, userID strin
 	return orderResp, nil
 }
 
+// QuoteOrder prices an order the way CreateReservation would, without reserving inventory
+// Availability is read without locks, so it may change before the order is placed; items that
+// don't fit the remaining quota are flagged instead of failing the quote
+func (s *reservationService) QuoteOrder(ctx context.Context, req *request.QuoteOrderRequest) (*response.OrderQuoteResponse, error) {
+	if len(req.Items) == 0 {
+		return nil, ErrInvalidQuantity
+	}
+
+	tenantID := tenantFromContext(ctx)
+	event, tenantConfig, err := s.getSaleTenant(ctx, tenantID, req.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]pricing.Line, 0, len(req.Items))
+	remaining := make([]int, 0, len(req.Items))
+
+	for _, item := range req.Items {
+		tier, err := s.ticketTierRepo.GetByID(ctx, item.TicketTierID)
+		if err != nil {
+			if errors.Is(err, repository.ErrTicketTierNotFound) {
+				return nil, ErrTicketTierNotFound
+			}
+			return nil, fmt.Errorf("failed to get ticket tier: %w", err)
+		}
+
+		if err := checkOrderItem(req.EventID, tier, item); err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, tierLine(tier, item.Quantity))
+		remaining = append(remaining, tier.GetAvailableQuota())
+	}
+
+	itemCount := len(lines)
+	var insurancePremium *money.Money
+	if req.Insurance {
+		policy, err := s.quoteInsurance(ctx, tenantID, event, lines)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, insuranceLine(policy))
+		insurancePremium = &policy.Premium
+	}
+
+	breakdown, err := pricing.Calculate(lines, tenantConfig.PricingPolicy())
+	if err != nil {
+		return nil, fmt.Errorf("failed to price order: %w", err)
+	}
+
+	itemResponses := make([]response.OrderQuoteItemResponse, itemCount)
+	available := true
+	for i, line := range breakdown.Lines[:itemCount] {
+		itemAvailable := remaining[i] >= line.Quantity
+		available = available && itemAvailable
+
+		itemResponses[i] = response.OrderQuoteItemResponse{
+			TicketTierID: line.Ref,
+			TierName:     line.Name,
+			Quantity:     line.Quantity,
+			Price:        line.UnitPrice,
+			Subtotal:     line.Subtotal(),
+			Remaining:    remaining[i],
+			Available:    itemAvailable,
+		}
+	}
+
+	return &response.OrderQuoteResponse{
+		EventID:                   req.EventID,
+		Items:                     itemResponses,
+		TotalAmount:               breakdown.Subtotal,
+		PlatformFee:               breakdown.PlatformFee,
+		ServiceFee:                breakdown.ServiceFee,
+		InsurancePremium:          insurancePremium,
+		GrandTotal:                breakdown.GrandTotal,
+		Available:                 available,
+		ReservationTimeoutSeconds: int(s.currentSettings().timeout.Seconds()),
+	}, nil
+}
+
+// checkOrderItem validates an ordered item against its ticket tier, availability aside
+func checkOrderItem(eventID string, tier *entity.TicketTier, item request.OrderItem) error {
+	// Tiers of other events (possibly another tenant's) can't be ordered under this event,
+	// archived tiers are no longer on sale
+	if tier.EventID != eventID || tier.IsArchived() {
+		return ErrTicketTierNotFound
+	}
+
+	// Validate quantity
+	if item.Quantity <= 0 {
+		return ErrInvalidQuantity
+	}
+
+	// Check max per order
+	if item.Quantity > tier.MaxPerOrder {
+		return ErrMaxPerOrderExceeded
+	}
+
+	return nil
+}
+
+// takeWaitlistOffer returns the seats held by the buyer's waitlist offer for the tier to the tier,
+// so the reservation can take them, and marks the offer purchased by the order
+// The tier must be locked in tx; tier.SoldCount is updated to match. Expired offers are left to the
+// waitlist worker, it offers their seats to the next buyer.
+func (s *reservationService) takeWaitlistOffer(ctx context.Context, tx *sql.Tx, tier *entity.TicketTier, userID, orderID string) error {
+	entry, err := s.waitlistRepo.GetOpenByUserWithLock(ctx, tx, tier.ID, userID)
+	if errors.Is(err, repository.ErrWaitlistEntryNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get waitlist entry: %w", err)
+	}
+	if !entry.HasOffer(time.Now()) {
+		return nil
+	}
+
+	if err := s.ticketTierRepo.ReleaseSoldCount(ctx, tx, tier.ID, entry.Quantity, entity.InventoryCause{Reason: entity.InventoryReasonRelease}); err != nil {
+		return fmt.Errorf("failed to release waitlist offer: %w", err)
+	}
+	tier.SoldCount -= entry.Quantity
+
+	if err := s.waitlistRepo.PurchaseWithTx(ctx, tx, entry.ID, orderID); err != nil {
+		return fmt.Errorf("failed to mark waitlist offer purchased: %w", err)
+	}
+
+	return nil
+}
+
+// tierLine returns the pricing line of quantity tickets of a tier
+func tierLine(tier *entity.TicketTier, quantity int) pricing.Line {
+	return pricing.Line{
+		Ref:       tier.ID,
+		Name:      tier.Name,
+		UnitPrice: tier.Price,
+		Quantity:  quantity,
+	}
+}
+
+// quoteReservationInsurance quotes insurance for the items of an order about to be reserved
+// Tiers are read without locks; CreateReservation checks the quoted coverage against the locked prices
+func (s *reservationService) quoteReservationInsurance(ctx context.Context, tenantID string, event *entity.Event, req *request.CreateOrderRequest) (*entity.InsurancePolicy, error) {
+	lines := make([]pricing.Line, 0, len(req.Items))
+	for _, item := range req.Items {
+		tier, err := s.ticketTierRepo.GetByID(ctx, item.TicketTierID)
+		if err != nil {
+			if errors.Is(err, repository.ErrTicketTierNotFound) {
+				return nil, ErrTicketTierNotFound
+			}
+			return nil, fmt.Errorf("failed to get ticket tier: %w", err)
+		}
+
+		if err := checkOrderItem(req.EventID, tier, item); err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, tierLine(tier, item.Quantity))
+	}
+
+	return s.quoteInsurance(ctx, tenantID, event, lines)
+}
+
+// quoteInsurance quotes refund-protection insurance covering the ticket prices of lines
+func (s *reservationService) quoteInsurance(ctx context.Context, tenantID string, event *entity.Event, lines []pricing.Line) (*entity.InsurancePolicy, error) {
+	if s.insurance == nil {
+		return nil, ErrInsuranceUnavailable
+	}
+
+	coverage, tickets := linesCoverage(lines)
+	return s.insurance.Quote(ctx, tenantID, event, coverage, tickets)
+}
+
+// linesCoverage returns the insured value and ticket count of ticket lines
+// Fees aren't covered, they're charged for a service that was provided
+func linesCoverage(lines []pricing.Line) (coverage money.Money, tickets int) {
+	for _, line := range lines {
+		if line.AddOn {
+			continue
+		}
+		coverage = coverage.Add(line.Subtotal())
+		tickets += line.Quantity
+	}
+	return coverage, tickets
+}
+
+// insuranceLine returns the pricing line of an insurance premium, passed on at cost
+func insuranceLine(policy *entity.InsurancePolicy) pricing.Line {
+	return pricing.Line{
+		Ref:       insuranceLineRef,
+		Name:      insuranceLineName,
+		UnitPrice: policy.Premium,
+		Quantity:  1,
+		AddOn:     true,
+	}
+}
+
+// getSaleTenant checks that the event is on sale under the request tenant and returns it with the tenant's config
+// Orders belong to the tenant serving the request and may only be for its events
+func (s *reservationService) getSaleTenant(ctx context.Context, tenantID, eventID string) (*entity.Event, *entity.Tenant, error) {
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return nil, nil, ErrEventNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if event.TenantID != tenantID {
+		return nil, nil, ErrEventNotFound
+	}
+
+	// Only published events sell tickets; ended events may not be completed by event service yet
+	if !event.IsActive() || event.HasEnded() {
+		return nil, nil, ErrEventNotOnSale
+	}
+
+	// Fees are configured per tenant
+	tenantConfig, err := s.tenantRepo.GetByID(ctx, tenantID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	return event, tenantConfig, nil
+}
+
 // ReleaseReservation releases a reservation and returns inventory
 // newStatus can be either "cancelled" (manual) or "expired" (automatic)
 func (s *reservationService) ReleaseReservation(ctx context.Context, orderID string, newStatus string) error {
+	return s.releaseOrder(ctx, orderID, newStatus, func(order *entity.Order) error {
+		if order.Status != entity.OrderStatusReserved {
+			return fmt.Errorf("order is not in reserved status")
+		}
+		return nil
+	})
+}
+
+// ReleaseSandboxOrder cancels a test order of a sandbox event, reserved or paid, and returns its seats
+// Tickets of paid test orders are voided by the caller
+func (s *reservationService) ReleaseSandboxOrder(ctx context.Context, orderID string) error {
+	return s.releaseOrder(ctx, orderID, entity.OrderStatusCancelled, func(order *entity.Order) error {
+		if !order.IsSandbox {
+			return fmt.Errorf("order is not a sandbox order")
+		}
+		if order.Status != entity.OrderStatusReserved && order.Status != entity.OrderStatusPaid {
+			return fmt.Errorf("order is not in reserved or paid status")
+		}
+		return nil
+	})
+}
+
+// ReleaseRefundedOrder marks a paid order refunded and returns its seats
+// Tickets of the refunded order are voided by the caller
+func (s *reservationService) ReleaseRefundedOrder(ctx context.Context, orderID string) error {
+	return s.releaseOrder(ctx, orderID, entity.OrderStatusRefunded, func(order *entity.Order) error {
+		if order.Status != entity.OrderStatusPaid {
+			return ErrRefundNotAllowed
+		}
+		return nil
+	})
+}
+
+// releaseOrder returns the seats of an order accepted by check and moves it to newStatus in one transaction
+func (s *reservationService) releaseOrder(ctx context.Context, orderID string, newStatus string, check func(order *entity.Order) error) error {
+	// Row locks are held until commit, the watchdog rolls back a stalled transaction
+	txCtx, txDone := s.txWatchdog.Watch(ctx, "release_reservation")
+	defer txDone()
+
 	// Start transaction
-	tx, err := s.orderRepo.BeginTx(ctx)
+	tx, err := s.orderRepo.BeginTx(txCtx)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -277,32 +783,46 @@ func (s *reservationService) ReleaseReservation(ctx context.Context, orderID str
 	}()
 
 	// Get order with lock
-	order, err := s.orderRepo.GetByIDWithLock(ctx, tx, orderID)
+	order, err := s.orderRepo.GetByIDWithLock(txCtx, tx, orderID)
 	if err != nil {
 		return fmt.Errorf("failed to get order: %w", err)
 	}
 
-	// Only release if status is reserved
-	if order.Status != entity.OrderStatusReserved {
-		return fmt.Errorf("order is not in reserved status")
+	if err = check(order); err != nil {
+		return err
 	}
 
 	// Get order items
-	items, err := s.orderItemRepo.GetByOrderID(ctx, orderID)
+	items, err := s.orderItemRepo.GetByOrderID(txCtx, orderID)
 	if err != nil {
 		return fmt.Errorf("failed to get order items: %w", err)
 	}
 
+	// Accessible seats go back to the tier's accessible seating
+	accommodations, err := s.accommodationRepo.GetByOrderID(txCtx, orderID)
+	if err != nil {
+		return fmt.Errorf("failed to get order accommodations: %w", err)
+	}
+	seats := accessibleSeatsByTier(accommodations)
+
 	// Release inventory for each item
 	for _, item := range items {
-		if err := s.ticketTierRepo.ReleaseSoldCount(ctx, tx, item.TicketTierID, item.Quantity); err != nil {
-			return fmt.Errorf("failed to release sold count: %w", err)
+		general, accessible := takeAccessibleSeats(seats, item.TicketTierID, item.Quantity)
+		if general > 0 {
+			if err := s.ticketTierRepo.ReleaseSoldCount(txCtx, tx, item.TicketTierID, general, entity.OrderInventoryCause(entity.InventoryReasonRelease, orderID)); err != nil {
+				return fmt.Errorf("failed to release sold count: %w", err)
+			}
+		}
+		if accessible > 0 {
+			if err := s.ticketTierRepo.ReleaseAccessibleSoldCount(txCtx, tx, item.TicketTierID, accessible, entity.OrderInventoryCause(entity.InventoryReasonRelease, orderID)); err != nil {
+				return fmt.Errorf("failed to release accessible sold count: %w", err)
+			}
 		}
 	}
 
 	// Update order status (cancelled or expired)
 	order.Status = newStatus
-	if err := s.orderRepo.UpdateWithTx(ctx, tx, order); err != nil {
+	if err := s.orderRepo.UpdateWithTx(txCtx, tx, order); err != nil {
 		return fmt.Errorf("failed to update order status: %w", err)
 	}
 
@@ -310,6 +830,10 @@ func (s *reservationService) ReleaseReservation(ctx context.Context, orderID str
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
+	txDone()
+
+	// Sold count changed, listing availability summary needs refresh
+	s.availability.MarkStale()
 
 	return nil
 }
@@ -330,24 +854,40 @@ func (s *reservationService) CleanupExpiredReservations(ctx context.Context) (in
 
 	// Process each expired order
 	for _, order := range expiredOrders {
-		// Acquire lock for this order
-		lockKey := fmt.Sprintf("lock:order:%s", order.ID)
-		acquired, err := s.redisClient.AcquireLock(ctx, lockKey, 10*time.Second)
-		if err != nil || !acquired {
-			// Skip if can't acquire lock (might be processing payment)
-			continue
+		if s.releaseExpired(ctx, order.ID) {
+			releasedCount++
 		}
+	}
 
-		// Release reservation with "expired" status
-		if err := s.ReleaseReservation(ctx, order.ID, entity.OrderStatusExpired); err != nil {
-			// Log error but continue processing other orders
-			s.redisClient.ReleaseLock(ctx, lockKey)
-			continue
-		}
+	return releasedCount, nil
+}
 
-		s.redisClient.ReleaseLock(ctx, lockKey)
-		releasedCount++
+// releaseExpired releases one expired reservation under its order lock
+// Returns false when the order is skipped or could not be released
+func (s *reservationService) releaseExpired(ctx context.Context, orderID string) bool {
+	// The order lock only reduces contention; ReleaseReservation locks the order row
+	// and re-checks its status, so during a Redis outage cleanup continues without it
+	if s.locker != nil {
+		// Acquire lock for this order
+		lock, err := s.locker.Acquire(ctx, fmt.Sprintf("lock:order:%s", orderID), 10*time.Second)
+		if errors.Is(err, cache.ErrLockNotAcquired) {
+			// Skip if lock is held (might be processing payment)
+			return false
+		}
+		if err == nil {
+			defer s.locker.Release(ctx, lock)
+		}
 	}
 
-	return releasedCount, nil
+	// Release reservation with "expired" status
+	// Errors are skipped so the other orders are still processed
+	return s.ReleaseReservation(ctx, orderID, entity.OrderStatusExpired) == nil
+}
+
+// tenantFromContext returns the tenant set by the tenant middleware, or the default tenant
+func tenantFromContext(ctx context.Context) string {
+	if tenantID, ok := tenant.FromContext(ctx); ok {
+		return tenantID
+	}
+	return tenant.DefaultID
 }
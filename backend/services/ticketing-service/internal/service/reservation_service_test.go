@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/pricing"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubLocker keeps held locks in memory; err simulates a Redis outage
+type stubLocker struct {
+	held map[string]bool
+	err  error
+}
+
+func (l *stubLocker) Acquire(ctx context.Context, key string, ttl time.Duration) (*cache.Lock, error) {
+	if l.err != nil {
+		return nil, l.err
+	}
+	if l.held[key] {
+		return nil, cache.ErrLockNotAcquired
+	}
+	l.held[key] = true
+	return &cache.Lock{Key: key, Token: "token"}, nil
+}
+
+func (l *stubLocker) Release(ctx context.Context, lock *cache.Lock) error {
+	delete(l.held, lock.Key)
+	return nil
+}
+
+func (l *stubLocker) Renew(ctx context.Context, lock *cache.Lock, ttl time.Duration) error {
+	return nil
+}
+
+func TestReservationService_AcquireTierLocks(t *testing.T) {
+	ctx := context.Background()
+	keys := []string{"lock:tier:a", "lock:tier:b"}
+
+	t.Run("redis locks", func(t *testing.T) {
+		locker := &stubLocker{held: map[string]bool{}}
+		svc := &reservationService{locker: locker, pgLock: cache.NewPGAdvisoryLock()}
+
+		useAdvisoryLock, release, err := svc.acquireTierLocks(ctx, keys)
+		require.NoError(t, err)
+		assert.False(t, useAdvisoryLock)
+		assert.Len(t, locker.held, 2)
+
+		release()
+		assert.Empty(t, locker.held)
+	})
+
+	t.Run("lock held by another request", func(t *testing.T) {
+		locker := &stubLocker{held: map[string]bool{"lock:tier:b": true}}
+		svc := &reservationService{locker: locker, pgLock: cache.NewPGAdvisoryLock()}
+
+		_, _, err := svc.acquireTierLocks(ctx, keys)
+		assert.ErrorIs(t, err, ErrLockAcquisitionFailed)
+		assert.False(t, locker.held["lock:tier:a"])
+		// The other owner's lock is left alone
+		assert.True(t, locker.held["lock:tier:b"])
+	})
+
+	t.Run("redis outage falls back to advisory locks", func(t *testing.T) {
+		locker := &stubLocker{held: map[string]bool{}, err: errors.New("connection refused")}
+		svc := &reservationService{locker: locker, pgLock: cache.NewPGAdvisoryLock()}
+
+		useAdvisoryLock, release, err := svc.acquireTierLocks(ctx, keys)
+		require.NoError(t, err)
+		assert.True(t, useAdvisoryLock)
+		release()
+	})
+
+	t.Run("redis outage without fallback", func(t *testing.T) {
+		locker := &stubLocker{held: map[string]bool{}, err: errors.New("connection refused")}
+		svc := &reservationService{locker: locker}
+
+		_, _, err := svc.acquireTierLocks(ctx, keys)
+		assert.ErrorIs(t, err, ErrLockAcquisitionFailed)
+	})
+
+	t.Run("no redis", func(t *testing.T) {
+		svc := &reservationService{pgLock: cache.NewPGAdvisoryLock()}
+
+		useAdvisoryLock, _, err := svc.acquireTierLocks(ctx, keys)
+		require.NoError(t, err)
+		assert.True(t, useAdvisoryLock)
+	})
+}
+
+func TestCreateReservation_RejectsOtherTenantsEvent(t *testing.T) {
+	const brandTenant = "6f1c2a4e-8d0b-4c55-9a77-3e2f1b0c9d11"
+	svc := &reservationService{
+		eventRepo: &stubEventRepo{event: &entity.Event{ID: "event-1", TenantID: tenant.DefaultID}},
+	}
+	req := &request.CreateOrderRequest{
+		EventID: "event-1",
+		Items:   []request.OrderItem{{TicketTierID: "tier-1", Quantity: 1}},
+	}
+
+	_, err := svc.CreateReservation(tenant.NewContext(context.Background(), brandTenant), "user-1", req)
+	assert.ErrorIs(t, err, ErrEventNotFound)
+}
+
+func TestCreateReservation_RejectsEventsNotOnSale(t *testing.T) {
+	future := time.Now().Add(24 * time.Hour)
+	tests := []struct {
+		name  string
+		event *entity.Event
+	}{
+		{"draft", &entity.Event{Status: entity.EventStatusDraft, EndDate: future}},
+		{"completed", &entity.Event{Status: entity.EventStatusCompleted, EndDate: time.Now().Add(-time.Hour)}},
+		{"ended but not completed yet", &entity.Event{Status: entity.EventStatusPublished, EndDate: time.Now().Add(-time.Minute)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.event.ID = "event-1"
+			tt.event.TenantID = tenant.DefaultID
+			svc := &reservationService{eventRepo: &stubEventRepo{event: tt.event}}
+			req := &request.CreateOrderRequest{
+				EventID: "event-1",
+				Items:   []request.OrderItem{{TicketTierID: "tier-1", Quantity: 1}},
+			}
+
+			_, err := svc.CreateReservation(tenant.NewContext(context.Background(), tenant.DefaultID), "user-1", req)
+			assert.ErrorIs(t, err, ErrEventNotOnSale)
+		})
+	}
+}
+
+func TestCreateReservation_RejectsInvalidMetadata(t *testing.T) {
+	tooMany := map[string]string{}
+	for i := 0; i <= request.MaxMetadataKeys; i++ {
+		tooMany[fmt.Sprintf("key_%d", i)] = "v"
+	}
+
+	tests := []struct {
+		name     string
+		metadata map[string]string
+	}{
+		{"too many keys", tooMany},
+		{"empty key", map[string]string{"": "v"}},
+		{"key with spaces", map[string]string{"partner ref": "v"}},
+		{"key too long", map[string]string{strings.Repeat("k", request.MaxMetadataKeyLength+1): "v"}},
+		{"value too long", map[string]string{"partner_ref": strings.Repeat("v", request.MaxMetadataValueLength+1)}},
+		{"object too large", map[string]string{
+			"a": strings.Repeat("v", 500), "b": strings.Repeat("v", 500), "c": strings.Repeat("v", 500), "d": strings.Repeat("v", 500),
+			"e": strings.Repeat("v", 500), "f": strings.Repeat("v", 500), "g": strings.Repeat("v", 500), "h": strings.Repeat("v", 500),
+			"i": strings.Repeat("v", 500),
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Rejected before any repository is used
+			svc := &reservationService{}
+			req := &request.CreateOrderRequest{
+				EventID:  "event-1",
+				Items:    []request.OrderItem{{TicketTierID: "tier-1", Quantity: 1}},
+				Metadata: tt.metadata,
+			}
+
+			_, err := svc.CreateReservation(tenant.NewContext(context.Background(), tenant.DefaultID), "user-1", req)
+			assert.ErrorIs(t, err, request.ErrInvalidMetadata)
+		})
+	}
+
+	valid := &request.CreateOrderRequest{Metadata: map[string]string{"partner_ref": "PO-1234", "channel.id": "web-01"}}
+	assert.NoError(t, valid.Validate())
+}
+
+func TestCreateOrderRequest_ValidateAccommodations(t *testing.T) {
+	items := []request.OrderItem{{TicketTierID: "tier-vip", Quantity: 2}}
+
+	tests := []struct {
+		name           string
+		accommodations []request.AccommodationRequest
+		wantErr        bool
+	}{
+		{"none", nil, false},
+		{"one per ticket", []request.AccommodationRequest{
+			{TicketTierID: "tier-vip", Type: entity.AccommodationWheelchair},
+			{TicketTierID: "tier-vip", Type: entity.AccommodationCompanionSeat},
+		}, false},
+		{"tier not ordered", []request.AccommodationRequest{
+			{TicketTierID: "tier-reg", Type: entity.AccommodationWheelchair},
+		}, true},
+		{"more than tickets", []request.AccommodationRequest{
+			{TicketTierID: "tier-vip", Type: entity.AccommodationWheelchair},
+			{TicketTierID: "tier-vip", Type: entity.AccommodationHearing},
+			{TicketTierID: "tier-vip", Type: entity.AccommodationOther},
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &request.CreateOrderRequest{EventID: "event-1", Items: items, Accommodations: tt.accommodations}
+			err := req.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, request.ErrInvalidAccommodations)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestQuoteOrder_PricesWithoutReserving(t *testing.T) {
+	tiers := &stubTicketTierRepo{tiers: map[string]*entity.TicketTier{
+		"tier-vip": {ID: "tier-vip", EventID: "event-1", Name: "VIP", Price: money.New(500000), Quota: 10, SoldCount: 9, MaxPerOrder: 5},
+		"tier-reg": {ID: "tier-reg", EventID: "event-1", Name: "Regular", Price: money.New(150000), Quota: 100, SoldCount: 0, MaxPerOrder: 5},
+	}}
+	svc := &reservationService{
+		eventRepo: &stubEventRepo{event: &entity.Event{
+			ID: "event-1", TenantID: tenant.DefaultID, Status: entity.EventStatusPublished, EndDate: time.Now().Add(24 * time.Hour),
+		}},
+		tenantRepo:     &stubTenantRepo{tenant: &entity.Tenant{ID: tenant.DefaultID, PlatformFeePercent: 3, ServiceFee: money.New(1000)}},
+		ticketTierRepo: tiers,
+	}
+	svc.UpdateSettings(15*time.Minute, 0)
+	req := &request.QuoteOrderRequest{
+		EventID: "event-1",
+		Items: []request.OrderItem{
+			{TicketTierID: "tier-vip", Quantity: 2},
+			{TicketTierID: "tier-reg", Quantity: 2},
+		},
+	}
+
+	quote, err := svc.QuoteOrder(tenant.NewContext(context.Background(), tenant.DefaultID), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, money.New(1300000), quote.TotalAmount)
+	assert.Equal(t, money.New(39000), quote.PlatformFee)
+	assert.Equal(t, money.New(1000), quote.ServiceFee)
+	assert.Equal(t, money.New(1340000), quote.GrandTotal)
+	assert.Equal(t, 900, quote.ReservationTimeoutSeconds)
+
+	// Only one VIP ticket is left: flagged, not rejected
+	assert.False(t, quote.Available)
+	require.Len(t, quote.Items, 2)
+	assert.False(t, quote.Items[0].Available)
+	assert.Equal(t, 1, quote.Items[0].Remaining)
+	assert.True(t, quote.Items[1].Available)
+	assert.Equal(t, money.New(300000), quote.Items[1].Subtotal)
+
+	// Nothing was reserved
+	assert.Equal(t, 9, tiers.tiers["tier-vip"].SoldCount)
+	assert.Equal(t, 0, tiers.tiers["tier-reg"].SoldCount)
+
+	req.Items[1].Quantity = 6
+	_, err = svc.QuoteOrder(tenant.NewContext(context.Background(), tenant.DefaultID), req)
+	assert.ErrorIs(t, err, ErrMaxPerOrderExceeded)
+}
+
+func TestTenantPricingPolicy(t *testing.T) {
+	brand := &entity.Tenant{PlatformFeePercent: 3, ServiceFee: money.New(1000)}
+
+	breakdown, err := pricing.Calculate([]pricing.Line{{Ref: "tier-1", UnitPrice: money.New(100000), Quantity: 2}}, brand.PricingPolicy())
+	require.NoError(t, err)
+	assert.Equal(t, money.New(6000), breakdown.PlatformFee)
+	assert.Equal(t, money.New(1000), breakdown.ServiceFee)
+	assert.Equal(t, money.New(207000), breakdown.GrandTotal)
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+)
+
+// ErrEventNotSandbox is returned when test orders are cleared on an event that is live
+var ErrEventNotSandbox = errors.New("event is not in sandbox mode")
+
+// sandboxVoidReason is recorded on the tickets of cleared test orders
+const sandboxVoidReason = "Sandbox test order cleared"
+
+// SandboxService clears the test orders of sandbox events
+// Organizers rehearse the whole flow on a sandbox event, then clear its test orders before going live
+type SandboxService interface {
+	ClearTestOrders(ctx context.Context, userID, role, eventID string) (*response.SandboxResetResponse, error)
+}
+
+// sandboxOrderReleaser cancels a test order and returns its seats (implemented by ReservationService)
+type sandboxOrderReleaser interface {
+	ReleaseSandboxOrder(ctx context.Context, orderID string) error
+}
+
+// sandboxService implements SandboxService interface
+type sandboxService struct {
+	orderRepo  repository.OrderRepository
+	ticketRepo repository.TicketRepository
+	eventRepo  repository.EventRepository
+	releaser   sandboxOrderReleaser
+}
+
+// NewSandboxService creates new sandbox service instance
+func NewSandboxService(
+	orderRepo repository.OrderRepository,
+	ticketRepo repository.TicketRepository,
+	eventRepo repository.EventRepository,
+	releaser sandboxOrderReleaser,
+) SandboxService {
+	return &sandboxService{
+		orderRepo:  orderRepo,
+		ticketRepo: ticketRepo,
+		eventRepo:  eventRepo,
+		releaser:   releaser,
+	}
+}
+
+// ClearTestOrders cancels the reserved and paid test orders of a sandbox event and returns their seats
+// Valid tickets of paid test orders are voided so they can't be scanned once the event is live.
+// Clearing is idempotent: an interrupted run is completed by running it again
+func (s *sandboxService) ClearTestOrders(ctx context.Context, userID, role, eventID string) (*response.SandboxResetResponse, error) {
+	event, err := managedEvent(ctx, s.eventRepo, userID, role, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if !event.IsSandbox {
+		return nil, ErrEventNotSandbox
+	}
+
+	orders, err := s.orderRepo.GetSandboxByEventID(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &response.SandboxResetResponse{EventID: event.ID}
+	for _, order := range orders {
+		// Tickets first: a cancelled order is not listed again, its tickets would stay valid
+		tickets, err := s.ticketRepo.GetByOrderID(ctx, order.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tickets of order %s: %w", order.ID, err)
+		}
+		for _, ticket := range tickets {
+			if !ticket.CanBeUsed() {
+				continue
+			}
+			if err := s.ticketRepo.Void(ctx, ticket.ID, sandboxVoidReason, userID); err != nil {
+				if errors.Is(err, repository.ErrTicketNotValid) {
+					continue
+				}
+				return nil, fmt.Errorf("failed to void ticket %s: %w", ticket.ID, err)
+			}
+			result.TicketsVoided++
+		}
+
+		if err := s.releaser.ReleaseSandboxOrder(ctx, order.ID); err != nil {
+			return nil, fmt.Errorf("failed to release order %s: %w", order.ID, err)
+		}
+		result.OrdersCleared++
+	}
+
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubSandboxOrderRepo lists fixed test orders
+type stubSandboxOrderRepo struct {
+	repository.OrderRepository
+	orders []entity.Order
+}
+
+func (r *stubSandboxOrderRepo) GetSandboxByEventID(ctx context.Context, eventID string) ([]entity.Order, error) {
+	return r.orders, nil
+}
+
+// stubSandboxReleaser records the released orders
+type stubSandboxReleaser struct {
+	released []string
+}
+
+func (r *stubSandboxReleaser) ReleaseSandboxOrder(ctx context.Context, orderID string) error {
+	r.released = append(r.released, orderID)
+	return nil
+}
+
+func newSandboxFixture(event *entity.Event) (*sandboxService, *stubTicketRepo, *stubSandboxReleaser) {
+	ticketRepo := &stubTicketRepo{tickets: map[string]*entity.Ticket{
+		"ticket-1": {ID: "ticket-1", OrderID: "order-paid", EventID: "event-1", Status: entity.TicketStatusValid},
+		"ticket-2": {ID: "ticket-2", OrderID: "order-paid", EventID: "event-1", Status: entity.TicketStatusUsed},
+	}}
+	releaser := &stubSandboxReleaser{}
+	return &sandboxService{
+		orderRepo: &stubSandboxOrderRepo{orders: []entity.Order{
+			{ID: "order-reserved", EventID: "event-1", Status: entity.OrderStatusReserved, IsSandbox: true},
+			{ID: "order-paid", EventID: "event-1", Status: entity.OrderStatusPaid, IsSandbox: true},
+		}},
+		ticketRepo: ticketRepo,
+		eventRepo:  &stubEventRepo{event: event},
+		releaser:   releaser,
+	}, ticketRepo, releaser
+}
+
+func TestClearTestOrders(t *testing.T) {
+	svc, ticketRepo, releaser := newSandboxFixture(&entity.Event{ID: "event-1", OrganizerID: "organizer-1", IsSandbox: true})
+
+	result, err := svc.ClearTestOrders(context.Background(), "organizer-1", entity.UserRoleOrganizer, "event-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, result.OrdersCleared)
+	assert.Equal(t, 1, result.TicketsVoided, "used tickets are left as they are")
+	assert.Equal(t, []string{"order-reserved", "order-paid"}, releaser.released)
+	assert.Equal(t, entity.TicketStatusVoid, ticketRepo.tickets["ticket-1"].Status)
+	assert.Equal(t, entity.TicketStatusUsed, ticketRepo.tickets["ticket-2"].Status)
+}
+
+func TestClearTestOrders_Rejected(t *testing.T) {
+	tests := []struct {
+		name   string
+		event  *entity.Event
+		userID string
+		want   error
+	}{
+		{name: "live event", event: &entity.Event{ID: "event-1", OrganizerID: "organizer-1"}, userID: "organizer-1", want: ErrEventNotSandbox},
+		{name: "other organizer's event", event: &entity.Event{ID: "event-1", OrganizerID: "organizer-1", IsSandbox: true}, userID: "organizer-2", want: ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, releaser := newSandboxFixture(tt.event)
+
+			_, err := svc.ClearTestOrders(context.Background(), tt.userID, entity.UserRoleOrganizer, "event-1")
+			assert.ErrorIs(t, err, tt.want)
+			assert.Empty(t, releaser.released)
+		})
+	}
+}
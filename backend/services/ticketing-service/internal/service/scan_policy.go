@@ -0,0 +1,116 @@
+package service
+
+import (
+	"errors"
+	"time"
+	_ "time/tzdata" // Event timezones; the runtime image ships without tzdata
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+)
+
+var (
+	ErrTicketAlreadyInside    = errors.New("ticket holder is already inside")
+	ErrTicketNotInside        = errors.New("ticket has no entry to exit from")
+	ErrTicketAlreadyUsedToday = errors.New("ticket has already been used today")
+	ErrTicketNotValidToday    = errors.New("ticket is not valid today")
+	ErrExitScanNotAllowed     = errors.New("exit scans are not used for this event")
+	ErrCheckInClosed          = errors.New("check-in is closed for this event")
+)
+
+// checkScan applies the event scan policy to a scan attempt
+// history is the ticket's scan history, newest first
+func checkScan(event *entity.Event, ticket *entity.Ticket, history []entity.TicketScan, direction string, now time.Time) error {
+	switch ticket.Status {
+	case entity.TicketStatusValid, entity.TicketStatusUsed:
+	case entity.TicketStatusVoid:
+		return ErrTicketVoid
+	default:
+		return ErrTicketInvalid
+	}
+
+	// Event service completes events once they end
+	if event.IsCompleted() {
+		return ErrCheckInClosed
+	}
+
+	switch event.ScanPolicy {
+	case entity.ScanPolicyReentry:
+		return checkReentryScan(ticket, history, direction)
+	case entity.ScanPolicyPerDay:
+		return checkPerDayScan(event, ticket, history, direction, now)
+	default:
+		if direction == entity.ScanDirectionExit {
+			return ErrExitScanNotAllowed
+		}
+		if ticket.IsUsed() {
+			return ErrTicketAlreadyUsed
+		}
+		return nil
+	}
+}
+
+// checkReentryScan allows entry and exit scans to alternate, starting with an entry
+func checkReentryScan(ticket *entity.Ticket, history []entity.TicketScan, direction string) error {
+	var last *entity.TicketScan
+	if len(history) > 0 {
+		last = &history[0]
+	}
+
+	if direction == entity.ScanDirectionExit {
+		if last == nil || !last.IsEntry() {
+			return ErrTicketNotInside
+		}
+		return nil
+	}
+
+	switch {
+	case last != nil && last.IsEntry():
+		return ErrTicketAlreadyInside
+	case last == nil && ticket.IsUsed():
+		// Used before scan history was recorded; treat as single entry
+		return ErrTicketAlreadyUsed
+	default:
+		return nil
+	}
+}
+
+// checkPerDayScan allows one entry per calendar day in the event timezone while the event runs
+func checkPerDayScan(event *entity.Event, ticket *entity.Ticket, history []entity.TicketScan, direction string, now time.Time) error {
+	if direction == entity.ScanDirectionExit {
+		return ErrExitScanNotAllowed
+	}
+
+	loc := eventLocation(event)
+	today := eventDay(now, loc)
+	if today < eventDay(event.StartDate, loc) || today > eventDay(event.EndDate, loc) {
+		return ErrTicketNotValidToday
+	}
+
+	for _, scan := range history {
+		if scan.IsEntry() && eventDay(scan.ScannedAt, loc) == today {
+			return ErrTicketAlreadyUsedToday
+		}
+	}
+
+	// Used before scan history was recorded
+	if len(history) == 0 && ticket.IsUsed() && ticket.UsedAt != nil && eventDay(*ticket.UsedAt, loc) == today {
+		return ErrTicketAlreadyUsedToday
+	}
+
+	return nil
+}
+
+// eventLocation returns the event timezone, falling back to UTC when unknown
+func eventLocation(event *entity.Event) *time.Location {
+	if event.Timezone != "" {
+		if loc, err := time.LoadLocation(event.Timezone); err == nil {
+			return loc
+		}
+	}
+	return time.UTC
+}
+
+// eventDay formats t as a calendar day (YYYY-MM-DD) in loc; the format sorts chronologically
+func eventDay(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format(time.DateOnly)
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func scanAt(direction string, at time.Time) entity.TicketScan {
+	return entity.TicketScan{TicketID: "ticket-1", Direction: direction, ScannedAt: at}
+}
+
+func TestCheckScan_Policies(t *testing.T) {
+	// Three-day festival in Jakarta (UTC+7)
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	start := time.Date(2026, 11, 1, 10, 0, 0, 0, jakarta)
+	festival := func(policy string) *entity.Event {
+		return &entity.Event{ID: "event-1", StartDate: start, EndDate: start.Add(56 * time.Hour), Timezone: "Asia/Jakarta", ScanPolicy: policy}
+	}
+	now := start.Add(2 * time.Hour)
+	usedAt := now.Add(-time.Hour)
+	valid := &entity.Ticket{ID: "ticket-1", Status: entity.TicketStatusValid}
+	used := &entity.Ticket{ID: "ticket-1", Status: entity.TicketStatusUsed, UsedAt: &usedAt}
+
+	tests := []struct {
+		name      string
+		policy    string
+		ticket    *entity.Ticket
+		history   []entity.TicketScan
+		direction string
+		now       time.Time
+		want      error
+	}{
+		{"single use first entry", entity.ScanPolicySingleUse, valid, nil, entity.ScanDirectionEntry, now, nil},
+		{"single use second entry", entity.ScanPolicySingleUse, used, nil, entity.ScanDirectionEntry, now, ErrTicketAlreadyUsed},
+		{"single use exit", entity.ScanPolicySingleUse, used, nil, entity.ScanDirectionExit, now, ErrExitScanNotAllowed},
+		{"unset policy is single use", "", used, nil, entity.ScanDirectionEntry, now, ErrTicketAlreadyUsed},
+		{"void ticket", entity.ScanPolicyReentry, &entity.Ticket{Status: entity.TicketStatusVoid}, nil, entity.ScanDirectionEntry, now, ErrTicketVoid},
+		{"cancelled ticket", entity.ScanPolicyPerDay, &entity.Ticket{Status: entity.TicketStatusCancelled}, nil, entity.ScanDirectionEntry, now, ErrTicketInvalid},
+
+		{"reentry first entry", entity.ScanPolicyReentry, valid, nil, entity.ScanDirectionEntry, now, nil},
+		{"reentry while inside", entity.ScanPolicyReentry, used, []entity.TicketScan{scanAt(entity.ScanDirectionEntry, usedAt)}, entity.ScanDirectionEntry, now, ErrTicketAlreadyInside},
+		{"reentry exit", entity.ScanPolicyReentry, used, []entity.TicketScan{scanAt(entity.ScanDirectionEntry, usedAt)}, entity.ScanDirectionExit, now, nil},
+		{"reentry after exit", entity.ScanPolicyReentry, used, []entity.TicketScan{scanAt(entity.ScanDirectionExit, now.Add(-time.Minute)), scanAt(entity.ScanDirectionEntry, usedAt)}, entity.ScanDirectionEntry, now, nil},
+		{"reentry exit without entry", entity.ScanPolicyReentry, valid, nil, entity.ScanDirectionExit, now, ErrTicketNotInside},
+		{"reentry double exit", entity.ScanPolicyReentry, used, []entity.TicketScan{scanAt(entity.ScanDirectionExit, now.Add(-time.Minute))}, entity.ScanDirectionExit, now, ErrTicketNotInside},
+		{"reentry used without history", entity.ScanPolicyReentry, used, nil, entity.ScanDirectionEntry, now, ErrTicketAlreadyUsed},
+
+		{"per day first entry", entity.ScanPolicyPerDay, valid, nil, entity.ScanDirectionEntry, now, nil},
+		{"per day same day", entity.ScanPolicyPerDay, used, []entity.TicketScan{scanAt(entity.ScanDirectionEntry, usedAt)}, entity.ScanDirectionEntry, now, ErrTicketAlreadyUsedToday},
+		{"per day next day", entity.ScanPolicyPerDay, used, []entity.TicketScan{scanAt(entity.ScanDirectionEntry, usedAt)}, entity.ScanDirectionEntry, now.Add(24 * time.Hour), nil},
+		// 06:00 WIB on day two is still day one in UTC
+		{"per day uses event timezone", entity.ScanPolicyPerDay, used, []entity.TicketScan{scanAt(entity.ScanDirectionEntry, usedAt)}, entity.ScanDirectionEntry, time.Date(2026, 11, 2, 6, 0, 0, 0, jakarta), nil},
+		{"per day before event", entity.ScanPolicyPerDay, valid, nil, entity.ScanDirectionEntry, start.Add(-24 * time.Hour), ErrTicketNotValidToday},
+		{"per day after event", entity.ScanPolicyPerDay, used, nil, entity.ScanDirectionEntry, start.Add(96 * time.Hour), ErrTicketNotValidToday},
+		{"per day used without history", entity.ScanPolicyPerDay, used, nil, entity.ScanDirectionEntry, now, ErrTicketAlreadyUsedToday},
+		{"per day exit", entity.ScanPolicyPerDay, used, nil, entity.ScanDirectionExit, now, ErrExitScanNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkScan(festival(tt.policy), tt.ticket, tt.history, tt.direction, tt.now)
+			if tt.want == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckScan_CompletedEventClosesCheckIn(t *testing.T) {
+	event := &entity.Event{ID: "event-1", ScanPolicy: entity.ScanPolicyReentry, Status: entity.EventStatusCompleted}
+	ticket := &entity.Ticket{ID: "ticket-1", Status: entity.TicketStatusValid}
+
+	assert.ErrorIs(t, checkScan(event, ticket, nil, entity.ScanDirectionEntry, time.Now()), ErrCheckInClosed)
+	assert.ErrorIs(t, checkScan(event, ticket, nil, entity.ScanDirectionExit, time.Now()), ErrCheckInClosed)
+}
+
+func TestTicketService_ValidateTicketReentry(t *testing.T) {
+	svc, tickets, _ := newTicketFixture()
+	ctx := context.Background()
+	svc.eventRepo.(*stubEventRepo).event.ScanPolicy = entity.ScanPolicyReentry
+	qrData := tickets.tickets["ticket-1"].QRData
+
+	scan := func(direction string) error {
+		_, err := svc.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: qrData, Direction: direction})
+		return err
+	}
+
+	require.NoError(t, scan(""))
+	assert.Equal(t, entity.TicketStatusUsed, tickets.tickets["ticket-1"].Status)
+	assert.ErrorIs(t, scan(entity.ScanDirectionEntry), ErrTicketAlreadyInside)
+	require.NoError(t, scan(entity.ScanDirectionExit))
+
+	verification, err := svc.VerifyTicket(ctx, &request.ValidateTicketRequest{QRData: qrData})
+	require.NoError(t, err)
+	assert.True(t, verification.Valid)
+	assert.Equal(t, entity.ScanPolicyReentry, verification.ScanPolicy)
+	require.Len(t, verification.Scans, 2)
+	assert.Equal(t, entity.ScanDirectionExit, verification.Scans[0].Direction)
+
+	require.NoError(t, scan(entity.ScanDirectionEntry))
+	assert.ErrorIs(t, scan(entity.ScanDirectionEntry), ErrTicketAlreadyInside)
+}
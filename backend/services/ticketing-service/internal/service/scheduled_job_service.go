@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+)
+
+// Scheduled job limits
+const (
+	jobBatchSize   = 50               // Jobs claimed per run
+	jobLease       = 2 * time.Minute  // How long a claimed job is left to its worker
+	jobMaxAttempts = 5                // Attempts before a job is marked failed
+	jobRetryDelay  = 30 * time.Second // Doubled with each failed attempt
+)
+
+// JobHandler runs a claimed job of one type
+// Returning an error retries the job later, until it runs out of attempts
+type JobHandler func(ctx context.Context, job *entity.ScheduledJob) error
+
+// ScheduledJobService runs delayed jobs once they are due
+type ScheduledJobService interface {
+	RunDueJobs(ctx context.Context) (int, error)
+}
+
+// scheduledJobService implements ScheduledJobService interface
+type scheduledJobService struct {
+	jobRepo  repository.ScheduledJobRepository
+	handlers map[string]JobHandler
+}
+
+// NewScheduledJobService creates new scheduled job service instance
+// handlers maps a job type to the handler that runs it
+func NewScheduledJobService(jobRepo repository.ScheduledJobRepository, handlers map[string]JobHandler) ScheduledJobService {
+	return &scheduledJobService{
+		jobRepo:  jobRepo,
+		handlers: handlers,
+	}
+}
+
+// RunDueJobs claims one batch of due jobs and runs them, returning how many finished
+// Failed jobs are retried with exponential backoff
+func (s *scheduledJobService) RunDueJobs(ctx context.Context) (int, error) {
+	jobs, err := s.jobRepo.ClaimDue(ctx, jobBatchSize, time.Now().Add(jobLease))
+	if err != nil {
+		return 0, err
+	}
+
+	done := 0
+	for i := range jobs {
+		job := &jobs[i]
+
+		handler, ok := s.handlers[job.Type]
+		if !ok {
+			log.Printf("[ScheduledJobService] No handler for job %s of type %s", job.ID, job.Type)
+			if err := s.jobRepo.MarkFailed(ctx, job.ID, fmt.Sprintf("no handler for job type %s", job.Type)); err != nil {
+				return done, err
+			}
+			continue
+		}
+
+		if err := handler(ctx, job); err != nil {
+			if job.Attempts >= jobMaxAttempts {
+				log.Printf("[ScheduledJobService] Job %s (%s) failed after %d attempts: %v", job.ID, job.Type, job.Attempts, err)
+				if err := s.jobRepo.MarkFailed(ctx, job.ID, err.Error()); err != nil {
+					return done, err
+				}
+				continue
+			}
+
+			log.Printf("[ScheduledJobService] Job %s (%s) failed, will retry: %v", job.ID, job.Type, err)
+			if err := s.jobRepo.Retry(ctx, job.ID, err.Error(), time.Now().Add(jobRetryBackoff(job.Attempts))); err != nil {
+				return done, err
+			}
+			continue
+		}
+
+		if err := s.jobRepo.MarkDone(ctx, job.ID); err != nil {
+			return done, err
+		}
+		done++
+	}
+
+	return done, nil
+}
+
+// jobRetryBackoff returns how long a job waits after its nth failed attempt
+func jobRetryBackoff(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	return jobRetryDelay << (attempts - 1)
+}
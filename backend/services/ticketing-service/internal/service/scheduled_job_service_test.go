@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubScheduledJobRepo hands out its due jobs once and records how each ended
+type stubScheduledJobRepo struct {
+	repository.ScheduledJobRepository
+	due     []entity.ScheduledJob
+	done    []string
+	retries map[string]time.Time
+	failed  map[string]string
+}
+
+func (r *stubScheduledJobRepo) ClaimDue(ctx context.Context, limit int, lockedUntil time.Time) ([]entity.ScheduledJob, error) {
+	jobs := r.due
+	r.due = nil
+	return jobs, nil
+}
+
+func (r *stubScheduledJobRepo) MarkDone(ctx context.Context, id string) error {
+	r.done = append(r.done, id)
+	return nil
+}
+
+func (r *stubScheduledJobRepo) Retry(ctx context.Context, id, lastError string, runAt time.Time) error {
+	r.retries[id] = runAt
+	return nil
+}
+
+func (r *stubScheduledJobRepo) MarkFailed(ctx context.Context, id, lastError string) error {
+	r.failed[id] = lastError
+	return nil
+}
+
+func TestScheduledJobService_RunDueJobs(t *testing.T) {
+	repo := &stubScheduledJobRepo{
+		due: []entity.ScheduledJob{
+			{ID: "job-ok", Type: "ok", Attempts: 1},
+			{ID: "job-retry", Type: "flaky", Attempts: 2},
+			{ID: "job-last", Type: "flaky", Attempts: jobMaxAttempts},
+			{ID: "job-unknown", Type: "unknown", Attempts: 1},
+		},
+		retries: map[string]time.Time{},
+		failed:  map[string]string{},
+	}
+	svc := NewScheduledJobService(repo, map[string]JobHandler{
+		"ok":    func(ctx context.Context, job *entity.ScheduledJob) error { return nil },
+		"flaky": func(ctx context.Context, job *entity.ScheduledJob) error { return errors.New("partner unavailable") },
+	})
+
+	before := time.Now()
+	done, err := svc.RunDueJobs(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, done)
+	assert.Equal(t, []string{"job-ok"}, repo.done)
+
+	// The second failed attempt waits twice the retry delay
+	require.Contains(t, repo.retries, "job-retry")
+	assert.WithinDuration(t, before.Add(2*jobRetryDelay), repo.retries["job-retry"], time.Second)
+
+	assert.Equal(t, "partner unavailable", repo.failed["job-last"])
+	assert.Equal(t, "no handler for job type unknown", repo.failed["job-unknown"])
+}
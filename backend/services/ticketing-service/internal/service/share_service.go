@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/utility"
+)
+
+var (
+	ErrShareLinkInvalid = errors.New("share link is invalid or revoked")
+	ErrShareLinkExpired = errors.New("share link has expired")
+)
+
+// ShareService handles public share links for tickets
+type ShareService interface {
+	CreateShareLink(ctx context.Context, userID, ticketID string) (*response.TicketShareResponse, error)
+	RevokeShareLinks(ctx context.Context, userID, ticketID string) (*response.RevokeShareResponse, error)
+	GetSharedTicket(ctx context.Context, token string) (*response.SharedTicketResponse, error)
+}
+
+// shareService implements ShareService interface
+type shareService struct {
+	shareRepo      repository.TicketShareRepository
+	ticketRepo     repository.TicketRepository
+	eventRepo      repository.EventRepository
+	ticketTierRepo repository.TicketTierRepository
+	secret         string
+	ttl            time.Duration
+	baseURL        string
+	now            func() time.Time
+}
+
+// NewShareService creates new share service instance
+// Links expire after ttl or when the event ends, whichever comes first
+func NewShareService(
+	shareRepo repository.TicketShareRepository,
+	ticketRepo repository.TicketRepository,
+	eventRepo repository.EventRepository,
+	ticketTierRepo repository.TicketTierRepository,
+	secret string,
+	ttl time.Duration,
+	baseURL string,
+) ShareService {
+	return &shareService{
+		shareRepo:      shareRepo,
+		ticketRepo:     ticketRepo,
+		eventRepo:      eventRepo,
+		ticketTierRepo: ticketTierRepo,
+		secret:         secret,
+		ttl:            ttl,
+		baseURL:        strings.TrimRight(baseURL, "/"),
+		now:            time.Now,
+	}
+}
+
+// CreateShareLink creates a signed, expiring link to the owner's ticket
+func (s *shareService) CreateShareLink(ctx context.Context, userID, ticketID string) (*response.TicketShareResponse, error) {
+	ticket, err := s.ownedTicket(ctx, userID, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Used, cancelled or expired tickets are not worth forwarding
+	if !ticket.CanBeUsed() {
+		return nil, ErrTicketInvalid
+	}
+
+	now := s.now()
+	expiresAt := now.Add(s.ttl)
+
+	event, err := s.eventRepo.GetByID(ctx, ticket.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if !event.EndDate.After(now) {
+		return nil, ErrTicketInvalid
+	}
+	if event.EndDate.Before(expiresAt) {
+		expiresAt = event.EndDate
+	}
+
+	link := &entity.TicketShareLink{
+		TicketID:  ticket.ID,
+		CreatedBy: userID,
+		ExpiresAt: expiresAt,
+	}
+	if err := s.shareRepo.Create(ctx, link); err != nil {
+		return nil, err
+	}
+
+	token := utility.GenerateShareToken(s.secret, link.ID, link.ExpiresAt)
+	return &response.TicketShareResponse{
+		ShareURL:  s.baseURL + "/" + url.PathEscape(token),
+		Token:     token,
+		ExpiresAt: link.ExpiresAt,
+	}, nil
+}
+
+// RevokeShareLinks revokes every active share link of the owner's ticket
+func (s *shareService) RevokeShareLinks(ctx context.Context, userID, ticketID string) (*response.RevokeShareResponse, error) {
+	if _, err := s.ownedTicket(ctx, userID, ticketID); err != nil {
+		return nil, err
+	}
+
+	revoked, err := s.shareRepo.RevokeByTicketID(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.RevokeShareResponse{Revoked: revoked}, nil
+}
+
+// GetSharedTicket resolves a share token into the public ticket view
+func (s *shareService) GetSharedTicket(ctx context.Context, token string) (*response.SharedTicketResponse, error) {
+	now := s.now()
+
+	// Signature and expiry are checked before touching the database
+	linkID, err := utility.ParseShareToken(s.secret, token, now)
+	if err != nil {
+		if errors.Is(err, utility.ErrShareTokenExpired) {
+			return nil, ErrShareLinkExpired
+		}
+		return nil, ErrShareLinkInvalid
+	}
+
+	link, err := s.shareRepo.GetByID(ctx, linkID)
+	if err != nil {
+		if errors.Is(err, repository.ErrShareLinkNotFound) {
+			return nil, ErrShareLinkInvalid
+		}
+		return nil, err
+	}
+	if link.RevokedAt != nil {
+		return nil, ErrShareLinkInvalid
+	}
+	if !link.IsActive(now) {
+		return nil, ErrShareLinkExpired
+	}
+
+	ticket, err := s.ticketRepo.GetByID(ctx, link.TicketID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketNotFound) {
+			return nil, ErrShareLinkInvalid
+		}
+		return nil, fmt.Errorf("failed to get ticket: %w", err)
+	}
+
+	shared := &response.SharedTicketResponse{
+		TicketNumber: ticket.TicketNumber,
+		QRCode:       ticket.QRCode,
+		Status:       ticket.Status,
+		UsedAt:       ticket.UsedAt,
+		ExpiresAt:    link.ExpiresAt,
+	}
+
+	if event, err := s.eventRepo.GetByID(ctx, ticket.EventID); err == nil {
+		shared.Event = response.ToEventSummaryResponse(event)
+	} else if !errors.Is(err, repository.ErrEventNotFound) {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	if tier, err := s.ticketTierRepo.GetByID(ctx, ticket.TicketTierID); err == nil {
+		shared.TierName = tier.Name
+	}
+
+	return shared, nil
+}
+
+// ownedTicket loads a ticket and checks it belongs to the user
+func (s *shareService) ownedTicket(ctx context.Context, userID, ticketID string) (*entity.Ticket, error) {
+	ticket, err := s.ticketRepo.GetByID(ctx, ticketID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketNotFound) {
+			return nil, ErrTicketNotFound
+		}
+		return nil, fmt.Errorf("failed to get ticket: %w", err)
+	}
+
+	if ticket.UserID != userID {
+		return nil, ErrUnauthorized
+	}
+
+	return ticket, nil
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubTicketRepo keeps tickets in memory
+type stubTicketRepo struct {
+	repository.TicketRepository
+	tickets map[string]*entity.Ticket
+}
+
+func (r *stubTicketRepo) GetByID(ctx context.Context, id string) (*entity.Ticket, error) {
+	ticket, ok := r.tickets[id]
+	if !ok {
+		return nil, repository.ErrTicketNotFound
+	}
+	copied := *ticket
+	return &copied, nil
+}
+
+func (r *stubTicketRepo) MarkAsUsed(ctx context.Context, ticketID string) error {
+	ticket, ok := r.tickets[ticketID]
+	if !ok || ticket.Status != entity.TicketStatusValid {
+		return errors.New("ticket not found or already used")
+	}
+	now := time.Now()
+	ticket.Status = entity.TicketStatusUsed
+	ticket.UsedAt = &now
+	return nil
+}
+
+func (r *stubTicketRepo) UpdateQR(ctx context.Context, ticketID, qrData, qrCode string) error {
+	ticket, ok := r.tickets[ticketID]
+	if !ok || ticket.Status != entity.TicketStatusValid {
+		return repository.ErrTicketNotValid
+	}
+	ticket.QRData = qrData
+	ticket.QRCode = qrCode
+	ticket.QRSecret = nil
+	return nil
+}
+
+func (r *stubTicketRepo) EnsureQRSecret(ctx context.Context, ticketID, secret string) (string, error) {
+	ticket, ok := r.tickets[ticketID]
+	if !ok || ticket.Status != entity.TicketStatusValid {
+		return "", repository.ErrTicketNotValid
+	}
+	if ticket.QRSecret == nil {
+		ticket.QRSecret = &secret
+	}
+	return *ticket.QRSecret, nil
+}
+
+func (r *stubTicketRepo) Void(ctx context.Context, ticketID, reason, voidedBy string) error {
+	ticket, ok := r.tickets[ticketID]
+	if !ok || ticket.Status != entity.TicketStatusValid {
+		return repository.ErrTicketNotValid
+	}
+	now := time.Now()
+	ticket.Status = entity.TicketStatusVoid
+	ticket.VoidReason = &reason
+	ticket.VoidedBy = &voidedBy
+	ticket.VoidedAt = &now
+	return nil
+}
+
+// stubShareRepo keeps share links in memory
+type stubShareRepo struct {
+	links map[string]*entity.TicketShareLink
+}
+
+func (r *stubShareRepo) Create(ctx context.Context, link *entity.TicketShareLink) error {
+	link.ID = "link-" + string(rune('a'+len(r.links)))
+	link.CreatedAt = time.Now()
+	r.links[link.ID] = link
+	return nil
+}
+
+func (r *stubShareRepo) GetByID(ctx context.Context, id string) (*entity.TicketShareLink, error) {
+	link, ok := r.links[id]
+	if !ok {
+		return nil, repository.ErrShareLinkNotFound
+	}
+	return link, nil
+}
+
+func (r *stubShareRepo) RevokeByTicketID(ctx context.Context, ticketID string) (int64, error) {
+	var revoked int64
+	now := time.Now()
+	for _, link := range r.links {
+		if link.TicketID == ticketID && link.RevokedAt == nil {
+			link.RevokedAt = &now
+			revoked++
+		}
+	}
+	return revoked, nil
+}
+
+var shareNow = time.Date(2026, 11, 1, 10, 0, 0, 0, time.UTC)
+
+func newShareFixture() (*shareService, *stubShareRepo) {
+	shares := &stubShareRepo{links: map[string]*entity.TicketShareLink{}}
+	svc := NewShareService(
+		shares,
+		&stubTicketRepo{tickets: map[string]*entity.Ticket{
+			"ticket-1":    {ID: "ticket-1", UserID: "owner", EventID: "event-1", TicketTierID: "tier-vip", TicketNumber: "JAZZ-0001", QRCode: "data:image/png;base64,xyz", Status: entity.TicketStatusValid},
+			"ticket-used": {ID: "ticket-used", UserID: "owner", EventID: "event-1", Status: entity.TicketStatusUsed},
+		}},
+		&stubEventRepo{event: &entity.Event{
+			ID:        "event-1",
+			Name:      "Jazz Night",
+			StartDate: shareNow.Add(24 * time.Hour),
+			EndDate:   shareNow.Add(28 * time.Hour),
+		}},
+		&stubTicketTierRepo{tiers: map[string]*entity.TicketTier{
+			"tier-vip": {ID: "tier-vip", Name: "VIP"},
+		}},
+		"share-secret",
+		72*time.Hour,
+		"https://tickets.example.com/shared-tickets/",
+	).(*shareService)
+	svc.now = func() time.Time { return shareNow }
+	return svc, shares
+}
+
+func TestShareService_CreateAndOpenShareLink(t *testing.T) {
+	svc, _ := newShareFixture()
+	ctx := context.Background()
+
+	share, err := svc.CreateShareLink(ctx, "owner", "ticket-1")
+	require.NoError(t, err)
+
+	// Expiry is capped at the event end
+	assert.Equal(t, shareNow.Add(28*time.Hour), share.ExpiresAt)
+	assert.Equal(t, "https://tickets.example.com/shared-tickets/"+share.Token, share.ShareURL)
+
+	shared, err := svc.GetSharedTicket(ctx, share.Token)
+	require.NoError(t, err)
+	assert.Equal(t, "JAZZ-0001", shared.TicketNumber)
+	assert.Equal(t, "VIP", shared.TierName)
+	assert.Equal(t, entity.TicketStatusValid, shared.Status)
+	require.NotNil(t, shared.Event)
+	assert.Equal(t, "Jazz Night", shared.Event.Name)
+}
+
+func TestShareService_CreateShareLinkChecksOwnershipAndStatus(t *testing.T) {
+	svc, _ := newShareFixture()
+	ctx := context.Background()
+
+	_, err := svc.CreateShareLink(ctx, "someone-else", "ticket-1")
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	_, err = svc.CreateShareLink(ctx, "owner", "missing")
+	assert.ErrorIs(t, err, ErrTicketNotFound)
+
+	_, err = svc.CreateShareLink(ctx, "owner", "ticket-used")
+	assert.ErrorIs(t, err, ErrTicketInvalid)
+}
+
+func TestShareService_RevokedLinkStopsWorking(t *testing.T) {
+	svc, _ := newShareFixture()
+	ctx := context.Background()
+
+	first, err := svc.CreateShareLink(ctx, "owner", "ticket-1")
+	require.NoError(t, err)
+	second, err := svc.CreateShareLink(ctx, "owner", "ticket-1")
+	require.NoError(t, err)
+
+	_, err = svc.RevokeShareLinks(ctx, "someone-else", "ticket-1")
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	result, err := svc.RevokeShareLinks(ctx, "owner", "ticket-1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), result.Revoked)
+
+	for _, token := range []string{first.Token, second.Token} {
+		_, err = svc.GetSharedTicket(ctx, token)
+		assert.ErrorIs(t, err, ErrShareLinkInvalid)
+	}
+}
+
+func TestShareService_RejectsTamperedAndExpiredTokens(t *testing.T) {
+	svc, shares := newShareFixture()
+	ctx := context.Background()
+
+	share, err := svc.CreateShareLink(ctx, "owner", "ticket-1")
+	require.NoError(t, err)
+
+	// Extending the expiry breaks the signature
+	parts := strings.Split(share.Token, ".")
+	forged := parts[0] + "." + "9999999999" + "." + parts[2]
+	_, err = svc.GetSharedTicket(ctx, forged)
+	assert.ErrorIs(t, err, ErrShareLinkInvalid)
+
+	// Tokens signed with another secret are rejected
+	link := shares.links[parts[0]]
+	_, err = svc.GetSharedTicket(ctx, utility.GenerateShareToken("other-secret", link.ID, link.ExpiresAt))
+	assert.ErrorIs(t, err, ErrShareLinkInvalid)
+
+	_, err = svc.GetSharedTicket(ctx, "garbage")
+	assert.ErrorIs(t, err, ErrShareLinkInvalid)
+
+	svc.now = func() time.Time { return link.ExpiresAt }
+	_, err = svc.GetSharedTicket(ctx, share.Token)
+	assert.ErrorIs(t, err, ErrShareLinkExpired)
+}
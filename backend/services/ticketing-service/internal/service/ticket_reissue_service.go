@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/utility"
+)
+
+var (
+	ErrNoTicketsToReissue      = errors.New("event has no unused tickets to reissue")
+	ErrTicketReissueInProgress = errors.New("a ticket reissue of this event is still in progress")
+	ErrTicketReissueNotFound   = errors.New("ticket reissue not found")
+)
+
+// ticketReissueBatchSize is how many tickets one reissue job handles
+// Orders are never split, so a batch holding a large order may be bigger
+const ticketReissueBatchSize = 100
+
+// TicketReissueSender defines interface for emailing the reissued tickets of an order (confirmation service)
+type TicketReissueSender interface {
+	SendReissuedTickets(ctx context.Context, orderID string) error
+}
+
+// TicketReissueService handles bulk replacement of the QR codes of an event's unused tickets,
+// e.g. after an export of its codes leaked
+type TicketReissueService interface {
+	StartReissue(ctx context.Context, userID, role, eventID string, req *request.ReissueTicketsRequest) (*response.TicketReissueResponse, error)
+	GetReissue(ctx context.Context, userID, role, eventID, reissueID string) (*response.TicketReissueResponse, error)
+	// ReissueBatch handles the entity.JobTypeTicketReissue jobs, referencing a batch of the reissue
+	ReissueBatch(ctx context.Context, job *entity.ScheduledJob) error
+}
+
+// ticketReissueService implements TicketReissueService interface
+type ticketReissueService struct {
+	reissueRepo      repository.TicketReissueRepository
+	ticketRepo       repository.TicketRepository
+	shareRepo        repository.TicketShareRepository
+	zoneRepo         repository.ZoneRepository
+	eventRepo        repository.EventRepository
+	orderRepo        repository.OrderRepository
+	scheduledJobRepo repository.ScheduledJobRepository
+	sender           TicketReissueSender
+	now              func() time.Time
+}
+
+// NewTicketReissueService creates new ticket reissue service instance
+func NewTicketReissueService(
+	reissueRepo repository.TicketReissueRepository,
+	ticketRepo repository.TicketRepository,
+	shareRepo repository.TicketShareRepository,
+	zoneRepo repository.ZoneRepository,
+	eventRepo repository.EventRepository,
+	orderRepo repository.OrderRepository,
+	scheduledJobRepo repository.ScheduledJobRepository,
+	sender TicketReissueSender,
+) TicketReissueService {
+	return &ticketReissueService{
+		reissueRepo:      reissueRepo,
+		ticketRepo:       ticketRepo,
+		shareRepo:        shareRepo,
+		zoneRepo:         zoneRepo,
+		eventRepo:        eventRepo,
+		orderRepo:        orderRepo,
+		scheduledJobRepo: scheduledJobRepo,
+		sender:           sender,
+		now:              time.Now,
+	}
+}
+
+// StartReissue schedules the reissue of every valid ticket of the event
+// Codes are rotated and tickets emailed by the scheduled job worker, batch by batch;
+// the returned reissue reports progress. Admins can reissue any event, organizers only their own.
+func (s *ticketReissueService) StartReissue(ctx context.Context, userID, role, eventID string, req *request.ReissueTicketsRequest) (_ *response.TicketReissueResponse, err error) {
+	if err := s.authorize(ctx, userID, role, eventID); err != nil {
+		return nil, err
+	}
+
+	active, err := s.reissueRepo.HasActive(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if active {
+		return nil, ErrTicketReissueInProgress
+	}
+
+	items, err := s.reissueRepo.GetReissuableItems(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, ErrNoTicketsToReissue
+	}
+	batchIDs := assignReissueBatches(items)
+
+	tx, err := s.orderRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	reissue := &entity.TicketReissue{EventID: eventID, Reason: req.Reason, RequestedBy: userID}
+	if err = s.reissueRepo.CreateWithTx(ctx, tx, reissue, items); err != nil {
+		return nil, err
+	}
+	for _, batchID := range batchIDs {
+		job := &entity.ScheduledJob{Type: entity.JobTypeTicketReissue, ReferenceID: batchID, RunAt: s.now()}
+		if err = s.scheduledJobRepo.CreateWithTx(ctx, tx, job); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.Printf("[TicketReissueService] Reissue %s of event %s started by %s: %d tickets in %d batches",
+		reissue.ID, eventID, userID, len(items), len(batchIDs))
+
+	return response.ToTicketReissueResponse(reissue, &entity.TicketReissueProgress{
+		Tickets:       len(items),
+		Pending:       len(items),
+		Batches:       len(batchIDs),
+		ActiveBatches: len(batchIDs),
+	}), nil
+}
+
+// assignReissueBatches splits items, grouped by order, into batches of whole orders
+// and returns the batch IDs
+func assignReissueBatches(items []entity.TicketReissueItem) []string {
+	var batchIDs []string
+	size := 0
+	for i := range items {
+		newOrder := i == 0 || items[i].OrderID != items[i-1].OrderID
+		if i == 0 || (newOrder && size >= ticketReissueBatchSize) {
+			batchIDs = append(batchIDs, uuid.New().String())
+			size = 0
+		}
+		items[i].BatchID = batchIDs[len(batchIDs)-1]
+		size++
+	}
+	return batchIDs
+}
+
+// GetReissue returns a reissue of the event with its progress
+func (s *ticketReissueService) GetReissue(ctx context.Context, userID, role, eventID, reissueID string) (*response.TicketReissueResponse, error) {
+	if err := s.authorize(ctx, userID, role, eventID); err != nil {
+		return nil, err
+	}
+
+	reissue, err := s.reissueRepo.GetByID(ctx, reissueID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketReissueNotFound) {
+			return nil, ErrTicketReissueNotFound
+		}
+		return nil, err
+	}
+	if reissue.EventID != eventID {
+		return nil, ErrTicketReissueNotFound
+	}
+
+	progress, err := s.reissueRepo.GetProgress(ctx, reissue.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.ToTicketReissueResponse(reissue, progress), nil
+}
+
+// authorize checks that the user may reissue the tickets of the event
+func (s *ticketReissueService) authorize(ctx context.Context, userID, role, eventID string) error {
+	if role != entity.UserRoleAdmin && role != entity.UserRoleOrganizer {
+		return ErrUnauthorized
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return ErrEventNotFound
+		}
+		return fmt.Errorf("failed to get event: %w", err)
+	}
+
+	if role == entity.UserRoleOrganizer && event.OrganizerID != userID {
+		return ErrUnauthorized
+	}
+
+	return nil
+}
+
+// ReissueBatch rotates the QR codes of the job's batch, then emails its orders the new tickets
+// Progress is recorded per ticket and per order, so a retry after a failure only does the rest
+func (s *ticketReissueService) ReissueBatch(ctx context.Context, job *entity.ScheduledJob) error {
+	tickets, err := s.reissueRepo.GetPendingTickets(ctx, job.ReferenceID)
+	if err != nil {
+		return err
+	}
+
+	if len(tickets) > 0 {
+		tierIDs := make([]string, 0, len(tickets))
+		for _, ticket := range tickets {
+			tierIDs = append(tierIDs, ticket.TicketTierID)
+		}
+		zones, err := s.zoneRepo.GetCodesByTierIDs(ctx, tierIDs)
+		if err != nil {
+			return fmt.Errorf("failed to get ticket zones: %w", err)
+		}
+
+		for _, ticket := range tickets {
+			if err := s.rotateQR(ctx, job.ReferenceID, &ticket, zones[ticket.TicketTierID]); err != nil {
+				return err
+			}
+		}
+	}
+
+	orderIDs, err := s.reissueRepo.GetUnemailedOrderIDs(ctx, job.ReferenceID)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, orderID := range orderIDs {
+		if err := s.sender.SendReissuedTickets(ctx, orderID); err != nil {
+			log.Printf("[TicketReissueService] Failed to email reissued tickets of order %s: %v", orderID, err)
+			failed++
+			continue
+		}
+
+		if err := s.reissueRepo.MarkOrderEmailed(ctx, job.ReferenceID, orderID); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to email %d of %d orders", failed, len(orderIDs))
+	}
+
+	return nil
+}
+
+// rotateQR replaces a ticket's QR code like RegenerateQR, revoking its share links
+// A ticket used or voided since the reissue started is skipped
+func (s *ticketReissueService) rotateQR(ctx context.Context, batchID string, ticket *entity.Ticket, zones []string) error {
+	qrData := utility.GenerateTicketQRData(ticket.ID, ticket.EventID, zones)
+	qrCode, err := utility.GenerateQRCode(qrData)
+	if err != nil {
+		return fmt.Errorf("failed to generate QR code: %w", err)
+	}
+
+	status := entity.TicketReissueReissued
+	if err := s.ticketRepo.UpdateQR(ctx, ticket.ID, qrData, qrCode); err != nil {
+		if !errors.Is(err, repository.ErrTicketNotValid) {
+			return fmt.Errorf("failed to update ticket QR: %w", err)
+		}
+		status = entity.TicketReissueSkipped
+	}
+
+	if status == entity.TicketReissueReissued {
+		if _, err := s.shareRepo.RevokeByTicketID(ctx, ticket.ID); err != nil {
+			return fmt.Errorf("failed to revoke share links: %w", err)
+		}
+	}
+
+	return s.reissueRepo.MarkTicket(ctx, batchID, ticket.ID, status)
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubReissueRepo keeps the items of one batch in memory
+type stubReissueRepo struct {
+	repository.TicketReissueRepository
+	tickets *stubTicketRepo
+	status  map[string]string // By ticket ID
+	orders  map[string]string // Order ID by ticket ID
+	emailed map[string]bool   // By order ID
+}
+
+func (r *stubReissueRepo) GetPendingTickets(ctx context.Context, batchID string) ([]entity.Ticket, error) {
+	tickets := []entity.Ticket{}
+	for _, ticketID := range []string{"ticket-1", "ticket-2", "ticket-3"} {
+		if r.status[ticketID] == entity.TicketReissuePending {
+			tickets = append(tickets, *r.tickets.tickets[ticketID])
+		}
+	}
+	return tickets, nil
+}
+
+func (r *stubReissueRepo) MarkTicket(ctx context.Context, batchID, ticketID, status string) error {
+	r.status[ticketID] = status
+	return nil
+}
+
+func (r *stubReissueRepo) GetUnemailedOrderIDs(ctx context.Context, batchID string) ([]string, error) {
+	orderIDs := []string{}
+	for _, orderID := range []string{"order-1", "order-2"} {
+		for ticketID, ticketOrderID := range r.orders {
+			if ticketOrderID == orderID && r.status[ticketID] == entity.TicketReissueReissued && !r.emailed[orderID] {
+				orderIDs = append(orderIDs, orderID)
+				break
+			}
+		}
+	}
+	return orderIDs, nil
+}
+
+func (r *stubReissueRepo) MarkOrderEmailed(ctx context.Context, batchID, orderID string) error {
+	r.emailed[orderID] = true
+	return nil
+}
+
+// stubReissueSender records the emailed orders, failing those in fail
+type stubReissueSender struct {
+	sent []string
+	fail map[string]bool
+}
+
+func (s *stubReissueSender) SendReissuedTickets(ctx context.Context, orderID string) error {
+	if s.fail[orderID] {
+		return errors.New("notification service unavailable")
+	}
+	s.sent = append(s.sent, orderID)
+	return nil
+}
+
+func newReissueFixture() (*ticketReissueService, *stubReissueRepo, *stubShareRepo, *stubReissueSender) {
+	ticketRepo := &stubTicketRepo{tickets: map[string]*entity.Ticket{
+		"ticket-1": {ID: "ticket-1", OrderID: "order-1", EventID: "event-1", TicketTierID: "tier-1", Status: entity.TicketStatusValid, QRData: "TICKET|ticket-1|event-1|leaked"},
+		"ticket-2": {ID: "ticket-2", OrderID: "order-1", EventID: "event-1", TicketTierID: "tier-1", Status: entity.TicketStatusValid, QRData: "TICKET|ticket-2|event-1|leaked"},
+		"ticket-3": {ID: "ticket-3", OrderID: "order-2", EventID: "event-1", TicketTierID: "tier-1", Status: entity.TicketStatusValid, QRData: "TICKET|ticket-3|event-1|leaked"},
+	}}
+	reissueRepo := &stubReissueRepo{
+		tickets: ticketRepo,
+		status:  map[string]string{"ticket-1": entity.TicketReissuePending, "ticket-2": entity.TicketReissuePending, "ticket-3": entity.TicketReissuePending},
+		orders:  map[string]string{"ticket-1": "order-1", "ticket-2": "order-1", "ticket-3": "order-2"},
+		emailed: map[string]bool{},
+	}
+	shareRepo := &stubShareRepo{links: map[string]*entity.TicketShareLink{
+		"link-a": {ID: "link-a", TicketID: "ticket-1"},
+	}}
+	sender := &stubReissueSender{fail: map[string]bool{}}
+	return &ticketReissueService{
+		reissueRepo: reissueRepo,
+		ticketRepo:  ticketRepo,
+		shareRepo:   shareRepo,
+		zoneRepo:    &stubZoneRepo{},
+		eventRepo:   &stubEventRepo{event: &entity.Event{ID: "event-1", OrganizerID: "organizer-1"}},
+		sender:      sender,
+	}, reissueRepo, shareRepo, sender
+}
+
+func TestAssignReissueBatches_KeepsOrdersWhole(t *testing.T) {
+	items := []entity.TicketReissueItem{}
+	for i := 0; i < ticketReissueBatchSize-1; i++ {
+		items = append(items, entity.TicketReissueItem{TicketID: "a", OrderID: "order-a"})
+	}
+	items = append(items,
+		entity.TicketReissueItem{TicketID: "b1", OrderID: "order-b"},
+		entity.TicketReissueItem{TicketID: "b2", OrderID: "order-b"},
+		entity.TicketReissueItem{TicketID: "c1", OrderID: "order-c"},
+	)
+
+	batchIDs := assignReissueBatches(items)
+
+	require.Len(t, batchIDs, 2)
+	assert.Equal(t, batchIDs[0], items[ticketReissueBatchSize].BatchID, "order-b stays in the batch it started in")
+	assert.Equal(t, batchIDs[1], items[len(items)-1].BatchID)
+}
+
+func TestReissueBatch_RotatesCodesAndEmailsOrders(t *testing.T) {
+	svc, reissueRepo, shareRepo, sender := newReissueFixture()
+	reissueRepo.tickets.tickets["ticket-3"].Status = entity.TicketStatusUsed // Scanned since the reissue started
+
+	err := svc.ReissueBatch(context.Background(), &entity.ScheduledJob{ReferenceID: "batch-1"})
+	require.NoError(t, err)
+
+	for _, ticketID := range []string{"ticket-1", "ticket-2"} {
+		assert.Equal(t, entity.TicketReissueReissued, reissueRepo.status[ticketID])
+		assert.NotContains(t, reissueRepo.tickets.tickets[ticketID].QRData, "leaked")
+	}
+	assert.Equal(t, entity.TicketReissueSkipped, reissueRepo.status["ticket-3"])
+	assert.Contains(t, reissueRepo.tickets.tickets["ticket-3"].QRData, "leaked", "used tickets keep their code")
+	assert.NotNil(t, shareRepo.links["link-a"].RevokedAt)
+	assert.Equal(t, []string{"order-1"}, sender.sent, "orders without reissued tickets are not emailed")
+}
+
+func TestReissueBatch_RetrySendsRemainingEmails(t *testing.T) {
+	svc, reissueRepo, _, sender := newReissueFixture()
+	sender.fail["order-2"] = true
+
+	err := svc.ReissueBatch(context.Background(), &entity.ScheduledJob{ReferenceID: "batch-1"})
+	assert.Error(t, err)
+	assert.Equal(t, []string{"order-1"}, sender.sent)
+	rotated := reissueRepo.tickets.tickets["ticket-3"].QRData
+
+	delete(sender.fail, "order-2")
+	err = svc.ReissueBatch(context.Background(), &entity.ScheduledJob{ReferenceID: "batch-1"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"order-1", "order-2"}, sender.sent, "order-1 is not emailed twice")
+	assert.Equal(t, rotated, reissueRepo.tickets.tickets["ticket-3"].QRData, "codes are not rotated again")
+}
+
+func TestGetReissue_ChecksOrganizer(t *testing.T) {
+	svc, _, _, _ := newReissueFixture()
+
+	_, err := svc.GetReissue(context.Background(), "organizer-2", entity.UserRoleOrganizer, "event-1", "reissue-1")
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	_, err = svc.StartReissue(context.Background(), "buyer-1", "customer", "event-1", nil)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+}
+
+func TestToTicketReissueResponse_Status(t *testing.T) {
+	reissue := &entity.TicketReissue{ID: "reissue-1", EventID: "event-1"}
+	tests := []struct {
+		name     string
+		progress entity.TicketReissueProgress
+		want     string
+	}{
+		{name: "running", progress: entity.TicketReissueProgress{Tickets: 3, Pending: 1, Reissued: 2, Batches: 2, ActiveBatches: 1}, want: response.TicketReissueInProgress},
+		{name: "done", progress: entity.TicketReissueProgress{Tickets: 3, Reissued: 2, Skipped: 1, Emailed: 2, Batches: 2}, want: response.TicketReissueCompleted},
+		{name: "batch gave up", progress: entity.TicketReissueProgress{Tickets: 3, Reissued: 3, Emailed: 2, Batches: 2, FailedBatches: 1}, want: response.TicketReissueFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, response.ToTicketReissueResponse(reissue, &tt.progress).Status)
+		})
+	}
+}
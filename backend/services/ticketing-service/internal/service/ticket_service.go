@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/cache"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
@@ -17,6 +19,7 @@ var (
 	ErrTicketNotFound    = errors.New("ticket not found")
 	ErrTicketAlreadyUsed = errors.New("ticket has already been used")
 	ErrTicketInvalid     = errors.New("ticket is invalid")
+	ErrTicketVoid        = errors.New("ticket has been voided")
 )
 
 // TicketService handles e-ticket operations
@@ -25,13 +28,30 @@ type TicketService interface {
 	GetTicket(ctx context.Context, userID, ticketID string) (*response.TicketResponse, error)
 	GetUserTickets(ctx context.Context, userID string) ([]response.TicketResponse, error)
 	ValidateTicket(ctx context.Context, req *request.ValidateTicketRequest) (*response.TicketResponse, error)
+	VerifyTicket(ctx context.Context, req *request.ValidateTicketRequest) (*response.TicketVerificationResponse, error)
+	RegenerateQR(ctx context.Context, userID, ticketID string) (*response.TicketResponse, error)
+	GetDynamicQR(ctx context.Context, userID, ticketID string) (*response.DynamicQRResponse, error)
+	VoidTicket(ctx context.Context, userID, role, ticketID string, req *request.VoidTicketRequest) (*response.VoidTicketResponse, error)
+
+	// API v2 views with event and ticket tier details
+	GetTicketV2(ctx context.Context, userID, ticketID string) (*response.TicketV2Response, error)
+	GetUserTicketsV2(ctx context.Context, userID string) ([]response.TicketV2Response, error)
 }
 
 // ticketService implements TicketService interface
 type ticketService struct {
-	ticketRepo    repository.TicketRepository
-	orderRepo     repository.OrderRepository
-	orderItemRepo repository.OrderItemRepository
+	ticketRepo        repository.TicketRepository
+	orderRepo         repository.OrderRepository
+	orderItemRepo     repository.OrderItemRepository
+	eventRepo         repository.EventRepository
+	ticketTierRepo    repository.TicketTierRepository
+	shareRepo         repository.TicketShareRepository
+	userRepo          repository.UserRepository
+	scanRepo          repository.TicketScanRepository
+	zoneRepo          repository.ZoneRepository
+	accommodationRepo repository.AccommodationRepository
+	checkins          *checkinMetrics
+	now               func() time.Time
 }
 
 // NewTicketService creates new ticket service instance
@@ -39,11 +59,28 @@ func NewTicketService(
 	ticketRepo repository.TicketRepository,
 	orderRepo repository.OrderRepository,
 	orderItemRepo repository.OrderItemRepository,
+	eventRepo repository.EventRepository,
+	ticketTierRepo repository.TicketTierRepository,
+	shareRepo repository.TicketShareRepository,
+	userRepo repository.UserRepository,
+	scanRepo repository.TicketScanRepository,
+	zoneRepo repository.ZoneRepository,
+	accommodationRepo repository.AccommodationRepository,
+	redisClient cache.RedisClient,
 ) TicketService {
 	return &ticketService{
-		ticketRepo:    ticketRepo,
-		orderRepo:     orderRepo,
-		orderItemRepo: orderItemRepo,
+		ticketRepo:        ticketRepo,
+		orderRepo:         orderRepo,
+		orderItemRepo:     orderItemRepo,
+		eventRepo:         eventRepo,
+		ticketTierRepo:    ticketTierRepo,
+		shareRepo:         shareRepo,
+		userRepo:          userRepo,
+		scanRepo:          scanRepo,
+		zoneRepo:          zoneRepo,
+		accommodationRepo: accommodationRepo,
+		checkins:          newCheckinMetrics(redisClient), // Gate scans per minute for the live check-in view; nil without Redis
+		now:               time.Now,
 	}
 }
 
@@ -67,6 +104,22 @@ func (s *ticketService) GenerateTickets(ctx context.Context, orderID string) ([]
 		return nil, fmt.Errorf("failed to get order items: %w", err)
 	}
 
+	// Zones each ticket tier may enter, embedded as QR claims
+	tierIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		tierIDs = append(tierIDs, item.TicketTierID)
+	}
+	tierZones, err := s.zoneRepo.GetCodesByTierIDs(ctx, tierIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket tier zones: %w", err)
+	}
+
+	// Accommodations requested at checkout are tied to the tickets issued for them
+	accommodations, err := s.accommodationRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order accommodations: %w", err)
+	}
+
 	// Start transaction
 	tx, err := s.orderRepo.BeginTx(ctx)
 	if err != nil {
@@ -90,7 +143,7 @@ func (s *ticketService) GenerateTickets(ctx context.Context, orderID string) ([]
 			ticketNumber := fmt.Sprintf("TKT-%s-%03d", orderID[:8], ticketCounter)
 
 			// Generate QR code data
-			qrData := utility.GenerateTicketQRData(ticketID, order.EventID)
+			qrData := utility.GenerateTicketQRData(ticketID, order.EventID, tierZones[item.TicketTierID])
 
 			// Generate QR code image (base64)
 			qrCode, err := utility.GenerateQRCode(qrData)
@@ -121,6 +174,12 @@ func (s *ticketService) GenerateTickets(ctx context.Context, orderID string) ([]
 		return nil, fmt.Errorf("failed to create tickets: %w", err)
 	}
 
+	if assigned := assignAccommodationTickets(accommodations, tickets); len(assigned) > 0 {
+		if err := s.accommodationRepo.AssignTicketsWithTx(ctx, tx, assigned); err != nil {
+			return nil, fmt.Errorf("failed to assign accommodation tickets: %w", err)
+		}
+	}
+
 	// Commit transaction
 	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("failed to commit transaction: %w", err)
@@ -170,15 +229,191 @@ func (s *ticketService) GetUserTickets(ctx context.Context, userID string) ([]re
 }
 
 // ValidateTicket validates a ticket at event entrance
-// This is called by event staff to scan and validate tickets
+// This is called by event staff to scan and validate tickets.
+// The event scan policy decides whether the scan is accepted; every accepted
+// scan is kept in the ticket's scan history.
 func (s *ticketService) ValidateTicket(ctx context.Context, req *request.ValidateTicketRequest) (*response.TicketResponse, error) {
+	ticket, claims, err := s.getScannedTicket(ctx, req.QRData)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, ticket.EventID)
+	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return nil, ErrTicketInvalid
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	direction := req.Direction
+	if direction == "" {
+		direction = entity.ScanDirectionEntry
+	}
+	gate := normalizeZone(req.Zone)
+
+	// Accepted and refused scans feed the gate's throughput in the live check-in view
+	err = s.admitScan(ctx, event, ticket, claims, direction, gate)
+	s.checkins.Record(ctx, event.ID, gate, direction, err == nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get updated ticket
+	ticket, err = s.ticketRepo.GetByID(ctx, ticket.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get updated ticket: %w", err)
+	}
+
+	return response.ToTicketResponse(ticket), nil
+}
+
+// admitScan checks a scanned ticket against the event's QR mode, the gate zone and the scan policy,
+// then records the scan
+func (s *ticketService) admitScan(ctx context.Context, event *entity.Event, ticket *entity.Ticket, claims *utility.TicketQRClaims, direction, gate string) error {
+	if err := checkQRMode(event, claims); err != nil {
+		return err
+	}
+
+	// Zones only restrict entries; holders may leave through any gate
+	if direction == entity.ScanDirectionEntry {
+		zones, err := s.ticketZones(ctx, ticket.TicketTierID)
+		if err != nil {
+			return err
+		}
+		if err := s.checkGateZone(ctx, ticket.EventID, gate, zones); err != nil {
+			return err
+		}
+	}
+
+	var scanZone *string
+	if gate != "" {
+		scanZone = &gate
+	}
+
+	now := s.now()
+	_, err := s.scanRepo.RecordScan(ctx, ticket.ID, func(locked *entity.Ticket, history []entity.TicketScan) (*entity.TicketScan, error) {
+		if err := checkScan(event, locked, history, direction, now); err != nil {
+			return nil, err
+		}
+		return &entity.TicketScan{
+			TicketID:  locked.ID,
+			EventID:   locked.EventID,
+			Direction: direction,
+			Zone:      scanZone,
+			ScannedAt: now,
+		}, nil
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketNotFound) {
+			return ErrTicketNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// VerifyTicket checks a scanned ticket without consuming it
+// Used for re-entry checks and the support desk; the ticket status is reported
+// instead of returned as an error, and no scan is recorded
+func (s *ticketService) VerifyTicket(ctx context.Context, req *request.ValidateTicketRequest) (*response.TicketVerificationResponse, error) {
+	ticket, claims, err := s.getScannedTicket(ctx, req.QRData)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.scanRepo.ListByTicketID(ctx, ticket.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	verification := response.ToTicketVerificationResponse(ticket, history)
+
+	zones, err := s.ticketZones(ctx, ticket.TicketTierID)
+	if err != nil {
+		return nil, err
+	}
+	if len(zones) > 0 {
+		verification.Zones = zones
+	}
+
+	// Holder, tier and event details are informational; missing ones are left empty
+	if user, err := s.userRepo.GetByID(ctx, ticket.UserID); err == nil {
+		verification.HolderName = user.FullName
+	} else if !errors.Is(err, repository.ErrUserNotFound) {
+		return nil, fmt.Errorf("failed to get ticket holder: %w", err)
+	}
+
+	if tier, err := s.ticketTierRepo.GetByID(ctx, ticket.TicketTierID); err == nil {
+		verification.TierName = tier.Name
+	}
+
+	event, err := s.eventRepo.GetByID(ctx, ticket.EventID)
+	if err != nil {
+		if !errors.Is(err, repository.ErrEventNotFound) {
+			return nil, fmt.Errorf("failed to get event: %w", err)
+		}
+		verification.Valid = false
+		return verification, nil
+	}
+
+	// Valid means an entry scan would be accepted now under the event scan policy,
+	// and through the given gate zone if any
+	verification.Event = response.ToEventSummaryResponse(event)
+	verification.ScanPolicy = event.ScanPolicy
+	verification.Valid = checkScan(event, ticket, history, entity.ScanDirectionEntry, s.now()) == nil &&
+		checkQRMode(event, claims) == nil
+
+	if err := s.checkGateZone(ctx, ticket.EventID, normalizeZone(req.Zone), zones); err != nil {
+		if errors.Is(err, ErrZoneNotFound) {
+			return nil, err
+		}
+		if !errors.Is(err, ErrTicketZoneNotAllowed) {
+			return nil, fmt.Errorf("failed to check gate zone: %w", err)
+		}
+		verification.Valid = false
+	}
+
+	return verification, nil
+}
+
+// getScannedTicket resolves scanned QR data into its ticket and the payload claims
+// Only the latest QR payload or a current rotating code is accepted; regenerating
+// the QR invalidates older ones
+func (s *ticketService) getScannedTicket(ctx context.Context, qrData string) (*entity.Ticket, *utility.TicketQRClaims, error) {
 	// Parse QR data to extract ticket ID and event ID
-	ticketID, eventID, err := utility.ParseTicketQRData(req.QRData)
+	claims, err := utility.ParseTicketQRData(qrData)
 	if err != nil {
-		return nil, ErrTicketInvalid
+		return nil, nil, ErrTicketInvalid
 	}
 
 	// Get ticket
+	ticket, err := s.ticketRepo.GetByID(ctx, claims.TicketID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketNotFound) {
+			return nil, nil, ErrTicketNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get ticket: %w", err)
+	}
+
+	// Verify ticket belongs to the event
+	if ticket.EventID != claims.EventID {
+		return nil, nil, ErrTicketInvalid
+	}
+
+	if !s.checkScannedQR(ticket, claims, qrData) {
+		return nil, nil, ErrTicketInvalid
+	}
+
+	return ticket, claims, nil
+}
+
+// RegenerateQR rotates the QR payload of the owner's ticket
+// The old QR code stops validating and active share links are revoked,
+// since they would otherwise keep showing the new code. The new payload
+// carries the tier's current zones.
+func (s *ticketService) RegenerateQR(ctx context.Context, userID, ticketID string) (*response.TicketResponse, error) {
 	ticket, err := s.ticketRepo.GetByID(ctx, ticketID)
 	if err != nil {
 		if errors.Is(err, repository.ErrTicketNotFound) {
@@ -187,29 +422,161 @@ func (s *ticketService) ValidateTicket(ctx context.Context, req *request.Validat
 		return nil, fmt.Errorf("failed to get ticket: %w", err)
 	}
 
-	// Verify ticket belongs to the event
-	if ticket.EventID != eventID {
+	// Check authorization
+	if ticket.UserID != userID {
+		return nil, ErrUnauthorized
+	}
+
+	if !ticket.CanBeUsed() {
+		if ticket.IsVoid() {
+			return nil, ErrTicketVoid
+		}
 		return nil, ErrTicketInvalid
 	}
 
-	// Check if ticket can be used
+	zones, err := s.ticketZones(ctx, ticket.TicketTierID)
+	if err != nil {
+		return nil, err
+	}
+
+	qrData := utility.GenerateTicketQRData(ticket.ID, ticket.EventID, zones)
+	qrCode, err := utility.GenerateQRCode(qrData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate QR code: %w", err)
+	}
+
+	if err := s.ticketRepo.UpdateQR(ctx, ticket.ID, qrData, qrCode); err != nil {
+		// Ticket was used or voided after it was loaded
+		if errors.Is(err, repository.ErrTicketNotValid) {
+			return nil, ErrTicketInvalid
+		}
+		return nil, fmt.Errorf("failed to update ticket QR: %w", err)
+	}
+
+	if _, err := s.shareRepo.RevokeByTicketID(ctx, ticket.ID); err != nil {
+		return nil, fmt.Errorf("failed to revoke share links: %w", err)
+	}
+
+	ticket.QRData = qrData
+	ticket.QRCode = qrCode
+	return response.ToTicketResponse(ticket), nil
+}
+
+// VoidTicket revokes a valid ticket with a reason
+// Admins can void any ticket, organizers only tickets of their own events
+func (s *ticketService) VoidTicket(ctx context.Context, userID, role, ticketID string, req *request.VoidTicketRequest) (*response.VoidTicketResponse, error) {
+	if role != entity.UserRoleAdmin && role != entity.UserRoleOrganizer {
+		return nil, ErrUnauthorized
+	}
+
+	ticket, err := s.ticketRepo.GetByID(ctx, ticketID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketNotFound) {
+			return nil, ErrTicketNotFound
+		}
+		return nil, fmt.Errorf("failed to get ticket: %w", err)
+	}
+
+	if role == entity.UserRoleOrganizer {
+		event, err := s.eventRepo.GetByID(ctx, ticket.EventID)
+		if err != nil {
+			if errors.Is(err, repository.ErrEventNotFound) {
+				return nil, ErrUnauthorized
+			}
+			return nil, fmt.Errorf("failed to get event: %w", err)
+		}
+		if event.OrganizerID != userID {
+			return nil, ErrUnauthorized
+		}
+	}
+
 	if !ticket.CanBeUsed() {
-		if ticket.IsUsed() {
-			return nil, ErrTicketAlreadyUsed
+		if ticket.IsVoid() {
+			return nil, ErrTicketVoid
 		}
 		return nil, ErrTicketInvalid
 	}
 
-	// Mark ticket as used
-	if err := s.ticketRepo.MarkAsUsed(ctx, ticketID); err != nil {
-		return nil, fmt.Errorf("failed to mark ticket as used: %w", err)
+	if err := s.ticketRepo.Void(ctx, ticket.ID, req.Reason, userID); err != nil {
+		if errors.Is(err, repository.ErrTicketNotValid) {
+			return nil, ErrTicketInvalid
+		}
+		return nil, fmt.Errorf("failed to void ticket: %w", err)
 	}
 
-	// Get updated ticket
-	ticket, err = s.ticketRepo.GetByID(ctx, ticketID)
+	ticket, err = s.ticketRepo.GetByID(ctx, ticket.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get updated ticket: %w", err)
 	}
 
-	return response.ToTicketResponse(ticket), nil
+	return response.ToVoidTicketResponse(ticket), nil
+}
+
+// GetTicketV2 retrieves a single ticket with event and ticket tier details
+func (s *ticketService) GetTicketV2(ctx context.Context, userID, ticketID string) (*response.TicketV2Response, error) {
+	ticket, err := s.ticketRepo.GetByID(ctx, ticketID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketNotFound) {
+			return nil, ErrTicketNotFound
+		}
+		return nil, fmt.Errorf("failed to get ticket: %w", err)
+	}
+
+	// Check authorization
+	if ticket.UserID != userID {
+		return nil, ErrUnauthorized
+	}
+
+	ticketResponses, err := s.toTicketV2Responses(ctx, []entity.Ticket{*ticket})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ticketResponses[0], nil
+}
+
+// GetUserTicketsV2 retrieves all tickets for a user with event and ticket tier details
+func (s *ticketService) GetUserTicketsV2(ctx context.Context, userID string) ([]response.TicketV2Response, error) {
+	tickets, err := s.ticketRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user tickets: %w", err)
+	}
+
+	return s.toTicketV2Responses(ctx, tickets)
+}
+
+// toTicketV2Responses loads events and ticket tiers for tickets and converts them
+func (s *ticketService) toTicketV2Responses(ctx context.Context, tickets []entity.Ticket) ([]response.TicketV2Response, error) {
+	eventIDs := make([]string, 0, len(tickets))
+	tierIDs := make([]string, 0, len(tickets))
+	for _, ticket := range tickets {
+		eventIDs = append(eventIDs, ticket.EventID)
+		tierIDs = append(tierIDs, ticket.TicketTierID)
+	}
+
+	events, err := s.eventRepo.GetByIDs(ctx, eventIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+	eventsByID := make(map[string]*entity.Event, len(events))
+	for i := range events {
+		eventsByID[events[i].ID] = &events[i]
+	}
+
+	tiers, err := s.ticketTierRepo.GetByIDs(ctx, tierIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket tiers: %w", err)
+	}
+	tiersByID := make(map[string]*entity.TicketTier, len(tiers))
+	for i := range tiers {
+		tiersByID[tiers[i].ID] = &tiers[i]
+	}
+
+	ticketResponses := make([]response.TicketV2Response, len(tickets))
+	for i := range tickets {
+		ticket := &tickets[i]
+		ticketResponses[i] = *response.ToTicketV2Response(ticket, eventsByID[ticket.EventID], tiersByID[ticket.TicketTierID])
+	}
+
+	return ticketResponses, nil
 }
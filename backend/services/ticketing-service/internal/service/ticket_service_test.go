@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubScanRepo keeps scan history in memory and applies scans to stubTicketRepo
+type stubScanRepo struct {
+	tickets *stubTicketRepo
+	scans   []entity.TicketScan
+}
+
+func (r *stubScanRepo) RecordScan(ctx context.Context, ticketID string, check repository.ScanCheck) (*entity.TicketScan, error) {
+	ticket, ok := r.tickets.tickets[ticketID]
+	if !ok {
+		return nil, repository.ErrTicketNotFound
+	}
+	history, _ := r.ListByTicketID(ctx, ticketID)
+
+	scan, err := check(ticket, history)
+	if err != nil {
+		return nil, err
+	}
+	r.scans = append(r.scans, *scan)
+
+	if scan.IsEntry() && ticket.CanBeUsed() {
+		scannedAt := scan.ScannedAt
+		ticket.Status = entity.TicketStatusUsed
+		ticket.UsedAt = &scannedAt
+	}
+	return scan, nil
+}
+
+func (r *stubScanRepo) ListByTicketID(ctx context.Context, ticketID string) ([]entity.TicketScan, error) {
+	history := []entity.TicketScan{}
+	for i := len(r.scans) - 1; i >= 0; i-- {
+		if r.scans[i].TicketID == ticketID {
+			history = append(history, r.scans[i])
+		}
+	}
+	return history, nil
+}
+
+// stubZoneRepo serves zone codes per event and per ticket tier
+type stubZoneRepo struct {
+	eventZones map[string][]string
+	tierZones  map[string][]string
+}
+
+func (r *stubZoneRepo) GetCodesByTierIDs(ctx context.Context, tierIDs []string) (map[string][]string, error) {
+	zones := make(map[string][]string)
+	for _, tierID := range tierIDs {
+		if codes, ok := r.tierZones[tierID]; ok {
+			zones[tierID] = codes
+		}
+	}
+	return zones, nil
+}
+
+func (r *stubZoneRepo) ExistsForEvent(ctx context.Context, eventID, code string) (bool, error) {
+	return slices.Contains(r.eventZones[eventID], code), nil
+}
+
+func newTicketFixture() (*ticketService, *stubTicketRepo, *stubShareRepo) {
+	tickets := &stubTicketRepo{tickets: map[string]*entity.Ticket{
+		"ticket-1":    {ID: "ticket-1", UserID: "owner", EventID: "event-1", QRData: "TICKET|ticket-1|event-1|nonce-a", Status: entity.TicketStatusValid},
+		"ticket-used": {ID: "ticket-used", UserID: "owner", EventID: "event-1", QRData: "TICKET|ticket-used|event-1", Status: entity.TicketStatusUsed},
+	}}
+	shares := &stubShareRepo{links: map[string]*entity.TicketShareLink{}}
+	scanNow := time.Date(2026, 11, 2, 18, 0, 0, 0, time.UTC)
+	svc := NewTicketService(
+		tickets,
+		nil,
+		nil,
+		&stubEventRepo{event: &entity.Event{
+			ID:          "event-1",
+			OrganizerID: "organizer-1",
+			StartDate:   scanNow.Add(-time.Hour),
+			EndDate:     scanNow.Add(48 * time.Hour),
+			ScanPolicy:  entity.ScanPolicySingleUse,
+		}},
+		&stubTicketTierRepo{},
+		shares,
+		&stubUserRepo{user: &entity.User{ID: "owner", FullName: "Ticket Owner"}},
+		&stubScanRepo{tickets: tickets},
+		&stubZoneRepo{},
+		nil,
+		nil,
+	).(*ticketService)
+	svc.now = func() time.Time { return scanNow }
+	return svc, tickets, shares
+}
+
+func TestTicketService_RegenerateQRInvalidatesOldCode(t *testing.T) {
+	svc, tickets, shares := newTicketFixture()
+	ctx := context.Background()
+
+	shares.links["link-a"] = &entity.TicketShareLink{ID: "link-a", TicketID: "ticket-1", ExpiresAt: time.Now().Add(time.Hour)}
+
+	oldQR := tickets.tickets["ticket-1"].QRData
+	ticket, err := svc.RegenerateQR(ctx, "owner", "ticket-1")
+	require.NoError(t, err)
+	assert.NotEmpty(t, ticket.QRCode)
+
+	newQR := tickets.tickets["ticket-1"].QRData
+	assert.NotEqual(t, oldQR, newQR)
+	assert.NotNil(t, shares.links["link-a"].RevokedAt)
+
+	_, err = svc.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: oldQR})
+	assert.ErrorIs(t, err, ErrTicketInvalid)
+
+	validated, err := svc.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: newQR})
+	require.NoError(t, err)
+	assert.Equal(t, entity.TicketStatusUsed, validated.Status)
+}
+
+func TestTicketService_RegenerateQRChecksOwnershipAndStatus(t *testing.T) {
+	svc, _, _ := newTicketFixture()
+	ctx := context.Background()
+
+	_, err := svc.RegenerateQR(ctx, "someone-else", "ticket-1")
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	_, err = svc.RegenerateQR(ctx, "owner", "missing")
+	assert.ErrorIs(t, err, ErrTicketNotFound)
+
+	_, err = svc.RegenerateQR(ctx, "owner", "ticket-used")
+	assert.ErrorIs(t, err, ErrTicketInvalid)
+}
+
+func TestTicketService_VoidTicket(t *testing.T) {
+	svc, tickets, _ := newTicketFixture()
+	ctx := context.Background()
+	req := &request.VoidTicketRequest{Reason: "QR posted on social media"}
+
+	_, err := svc.VoidTicket(ctx, "owner", entity.UserRoleCustomer, "ticket-1", req)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	// Organizers can only void tickets of their own events
+	_, err = svc.VoidTicket(ctx, "organizer-2", entity.UserRoleOrganizer, "ticket-1", req)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	voided, err := svc.VoidTicket(ctx, "organizer-1", entity.UserRoleOrganizer, "ticket-1", req)
+	require.NoError(t, err)
+	assert.Equal(t, entity.TicketStatusVoid, voided.Status)
+	assert.Equal(t, "QR posted on social media", voided.VoidReason)
+	assert.NotNil(t, voided.VoidedAt)
+
+	_, err = svc.VoidTicket(ctx, "admin-1", entity.UserRoleAdmin, "ticket-1", req)
+	assert.ErrorIs(t, err, ErrTicketVoid)
+
+	_, err = svc.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: tickets.tickets["ticket-1"].QRData})
+	assert.ErrorIs(t, err, ErrTicketVoid)
+
+	_, err = svc.RegenerateQR(ctx, "owner", "ticket-1")
+	assert.ErrorIs(t, err, ErrTicketVoid)
+}
+
+func TestTicketService_ValidateTicketAcceptsLegacyQRData(t *testing.T) {
+	svc, tickets, _ := newTicketFixture()
+	ctx := context.Background()
+
+	tickets.tickets["ticket-1"].QRData = "TICKET|ticket-1|event-1"
+
+	_, err := svc.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: "TICKET|ticket-1|event-1|forged"})
+	assert.ErrorIs(t, err, ErrTicketInvalid)
+
+	_, err = svc.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: "TICKET|ticket-1|event-1"})
+	assert.NoError(t, err)
+}
+
+func TestTicketService_VerifyTicketDoesNotConsume(t *testing.T) {
+	svc, tickets, _ := newTicketFixture()
+	ctx := context.Background()
+	svc.ticketTierRepo = &stubTicketTierRepo{tiers: map[string]*entity.TicketTier{
+		"tier-vip": {ID: "tier-vip", Name: "VIP"},
+	}}
+	tickets.tickets["ticket-1"].TicketTierID = "tier-vip"
+	qrData := tickets.tickets["ticket-1"].QRData
+
+	for i := 0; i < 2; i++ {
+		verification, err := svc.VerifyTicket(ctx, &request.ValidateTicketRequest{QRData: qrData})
+		require.NoError(t, err)
+		assert.True(t, verification.Valid)
+		assert.Equal(t, entity.TicketStatusValid, verification.Ticket.Status)
+		assert.Equal(t, "Ticket Owner", verification.HolderName)
+		assert.Equal(t, "VIP", verification.TierName)
+		require.NotNil(t, verification.Event)
+		assert.Equal(t, "event-1", verification.Event.ID)
+	}
+	assert.Equal(t, entity.TicketStatusValid, tickets.tickets["ticket-1"].Status)
+
+	// Used tickets are reported, not rejected
+	verification, err := svc.VerifyTicket(ctx, &request.ValidateTicketRequest{QRData: "TICKET|ticket-used|event-1"})
+	require.NoError(t, err)
+	assert.False(t, verification.Valid)
+	assert.Equal(t, entity.TicketStatusUsed, verification.Ticket.Status)
+
+	// Forged or rotated QR data is still rejected
+	_, err = svc.VerifyTicket(ctx, &request.ValidateTicketRequest{QRData: "TICKET|ticket-1|event-1|stale"})
+	assert.ErrorIs(t, err, ErrTicketInvalid)
+}
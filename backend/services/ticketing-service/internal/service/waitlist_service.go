@@ -0,0 +1,398 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/client"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/response"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+)
+
+var (
+	ErrTierNotSoldOut        = errors.New("ticket tier still has enough tickets on sale")
+	ErrAlreadyOnWaitlist     = errors.New("already on the waitlist of this ticket tier")
+	ErrWaitlistEntryNotFound = errors.New("not on the waitlist of this ticket tier")
+)
+
+// waitlistTiersPerRun caps the tiers processed per run, the rest are processed by the next run
+const waitlistTiersPerRun = 100
+
+// WaitlistOfferSender defines interface for emailing waitlist offers (notification service)
+type WaitlistOfferSender interface {
+	SendWaitlistOfferEmail(ctx context.Context, req *client.SendWaitlistOfferEmailRequest) error
+}
+
+// WaitlistService handles the waitlists of sold-out ticket tiers
+// Seats freed by expired or cancelled reservations are offered to the waiting buyers in line order by
+// ProcessWaitlists: an offer holds its seats in the tier's sold_count for the offer window, and the
+// buyer's next reservation of the tier takes them over. Offers not used in time expire and their seats
+// are offered to the next buyer. Until the next run freed seats are on general sale.
+type WaitlistService interface {
+	JoinWaitlist(ctx context.Context, userID, tierID string, req *request.JoinWaitlistRequest) (*response.WaitlistEntryResponse, error)
+	GetWaitlistEntry(ctx context.Context, userID, tierID string) (*response.WaitlistEntryResponse, error)
+	LeaveWaitlist(ctx context.Context, userID, tierID string) error
+	ProcessWaitlists(ctx context.Context) (int, error)
+}
+
+// waitlistService implements WaitlistService interface
+type waitlistService struct {
+	waitlistRepo   repository.WaitlistRepository
+	orderRepo      repository.OrderRepository
+	ticketTierRepo repository.TicketTierRepository
+	eventRepo      repository.EventRepository
+	userRepo       repository.UserRepository
+	tenantRepo     repository.TenantRepository
+	txWatchdog     *repository.TxWatchdog // nil leaves transactions without deadline
+	sender         WaitlistOfferSender
+	availability   AvailabilityService
+	offerWindow    time.Duration
+	baseURL        string
+	now            func() time.Time
+}
+
+// NewWaitlistService creates new waitlist service instance
+// Offers hold their seats for offerWindow; offer emails link to baseURL + "/" + event ID
+func NewWaitlistService(
+	waitlistRepo repository.WaitlistRepository,
+	orderRepo repository.OrderRepository,
+	ticketTierRepo repository.TicketTierRepository,
+	eventRepo repository.EventRepository,
+	userRepo repository.UserRepository,
+	tenantRepo repository.TenantRepository,
+	txWatchdog *repository.TxWatchdog,
+	sender WaitlistOfferSender,
+	availability AvailabilityService,
+	offerWindow time.Duration,
+	baseURL string,
+) WaitlistService {
+	return &waitlistService{
+		waitlistRepo:   waitlistRepo,
+		orderRepo:      orderRepo,
+		ticketTierRepo: ticketTierRepo,
+		eventRepo:      eventRepo,
+		userRepo:       userRepo,
+		tenantRepo:     tenantRepo,
+		txWatchdog:     txWatchdog,
+		sender:         sender,
+		availability:   availability,
+		offerWindow:    offerWindow,
+		baseURL:        strings.TrimRight(baseURL, "/"),
+		now:            time.Now,
+	}
+}
+
+// JoinWaitlist puts the user in line for quantity seats of a tier that can't sell them now
+func (s *waitlistService) JoinWaitlist(ctx context.Context, userID, tierID string, req *request.JoinWaitlistRequest) (*response.WaitlistEntryResponse, error) {
+	tier, err := s.ticketTierRepo.GetByID(ctx, tierID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketTierNotFound) {
+			return nil, ErrTicketTierNotFound
+		}
+		return nil, fmt.Errorf("failed to get ticket tier: %w", err)
+	}
+	if tier.IsArchived() {
+		return nil, ErrTicketTierNotFound
+	}
+
+	// Like orders, waitlists are joined under the tenant serving the request
+	event, err := s.eventRepo.GetByID(ctx, tier.EventID)
+	if err != nil {
+		if errors.Is(err, repository.ErrEventNotFound) {
+			return nil, ErrEventNotFound
+		}
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	if event.TenantID != tenantFromContext(ctx) {
+		return nil, ErrEventNotFound
+	}
+	if !event.IsActive() || event.HasEnded() {
+		return nil, ErrEventNotOnSale
+	}
+
+	quantity := req.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+	if quantity > tier.MaxPerOrder {
+		return nil, ErrMaxPerOrderExceeded
+	}
+	if tier.GetAvailableQuota() >= quantity {
+		return nil, ErrTierNotSoldOut
+	}
+
+	entry := &entity.WaitlistEntry{
+		EventID:      event.ID,
+		TicketTierID: tier.ID,
+		UserID:       userID,
+		Quantity:     quantity,
+	}
+	if err := s.waitlistRepo.Create(ctx, entry); err != nil {
+		if errors.Is(err, repository.ErrAlreadyOnWaitlist) {
+			return nil, ErrAlreadyOnWaitlist
+		}
+		return nil, err
+	}
+
+	log.Printf("[WaitlistService] User %s joined the waitlist of tier %s for %d tickets", userID, tier.ID, quantity)
+
+	return s.toResponse(ctx, entry)
+}
+
+// GetWaitlistEntry returns the user's open entry on the waitlist of a tier with their position in line
+func (s *waitlistService) GetWaitlistEntry(ctx context.Context, userID, tierID string) (*response.WaitlistEntryResponse, error) {
+	entry, err := s.waitlistRepo.GetOpenByUser(ctx, tierID, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrWaitlistEntryNotFound) {
+			return nil, ErrWaitlistEntryNotFound
+		}
+		return nil, err
+	}
+
+	return s.toResponse(ctx, entry)
+}
+
+// toResponse converts an entry to its response, counting the position of a waiting entry
+func (s *waitlistService) toResponse(ctx context.Context, entry *entity.WaitlistEntry) (*response.WaitlistEntryResponse, error) {
+	position := 0
+	if entry.Status == entity.WaitlistStatusWaiting {
+		ahead, err := s.waitlistRepo.CountAhead(ctx, entry)
+		if err != nil {
+			return nil, err
+		}
+		position = ahead + 1
+	}
+
+	return response.ToWaitlistEntryResponse(entry, position), nil
+}
+
+// LeaveWaitlist takes the user off the waitlist of a tier; the seats of an offer go back to the tier
+func (s *waitlistService) LeaveWaitlist(ctx context.Context, userID, tierID string) (err error) {
+	// Row locks are held until commit, the watchdog rolls back a stalled transaction
+	txCtx, txDone := s.txWatchdog.Watch(ctx, "leave_waitlist")
+	defer txDone()
+
+	tx, err := s.orderRepo.BeginTx(txCtx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// The tier is locked before its entries, like the waitlist worker and reservations do
+	if _, err = s.ticketTierRepo.GetByIDWithLock(txCtx, tx, tierID); err != nil {
+		if errors.Is(err, repository.ErrTicketTierNotFound) {
+			return ErrWaitlistEntryNotFound
+		}
+		return fmt.Errorf("failed to get ticket tier: %w", err)
+	}
+
+	entry, err := s.waitlistRepo.GetOpenByUserWithLock(txCtx, tx, tierID, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrWaitlistEntryNotFound) {
+			return ErrWaitlistEntryNotFound
+		}
+		return err
+	}
+
+	if entry.Status == entity.WaitlistStatusOffered {
+		if err = s.ticketTierRepo.ReleaseSoldCount(txCtx, tx, tierID, entry.Quantity, entity.InventoryCause{Reason: entity.InventoryReasonRelease}); err != nil {
+			return fmt.Errorf("failed to release sold count: %w", err)
+		}
+	}
+	if err = s.waitlistRepo.CloseWithTx(txCtx, tx, entry.ID, entity.WaitlistStatusCancelled); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	txDone()
+
+	if entry.Status == entity.WaitlistStatusOffered {
+		// Sold count changed, listing availability summary needs refresh
+		s.availability.MarkStale()
+	}
+	log.Printf("[WaitlistService] User %s left the waitlist of tier %s", userID, tierID)
+
+	return nil
+}
+
+// waitlistOffers are the offers made on the waitlist of a tier by one run
+type waitlistOffers struct {
+	tier    *entity.TicketTier
+	event   *entity.Event
+	entries []entity.WaitlistEntry
+}
+
+// ProcessWaitlists expires unused offers and offers free seats to the waiting buyers of each tier
+// that needs it, then emails the buyers their offers. Returns the number of offers made
+// A failing tier is logged and retried by the next run, the other tiers are processed
+func (s *waitlistService) ProcessWaitlists(ctx context.Context) (int, error) {
+	tierIDs, err := s.waitlistRepo.ListTiersToProcess(ctx, s.now(), waitlistTiersPerRun)
+	if err != nil {
+		return 0, err
+	}
+
+	offered := 0
+	for _, tierID := range tierIDs {
+		offers, err := s.processTier(ctx, tierID)
+		if err != nil {
+			log.Printf("[WaitlistService] Failed to process waitlist of tier %s: %v", tierID, err)
+			continue
+		}
+		if offers == nil {
+			continue
+		}
+
+		s.sendOffers(ctx, offers)
+		offered += len(offers.entries)
+	}
+
+	return offered, nil
+}
+
+// processTier expires the tier's unused offers and offers its free seats in line order, all or nothing
+// Buyers are served strictly first come first served: when the seats don't cover the next buyer's
+// quantity, nobody behind them gets an offer. Waitlists of tiers or events no longer on sale are closed.
+func (s *waitlistService) processTier(ctx context.Context, tierID string) (offers *waitlistOffers, err error) {
+	// Row locks are held until commit, the watchdog rolls back a stalled transaction
+	txCtx, txDone := s.txWatchdog.Watch(ctx, "process_waitlist")
+	defer txDone()
+
+	tx, err := s.orderRepo.BeginTx(txCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	tier, err := s.ticketTierRepo.GetByIDWithLock(txCtx, tx, tierID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket tier: %w", err)
+	}
+	event, err := s.eventRepo.GetByID(txCtx, tier.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	entries, err := s.waitlistRepo.ListOpenByTierWithLock(txCtx, tx, tierID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	released := 0
+	waiting := make([]entity.WaitlistEntry, 0, len(entries))
+	for _, entry := range entries {
+		switch {
+		case entry.Status == entity.WaitlistStatusWaiting:
+			waiting = append(waiting, entry)
+		case !entry.HasOffer(now):
+			if err = s.waitlistRepo.CloseWithTx(txCtx, tx, entry.ID, entity.WaitlistStatusExpired); err != nil {
+				return nil, err
+			}
+			released += entry.Quantity
+		}
+	}
+	if released > 0 {
+		if err = s.ticketTierRepo.ReleaseSoldCount(txCtx, tx, tierID, released, entity.InventoryCause{Reason: entity.InventoryReasonRelease}); err != nil {
+			return nil, fmt.Errorf("failed to release sold count: %w", err)
+		}
+		tier.SoldCount -= released
+	}
+
+	offers = &waitlistOffers{tier: tier, event: event}
+	closed := tier.IsArchived() || !event.IsActive() || event.HasEnded()
+	held := 0
+	available := tier.GetAvailableQuota()
+	expiresAt := now.Add(s.offerWindow)
+	for _, entry := range waiting {
+		if closed {
+			if err = s.waitlistRepo.CloseWithTx(txCtx, tx, entry.ID, entity.WaitlistStatusCancelled); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if entry.Quantity > available-held {
+			break
+		}
+
+		if err = s.waitlistRepo.OfferWithTx(txCtx, tx, entry.ID, expiresAt); err != nil {
+			return nil, err
+		}
+		entry.Status = entity.WaitlistStatusOffered
+		entry.OfferedAt = &now
+		entry.OfferExpiresAt = &expiresAt
+		offers.entries = append(offers.entries, entry)
+		held += entry.Quantity
+	}
+	if held > 0 {
+		if err = s.ticketTierRepo.UpdateSoldCount(txCtx, tx, tierID, held, entity.InventoryCause{Reason: entity.InventoryReasonReserve}); err != nil {
+			return nil, fmt.Errorf("failed to update sold count: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	txDone()
+
+	if released > 0 || held > 0 {
+		// Sold count changed, listing availability summary needs refresh
+		s.availability.MarkStale()
+		log.Printf("[WaitlistService] Tier %s: %d expired offer tickets released, %d tickets offered to %d buyers",
+			tierID, released, held, len(offers.entries))
+	}
+	if closed && len(waiting) > 0 {
+		log.Printf("[WaitlistService] Tier %s is no longer on sale, closed its waitlist of %d buyers", tierID, len(waiting))
+	}
+	if len(offers.entries) == 0 {
+		return nil, nil
+	}
+
+	return offers, nil
+}
+
+// sendOffers emails the buyers of new offers their purchase window
+// A failed email is logged; the offer stands and is shown by GET /ticket-tiers/:id/waitlist
+func (s *waitlistService) sendOffers(ctx context.Context, offers *waitlistOffers) {
+	branding := tenantEmailBranding(ctx, s.tenantRepo, offers.event.TenantID, offers.event.OrganizerID)
+
+	for _, entry := range offers.entries {
+		user, err := s.userRepo.GetByID(ctx, entry.UserID)
+		if err != nil {
+			log.Printf("[WaitlistService] Failed to get user %s for waitlist offer %s: %v", entry.UserID, entry.ID, err)
+			continue
+		}
+
+		err = s.sender.SendWaitlistOfferEmail(ctx, &client.SendWaitlistOfferEmailRequest{
+			EntryID:        entry.ID,
+			RecipientEmail: user.Email,
+			RecipientName:  user.FullName,
+			EventName:      offers.event.Name,
+			EventLocation:  offers.event.Location,
+			EventStartTime: offers.event.StartDate,
+			TierName:       offers.tier.Name,
+			Quantity:       entry.Quantity,
+			Price:          offers.tier.Price,
+			PurchaseURL:    s.baseURL + "/" + offers.event.ID,
+			OfferExpiresAt: *entry.OfferExpiresAt,
+			Branding:       branding,
+		})
+		if err != nil {
+			log.Printf("[WaitlistService] Failed to email waitlist offer %s: %v", entry.ID, err)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/money"
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/tenant"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/repository"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubWaitlistRepo keeps waitlist entries in memory, in line order
+type stubWaitlistRepo struct {
+	repository.WaitlistRepository
+	entries []*entity.WaitlistEntry
+}
+
+func (r *stubWaitlistRepo) Create(ctx context.Context, entry *entity.WaitlistEntry) error {
+	for _, existing := range r.entries {
+		if existing.TicketTierID == entry.TicketTierID && existing.UserID == entry.UserID && existing.IsOpen() {
+			return repository.ErrAlreadyOnWaitlist
+		}
+	}
+	entry.ID = "entry-" + entry.UserID
+	entry.Status = entity.WaitlistStatusWaiting
+	entry.CreatedAt = time.Now()
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func (r *stubWaitlistRepo) GetOpenByUser(ctx context.Context, tierID, userID string) (*entity.WaitlistEntry, error) {
+	for _, entry := range r.entries {
+		if entry.TicketTierID == tierID && entry.UserID == userID && entry.IsOpen() {
+			return entry, nil
+		}
+	}
+	return nil, repository.ErrWaitlistEntryNotFound
+}
+
+func (r *stubWaitlistRepo) CountAhead(ctx context.Context, entry *entity.WaitlistEntry) (int, error) {
+	ahead := 0
+	for _, other := range r.entries {
+		if other == entry {
+			break
+		}
+		if other.TicketTierID == entry.TicketTierID && other.Status == entity.WaitlistStatusWaiting {
+			ahead++
+		}
+	}
+	return ahead, nil
+}
+
+func newWaitlistFixture() (*waitlistService, *stubWaitlistRepo) {
+	now := time.Now()
+	waitlistRepo := &stubWaitlistRepo{}
+	svc := &waitlistService{
+		waitlistRepo: waitlistRepo,
+		eventRepo: &stubEventRepo{event: &entity.Event{
+			ID:          "event-1",
+			TenantID:    tenant.DefaultID,
+			Name:        "Jazz Night",
+			Location:    "Jakarta",
+			OrganizerID: "organizer-1",
+			Status:      entity.EventStatusPublished,
+			StartDate:   now.Add(7 * 24 * time.Hour),
+			EndDate:     now.Add(8 * 24 * time.Hour),
+		}},
+		ticketTierRepo: &stubTicketTierRepo{tiers: map[string]*entity.TicketTier{
+			"tier-vip": {ID: "tier-vip", EventID: "event-1", Name: "VIP", Price: money.New(500000), Quota: 10, SoldCount: 9, MaxPerOrder: 4},
+		}},
+		offerWindow: 30 * time.Minute,
+		baseURL:     "https://tickets.example/events",
+		now:         func() time.Time { return now },
+	}
+	return svc, waitlistRepo
+}
+
+func TestJoinWaitlist_InLineOrder(t *testing.T) {
+	svc, waitlistRepo := newWaitlistFixture()
+	svc.ticketTierRepo.(*stubTicketTierRepo).tiers["tier-vip"].SoldCount = 10
+
+	first, err := svc.JoinWaitlist(context.Background(), "user-1", "tier-vip", &request.JoinWaitlistRequest{Quantity: 2})
+	require.NoError(t, err)
+	second, err := svc.JoinWaitlist(context.Background(), "user-2", "tier-vip", &request.JoinWaitlistRequest{})
+	require.NoError(t, err)
+
+	assert.Equal(t, entity.WaitlistStatusWaiting, first.Status)
+	assert.Equal(t, 1, first.Position)
+	assert.Equal(t, 2, second.Position)
+	assert.Equal(t, 1, second.Quantity, "quantity defaults to 1")
+	require.Len(t, waitlistRepo.entries, 2)
+	assert.Equal(t, "event-1", waitlistRepo.entries[0].EventID)
+}
+
+func TestJoinWaitlist_Rejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		mutate   func(svc *waitlistService)
+		quantity int
+		wantErr  error
+	}{
+		{
+			name:     "enough tickets left",
+			quantity: 1,
+			wantErr:  ErrTierNotSoldOut,
+		},
+		{
+			name:     "more than the tier sells per order",
+			quantity: 5,
+			wantErr:  ErrMaxPerOrderExceeded,
+		},
+		{
+			name: "archived tier",
+			mutate: func(svc *waitlistService) {
+				archivedAt := time.Now()
+				svc.ticketTierRepo.(*stubTicketTierRepo).tiers["tier-vip"].ArchivedAt = &archivedAt
+			},
+			quantity: 2,
+			wantErr:  ErrTicketTierNotFound,
+		},
+		{
+			name: "event of another tenant",
+			mutate: func(svc *waitlistService) {
+				svc.eventRepo.(*stubEventRepo).event.TenantID = "tenant-2"
+			},
+			quantity: 2,
+			wantErr:  ErrEventNotFound,
+		},
+		{
+			name: "cancelled event",
+			mutate: func(svc *waitlistService) {
+				svc.eventRepo.(*stubEventRepo).event.Status = entity.EventStatusCancelled
+			},
+			quantity: 2,
+			wantErr:  ErrEventNotOnSale,
+		},
+		{
+			name: "ended event",
+			mutate: func(svc *waitlistService) {
+				svc.eventRepo.(*stubEventRepo).event.EndDate = time.Now().Add(-time.Hour)
+			},
+			quantity: 2,
+			wantErr:  ErrEventNotOnSale,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, waitlistRepo := newWaitlistFixture()
+			if tt.mutate != nil {
+				tt.mutate(svc)
+			}
+
+			_, err := svc.JoinWaitlist(context.Background(), "user-1", "tier-vip", &request.JoinWaitlistRequest{Quantity: tt.quantity})
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Empty(t, waitlistRepo.entries)
+		})
+	}
+}
+
+func TestJoinWaitlist_AlreadyOnWaitlist(t *testing.T) {
+	svc, _ := newWaitlistFixture()
+
+	_, err := svc.JoinWaitlist(context.Background(), "user-1", "tier-vip", &request.JoinWaitlistRequest{Quantity: 2})
+	require.NoError(t, err)
+
+	_, err = svc.JoinWaitlist(context.Background(), "user-1", "tier-vip", &request.JoinWaitlistRequest{Quantity: 3})
+	assert.ErrorIs(t, err, ErrAlreadyOnWaitlist)
+}
+
+func TestGetWaitlistEntry(t *testing.T) {
+	svc, waitlistRepo := newWaitlistFixture()
+	expiresAt := time.Now().Add(20 * time.Minute)
+	waitlistRepo.entries = []*entity.WaitlistEntry{
+		{ID: "entry-1", TicketTierID: "tier-vip", UserID: "user-1", Quantity: 2, Status: entity.WaitlistStatusOffered, OfferExpiresAt: &expiresAt},
+		{ID: "entry-2", TicketTierID: "tier-vip", UserID: "user-2", Quantity: 1, Status: entity.WaitlistStatusWaiting},
+		{ID: "entry-3", TicketTierID: "tier-vip", UserID: "user-3", Quantity: 1, Status: entity.WaitlistStatusWaiting},
+	}
+
+	offered, err := svc.GetWaitlistEntry(context.Background(), "user-1", "tier-vip")
+	require.NoError(t, err)
+	assert.Equal(t, entity.WaitlistStatusOffered, offered.Status)
+	assert.Zero(t, offered.Position, "an offered entry is no longer in line")
+	assert.Equal(t, &expiresAt, offered.OfferExpiresAt)
+
+	waiting, err := svc.GetWaitlistEntry(context.Background(), "user-3", "tier-vip")
+	require.NoError(t, err)
+	assert.Equal(t, 2, waiting.Position, "offered entries are not counted ahead")
+
+	_, err = svc.GetWaitlistEntry(context.Background(), "user-4", "tier-vip")
+	assert.ErrorIs(t, err, ErrWaitlistEntryNotFound)
+}
+
+func TestSendOffers(t *testing.T) {
+	svc, _ := newWaitlistFixture()
+	sender := &testutil.NotificationClient{}
+	svc.sender = sender
+	svc.userRepo = &stubUserRepo{user: &entity.User{ID: "user-1", Email: "ana@example.com", FullName: "Ana"}}
+	svc.tenantRepo = &stubTenantRepo{}
+
+	expiresAt := time.Now().Add(30 * time.Minute)
+	tier := svc.ticketTierRepo.(*stubTicketTierRepo).tiers["tier-vip"]
+	svc.sendOffers(context.Background(), &waitlistOffers{
+		tier:  tier,
+		event: svc.eventRepo.(*stubEventRepo).event,
+		entries: []entity.WaitlistEntry{
+			{ID: "entry-1", TicketTierID: "tier-vip", UserID: "user-1", Quantity: 2, Status: entity.WaitlistStatusOffered, OfferExpiresAt: &expiresAt},
+		},
+	})
+
+	calls := sender.SendWaitlistOfferEmailCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "entry-1", calls[0].EntryID)
+	assert.Equal(t, "ana@example.com", calls[0].RecipientEmail)
+	assert.Equal(t, "VIP", calls[0].TierName)
+	assert.Equal(t, 2, calls[0].Quantity)
+	assert.Equal(t, "https://tickets.example/events/event-1", calls[0].PurchaseURL)
+	assert.Equal(t, expiresAt, calls[0].OfferExpiresAt)
+	assert.Nil(t, calls[0].Branding, "events of the default tenant are not branded")
+}
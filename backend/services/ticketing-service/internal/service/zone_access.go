@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrZoneNotFound         = errors.New("gate zone not found for this event")
+	ErrTicketZoneNotAllowed = errors.New("ticket is not allowed in this zone")
+)
+
+// ticketZones returns the zone codes a ticket tier may enter, empty when unrestricted
+func (s *ticketService) ticketZones(ctx context.Context, tierID string) ([]string, error) {
+	zones, err := s.zoneRepo.GetCodesByTierIDs(ctx, []string{tierID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket zones: %w", err)
+	}
+	return zones[tierID], nil
+}
+
+// checkGateZone checks that a ticket allowed in zones may enter through a gate of the event
+// The current tier mapping is used rather than the QR claims, so zone changes
+// apply to tickets issued before them
+func (s *ticketService) checkGateZone(ctx context.Context, eventID, gate string, zones []string) error {
+	if gate == "" {
+		return nil
+	}
+
+	exists, err := s.zoneRepo.ExistsForEvent(ctx, eventID, gate)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrZoneNotFound
+	}
+
+	if len(zones) > 0 && !slices.Contains(zones, gate) {
+		return ErrTicketZoneNotAllowed
+	}
+
+	return nil
+}
+
+// normalizeZone formats a gate zone code the way zone codes are stored
+func normalizeZone(zone string) string {
+	return strings.ToUpper(strings.TrimSpace(zone))
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/entity"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/payload/request"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/utility"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newZoneFixture() (*ticketService, *stubTicketRepo, *stubScanRepo) {
+	svc, tickets, _ := newTicketFixture()
+	svc.zoneRepo = &stubZoneRepo{
+		eventZones: map[string][]string{"event-1": {"GA", "VIP"}},
+		tierZones:  map[string][]string{"tier-vip": {"GA", "VIP"}, "tier-ga": {"GA"}},
+	}
+	tickets.tickets["ticket-1"].TicketTierID = "tier-ga"
+	return svc, tickets, svc.scanRepo.(*stubScanRepo)
+}
+
+func TestTicketService_ValidateTicketChecksGateZone(t *testing.T) {
+	svc, tickets, scans := newZoneFixture()
+	ctx := context.Background()
+	qrData := tickets.tickets["ticket-1"].QRData
+
+	_, err := svc.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: qrData, Zone: "VIP"})
+	assert.ErrorIs(t, err, ErrTicketZoneNotAllowed)
+
+	_, err = svc.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: qrData, Zone: "BACKSTAGE"})
+	assert.ErrorIs(t, err, ErrZoneNotFound)
+	assert.Empty(t, scans.scans)
+
+	// Gate zone codes are matched case-insensitively
+	validated, err := svc.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: qrData, Zone: "ga"})
+	require.NoError(t, err)
+	assert.Equal(t, entity.TicketStatusUsed, validated.Status)
+	require.Len(t, scans.scans, 1)
+	require.NotNil(t, scans.scans[0].Zone)
+	assert.Equal(t, "GA", *scans.scans[0].Zone)
+}
+
+func TestTicketService_ValidateTicketUnrestrictedTier(t *testing.T) {
+	svc, tickets, _ := newZoneFixture()
+	ctx := context.Background()
+	tickets.tickets["ticket-1"].TicketTierID = "tier-no-zones"
+
+	_, err := svc.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: tickets.tickets["ticket-1"].QRData, Zone: "VIP"})
+	assert.NoError(t, err)
+}
+
+func TestTicketService_VerifyTicketReportsZones(t *testing.T) {
+	svc, tickets, _ := newZoneFixture()
+	ctx := context.Background()
+	qrData := tickets.tickets["ticket-1"].QRData
+
+	verification, err := svc.VerifyTicket(ctx, &request.ValidateTicketRequest{QRData: qrData, Zone: "VIP"})
+	require.NoError(t, err)
+	assert.False(t, verification.Valid)
+	assert.Equal(t, []string{"GA"}, verification.Zones)
+
+	verification, err = svc.VerifyTicket(ctx, &request.ValidateTicketRequest{QRData: qrData, Zone: "GA"})
+	require.NoError(t, err)
+	assert.True(t, verification.Valid)
+}
+
+func TestTicketService_RegenerateQRIncludesZoneClaims(t *testing.T) {
+	svc, tickets, _ := newZoneFixture()
+	ctx := context.Background()
+	tickets.tickets["ticket-1"].TicketTierID = "tier-vip"
+
+	_, err := svc.RegenerateQR(ctx, "owner", "ticket-1")
+	require.NoError(t, err)
+
+	claims, err := utility.ParseTicketQRData(tickets.tickets["ticket-1"].QRData)
+	require.NoError(t, err)
+	assert.Equal(t, "ticket-1", claims.TicketID)
+	assert.Equal(t, []string{"GA", "VIP"}, claims.Zones)
+
+	_, err = svc.ValidateTicket(ctx, &request.ValidateTicketRequest{QRData: tickets.tickets["ticket-1"].QRData, Zone: "VIP"})
+	assert.NoError(t, err)
+}
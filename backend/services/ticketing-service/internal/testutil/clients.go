@@ -0,0 +1,282 @@
+// Package testutil provides test doubles for ticketing-service dependencies
+// so services can be unit tested without live gRPC connections.
+package testutil
+
+import (
+	"context"
+	"sync"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/client"
+)
+
+// NotificationClient is a test double for service.NotificationClient, service.BadgeRenderer,
+// service.AnnouncementSender, service.ReminderSender, service.InvitationSender, service.WaitlistOfferSender and service.DigestPreferenceClient
+// Calls are recorded; the Func fields override the default results
+type NotificationClient struct {
+	SendTicketEmailFunc              func(ctx context.Context, req *client.SendTicketEmailRequest) error
+	GenerateBadgePDFFunc             func(ctx context.Context, req *client.GenerateBadgePDFRequest) ([]byte, error)
+	SendAnnouncementEmailFunc        func(ctx context.Context, req *client.SendAnnouncementEmailRequest) (*client.SendAnnouncementEmailResponse, error)
+	SendReservationReminderEmailFunc func(ctx context.Context, req *client.SendReservationReminderEmailRequest) error
+	SendInvitationEmailFunc          func(ctx context.Context, req *client.SendInvitationEmailRequest) error
+	SendWaitlistOfferEmailFunc       func(ctx context.Context, req *client.SendWaitlistOfferEmailRequest) error
+	SetDigestPreferenceFunc          func(ctx context.Context, email, frequency string) (*client.DigestPreference, error)
+
+	mu                sync.Mutex
+	calls             []*client.SendTicketEmailRequest
+	badgeCalls        []*client.GenerateBadgePDFRequest
+	announcementCalls []*client.SendAnnouncementEmailRequest
+	reminderCalls     []*client.SendReservationReminderEmailRequest
+	invitationCalls   []*client.SendInvitationEmailRequest
+	waitlistCalls     []*client.SendWaitlistOfferEmailRequest
+	digestFrequencies map[string]string
+}
+
+// SendTicketEmail records the request and returns SendTicketEmailFunc's result
+func (m *NotificationClient) SendTicketEmail(ctx context.Context, req *client.SendTicketEmailRequest) error {
+	m.mu.Lock()
+	m.calls = append(m.calls, req)
+	m.mu.Unlock()
+
+	if m.SendTicketEmailFunc != nil {
+		return m.SendTicketEmailFunc(ctx, req)
+	}
+	return nil
+}
+
+// SendTicketEmailCalls returns recorded SendTicketEmail requests
+func (m *NotificationClient) SendTicketEmailCalls() []*client.SendTicketEmailRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*client.SendTicketEmailRequest(nil), m.calls...)
+}
+
+// GenerateBadgePDF records the request and returns GenerateBadgePDFFunc's result
+// Defaults to a minimal PDF header
+func (m *NotificationClient) GenerateBadgePDF(ctx context.Context, req *client.GenerateBadgePDFRequest) ([]byte, error) {
+	m.mu.Lock()
+	m.badgeCalls = append(m.badgeCalls, req)
+	m.mu.Unlock()
+
+	if m.GenerateBadgePDFFunc != nil {
+		return m.GenerateBadgePDFFunc(ctx, req)
+	}
+	return []byte("%PDF-1.3"), nil
+}
+
+// GenerateBadgePDFCalls returns recorded GenerateBadgePDF requests
+func (m *NotificationClient) GenerateBadgePDFCalls() []*client.GenerateBadgePDFRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*client.GenerateBadgePDFRequest(nil), m.badgeCalls...)
+}
+
+// SendAnnouncementEmail records the request and returns SendAnnouncementEmailFunc's result
+// Defaults to every recipient sent, counted against the quota as if none were used before
+func (m *NotificationClient) SendAnnouncementEmail(ctx context.Context, req *client.SendAnnouncementEmailRequest) (*client.SendAnnouncementEmailResponse, error) {
+	m.mu.Lock()
+	m.announcementCalls = append(m.announcementCalls, req)
+	m.mu.Unlock()
+
+	if m.SendAnnouncementEmailFunc != nil {
+		return m.SendAnnouncementEmailFunc(ctx, req)
+	}
+	return &client.SendAnnouncementEmailResponse{
+		SentCount:  len(req.RecipientEmails),
+		QuotaUsed:  len(req.RecipientEmails),
+		QuotaLimit: req.MonthlyEmailQuota,
+	}, nil
+}
+
+// SendAnnouncementEmailCalls returns recorded SendAnnouncementEmail requests
+func (m *NotificationClient) SendAnnouncementEmailCalls() []*client.SendAnnouncementEmailRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*client.SendAnnouncementEmailRequest(nil), m.announcementCalls...)
+}
+
+// SendReservationReminderEmail records the request and returns SendReservationReminderEmailFunc's result
+func (m *NotificationClient) SendReservationReminderEmail(ctx context.Context, req *client.SendReservationReminderEmailRequest) error {
+	m.mu.Lock()
+	m.reminderCalls = append(m.reminderCalls, req)
+	m.mu.Unlock()
+
+	if m.SendReservationReminderEmailFunc != nil {
+		return m.SendReservationReminderEmailFunc(ctx, req)
+	}
+	return nil
+}
+
+// SendReservationReminderEmailCalls returns recorded SendReservationReminderEmail requests
+func (m *NotificationClient) SendReservationReminderEmailCalls() []*client.SendReservationReminderEmailRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*client.SendReservationReminderEmailRequest(nil), m.reminderCalls...)
+}
+
+// SendInvitationEmail records the request and returns SendInvitationEmailFunc's result
+func (m *NotificationClient) SendInvitationEmail(ctx context.Context, req *client.SendInvitationEmailRequest) error {
+	m.mu.Lock()
+	m.invitationCalls = append(m.invitationCalls, req)
+	m.mu.Unlock()
+
+	if m.SendInvitationEmailFunc != nil {
+		return m.SendInvitationEmailFunc(ctx, req)
+	}
+	return nil
+}
+
+// SendInvitationEmailCalls returns recorded SendInvitationEmail requests
+func (m *NotificationClient) SendInvitationEmailCalls() []*client.SendInvitationEmailRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*client.SendInvitationEmailRequest(nil), m.invitationCalls...)
+}
+
+// SendWaitlistOfferEmail records the request and returns SendWaitlistOfferEmailFunc's result
+func (m *NotificationClient) SendWaitlistOfferEmail(ctx context.Context, req *client.SendWaitlistOfferEmailRequest) error {
+	m.mu.Lock()
+	m.waitlistCalls = append(m.waitlistCalls, req)
+	m.mu.Unlock()
+
+	if m.SendWaitlistOfferEmailFunc != nil {
+		return m.SendWaitlistOfferEmailFunc(ctx, req)
+	}
+	return nil
+}
+
+// SendWaitlistOfferEmailCalls returns recorded SendWaitlistOfferEmail requests
+func (m *NotificationClient) SendWaitlistOfferEmailCalls() []*client.SendWaitlistOfferEmailRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*client.SendWaitlistOfferEmailRequest(nil), m.waitlistCalls...)
+}
+
+// GetDigestPreference returns the frequency last set for email, immediate by default
+func (m *NotificationClient) GetDigestPreference(ctx context.Context, email string) (*client.DigestPreference, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	frequency := m.digestFrequencies[email]
+	if frequency == "" {
+		frequency = "immediate"
+	}
+	return &client.DigestPreference{Email: email, Frequency: frequency, DigestTypes: []string{"announcement"}}, nil
+}
+
+// SetDigestPreference records the frequency and returns SetDigestPreferenceFunc's result
+func (m *NotificationClient) SetDigestPreference(ctx context.Context, email, frequency string) (*client.DigestPreference, error) {
+	if m.SetDigestPreferenceFunc != nil {
+		return m.SetDigestPreferenceFunc(ctx, email, frequency)
+	}
+
+	m.mu.Lock()
+	if m.digestFrequencies == nil {
+		m.digestFrequencies = make(map[string]string)
+	}
+	m.digestFrequencies[email] = frequency
+	m.mu.Unlock()
+
+	return m.GetDigestPreference(ctx, email)
+}
+
+// PaymentClient is a test double for service.PaymentClient
+// Calls are recorded; the Func fields override the default results
+type PaymentClient struct {
+	CreateInvoiceFunc    func(ctx context.Context, req *client.CreateInvoiceRequest) (*client.CreateInvoiceResponse, error)
+	GetPaymentStatusFunc func(ctx context.Context, orderID string) (*client.CreateInvoiceResponse, error)
+	ExpireInvoiceFunc    func(ctx context.Context, orderID, reason string) (*client.ExpireInvoiceResponse, error)
+	RecordEmailFunc      func(ctx context.Context, orderID, paymentID string) (bool, error)
+
+	mu                 sync.Mutex
+	createInvoiceCalls []*client.CreateInvoiceRequest
+	paymentStatusCalls []string
+	expireInvoiceCalls []string
+	recordEmailCalls   []string
+}
+
+// CreateInvoice records the request and returns CreateInvoiceFunc's result
+// Defaults to a pending invoice for the requested order
+func (m *PaymentClient) CreateInvoice(ctx context.Context, req *client.CreateInvoiceRequest) (*client.CreateInvoiceResponse, error) {
+	m.mu.Lock()
+	m.createInvoiceCalls = append(m.createInvoiceCalls, req)
+	m.mu.Unlock()
+
+	if m.CreateInvoiceFunc != nil {
+		return m.CreateInvoiceFunc(ctx, req)
+	}
+	return &client.CreateInvoiceResponse{
+		PaymentID:  "payment-" + req.OrderID,
+		InvoiceID:  "invoice-" + req.OrderID,
+		InvoiceURL: "https://checkout.example.com/" + req.OrderID,
+		ExternalID: req.OrderID,
+		Amount:     req.Amount,
+		Status:     "PENDING",
+	}, nil
+}
+
+// GetPaymentStatus records the order ID and returns GetPaymentStatusFunc's result
+// Defaults to a pending payment with no invoice URL
+func (m *PaymentClient) GetPaymentStatus(ctx context.Context, orderID string) (*client.CreateInvoiceResponse, error) {
+	m.mu.Lock()
+	m.paymentStatusCalls = append(m.paymentStatusCalls, orderID)
+	m.mu.Unlock()
+
+	if m.GetPaymentStatusFunc != nil {
+		return m.GetPaymentStatusFunc(ctx, orderID)
+	}
+	return &client.CreateInvoiceResponse{ExternalID: orderID, Status: "PENDING"}, nil
+}
+
+// ExpireInvoice records the order ID and returns ExpireInvoiceFunc's result
+// Defaults to one expired invoice
+func (m *PaymentClient) ExpireInvoice(ctx context.Context, orderID, reason string) (*client.ExpireInvoiceResponse, error) {
+	m.mu.Lock()
+	m.expireInvoiceCalls = append(m.expireInvoiceCalls, orderID)
+	m.mu.Unlock()
+
+	if m.ExpireInvoiceFunc != nil {
+		return m.ExpireInvoiceFunc(ctx, orderID, reason)
+	}
+	return &client.ExpireInvoiceResponse{InvoicesExpired: 1}, nil
+}
+
+// RecordTicketEmail records the order ID and returns RecordEmailFunc's result
+// Defaults to a recorded email
+func (m *PaymentClient) RecordTicketEmail(ctx context.Context, orderID, paymentID string) (bool, error) {
+	m.mu.Lock()
+	m.recordEmailCalls = append(m.recordEmailCalls, orderID)
+	m.mu.Unlock()
+
+	if m.RecordEmailFunc != nil {
+		return m.RecordEmailFunc(ctx, orderID, paymentID)
+	}
+	return true, nil
+}
+
+// CreateInvoiceCalls returns recorded CreateInvoice requests
+func (m *PaymentClient) CreateInvoiceCalls() []*client.CreateInvoiceRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*client.CreateInvoiceRequest(nil), m.createInvoiceCalls...)
+}
+
+// GetPaymentStatusCalls returns recorded GetPaymentStatus order IDs
+func (m *PaymentClient) GetPaymentStatusCalls() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.paymentStatusCalls...)
+}
+
+// ExpireInvoiceCalls returns recorded ExpireInvoice order IDs
+func (m *PaymentClient) ExpireInvoiceCalls() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.expireInvoiceCalls...)
+}
+
+// RecordTicketEmailCalls returns recorded RecordTicketEmail order IDs
+func (m *PaymentClient) RecordTicketEmailCalls() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.recordEmailCalls...)
+}
@@ -0,0 +1,71 @@
+package utility
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Dynamic QR codes follow TOTP (RFC 6238) with the ticket's QR secret as key
+const (
+	DynamicQRStep = 30 * time.Second
+	dynamicQRSkew = 1 // Steps accepted either side of the current one, for clock drift and scan delay
+)
+
+// GenerateQRSecret creates the per-ticket key of dynamic QR codes
+func GenerateQRSecret() string {
+	return rand.Text()
+}
+
+// DynamicQRCode returns the code of the time step containing t
+func DynamicQRCode(secret string, t time.Time) string {
+	return dynamicQRCodeAt(secret, uint64(t.Unix()/int64(DynamicQRStep/time.Second)))
+}
+
+// VerifyDynamicQRCode reports whether code is valid at t, allowing one step of skew
+func VerifyDynamicQRCode(secret, code string, t time.Time) bool {
+	step := t.Unix() / int64(DynamicQRStep/time.Second)
+	valid := false
+	for i := -dynamicQRSkew; i <= dynamicQRSkew; i++ {
+		// Every step is compared so the check takes the same time wherever the code matches
+		if hmac.Equal([]byte(code), []byte(dynamicQRCodeAt(secret, uint64(step+int64(i))))) {
+			valid = true
+		}
+	}
+	return valid
+}
+
+// DynamicQRExpiresAt returns when the code of the time step containing t stops being generated
+func DynamicQRExpiresAt(t time.Time) time.Time {
+	return t.Truncate(DynamicQRStep).Add(DynamicQRStep)
+}
+
+// GenerateDynamicTicketQRData creates the rotating QR payload of a ticket
+// Format: DTICKET|{ticket_id}|{event_id}|{code}[|{zone},{zone}...]
+func GenerateDynamicTicketQRData(ticketID, eventID, code string, zones []string) string {
+	data := fmt.Sprintf("DTICKET|%s|%s|%s", ticketID, eventID, code)
+	if len(zones) > 0 {
+		data += "|" + strings.Join(zones, ",")
+	}
+	return data
+}
+
+// dynamicQRCodeAt computes the HOTP value (RFC 4226) of a time step
+func dynamicQRCodeAt(secret string, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	// 8 digits
+	return fmt.Sprintf("%08d", value%100000000)
+}
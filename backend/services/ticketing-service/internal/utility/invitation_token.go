@@ -0,0 +1,43 @@
+package utility
+
+import (
+	"crypto/hmac"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidInvitationToken = errors.New("invalid invitation token")
+)
+
+// invitationTokenPrefix separates invitation signatures from share link signatures made with the same secret
+const invitationTokenPrefix = "invitation:"
+
+// GenerateInvitationToken creates the claim token of an event invitation
+// Format: {invitation_id}.{deadline_unix}.{base64url(HMAC-SHA256)}
+// The deadline is informational; whether the invitation can still be claimed is checked in the database
+func GenerateInvitationToken(secret, invitationID string, deadline time.Time) string {
+	payload := fmt.Sprintf("%s.%d", invitationID, deadline.Unix())
+	return payload + "." + signSharePayload(secret, invitationTokenPrefix+payload)
+}
+
+// ParseInvitationToken verifies the token signature and returns the invitation ID
+func ParseInvitationToken(secret, token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] == "" {
+		return "", ErrInvalidInvitationToken
+	}
+	if _, err := strconv.ParseInt(parts[1], 10, 64); err != nil {
+		return "", ErrInvalidInvitationToken
+	}
+
+	payload := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(signSharePayload(secret, invitationTokenPrefix+payload))) {
+		return "", ErrInvalidInvitationToken
+	}
+
+	return parts[0], nil
+}
@@ -0,0 +1,35 @@
+package utility
+
+import (
+	"crypto/hmac"
+	"errors"
+	"strings"
+)
+
+var (
+	ErrInvalidKioskToken = errors.New("invalid kiosk token")
+)
+
+// kioskTokenPrefix separates kiosk signatures from share link signatures made with the same secret
+const kioskTokenPrefix = "kiosk:"
+
+// GenerateKioskToken creates the device token of a check-in kiosk
+// Format: {kiosk_id}.{base64url(HMAC-SHA256)}
+// The token does not expire; kiosks are revoked in the database instead
+func GenerateKioskToken(secret, kioskID string) string {
+	return kioskID + "." + signSharePayload(secret, kioskTokenPrefix+kioskID)
+}
+
+// ParseKioskToken verifies the token signature and returns the kiosk ID
+func ParseKioskToken(secret, token string) (string, error) {
+	kioskID, signature, found := strings.Cut(token, ".")
+	if !found || kioskID == "" {
+		return "", ErrInvalidKioskToken
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(signSharePayload(secret, kioskTokenPrefix+kioskID))) {
+		return "", ErrInvalidKioskToken
+	}
+
+	return kioskID, nil
+}
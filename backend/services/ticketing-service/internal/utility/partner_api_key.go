@@ -0,0 +1,35 @@
+package utility
+
+import (
+	"crypto/hmac"
+	"errors"
+	"strings"
+)
+
+var (
+	ErrInvalidPartnerAPIKey = errors.New("invalid partner API key")
+)
+
+// partnerAPIKeyPrefix separates partner key signatures from other signatures made with the same secret
+const partnerAPIKeyPrefix = "partner:"
+
+// GeneratePartnerAPIKey creates the secret of a partner API key
+// Format: {key_id}.{base64url(HMAC-SHA256)}
+// The key does not expire; keys are revoked in the database instead
+func GeneratePartnerAPIKey(secret, keyID string) string {
+	return keyID + "." + signSharePayload(secret, partnerAPIKeyPrefix+keyID)
+}
+
+// ParsePartnerAPIKey verifies the key signature and returns the key ID
+func ParsePartnerAPIKey(secret, key string) (string, error) {
+	keyID, signature, found := strings.Cut(key, ".")
+	if !found || keyID == "" {
+		return "", ErrInvalidPartnerAPIKey
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(signSharePayload(secret, partnerAPIKeyPrefix+keyID))) {
+		return "", ErrInvalidPartnerAPIKey
+	}
+
+	return keyID, nil
+}
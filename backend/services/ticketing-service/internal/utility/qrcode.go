@@ -1,6 +1,7 @@
 package utility
 
 import (
+	"crypto/rand"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -28,33 +29,74 @@ func GenerateQRCode(data string) (string, error) {
 	return fmt.Sprintf("data:image/png;base64,%s", encoded), nil
 }
 
+// TicketQRClaims holds the fields encoded in a ticket QR payload
+type TicketQRClaims struct {
+	TicketID string
+	EventID  string
+	Zones    []string // Zone codes the ticket may enter, empty when unrestricted
+	Dynamic  bool     // Rotating payload fetched by the buyer's app, see GenerateDynamicTicketQRData
+	Code     string   // Time-based code of a dynamic payload
+}
+
 // GenerateTicketQRData creates the data string for ticket QR code
-func GenerateTicketQRData(ticketID, eventID string) string {
-	// Format: TICKET|{ticket_id}|{event_id}
+// The random nonce makes every generated payload unique, so a regenerated
+// QR code no longer matches the payload stored for the ticket.
+// Zone claims let offline gate scanners reject tickets at the wrong entrance.
+func GenerateTicketQRData(ticketID, eventID string, zones []string) string {
+	// Format: TICKET|{ticket_id}|{event_id}|{nonce}[|{zone},{zone}...]
 	// This can be scanned and validated at event entrance
-	return fmt.Sprintf("TICKET|%s|%s", ticketID, eventID)
+	data := fmt.Sprintf("TICKET|%s|%s|%s", ticketID, eventID, rand.Text())
+	if len(zones) > 0 {
+		data += "|" + strings.Join(zones, ",")
+	}
+	return data
 }
 
-// ParseTicketQRData parses QR data and extracts ticket ID and event ID
-func ParseTicketQRData(qrData string) (ticketID, eventID string, err error) {
-	// Expected format: TICKET|{ticket_id}|{event_id}
+// ParseTicketQRData parses QR data and extracts its claims
+func ParseTicketQRData(qrData string) (*TicketQRClaims, error) {
+	// Expected format: TICKET|{ticket_id}|{event_id}|{nonce}[|{zones}]
+	// Tickets issued before QR rotation use TICKET|{ticket_id}|{event_id}
+	// Dynamic payloads use DTICKET|{ticket_id}|{event_id}|{code}[|{zones}]
 	parts := strings.Split(qrData, "|")
 
-	if len(parts) != 3 {
-		return "", "", errors.New("invalid QR data format")
+	if len(parts) < 3 || len(parts) > 5 {
+		return nil, errors.New("invalid QR data format")
 	}
 
-	if parts[0] != "TICKET" {
-		return "", "", errors.New("invalid QR data prefix")
+	if parts[0] != "TICKET" && parts[0] != "DTICKET" {
+		return nil, errors.New("invalid QR data prefix")
 	}
 
-	ticketID = parts[1]
-	eventID = parts[2]
+	claims := &TicketQRClaims{
+		TicketID: parts[1],
+		EventID:  parts[2],
+		Dynamic:  parts[0] == "DTICKET",
+	}
 
 	// Basic validation - ensure they're not empty
-	if ticketID == "" || eventID == "" {
-		return "", "", errors.New("invalid ticket or event ID in QR data")
+	if claims.TicketID == "" || claims.EventID == "" {
+		return nil, errors.New("invalid ticket or event ID in QR data")
+	}
+
+	if len(parts) >= 4 && parts[3] == "" {
+		return nil, errors.New("invalid nonce in QR data")
+	}
+
+	if claims.Dynamic {
+		if len(parts) < 4 {
+			return nil, errors.New("missing code in dynamic QR data")
+		}
+		claims.Code = parts[3]
+	}
+
+	if len(parts) == 5 {
+		for _, zone := range strings.Split(parts[4], ",") {
+			if zone == "" {
+				return nil, errors.New("invalid zone in QR data")
+			}
+			claims.Zones = append(claims.Zones, zone)
+		}
 	}
 
-	return ticketID, eventID, nil
+	return claims, nil
 }
@@ -1,69 +0,0 @@
-package utility
-
-import (
-	"context"
-	"fmt"
-	"time"
-
-	"github.com/redis/go-redis/v9"
-)
-
-// RedisClient wraps redis client with helper methods
-type RedisClient struct {
-	client *redis.Client
-}
-
-// NewRedisClient creates a new Redis client
-func NewRedisClient(host, port, password string, db int) (*RedisClient, error) {
-	client := redis.NewClient(&redis.Options{
-		Addr:     fmt.Sprintf("%s:%s", host, port),
-		Password: password,
-		DB:       db,
-	})
-
-	// Test connection
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	if err := client.Ping(ctx).Err(); err != nil {
-		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
-	}
-
-	return &RedisClient{client: client}, nil
-}
-
-// GetClient returns the underlying Redis client
-func (r *RedisClient) GetClient() *redis.Client {
-	return r.client
-}
-
-// Close closes the Redis connection
-func (r *RedisClient) Close() error {
-	return r.client.Close()
-}
-
-// AcquireLock acquires a distributed lock
-func (r *RedisClient) AcquireLock(ctx context.Context, key string, expiration time.Duration) (bool, error) {
-	// Use SET NX (set if not exists) with expiration
-	return r.client.SetNX(ctx, key, "locked", expiration).Result()
-}
-
-// ReleaseLock releases a distributed lock
-func (r *RedisClient) ReleaseLock(ctx context.Context, key string) error {
-	return r.client.Del(ctx, key).Err()
-}
-
-// Get retrieves a value from Redis
-func (r *RedisClient) Get(ctx context.Context, key string) (string, error) {
-	return r.client.Get(ctx, key).Result()
-}
-
-// Set sets a value in Redis with expiration
-func (r *RedisClient) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
-	return r.client.Set(ctx, key, value, expiration).Err()
-}
-
-// Delete deletes a key from Redis
-func (r *RedisClient) Delete(ctx context.Context, key string) error {
-	return r.client.Del(ctx, key).Err()
-}
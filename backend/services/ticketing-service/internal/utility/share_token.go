@@ -0,0 +1,53 @@
+package utility
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidShareToken = errors.New("invalid share token")
+	ErrShareTokenExpired = errors.New("share token expired")
+)
+
+// GenerateShareToken creates a signed token for a ticket share link
+// Format: {link_id}.{expires_unix}.{base64url(HMAC-SHA256)}
+func GenerateShareToken(secret, linkID string, expiresAt time.Time) string {
+	payload := fmt.Sprintf("%s.%d", linkID, expiresAt.Unix())
+	return payload + "." + signSharePayload(secret, payload)
+}
+
+// ParseShareToken verifies the token signature and expiry and returns the share link ID
+func ParseShareToken(secret, token string, now time.Time) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] == "" {
+		return "", ErrInvalidShareToken
+	}
+
+	payload := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(signSharePayload(secret, payload))) {
+		return "", ErrInvalidShareToken
+	}
+
+	expiresUnix, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", ErrInvalidShareToken
+	}
+	if !now.Before(time.Unix(expiresUnix, 0)) {
+		return "", ErrShareTokenExpired
+	}
+
+	return parts[0], nil
+}
+
+func signSharePayload(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
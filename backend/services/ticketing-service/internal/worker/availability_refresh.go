@@ -0,0 +1,77 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// AvailabilityRefreshWorker refreshes the event availability summary after sold_count changes
+type AvailabilityRefreshWorker struct {
+	availabilityService service.AvailabilityService
+	interval            time.Duration
+	stopChan            chan struct{}
+}
+
+// NewAvailabilityRefreshWorker creates new availability refresh worker instance
+func NewAvailabilityRefreshWorker(
+	availabilityService service.AvailabilityService,
+	interval time.Duration,
+) *AvailabilityRefreshWorker {
+	return &AvailabilityRefreshWorker{
+		availabilityService: availabilityService,
+		interval:            interval,
+		stopChan:            make(chan struct{}),
+	}
+}
+
+// Start begins the availability refresh worker
+func (w *AvailabilityRefreshWorker) Start(ctx context.Context) {
+	log.Printf("[Worker] Availability refresh worker started (interval: %v)", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	// Run refresh immediately on start
+	w.runRefresh(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			w.runRefresh(ctx)
+		case <-w.stopChan:
+			log.Println("[Worker] Availability refresh worker stopped")
+			return
+		case <-ctx.Done():
+			log.Println("[Worker] Availability refresh worker stopped due to context cancellation")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the availability refresh worker
+func (w *AvailabilityRefreshWorker) Stop() {
+	close(w.stopChan)
+}
+
+// runRefresh executes the refresh operation
+// Runs frequently, so only refreshes and failures are logged
+func (w *AvailabilityRefreshWorker) runRefresh(ctx context.Context) {
+	defer errreport.Recover("availability_refresh_worker")
+
+	startTime := time.Now()
+	refreshed, err := w.availabilityService.RefreshIfStale(ctx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		log.Printf("[Worker] Availability refresh failed: %v (duration: %v)", err, duration)
+		return
+	}
+
+	if refreshed {
+		log.Printf("[Worker] Availability refresh completed (duration: %v)", duration)
+	}
+}
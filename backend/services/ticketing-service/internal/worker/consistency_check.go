@@ -0,0 +1,71 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// ConsistencyCheckWorker handles periodic data consistency checks
+type ConsistencyCheckWorker struct {
+	consistencyService service.ConsistencyService
+	interval           time.Duration
+	stopChan           chan struct{}
+}
+
+// NewConsistencyCheckWorker creates new consistency check worker instance
+func NewConsistencyCheckWorker(
+	consistencyService service.ConsistencyService,
+	interval time.Duration,
+) *ConsistencyCheckWorker {
+	return &ConsistencyCheckWorker{
+		consistencyService: consistencyService,
+		interval:           interval,
+		stopChan:           make(chan struct{}),
+	}
+}
+
+// Start begins the consistency check worker
+func (w *ConsistencyCheckWorker) Start(ctx context.Context) {
+	log.Printf("[Worker] Consistency check worker started (interval: %v)", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.runChecks(ctx)
+		case <-w.stopChan:
+			log.Println("[Worker] Consistency check worker stopped")
+			return
+		case <-ctx.Done():
+			log.Println("[Worker] Consistency check worker stopped due to context cancellation")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the consistency check worker
+func (w *ConsistencyCheckWorker) Stop() {
+	close(w.stopChan)
+}
+
+// runChecks executes the consistency checks
+func (w *ConsistencyCheckWorker) runChecks(ctx context.Context) {
+	defer errreport.Recover("consistency_check_worker")
+
+	log.Println("[Worker] Running consistency checks...")
+
+	report, err := w.consistencyService.RunChecks(ctx)
+	if err != nil {
+		log.Printf("[Worker] Consistency checks failed: %v", err)
+		return
+	}
+
+	log.Printf("[Worker] Consistency checks completed: %d discrepancies, %d healed (duration: %v)",
+		len(report.Discrepancies), report.Healed, report.Duration)
+}
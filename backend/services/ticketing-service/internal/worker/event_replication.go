@@ -0,0 +1,77 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// EventReplicationWorker applies event service's change feed to the local event replica
+type EventReplicationWorker struct {
+	replicationService service.EventReplicationService
+	interval           time.Duration
+	stopChan           chan struct{}
+}
+
+// NewEventReplicationWorker creates new event replication worker instance
+func NewEventReplicationWorker(
+	replicationService service.EventReplicationService,
+	interval time.Duration,
+) *EventReplicationWorker {
+	return &EventReplicationWorker{
+		replicationService: replicationService,
+		interval:           interval,
+		stopChan:           make(chan struct{}),
+	}
+}
+
+// Start begins the event replication worker
+func (w *EventReplicationWorker) Start(ctx context.Context) {
+	log.Printf("[Worker] Event replication worker started (interval: %v)", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	// Run sync immediately on start
+	w.runSync(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			w.runSync(ctx)
+		case <-w.stopChan:
+			log.Println("[Worker] Event replication worker stopped")
+			return
+		case <-ctx.Done():
+			log.Println("[Worker] Event replication worker stopped due to context cancellation")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the event replication worker
+func (w *EventReplicationWorker) Stop() {
+	close(w.stopChan)
+}
+
+// runSync executes the sync operation
+// Runs frequently, so only applied changes and failures are logged
+func (w *EventReplicationWorker) runSync(ctx context.Context) {
+	defer errreport.Recover("event_replication_worker")
+
+	startTime := time.Now()
+	replicated, err := w.replicationService.Sync(ctx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		log.Printf("[Worker] Event replication failed after %d events: %v (duration: %v)", replicated, err, duration)
+		return
+	}
+
+	if replicated > 0 {
+		log.Printf("[Worker] Event replication completed: %d events (duration: %v)", replicated, duration)
+	}
+}
@@ -0,0 +1,77 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// InsuranceIssuanceWorker issues the refund-protection insurance of paid orders with the partner
+type InsuranceIssuanceWorker struct {
+	insuranceService service.InsuranceService
+	interval         time.Duration
+	stopChan         chan struct{}
+}
+
+// NewInsuranceIssuanceWorker creates new insurance issuance worker instance
+func NewInsuranceIssuanceWorker(
+	insuranceService service.InsuranceService,
+	interval time.Duration,
+) *InsuranceIssuanceWorker {
+	return &InsuranceIssuanceWorker{
+		insuranceService: insuranceService,
+		interval:         interval,
+		stopChan:         make(chan struct{}),
+	}
+}
+
+// Start begins the insurance issuance worker
+func (w *InsuranceIssuanceWorker) Start(ctx context.Context) {
+	log.Printf("[Worker] Insurance issuance worker started (interval: %v)", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	// Run issuance immediately on start
+	w.runIssuance(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			w.runIssuance(ctx)
+		case <-w.stopChan:
+			log.Println("[Worker] Insurance issuance worker stopped")
+			return
+		case <-ctx.Done():
+			log.Println("[Worker] Insurance issuance worker stopped due to context cancellation")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the insurance issuance worker
+func (w *InsuranceIssuanceWorker) Stop() {
+	close(w.stopChan)
+}
+
+// runIssuance executes the issuance operation
+// Runs frequently, so only issued policies and failures are logged
+func (w *InsuranceIssuanceWorker) runIssuance(ctx context.Context) {
+	defer errreport.Recover("insurance_issuance_worker")
+
+	startTime := time.Now()
+	issued, err := w.insuranceService.IssuePaidPolicies(ctx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		log.Printf("[Worker] Insurance issuance failed: %v (duration: %v)", err, duration)
+		return
+	}
+
+	if issued > 0 {
+		log.Printf("[Worker] Insurance issuance completed: %d policies issued (duration: %v)", issued, duration)
+	}
+}
@@ -0,0 +1,77 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// NotificationOutboxWorker retries emails that failed to send, such as e-ticket emails
+type NotificationOutboxWorker struct {
+	outboxService service.NotificationOutboxService
+	interval      time.Duration
+	stopChan      chan struct{}
+}
+
+// NewNotificationOutboxWorker creates new notification outbox worker instance
+func NewNotificationOutboxWorker(
+	outboxService service.NotificationOutboxService,
+	interval time.Duration,
+) *NotificationOutboxWorker {
+	return &NotificationOutboxWorker{
+		outboxService: outboxService,
+		interval:      interval,
+		stopChan:      make(chan struct{}),
+	}
+}
+
+// Start begins the notification outbox worker
+func (w *NotificationOutboxWorker) Start(ctx context.Context) {
+	log.Printf("[Worker] Notification outbox worker started (interval: %v)", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	// Send due messages immediately on start
+	w.sendDue(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			w.sendDue(ctx)
+		case <-w.stopChan:
+			log.Println("[Worker] Notification outbox worker stopped")
+			return
+		case <-ctx.Done():
+			log.Println("[Worker] Notification outbox worker stopped due to context cancellation")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the notification outbox worker
+func (w *NotificationOutboxWorker) Stop() {
+	close(w.stopChan)
+}
+
+// sendDue sends one batch of due messages
+// Runs frequently, so only sent messages and failures are logged
+func (w *NotificationOutboxWorker) sendDue(ctx context.Context) {
+	defer errreport.Recover("notification_outbox_worker")
+
+	startTime := time.Now()
+	sent, err := w.outboxService.SendDue(ctx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		log.Printf("[Worker] Notification outbox failed: %v (duration: %v)", err, duration)
+		return
+	}
+
+	if sent > 0 {
+		log.Printf("[Worker] Notification outbox completed: %d emails sent (duration: %v)", sent, duration)
+	}
+}
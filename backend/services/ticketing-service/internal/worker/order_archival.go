@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// OrderArchivalWorker handles periodic archival of old terminal orders
+type OrderArchivalWorker struct {
+	archiveService service.ArchiveService
+	interval       time.Duration
+	stopChan       chan struct{}
+}
+
+// NewOrderArchivalWorker creates new archival worker instance
+func NewOrderArchivalWorker(
+	archiveService service.ArchiveService,
+	interval time.Duration,
+) *OrderArchivalWorker {
+	return &OrderArchivalWorker{
+		archiveService: archiveService,
+		interval:       interval,
+		stopChan:       make(chan struct{}),
+	}
+}
+
+// Start begins the archival worker
+func (w *OrderArchivalWorker) Start(ctx context.Context) {
+	log.Printf("[Worker] Order archival worker started (interval: %v)", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	// Run archival immediately on start
+	w.runArchival(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			w.runArchival(ctx)
+		case <-w.stopChan:
+			log.Println("[Worker] Order archival worker stopped")
+			return
+		case <-ctx.Done():
+			log.Println("[Worker] Order archival worker stopped due to context cancellation")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the archival worker
+func (w *OrderArchivalWorker) Stop() {
+	close(w.stopChan)
+}
+
+// runArchival executes the archival operation
+func (w *OrderArchivalWorker) runArchival(ctx context.Context) {
+	defer errreport.Recover("order_archival_worker")
+
+	log.Println("[Worker] Running order archival...")
+
+	startTime := time.Now()
+	count, err := w.archiveService.ArchiveOldOrders(ctx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		log.Printf("[Worker] Archival failed: %v (archived before failure: %d, duration: %v)", err, count, duration)
+		return
+	}
+
+	log.Printf("[Worker] Archival completed: %d orders archived (duration: %v)", count, duration)
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
 	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
 )
 
@@ -58,6 +59,8 @@ func (w *ReservationCleanupWorker) Stop() {
 
 // runCleanup executes the cleanup operation
 func (w *ReservationCleanupWorker) runCleanup(ctx context.Context) {
+	defer errreport.Recover("reservation_cleanup_worker")
+
 	log.Println("[Worker] Running reservation cleanup...")
 
 	startTime := time.Now()
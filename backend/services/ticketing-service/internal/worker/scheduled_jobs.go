@@ -0,0 +1,77 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// ScheduledJobWorker runs delayed jobs, such as reservation payment reminders, once they are due
+type ScheduledJobWorker struct {
+	jobService service.ScheduledJobService
+	interval   time.Duration
+	stopChan   chan struct{}
+}
+
+// NewScheduledJobWorker creates new scheduled job worker instance
+func NewScheduledJobWorker(
+	jobService service.ScheduledJobService,
+	interval time.Duration,
+) *ScheduledJobWorker {
+	return &ScheduledJobWorker{
+		jobService: jobService,
+		interval:   interval,
+		stopChan:   make(chan struct{}),
+	}
+}
+
+// Start begins the scheduled job worker
+func (w *ScheduledJobWorker) Start(ctx context.Context) {
+	log.Printf("[Worker] Scheduled job worker started (interval: %v)", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	// Run due jobs immediately on start
+	w.runJobs(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			w.runJobs(ctx)
+		case <-w.stopChan:
+			log.Println("[Worker] Scheduled job worker stopped")
+			return
+		case <-ctx.Done():
+			log.Println("[Worker] Scheduled job worker stopped due to context cancellation")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the scheduled job worker
+func (w *ScheduledJobWorker) Stop() {
+	close(w.stopChan)
+}
+
+// runJobs executes one batch of due jobs
+// Runs frequently, so only finished jobs and failures are logged
+func (w *ScheduledJobWorker) runJobs(ctx context.Context) {
+	defer errreport.Recover("scheduled_job_worker")
+
+	startTime := time.Now()
+	done, err := w.jobService.RunDueJobs(ctx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		log.Printf("[Worker] Scheduled jobs failed: %v (duration: %v)", err, duration)
+		return
+	}
+
+	if done > 0 {
+		log.Printf("[Worker] Scheduled jobs completed: %d jobs done (duration: %v)", done, duration)
+	}
+}
@@ -0,0 +1,77 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/raflibima25/event-ticketing-platform/backend/pkg/errreport"
+	"github.com/raflibima25/event-ticketing-platform/backend/services/ticketing-service/internal/service"
+)
+
+// WaitlistOfferWorker offers freed seats of sold-out tiers to their waitlists and expires unused offers
+type WaitlistOfferWorker struct {
+	waitlistService service.WaitlistService
+	interval        time.Duration
+	stopChan        chan struct{}
+}
+
+// NewWaitlistOfferWorker creates new waitlist offer worker instance
+func NewWaitlistOfferWorker(
+	waitlistService service.WaitlistService,
+	interval time.Duration,
+) *WaitlistOfferWorker {
+	return &WaitlistOfferWorker{
+		waitlistService: waitlistService,
+		interval:        interval,
+		stopChan:        make(chan struct{}),
+	}
+}
+
+// Start begins the waitlist offer worker
+func (w *WaitlistOfferWorker) Start(ctx context.Context) {
+	log.Printf("[Worker] Waitlist offer worker started (interval: %v)", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	// Run offers immediately on start
+	w.runOffers(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			w.runOffers(ctx)
+		case <-w.stopChan:
+			log.Println("[Worker] Waitlist offer worker stopped")
+			return
+		case <-ctx.Done():
+			log.Println("[Worker] Waitlist offer worker stopped due to context cancellation")
+			return
+		}
+	}
+}
+
+// Stop gracefully stops the waitlist offer worker
+func (w *WaitlistOfferWorker) Stop() {
+	close(w.stopChan)
+}
+
+// runOffers executes the offer operation
+// Runs frequently, so only offers made and failures are logged
+func (w *WaitlistOfferWorker) runOffers(ctx context.Context) {
+	defer errreport.Recover("waitlist_offer_worker")
+
+	startTime := time.Now()
+	offered, err := w.waitlistService.ProcessWaitlists(ctx)
+	duration := time.Since(startTime)
+
+	if err != nil {
+		log.Printf("[Worker] Waitlist offers failed: %v (duration: %v)", err, duration)
+		return
+	}
+
+	if offered > 0 {
+		log.Printf("[Worker] Waitlist offers completed: %d offers made (duration: %v)", offered, duration)
+	}
+}